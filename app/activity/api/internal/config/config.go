@@ -19,4 +19,9 @@ type Config struct {
 
 	// RPC 服务配置
 	ActivityRpc zrpc.RpcClientConf // 活动服务 RPC 客户端
+
+	// 日历订阅配置
+	Calendar struct {
+		FeedBaseURL string `json:",optional"` // 订阅地址的对外访问域名，如 https://campushub.example.com
+	}
 }
@@ -0,0 +1,25 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package activity
+
+import (
+	"net/http"
+
+	"activity-platform/app/activity/api/internal/logic/activity"
+	"activity-platform/app/activity/api/internal/svc"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// 重置日历订阅地址（旧地址立即失效）
+func ResetCalendarFeedUrlHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		l := activity.NewResetCalendarFeedUrlLogic(r.Context(), svcCtx)
+		resp, err := l.ResetCalendarFeedUrl()
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
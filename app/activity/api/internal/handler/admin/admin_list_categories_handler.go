@@ -0,0 +1,25 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package admin
+
+import (
+	"net/http"
+
+	"activity-platform/app/activity/api/internal/logic/admin"
+	"activity-platform/app/activity/api/internal/svc"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// 分类列表（含已禁用）
+func AdminListCategoriesHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		l := admin.NewAdminListCategoriesLogic(r.Context(), svcCtx)
+		resp, err := l.AdminListCategories()
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
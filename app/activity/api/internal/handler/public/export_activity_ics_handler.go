@@ -0,0 +1,38 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package public
+
+import (
+	"fmt"
+	"net/http"
+
+	"activity-platform/app/activity/api/internal/logic/public"
+	"activity-platform/app/activity/api/internal/svc"
+	"activity-platform/app/activity/api/internal/types"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// 导出活动日历文件（.ics）
+// 以附件形式输出 text/calendar 正文
+func ExportActivityIcsHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.ExportActivityIcsReq
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := public.NewExportActivityIcsLogic(r.Context(), svcCtx)
+		file, err := l.ExportActivityIcs(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", file.Filename))
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(file.Content))
+	}
+}
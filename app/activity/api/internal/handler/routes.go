@@ -36,24 +36,168 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 				Path:    "/:id",
 				Handler: activity.DeleteActivityHandler(serverCtx),
 			},
+			{
+				// 活动数据看板（组织者）
+				Method:  http.MethodGet,
+				Path:    "/:id/analytics",
+				Handler: activity.GetActivityAnalyticsHandler(serverCtx),
+			},
 			{
 				// 取消活动
 				Method:  http.MethodPost,
 				Path:    "/:id/cancel",
 				Handler: activity.CancelActivityHandler(serverCtx),
 			},
+			{
+				// 提交时间/地点变更申请（已发布活动）
+				Method:  http.MethodPost,
+				Path:    "/:id/change-requests",
+				Handler: activity.SubmitActivityChangeHandler(serverCtx),
+			},
+			{
+				// 活动变更申请列表（组织者）
+				Method:  http.MethodGet,
+				Path:    "/:id/change-requests",
+				Handler: activity.ListActivityChangesHandler(serverCtx),
+			},
+			{
+				// 签到记录（组织者，可只看疑似异常）
+				Method:  http.MethodGet,
+				Path:    "/:id/check-in-records",
+				Handler: activity.ListCheckInRecordsHandler(serverCtx),
+			},
+			{
+				// 复制活动为新草稿
+				Method:  http.MethodPost,
+				Path:    "/:id/clone",
+				Handler: activity.CloneActivityHandler(serverCtx),
+			},
+			{
+				// 收藏活动（报名即将截止时提醒）
+				Method:  http.MethodPost,
+				Path:    "/:id/favorite",
+				Handler: activity.FavoriteActivityHandler(serverCtx),
+			},
+			{
+				// 取消收藏活动
+				Method:  http.MethodDelete,
+				Path:    "/:id/favorite",
+				Handler: activity.UnfavoriteActivityHandler(serverCtx),
+			},
+			{
+				// 提交活动评价
+				Method:  http.MethodPost,
+				Path:    "/:id/feedback",
+				Handler: activity.SubmitFeedbackHandler(serverCtx),
+			},
+			{
+				// 活动评价汇总（组织者）
+				Method:  http.MethodGet,
+				Path:    "/:id/feedback/summary",
+				Handler: activity.GetFeedbackSummaryHandler(serverCtx),
+			},
+			{
+				// 设置报名资格规则
+				Method:  http.MethodPut,
+				Path:    "/:id/eligibility-rules",
+				Handler: activity.SetEligibilityRulesHandler(serverCtx),
+			},
+			{
+				// 另存为个人模板
+				Method:  http.MethodPost,
+				Path:    "/:id/save-template",
+				Handler: activity.SaveActivityTemplateHandler(serverCtx),
+			},
+			{
+				// 开启/关闭自助签到
+				Method:  http.MethodPut,
+				Path:    "/:id/self-check-in",
+				Handler: activity.SetSelfCheckInHandler(serverCtx),
+			},
+			{
+				// 现场签到码（大屏展示，30 秒轮换）
+				Method:  http.MethodGet,
+				Path:    "/:id/self-check-in/code",
+				Handler: activity.GetSelfCheckInCodeHandler(serverCtx),
+			},
 			{
 				// 提交审核
 				Method:  http.MethodPost,
 				Path:    "/:id/submit",
 				Handler: activity.SubmitActivityHandler(serverCtx),
 			},
+			{
+				// 我的活动数据总览
+				Method:  http.MethodGet,
+				Path:    "/analytics/overview",
+				Handler: activity.GetOrganizerAnalyticsHandler(serverCtx),
+			},
+			{
+				// 我的日历订阅地址
+				Method:  http.MethodGet,
+				Path:    "/calendar/feed",
+				Handler: activity.GetCalendarFeedUrlHandler(serverCtx),
+			},
+			{
+				// 重置日历订阅地址（旧地址立即失效）
+				Method:  http.MethodPost,
+				Path:    "/calendar/feed/reset",
+				Handler: activity.ResetCalendarFeedUrlHandler(serverCtx),
+			},
 			{
 				// 我创建的活动
 				Method:  http.MethodGet,
 				Path:    "/my/created",
 				Handler: activity.MyCreatedActivityHandler(serverCtx),
 			},
+			{
+				// 创建系列活动（按重复规则生成场次）
+				Method:  http.MethodPost,
+				Path:    "/series",
+				Handler: activity.CreateActivitySeriesHandler(serverCtx),
+			},
+			{
+				// 整期修改系列活动
+				Method:  http.MethodPut,
+				Path:    "/series/:id",
+				Handler: activity.UpdateActivitySeriesHandler(serverCtx),
+			},
+			{
+				// 取消系列活动
+				Method:  http.MethodPost,
+				Path:    "/series/:id/cancel",
+				Handler: activity.CancelActivitySeriesHandler(serverCtx),
+			},
+			{
+				// 整期报名
+				Method:  http.MethodPost,
+				Path:    "/series/:id/register",
+				Handler: activity.RegisterActivitySeriesHandler(serverCtx),
+			},
+			{
+				// 退出整期报名
+				Method:  http.MethodDelete,
+				Path:    "/series/:id/register",
+				Handler: activity.UnregisterActivitySeriesHandler(serverCtx),
+			},
+			{
+				// 活动模板列表（个人模板 + 公共模板）
+				Method:  http.MethodGet,
+				Path:    "/templates",
+				Handler: activity.ListActivityTemplatesHandler(serverCtx),
+			},
+			{
+				// 删除个人模板
+				Method:  http.MethodDelete,
+				Path:    "/templates/:id",
+				Handler: activity.DeleteActivityTemplateHandler(serverCtx),
+			},
+			{
+				// 使用模板创建草稿
+				Method:  http.MethodPost,
+				Path:    "/templates/:id/use",
+				Handler: activity.UseActivityTemplateHandler(serverCtx),
+			},
 		},
 		rest.WithJwt(serverCtx.Config.Auth.AccessSecret),
 		rest.WithPrefix("/api/v1/activity"),
@@ -69,18 +213,144 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 					Path:    "/:id/approve",
 					Handler: admin.ApproveActivityHandler(serverCtx),
 				},
+				{
+					// 分配/领取/释放审核任务
+					Method:  http.MethodPost,
+					Path:    "/:id/assign",
+					Handler: admin.AssignActivityReviewHandler(serverCtx),
+				},
 				{
 					// 审核拒绝
 					Method:  http.MethodPost,
 					Path:    "/:id/reject",
 					Handler: admin.RejectActivityHandler(serverCtx),
 				},
+				{
+					// 分类列表（含已禁用）
+					Method:  http.MethodGet,
+					Path:    "/categories",
+					Handler: admin.AdminListCategoriesHandler(serverCtx),
+				},
+				{
+					// 创建分类
+					Method:  http.MethodPost,
+					Path:    "/categories",
+					Handler: admin.CreateCategoryHandler(serverCtx),
+				},
+				{
+					// 调整分类排序
+					Method:  http.MethodPost,
+					Path:    "/categories/sort",
+					Handler: admin.SortCategoriesHandler(serverCtx),
+				},
+				{
+					// 修改分类
+					Method:  http.MethodPut,
+					Path:    "/categories/:id",
+					Handler: admin.UpdateCategoryHandler(serverCtx),
+				},
+				{
+					// 启用/禁用分类
+					Method:  http.MethodPut,
+					Path:    "/categories/:id/status",
+					Handler: admin.SetCategoryStatusHandler(serverCtx),
+				},
+				{
+					// 删除分类
+					Method:  http.MethodDelete,
+					Path:    "/categories/:id",
+					Handler: admin.DeleteCategoryHandler(serverCtx),
+				},
+				{
+					// 变更申请列表
+					Method:  http.MethodGet,
+					Path:    "/change-requests",
+					Handler: admin.AdminListActivityChangesHandler(serverCtx),
+				},
+				{
+					// 审核变更申请
+					Method:  http.MethodPost,
+					Path:    "/change-requests/:id/review",
+					Handler: admin.ReviewActivityChangeHandler(serverCtx),
+				},
+				{
+					// 评价短评审核列表
+					Method:  http.MethodGet,
+					Path:    "/feedback-reviews",
+					Handler: admin.ListFeedbackReviewsHandler(serverCtx),
+				},
+				{
+					// 审核评价短评
+					Method:  http.MethodPost,
+					Path:    "/feedback-reviews/:id/review",
+					Handler: admin.ReviewFeedbackCommentHandler(serverCtx),
+				},
 				{
 					// 管理员活动列表
 					Method:  http.MethodGet,
 					Path:    "/list",
 					Handler: admin.AdminListActivityHandler(serverCtx),
 				},
+				{
+					// 审核队列
+					Method:  http.MethodGet,
+					Path:    "/review-queue",
+					Handler: admin.ListReviewQueueHandler(serverCtx),
+				},
+				{
+					// 标签列表（含已禁用）
+					Method:  http.MethodGet,
+					Path:    "/tags",
+					Handler: admin.AdminListTagsHandler(serverCtx),
+				},
+				{
+					// 创建标签
+					Method:  http.MethodPost,
+					Path:    "/tags",
+					Handler: admin.CreateTagHandler(serverCtx),
+				},
+				{
+					// 修改标签
+					Method:  http.MethodPut,
+					Path:    "/tags/:id",
+					Handler: admin.UpdateTagHandler(serverCtx),
+				},
+				{
+					// 启用/禁用标签
+					Method:  http.MethodPut,
+					Path:    "/tags/:id/status",
+					Handler: admin.SetTagStatusHandler(serverCtx),
+				},
+				{
+					// 合并标签
+					Method:  http.MethodPost,
+					Path:    "/tags/:id/merge",
+					Handler: admin.MergeTagsHandler(serverCtx),
+				},
+				{
+					// 公共模板列表（含已下架）
+					Method:  http.MethodGet,
+					Path:    "/templates",
+					Handler: admin.AdminListTemplatesHandler(serverCtx),
+				},
+				{
+					// 创建公共模板
+					Method:  http.MethodPost,
+					Path:    "/templates",
+					Handler: admin.AdminCreateTemplateHandler(serverCtx),
+				},
+				{
+					// 修改公共模板（名称、分类、上下架）
+					Method:  http.MethodPut,
+					Path:    "/templates/:id",
+					Handler: admin.AdminUpdateTemplateHandler(serverCtx),
+				},
+				{
+					// 删除公共模板
+					Method:  http.MethodDelete,
+					Path:    "/templates/:id",
+					Handler: admin.AdminDeleteTemplateHandler(serverCtx),
+				},
 			}...,
 		),
 		rest.WithJwt(serverCtx.Config.Auth.AccessSecret),
@@ -95,12 +365,30 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 				Path:    "/:id",
 				Handler: public.GetActivityHandler(serverCtx),
 			},
+			{
+				// 报名资格规则
+				Method:  http.MethodGet,
+				Path:    "/:id/eligibility-rules",
+				Handler: public.GetEligibilityRulesHandler(serverCtx),
+			},
+			{
+				// 导出活动日历文件（.ics）
+				Method:  http.MethodGet,
+				Path:    "/:id/ics",
+				Handler: public.ExportActivityIcsHandler(serverCtx),
+			},
 			{
 				// 增加浏览量
 				Method:  http.MethodPost,
 				Path:    "/:id/view",
 				Handler: public.IncrViewCountHandler(serverCtx),
 			},
+			{
+				// 日历订阅内容（iCalendar，按订阅令牌识别用户）
+				Method:  http.MethodGet,
+				Path:    "/calendar/feed/:token",
+				Handler: public.GetCalendarFeedHandler(serverCtx),
+			},
 			{
 				// 分类列表
 				Method:  http.MethodGet,
@@ -119,12 +407,30 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 				Path:    "/lists",
 				Handler: public.ListActivityHandler(serverCtx),
 			},
+			{
+				// 附近活动
+				Method:  http.MethodGet,
+				Path:    "/nearby",
+				Handler: public.NearbyActivityHandler(serverCtx),
+			},
 			{
 				// 搜索活动
 				Method:  http.MethodGet,
 				Path:    "/search",
 				Handler: public.SearchActivityHandler(serverCtx),
 			},
+			{
+				// 搜索建议
+				Method:  http.MethodGet,
+				Path:    "/search/suggest",
+				Handler: public.SuggestActivityHandler(serverCtx),
+			},
+			{
+				// 系列活动详情
+				Method:  http.MethodGet,
+				Path:    "/series/:id",
+				Handler: public.GetActivitySeriesHandler(serverCtx),
+			},
 			{
 				// 标签列表
 				Method:  http.MethodGet,
@@ -143,6 +449,12 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 				Path:    "/cancel",
 				Handler: ticket.CancelActivitiesHandler(serverCtx),
 			},
+			{
+				// 报名资格预检
+				Method:  http.MethodGet,
+				Path:    "/eligibility",
+				Handler: ticket.CheckEligibilityHandler(serverCtx),
+			},
 			{
 				// 获取待参加/已参加活动列表
 				Method:  http.MethodGet,
@@ -155,6 +467,12 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 				Path:    "/register",
 				Handler: ticket.RegisterActivityHandler(serverCtx),
 			},
+			{
+				// 自助签到（定位 + 现场签到码）
+				Method:  http.MethodPost,
+				Path:    "/self-check-in",
+				Handler: ticket.SelfCheckInHandler(serverCtx),
+			},
 			{
 				// 获取个人票券列表
 				Method:  http.MethodGet,
@@ -0,0 +1,62 @@
+package activity
+
+import (
+	"context"
+
+	"activity-platform/app/activity/api/internal/svc"
+	"activity-platform/app/activity/api/internal/types"
+	"activity-platform/app/activity/rpc/activityservice"
+	"activity-platform/common/ctxdata"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CancelActivitySeriesLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 取消系列活动
+func NewCancelActivitySeriesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CancelActivitySeriesLogic {
+	return &CancelActivitySeriesLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *CancelActivitySeriesLogic) CancelActivitySeries(req *types.CancelActivitySeriesReq) (resp *types.CancelActivitySeriesResp, err error) {
+	// 1. 获取当前用户 ID
+	userID := ctxdata.GetUserIDFromCtx(l.ctx)
+	if userID <= 0 {
+		return nil, errorx.ErrUnauthorized()
+	}
+
+	// 2. 参数校验
+	if req.Id <= 0 {
+		return nil, errorx.ErrInvalidParams("系列ID无效")
+	}
+	if len([]rune(req.Reason)) > 500 {
+		return nil, errorx.ErrInvalidParams("取消原因不能超过500字符")
+	}
+
+	// 3. 调用 RPC 取消系列
+	rpcResp, err := l.svcCtx.ActivityRpc.CancelActivitySeries(l.ctx, &activityservice.CancelActivitySeriesReq{
+		Id:           req.Id,
+		OperatorId:   userID,
+		IsAdmin:      false, // 普通用户接口，非管理员
+		Reason:       req.Reason,
+		CancelFuture: req.CancelFuture,
+	})
+	if err != nil {
+		l.Errorf("RPC CancelActivitySeries failed: id=%d, userID=%d, err=%v", req.Id, userID, err)
+		return nil, errorx.FromError(err)
+	}
+
+	return &types.CancelActivitySeriesResp{
+		Cancelled: rpcResp.Cancelled,
+		Skipped:   convertSkippedOccurrences(rpcResp.Skipped),
+	}, nil
+}
@@ -0,0 +1,61 @@
+package activity
+
+import (
+	"context"
+
+	"activity-platform/app/activity/api/internal/svc"
+	"activity-platform/app/activity/api/internal/types"
+	"activity-platform/app/activity/rpc/activityservice"
+	"activity-platform/common/ctxdata"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CloneActivityLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 复制活动为新草稿
+func NewCloneActivityLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CloneActivityLogic {
+	return &CloneActivityLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *CloneActivityLogic) CloneActivity(req *types.CloneActivityReq) (resp *types.CreateActivityResp, err error) {
+	// 1. 获取当前用户 ID
+	userID := ctxdata.GetUserIDFromCtx(l.ctx)
+	if userID <= 0 {
+		return nil, errorx.ErrUnauthorized()
+	}
+
+	// 2. 参数校验
+	if req.Id <= 0 {
+		return nil, errorx.ErrInvalidParams("活动ID无效")
+	}
+	if req.ActivityStartTime <= 0 {
+		return nil, errorx.ErrInvalidParams("请选择新活动的开始时间")
+	}
+
+	// 3. 调用 RPC 服务（仅组织者可复制自己的活动）
+	rpcResp, err := l.svcCtx.ActivityRpc.CloneActivity(l.ctx, &activityservice.CloneActivityReq{
+		ActivityId:        req.Id,
+		OperatorId:        userID,
+		ActivityStartTime: req.ActivityStartTime,
+		Title:             req.Title,
+	})
+	if err != nil {
+		l.Errorf("RPC CloneActivity failed: id=%d, userID=%d, err=%v", req.Id, userID, err)
+		return nil, errorx.FromError(err)
+	}
+
+	return &types.CreateActivityResp{
+		Id:     rpcResp.Id,
+		Status: rpcResp.Status,
+	}, nil
+}
@@ -0,0 +1,89 @@
+package activity
+
+import (
+	"context"
+
+	"activity-platform/app/activity/api/internal/svc"
+	"activity-platform/app/activity/api/internal/types"
+	"activity-platform/app/activity/rpc/activityservice"
+	"activity-platform/common/ctxdata"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateActivitySeriesLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 创建系列活动（按重复规则生成场次）
+func NewCreateActivitySeriesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateActivitySeriesLogic {
+	return &CreateActivitySeriesLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *CreateActivitySeriesLogic) CreateActivitySeries(req *types.CreateActivitySeriesReq) (resp *types.CreateActivitySeriesResp, err error) {
+	// 1. 获取当前用户 ID
+	userID := ctxdata.GetUserIDFromCtx(l.ctx)
+	if userID <= 0 {
+		return nil, errorx.ErrUnauthorized()
+	}
+
+	// 2. 参数校验（快速失败，规则与时间由 RPC 层校验）
+	if req.Rrule == "" {
+		return nil, errorx.ErrInvalidParams("请填写重复规则")
+	}
+	if req.CoverImageId <= 0 {
+		return nil, errorx.ErrInvalidParams("请上传活动封面")
+	}
+	if len(req.TagIds) > 5 {
+		return nil, errorx.ErrInvalidParams("最多选择5个标签")
+	}
+
+	// 3. 调用 RPC 创建系列
+	rpcResp, err := l.svcCtx.ActivityRpc.CreateActivitySeries(l.ctx, &activityservice.CreateActivitySeriesReq{
+		Template: &activityservice.CreateActivityReq{
+			Title:                req.Title,
+			CoverImageId:         req.CoverImageId,
+			CoverType:            req.CoverType,
+			Content:              req.Content,
+			CategoryId:           req.CategoryId,
+			ContactPhone:         req.ContactPhone,
+			RegisterStartTime:    req.RegisterStartTime,
+			RegisterEndTime:      req.RegisterEndTime,
+			ActivityStartTime:    req.ActivityStartTime,
+			ActivityEndTime:      req.ActivityEndTime,
+			Location:             req.Location,
+			AddressDetail:        req.AddressDetail,
+			Longitude:            req.Longitude,
+			Latitude:             req.Latitude,
+			MaxParticipants:      req.MaxParticipants,
+			RequireApproval:      req.RequireApproval,
+			RequireStudentVerify: req.RequireStudentVerify,
+			MinCreditScore:       req.MinCreditScore,
+			TagIds:               req.TagIds,
+			OrganizerId:          userID,
+		},
+		Rrule:               req.Rrule,
+		AllowSeriesRegister: req.AllowSeriesRegister,
+	})
+	if err != nil {
+		l.Errorf("RPC CreateActivitySeries failed: userID=%d, title=%s, err=%v", userID, req.Title, err)
+		return nil, errorx.FromError(err)
+	}
+
+	activityIDs := rpcResp.ActivityIds
+	if activityIDs == nil {
+		activityIDs = []int64{}
+	}
+	return &types.CreateActivitySeriesResp{
+		SeriesId:         rpcResp.SeriesId,
+		ActivityIds:      activityIDs,
+		TotalOccurrences: rpcResp.TotalOccurrences,
+	}, nil
+}
@@ -0,0 +1,53 @@
+package activity
+
+import (
+	"context"
+
+	"activity-platform/app/activity/api/internal/svc"
+	"activity-platform/app/activity/api/internal/types"
+	"activity-platform/app/activity/rpc/activityservice"
+	"activity-platform/common/ctxdata"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeleteActivityTemplateLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 删除个人模板
+func NewDeleteActivityTemplateLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteActivityTemplateLogic {
+	return &DeleteActivityTemplateLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *DeleteActivityTemplateLogic) DeleteActivityTemplate(req *types.DeleteActivityTemplateReq) (resp *types.DeleteActivityTemplateResp, err error) {
+	// 1. 获取当前用户 ID
+	userID := ctxdata.GetUserIDFromCtx(l.ctx)
+	if userID <= 0 {
+		return nil, errorx.ErrUnauthorized()
+	}
+
+	// 2. 参数校验
+	if req.Id <= 0 {
+		return nil, errorx.ErrInvalidParams("模板ID无效")
+	}
+
+	// 3. 调用 RPC 服务（仅能删除自己的个人模板）
+	_, err = l.svcCtx.ActivityRpc.DeleteActivityTemplate(l.ctx, &activityservice.DeleteActivityTemplateReq{
+		Id:         req.Id,
+		OperatorId: userID,
+	})
+	if err != nil {
+		l.Errorf("RPC DeleteActivityTemplate failed: id=%d, userID=%d, err=%v", req.Id, userID, err)
+		return nil, errorx.FromError(err)
+	}
+
+	return &types.DeleteActivityTemplateResp{Success: true}, nil
+}
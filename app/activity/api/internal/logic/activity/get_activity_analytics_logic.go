@@ -0,0 +1,73 @@
+package activity
+
+import (
+	"context"
+
+	"activity-platform/app/activity/api/internal/logic"
+	"activity-platform/app/activity/api/internal/svc"
+	"activity-platform/app/activity/api/internal/types"
+	"activity-platform/app/activity/rpc/activityservice"
+	"activity-platform/common/ctxdata"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetActivityAnalyticsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 活动数据看板（组织者）
+func NewGetActivityAnalyticsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetActivityAnalyticsLogic {
+	return &GetActivityAnalyticsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetActivityAnalyticsLogic) GetActivityAnalytics(req *types.GetActivityAnalyticsReq) (resp *types.GetActivityAnalyticsResp, err error) {
+	// 1. 获取当前用户 ID
+	userID := ctxdata.GetUserIDFromCtx(l.ctx)
+	if userID <= 0 {
+		return nil, errorx.ErrUnauthorized()
+	}
+
+	// 2. 参数校验
+	if req.Id <= 0 {
+		return nil, errorx.ErrInvalidParams("活动ID无效")
+	}
+
+	// 3. 调用 RPC 服务（仅组织者可查看）
+	rpcResp, err := l.svcCtx.ActivityRpc.GetActivityAnalytics(l.ctx, &activityservice.GetActivityAnalyticsReq{
+		ActivityId: req.Id,
+		OperatorId: userID,
+		StartDate:  req.StartDate,
+		EndDate:    req.EndDate,
+	})
+	if err != nil {
+		l.Errorf("RPC GetActivityAnalytics failed: id=%d, userID=%d, err=%v", req.Id, userID, err)
+		return nil, errorx.FromError(err)
+	}
+
+	// 4. 转换响应
+	resp = &types.GetActivityAnalyticsResp{
+		ActivityId: rpcResp.ActivityId,
+		Title:      rpcResp.Title,
+		Funnel:     logic.ConvertRpcFunnelToApi(rpcResp.Funnel),
+		Daily:      logic.ConvertRpcAnalyticsDailyToApi(rpcResp.Daily),
+		Audience:   logic.ConvertRpcAudienceToApi(rpcResp.Audience),
+		StatDate:   rpcResp.StatDate,
+		UpdatedAt:  rpcResp.UpdatedAt,
+	}
+	if v := rpcResp.Velocity; v != nil {
+		resp.Velocity = types.RegistrationVelocity{
+			First24hRegistrations:  v.First_24HRegistrations,
+			PeakDailyRegistrations: v.PeakDailyRegistrations,
+			AvgDailyRegistrations:  v.AvgDailyRegistrations,
+		}
+	}
+	return resp, nil
+}
@@ -0,0 +1,66 @@
+package activity
+
+import (
+	"context"
+	"strings"
+
+	"activity-platform/app/activity/api/internal/svc"
+	"activity-platform/app/activity/api/internal/types"
+	"activity-platform/app/activity/rpc/activityservice"
+	"activity-platform/common/ctxdata"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// calendarFeedPath 订阅地址路径（与 public 组 /calendar/feed/:token 路由一致）
+const calendarFeedPath = "/api/v1/activity/calendar/feed/"
+
+type GetCalendarFeedUrlLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 我的日历订阅地址
+func NewGetCalendarFeedUrlLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetCalendarFeedUrlLogic {
+	return &GetCalendarFeedUrlLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetCalendarFeedUrlLogic) GetCalendarFeedUrl() (resp *types.CalendarFeedUrlResp, err error) {
+	userID := ctxdata.GetUserIDFromCtx(l.ctx)
+	if userID <= 0 {
+		return nil, errorx.ErrUnauthorized()
+	}
+
+	rpcResp, err := l.svcCtx.ActivityRpc.GetCalendarFeedToken(l.ctx, &activityservice.GetCalendarFeedTokenReq{
+		UserId: userID,
+	})
+	if err != nil {
+		l.Errorf("RPC GetCalendarFeedToken failed: userID=%d, err=%v", userID, err)
+		return nil, errorx.FromError(err)
+	}
+
+	return toCalendarFeedUrlResp(l.svcCtx.Config.Calendar.FeedBaseURL, rpcResp.Token), nil
+}
+
+// toCalendarFeedUrlResp 拼接订阅地址（https 与 webcal 两种形式）
+func toCalendarFeedUrlResp(baseURL, token string) *types.CalendarFeedUrlResp {
+	url := strings.TrimRight(baseURL, "/") + calendarFeedPath + token
+	webcalURL := url
+	for _, scheme := range []string{"https://", "http://"} {
+		if strings.HasPrefix(url, scheme) {
+			webcalURL = "webcal://" + strings.TrimPrefix(url, scheme)
+			break
+		}
+	}
+	return &types.CalendarFeedUrlResp{
+		Token:     token,
+		Url:       url,
+		WebcalUrl: webcalURL,
+	}
+}
@@ -0,0 +1,77 @@
+package activity
+
+import (
+	"context"
+
+	"activity-platform/app/activity/api/internal/logic"
+	"activity-platform/app/activity/api/internal/svc"
+	"activity-platform/app/activity/api/internal/types"
+	"activity-platform/app/activity/rpc/activityservice"
+	"activity-platform/common/ctxdata"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetFeedbackSummaryLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 活动评价汇总（组织者）
+func NewGetFeedbackSummaryLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetFeedbackSummaryLogic {
+	return &GetFeedbackSummaryLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetFeedbackSummaryLogic) GetFeedbackSummary(req *types.GetFeedbackSummaryReq) (resp *types.GetFeedbackSummaryResp, err error) {
+	// 1. 获取当前用户 ID
+	userID := ctxdata.GetUserIDFromCtx(l.ctx)
+	if userID <= 0 {
+		return nil, errorx.ErrUnauthorized()
+	}
+
+	// 2. 参数校验
+	if req.Id <= 0 {
+		return nil, errorx.ErrInvalidParams("活动ID无效")
+	}
+
+	// 3. 调用 RPC 服务（仅组织者可查看）
+	rpcResp, err := l.svcCtx.ActivityRpc.GetFeedbackSummary(l.ctx, &activityservice.GetFeedbackSummaryReq{
+		ActivityId: req.Id,
+		OperatorId: userID,
+		Page:       req.Page,
+		PageSize:   req.PageSize,
+	})
+	if err != nil {
+		l.Errorf("RPC GetFeedbackSummary failed: id=%d, userID=%d, err=%v", req.Id, userID, err)
+		return nil, errorx.FromError(err)
+	}
+
+	// 4. 转换响应
+	comments := make([]types.FeedbackComment, 0, len(rpcResp.Comments))
+	for _, c := range rpcResp.Comments {
+		comments = append(comments, types.FeedbackComment{
+			Id:        c.Id,
+			UserId:    c.UserId,
+			Rating:    c.Rating,
+			Comment:   c.Comment,
+			CreatedAt: c.CreatedAt,
+			UpdatedAt: c.UpdatedAt,
+		})
+	}
+
+	return &types.GetFeedbackSummaryResp{
+		RatingAvg:           rpcResp.RatingAvg,
+		RatingCount:         rpcResp.RatingCount,
+		Distribution:        rpcResp.Distribution,
+		PendingCommentCount: rpcResp.PendingCommentCount,
+		Comments:            comments,
+		Pagination:          logic.ConvertRpcPaginationToApi(rpcResp.Pagination),
+		FeedbackDeadline:    rpcResp.FeedbackDeadline,
+	}, nil
+}
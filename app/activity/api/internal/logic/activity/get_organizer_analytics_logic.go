@@ -0,0 +1,69 @@
+package activity
+
+import (
+	"context"
+
+	"activity-platform/app/activity/api/internal/logic"
+	"activity-platform/app/activity/api/internal/svc"
+	"activity-platform/app/activity/api/internal/types"
+	"activity-platform/app/activity/rpc/activityservice"
+	"activity-platform/common/ctxdata"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetOrganizerAnalyticsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 我的活动数据总览
+func NewGetOrganizerAnalyticsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetOrganizerAnalyticsLogic {
+	return &GetOrganizerAnalyticsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetOrganizerAnalyticsLogic) GetOrganizerAnalytics(req *types.GetOrganizerAnalyticsReq) (resp *types.GetOrganizerAnalyticsResp, err error) {
+	// 1. 获取当前用户 ID
+	userID := ctxdata.GetUserIDFromCtx(l.ctx)
+	if userID <= 0 {
+		return nil, errorx.ErrUnauthorized()
+	}
+
+	// 2. 调用 RPC 服务
+	rpcResp, err := l.svcCtx.ActivityRpc.GetOrganizerAnalytics(l.ctx, &activityservice.GetOrganizerAnalyticsReq{
+		OrganizerId: userID,
+		StartDate:   req.StartDate,
+		EndDate:     req.EndDate,
+	})
+	if err != nil {
+		l.Errorf("RPC GetOrganizerAnalytics failed: userID=%d, err=%v", userID, err)
+		return nil, errorx.FromError(err)
+	}
+
+	// 3. 转换响应
+	top := make([]types.ActivityAnalyticsBrief, 0, len(rpcResp.TopActivities))
+	for _, a := range rpcResp.TopActivities {
+		top = append(top, types.ActivityAnalyticsBrief{
+			ActivityId:     a.ActivityId,
+			Title:          a.Title,
+			UniqueVisitors: a.UniqueVisitors,
+			Registrations:  a.Registrations,
+			CheckIns:       a.CheckIns,
+			AttendanceRate: a.AttendanceRate,
+		})
+	}
+
+	return &types.GetOrganizerAnalyticsResp{
+		ActivityCount: rpcResp.ActivityCount,
+		Funnel:        logic.ConvertRpcFunnelToApi(rpcResp.Funnel),
+		Daily:         logic.ConvertRpcAnalyticsDailyToApi(rpcResp.Daily),
+		Audience:      logic.ConvertRpcAudienceToApi(rpcResp.Audience),
+		TopActivities: top,
+	}, nil
+}
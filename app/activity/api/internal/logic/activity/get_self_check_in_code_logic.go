@@ -0,0 +1,58 @@
+package activity
+
+import (
+	"context"
+
+	"activity-platform/app/activity/api/internal/svc"
+	"activity-platform/app/activity/api/internal/types"
+	"activity-platform/app/activity/rpc/activityservice"
+	"activity-platform/common/ctxdata"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetSelfCheckInCodeLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 现场签到码（大屏展示，30 秒轮换）
+func NewGetSelfCheckInCodeLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetSelfCheckInCodeLogic {
+	return &GetSelfCheckInCodeLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetSelfCheckInCodeLogic) GetSelfCheckInCode(req *types.GetSelfCheckInCodeReq) (resp *types.GetSelfCheckInCodeResp, err error) {
+	// 1. 获取当前用户 ID
+	userID := ctxdata.GetUserIDFromCtx(l.ctx)
+	if userID <= 0 {
+		return nil, errorx.ErrUnauthorized()
+	}
+
+	// 2. 参数校验
+	if req.Id <= 0 {
+		return nil, errorx.ErrInvalidParams("活动ID无效")
+	}
+
+	// 3. 调用 RPC 服务（仅组织者可查看）
+	rpcResp, err := l.svcCtx.ActivityRpc.GetSelfCheckInCode(l.ctx, &activityservice.GetSelfCheckInCodeReq{
+		ActivityId: req.Id,
+		OperatorId: userID,
+	})
+	if err != nil {
+		l.Errorf("RPC GetSelfCheckInCode failed: id=%d, userID=%d, err=%v", req.Id, userID, err)
+		return nil, errorx.FromError(err)
+	}
+
+	return &types.GetSelfCheckInCodeResp{
+		Code:           rpcResp.Code,
+		ExpiresAt:      rpcResp.ExpiresAt,
+		RadiusMeters:   rpcResp.RadiusMeters,
+		CheckedInCount: rpcResp.CheckedInCount,
+	}, nil
+}
@@ -0,0 +1,56 @@
+package activity
+
+import (
+	"context"
+
+	"activity-platform/app/activity/api/internal/logic"
+	"activity-platform/app/activity/api/internal/svc"
+	"activity-platform/app/activity/api/internal/types"
+	"activity-platform/app/activity/rpc/activityservice"
+	"activity-platform/common/ctxdata"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListActivityChangesLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 活动变更申请列表（组织者）
+func NewListActivityChangesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListActivityChangesLogic {
+	return &ListActivityChangesLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListActivityChangesLogic) ListActivityChanges(req *types.ListActivityChangesReq) (resp *types.ListActivityChangesResp, err error) {
+	userID := ctxdata.GetUserIDFromCtx(l.ctx)
+	if userID <= 0 {
+		return nil, errorx.ErrUnauthorized()
+	}
+	if req.Id <= 0 {
+		return nil, errorx.ErrInvalidParams("活动ID无效")
+	}
+
+	rpcResp, err := l.svcCtx.ActivityRpc.ListActivityChanges(l.ctx, &activityservice.ListActivityChangesReq{
+		OperatorId: userID,
+		ActivityId: req.Id,
+		Status:     req.Status,
+		Page:       req.Page,
+		PageSize:   req.PageSize,
+	})
+	if err != nil {
+		l.Errorf("RPC ListActivityChanges failed: id=%d, userID=%d, err=%v", req.Id, userID, err)
+		return nil, errorx.FromError(err)
+	}
+
+	return &types.ListActivityChangesResp{
+		List:       logic.ConvertRpcActivityChangesToApi(rpcResp.List),
+		Pagination: logic.ConvertRpcPaginationToApi(rpcResp.Pagination),
+	}, nil
+}
@@ -0,0 +1,60 @@
+package activity
+
+import (
+	"context"
+
+	"activity-platform/app/activity/api/internal/logic"
+	"activity-platform/app/activity/api/internal/svc"
+	"activity-platform/app/activity/api/internal/types"
+	"activity-platform/app/activity/rpc/activityservice"
+	"activity-platform/common/ctxdata"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListActivityTemplatesLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 活动模板列表（个人模板 + 公共模板）
+func NewListActivityTemplatesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListActivityTemplatesLogic {
+	return &ListActivityTemplatesLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListActivityTemplatesLogic) ListActivityTemplates(req *types.ListActivityTemplatesReq) (resp *types.ListActivityTemplatesResp, err error) {
+	// 1. 获取当前用户 ID
+	userID := ctxdata.GetUserIDFromCtx(l.ctx)
+	if userID <= 0 {
+		return nil, errorx.ErrUnauthorized()
+	}
+
+	// 2. 参数校验
+	if req.Scope < 0 || req.Scope > 2 {
+		return nil, errorx.ErrInvalidParams("模板范围无效")
+	}
+
+	// 3. 调用 RPC 服务
+	rpcResp, err := l.svcCtx.ActivityRpc.ListActivityTemplates(l.ctx, &activityservice.ListActivityTemplatesReq{
+		OperatorId: userID,
+		Scope:      req.Scope,
+		CategoryId: req.CategoryId,
+		Page:       req.Page,
+		PageSize:   req.PageSize,
+	})
+	if err != nil {
+		l.Errorf("RPC ListActivityTemplates failed: userID=%d, err=%v", userID, err)
+		return nil, errorx.FromError(err)
+	}
+
+	return &types.ListActivityTemplatesResp{
+		List:       logic.ConvertRpcTemplatesToApi(rpcResp.List),
+		Pagination: logic.ConvertRpcPaginationToApi(rpcResp.Pagination),
+	}, nil
+}
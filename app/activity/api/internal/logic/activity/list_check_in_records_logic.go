@@ -0,0 +1,78 @@
+package activity
+
+import (
+	"context"
+
+	"activity-platform/app/activity/api/internal/logic"
+	"activity-platform/app/activity/api/internal/svc"
+	"activity-platform/app/activity/api/internal/types"
+	"activity-platform/app/activity/rpc/activityservice"
+	"activity-platform/common/ctxdata"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListCheckInRecordsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 签到记录（组织者，可只看疑似异常）
+func NewListCheckInRecordsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListCheckInRecordsLogic {
+	return &ListCheckInRecordsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListCheckInRecordsLogic) ListCheckInRecords(req *types.ListCheckInRecordsReq) (resp *types.ListCheckInRecordsResp, err error) {
+	// 1. 获取当前用户 ID
+	userID := ctxdata.GetUserIDFromCtx(l.ctx)
+	if userID <= 0 {
+		return nil, errorx.ErrUnauthorized()
+	}
+
+	// 2. 参数校验
+	if req.Id <= 0 {
+		return nil, errorx.ErrInvalidParams("活动ID无效")
+	}
+
+	// 3. 调用 RPC 服务（仅组织者可查看）
+	rpcResp, err := l.svcCtx.ActivityRpc.ListCheckInRecords(l.ctx, &activityservice.ListCheckInRecordsReq{
+		ActivityId:  req.Id,
+		OperatorId:  userID,
+		FlaggedOnly: req.FlaggedOnly,
+		Page:        req.Page,
+		PageSize:    req.PageSize,
+	})
+	if err != nil {
+		l.Errorf("RPC ListCheckInRecords failed: id=%d, userID=%d, err=%v", req.Id, userID, err)
+		return nil, errorx.FromError(err)
+	}
+
+	// 4. 转换响应
+	list := make([]types.CheckInRecordItem, 0, len(rpcResp.List))
+	for _, r := range rpcResp.List {
+		list = append(list, types.CheckInRecordItem{
+			Id:             r.Id,
+			UserId:         r.UserId,
+			TicketCode:     r.TicketCode,
+			Method:         r.Method,
+			CheckInTime:    r.CheckInTime,
+			Latitude:       r.Latitude,
+			Longitude:      r.Longitude,
+			DistanceMeters: r.DistanceMeters,
+			DeviceId:       r.DeviceId,
+			Flagged:        r.Flagged,
+			FlagReason:     r.FlagReason,
+		})
+	}
+
+	return &types.ListCheckInRecordsResp{
+		List:       list,
+		Pagination: logic.ConvertRpcPaginationToApi(rpcResp.Pagination),
+	}, nil
+}
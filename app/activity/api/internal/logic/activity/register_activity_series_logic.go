@@ -0,0 +1,57 @@
+package activity
+
+import (
+	"context"
+
+	"activity-platform/app/activity/api/internal/svc"
+	"activity-platform/app/activity/api/internal/types"
+	"activity-platform/app/activity/rpc/activityservice"
+	"activity-platform/common/ctxdata"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RegisterActivitySeriesLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 整期报名
+func NewRegisterActivitySeriesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RegisterActivitySeriesLogic {
+	return &RegisterActivitySeriesLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *RegisterActivitySeriesLogic) RegisterActivitySeries(req *types.RegisterActivitySeriesReq) (resp *types.RegisterActivitySeriesResp, err error) {
+	// 1. 获取当前用户 ID
+	userID := ctxdata.GetUserIDFromCtx(l.ctx)
+	if userID <= 0 {
+		return nil, errorx.ErrUnauthorized()
+	}
+
+	// 2. 参数校验
+	if req.Id <= 0 {
+		return nil, errorx.ErrInvalidParams("系列ID无效")
+	}
+
+	// 3. 调用 RPC 服务
+	rpcResp, err := l.svcCtx.ActivityRpc.RegisterActivitySeries(l.ctx, &activityservice.RegisterActivitySeriesReq{
+		SeriesId: req.Id,
+		UserId:   userID,
+		Register: true,
+	})
+	if err != nil {
+		l.Errorf("RPC RegisterActivitySeries failed: id=%d, userID=%d, register=true, err=%v", req.Id, userID, err)
+		return nil, errorx.FromError(err)
+	}
+
+	return &types.RegisterActivitySeriesResp{
+		Subscribed: rpcResp.Subscribed,
+		Enrolled:   rpcResp.Enrolled,
+	}, nil
+}
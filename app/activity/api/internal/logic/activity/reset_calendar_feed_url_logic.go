@@ -0,0 +1,46 @@
+package activity
+
+import (
+	"context"
+
+	"activity-platform/app/activity/api/internal/svc"
+	"activity-platform/app/activity/api/internal/types"
+	"activity-platform/app/activity/rpc/activityservice"
+	"activity-platform/common/ctxdata"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ResetCalendarFeedUrlLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 重置日历订阅地址（旧地址立即失效）
+func NewResetCalendarFeedUrlLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ResetCalendarFeedUrlLogic {
+	return &ResetCalendarFeedUrlLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ResetCalendarFeedUrlLogic) ResetCalendarFeedUrl() (resp *types.CalendarFeedUrlResp, err error) {
+	userID := ctxdata.GetUserIDFromCtx(l.ctx)
+	if userID <= 0 {
+		return nil, errorx.ErrUnauthorized()
+	}
+
+	rpcResp, err := l.svcCtx.ActivityRpc.GetCalendarFeedToken(l.ctx, &activityservice.GetCalendarFeedTokenReq{
+		UserId: userID,
+		Reset_: true,
+	})
+	if err != nil {
+		l.Errorf("RPC GetCalendarFeedToken failed: userID=%d, reset=true, err=%v", userID, err)
+		return nil, errorx.FromError(err)
+	}
+
+	return toCalendarFeedUrlResp(l.svcCtx.Config.Calendar.FeedBaseURL, rpcResp.Token), nil
+}
@@ -0,0 +1,57 @@
+package activity
+
+import (
+	"context"
+
+	"activity-platform/app/activity/api/internal/logic"
+	"activity-platform/app/activity/api/internal/svc"
+	"activity-platform/app/activity/api/internal/types"
+	"activity-platform/app/activity/rpc/activityservice"
+	"activity-platform/common/ctxdata"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SaveActivityTemplateLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 另存为个人模板
+func NewSaveActivityTemplateLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SaveActivityTemplateLogic {
+	return &SaveActivityTemplateLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *SaveActivityTemplateLogic) SaveActivityTemplate(req *types.SaveActivityTemplateReq) (resp *types.ActivityTemplateResp, err error) {
+	// 1. 获取当前用户 ID
+	userID := ctxdata.GetUserIDFromCtx(l.ctx)
+	if userID <= 0 {
+		return nil, errorx.ErrUnauthorized()
+	}
+
+	// 2. 参数校验
+	if req.Id <= 0 {
+		return nil, errorx.ErrInvalidParams("活动ID无效")
+	}
+
+	// 3. 调用 RPC 服务（仅组织者可保存自己的活动）
+	rpcResp, err := l.svcCtx.ActivityRpc.SaveActivityTemplate(l.ctx, &activityservice.SaveActivityTemplateReq{
+		ActivityId: req.Id,
+		OperatorId: userID,
+		Name:       req.Name,
+	})
+	if err != nil {
+		l.Errorf("RPC SaveActivityTemplate failed: id=%d, userID=%d, err=%v", req.Id, userID, err)
+		return nil, errorx.FromError(err)
+	}
+
+	return &types.ActivityTemplateResp{
+		Template: logic.ConvertRpcTemplateToApi(rpcResp.Template),
+	}, nil
+}
@@ -0,0 +1,58 @@
+package activity
+
+import (
+	"context"
+
+	"activity-platform/app/activity/api/internal/logic"
+	"activity-platform/app/activity/api/internal/svc"
+	"activity-platform/app/activity/api/internal/types"
+	"activity-platform/app/activity/rpc/activityservice"
+	"activity-platform/common/ctxdata"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SetEligibilityRulesLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 设置报名资格规则
+func NewSetEligibilityRulesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SetEligibilityRulesLogic {
+	return &SetEligibilityRulesLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *SetEligibilityRulesLogic) SetEligibilityRules(req *types.SetEligibilityRulesReq) (resp *types.SetEligibilityRulesResp, err error) {
+	// 1. 获取当前用户 ID
+	userID := ctxdata.GetUserIDFromCtx(l.ctx)
+	if userID <= 0 {
+		return nil, errorx.ErrUnauthorized()
+	}
+
+	// 2. 参数校验（规则内容由 RPC 层统一校验）
+	if req.Id <= 0 {
+		return nil, errorx.ErrInvalidParams("活动ID无效")
+	}
+
+	// 3. 调用 RPC 服务（仅组织者可设置）
+	rpcResp, err := l.svcCtx.ActivityRpc.SetEligibilityRules(l.ctx, &activityservice.SetEligibilityRulesReq{
+		ActivityId: req.Id,
+		OperatorId: userID,
+		Rules:      logic.ConvertApiEligibilityRulesToRpc(req.Rules),
+	})
+	if err != nil {
+		l.Errorf("RPC SetEligibilityRules failed: id=%d, userID=%d, err=%v", req.Id, userID, err)
+		return nil, errorx.FromError(err)
+	}
+
+	// 4. 返回响应
+	return &types.SetEligibilityRulesResp{
+		Rules: logic.ConvertRpcEligibilityRulesToApi(rpcResp.Rules),
+	}, nil
+}
@@ -0,0 +1,58 @@
+package activity
+
+import (
+	"context"
+
+	"activity-platform/app/activity/api/internal/svc"
+	"activity-platform/app/activity/api/internal/types"
+	"activity-platform/app/activity/rpc/activityservice"
+	"activity-platform/common/ctxdata"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SetSelfCheckInLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 开启/关闭自助签到
+func NewSetSelfCheckInLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SetSelfCheckInLogic {
+	return &SetSelfCheckInLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *SetSelfCheckInLogic) SetSelfCheckIn(req *types.SetSelfCheckInReq) (resp *types.SetSelfCheckInResp, err error) {
+	// 1. 获取当前用户 ID
+	userID := ctxdata.GetUserIDFromCtx(l.ctx)
+	if userID <= 0 {
+		return nil, errorx.ErrUnauthorized()
+	}
+
+	// 2. 参数校验
+	if req.Id <= 0 {
+		return nil, errorx.ErrInvalidParams("活动ID无效")
+	}
+
+	// 3. 调用 RPC 服务（仅组织者可设置）
+	rpcResp, err := l.svcCtx.ActivityRpc.SetSelfCheckIn(l.ctx, &activityservice.SetSelfCheckInReq{
+		ActivityId:   req.Id,
+		OperatorId:   userID,
+		Enabled:      req.Enabled,
+		RadiusMeters: req.RadiusMeters,
+	})
+	if err != nil {
+		l.Errorf("RPC SetSelfCheckIn failed: id=%d, userID=%d, enabled=%v, err=%v", req.Id, userID, req.Enabled, err)
+		return nil, errorx.FromError(err)
+	}
+
+	return &types.SetSelfCheckInResp{
+		Enabled:      rpcResp.Enabled,
+		RadiusMeters: rpcResp.RadiusMeters,
+	}, nil
+}
@@ -0,0 +1,64 @@
+package activity
+
+import (
+	"context"
+
+	"activity-platform/app/activity/api/internal/svc"
+	"activity-platform/app/activity/api/internal/types"
+	"activity-platform/app/activity/rpc/activityservice"
+	"activity-platform/common/ctxdata"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SubmitActivityChangeLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 提交时间/地点变更申请（已发布活动）
+func NewSubmitActivityChangeLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SubmitActivityChangeLogic {
+	return &SubmitActivityChangeLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *SubmitActivityChangeLogic) SubmitActivityChange(req *types.SubmitActivityChangeReq) (resp *types.SubmitActivityChangeResp, err error) {
+	// 1. 获取当前用户 ID
+	userID := ctxdata.GetUserIDFromCtx(l.ctx)
+	if userID <= 0 {
+		return nil, errorx.ErrUnauthorized()
+	}
+
+	// 2. 参数校验（时间逻辑由 RPC 层统一校验）
+	if req.Id <= 0 {
+		return nil, errorx.ErrInvalidParams("活动ID无效")
+	}
+
+	// 3. 调用 RPC 服务（仅组织者可申请）
+	rpcResp, err := l.svcCtx.ActivityRpc.SubmitActivityChange(l.ctx, &activityservice.SubmitActivityChangeReq{
+		ActivityId:        req.Id,
+		OperatorId:        userID,
+		RegisterEndTime:   req.RegisterEndTime,
+		ActivityStartTime: req.ActivityStartTime,
+		ActivityEndTime:   req.ActivityEndTime,
+		Location:          req.Location,
+		AddressDetail:     req.AddressDetail,
+		Longitude:         req.Longitude,
+		Latitude:          req.Latitude,
+		Reason:            req.Reason,
+	})
+	if err != nil {
+		l.Errorf("RPC SubmitActivityChange failed: id=%d, userID=%d, err=%v", req.Id, userID, err)
+		return nil, errorx.FromError(err)
+	}
+
+	return &types.SubmitActivityChangeResp{
+		RequestId: rpcResp.RequestId,
+		Status:    rpcResp.Status,
+	}, nil
+}
@@ -0,0 +1,61 @@
+package activity
+
+import (
+	"context"
+
+	"activity-platform/app/activity/api/internal/svc"
+	"activity-platform/app/activity/api/internal/types"
+	"activity-platform/app/activity/rpc/activityservice"
+	"activity-platform/common/ctxdata"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SubmitFeedbackLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 提交活动评价
+func NewSubmitFeedbackLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SubmitFeedbackLogic {
+	return &SubmitFeedbackLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *SubmitFeedbackLogic) SubmitFeedback(req *types.SubmitFeedbackReq) (resp *types.SubmitFeedbackResp, err error) {
+	// 1. 获取当前用户 ID
+	userID := ctxdata.GetUserIDFromCtx(l.ctx)
+	if userID <= 0 {
+		return nil, errorx.ErrUnauthorized()
+	}
+
+	// 2. 参数校验（评分范围、短评长度、参与资格由 RPC 层统一校验）
+	if req.Id <= 0 {
+		return nil, errorx.ErrInvalidParams("活动ID无效")
+	}
+
+	// 3. 调用 RPC 服务
+	rpcResp, err := l.svcCtx.ActivityRpc.SubmitFeedback(l.ctx, &activityservice.SubmitFeedbackReq{
+		ActivityId: req.Id,
+		UserId:     userID,
+		Rating:     req.Rating,
+		Comment:    req.Comment,
+	})
+	if err != nil {
+		l.Errorf("RPC SubmitFeedback failed: id=%d, userID=%d, err=%v", req.Id, userID, err)
+		return nil, errorx.FromError(err)
+	}
+
+	// 4. 返回响应
+	return &types.SubmitFeedbackResp{
+		RatingAvg:     rpcResp.RatingAvg,
+		RatingCount:   rpcResp.RatingCount,
+		CommentStatus: rpcResp.CommentStatus,
+		Comment:       rpcResp.Comment,
+	}, nil
+}
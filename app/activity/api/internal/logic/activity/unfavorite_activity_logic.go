@@ -0,0 +1,54 @@
+package activity
+
+import (
+	"context"
+
+	"activity-platform/app/activity/api/internal/svc"
+	"activity-platform/app/activity/api/internal/types"
+	"activity-platform/app/activity/rpc/activityservice"
+	"activity-platform/common/ctxdata"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UnfavoriteActivityLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 取消收藏活动
+func NewUnfavoriteActivityLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UnfavoriteActivityLogic {
+	return &UnfavoriteActivityLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *UnfavoriteActivityLogic) UnfavoriteActivity(req *types.FavoriteActivityReq) (resp *types.FavoriteActivityResp, err error) {
+	// 1. 获取当前用户 ID
+	userID := ctxdata.GetUserIDFromCtx(l.ctx)
+	if userID <= 0 {
+		return nil, errorx.ErrUnauthorized()
+	}
+
+	// 2. 参数校验
+	if req.Id <= 0 {
+		return nil, errorx.ErrInvalidParams("活动ID无效")
+	}
+
+	// 3. 调用 RPC 服务
+	rpcResp, err := l.svcCtx.ActivityRpc.FavoriteActivity(l.ctx, &activityservice.FavoriteActivityReq{
+		ActivityId: req.Id,
+		UserId:     userID,
+		Favorite:   false,
+	})
+	if err != nil {
+		l.Errorf("RPC FavoriteActivity failed: id=%d, userID=%d, favorite=false, err=%v", req.Id, userID, err)
+		return nil, errorx.FromError(err)
+	}
+
+	return &types.FavoriteActivityResp{Favorited: rpcResp.Favorited}, nil
+}
@@ -0,0 +1,57 @@
+package activity
+
+import (
+	"context"
+
+	"activity-platform/app/activity/api/internal/svc"
+	"activity-platform/app/activity/api/internal/types"
+	"activity-platform/app/activity/rpc/activityservice"
+	"activity-platform/common/ctxdata"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UnregisterActivitySeriesLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 退出整期报名
+func NewUnregisterActivitySeriesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UnregisterActivitySeriesLogic {
+	return &UnregisterActivitySeriesLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *UnregisterActivitySeriesLogic) UnregisterActivitySeries(req *types.RegisterActivitySeriesReq) (resp *types.RegisterActivitySeriesResp, err error) {
+	// 1. 获取当前用户 ID
+	userID := ctxdata.GetUserIDFromCtx(l.ctx)
+	if userID <= 0 {
+		return nil, errorx.ErrUnauthorized()
+	}
+
+	// 2. 参数校验
+	if req.Id <= 0 {
+		return nil, errorx.ErrInvalidParams("系列ID无效")
+	}
+
+	// 3. 调用 RPC 服务
+	rpcResp, err := l.svcCtx.ActivityRpc.RegisterActivitySeries(l.ctx, &activityservice.RegisterActivitySeriesReq{
+		SeriesId: req.Id,
+		UserId:   userID,
+		Register: false,
+	})
+	if err != nil {
+		l.Errorf("RPC RegisterActivitySeries failed: id=%d, userID=%d, register=false, err=%v", req.Id, userID, err)
+		return nil, errorx.FromError(err)
+	}
+
+	return &types.RegisterActivitySeriesResp{
+		Subscribed: rpcResp.Subscribed,
+		Enrolled:   rpcResp.Enrolled,
+	}, nil
+}
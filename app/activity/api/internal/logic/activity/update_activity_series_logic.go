@@ -0,0 +1,88 @@
+package activity
+
+import (
+	"context"
+
+	"activity-platform/app/activity/api/internal/svc"
+	"activity-platform/app/activity/api/internal/types"
+	"activity-platform/app/activity/rpc/activityservice"
+	"activity-platform/common/ctxdata"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdateActivitySeriesLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 整期修改系列活动
+func NewUpdateActivitySeriesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateActivitySeriesLogic {
+	return &UpdateActivitySeriesLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *UpdateActivitySeriesLogic) UpdateActivitySeries(req *types.UpdateActivitySeriesReq) (resp *types.UpdateActivitySeriesResp, err error) {
+	// 1. 获取当前用户 ID
+	userID := ctxdata.GetUserIDFromCtx(l.ctx)
+	if userID <= 0 {
+		return nil, errorx.ErrUnauthorized()
+	}
+
+	// 2. 参数校验
+	if req.Id <= 0 {
+		return nil, errorx.ErrInvalidParams("系列ID无效")
+	}
+	if len(req.TagIds) > 5 {
+		return nil, errorx.ErrInvalidParams("最多选择5个标签")
+	}
+
+	// 3. 调用 RPC（可选字段原样透传，nil 表示不修改）
+	rpcResp, err := l.svcCtx.ActivityRpc.UpdateActivitySeries(l.ctx, &activityservice.UpdateActivitySeriesReq{
+		Id:                   req.Id,
+		OperatorId:           userID,
+		Title:                req.Title,
+		Content:              req.Content,
+		CategoryId:           req.CategoryId,
+		ContactPhone:         req.ContactPhone,
+		CoverImageId:         req.CoverImageId,
+		CoverType:            req.CoverType,
+		Location:             req.Location,
+		AddressDetail:        req.AddressDetail,
+		Longitude:            req.Longitude,
+		Latitude:             req.Latitude,
+		MaxParticipants:      req.MaxParticipants,
+		RequireApproval:      req.RequireApproval,
+		RequireStudentVerify: req.RequireStudentVerify,
+		MinCreditScore:       req.MinCreditScore,
+		TagIds:               req.TagIds,
+		UpdateTags:           req.UpdateTags,
+		AllowSeriesRegister:  req.AllowSeriesRegister,
+	})
+	if err != nil {
+		l.Errorf("RPC UpdateActivitySeries failed: id=%d, userID=%d, err=%v", req.Id, userID, err)
+		return nil, errorx.FromError(err)
+	}
+
+	return &types.UpdateActivitySeriesResp{
+		Updated: rpcResp.Updated,
+		Skipped: convertSkippedOccurrences(rpcResp.Skipped),
+	}, nil
+}
+
+// convertSkippedOccurrences 转换未同步/未取消的场次列表
+func convertSkippedOccurrences(list []*activityservice.SeriesSkippedOccurrence) []types.SeriesSkippedOccurrence {
+	result := make([]types.SeriesSkippedOccurrence, 0, len(list))
+	for _, item := range list {
+		result = append(result, types.SeriesSkippedOccurrence{
+			ActivityId: item.ActivityId,
+			Reason:     item.Reason,
+		})
+	}
+	return result
+}
@@ -0,0 +1,61 @@
+package activity
+
+import (
+	"context"
+
+	"activity-platform/app/activity/api/internal/svc"
+	"activity-platform/app/activity/api/internal/types"
+	"activity-platform/app/activity/rpc/activityservice"
+	"activity-platform/common/ctxdata"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UseActivityTemplateLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 使用模板创建草稿
+func NewUseActivityTemplateLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UseActivityTemplateLogic {
+	return &UseActivityTemplateLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *UseActivityTemplateLogic) UseActivityTemplate(req *types.UseActivityTemplateReq) (resp *types.CreateActivityResp, err error) {
+	// 1. 获取当前用户 ID
+	userID := ctxdata.GetUserIDFromCtx(l.ctx)
+	if userID <= 0 {
+		return nil, errorx.ErrUnauthorized()
+	}
+
+	// 2. 参数校验
+	if req.Id <= 0 {
+		return nil, errorx.ErrInvalidParams("模板ID无效")
+	}
+	if req.ActivityStartTime <= 0 {
+		return nil, errorx.ErrInvalidParams("请选择活动开始时间")
+	}
+
+	// 3. 调用 RPC 服务
+	rpcResp, err := l.svcCtx.ActivityRpc.CreateActivityFromTemplate(l.ctx, &activityservice.CreateActivityFromTemplateReq{
+		TemplateId:        req.Id,
+		OperatorId:        userID,
+		ActivityStartTime: req.ActivityStartTime,
+		Title:             req.Title,
+	})
+	if err != nil {
+		l.Errorf("RPC CreateActivityFromTemplate failed: templateId=%d, userID=%d, err=%v", req.Id, userID, err)
+		return nil, errorx.FromError(err)
+	}
+
+	return &types.CreateActivityResp{
+		Id:     rpcResp.Id,
+		Status: rpcResp.Status,
+	}, nil
+}
@@ -0,0 +1,54 @@
+package admin
+
+import (
+	"context"
+
+	"activity-platform/app/activity/api/internal/logic"
+	"activity-platform/app/activity/api/internal/svc"
+	"activity-platform/app/activity/api/internal/types"
+	"activity-platform/app/activity/rpc/activityservice"
+	"activity-platform/common/ctxdata"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AdminCreateTemplateLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 创建公共模板
+func NewAdminCreateTemplateLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AdminCreateTemplateLogic {
+	return &AdminCreateTemplateLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *AdminCreateTemplateLogic) AdminCreateTemplate(req *types.AdminCreateTemplateReq) (resp *types.ActivityTemplateResp, err error) {
+	adminID := ctxdata.GetUserIDFromCtx(l.ctx)
+	if adminID <= 0 {
+		return nil, errorx.ErrUnauthorized()
+	}
+	if req.SourceActivityId <= 0 {
+		return nil, errorx.ErrInvalidParams("请选择蓝本活动")
+	}
+
+	rpcResp, err := l.svcCtx.ActivityRpc.AdminCreatePublicTemplate(l.ctx, &activityservice.AdminCreatePublicTemplateReq{
+		SourceActivityId: req.SourceActivityId,
+		OperatorId:       adminID,
+		Name:             req.Name,
+		CategoryId:       req.CategoryId,
+	})
+	if err != nil {
+		l.Errorf("RPC AdminCreatePublicTemplate failed: sourceId=%d, adminID=%d, err=%v", req.SourceActivityId, adminID, err)
+		return nil, errorx.FromError(err)
+	}
+
+	return &types.ActivityTemplateResp{
+		Template: logic.ConvertRpcTemplateToApi(rpcResp.Template),
+	}, nil
+}
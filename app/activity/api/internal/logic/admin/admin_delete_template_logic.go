@@ -0,0 +1,50 @@
+package admin
+
+import (
+	"context"
+
+	"activity-platform/app/activity/api/internal/svc"
+	"activity-platform/app/activity/api/internal/types"
+	"activity-platform/app/activity/rpc/activityservice"
+	"activity-platform/common/ctxdata"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AdminDeleteTemplateLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 删除公共模板
+func NewAdminDeleteTemplateLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AdminDeleteTemplateLogic {
+	return &AdminDeleteTemplateLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *AdminDeleteTemplateLogic) AdminDeleteTemplate(req *types.DeleteActivityTemplateReq) (resp *types.DeleteActivityTemplateResp, err error) {
+	adminID := ctxdata.GetUserIDFromCtx(l.ctx)
+	if adminID <= 0 {
+		return nil, errorx.ErrUnauthorized()
+	}
+	if req.Id <= 0 {
+		return nil, errorx.ErrInvalidParams("模板ID无效")
+	}
+
+	_, err = l.svcCtx.ActivityRpc.DeleteActivityTemplate(l.ctx, &activityservice.DeleteActivityTemplateReq{
+		Id:         req.Id,
+		OperatorId: adminID,
+		IsAdmin:    true,
+	})
+	if err != nil {
+		l.Errorf("RPC DeleteActivityTemplate (admin) failed: id=%d, adminID=%d, err=%v", req.Id, adminID, err)
+		return nil, errorx.FromError(err)
+	}
+
+	return &types.DeleteActivityTemplateResp{Success: true}, nil
+}
@@ -0,0 +1,54 @@
+package admin
+
+import (
+	"context"
+
+	"activity-platform/app/activity/api/internal/logic"
+	"activity-platform/app/activity/api/internal/svc"
+	"activity-platform/app/activity/api/internal/types"
+	"activity-platform/app/activity/rpc/activityservice"
+	"activity-platform/common/ctxdata"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AdminListActivityChangesLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 变更申请列表
+func NewAdminListActivityChangesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AdminListActivityChangesLogic {
+	return &AdminListActivityChangesLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *AdminListActivityChangesLogic) AdminListActivityChanges(req *types.AdminListActivityChangesReq) (resp *types.ListActivityChangesResp, err error) {
+	adminID := ctxdata.GetUserIDFromCtx(l.ctx)
+	if adminID <= 0 {
+		return nil, errorx.ErrUnauthorized()
+	}
+
+	rpcResp, err := l.svcCtx.ActivityRpc.ListActivityChanges(l.ctx, &activityservice.ListActivityChangesReq{
+		OperatorId: adminID,
+		IsAdmin:    true,
+		ActivityId: req.ActivityId,
+		Status:     req.Status,
+		Page:       req.Page,
+		PageSize:   req.PageSize,
+	})
+	if err != nil {
+		l.Errorf("RPC ListActivityChanges (admin) failed: adminID=%d, err=%v", adminID, err)
+		return nil, errorx.FromError(err)
+	}
+
+	return &types.ListActivityChangesResp{
+		List:       logic.ConvertRpcActivityChangesToApi(rpcResp.List),
+		Pagination: logic.ConvertRpcPaginationToApi(rpcResp.Pagination),
+	}, nil
+}
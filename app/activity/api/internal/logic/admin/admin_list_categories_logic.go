@@ -0,0 +1,64 @@
+package admin
+
+import (
+	"context"
+
+	"activity-platform/app/activity/api/internal/svc"
+	"activity-platform/app/activity/api/internal/types"
+	"activity-platform/app/activity/rpc/activityservice"
+	"activity-platform/common/ctxdata"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AdminListCategoriesLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 分类列表（含已禁用）
+func NewAdminListCategoriesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AdminListCategoriesLogic {
+	return &AdminListCategoriesLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *AdminListCategoriesLogic) AdminListCategories() (resp *types.AdminListCategoryResp, err error) {
+	adminID := ctxdata.GetUserIDFromCtx(l.ctx)
+	if adminID <= 0 {
+		return nil, errorx.ErrUnauthorized()
+	}
+
+	rpcResp, err := l.svcCtx.ActivityRpc.AdminListCategories(l.ctx, &activityservice.AdminListCategoriesReq{})
+	if err != nil {
+		l.Errorf("RPC AdminListCategories failed: adminID=%d, err=%v", adminID, err)
+		return nil, errorx.FromError(err)
+	}
+
+	list := make([]types.AdminCategory, 0, len(rpcResp.List))
+	for _, cat := range rpcResp.List {
+		list = append(list, toAdminCategory(cat))
+	}
+	return &types.AdminListCategoryResp{List: list}, nil
+}
+
+// toAdminCategory RPC 分类转换为 API 类型
+func toAdminCategory(cat *activityservice.AdminCategory) types.AdminCategory {
+	if cat == nil {
+		return types.AdminCategory{}
+	}
+	return types.AdminCategory{
+		Id:            cat.Id,
+		Name:          cat.Name,
+		Icon:          cat.Icon,
+		Sort:          cat.Sort,
+		Status:        cat.Status,
+		ActivityCount: cat.ActivityCount,
+		CreatedAt:     cat.CreatedAt,
+		UpdatedAt:     cat.UpdatedAt,
+	}
+}
@@ -0,0 +1,63 @@
+package admin
+
+import (
+	"context"
+
+	"activity-platform/app/activity/api/internal/svc"
+	"activity-platform/app/activity/api/internal/types"
+	"activity-platform/app/activity/rpc/activityservice"
+	"activity-platform/common/ctxdata"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AdminListTagsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 标签列表（含已禁用）
+func NewAdminListTagsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AdminListTagsLogic {
+	return &AdminListTagsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *AdminListTagsLogic) AdminListTags() (resp *types.AdminListTagResp, err error) {
+	adminID := ctxdata.GetUserIDFromCtx(l.ctx)
+	if adminID <= 0 {
+		return nil, errorx.ErrUnauthorized()
+	}
+
+	rpcResp, err := l.svcCtx.ActivityRpc.AdminListTags(l.ctx, &activityservice.AdminListTagsReq{})
+	if err != nil {
+		l.Errorf("RPC AdminListTags failed: adminID=%d, err=%v", adminID, err)
+		return nil, errorx.FromError(err)
+	}
+
+	list := make([]types.AdminTag, 0, len(rpcResp.List))
+	for _, tag := range rpcResp.List {
+		list = append(list, toAdminTag(tag))
+	}
+	return &types.AdminListTagResp{List: list}, nil
+}
+
+// toAdminTag RPC 标签转换为 API 类型
+func toAdminTag(tag *activityservice.AdminTag) types.AdminTag {
+	if tag == nil {
+		return types.AdminTag{}
+	}
+	return types.AdminTag{
+		Id:            tag.Id,
+		Name:          tag.Name,
+		Color:         tag.Color,
+		Icon:          tag.Icon,
+		Description:   tag.Description,
+		Status:        tag.Status,
+		ActivityCount: tag.ActivityCount,
+	}
+}
@@ -0,0 +1,53 @@
+package admin
+
+import (
+	"context"
+
+	"activity-platform/app/activity/api/internal/logic"
+	"activity-platform/app/activity/api/internal/svc"
+	"activity-platform/app/activity/api/internal/types"
+	"activity-platform/app/activity/rpc/activityservice"
+	"activity-platform/common/ctxdata"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AdminListTemplatesLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 公共模板列表（含已下架）
+func NewAdminListTemplatesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AdminListTemplatesLogic {
+	return &AdminListTemplatesLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *AdminListTemplatesLogic) AdminListTemplates(req *types.AdminListTemplatesReq) (resp *types.ListActivityTemplatesResp, err error) {
+	adminID := ctxdata.GetUserIDFromCtx(l.ctx)
+	if adminID <= 0 {
+		return nil, errorx.ErrUnauthorized()
+	}
+
+	rpcResp, err := l.svcCtx.ActivityRpc.ListActivityTemplates(l.ctx, &activityservice.ListActivityTemplatesReq{
+		OperatorId: adminID,
+		IsAdmin:    true,
+		CategoryId: req.CategoryId,
+		Page:       req.Page,
+		PageSize:   req.PageSize,
+	})
+	if err != nil {
+		l.Errorf("RPC ListActivityTemplates (admin) failed: adminID=%d, err=%v", adminID, err)
+		return nil, errorx.FromError(err)
+	}
+
+	return &types.ListActivityTemplatesResp{
+		List:       logic.ConvertRpcTemplatesToApi(rpcResp.List),
+		Pagination: logic.ConvertRpcPaginationToApi(rpcResp.Pagination),
+	}, nil
+}
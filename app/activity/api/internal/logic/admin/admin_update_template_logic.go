@@ -0,0 +1,55 @@
+package admin
+
+import (
+	"context"
+
+	"activity-platform/app/activity/api/internal/logic"
+	"activity-platform/app/activity/api/internal/svc"
+	"activity-platform/app/activity/api/internal/types"
+	"activity-platform/app/activity/rpc/activityservice"
+	"activity-platform/common/ctxdata"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AdminUpdateTemplateLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 修改公共模板（名称、分类、上下架）
+func NewAdminUpdateTemplateLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AdminUpdateTemplateLogic {
+	return &AdminUpdateTemplateLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *AdminUpdateTemplateLogic) AdminUpdateTemplate(req *types.AdminUpdateTemplateReq) (resp *types.ActivityTemplateResp, err error) {
+	adminID := ctxdata.GetUserIDFromCtx(l.ctx)
+	if adminID <= 0 {
+		return nil, errorx.ErrUnauthorized()
+	}
+	if req.Id <= 0 {
+		return nil, errorx.ErrInvalidParams("模板ID无效")
+	}
+
+	rpcResp, err := l.svcCtx.ActivityRpc.AdminUpdateTemplate(l.ctx, &activityservice.AdminUpdateTemplateReq{
+		Id:         req.Id,
+		OperatorId: adminID,
+		Name:       req.Name,
+		CategoryId: req.CategoryId,
+		Status:     req.Status,
+	})
+	if err != nil {
+		l.Errorf("RPC AdminUpdateTemplate failed: id=%d, adminID=%d, err=%v", req.Id, adminID, err)
+		return nil, errorx.FromError(err)
+	}
+
+	return &types.ActivityTemplateResp{
+		Template: logic.ConvertRpcTemplateToApi(rpcResp.Template),
+	}, nil
+}
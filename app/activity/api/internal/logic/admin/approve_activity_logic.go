@@ -5,6 +5,8 @@ import (
 
 	"activity-platform/app/activity/api/internal/svc"
 	"activity-platform/app/activity/api/internal/types"
+	"activity-platform/app/activity/rpc/activityservice"
+	"activity-platform/common/ctxdata"
 	"activity-platform/common/errorx"
 
 	"github.com/zeromicro/go-zero/core/logx"
@@ -26,7 +28,22 @@ func NewApproveActivityLogic(ctx context.Context, svcCtx *svc.ServiceContext) *A
 }
 
 func (l *ApproveActivityLogic) ApproveActivity(req *types.ApproveActivityReq) (resp *types.ApproveActivityResp, err error) {
-	// MVP 版本：无后台管理系统，审核功能暂未开放
-	// 活动创建后直接发布，无需审核
-	return nil, errorx.ErrInvalidParams("MVP 版本暂未开放审核功能，活动创建后自动发布")
+	adminID := ctxdata.GetUserIDFromCtx(l.ctx)
+	if adminID <= 0 {
+		return nil, errorx.ErrUnauthorized()
+	}
+	if req.Id <= 0 {
+		return nil, errorx.ErrInvalidParams("活动ID无效")
+	}
+
+	rpcResp, err := l.svcCtx.ActivityRpc.ApproveActivity(l.ctx, &activityservice.ApproveActivityReq{
+		Id:         req.Id,
+		OperatorId: adminID,
+	})
+	if err != nil {
+		l.Errorf("RPC ApproveActivity failed: activityID=%d, adminID=%d, err=%v", req.Id, adminID, err)
+		return nil, errorx.FromError(err)
+	}
+
+	return &types.ApproveActivityResp{Status: rpcResp.Status}, nil
 }
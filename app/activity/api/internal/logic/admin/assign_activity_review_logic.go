@@ -0,0 +1,54 @@
+package admin
+
+import (
+	"context"
+
+	"activity-platform/app/activity/api/internal/svc"
+	"activity-platform/app/activity/api/internal/types"
+	"activity-platform/app/activity/rpc/activityservice"
+	"activity-platform/common/ctxdata"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AssignActivityReviewLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 分配/领取/释放审核任务
+func NewAssignActivityReviewLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AssignActivityReviewLogic {
+	return &AssignActivityReviewLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *AssignActivityReviewLogic) AssignActivityReview(req *types.AssignActivityReviewReq) (resp *types.AssignActivityReviewResp, err error) {
+	adminID := ctxdata.GetUserIDFromCtx(l.ctx)
+	if adminID <= 0 {
+		return nil, errorx.ErrUnauthorized()
+	}
+	if req.Id <= 0 {
+		return nil, errorx.ErrInvalidParams("活动ID无效")
+	}
+
+	rpcResp, err := l.svcCtx.ActivityRpc.AssignActivityReview(l.ctx, &activityservice.AssignActivityReviewReq{
+		ActivityId: req.Id,
+		OperatorId: adminID,
+		AssigneeId: req.AssigneeId,
+		Release:    req.Release,
+	})
+	if err != nil {
+		l.Errorf("RPC AssignActivityReview failed: activityID=%d, adminID=%d, err=%v", req.Id, adminID, err)
+		return nil, errorx.FromError(err)
+	}
+
+	return &types.AssignActivityReviewResp{
+		AssigneeId: rpcResp.AssigneeId,
+		AssignedAt: rpcResp.AssignedAt,
+	}, nil
+}
@@ -0,0 +1,48 @@
+package admin
+
+import (
+	"context"
+
+	"activity-platform/app/activity/api/internal/svc"
+	"activity-platform/app/activity/api/internal/types"
+	"activity-platform/app/activity/rpc/activityservice"
+	"activity-platform/common/ctxdata"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateCategoryLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 创建分类
+func NewCreateCategoryLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateCategoryLogic {
+	return &CreateCategoryLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *CreateCategoryLogic) CreateCategory(req *types.CreateCategoryReq) (resp *types.AdminCategoryResp, err error) {
+	adminID := ctxdata.GetUserIDFromCtx(l.ctx)
+	if adminID <= 0 {
+		return nil, errorx.ErrUnauthorized()
+	}
+
+	rpcResp, err := l.svcCtx.ActivityRpc.CreateCategory(l.ctx, &activityservice.CreateCategoryReq{
+		Name:       req.Name,
+		Icon:       req.Icon,
+		Sort:       req.Sort,
+		OperatorId: adminID,
+	})
+	if err != nil {
+		l.Errorf("RPC CreateCategory failed: name=%s, adminID=%d, err=%v", req.Name, adminID, err)
+		return nil, errorx.FromError(err)
+	}
+
+	return &types.AdminCategoryResp{Category: toAdminCategory(rpcResp.Category)}, nil
+}
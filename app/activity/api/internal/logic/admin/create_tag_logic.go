@@ -0,0 +1,49 @@
+package admin
+
+import (
+	"context"
+
+	"activity-platform/app/activity/api/internal/svc"
+	"activity-platform/app/activity/api/internal/types"
+	"activity-platform/app/activity/rpc/activityservice"
+	"activity-platform/common/ctxdata"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateTagLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 创建标签
+func NewCreateTagLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateTagLogic {
+	return &CreateTagLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *CreateTagLogic) CreateTag(req *types.CreateTagReq) (resp *types.AdminTagResp, err error) {
+	adminID := ctxdata.GetUserIDFromCtx(l.ctx)
+	if adminID <= 0 {
+		return nil, errorx.ErrUnauthorized()
+	}
+
+	rpcResp, err := l.svcCtx.ActivityRpc.CreateTag(l.ctx, &activityservice.CreateTagReq{
+		Name:        req.Name,
+		Color:       req.Color,
+		Icon:        req.Icon,
+		Description: req.Description,
+		OperatorId:  adminID,
+	})
+	if err != nil {
+		l.Errorf("RPC CreateTag failed: name=%s, adminID=%d, err=%v", req.Name, adminID, err)
+		return nil, errorx.FromError(err)
+	}
+
+	return &types.AdminTagResp{Tag: toAdminTag(rpcResp.Tag)}, nil
+}
@@ -0,0 +1,49 @@
+package admin
+
+import (
+	"context"
+
+	"activity-platform/app/activity/api/internal/svc"
+	"activity-platform/app/activity/api/internal/types"
+	"activity-platform/app/activity/rpc/activityservice"
+	"activity-platform/common/ctxdata"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeleteCategoryLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 删除分类
+func NewDeleteCategoryLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteCategoryLogic {
+	return &DeleteCategoryLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *DeleteCategoryLogic) DeleteCategory(req *types.DeleteCategoryReq) (resp *types.DeleteCategoryResp, err error) {
+	adminID := ctxdata.GetUserIDFromCtx(l.ctx)
+	if adminID <= 0 {
+		return nil, errorx.ErrUnauthorized()
+	}
+	if req.Id <= 0 {
+		return nil, errorx.ErrInvalidParams("分类ID无效")
+	}
+
+	_, err = l.svcCtx.ActivityRpc.DeleteCategory(l.ctx, &activityservice.DeleteCategoryReq{
+		Id:         req.Id,
+		OperatorId: adminID,
+	})
+	if err != nil {
+		l.Errorf("RPC DeleteCategory failed: id=%d, adminID=%d, err=%v", req.Id, adminID, err)
+		return nil, errorx.FromError(err)
+	}
+
+	return &types.DeleteCategoryResp{Success: true}, nil
+}
@@ -0,0 +1,74 @@
+package admin
+
+import (
+	"context"
+
+	"activity-platform/app/activity/api/internal/logic"
+	"activity-platform/app/activity/api/internal/svc"
+	"activity-platform/app/activity/api/internal/types"
+	"activity-platform/app/activity/rpc/activityservice"
+	"activity-platform/common/ctxdata"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListFeedbackReviewsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 评价短评审核列表
+func NewListFeedbackReviewsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListFeedbackReviewsLogic {
+	return &ListFeedbackReviewsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListFeedbackReviewsLogic) ListFeedbackReviews(req *types.ListFeedbackReviewsReq) (resp *types.ListFeedbackReviewsResp, err error) {
+	adminID := ctxdata.GetUserIDFromCtx(l.ctx)
+	if adminID <= 0 {
+		return nil, errorx.ErrUnauthorized()
+	}
+
+	rpcResp, err := l.svcCtx.ActivityRpc.ListFeedbackReviews(l.ctx, &activityservice.ListFeedbackReviewsReq{
+		Status:   req.Status,
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	})
+	if err != nil {
+		l.Errorf("RPC ListFeedbackReviews failed: adminID=%d, err=%v", adminID, err)
+		return nil, errorx.FromError(err)
+	}
+
+	list := make([]types.FeedbackReviewItem, 0, len(rpcResp.List))
+	for _, item := range rpcResp.List {
+		hitWords := item.HitWords
+		if hitWords == nil {
+			hitWords = []string{}
+		}
+		list = append(list, types.FeedbackReviewItem{
+			Id:            item.Id,
+			ActivityId:    item.ActivityId,
+			ActivityTitle: item.ActivityTitle,
+			FeedbackId:    item.FeedbackId,
+			UserId:        item.UserId,
+			Comment:       item.Comment,
+			CommentStatus: item.CommentStatus,
+			HitWords:      hitWords,
+			Status:        item.Status,
+			ReviewerId:    item.ReviewerId,
+			ReviewNote:    item.ReviewNote,
+			ReviewedAt:    item.ReviewedAt,
+			CreatedAt:     item.CreatedAt,
+		})
+	}
+
+	return &types.ListFeedbackReviewsResp{
+		List:       list,
+		Pagination: logic.ConvertRpcPaginationToApi(rpcResp.Pagination),
+	}, nil
+}
@@ -0,0 +1,53 @@
+package admin
+
+import (
+	"context"
+
+	"activity-platform/app/activity/api/internal/logic"
+	"activity-platform/app/activity/api/internal/svc"
+	"activity-platform/app/activity/api/internal/types"
+	"activity-platform/app/activity/rpc/activityservice"
+	"activity-platform/common/ctxdata"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListReviewQueueLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 审核队列
+func NewListReviewQueueLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListReviewQueueLogic {
+	return &ListReviewQueueLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListReviewQueueLogic) ListReviewQueue(req *types.ListReviewQueueReq) (resp *types.ListReviewQueueResp, err error) {
+	adminID := ctxdata.GetUserIDFromCtx(l.ctx)
+	if adminID <= 0 {
+		return nil, errorx.ErrUnauthorized()
+	}
+
+	rpcResp, err := l.svcCtx.ActivityRpc.ListReviewQueue(l.ctx, &activityservice.ListReviewQueueReq{
+		OperatorId:  adminID,
+		Scope:       req.Scope,
+		OverdueOnly: req.OverdueOnly,
+		Page:        req.Page,
+		PageSize:    req.PageSize,
+	})
+	if err != nil {
+		l.Errorf("RPC ListReviewQueue failed: adminID=%d, scope=%s, err=%v", adminID, req.Scope, err)
+		return nil, errorx.FromError(err)
+	}
+
+	return &types.ListReviewQueueResp{
+		List:       logic.ConvertRpcReviewQueueItemsToApi(rpcResp.List),
+		Pagination: logic.ConvertRpcPaginationToApi(rpcResp.Pagination),
+	}, nil
+}
@@ -0,0 +1,54 @@
+package admin
+
+import (
+	"context"
+
+	"activity-platform/app/activity/api/internal/svc"
+	"activity-platform/app/activity/api/internal/types"
+	"activity-platform/app/activity/rpc/activityservice"
+	"activity-platform/common/ctxdata"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type MergeTagsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 合并标签
+func NewMergeTagsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *MergeTagsLogic {
+	return &MergeTagsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *MergeTagsLogic) MergeTags(req *types.MergeTagsReq) (resp *types.MergeTagsResp, err error) {
+	adminID := ctxdata.GetUserIDFromCtx(l.ctx)
+	if adminID <= 0 {
+		return nil, errorx.ErrUnauthorized()
+	}
+	if req.Id <= 0 || req.TargetId <= 0 {
+		return nil, errorx.ErrInvalidParams("标签ID无效")
+	}
+
+	rpcResp, err := l.svcCtx.ActivityRpc.MergeTags(l.ctx, &activityservice.MergeTagsReq{
+		SourceId:   req.Id,
+		TargetId:   req.TargetId,
+		OperatorId: adminID,
+	})
+	if err != nil {
+		l.Errorf("RPC MergeTags failed: source=%d, target=%d, adminID=%d, err=%v", req.Id, req.TargetId, adminID, err)
+		return nil, errorx.FromError(err)
+	}
+
+	return &types.MergeTagsResp{
+		Target:          toAdminTag(rpcResp.Target),
+		MovedActivities: rpcResp.MovedActivities,
+		MovedUsers:      rpcResp.MovedUsers,
+	}, nil
+}
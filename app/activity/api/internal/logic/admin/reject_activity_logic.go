@@ -5,6 +5,8 @@ import (
 
 	"activity-platform/app/activity/api/internal/svc"
 	"activity-platform/app/activity/api/internal/types"
+	"activity-platform/app/activity/rpc/activityservice"
+	"activity-platform/common/ctxdata"
 	"activity-platform/common/errorx"
 
 	"github.com/zeromicro/go-zero/core/logx"
@@ -26,7 +28,24 @@ func NewRejectActivityLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Re
 }
 
 func (l *RejectActivityLogic) RejectActivity(req *types.RejectActivityReq) (resp *types.RejectActivityResp, err error) {
-	// MVP 版本：无后台管理系统，审核功能暂未开放
-	// 活动创建后直接发布，无需审核
-	return nil, errorx.ErrInvalidParams("MVP 版本暂未开放审核功能，活动创建后自动发布")
+	adminID := ctxdata.GetUserIDFromCtx(l.ctx)
+	if adminID <= 0 {
+		return nil, errorx.ErrUnauthorized()
+	}
+	if req.Id <= 0 {
+		return nil, errorx.ErrInvalidParams("活动ID无效")
+	}
+
+	// 驳回原因的长度校验在 RPC 层统一处理
+	rpcResp, err := l.svcCtx.ActivityRpc.RejectActivity(l.ctx, &activityservice.RejectActivityReq{
+		Id:         req.Id,
+		Reason:     req.Reason,
+		OperatorId: adminID,
+	})
+	if err != nil {
+		l.Errorf("RPC RejectActivity failed: activityID=%d, adminID=%d, err=%v", req.Id, adminID, err)
+		return nil, errorx.FromError(err)
+	}
+
+	return &types.RejectActivityResp{Status: rpcResp.Status}, nil
 }
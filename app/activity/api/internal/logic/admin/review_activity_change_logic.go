@@ -0,0 +1,55 @@
+package admin
+
+import (
+	"context"
+
+	"activity-platform/app/activity/api/internal/svc"
+	"activity-platform/app/activity/api/internal/types"
+	"activity-platform/app/activity/rpc/activityservice"
+	"activity-platform/common/ctxdata"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ReviewActivityChangeLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 审核变更申请
+func NewReviewActivityChangeLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ReviewActivityChangeLogic {
+	return &ReviewActivityChangeLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ReviewActivityChangeLogic) ReviewActivityChange(req *types.ReviewActivityChangeReq) (resp *types.ReviewActivityChangeResp, err error) {
+	adminID := ctxdata.GetUserIDFromCtx(l.ctx)
+	if adminID <= 0 {
+		return nil, errorx.ErrUnauthorized()
+	}
+	if req.Id <= 0 {
+		return nil, errorx.ErrInvalidParams("变更申请ID无效")
+	}
+
+	rpcResp, err := l.svcCtx.ActivityRpc.ReviewActivityChange(l.ctx, &activityservice.ReviewActivityChangeReq{
+		RequestId:  req.Id,
+		OperatorId: adminID,
+		Approve:    req.Approve,
+		Reason:     req.Reason,
+	})
+	if err != nil {
+		l.Errorf("RPC ReviewActivityChange failed: requestID=%d, adminID=%d, err=%v", req.Id, adminID, err)
+		return nil, errorx.FromError(err)
+	}
+
+	return &types.ReviewActivityChangeResp{
+		Status:          rpcResp.Status,
+		FreeCancelUntil: rpcResp.FreeCancelUntil,
+		NotifiedCount:   rpcResp.NotifiedCount,
+	}, nil
+}
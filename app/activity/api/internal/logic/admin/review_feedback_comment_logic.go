@@ -0,0 +1,54 @@
+package admin
+
+import (
+	"context"
+
+	"activity-platform/app/activity/api/internal/svc"
+	"activity-platform/app/activity/api/internal/types"
+	"activity-platform/app/activity/rpc/activityservice"
+	"activity-platform/common/ctxdata"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ReviewFeedbackCommentLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 审核评价短评
+func NewReviewFeedbackCommentLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ReviewFeedbackCommentLogic {
+	return &ReviewFeedbackCommentLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ReviewFeedbackCommentLogic) ReviewFeedbackComment(req *types.ReviewFeedbackCommentReq) (resp *types.ReviewFeedbackCommentResp, err error) {
+	adminID := ctxdata.GetUserIDFromCtx(l.ctx)
+	if adminID <= 0 {
+		return nil, errorx.ErrUnauthorized()
+	}
+	if req.Id <= 0 {
+		return nil, errorx.ErrInvalidParams("审核记录ID无效")
+	}
+
+	rpcResp, err := l.svcCtx.ActivityRpc.ReviewFeedbackComment(l.ctx, &activityservice.ReviewFeedbackCommentReq{
+		ReviewId:   req.Id,
+		OperatorId: adminID,
+		Approve:    req.Approve,
+		Note:       req.Note,
+	})
+	if err != nil {
+		l.Errorf("RPC ReviewFeedbackComment failed: reviewID=%d, adminID=%d, err=%v", req.Id, adminID, err)
+		return nil, errorx.FromError(err)
+	}
+
+	return &types.ReviewFeedbackCommentResp{
+		Status:         rpcResp.Status,
+		CommentUpdated: rpcResp.CommentUpdated,
+	}, nil
+}
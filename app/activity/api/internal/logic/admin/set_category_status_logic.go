@@ -0,0 +1,50 @@
+package admin
+
+import (
+	"context"
+
+	"activity-platform/app/activity/api/internal/svc"
+	"activity-platform/app/activity/api/internal/types"
+	"activity-platform/app/activity/rpc/activityservice"
+	"activity-platform/common/ctxdata"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SetCategoryStatusLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 启用/禁用分类
+func NewSetCategoryStatusLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SetCategoryStatusLogic {
+	return &SetCategoryStatusLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *SetCategoryStatusLogic) SetCategoryStatus(req *types.SetCategoryStatusReq) (resp *types.AdminCategoryResp, err error) {
+	adminID := ctxdata.GetUserIDFromCtx(l.ctx)
+	if adminID <= 0 {
+		return nil, errorx.ErrUnauthorized()
+	}
+	if req.Id <= 0 {
+		return nil, errorx.ErrInvalidParams("分类ID无效")
+	}
+
+	rpcResp, err := l.svcCtx.ActivityRpc.SetCategoryStatus(l.ctx, &activityservice.SetCategoryStatusReq{
+		Id:         req.Id,
+		Status:     req.Status,
+		OperatorId: adminID,
+	})
+	if err != nil {
+		l.Errorf("RPC SetCategoryStatus failed: id=%d, adminID=%d, err=%v", req.Id, adminID, err)
+		return nil, errorx.FromError(err)
+	}
+
+	return &types.AdminCategoryResp{Category: toAdminCategory(rpcResp.Category)}, nil
+}
@@ -0,0 +1,50 @@
+package admin
+
+import (
+	"context"
+
+	"activity-platform/app/activity/api/internal/svc"
+	"activity-platform/app/activity/api/internal/types"
+	"activity-platform/app/activity/rpc/activityservice"
+	"activity-platform/common/ctxdata"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SetTagStatusLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 启用/禁用标签
+func NewSetTagStatusLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SetTagStatusLogic {
+	return &SetTagStatusLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *SetTagStatusLogic) SetTagStatus(req *types.SetTagStatusReq) (resp *types.AdminTagResp, err error) {
+	adminID := ctxdata.GetUserIDFromCtx(l.ctx)
+	if adminID <= 0 {
+		return nil, errorx.ErrUnauthorized()
+	}
+	if req.Id <= 0 {
+		return nil, errorx.ErrInvalidParams("标签ID无效")
+	}
+
+	rpcResp, err := l.svcCtx.ActivityRpc.SetTagStatus(l.ctx, &activityservice.SetTagStatusReq{
+		Id:         req.Id,
+		Status:     req.Status,
+		OperatorId: adminID,
+	})
+	if err != nil {
+		l.Errorf("RPC SetTagStatus failed: id=%d, adminID=%d, err=%v", req.Id, adminID, err)
+		return nil, errorx.FromError(err)
+	}
+
+	return &types.AdminTagResp{Tag: toAdminTag(rpcResp.Tag)}, nil
+}
@@ -0,0 +1,53 @@
+package admin
+
+import (
+	"context"
+
+	"activity-platform/app/activity/api/internal/svc"
+	"activity-platform/app/activity/api/internal/types"
+	"activity-platform/app/activity/rpc/activityservice"
+	"activity-platform/common/ctxdata"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SortCategoriesLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 调整分类排序
+func NewSortCategoriesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SortCategoriesLogic {
+	return &SortCategoriesLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *SortCategoriesLogic) SortCategories(req *types.SortCategoriesReq) (resp *types.SortCategoriesResp, err error) {
+	adminID := ctxdata.GetUserIDFromCtx(l.ctx)
+	if adminID <= 0 {
+		return nil, errorx.ErrUnauthorized()
+	}
+	if len(req.Items) == 0 {
+		return nil, errorx.ErrInvalidParams("排序列表不能为空")
+	}
+
+	items := make([]*activityservice.CategorySortItem, 0, len(req.Items))
+	for _, item := range req.Items {
+		items = append(items, &activityservice.CategorySortItem{Id: item.Id, Sort: item.Sort})
+	}
+	rpcResp, err := l.svcCtx.ActivityRpc.SortCategories(l.ctx, &activityservice.SortCategoriesReq{
+		Items:      items,
+		OperatorId: adminID,
+	})
+	if err != nil {
+		l.Errorf("RPC SortCategories failed: count=%d, adminID=%d, err=%v", len(items), adminID, err)
+		return nil, errorx.FromError(err)
+	}
+
+	return &types.SortCategoriesResp{Updated: rpcResp.Updated}, nil
+}
@@ -0,0 +1,53 @@
+package admin
+
+import (
+	"context"
+
+	"activity-platform/app/activity/api/internal/svc"
+	"activity-platform/app/activity/api/internal/types"
+	"activity-platform/app/activity/rpc/activityservice"
+	"activity-platform/common/ctxdata"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdateTagLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 修改标签
+func NewUpdateTagLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateTagLogic {
+	return &UpdateTagLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *UpdateTagLogic) UpdateTag(req *types.UpdateTagReq) (resp *types.AdminTagResp, err error) {
+	adminID := ctxdata.GetUserIDFromCtx(l.ctx)
+	if adminID <= 0 {
+		return nil, errorx.ErrUnauthorized()
+	}
+	if req.Id <= 0 {
+		return nil, errorx.ErrInvalidParams("标签ID无效")
+	}
+
+	rpcResp, err := l.svcCtx.ActivityRpc.UpdateTag(l.ctx, &activityservice.UpdateTagReq{
+		Id:          req.Id,
+		Name:        req.Name,
+		Color:       req.Color,
+		Icon:        req.Icon,
+		Description: req.Description,
+		OperatorId:  adminID,
+	})
+	if err != nil {
+		l.Errorf("RPC UpdateTag failed: id=%d, adminID=%d, err=%v", req.Id, adminID, err)
+		return nil, errorx.FromError(err)
+	}
+
+	return &types.AdminTagResp{Tag: toAdminTag(rpcResp.Tag)}, nil
+}
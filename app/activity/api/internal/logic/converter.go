@@ -103,6 +103,8 @@ func ConvertRpcActivityDetailToApi(rpc *activityservice.ActivityDetail) types.Ac
 		Version:                rpc.Version,
 		RegistrationStatus:     rpc.RegistrationStatus,
 		RegistrationStatusText: rpc.RegistrationStatusText,
+		RatingAvg:              rpc.RatingAvg,
+		RatingCount:            rpc.RatingCount,
 	}
 }
 
@@ -132,6 +134,11 @@ func ConvertRpcActivityListItemToApi(rpc *activityservice.ActivityListItem) type
 		CreatedAt:              rpc.CreatedAt,
 		RegistrationStatus:     rpc.RegistrationStatus,
 		RegistrationStatusText: rpc.RegistrationStatusText,
+		RatingAvg:              rpc.RatingAvg,
+		RatingCount:            rpc.RatingCount,
+		Distance:               rpc.Distance,
+		TitleHighlight:         rpc.TitleHighlight,
+		DescriptionHighlight:   rpc.DescriptionHighlight,
 	}
 }
 
@@ -161,3 +168,270 @@ func ConvertRpcPaginationToApi(rpc *activityservice.Pagination) types.Pagination
 		TotalPages: rpc.TotalPages,
 	}
 }
+
+// ==================== SearchFacets 转换 ====================
+
+// ConvertRpcSearchFacetsToApi 将 RPC 分面统计转换为 API 分面统计
+func ConvertRpcSearchFacetsToApi(rpc *activityservice.SearchFacets) types.SearchFacets {
+	if rpc == nil {
+		return types.SearchFacets{
+			Categories: []types.FacetBucket{},
+			Tags:       []types.FacetBucket{},
+			Statuses:   []types.FacetBucket{},
+		}
+	}
+	return types.SearchFacets{
+		Categories: convertRpcFacetBuckets(rpc.Categories),
+		Tags:       convertRpcFacetBuckets(rpc.Tags),
+		Statuses:   convertRpcFacetBuckets(rpc.Statuses),
+	}
+}
+
+func convertRpcFacetBuckets(rpcBuckets []*activityservice.FacetBucket) []types.FacetBucket {
+	result := make([]types.FacetBucket, 0, len(rpcBuckets))
+	for _, b := range rpcBuckets {
+		if b == nil {
+			continue
+		}
+		result = append(result, types.FacetBucket{
+			Id:    b.Id,
+			Name:  b.Name,
+			Count: b.Count,
+		})
+	}
+	return result
+}
+
+// ==================== 报名资格规则转换 ====================
+
+// ConvertRpcEligibilityRulesToApi 将 RPC 资格规则转换为 API 资格规则
+func ConvertRpcEligibilityRulesToApi(rpcRules []*activityservice.EligibilityRule) []types.EligibilityRule {
+	result := make([]types.EligibilityRule, 0, len(rpcRules))
+	for _, r := range rpcRules {
+		if r == nil {
+			continue
+		}
+		values := r.Values
+		if values == nil {
+			values = []string{}
+		}
+		result = append(result, types.EligibilityRule{
+			RuleType:    r.RuleType,
+			MinValue:    r.MinValue,
+			MaxValue:    r.MaxValue,
+			Values:      values,
+			Description: r.Description,
+		})
+	}
+	return result
+}
+
+// ConvertApiEligibilityRulesToRpc 将 API 资格规则转换为 RPC 资格规则
+func ConvertApiEligibilityRulesToRpc(rules []types.EligibilityRule) []*activityservice.EligibilityRule {
+	result := make([]*activityservice.EligibilityRule, 0, len(rules))
+	for _, r := range rules {
+		result = append(result, &activityservice.EligibilityRule{
+			RuleType: r.RuleType,
+			MinValue: r.MinValue,
+			MaxValue: r.MaxValue,
+			Values:   r.Values,
+		})
+	}
+	return result
+}
+
+// ConvertRpcEligibilityCheckItemsToApi 将 RPC 资格校验结果转换为 API 格式
+func ConvertRpcEligibilityCheckItemsToApi(rpcItems []*activityservice.EligibilityCheckItem) []types.EligibilityCheckItem {
+	result := make([]types.EligibilityCheckItem, 0, len(rpcItems))
+	for _, item := range rpcItems {
+		if item == nil {
+			continue
+		}
+		result = append(result, types.EligibilityCheckItem{
+			RuleType:    item.RuleType,
+			Description: item.Description,
+			Passed:      item.Passed,
+			Reason:      item.Reason,
+		})
+	}
+	return result
+}
+
+// ==================== 审核队列转换 ====================
+
+// ConvertRpcReviewQueueItemsToApi 将 RPC 审核队列项转换为 API 格式
+func ConvertRpcReviewQueueItemsToApi(rpcItems []*activityservice.ReviewQueueItem) []types.ReviewQueueItem {
+	result := make([]types.ReviewQueueItem, 0, len(rpcItems))
+	for _, item := range rpcItems {
+		if item == nil {
+			continue
+		}
+		result = append(result, types.ReviewQueueItem{
+			ReviewId:           item.ReviewId,
+			ActivityId:         item.ActivityId,
+			Title:              item.Title,
+			CoverUrl:           item.CoverUrl,
+			CategoryName:       item.CategoryName,
+			OrganizerId:        item.OrganizerId,
+			OrganizerName:      item.OrganizerName,
+			ActivityStartTime:  item.ActivityStartTime,
+			PolicyReason:       item.PolicyReason,
+			Flagged:            item.Flagged,
+			SubmittedAt:        item.SubmittedAt,
+			DueAt:              item.DueAt,
+			RemainingSeconds:   item.RemainingSeconds,
+			Overdue:            item.Overdue,
+			AssigneeId:         item.AssigneeId,
+			AssignedAt:         item.AssignedAt,
+			HasApprovedVersion: item.HasApprovedVersion,
+			Diffs:              convertRpcReviewFieldDiffsToApi(item.Diffs),
+		})
+	}
+	return result
+}
+
+// convertRpcReviewFieldDiffsToApi 将 RPC 字段差异转换为 API 格式
+func convertRpcReviewFieldDiffsToApi(rpcDiffs []*activityservice.ReviewFieldDiff) []types.ReviewFieldDiff {
+	diffs := make([]types.ReviewFieldDiff, 0, len(rpcDiffs))
+	for _, d := range rpcDiffs {
+		if d == nil {
+			continue
+		}
+		diffs = append(diffs, types.ReviewFieldDiff{
+			Field:    d.Field,
+			Label:    d.Label,
+			OldValue: d.OldValue,
+			NewValue: d.NewValue,
+		})
+	}
+	return diffs
+}
+
+// ==================== 变更申请转换 ====================
+
+// ConvertRpcActivityChangesToApi 将 RPC 变更申请列表转换为 API 格式
+func ConvertRpcActivityChangesToApi(rpcItems []*activityservice.ActivityChangeInfo) []types.ActivityChangeInfo {
+	result := make([]types.ActivityChangeInfo, 0, len(rpcItems))
+	for _, item := range rpcItems {
+		if item == nil {
+			continue
+		}
+		result = append(result, types.ActivityChangeInfo{
+			Id:              item.Id,
+			ActivityId:      item.ActivityId,
+			ActivityTitle:   item.ActivityTitle,
+			OrganizerId:     item.OrganizerId,
+			Status:          item.Status,
+			StatusText:      item.StatusText,
+			Reason:          item.Reason,
+			Changes:         convertRpcReviewFieldDiffsToApi(item.Changes),
+			ReviewerId:      item.ReviewerId,
+			RejectReason:    item.RejectReason,
+			CreatedAt:       item.CreatedAt,
+			DecidedAt:       item.DecidedAt,
+			FreeCancelUntil: item.FreeCancelUntil,
+		})
+	}
+	return result
+}
+
+// ==================== 活动模板转换 ====================
+
+// ConvertRpcTemplateToApi 转换单个活动模板
+func ConvertRpcTemplateToApi(rpc *activityservice.ActivityTemplate) types.ActivityTemplateItem {
+	if rpc == nil {
+		return types.ActivityTemplateItem{}
+	}
+	tagIds := rpc.TagIds
+	if tagIds == nil {
+		tagIds = []int64{}
+	}
+	return types.ActivityTemplateItem{
+		Id:                  rpc.Id,
+		Scope:               rpc.Scope,
+		Name:                rpc.Name,
+		CategoryId:          rpc.CategoryId,
+		CategoryName:        rpc.CategoryName,
+		Status:              rpc.Status,
+		Title:               rpc.Title,
+		CoverUrl:            rpc.CoverUrl,
+		Location:            rpc.Location,
+		MaxParticipants:     rpc.MaxParticipants,
+		DurationSeconds:     rpc.DurationSeconds,
+		RegisterOpenBefore:  rpc.RegisterOpenBefore,
+		RegisterCloseBefore: rpc.RegisterCloseBefore,
+		TagIds:              tagIds,
+		SourceActivityId:    rpc.SourceActivityId,
+		UseCount:            rpc.UseCount,
+		CreatedAt:           rpc.CreatedAt,
+	}
+}
+
+// ConvertRpcTemplatesToApi 批量转换活动模板
+func ConvertRpcTemplatesToApi(rpcItems []*activityservice.ActivityTemplate) []types.ActivityTemplateItem {
+	result := make([]types.ActivityTemplateItem, 0, len(rpcItems))
+	for _, item := range rpcItems {
+		if item == nil {
+			continue
+		}
+		result = append(result, ConvertRpcTemplateToApi(item))
+	}
+	return result
+}
+
+// ==================== 活动分析转换 ====================
+
+// ConvertRpcFunnelToApi 转换报名转化漏斗
+func ConvertRpcFunnelToApi(rpc *activityservice.AnalyticsFunnel) types.AnalyticsFunnel {
+	if rpc == nil {
+		return types.AnalyticsFunnel{}
+	}
+	return types.AnalyticsFunnel{
+		PageViews:           rpc.PageViews,
+		UniqueVisitors:      rpc.UniqueVisitors,
+		Registrations:       rpc.Registrations,
+		Cancellations:       rpc.Cancellations,
+		ActiveRegistrations: rpc.ActiveRegistrations,
+		CheckIns:            rpc.CheckIns,
+		NoShows:             rpc.NoShows,
+		AttendanceRate:      rpc.AttendanceRate,
+	}
+}
+
+// ConvertRpcAnalyticsDailyToApi 转换每日统计
+func ConvertRpcAnalyticsDailyToApi(rpcItems []*activityservice.AnalyticsDaily) []types.AnalyticsDaily {
+	result := make([]types.AnalyticsDaily, 0, len(rpcItems))
+	for _, item := range rpcItems {
+		if item == nil {
+			continue
+		}
+		result = append(result, types.AnalyticsDaily{
+			Date:           item.Date,
+			PageViews:      item.PageViews,
+			UniqueVisitors: item.UniqueVisitors,
+			Registrations:  item.Registrations,
+			Cancellations:  item.Cancellations,
+			CheckIns:       item.CheckIns,
+		})
+	}
+	return result
+}
+
+// ConvertRpcAudienceToApi 转换报名者构成
+func ConvertRpcAudienceToApi(rpc *activityservice.AudienceBreakdown) types.AudienceBreakdown {
+	result := types.AudienceBreakdown{
+		Departments:    []types.AudienceBucket{},
+		AdmissionYears: []types.AudienceBucket{},
+	}
+	if rpc == nil {
+		return result
+	}
+	result.VerifiedRegistrants = rpc.VerifiedRegistrants
+	for _, b := range rpc.Departments {
+		result.Departments = append(result.Departments, types.AudienceBucket{Value: b.Value, Count: b.Count})
+	}
+	for _, b := range rpc.AdmissionYears {
+		result.AdmissionYears = append(result.AdmissionYears, types.AudienceBucket{Value: b.Value, Count: b.Count})
+	}
+	return result
+}
@@ -0,0 +1,45 @@
+package public
+
+import (
+	"context"
+
+	"activity-platform/app/activity/api/internal/svc"
+	"activity-platform/app/activity/api/internal/types"
+	"activity-platform/app/activity/rpc/activityservice"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ExportActivityIcsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 导出活动日历文件（.ics）
+func NewExportActivityIcsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ExportActivityIcsLogic {
+	return &ExportActivityIcsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// ExportActivityIcs 返回 .ics 文件内容与文件名
+func (l *ExportActivityIcsLogic) ExportActivityIcs(req *types.ExportActivityIcsReq) (*activityservice.ExportActivityIcsResp, error) {
+	if req.Id <= 0 {
+		return nil, errorx.ErrInvalidParams("活动ID无效")
+	}
+
+	rpcResp, err := l.svcCtx.ActivityRpc.ExportActivityIcs(l.ctx, &activityservice.ExportActivityIcsReq{
+		ActivityId: req.Id,
+		ViewerId:   0, // 公开接口不需要登录，由 RPC 层判断权限
+	})
+	if err != nil {
+		l.Errorf("RPC ExportActivityIcs failed: id=%d, err=%v", req.Id, err)
+		return nil, errorx.FromError(err)
+	}
+
+	return rpcResp, nil
+}
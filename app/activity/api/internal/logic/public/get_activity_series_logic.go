@@ -0,0 +1,85 @@
+package public
+
+import (
+	"context"
+
+	"activity-platform/app/activity/api/internal/svc"
+	"activity-platform/app/activity/api/internal/types"
+	"activity-platform/app/activity/rpc/activityservice"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetActivitySeriesLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 系列活动详情（公开接口）
+func NewGetActivitySeriesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetActivitySeriesLogic {
+	return &GetActivitySeriesLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetActivitySeriesLogic) GetActivitySeries(req *types.GetActivitySeriesReq) (resp *types.GetActivitySeriesResp, err error) {
+	// 1. 参数校验
+	if req.Id <= 0 {
+		return nil, errorx.ErrInvalidParams("系列ID无效")
+	}
+
+	// 2. 调用 RPC 服务（公开接口 viewer_id = 0，只返回公开状态的场次）
+	rpcResp, err := l.svcCtx.ActivityRpc.GetActivitySeries(l.ctx, &activityservice.GetActivitySeriesReq{
+		Id:       req.Id,
+		ViewerId: 0,
+	})
+	if err != nil {
+		l.Errorf("RPC GetActivitySeries failed: id=%d, err=%v", req.Id, err)
+		return nil, errorx.FromError(err)
+	}
+
+	// 3. 转换响应类型
+	resp = &types.GetActivitySeriesResp{
+		Occurrences: make([]types.SeriesOccurrence, 0, len(rpcResp.Occurrences)),
+		Subscribed:  rpcResp.Subscribed,
+	}
+	if s := rpcResp.Series; s != nil {
+		resp.Series = types.ActivitySeriesInfo{
+			Id:                  s.Id,
+			Title:               s.Title,
+			Rrule:               s.Rrule,
+			Status:              s.Status,
+			StatusText:          s.StatusText,
+			OrganizerId:         s.OrganizerId,
+			OrganizerName:       s.OrganizerName,
+			CoverUrl:            s.CoverUrl,
+			CategoryId:          s.CategoryId,
+			Location:            s.Location,
+			FirstStartTime:      s.FirstStartTime,
+			DurationSeconds:     s.DurationSeconds,
+			AllowSeriesRegister: s.AllowSeriesRegister,
+			TotalOccurrences:    s.TotalOccurrences,
+			GeneratedCount:      s.GeneratedCount,
+			SubscriberCount:     s.SubscriberCount,
+			CreatedAt:           s.CreatedAt,
+		}
+	}
+	for _, o := range rpcResp.Occurrences {
+		resp.Occurrences = append(resp.Occurrences, types.SeriesOccurrence{
+			Index:               o.Index,
+			ActivityId:          o.ActivityId,
+			ActivityStartTime:   o.ActivityStartTime,
+			ActivityEndTime:     o.ActivityEndTime,
+			Status:              o.Status,
+			StatusText:          o.StatusText,
+			Overridden:          o.Overridden,
+			CurrentParticipants: o.CurrentParticipants,
+			MaxParticipants:     o.MaxParticipants,
+		})
+	}
+	return resp, nil
+}
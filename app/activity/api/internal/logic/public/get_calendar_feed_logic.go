@@ -0,0 +1,44 @@
+package public
+
+import (
+	"context"
+
+	"activity-platform/app/activity/api/internal/svc"
+	"activity-platform/app/activity/api/internal/types"
+	"activity-platform/app/activity/rpc/activityservice"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetCalendarFeedLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 日历订阅内容（iCalendar，按订阅令牌识别用户）
+func NewGetCalendarFeedLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetCalendarFeedLogic {
+	return &GetCalendarFeedLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// GetCalendarFeed 返回 iCalendar 正文
+func (l *GetCalendarFeedLogic) GetCalendarFeed(req *types.CalendarFeedReq) (string, error) {
+	if req.Token == "" {
+		return "", errorx.New(errorx.CodeCalendarFeedInvalid)
+	}
+
+	rpcResp, err := l.svcCtx.ActivityRpc.GetCalendarFeed(l.ctx, &activityservice.GetCalendarFeedReq{
+		Token: req.Token,
+	})
+	if err != nil {
+		l.Errorf("RPC GetCalendarFeed failed: err=%v", err)
+		return "", errorx.FromError(err)
+	}
+
+	return rpcResp.Content, nil
+}
@@ -0,0 +1,51 @@
+package public
+
+import (
+	"context"
+
+	"activity-platform/app/activity/api/internal/logic"
+	"activity-platform/app/activity/api/internal/svc"
+	"activity-platform/app/activity/api/internal/types"
+	"activity-platform/app/activity/rpc/activityservice"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetEligibilityRulesLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 报名资格规则
+func NewGetEligibilityRulesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetEligibilityRulesLogic {
+	return &GetEligibilityRulesLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetEligibilityRulesLogic) GetEligibilityRules(req *types.GetEligibilityRulesReq) (resp *types.GetEligibilityRulesResp, err error) {
+	// 1. 参数校验
+	if req.Id <= 0 {
+		return nil, errorx.ErrInvalidParams("活动ID无效")
+	}
+
+	// 2. 调用 RPC 服务
+	rpcResp, err := l.svcCtx.ActivityRpc.GetEligibilityRules(l.ctx, &activityservice.GetEligibilityRulesReq{
+		ActivityId: req.Id,
+	})
+	if err != nil {
+		l.Errorf("RPC GetEligibilityRules failed: id=%d, err=%v", req.Id, err)
+		return nil, errorx.FromError(err)
+	}
+
+	// 3. 转换响应类型
+	return &types.GetEligibilityRulesResp{
+		Rules:                logic.ConvertRpcEligibilityRulesToApi(rpcResp.Rules),
+		MinCreditScore:       rpcResp.MinCreditScore,
+		RequireStudentVerify: rpcResp.RequireStudentVerify,
+	}, nil
+}
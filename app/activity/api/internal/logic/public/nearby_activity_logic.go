@@ -0,0 +1,70 @@
+package public
+
+import (
+	"context"
+
+	"activity-platform/app/activity/api/internal/logic"
+	"activity-platform/app/activity/api/internal/svc"
+	"activity-platform/app/activity/api/internal/types"
+	"activity-platform/app/activity/rpc/activityservice"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type NearbyActivityLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 附近活动
+func NewNearbyActivityLogic(ctx context.Context, svcCtx *svc.ServiceContext) *NearbyActivityLogic {
+	return &NearbyActivityLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *NearbyActivityLogic) NearbyActivity(req *types.NearbyActivityReq) (resp *types.NearbyActivityResp, err error) {
+	// 1. 参数校验
+	if req.Longitude < -180 || req.Longitude > 180 || req.Latitude < -90 || req.Latitude > 90 ||
+		(req.Longitude == 0 && req.Latitude == 0) {
+		return nil, errorx.ErrInvalidParams("经纬度不合法")
+	}
+
+	// 分页参数校验
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 {
+		req.PageSize = 10
+	}
+	if req.PageSize > 50 {
+		req.PageSize = 50
+	}
+
+	// 2. 调用 RPC 服务
+	rpcResp, err := l.svcCtx.ActivityRpc.NearbyActivities(l.ctx, &activityservice.NearbyActivitiesReq{
+		Longitude:  req.Longitude,
+		Latitude:   req.Latitude,
+		RadiusKm:   req.RadiusKm,
+		CategoryId: req.CategoryId,
+		Keyword:    req.Keyword,
+		Page:       req.Page,
+		PageSize:   req.PageSize,
+		Sort:       req.Sort,
+	})
+	if err != nil {
+		l.Errorf("RPC NearbyActivities failed: lon=%f, lat=%f, err=%v", req.Longitude, req.Latitude, err)
+		return nil, errorx.FromError(err)
+	}
+
+	// 3. 转换响应类型
+	return &types.NearbyActivityResp{
+		List:        logic.ConvertRpcActivityListItemsToApi(rpcResp.List),
+		Total:       rpcResp.Total,
+		QueryTimeMs: rpcResp.QueryTimeMs,
+	}, nil
+}
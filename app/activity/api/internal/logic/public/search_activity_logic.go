@@ -49,13 +49,25 @@ func (l *SearchActivityLogic) SearchActivity(req *types.SearchActivityReq) (resp
 	}
 
 	// 2. 调用 RPC 服务
-	rpcResp, err := l.svcCtx.ActivityRpc.SearchActivities(l.ctx, &activityservice.SearchActivitiesReq{
-		Keyword:    req.Keyword,
-		CategoryId: req.CategoryId,
-		Page:       req.Page,
-		PageSize:   req.PageSize,
-		Sort:       req.Sort,
-	})
+	rpcReq := &activityservice.SearchActivitiesReq{
+		Keyword:         req.Keyword,
+		CategoryId:      req.CategoryId,
+		Page:            req.Page,
+		PageSize:        req.PageSize,
+		Sort:            req.Sort,
+		Longitude:       req.Longitude,
+		Latitude:        req.Latitude,
+		RadiusKm:        req.RadiusKm,
+		TagIds:          req.TagIds,
+		StartTimeFrom:   req.StartTimeFrom,
+		StartTimeTo:     req.StartTimeTo,
+		HasSeats:        req.HasSeats,
+		NoStudentVerify: req.NoStudentVerify,
+	}
+	if req.MaxCreditScore >= 0 {
+		rpcReq.MaxCreditScore = &req.MaxCreditScore
+	}
+	rpcResp, err := l.svcCtx.ActivityRpc.SearchActivities(l.ctx, rpcReq)
 	if err != nil {
 		l.Errorf("RPC SearchActivities failed: keyword=%s, err=%v", req.Keyword, err)
 		return nil, errorx.FromError(err)
@@ -66,5 +78,6 @@ func (l *SearchActivityLogic) SearchActivity(req *types.SearchActivityReq) (resp
 		List:        logic.ConvertRpcActivityListItemsToApi(rpcResp.List),
 		Total:       rpcResp.Total,
 		QueryTimeMs: rpcResp.QueryTimeMs,
+		Facets:      logic.ConvertRpcSearchFacetsToApi(rpcResp.Facets),
 	}, nil
 }
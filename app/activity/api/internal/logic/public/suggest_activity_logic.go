@@ -0,0 +1,60 @@
+package public
+
+import (
+	"context"
+	"strings"
+
+	"activity-platform/app/activity/api/internal/svc"
+	"activity-platform/app/activity/api/internal/types"
+	"activity-platform/app/activity/rpc/activityservice"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SuggestActivityLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 搜索建议
+func NewSuggestActivityLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SuggestActivityLogic {
+	return &SuggestActivityLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *SuggestActivityLogic) SuggestActivity(req *types.SuggestActivityReq) (resp *types.SuggestActivityResp, err error) {
+	// 1. 参数校验
+	req.Prefix = strings.TrimSpace(req.Prefix)
+	prefixLen := len([]rune(req.Prefix))
+	if prefixLen == 0 {
+		return nil, errorx.ErrInvalidParams("搜索前缀不能为空")
+	}
+	if prefixLen > 20 {
+		return nil, errorx.ErrInvalidParams("搜索前缀不能超过20个字符")
+	}
+
+	// 2. 调用 RPC 服务
+	rpcResp, err := l.svcCtx.ActivityRpc.SuggestActivities(l.ctx, &activityservice.SuggestActivitiesReq{
+		Prefix:     req.Prefix,
+		CategoryId: req.CategoryId,
+		Size:       req.Size,
+	})
+	if err != nil {
+		l.Errorf("RPC SuggestActivities failed: prefix=%s, err=%v", req.Prefix, err)
+		return nil, errorx.FromError(err)
+	}
+
+	// 3. 转换响应类型
+	suggestions := rpcResp.Suggestions
+	if suggestions == nil {
+		suggestions = []string{}
+	}
+	return &types.SuggestActivityResp{
+		Suggestions: suggestions,
+	}, nil
+}
@@ -0,0 +1,63 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package ticket
+
+import (
+	"context"
+
+	"activity-platform/app/activity/api/internal/logic"
+	"activity-platform/app/activity/api/internal/svc"
+	"activity-platform/app/activity/api/internal/types"
+	"activity-platform/app/activity/rpc/activityservice"
+	"activity-platform/common/ctxdata"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CheckEligibilityLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 报名资格预检
+func NewCheckEligibilityLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CheckEligibilityLogic {
+	return &CheckEligibilityLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *CheckEligibilityLogic) CheckEligibility(req *types.CheckEligibilityRequest) (resp *types.CheckEligibilityResponse, err error) {
+	// 1. 获取当前用户 ID
+	userID := ctxdata.GetUserIDFromCtx(l.ctx)
+	if userID <= 0 {
+		return nil, errorx.ErrUnauthorized()
+	}
+
+	// 2. 参数校验
+	if req.ActivityId <= 0 {
+		return nil, errorx.ErrInvalidParams(errMsgActivityIDInvalid)
+	}
+
+	// 3. 调用 RPC 服务（只读预检，不写入报名记录）
+	rpcResp, err := l.svcCtx.ActivityRpc.CheckEligibility(l.ctx, &activityservice.CheckEligibilityReq{
+		ActivityId: req.ActivityId,
+		UserId:     userID,
+	})
+	if err != nil {
+		l.Errorf("RPC CheckEligibility failed: activityId=%d, userID=%d, err=%v", req.ActivityId, userID, err)
+		return nil, errorx.FromError(err)
+	}
+
+	// 4. 返回响应
+	return &types.CheckEligibilityResponse{
+		Eligible:    rpcResp.Eligible,
+		Items:       logic.ConvertRpcEligibilityCheckItemsToApi(rpcResp.Items),
+		CanRegister: rpcResp.CanRegister,
+		Reason:      rpcResp.Reason,
+	}, nil
+}
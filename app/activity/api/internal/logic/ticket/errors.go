@@ -4,5 +4,6 @@ const (
 	errMsgActivityIDInvalid = "活动ID无效"
 	errMsgTicketIDInvalid   = "票券ID无效"
 	errMsgTicketCodeEmpty   = "票券码不能为空"
+	errMsgRoomCodeEmpty     = "请输入现场签到码"
 	errMsgTypeInvalid       = "类型无效"
 )
@@ -6,6 +6,7 @@ package ticket
 import (
 	"context"
 
+	"activity-platform/app/activity/api/internal/logic"
 	"activity-platform/app/activity/api/internal/svc"
 	"activity-platform/app/activity/api/internal/types"
 	"activity-platform/app/activity/rpc/activityservice"
@@ -54,7 +55,8 @@ func (l *RegisterActivityLogic) RegisterActivity(req *types.RegisterActivityRequ
 
 	// 4. 返回响应
 	return &types.RegisterActivityResponse{
-		Result: rpcResp.Result,
-		Reason: rpcResp.Reason,
+		Result:      rpcResp.Result,
+		Reason:      rpcResp.Reason,
+		FailedRules: logic.ConvertRpcEligibilityCheckItemsToApi(rpcResp.FailedRules),
 	}, nil
 }
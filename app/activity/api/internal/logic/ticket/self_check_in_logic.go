@@ -0,0 +1,74 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package ticket
+
+import (
+	"context"
+	"strings"
+
+	"activity-platform/app/activity/api/internal/svc"
+	"activity-platform/app/activity/api/internal/types"
+	"activity-platform/app/activity/rpc/activityservice"
+	"activity-platform/common/ctxdata"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SelfCheckInLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 自助签到（定位 + 现场签到码）
+func NewSelfCheckInLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SelfCheckInLogic {
+	return &SelfCheckInLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *SelfCheckInLogic) SelfCheckIn(req *types.SelfCheckInRequest) (resp *types.SelfCheckInResponse, err error) {
+	// 1. 获取当前用户 ID
+	userID := ctxdata.GetUserIDFromCtx(l.ctx)
+	if userID <= 0 {
+		return nil, errorx.ErrUnauthorized()
+	}
+
+	// 2. 参数校验
+	if req.ActivityId <= 0 {
+		return nil, errorx.ErrInvalidParams(errMsgActivityIDInvalid)
+	}
+	if strings.TrimSpace(req.TicketCode) == "" {
+		return nil, errorx.ErrInvalidParams(errMsgTicketCodeEmpty)
+	}
+	if strings.TrimSpace(req.RoomCode) == "" {
+		return nil, errorx.ErrInvalidParams(errMsgRoomCodeEmpty)
+	}
+
+	// 3. 调用 RPC 服务
+	rpcResp, err := l.svcCtx.ActivityRpc.SelfCheckIn(l.ctx, &activityservice.SelfCheckInReq{
+		ActivityId:      req.ActivityId,
+		UserId:          userID,
+		TicketCode:      req.TicketCode,
+		RoomCode:        req.RoomCode,
+		Latitude:        req.Latitude,
+		Longitude:       req.Longitude,
+		DeviceId:        req.DeviceId,
+		ClientRequestId: req.ClientRequestId,
+	})
+	if err != nil {
+		l.Errorf("RPC SelfCheckIn failed: activityId=%d, userID=%d, err=%v", req.ActivityId, userID, err)
+		return nil, errorx.FromError(err)
+	}
+
+	// 4. 返回响应
+	return &types.SelfCheckInResponse{
+		Result:         rpcResp.Result,
+		DistanceMeters: rpcResp.DistanceMeters,
+		CheckInTime:    rpcResp.CheckInTime,
+	}, nil
+}
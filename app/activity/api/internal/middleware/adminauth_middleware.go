@@ -3,8 +3,18 @@
 
 package middleware
 
-import "net/http"
+import (
+	"net/http"
 
+	"activity-platform/common/ctxdata"
+	"activity-platform/common/errorx"
+	"activity-platform/common/response"
+	"activity-platform/common/utils/jwt"
+)
+
+// AdminAuthMiddleware 管理员鉴权
+//
+// 活动 API 不直连数据库，这里只校验 JWT 中的用户与角色声明
 type AdminAuthMiddleware struct {
 }
 
@@ -14,9 +24,16 @@ func NewAdminAuthMiddleware() *AdminAuthMiddleware {
 
 func (m *AdminAuthMiddleware) Handle(next http.HandlerFunc) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		// TODO generate middleware implement function, delete after code implementation
+		ctx := r.Context()
+		if ctxdata.GetUserIDFromCtx(ctx) <= 0 {
+			response.Fail(w, errorx.ErrUnauthorized())
+			return
+		}
+		if !jwt.IsAdmin(ctx) {
+			response.Fail(w, errorx.ErrForbidden())
+			return
+		}
 
-		// Passthrough to next handler if need
 		next(w, r)
 	}
 }
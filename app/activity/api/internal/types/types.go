@@ -3,6 +3,31 @@
 
 package types
 
+type ActivityAnalyticsBrief struct {
+	ActivityId     int64   `json:"activityId"`
+	Title          string  `json:"title"`
+	UniqueVisitors int64   `json:"uniqueVisitors"`
+	Registrations  int64   `json:"registrations"`
+	CheckIns       int64   `json:"checkIns"`
+	AttendanceRate float64 `json:"attendanceRate"`
+}
+
+type ActivityChangeInfo struct {
+	Id              int64             `json:"id"`
+	ActivityId      int64             `json:"activityId"`
+	ActivityTitle   string            `json:"activityTitle"`
+	OrganizerId     int64             `json:"organizerId"`
+	Status          int32             `json:"status"` // 0=待审核 1=已生效 2=已驳回
+	StatusText      string            `json:"statusText"`
+	Reason          string            `json:"reason"`
+	Changes         []ReviewFieldDiff `json:"changes"`
+	ReviewerId      int64             `json:"reviewerId"`
+	RejectReason    string            `json:"rejectReason"`
+	CreatedAt       int64             `json:"createdAt"`
+	DecidedAt       int64             `json:"decidedAt"`
+	FreeCancelUntil int64             `json:"freeCancelUntil"` // 无责取消截止时间（生效后有值）
+}
+
 type ActivityDetail struct {
 	Id                     int64   `json:"id"`
 	Title                  string  `json:"title"`
@@ -39,27 +64,34 @@ type ActivityDetail struct {
 	Version                int32   `json:"version"`                // 乐观锁版本号
 	RegistrationStatus     int32   `json:"registrationStatus"`     // 报名状态: 0=不适用, 1=未开始, 2=报名中, 3=已截止
 	RegistrationStatusText string  `json:"registrationStatusText"` // 报名状态文本
+	RatingAvg              float64 `json:"ratingAvg"`              // 平均评分（1-5，无评价时为 0）
+	RatingCount            int32   `json:"ratingCount"`            // 评价人数
 }
 
 type ActivityListItem struct {
-	Id                     int64  `json:"id"`
-	Title                  string `json:"title"`
-	CoverUrl               string `json:"coverUrl"`
-	CoverType              int32  `json:"coverType"`
-	CategoryName           string `json:"categoryName"`
-	OrganizerName          string `json:"organizerName"`
-	OrganizerAvatar        string `json:"organizerAvatar"`
-	ActivityStartTime      int64  `json:"activityStartTime"`
-	Location               string `json:"location"`
-	MaxParticipants        int32  `json:"maxParticipants"`
-	CurrentParticipants    int32  `json:"currentParticipants"`
-	Status                 int32  `json:"status"`
-	StatusText             string `json:"statusText"`
-	Tags                   []Tag  `json:"tags"`
-	ViewCount              int64  `json:"viewCount"`
-	CreatedAt              int64  `json:"createdAt"`
-	RegistrationStatus     int32  `json:"registrationStatus"`     // 报名状态: 0=不适用, 1=未开始, 2=报名中, 3=已截止
-	RegistrationStatusText string `json:"registrationStatusText"` // 报名状态文本
+	Id                     int64   `json:"id"`
+	Title                  string  `json:"title"`
+	CoverUrl               string  `json:"coverUrl"`
+	CoverType              int32   `json:"coverType"`
+	CategoryName           string  `json:"categoryName"`
+	OrganizerName          string  `json:"organizerName"`
+	OrganizerAvatar        string  `json:"organizerAvatar"`
+	ActivityStartTime      int64   `json:"activityStartTime"`
+	Location               string  `json:"location"`
+	MaxParticipants        int32   `json:"maxParticipants"`
+	CurrentParticipants    int32   `json:"currentParticipants"`
+	Status                 int32   `json:"status"`
+	StatusText             string  `json:"statusText"`
+	Tags                   []Tag   `json:"tags"`
+	ViewCount              int64   `json:"viewCount"`
+	CreatedAt              int64   `json:"createdAt"`
+	RegistrationStatus     int32   `json:"registrationStatus"`             // 报名状态: 0=不适用, 1=未开始, 2=报名中, 3=已截止
+	RegistrationStatusText string  `json:"registrationStatusText"`         // 报名状态文本
+	RatingAvg              float64 `json:"ratingAvg"`                      // 平均评分（1-5，无评价时为 0）
+	RatingCount            int32   `json:"ratingCount"`                    // 评价人数
+	Distance               float64 `json:"distance,omitempty"`             // 与查询坐标的距离（米），仅地理搜索时返回
+	TitleHighlight         string  `json:"titleHighlight,omitempty"`       // 标题高亮（<em> 包裹命中词），仅搜索时返回
+	DescriptionHighlight   string  `json:"descriptionHighlight,omitempty"` // 描述高亮片段，仅搜索时返回
 }
 
 type ActivityListItems struct {
@@ -70,6 +102,133 @@ type ActivityListItems struct {
 	ImageUrl string `json:"imageUrl"`
 }
 
+type ActivitySeriesInfo struct {
+	Id                  int64  `json:"id"`
+	Title               string `json:"title"`
+	Rrule               string `json:"rrule"`
+	Status              int32  `json:"status"` // 1=进行中 2=已排完 3=已取消
+	StatusText          string `json:"statusText"`
+	OrganizerId         int64  `json:"organizerId"`
+	OrganizerName       string `json:"organizerName"`
+	CoverUrl            string `json:"coverUrl"`
+	CategoryId          int64  `json:"categoryId"`
+	Location            string `json:"location"`
+	FirstStartTime      int64  `json:"firstStartTime"`
+	DurationSeconds     int64  `json:"durationSeconds"`
+	AllowSeriesRegister bool   `json:"allowSeriesRegister"`
+	TotalOccurrences    int32  `json:"totalOccurrences"`
+	GeneratedCount      int32  `json:"generatedCount"`
+	SubscriberCount     int64  `json:"subscriberCount"` // 整期报名人数
+	CreatedAt           int64  `json:"createdAt"`
+}
+
+type ActivityTemplateItem struct {
+	Id                  int64   `json:"id"`
+	Scope               int32   `json:"scope"` // 1个人 2公共
+	Name                string  `json:"name"`
+	CategoryId          int64   `json:"categoryId"`
+	CategoryName        string  `json:"categoryName"`
+	Status              int32   `json:"status"` // 1可用 0已下架
+	Title               string  `json:"title"`
+	CoverUrl            string  `json:"coverUrl"`
+	Location            string  `json:"location"`
+	MaxParticipants     int32   `json:"maxParticipants"`
+	DurationSeconds     int64   `json:"durationSeconds"`     // 活动时长
+	RegisterOpenBefore  int64   `json:"registerOpenBefore"`  // 开始前多久开放报名（秒）
+	RegisterCloseBefore int64   `json:"registerCloseBefore"` // 开始前多久截止报名（秒）
+	TagIds              []int64 `json:"tagIds"`
+	SourceActivityId    int64   `json:"sourceActivityId"`
+	UseCount            int64   `json:"useCount"`
+	CreatedAt           int64   `json:"createdAt"`
+}
+
+type ActivityTemplateResp struct {
+	Template ActivityTemplateItem `json:"template"`
+}
+
+type AdminCategory struct {
+	Id            int64  `json:"id"`
+	Name          string `json:"name"`
+	Icon          string `json:"icon"`
+	Sort          int32  `json:"sort"`
+	Status        int32  `json:"status"`        // 1=启用 0=禁用
+	ActivityCount int64  `json:"activityCount"` // 分类下的活动数
+	CreatedAt     int64  `json:"createdAt"`
+	UpdatedAt     int64  `json:"updatedAt"`
+}
+
+type AdminCategoryResp struct {
+	Category AdminCategory `json:"category"`
+}
+
+type AdminCreateTemplateReq struct {
+	SourceActivityId int64  `json:"sourceActivityId"`    // 蓝本活动
+	Name             string `json:"name,optional"`       // 不填使用活动标题
+	CategoryId       int64  `json:"categoryId,optional"` // 不填沿用活动分类
+}
+
+type AdminListActivityChangesReq struct {
+	ActivityId int64 `form:"activityId,optional"`
+	Status     int32 `form:"status,default=0"` // 默认只看待审核，-1=全部
+	Page       int32 `form:"page,default=1"`
+	PageSize   int32 `form:"pageSize,default=20"`
+}
+
+type AdminListCategoryResp struct {
+	List []AdminCategory `json:"list"`
+}
+
+type AdminListTagResp struct {
+	List []AdminTag `json:"list"`
+}
+
+type AdminListTemplatesReq struct {
+	CategoryId int64 `form:"categoryId,optional"`
+	Page       int32 `form:"page,default=1"`
+	PageSize   int32 `form:"pageSize,default=20"`
+}
+
+type AdminTag struct {
+	Id            int64  `json:"id"`
+	Name          string `json:"name"`
+	Color         string `json:"color"`
+	Icon          string `json:"icon"`
+	Description   string `json:"description"`
+	Status        int32  `json:"status"`        // 1=启用 0=禁用
+	ActivityCount int64  `json:"activityCount"` // 关联的活动数
+}
+
+type AdminTagResp struct {
+	Tag AdminTag `json:"tag"`
+}
+
+type AdminUpdateTemplateReq struct {
+	Id         int64  `path:"id"`
+	Name       string `json:"name,optional"`
+	CategoryId int64  `json:"categoryId,optional"`
+	Status     *int32 `json:"status,optional"` // 1上架 0下架
+}
+
+type AnalyticsDaily struct {
+	Date           string `json:"date"` // YYYY-MM-DD
+	PageViews      int64  `json:"pageViews"`
+	UniqueVisitors int64  `json:"uniqueVisitors"`
+	Registrations  int64  `json:"registrations"`
+	Cancellations  int64  `json:"cancellations"`
+	CheckIns       int64  `json:"checkIns"`
+}
+
+type AnalyticsFunnel struct {
+	PageViews           int64   `json:"pageViews"`           // 浏览次数
+	UniqueVisitors      int64   `json:"uniqueVisitors"`      // 浏览人数
+	Registrations       int64   `json:"registrations"`       // 累计报名数（含已取消）
+	Cancellations       int64   `json:"cancellations"`       // 取消数
+	ActiveRegistrations int64   `json:"activeRegistrations"` // 有效报名数
+	CheckIns            int64   `json:"checkIns"`            // 签到人数
+	NoShows             int64   `json:"noShows"`             // 未到场人数（活动结束后统计）
+	AttendanceRate      float64 `json:"attendanceRate"`      // 到场率
+}
+
 type ApproveActivityReq struct {
 	Id int64 `path:"id"`
 }
@@ -78,6 +237,38 @@ type ApproveActivityResp struct {
 	Status int32 `json:"status"` // 2=已发布
 }
 
+type AssignActivityReviewReq struct {
+	Id         int64 `path:"id"`
+	AssigneeId int64 `json:"assigneeId,optional"` // 0=领取给自己
+	Release    bool  `json:"release,optional"`    // 释放任务
+}
+
+type AssignActivityReviewResp struct {
+	AssigneeId int64 `json:"assigneeId"`
+	AssignedAt int64 `json:"assignedAt"`
+}
+
+type AudienceBreakdown struct {
+	VerifiedRegistrants int64            `json:"verifiedRegistrants"`
+	Departments         []AudienceBucket `json:"departments"`
+	AdmissionYears      []AudienceBucket `json:"admissionYears"`
+}
+
+type AudienceBucket struct {
+	Value string `json:"value"`
+	Count int64  `json:"count"`
+}
+
+type CalendarFeedReq struct {
+	Token string `path:"token"`
+}
+
+type CalendarFeedUrlResp struct {
+	Token     string `json:"token"`
+	Url       string `json:"url"`       // https 订阅地址
+	WebcalUrl string `json:"webcalUrl"` // webcal:// 地址（系统日历一键订阅）
+}
+
 type CancelActivityReq struct {
 	Id     int64  `path:"id"`
 	Reason string `json:"reason,optional"`
@@ -95,6 +286,17 @@ type CancelActivityResponse struct {
 	Result string `json:"result"`
 }
 
+type CancelActivitySeriesReq struct {
+	Id           int64  `path:"id"`
+	Reason       string `json:"reason,optional"`
+	CancelFuture bool   `json:"cancelFuture,default=false"` // 同时取消未开始的场次
+}
+
+type CancelActivitySeriesResp struct {
+	Cancelled int32                     `json:"cancelled"` // 已取消的场次数
+	Skipped   []SeriesSkippedOccurrence `json:"skipped"`
+}
+
 type Category struct {
 	Id   int64  `json:"id"`
 	Name string `json:"name"`
@@ -102,6 +304,42 @@ type Category struct {
 	Sort int32  `json:"sort"`
 }
 
+type CategorySortItem struct {
+	Id   int64 `json:"id"`
+	Sort int32 `json:"sort"`
+}
+
+type CheckEligibilityRequest struct {
+	ActivityId int64 `form:"activityId"`
+}
+
+type CheckEligibilityResponse struct {
+	Eligible    bool                   `json:"eligible"`    // 是否满足全部资格规则
+	Items       []EligibilityCheckItem `json:"items"`       // 逐条校验结果
+	CanRegister bool                   `json:"canRegister"` // 综合判断当前能否报名（含状态、时间、名额）
+	Reason      string                 `json:"reason"`      // 不能报名的原因
+}
+
+type CheckInRecordItem struct {
+	Id             int64   `json:"id"`
+	UserId         int64   `json:"userId"`
+	TicketCode     string  `json:"ticketCode"`
+	Method         int32   `json:"method"` // 1扫码 2自助
+	CheckInTime    int64   `json:"checkInTime"`
+	Latitude       float64 `json:"latitude"`
+	Longitude      float64 `json:"longitude"`
+	DistanceMeters int32   `json:"distanceMeters"`
+	DeviceId       string  `json:"deviceId"`
+	Flagged        bool    `json:"flagged"`
+	FlagReason     string  `json:"flagReason"`
+}
+
+type CloneActivityReq struct {
+	Id                int64  `path:"id"`
+	ActivityStartTime int64  `json:"activityStartTime"` // 新活动开始时间
+	Title             string `json:"title,optional"`    // 不填沿用原标题
+}
+
 type CreateActivityReq struct {
 	Title                string  `json:"title"`               // 必填，2-100字
 	CoverImageId         int64   `json:"coverImageId"`        // 必填，封面图片ID
@@ -130,6 +368,49 @@ type CreateActivityResp struct {
 	Status int32 `json:"status"` // 0=草稿，1=待审核
 }
 
+type CreateActivitySeriesReq struct {
+	Title                string  `json:"title"`               // 必填，2-100字
+	CoverImageId         int64   `json:"coverImageId"`        // 必填，封面图片ID
+	CoverType            int32   `json:"coverType,default=1"` // 1=图片(默认), 2=视频
+	Content              string  `json:"content,optional"`
+	CategoryId           int64   `json:"categoryId"` // 必填
+	ContactPhone         string  `json:"contactPhone,optional"`
+	RegisterStartTime    int64   `json:"registerStartTime"` // 首场报名开始时间
+	RegisterEndTime      int64   `json:"registerEndTime"`   // 首场报名截止时间
+	ActivityStartTime    int64   `json:"activityStartTime"` // 首场开始时间
+	ActivityEndTime      int64   `json:"activityEndTime"`   // 首场结束时间
+	Location             string  `json:"location"`          // 必填
+	AddressDetail        string  `json:"addressDetail,optional"`
+	Longitude            float64 `json:"longitude,optional"`
+	Latitude             float64 `json:"latitude,optional"`
+	MaxParticipants      int32   `json:"maxParticipants,default=0"` // 每场人数上限，0=不限
+	RequireApproval      bool    `json:"requireApproval,default=false"`
+	RequireStudentVerify bool    `json:"requireStudentVerify,default=false"`
+	MinCreditScore       int32   `json:"minCreditScore,default=0"`
+	TagIds               []int64 `json:"tagIds,optional"`                   // 最多5个
+	Rrule                string  `json:"rrule"`                             // 重复规则，如 FREQ=WEEKLY;INTERVAL=2;BYDAY=TU;COUNT=10
+	AllowSeriesRegister  bool    `json:"allowSeriesRegister,default=false"` // 是否开放整期报名
+}
+
+type CreateActivitySeriesResp struct {
+	SeriesId         int64   `json:"seriesId"`
+	ActivityIds      []int64 `json:"activityIds"`      // 已生成的场次
+	TotalOccurrences int32   `json:"totalOccurrences"` // 按规则计算的总场次
+}
+
+type CreateCategoryReq struct {
+	Name string `json:"name"`
+	Icon string `json:"icon,optional"`
+	Sort int32  `json:"sort,optional"` // 排序权重（越大越靠前）
+}
+
+type CreateTagReq struct {
+	Name        string `json:"name"`
+	Color       string `json:"color,optional"`
+	Icon        string `json:"icon,optional"`
+	Description string `json:"description,optional"`
+}
+
 type DeleteActivityReq struct {
 	Id int64 `path:"id"`
 }
@@ -138,6 +419,97 @@ type DeleteActivityResp struct {
 	Success bool `json:"success"`
 }
 
+type DeleteActivityTemplateReq struct {
+	Id int64 `path:"id"`
+}
+
+type DeleteActivityTemplateResp struct {
+	Success bool `json:"success"`
+}
+
+type DeleteCategoryReq struct {
+	Id int64 `path:"id"`
+}
+
+type DeleteCategoryResp struct {
+	Success bool `json:"success"`
+}
+
+type EligibilityCheckItem struct {
+	RuleType    string `json:"ruleType"` // platform_credit 为平台内置信用规则
+	Description string `json:"description"`
+	Passed      bool   `json:"passed"`
+	Reason      string `json:"reason"`
+}
+
+type EligibilityRule struct {
+	RuleType    string   `json:"ruleType"`
+	MinValue    int32    `json:"minValue,optional"`    // 最低信用分 / 入学年份下限（0=不限）
+	MaxValue    int32    `json:"maxValue,optional"`    // 入学年份上限（0=不限）
+	Values      []string `json:"values,optional"`      // 学校/院系名称（任一匹配）
+	Description string   `json:"description,optional"` // 规则说明（只读）
+}
+
+type ExportActivityIcsReq struct {
+	Id int64 `path:"id"`
+}
+
+type FacetBucket struct {
+	Id    int64  `json:"id"`    // 分类ID / 标签ID / 状态值
+	Name  string `json:"name"`  // 分类名 / 标签名 / 状态文本
+	Count int64  `json:"count"` // 命中活动数
+}
+
+type FavoriteActivityReq struct {
+	Id int64 `path:"id"`
+}
+
+type FavoriteActivityResp struct {
+	Favorited bool `json:"favorited"` // 操作后的收藏状态
+}
+
+type FeedbackComment struct {
+	Id        int64  `json:"id"`
+	UserId    int64  `json:"userId"`
+	Rating    int32  `json:"rating"`
+	Comment   string `json:"comment"`
+	CreatedAt int64  `json:"createdAt"`
+	UpdatedAt int64  `json:"updatedAt"`
+}
+
+type FeedbackReviewItem struct {
+	Id            int64    `json:"id"`
+	ActivityId    int64    `json:"activityId"`
+	ActivityTitle string   `json:"activityTitle"`
+	FeedbackId    int64    `json:"feedbackId"`
+	UserId        int64    `json:"userId"`
+	Comment       string   `json:"comment"`       // 当前短评内容
+	CommentStatus int32    `json:"commentStatus"` // 0=公开 1=待审核 2=已隐藏
+	HitWords      []string `json:"hitWords"`      // 命中的送审词
+	Status        int32    `json:"status"`        // 0=待审核 1=已通过 2=已驳回
+	ReviewerId    int64    `json:"reviewerId"`
+	ReviewNote    string   `json:"reviewNote"`
+	ReviewedAt    int64    `json:"reviewedAt"`
+	CreatedAt     int64    `json:"createdAt"`
+}
+
+type GetActivityAnalyticsReq struct {
+	Id        int64  `path:"id"`
+	StartDate string `form:"startDate,optional"` // YYYY-MM-DD，默认最近 30 天
+	EndDate   string `form:"endDate,optional"`   // YYYY-MM-DD，默认今天
+}
+
+type GetActivityAnalyticsResp struct {
+	ActivityId int64                `json:"activityId"`
+	Title      string               `json:"title"`
+	Funnel     AnalyticsFunnel      `json:"funnel"`
+	Velocity   RegistrationVelocity `json:"velocity"`
+	Daily      []AnalyticsDaily     `json:"daily"`
+	Audience   AudienceBreakdown    `json:"audience"`
+	StatDate   string               `json:"statDate"`  // 最近聚合日期（空表示尚未统计）
+	UpdatedAt  int64                `json:"updatedAt"` // 最近聚合时间
+}
+
 type GetActivityListRequest struct {
 	Page     int32  `form:"page"`
 	PageSize int32  `form:"pageSize"`
@@ -159,6 +531,42 @@ type GetActivityResp struct {
 	Activity ActivityDetail `json:"activity"`
 }
 
+type GetActivitySeriesReq struct {
+	Id int64 `path:"id"`
+}
+
+type GetActivitySeriesResp struct {
+	Series      ActivitySeriesInfo `json:"series"`
+	Occurrences []SeriesOccurrence `json:"occurrences"`
+	Subscribed  bool               `json:"subscribed"`
+}
+
+type GetEligibilityRulesReq struct {
+	Id int64 `path:"id"`
+}
+
+type GetEligibilityRulesResp struct {
+	Rules                []EligibilityRule `json:"rules"`
+	MinCreditScore       int32             `json:"minCreditScore"`       // 活动字段：最低信用分
+	RequireStudentVerify bool              `json:"requireStudentVerify"` // 活动字段：是否需要学生认证
+}
+
+type GetFeedbackSummaryReq struct {
+	Id       int64 `path:"id"`
+	Page     int32 `form:"page,default=1"`
+	PageSize int32 `form:"pageSize,default=10"`
+}
+
+type GetFeedbackSummaryResp struct {
+	RatingAvg           float64           `json:"ratingAvg"`
+	RatingCount         int32             `json:"ratingCount"`
+	Distribution        []int64           `json:"distribution"`        // 1-5 分各自人数
+	PendingCommentCount int64             `json:"pendingCommentCount"` // 待审核短评数
+	Comments            []FeedbackComment `json:"comments"`            // 公开短评
+	Pagination          Pagination        `json:"pagination"`
+	FeedbackDeadline    int64             `json:"feedbackDeadline"` // 评价截止时间
+}
+
 type GetHotActivityReq struct {
 	Limit int32 `form:"limit,default=10"` // 最大20
 }
@@ -167,6 +575,30 @@ type GetHotActivityResp struct {
 	List []ActivityListItem `json:"list"`
 }
 
+type GetOrganizerAnalyticsReq struct {
+	StartDate string `form:"startDate,optional"` // YYYY-MM-DD，默认最近 30 天
+	EndDate   string `form:"endDate,optional"`   // YYYY-MM-DD，默认今天
+}
+
+type GetOrganizerAnalyticsResp struct {
+	ActivityCount int64                    `json:"activityCount"` // 有统计数据的活动数
+	Funnel        AnalyticsFunnel          `json:"funnel"`
+	Daily         []AnalyticsDaily         `json:"daily"`
+	Audience      AudienceBreakdown        `json:"audience"`
+	TopActivities []ActivityAnalyticsBrief `json:"topActivities"` // 报名数最多的活动
+}
+
+type GetSelfCheckInCodeReq struct {
+	Id int64 `path:"id"`
+}
+
+type GetSelfCheckInCodeResp struct {
+	Code           string `json:"code"`      // 6 位签到码
+	ExpiresAt      int64  `json:"expiresAt"` // 失效时间，到期后重新获取
+	RadiusMeters   int32  `json:"radiusMeters"`
+	CheckedInCount int64  `json:"checkedInCount"` // 已签到人数
+}
+
 type GetTicketDetailRequest struct {
 	TicketId int64 `form:"ticketId"`
 }
@@ -200,6 +632,18 @@ type IncrViewCountResp struct {
 	ViewCount int64 `json:"viewCount"`
 }
 
+type ListActivityChangesReq struct {
+	Id       int64 `path:"id"`
+	Status   int32 `form:"status,default=-1"` // -1=全部
+	Page     int32 `form:"page,default=1"`
+	PageSize int32 `form:"pageSize,default=20"`
+}
+
+type ListActivityChangesResp struct {
+	List       []ActivityChangeInfo `json:"list"`
+	Pagination Pagination           `json:"pagination"`
+}
+
 type ListActivityReq struct {
 	Page       int32  `form:"page,default=1"`
 	PageSize   int32  `form:"pageSize,default=10"`
@@ -214,6 +658,18 @@ type ListActivityResp struct {
 	Pagination Pagination         `json:"pagination"`
 }
 
+type ListActivityTemplatesReq struct {
+	Scope      int32 `form:"scope,optional"` // 0全部 1个人 2公共
+	CategoryId int64 `form:"categoryId,optional"`
+	Page       int32 `form:"page,default=1"`
+	PageSize   int32 `form:"pageSize,default=20"`
+}
+
+type ListActivityTemplatesResp struct {
+	List       []ActivityTemplateItem `json:"list"`
+	Pagination Pagination             `json:"pagination"`
+}
+
 type ListCategoryReq struct {
 }
 
@@ -221,6 +677,41 @@ type ListCategoryResp struct {
 	List []Category `json:"list"`
 }
 
+type ListCheckInRecordsReq struct {
+	Id          int64 `path:"id"`
+	FlaggedOnly bool  `form:"flaggedOnly,optional"` // 只看疑似异常记录
+	Page        int32 `form:"page,default=1"`
+	PageSize    int32 `form:"pageSize,default=20"`
+}
+
+type ListCheckInRecordsResp struct {
+	List       []CheckInRecordItem `json:"list"`
+	Pagination Pagination          `json:"pagination"`
+}
+
+type ListFeedbackReviewsReq struct {
+	Status   int32 `form:"status,default=0"` // 0=待审核 1=已通过 2=已驳回
+	Page     int32 `form:"page,default=1"`
+	PageSize int32 `form:"pageSize,default=20"`
+}
+
+type ListFeedbackReviewsResp struct {
+	List       []FeedbackReviewItem `json:"list"`
+	Pagination Pagination           `json:"pagination"`
+}
+
+type ListReviewQueueReq struct {
+	Scope       string `form:"scope,optional,default=all"` // all / mine / unassigned
+	OverdueOnly bool   `form:"overdueOnly,optional"`
+	Page        int32  `form:"page,default=1"`
+	PageSize    int32  `form:"pageSize,default=20"`
+}
+
+type ListReviewQueueResp struct {
+	List       []ReviewQueueItem `json:"list"`
+	Pagination Pagination        `json:"pagination"`
+}
+
 type ListTagReq struct {
 	Limit int32 `form:"limit,optional"` // 0=全部，>0=热门N个
 }
@@ -229,6 +720,17 @@ type ListTagResp struct {
 	List []Tag `json:"list"`
 }
 
+type MergeTagsReq struct {
+	Id       int64 `path:"id"`
+	TargetId int64 `json:"targetId"`
+}
+
+type MergeTagsResp struct {
+	Target          AdminTag `json:"target"`
+	MovedActivities int64    `json:"movedActivities"` // 受影响的活动数
+	MovedUsers      int64    `json:"movedUsers"`      // 改绑兴趣的用户数
+}
+
 type MyActivityReq struct {
 	Page     int32 `form:"page,default=1"`
 	PageSize int32 `form:"pageSize,default=10"`
@@ -239,6 +741,23 @@ type MyActivityResp struct {
 	Pagination Pagination         `json:"pagination"`
 }
 
+type NearbyActivityReq struct {
+	Longitude  float64 `form:"longitude"`          // 必填，用户经度
+	Latitude   float64 `form:"latitude"`           // 必填，用户纬度
+	RadiusKm   float64 `form:"radiusKm,default=5"` // 搜索半径（公里），最大 50
+	CategoryId int64   `form:"categoryId,optional"`
+	Keyword    string  `form:"keyword,optional"` // 可选，最多50字
+	Page       int32   `form:"page,default=1"`
+	PageSize   int32   `form:"pageSize,default=10"`
+	Sort       string  `form:"sort,default=distance"` // distance, time, hot
+}
+
+type NearbyActivityResp struct {
+	List        []ActivityListItem `json:"list"` // distance 为与用户的距离（米）
+	Total       int64              `json:"total"`
+	QueryTimeMs int32              `json:"queryTimeMs"` // 查询耗时（毫秒）
+}
+
 type Pagination struct {
 	Page       int32 `json:"page"`
 	PageSize   int32 `json:"pageSize"`
@@ -251,8 +770,24 @@ type RegisterActivityRequest struct {
 }
 
 type RegisterActivityResponse struct {
-	Result string `json:"result"`
-	Reason string `json:"reason"`
+	Result      string                 `json:"result"`
+	Reason      string                 `json:"reason"`
+	FailedRules []EligibilityCheckItem `json:"failedRules"` // 未通过的资格规则
+}
+
+type RegisterActivitySeriesReq struct {
+	Id int64 `path:"id"`
+}
+
+type RegisterActivitySeriesResp struct {
+	Subscribed bool  `json:"subscribed"`
+	Enrolled   int32 `json:"enrolled"` // 本次立即出票的场次数
+}
+
+type RegistrationVelocity struct {
+	First24hRegistrations  int64   `json:"first24hRegistrations"`  // 开放报名 24 小时内报名数
+	PeakDailyRegistrations int64   `json:"peakDailyRegistrations"` // 单日最高报名数
+	AvgDailyRegistrations  float64 `json:"avgDailyRegistrations"`  // 报名期日均报名数
 }
 
 type RejectActivityReq struct {
@@ -264,18 +799,178 @@ type RejectActivityResp struct {
 	Status int32 `json:"status"` // 5=已拒绝
 }
 
+type ReviewActivityChangeReq struct {
+	Id      int64  `path:"id"`
+	Approve bool   `json:"approve"`
+	Reason  string `json:"reason,optional"` // 驳回时必填
+}
+
+type ReviewActivityChangeResp struct {
+	Status          int32 `json:"status"`          // 1=已生效 2=已驳回
+	FreeCancelUntil int64 `json:"freeCancelUntil"` // 无责取消截止时间
+	NotifiedCount   int32 `json:"notifiedCount"`   // 待通知的报名人数
+}
+
+type ReviewFeedbackCommentReq struct {
+	Id      int64  `path:"id"`
+	Approve bool   `json:"approve"`       // true=通过（公开） false=驳回（隐藏）
+	Note    string `json:"note,optional"` // 审核备注
+}
+
+type ReviewFeedbackCommentResp struct {
+	Status         int32 `json:"status"`         // 1=已通过 2=已驳回
+	CommentUpdated bool  `json:"commentUpdated"` // 短评状态是否随之更新（用户已重新提交评价时不更新）
+}
+
+type ReviewFieldDiff struct {
+	Field    string `json:"field"`
+	Label    string `json:"label"`
+	OldValue string `json:"oldValue"`
+	NewValue string `json:"newValue"`
+}
+
+type ReviewQueueItem struct {
+	ReviewId           int64             `json:"reviewId"`
+	ActivityId         int64             `json:"activityId"`
+	Title              string            `json:"title"`
+	CoverUrl           string            `json:"coverUrl"`
+	CategoryName       string            `json:"categoryName"`
+	OrganizerId        int64             `json:"organizerId"`
+	OrganizerName      string            `json:"organizerName"`
+	ActivityStartTime  int64             `json:"activityStartTime"`
+	PolicyReason       string            `json:"policyReason"` // 进入人工审核的原因
+	Flagged            bool              `json:"flagged"`      // 内容命中送审词
+	SubmittedAt        int64             `json:"submittedAt"`
+	DueAt              int64             `json:"dueAt"`            // SLA 截止时间
+	RemainingSeconds   int64             `json:"remainingSeconds"` // 已超时为负数
+	Overdue            bool              `json:"overdue"`
+	AssigneeId         int64             `json:"assigneeId"` // 0=未分配
+	AssignedAt         int64             `json:"assignedAt"`
+	HasApprovedVersion bool              `json:"hasApprovedVersion"` // 首次提交为 false
+	Diffs              []ReviewFieldDiff `json:"diffs"`
+}
+
+type SaveActivityTemplateReq struct {
+	Id   int64  `path:"id"`
+	Name string `json:"name,optional"` // 不填使用活动标题
+}
+
 type SearchActivityReq struct {
-	Keyword    string `form:"keyword"` // 必填，2-50字
-	CategoryId int64  `form:"categoryId,optional"`
-	Page       int32  `form:"page,default=1"`
-	PageSize   int32  `form:"pageSize,default=10"`
-	Sort       string `form:"sort,default=relevance"` // relevance, time, hot
+	Keyword         string  `form:"keyword"` // 必填，2-50字
+	CategoryId      int64   `form:"categoryId,optional"`
+	Page            int32   `form:"page,default=1"`
+	PageSize        int32   `form:"pageSize,default=10"`
+	Sort            string  `form:"sort,default=relevance"`    // relevance, time, hot, distance, rating
+	Longitude       float64 `form:"longitude,optional"`        // 用户经度（可选，与 latitude 同时传入时启用地理过滤）
+	Latitude        float64 `form:"latitude,optional"`         // 用户纬度
+	RadiusKm        float64 `form:"radiusKm,optional"`         // 搜索半径（公里），默认 5，最大 50
+	TagIds          []int64 `form:"tagIds,optional"`           // 标签筛选（命中任一即可，最多5个）
+	StartTimeFrom   int64   `form:"startTimeFrom,optional"`    // 活动开始时间下限（Unix 秒）
+	StartTimeTo     int64   `form:"startTimeTo,optional"`      // 活动开始时间上限（Unix 秒）
+	HasSeats        bool    `form:"hasSeats,optional"`         // 只看还有名额的活动
+	NoStudentVerify bool    `form:"noStudentVerify,optional"`  // 只看不需要学生认证的活动
+	MaxCreditScore  int32   `form:"maxCreditScore,default=-1"` // 信用分门槛上限（-1=不限）
 }
 
 type SearchActivityResp struct {
 	List        []ActivityListItem `json:"list"`
 	Total       int64              `json:"total"`
 	QueryTimeMs int32              `json:"queryTimeMs"` // 搜索耗时（毫秒）
+	Facets      SearchFacets       `json:"facets"`      // 分面统计（筛选侧栏）
+}
+
+type SearchFacets struct {
+	Categories []FacetBucket `json:"categories"`
+	Tags       []FacetBucket `json:"tags"`
+	Statuses   []FacetBucket `json:"statuses"`
+}
+
+type SelfCheckInRequest struct {
+	ActivityId      int64   `json:"activityId"`
+	TicketCode      string  `json:"ticketCode"`
+	RoomCode        string  `json:"roomCode"` // 现场屏幕上的签到码
+	Latitude        float64 `json:"latitude"`
+	Longitude       float64 `json:"longitude"`
+	DeviceId        string  `json:"deviceId,optional"`
+	ClientRequestId string  `json:"clientRequestId,optional"`
+}
+
+type SelfCheckInResponse struct {
+	Result         string `json:"result"`
+	DistanceMeters int32  `json:"distanceMeters"`
+	CheckInTime    int64  `json:"checkInTime"`
+}
+
+type SeriesOccurrence struct {
+	Index               int32  `json:"index"`
+	ActivityId          int64  `json:"activityId"`
+	ActivityStartTime   int64  `json:"activityStartTime"`
+	ActivityEndTime     int64  `json:"activityEndTime"`
+	Status              int32  `json:"status"`
+	StatusText          string `json:"statusText"`
+	Overridden          bool   `json:"overridden"` // 是否单独修改过（不再跟随整期修改）
+	CurrentParticipants int32  `json:"currentParticipants"`
+	MaxParticipants     int32  `json:"maxParticipants"`
+}
+
+type SeriesSkippedOccurrence struct {
+	ActivityId int64  `json:"activityId"`
+	Reason     string `json:"reason"`
+}
+
+type SetCategoryStatusReq struct {
+	Id     int64 `path:"id"`
+	Status int32 `json:"status,options=0|1"` // 1=启用 0=禁用
+}
+
+type SetEligibilityRulesReq struct {
+	Id    int64             `path:"id"`
+	Rules []EligibilityRule `json:"rules"`
+}
+
+type SetEligibilityRulesResp struct {
+	Rules []EligibilityRule `json:"rules"`
+}
+
+type SetSelfCheckInReq struct {
+	Id           int64 `path:"id"`
+	Enabled      bool  `json:"enabled"`
+	RadiusMeters int32 `json:"radiusMeters,optional"` // 签到半径（米），不填使用默认值
+}
+
+type SetSelfCheckInResp struct {
+	Enabled      bool  `json:"enabled"`
+	RadiusMeters int32 `json:"radiusMeters"`
+}
+
+type SetTagStatusReq struct {
+	Id     int64 `path:"id"`
+	Status int32 `json:"status,options=0|1"` // 1=启用 0=禁用
+}
+
+type SortCategoriesReq struct {
+	Items []CategorySortItem `json:"items"`
+}
+
+type SortCategoriesResp struct {
+	Updated int32 `json:"updated"`
+}
+
+type SubmitActivityChangeReq struct {
+	Id                int64    `path:"id"`
+	RegisterEndTime   *int64   `json:"registerEndTime,optional"`
+	ActivityStartTime *int64   `json:"activityStartTime,optional"`
+	ActivityEndTime   *int64   `json:"activityEndTime,optional"`
+	Location          *string  `json:"location,optional"`
+	AddressDetail     *string  `json:"addressDetail,optional"`
+	Longitude         *float64 `json:"longitude,optional"`
+	Latitude          *float64 `json:"latitude,optional"`
+	Reason            string   `json:"reason"` // 变更原因（必填，1-500字，随通知发送给报名者）
+}
+
+type SubmitActivityChangeResp struct {
+	RequestId int64 `json:"requestId"`
+	Status    int32 `json:"status"` // 0=待审核
 }
 
 type SubmitActivityReq struct {
@@ -286,6 +981,29 @@ type SubmitActivityResp struct {
 	Status int32 `json:"status"` // 1=待审核
 }
 
+type SubmitFeedbackReq struct {
+	Id      int64  `path:"id"`
+	Rating  int32  `json:"rating"`           // 评分 1-5
+	Comment string `json:"comment,optional"` // 短评，最多 200 字
+}
+
+type SubmitFeedbackResp struct {
+	RatingAvg     float64 `json:"ratingAvg"`     // 最新平均评分
+	RatingCount   int32   `json:"ratingCount"`   // 最新评价人数
+	CommentStatus int32   `json:"commentStatus"` // 短评状态: 0=公开, 1=待审核
+	Comment       string  `json:"comment"`       // 实际保存的短评（敏感词已处置）
+}
+
+type SuggestActivityReq struct {
+	Prefix     string `form:"prefix"` // 必填，1-20字
+	CategoryId int64  `form:"categoryId,optional"`
+	Size       int32  `form:"size,default=10"` // 最大20
+}
+
+type SuggestActivityResp struct {
+	Suggestions []string `json:"suggestions"`
+}
+
 type Tag struct {
 	Id    int64  `json:"id"`
 	Name  string `json:"name"`
@@ -332,6 +1050,52 @@ type UpdateActivityResp struct {
 	NewVersion int32 `json:"newVersion"`
 }
 
+type UpdateActivitySeriesReq struct {
+	Id                   int64    `path:"id"`
+	Title                *string  `json:"title,optional"`
+	Content              *string  `json:"content,optional"`
+	CategoryId           *int64   `json:"categoryId,optional"`
+	ContactPhone         *string  `json:"contactPhone,optional"`
+	CoverImageId         *int64   `json:"coverImageId,optional"`
+	CoverType            *int32   `json:"coverType,optional"`
+	Location             *string  `json:"location,optional"`
+	AddressDetail        *string  `json:"addressDetail,optional"`
+	Longitude            *float64 `json:"longitude,optional"`
+	Latitude             *float64 `json:"latitude,optional"`
+	MaxParticipants      *int32   `json:"maxParticipants,optional"`
+	RequireApproval      *bool    `json:"requireApproval,optional"`
+	RequireStudentVerify *bool    `json:"requireStudentVerify,optional"`
+	MinCreditScore       *int32   `json:"minCreditScore,optional"`
+	TagIds               []int64  `json:"tagIds,optional"`
+	UpdateTags           bool     `json:"updateTags,default=false"`
+	AllowSeriesRegister  *bool    `json:"allowSeriesRegister,optional"`
+}
+
+type UpdateActivitySeriesResp struct {
+	Updated int32                     `json:"updated"` // 已同步的场次数
+	Skipped []SeriesSkippedOccurrence `json:"skipped"` // 未同步的场次及原因
+}
+
+type UpdateCategoryReq struct {
+	Id   int64  `path:"id"`
+	Name string `json:"name,optional"`
+	Icon string `json:"icon,optional"`
+}
+
+type UpdateTagReq struct {
+	Id          int64  `path:"id"`
+	Name        string `json:"name,optional"`
+	Color       string `json:"color,optional"`
+	Icon        string `json:"icon,optional"`
+	Description string `json:"description,optional"`
+}
+
+type UseActivityTemplateReq struct {
+	Id                int64  `path:"id"`
+	ActivityStartTime int64  `json:"activityStartTime"`
+	Title             string `json:"title,optional"` // 不填使用模板标题
+}
+
 type VerifyTicketRequest struct {
 	ActivityId int64  `json:"activityId"`
 	TicketCode string `json:"ticketCode"`
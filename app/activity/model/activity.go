@@ -80,6 +80,9 @@ type Activity struct {
 	// 统计（异步更新）
 	ViewCount uint32 `gorm:"default:0;comment:浏览量" json:"view_count"`
 	LikeCount uint32 `gorm:"default:0;comment:点赞数" json:"like_count"`
+	// 评价汇总（评价写入时同步更新）
+	RatingAvg   float64 `gorm:"type:decimal(3,2);default:0;comment:平均评分" json:"rating_avg"`
+	RatingCount uint32  `gorm:"default:0;comment:评价人数" json:"rating_count"`
 	// 乐观锁
 	Version uint32 `gorm:"default:0;comment:乐观锁版本号" json:"version"`
 	// 时间戳
@@ -404,6 +407,20 @@ func (m *ActivityModel) FindHot(ctx context.Context, limit int) ([]Activity, err
 
 // ==================== 内部服务方法 ====================
 
+// UpdateRating 更新评价汇总（统计字段，不递增乐观锁版本，在事务内调用）
+func (m *ActivityModel) UpdateRating(ctx context.Context, tx *gorm.DB, id uint64, avg float64, count int64) error {
+	if tx == nil {
+		tx = m.db
+	}
+	return tx.WithContext(ctx).
+		Model(&Activity{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"rating_avg":   avg,
+			"rating_count": count,
+		}).Error
+}
+
 // UpdateParticipantCount 更新报名人数（原子操作，供报名模块调用）
 func (m *ActivityModel) UpdateParticipantCount(ctx context.Context, id uint64, delta int) (uint32, error) {
 	var activity Activity
@@ -419,6 +436,9 @@ func (m *ActivityModel) UpdateParticipantCount(ctx context.Context, id uint64, d
 		if result.RowsAffected == 0 {
 			return ErrActivityNotFound
 		}
+		if err := NewActivityChangeEventModel(tx).Record(ctx, tx, id, ChangeTypeParticipants, ChangeSourceActivityRpc); err != nil {
+			return err
+		}
 
 		// 2. 查询更新后的值
 		if err := tx.Where("id = ?", id).First(&activity).Error; err != nil {
@@ -455,11 +475,20 @@ func (m *ActivityModel) IncrViewCount(ctx context.Context, id uint64, delta int)
 
 // SearchQuery 搜索查询条件
 type SearchQuery struct {
-	Keyword    string // 搜索关键词（搜索标题、描述、地点）
-	CategoryID uint64 // 分类筛选（0=全部）
-	Page       int    // 页码
-	PageSize   int    // 每页数量
-	Sort       string // 排序方式：relevance（相关性）/ time（时间）/ hot（热度）
+	Keyword    string     // 搜索关键词（搜索标题、描述、地点）
+	CategoryID uint64     // 分类筛选（0=全部）
+	Page       int        // 页码
+	PageSize   int        // 每页数量
+	Sort       string     // 排序方式：relevance（相关性）/ time（时间）/ hot（热度）/ distance（距离）
+	Geo        *GeoCircle // 地理范围筛选（可选，nil 表示不限）
+
+	// ===== 高级筛选（均为可选）=====
+	TagIDs          []uint64 // 标签筛选（命中任一即可）
+	StartTimeFrom   int64    // 活动开始时间下限（0=不限）
+	StartTimeTo     int64    // 活动开始时间上限（0=不限）
+	HasSeats        bool     // 只看还有名额的活动（max_participants=0 视为不限）
+	NoStudentVerify bool     // 只看不需要学生认证的活动
+	MaxCreditScore  *int     // 信用分门槛上限（nil=不限）
 }
 
 // SearchResult 搜索结果
@@ -476,7 +505,7 @@ type SearchResult struct {
 // 搜索规则：
 //  1. 关键词同时匹配标题、描述、地点（OR 关系）
 //  2. 只搜索公开状态的活动（已发布/进行中/已结束）
-//  3. 支持分类筛选
+//  3. 支持分类、标签、时间、名额、报名门槛筛选
 //  4. 支持多种排序方式
 //
 // 性能说明：
@@ -537,6 +566,15 @@ func (m *ActivityModel) Search(ctx context.Context, query *SearchQuery) (*Search
 
 // buildSearchConditions 构建搜索查询条件
 func (m *ActivityModel) buildSearchConditions(db *gorm.DB, query *SearchQuery) *gorm.DB {
+	db = m.buildBaseSearchConditions(db, query)
+	db = applyCategoryFilter(db, query)
+	return applyTagFilter(db, query)
+}
+
+// buildBaseSearchConditions 构建除分类、标签以外的搜索条件
+//
+// 分类、标签作为分面维度单独拼接（见 SearchFacets）
+func (m *ActivityModel) buildBaseSearchConditions(db *gorm.DB, query *SearchQuery) *gorm.DB {
 	// 1. 只搜索公开状态的活动
 	db = db.Where("status IN ?", []int8{StatusPublished, StatusOngoing, StatusFinished})
 
@@ -553,11 +591,50 @@ func (m *ActivityModel) buildSearchConditions(db *gorm.DB, query *SearchQuery) *
 		)
 	}
 
-	// 3. 分类筛选
+	// 3. 地理范围筛选
+	// 先用外接矩形（可走经纬度索引）粗筛，再用球面距离精确过滤圆形范围
+	if query.Geo != nil {
+		box := query.Geo.BoundingBox()
+		db = db.Where("latitude BETWEEN ? AND ? AND longitude BETWEEN ? AND ?",
+			box.MinLat, box.MaxLat, box.MinLon, box.MaxLon).
+			Where(distanceSQL+" <= ?", query.Geo.Lon, query.Geo.Lat, query.Geo.RadiusMeters())
+	}
+
+	// 4. 活动开始时间范围
+	if query.StartTimeFrom > 0 {
+		db = db.Where("activity_start_time >= ?", query.StartTimeFrom)
+	}
+	if query.StartTimeTo > 0 {
+		db = db.Where("activity_start_time <= ?", query.StartTimeTo)
+	}
+
+	// 5. 报名门槛筛选
+	if query.HasSeats {
+		db = db.Where("max_participants = 0 OR current_participants < max_participants")
+	}
+	if query.NoStudentVerify {
+		db = db.Where("require_student_verify = ?", false)
+	}
+	if query.MaxCreditScore != nil {
+		db = db.Where("min_credit_score <= ?", *query.MaxCreditScore)
+	}
+
+	return db
+}
+
+// applyCategoryFilter 分类筛选
+func applyCategoryFilter(db *gorm.DB, query *SearchQuery) *gorm.DB {
 	if query.CategoryID > 0 {
 		db = db.Where("category_id = ?", query.CategoryID)
 	}
+	return db
+}
 
+// applyTagFilter 标签筛选（命中任一标签即可）
+func applyTagFilter(db *gorm.DB, query *SearchQuery) *gorm.DB {
+	if len(query.TagIDs) > 0 {
+		db = db.Where("id IN (SELECT activity_id FROM activity_tags WHERE tag_id IN ?)", query.TagIDs)
+	}
 	return db
 }
 
@@ -567,9 +644,21 @@ func (m *ActivityModel) buildSearchConditions(db *gorm.DB, query *SearchQuery) *
 //   - relevance：相关性排序（标题匹配优先，然后按热度）
 //   - time：按活动开始时间升序（即将开始的优先）
 //   - hot：按报名人数降序
+//   - rating：按平均评分降序（评分相同按评价人数）
+//   - distance：按与查询点的距离升序（需要 Geo 条件）
 //   - 默认：按创建时间降序
 func (m *ActivityModel) buildSearchOrder(db *gorm.DB, query *SearchQuery) *gorm.DB {
 	switch query.Sort {
+	case "distance":
+		if query.Geo != nil {
+			return db.Clauses(clause.OrderBy{Expression: clause.Expr{
+				SQL:                distanceSQL + " ASC, id DESC",
+				Vars:               []interface{}{query.Geo.Lon, query.Geo.Lat},
+				WithoutParentheses: true,
+			}})
+		}
+		return db.Order("created_at DESC")
+
 	case "relevance":
 		if query.Keyword != "" {
 			escapedKeyword := escapeKeyword(query.Keyword)
@@ -591,6 +680,10 @@ func (m *ActivityModel) buildSearchOrder(db *gorm.DB, query *SearchQuery) *gorm.
 		// 按报名人数降序
 		return db.Order("current_participants DESC, created_at DESC")
 
+	case "rating":
+		// 按平均评分降序，评分相同时评价人数多的优先
+		return db.Order("rating_avg DESC, rating_count DESC, created_at DESC")
+
 	default:
 		// 默认按创建时间降序
 		return db.Order("created_at DESC")
@@ -613,6 +706,32 @@ func escapeKeyword(keyword string) string {
 	return keyword
 }
 
+// SuggestTitlesByPrefix 按标题前缀查询搜索建议（ES 不可用时的降级方案）
+//
+// 说明：
+//   - 只匹配公开状态的活动（已发布/进行中/已结束）
+//   - 前缀匹配 title LIKE 'xxx%'，同名标题去重
+//   - 按报名人数降序，热门活动优先
+func (m *ActivityModel) SuggestTitlesByPrefix(ctx context.Context, prefix string, categoryID uint64, limit int) ([]string, error) {
+	if prefix == "" || limit <= 0 {
+		return []string{}, nil
+	}
+
+	db := m.db.WithContext(ctx).Model(&Activity{}).
+		Where("status IN ?", []int8{StatusPublished, StatusOngoing, StatusFinished}).
+		Where("title LIKE ?", escapeKeyword(prefix)+"%")
+	if categoryID > 0 {
+		db = db.Where("category_id = ?", categoryID)
+	}
+
+	var titles []string
+	err := db.Group("title").
+		Order("MAX(current_participants) DESC").
+		Limit(limit).
+		Pluck("title", &titles).Error
+	return titles, err
+}
+
 // ==================== 定时任务方法 ====================
 
 // BatchUpdateStatusByTime 批量更新状态（定时任务用）
@@ -0,0 +1,358 @@
+package model
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ==================== 活动分析统计 ====================
+//
+// 组织者数据看板只读以下汇总表，不扫描原始报名/签到记录：
+//   - activity_daily_stats：按活动按天的浏览、报名、取消、签到数
+//   - activity_stats_summary：活动累计指标（漏斗、报名速度、到场率）
+//   - activity_audience_stats：报名者构成（院系、入学年份，仅统计已认证用户）
+//
+// 三张表均由 AnalyticsCron 定时聚合写入（幂等覆盖），统计日期为服务器本地时区 YYYY-MM-DD
+
+// 报名者构成维度
+const (
+	AudienceDimensionDepartment    = "department"     // 院系
+	AudienceDimensionAdmissionYear = "admission_year" // 入学年份
+)
+
+var (
+	ErrStatsSummaryNotFound = errors.New("活动统计数据不存在")
+)
+
+// ActivityDailyStats 活动每日统计
+type ActivityDailyStats struct {
+	ID            uint64 `gorm:"primaryKey;autoIncrement" json:"id"`
+	ActivityID    uint64 `gorm:"uniqueIndex:uk_activity_date,priority:1;not null;comment:活动ID" json:"activity_id"`
+	StatDate      string `gorm:"type:char(10);uniqueIndex:uk_activity_date,priority:2;index:idx_organizer_date,priority:2;not null;comment:统计日期" json:"stat_date"`
+	OrganizerID   uint64 `gorm:"index:idx_organizer_date,priority:1;not null;comment:组织者ID" json:"organizer_id"`
+	PV            int64  `gorm:"column:pv;default:0;comment:浏览次数" json:"pv"`
+	UV            int64  `gorm:"column:uv;default:0;comment:浏览人数" json:"uv"`
+	Registrations int64  `gorm:"default:0;comment:报名数" json:"registrations"`
+	Cancellations int64  `gorm:"default:0;comment:取消数" json:"cancellations"`
+	CheckIns      int64  `gorm:"default:0;comment:签到数" json:"check_ins"`
+	UpdatedAt     int64  `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+func (ActivityDailyStats) TableName() string {
+	return "activity_daily_stats"
+}
+
+// ActivityStatsSummary 活动累计统计
+type ActivityStatsSummary struct {
+	ActivityID  uint64 `gorm:"primaryKey;comment:活动ID" json:"activity_id"`
+	OrganizerID uint64 `gorm:"index:idx_organizer;not null;comment:组织者ID" json:"organizer_id"`
+
+	// 转化漏斗
+	PV                  int64   `gorm:"column:pv;default:0;comment:累计浏览次数" json:"pv"`
+	UV                  int64   `gorm:"column:uv;default:0;comment:累计浏览人数" json:"uv"`
+	Registrations       int64   `gorm:"default:0;comment:累计报名数(含已取消)" json:"registrations"`
+	Cancellations       int64   `gorm:"default:0;comment:取消数" json:"cancellations"`
+	ActiveRegistrations int64   `gorm:"default:0;comment:有效报名数" json:"active_registrations"`
+	CheckIns            int64   `gorm:"default:0;comment:签到人数" json:"check_ins"`
+	NoShows             int64   `gorm:"default:0;comment:未到场人数(活动结束后统计)" json:"no_shows"`
+	AttendanceRate      float64 `gorm:"type:decimal(5,4);default:0;comment:到场率" json:"attendance_rate"`
+
+	// 报名速度
+	First24hRegistrations  int64   `gorm:"column:first_24h_registrations;default:0;comment:开放报名24小时内报名数" json:"first_24h_registrations"`
+	PeakDailyRegistrations int64   `gorm:"default:0;comment:单日最高报名数" json:"peak_daily_registrations"`
+	AvgDailyRegistrations  float64 `gorm:"type:decimal(10,2);default:0;comment:报名期日均报名数" json:"avg_daily_registrations"`
+
+	VerifiedRegistrants int64  `gorm:"default:0;comment:已认证报名人数" json:"verified_registrants"`
+	StatDate            string `gorm:"type:char(10);default:'';comment:最近聚合日期" json:"stat_date"`
+	UpdatedAt           int64  `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+func (ActivityStatsSummary) TableName() string {
+	return "activity_stats_summary"
+}
+
+// ActivityAudienceStats 报名者构成统计
+type ActivityAudienceStats struct {
+	ID          uint64 `gorm:"primaryKey;autoIncrement" json:"id"`
+	ActivityID  uint64 `gorm:"uniqueIndex:uk_activity_dimension_value,priority:1;not null;comment:活动ID" json:"activity_id"`
+	Dimension   string `gorm:"type:varchar(20);uniqueIndex:uk_activity_dimension_value,priority:2;not null;comment:维度: department/admission_year" json:"dimension"`
+	Value       string `gorm:"type:varchar(100);uniqueIndex:uk_activity_dimension_value,priority:3;not null;comment:维度取值" json:"value"`
+	OrganizerID uint64 `gorm:"index:idx_organizer;not null;comment:组织者ID" json:"organizer_id"`
+	Count       int64  `gorm:"default:0;comment:人数" json:"count"`
+}
+
+func (ActivityAudienceStats) TableName() string {
+	return "activity_audience_stats"
+}
+
+// DailyTotals 活动每日统计汇总
+type DailyTotals struct {
+	PV                     int64
+	Registrations          int64
+	Cancellations          int64
+	PeakDailyRegistrations int64
+}
+
+// OrganizerTotals 组织者全部活动的累计指标
+type OrganizerTotals struct {
+	Activities          int64
+	PV                  int64
+	UV                  int64
+	Registrations       int64
+	Cancellations       int64
+	ActiveRegistrations int64
+	CheckIns            int64
+	NoShows             int64
+	VerifiedRegistrants int64
+}
+
+// ==================== ActivityAnalyticsModel 数据访问层 ====================
+
+type ActivityAnalyticsModel struct {
+	db *gorm.DB
+}
+
+func NewActivityAnalyticsModel(db *gorm.DB) *ActivityAnalyticsModel {
+	return &ActivityAnalyticsModel{db: db}
+}
+
+// ==================== 聚合任务：原始数据统计 ====================
+
+// activityCount 按活动分组的计数结果
+type activityCount struct {
+	ActivityID uint64
+	Count      int64
+}
+
+// groupCount 执行按活动分组计数查询
+func groupCount(db *gorm.DB) (map[uint64]int64, error) {
+	var rows []activityCount
+	if err := db.Group("activity_id").Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	result := make(map[uint64]int64, len(rows))
+	for _, r := range rows {
+		result[r.ActivityID] = r.Count
+	}
+	return result, nil
+}
+
+// CountRegistrationsBetween 统计时间段内各活动的新增报名数（成功报名及之后取消的，不含失败记录）
+func (m *ActivityAnalyticsModel) CountRegistrationsBetween(ctx context.Context, start, end int64) (map[uint64]int64, error) {
+	return groupCount(m.db.WithContext(ctx).
+		Model(&ActivityRegistration{}).
+		Select("activity_id, COUNT(*) AS count").
+		Where("created_at >= ? AND created_at < ?", start, end).
+		Where("status IN ?", []int8{RegistrationStatusSuccess, RegistrationStatusCanceled}))
+}
+
+// CountCancellationsBetween 统计时间段内各活动的取消报名数
+func (m *ActivityAnalyticsModel) CountCancellationsBetween(ctx context.Context, start, end int64) (map[uint64]int64, error) {
+	return groupCount(m.db.WithContext(ctx).
+		Model(&ActivityRegistration{}).
+		Select("activity_id, COUNT(*) AS count").
+		Where("status = ? AND cancel_time >= ? AND cancel_time < ?", RegistrationStatusCanceled, start, end))
+}
+
+// CountCheckInsBetween 统计时间段内各活动的签到数
+func (m *ActivityAnalyticsModel) CountCheckInsBetween(ctx context.Context, start, end int64) (map[uint64]int64, error) {
+	return groupCount(m.db.WithContext(ctx).
+		Model(&CheckInRecord{}).
+		Select("activity_id, COUNT(*) AS count").
+		Where("check_in_time >= ? AND check_in_time < ?", start, end))
+}
+
+// ListActivityIDsEndedBetween 查询时间段内结束的活动（结束后需要计算未到场人数）
+func (m *ActivityAnalyticsModel) ListActivityIDsEndedBetween(ctx context.Context, start, end int64) ([]uint64, error) {
+	var ids []uint64
+	err := m.db.WithContext(ctx).
+		Model(&Activity{}).
+		Where("activity_end_time >= ? AND activity_end_time < ?", start, end).
+		Pluck("id", &ids).Error
+	return ids, err
+}
+
+// CountActivityRegistrations 统计活动的有效报名数与已取消报名数
+func (m *ActivityAnalyticsModel) CountActivityRegistrations(ctx context.Context, activityID uint64) (success, canceled int64, err error) {
+	var rows []struct {
+		Status int8
+		Count  int64
+	}
+	err = m.db.WithContext(ctx).
+		Model(&ActivityRegistration{}).
+		Select("status, COUNT(*) AS count").
+		Where("activity_id = ?", activityID).
+		Group("status").
+		Find(&rows).Error
+	if err != nil {
+		return 0, 0, err
+	}
+	for _, r := range rows {
+		switch r.Status {
+		case RegistrationStatusSuccess:
+			success = r.Count
+		case RegistrationStatusCanceled:
+			canceled = r.Count
+		}
+	}
+	return success, canceled, nil
+}
+
+// CountActivityRegistrationsWithin 统计活动在时间段内的报名数（用于开放报名后 24 小时报名数）
+func (m *ActivityAnalyticsModel) CountActivityRegistrationsWithin(ctx context.Context, activityID uint64, start, end int64) (int64, error) {
+	var count int64
+	err := m.db.WithContext(ctx).
+		Model(&ActivityRegistration{}).
+		Where("activity_id = ? AND created_at >= ? AND created_at < ?", activityID, start, end).
+		Where("status IN ?", []int8{RegistrationStatusSuccess, RegistrationStatusCanceled}).
+		Count(&count).Error
+	return count, err
+}
+
+// CountActivityCheckedInUsers 统计活动的签到人数（按用户去重）
+func (m *ActivityAnalyticsModel) CountActivityCheckedInUsers(ctx context.Context, activityID uint64) (int64, error) {
+	var count int64
+	err := m.db.WithContext(ctx).
+		Model(&CheckInRecord{}).
+		Where("activity_id = ?", activityID).
+		Distinct("user_id").
+		Count(&count).Error
+	return count, err
+}
+
+// ==================== 聚合任务：写入汇总表 ====================
+
+// UpsertDaily 写入活动每日统计（按活动+日期覆盖）
+func (m *ActivityAnalyticsModel) UpsertDaily(ctx context.Context, stats *ActivityDailyStats) error {
+	return m.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "activity_id"}, {Name: "stat_date"}},
+			DoUpdates: clause.AssignmentColumns([]string{"organizer_id", "pv", "uv", "registrations", "cancellations", "check_ins", "updated_at"}),
+		}).
+		Create(stats).Error
+}
+
+// SumDaily 汇总活动的每日统计
+func (m *ActivityAnalyticsModel) SumDaily(ctx context.Context, activityID uint64) (*DailyTotals, error) {
+	var totals DailyTotals
+	err := m.db.WithContext(ctx).
+		Model(&ActivityDailyStats{}).
+		Select("COALESCE(SUM(pv), 0) AS pv, COALESCE(SUM(registrations), 0) AS registrations, "+
+			"COALESCE(SUM(cancellations), 0) AS cancellations, COALESCE(MAX(registrations), 0) AS peak_daily_registrations").
+		Where("activity_id = ?", activityID).
+		Scan(&totals).Error
+	return &totals, err
+}
+
+// UpsertSummary 写入活动累计统计
+func (m *ActivityAnalyticsModel) UpsertSummary(ctx context.Context, summary *ActivityStatsSummary) error {
+	return m.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "activity_id"}},
+			UpdateAll: true,
+		}).
+		Create(summary).Error
+}
+
+// ReplaceAudience 覆盖活动的报名者构成统计
+func (m *ActivityAnalyticsModel) ReplaceAudience(ctx context.Context, activityID uint64, rows []ActivityAudienceStats) error {
+	return m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("activity_id = ?", activityID).Delete(&ActivityAudienceStats{}).Error; err != nil {
+			return err
+		}
+		if len(rows) == 0 {
+			return nil
+		}
+		return tx.CreateInBatches(rows, 200).Error
+	})
+}
+
+// ==================== 看板查询 ====================
+
+// FindSummary 查询活动累计统计
+func (m *ActivityAnalyticsModel) FindSummary(ctx context.Context, activityID uint64) (*ActivityStatsSummary, error) {
+	var summary ActivityStatsSummary
+	err := m.db.WithContext(ctx).Where("activity_id = ?", activityID).First(&summary).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrStatsSummaryNotFound
+		}
+		return nil, err
+	}
+	return &summary, nil
+}
+
+// ListDaily 查询活动在日期范围内的每日统计（日期正序）
+func (m *ActivityAnalyticsModel) ListDaily(ctx context.Context, activityID uint64, startDate, endDate string) ([]ActivityDailyStats, error) {
+	var list []ActivityDailyStats
+	err := m.db.WithContext(ctx).
+		Where("activity_id = ? AND stat_date >= ? AND stat_date <= ?", activityID, startDate, endDate).
+		Order("stat_date ASC").
+		Find(&list).Error
+	return list, err
+}
+
+// ListAudience 查询活动的报名者构成（按人数倒序）
+func (m *ActivityAnalyticsModel) ListAudience(ctx context.Context, activityID uint64) ([]ActivityAudienceStats, error) {
+	var list []ActivityAudienceStats
+	err := m.db.WithContext(ctx).
+		Where("activity_id = ?", activityID).
+		Order("dimension ASC, count DESC").
+		Find(&list).Error
+	return list, err
+}
+
+// SumSummariesByOrganizer 汇总组织者全部活动的累计指标
+func (m *ActivityAnalyticsModel) SumSummariesByOrganizer(ctx context.Context, organizerID uint64) (*OrganizerTotals, error) {
+	var totals OrganizerTotals
+	err := m.db.WithContext(ctx).
+		Model(&ActivityStatsSummary{}).
+		Select("COUNT(*) AS activities, COALESCE(SUM(pv), 0) AS pv, COALESCE(SUM(uv), 0) AS uv, "+
+			"COALESCE(SUM(registrations), 0) AS registrations, COALESCE(SUM(cancellations), 0) AS cancellations, "+
+			"COALESCE(SUM(active_registrations), 0) AS active_registrations, COALESCE(SUM(check_ins), 0) AS check_ins, "+
+			"COALESCE(SUM(no_shows), 0) AS no_shows, COALESCE(SUM(verified_registrants), 0) AS verified_registrants").
+		Where("organizer_id = ?", organizerID).
+		Scan(&totals).Error
+	return &totals, err
+}
+
+// ListSummariesByOrganizer 查询组织者报名数最多的活动
+func (m *ActivityAnalyticsModel) ListSummariesByOrganizer(ctx context.Context, organizerID uint64, limit int) ([]ActivityStatsSummary, error) {
+	var list []ActivityStatsSummary
+	err := m.db.WithContext(ctx).
+		Where("organizer_id = ?", organizerID).
+		Order("registrations DESC, activity_id DESC").
+		Limit(limit).
+		Find(&list).Error
+	return list, err
+}
+
+// ListOrganizerDaily 按日期汇总组织者全部活动的每日统计（日期正序）
+func (m *ActivityAnalyticsModel) ListOrganizerDaily(ctx context.Context, organizerID uint64, startDate, endDate string) ([]ActivityDailyStats, error) {
+	var list []ActivityDailyStats
+	err := m.db.WithContext(ctx).
+		Model(&ActivityDailyStats{}).
+		Select("stat_date, SUM(pv) AS pv, SUM(uv) AS uv, SUM(registrations) AS registrations, "+
+			"SUM(cancellations) AS cancellations, SUM(check_ins) AS check_ins").
+		Where("organizer_id = ? AND stat_date >= ? AND stat_date <= ?", organizerID, startDate, endDate).
+		Group("stat_date").
+		Order("stat_date ASC").
+		Find(&list).Error
+	return list, err
+}
+
+// ListOrganizerAudience 汇总组织者全部活动的报名者构成（按人数倒序）
+func (m *ActivityAnalyticsModel) ListOrganizerAudience(ctx context.Context, organizerID uint64) ([]ActivityAudienceStats, error) {
+	var list []ActivityAudienceStats
+	err := m.db.WithContext(ctx).
+		Model(&ActivityAudienceStats{}).
+		Select("dimension, value, SUM(count) AS count").
+		Where("organizer_id = ?", organizerID).
+		Group("dimension, value").
+		Order("dimension ASC, count DESC").
+		Find(&list).Error
+	return list, err
+}
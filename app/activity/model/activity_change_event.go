@@ -0,0 +1,140 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// ==================== 活动变更事件（Outbox） ====================
+//
+// 设计说明：
+//   - 任何修改 activities 表的写入方，在同一事务内插入一条变更事件
+//   - 事件只记录「哪个活动变了」，消费方按 activity_id 回查最新数据，天然幂等
+//   - 消费成功后删除事件；失败累加 retry_count 并按次数推迟 next_retry_at（只推迟该事件，不影响其他活动），
+//     超过上限后保留在表中供人工排查
+
+// 变更类型
+const (
+	ChangeTypeCreate   = "create"   // 创建
+	ChangeTypeUpdate   = "update"   // 编辑
+	ChangeTypeStatus   = "status"   // 状态变更（提交/取消/自动流转）
+	ChangeTypeDelete   = "delete"   // 删除
+	ChangeTypeRestore  = "restore"  // 恢复（DTM 补偿）
+	ChangeTypeCover    = "cover"    // 封面更新
+	ChangeTypeRating   = "rating"   // 评价汇总更新
+	ChangeTypeTaxonomy = "taxonomy" // 分类/标签变更（重命名、合并、禁用）
+
+	ChangeTypeParticipants = "participants" // 报名人数变更（报名/取消报名）
+)
+
+// 写入方
+const (
+	ChangeSourceActivityRpc = "activity-rpc"
+	ChangeSourceUserRpc     = "user-rpc"
+	ChangeSourceStatusCron  = "status-cron"
+)
+
+// ActivityChangeEvent 活动变更事件
+type ActivityChangeEvent struct {
+	ID          uint64 `gorm:"primaryKey;autoIncrement"                    json:"id"`
+	ActivityID  uint64 `gorm:"index:idx_activity_id;not null;comment:活动ID" json:"activity_id"`
+	ChangeType  string `gorm:"type:varchar(32);not null;comment:变更类型"      json:"change_type"`
+	Source      string `gorm:"type:varchar(32);default:'';comment:写入方"     json:"source"`
+	RetryCount  uint32 `gorm:"default:0;comment:消费失败次数"                    json:"retry_count"`
+	NextRetryAt int64  `gorm:"default:0;comment:下次允许消费的时间（失败退避）"            json:"next_retry_at"`
+	LastError   string `gorm:"type:varchar(500);default:'';comment:最近一次消费失败原因" json:"last_error"`
+	CreatedAt   int64  `gorm:"autoCreateTime"                             json:"created_at"`
+}
+
+func (ActivityChangeEvent) TableName() string {
+	return "activity_change_events"
+}
+
+// insertChangeEventSQL 原生 SQL 插入（DTM 分支使用 *sql.Tx）
+const insertChangeEventSQL = `
+	INSERT INTO activity_change_events (activity_id, change_type, source, created_at)
+	VALUES (?, ?, ?, ?)
+`
+
+// ==================== ActivityChangeEventModel 数据访问层
+
+type ActivityChangeEventModel struct {
+	db *gorm.DB
+}
+
+func NewActivityChangeEventModel(db *gorm.DB) *ActivityChangeEventModel {
+	return &ActivityChangeEventModel{db: db}
+}
+
+// Record 记录单个活动变更（应在修改 activities 的同一事务内调用）
+func (m *ActivityChangeEventModel) Record(ctx context.Context, tx *gorm.DB, activityID uint64, changeType, source string) error {
+	return m.RecordBatch(ctx, tx, []uint64{activityID}, changeType, source)
+}
+
+// RecordBatch 批量记录活动变更
+func (m *ActivityChangeEventModel) RecordBatch(ctx context.Context, tx *gorm.DB, activityIDs []uint64, changeType, source string) error {
+	if len(activityIDs) == 0 {
+		return nil
+	}
+	if tx == nil {
+		tx = m.db
+	}
+
+	events := make([]ActivityChangeEvent, 0, len(activityIDs))
+	for _, id := range activityIDs {
+		events = append(events, ActivityChangeEvent{
+			ActivityID: id,
+			ChangeType: changeType,
+			Source:     source,
+		})
+	}
+	return tx.WithContext(ctx).Create(&events).Error
+}
+
+// RecordChangeEventTx 在原生 SQL 事务内记录活动变更（DTM barrier 场景）
+func RecordChangeEventTx(ctx context.Context, tx *sql.Tx, activityID uint64, changeType, source string) error {
+	_, err := tx.ExecContext(ctx, insertChangeEventSQL, activityID, changeType, source, time.Now().Unix())
+	return err
+}
+
+// FetchPending 按 ID 顺序获取待消费事件（跳过超过重试上限、以及仍在退避中的事件）
+func (m *ActivityChangeEventModel) FetchPending(ctx context.Context, maxRetry uint32, now int64, limit int) ([]ActivityChangeEvent, error) {
+	var events []ActivityChangeEvent
+	err := m.db.WithContext(ctx).
+		Where("retry_count < ? AND next_retry_at <= ?", maxRetry, now).
+		Order("id ASC").
+		Limit(limit).
+		Find(&events).Error
+	return events, err
+}
+
+// DeleteByIDs 删除已消费的事件
+func (m *ActivityChangeEventModel) DeleteByIDs(ctx context.Context, ids []uint64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return m.db.WithContext(ctx).
+		Where("id IN ?", ids).
+		Delete(&ActivityChangeEvent{}).Error
+}
+
+// MarkFailed 记录消费失败（retry_count + 1，nextRetryAt 之前不再消费）
+func (m *ActivityChangeEventModel) MarkFailed(ctx context.Context, ids []uint64, reason string, nextRetryAt int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	if len([]rune(reason)) > 500 {
+		reason = string([]rune(reason)[:500])
+	}
+	return m.db.WithContext(ctx).
+		Model(&ActivityChangeEvent{}).
+		Where("id IN ?", ids).
+		Updates(map[string]interface{}{
+			"retry_count":   gorm.Expr("retry_count + 1"),
+			"next_retry_at": nextRetryAt,
+			"last_error":    reason,
+		}).Error
+}
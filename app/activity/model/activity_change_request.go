@@ -0,0 +1,257 @@
+package model
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+// ==================== 变更申请状态 ====================
+
+const (
+	ChangeRequestStatusPending  int8 = 0 // 待审核
+	ChangeRequestStatusApplied  int8 = 1 // 审核通过并已生效
+	ChangeRequestStatusRejected int8 = 2 // 审核驳回
+)
+
+// ChangeRequestStatusText 变更申请状态文本
+var ChangeRequestStatusText = map[int8]string{
+	ChangeRequestStatusPending:  "待审核",
+	ChangeRequestStatusApplied:  "已生效",
+	ChangeRequestStatusRejected: "已驳回",
+}
+
+var (
+	ErrChangeRequestNotFound = errors.New("变更申请不存在")
+)
+
+// ==================== ActivityChangeRequest 变更申请模型 ====================
+
+// ActivityChangeRequest 已发布活动的时间/地点变更申请
+//
+// 已发布活动不能直接修改时间与地点，组织者提交申请后由管理员重新审核；
+// 生效后报名者获得一段无责取消窗口（FreeCancelUntil）
+type ActivityChangeRequest struct {
+	ID              uint64 `gorm:"primaryKey;autoIncrement"                                        json:"id"`
+	ActivityID      uint64 `gorm:"index:idx_activity_status,priority:1;not null;comment:活动ID"   json:"activity_id"`
+	OrganizerID     uint64 `gorm:"not null;comment:组织者ID"                                        json:"organizer_id"`
+	Status          int8   `gorm:"index:idx_activity_status,priority:2;default:0;comment:状态"    json:"status"`
+	Reason          string `gorm:"type:varchar(500);not null;comment:变更原因"                       json:"reason"`
+	OldValues       string `gorm:"type:text;comment:变更前字段（JSON）"                                json:"-"`
+	NewValues       string `gorm:"type:text;comment:变更后字段（JSON）"                                json:"-"`
+	ReviewerID      uint64 `gorm:"default:0;comment:审核人ID"                                       json:"reviewer_id"`
+	RejectReason    string `gorm:"type:varchar(500);default:'';comment:驳回原因"                    json:"reject_reason"`
+	DecidedAt       int64  `gorm:"default:0;comment:审核时间"                                        json:"decided_at"`
+	FreeCancelUntil int64  `gorm:"default:0;comment:无责取消截止时间"                                  json:"free_cancel_until"`
+	CreatedAt       int64  `gorm:"autoCreateTime;index"                                            json:"created_at"`
+	UpdatedAt       int64  `gorm:"autoUpdateTime"                                                  json:"updated_at"`
+}
+
+func (ActivityChangeRequest) TableName() string {
+	return "activity_change_requests"
+}
+
+// Changes 变更字段明细（按固定顺序）
+func (r *ActivityChangeRequest) Changes() []ReviewFieldChange {
+	oldFields, _ := DecodeActivityChangeFields(r.OldValues)
+	newFields, _ := DecodeActivityChangeFields(r.NewValues)
+	return DiffActivityChangeFields(oldFields, newFields)
+}
+
+// ==================== 可变更字段 ====================
+
+// ActivityChangeFields 变更申请涉及的字段
+type ActivityChangeFields struct {
+	RegisterEndTime   int64   `json:"register_end_time"`
+	ActivityStartTime int64   `json:"activity_start_time"`
+	ActivityEndTime   int64   `json:"activity_end_time"`
+	Location          string  `json:"location"`
+	AddressDetail     string  `json:"address_detail"`
+	Longitude         float64 `json:"longitude"`
+	Latitude          float64 `json:"latitude"`
+}
+
+// NewActivityChangeFields 取活动当前的可变更字段
+func NewActivityChangeFields(act *Activity) ActivityChangeFields {
+	return ActivityChangeFields{
+		RegisterEndTime:   act.RegisterEndTime,
+		ActivityStartTime: act.ActivityStartTime,
+		ActivityEndTime:   act.ActivityEndTime,
+		Location:          act.Location,
+		AddressDetail:     act.AddressDetail,
+		Longitude:         act.Longitude,
+		Latitude:          act.Latitude,
+	}
+}
+
+// Encode 序列化
+func (f ActivityChangeFields) Encode() string {
+	data, err := json.Marshal(f)
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
+
+// Updates 生成活动表更新字段
+func (f ActivityChangeFields) Updates() map[string]interface{} {
+	return map[string]interface{}{
+		"register_end_time":   f.RegisterEndTime,
+		"activity_start_time": f.ActivityStartTime,
+		"activity_end_time":   f.ActivityEndTime,
+		"location":            f.Location,
+		"address_detail":      f.AddressDetail,
+		"longitude":           f.Longitude,
+		"latitude":            f.Latitude,
+	}
+}
+
+// DecodeActivityChangeFields 反序列化（空字符串返回 false）
+func DecodeActivityChangeFields(raw string) (ActivityChangeFields, bool) {
+	var f ActivityChangeFields
+	if raw == "" {
+		return f, false
+	}
+	if err := json.Unmarshal([]byte(raw), &f); err != nil {
+		return f, false
+	}
+	return f, true
+}
+
+// DiffActivityChangeFields 对比变更前后字段，返回发生变化的字段
+func DiffActivityChangeFields(base, current ActivityChangeFields) []ReviewFieldChange {
+	var changes []ReviewFieldChange
+	add := func(field, label, oldValue, newValue string) {
+		if oldValue != newValue {
+			changes = append(changes, ReviewFieldChange{Field: field, Label: label, OldValue: oldValue, NewValue: newValue})
+		}
+	}
+	add("activity_start_time", "活动开始时间", formatReviewTime(base.ActivityStartTime), formatReviewTime(current.ActivityStartTime))
+	add("activity_end_time", "活动结束时间", formatReviewTime(base.ActivityEndTime), formatReviewTime(current.ActivityEndTime))
+	add("register_end_time", "报名截止时间", formatReviewTime(base.RegisterEndTime), formatReviewTime(current.RegisterEndTime))
+	add("location", "活动地点", base.Location, current.Location)
+	add("address_detail", "详细地址", base.AddressDetail, current.AddressDetail)
+	add("coordinate", "地图坐标", formatCoordinate(base.Longitude, base.Latitude), formatCoordinate(current.Longitude, current.Latitude))
+	return changes
+}
+
+// formatCoordinate 经纬度格式化（差异展示用）
+func formatCoordinate(lng, lat float64) string {
+	if lng == 0 && lat == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%.6f,%.6f", lng, lat)
+}
+
+// ==================== ActivityChangeRequestModel 数据访问层 ====================
+
+type ActivityChangeRequestModel struct {
+	db *gorm.DB
+}
+
+func NewActivityChangeRequestModel(db *gorm.DB) *ActivityChangeRequestModel {
+	return &ActivityChangeRequestModel{db: db}
+}
+
+// Create 创建变更申请
+func (m *ActivityChangeRequestModel) Create(ctx context.Context, req *ActivityChangeRequest) error {
+	return m.db.WithContext(ctx).Create(req).Error
+}
+
+// FindByID 根据ID查询
+func (m *ActivityChangeRequestModel) FindByID(ctx context.Context, id uint64) (*ActivityChangeRequest, error) {
+	var req ActivityChangeRequest
+	err := m.db.WithContext(ctx).Where("id = ?", id).First(&req).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrChangeRequestNotFound
+		}
+		return nil, err
+	}
+	return &req, nil
+}
+
+// ExistsPending 活动是否有待审核的变更申请
+func (m *ActivityChangeRequestModel) ExistsPending(ctx context.Context, activityID uint64) (bool, error) {
+	var count int64
+	err := m.db.WithContext(ctx).
+		Model(&ActivityChangeRequest{}).
+		Where("activity_id = ? AND status = ?", activityID, ChangeRequestStatusPending).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// ChangeRequestQuery 变更申请列表查询条件
+type ChangeRequestQuery struct {
+	ActivityID uint64 // 0=不限
+	Status     int8   // -1=全部
+	Page       int
+	PageSize   int
+}
+
+// List 分页查询变更申请（按ID倒序）
+func (m *ActivityChangeRequestModel) List(ctx context.Context, query *ChangeRequestQuery) ([]ActivityChangeRequest, int64, error) {
+	db := m.db.WithContext(ctx).Model(&ActivityChangeRequest{})
+	if query.ActivityID > 0 {
+		db = db.Where("activity_id = ?", query.ActivityID)
+	}
+	if query.Status >= 0 {
+		db = db.Where("status = ?", query.Status)
+	}
+
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var list []ActivityChangeRequest
+	if total == 0 {
+		return list, 0, nil
+	}
+	err := db.Order("id DESC").
+		Offset((query.Page - 1) * query.PageSize).
+		Limit(query.PageSize).
+		Find(&list).Error
+	return list, total, err
+}
+
+// Decide 记录审核结论（在事务内使用，仅待审核的申请可处理）
+func (m *ActivityChangeRequestModel) Decide(ctx context.Context, tx *gorm.DB, id uint64, status int8,
+	reviewerID uint64, rejectReason string, decidedAt, freeCancelUntil int64) error {
+	result := tx.WithContext(ctx).
+		Model(&ActivityChangeRequest{}).
+		Where("id = ? AND status = ?", id, ChangeRequestStatusPending).
+		Updates(map[string]interface{}{
+			"status":            status,
+			"reviewer_id":       reviewerID,
+			"reject_reason":     rejectReason,
+			"decided_at":        decidedAt,
+			"free_cancel_until": freeCancelUntil,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrActivityConcurrentUpdate
+	}
+	return nil
+}
+
+// FindActiveFreeCancel 查询活动当前有效的无责取消窗口（最近一次生效且未过期的变更）
+func (m *ActivityChangeRequestModel) FindActiveFreeCancel(ctx context.Context, activityID uint64, now int64) (*ActivityChangeRequest, error) {
+	var req ActivityChangeRequest
+	err := m.db.WithContext(ctx).
+		Where("activity_id = ? AND status = ? AND free_cancel_until >= ?", activityID, ChangeRequestStatusApplied, now).
+		Order("id DESC").
+		First(&req).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrChangeRequestNotFound
+		}
+		return nil, err
+	}
+	return &req, nil
+}
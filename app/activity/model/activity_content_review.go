@@ -0,0 +1,190 @@
+package model
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// ==================== 活动内容审核记录 ====================
+
+// 检测场景
+const (
+	ContentSceneCreate   = "create"   // 创建活动
+	ContentSceneUpdate   = "update"   // 编辑活动
+	ContentSceneSubmit   = "submit"   // 草稿提交发布
+	ContentSceneFeedback = "feedback" // 活动评价短评
+)
+
+// 审核状态
+const (
+	ContentReviewPending  int8 = 0 // 待审核（命中送审词）
+	ContentReviewPassed   int8 = 1 // 审核通过
+	ContentReviewRejected int8 = 2 // 审核驳回
+	ContentReviewAuto     int8 = 3 // 已自动处置（替换 / 拦截）
+)
+
+// maxContentReviewHits 单条记录保留的命中明细上限（防止超出列长度）
+const maxContentReviewHits = 20
+
+var (
+	ErrContentReviewNotFound = errors.New("审核记录不存在")
+)
+
+// ContentHit 命中明细
+type ContentHit struct {
+	Field    string `json:"field"`    // 命中字段: title/content
+	Word     string `json:"word"`     // 命中词条
+	Category string `json:"category"` // 词条分类
+	Action   string `json:"action"`   // 分类动作
+}
+
+// ActivityContentReview 活动内容审核记录
+type ActivityContentReview struct {
+	ID          uint64 `gorm:"primaryKey;autoIncrement"                       json:"id"`
+	ActivityID  uint64 `gorm:"index:idx_activity_id;default:0;comment:活动ID" json:"activity_id"`
+	OrganizerID uint64 `gorm:"not null;comment:组织者ID"                       json:"organizer_id"`
+	FeedbackID  uint64 `gorm:"default:0;comment:评价ID（仅评价短评场景）"        json:"feedback_id"`
+	Scene       string `gorm:"type:varchar(20);not null;comment:检测场景"       json:"scene"`
+	Action      string `gorm:"type:varchar(10);not null;comment:处置动作"       json:"action"`
+	Title       string `gorm:"type:varchar(100);default:'';comment:标题原文"    json:"title"`
+	HitsJSON    string `gorm:"column:hits;type:varchar(2000);default:''"     json:"-"`
+	Status      int8   `gorm:"default:0;comment:审核状态"                       json:"status"`
+	ReviewerID  uint64 `gorm:"default:0;comment:审核人ID"                      json:"reviewer_id"`
+	ReviewNote  string `gorm:"type:varchar(500);default:'';comment:审核备注"    json:"review_note"`
+	ReviewedAt  int64  `gorm:"default:0;comment:审核时间"                       json:"reviewed_at"`
+	CreatedAt   int64  `gorm:"autoCreateTime"                                json:"created_at"`
+
+	Hits []ContentHit `gorm:"-" json:"hits"` // 解析后的命中明细
+}
+
+func (ActivityContentReview) TableName() string {
+	return "activity_content_reviews"
+}
+
+// BeforeSave 序列化命中明细
+func (r *ActivityContentReview) BeforeSave(_ *gorm.DB) error {
+	hits := r.Hits
+	if len(hits) > maxContentReviewHits {
+		hits = hits[:maxContentReviewHits]
+	}
+	data, err := json.Marshal(hits)
+	if err != nil {
+		return err
+	}
+	r.HitsJSON = string(data)
+	return nil
+}
+
+// AfterFind 反序列化命中明细
+func (r *ActivityContentReview) AfterFind(_ *gorm.DB) error {
+	if r.HitsJSON == "" {
+		r.Hits = nil
+		return nil
+	}
+	return json.Unmarshal([]byte(r.HitsJSON), &r.Hits)
+}
+
+// ==================== ActivityContentReviewModel 数据访问层
+
+type ActivityContentReviewModel struct {
+	db *gorm.DB
+}
+
+func NewActivityContentReviewModel(db *gorm.DB) *ActivityContentReviewModel {
+	return &ActivityContentReviewModel{db: db}
+}
+
+// Create 写入审核记录
+func (m *ActivityContentReviewModel) Create(ctx context.Context, review *ActivityContentReview) error {
+	return m.db.WithContext(ctx).Create(review).Error
+}
+
+// FindByID 按 ID 查询审核记录
+func (m *ActivityContentReviewModel) FindByID(ctx context.Context, id uint64) (*ActivityContentReview, error) {
+	var review ActivityContentReview
+	err := m.db.WithContext(ctx).Where("id = ?", id).First(&review).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrContentReviewNotFound
+		}
+		return nil, err
+	}
+	return &review, nil
+}
+
+// ListByStatus 按检测场景与审核状态分页查询（按 ID 倒序）
+func (m *ActivityContentReviewModel) ListByStatus(ctx context.Context, scene string, status int8, page, pageSize int) ([]ActivityContentReview, int64, error) {
+	var (
+		reviews []ActivityContentReview
+		total   int64
+	)
+	query := m.db.WithContext(ctx).Model(&ActivityContentReview{}).Where("scene = ? AND status = ?", scene, status)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	err := query.Order("id DESC").
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		Find(&reviews).Error
+	return reviews, total, err
+}
+
+// FindByActivityID 查询活动的全部审核记录
+func (m *ActivityContentReviewModel) FindByActivityID(ctx context.Context, activityID uint64) ([]ActivityContentReview, error) {
+	var reviews []ActivityContentReview
+	err := m.db.WithContext(ctx).
+		Where("activity_id = ?", activityID).
+		Order("id DESC").
+		Find(&reviews).Error
+	return reviews, err
+}
+
+// Resolve 处理单条待审核记录，返回是否处理成功（已被处理时返回 false），在事务内调用
+func (m *ActivityContentReviewModel) Resolve(ctx context.Context, tx *gorm.DB, id uint64, status int8, reviewerID uint64, note string) (bool, error) {
+	if tx == nil {
+		tx = m.db
+	}
+	result := tx.WithContext(ctx).
+		Model(&ActivityContentReview{}).
+		Where("id = ? AND status = ?", id, ContentReviewPending).
+		Updates(map[string]interface{}{
+			"status":      status,
+			"reviewer_id": reviewerID,
+			"review_note": note,
+			"reviewed_at": time.Now().Unix(),
+		})
+	return result.RowsAffected > 0, result.Error
+}
+
+// HasNewerFeedbackReview 评价是否有更新的审核记录（用户重新提交评价后旧记录不再对应当前短评）
+func (m *ActivityContentReviewModel) HasNewerFeedbackReview(ctx context.Context, tx *gorm.DB, feedbackID, reviewID uint64) (bool, error) {
+	if tx == nil {
+		tx = m.db
+	}
+	var count int64
+	err := tx.WithContext(ctx).
+		Model(&ActivityContentReview{}).
+		Where("feedback_id = ? AND id > ?", feedbackID, reviewID).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// ResolvePendingByActivity 处理活动内容的待审核记录（随活动发布审核一并处理；评价短评由管理员单独审核，见 Resolve）
+func (m *ActivityContentReviewModel) ResolvePendingByActivity(ctx context.Context, tx *gorm.DB, activityID uint64, status int8, reviewerID uint64, note string) error {
+	if tx == nil {
+		tx = m.db
+	}
+	return tx.WithContext(ctx).
+		Model(&ActivityContentReview{}).
+		Where("activity_id = ? AND feedback_id = 0 AND status = ?", activityID, ContentReviewPending).
+		Updates(map[string]interface{}{
+			"status":      status,
+			"reviewer_id": reviewerID,
+			"review_note": note,
+			"reviewed_at": time.Now().Unix(),
+		}).Error
+}
@@ -0,0 +1,173 @@
+package model
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// ==================== 活动报名资格规则 ====================
+//
+// 组织者为活动声明报名条件，报名时由规则引擎逐条校验：
+//   - 规则之间为 AND：全部通过才能报名
+//   - 同一规则的取值之间为 OR：如 school 规则允许多所学校
+
+// 规则类型
+const (
+	RuleTypeMinCredit     = "min_credit"     // 最低信用分（min_value）
+	RuleTypeVerifiedOnly  = "verified_only"  // 仅限已完成学生认证
+	RuleTypeSchool        = "school"         // 限定学校（values）
+	RuleTypeDepartment    = "department"     // 限定院系（values）
+	RuleTypeAdmissionYear = "admission_year" // 入学年份区间（min_value ~ max_value，0=不限）
+)
+
+// 规则数量与取值限制
+const (
+	MaxEligibilityRules      = 10  // 每个活动最多规则数
+	MaxEligibilityRuleValues = 20  // 每条规则最多取值数
+	MaxEligibilityValueLen   = 50  // 单个取值最大长度（字符）
+	maxCreditScore           = 100 // 信用分上限
+	minAdmissionYear         = 1950
+	maxAdmissionYear         = 2100
+)
+
+var (
+	ErrEligibilityRuleInvalid = errors.New("报名资格规则无效")
+)
+
+// ActivityEligibilityRule 活动报名资格规则
+type ActivityEligibilityRule struct {
+	ID         uint64 `gorm:"primaryKey;autoIncrement"                     json:"id"`
+	ActivityID uint64 `gorm:"index:idx_activity_id;not null;comment:活动ID" json:"activity_id"`
+	RuleType   string `gorm:"type:varchar(32);not null;comment:规则类型"       json:"rule_type"`
+	MinValue   int    `gorm:"default:0;comment:下限"                          json:"min_value"`
+	MaxValue   int    `gorm:"default:0;comment:上限"                          json:"max_value"`
+	RuleValues string `gorm:"type:varchar(1000);default:'';comment:取值列表"    json:"-"`
+	CreatedAt  int64  `gorm:"autoCreateTime"                               json:"created_at"`
+
+	Values []string `gorm:"-" json:"values"` // 解析后的取值列表
+}
+
+func (ActivityEligibilityRule) TableName() string {
+	return "activity_eligibility_rules"
+}
+
+// BeforeSave 序列化取值列表
+func (r *ActivityEligibilityRule) BeforeSave(_ *gorm.DB) error {
+	if len(r.Values) == 0 {
+		r.RuleValues = ""
+		return nil
+	}
+	data, err := json.Marshal(r.Values)
+	if err != nil {
+		return err
+	}
+	r.RuleValues = string(data)
+	return nil
+}
+
+// AfterFind 反序列化取值列表
+func (r *ActivityEligibilityRule) AfterFind(_ *gorm.DB) error {
+	if r.RuleValues == "" {
+		r.Values = nil
+		return nil
+	}
+	return json.Unmarshal([]byte(r.RuleValues), &r.Values)
+}
+
+// Normalize 校验并规范化规则（去空白、去重）
+func (r *ActivityEligibilityRule) Normalize() error {
+	switch r.RuleType {
+	case RuleTypeMinCredit:
+		if r.MinValue <= 0 || r.MinValue > maxCreditScore {
+			return fmt.Errorf("%w: 最低信用分需在 1-%d 之间", ErrEligibilityRuleInvalid, maxCreditScore)
+		}
+		r.MaxValue, r.Values = 0, nil
+
+	case RuleTypeVerifiedOnly:
+		r.MinValue, r.MaxValue, r.Values = 0, 0, nil
+
+	case RuleTypeSchool, RuleTypeDepartment:
+		values := make([]string, 0, len(r.Values))
+		seen := make(map[string]struct{}, len(r.Values))
+		for _, v := range r.Values {
+			v = strings.TrimSpace(v)
+			if v == "" {
+				continue
+			}
+			if len([]rune(v)) > MaxEligibilityValueLen {
+				return fmt.Errorf("%w: 名称不能超过%d个字符", ErrEligibilityRuleInvalid, MaxEligibilityValueLen)
+			}
+			if _, ok := seen[v]; ok {
+				continue
+			}
+			seen[v] = struct{}{}
+			values = append(values, v)
+		}
+		if len(values) == 0 {
+			return fmt.Errorf("%w: 学校/院系规则至少需要一个取值", ErrEligibilityRuleInvalid)
+		}
+		if len(values) > MaxEligibilityRuleValues {
+			return fmt.Errorf("%w: 每条规则最多%d个取值", ErrEligibilityRuleInvalid, MaxEligibilityRuleValues)
+		}
+		r.MinValue, r.MaxValue, r.Values = 0, 0, values
+
+	case RuleTypeAdmissionYear:
+		if r.MinValue == 0 && r.MaxValue == 0 {
+			return fmt.Errorf("%w: 入学年份至少需要设置上限或下限", ErrEligibilityRuleInvalid)
+		}
+		if (r.MinValue != 0 && (r.MinValue < minAdmissionYear || r.MinValue > maxAdmissionYear)) ||
+			(r.MaxValue != 0 && (r.MaxValue < minAdmissionYear || r.MaxValue > maxAdmissionYear)) {
+			return fmt.Errorf("%w: 入学年份需在 %d-%d 之间", ErrEligibilityRuleInvalid, minAdmissionYear, maxAdmissionYear)
+		}
+		if r.MinValue != 0 && r.MaxValue != 0 && r.MinValue > r.MaxValue {
+			return fmt.Errorf("%w: 入学年份下限不能大于上限", ErrEligibilityRuleInvalid)
+		}
+		r.Values = nil
+
+	default:
+		return fmt.Errorf("%w: 不支持的规则类型 %q", ErrEligibilityRuleInvalid, r.RuleType)
+	}
+	return nil
+}
+
+// ==================== ActivityEligibilityRuleModel 数据访问层
+
+type ActivityEligibilityRuleModel struct {
+	db *gorm.DB
+}
+
+func NewActivityEligibilityRuleModel(db *gorm.DB) *ActivityEligibilityRuleModel {
+	return &ActivityEligibilityRuleModel{db: db}
+}
+
+// FindByActivityID 查询活动的全部规则
+func (m *ActivityEligibilityRuleModel) FindByActivityID(ctx context.Context, activityID uint64) ([]ActivityEligibilityRule, error) {
+	var rules []ActivityEligibilityRule
+	err := m.db.WithContext(ctx).
+		Where("activity_id = ?", activityID).
+		Order("id ASC").
+		Find(&rules).Error
+	return rules, err
+}
+
+// ReplaceByActivityID 覆盖活动的全部规则（事务内先删后插）
+func (m *ActivityEligibilityRuleModel) ReplaceByActivityID(ctx context.Context, activityID uint64, rules []ActivityEligibilityRule) error {
+	return m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("activity_id = ?", activityID).Delete(&ActivityEligibilityRule{}).Error; err != nil {
+			return err
+		}
+		if len(rules) == 0 {
+			return nil
+		}
+		for i := range rules {
+			rules[i].ID = 0
+			rules[i].ActivityID = activityID
+		}
+		return tx.Create(&rules).Error
+	})
+}
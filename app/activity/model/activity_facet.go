@@ -0,0 +1,89 @@
+package model
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+// ==================== 搜索分面统计 ====================
+//
+// 用于搜索页的筛选侧栏，统计当前搜索条件下各分类、标签、状态的活动数量
+//
+// 统计规则：
+//   - 每个维度只应用「其他维度」的筛选条件
+//     例：已选分类 A 时，分类分面仍展示其他分类的数量，方便切换
+//   - 与 ES 版本（post_filter + filter 聚合）语义保持一致
+
+// facetLimit 分类/标签分面最多返回的桶数
+const facetLimit = 50
+
+// SearchFacetResult 分面统计结果
+type SearchFacetResult struct {
+	Categories map[uint64]int64 // 分类ID -> 活动数
+	Tags       map[uint64]int64 // 标签ID -> 活动数
+	Statuses   map[int8]int64   // 状态 -> 活动数
+}
+
+// facetRow 分组统计行
+type facetRow struct {
+	Key   uint64
+	Count int64
+}
+
+// SearchFacets 按搜索条件统计分面（MySQL 版本）
+func (m *ActivityModel) SearchFacets(ctx context.Context, query *SearchQuery) (*SearchFacetResult, error) {
+	base := func() *gorm.DB {
+		return m.buildBaseSearchConditions(m.db.WithContext(ctx).Model(&Activity{}), query)
+	}
+
+	// 1. 分类分面（应用标签筛选）
+	var categoryRows []facetRow
+	if err := applyTagFilter(base(), query).
+		Select("category_id AS `key`, COUNT(*) AS `count`").
+		Group("category_id").
+		Order("`count` DESC").
+		Limit(facetLimit).
+		Scan(&categoryRows).Error; err != nil {
+		return nil, err
+	}
+
+	// 2. 标签分面（应用分类筛选）
+	var tagRows []facetRow
+	activityIDs := applyCategoryFilter(base(), query).Select("id")
+	if err := m.db.WithContext(ctx).
+		Table(ActivityTag{}.TableName()).
+		Select("tag_id AS `key`, COUNT(*) AS `count`").
+		Where("activity_id IN (?)", activityIDs).
+		Group("tag_id").
+		Order("`count` DESC").
+		Limit(facetLimit).
+		Scan(&tagRows).Error; err != nil {
+		return nil, err
+	}
+
+	// 3. 状态分面（应用全部筛选）
+	var statusRows []facetRow
+	if err := applyTagFilter(applyCategoryFilter(base(), query), query).
+		Select("status AS `key`, COUNT(*) AS `count`").
+		Group("status").
+		Scan(&statusRows).Error; err != nil {
+		return nil, err
+	}
+
+	result := &SearchFacetResult{
+		Categories: make(map[uint64]int64, len(categoryRows)),
+		Tags:       make(map[uint64]int64, len(tagRows)),
+		Statuses:   make(map[int8]int64, len(statusRows)),
+	}
+	for _, r := range categoryRows {
+		result.Categories[r.Key] = r.Count
+	}
+	for _, r := range tagRows {
+		result.Tags[r.Key] = r.Count
+	}
+	for _, r := range statusRows {
+		result.Statuses[int8(r.Key)] = r.Count
+	}
+	return result, nil
+}
@@ -0,0 +1,69 @@
+package model
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ==================== ActivityFavorite 活动收藏模型 ====================
+
+// ActivityFavorite 用户收藏的活动（用于报名截止提醒）
+type ActivityFavorite struct {
+	ID         uint64 `gorm:"primaryKey;autoIncrement"                                                   json:"id"`
+	ActivityID uint64 `gorm:"uniqueIndex:uk_activity_user,priority:1;not null;comment:活动ID"           json:"activity_id"`
+	UserID     uint64 `gorm:"uniqueIndex:uk_activity_user,priority:2;index:idx_user_id;not null;comment:用户ID" json:"user_id"`
+	CreatedAt  int64  `gorm:"autoCreateTime"                                                             json:"created_at"`
+}
+
+func (ActivityFavorite) TableName() string {
+	return "activity_favorites"
+}
+
+// ==================== ActivityFavoriteModel 数据访问层 ====================
+
+type ActivityFavoriteModel struct {
+	db *gorm.DB
+}
+
+func NewActivityFavoriteModel(db *gorm.DB) *ActivityFavoriteModel {
+	return &ActivityFavoriteModel{db: db}
+}
+
+// Add 收藏活动（重复收藏幂等），返回是否新增
+func (m *ActivityFavoriteModel) Add(ctx context.Context, activityID, userID uint64) (bool, error) {
+	result := m.db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&ActivityFavorite{ActivityID: activityID, UserID: userID})
+	return result.RowsAffected > 0, result.Error
+}
+
+// Remove 取消收藏（未收藏时幂等），返回是否删除
+func (m *ActivityFavoriteModel) Remove(ctx context.Context, activityID, userID uint64) (bool, error) {
+	result := m.db.WithContext(ctx).
+		Where("activity_id = ? AND user_id = ?", activityID, userID).
+		Delete(&ActivityFavorite{})
+	return result.RowsAffected > 0, result.Error
+}
+
+// Exists 是否已收藏
+func (m *ActivityFavoriteModel) Exists(ctx context.Context, activityID, userID uint64) (bool, error) {
+	var count int64
+	err := m.db.WithContext(ctx).
+		Model(&ActivityFavorite{}).
+		Where("activity_id = ? AND user_id = ?", activityID, userID).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// ListUserIDs 查询收藏了活动的用户ID
+func (m *ActivityFavoriteModel) ListUserIDs(ctx context.Context, activityID uint64) ([]uint64, error) {
+	var userIDs []uint64
+	err := m.db.WithContext(ctx).
+		Model(&ActivityFavorite{}).
+		Where("activity_id = ?", activityID).
+		Order("id ASC").
+		Pluck("user_id", &userIDs).Error
+	return userIDs, err
+}
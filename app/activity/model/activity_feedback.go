@@ -0,0 +1,219 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"math"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ==================== 活动评价 ====================
+//
+// 活动结束后，已核销票据的参与者可在评价窗口内打分（1-5）并留下短评：
+//   - 每人每个活动一条评价，窗口内重复提交视为修改
+//   - 评分汇总（均分、人数）冗余在 activities 表，随变更事件同步到 ES 与缓存
+//   - 短评与活动内容走同一套敏感词处置，命中送审词的短评在审核通过前不公开
+
+// 评分范围与短评长度
+const (
+	MinFeedbackRating     = 1
+	MaxFeedbackRating     = 5
+	MaxFeedbackCommentLen = 200 // 短评最大长度（字符）
+)
+
+// 短评状态
+const (
+	FeedbackCommentVisible int8 = 0 // 公开
+	FeedbackCommentPending int8 = 1 // 待审核（命中送审词，审核通过前不公开）
+	FeedbackCommentHidden  int8 = 2 // 已隐藏（审核驳回）
+)
+
+var (
+	ErrFeedbackNotFound = errors.New("评价不存在")
+)
+
+// ActivityFeedback 活动评价
+type ActivityFeedback struct {
+	ID            uint64 `gorm:"primaryKey;autoIncrement"                                                json:"id"`
+	ActivityID    uint64 `gorm:"uniqueIndex:uk_activity_user,priority:1;not null;comment:活动ID"         json:"activity_id"`
+	UserID        uint64 `gorm:"uniqueIndex:uk_activity_user,priority:2;not null;comment:评价用户ID"       json:"user_id"`
+	OrganizerID   uint64 `gorm:"index:idx_organizer_id;not null;comment:组织者ID（冗余，用于组织者评分汇总）" json:"organizer_id"`
+	Rating        int8   `gorm:"not null;comment:评分(1-5)"                                              json:"rating"`
+	Comment       string `gorm:"type:varchar(500);default:'';comment:短评（已按敏感词处置）"                    json:"comment"`
+	CommentStatus int8   `gorm:"default:0;comment:短评状态"                                                json:"comment_status"`
+	CreatedAt     int64  `gorm:"autoCreateTime"                                                         json:"created_at"`
+	UpdatedAt     int64  `gorm:"autoUpdateTime"                                                         json:"updated_at"`
+}
+
+func (ActivityFeedback) TableName() string {
+	return "activity_feedbacks"
+}
+
+// RatingSummary 评分汇总
+type RatingSummary struct {
+	Avg          float64  // 平均分（保留两位小数）
+	Count        int64    // 评价人数
+	Distribution [5]int64 // 各分值人数（下标 0 对应 1 分）
+}
+
+// ==================== ActivityFeedbackModel 数据访问层
+
+type ActivityFeedbackModel struct {
+	db *gorm.DB
+}
+
+func NewActivityFeedbackModel(db *gorm.DB) *ActivityFeedbackModel {
+	return &ActivityFeedbackModel{db: db}
+}
+
+// FindByActivityAndUser 查询用户对活动的评价
+func (m *ActivityFeedbackModel) FindByActivityAndUser(ctx context.Context, activityID, userID uint64) (*ActivityFeedback, error) {
+	var feedback ActivityFeedback
+	err := m.db.WithContext(ctx).
+		Where("activity_id = ? AND user_id = ?", activityID, userID).
+		First(&feedback).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrFeedbackNotFound
+		}
+		return nil, err
+	}
+	return &feedback, nil
+}
+
+// FindByIDs 批量查询评价
+func (m *ActivityFeedbackModel) FindByIDs(ctx context.Context, ids []uint64) ([]ActivityFeedback, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	var list []ActivityFeedback
+	err := m.db.WithContext(ctx).Where("id IN ?", ids).Find(&list).Error
+	return list, err
+}
+
+// Upsert 写入评价（已存在则覆盖评分与短评），在事务内调用
+//
+// 写入后 feedback.ID 回填为实际记录 ID
+func (m *ActivityFeedbackModel) Upsert(ctx context.Context, tx *gorm.DB, feedback *ActivityFeedback) error {
+	if tx == nil {
+		tx = m.db
+	}
+	err := tx.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "activity_id"}, {Name: "user_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"rating", "comment", "comment_status", "updated_at"}),
+		}).
+		Create(feedback).Error
+	if err != nil {
+		return err
+	}
+
+	// ON DUPLICATE KEY UPDATE 时 LastInsertId 不可靠，回查实际 ID
+	var id uint64
+	if err := tx.WithContext(ctx).Model(&ActivityFeedback{}).
+		Where("activity_id = ? AND user_id = ?", feedback.ActivityID, feedback.UserID).
+		Pluck("id", &id).Error; err != nil {
+		return err
+	}
+	feedback.ID = id
+	return nil
+}
+
+// SummaryByActivity 统计活动评分汇总
+func (m *ActivityFeedbackModel) SummaryByActivity(ctx context.Context, tx *gorm.DB, activityID uint64) (*RatingSummary, error) {
+	if tx == nil {
+		tx = m.db
+	}
+	var rows []struct {
+		Rating int8
+		Cnt    int64
+	}
+	err := tx.WithContext(ctx).
+		Model(&ActivityFeedback{}).
+		Select("rating, COUNT(*) AS cnt").
+		Where("activity_id = ?", activityID).
+		Group("rating").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &RatingSummary{}
+	var total int64
+	for _, r := range rows {
+		if r.Rating < MinFeedbackRating || r.Rating > MaxFeedbackRating {
+			continue
+		}
+		summary.Distribution[r.Rating-1] = r.Cnt
+		summary.Count += r.Cnt
+		total += int64(r.Rating) * r.Cnt
+	}
+	if summary.Count > 0 {
+		summary.Avg = roundRating(float64(total) / float64(summary.Count))
+	}
+	return summary, nil
+}
+
+// SummaryByOrganizer 统计组织者全部活动的评分汇总（按评价人数加权）
+func (m *ActivityFeedbackModel) SummaryByOrganizer(ctx context.Context, organizerID uint64) (float64, int64, error) {
+	var row struct {
+		Total int64
+		Cnt   int64
+	}
+	err := m.db.WithContext(ctx).
+		Model(&ActivityFeedback{}).
+		Select("COALESCE(SUM(rating), 0) AS total, COUNT(*) AS cnt").
+		Where("organizer_id = ?", organizerID).
+		Scan(&row).Error
+	if err != nil || row.Cnt == 0 {
+		return 0, 0, err
+	}
+	return roundRating(float64(row.Total) / float64(row.Cnt)), row.Cnt, nil
+}
+
+// ListComments 分页查询活动的公开短评（按更新时间倒序，空短评不返回）
+func (m *ActivityFeedbackModel) ListComments(ctx context.Context, activityID uint64, page, pageSize int) ([]ActivityFeedback, int64, error) {
+	var (
+		list  []ActivityFeedback
+		total int64
+	)
+	query := m.db.WithContext(ctx).Model(&ActivityFeedback{}).
+		Where("activity_id = ? AND comment <> '' AND comment_status = ?", activityID, FeedbackCommentVisible)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	err := query.Order("updated_at DESC, id DESC").
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		Find(&list).Error
+	return list, total, err
+}
+
+// ResolvePendingComment 审核待审核短评（公开/隐藏），返回是否更新（短评已不是待审核时返回 false），在事务内调用
+func (m *ActivityFeedbackModel) ResolvePendingComment(ctx context.Context, tx *gorm.DB, id uint64, status int8) (bool, error) {
+	if tx == nil {
+		tx = m.db
+	}
+	result := tx.WithContext(ctx).
+		Model(&ActivityFeedback{}).
+		Where("id = ? AND comment_status = ?", id, FeedbackCommentPending).
+		Update("comment_status", status)
+	return result.RowsAffected > 0, result.Error
+}
+
+// CountPendingComments 统计活动待审核的短评数
+func (m *ActivityFeedbackModel) CountPendingComments(ctx context.Context, activityID uint64) (int64, error) {
+	var count int64
+	err := m.db.WithContext(ctx).
+		Model(&ActivityFeedback{}).
+		Where("activity_id = ? AND comment_status = ?", activityID, FeedbackCommentPending).
+		Count(&count).Error
+	return count, err
+}
+
+// roundRating 评分保留两位小数
+func roundRating(v float64) float64 {
+	return math.Round(v*100) / 100
+}
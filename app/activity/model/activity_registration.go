@@ -189,6 +189,44 @@ func (m *ActivityRegistrationModel) ListByActivityID(ctx context.Context, activi
 	return regs, err
 }
 
+// ListSuccessUserIDs 查询活动所有报名成功的用户ID
+func (m *ActivityRegistrationModel) ListSuccessUserIDs(ctx context.Context, activityID uint64) ([]uint64, error) {
+	var userIDs []uint64
+	err := m.db.WithContext(ctx).
+		Model(&ActivityRegistration{}).
+		Where("activity_id = ? AND status = ?", activityID, RegistrationStatusSuccess).
+		Order("id ASC").
+		Pluck("user_id", &userIDs).Error
+	return userIDs, err
+}
+
+// ListCalendarRegistrations 查询用户日历订阅需要的报名记录（报名成功与已取消，按报名时间倒序）
+//
+// 已取消的报名在订阅中输出为已取消事件，客户端据此移除日程
+func (m *ActivityRegistrationModel) ListCalendarRegistrations(ctx context.Context, userID uint64, limit int) ([]ActivityRegistration, error) {
+	var regs []ActivityRegistration
+	err := m.db.WithContext(ctx).
+		Where("user_id = ? AND status IN ?", userID, []int8{RegistrationStatusSuccess, RegistrationStatusCanceled}).
+		Order("created_at DESC").
+		Limit(limit).
+		Find(&regs).Error
+	return regs, err
+}
+
+// ListUserIDsByActivityIDs 查询一批活动的报名用户ID（报名成功与已取消，去重）
+func (m *ActivityRegistrationModel) ListUserIDsByActivityIDs(ctx context.Context, activityIDs []uint64) ([]uint64, error) {
+	if len(activityIDs) == 0 {
+		return nil, nil
+	}
+	var userIDs []uint64
+	err := m.db.WithContext(ctx).
+		Model(&ActivityRegistration{}).
+		Where("activity_id IN ? AND status IN ?", activityIDs, []int8{RegistrationStatusSuccess, RegistrationStatusCanceled}).
+		Distinct("user_id").
+		Pluck("user_id", &userIDs).Error
+	return userIDs, err
+}
+
 // CountByUserID 统计用户报名记录数量
 func (m *ActivityRegistrationModel) CountByUserID(ctx context.Context, userID uint64) (int64, error) {
 	var count int64
@@ -255,6 +293,7 @@ func (m *ActivityRegistrationModel) ReopenIfCanceledOrFailed(ctx context.Context
 }
 
 // OccupyActivityQuota 报名成功占用名额（当前报名人数+1）
+// 同一事务内记录活动变更事件，刷新 ES 报名人数与详情/热门缓存
 func (m *ActivityRegistrationModel) OccupyActivityQuota(ctx context.Context, tx *gorm.DB, activityID uint64) error {
 	if tx == nil {
 		return errors.New("tx is nil")
@@ -269,7 +308,7 @@ func (m *ActivityRegistrationModel) OccupyActivityQuota(ctx context.Context, tx
 	if result.RowsAffected == 0 {
 		return ErrActivityQuotaFull
 	}
-	return nil
+	return NewActivityChangeEventModel(tx).Record(ctx, tx, activityID, ChangeTypeParticipants, ChangeSourceActivityRpc)
 }
 
 // RegisterWithTicket 创建或恢复报名并生成票券（事务内）
@@ -0,0 +1,338 @@
+package model
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// ==================== 活动发布审核 ====================
+//
+// 组织者提交发布（创建即发布 / 草稿提交）时生成一条审核单：
+//   - 审核策略判定可自动通过的，审核单直接记为自动通过，活动进入已发布
+//   - 否则活动进入待审核，审核单进入管理员审核队列（带 SLA 截止时间、审核员分配）
+//   - 审核通过时保存活动内容快照，后续再次提交审核时与最近一次通过的快照对比差异
+
+// 审核单状态
+const (
+	ReviewStatusPending      int8 = 0 // 待审核
+	ReviewStatusApproved     int8 = 1 // 人工审核通过
+	ReviewStatusRejected     int8 = 2 // 审核驳回
+	ReviewStatusAutoApproved int8 = 3 // 策略自动通过
+)
+
+// ReviewStatusText 审核单状态文本
+var ReviewStatusText = map[int8]string{
+	ReviewStatusPending:      "待审核",
+	ReviewStatusApproved:     "审核通过",
+	ReviewStatusRejected:     "审核驳回",
+	ReviewStatusAutoApproved: "自动通过",
+}
+
+// 审核队列范围
+const (
+	ReviewScopeAll        = "all"        // 全部待审核
+	ReviewScopeMine       = "mine"       // 分配给我的
+	ReviewScopeUnassigned = "unassigned" // 未分配
+)
+
+var (
+	ErrReviewNotFound = errors.New("审核单不存在")
+)
+
+// ActivityReview 活动发布审核单
+type ActivityReview struct {
+	ID           uint64 `gorm:"primaryKey;autoIncrement"                                     json:"id"`
+	ActivityID   uint64 `gorm:"index:idx_activity_id;not null;comment:活动ID"                 json:"activity_id"`
+	OrganizerID  uint64 `gorm:"not null;comment:组织者ID"                                     json:"organizer_id"`
+	Status       int8   `gorm:"index:idx_status_due,priority:1;default:0;comment:审核状态"    json:"status"`
+	PolicyReason string `gorm:"type:varchar(200);default:'';comment:策略判定说明"              json:"policy_reason"`
+	Flagged      bool   `gorm:"default:false;comment:内容是否命中送审词"                        json:"flagged"`
+	SubmittedAt  int64  `gorm:"not null;comment:提交时间"                                      json:"submitted_at"`
+	DueAt        int64  `gorm:"index:idx_status_due,priority:2;not null;comment:SLA截止时间" json:"due_at"`
+	AssigneeID   uint64 `gorm:"index:idx_assignee_id;default:0;comment:分配的审核员ID"         json:"assignee_id"`
+	AssignedAt   int64  `gorm:"default:0;comment:分配时间"                                     json:"assigned_at"`
+	ReviewerID   uint64 `gorm:"default:0;comment:审核人ID（自动通过为0）"                       json:"reviewer_id"`
+	Reason       string `gorm:"type:varchar(500);default:'';comment:驳回原因"                 json:"reason"`
+	DecidedAt    int64  `gorm:"default:0;comment:审核时间"                                     json:"decided_at"`
+	Snapshot     string `gorm:"type:text;comment:审核通过时的活动内容快照（JSON）"                 json:"-"`
+	CreatedAt    int64  `gorm:"autoCreateTime"                                              json:"created_at"`
+	UpdatedAt    int64  `gorm:"autoUpdateTime"                                              json:"updated_at"`
+}
+
+func (ActivityReview) TableName() string {
+	return "activity_reviews"
+}
+
+// Overdue 是否已超过 SLA（仅待审核有意义）
+func (r *ActivityReview) Overdue(now int64) bool {
+	return r.Status == ReviewStatusPending && r.DueAt > 0 && now > r.DueAt
+}
+
+// ==================== 内容快照与差异 ====================
+
+// ReviewSnapshot 审核关注的活动内容快照
+type ReviewSnapshot struct {
+	Title                string `json:"title"`
+	Description          string `json:"description"`
+	CoverURL             string `json:"cover_url"`
+	CategoryID           uint64 `json:"category_id"`
+	Location             string `json:"location"`
+	AddressDetail        string `json:"address_detail"`
+	ContactPhone         string `json:"contact_phone"`
+	RegisterStartTime    int64  `json:"register_start_time"`
+	RegisterEndTime      int64  `json:"register_end_time"`
+	ActivityStartTime    int64  `json:"activity_start_time"`
+	ActivityEndTime      int64  `json:"activity_end_time"`
+	MaxParticipants      uint32 `json:"max_participants"`
+	RequireStudentVerify bool   `json:"require_student_verify"`
+	MinCreditScore       int    `json:"min_credit_score"`
+}
+
+// ReviewFieldChange 单个字段的变更
+type ReviewFieldChange struct {
+	Field    string // 字段名（与快照 JSON 字段一致）
+	Label    string // 字段中文名
+	OldValue string
+	NewValue string
+}
+
+// NewReviewSnapshot 根据活动生成内容快照
+func NewReviewSnapshot(act *Activity) ReviewSnapshot {
+	return ReviewSnapshot{
+		Title:                act.Title,
+		Description:          act.Description,
+		CoverURL:             act.CoverURL,
+		CategoryID:           act.CategoryID,
+		Location:             act.Location,
+		AddressDetail:        act.AddressDetail,
+		ContactPhone:         act.ContactPhone,
+		RegisterStartTime:    act.RegisterStartTime,
+		RegisterEndTime:      act.RegisterEndTime,
+		ActivityStartTime:    act.ActivityStartTime,
+		ActivityEndTime:      act.ActivityEndTime,
+		MaxParticipants:      act.MaxParticipants,
+		RequireStudentVerify: act.RequireStudentVerify,
+		MinCreditScore:       act.MinCreditScore,
+	}
+}
+
+// Encode 序列化快照
+func (s ReviewSnapshot) Encode() string {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
+
+// DecodeReviewSnapshot 反序列化快照（空字符串返回 false）
+func DecodeReviewSnapshot(raw string) (ReviewSnapshot, bool) {
+	var s ReviewSnapshot
+	if raw == "" {
+		return s, false
+	}
+	if err := json.Unmarshal([]byte(raw), &s); err != nil {
+		return s, false
+	}
+	return s, true
+}
+
+// DiffReviewSnapshot 对比两个快照，返回发生变化的字段（按固定顺序）
+func DiffReviewSnapshot(base, current ReviewSnapshot) []ReviewFieldChange {
+	var changes []ReviewFieldChange
+	add := func(field, label, oldValue, newValue string) {
+		if oldValue != newValue {
+			changes = append(changes, ReviewFieldChange{Field: field, Label: label, OldValue: oldValue, NewValue: newValue})
+		}
+	}
+	add("title", "标题", base.Title, current.Title)
+	add("description", "活动详情", base.Description, current.Description)
+	add("cover_url", "封面", base.CoverURL, current.CoverURL)
+	add("category_id", "分类", fmt.Sprint(base.CategoryID), fmt.Sprint(current.CategoryID))
+	add("location", "活动地点", base.Location, current.Location)
+	add("address_detail", "详细地址", base.AddressDetail, current.AddressDetail)
+	add("contact_phone", "联系电话", base.ContactPhone, current.ContactPhone)
+	add("register_start_time", "报名开始时间", formatReviewTime(base.RegisterStartTime), formatReviewTime(current.RegisterStartTime))
+	add("register_end_time", "报名截止时间", formatReviewTime(base.RegisterEndTime), formatReviewTime(current.RegisterEndTime))
+	add("activity_start_time", "活动开始时间", formatReviewTime(base.ActivityStartTime), formatReviewTime(current.ActivityStartTime))
+	add("activity_end_time", "活动结束时间", formatReviewTime(base.ActivityEndTime), formatReviewTime(current.ActivityEndTime))
+	add("max_participants", "人数上限", fmt.Sprint(base.MaxParticipants), fmt.Sprint(current.MaxParticipants))
+	add("require_student_verify", "需要学生认证", fmt.Sprint(base.RequireStudentVerify), fmt.Sprint(current.RequireStudentVerify))
+	add("min_credit_score", "最低信用分", fmt.Sprint(base.MinCreditScore), fmt.Sprint(current.MinCreditScore))
+	return changes
+}
+
+// formatReviewTime 时间戳格式化（差异展示用）
+func formatReviewTime(ts int64) string {
+	if ts <= 0 {
+		return ""
+	}
+	return time.Unix(ts, 0).Format("2006-01-02 15:04")
+}
+
+// ==================== ActivityReviewModel 数据访问层
+
+type ActivityReviewModel struct {
+	db *gorm.DB
+}
+
+func NewActivityReviewModel(db *gorm.DB) *ActivityReviewModel {
+	return &ActivityReviewModel{db: db}
+}
+
+// Create 创建审核单（通常在事务内调用）
+func (m *ActivityReviewModel) Create(ctx context.Context, tx *gorm.DB, review *ActivityReview) error {
+	if tx == nil {
+		tx = m.db
+	}
+	return tx.WithContext(ctx).Create(review).Error
+}
+
+// FindPendingByActivityID 查询活动当前待审核的审核单
+func (m *ActivityReviewModel) FindPendingByActivityID(ctx context.Context, tx *gorm.DB, activityID uint64) (*ActivityReview, error) {
+	if tx == nil {
+		tx = m.db
+	}
+	var review ActivityReview
+	err := tx.WithContext(ctx).
+		Where("activity_id = ? AND status = ?", activityID, ReviewStatusPending).
+		Order("id DESC").
+		First(&review).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrReviewNotFound
+		}
+		return nil, err
+	}
+	return &review, nil
+}
+
+// FindLastApproved 查询活动最近一次通过（人工或自动）的审核单
+func (m *ActivityReviewModel) FindLastApproved(ctx context.Context, activityID uint64) (*ActivityReview, error) {
+	var review ActivityReview
+	err := m.db.WithContext(ctx).
+		Where("activity_id = ? AND status IN ?", activityID, []int8{ReviewStatusApproved, ReviewStatusAutoApproved}).
+		Order("id DESC").
+		First(&review).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrReviewNotFound
+		}
+		return nil, err
+	}
+	return &review, nil
+}
+
+// FindLastApprovedByActivityIDs 批量查询活动最近一次通过的审核单
+// 返回 map[活动ID]审核单，未通过过的活动不在结果中
+func (m *ActivityReviewModel) FindLastApprovedByActivityIDs(ctx context.Context, activityIDs []uint64) (map[uint64]*ActivityReview, error) {
+	result := make(map[uint64]*ActivityReview, len(activityIDs))
+	if len(activityIDs) == 0 {
+		return result, nil
+	}
+	var reviews []ActivityReview
+	err := m.db.WithContext(ctx).
+		Where("id IN (?)", m.db.Model(&ActivityReview{}).
+			Select("MAX(id)").
+			Where("activity_id IN ? AND status IN ?", activityIDs, []int8{ReviewStatusApproved, ReviewStatusAutoApproved}).
+			Group("activity_id")).
+		Find(&reviews).Error
+	if err != nil {
+		return nil, err
+	}
+	for i := range reviews {
+		result[reviews[i].ActivityID] = &reviews[i]
+	}
+	return result, nil
+}
+
+// Decide 记录审核结论（在事务内调用，仅待审核的审核单可变更）
+func (m *ActivityReviewModel) Decide(ctx context.Context, tx *gorm.DB, id uint64, status int8, reviewerID uint64, reason, snapshot string) error {
+	result := tx.WithContext(ctx).
+		Model(&ActivityReview{}).
+		Where("id = ? AND status = ?", id, ReviewStatusPending).
+		Updates(map[string]interface{}{
+			"status":      status,
+			"reviewer_id": reviewerID,
+			"reason":      reason,
+			"snapshot":    snapshot,
+			"decided_at":  time.Now().Unix(),
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrActivityConcurrentUpdate
+	}
+	return nil
+}
+
+// Assign 分配审核员（assigneeID 为 0 表示释放），返回分配时间
+//
+// expectAssignee 为分配前预期的审核员，用于防止并发领取覆盖
+func (m *ActivityReviewModel) Assign(ctx context.Context, id, expectAssignee, assigneeID uint64) (int64, error) {
+	assignedAt := int64(0)
+	if assigneeID > 0 {
+		assignedAt = time.Now().Unix()
+	}
+	result := m.db.WithContext(ctx).
+		Model(&ActivityReview{}).
+		Where("id = ? AND status = ? AND assignee_id = ?", id, ReviewStatusPending, expectAssignee).
+		Updates(map[string]interface{}{
+			"assignee_id": assigneeID,
+			"assigned_at": assignedAt,
+		})
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return 0, ErrActivityConcurrentUpdate
+	}
+	return assignedAt, nil
+}
+
+// ReviewQueueQuery 审核队列查询条件
+type ReviewQueueQuery struct {
+	Scope       string // all/mine/unassigned
+	ReviewerID  uint64 // Scope=mine 时的审核员
+	OverdueOnly bool   // 只看已超时
+	Page        int
+	PageSize    int
+}
+
+// ListQueue 分页查询审核队列（仍处于待审核状态的活动，按 SLA 截止时间升序）
+func (m *ActivityReviewModel) ListQueue(ctx context.Context, query *ReviewQueueQuery) ([]ActivityReview, int64, error) {
+	var (
+		reviews []ActivityReview
+		total   int64
+	)
+	db := m.db.WithContext(ctx).
+		Model(&ActivityReview{}).
+		Joins("JOIN activities ON activities.id = activity_reviews.activity_id AND activities.deleted_at IS NULL").
+		Where("activity_reviews.status = ? AND activities.status = ?", ReviewStatusPending, StatusPending)
+
+	switch query.Scope {
+	case ReviewScopeMine:
+		db = db.Where("activity_reviews.assignee_id = ?", query.ReviewerID)
+	case ReviewScopeUnassigned:
+		db = db.Where("activity_reviews.assignee_id = 0")
+	}
+	if query.OverdueOnly {
+		db = db.Where("activity_reviews.due_at < ?", time.Now().Unix())
+	}
+
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	err := db.Select("activity_reviews.*").
+		Order("activity_reviews.due_at ASC, activity_reviews.id ASC").
+		Offset((query.Page - 1) * query.PageSize).
+		Limit(query.PageSize).
+		Find(&reviews).Error
+	return reviews, total, err
+}
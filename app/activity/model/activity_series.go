@@ -0,0 +1,265 @@
+package model
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ==================== 系列活动状态 ====================
+
+const (
+	SeriesStatusActive    int8 = 1 // 生成中（按规则持续生成后续场次）
+	SeriesStatusFinished  int8 = 2 // 已生成全部场次
+	SeriesStatusCancelled int8 = 3 // 已取消（不再生成）
+)
+
+// SeriesStatusText 系列状态文本
+var SeriesStatusText = map[int8]string{
+	SeriesStatusActive:    "进行中",
+	SeriesStatusFinished:  "已排完",
+	SeriesStatusCancelled: "已取消",
+}
+
+var (
+	ErrSeriesNotFound = errors.New("系列活动不存在")
+)
+
+// ==================== ActivitySeries 系列活动模型 ====================
+
+// ActivitySeries 重复举办的系列活动（如每周二羽毛球）
+//
+// 系列本身不对外展示，只保存活动模板与重复规则；
+// 具体场次由 SeriesCron 按规则提前生成为普通 Activity（走正常创建与审核流程），
+// 场次与系列的对应关系记录在 activity_series_occurrences
+type ActivitySeries struct {
+	ID          uint64 `gorm:"primaryKey;autoIncrement"                                json:"id"`
+	OrganizerID uint64 `gorm:"index:idx_organizer;not null;comment:组织者ID"            json:"organizer_id"`
+	Status      int8   `gorm:"index:idx_status;default:1;comment:状态: 1生成中 2已排完 3已取消" json:"status"`
+
+	// 重复规则
+	RRule                string `gorm:"type:varchar(255);not null;comment:重复规则(RRULE子集)"  json:"rrule"`
+	FirstStartTime       int64  `gorm:"not null;comment:首场开始时间(锚点)"                     json:"first_start_time"`
+	DurationSeconds      int64  `gorm:"not null;comment:每场时长(秒)"                          json:"duration_seconds"`
+	RegisterOpenBefore   int64  `gorm:"not null;comment:开始前多久开放报名(秒)"                 json:"register_open_before"`
+	RegisterCloseBefore  int64  `gorm:"not null;comment:开始前多久截止报名(秒)"                 json:"register_close_before"`
+	AllowSeriesRegister  bool   `gorm:"default:false;comment:是否允许整期报名"                  json:"allow_series_register"`
+	GeneratedCount       int    `gorm:"default:0;comment:已处理的场次数(含跳过)"                  json:"generated_count"`
+	LastGeneratedStartAt int64  `gorm:"default:0;comment:最近生成场次的开始时间"                 json:"last_generated_start_at"`
+
+	// 活动模板（场次创建时使用，整期修改时同步更新）
+	Title                string  `gorm:"type:varchar(100);not null;comment:活动标题"         json:"title"`
+	Description          string  `gorm:"type:text;comment:活动详情"                           json:"description"`
+	CoverImageID         int64   `gorm:"default:0;comment:封面图片ID"                        json:"cover_image_id"`
+	CoverType            int8    `gorm:"default:1;comment:封面类型"                          json:"cover_type"`
+	CategoryID           uint64  `gorm:"not null;comment:分类ID"                            json:"category_id"`
+	ContactPhone         string  `gorm:"type:varchar(20);default:'';comment:联系电话"       json:"contact_phone"`
+	Location             string  `gorm:"type:varchar(200);not null;comment:活动地点"        json:"location"`
+	AddressDetail        string  `gorm:"type:varchar(500);default:'';comment:详细地址"      json:"address_detail"`
+	Longitude            float64 `gorm:"type:decimal(10,7);default:0;comment:经度"          json:"longitude"`
+	Latitude             float64 `gorm:"type:decimal(10,7);default:0;comment:纬度"          json:"latitude"`
+	MaxParticipants      uint32  `gorm:"default:0;comment:每场人数上限"                       json:"max_participants"`
+	RequireApproval      bool    `gorm:"default:false;comment:报名是否需要审核"                json:"require_approval"`
+	RequireStudentVerify bool    `gorm:"default:false;comment:是否需要学生认证"                json:"require_student_verify"`
+	MinCreditScore       int     `gorm:"default:0;comment:最低信用分"                         json:"min_credit_score"`
+	TagIDs               string  `gorm:"type:varchar(100);default:'';comment:标签ID(JSON)" json:"-"`
+	OrganizerName        string  `gorm:"type:varchar(50);default:'';comment:组织者昵称快照"   json:"organizer_name"`
+	OrganizerAvatar      string  `gorm:"type:varchar(255);default:'';comment:组织者头像快照"  json:"organizer_avatar"`
+
+	CreatedAt int64 `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt int64 `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+func (ActivitySeries) TableName() string {
+	return "activity_series"
+}
+
+// Tags 模板标签ID
+func (s *ActivitySeries) Tags() []int64 {
+	return DecodeTagIDs(s.TagIDs)
+}
+
+// EncodeTagIDs 编码模板标签ID（系列活动、活动模板共用）
+func EncodeTagIDs(ids []int64) string {
+	if len(ids) == 0 {
+		return ""
+	}
+	raw, _ := json.Marshal(ids)
+	return string(raw)
+}
+
+// DecodeTagIDs 解码模板标签ID
+func DecodeTagIDs(raw string) []int64 {
+	var ids []int64
+	if raw != "" {
+		_ = json.Unmarshal([]byte(raw), &ids)
+	}
+	return ids
+}
+
+// ActivitySeriesOccurrence 系列场次
+//
+// (series_id, occurrence_index) 唯一：生成前先占位再创建活动，保证同一场次不会重复生成；
+// 单场被组织者单独修改后标记 Overridden，整期修改不再覆盖该场次
+type ActivitySeriesOccurrence struct {
+	ID              uint64 `gorm:"primaryKey;autoIncrement"                                           json:"id"`
+	SeriesID        uint64 `gorm:"uniqueIndex:uk_series_index,priority:1;not null;comment:系列ID"     json:"series_id"`
+	OccurrenceIndex int    `gorm:"uniqueIndex:uk_series_index,priority:2;not null;comment:场次序号"    json:"occurrence_index"`
+	ActivityID      uint64 `gorm:"index:idx_activity_id;default:0;comment:活动ID(0表示生成中)"          json:"activity_id"`
+	StartTime       int64  `gorm:"not null;comment:场次开始时间"                                         json:"start_time"`
+	Overridden      bool   `gorm:"default:false;comment:是否被单独修改"                                  json:"overridden"`
+	CreatedAt       int64  `gorm:"autoCreateTime"                                                     json:"created_at"`
+	UpdatedAt       int64  `gorm:"autoUpdateTime"                                                     json:"updated_at"`
+}
+
+func (ActivitySeriesOccurrence) TableName() string {
+	return "activity_series_occurrences"
+}
+
+// ==================== ActivitySeriesModel 数据访问层 ====================
+
+type ActivitySeriesModel struct {
+	db *gorm.DB
+}
+
+func NewActivitySeriesModel(db *gorm.DB) *ActivitySeriesModel {
+	return &ActivitySeriesModel{db: db}
+}
+
+// Create 创建系列
+func (m *ActivitySeriesModel) Create(ctx context.Context, series *ActivitySeries) error {
+	return m.db.WithContext(ctx).Create(series).Error
+}
+
+// FindByID 根据ID查询系列
+func (m *ActivitySeriesModel) FindByID(ctx context.Context, id uint64) (*ActivitySeries, error) {
+	var series ActivitySeries
+	err := m.db.WithContext(ctx).Where("id = ?", id).First(&series).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrSeriesNotFound
+		}
+		return nil, err
+	}
+	return &series, nil
+}
+
+// ListActiveIDs 查询生成中的系列ID
+func (m *ActivitySeriesModel) ListActiveIDs(ctx context.Context) ([]uint64, error) {
+	var ids []uint64
+	err := m.db.WithContext(ctx).
+		Model(&ActivitySeries{}).
+		Where("status = ?", SeriesStatusActive).
+		Order("id ASC").
+		Pluck("id", &ids).Error
+	return ids, err
+}
+
+// ListIDsAllowSeriesRegister 查询开放整期报名且未取消的系列ID
+func (m *ActivitySeriesModel) ListIDsAllowSeriesRegister(ctx context.Context) ([]uint64, error) {
+	var ids []uint64
+	err := m.db.WithContext(ctx).
+		Model(&ActivitySeries{}).
+		Where("allow_series_register = ? AND status <> ?", true, SeriesStatusCancelled).
+		Order("id ASC").
+		Pluck("id", &ids).Error
+	return ids, err
+}
+
+// UpdateFields 按字段更新系列
+func (m *ActivitySeriesModel) UpdateFields(ctx context.Context, id uint64, fields map[string]interface{}) error {
+	return m.db.WithContext(ctx).
+		Model(&ActivitySeries{}).
+		Where("id = ?", id).
+		Updates(fields).Error
+}
+
+// MarkGenerated 记录生成进度（仅向前推进，多实例下重复推进幂等）
+func (m *ActivitySeriesModel) MarkGenerated(ctx context.Context, id uint64, generatedCount int, lastStartAt int64, finished bool) error {
+	fields := map[string]interface{}{
+		"generated_count":         generatedCount,
+		"last_generated_start_at": lastStartAt,
+	}
+	if finished {
+		fields["status"] = SeriesStatusFinished
+	}
+	return m.db.WithContext(ctx).
+		Model(&ActivitySeries{}).
+		Where("id = ? AND generated_count <= ? AND status = ?", id, generatedCount, SeriesStatusActive).
+		Updates(fields).Error
+}
+
+// ==================== 场次 ====================
+
+// ClaimOccurrence 占位场次（已存在返回 false，表示该场次已生成或正在生成）
+func (m *ActivitySeriesModel) ClaimOccurrence(ctx context.Context, seriesID uint64, index int, startTime int64) (bool, error) {
+	result := m.db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&ActivitySeriesOccurrence{
+			SeriesID:        seriesID,
+			OccurrenceIndex: index,
+			StartTime:       startTime,
+		})
+	return result.RowsAffected > 0, result.Error
+}
+
+// BindOccurrence 场次活动创建成功后回填活动ID
+func (m *ActivitySeriesModel) BindOccurrence(ctx context.Context, seriesID uint64, index int, activityID uint64) error {
+	return m.db.WithContext(ctx).
+		Model(&ActivitySeriesOccurrence{}).
+		Where("series_id = ? AND occurrence_index = ?", seriesID, index).
+		Update("activity_id", activityID).Error
+}
+
+// ReleaseOccurrence 场次活动创建失败后释放占位（下一轮重试）
+func (m *ActivitySeriesModel) ReleaseOccurrence(ctx context.Context, seriesID uint64, index int) error {
+	return m.db.WithContext(ctx).
+		Where("series_id = ? AND occurrence_index = ? AND activity_id = 0", seriesID, index).
+		Delete(&ActivitySeriesOccurrence{}).Error
+}
+
+// ListOccurrences 查询系列的全部场次（按序号）
+func (m *ActivitySeriesModel) ListOccurrences(ctx context.Context, seriesID uint64) ([]ActivitySeriesOccurrence, error) {
+	var list []ActivitySeriesOccurrence
+	err := m.db.WithContext(ctx).
+		Where("series_id = ? AND activity_id > 0", seriesID).
+		Order("occurrence_index ASC").
+		Find(&list).Error
+	return list, err
+}
+
+// ListFutureOccurrences 查询尚未开始的场次
+func (m *ActivitySeriesModel) ListFutureOccurrences(ctx context.Context, seriesID uint64, now int64) ([]ActivitySeriesOccurrence, error) {
+	var list []ActivitySeriesOccurrence
+	err := m.db.WithContext(ctx).
+		Where("series_id = ? AND activity_id > 0 AND start_time > ?", seriesID, now).
+		Order("occurrence_index ASC").
+		Find(&list).Error
+	return list, err
+}
+
+// FindOccurrenceByActivityID 查询活动对应的场次（非系列活动返回 nil）
+func (m *ActivitySeriesModel) FindOccurrenceByActivityID(ctx context.Context, activityID uint64) (*ActivitySeriesOccurrence, error) {
+	var occ ActivitySeriesOccurrence
+	err := m.db.WithContext(ctx).
+		Where("activity_id = ?", activityID).
+		First(&occ).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &occ, nil
+}
+
+// MarkOverridden 标记场次被单独修改（非系列活动无影响）
+func (m *ActivitySeriesModel) MarkOverridden(ctx context.Context, activityID uint64) error {
+	return m.db.WithContext(ctx).
+		Model(&ActivitySeriesOccurrence{}).
+		Where("activity_id = ? AND overridden = ?", activityID, false).
+		Update("overridden", true).Error
+}
@@ -0,0 +1,129 @@
+package model
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ==================== 整期报名状态 ====================
+
+const (
+	SeriesSubscriptionCancelled int8 = 0 // 已退出整期报名
+	SeriesSubscriptionActive    int8 = 1 // 整期报名中
+)
+
+// 单场出票结果
+const (
+	SeriesEnrollSuccess int8 = 1 // 已报名并出票
+	SeriesEnrollFailed  int8 = 2 // 报名失败（名额已满、资格不满足等）
+)
+
+// ==================== ActivitySeriesSubscription 整期报名 ====================
+
+// ActivitySeriesSubscription 用户对整个系列的报名
+//
+// 整期报名不直接占用名额：每场开放报名后由 SeriesCron 逐场调用正常报名流程出票，
+// 名额、资格、信用分规则与单场报名一致
+type ActivitySeriesSubscription struct {
+	ID        uint64 `gorm:"primaryKey;autoIncrement"                                                   json:"id"`
+	SeriesID  uint64 `gorm:"uniqueIndex:uk_series_user,priority:1;not null;comment:系列ID"              json:"series_id"`
+	UserID    uint64 `gorm:"uniqueIndex:uk_series_user,priority:2;index:idx_user_id;not null;comment:用户ID" json:"user_id"`
+	Status    int8   `gorm:"default:1;comment:状态: 1整期报名中 0已退出"                                      json:"status"`
+	CreatedAt int64  `gorm:"autoCreateTime"                                                             json:"created_at"`
+	UpdatedAt int64  `gorm:"autoUpdateTime"                                                             json:"updated_at"`
+}
+
+func (ActivitySeriesSubscription) TableName() string {
+	return "activity_series_subscriptions"
+}
+
+// ActivitySeriesEnrollment 整期报名的单场出票记录
+//
+// 每个 (场次, 用户) 只处理一次：失败（如名额已满）不重复尝试，
+// 用户取消单场报名后也不会被重新报名
+type ActivitySeriesEnrollment struct {
+	ID         uint64 `gorm:"primaryKey;autoIncrement"                                                json:"id"`
+	SeriesID   uint64 `gorm:"index:idx_series_id;not null;comment:系列ID"                              json:"series_id"`
+	ActivityID uint64 `gorm:"uniqueIndex:uk_activity_user,priority:1;not null;comment:场次活动ID"      json:"activity_id"`
+	UserID     uint64 `gorm:"uniqueIndex:uk_activity_user,priority:2;not null;comment:用户ID"         json:"user_id"`
+	Result     int8   `gorm:"not null;comment:结果: 1已出票 2失败"                                        json:"result"`
+	Reason     string `gorm:"type:varchar(255);default:'';comment:失败原因"                            json:"reason"`
+	CreatedAt  int64  `gorm:"autoCreateTime"                                                          json:"created_at"`
+}
+
+func (ActivitySeriesEnrollment) TableName() string {
+	return "activity_series_enrollments"
+}
+
+// ==================== ActivitySeriesRegistrationModel 数据访问层 ====================
+
+type ActivitySeriesRegistrationModel struct {
+	db *gorm.DB
+}
+
+func NewActivitySeriesRegistrationModel(db *gorm.DB) *ActivitySeriesRegistrationModel {
+	return &ActivitySeriesRegistrationModel{db: db}
+}
+
+// Subscribe 加入/退出整期报名（幂等）
+func (m *ActivitySeriesRegistrationModel) Subscribe(ctx context.Context, seriesID, userID uint64, status int8) error {
+	return m.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "series_id"}, {Name: "user_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"status", "updated_at"}),
+		}).
+		Create(&ActivitySeriesSubscription{SeriesID: seriesID, UserID: userID, Status: status}).Error
+}
+
+// IsSubscribed 用户是否整期报名中
+func (m *ActivitySeriesRegistrationModel) IsSubscribed(ctx context.Context, seriesID, userID uint64) (bool, error) {
+	var count int64
+	err := m.db.WithContext(ctx).
+		Model(&ActivitySeriesSubscription{}).
+		Where("series_id = ? AND user_id = ? AND status = ?", seriesID, userID, SeriesSubscriptionActive).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// ListSubscriberIDs 查询整期报名中的用户ID
+func (m *ActivitySeriesRegistrationModel) ListSubscriberIDs(ctx context.Context, seriesID uint64) ([]uint64, error) {
+	var ids []uint64
+	err := m.db.WithContext(ctx).
+		Model(&ActivitySeriesSubscription{}).
+		Where("series_id = ? AND status = ?", seriesID, SeriesSubscriptionActive).
+		Order("id ASC").
+		Pluck("user_id", &ids).Error
+	return ids, err
+}
+
+// CountSubscribers 统计整期报名人数
+func (m *ActivitySeriesRegistrationModel) CountSubscribers(ctx context.Context, seriesID uint64) (int64, error) {
+	var count int64
+	err := m.db.WithContext(ctx).
+		Model(&ActivitySeriesSubscription{}).
+		Where("series_id = ? AND status = ?", seriesID, SeriesSubscriptionActive).
+		Count(&count).Error
+	return count, err
+}
+
+// ListEnrolledUserIDs 查询场次已处理过的用户ID（无论成功失败）
+func (m *ActivitySeriesRegistrationModel) ListEnrolledUserIDs(ctx context.Context, activityID uint64) ([]uint64, error) {
+	var ids []uint64
+	err := m.db.WithContext(ctx).
+		Model(&ActivitySeriesEnrollment{}).
+		Where("activity_id = ?", activityID).
+		Pluck("user_id", &ids).Error
+	return ids, err
+}
+
+// RecordEnrollment 记录单场出票结果（重复记录忽略）
+func (m *ActivitySeriesRegistrationModel) RecordEnrollment(ctx context.Context, enrollment *ActivitySeriesEnrollment) error {
+	if len([]rune(enrollment.Reason)) > 255 {
+		enrollment.Reason = string([]rune(enrollment.Reason)[:255])
+	}
+	return m.db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(enrollment).Error
+}
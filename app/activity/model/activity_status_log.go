@@ -15,7 +15,7 @@ type ActivityStatusLog struct {
 	OperatorID   uint64 `gorm:"not null;comment:操作人ID"         json:"operator_id"`
 	OperatorType int8   `gorm:"default:1;comment:操作人类型: 1用户 2管理员 3系统" json:"operator_type"`
 	Reason       string `gorm:"type:varchar(500);default:'';comment:变更原因" json:"reason"`
-	CreatedAt    int64  `gorm:"autoCreateTime;index:idx_activity_created,priority:2"  json:"created_at"`
+	CreatedAt    int64  `gorm:"autoCreateTime;index:idx_activity_created,priority:2;index:idx_created_at"  json:"created_at"`
 }
 
 func (ActivityStatusLog) TableName() string {
@@ -49,3 +49,26 @@ func (m *ActivityStatusLogModel) FindByActivityID(ctx context.Context, activityI
 		Find(&logs).Error
 	return logs, err
 }
+
+// CategoryTransitionCount 按目标状态、分类统计的活动数
+type CategoryTransitionCount struct {
+	ToStatus   int8   `gorm:"column:to_status"`
+	CategoryID uint64 `gorm:"column:category_id"`
+	Total      int64  `gorm:"column:total"`
+}
+
+// CountTransitionsByCategory 统计时间范围内进入指定状态的活动数（按目标状态、分类分组）
+//
+// 只统计真正发生状态变化的日志（审核修改等 from = to 的记录不计），同一活动多次进入同一状态只计一次
+func (m *ActivityStatusLogModel) CountTransitionsByCategory(ctx context.Context, start, end int64, toStatuses []int8) ([]CategoryTransitionCount, error) {
+	var list []CategoryTransitionCount
+	err := m.db.WithContext(ctx).
+		Table("activity_status_logs AS l").
+		Select("l.to_status, a.category_id, COUNT(DISTINCT l.activity_id) AS total").
+		Joins("JOIN activities AS a ON a.id = l.activity_id").
+		Where("l.created_at >= ? AND l.created_at < ?", start, end).
+		Where("l.to_status IN ? AND l.from_status <> l.to_status", toStatuses).
+		Group("l.to_status, a.category_id").
+		Scan(&list).Error
+	return list, err
+}
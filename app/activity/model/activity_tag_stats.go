@@ -249,6 +249,43 @@ func (m *ActivityTagStatsModel) RecalculateStats(ctx context.Context) error {
 	return m.db.WithContext(ctx).Exec(sql, now).Error
 }
 
+// RecalculateByTagIDs 重新计算指定标签的统计（事务内使用，标签合并后调用）
+//
+// 没有关联活动的标签统计归零
+func (m *ActivityTagStatsModel) RecalculateByTagIDs(ctx context.Context, tx *gorm.DB, tagIDs []uint64) error {
+	if len(tagIDs) == 0 {
+		return nil
+	}
+	if tx == nil {
+		tx = m.db
+	}
+	now := time.Now().Unix()
+	db := tx.WithContext(ctx)
+
+	if err := db.Model(&ActivityTagStats{}).
+		Where("tag_id IN ?", tagIDs).
+		Updates(map[string]interface{}{"activity_count": 0, "updated_at": now}).Error; err != nil {
+		return err
+	}
+
+	sql := `
+		INSERT INTO activity_tag_stats (tag_id, activity_count, view_count, updated_at)
+		SELECT
+			at.tag_id,
+			COUNT(DISTINCT at.activity_id) as activity_count,
+			0 as view_count,
+			? as updated_at
+		FROM activity_tags at
+		INNER JOIN activities a ON a.id = at.activity_id AND a.deleted_at IS NULL
+		WHERE at.tag_id IN ?
+		GROUP BY at.tag_id
+		ON DUPLICATE KEY UPDATE
+			activity_count = VALUES(activity_count),
+			updated_at = VALUES(updated_at)
+	`
+	return db.Exec(sql, now, tagIDs).Error
+}
+
 // CleanupOrphanStats 清理孤儿统计记录（标签已被删除但统计还在）
 func (m *ActivityTagStatsModel) CleanupOrphanStats(ctx context.Context) error {
 	sql := `
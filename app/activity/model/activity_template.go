@@ -0,0 +1,180 @@
+package model
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+// ==================== 活动模板范围与状态 ====================
+
+const (
+	TemplateScopePrivate int8 = 1 // 个人模板（仅创建者可见）
+	TemplateScopePublic  int8 = 2 // 公共模板（管理员按分类维护，所有用户可用）
+)
+
+const (
+	TemplateStatusDisabled int8 = 0 // 已下架（仅公共模板）
+	TemplateStatusEnabled  int8 = 1 // 可用
+)
+
+var (
+	ErrTemplateNotFound = errors.New("活动模板不存在")
+)
+
+// ==================== ActivityTemplate 活动模板模型 ====================
+
+// ActivityTemplate 活动模板
+//
+// 由已有活动另存而来：保存内容字段与相对时间（时长、报名开放/截止提前量），
+// 使用模板时按新的活动开始时间推算报名与结束时间，生成草稿活动。
+// 封面图片被模板引用期间计入 SysImage.RefCount，删除模板时释放
+type ActivityTemplate struct {
+	ID               uint64 `gorm:"primaryKey;autoIncrement" json:"id"`
+	Scope            int8   `gorm:"index:idx_scope_category,priority:1;not null;comment:范围: 1个人 2公共" json:"scope"`
+	CategoryID       uint64 `gorm:"index:idx_scope_category,priority:2;not null;comment:分类ID" json:"category_id"`
+	OwnerID          uint64 `gorm:"index:idx_owner;not null;comment:创建者ID(公共模板为管理员)" json:"owner_id"`
+	Name             string `gorm:"type:varchar(50);not null;comment:模板名称" json:"name"`
+	Status           int8   `gorm:"default:1;comment:状态: 1可用 0已下架" json:"status"`
+	SourceActivityID uint64 `gorm:"default:0;comment:来源活动ID" json:"source_activity_id"`
+	UseCount         int64  `gorm:"default:0;comment:使用次数" json:"use_count"`
+
+	// 相对时间（使用模板时按活动开始时间推算）
+	DurationSeconds     int64 `gorm:"not null;comment:活动时长(秒)" json:"duration_seconds"`
+	RegisterOpenBefore  int64 `gorm:"not null;comment:开始前多久开放报名(秒)" json:"register_open_before"`
+	RegisterCloseBefore int64 `gorm:"not null;comment:开始前多久截止报名(秒)" json:"register_close_before"`
+
+	// 活动内容
+	Title                string  `gorm:"type:varchar(100);not null;comment:活动标题" json:"title"`
+	Description          string  `gorm:"type:text;comment:活动详情" json:"description"`
+	CoverImageID         int64   `gorm:"default:0;comment:封面图片ID" json:"cover_image_id"`
+	CoverURL             string  `gorm:"type:varchar(500);default:'';comment:封面URL快照(列表展示)" json:"cover_url"`
+	CoverType            int8    `gorm:"default:1;comment:封面类型" json:"cover_type"`
+	ContactPhone         string  `gorm:"type:varchar(20);default:'';comment:联系电话" json:"contact_phone"`
+	Location             string  `gorm:"type:varchar(200);not null;comment:活动地点" json:"location"`
+	AddressDetail        string  `gorm:"type:varchar(500);default:'';comment:详细地址" json:"address_detail"`
+	Longitude            float64 `gorm:"type:decimal(10,7);default:0;comment:经度" json:"longitude"`
+	Latitude             float64 `gorm:"type:decimal(10,7);default:0;comment:纬度" json:"latitude"`
+	MaxParticipants      uint32  `gorm:"default:0;comment:人数上限" json:"max_participants"`
+	RequireApproval      bool    `gorm:"default:false;comment:报名是否需要审核" json:"require_approval"`
+	RequireStudentVerify bool    `gorm:"default:false;comment:是否需要学生认证" json:"require_student_verify"`
+	MinCreditScore       int     `gorm:"default:0;comment:最低信用分" json:"min_credit_score"`
+	TagIDs               string  `gorm:"type:varchar(100);default:'';comment:标签ID(JSON)" json:"-"`
+	EligibilityRules     string  `gorm:"type:text;comment:报名资格规则(JSON)" json:"-"`
+
+	CreatedAt int64 `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt int64 `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+func (ActivityTemplate) TableName() string {
+	return "activity_templates"
+}
+
+// Tags 模板标签ID
+func (t *ActivityTemplate) Tags() []int64 {
+	return DecodeTagIDs(t.TagIDs)
+}
+
+// TemplateFilter 模板列表筛选条件
+type TemplateFilter struct {
+	OwnerID         uint64 // 个人模板的创建者；0 表示不返回个人模板
+	IncludePublic   bool   // 是否包含公共模板
+	IncludeDisabled bool   // 是否包含已下架的公共模板（管理端）
+	CategoryID      uint64
+}
+
+// ==================== ActivityTemplateModel 数据访问层 ====================
+
+type ActivityTemplateModel struct {
+	db *gorm.DB
+}
+
+func NewActivityTemplateModel(db *gorm.DB) *ActivityTemplateModel {
+	return &ActivityTemplateModel{db: db}
+}
+
+// Create 创建模板
+func (m *ActivityTemplateModel) Create(ctx context.Context, tpl *ActivityTemplate) error {
+	return m.db.WithContext(ctx).Create(tpl).Error
+}
+
+// FindByID 根据ID查询模板
+func (m *ActivityTemplateModel) FindByID(ctx context.Context, id uint64) (*ActivityTemplate, error) {
+	var tpl ActivityTemplate
+	err := m.db.WithContext(ctx).Where("id = ?", id).First(&tpl).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrTemplateNotFound
+		}
+		return nil, err
+	}
+	return &tpl, nil
+}
+
+// List 分页查询模板（公共模板在前，按使用次数排序；个人模板按创建时间倒序）
+func (m *ActivityTemplateModel) List(ctx context.Context, filter TemplateFilter, offset, limit int) ([]ActivityTemplate, int64, error) {
+	db := m.db.WithContext(ctx).Model(&ActivityTemplate{})
+
+	switch {
+	case filter.OwnerID > 0 && filter.IncludePublic:
+		db = db.Where("(scope = ? AND owner_id = ?) OR scope = ?", TemplateScopePrivate, filter.OwnerID, TemplateScopePublic)
+	case filter.OwnerID > 0:
+		db = db.Where("scope = ? AND owner_id = ?", TemplateScopePrivate, filter.OwnerID)
+	case filter.IncludePublic:
+		db = db.Where("scope = ?", TemplateScopePublic)
+	default:
+		return []ActivityTemplate{}, 0, nil
+	}
+	if !filter.IncludeDisabled {
+		db = db.Where("status = ?", TemplateStatusEnabled)
+	}
+	if filter.CategoryID > 0 {
+		db = db.Where("category_id = ?", filter.CategoryID)
+	}
+
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var list []ActivityTemplate
+	err := db.Order("scope DESC, use_count DESC, id DESC").
+		Offset(offset).
+		Limit(limit).
+		Find(&list).Error
+	return list, total, err
+}
+
+// CountByOwner 统计用户的个人模板数
+func (m *ActivityTemplateModel) CountByOwner(ctx context.Context, ownerID uint64) (int64, error) {
+	var count int64
+	err := m.db.WithContext(ctx).
+		Model(&ActivityTemplate{}).
+		Where("scope = ? AND owner_id = ?", TemplateScopePrivate, ownerID).
+		Count(&count).Error
+	return count, err
+}
+
+// UpdateFields 按字段更新模板
+func (m *ActivityTemplateModel) UpdateFields(ctx context.Context, id uint64, fields map[string]interface{}) error {
+	return m.db.WithContext(ctx).
+		Model(&ActivityTemplate{}).
+		Where("id = ?", id).
+		Updates(fields).Error
+}
+
+// IncrUseCount 使用次数 +1
+func (m *ActivityTemplateModel) IncrUseCount(ctx context.Context, id uint64) error {
+	return m.db.WithContext(ctx).
+		Model(&ActivityTemplate{}).
+		Where("id = ?", id).
+		UpdateColumn("use_count", gorm.Expr("use_count + 1")).Error
+}
+
+// Delete 删除模板
+func (m *ActivityTemplateModel) Delete(ctx context.Context, id uint64) error {
+	return m.db.WithContext(ctx).
+		Where("id = ?", id).
+		Delete(&ActivityTemplate{}).Error
+}
@@ -201,6 +201,26 @@ func (m *ActivityTicketModel) CountByUserID(ctx context.Context, userID uint64)
 	return count, err
 }
 
+// HasUsedTicket 判断用户在活动中是否有已核销的票据（即实际到场参与）
+func (m *ActivityTicketModel) HasUsedTicket(ctx context.Context, activityID, userID uint64) (bool, error) {
+	var count int64
+	err := m.db.WithContext(ctx).
+		Model(&ActivityTicket{}).
+		Where("activity_id = ? AND user_id = ? AND status = ?", activityID, userID, TicketStatusUsed).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// CountUsedByActivity 统计活动已核销（已签到）票据数
+func (m *ActivityTicketModel) CountUsedByActivity(ctx context.Context, activityID uint64) (int64, error) {
+	var count int64
+	err := m.db.WithContext(ctx).
+		Model(&ActivityTicket{}).
+		Where("activity_id = ? AND status = ?", activityID, TicketStatusUsed).
+		Count(&count).Error
+	return count, err
+}
+
 // MarkUsed 核销票据
 func (m *ActivityTicketModel) MarkUsed(ctx context.Context, id uint64, usedTime int64, usedLocation, snapshot string) error {
 	result := m.db.WithContext(ctx).
@@ -221,6 +241,29 @@ func (m *ActivityTicketModel) MarkUsed(ctx context.Context, id uint64, usedTime
 	return nil
 }
 
+// MarkUsedTx 核销未使用的票据（事务内，仅未使用状态可核销，防止重复签到）
+func (m *ActivityTicketModel) MarkUsedTx(ctx context.Context, tx *gorm.DB, id uint64, usedTime int64, usedLocation, snapshot string) error {
+	if tx == nil {
+		return errors.New("tx is nil")
+	}
+	result := tx.WithContext(ctx).
+		Model(&ActivityTicket{}).
+		Where("id = ? AND status = ?", id, TicketStatusUnused).
+		Updates(map[string]interface{}{
+			"status":            TicketStatusUsed,
+			"used_time":         usedTime,
+			"used_location":     usedLocation,
+			"check_in_snapshot": snapshot,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrTicketNotFound
+	}
+	return nil
+}
+
 // UpdateStatus 更新票据状态
 func (m *ActivityTicketModel) UpdateStatus(ctx context.Context, id uint64, status int8) error {
 	result := m.db.WithContext(ctx).
@@ -236,6 +279,21 @@ func (m *ActivityTicketModel) UpdateStatus(ctx context.Context, id uint64, statu
 	return nil
 }
 
+// UpdateValidWindowByActivity 重算活动下未使用票据的可核销时间窗（事务内，活动时间变更后调用）
+func (m *ActivityTicketModel) UpdateValidWindowByActivity(ctx context.Context, tx *gorm.DB, activityID uint64, validStart, validEnd int64) (int64, error) {
+	if tx == nil {
+		return 0, errors.New("tx is nil")
+	}
+	result := tx.WithContext(ctx).
+		Model(&ActivityTicket{}).
+		Where("activity_id = ? AND status = ?", activityID, TicketStatusUnused).
+		Updates(map[string]interface{}{
+			"valid_start_time": validStart,
+			"valid_end_time":   validEnd,
+		})
+	return result.RowsAffected, result.Error
+}
+
 // ResetForReuse 重置票据为可用状态（事务内）
 func (m *ActivityTicketModel) ResetForReuse(ctx context.Context, tx *gorm.DB, id uint64) error {
 	if tx == nil {
@@ -0,0 +1,77 @@
+package model
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrCalendarTokenNotFound = errors.New("日历订阅令牌不存在")
+)
+
+// ==================== CalendarFeedToken 日历订阅令牌 ====================
+
+// CalendarFeedToken 用户日历订阅令牌
+//
+// 订阅地址由日历客户端直接拉取，无法携带登录态，以随机令牌识别用户；
+// 每个用户只有一个令牌，重置后旧地址立即失效
+type CalendarFeedToken struct {
+	ID        uint64 `gorm:"primaryKey;autoIncrement"                                  json:"id"`
+	UserID    uint64 `gorm:"uniqueIndex:uk_user_id;not null;comment:用户ID"             json:"user_id"`
+	Token     string `gorm:"type:varchar(64);uniqueIndex:uk_token;not null;comment:订阅令牌" json:"-"`
+	CreatedAt int64  `gorm:"autoCreateTime"                                            json:"created_at"`
+	UpdatedAt int64  `gorm:"autoUpdateTime"                                            json:"updated_at"`
+}
+
+func (CalendarFeedToken) TableName() string {
+	return "calendar_feed_tokens"
+}
+
+// ==================== CalendarFeedTokenModel 数据访问层 ====================
+
+type CalendarFeedTokenModel struct {
+	db *gorm.DB
+}
+
+func NewCalendarFeedTokenModel(db *gorm.DB) *CalendarFeedTokenModel {
+	return &CalendarFeedTokenModel{db: db}
+}
+
+// FindByUserID 查询用户的订阅令牌（未生成返回 nil）
+func (m *CalendarFeedTokenModel) FindByUserID(ctx context.Context, userID uint64) (*CalendarFeedToken, error) {
+	var token CalendarFeedToken
+	err := m.db.WithContext(ctx).Where("user_id = ?", userID).First(&token).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &token, nil
+}
+
+// FindByToken 根据令牌查询
+func (m *CalendarFeedTokenModel) FindByToken(ctx context.Context, token string) (*CalendarFeedToken, error) {
+	var feedToken CalendarFeedToken
+	err := m.db.WithContext(ctx).Where("token = ?", token).First(&feedToken).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrCalendarTokenNotFound
+		}
+		return nil, err
+	}
+	return &feedToken, nil
+}
+
+// Upsert 生成或重置用户的订阅令牌
+func (m *CalendarFeedTokenModel) Upsert(ctx context.Context, userID uint64, token string) error {
+	return m.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"token", "updated_at"}),
+		}).
+		Create(&CalendarFeedToken{UserID: userID, Token: token}).Error
+}
@@ -63,3 +63,124 @@ func (m *CategoryModel) Exists(ctx context.Context, id uint64) (bool, error) {
 		Count(&count).Error
 	return count > 0, err
 }
+
+// ==================== 管理端方法 ====================
+
+// 分类状态
+const (
+	CategoryStatusDisabled int8 = 0 // 禁用
+	CategoryStatusEnabled  int8 = 1 // 启用
+)
+
+// FindByIDAny 根据ID查询（包含已禁用的分类）
+func (m *CategoryModel) FindByIDAny(ctx context.Context, id uint64) (*Category, error) {
+	var category Category
+	err := m.db.WithContext(ctx).
+		Where("id = ?", id).
+		First(&category).Error
+	if err != nil {
+		return nil, err
+	}
+	return &category, nil
+}
+
+// ListAll 获取全部分类（包含已禁用，管理端使用）
+func (m *CategoryModel) ListAll(ctx context.Context) ([]Category, error) {
+	var categories []Category
+	err := m.db.WithContext(ctx).
+		Order("sort DESC, id ASC").
+		Find(&categories).Error
+	return categories, err
+}
+
+// NameExists 检查分类名称是否被其他分类占用
+func (m *CategoryModel) NameExists(ctx context.Context, name string, excludeID uint64) (bool, error) {
+	var count int64
+	err := m.db.WithContext(ctx).
+		Model(&Category{}).
+		Where("name = ? AND id <> ?", name, excludeID).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// Create 创建分类
+func (m *CategoryModel) Create(ctx context.Context, category *Category) error {
+	return m.db.WithContext(ctx).Create(category).Error
+}
+
+// UpdateFields 按字段更新分类
+func (m *CategoryModel) UpdateFields(ctx context.Context, id uint64, fields map[string]interface{}) error {
+	return m.db.WithContext(ctx).
+		Model(&Category{}).
+		Where("id = ?", id).
+		Updates(fields).Error
+}
+
+// UpdateSorts 批量更新排序权重（事务内完成，map[分类ID]排序权重）
+func (m *CategoryModel) UpdateSorts(ctx context.Context, sorts map[uint64]int) error {
+	if len(sorts) == 0 {
+		return nil
+	}
+	return m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for id, sort := range sorts {
+			if err := tx.Model(&Category{}).
+				Where("id = ?", id).
+				Update("sort", sort).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Delete 删除分类（调用前应确认没有活动引用）
+func (m *CategoryModel) Delete(ctx context.Context, id uint64) error {
+	return m.db.WithContext(ctx).
+		Where("id = ?", id).
+		Delete(&Category{}).Error
+}
+
+// CountActivities 统计分类下的活动数量（含已删除的活动，避免恢复后分类悬空）
+func (m *CategoryModel) CountActivities(ctx context.Context, id uint64) (int64, error) {
+	var count int64
+	err := m.db.WithContext(ctx).
+		Unscoped().
+		Model(&Activity{}).
+		Where("category_id = ?", id).
+		Count(&count).Error
+	return count, err
+}
+
+// ListActivityIDs 查询分类下未删除的活动ID（用于搜索索引重建）
+func (m *CategoryModel) ListActivityIDs(ctx context.Context, id uint64) ([]uint64, error) {
+	var ids []uint64
+	err := m.db.WithContext(ctx).
+		Model(&Activity{}).
+		Where("category_id = ?", id).
+		Pluck("id", &ids).Error
+	return ids, err
+}
+
+// CategoryActivityCount 分类活动数
+type CategoryActivityCount struct {
+	CategoryID uint64 `gorm:"column:category_id"`
+	Count      int64  `gorm:"column:cnt"`
+}
+
+// CountActivitiesByCategory 按分类统计未删除的活动数（map[分类ID]活动数）
+func (m *CategoryModel) CountActivitiesByCategory(ctx context.Context) (map[uint64]int64, error) {
+	var rows []CategoryActivityCount
+	err := m.db.WithContext(ctx).
+		Model(&Activity{}).
+		Select("category_id, COUNT(*) AS cnt").
+		Group("category_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[uint64]int64, len(rows))
+	for _, row := range rows {
+		result[row.CategoryID] = row.Count
+	}
+	return result, nil
+}
@@ -13,6 +13,13 @@ var (
 	ErrCheckInRecordNotFound = errors.New("核销记录不存在")
 )
 
+// ==================== 签到方式 ====================
+
+const (
+	CheckInMethodScan int8 = 1 // 组织者扫码核销
+	CheckInMethodSelf int8 = 2 // 参与者自助签到（定位 + 现场签到码）
+)
+
 // ==================== CheckInRecord 核销记录模型 ====================
 
 type CheckInRecord struct {
@@ -21,7 +28,7 @@ type CheckInRecord struct {
 
 	TicketID   uint64 `gorm:"index:idx_ticket_id;not null;comment:票据ID" json:"ticket_id"`
 	TicketCode string `gorm:"type:varchar(32);not null;comment:票据短码" json:"ticket_code"`
-	ActivityID uint64 `gorm:"index:idx_activity_id;not null;comment:活动ID" json:"activity_id"`
+	ActivityID uint64 `gorm:"index:idx_activity_id;index:idx_activity_device,priority:1;not null;comment:活动ID" json:"activity_id"`
 	UserID     uint64 `gorm:"index:idx_user_id;not null;comment:用户ID" json:"user_id"`
 
 	CheckInTime int64 `gorm:"default:0;comment:核销时间" json:"check_in_time"`
@@ -29,6 +36,13 @@ type CheckInRecord struct {
 	Longitude float64 `gorm:"type:decimal(10,7);comment:经度" json:"longitude"`
 	Latitude  float64 `gorm:"type:decimal(10,7);comment:纬度" json:"latitude"`
 
+	// 自助签到信息
+	CheckInMethod  int8   `gorm:"default:1;comment:签到方式 1扫码 2自助" json:"check_in_method"`
+	DeviceID       string `gorm:"type:varchar(64);index:idx_activity_device,priority:2;default:'';comment:设备标识" json:"device_id"`
+	DistanceMeters int    `gorm:"default:0;comment:签到位置距活动地点(米)" json:"distance_meters"`
+	Flagged        bool   `gorm:"default:false;comment:是否疑似异常" json:"flagged"`
+	FlagReason     string `gorm:"type:varchar(255);default:'';comment:异常原因" json:"flag_reason"`
+
 	ClientRequestID string `gorm:"type:varchar(64);uniqueIndex:uk_client_request_id;not null;comment:请求ID(幂等)" json:"client_request_id"`
 
 	CheckInSnapshot string `gorm:"type:text;comment:核销快照" json:"check_in_snapshot"`
@@ -56,6 +70,14 @@ func (m *CheckInRecordModel) Create(ctx context.Context, record *CheckInRecord)
 	return m.db.WithContext(ctx).Create(record).Error
 }
 
+// CreateTx 创建核销记录（事务内）
+func (m *CheckInRecordModel) CreateTx(ctx context.Context, tx *gorm.DB, record *CheckInRecord) error {
+	if tx == nil {
+		return errors.New("tx is nil")
+	}
+	return tx.WithContext(ctx).Create(record).Error
+}
+
 // FindByID 根据ID查询
 func (m *CheckInRecordModel) FindByID(ctx context.Context, id uint64) (*CheckInRecord, error) {
 	var record CheckInRecord
@@ -142,3 +164,35 @@ func (m *CheckInRecordModel) CountByActivityID(ctx context.Context, activityID u
 		Count(&count).Error
 	return count, err
 }
+
+// CountOtherUsersByDevice 统计同一活动中使用该设备签到的其他用户数（自助签到异常检测）
+func (m *CheckInRecordModel) CountOtherUsersByDevice(ctx context.Context, activityID uint64, deviceID string, userID uint64) (int64, error) {
+	var count int64
+	err := m.db.WithContext(ctx).
+		Model(&CheckInRecord{}).
+		Where("activity_id = ? AND device_id = ? AND user_id <> ?", activityID, deviceID, userID).
+		Distinct("user_id").
+		Count(&count).Error
+	return count, err
+}
+
+// ListByActivityFiltered 分页查询活动签到记录（flaggedOnly=true 只返回疑似异常记录）
+func (m *CheckInRecordModel) ListByActivityFiltered(ctx context.Context, activityID uint64, flaggedOnly bool, offset, limit int) ([]CheckInRecord, int64, error) {
+	db := m.db.WithContext(ctx).
+		Model(&CheckInRecord{}).
+		Where("activity_id = ?", activityID)
+	if flaggedOnly {
+		db = db.Where("flagged = ?", true)
+	}
+
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var records []CheckInRecord
+	err := db.Order("created_at DESC").
+		Offset(offset).
+		Limit(limit).
+		Find(&records).Error
+	return records, total, err
+}
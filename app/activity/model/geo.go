@@ -0,0 +1,78 @@
+package model
+
+import "math"
+
+// ==================== 地理位置工具 ====================
+//
+// 活动坐标以 WGS84 经纬度存储（activities.longitude / latitude）
+// MySQL 降级搜索使用「外接矩形粗筛 + ST_Distance_Sphere 精确过滤」两段式查询
+
+const (
+	earthRadiusMeters = 6371000.0 // 地球平均半径（米）
+	metersPerDegree   = 111320.0  // 纬度 1° 对应的距离（米）
+
+	DefaultGeoRadiusKm = 5.0  // 默认搜索半径（公里）
+	MaxGeoRadiusKm     = 50.0 // 最大搜索半径（公里）
+)
+
+// distanceSQL 计算活动与查询点的球面距离（米）
+// 参数顺序：查询点经度, 查询点纬度
+const distanceSQL = "ST_Distance_Sphere(POINT(longitude, latitude), POINT(?, ?))"
+
+// GeoCircle 圆形地理范围
+type GeoCircle struct {
+	Lat      float64 // 中心点纬度
+	Lon      float64 // 中心点经度
+	RadiusKm float64 // 半径（公里）
+}
+
+// GeoBox 经纬度外接矩形
+type GeoBox struct {
+	MinLat, MaxLat float64
+	MinLon, MaxLon float64
+}
+
+// RadiusMeters 半径（米）
+func (c *GeoCircle) RadiusMeters() float64 {
+	return c.RadiusKm * 1000
+}
+
+// BoundingBox 计算圆形范围的外接矩形
+//
+// 经度方向的跨度随纬度增大而变大（cos(lat) 变小），
+// 高纬度地区做了下限保护，避免除零
+func (c *GeoCircle) BoundingBox() GeoBox {
+	latDelta := c.RadiusMeters() / metersPerDegree
+	cosLat := math.Cos(c.Lat * math.Pi / 180)
+	if cosLat < 0.01 {
+		cosLat = 0.01
+	}
+	lonDelta := c.RadiusMeters() / (metersPerDegree * cosLat)
+
+	return GeoBox{
+		MinLat: math.Max(c.Lat-latDelta, -90),
+		MaxLat: math.Min(c.Lat+latDelta, 90),
+		MinLon: math.Max(c.Lon-lonDelta, -180),
+		MaxLon: math.Min(c.Lon+lonDelta, 180),
+	}
+}
+
+// ValidCoordinate 校验经纬度是否合法
+// (0, 0) 视为未设置坐标（活动表中坐标可选，默认值为 0）
+func ValidCoordinate(lon, lat float64) bool {
+	if lon == 0 && lat == 0 {
+		return false
+	}
+	return lat >= -90 && lat <= 90 && lon >= -180 && lon <= 180
+}
+
+// DistanceMeters 使用 Haversine 公式计算两点间球面距离（米）
+func DistanceMeters(lat1, lon1, lat2, lon2 float64) float64 {
+	toRad := func(d float64) float64 { return d * math.Pi / 180 }
+
+	dLat := toRad(lat2 - lat1)
+	dLon := toRad(lon2 - lon1)
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(toRad(lat1))*math.Cos(toRad(lat2))*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadiusMeters * math.Asin(math.Sqrt(a))
+}
@@ -0,0 +1,63 @@
+package model
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ==================== SelfCheckInSetting 自助签到设置模型 ====================
+//
+// 组织者为活动开启自助签到后，参与者提交票券、当前定位与现场屏幕上的轮换签到码即可签到。
+// CodeSecret 为签到码派生密钥，每次重新开启时更换，旧屏幕上的签到码随之失效
+type SelfCheckInSetting struct {
+	ID           uint64 `gorm:"primaryKey;autoIncrement" json:"id"`
+	ActivityID   uint64 `gorm:"uniqueIndex:uk_activity_id;not null;comment:活动ID" json:"activity_id"`
+	Enabled      bool   `gorm:"default:false;comment:是否开启" json:"enabled"`
+	RadiusMeters int    `gorm:"not null;comment:签到半径(米)" json:"radius_meters"`
+	CodeSecret   string `gorm:"type:varchar(64);not null;comment:签到码密钥" json:"-"`
+	OperatorID   uint64 `gorm:"not null;default:0;comment:最后设置人" json:"operator_id"`
+	CreatedAt    int64  `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt    int64  `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+func (SelfCheckInSetting) TableName() string {
+	return "activity_self_check_in_settings"
+}
+
+// ==================== SelfCheckInSettingModel 数据访问层 ====================
+
+type SelfCheckInSettingModel struct {
+	db *gorm.DB
+}
+
+func NewSelfCheckInSettingModel(db *gorm.DB) *SelfCheckInSettingModel {
+	return &SelfCheckInSettingModel{db: db}
+}
+
+// FindByActivityID 查询活动的自助签到设置（未设置过返回 nil）
+func (m *SelfCheckInSettingModel) FindByActivityID(ctx context.Context, activityID uint64) (*SelfCheckInSetting, error) {
+	var setting SelfCheckInSetting
+	err := m.db.WithContext(ctx).
+		Where("activity_id = ?", activityID).
+		First(&setting).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &setting, nil
+}
+
+// Upsert 保存自助签到设置（按活动唯一）
+func (m *SelfCheckInSettingModel) Upsert(ctx context.Context, setting *SelfCheckInSetting) error {
+	return m.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "activity_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"enabled", "radius_meters", "code_secret", "operator_id", "updated_at"}),
+		}).
+		Create(setting).Error
+}
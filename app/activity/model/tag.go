@@ -98,3 +98,31 @@ func (m *ActivityTagModel) CountByActivityID(ctx context.Context, activityID uin
 		Count(&count).Error
 	return count, err
 }
+
+// ListActivityIDsByTag 查询使用该标签的活动 ID 列表
+//
+// 用途：标签重命名/禁用/合并后重建这些活动的搜索索引
+func (m *ActivityTagModel) ListActivityIDsByTag(ctx context.Context, tagID uint64) ([]uint64, error) {
+	var ids []uint64
+	err := m.db.WithContext(ctx).
+		Model(&ActivityTag{}).
+		Where("tag_id = ?", tagID).
+		Pluck("activity_id", &ids).Error
+	return ids, err
+}
+
+// RebindTag 将源标签的活动关联改绑到目标标签（事务内使用）
+//
+// 已同时绑定源标签和目标标签的活动，直接删除源标签关联，避免唯一索引冲突
+func (m *ActivityTagModel) RebindTag(ctx context.Context, tx *gorm.DB, sourceTagID, targetTagID uint64) error {
+	db := tx.WithContext(ctx)
+	if err := db.Exec(`
+		DELETE s FROM activity_tags s
+		INNER JOIN activity_tags t ON t.activity_id = s.activity_id AND t.tag_id = ?
+		WHERE s.tag_id = ?`, targetTagID, sourceTagID).Error; err != nil {
+		return err
+	}
+	return db.Model(&ActivityTag{}).
+		Where("tag_id = ?", sourceTagID).
+		Update("tag_id", targetTagID).Error
+}
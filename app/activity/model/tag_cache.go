@@ -76,6 +76,24 @@ func (m *TagCacheModel) FindHot(ctx context.Context, limit int) ([]TagCache, err
 	return tags, err
 }
 
+// TagCacheWithStats 标签及活动使用统计（管理端列表）
+type TagCacheWithStats struct {
+	TagCache
+	ActivityCount uint32 `gorm:"column:activity_count"`
+}
+
+// FindAllWithStats 获取全部标签（包含已禁用）及关联活动数，管理端使用
+func (m *TagCacheModel) FindAllWithStats(ctx context.Context) ([]TagCacheWithStats, error) {
+	var tags []TagCacheWithStats
+	err := m.db.WithContext(ctx).
+		Table("tag_cache tc").
+		Select("tc.*, COALESCE(ats.activity_count, 0) AS activity_count").
+		Joins("LEFT JOIN activity_tag_stats ats ON tc.id = ats.tag_id").
+		Order("tc.status DESC, tc.id ASC").
+		Find(&tags).Error
+	return tags, err
+}
+
 // FindByID 根据 ID 查询单个标签
 func (m *TagCacheModel) FindByID(ctx context.Context, id uint64) (*TagCache, error) {
 	var tag TagCache
@@ -236,6 +254,23 @@ func (m *TagCacheModel) DeleteByID(ctx context.Context, id uint64) error {
 		Delete(&TagCache{}).Error
 }
 
+// DisableExcept 禁用不在启用列表中的标签（用于定时同步对账）
+//
+// 用户服务全量接口只返回启用的标签，未出现的标签视为已禁用或已合并
+func (m *TagCacheModel) DisableExcept(ctx context.Context, enabledIDs []uint64) (int64, error) {
+	if len(enabledIDs) == 0 {
+		return 0, nil
+	}
+	result := m.db.WithContext(ctx).
+		Model(&TagCache{}).
+		Where("id NOT IN ? AND status = ?", enabledIDs, 1).
+		Updates(map[string]interface{}{
+			"status":    0,
+			"synced_at": time.Now().Unix(),
+		})
+	return result.RowsAffected, result.Error
+}
+
 // GetLastSyncTime 获取最后同步时间
 func (m *TagCacheModel) GetLastSyncTime(ctx context.Context) (int64, error) {
 	var maxSyncedAt int64
@@ -1,12 +1,15 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 
+	"activity-platform/app/activity/model"
 	"activity-platform/app/activity/rpc/activity"
 	"activity-platform/app/activity/rpc/internal/config"
 	"activity-platform/app/activity/rpc/internal/cron"
+	"activity-platform/app/activity/rpc/internal/logic"
 	"activity-platform/app/activity/rpc/internal/server"
 	activitybranchserver "activity-platform/app/activity/rpc/internal/server/activitybranchservice"
 	"activity-platform/app/activity/rpc/internal/svc"
@@ -42,7 +45,7 @@ func main() {
 		ctx.ActivityModel,
 		ctx.StatusLogModel,
 		ctx.ActivityCache,
-		ctx.SyncService,
+		ctx.ChangeEventModel,
 		ctx.MsgProducer,
 	)
 	statusCron.Start()
@@ -64,6 +67,62 @@ func main() {
 	recommendCron.Start()
 	defer recommendCron.Stop()
 
+	// 4.6 启动活动变更事件消费（Outbox → ES / 详情缓存 / 热门缓存 / 推荐缓存 / 日历订阅）
+	changeRelay := cron.NewChangeRelay(ctx.Redis, ctx.ChangeEventModel)
+	if ctx.SyncService != nil {
+		changeRelay.Subscribe("es", ctx.SyncService.SyncActivities)
+	}
+	changeRelay.Subscribe("activity_cache", ctx.ActivityCache.InvalidateBatch)
+	// 热门列表整体重建，只在影响上榜资格的变更时刷新；报名人数等变化由 5min TTL 兜底
+	changeRelay.SubscribeTypes("hot_cache", []string{
+		model.ChangeTypeCreate,
+		model.ChangeTypeStatus,
+		model.ChangeTypeDelete,
+		model.ChangeTypeRestore,
+	}, func(c context.Context, _ []uint64) error {
+		return ctx.HotCache.Refresh(c)
+	})
+	changeRelay.Subscribe("recommend_cache", recommendCron.EvictInactive)
+	changeRelay.Subscribe("calendar_feed", ctx.CalendarCache.InvalidateActivities)
+	changeRelay.Start()
+	defer changeRelay.Stop()
+
+	// 4.7 启动定时提醒任务（活动开始前提醒、报名截止提醒）
+	reminderCron := cron.NewReminderCron(
+		ctx.Reminders,
+		ctx.ActivityModel,
+		ctx.ActivityRegistrationModel,
+		ctx.FavoriteModel,
+		ctx.MsgProducer,
+	)
+	reminderCron.SetInterval(c.Reminder.PollIntervalSeconds)
+	reminderCron.Start()
+	defer reminderCron.Stop()
+
+	// 4.8 启动系列活动定时任务（按重复规则生成场次、为整期报名用户出票）
+	seriesCron := cron.NewSeriesCron(
+		ctx.Redis,
+		func(c context.Context) error {
+			return logic.NewSeriesScheduler(c, ctx).GenerateAll()
+		},
+		func(c context.Context) error {
+			return logic.NewSeriesScheduler(c, ctx).EnrollAll()
+		},
+	)
+	seriesCron.SetInterval(c.Series.IntervalSeconds)
+	seriesCron.Start()
+	defer seriesCron.Stop()
+
+	// 4.9 启动活动统计聚合定时任务（组织者数据看板的汇总表 + 平台统计上报）
+	analyticsCron := cron.NewAnalyticsCron(ctx.Redis, func(c context.Context) error {
+		return logic.NewAnalyticsAggregator(c, ctx).RunAll()
+	}, func(c context.Context) error {
+		return logic.NewPlatformStatsReporter(c, ctx).ReportRecent()
+	})
+	analyticsCron.SetInterval(c.Analytics.IntervalSeconds)
+	analyticsCron.Start()
+	defer analyticsCron.Stop()
+
 	// 5. DTM 客户端关闭（如果启用）
 	if ctx.DTMClient != nil {
 		defer ctx.DTMClient.Close()
@@ -268,6 +268,8 @@ type ActivityDetail struct {
 	Version                int32                  `protobuf:"varint,33,opt,name=version,proto3" json:"version,omitempty"`                                                              // 乐观锁版本号
 	RegistrationStatus     int32                  `protobuf:"varint,34,opt,name=registration_status,json=registrationStatus,proto3" json:"registration_status,omitempty"`              // 报名状态: 0=不适用, 1=未开始报名, 2=报名中, 3=报名已截止
 	RegistrationStatusText string                 `protobuf:"bytes,35,opt,name=registration_status_text,json=registrationStatusText,proto3" json:"registration_status_text,omitempty"` // 报名状态文本
+	RatingAvg              float64                `protobuf:"fixed64,36,opt,name=rating_avg,json=ratingAvg,proto3" json:"rating_avg,omitempty"`                                        // 平均评分（1-5，0=暂无评价）
+	RatingCount            int32                  `protobuf:"varint,37,opt,name=rating_count,json=ratingCount,proto3" json:"rating_count,omitempty"`                                   // 评价人数
 	unknownFields          protoimpl.UnknownFields
 	sizeCache              protoimpl.SizeCache
 }
@@ -547,6 +549,20 @@ func (x *ActivityDetail) GetRegistrationStatusText() string {
 	return ""
 }
 
+func (x *ActivityDetail) GetRatingAvg() float64 {
+	if x != nil {
+		return x.RatingAvg
+	}
+	return 0
+}
+
+func (x *ActivityDetail) GetRatingCount() int32 {
+	if x != nil {
+		return x.RatingCount
+	}
+	return 0
+}
+
 // ActivityListItem 活动列表项（简化信息）
 type ActivityListItem struct {
 	state                  protoimpl.MessageState `protogen:"open.v1"`
@@ -568,6 +584,11 @@ type ActivityListItem struct {
 	CreatedAt              int64                  `protobuf:"varint,16,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	RegistrationStatus     int32                  `protobuf:"varint,17,opt,name=registration_status,json=registrationStatus,proto3" json:"registration_status,omitempty"`              // 报名状态: 0=不适用, 1=未开始报名, 2=报名中, 3=报名已截止
 	RegistrationStatusText string                 `protobuf:"bytes,18,opt,name=registration_status_text,json=registrationStatusText,proto3" json:"registration_status_text,omitempty"` // 报名状态文本
+	Distance               float64                `protobuf:"fixed64,19,opt,name=distance,proto3" json:"distance,omitempty"`                                                           // 与查询坐标的距离（米），仅地理搜索时返回
+	TitleHighlight         string                 `protobuf:"bytes,20,opt,name=title_highlight,json=titleHighlight,proto3" json:"title_highlight,omitempty"`                           // 标题高亮（<em> 包裹命中词），仅搜索时返回
+	DescriptionHighlight   string                 `protobuf:"bytes,21,opt,name=description_highlight,json=descriptionHighlight,proto3" json:"description_highlight,omitempty"`         // 描述高亮片段，仅搜索时返回
+	RatingAvg              float64                `protobuf:"fixed64,22,opt,name=rating_avg,json=ratingAvg,proto3" json:"rating_avg,omitempty"`                                        // 平均评分（0=暂无评价）
+	RatingCount            int32                  `protobuf:"varint,23,opt,name=rating_count,json=ratingCount,proto3" json:"rating_count,omitempty"`                                   // 评价人数
 	unknownFields          protoimpl.UnknownFields
 	sizeCache              protoimpl.SizeCache
 }
@@ -728,6 +749,41 @@ func (x *ActivityListItem) GetRegistrationStatusText() string {
 	return ""
 }
 
+func (x *ActivityListItem) GetDistance() float64 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+func (x *ActivityListItem) GetTitleHighlight() string {
+	if x != nil {
+		return x.TitleHighlight
+	}
+	return ""
+}
+
+func (x *ActivityListItem) GetDescriptionHighlight() string {
+	if x != nil {
+		return x.DescriptionHighlight
+	}
+	return ""
+}
+
+func (x *ActivityListItem) GetRatingAvg() float64 {
+	if x != nil {
+		return x.RatingAvg
+	}
+	return 0
+}
+
+func (x *ActivityListItem) GetRatingCount() int32 {
+	if x != nil {
+		return x.RatingCount
+	}
+	return 0
+}
+
 // 报名活动请求
 type RegisterActivityRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -783,9 +839,10 @@ func (x *RegisterActivityRequest) GetUserId() int64 {
 
 // 报名活动响应
 type RegisterActivityResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Result        string                 `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
-	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Result        string                  `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	Reason        string                  `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	FailedRules   []*EligibilityCheckItem `protobuf:"bytes,3,rep,name=failed_rules,json=failedRules,proto3" json:"failed_rules,omitempty"` // 未通过的资格规则（因资格不满足失败时返回）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -834,6 +891,13 @@ func (x *RegisterActivityResponse) GetReason() string {
 	return ""
 }
 
+func (x *RegisterActivityResponse) GetFailedRules() []*EligibilityCheckItem {
+	if x != nil {
+		return x.FailedRules
+	}
+	return nil
+}
+
 // 取消报名活动请求
 type CancelActivityRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1702,51 +1766,39 @@ func (x *GetRegisteredCountResponse) GetCount() int32 {
 	return 0
 }
 
-type CreateActivityReq struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	Title                string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	CoverUrl             string                 `protobuf:"bytes,2,opt,name=cover_url,json=coverUrl,proto3" json:"cover_url,omitempty"`
-	CoverType            int32                  `protobuf:"varint,3,opt,name=cover_type,json=coverType,proto3" json:"cover_type,omitempty"`
-	Content              string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
-	CategoryId           int64                  `protobuf:"varint,5,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
-	ContactPhone         string                 `protobuf:"bytes,6,opt,name=contact_phone,json=contactPhone,proto3" json:"contact_phone,omitempty"`
-	RegisterStartTime    int64                  `protobuf:"varint,7,opt,name=register_start_time,json=registerStartTime,proto3" json:"register_start_time,omitempty"`
-	RegisterEndTime      int64                  `protobuf:"varint,8,opt,name=register_end_time,json=registerEndTime,proto3" json:"register_end_time,omitempty"`
-	ActivityStartTime    int64                  `protobuf:"varint,9,opt,name=activity_start_time,json=activityStartTime,proto3" json:"activity_start_time,omitempty"`
-	ActivityEndTime      int64                  `protobuf:"varint,10,opt,name=activity_end_time,json=activityEndTime,proto3" json:"activity_end_time,omitempty"`
-	Location             string                 `protobuf:"bytes,11,opt,name=location,proto3" json:"location,omitempty"`
-	AddressDetail        string                 `protobuf:"bytes,12,opt,name=address_detail,json=addressDetail,proto3" json:"address_detail,omitempty"`
-	Longitude            float64                `protobuf:"fixed64,13,opt,name=longitude,proto3" json:"longitude,omitempty"`
-	Latitude             float64                `protobuf:"fixed64,14,opt,name=latitude,proto3" json:"latitude,omitempty"`
-	MaxParticipants      int32                  `protobuf:"varint,15,opt,name=max_participants,json=maxParticipants,proto3" json:"max_participants,omitempty"`
-	RequireApproval      bool                   `protobuf:"varint,16,opt,name=require_approval,json=requireApproval,proto3" json:"require_approval,omitempty"`
-	RequireStudentVerify bool                   `protobuf:"varint,17,opt,name=require_student_verify,json=requireStudentVerify,proto3" json:"require_student_verify,omitempty"`
-	MinCreditScore       int32                  `protobuf:"varint,18,opt,name=min_credit_score,json=minCreditScore,proto3" json:"min_credit_score,omitempty"`
-	TagIds               []int64                `protobuf:"varint,19,rep,packed,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty"`
-	IsDraft              bool                   `protobuf:"varint,20,opt,name=is_draft,json=isDraft,proto3" json:"is_draft,omitempty"`
-	// Gateway 传入
-	OrganizerId     int64  `protobuf:"varint,21,opt,name=organizer_id,json=organizerId,proto3" json:"organizer_id,omitempty"`
-	OrganizerName   string `protobuf:"bytes,22,opt,name=organizer_name,json=organizerName,proto3" json:"organizer_name,omitempty"`
-	OrganizerAvatar string `protobuf:"bytes,23,opt,name=organizer_avatar,json=organizerAvatar,proto3" json:"organizer_avatar,omitempty"`
-	CoverImageId    int64  `protobuf:"varint,24,opt,name=cover_image_id,json=coverImageId,proto3" json:"cover_image_id,omitempty"` // 封面图片ID(关联sys_images)
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+// 报名资格规则
+// rule_type:
+//
+//	min_credit     - 最低信用分（min_value）
+//	verified_only  - 仅限已认证学生
+//	school         - 限定学校（values，任一匹配）
+//	department     - 限定院系（values，任一匹配）
+//	admission_year - 入学年份区间（min_value ~ max_value，0=不限）
+type EligibilityRule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RuleType      string                 `protobuf:"bytes,1,opt,name=rule_type,json=ruleType,proto3" json:"rule_type,omitempty"`  // 规则类型
+	MinValue      int32                  `protobuf:"varint,2,opt,name=min_value,json=minValue,proto3" json:"min_value,omitempty"` // 下限
+	MaxValue      int32                  `protobuf:"varint,3,opt,name=max_value,json=maxValue,proto3" json:"max_value,omitempty"` // 上限
+	Values        []string               `protobuf:"bytes,4,rep,name=values,proto3" json:"values,omitempty"`                      // 取值列表
+	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`            // 规则说明（只读）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateActivityReq) Reset() {
-	*x = CreateActivityReq{}
+func (x *EligibilityRule) Reset() {
+	*x = EligibilityRule{}
 	mi := &file_activity_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateActivityReq) String() string {
+func (x *EligibilityRule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateActivityReq) ProtoMessage() {}
+func (*EligibilityRule) ProtoMessage() {}
 
-func (x *CreateActivityReq) ProtoReflect() protoreflect.Message {
+func (x *EligibilityRule) ProtoReflect() protoreflect.Message {
 	mi := &file_activity_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1758,202 +1810,244 @@ func (x *CreateActivityReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateActivityReq.ProtoReflect.Descriptor instead.
-func (*CreateActivityReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use EligibilityRule.ProtoReflect.Descriptor instead.
+func (*EligibilityRule) Descriptor() ([]byte, []int) {
 	return file_activity_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *CreateActivityReq) GetTitle() string {
+func (x *EligibilityRule) GetRuleType() string {
 	if x != nil {
-		return x.Title
+		return x.RuleType
 	}
 	return ""
 }
 
-func (x *CreateActivityReq) GetCoverUrl() string {
+func (x *EligibilityRule) GetMinValue() int32 {
 	if x != nil {
-		return x.CoverUrl
+		return x.MinValue
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateActivityReq) GetCoverType() int32 {
+func (x *EligibilityRule) GetMaxValue() int32 {
 	if x != nil {
-		return x.CoverType
+		return x.MaxValue
 	}
 	return 0
 }
 
-func (x *CreateActivityReq) GetContent() string {
+func (x *EligibilityRule) GetValues() []string {
 	if x != nil {
-		return x.Content
+		return x.Values
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateActivityReq) GetCategoryId() int64 {
+func (x *EligibilityRule) GetDescription() string {
 	if x != nil {
-		return x.CategoryId
+		return x.Description
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateActivityReq) GetContactPhone() string {
-	if x != nil {
-		return x.ContactPhone
-	}
-	return ""
+// 单条规则校验结果
+type EligibilityCheckItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RuleType      string                 `protobuf:"bytes,1,opt,name=rule_type,json=ruleType,proto3" json:"rule_type,omitempty"` // 规则类型（platform_credit 为平台内置信用规则）
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`           // 规则说明
+	Passed        bool                   `protobuf:"varint,3,opt,name=passed,proto3" json:"passed,omitempty"`                    // 是否通过
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`                     // 未通过原因
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateActivityReq) GetRegisterStartTime() int64 {
-	if x != nil {
-		return x.RegisterStartTime
-	}
-	return 0
+func (x *EligibilityCheckItem) Reset() {
+	*x = EligibilityCheckItem{}
+	mi := &file_activity_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateActivityReq) GetRegisterEndTime() int64 {
-	if x != nil {
-		return x.RegisterEndTime
-	}
-	return 0
+func (x *EligibilityCheckItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *CreateActivityReq) GetActivityStartTime() int64 {
+func (*EligibilityCheckItem) ProtoMessage() {}
+
+func (x *EligibilityCheckItem) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[22]
 	if x != nil {
-		return x.ActivityStartTime
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *CreateActivityReq) GetActivityEndTime() int64 {
-	if x != nil {
-		return x.ActivityEndTime
-	}
-	return 0
+// Deprecated: Use EligibilityCheckItem.ProtoReflect.Descriptor instead.
+func (*EligibilityCheckItem) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *CreateActivityReq) GetLocation() string {
+func (x *EligibilityCheckItem) GetRuleType() string {
 	if x != nil {
-		return x.Location
+		return x.RuleType
 	}
 	return ""
 }
 
-func (x *CreateActivityReq) GetAddressDetail() string {
+func (x *EligibilityCheckItem) GetDescription() string {
 	if x != nil {
-		return x.AddressDetail
+		return x.Description
 	}
 	return ""
 }
 
-func (x *CreateActivityReq) GetLongitude() float64 {
+func (x *EligibilityCheckItem) GetPassed() bool {
 	if x != nil {
-		return x.Longitude
+		return x.Passed
 	}
-	return 0
+	return false
 }
 
-func (x *CreateActivityReq) GetLatitude() float64 {
+func (x *EligibilityCheckItem) GetReason() string {
 	if x != nil {
-		return x.Latitude
+		return x.Reason
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateActivityReq) GetMaxParticipants() int32 {
-	if x != nil {
-		return x.MaxParticipants
-	}
-	return 0
+// 设置报名资格规则请求
+type SetEligibilityRulesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActivityId    int64                  `protobuf:"varint,1,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"` // 活动ID
+	OperatorId    int64                  `protobuf:"varint,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"` // 操作人ID（必须为组织者）
+	Rules         []*EligibilityRule     `protobuf:"bytes,3,rep,name=rules,proto3" json:"rules,omitempty"`                              // 规则列表（为空表示清空）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateActivityReq) GetRequireApproval() bool {
+func (x *SetEligibilityRulesReq) Reset() {
+	*x = SetEligibilityRulesReq{}
+	mi := &file_activity_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetEligibilityRulesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetEligibilityRulesReq) ProtoMessage() {}
+
+func (x *SetEligibilityRulesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[23]
 	if x != nil {
-		return x.RequireApproval
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *CreateActivityReq) GetRequireStudentVerify() bool {
+// Deprecated: Use SetEligibilityRulesReq.ProtoReflect.Descriptor instead.
+func (*SetEligibilityRulesReq) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SetEligibilityRulesReq) GetActivityId() int64 {
 	if x != nil {
-		return x.RequireStudentVerify
+		return x.ActivityId
 	}
-	return false
+	return 0
 }
 
-func (x *CreateActivityReq) GetMinCreditScore() int32 {
+func (x *SetEligibilityRulesReq) GetOperatorId() int64 {
 	if x != nil {
-		return x.MinCreditScore
+		return x.OperatorId
 	}
 	return 0
 }
 
-func (x *CreateActivityReq) GetTagIds() []int64 {
+func (x *SetEligibilityRulesReq) GetRules() []*EligibilityRule {
 	if x != nil {
-		return x.TagIds
+		return x.Rules
 	}
 	return nil
 }
 
-func (x *CreateActivityReq) GetIsDraft() bool {
-	if x != nil {
-		return x.IsDraft
-	}
-	return false
+// 设置报名资格规则响应
+type SetEligibilityRulesResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*EligibilityRule     `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"` // 规范化后的规则列表
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateActivityReq) GetOrganizerId() int64 {
-	if x != nil {
-		return x.OrganizerId
-	}
-	return 0
+func (x *SetEligibilityRulesResp) Reset() {
+	*x = SetEligibilityRulesResp{}
+	mi := &file_activity_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateActivityReq) GetOrganizerName() string {
-	if x != nil {
-		return x.OrganizerName
-	}
-	return ""
+func (x *SetEligibilityRulesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *CreateActivityReq) GetOrganizerAvatar() string {
+func (*SetEligibilityRulesResp) ProtoMessage() {}
+
+func (x *SetEligibilityRulesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[24]
 	if x != nil {
-		return x.OrganizerAvatar
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CreateActivityReq) GetCoverImageId() int64 {
+// Deprecated: Use SetEligibilityRulesResp.ProtoReflect.Descriptor instead.
+func (*SetEligibilityRulesResp) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SetEligibilityRulesResp) GetRules() []*EligibilityRule {
 	if x != nil {
-		return x.CoverImageId
+		return x.Rules
 	}
-	return 0
+	return nil
 }
 
-type CreateActivityResp struct {
+// 获取报名资格规则请求
+type GetEligibilityRulesReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Status        int32                  `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	ActivityId    int64                  `protobuf:"varint,1,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"` // 活动ID
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateActivityResp) Reset() {
-	*x = CreateActivityResp{}
-	mi := &file_activity_proto_msgTypes[22]
+func (x *GetEligibilityRulesReq) Reset() {
+	*x = GetEligibilityRulesReq{}
+	mi := &file_activity_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateActivityResp) String() string {
+func (x *GetEligibilityRulesReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateActivityResp) ProtoMessage() {}
+func (*GetEligibilityRulesReq) ProtoMessage() {}
 
-func (x *CreateActivityResp) ProtoReflect() protoreflect.Message {
-	mi := &file_activity_proto_msgTypes[22]
+func (x *GetEligibilityRulesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1964,70 +2058,43 @@ func (x *CreateActivityResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateActivityResp.ProtoReflect.Descriptor instead.
-func (*CreateActivityResp) Descriptor() ([]byte, []int) {
-	return file_activity_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use GetEligibilityRulesReq.ProtoReflect.Descriptor instead.
+func (*GetEligibilityRulesReq) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *CreateActivityResp) GetId() int64 {
+func (x *GetEligibilityRulesReq) GetActivityId() int64 {
 	if x != nil {
-		return x.Id
+		return x.ActivityId
 	}
 	return 0
 }
 
-func (x *CreateActivityResp) GetStatus() int32 {
-	if x != nil {
-		return x.Status
-	}
-	return 0
-}
-
-type UpdateActivityReq struct {
+// 获取报名资格规则响应
+type GetEligibilityRulesResp struct {
 	state                protoimpl.MessageState `protogen:"open.v1"`
-	Id                   int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Version              int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
-	Title                *string                `protobuf:"bytes,3,opt,name=title,proto3,oneof" json:"title,omitempty"`
-	CoverUrl             *string                `protobuf:"bytes,4,opt,name=cover_url,json=coverUrl,proto3,oneof" json:"cover_url,omitempty"`
-	CoverType            *int32                 `protobuf:"varint,5,opt,name=cover_type,json=coverType,proto3,oneof" json:"cover_type,omitempty"`
-	Content              *string                `protobuf:"bytes,6,opt,name=content,proto3,oneof" json:"content,omitempty"`
-	CategoryId           *int64                 `protobuf:"varint,7,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
-	ContactPhone         *string                `protobuf:"bytes,8,opt,name=contact_phone,json=contactPhone,proto3,oneof" json:"contact_phone,omitempty"`
-	RegisterStartTime    *int64                 `protobuf:"varint,9,opt,name=register_start_time,json=registerStartTime,proto3,oneof" json:"register_start_time,omitempty"`
-	RegisterEndTime      *int64                 `protobuf:"varint,10,opt,name=register_end_time,json=registerEndTime,proto3,oneof" json:"register_end_time,omitempty"`
-	ActivityStartTime    *int64                 `protobuf:"varint,11,opt,name=activity_start_time,json=activityStartTime,proto3,oneof" json:"activity_start_time,omitempty"`
-	ActivityEndTime      *int64                 `protobuf:"varint,12,opt,name=activity_end_time,json=activityEndTime,proto3,oneof" json:"activity_end_time,omitempty"`
-	Location             *string                `protobuf:"bytes,13,opt,name=location,proto3,oneof" json:"location,omitempty"`
-	AddressDetail        *string                `protobuf:"bytes,14,opt,name=address_detail,json=addressDetail,proto3,oneof" json:"address_detail,omitempty"`
-	Longitude            *float64               `protobuf:"fixed64,15,opt,name=longitude,proto3,oneof" json:"longitude,omitempty"`
-	Latitude             *float64               `protobuf:"fixed64,16,opt,name=latitude,proto3,oneof" json:"latitude,omitempty"`
-	MaxParticipants      *int32                 `protobuf:"varint,17,opt,name=max_participants,json=maxParticipants,proto3,oneof" json:"max_participants,omitempty"`
-	RequireApproval      *bool                  `protobuf:"varint,18,opt,name=require_approval,json=requireApproval,proto3,oneof" json:"require_approval,omitempty"`
-	RequireStudentVerify *bool                  `protobuf:"varint,19,opt,name=require_student_verify,json=requireStudentVerify,proto3,oneof" json:"require_student_verify,omitempty"`
-	MinCreditScore       *int32                 `protobuf:"varint,20,opt,name=min_credit_score,json=minCreditScore,proto3,oneof" json:"min_credit_score,omitempty"`
-	TagIds               []int64                `protobuf:"varint,21,rep,packed,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty"`
-	UpdateTags           bool                   `protobuf:"varint,22,opt,name=update_tags,json=updateTags,proto3" json:"update_tags,omitempty"`
-	OperatorId           int64                  `protobuf:"varint,23,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
-	CoverImageId         *int64                 `protobuf:"varint,24,opt,name=cover_image_id,json=coverImageId,proto3,oneof" json:"cover_image_id,omitempty"` // 封面图片ID(关联sys_images)
+	Rules                []*EligibilityRule     `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`                                                              // 组织者声明的规则
+	MinCreditScore       int32                  `protobuf:"varint,2,opt,name=min_credit_score,json=minCreditScore,proto3" json:"min_credit_score,omitempty"`                   // 活动字段：最低信用分
+	RequireStudentVerify bool                   `protobuf:"varint,3,opt,name=require_student_verify,json=requireStudentVerify,proto3" json:"require_student_verify,omitempty"` // 活动字段：是否需要学生认证
 	unknownFields        protoimpl.UnknownFields
 	sizeCache            protoimpl.SizeCache
 }
 
-func (x *UpdateActivityReq) Reset() {
-	*x = UpdateActivityReq{}
-	mi := &file_activity_proto_msgTypes[23]
+func (x *GetEligibilityRulesResp) Reset() {
+	*x = GetEligibilityRulesResp{}
+	mi := &file_activity_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateActivityReq) String() string {
+func (x *GetEligibilityRulesResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateActivityReq) ProtoMessage() {}
+func (*GetEligibilityRulesResp) ProtoMessage() {}
 
-func (x *UpdateActivityReq) ProtoReflect() protoreflect.Message {
-	mi := &file_activity_proto_msgTypes[23]
+func (x *GetEligibilityRulesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2038,202 +2105,7798 @@ func (x *UpdateActivityReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateActivityReq.ProtoReflect.Descriptor instead.
-func (*UpdateActivityReq) Descriptor() ([]byte, []int) {
-	return file_activity_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use GetEligibilityRulesResp.ProtoReflect.Descriptor instead.
+func (*GetEligibilityRulesResp) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *UpdateActivityReq) GetId() int64 {
+func (x *GetEligibilityRulesResp) GetRules() []*EligibilityRule {
 	if x != nil {
-		return x.Id
+		return x.Rules
 	}
-	return 0
+	return nil
 }
 
-func (x *UpdateActivityReq) GetVersion() int32 {
+func (x *GetEligibilityRulesResp) GetMinCreditScore() int32 {
 	if x != nil {
-		return x.Version
+		return x.MinCreditScore
 	}
 	return 0
 }
 
-func (x *UpdateActivityReq) GetTitle() string {
-	if x != nil && x.Title != nil {
-		return *x.Title
+func (x *GetEligibilityRulesResp) GetRequireStudentVerify() bool {
+	if x != nil {
+		return x.RequireStudentVerify
 	}
-	return ""
+	return false
 }
 
-func (x *UpdateActivityReq) GetCoverUrl() string {
-	if x != nil && x.CoverUrl != nil {
-		return *x.CoverUrl
+// 报名资格预检请求
+type CheckEligibilityReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActivityId    int64                  `protobuf:"varint,1,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"` // 活动ID
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`             // 用户ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckEligibilityReq) Reset() {
+	*x = CheckEligibilityReq{}
+	mi := &file_activity_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckEligibilityReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckEligibilityReq) ProtoMessage() {}
+
+func (x *CheckEligibilityReq) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateActivityReq) GetCoverType() int32 {
-	if x != nil && x.CoverType != nil {
-		return *x.CoverType
+// Deprecated: Use CheckEligibilityReq.ProtoReflect.Descriptor instead.
+func (*CheckEligibilityReq) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CheckEligibilityReq) GetActivityId() int64 {
+	if x != nil {
+		return x.ActivityId
 	}
 	return 0
 }
 
-func (x *UpdateActivityReq) GetContent() string {
-	if x != nil && x.Content != nil {
-		return *x.Content
+func (x *CheckEligibilityReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateActivityReq) GetCategoryId() int64 {
-	if x != nil && x.CategoryId != nil {
-		return *x.CategoryId
+// 报名资格预检响应
+type CheckEligibilityResp struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Eligible      bool                    `protobuf:"varint,1,opt,name=eligible,proto3" json:"eligible,omitempty"`                          // 是否满足全部资格规则
+	Items         []*EligibilityCheckItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`                                 // 逐条校验结果
+	CanRegister   bool                    `protobuf:"varint,3,opt,name=can_register,json=canRegister,proto3" json:"can_register,omitempty"` // 综合判断当前能否报名（含活动状态、报名时间、名额）
+	Reason        string                  `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`                               // 不能报名的原因
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckEligibilityResp) Reset() {
+	*x = CheckEligibilityResp{}
+	mi := &file_activity_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckEligibilityResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckEligibilityResp) ProtoMessage() {}
+
+func (x *CheckEligibilityResp) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateActivityReq) GetContactPhone() string {
-	if x != nil && x.ContactPhone != nil {
-		return *x.ContactPhone
+// Deprecated: Use CheckEligibilityResp.ProtoReflect.Descriptor instead.
+func (*CheckEligibilityResp) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CheckEligibilityResp) GetEligible() bool {
+	if x != nil {
+		return x.Eligible
+	}
+	return false
+}
+
+func (x *CheckEligibilityResp) GetItems() []*EligibilityCheckItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *CheckEligibilityResp) GetCanRegister() bool {
+	if x != nil {
+		return x.CanRegister
+	}
+	return false
+}
+
+func (x *CheckEligibilityResp) GetReason() string {
+	if x != nil {
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *UpdateActivityReq) GetRegisterStartTime() int64 {
-	if x != nil && x.RegisterStartTime != nil {
-		return *x.RegisterStartTime
+// 提交活动评价请求
+type SubmitFeedbackReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActivityId    int64                  `protobuf:"varint,1,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"` // 活动ID
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`             // 评价用户ID
+	Rating        int32                  `protobuf:"varint,3,opt,name=rating,proto3" json:"rating,omitempty"`                           // 评分（1-5）
+	Comment       string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`                          // 短评（可选，最多200字）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitFeedbackReq) Reset() {
+	*x = SubmitFeedbackReq{}
+	mi := &file_activity_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitFeedbackReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitFeedbackReq) ProtoMessage() {}
+
+func (x *SubmitFeedbackReq) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateActivityReq) GetRegisterEndTime() int64 {
-	if x != nil && x.RegisterEndTime != nil {
-		return *x.RegisterEndTime
+// Deprecated: Use SubmitFeedbackReq.ProtoReflect.Descriptor instead.
+func (*SubmitFeedbackReq) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SubmitFeedbackReq) GetActivityId() int64 {
+	if x != nil {
+		return x.ActivityId
 	}
 	return 0
 }
 
-func (x *UpdateActivityReq) GetActivityStartTime() int64 {
-	if x != nil && x.ActivityStartTime != nil {
-		return *x.ActivityStartTime
+func (x *SubmitFeedbackReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *UpdateActivityReq) GetActivityEndTime() int64 {
-	if x != nil && x.ActivityEndTime != nil {
-		return *x.ActivityEndTime
+func (x *SubmitFeedbackReq) GetRating() int32 {
+	if x != nil {
+		return x.Rating
 	}
 	return 0
 }
 
-func (x *UpdateActivityReq) GetLocation() string {
-	if x != nil && x.Location != nil {
-		return *x.Location
+func (x *SubmitFeedbackReq) GetComment() string {
+	if x != nil {
+		return x.Comment
 	}
 	return ""
 }
 
-func (x *UpdateActivityReq) GetAddressDetail() string {
-	if x != nil && x.AddressDetail != nil {
-		return *x.AddressDetail
+// 提交活动评价响应
+type SubmitFeedbackResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RatingAvg     float64                `protobuf:"fixed64,1,opt,name=rating_avg,json=ratingAvg,proto3" json:"rating_avg,omitempty"`            // 活动最新平均评分
+	RatingCount   int32                  `protobuf:"varint,2,opt,name=rating_count,json=ratingCount,proto3" json:"rating_count,omitempty"`       // 活动最新评价人数
+	CommentStatus int32                  `protobuf:"varint,3,opt,name=comment_status,json=commentStatus,proto3" json:"comment_status,omitempty"` // 短评状态：0-公开 1-待审核 2-已隐藏
+	Comment       string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`                                   // 处置后的短评（命中替换词时已掩码）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitFeedbackResp) Reset() {
+	*x = SubmitFeedbackResp{}
+	mi := &file_activity_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitFeedbackResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitFeedbackResp) ProtoMessage() {}
+
+func (x *SubmitFeedbackResp) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateActivityReq) GetLongitude() float64 {
-	if x != nil && x.Longitude != nil {
-		return *x.Longitude
+// Deprecated: Use SubmitFeedbackResp.ProtoReflect.Descriptor instead.
+func (*SubmitFeedbackResp) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SubmitFeedbackResp) GetRatingAvg() float64 {
+	if x != nil {
+		return x.RatingAvg
 	}
 	return 0
 }
 
-func (x *UpdateActivityReq) GetLatitude() float64 {
-	if x != nil && x.Latitude != nil {
-		return *x.Latitude
+func (x *SubmitFeedbackResp) GetRatingCount() int32 {
+	if x != nil {
+		return x.RatingCount
 	}
 	return 0
 }
 
-func (x *UpdateActivityReq) GetMaxParticipants() int32 {
-	if x != nil && x.MaxParticipants != nil {
-		return *x.MaxParticipants
+func (x *SubmitFeedbackResp) GetCommentStatus() int32 {
+	if x != nil {
+		return x.CommentStatus
 	}
 	return 0
 }
 
-func (x *UpdateActivityReq) GetRequireApproval() bool {
-	if x != nil && x.RequireApproval != nil {
-		return *x.RequireApproval
+func (x *SubmitFeedbackResp) GetComment() string {
+	if x != nil {
+		return x.Comment
 	}
-	return false
+	return ""
 }
 
-func (x *UpdateActivityReq) GetRequireStudentVerify() bool {
-	if x != nil && x.RequireStudentVerify != nil {
-		return *x.RequireStudentVerify
-	}
-	return false
+// 评价短评
+type FeedbackComment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Rating        int32                  `protobuf:"varint,3,opt,name=rating,proto3" json:"rating,omitempty"`
+	Comment       string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     int64                  `protobuf:"varint,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FeedbackComment) Reset() {
+	*x = FeedbackComment{}
+	mi := &file_activity_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeedbackComment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeedbackComment) ProtoMessage() {}
+
+func (x *FeedbackComment) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeedbackComment.ProtoReflect.Descriptor instead.
+func (*FeedbackComment) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *FeedbackComment) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *FeedbackComment) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *FeedbackComment) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *FeedbackComment) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *FeedbackComment) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *FeedbackComment) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+// 活动评价汇总请求
+type GetFeedbackSummaryReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActivityId    int64                  `protobuf:"varint,1,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"` // 活动ID
+	OperatorId    int64                  `protobuf:"varint,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"` // 操作人ID（必须为组织者）
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`                               // 短评页码
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`       // 短评每页数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFeedbackSummaryReq) Reset() {
+	*x = GetFeedbackSummaryReq{}
+	mi := &file_activity_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFeedbackSummaryReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFeedbackSummaryReq) ProtoMessage() {}
+
+func (x *GetFeedbackSummaryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFeedbackSummaryReq.ProtoReflect.Descriptor instead.
+func (*GetFeedbackSummaryReq) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetFeedbackSummaryReq) GetActivityId() int64 {
+	if x != nil {
+		return x.ActivityId
+	}
+	return 0
+}
+
+func (x *GetFeedbackSummaryReq) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *GetFeedbackSummaryReq) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetFeedbackSummaryReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// 活动评价汇总响应
+type GetFeedbackSummaryResp struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	RatingAvg           float64                `protobuf:"fixed64,1,opt,name=rating_avg,json=ratingAvg,proto3" json:"rating_avg,omitempty"`                                // 平均评分
+	RatingCount         int32                  `protobuf:"varint,2,opt,name=rating_count,json=ratingCount,proto3" json:"rating_count,omitempty"`                           // 评价人数
+	Distribution        []int64                `protobuf:"varint,3,rep,packed,name=distribution,proto3" json:"distribution,omitempty"`                                     // 各分值人数（下标 0 对应 1 分）
+	PendingCommentCount int64                  `protobuf:"varint,4,opt,name=pending_comment_count,json=pendingCommentCount,proto3" json:"pending_comment_count,omitempty"` // 待审核短评数（审核通过前不展示）
+	Comments            []*FeedbackComment     `protobuf:"bytes,5,rep,name=comments,proto3" json:"comments,omitempty"`                                                     // 公开短评
+	Pagination          *Pagination            `protobuf:"bytes,6,opt,name=pagination,proto3" json:"pagination,omitempty"`                                                 // 短评分页
+	FeedbackDeadline    int64                  `protobuf:"varint,7,opt,name=feedback_deadline,json=feedbackDeadline,proto3" json:"feedback_deadline,omitempty"`            // 评价截止时间（Unix 秒）
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *GetFeedbackSummaryResp) Reset() {
+	*x = GetFeedbackSummaryResp{}
+	mi := &file_activity_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFeedbackSummaryResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFeedbackSummaryResp) ProtoMessage() {}
+
+func (x *GetFeedbackSummaryResp) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFeedbackSummaryResp.ProtoReflect.Descriptor instead.
+func (*GetFeedbackSummaryResp) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetFeedbackSummaryResp) GetRatingAvg() float64 {
+	if x != nil {
+		return x.RatingAvg
+	}
+	return 0
+}
+
+func (x *GetFeedbackSummaryResp) GetRatingCount() int32 {
+	if x != nil {
+		return x.RatingCount
+	}
+	return 0
+}
+
+func (x *GetFeedbackSummaryResp) GetDistribution() []int64 {
+	if x != nil {
+		return x.Distribution
+	}
+	return nil
+}
+
+func (x *GetFeedbackSummaryResp) GetPendingCommentCount() int64 {
+	if x != nil {
+		return x.PendingCommentCount
+	}
+	return 0
+}
+
+func (x *GetFeedbackSummaryResp) GetComments() []*FeedbackComment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *GetFeedbackSummaryResp) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *GetFeedbackSummaryResp) GetFeedbackDeadline() int64 {
+	if x != nil {
+		return x.FeedbackDeadline
+	}
+	return 0
+}
+
+// 评价短评审核列表请求（管理员）
+type ListFeedbackReviewsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"` // 审核状态：0-待审核 1-已通过 2-已驳回
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFeedbackReviewsReq) Reset() {
+	*x = ListFeedbackReviewsReq{}
+	mi := &file_activity_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFeedbackReviewsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFeedbackReviewsReq) ProtoMessage() {}
+
+func (x *ListFeedbackReviewsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFeedbackReviewsReq.ProtoReflect.Descriptor instead.
+func (*ListFeedbackReviewsReq) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListFeedbackReviewsReq) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *ListFeedbackReviewsReq) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListFeedbackReviewsReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// 评价短评审核记录
+type FeedbackReviewItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                            // 审核记录ID
+	ActivityId    int64                  `protobuf:"varint,2,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"`          // 活动ID
+	ActivityTitle string                 `protobuf:"bytes,3,opt,name=activity_title,json=activityTitle,proto3" json:"activity_title,omitempty"`  // 活动标题
+	FeedbackId    int64                  `protobuf:"varint,4,opt,name=feedback_id,json=feedbackId,proto3" json:"feedback_id,omitempty"`          // 评价ID
+	UserId        int64                  `protobuf:"varint,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                      // 评价用户ID
+	Comment       string                 `protobuf:"bytes,6,opt,name=comment,proto3" json:"comment,omitempty"`                                   // 当前短评内容（评价已删除时为空）
+	CommentStatus int32                  `protobuf:"varint,7,opt,name=comment_status,json=commentStatus,proto3" json:"comment_status,omitempty"` // 当前短评状态：0-公开 1-待审核 2-已隐藏
+	HitWords      []string               `protobuf:"bytes,8,rep,name=hit_words,json=hitWords,proto3" json:"hit_words,omitempty"`                 // 命中的送审词
+	Status        int32                  `protobuf:"varint,9,opt,name=status,proto3" json:"status,omitempty"`                                    // 审核状态
+	ReviewerId    int64                  `protobuf:"varint,10,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`         // 审核人ID
+	ReviewNote    string                 `protobuf:"bytes,11,opt,name=review_note,json=reviewNote,proto3" json:"review_note,omitempty"`          // 审核备注
+	ReviewedAt    int64                  `protobuf:"varint,12,opt,name=reviewed_at,json=reviewedAt,proto3" json:"reviewed_at,omitempty"`         // 审核时间
+	CreatedAt     int64                  `protobuf:"varint,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`            // 送审时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FeedbackReviewItem) Reset() {
+	*x = FeedbackReviewItem{}
+	mi := &file_activity_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeedbackReviewItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeedbackReviewItem) ProtoMessage() {}
+
+func (x *FeedbackReviewItem) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeedbackReviewItem.ProtoReflect.Descriptor instead.
+func (*FeedbackReviewItem) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *FeedbackReviewItem) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *FeedbackReviewItem) GetActivityId() int64 {
+	if x != nil {
+		return x.ActivityId
+	}
+	return 0
+}
+
+func (x *FeedbackReviewItem) GetActivityTitle() string {
+	if x != nil {
+		return x.ActivityTitle
+	}
+	return ""
+}
+
+func (x *FeedbackReviewItem) GetFeedbackId() int64 {
+	if x != nil {
+		return x.FeedbackId
+	}
+	return 0
+}
+
+func (x *FeedbackReviewItem) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *FeedbackReviewItem) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *FeedbackReviewItem) GetCommentStatus() int32 {
+	if x != nil {
+		return x.CommentStatus
+	}
+	return 0
+}
+
+func (x *FeedbackReviewItem) GetHitWords() []string {
+	if x != nil {
+		return x.HitWords
+	}
+	return nil
+}
+
+func (x *FeedbackReviewItem) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *FeedbackReviewItem) GetReviewerId() int64 {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return 0
+}
+
+func (x *FeedbackReviewItem) GetReviewNote() string {
+	if x != nil {
+		return x.ReviewNote
+	}
+	return ""
+}
+
+func (x *FeedbackReviewItem) GetReviewedAt() int64 {
+	if x != nil {
+		return x.ReviewedAt
+	}
+	return 0
+}
+
+func (x *FeedbackReviewItem) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// 评价短评审核列表响应
+type ListFeedbackReviewsResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          []*FeedbackReviewItem  `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	Pagination    *Pagination            `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFeedbackReviewsResp) Reset() {
+	*x = ListFeedbackReviewsResp{}
+	mi := &file_activity_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFeedbackReviewsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFeedbackReviewsResp) ProtoMessage() {}
+
+func (x *ListFeedbackReviewsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFeedbackReviewsResp.ProtoReflect.Descriptor instead.
+func (*ListFeedbackReviewsResp) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListFeedbackReviewsResp) GetList() []*FeedbackReviewItem {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *ListFeedbackReviewsResp) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// 审核评价短评请求（管理员）
+type ReviewFeedbackCommentReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReviewId      int64                  `protobuf:"varint,1,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`       // 审核记录ID
+	OperatorId    int64                  `protobuf:"varint,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"` // 审核人ID
+	Approve       bool                   `protobuf:"varint,3,opt,name=approve,proto3" json:"approve,omitempty"`                         // true-通过（公开） false-驳回（隐藏）
+	Note          string                 `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`                                // 审核备注（可选，最多500字）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewFeedbackCommentReq) Reset() {
+	*x = ReviewFeedbackCommentReq{}
+	mi := &file_activity_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewFeedbackCommentReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewFeedbackCommentReq) ProtoMessage() {}
+
+func (x *ReviewFeedbackCommentReq) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewFeedbackCommentReq.ProtoReflect.Descriptor instead.
+func (*ReviewFeedbackCommentReq) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ReviewFeedbackCommentReq) GetReviewId() int64 {
+	if x != nil {
+		return x.ReviewId
+	}
+	return 0
+}
+
+func (x *ReviewFeedbackCommentReq) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *ReviewFeedbackCommentReq) GetApprove() bool {
+	if x != nil {
+		return x.Approve
+	}
+	return false
+}
+
+func (x *ReviewFeedbackCommentReq) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+// 审核评价短评响应
+type ReviewFeedbackCommentResp struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Status         int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`                                       // 审核状态：1-已通过 2-已驳回
+	CommentUpdated bool                   `protobuf:"varint,2,opt,name=comment_updated,json=commentUpdated,proto3" json:"comment_updated,omitempty"` // 短评状态是否随之更新（用户已重新提交评价时不更新）
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReviewFeedbackCommentResp) Reset() {
+	*x = ReviewFeedbackCommentResp{}
+	mi := &file_activity_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewFeedbackCommentResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewFeedbackCommentResp) ProtoMessage() {}
+
+func (x *ReviewFeedbackCommentResp) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewFeedbackCommentResp.ProtoReflect.Descriptor instead.
+func (*ReviewFeedbackCommentResp) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ReviewFeedbackCommentResp) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *ReviewFeedbackCommentResp) GetCommentUpdated() bool {
+	if x != nil {
+		return x.CommentUpdated
+	}
+	return false
+}
+
+type CreateActivityReq struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Title                string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	CoverUrl             string                 `protobuf:"bytes,2,opt,name=cover_url,json=coverUrl,proto3" json:"cover_url,omitempty"`
+	CoverType            int32                  `protobuf:"varint,3,opt,name=cover_type,json=coverType,proto3" json:"cover_type,omitempty"`
+	Content              string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	CategoryId           int64                  `protobuf:"varint,5,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	ContactPhone         string                 `protobuf:"bytes,6,opt,name=contact_phone,json=contactPhone,proto3" json:"contact_phone,omitempty"`
+	RegisterStartTime    int64                  `protobuf:"varint,7,opt,name=register_start_time,json=registerStartTime,proto3" json:"register_start_time,omitempty"`
+	RegisterEndTime      int64                  `protobuf:"varint,8,opt,name=register_end_time,json=registerEndTime,proto3" json:"register_end_time,omitempty"`
+	ActivityStartTime    int64                  `protobuf:"varint,9,opt,name=activity_start_time,json=activityStartTime,proto3" json:"activity_start_time,omitempty"`
+	ActivityEndTime      int64                  `protobuf:"varint,10,opt,name=activity_end_time,json=activityEndTime,proto3" json:"activity_end_time,omitempty"`
+	Location             string                 `protobuf:"bytes,11,opt,name=location,proto3" json:"location,omitempty"`
+	AddressDetail        string                 `protobuf:"bytes,12,opt,name=address_detail,json=addressDetail,proto3" json:"address_detail,omitempty"`
+	Longitude            float64                `protobuf:"fixed64,13,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Latitude             float64                `protobuf:"fixed64,14,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	MaxParticipants      int32                  `protobuf:"varint,15,opt,name=max_participants,json=maxParticipants,proto3" json:"max_participants,omitempty"`
+	RequireApproval      bool                   `protobuf:"varint,16,opt,name=require_approval,json=requireApproval,proto3" json:"require_approval,omitempty"`
+	RequireStudentVerify bool                   `protobuf:"varint,17,opt,name=require_student_verify,json=requireStudentVerify,proto3" json:"require_student_verify,omitempty"`
+	MinCreditScore       int32                  `protobuf:"varint,18,opt,name=min_credit_score,json=minCreditScore,proto3" json:"min_credit_score,omitempty"`
+	TagIds               []int64                `protobuf:"varint,19,rep,packed,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty"`
+	IsDraft              bool                   `protobuf:"varint,20,opt,name=is_draft,json=isDraft,proto3" json:"is_draft,omitempty"`
+	// Gateway 传入
+	OrganizerId     int64  `protobuf:"varint,21,opt,name=organizer_id,json=organizerId,proto3" json:"organizer_id,omitempty"`
+	OrganizerName   string `protobuf:"bytes,22,opt,name=organizer_name,json=organizerName,proto3" json:"organizer_name,omitempty"`
+	OrganizerAvatar string `protobuf:"bytes,23,opt,name=organizer_avatar,json=organizerAvatar,proto3" json:"organizer_avatar,omitempty"`
+	CoverImageId    int64  `protobuf:"varint,24,opt,name=cover_image_id,json=coverImageId,proto3" json:"cover_image_id,omitempty"` // 封面图片ID(关联sys_images)
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateActivityReq) Reset() {
+	*x = CreateActivityReq{}
+	mi := &file_activity_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateActivityReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateActivityReq) ProtoMessage() {}
+
+func (x *CreateActivityReq) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateActivityReq.ProtoReflect.Descriptor instead.
+func (*CreateActivityReq) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CreateActivityReq) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateActivityReq) GetCoverUrl() string {
+	if x != nil {
+		return x.CoverUrl
+	}
+	return ""
+}
+
+func (x *CreateActivityReq) GetCoverType() int32 {
+	if x != nil {
+		return x.CoverType
+	}
+	return 0
+}
+
+func (x *CreateActivityReq) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *CreateActivityReq) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *CreateActivityReq) GetContactPhone() string {
+	if x != nil {
+		return x.ContactPhone
+	}
+	return ""
+}
+
+func (x *CreateActivityReq) GetRegisterStartTime() int64 {
+	if x != nil {
+		return x.RegisterStartTime
+	}
+	return 0
+}
+
+func (x *CreateActivityReq) GetRegisterEndTime() int64 {
+	if x != nil {
+		return x.RegisterEndTime
+	}
+	return 0
+}
+
+func (x *CreateActivityReq) GetActivityStartTime() int64 {
+	if x != nil {
+		return x.ActivityStartTime
+	}
+	return 0
+}
+
+func (x *CreateActivityReq) GetActivityEndTime() int64 {
+	if x != nil {
+		return x.ActivityEndTime
+	}
+	return 0
+}
+
+func (x *CreateActivityReq) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *CreateActivityReq) GetAddressDetail() string {
+	if x != nil {
+		return x.AddressDetail
+	}
+	return ""
+}
+
+func (x *CreateActivityReq) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *CreateActivityReq) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *CreateActivityReq) GetMaxParticipants() int32 {
+	if x != nil {
+		return x.MaxParticipants
+	}
+	return 0
+}
+
+func (x *CreateActivityReq) GetRequireApproval() bool {
+	if x != nil {
+		return x.RequireApproval
+	}
+	return false
+}
+
+func (x *CreateActivityReq) GetRequireStudentVerify() bool {
+	if x != nil {
+		return x.RequireStudentVerify
+	}
+	return false
+}
+
+func (x *CreateActivityReq) GetMinCreditScore() int32 {
+	if x != nil {
+		return x.MinCreditScore
+	}
+	return 0
+}
+
+func (x *CreateActivityReq) GetTagIds() []int64 {
+	if x != nil {
+		return x.TagIds
+	}
+	return nil
+}
+
+func (x *CreateActivityReq) GetIsDraft() bool {
+	if x != nil {
+		return x.IsDraft
+	}
+	return false
+}
+
+func (x *CreateActivityReq) GetOrganizerId() int64 {
+	if x != nil {
+		return x.OrganizerId
+	}
+	return 0
+}
+
+func (x *CreateActivityReq) GetOrganizerName() string {
+	if x != nil {
+		return x.OrganizerName
+	}
+	return ""
+}
+
+func (x *CreateActivityReq) GetOrganizerAvatar() string {
+	if x != nil {
+		return x.OrganizerAvatar
+	}
+	return ""
+}
+
+func (x *CreateActivityReq) GetCoverImageId() int64 {
+	if x != nil {
+		return x.CoverImageId
+	}
+	return 0
+}
+
+type CreateActivityResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        int32                  `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateActivityResp) Reset() {
+	*x = CreateActivityResp{}
+	mi := &file_activity_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateActivityResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateActivityResp) ProtoMessage() {}
+
+func (x *CreateActivityResp) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateActivityResp.ProtoReflect.Descriptor instead.
+func (*CreateActivityResp) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *CreateActivityResp) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CreateActivityResp) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+type UpdateActivityReq struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Version              int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Title                *string                `protobuf:"bytes,3,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	CoverUrl             *string                `protobuf:"bytes,4,opt,name=cover_url,json=coverUrl,proto3,oneof" json:"cover_url,omitempty"`
+	CoverType            *int32                 `protobuf:"varint,5,opt,name=cover_type,json=coverType,proto3,oneof" json:"cover_type,omitempty"`
+	Content              *string                `protobuf:"bytes,6,opt,name=content,proto3,oneof" json:"content,omitempty"`
+	CategoryId           *int64                 `protobuf:"varint,7,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	ContactPhone         *string                `protobuf:"bytes,8,opt,name=contact_phone,json=contactPhone,proto3,oneof" json:"contact_phone,omitempty"`
+	RegisterStartTime    *int64                 `protobuf:"varint,9,opt,name=register_start_time,json=registerStartTime,proto3,oneof" json:"register_start_time,omitempty"`
+	RegisterEndTime      *int64                 `protobuf:"varint,10,opt,name=register_end_time,json=registerEndTime,proto3,oneof" json:"register_end_time,omitempty"`
+	ActivityStartTime    *int64                 `protobuf:"varint,11,opt,name=activity_start_time,json=activityStartTime,proto3,oneof" json:"activity_start_time,omitempty"`
+	ActivityEndTime      *int64                 `protobuf:"varint,12,opt,name=activity_end_time,json=activityEndTime,proto3,oneof" json:"activity_end_time,omitempty"`
+	Location             *string                `protobuf:"bytes,13,opt,name=location,proto3,oneof" json:"location,omitempty"`
+	AddressDetail        *string                `protobuf:"bytes,14,opt,name=address_detail,json=addressDetail,proto3,oneof" json:"address_detail,omitempty"`
+	Longitude            *float64               `protobuf:"fixed64,15,opt,name=longitude,proto3,oneof" json:"longitude,omitempty"`
+	Latitude             *float64               `protobuf:"fixed64,16,opt,name=latitude,proto3,oneof" json:"latitude,omitempty"`
+	MaxParticipants      *int32                 `protobuf:"varint,17,opt,name=max_participants,json=maxParticipants,proto3,oneof" json:"max_participants,omitempty"`
+	RequireApproval      *bool                  `protobuf:"varint,18,opt,name=require_approval,json=requireApproval,proto3,oneof" json:"require_approval,omitempty"`
+	RequireStudentVerify *bool                  `protobuf:"varint,19,opt,name=require_student_verify,json=requireStudentVerify,proto3,oneof" json:"require_student_verify,omitempty"`
+	MinCreditScore       *int32                 `protobuf:"varint,20,opt,name=min_credit_score,json=minCreditScore,proto3,oneof" json:"min_credit_score,omitempty"`
+	TagIds               []int64                `protobuf:"varint,21,rep,packed,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty"`
+	UpdateTags           bool                   `protobuf:"varint,22,opt,name=update_tags,json=updateTags,proto3" json:"update_tags,omitempty"`
+	OperatorId           int64                  `protobuf:"varint,23,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	CoverImageId         *int64                 `protobuf:"varint,24,opt,name=cover_image_id,json=coverImageId,proto3,oneof" json:"cover_image_id,omitempty"` // 封面图片ID(关联sys_images)
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *UpdateActivityReq) Reset() {
+	*x = UpdateActivityReq{}
+	mi := &file_activity_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateActivityReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateActivityReq) ProtoMessage() {}
+
+func (x *UpdateActivityReq) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateActivityReq.ProtoReflect.Descriptor instead.
+func (*UpdateActivityReq) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *UpdateActivityReq) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateActivityReq) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *UpdateActivityReq) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *UpdateActivityReq) GetCoverUrl() string {
+	if x != nil && x.CoverUrl != nil {
+		return *x.CoverUrl
+	}
+	return ""
+}
+
+func (x *UpdateActivityReq) GetCoverType() int32 {
+	if x != nil && x.CoverType != nil {
+		return *x.CoverType
+	}
+	return 0
+}
+
+func (x *UpdateActivityReq) GetContent() string {
+	if x != nil && x.Content != nil {
+		return *x.Content
+	}
+	return ""
+}
+
+func (x *UpdateActivityReq) GetCategoryId() int64 {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return 0
+}
+
+func (x *UpdateActivityReq) GetContactPhone() string {
+	if x != nil && x.ContactPhone != nil {
+		return *x.ContactPhone
+	}
+	return ""
+}
+
+func (x *UpdateActivityReq) GetRegisterStartTime() int64 {
+	if x != nil && x.RegisterStartTime != nil {
+		return *x.RegisterStartTime
+	}
+	return 0
+}
+
+func (x *UpdateActivityReq) GetRegisterEndTime() int64 {
+	if x != nil && x.RegisterEndTime != nil {
+		return *x.RegisterEndTime
+	}
+	return 0
+}
+
+func (x *UpdateActivityReq) GetActivityStartTime() int64 {
+	if x != nil && x.ActivityStartTime != nil {
+		return *x.ActivityStartTime
+	}
+	return 0
+}
+
+func (x *UpdateActivityReq) GetActivityEndTime() int64 {
+	if x != nil && x.ActivityEndTime != nil {
+		return *x.ActivityEndTime
+	}
+	return 0
+}
+
+func (x *UpdateActivityReq) GetLocation() string {
+	if x != nil && x.Location != nil {
+		return *x.Location
+	}
+	return ""
+}
+
+func (x *UpdateActivityReq) GetAddressDetail() string {
+	if x != nil && x.AddressDetail != nil {
+		return *x.AddressDetail
+	}
+	return ""
+}
+
+func (x *UpdateActivityReq) GetLongitude() float64 {
+	if x != nil && x.Longitude != nil {
+		return *x.Longitude
+	}
+	return 0
+}
+
+func (x *UpdateActivityReq) GetLatitude() float64 {
+	if x != nil && x.Latitude != nil {
+		return *x.Latitude
+	}
+	return 0
+}
+
+func (x *UpdateActivityReq) GetMaxParticipants() int32 {
+	if x != nil && x.MaxParticipants != nil {
+		return *x.MaxParticipants
+	}
+	return 0
+}
+
+func (x *UpdateActivityReq) GetRequireApproval() bool {
+	if x != nil && x.RequireApproval != nil {
+		return *x.RequireApproval
+	}
+	return false
+}
+
+func (x *UpdateActivityReq) GetRequireStudentVerify() bool {
+	if x != nil && x.RequireStudentVerify != nil {
+		return *x.RequireStudentVerify
+	}
+	return false
+}
+
+func (x *UpdateActivityReq) GetMinCreditScore() int32 {
+	if x != nil && x.MinCreditScore != nil {
+		return *x.MinCreditScore
+	}
+	return 0
+}
+
+func (x *UpdateActivityReq) GetTagIds() []int64 {
+	if x != nil {
+		return x.TagIds
+	}
+	return nil
+}
+
+func (x *UpdateActivityReq) GetUpdateTags() bool {
+	if x != nil {
+		return x.UpdateTags
+	}
+	return false
+}
+
+func (x *UpdateActivityReq) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *UpdateActivityReq) GetCoverImageId() int64 {
+	if x != nil && x.CoverImageId != nil {
+		return *x.CoverImageId
+	}
+	return 0
+}
+
+type UpdateActivityResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	NewVersion    int32                  `protobuf:"varint,2,opt,name=new_version,json=newVersion,proto3" json:"new_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateActivityResp) Reset() {
+	*x = UpdateActivityResp{}
+	mi := &file_activity_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateActivityResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateActivityResp) ProtoMessage() {}
+
+func (x *UpdateActivityResp) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateActivityResp.ProtoReflect.Descriptor instead.
+func (*UpdateActivityResp) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *UpdateActivityResp) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *UpdateActivityResp) GetNewVersion() int32 {
+	if x != nil {
+		return x.NewVersion
+	}
+	return 0
+}
+
+type DeleteActivityReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OperatorId    int64                  `protobuf:"varint,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	IsAdmin       bool                   `protobuf:"varint,3,opt,name=is_admin,json=isAdmin,proto3" json:"is_admin,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteActivityReq) Reset() {
+	*x = DeleteActivityReq{}
+	mi := &file_activity_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteActivityReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteActivityReq) ProtoMessage() {}
+
+func (x *DeleteActivityReq) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteActivityReq.ProtoReflect.Descriptor instead.
+func (*DeleteActivityReq) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *DeleteActivityReq) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeleteActivityReq) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *DeleteActivityReq) GetIsAdmin() bool {
+	if x != nil {
+		return x.IsAdmin
+	}
+	return false
+}
+
+type DeleteActivityResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteActivityResp) Reset() {
+	*x = DeleteActivityResp{}
+	mi := &file_activity_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteActivityResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteActivityResp) ProtoMessage() {}
+
+func (x *DeleteActivityResp) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteActivityResp.ProtoReflect.Descriptor instead.
+func (*DeleteActivityResp) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *DeleteActivityResp) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetActivityReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ViewerId      int64                  `protobuf:"varint,2,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetActivityReq) Reset() {
+	*x = GetActivityReq{}
+	mi := &file_activity_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetActivityReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActivityReq) ProtoMessage() {}
+
+func (x *GetActivityReq) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActivityReq.ProtoReflect.Descriptor instead.
+func (*GetActivityReq) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetActivityReq) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetActivityReq) GetViewerId() int64 {
+	if x != nil {
+		return x.ViewerId
+	}
+	return 0
+}
+
+type GetActivityResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Activity      *ActivityDetail        `protobuf:"bytes,1,opt,name=activity,proto3" json:"activity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetActivityResp) Reset() {
+	*x = GetActivityResp{}
+	mi := &file_activity_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetActivityResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActivityResp) ProtoMessage() {}
+
+func (x *GetActivityResp) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActivityResp.ProtoReflect.Descriptor instead.
+func (*GetActivityResp) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetActivityResp) GetActivity() *ActivityDetail {
+	if x != nil {
+		return x.Activity
+	}
+	return nil
+}
+
+type ListActivitiesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	CategoryId    int64                  `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Status        int32                  `protobuf:"varint,4,opt,name=status,proto3" json:"status,omitempty"` // -1=公开状态, -2=全部
+	OrganizerId   int64                  `protobuf:"varint,5,opt,name=organizer_id,json=organizerId,proto3" json:"organizer_id,omitempty"`
+	Sort          string                 `protobuf:"bytes,6,opt,name=sort,proto3" json:"sort,omitempty"` // created_at, hot, start_time
+	ViewerId      int64                  `protobuf:"varint,7,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
+	IsAdmin       bool                   `protobuf:"varint,8,opt,name=is_admin,json=isAdmin,proto3" json:"is_admin,omitempty"`
+	Recommend     bool                   `protobuf:"varint,9,opt,name=recommend,proto3" json:"recommend,omitempty"` // true=从Redis推荐缓存读取
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListActivitiesReq) Reset() {
+	*x = ListActivitiesReq{}
+	mi := &file_activity_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListActivitiesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListActivitiesReq) ProtoMessage() {}
+
+func (x *ListActivitiesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListActivitiesReq.ProtoReflect.Descriptor instead.
+func (*ListActivitiesReq) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListActivitiesReq) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListActivitiesReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListActivitiesReq) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *ListActivitiesReq) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *ListActivitiesReq) GetOrganizerId() int64 {
+	if x != nil {
+		return x.OrganizerId
+	}
+	return 0
+}
+
+func (x *ListActivitiesReq) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *ListActivitiesReq) GetViewerId() int64 {
+	if x != nil {
+		return x.ViewerId
+	}
+	return 0
+}
+
+func (x *ListActivitiesReq) GetIsAdmin() bool {
+	if x != nil {
+		return x.IsAdmin
+	}
+	return false
+}
+
+func (x *ListActivitiesReq) GetRecommend() bool {
+	if x != nil {
+		return x.Recommend
+	}
+	return false
+}
+
+type ListActivitiesResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          []*ActivityListItem    `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	Pagination    *Pagination            `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListActivitiesResp) Reset() {
+	*x = ListActivitiesResp{}
+	mi := &file_activity_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListActivitiesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListActivitiesResp) ProtoMessage() {}
+
+func (x *ListActivitiesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListActivitiesResp.ProtoReflect.Descriptor instead.
+func (*ListActivitiesResp) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListActivitiesResp) GetList() []*ActivityListItem {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *ListActivitiesResp) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type SubmitActivityReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OperatorId    int64                  `protobuf:"varint,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitActivityReq) Reset() {
+	*x = SubmitActivityReq{}
+	mi := &file_activity_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitActivityReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitActivityReq) ProtoMessage() {}
+
+func (x *SubmitActivityReq) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitActivityReq.ProtoReflect.Descriptor instead.
+func (*SubmitActivityReq) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *SubmitActivityReq) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SubmitActivityReq) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+type SubmitActivityResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitActivityResp) Reset() {
+	*x = SubmitActivityResp{}
+	mi := &file_activity_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitActivityResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitActivityResp) ProtoMessage() {}
+
+func (x *SubmitActivityResp) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitActivityResp.ProtoReflect.Descriptor instead.
+func (*SubmitActivityResp) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *SubmitActivityResp) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+type ApproveActivityReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OperatorId    int64                  `protobuf:"varint,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveActivityReq) Reset() {
+	*x = ApproveActivityReq{}
+	mi := &file_activity_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveActivityReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveActivityReq) ProtoMessage() {}
+
+func (x *ApproveActivityReq) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveActivityReq.ProtoReflect.Descriptor instead.
+func (*ApproveActivityReq) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ApproveActivityReq) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ApproveActivityReq) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+type ApproveActivityResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveActivityResp) Reset() {
+	*x = ApproveActivityResp{}
+	mi := &file_activity_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveActivityResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveActivityResp) ProtoMessage() {}
+
+func (x *ApproveActivityResp) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveActivityResp.ProtoReflect.Descriptor instead.
+func (*ApproveActivityResp) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ApproveActivityResp) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+type RejectActivityReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	OperatorId    int64                  `protobuf:"varint,3,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectActivityReq) Reset() {
+	*x = RejectActivityReq{}
+	mi := &file_activity_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectActivityReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectActivityReq) ProtoMessage() {}
+
+func (x *RejectActivityReq) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectActivityReq.ProtoReflect.Descriptor instead.
+func (*RejectActivityReq) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *RejectActivityReq) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RejectActivityReq) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RejectActivityReq) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+type RejectActivityResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectActivityResp) Reset() {
+	*x = RejectActivityResp{}
+	mi := &file_activity_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectActivityResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectActivityResp) ProtoMessage() {}
+
+func (x *RejectActivityResp) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectActivityResp.ProtoReflect.Descriptor instead.
+func (*RejectActivityResp) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *RejectActivityResp) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+type ListReviewQueueReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OperatorId    int64                  `protobuf:"varint,1,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`    // 管理员ID（scope=mine 时使用）
+	Scope         string                 `protobuf:"bytes,2,opt,name=scope,proto3" json:"scope,omitempty"`                                 // all（默认）/ mine / unassigned
+	OverdueOnly   bool                   `protobuf:"varint,3,opt,name=overdue_only,json=overdueOnly,proto3" json:"overdue_only,omitempty"` // 只看已超过 SLA 的
+	Page          int32                  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReviewQueueReq) Reset() {
+	*x = ListReviewQueueReq{}
+	mi := &file_activity_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReviewQueueReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReviewQueueReq) ProtoMessage() {}
+
+func (x *ListReviewQueueReq) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReviewQueueReq.ProtoReflect.Descriptor instead.
+func (*ListReviewQueueReq) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ListReviewQueueReq) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *ListReviewQueueReq) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *ListReviewQueueReq) GetOverdueOnly() bool {
+	if x != nil {
+		return x.OverdueOnly
+	}
+	return false
+}
+
+func (x *ListReviewQueueReq) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListReviewQueueReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// 字段差异（与最近一次审核通过的版本对比）
+type ReviewFieldDiff struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"` // 字段名
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"` // 字段中文名
+	OldValue      string                 `protobuf:"bytes,3,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"`
+	NewValue      string                 `protobuf:"bytes,4,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewFieldDiff) Reset() {
+	*x = ReviewFieldDiff{}
+	mi := &file_activity_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewFieldDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewFieldDiff) ProtoMessage() {}
+
+func (x *ReviewFieldDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewFieldDiff.ProtoReflect.Descriptor instead.
+func (*ReviewFieldDiff) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ReviewFieldDiff) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *ReviewFieldDiff) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *ReviewFieldDiff) GetOldValue() string {
+	if x != nil {
+		return x.OldValue
+	}
+	return ""
+}
+
+func (x *ReviewFieldDiff) GetNewValue() string {
+	if x != nil {
+		return x.NewValue
+	}
+	return ""
+}
+
+type ReviewQueueItem struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ReviewId           int64                  `protobuf:"varint,1,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	ActivityId         int64                  `protobuf:"varint,2,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"`
+	Title              string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	CoverUrl           string                 `protobuf:"bytes,4,opt,name=cover_url,json=coverUrl,proto3" json:"cover_url,omitempty"`
+	CategoryName       string                 `protobuf:"bytes,5,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
+	OrganizerId        int64                  `protobuf:"varint,6,opt,name=organizer_id,json=organizerId,proto3" json:"organizer_id,omitempty"`
+	OrganizerName      string                 `protobuf:"bytes,7,opt,name=organizer_name,json=organizerName,proto3" json:"organizer_name,omitempty"`
+	ActivityStartTime  int64                  `protobuf:"varint,8,opt,name=activity_start_time,json=activityStartTime,proto3" json:"activity_start_time,omitempty"`
+	PolicyReason       string                 `protobuf:"bytes,9,opt,name=policy_reason,json=policyReason,proto3" json:"policy_reason,omitempty"` // 进入人工审核的原因
+	Flagged            bool                   `protobuf:"varint,10,opt,name=flagged,proto3" json:"flagged,omitempty"`                             // 内容命中送审词
+	SubmittedAt        int64                  `protobuf:"varint,11,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`
+	DueAt              int64                  `protobuf:"varint,12,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"`                                  // SLA 截止时间
+	RemainingSeconds   int64                  `protobuf:"varint,13,opt,name=remaining_seconds,json=remainingSeconds,proto3" json:"remaining_seconds,omitempty"` // 距 SLA 截止的剩余秒数（已超时为负数）
+	Overdue            bool                   `protobuf:"varint,14,opt,name=overdue,proto3" json:"overdue,omitempty"`
+	AssigneeId         int64                  `protobuf:"varint,15,opt,name=assignee_id,json=assigneeId,proto3" json:"assignee_id,omitempty"` // 分配的审核员（0=未分配）
+	AssignedAt         int64                  `protobuf:"varint,16,opt,name=assigned_at,json=assignedAt,proto3" json:"assigned_at,omitempty"`
+	HasApprovedVersion bool                   `protobuf:"varint,17,opt,name=has_approved_version,json=hasApprovedVersion,proto3" json:"has_approved_version,omitempty"` // 是否有审核通过的历史版本（首次提交为 false）
+	Diffs              []*ReviewFieldDiff     `protobuf:"bytes,18,rep,name=diffs,proto3" json:"diffs,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ReviewQueueItem) Reset() {
+	*x = ReviewQueueItem{}
+	mi := &file_activity_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewQueueItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewQueueItem) ProtoMessage() {}
+
+func (x *ReviewQueueItem) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewQueueItem.ProtoReflect.Descriptor instead.
+func (*ReviewQueueItem) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ReviewQueueItem) GetReviewId() int64 {
+	if x != nil {
+		return x.ReviewId
+	}
+	return 0
+}
+
+func (x *ReviewQueueItem) GetActivityId() int64 {
+	if x != nil {
+		return x.ActivityId
+	}
+	return 0
+}
+
+func (x *ReviewQueueItem) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ReviewQueueItem) GetCoverUrl() string {
+	if x != nil {
+		return x.CoverUrl
+	}
+	return ""
+}
+
+func (x *ReviewQueueItem) GetCategoryName() string {
+	if x != nil {
+		return x.CategoryName
+	}
+	return ""
+}
+
+func (x *ReviewQueueItem) GetOrganizerId() int64 {
+	if x != nil {
+		return x.OrganizerId
+	}
+	return 0
+}
+
+func (x *ReviewQueueItem) GetOrganizerName() string {
+	if x != nil {
+		return x.OrganizerName
+	}
+	return ""
+}
+
+func (x *ReviewQueueItem) GetActivityStartTime() int64 {
+	if x != nil {
+		return x.ActivityStartTime
+	}
+	return 0
+}
+
+func (x *ReviewQueueItem) GetPolicyReason() string {
+	if x != nil {
+		return x.PolicyReason
+	}
+	return ""
+}
+
+func (x *ReviewQueueItem) GetFlagged() bool {
+	if x != nil {
+		return x.Flagged
+	}
+	return false
+}
+
+func (x *ReviewQueueItem) GetSubmittedAt() int64 {
+	if x != nil {
+		return x.SubmittedAt
+	}
+	return 0
+}
+
+func (x *ReviewQueueItem) GetDueAt() int64 {
+	if x != nil {
+		return x.DueAt
+	}
+	return 0
+}
+
+func (x *ReviewQueueItem) GetRemainingSeconds() int64 {
+	if x != nil {
+		return x.RemainingSeconds
+	}
+	return 0
+}
+
+func (x *ReviewQueueItem) GetOverdue() bool {
+	if x != nil {
+		return x.Overdue
+	}
+	return false
+}
+
+func (x *ReviewQueueItem) GetAssigneeId() int64 {
+	if x != nil {
+		return x.AssigneeId
+	}
+	return 0
+}
+
+func (x *ReviewQueueItem) GetAssignedAt() int64 {
+	if x != nil {
+		return x.AssignedAt
+	}
+	return 0
+}
+
+func (x *ReviewQueueItem) GetHasApprovedVersion() bool {
+	if x != nil {
+		return x.HasApprovedVersion
+	}
+	return false
+}
+
+func (x *ReviewQueueItem) GetDiffs() []*ReviewFieldDiff {
+	if x != nil {
+		return x.Diffs
+	}
+	return nil
+}
+
+type ListReviewQueueResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          []*ReviewQueueItem     `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	Pagination    *Pagination            `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReviewQueueResp) Reset() {
+	*x = ListReviewQueueResp{}
+	mi := &file_activity_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReviewQueueResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReviewQueueResp) ProtoMessage() {}
+
+func (x *ListReviewQueueResp) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReviewQueueResp.ProtoReflect.Descriptor instead.
+func (*ListReviewQueueResp) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListReviewQueueResp) GetList() []*ReviewQueueItem {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *ListReviewQueueResp) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type AssignActivityReviewReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActivityId    int64                  `protobuf:"varint,1,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"`
+	OperatorId    int64                  `protobuf:"varint,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"` // 操作的管理员
+	AssigneeId    int64                  `protobuf:"varint,3,opt,name=assignee_id,json=assigneeId,proto3" json:"assignee_id,omitempty"` // 分配给谁（0=领取给自己）
+	Release       bool                   `protobuf:"varint,4,opt,name=release,proto3" json:"release,omitempty"`                         // 释放任务（仅当前审核员本人可释放）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignActivityReviewReq) Reset() {
+	*x = AssignActivityReviewReq{}
+	mi := &file_activity_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignActivityReviewReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignActivityReviewReq) ProtoMessage() {}
+
+func (x *AssignActivityReviewReq) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignActivityReviewReq.ProtoReflect.Descriptor instead.
+func (*AssignActivityReviewReq) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *AssignActivityReviewReq) GetActivityId() int64 {
+	if x != nil {
+		return x.ActivityId
+	}
+	return 0
+}
+
+func (x *AssignActivityReviewReq) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *AssignActivityReviewReq) GetAssigneeId() int64 {
+	if x != nil {
+		return x.AssigneeId
+	}
+	return 0
+}
+
+func (x *AssignActivityReviewReq) GetRelease() bool {
+	if x != nil {
+		return x.Release
+	}
+	return false
+}
+
+type AssignActivityReviewResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AssigneeId    int64                  `protobuf:"varint,1,opt,name=assignee_id,json=assigneeId,proto3" json:"assignee_id,omitempty"`
+	AssignedAt    int64                  `protobuf:"varint,2,opt,name=assigned_at,json=assignedAt,proto3" json:"assigned_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignActivityReviewResp) Reset() {
+	*x = AssignActivityReviewResp{}
+	mi := &file_activity_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignActivityReviewResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignActivityReviewResp) ProtoMessage() {}
+
+func (x *AssignActivityReviewResp) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignActivityReviewResp.ProtoReflect.Descriptor instead.
+func (*AssignActivityReviewResp) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *AssignActivityReviewResp) GetAssigneeId() int64 {
+	if x != nil {
+		return x.AssigneeId
+	}
+	return 0
+}
+
+func (x *AssignActivityReviewResp) GetAssignedAt() int64 {
+	if x != nil {
+		return x.AssignedAt
+	}
+	return 0
+}
+
+type CancelActivityReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	OperatorId    int64                  `protobuf:"varint,3,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	IsAdmin       bool                   `protobuf:"varint,4,opt,name=is_admin,json=isAdmin,proto3" json:"is_admin,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelActivityReq) Reset() {
+	*x = CancelActivityReq{}
+	mi := &file_activity_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelActivityReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelActivityReq) ProtoMessage() {}
+
+func (x *CancelActivityReq) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelActivityReq.ProtoReflect.Descriptor instead.
+func (*CancelActivityReq) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *CancelActivityReq) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CancelActivityReq) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CancelActivityReq) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *CancelActivityReq) GetIsAdmin() bool {
+	if x != nil {
+		return x.IsAdmin
+	}
+	return false
+}
+
+type CancelActivityResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelActivityResp) Reset() {
+	*x = CancelActivityResp{}
+	mi := &file_activity_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelActivityResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelActivityResp) ProtoMessage() {}
+
+func (x *CancelActivityResp) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelActivityResp.ProtoReflect.Descriptor instead.
+func (*CancelActivityResp) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *CancelActivityResp) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+// 仅传入需要变更的字段；时间与地点之外的字段仍走 UpdateActivity
+type SubmitActivityChangeReq struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ActivityId        int64                  `protobuf:"varint,1,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"`
+	OperatorId        int64                  `protobuf:"varint,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	RegisterEndTime   *int64                 `protobuf:"varint,3,opt,name=register_end_time,json=registerEndTime,proto3,oneof" json:"register_end_time,omitempty"`
+	ActivityStartTime *int64                 `protobuf:"varint,4,opt,name=activity_start_time,json=activityStartTime,proto3,oneof" json:"activity_start_time,omitempty"`
+	ActivityEndTime   *int64                 `protobuf:"varint,5,opt,name=activity_end_time,json=activityEndTime,proto3,oneof" json:"activity_end_time,omitempty"`
+	Location          *string                `protobuf:"bytes,6,opt,name=location,proto3,oneof" json:"location,omitempty"`
+	AddressDetail     *string                `protobuf:"bytes,7,opt,name=address_detail,json=addressDetail,proto3,oneof" json:"address_detail,omitempty"`
+	Longitude         *float64               `protobuf:"fixed64,8,opt,name=longitude,proto3,oneof" json:"longitude,omitempty"`
+	Latitude          *float64               `protobuf:"fixed64,9,opt,name=latitude,proto3,oneof" json:"latitude,omitempty"`
+	Reason            string                 `protobuf:"bytes,10,opt,name=reason,proto3" json:"reason,omitempty"` // 变更原因（必填，随通知发送给报名者）
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SubmitActivityChangeReq) Reset() {
+	*x = SubmitActivityChangeReq{}
+	mi := &file_activity_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitActivityChangeReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitActivityChangeReq) ProtoMessage() {}
+
+func (x *SubmitActivityChangeReq) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitActivityChangeReq.ProtoReflect.Descriptor instead.
+func (*SubmitActivityChangeReq) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *SubmitActivityChangeReq) GetActivityId() int64 {
+	if x != nil {
+		return x.ActivityId
+	}
+	return 0
+}
+
+func (x *SubmitActivityChangeReq) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *SubmitActivityChangeReq) GetRegisterEndTime() int64 {
+	if x != nil && x.RegisterEndTime != nil {
+		return *x.RegisterEndTime
+	}
+	return 0
+}
+
+func (x *SubmitActivityChangeReq) GetActivityStartTime() int64 {
+	if x != nil && x.ActivityStartTime != nil {
+		return *x.ActivityStartTime
+	}
+	return 0
+}
+
+func (x *SubmitActivityChangeReq) GetActivityEndTime() int64 {
+	if x != nil && x.ActivityEndTime != nil {
+		return *x.ActivityEndTime
+	}
+	return 0
+}
+
+func (x *SubmitActivityChangeReq) GetLocation() string {
+	if x != nil && x.Location != nil {
+		return *x.Location
+	}
+	return ""
+}
+
+func (x *SubmitActivityChangeReq) GetAddressDetail() string {
+	if x != nil && x.AddressDetail != nil {
+		return *x.AddressDetail
+	}
+	return ""
+}
+
+func (x *SubmitActivityChangeReq) GetLongitude() float64 {
+	if x != nil && x.Longitude != nil {
+		return *x.Longitude
+	}
+	return 0
+}
+
+func (x *SubmitActivityChangeReq) GetLatitude() float64 {
+	if x != nil && x.Latitude != nil {
+		return *x.Latitude
+	}
+	return 0
+}
+
+func (x *SubmitActivityChangeReq) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type SubmitActivityChangeResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     int64                  `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Status        int32                  `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"` // 0=待审核
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitActivityChangeResp) Reset() {
+	*x = SubmitActivityChangeResp{}
+	mi := &file_activity_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitActivityChangeResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitActivityChangeResp) ProtoMessage() {}
+
+func (x *SubmitActivityChangeResp) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitActivityChangeResp.ProtoReflect.Descriptor instead.
+func (*SubmitActivityChangeResp) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *SubmitActivityChangeResp) GetRequestId() int64 {
+	if x != nil {
+		return x.RequestId
+	}
+	return 0
+}
+
+func (x *SubmitActivityChangeResp) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+type ActivityChangeInfo struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ActivityId      int64                  `protobuf:"varint,2,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"`
+	ActivityTitle   string                 `protobuf:"bytes,3,opt,name=activity_title,json=activityTitle,proto3" json:"activity_title,omitempty"`
+	OrganizerId     int64                  `protobuf:"varint,4,opt,name=organizer_id,json=organizerId,proto3" json:"organizer_id,omitempty"`
+	Status          int32                  `protobuf:"varint,5,opt,name=status,proto3" json:"status,omitempty"` // 0=待审核 1=已生效 2=已驳回
+	StatusText      string                 `protobuf:"bytes,6,opt,name=status_text,json=statusText,proto3" json:"status_text,omitempty"`
+	Reason          string                 `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	Changes         []*ReviewFieldDiff     `protobuf:"bytes,8,rep,name=changes,proto3" json:"changes,omitempty"`
+	ReviewerId      int64                  `protobuf:"varint,9,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	RejectReason    string                 `protobuf:"bytes,10,opt,name=reject_reason,json=rejectReason,proto3" json:"reject_reason,omitempty"`
+	CreatedAt       int64                  `protobuf:"varint,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	DecidedAt       int64                  `protobuf:"varint,12,opt,name=decided_at,json=decidedAt,proto3" json:"decided_at,omitempty"`
+	FreeCancelUntil int64                  `protobuf:"varint,13,opt,name=free_cancel_until,json=freeCancelUntil,proto3" json:"free_cancel_until,omitempty"` // 无责取消截止时间（生效后有值）
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ActivityChangeInfo) Reset() {
+	*x = ActivityChangeInfo{}
+	mi := &file_activity_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityChangeInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityChangeInfo) ProtoMessage() {}
+
+func (x *ActivityChangeInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityChangeInfo.ProtoReflect.Descriptor instead.
+func (*ActivityChangeInfo) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ActivityChangeInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ActivityChangeInfo) GetActivityId() int64 {
+	if x != nil {
+		return x.ActivityId
+	}
+	return 0
+}
+
+func (x *ActivityChangeInfo) GetActivityTitle() string {
+	if x != nil {
+		return x.ActivityTitle
+	}
+	return ""
+}
+
+func (x *ActivityChangeInfo) GetOrganizerId() int64 {
+	if x != nil {
+		return x.OrganizerId
+	}
+	return 0
+}
+
+func (x *ActivityChangeInfo) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *ActivityChangeInfo) GetStatusText() string {
+	if x != nil {
+		return x.StatusText
+	}
+	return ""
+}
+
+func (x *ActivityChangeInfo) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ActivityChangeInfo) GetChanges() []*ReviewFieldDiff {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *ActivityChangeInfo) GetReviewerId() int64 {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return 0
+}
+
+func (x *ActivityChangeInfo) GetRejectReason() string {
+	if x != nil {
+		return x.RejectReason
+	}
+	return ""
+}
+
+func (x *ActivityChangeInfo) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *ActivityChangeInfo) GetDecidedAt() int64 {
+	if x != nil {
+		return x.DecidedAt
+	}
+	return 0
+}
+
+func (x *ActivityChangeInfo) GetFreeCancelUntil() int64 {
+	if x != nil {
+		return x.FreeCancelUntil
+	}
+	return 0
+}
+
+type ListActivityChangesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OperatorId    int64                  `protobuf:"varint,1,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	IsAdmin       bool                   `protobuf:"varint,2,opt,name=is_admin,json=isAdmin,proto3" json:"is_admin,omitempty"`
+	ActivityId    int64                  `protobuf:"varint,3,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"` // 组织者必填；管理员可选
+	Status        int32                  `protobuf:"varint,4,opt,name=status,proto3" json:"status,omitempty"`                           // -1=全部
+	Page          int32                  `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListActivityChangesReq) Reset() {
+	*x = ListActivityChangesReq{}
+	mi := &file_activity_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListActivityChangesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListActivityChangesReq) ProtoMessage() {}
+
+func (x *ListActivityChangesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListActivityChangesReq.ProtoReflect.Descriptor instead.
+func (*ListActivityChangesReq) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ListActivityChangesReq) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *ListActivityChangesReq) GetIsAdmin() bool {
+	if x != nil {
+		return x.IsAdmin
+	}
+	return false
+}
+
+func (x *ListActivityChangesReq) GetActivityId() int64 {
+	if x != nil {
+		return x.ActivityId
+	}
+	return 0
+}
+
+func (x *ListActivityChangesReq) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *ListActivityChangesReq) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListActivityChangesReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListActivityChangesResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          []*ActivityChangeInfo  `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	Pagination    *Pagination            `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListActivityChangesResp) Reset() {
+	*x = ListActivityChangesResp{}
+	mi := &file_activity_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListActivityChangesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListActivityChangesResp) ProtoMessage() {}
+
+func (x *ListActivityChangesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListActivityChangesResp.ProtoReflect.Descriptor instead.
+func (*ListActivityChangesResp) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ListActivityChangesResp) GetList() []*ActivityChangeInfo {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *ListActivityChangesResp) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ReviewActivityChangeReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     int64                  `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	OperatorId    int64                  `protobuf:"varint,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	Approve       bool                   `protobuf:"varint,3,opt,name=approve,proto3" json:"approve,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"` // 驳回原因（驳回时必填）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewActivityChangeReq) Reset() {
+	*x = ReviewActivityChangeReq{}
+	mi := &file_activity_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewActivityChangeReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewActivityChangeReq) ProtoMessage() {}
+
+func (x *ReviewActivityChangeReq) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewActivityChangeReq.ProtoReflect.Descriptor instead.
+func (*ReviewActivityChangeReq) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ReviewActivityChangeReq) GetRequestId() int64 {
+	if x != nil {
+		return x.RequestId
+	}
+	return 0
+}
+
+func (x *ReviewActivityChangeReq) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *ReviewActivityChangeReq) GetApprove() bool {
+	if x != nil {
+		return x.Approve
+	}
+	return false
+}
+
+func (x *ReviewActivityChangeReq) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ReviewActivityChangeResp struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Status          int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	FreeCancelUntil int64                  `protobuf:"varint,2,opt,name=free_cancel_until,json=freeCancelUntil,proto3" json:"free_cancel_until,omitempty"`
+	NotifiedCount   int32                  `protobuf:"varint,3,opt,name=notified_count,json=notifiedCount,proto3" json:"notified_count,omitempty"` // 待通知的报名人数
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ReviewActivityChangeResp) Reset() {
+	*x = ReviewActivityChangeResp{}
+	mi := &file_activity_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewActivityChangeResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewActivityChangeResp) ProtoMessage() {}
+
+func (x *ReviewActivityChangeResp) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewActivityChangeResp.ProtoReflect.Descriptor instead.
+func (*ReviewActivityChangeResp) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ReviewActivityChangeResp) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *ReviewActivityChangeResp) GetFreeCancelUntil() int64 {
+	if x != nil {
+		return x.FreeCancelUntil
+	}
+	return 0
+}
+
+func (x *ReviewActivityChangeResp) GetNotifiedCount() int32 {
+	if x != nil {
+		return x.NotifiedCount
+	}
+	return 0
+}
+
+type FavoriteActivityReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActivityId    int64                  `protobuf:"varint,1,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Favorite      bool                   `protobuf:"varint,3,opt,name=favorite,proto3" json:"favorite,omitempty"` // true=收藏，false=取消收藏
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FavoriteActivityReq) Reset() {
+	*x = FavoriteActivityReq{}
+	mi := &file_activity_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FavoriteActivityReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FavoriteActivityReq) ProtoMessage() {}
+
+func (x *FavoriteActivityReq) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FavoriteActivityReq.ProtoReflect.Descriptor instead.
+func (*FavoriteActivityReq) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *FavoriteActivityReq) GetActivityId() int64 {
+	if x != nil {
+		return x.ActivityId
+	}
+	return 0
+}
+
+func (x *FavoriteActivityReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *FavoriteActivityReq) GetFavorite() bool {
+	if x != nil {
+		return x.Favorite
+	}
+	return false
+}
+
+type FavoriteActivityResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Favorited     bool                   `protobuf:"varint,1,opt,name=favorited,proto3" json:"favorited,omitempty"` // 操作后的收藏状态
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FavoriteActivityResp) Reset() {
+	*x = FavoriteActivityResp{}
+	mi := &file_activity_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FavoriteActivityResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FavoriteActivityResp) ProtoMessage() {}
+
+func (x *FavoriteActivityResp) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FavoriteActivityResp.ProtoReflect.Descriptor instead.
+func (*FavoriteActivityResp) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *FavoriteActivityResp) GetFavorited() bool {
+	if x != nil {
+		return x.Favorited
+	}
+	return false
+}
+
+// 时间字段均为首场时间：报名开始/截止相对活动开始的提前量、活动时长由首场推算，后续场次沿用
+type CreateActivitySeriesReq struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Template            *CreateActivityReq     `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`                                                     // 首场活动信息（is_draft 无效，场次生成后直接提交审核）
+	Rrule               string                 `protobuf:"bytes,2,opt,name=rrule,proto3" json:"rrule,omitempty"`                                                           // 重复规则，如 FREQ=WEEKLY;INTERVAL=2;BYDAY=TU,TH;COUNT=10
+	AllowSeriesRegister bool                   `protobuf:"varint,3,opt,name=allow_series_register,json=allowSeriesRegister,proto3" json:"allow_series_register,omitempty"` // 是否开放整期报名
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CreateActivitySeriesReq) Reset() {
+	*x = CreateActivitySeriesReq{}
+	mi := &file_activity_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateActivitySeriesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateActivitySeriesReq) ProtoMessage() {}
+
+func (x *CreateActivitySeriesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateActivitySeriesReq.ProtoReflect.Descriptor instead.
+func (*CreateActivitySeriesReq) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *CreateActivitySeriesReq) GetTemplate() *CreateActivityReq {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+func (x *CreateActivitySeriesReq) GetRrule() string {
+	if x != nil {
+		return x.Rrule
+	}
+	return ""
+}
+
+func (x *CreateActivitySeriesReq) GetAllowSeriesRegister() bool {
+	if x != nil {
+		return x.AllowSeriesRegister
+	}
+	return false
+}
+
+type CreateActivitySeriesResp struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	SeriesId         int64                  `protobuf:"varint,1,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	ActivityIds      []int64                `protobuf:"varint,2,rep,packed,name=activity_ids,json=activityIds,proto3" json:"activity_ids,omitempty"`         // 本次已生成的场次
+	TotalOccurrences int32                  `protobuf:"varint,3,opt,name=total_occurrences,json=totalOccurrences,proto3" json:"total_occurrences,omitempty"` // 按规则计算的总场次
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreateActivitySeriesResp) Reset() {
+	*x = CreateActivitySeriesResp{}
+	mi := &file_activity_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateActivitySeriesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateActivitySeriesResp) ProtoMessage() {}
+
+func (x *CreateActivitySeriesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateActivitySeriesResp.ProtoReflect.Descriptor instead.
+func (*CreateActivitySeriesResp) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *CreateActivitySeriesResp) GetSeriesId() int64 {
+	if x != nil {
+		return x.SeriesId
+	}
+	return 0
+}
+
+func (x *CreateActivitySeriesResp) GetActivityIds() []int64 {
+	if x != nil {
+		return x.ActivityIds
+	}
+	return nil
+}
+
+func (x *CreateActivitySeriesResp) GetTotalOccurrences() int32 {
+	if x != nil {
+		return x.TotalOccurrences
+	}
+	return 0
+}
+
+type SeriesOccurrence struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Index               int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"` // 场次序号（从 0 开始）
+	ActivityId          int64                  `protobuf:"varint,2,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"`
+	ActivityStartTime   int64                  `protobuf:"varint,3,opt,name=activity_start_time,json=activityStartTime,proto3" json:"activity_start_time,omitempty"`
+	ActivityEndTime     int64                  `protobuf:"varint,4,opt,name=activity_end_time,json=activityEndTime,proto3" json:"activity_end_time,omitempty"`
+	Status              int32                  `protobuf:"varint,5,opt,name=status,proto3" json:"status,omitempty"`
+	StatusText          string                 `protobuf:"bytes,6,opt,name=status_text,json=statusText,proto3" json:"status_text,omitempty"`
+	Overridden          bool                   `protobuf:"varint,7,opt,name=overridden,proto3" json:"overridden,omitempty"` // 是否单独修改过（不再跟随整期修改）
+	CurrentParticipants int32                  `protobuf:"varint,8,opt,name=current_participants,json=currentParticipants,proto3" json:"current_participants,omitempty"`
+	MaxParticipants     int32                  `protobuf:"varint,9,opt,name=max_participants,json=maxParticipants,proto3" json:"max_participants,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *SeriesOccurrence) Reset() {
+	*x = SeriesOccurrence{}
+	mi := &file_activity_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeriesOccurrence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeriesOccurrence) ProtoMessage() {}
+
+func (x *SeriesOccurrence) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeriesOccurrence.ProtoReflect.Descriptor instead.
+func (*SeriesOccurrence) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *SeriesOccurrence) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *SeriesOccurrence) GetActivityId() int64 {
+	if x != nil {
+		return x.ActivityId
+	}
+	return 0
+}
+
+func (x *SeriesOccurrence) GetActivityStartTime() int64 {
+	if x != nil {
+		return x.ActivityStartTime
+	}
+	return 0
+}
+
+func (x *SeriesOccurrence) GetActivityEndTime() int64 {
+	if x != nil {
+		return x.ActivityEndTime
+	}
+	return 0
+}
+
+func (x *SeriesOccurrence) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *SeriesOccurrence) GetStatusText() string {
+	if x != nil {
+		return x.StatusText
+	}
+	return ""
+}
+
+func (x *SeriesOccurrence) GetOverridden() bool {
+	if x != nil {
+		return x.Overridden
+	}
+	return false
+}
+
+func (x *SeriesOccurrence) GetCurrentParticipants() int32 {
+	if x != nil {
+		return x.CurrentParticipants
+	}
+	return 0
+}
+
+func (x *SeriesOccurrence) GetMaxParticipants() int32 {
+	if x != nil {
+		return x.MaxParticipants
+	}
+	return 0
+}
+
+type ActivitySeriesInfo struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title               string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Rrule               string                 `protobuf:"bytes,3,opt,name=rrule,proto3" json:"rrule,omitempty"`
+	Status              int32                  `protobuf:"varint,4,opt,name=status,proto3" json:"status,omitempty"` // 1=进行中 2=已全部生成 3=已取消
+	StatusText          string                 `protobuf:"bytes,5,opt,name=status_text,json=statusText,proto3" json:"status_text,omitempty"`
+	OrganizerId         int64                  `protobuf:"varint,6,opt,name=organizer_id,json=organizerId,proto3" json:"organizer_id,omitempty"`
+	OrganizerName       string                 `protobuf:"bytes,7,opt,name=organizer_name,json=organizerName,proto3" json:"organizer_name,omitempty"`
+	CoverUrl            string                 `protobuf:"bytes,8,opt,name=cover_url,json=coverUrl,proto3" json:"cover_url,omitempty"`
+	CategoryId          int64                  `protobuf:"varint,9,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Location            string                 `protobuf:"bytes,10,opt,name=location,proto3" json:"location,omitempty"`
+	FirstStartTime      int64                  `protobuf:"varint,11,opt,name=first_start_time,json=firstStartTime,proto3" json:"first_start_time,omitempty"`
+	DurationSeconds     int64                  `protobuf:"varint,12,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	AllowSeriesRegister bool                   `protobuf:"varint,13,opt,name=allow_series_register,json=allowSeriesRegister,proto3" json:"allow_series_register,omitempty"`
+	TotalOccurrences    int32                  `protobuf:"varint,14,opt,name=total_occurrences,json=totalOccurrences,proto3" json:"total_occurrences,omitempty"`
+	GeneratedCount      int32                  `protobuf:"varint,15,opt,name=generated_count,json=generatedCount,proto3" json:"generated_count,omitempty"`
+	SubscriberCount     int64                  `protobuf:"varint,16,opt,name=subscriber_count,json=subscriberCount,proto3" json:"subscriber_count,omitempty"` // 整期报名人数
+	CreatedAt           int64                  `protobuf:"varint,17,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ActivitySeriesInfo) Reset() {
+	*x = ActivitySeriesInfo{}
+	mi := &file_activity_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivitySeriesInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivitySeriesInfo) ProtoMessage() {}
+
+func (x *ActivitySeriesInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivitySeriesInfo.ProtoReflect.Descriptor instead.
+func (*ActivitySeriesInfo) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ActivitySeriesInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ActivitySeriesInfo) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ActivitySeriesInfo) GetRrule() string {
+	if x != nil {
+		return x.Rrule
+	}
+	return ""
+}
+
+func (x *ActivitySeriesInfo) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *ActivitySeriesInfo) GetStatusText() string {
+	if x != nil {
+		return x.StatusText
+	}
+	return ""
+}
+
+func (x *ActivitySeriesInfo) GetOrganizerId() int64 {
+	if x != nil {
+		return x.OrganizerId
+	}
+	return 0
+}
+
+func (x *ActivitySeriesInfo) GetOrganizerName() string {
+	if x != nil {
+		return x.OrganizerName
+	}
+	return ""
+}
+
+func (x *ActivitySeriesInfo) GetCoverUrl() string {
+	if x != nil {
+		return x.CoverUrl
+	}
+	return ""
+}
+
+func (x *ActivitySeriesInfo) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *ActivitySeriesInfo) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *ActivitySeriesInfo) GetFirstStartTime() int64 {
+	if x != nil {
+		return x.FirstStartTime
+	}
+	return 0
+}
+
+func (x *ActivitySeriesInfo) GetDurationSeconds() int64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *ActivitySeriesInfo) GetAllowSeriesRegister() bool {
+	if x != nil {
+		return x.AllowSeriesRegister
+	}
+	return false
+}
+
+func (x *ActivitySeriesInfo) GetTotalOccurrences() int32 {
+	if x != nil {
+		return x.TotalOccurrences
+	}
+	return 0
+}
+
+func (x *ActivitySeriesInfo) GetGeneratedCount() int32 {
+	if x != nil {
+		return x.GeneratedCount
+	}
+	return 0
+}
+
+func (x *ActivitySeriesInfo) GetSubscriberCount() int64 {
+	if x != nil {
+		return x.SubscriberCount
+	}
+	return 0
+}
+
+func (x *ActivitySeriesInfo) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type GetActivitySeriesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ViewerId      int64                  `protobuf:"varint,2,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetActivitySeriesReq) Reset() {
+	*x = GetActivitySeriesReq{}
+	mi := &file_activity_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetActivitySeriesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActivitySeriesReq) ProtoMessage() {}
+
+func (x *GetActivitySeriesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActivitySeriesReq.ProtoReflect.Descriptor instead.
+func (*GetActivitySeriesReq) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *GetActivitySeriesReq) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetActivitySeriesReq) GetViewerId() int64 {
+	if x != nil {
+		return x.ViewerId
+	}
+	return 0
+}
+
+type GetActivitySeriesResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Series        *ActivitySeriesInfo    `protobuf:"bytes,1,opt,name=series,proto3" json:"series,omitempty"`
+	Occurrences   []*SeriesOccurrence    `protobuf:"bytes,2,rep,name=occurrences,proto3" json:"occurrences,omitempty"`
+	Subscribed    bool                   `protobuf:"varint,3,opt,name=subscribed,proto3" json:"subscribed,omitempty"` // 当前用户是否整期报名中
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetActivitySeriesResp) Reset() {
+	*x = GetActivitySeriesResp{}
+	mi := &file_activity_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetActivitySeriesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActivitySeriesResp) ProtoMessage() {}
+
+func (x *GetActivitySeriesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActivitySeriesResp.ProtoReflect.Descriptor instead.
+func (*GetActivitySeriesResp) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *GetActivitySeriesResp) GetSeries() *ActivitySeriesInfo {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+func (x *GetActivitySeriesResp) GetOccurrences() []*SeriesOccurrence {
+	if x != nil {
+		return x.Occurrences
+	}
+	return nil
+}
+
+func (x *GetActivitySeriesResp) GetSubscribed() bool {
+	if x != nil {
+		return x.Subscribed
+	}
+	return false
+}
+
+// 仅传入需要修改的字段；时间类字段不支持整期修改
+type UpdateActivitySeriesReq struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OperatorId           int64                  `protobuf:"varint,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	Title                *string                `protobuf:"bytes,3,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Content              *string                `protobuf:"bytes,4,opt,name=content,proto3,oneof" json:"content,omitempty"`
+	CategoryId           *int64                 `protobuf:"varint,5,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	ContactPhone         *string                `protobuf:"bytes,6,opt,name=contact_phone,json=contactPhone,proto3,oneof" json:"contact_phone,omitempty"`
+	CoverImageId         *int64                 `protobuf:"varint,7,opt,name=cover_image_id,json=coverImageId,proto3,oneof" json:"cover_image_id,omitempty"`
+	CoverType            *int32                 `protobuf:"varint,8,opt,name=cover_type,json=coverType,proto3,oneof" json:"cover_type,omitempty"`
+	Location             *string                `protobuf:"bytes,9,opt,name=location,proto3,oneof" json:"location,omitempty"`
+	AddressDetail        *string                `protobuf:"bytes,10,opt,name=address_detail,json=addressDetail,proto3,oneof" json:"address_detail,omitempty"`
+	Longitude            *float64               `protobuf:"fixed64,11,opt,name=longitude,proto3,oneof" json:"longitude,omitempty"`
+	Latitude             *float64               `protobuf:"fixed64,12,opt,name=latitude,proto3,oneof" json:"latitude,omitempty"`
+	MaxParticipants      *int32                 `protobuf:"varint,13,opt,name=max_participants,json=maxParticipants,proto3,oneof" json:"max_participants,omitempty"`
+	RequireApproval      *bool                  `protobuf:"varint,14,opt,name=require_approval,json=requireApproval,proto3,oneof" json:"require_approval,omitempty"`
+	RequireStudentVerify *bool                  `protobuf:"varint,15,opt,name=require_student_verify,json=requireStudentVerify,proto3,oneof" json:"require_student_verify,omitempty"`
+	MinCreditScore       *int32                 `protobuf:"varint,16,opt,name=min_credit_score,json=minCreditScore,proto3,oneof" json:"min_credit_score,omitempty"`
+	TagIds               []int64                `protobuf:"varint,17,rep,packed,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty"`
+	UpdateTags           bool                   `protobuf:"varint,18,opt,name=update_tags,json=updateTags,proto3" json:"update_tags,omitempty"`
+	AllowSeriesRegister  *bool                  `protobuf:"varint,19,opt,name=allow_series_register,json=allowSeriesRegister,proto3,oneof" json:"allow_series_register,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *UpdateActivitySeriesReq) Reset() {
+	*x = UpdateActivitySeriesReq{}
+	mi := &file_activity_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateActivitySeriesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateActivitySeriesReq) ProtoMessage() {}
+
+func (x *UpdateActivitySeriesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateActivitySeriesReq.ProtoReflect.Descriptor instead.
+func (*UpdateActivitySeriesReq) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *UpdateActivitySeriesReq) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateActivitySeriesReq) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *UpdateActivitySeriesReq) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *UpdateActivitySeriesReq) GetContent() string {
+	if x != nil && x.Content != nil {
+		return *x.Content
+	}
+	return ""
+}
+
+func (x *UpdateActivitySeriesReq) GetCategoryId() int64 {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return 0
+}
+
+func (x *UpdateActivitySeriesReq) GetContactPhone() string {
+	if x != nil && x.ContactPhone != nil {
+		return *x.ContactPhone
+	}
+	return ""
+}
+
+func (x *UpdateActivitySeriesReq) GetCoverImageId() int64 {
+	if x != nil && x.CoverImageId != nil {
+		return *x.CoverImageId
+	}
+	return 0
+}
+
+func (x *UpdateActivitySeriesReq) GetCoverType() int32 {
+	if x != nil && x.CoverType != nil {
+		return *x.CoverType
+	}
+	return 0
+}
+
+func (x *UpdateActivitySeriesReq) GetLocation() string {
+	if x != nil && x.Location != nil {
+		return *x.Location
+	}
+	return ""
+}
+
+func (x *UpdateActivitySeriesReq) GetAddressDetail() string {
+	if x != nil && x.AddressDetail != nil {
+		return *x.AddressDetail
+	}
+	return ""
+}
+
+func (x *UpdateActivitySeriesReq) GetLongitude() float64 {
+	if x != nil && x.Longitude != nil {
+		return *x.Longitude
+	}
+	return 0
+}
+
+func (x *UpdateActivitySeriesReq) GetLatitude() float64 {
+	if x != nil && x.Latitude != nil {
+		return *x.Latitude
+	}
+	return 0
+}
+
+func (x *UpdateActivitySeriesReq) GetMaxParticipants() int32 {
+	if x != nil && x.MaxParticipants != nil {
+		return *x.MaxParticipants
+	}
+	return 0
+}
+
+func (x *UpdateActivitySeriesReq) GetRequireApproval() bool {
+	if x != nil && x.RequireApproval != nil {
+		return *x.RequireApproval
+	}
+	return false
+}
+
+func (x *UpdateActivitySeriesReq) GetRequireStudentVerify() bool {
+	if x != nil && x.RequireStudentVerify != nil {
+		return *x.RequireStudentVerify
+	}
+	return false
+}
+
+func (x *UpdateActivitySeriesReq) GetMinCreditScore() int32 {
+	if x != nil && x.MinCreditScore != nil {
+		return *x.MinCreditScore
+	}
+	return 0
+}
+
+func (x *UpdateActivitySeriesReq) GetTagIds() []int64 {
+	if x != nil {
+		return x.TagIds
+	}
+	return nil
+}
+
+func (x *UpdateActivitySeriesReq) GetUpdateTags() bool {
+	if x != nil {
+		return x.UpdateTags
+	}
+	return false
+}
+
+func (x *UpdateActivitySeriesReq) GetAllowSeriesRegister() bool {
+	if x != nil && x.AllowSeriesRegister != nil {
+		return *x.AllowSeriesRegister
+	}
+	return false
+}
+
+type SeriesSkippedOccurrence struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActivityId    int64                  `protobuf:"varint,1,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeriesSkippedOccurrence) Reset() {
+	*x = SeriesSkippedOccurrence{}
+	mi := &file_activity_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeriesSkippedOccurrence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeriesSkippedOccurrence) ProtoMessage() {}
+
+func (x *SeriesSkippedOccurrence) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeriesSkippedOccurrence.ProtoReflect.Descriptor instead.
+func (*SeriesSkippedOccurrence) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *SeriesSkippedOccurrence) GetActivityId() int64 {
+	if x != nil {
+		return x.ActivityId
+	}
+	return 0
+}
+
+func (x *SeriesSkippedOccurrence) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type UpdateActivitySeriesResp struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Updated       int32                      `protobuf:"varint,1,opt,name=updated,proto3" json:"updated,omitempty"` // 已同步的场次数
+	Skipped       []*SeriesSkippedOccurrence `protobuf:"bytes,2,rep,name=skipped,proto3" json:"skipped,omitempty"`  // 未同步的场次及原因
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateActivitySeriesResp) Reset() {
+	*x = UpdateActivitySeriesResp{}
+	mi := &file_activity_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateActivitySeriesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateActivitySeriesResp) ProtoMessage() {}
+
+func (x *UpdateActivitySeriesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateActivitySeriesResp.ProtoReflect.Descriptor instead.
+func (*UpdateActivitySeriesResp) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *UpdateActivitySeriesResp) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *UpdateActivitySeriesResp) GetSkipped() []*SeriesSkippedOccurrence {
+	if x != nil {
+		return x.Skipped
+	}
+	return nil
+}
+
+type CancelActivitySeriesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OperatorId    int64                  `protobuf:"varint,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	IsAdmin       bool                   `protobuf:"varint,3,opt,name=is_admin,json=isAdmin,proto3" json:"is_admin,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	CancelFuture  bool                   `protobuf:"varint,5,opt,name=cancel_future,json=cancelFuture,proto3" json:"cancel_future,omitempty"` // 同时取消未开始的场次
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelActivitySeriesReq) Reset() {
+	*x = CancelActivitySeriesReq{}
+	mi := &file_activity_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelActivitySeriesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelActivitySeriesReq) ProtoMessage() {}
+
+func (x *CancelActivitySeriesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelActivitySeriesReq.ProtoReflect.Descriptor instead.
+func (*CancelActivitySeriesReq) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *CancelActivitySeriesReq) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CancelActivitySeriesReq) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *CancelActivitySeriesReq) GetIsAdmin() bool {
+	if x != nil {
+		return x.IsAdmin
+	}
+	return false
+}
+
+func (x *CancelActivitySeriesReq) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CancelActivitySeriesReq) GetCancelFuture() bool {
+	if x != nil {
+		return x.CancelFuture
+	}
+	return false
+}
+
+type CancelActivitySeriesResp struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Cancelled     int32                      `protobuf:"varint,1,opt,name=cancelled,proto3" json:"cancelled,omitempty"` // 已取消的场次数
+	Skipped       []*SeriesSkippedOccurrence `protobuf:"bytes,2,rep,name=skipped,proto3" json:"skipped,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelActivitySeriesResp) Reset() {
+	*x = CancelActivitySeriesResp{}
+	mi := &file_activity_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelActivitySeriesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelActivitySeriesResp) ProtoMessage() {}
+
+func (x *CancelActivitySeriesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelActivitySeriesResp.ProtoReflect.Descriptor instead.
+func (*CancelActivitySeriesResp) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *CancelActivitySeriesResp) GetCancelled() int32 {
+	if x != nil {
+		return x.Cancelled
+	}
+	return 0
+}
+
+func (x *CancelActivitySeriesResp) GetSkipped() []*SeriesSkippedOccurrence {
+	if x != nil {
+		return x.Skipped
+	}
+	return nil
+}
+
+type RegisterActivitySeriesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SeriesId      int64                  `protobuf:"varint,1,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Register      bool                   `protobuf:"varint,3,opt,name=register,proto3" json:"register,omitempty"` // true=整期报名，false=退出整期报名（已出票的场次不受影响）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterActivitySeriesReq) Reset() {
+	*x = RegisterActivitySeriesReq{}
+	mi := &file_activity_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterActivitySeriesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterActivitySeriesReq) ProtoMessage() {}
+
+func (x *RegisterActivitySeriesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterActivitySeriesReq.ProtoReflect.Descriptor instead.
+func (*RegisterActivitySeriesReq) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *RegisterActivitySeriesReq) GetSeriesId() int64 {
+	if x != nil {
+		return x.SeriesId
+	}
+	return 0
+}
+
+func (x *RegisterActivitySeriesReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RegisterActivitySeriesReq) GetRegister() bool {
+	if x != nil {
+		return x.Register
+	}
+	return false
+}
+
+type RegisterActivitySeriesResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subscribed    bool                   `protobuf:"varint,1,opt,name=subscribed,proto3" json:"subscribed,omitempty"`
+	Enrolled      int32                  `protobuf:"varint,2,opt,name=enrolled,proto3" json:"enrolled,omitempty"` // 本次立即出票的场次数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterActivitySeriesResp) Reset() {
+	*x = RegisterActivitySeriesResp{}
+	mi := &file_activity_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterActivitySeriesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterActivitySeriesResp) ProtoMessage() {}
+
+func (x *RegisterActivitySeriesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterActivitySeriesResp.ProtoReflect.Descriptor instead.
+func (*RegisterActivitySeriesResp) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *RegisterActivitySeriesResp) GetSubscribed() bool {
+	if x != nil {
+		return x.Subscribed
+	}
+	return false
+}
+
+func (x *RegisterActivitySeriesResp) GetEnrolled() int32 {
+	if x != nil {
+		return x.Enrolled
+	}
+	return 0
+}
+
+type GetCalendarFeedTokenReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Reset_        bool                   `protobuf:"varint,2,opt,name=reset,proto3" json:"reset,omitempty"` // true=重置令牌（旧订阅地址立即失效）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCalendarFeedTokenReq) Reset() {
+	*x = GetCalendarFeedTokenReq{}
+	mi := &file_activity_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCalendarFeedTokenReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCalendarFeedTokenReq) ProtoMessage() {}
+
+func (x *GetCalendarFeedTokenReq) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCalendarFeedTokenReq.ProtoReflect.Descriptor instead.
+func (*GetCalendarFeedTokenReq) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *GetCalendarFeedTokenReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetCalendarFeedTokenReq) GetReset_() bool {
+	if x != nil {
+		return x.Reset_
+	}
+	return false
+}
+
+type GetCalendarFeedTokenResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCalendarFeedTokenResp) Reset() {
+	*x = GetCalendarFeedTokenResp{}
+	mi := &file_activity_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCalendarFeedTokenResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCalendarFeedTokenResp) ProtoMessage() {}
+
+func (x *GetCalendarFeedTokenResp) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCalendarFeedTokenResp.ProtoReflect.Descriptor instead.
+func (*GetCalendarFeedTokenResp) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *GetCalendarFeedTokenResp) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type GetCalendarFeedReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCalendarFeedReq) Reset() {
+	*x = GetCalendarFeedReq{}
+	mi := &file_activity_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCalendarFeedReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCalendarFeedReq) ProtoMessage() {}
+
+func (x *GetCalendarFeedReq) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCalendarFeedReq.ProtoReflect.Descriptor instead.
+func (*GetCalendarFeedReq) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *GetCalendarFeedReq) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type GetCalendarFeedResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Content       string                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"` // text/calendar
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCalendarFeedResp) Reset() {
+	*x = GetCalendarFeedResp{}
+	mi := &file_activity_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCalendarFeedResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCalendarFeedResp) ProtoMessage() {}
+
+func (x *GetCalendarFeedResp) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCalendarFeedResp.ProtoReflect.Descriptor instead.
+func (*GetCalendarFeedResp) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *GetCalendarFeedResp) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type ExportActivityIcsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActivityId    int64                  `protobuf:"varint,1,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"`
+	ViewerId      int64                  `protobuf:"varint,2,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"` // 0=未登录，仅可导出公开状态的活动
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportActivityIcsReq) Reset() {
+	*x = ExportActivityIcsReq{}
+	mi := &file_activity_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportActivityIcsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportActivityIcsReq) ProtoMessage() {}
+
+func (x *ExportActivityIcsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportActivityIcsReq.ProtoReflect.Descriptor instead.
+func (*ExportActivityIcsReq) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *ExportActivityIcsReq) GetActivityId() int64 {
+	if x != nil {
+		return x.ActivityId
+	}
+	return 0
+}
+
+func (x *ExportActivityIcsReq) GetViewerId() int64 {
+	if x != nil {
+		return x.ViewerId
+	}
+	return 0
+}
+
+type ExportActivityIcsResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Content       string                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"` // text/calendar
+	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportActivityIcsResp) Reset() {
+	*x = ExportActivityIcsResp{}
+	mi := &file_activity_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportActivityIcsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportActivityIcsResp) ProtoMessage() {}
+
+func (x *ExportActivityIcsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportActivityIcsResp.ProtoReflect.Descriptor instead.
+func (*ExportActivityIcsResp) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *ExportActivityIcsResp) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ExportActivityIcsResp) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+type SetSelfCheckInReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActivityId    int64                  `protobuf:"varint,1,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"`
+	OperatorId    int64                  `protobuf:"varint,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	Enabled       bool                   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	RadiusMeters  int32                  `protobuf:"varint,4,opt,name=radius_meters,json=radiusMeters,proto3" json:"radius_meters,omitempty"` // 签到半径（米），0=使用默认值
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSelfCheckInReq) Reset() {
+	*x = SetSelfCheckInReq{}
+	mi := &file_activity_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSelfCheckInReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSelfCheckInReq) ProtoMessage() {}
+
+func (x *SetSelfCheckInReq) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSelfCheckInReq.ProtoReflect.Descriptor instead.
+func (*SetSelfCheckInReq) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *SetSelfCheckInReq) GetActivityId() int64 {
+	if x != nil {
+		return x.ActivityId
+	}
+	return 0
+}
+
+func (x *SetSelfCheckInReq) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *SetSelfCheckInReq) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *SetSelfCheckInReq) GetRadiusMeters() int32 {
+	if x != nil {
+		return x.RadiusMeters
+	}
+	return 0
+}
+
+type SetSelfCheckInResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	RadiusMeters  int32                  `protobuf:"varint,2,opt,name=radius_meters,json=radiusMeters,proto3" json:"radius_meters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSelfCheckInResp) Reset() {
+	*x = SetSelfCheckInResp{}
+	mi := &file_activity_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSelfCheckInResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSelfCheckInResp) ProtoMessage() {}
+
+func (x *SetSelfCheckInResp) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSelfCheckInResp.ProtoReflect.Descriptor instead.
+func (*SetSelfCheckInResp) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *SetSelfCheckInResp) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *SetSelfCheckInResp) GetRadiusMeters() int32 {
+	if x != nil {
+		return x.RadiusMeters
+	}
+	return 0
+}
+
+type GetSelfCheckInCodeReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActivityId    int64                  `protobuf:"varint,1,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"`
+	OperatorId    int64                  `protobuf:"varint,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSelfCheckInCodeReq) Reset() {
+	*x = GetSelfCheckInCodeReq{}
+	mi := &file_activity_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSelfCheckInCodeReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSelfCheckInCodeReq) ProtoMessage() {}
+
+func (x *GetSelfCheckInCodeReq) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSelfCheckInCodeReq.ProtoReflect.Descriptor instead.
+func (*GetSelfCheckInCodeReq) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *GetSelfCheckInCodeReq) GetActivityId() int64 {
+	if x != nil {
+		return x.ActivityId
+	}
+	return 0
+}
+
+func (x *GetSelfCheckInCodeReq) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+type GetSelfCheckInCodeResp struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Code           string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`                             // 当前签到码（6 位数字）
+	ExpiresAt      int64                  `protobuf:"varint,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // 本轮签到码失效时间
+	RadiusMeters   int32                  `protobuf:"varint,3,opt,name=radius_meters,json=radiusMeters,proto3" json:"radius_meters,omitempty"`
+	CheckedInCount int64                  `protobuf:"varint,4,opt,name=checked_in_count,json=checkedInCount,proto3" json:"checked_in_count,omitempty"` // 已签到人数
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetSelfCheckInCodeResp) Reset() {
+	*x = GetSelfCheckInCodeResp{}
+	mi := &file_activity_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSelfCheckInCodeResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSelfCheckInCodeResp) ProtoMessage() {}
+
+func (x *GetSelfCheckInCodeResp) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSelfCheckInCodeResp.ProtoReflect.Descriptor instead.
+func (*GetSelfCheckInCodeResp) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *GetSelfCheckInCodeResp) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *GetSelfCheckInCodeResp) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *GetSelfCheckInCodeResp) GetRadiusMeters() int32 {
+	if x != nil {
+		return x.RadiusMeters
+	}
+	return 0
+}
+
+func (x *GetSelfCheckInCodeResp) GetCheckedInCount() int64 {
+	if x != nil {
+		return x.CheckedInCount
+	}
+	return 0
+}
+
+type SelfCheckInReq struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ActivityId      int64                  `protobuf:"varint,1,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"`
+	UserId          int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TicketCode      string                 `protobuf:"bytes,3,opt,name=ticket_code,json=ticketCode,proto3" json:"ticket_code,omitempty"`
+	RoomCode        string                 `protobuf:"bytes,4,opt,name=room_code,json=roomCode,proto3" json:"room_code,omitempty"` // 现场大屏上的签到码
+	Latitude        float64                `protobuf:"fixed64,5,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude       float64                `protobuf:"fixed64,6,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	DeviceId        string                 `protobuf:"bytes,7,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`                        // 客户端设备标识（异常检测用）
+	ClientRequestId string                 `protobuf:"bytes,8,opt,name=client_request_id,json=clientRequestId,proto3" json:"client_request_id,omitempty"` // 幂等键，重复提交返回首次结果
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SelfCheckInReq) Reset() {
+	*x = SelfCheckInReq{}
+	mi := &file_activity_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SelfCheckInReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelfCheckInReq) ProtoMessage() {}
+
+func (x *SelfCheckInReq) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelfCheckInReq.ProtoReflect.Descriptor instead.
+func (*SelfCheckInReq) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *SelfCheckInReq) GetActivityId() int64 {
+	if x != nil {
+		return x.ActivityId
+	}
+	return 0
+}
+
+func (x *SelfCheckInReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SelfCheckInReq) GetTicketCode() string {
+	if x != nil {
+		return x.TicketCode
+	}
+	return ""
+}
+
+func (x *SelfCheckInReq) GetRoomCode() string {
+	if x != nil {
+		return x.RoomCode
+	}
+	return ""
+}
+
+func (x *SelfCheckInReq) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *SelfCheckInReq) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *SelfCheckInReq) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *SelfCheckInReq) GetClientRequestId() string {
+	if x != nil {
+		return x.ClientRequestId
+	}
+	return ""
+}
+
+type SelfCheckInResp struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Result         string                 `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`                                        // success
+	DistanceMeters int32                  `protobuf:"varint,2,opt,name=distance_meters,json=distanceMeters,proto3" json:"distance_meters,omitempty"` // 签到位置距活动地点距离
+	CheckInTime    int64                  `protobuf:"varint,3,opt,name=check_in_time,json=checkInTime,proto3" json:"check_in_time,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SelfCheckInResp) Reset() {
+	*x = SelfCheckInResp{}
+	mi := &file_activity_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SelfCheckInResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelfCheckInResp) ProtoMessage() {}
+
+func (x *SelfCheckInResp) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelfCheckInResp.ProtoReflect.Descriptor instead.
+func (*SelfCheckInResp) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *SelfCheckInResp) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+func (x *SelfCheckInResp) GetDistanceMeters() int32 {
+	if x != nil {
+		return x.DistanceMeters
+	}
+	return 0
+}
+
+func (x *SelfCheckInResp) GetCheckInTime() int64 {
+	if x != nil {
+		return x.CheckInTime
+	}
+	return 0
+}
+
+type ListCheckInRecordsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActivityId    int64                  `protobuf:"varint,1,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"`
+	OperatorId    int64                  `protobuf:"varint,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	FlaggedOnly   bool                   `protobuf:"varint,3,opt,name=flagged_only,json=flaggedOnly,proto3" json:"flagged_only,omitempty"` // 只看疑似异常记录
+	Page          int32                  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCheckInRecordsReq) Reset() {
+	*x = ListCheckInRecordsReq{}
+	mi := &file_activity_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCheckInRecordsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCheckInRecordsReq) ProtoMessage() {}
+
+func (x *ListCheckInRecordsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCheckInRecordsReq.ProtoReflect.Descriptor instead.
+func (*ListCheckInRecordsReq) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *ListCheckInRecordsReq) GetActivityId() int64 {
+	if x != nil {
+		return x.ActivityId
+	}
+	return 0
+}
+
+func (x *ListCheckInRecordsReq) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *ListCheckInRecordsReq) GetFlaggedOnly() bool {
+	if x != nil {
+		return x.FlaggedOnly
+	}
+	return false
+}
+
+func (x *ListCheckInRecordsReq) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListCheckInRecordsReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type CheckInRecordItem struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId         int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TicketCode     string                 `protobuf:"bytes,3,opt,name=ticket_code,json=ticketCode,proto3" json:"ticket_code,omitempty"`
+	Method         int32                  `protobuf:"varint,4,opt,name=method,proto3" json:"method,omitempty"` // 1扫码 2自助
+	CheckInTime    int64                  `protobuf:"varint,5,opt,name=check_in_time,json=checkInTime,proto3" json:"check_in_time,omitempty"`
+	Latitude       float64                `protobuf:"fixed64,6,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude      float64                `protobuf:"fixed64,7,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	DistanceMeters int32                  `protobuf:"varint,8,opt,name=distance_meters,json=distanceMeters,proto3" json:"distance_meters,omitempty"`
+	DeviceId       string                 `protobuf:"bytes,9,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	Flagged        bool                   `protobuf:"varint,10,opt,name=flagged,proto3" json:"flagged,omitempty"`
+	FlagReason     string                 `protobuf:"bytes,11,opt,name=flag_reason,json=flagReason,proto3" json:"flag_reason,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CheckInRecordItem) Reset() {
+	*x = CheckInRecordItem{}
+	mi := &file_activity_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckInRecordItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckInRecordItem) ProtoMessage() {}
+
+func (x *CheckInRecordItem) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckInRecordItem.ProtoReflect.Descriptor instead.
+func (*CheckInRecordItem) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *CheckInRecordItem) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CheckInRecordItem) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CheckInRecordItem) GetTicketCode() string {
+	if x != nil {
+		return x.TicketCode
+	}
+	return ""
+}
+
+func (x *CheckInRecordItem) GetMethod() int32 {
+	if x != nil {
+		return x.Method
+	}
+	return 0
+}
+
+func (x *CheckInRecordItem) GetCheckInTime() int64 {
+	if x != nil {
+		return x.CheckInTime
+	}
+	return 0
+}
+
+func (x *CheckInRecordItem) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *CheckInRecordItem) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *CheckInRecordItem) GetDistanceMeters() int32 {
+	if x != nil {
+		return x.DistanceMeters
+	}
+	return 0
+}
+
+func (x *CheckInRecordItem) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *CheckInRecordItem) GetFlagged() bool {
+	if x != nil {
+		return x.Flagged
+	}
+	return false
+}
+
+func (x *CheckInRecordItem) GetFlagReason() string {
+	if x != nil {
+		return x.FlagReason
+	}
+	return ""
+}
+
+type ListCheckInRecordsResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          []*CheckInRecordItem   `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	Pagination    *Pagination            `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCheckInRecordsResp) Reset() {
+	*x = ListCheckInRecordsResp{}
+	mi := &file_activity_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCheckInRecordsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCheckInRecordsResp) ProtoMessage() {}
+
+func (x *ListCheckInRecordsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCheckInRecordsResp.ProtoReflect.Descriptor instead.
+func (*ListCheckInRecordsResp) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *ListCheckInRecordsResp) GetList() []*CheckInRecordItem {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *ListCheckInRecordsResp) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// ActivityTemplate 活动模板（时间以相对活动开始时间的秒数保存）
+type ActivityTemplate struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Scope               int32                  `protobuf:"varint,2,opt,name=scope,proto3" json:"scope,omitempty"` // 1个人 2公共
+	Name                string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	CategoryId          int64                  `protobuf:"varint,4,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	CategoryName        string                 `protobuf:"bytes,5,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
+	Status              int32                  `protobuf:"varint,6,opt,name=status,proto3" json:"status,omitempty"` // 1可用 0已下架
+	Title               string                 `protobuf:"bytes,7,opt,name=title,proto3" json:"title,omitempty"`
+	CoverUrl            string                 `protobuf:"bytes,8,opt,name=cover_url,json=coverUrl,proto3" json:"cover_url,omitempty"`
+	Location            string                 `protobuf:"bytes,9,opt,name=location,proto3" json:"location,omitempty"`
+	MaxParticipants     int32                  `protobuf:"varint,10,opt,name=max_participants,json=maxParticipants,proto3" json:"max_participants,omitempty"`
+	DurationSeconds     int64                  `protobuf:"varint,11,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`               // 活动时长
+	RegisterOpenBefore  int64                  `protobuf:"varint,12,opt,name=register_open_before,json=registerOpenBefore,proto3" json:"register_open_before,omitempty"`    // 开始前多久开放报名
+	RegisterCloseBefore int64                  `protobuf:"varint,13,opt,name=register_close_before,json=registerCloseBefore,proto3" json:"register_close_before,omitempty"` // 开始前多久截止报名
+	TagIds              []int64                `protobuf:"varint,14,rep,packed,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty"`
+	SourceActivityId    int64                  `protobuf:"varint,15,opt,name=source_activity_id,json=sourceActivityId,proto3" json:"source_activity_id,omitempty"`
+	UseCount            int64                  `protobuf:"varint,16,opt,name=use_count,json=useCount,proto3" json:"use_count,omitempty"`
+	OwnerId             int64                  `protobuf:"varint,17,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	CreatedAt           int64                  `protobuf:"varint,18,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ActivityTemplate) Reset() {
+	*x = ActivityTemplate{}
+	mi := &file_activity_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityTemplate) ProtoMessage() {}
+
+func (x *ActivityTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityTemplate.ProtoReflect.Descriptor instead.
+func (*ActivityTemplate) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *ActivityTemplate) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ActivityTemplate) GetScope() int32 {
+	if x != nil {
+		return x.Scope
+	}
+	return 0
+}
+
+func (x *ActivityTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ActivityTemplate) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *ActivityTemplate) GetCategoryName() string {
+	if x != nil {
+		return x.CategoryName
+	}
+	return ""
+}
+
+func (x *ActivityTemplate) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *ActivityTemplate) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ActivityTemplate) GetCoverUrl() string {
+	if x != nil {
+		return x.CoverUrl
+	}
+	return ""
+}
+
+func (x *ActivityTemplate) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *ActivityTemplate) GetMaxParticipants() int32 {
+	if x != nil {
+		return x.MaxParticipants
+	}
+	return 0
+}
+
+func (x *ActivityTemplate) GetDurationSeconds() int64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *ActivityTemplate) GetRegisterOpenBefore() int64 {
+	if x != nil {
+		return x.RegisterOpenBefore
+	}
+	return 0
+}
+
+func (x *ActivityTemplate) GetRegisterCloseBefore() int64 {
+	if x != nil {
+		return x.RegisterCloseBefore
+	}
+	return 0
+}
+
+func (x *ActivityTemplate) GetTagIds() []int64 {
+	if x != nil {
+		return x.TagIds
+	}
+	return nil
+}
+
+func (x *ActivityTemplate) GetSourceActivityId() int64 {
+	if x != nil {
+		return x.SourceActivityId
+	}
+	return 0
+}
+
+func (x *ActivityTemplate) GetUseCount() int64 {
+	if x != nil {
+		return x.UseCount
+	}
+	return 0
+}
+
+func (x *ActivityTemplate) GetOwnerId() int64 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+func (x *ActivityTemplate) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type ActivityTemplateResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *ActivityTemplate      `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityTemplateResp) Reset() {
+	*x = ActivityTemplateResp{}
+	mi := &file_activity_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityTemplateResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityTemplateResp) ProtoMessage() {}
+
+func (x *ActivityTemplateResp) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityTemplateResp.ProtoReflect.Descriptor instead.
+func (*ActivityTemplateResp) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *ActivityTemplateResp) GetTemplate() *ActivityTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type CloneActivityReq struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ActivityId        int64                  `protobuf:"varint,1,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"`
+	OperatorId        int64                  `protobuf:"varint,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	ActivityStartTime int64                  `protobuf:"varint,3,opt,name=activity_start_time,json=activityStartTime,proto3" json:"activity_start_time,omitempty"` // 新活动开始时间，报名与结束时间按原活动间隔平移
+	Title             string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`                                                     // 为空沿用原标题
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CloneActivityReq) Reset() {
+	*x = CloneActivityReq{}
+	mi := &file_activity_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloneActivityReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloneActivityReq) ProtoMessage() {}
+
+func (x *CloneActivityReq) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloneActivityReq.ProtoReflect.Descriptor instead.
+func (*CloneActivityReq) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *CloneActivityReq) GetActivityId() int64 {
+	if x != nil {
+		return x.ActivityId
+	}
+	return 0
+}
+
+func (x *CloneActivityReq) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *CloneActivityReq) GetActivityStartTime() int64 {
+	if x != nil {
+		return x.ActivityStartTime
+	}
+	return 0
+}
+
+func (x *CloneActivityReq) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+type SaveActivityTemplateReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActivityId    int64                  `protobuf:"varint,1,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"`
+	OperatorId    int64                  `protobuf:"varint,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"` // 为空使用活动标题
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveActivityTemplateReq) Reset() {
+	*x = SaveActivityTemplateReq{}
+	mi := &file_activity_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveActivityTemplateReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveActivityTemplateReq) ProtoMessage() {}
+
+func (x *SaveActivityTemplateReq) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveActivityTemplateReq.ProtoReflect.Descriptor instead.
+func (*SaveActivityTemplateReq) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *SaveActivityTemplateReq) GetActivityId() int64 {
+	if x != nil {
+		return x.ActivityId
+	}
+	return 0
+}
+
+func (x *SaveActivityTemplateReq) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *SaveActivityTemplateReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListActivityTemplatesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OperatorId    int64                  `protobuf:"varint,1,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	Scope         int32                  `protobuf:"varint,2,opt,name=scope,proto3" json:"scope,omitempty"` // 0全部 1个人 2公共（管理员固定为公共）
+	CategoryId    int64                  `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	IsAdmin       bool                   `protobuf:"varint,4,opt,name=is_admin,json=isAdmin,proto3" json:"is_admin,omitempty"` // 管理端：返回全部公共模板（含已下架）
+	Page          int32                  `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListActivityTemplatesReq) Reset() {
+	*x = ListActivityTemplatesReq{}
+	mi := &file_activity_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListActivityTemplatesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListActivityTemplatesReq) ProtoMessage() {}
+
+func (x *ListActivityTemplatesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListActivityTemplatesReq.ProtoReflect.Descriptor instead.
+func (*ListActivityTemplatesReq) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *ListActivityTemplatesReq) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *ListActivityTemplatesReq) GetScope() int32 {
+	if x != nil {
+		return x.Scope
+	}
+	return 0
+}
+
+func (x *ListActivityTemplatesReq) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *ListActivityTemplatesReq) GetIsAdmin() bool {
+	if x != nil {
+		return x.IsAdmin
+	}
+	return false
+}
+
+func (x *ListActivityTemplatesReq) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListActivityTemplatesReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListActivityTemplatesResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          []*ActivityTemplate    `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	Pagination    *Pagination            `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListActivityTemplatesResp) Reset() {
+	*x = ListActivityTemplatesResp{}
+	mi := &file_activity_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListActivityTemplatesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListActivityTemplatesResp) ProtoMessage() {}
+
+func (x *ListActivityTemplatesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListActivityTemplatesResp.ProtoReflect.Descriptor instead.
+func (*ListActivityTemplatesResp) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *ListActivityTemplatesResp) GetList() []*ActivityTemplate {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *ListActivityTemplatesResp) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type CreateActivityFromTemplateReq struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId        int64                  `protobuf:"varint,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	OperatorId        int64                  `protobuf:"varint,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	ActivityStartTime int64                  `protobuf:"varint,3,opt,name=activity_start_time,json=activityStartTime,proto3" json:"activity_start_time,omitempty"`
+	Title             string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"` // 为空使用模板标题
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CreateActivityFromTemplateReq) Reset() {
+	*x = CreateActivityFromTemplateReq{}
+	mi := &file_activity_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateActivityFromTemplateReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateActivityFromTemplateReq) ProtoMessage() {}
+
+func (x *CreateActivityFromTemplateReq) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateActivityFromTemplateReq.ProtoReflect.Descriptor instead.
+func (*CreateActivityFromTemplateReq) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *CreateActivityFromTemplateReq) GetTemplateId() int64 {
+	if x != nil {
+		return x.TemplateId
+	}
+	return 0
+}
+
+func (x *CreateActivityFromTemplateReq) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *CreateActivityFromTemplateReq) GetActivityStartTime() int64 {
+	if x != nil {
+		return x.ActivityStartTime
+	}
+	return 0
+}
+
+func (x *CreateActivityFromTemplateReq) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+type DeleteActivityTemplateReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OperatorId    int64                  `protobuf:"varint,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	IsAdmin       bool                   `protobuf:"varint,3,opt,name=is_admin,json=isAdmin,proto3" json:"is_admin,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteActivityTemplateReq) Reset() {
+	*x = DeleteActivityTemplateReq{}
+	mi := &file_activity_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteActivityTemplateReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteActivityTemplateReq) ProtoMessage() {}
+
+func (x *DeleteActivityTemplateReq) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteActivityTemplateReq.ProtoReflect.Descriptor instead.
+func (*DeleteActivityTemplateReq) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *DeleteActivityTemplateReq) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeleteActivityTemplateReq) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *DeleteActivityTemplateReq) GetIsAdmin() bool {
+	if x != nil {
+		return x.IsAdmin
+	}
+	return false
+}
+
+type DeleteActivityTemplateResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteActivityTemplateResp) Reset() {
+	*x = DeleteActivityTemplateResp{}
+	mi := &file_activity_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteActivityTemplateResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteActivityTemplateResp) ProtoMessage() {}
+
+func (x *DeleteActivityTemplateResp) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteActivityTemplateResp.ProtoReflect.Descriptor instead.
+func (*DeleteActivityTemplateResp) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{108}
+}
+
+type AdminCreatePublicTemplateReq struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	SourceActivityId int64                  `protobuf:"varint,1,opt,name=source_activity_id,json=sourceActivityId,proto3" json:"source_activity_id,omitempty"`
+	OperatorId       int64                  `protobuf:"varint,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	Name             string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	CategoryId       int64                  `protobuf:"varint,4,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"` // 0=沿用来源活动分类
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *AdminCreatePublicTemplateReq) Reset() {
+	*x = AdminCreatePublicTemplateReq{}
+	mi := &file_activity_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminCreatePublicTemplateReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminCreatePublicTemplateReq) ProtoMessage() {}
+
+func (x *AdminCreatePublicTemplateReq) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminCreatePublicTemplateReq.ProtoReflect.Descriptor instead.
+func (*AdminCreatePublicTemplateReq) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *AdminCreatePublicTemplateReq) GetSourceActivityId() int64 {
+	if x != nil {
+		return x.SourceActivityId
+	}
+	return 0
+}
+
+func (x *AdminCreatePublicTemplateReq) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *AdminCreatePublicTemplateReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AdminCreatePublicTemplateReq) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+// AdminUpdateTemplateReq 修改公共模板（空字段不修改）
+type AdminUpdateTemplateReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OperatorId    int64                  `protobuf:"varint,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	CategoryId    int64                  `protobuf:"varint,4,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Status        *int32                 `protobuf:"varint,5,opt,name=status,proto3,oneof" json:"status,omitempty"` // 1上架 0下架
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminUpdateTemplateReq) Reset() {
+	*x = AdminUpdateTemplateReq{}
+	mi := &file_activity_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminUpdateTemplateReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUpdateTemplateReq) ProtoMessage() {}
+
+func (x *AdminUpdateTemplateReq) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUpdateTemplateReq.ProtoReflect.Descriptor instead.
+func (*AdminUpdateTemplateReq) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *AdminUpdateTemplateReq) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminUpdateTemplateReq) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *AdminUpdateTemplateReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AdminUpdateTemplateReq) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *AdminUpdateTemplateReq) GetStatus() int32 {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return 0
+}
+
+// AnalyticsFunnel 报名转化漏斗
+type AnalyticsFunnel struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	PageViews           int64                  `protobuf:"varint,1,opt,name=page_views,json=pageViews,proto3" json:"page_views,omitempty"`                               // 浏览次数
+	UniqueVisitors      int64                  `protobuf:"varint,2,opt,name=unique_visitors,json=uniqueVisitors,proto3" json:"unique_visitors,omitempty"`                // 浏览人数
+	Registrations       int64                  `protobuf:"varint,3,opt,name=registrations,proto3" json:"registrations,omitempty"`                                        // 累计报名数（含已取消）
+	Cancellations       int64                  `protobuf:"varint,4,opt,name=cancellations,proto3" json:"cancellations,omitempty"`                                        // 取消数
+	ActiveRegistrations int64                  `protobuf:"varint,5,opt,name=active_registrations,json=activeRegistrations,proto3" json:"active_registrations,omitempty"` // 有效报名数
+	CheckIns            int64                  `protobuf:"varint,6,opt,name=check_ins,json=checkIns,proto3" json:"check_ins,omitempty"`                                  // 签到人数
+	NoShows             int64                  `protobuf:"varint,7,opt,name=no_shows,json=noShows,proto3" json:"no_shows,omitempty"`                                     // 未到场人数（活动结束后统计）
+	AttendanceRate      float64                `protobuf:"fixed64,8,opt,name=attendance_rate,json=attendanceRate,proto3" json:"attendance_rate,omitempty"`               // 到场率 = 签到人数 / 有效报名数
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *AnalyticsFunnel) Reset() {
+	*x = AnalyticsFunnel{}
+	mi := &file_activity_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnalyticsFunnel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalyticsFunnel) ProtoMessage() {}
+
+func (x *AnalyticsFunnel) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalyticsFunnel.ProtoReflect.Descriptor instead.
+func (*AnalyticsFunnel) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *AnalyticsFunnel) GetPageViews() int64 {
+	if x != nil {
+		return x.PageViews
+	}
+	return 0
+}
+
+func (x *AnalyticsFunnel) GetUniqueVisitors() int64 {
+	if x != nil {
+		return x.UniqueVisitors
+	}
+	return 0
+}
+
+func (x *AnalyticsFunnel) GetRegistrations() int64 {
+	if x != nil {
+		return x.Registrations
+	}
+	return 0
+}
+
+func (x *AnalyticsFunnel) GetCancellations() int64 {
+	if x != nil {
+		return x.Cancellations
+	}
+	return 0
+}
+
+func (x *AnalyticsFunnel) GetActiveRegistrations() int64 {
+	if x != nil {
+		return x.ActiveRegistrations
+	}
+	return 0
+}
+
+func (x *AnalyticsFunnel) GetCheckIns() int64 {
+	if x != nil {
+		return x.CheckIns
+	}
+	return 0
+}
+
+func (x *AnalyticsFunnel) GetNoShows() int64 {
+	if x != nil {
+		return x.NoShows
+	}
+	return 0
+}
+
+func (x *AnalyticsFunnel) GetAttendanceRate() float64 {
+	if x != nil {
+		return x.AttendanceRate
+	}
+	return 0
+}
+
+// RegistrationVelocity 报名速度
+type RegistrationVelocity struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	First_24HRegistrations int64                  `protobuf:"varint,1,opt,name=first_24h_registrations,json=first24hRegistrations,proto3" json:"first_24h_registrations,omitempty"`    // 开放报名 24 小时内报名数
+	PeakDailyRegistrations int64                  `protobuf:"varint,2,opt,name=peak_daily_registrations,json=peakDailyRegistrations,proto3" json:"peak_daily_registrations,omitempty"` // 单日最高报名数
+	AvgDailyRegistrations  float64                `protobuf:"fixed64,3,opt,name=avg_daily_registrations,json=avgDailyRegistrations,proto3" json:"avg_daily_registrations,omitempty"`   // 报名期日均报名数
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *RegistrationVelocity) Reset() {
+	*x = RegistrationVelocity{}
+	mi := &file_activity_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegistrationVelocity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegistrationVelocity) ProtoMessage() {}
+
+func (x *RegistrationVelocity) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegistrationVelocity.ProtoReflect.Descriptor instead.
+func (*RegistrationVelocity) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *RegistrationVelocity) GetFirst_24HRegistrations() int64 {
+	if x != nil {
+		return x.First_24HRegistrations
+	}
+	return 0
+}
+
+func (x *RegistrationVelocity) GetPeakDailyRegistrations() int64 {
+	if x != nil {
+		return x.PeakDailyRegistrations
+	}
+	return 0
+}
+
+func (x *RegistrationVelocity) GetAvgDailyRegistrations() float64 {
+	if x != nil {
+		return x.AvgDailyRegistrations
+	}
+	return 0
+}
+
+// AnalyticsDaily 每日统计
+type AnalyticsDaily struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Date           string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"` // YYYY-MM-DD
+	PageViews      int64                  `protobuf:"varint,2,opt,name=page_views,json=pageViews,proto3" json:"page_views,omitempty"`
+	UniqueVisitors int64                  `protobuf:"varint,3,opt,name=unique_visitors,json=uniqueVisitors,proto3" json:"unique_visitors,omitempty"`
+	Registrations  int64                  `protobuf:"varint,4,opt,name=registrations,proto3" json:"registrations,omitempty"`
+	Cancellations  int64                  `protobuf:"varint,5,opt,name=cancellations,proto3" json:"cancellations,omitempty"`
+	CheckIns       int64                  `protobuf:"varint,6,opt,name=check_ins,json=checkIns,proto3" json:"check_ins,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AnalyticsDaily) Reset() {
+	*x = AnalyticsDaily{}
+	mi := &file_activity_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnalyticsDaily) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalyticsDaily) ProtoMessage() {}
+
+func (x *AnalyticsDaily) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalyticsDaily.ProtoReflect.Descriptor instead.
+func (*AnalyticsDaily) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *AnalyticsDaily) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *AnalyticsDaily) GetPageViews() int64 {
+	if x != nil {
+		return x.PageViews
+	}
+	return 0
+}
+
+func (x *AnalyticsDaily) GetUniqueVisitors() int64 {
+	if x != nil {
+		return x.UniqueVisitors
+	}
+	return 0
+}
+
+func (x *AnalyticsDaily) GetRegistrations() int64 {
+	if x != nil {
+		return x.Registrations
+	}
+	return 0
+}
+
+func (x *AnalyticsDaily) GetCancellations() int64 {
+	if x != nil {
+		return x.Cancellations
+	}
+	return 0
+}
+
+func (x *AnalyticsDaily) GetCheckIns() int64 {
+	if x != nil {
+		return x.CheckIns
+	}
+	return 0
+}
+
+// AudienceBucket 报名者构成分布项
+type AudienceBucket struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AudienceBucket) Reset() {
+	*x = AudienceBucket{}
+	mi := &file_activity_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AudienceBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AudienceBucket) ProtoMessage() {}
+
+func (x *AudienceBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AudienceBucket.ProtoReflect.Descriptor instead.
+func (*AudienceBucket) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *AudienceBucket) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *AudienceBucket) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// AudienceBreakdown 报名者构成（仅统计已通过学生认证的有效报名者）
+type AudienceBreakdown struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	VerifiedRegistrants int64                  `protobuf:"varint,1,opt,name=verified_registrants,json=verifiedRegistrants,proto3" json:"verified_registrants,omitempty"`
+	Departments         []*AudienceBucket      `protobuf:"bytes,2,rep,name=departments,proto3" json:"departments,omitempty"`
+	AdmissionYears      []*AudienceBucket      `protobuf:"bytes,3,rep,name=admission_years,json=admissionYears,proto3" json:"admission_years,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *AudienceBreakdown) Reset() {
+	*x = AudienceBreakdown{}
+	mi := &file_activity_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AudienceBreakdown) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AudienceBreakdown) ProtoMessage() {}
+
+func (x *AudienceBreakdown) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AudienceBreakdown.ProtoReflect.Descriptor instead.
+func (*AudienceBreakdown) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *AudienceBreakdown) GetVerifiedRegistrants() int64 {
+	if x != nil {
+		return x.VerifiedRegistrants
+	}
+	return 0
+}
+
+func (x *AudienceBreakdown) GetDepartments() []*AudienceBucket {
+	if x != nil {
+		return x.Departments
+	}
+	return nil
+}
+
+func (x *AudienceBreakdown) GetAdmissionYears() []*AudienceBucket {
+	if x != nil {
+		return x.AdmissionYears
+	}
+	return nil
+}
+
+type GetActivityAnalyticsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActivityId    int64                  `protobuf:"varint,1,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"`
+	OperatorId    int64                  `protobuf:"varint,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	StartDate     string                 `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // YYYY-MM-DD，为空默认最近 30 天
+	EndDate       string                 `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`       // YYYY-MM-DD，为空默认今天
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetActivityAnalyticsReq) Reset() {
+	*x = GetActivityAnalyticsReq{}
+	mi := &file_activity_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetActivityAnalyticsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActivityAnalyticsReq) ProtoMessage() {}
+
+func (x *GetActivityAnalyticsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActivityAnalyticsReq.ProtoReflect.Descriptor instead.
+func (*GetActivityAnalyticsReq) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *GetActivityAnalyticsReq) GetActivityId() int64 {
+	if x != nil {
+		return x.ActivityId
+	}
+	return 0
+}
+
+func (x *GetActivityAnalyticsReq) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *GetActivityAnalyticsReq) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *GetActivityAnalyticsReq) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+type GetActivityAnalyticsResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActivityId    int64                  `protobuf:"varint,1,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Funnel        *AnalyticsFunnel       `protobuf:"bytes,3,opt,name=funnel,proto3" json:"funnel,omitempty"`
+	Velocity      *RegistrationVelocity  `protobuf:"bytes,4,opt,name=velocity,proto3" json:"velocity,omitempty"`
+	Daily         []*AnalyticsDaily      `protobuf:"bytes,5,rep,name=daily,proto3" json:"daily,omitempty"`
+	Audience      *AudienceBreakdown     `protobuf:"bytes,6,opt,name=audience,proto3" json:"audience,omitempty"`
+	StatDate      string                 `protobuf:"bytes,7,opt,name=stat_date,json=statDate,proto3" json:"stat_date,omitempty"`     // 最近聚合日期（空表示尚未统计）
+	UpdatedAt     int64                  `protobuf:"varint,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // 最近聚合时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetActivityAnalyticsResp) Reset() {
+	*x = GetActivityAnalyticsResp{}
+	mi := &file_activity_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetActivityAnalyticsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActivityAnalyticsResp) ProtoMessage() {}
+
+func (x *GetActivityAnalyticsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActivityAnalyticsResp.ProtoReflect.Descriptor instead.
+func (*GetActivityAnalyticsResp) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *GetActivityAnalyticsResp) GetActivityId() int64 {
+	if x != nil {
+		return x.ActivityId
+	}
+	return 0
+}
+
+func (x *GetActivityAnalyticsResp) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *GetActivityAnalyticsResp) GetFunnel() *AnalyticsFunnel {
+	if x != nil {
+		return x.Funnel
+	}
+	return nil
+}
+
+func (x *GetActivityAnalyticsResp) GetVelocity() *RegistrationVelocity {
+	if x != nil {
+		return x.Velocity
+	}
+	return nil
+}
+
+func (x *GetActivityAnalyticsResp) GetDaily() []*AnalyticsDaily {
+	if x != nil {
+		return x.Daily
+	}
+	return nil
+}
+
+func (x *GetActivityAnalyticsResp) GetAudience() *AudienceBreakdown {
+	if x != nil {
+		return x.Audience
+	}
+	return nil
+}
+
+func (x *GetActivityAnalyticsResp) GetStatDate() string {
+	if x != nil {
+		return x.StatDate
+	}
+	return ""
+}
+
+func (x *GetActivityAnalyticsResp) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+// ActivityAnalyticsBrief 总览中的单个活动指标
+type ActivityAnalyticsBrief struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ActivityId     int64                  `protobuf:"varint,1,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"`
+	Title          string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	UniqueVisitors int64                  `protobuf:"varint,3,opt,name=unique_visitors,json=uniqueVisitors,proto3" json:"unique_visitors,omitempty"`
+	Registrations  int64                  `protobuf:"varint,4,opt,name=registrations,proto3" json:"registrations,omitempty"`
+	CheckIns       int64                  `protobuf:"varint,5,opt,name=check_ins,json=checkIns,proto3" json:"check_ins,omitempty"`
+	AttendanceRate float64                `protobuf:"fixed64,6,opt,name=attendance_rate,json=attendanceRate,proto3" json:"attendance_rate,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ActivityAnalyticsBrief) Reset() {
+	*x = ActivityAnalyticsBrief{}
+	mi := &file_activity_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityAnalyticsBrief) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityAnalyticsBrief) ProtoMessage() {}
+
+func (x *ActivityAnalyticsBrief) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityAnalyticsBrief.ProtoReflect.Descriptor instead.
+func (*ActivityAnalyticsBrief) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *ActivityAnalyticsBrief) GetActivityId() int64 {
+	if x != nil {
+		return x.ActivityId
+	}
+	return 0
+}
+
+func (x *ActivityAnalyticsBrief) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ActivityAnalyticsBrief) GetUniqueVisitors() int64 {
+	if x != nil {
+		return x.UniqueVisitors
+	}
+	return 0
+}
+
+func (x *ActivityAnalyticsBrief) GetRegistrations() int64 {
+	if x != nil {
+		return x.Registrations
+	}
+	return 0
+}
+
+func (x *ActivityAnalyticsBrief) GetCheckIns() int64 {
+	if x != nil {
+		return x.CheckIns
+	}
+	return 0
+}
+
+func (x *ActivityAnalyticsBrief) GetAttendanceRate() float64 {
+	if x != nil {
+		return x.AttendanceRate
+	}
+	return 0
+}
+
+type GetOrganizerAnalyticsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrganizerId   int64                  `protobuf:"varint,1,opt,name=organizer_id,json=organizerId,proto3" json:"organizer_id,omitempty"`
+	StartDate     string                 `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // YYYY-MM-DD，为空默认最近 30 天
+	EndDate       string                 `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`       // YYYY-MM-DD，为空默认今天
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrganizerAnalyticsReq) Reset() {
+	*x = GetOrganizerAnalyticsReq{}
+	mi := &file_activity_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrganizerAnalyticsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrganizerAnalyticsReq) ProtoMessage() {}
+
+func (x *GetOrganizerAnalyticsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrganizerAnalyticsReq.ProtoReflect.Descriptor instead.
+func (*GetOrganizerAnalyticsReq) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *GetOrganizerAnalyticsReq) GetOrganizerId() int64 {
+	if x != nil {
+		return x.OrganizerId
+	}
+	return 0
+}
+
+func (x *GetOrganizerAnalyticsReq) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *GetOrganizerAnalyticsReq) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+type GetOrganizerAnalyticsResp struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	ActivityCount int64                     `protobuf:"varint,1,opt,name=activity_count,json=activityCount,proto3" json:"activity_count,omitempty"` // 有统计数据的活动数
+	Funnel        *AnalyticsFunnel          `protobuf:"bytes,2,opt,name=funnel,proto3" json:"funnel,omitempty"`                                     // 全部活动累计
+	Daily         []*AnalyticsDaily         `protobuf:"bytes,3,rep,name=daily,proto3" json:"daily,omitempty"`
+	Audience      *AudienceBreakdown        `protobuf:"bytes,4,opt,name=audience,proto3" json:"audience,omitempty"`
+	TopActivities []*ActivityAnalyticsBrief `protobuf:"bytes,5,rep,name=top_activities,json=topActivities,proto3" json:"top_activities,omitempty"` // 报名数最多的活动
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrganizerAnalyticsResp) Reset() {
+	*x = GetOrganizerAnalyticsResp{}
+	mi := &file_activity_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrganizerAnalyticsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrganizerAnalyticsResp) ProtoMessage() {}
+
+func (x *GetOrganizerAnalyticsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrganizerAnalyticsResp.ProtoReflect.Descriptor instead.
+func (*GetOrganizerAnalyticsResp) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *GetOrganizerAnalyticsResp) GetActivityCount() int64 {
+	if x != nil {
+		return x.ActivityCount
+	}
+	return 0
+}
+
+func (x *GetOrganizerAnalyticsResp) GetFunnel() *AnalyticsFunnel {
+	if x != nil {
+		return x.Funnel
+	}
+	return nil
+}
+
+func (x *GetOrganizerAnalyticsResp) GetDaily() []*AnalyticsDaily {
+	if x != nil {
+		return x.Daily
+	}
+	return nil
+}
+
+func (x *GetOrganizerAnalyticsResp) GetAudience() *AudienceBreakdown {
+	if x != nil {
+		return x.Audience
+	}
+	return nil
+}
+
+func (x *GetOrganizerAnalyticsResp) GetTopActivities() []*ActivityAnalyticsBrief {
+	if x != nil {
+		return x.TopActivities
+	}
+	return nil
+}
+
+type SearchActivitiesReq struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Keyword         string                 `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword,omitempty"`
+	CategoryId      int64                  `protobuf:"varint,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Page            int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize        int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Sort            string                 `protobuf:"bytes,5,opt,name=sort,proto3" json:"sort,omitempty"`                                                     // relevance, time, hot, rating, distance
+	Longitude       float64                `protobuf:"fixed64,6,opt,name=longitude,proto3" json:"longitude,omitempty"`                                         // 用户经度（可选，与 latitude 同时传入时启用地理过滤）
+	Latitude        float64                `protobuf:"fixed64,7,opt,name=latitude,proto3" json:"latitude,omitempty"`                                           // 用户纬度
+	RadiusKm        float64                `protobuf:"fixed64,8,opt,name=radius_km,json=radiusKm,proto3" json:"radius_km,omitempty"`                           // 搜索半径（公里），默认 5，最大 50
+	TagIds          []int64                `protobuf:"varint,9,rep,packed,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty"`                           // 标签筛选（命中任一标签即可，最多 5 个）
+	StartTimeFrom   int64                  `protobuf:"varint,10,opt,name=start_time_from,json=startTimeFrom,proto3" json:"start_time_from,omitempty"`          // 活动开始时间下限（Unix 秒，0=不限）
+	StartTimeTo     int64                  `protobuf:"varint,11,opt,name=start_time_to,json=startTimeTo,proto3" json:"start_time_to,omitempty"`                // 活动开始时间上限（Unix 秒，0=不限）
+	HasSeats        bool                   `protobuf:"varint,12,opt,name=has_seats,json=hasSeats,proto3" json:"has_seats,omitempty"`                           // 只看还有名额的活动（不限人数的活动视为有名额）
+	NoStudentVerify bool                   `protobuf:"varint,13,opt,name=no_student_verify,json=noStudentVerify,proto3" json:"no_student_verify,omitempty"`    // 只看不需要学生认证的活动
+	MaxCreditScore  *int32                 `protobuf:"varint,14,opt,name=max_credit_score,json=maxCreditScore,proto3,oneof" json:"max_credit_score,omitempty"` // 信用分门槛上限（只看 min_credit_score <= 该值的活动）
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SearchActivitiesReq) Reset() {
+	*x = SearchActivitiesReq{}
+	mi := &file_activity_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchActivitiesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchActivitiesReq) ProtoMessage() {}
+
+func (x *SearchActivitiesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchActivitiesReq.ProtoReflect.Descriptor instead.
+func (*SearchActivitiesReq) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *SearchActivitiesReq) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *SearchActivitiesReq) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *SearchActivitiesReq) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *SearchActivitiesReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchActivitiesReq) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *SearchActivitiesReq) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *SearchActivitiesReq) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *SearchActivitiesReq) GetRadiusKm() float64 {
+	if x != nil {
+		return x.RadiusKm
+	}
+	return 0
+}
+
+func (x *SearchActivitiesReq) GetTagIds() []int64 {
+	if x != nil {
+		return x.TagIds
+	}
+	return nil
+}
+
+func (x *SearchActivitiesReq) GetStartTimeFrom() int64 {
+	if x != nil {
+		return x.StartTimeFrom
+	}
+	return 0
+}
+
+func (x *SearchActivitiesReq) GetStartTimeTo() int64 {
+	if x != nil {
+		return x.StartTimeTo
+	}
+	return 0
+}
+
+func (x *SearchActivitiesReq) GetHasSeats() bool {
+	if x != nil {
+		return x.HasSeats
+	}
+	return false
+}
+
+func (x *SearchActivitiesReq) GetNoStudentVerify() bool {
+	if x != nil {
+		return x.NoStudentVerify
+	}
+	return false
+}
+
+func (x *SearchActivitiesReq) GetMaxCreditScore() int32 {
+	if x != nil && x.MaxCreditScore != nil {
+		return *x.MaxCreditScore
+	}
+	return 0
+}
+
+type SearchActivitiesResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          []*ActivityListItem    `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	QueryTimeMs   int32                  `protobuf:"varint,3,opt,name=query_time_ms,json=queryTimeMs,proto3" json:"query_time_ms,omitempty"`
+	Facets        *SearchFacets          `protobuf:"bytes,4,opt,name=facets,proto3" json:"facets,omitempty"` // 分面统计（用于前端筛选侧栏）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchActivitiesResp) Reset() {
+	*x = SearchActivitiesResp{}
+	mi := &file_activity_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchActivitiesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchActivitiesResp) ProtoMessage() {}
+
+func (x *SearchActivitiesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchActivitiesResp.ProtoReflect.Descriptor instead.
+func (*SearchActivitiesResp) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *SearchActivitiesResp) GetList() []*ActivityListItem {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *SearchActivitiesResp) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SearchActivitiesResp) GetQueryTimeMs() int32 {
+	if x != nil {
+		return x.QueryTimeMs
+	}
+	return 0
+}
+
+func (x *SearchActivitiesResp) GetFacets() *SearchFacets {
+	if x != nil {
+		return x.Facets
+	}
+	return nil
+}
+
+// 分面统计桶
+type FacetBucket struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`       // 分类ID / 标签ID / 状态值
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`    // 分类名 / 标签名 / 状态文本
+	Count         int64                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"` // 命中活动数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FacetBucket) Reset() {
+	*x = FacetBucket{}
+	mi := &file_activity_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FacetBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FacetBucket) ProtoMessage() {}
+
+func (x *FacetBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FacetBucket.ProtoReflect.Descriptor instead.
+func (*FacetBucket) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *FacetBucket) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *FacetBucket) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FacetBucket) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// 搜索分面统计
+// 每个维度的统计不受该维度自身筛选条件影响（多选筛选时其他选项的数量仍可见）
+type SearchFacets struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Categories    []*FacetBucket         `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	Tags          []*FacetBucket         `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	Statuses      []*FacetBucket         `protobuf:"bytes,3,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchFacets) Reset() {
+	*x = SearchFacets{}
+	mi := &file_activity_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchFacets) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchFacets) ProtoMessage() {}
+
+func (x *SearchFacets) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchFacets.ProtoReflect.Descriptor instead.
+func (*SearchFacets) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *SearchFacets) GetCategories() []*FacetBucket {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *SearchFacets) GetTags() []*FacetBucket {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *SearchFacets) GetStatuses() []*FacetBucket {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+type GetHotActivitiesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHotActivitiesReq) Reset() {
+	*x = GetHotActivitiesReq{}
+	mi := &file_activity_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHotActivitiesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHotActivitiesReq) ProtoMessage() {}
+
+func (x *GetHotActivitiesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHotActivitiesReq.ProtoReflect.Descriptor instead.
+func (*GetHotActivitiesReq) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *GetHotActivitiesReq) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetHotActivitiesResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          []*ActivityListItem    `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHotActivitiesResp) Reset() {
+	*x = GetHotActivitiesResp{}
+	mi := &file_activity_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHotActivitiesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHotActivitiesResp) ProtoMessage() {}
+
+func (x *GetHotActivitiesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHotActivitiesResp.ProtoReflect.Descriptor instead.
+func (*GetHotActivitiesResp) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *GetHotActivitiesResp) GetList() []*ActivityListItem {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type NearbyActivitiesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Longitude     float64                `protobuf:"fixed64,1,opt,name=longitude,proto3" json:"longitude,omitempty"`                    // 用户经度（必填）
+	Latitude      float64                `protobuf:"fixed64,2,opt,name=latitude,proto3" json:"latitude,omitempty"`                      // 用户纬度（必填）
+	RadiusKm      float64                `protobuf:"fixed64,3,opt,name=radius_km,json=radiusKm,proto3" json:"radius_km,omitempty"`      // 搜索半径（公里），默认 5，最大 50
+	CategoryId    int64                  `protobuf:"varint,4,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"` // 分类筛选（0=全部）
+	Keyword       string                 `protobuf:"bytes,5,opt,name=keyword,proto3" json:"keyword,omitempty"`                          // 关键词（可选）
+	Page          int32                  `protobuf:"varint,6,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,7,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Sort          string                 `protobuf:"bytes,8,opt,name=sort,proto3" json:"sort,omitempty"` // distance（默认）, time, hot
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NearbyActivitiesReq) Reset() {
+	*x = NearbyActivitiesReq{}
+	mi := &file_activity_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NearbyActivitiesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NearbyActivitiesReq) ProtoMessage() {}
+
+func (x *NearbyActivitiesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NearbyActivitiesReq.ProtoReflect.Descriptor instead.
+func (*NearbyActivitiesReq) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *NearbyActivitiesReq) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *NearbyActivitiesReq) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *NearbyActivitiesReq) GetRadiusKm() float64 {
+	if x != nil {
+		return x.RadiusKm
+	}
+	return 0
+}
+
+func (x *NearbyActivitiesReq) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *NearbyActivitiesReq) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *NearbyActivitiesReq) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *NearbyActivitiesReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *NearbyActivitiesReq) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+type NearbyActivitiesResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          []*ActivityListItem    `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"` // distance 字段为与用户的距离（米）
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	QueryTimeMs   int32                  `protobuf:"varint,3,opt,name=query_time_ms,json=queryTimeMs,proto3" json:"query_time_ms,omitempty"`
+	Source        string                 `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"` // 数据来源: es / mysql
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NearbyActivitiesResp) Reset() {
+	*x = NearbyActivitiesResp{}
+	mi := &file_activity_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NearbyActivitiesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NearbyActivitiesResp) ProtoMessage() {}
+
+func (x *NearbyActivitiesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NearbyActivitiesResp.ProtoReflect.Descriptor instead.
+func (*NearbyActivitiesResp) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *NearbyActivitiesResp) GetList() []*ActivityListItem {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *NearbyActivitiesResp) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *NearbyActivitiesResp) GetQueryTimeMs() int32 {
+	if x != nil {
+		return x.QueryTimeMs
+	}
+	return 0
+}
+
+func (x *NearbyActivitiesResp) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+type SuggestActivitiesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Prefix        string                 `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`                            // 输入前缀（必填，1-20字）
+	CategoryId    int64                  `protobuf:"varint,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"` // 分类筛选（0=全部）
+	Size          int32                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`                               // 返回数量，默认 10，最大 20
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestActivitiesReq) Reset() {
+	*x = SuggestActivitiesReq{}
+	mi := &file_activity_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestActivitiesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestActivitiesReq) ProtoMessage() {}
+
+func (x *SuggestActivitiesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestActivitiesReq.ProtoReflect.Descriptor instead.
+func (*SuggestActivitiesReq) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *SuggestActivitiesReq) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *SuggestActivitiesReq) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *SuggestActivitiesReq) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type SuggestActivitiesResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Suggestions   []string               `protobuf:"bytes,1,rep,name=suggestions,proto3" json:"suggestions,omitempty"` // 建议词列表（标题/标签/组织者）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestActivitiesResp) Reset() {
+	*x = SuggestActivitiesResp{}
+	mi := &file_activity_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestActivitiesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestActivitiesResp) ProtoMessage() {}
+
+func (x *SuggestActivitiesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestActivitiesResp.ProtoReflect.Descriptor instead.
+func (*SuggestActivitiesResp) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *SuggestActivitiesResp) GetSuggestions() []string {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+type ListCategoriesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoriesReq) Reset() {
+	*x = ListCategoriesReq{}
+	mi := &file_activity_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesReq) ProtoMessage() {}
+
+func (x *ListCategoriesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesReq.ProtoReflect.Descriptor instead.
+func (*ListCategoriesReq) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{131}
+}
+
+type ListCategoriesResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          []*Category            `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoriesResp) Reset() {
+	*x = ListCategoriesResp{}
+	mi := &file_activity_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesResp) ProtoMessage() {}
+
+func (x *ListCategoriesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateActivityReq) GetMinCreditScore() int32 {
-	if x != nil && x.MinCreditScore != nil {
-		return *x.MinCreditScore
-	}
-	return 0
+// Deprecated: Use ListCategoriesResp.ProtoReflect.Descriptor instead.
+func (*ListCategoriesResp) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{132}
 }
 
-func (x *UpdateActivityReq) GetTagIds() []int64 {
+func (x *ListCategoriesResp) GetList() []*Category {
 	if x != nil {
-		return x.TagIds
+		return x.List
 	}
 	return nil
 }
 
-func (x *UpdateActivityReq) GetUpdateTags() bool {
-	if x != nil {
-		return x.UpdateTags
-	}
-	return false
+type ListTagsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateActivityReq) GetOperatorId() int64 {
+func (x *ListTagsReq) Reset() {
+	*x = ListTagsReq{}
+	mi := &file_activity_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsReq) ProtoMessage() {}
+
+func (x *ListTagsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[133]
 	if x != nil {
-		return x.OperatorId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateActivityReq) GetCoverImageId() int64 {
-	if x != nil && x.CoverImageId != nil {
-		return *x.CoverImageId
+// Deprecated: Use ListTagsReq.ProtoReflect.Descriptor instead.
+func (*ListTagsReq) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *ListTagsReq) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
 	}
 	return 0
 }
 
-type UpdateActivityResp struct {
+type ListTagsResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	NewVersion    int32                  `protobuf:"varint,2,opt,name=new_version,json=newVersion,proto3" json:"new_version,omitempty"`
+	List          []*Tag                 `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateActivityResp) Reset() {
-	*x = UpdateActivityResp{}
-	mi := &file_activity_proto_msgTypes[24]
+func (x *ListTagsResp) Reset() {
+	*x = ListTagsResp{}
+	mi := &file_activity_proto_msgTypes[134]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateActivityResp) String() string {
+func (x *ListTagsResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateActivityResp) ProtoMessage() {}
+func (*ListTagsResp) ProtoMessage() {}
 
-func (x *UpdateActivityResp) ProtoReflect() protoreflect.Message {
-	mi := &file_activity_proto_msgTypes[24]
+func (x *ListTagsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[134]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2244,49 +9907,48 @@ func (x *UpdateActivityResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateActivityResp.ProtoReflect.Descriptor instead.
-func (*UpdateActivityResp) Descriptor() ([]byte, []int) {
-	return file_activity_proto_rawDescGZIP(), []int{24}
-}
-
-func (x *UpdateActivityResp) GetStatus() int32 {
-	if x != nil {
-		return x.Status
-	}
-	return 0
+// Deprecated: Use ListTagsResp.ProtoReflect.Descriptor instead.
+func (*ListTagsResp) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{134}
 }
 
-func (x *UpdateActivityResp) GetNewVersion() int32 {
+func (x *ListTagsResp) GetList() []*Tag {
 	if x != nil {
-		return x.NewVersion
+		return x.List
 	}
-	return 0
+	return nil
 }
 
-type DeleteActivityReq struct {
+// AdminCategory 分类（含禁用状态与活动数）
+type AdminCategory struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	OperatorId    int64                  `protobuf:"varint,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
-	IsAdmin       bool                   `protobuf:"varint,3,opt,name=is_admin,json=isAdmin,proto3" json:"is_admin,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Icon          string                 `protobuf:"bytes,3,opt,name=icon,proto3" json:"icon,omitempty"`
+	Sort          int32                  `protobuf:"varint,4,opt,name=sort,proto3" json:"sort,omitempty"`
+	Status        int32                  `protobuf:"varint,5,opt,name=status,proto3" json:"status,omitempty"`                                    // 1启用 0禁用
+	ActivityCount int64                  `protobuf:"varint,6,opt,name=activity_count,json=activityCount,proto3" json:"activity_count,omitempty"` // 分类下的活动数（不含已删除）
+	CreatedAt     int64                  `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     int64                  `protobuf:"varint,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteActivityReq) Reset() {
-	*x = DeleteActivityReq{}
-	mi := &file_activity_proto_msgTypes[25]
+func (x *AdminCategory) Reset() {
+	*x = AdminCategory{}
+	mi := &file_activity_proto_msgTypes[135]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteActivityReq) String() string {
+func (x *AdminCategory) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteActivityReq) ProtoMessage() {}
+func (*AdminCategory) ProtoMessage() {}
 
-func (x *DeleteActivityReq) ProtoReflect() protoreflect.Message {
-	mi := &file_activity_proto_msgTypes[25]
+func (x *AdminCategory) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[135]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2297,54 +9959,88 @@ func (x *DeleteActivityReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteActivityReq.ProtoReflect.Descriptor instead.
-func (*DeleteActivityReq) Descriptor() ([]byte, []int) {
-	return file_activity_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use AdminCategory.ProtoReflect.Descriptor instead.
+func (*AdminCategory) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{135}
 }
 
-func (x *DeleteActivityReq) GetId() int64 {
+func (x *AdminCategory) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *DeleteActivityReq) GetOperatorId() int64 {
+func (x *AdminCategory) GetName() string {
 	if x != nil {
-		return x.OperatorId
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AdminCategory) GetIcon() string {
+	if x != nil {
+		return x.Icon
+	}
+	return ""
+}
+
+func (x *AdminCategory) GetSort() int32 {
+	if x != nil {
+		return x.Sort
 	}
 	return 0
 }
 
-func (x *DeleteActivityReq) GetIsAdmin() bool {
+func (x *AdminCategory) GetStatus() int32 {
 	if x != nil {
-		return x.IsAdmin
+		return x.Status
 	}
-	return false
+	return 0
 }
 
-type DeleteActivityResp struct {
+func (x *AdminCategory) GetActivityCount() int64 {
+	if x != nil {
+		return x.ActivityCount
+	}
+	return 0
+}
+
+func (x *AdminCategory) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *AdminCategory) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type AdminListCategoriesReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteActivityResp) Reset() {
-	*x = DeleteActivityResp{}
-	mi := &file_activity_proto_msgTypes[26]
+func (x *AdminListCategoriesReq) Reset() {
+	*x = AdminListCategoriesReq{}
+	mi := &file_activity_proto_msgTypes[136]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteActivityResp) String() string {
+func (x *AdminListCategoriesReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteActivityResp) ProtoMessage() {}
+func (*AdminListCategoriesReq) ProtoMessage() {}
 
-func (x *DeleteActivityResp) ProtoReflect() protoreflect.Message {
-	mi := &file_activity_proto_msgTypes[26]
+func (x *AdminListCategoriesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[136]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2355,41 +10051,80 @@ func (x *DeleteActivityResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteActivityResp.ProtoReflect.Descriptor instead.
-func (*DeleteActivityResp) Descriptor() ([]byte, []int) {
-	return file_activity_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use AdminListCategoriesReq.ProtoReflect.Descriptor instead.
+func (*AdminListCategoriesReq) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{136}
 }
 
-func (x *DeleteActivityResp) GetSuccess() bool {
+type AdminListCategoriesResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          []*AdminCategory       `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminListCategoriesResp) Reset() {
+	*x = AdminListCategoriesResp{}
+	mi := &file_activity_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListCategoriesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListCategoriesResp) ProtoMessage() {}
+
+func (x *AdminListCategoriesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[137]
 	if x != nil {
-		return x.Success
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-type GetActivityReq struct {
+// Deprecated: Use AdminListCategoriesResp.ProtoReflect.Descriptor instead.
+func (*AdminListCategoriesResp) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *AdminListCategoriesResp) GetList() []*AdminCategory {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type CreateCategoryReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	ViewerId      int64                  `protobuf:"varint,2,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Icon          string                 `protobuf:"bytes,2,opt,name=icon,proto3" json:"icon,omitempty"`
+	Sort          int32                  `protobuf:"varint,3,opt,name=sort,proto3" json:"sort,omitempty"`
+	OperatorId    int64                  `protobuf:"varint,4,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetActivityReq) Reset() {
-	*x = GetActivityReq{}
-	mi := &file_activity_proto_msgTypes[27]
+func (x *CreateCategoryReq) Reset() {
+	*x = CreateCategoryReq{}
+	mi := &file_activity_proto_msgTypes[138]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetActivityReq) String() string {
+func (x *CreateCategoryReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetActivityReq) ProtoMessage() {}
+func (*CreateCategoryReq) ProtoMessage() {}
 
-func (x *GetActivityReq) ProtoReflect() protoreflect.Message {
-	mi := &file_activity_proto_msgTypes[27]
+func (x *CreateCategoryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[138]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2400,47 +10135,65 @@ func (x *GetActivityReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetActivityReq.ProtoReflect.Descriptor instead.
-func (*GetActivityReq) Descriptor() ([]byte, []int) {
-	return file_activity_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use CreateCategoryReq.ProtoReflect.Descriptor instead.
+func (*CreateCategoryReq) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{138}
 }
 
-func (x *GetActivityReq) GetId() int64 {
+func (x *CreateCategoryReq) GetName() string {
 	if x != nil {
-		return x.Id
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCategoryReq) GetIcon() string {
+	if x != nil {
+		return x.Icon
+	}
+	return ""
+}
+
+func (x *CreateCategoryReq) GetSort() int32 {
+	if x != nil {
+		return x.Sort
 	}
 	return 0
 }
 
-func (x *GetActivityReq) GetViewerId() int64 {
+func (x *CreateCategoryReq) GetOperatorId() int64 {
 	if x != nil {
-		return x.ViewerId
+		return x.OperatorId
 	}
 	return 0
 }
 
-type GetActivityResp struct {
+// UpdateCategoryReq 修改分类（空字段不修改，排序通过 SortCategories 调整）
+type UpdateCategoryReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Activity      *ActivityDetail        `protobuf:"bytes,1,opt,name=activity,proto3" json:"activity,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Icon          string                 `protobuf:"bytes,3,opt,name=icon,proto3" json:"icon,omitempty"`
+	OperatorId    int64                  `protobuf:"varint,4,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetActivityResp) Reset() {
-	*x = GetActivityResp{}
-	mi := &file_activity_proto_msgTypes[28]
+func (x *UpdateCategoryReq) Reset() {
+	*x = UpdateCategoryReq{}
+	mi := &file_activity_proto_msgTypes[139]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetActivityResp) String() string {
+func (x *UpdateCategoryReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetActivityResp) ProtoMessage() {}
+func (*UpdateCategoryReq) ProtoMessage() {}
 
-func (x *GetActivityResp) ProtoReflect() protoreflect.Message {
-	mi := &file_activity_proto_msgTypes[28]
+func (x *UpdateCategoryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[139]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2451,48 +10204,63 @@ func (x *GetActivityResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetActivityResp.ProtoReflect.Descriptor instead.
-func (*GetActivityResp) Descriptor() ([]byte, []int) {
-	return file_activity_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use UpdateCategoryReq.ProtoReflect.Descriptor instead.
+func (*UpdateCategoryReq) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{139}
 }
 
-func (x *GetActivityResp) GetActivity() *ActivityDetail {
+func (x *UpdateCategoryReq) GetId() int64 {
 	if x != nil {
-		return x.Activity
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-type ListActivitiesReq struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	CategoryId    int64                  `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
-	Status        int32                  `protobuf:"varint,4,opt,name=status,proto3" json:"status,omitempty"` // -1=公开状态, -2=全部
-	OrganizerId   int64                  `protobuf:"varint,5,opt,name=organizer_id,json=organizerId,proto3" json:"organizer_id,omitempty"`
-	Sort          string                 `protobuf:"bytes,6,opt,name=sort,proto3" json:"sort,omitempty"` // created_at, hot, start_time
-	ViewerId      int64                  `protobuf:"varint,7,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
-	IsAdmin       bool                   `protobuf:"varint,8,opt,name=is_admin,json=isAdmin,proto3" json:"is_admin,omitempty"`
-	Recommend     bool                   `protobuf:"varint,9,opt,name=recommend,proto3" json:"recommend,omitempty"` // true=从Redis推荐缓存读取
+func (x *UpdateCategoryReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateCategoryReq) GetIcon() string {
+	if x != nil {
+		return x.Icon
+	}
+	return ""
+}
+
+func (x *UpdateCategoryReq) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+type SetCategoryStatusReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        int32                  `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"` // 1启用 0禁用
+	OperatorId    int64                  `protobuf:"varint,3,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListActivitiesReq) Reset() {
-	*x = ListActivitiesReq{}
-	mi := &file_activity_proto_msgTypes[29]
+func (x *SetCategoryStatusReq) Reset() {
+	*x = SetCategoryStatusReq{}
+	mi := &file_activity_proto_msgTypes[140]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListActivitiesReq) String() string {
+func (x *SetCategoryStatusReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListActivitiesReq) ProtoMessage() {}
+func (*SetCategoryStatusReq) ProtoMessage() {}
 
-func (x *ListActivitiesReq) ProtoReflect() protoreflect.Message {
-	mi := &file_activity_proto_msgTypes[29]
+func (x *SetCategoryStatusReq) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[140]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2503,97 +10271,99 @@ func (x *ListActivitiesReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListActivitiesReq.ProtoReflect.Descriptor instead.
-func (*ListActivitiesReq) Descriptor() ([]byte, []int) {
-	return file_activity_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use SetCategoryStatusReq.ProtoReflect.Descriptor instead.
+func (*SetCategoryStatusReq) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{140}
 }
 
-func (x *ListActivitiesReq) GetPage() int32 {
+func (x *SetCategoryStatusReq) GetId() int64 {
 	if x != nil {
-		return x.Page
+		return x.Id
 	}
 	return 0
 }
 
-func (x *ListActivitiesReq) GetPageSize() int32 {
+func (x *SetCategoryStatusReq) GetStatus() int32 {
 	if x != nil {
-		return x.PageSize
+		return x.Status
 	}
 	return 0
 }
 
-func (x *ListActivitiesReq) GetCategoryId() int64 {
+func (x *SetCategoryStatusReq) GetOperatorId() int64 {
 	if x != nil {
-		return x.CategoryId
+		return x.OperatorId
 	}
 	return 0
 }
 
-func (x *ListActivitiesReq) GetStatus() int32 {
-	if x != nil {
-		return x.Status
-	}
-	return 0
+type AdminCategoryResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      *AdminCategory         `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListActivitiesReq) GetOrganizerId() int64 {
-	if x != nil {
-		return x.OrganizerId
-	}
-	return 0
+func (x *AdminCategoryResp) Reset() {
+	*x = AdminCategoryResp{}
+	mi := &file_activity_proto_msgTypes[141]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListActivitiesReq) GetSort() string {
-	if x != nil {
-		return x.Sort
-	}
-	return ""
+func (x *AdminCategoryResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ListActivitiesReq) GetViewerId() int64 {
+func (*AdminCategoryResp) ProtoMessage() {}
+
+func (x *AdminCategoryResp) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[141]
 	if x != nil {
-		return x.ViewerId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *ListActivitiesReq) GetIsAdmin() bool {
-	if x != nil {
-		return x.IsAdmin
-	}
-	return false
+// Deprecated: Use AdminCategoryResp.ProtoReflect.Descriptor instead.
+func (*AdminCategoryResp) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{141}
 }
 
-func (x *ListActivitiesReq) GetRecommend() bool {
+func (x *AdminCategoryResp) GetCategory() *AdminCategory {
 	if x != nil {
-		return x.Recommend
+		return x.Category
 	}
-	return false
+	return nil
 }
 
-type ListActivitiesResp struct {
+type CategorySortItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	List          []*ActivityListItem    `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
-	Pagination    *Pagination            `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Sort          int32                  `protobuf:"varint,2,opt,name=sort,proto3" json:"sort,omitempty"` // 排序权重（越大越靠前）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListActivitiesResp) Reset() {
-	*x = ListActivitiesResp{}
-	mi := &file_activity_proto_msgTypes[30]
+func (x *CategorySortItem) Reset() {
+	*x = CategorySortItem{}
+	mi := &file_activity_proto_msgTypes[142]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListActivitiesResp) String() string {
+func (x *CategorySortItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListActivitiesResp) ProtoMessage() {}
+func (*CategorySortItem) ProtoMessage() {}
 
-func (x *ListActivitiesResp) ProtoReflect() protoreflect.Message {
-	mi := &file_activity_proto_msgTypes[30]
+func (x *CategorySortItem) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[142]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2604,48 +10374,48 @@ func (x *ListActivitiesResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListActivitiesResp.ProtoReflect.Descriptor instead.
-func (*ListActivitiesResp) Descriptor() ([]byte, []int) {
-	return file_activity_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use CategorySortItem.ProtoReflect.Descriptor instead.
+func (*CategorySortItem) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{142}
 }
 
-func (x *ListActivitiesResp) GetList() []*ActivityListItem {
+func (x *CategorySortItem) GetId() int64 {
 	if x != nil {
-		return x.List
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-func (x *ListActivitiesResp) GetPagination() *Pagination {
+func (x *CategorySortItem) GetSort() int32 {
 	if x != nil {
-		return x.Pagination
+		return x.Sort
 	}
-	return nil
+	return 0
 }
 
-type SubmitActivityReq struct {
+type SortCategoriesReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Items         []*CategorySortItem    `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	OperatorId    int64                  `protobuf:"varint,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SubmitActivityReq) Reset() {
-	*x = SubmitActivityReq{}
-	mi := &file_activity_proto_msgTypes[31]
+func (x *SortCategoriesReq) Reset() {
+	*x = SortCategoriesReq{}
+	mi := &file_activity_proto_msgTypes[143]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubmitActivityReq) String() string {
+func (x *SortCategoriesReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitActivityReq) ProtoMessage() {}
+func (*SortCategoriesReq) ProtoMessage() {}
 
-func (x *SubmitActivityReq) ProtoReflect() protoreflect.Message {
-	mi := &file_activity_proto_msgTypes[31]
+func (x *SortCategoriesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[143]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2656,47 +10426,47 @@ func (x *SubmitActivityReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmitActivityReq.ProtoReflect.Descriptor instead.
-func (*SubmitActivityReq) Descriptor() ([]byte, []int) {
-	return file_activity_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use SortCategoriesReq.ProtoReflect.Descriptor instead.
+func (*SortCategoriesReq) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{143}
 }
 
-func (x *SubmitActivityReq) GetId() int64 {
+func (x *SortCategoriesReq) GetItems() []*CategorySortItem {
 	if x != nil {
-		return x.Id
+		return x.Items
 	}
-	return 0
+	return nil
 }
 
-func (x *SubmitActivityReq) GetOperatorId() int64 {
+func (x *SortCategoriesReq) GetOperatorId() int64 {
 	if x != nil {
 		return x.OperatorId
 	}
 	return 0
 }
 
-type SubmitActivityResp struct {
+type SortCategoriesResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Updated       int32                  `protobuf:"varint,1,opt,name=updated,proto3" json:"updated,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SubmitActivityResp) Reset() {
-	*x = SubmitActivityResp{}
-	mi := &file_activity_proto_msgTypes[32]
+func (x *SortCategoriesResp) Reset() {
+	*x = SortCategoriesResp{}
+	mi := &file_activity_proto_msgTypes[144]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubmitActivityResp) String() string {
+func (x *SortCategoriesResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitActivityResp) ProtoMessage() {}
+func (*SortCategoriesResp) ProtoMessage() {}
 
-func (x *SubmitActivityResp) ProtoReflect() protoreflect.Message {
-	mi := &file_activity_proto_msgTypes[32]
+func (x *SortCategoriesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[144]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2707,19 +10477,19 @@ func (x *SubmitActivityResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmitActivityResp.ProtoReflect.Descriptor instead.
-func (*SubmitActivityResp) Descriptor() ([]byte, []int) {
-	return file_activity_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use SortCategoriesResp.ProtoReflect.Descriptor instead.
+func (*SortCategoriesResp) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{144}
 }
 
-func (x *SubmitActivityResp) GetStatus() int32 {
+func (x *SortCategoriesResp) GetUpdated() int32 {
 	if x != nil {
-		return x.Status
+		return x.Updated
 	}
 	return 0
 }
 
-type ApproveActivityReq struct {
+type DeleteCategoryReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	OperatorId    int64                  `protobuf:"varint,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
@@ -2727,21 +10497,21 @@ type ApproveActivityReq struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ApproveActivityReq) Reset() {
-	*x = ApproveActivityReq{}
-	mi := &file_activity_proto_msgTypes[33]
+func (x *DeleteCategoryReq) Reset() {
+	*x = DeleteCategoryReq{}
+	mi := &file_activity_proto_msgTypes[145]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApproveActivityReq) String() string {
+func (x *DeleteCategoryReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApproveActivityReq) ProtoMessage() {}
+func (*DeleteCategoryReq) ProtoMessage() {}
 
-func (x *ApproveActivityReq) ProtoReflect() protoreflect.Message {
-	mi := &file_activity_proto_msgTypes[33]
+func (x *DeleteCategoryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[145]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2752,47 +10522,46 @@ func (x *ApproveActivityReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApproveActivityReq.ProtoReflect.Descriptor instead.
-func (*ApproveActivityReq) Descriptor() ([]byte, []int) {
-	return file_activity_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use DeleteCategoryReq.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryReq) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{145}
 }
 
-func (x *ApproveActivityReq) GetId() int64 {
+func (x *DeleteCategoryReq) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *ApproveActivityReq) GetOperatorId() int64 {
+func (x *DeleteCategoryReq) GetOperatorId() int64 {
 	if x != nil {
 		return x.OperatorId
 	}
 	return 0
 }
 
-type ApproveActivityResp struct {
+type DeleteCategoryResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ApproveActivityResp) Reset() {
-	*x = ApproveActivityResp{}
-	mi := &file_activity_proto_msgTypes[34]
+func (x *DeleteCategoryResp) Reset() {
+	*x = DeleteCategoryResp{}
+	mi := &file_activity_proto_msgTypes[146]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApproveActivityResp) String() string {
+func (x *DeleteCategoryResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApproveActivityResp) ProtoMessage() {}
+func (*DeleteCategoryResp) ProtoMessage() {}
 
-func (x *ApproveActivityResp) ProtoReflect() protoreflect.Message {
-	mi := &file_activity_proto_msgTypes[34]
+func (x *DeleteCategoryResp) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[146]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2803,42 +10572,40 @@ func (x *ApproveActivityResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApproveActivityResp.ProtoReflect.Descriptor instead.
-func (*ApproveActivityResp) Descriptor() ([]byte, []int) {
-	return file_activity_proto_rawDescGZIP(), []int{34}
-}
-
-func (x *ApproveActivityResp) GetStatus() int32 {
-	if x != nil {
-		return x.Status
-	}
-	return 0
+// Deprecated: Use DeleteCategoryResp.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryResp) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{146}
 }
 
-type RejectActivityReq struct {
+// AdminTag 标签（含禁用状态与活动数）
+type AdminTag struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
-	OperatorId    int64                  `protobuf:"varint,3,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Color         string                 `protobuf:"bytes,3,opt,name=color,proto3" json:"color,omitempty"`
+	Icon          string                 `protobuf:"bytes,4,opt,name=icon,proto3" json:"icon,omitempty"`
+	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Status        int32                  `protobuf:"varint,6,opt,name=status,proto3" json:"status,omitempty"`                                    // 1启用 0禁用
+	ActivityCount int64                  `protobuf:"varint,7,opt,name=activity_count,json=activityCount,proto3" json:"activity_count,omitempty"` // 关联的活动数
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RejectActivityReq) Reset() {
-	*x = RejectActivityReq{}
-	mi := &file_activity_proto_msgTypes[35]
+func (x *AdminTag) Reset() {
+	*x = AdminTag{}
+	mi := &file_activity_proto_msgTypes[147]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RejectActivityReq) String() string {
+func (x *AdminTag) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RejectActivityReq) ProtoMessage() {}
+func (*AdminTag) ProtoMessage() {}
 
-func (x *RejectActivityReq) ProtoReflect() protoreflect.Message {
-	mi := &file_activity_proto_msgTypes[35]
+func (x *AdminTag) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[147]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2849,166 +10616,118 @@ func (x *RejectActivityReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RejectActivityReq.ProtoReflect.Descriptor instead.
-func (*RejectActivityReq) Descriptor() ([]byte, []int) {
-	return file_activity_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use AdminTag.ProtoReflect.Descriptor instead.
+func (*AdminTag) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{147}
 }
 
-func (x *RejectActivityReq) GetId() int64 {
+func (x *AdminTag) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *RejectActivityReq) GetReason() string {
+func (x *AdminTag) GetName() string {
 	if x != nil {
-		return x.Reason
+		return x.Name
 	}
 	return ""
 }
 
-func (x *RejectActivityReq) GetOperatorId() int64 {
+func (x *AdminTag) GetColor() string {
 	if x != nil {
-		return x.OperatorId
+		return x.Color
 	}
-	return 0
-}
-
-type RejectActivityResp struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *RejectActivityResp) Reset() {
-	*x = RejectActivityResp{}
-	mi := &file_activity_proto_msgTypes[36]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+	return ""
 }
 
-func (x *RejectActivityResp) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AdminTag) GetIcon() string {
+	if x != nil {
+		return x.Icon
+	}
+	return ""
 }
 
-func (*RejectActivityResp) ProtoMessage() {}
-
-func (x *RejectActivityResp) ProtoReflect() protoreflect.Message {
-	mi := &file_activity_proto_msgTypes[36]
+func (x *AdminTag) GetDescription() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Description
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use RejectActivityResp.ProtoReflect.Descriptor instead.
-func (*RejectActivityResp) Descriptor() ([]byte, []int) {
-	return file_activity_proto_rawDescGZIP(), []int{36}
+func (x *AdminTag) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
 }
 
-func (x *RejectActivityResp) GetStatus() int32 {
+func (x *AdminTag) GetActivityCount() int64 {
 	if x != nil {
-		return x.Status
+		return x.ActivityCount
 	}
 	return 0
 }
 
-type CancelActivityReq struct {
+type AdminListTagsReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
-	OperatorId    int64                  `protobuf:"varint,3,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
-	IsAdmin       bool                   `protobuf:"varint,4,opt,name=is_admin,json=isAdmin,proto3" json:"is_admin,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelActivityReq) Reset() {
-	*x = CancelActivityReq{}
-	mi := &file_activity_proto_msgTypes[37]
+func (x *AdminListTagsReq) Reset() {
+	*x = AdminListTagsReq{}
+	mi := &file_activity_proto_msgTypes[148]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelActivityReq) String() string {
+func (x *AdminListTagsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelActivityReq) ProtoMessage() {}
+func (*AdminListTagsReq) ProtoMessage() {}
 
-func (x *CancelActivityReq) ProtoReflect() protoreflect.Message {
-	mi := &file_activity_proto_msgTypes[37]
+func (x *AdminListTagsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[148]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CancelActivityReq.ProtoReflect.Descriptor instead.
-func (*CancelActivityReq) Descriptor() ([]byte, []int) {
-	return file_activity_proto_rawDescGZIP(), []int{37}
-}
-
-func (x *CancelActivityReq) GetId() int64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *CancelActivityReq) GetReason() string {
-	if x != nil {
-		return x.Reason
-	}
-	return ""
-}
-
-func (x *CancelActivityReq) GetOperatorId() int64 {
-	if x != nil {
-		return x.OperatorId
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *CancelActivityReq) GetIsAdmin() bool {
-	if x != nil {
-		return x.IsAdmin
-	}
-	return false
+// Deprecated: Use AdminListTagsReq.ProtoReflect.Descriptor instead.
+func (*AdminListTagsReq) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{148}
 }
 
-type CancelActivityResp struct {
+type AdminListTagsResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	List          []*AdminTag            `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelActivityResp) Reset() {
-	*x = CancelActivityResp{}
-	mi := &file_activity_proto_msgTypes[38]
+func (x *AdminListTagsResp) Reset() {
+	*x = AdminListTagsResp{}
+	mi := &file_activity_proto_msgTypes[149]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelActivityResp) String() string {
+func (x *AdminListTagsResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelActivityResp) ProtoMessage() {}
+func (*AdminListTagsResp) ProtoMessage() {}
 
-func (x *CancelActivityResp) ProtoReflect() protoreflect.Message {
-	mi := &file_activity_proto_msgTypes[38]
+func (x *AdminListTagsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[149]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3019,44 +10738,44 @@ func (x *CancelActivityResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelActivityResp.ProtoReflect.Descriptor instead.
-func (*CancelActivityResp) Descriptor() ([]byte, []int) {
-	return file_activity_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use AdminListTagsResp.ProtoReflect.Descriptor instead.
+func (*AdminListTagsResp) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{149}
 }
 
-func (x *CancelActivityResp) GetStatus() int32 {
+func (x *AdminListTagsResp) GetList() []*AdminTag {
 	if x != nil {
-		return x.Status
+		return x.List
 	}
-	return 0
+	return nil
 }
 
-type SearchActivitiesReq struct {
+type CreateTagReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Keyword       string                 `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword,omitempty"`
-	CategoryId    int64                  `protobuf:"varint,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	Sort          string                 `protobuf:"bytes,5,opt,name=sort,proto3" json:"sort,omitempty"` // relevance, time, hot
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Color         string                 `protobuf:"bytes,2,opt,name=color,proto3" json:"color,omitempty"`
+	Icon          string                 `protobuf:"bytes,3,opt,name=icon,proto3" json:"icon,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	OperatorId    int64                  `protobuf:"varint,5,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchActivitiesReq) Reset() {
-	*x = SearchActivitiesReq{}
-	mi := &file_activity_proto_msgTypes[39]
+func (x *CreateTagReq) Reset() {
+	*x = CreateTagReq{}
+	mi := &file_activity_proto_msgTypes[150]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchActivitiesReq) String() string {
+func (x *CreateTagReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchActivitiesReq) ProtoMessage() {}
+func (*CreateTagReq) ProtoMessage() {}
 
-func (x *SearchActivitiesReq) ProtoReflect() protoreflect.Message {
-	mi := &file_activity_proto_msgTypes[39]
+func (x *CreateTagReq) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[150]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3067,70 +10786,74 @@ func (x *SearchActivitiesReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchActivitiesReq.ProtoReflect.Descriptor instead.
-func (*SearchActivitiesReq) Descriptor() ([]byte, []int) {
-	return file_activity_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use CreateTagReq.ProtoReflect.Descriptor instead.
+func (*CreateTagReq) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{150}
 }
 
-func (x *SearchActivitiesReq) GetKeyword() string {
+func (x *CreateTagReq) GetName() string {
 	if x != nil {
-		return x.Keyword
+		return x.Name
 	}
 	return ""
 }
 
-func (x *SearchActivitiesReq) GetCategoryId() int64 {
+func (x *CreateTagReq) GetColor() string {
 	if x != nil {
-		return x.CategoryId
+		return x.Color
 	}
-	return 0
+	return ""
 }
 
-func (x *SearchActivitiesReq) GetPage() int32 {
+func (x *CreateTagReq) GetIcon() string {
 	if x != nil {
-		return x.Page
+		return x.Icon
 	}
-	return 0
+	return ""
 }
 
-func (x *SearchActivitiesReq) GetPageSize() int32 {
+func (x *CreateTagReq) GetDescription() string {
 	if x != nil {
-		return x.PageSize
+		return x.Description
 	}
-	return 0
+	return ""
 }
 
-func (x *SearchActivitiesReq) GetSort() string {
+func (x *CreateTagReq) GetOperatorId() int64 {
 	if x != nil {
-		return x.Sort
+		return x.OperatorId
 	}
-	return ""
+	return 0
 }
 
-type SearchActivitiesResp struct {
+// UpdateTagReq 修改标签（空字段不修改）
+type UpdateTagReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	List          []*ActivityListItem    `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
-	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	QueryTimeMs   int32                  `protobuf:"varint,3,opt,name=query_time_ms,json=queryTimeMs,proto3" json:"query_time_ms,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Color         string                 `protobuf:"bytes,3,opt,name=color,proto3" json:"color,omitempty"`
+	Icon          string                 `protobuf:"bytes,4,opt,name=icon,proto3" json:"icon,omitempty"`
+	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	OperatorId    int64                  `protobuf:"varint,6,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchActivitiesResp) Reset() {
-	*x = SearchActivitiesResp{}
-	mi := &file_activity_proto_msgTypes[40]
+func (x *UpdateTagReq) Reset() {
+	*x = UpdateTagReq{}
+	mi := &file_activity_proto_msgTypes[151]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchActivitiesResp) String() string {
+func (x *UpdateTagReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchActivitiesResp) ProtoMessage() {}
+func (*UpdateTagReq) ProtoMessage() {}
 
-func (x *SearchActivitiesResp) ProtoReflect() protoreflect.Message {
-	mi := &file_activity_proto_msgTypes[40]
+func (x *UpdateTagReq) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[151]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3141,98 +10864,77 @@ func (x *SearchActivitiesResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchActivitiesResp.ProtoReflect.Descriptor instead.
-func (*SearchActivitiesResp) Descriptor() ([]byte, []int) {
-	return file_activity_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use UpdateTagReq.ProtoReflect.Descriptor instead.
+func (*UpdateTagReq) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{151}
 }
 
-func (x *SearchActivitiesResp) GetList() []*ActivityListItem {
+func (x *UpdateTagReq) GetId() int64 {
 	if x != nil {
-		return x.List
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-func (x *SearchActivitiesResp) GetTotal() int64 {
+func (x *UpdateTagReq) GetName() string {
 	if x != nil {
-		return x.Total
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *SearchActivitiesResp) GetQueryTimeMs() int32 {
+func (x *UpdateTagReq) GetColor() string {
 	if x != nil {
-		return x.QueryTimeMs
+		return x.Color
 	}
-	return 0
-}
-
-type GetHotActivitiesReq struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetHotActivitiesReq) Reset() {
-	*x = GetHotActivitiesReq{}
-	mi := &file_activity_proto_msgTypes[41]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetHotActivitiesReq) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return ""
 }
 
-func (*GetHotActivitiesReq) ProtoMessage() {}
-
-func (x *GetHotActivitiesReq) ProtoReflect() protoreflect.Message {
-	mi := &file_activity_proto_msgTypes[41]
+func (x *UpdateTagReq) GetIcon() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Icon
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetHotActivitiesReq.ProtoReflect.Descriptor instead.
-func (*GetHotActivitiesReq) Descriptor() ([]byte, []int) {
-	return file_activity_proto_rawDescGZIP(), []int{41}
+func (x *UpdateTagReq) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
 }
 
-func (x *GetHotActivitiesReq) GetLimit() int32 {
+func (x *UpdateTagReq) GetOperatorId() int64 {
 	if x != nil {
-		return x.Limit
+		return x.OperatorId
 	}
 	return 0
 }
 
-type GetHotActivitiesResp struct {
+type SetTagStatusReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	List          []*ActivityListItem    `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        int32                  `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"` // 1启用 0禁用
+	OperatorId    int64                  `protobuf:"varint,3,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetHotActivitiesResp) Reset() {
-	*x = GetHotActivitiesResp{}
-	mi := &file_activity_proto_msgTypes[42]
+func (x *SetTagStatusReq) Reset() {
+	*x = SetTagStatusReq{}
+	mi := &file_activity_proto_msgTypes[152]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetHotActivitiesResp) String() string {
+func (x *SetTagStatusReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetHotActivitiesResp) ProtoMessage() {}
+func (*SetTagStatusReq) ProtoMessage() {}
 
-func (x *GetHotActivitiesResp) ProtoReflect() protoreflect.Message {
-	mi := &file_activity_proto_msgTypes[42]
+func (x *SetTagStatusReq) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[152]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3243,76 +10945,54 @@ func (x *GetHotActivitiesResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetHotActivitiesResp.ProtoReflect.Descriptor instead.
-func (*GetHotActivitiesResp) Descriptor() ([]byte, []int) {
-	return file_activity_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use SetTagStatusReq.ProtoReflect.Descriptor instead.
+func (*SetTagStatusReq) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{152}
 }
 
-func (x *GetHotActivitiesResp) GetList() []*ActivityListItem {
+func (x *SetTagStatusReq) GetId() int64 {
 	if x != nil {
-		return x.List
+		return x.Id
 	}
-	return nil
-}
-
-type ListCategoriesReq struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ListCategoriesReq) Reset() {
-	*x = ListCategoriesReq{}
-	mi := &file_activity_proto_msgTypes[43]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ListCategoriesReq) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return 0
 }
 
-func (*ListCategoriesReq) ProtoMessage() {}
-
-func (x *ListCategoriesReq) ProtoReflect() protoreflect.Message {
-	mi := &file_activity_proto_msgTypes[43]
+func (x *SetTagStatusReq) GetStatus() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Status
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use ListCategoriesReq.ProtoReflect.Descriptor instead.
-func (*ListCategoriesReq) Descriptor() ([]byte, []int) {
-	return file_activity_proto_rawDescGZIP(), []int{43}
+func (x *SetTagStatusReq) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
 }
 
-type ListCategoriesResp struct {
+type AdminTagResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	List          []*Category            `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	Tag           *AdminTag              `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCategoriesResp) Reset() {
-	*x = ListCategoriesResp{}
-	mi := &file_activity_proto_msgTypes[44]
+func (x *AdminTagResp) Reset() {
+	*x = AdminTagResp{}
+	mi := &file_activity_proto_msgTypes[153]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCategoriesResp) String() string {
+func (x *AdminTagResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCategoriesResp) ProtoMessage() {}
+func (*AdminTagResp) ProtoMessage() {}
 
-func (x *ListCategoriesResp) ProtoReflect() protoreflect.Message {
-	mi := &file_activity_proto_msgTypes[44]
+func (x *AdminTagResp) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[153]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3323,40 +11003,42 @@ func (x *ListCategoriesResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCategoriesResp.ProtoReflect.Descriptor instead.
-func (*ListCategoriesResp) Descriptor() ([]byte, []int) {
-	return file_activity_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use AdminTagResp.ProtoReflect.Descriptor instead.
+func (*AdminTagResp) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{153}
 }
 
-func (x *ListCategoriesResp) GetList() []*Category {
+func (x *AdminTagResp) GetTag() *AdminTag {
 	if x != nil {
-		return x.List
+		return x.Tag
 	}
 	return nil
 }
 
-type ListTagsReq struct {
+type MergeTagsReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	SourceId      int64                  `protobuf:"varint,1,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"` // 被合并的标签（合并后禁用）
+	TargetId      int64                  `protobuf:"varint,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"` // 保留的标签
+	OperatorId    int64                  `protobuf:"varint,3,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTagsReq) Reset() {
-	*x = ListTagsReq{}
-	mi := &file_activity_proto_msgTypes[45]
+func (x *MergeTagsReq) Reset() {
+	*x = MergeTagsReq{}
+	mi := &file_activity_proto_msgTypes[154]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTagsReq) String() string {
+func (x *MergeTagsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTagsReq) ProtoMessage() {}
+func (*MergeTagsReq) ProtoMessage() {}
 
-func (x *ListTagsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_activity_proto_msgTypes[45]
+func (x *MergeTagsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[154]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3367,40 +11049,56 @@ func (x *ListTagsReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTagsReq.ProtoReflect.Descriptor instead.
-func (*ListTagsReq) Descriptor() ([]byte, []int) {
-	return file_activity_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use MergeTagsReq.ProtoReflect.Descriptor instead.
+func (*MergeTagsReq) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{154}
 }
 
-func (x *ListTagsReq) GetLimit() int32 {
+func (x *MergeTagsReq) GetSourceId() int64 {
 	if x != nil {
-		return x.Limit
+		return x.SourceId
 	}
 	return 0
 }
 
-type ListTagsResp struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	List          []*Tag                 `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *MergeTagsReq) GetTargetId() int64 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
 }
 
-func (x *ListTagsResp) Reset() {
-	*x = ListTagsResp{}
-	mi := &file_activity_proto_msgTypes[46]
+func (x *MergeTagsReq) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+type MergeTagsResp struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Target          *AdminTag              `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	MovedActivities int64                  `protobuf:"varint,2,opt,name=moved_activities,json=movedActivities,proto3" json:"moved_activities,omitempty"` // 受影响的活动数
+	MovedUsers      int64                  `protobuf:"varint,3,opt,name=moved_users,json=movedUsers,proto3" json:"moved_users,omitempty"`                // 改绑兴趣的用户数
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MergeTagsResp) Reset() {
+	*x = MergeTagsResp{}
+	mi := &file_activity_proto_msgTypes[155]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTagsResp) String() string {
+func (x *MergeTagsResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTagsResp) ProtoMessage() {}
+func (*MergeTagsResp) ProtoMessage() {}
 
-func (x *ListTagsResp) ProtoReflect() protoreflect.Message {
-	mi := &file_activity_proto_msgTypes[46]
+func (x *MergeTagsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[155]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3411,18 +11109,32 @@ func (x *ListTagsResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTagsResp.ProtoReflect.Descriptor instead.
-func (*ListTagsResp) Descriptor() ([]byte, []int) {
-	return file_activity_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use MergeTagsResp.ProtoReflect.Descriptor instead.
+func (*MergeTagsResp) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{155}
 }
 
-func (x *ListTagsResp) GetList() []*Tag {
+func (x *MergeTagsResp) GetTarget() *AdminTag {
 	if x != nil {
-		return x.List
+		return x.Target
 	}
 	return nil
 }
 
+func (x *MergeTagsResp) GetMovedActivities() int64 {
+	if x != nil {
+		return x.MovedActivities
+	}
+	return 0
+}
+
+func (x *MergeTagsResp) GetMovedUsers() int64 {
+	if x != nil {
+		return x.MovedUsers
+	}
+	return 0
+}
+
 type IncrViewCountReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -3434,7 +11146,7 @@ type IncrViewCountReq struct {
 
 func (x *IncrViewCountReq) Reset() {
 	*x = IncrViewCountReq{}
-	mi := &file_activity_proto_msgTypes[47]
+	mi := &file_activity_proto_msgTypes[156]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3446,7 +11158,7 @@ func (x *IncrViewCountReq) String() string {
 func (*IncrViewCountReq) ProtoMessage() {}
 
 func (x *IncrViewCountReq) ProtoReflect() protoreflect.Message {
-	mi := &file_activity_proto_msgTypes[47]
+	mi := &file_activity_proto_msgTypes[156]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3459,7 +11171,7 @@ func (x *IncrViewCountReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IncrViewCountReq.ProtoReflect.Descriptor instead.
 func (*IncrViewCountReq) Descriptor() ([]byte, []int) {
-	return file_activity_proto_rawDescGZIP(), []int{47}
+	return file_activity_proto_rawDescGZIP(), []int{156}
 }
 
 func (x *IncrViewCountReq) GetId() int64 {
@@ -3492,7 +11204,7 @@ type IncrViewCountResp struct {
 
 func (x *IncrViewCountResp) Reset() {
 	*x = IncrViewCountResp{}
-	mi := &file_activity_proto_msgTypes[48]
+	mi := &file_activity_proto_msgTypes[157]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3504,7 +11216,7 @@ func (x *IncrViewCountResp) String() string {
 func (*IncrViewCountResp) ProtoMessage() {}
 
 func (x *IncrViewCountResp) ProtoReflect() protoreflect.Message {
-	mi := &file_activity_proto_msgTypes[48]
+	mi := &file_activity_proto_msgTypes[157]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3517,7 +11229,7 @@ func (x *IncrViewCountResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IncrViewCountResp.ProtoReflect.Descriptor instead.
 func (*IncrViewCountResp) Descriptor() ([]byte, []int) {
-	return file_activity_proto_rawDescGZIP(), []int{48}
+	return file_activity_proto_rawDescGZIP(), []int{157}
 }
 
 func (x *IncrViewCountResp) GetViewCount() int64 {
@@ -3536,7 +11248,7 @@ type GetActivityBasicReq struct {
 
 func (x *GetActivityBasicReq) Reset() {
 	*x = GetActivityBasicReq{}
-	mi := &file_activity_proto_msgTypes[49]
+	mi := &file_activity_proto_msgTypes[158]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3548,7 +11260,7 @@ func (x *GetActivityBasicReq) String() string {
 func (*GetActivityBasicReq) ProtoMessage() {}
 
 func (x *GetActivityBasicReq) ProtoReflect() protoreflect.Message {
-	mi := &file_activity_proto_msgTypes[49]
+	mi := &file_activity_proto_msgTypes[158]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3561,7 +11273,7 @@ func (x *GetActivityBasicReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetActivityBasicReq.ProtoReflect.Descriptor instead.
 func (*GetActivityBasicReq) Descriptor() ([]byte, []int) {
-	return file_activity_proto_rawDescGZIP(), []int{49}
+	return file_activity_proto_rawDescGZIP(), []int{158}
 }
 
 func (x *GetActivityBasicReq) GetId() int64 {
@@ -3593,7 +11305,7 @@ type GetActivityBasicResp struct {
 
 func (x *GetActivityBasicResp) Reset() {
 	*x = GetActivityBasicResp{}
-	mi := &file_activity_proto_msgTypes[50]
+	mi := &file_activity_proto_msgTypes[159]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3605,7 +11317,7 @@ func (x *GetActivityBasicResp) String() string {
 func (*GetActivityBasicResp) ProtoMessage() {}
 
 func (x *GetActivityBasicResp) ProtoReflect() protoreflect.Message {
-	mi := &file_activity_proto_msgTypes[50]
+	mi := &file_activity_proto_msgTypes[159]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3618,7 +11330,7 @@ func (x *GetActivityBasicResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetActivityBasicResp.ProtoReflect.Descriptor instead.
 func (*GetActivityBasicResp) Descriptor() ([]byte, []int) {
-	return file_activity_proto_rawDescGZIP(), []int{50}
+	return file_activity_proto_rawDescGZIP(), []int{159}
 }
 
 func (x *GetActivityBasicResp) GetId() int64 {
@@ -3728,7 +11440,7 @@ type BatchGetActivityBasicReq struct {
 
 func (x *BatchGetActivityBasicReq) Reset() {
 	*x = BatchGetActivityBasicReq{}
-	mi := &file_activity_proto_msgTypes[51]
+	mi := &file_activity_proto_msgTypes[160]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3740,7 +11452,7 @@ func (x *BatchGetActivityBasicReq) String() string {
 func (*BatchGetActivityBasicReq) ProtoMessage() {}
 
 func (x *BatchGetActivityBasicReq) ProtoReflect() protoreflect.Message {
-	mi := &file_activity_proto_msgTypes[51]
+	mi := &file_activity_proto_msgTypes[160]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3753,7 +11465,7 @@ func (x *BatchGetActivityBasicReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchGetActivityBasicReq.ProtoReflect.Descriptor instead.
 func (*BatchGetActivityBasicReq) Descriptor() ([]byte, []int) {
-	return file_activity_proto_rawDescGZIP(), []int{51}
+	return file_activity_proto_rawDescGZIP(), []int{160}
 }
 
 func (x *BatchGetActivityBasicReq) GetIds() []int64 {
@@ -3772,7 +11484,7 @@ type BatchGetActivityBasicResp struct {
 
 func (x *BatchGetActivityBasicResp) Reset() {
 	*x = BatchGetActivityBasicResp{}
-	mi := &file_activity_proto_msgTypes[52]
+	mi := &file_activity_proto_msgTypes[161]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3784,7 +11496,7 @@ func (x *BatchGetActivityBasicResp) String() string {
 func (*BatchGetActivityBasicResp) ProtoMessage() {}
 
 func (x *BatchGetActivityBasicResp) ProtoReflect() protoreflect.Message {
-	mi := &file_activity_proto_msgTypes[52]
+	mi := &file_activity_proto_msgTypes[161]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3797,7 +11509,7 @@ func (x *BatchGetActivityBasicResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchGetActivityBasicResp.ProtoReflect.Descriptor instead.
 func (*BatchGetActivityBasicResp) Descriptor() ([]byte, []int) {
-	return file_activity_proto_rawDescGZIP(), []int{52}
+	return file_activity_proto_rawDescGZIP(), []int{161}
 }
 
 func (x *BatchGetActivityBasicResp) GetActivities() []*GetActivityBasicResp {
@@ -3820,7 +11532,7 @@ type GetUserPublishedActivitiesReq struct {
 
 func (x *GetUserPublishedActivitiesReq) Reset() {
 	*x = GetUserPublishedActivitiesReq{}
-	mi := &file_activity_proto_msgTypes[53]
+	mi := &file_activity_proto_msgTypes[162]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3832,7 +11544,7 @@ func (x *GetUserPublishedActivitiesReq) String() string {
 func (*GetUserPublishedActivitiesReq) ProtoMessage() {}
 
 func (x *GetUserPublishedActivitiesReq) ProtoReflect() protoreflect.Message {
-	mi := &file_activity_proto_msgTypes[53]
+	mi := &file_activity_proto_msgTypes[162]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3845,7 +11557,7 @@ func (x *GetUserPublishedActivitiesReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserPublishedActivitiesReq.ProtoReflect.Descriptor instead.
 func (*GetUserPublishedActivitiesReq) Descriptor() ([]byte, []int) {
-	return file_activity_proto_rawDescGZIP(), []int{53}
+	return file_activity_proto_rawDescGZIP(), []int{162}
 }
 
 func (x *GetUserPublishedActivitiesReq) GetUserId() int64 {
@@ -3877,16 +11589,17 @@ func (x *GetUserPublishedActivitiesReq) GetStatus() int32 {
 }
 
 type GetUserPublishedActivitiesResp struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	List          []*ActivityListItem    `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
-	Pagination    *Pagination            `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	List            []*ActivityListItem    `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	Pagination      *Pagination            `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	OrganizerRating *OrganizerRating       `protobuf:"bytes,3,opt,name=organizer_rating,json=organizerRating,proto3" json:"organizer_rating,omitempty"` // 组织者评分汇总（全部活动）
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *GetUserPublishedActivitiesResp) Reset() {
 	*x = GetUserPublishedActivitiesResp{}
-	mi := &file_activity_proto_msgTypes[54]
+	mi := &file_activity_proto_msgTypes[163]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3898,7 +11611,7 @@ func (x *GetUserPublishedActivitiesResp) String() string {
 func (*GetUserPublishedActivitiesResp) ProtoMessage() {}
 
 func (x *GetUserPublishedActivitiesResp) ProtoReflect() protoreflect.Message {
-	mi := &file_activity_proto_msgTypes[54]
+	mi := &file_activity_proto_msgTypes[163]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3911,7 +11624,7 @@ func (x *GetUserPublishedActivitiesResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserPublishedActivitiesResp.ProtoReflect.Descriptor instead.
 func (*GetUserPublishedActivitiesResp) Descriptor() ([]byte, []int) {
-	return file_activity_proto_rawDescGZIP(), []int{54}
+	return file_activity_proto_rawDescGZIP(), []int{163}
 }
 
 func (x *GetUserPublishedActivitiesResp) GetList() []*ActivityListItem {
@@ -3928,6 +11641,66 @@ func (x *GetUserPublishedActivitiesResp) GetPagination() *Pagination {
 	return nil
 }
 
+func (x *GetUserPublishedActivitiesResp) GetOrganizerRating() *OrganizerRating {
+	if x != nil {
+		return x.OrganizerRating
+	}
+	return nil
+}
+
+// 组织者评分汇总
+type OrganizerRating struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RatingAvg     float64                `protobuf:"fixed64,1,opt,name=rating_avg,json=ratingAvg,proto3" json:"rating_avg,omitempty"`      // 平均评分（按评价人数加权）
+	RatingCount   int64                  `protobuf:"varint,2,opt,name=rating_count,json=ratingCount,proto3" json:"rating_count,omitempty"` // 评价总人数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrganizerRating) Reset() {
+	*x = OrganizerRating{}
+	mi := &file_activity_proto_msgTypes[164]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrganizerRating) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrganizerRating) ProtoMessage() {}
+
+func (x *OrganizerRating) ProtoReflect() protoreflect.Message {
+	mi := &file_activity_proto_msgTypes[164]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrganizerRating.ProtoReflect.Descriptor instead.
+func (*OrganizerRating) Descriptor() ([]byte, []int) {
+	return file_activity_proto_rawDescGZIP(), []int{164}
+}
+
+func (x *OrganizerRating) GetRatingAvg() float64 {
+	if x != nil {
+		return x.RatingAvg
+	}
+	return 0
+}
+
+func (x *OrganizerRating) GetRatingCount() int64 {
+	if x != nil {
+		return x.RatingCount
+	}
+	return 0
+}
+
 // CreateActivityActionReq 创建活动正向操作请求
 type CreateActivityActionReq struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -3969,7 +11742,7 @@ type CreateActivityActionReq struct {
 
 func (x *CreateActivityActionReq) Reset() {
 	*x = CreateActivityActionReq{}
-	mi := &file_activity_proto_msgTypes[55]
+	mi := &file_activity_proto_msgTypes[165]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3981,7 +11754,7 @@ func (x *CreateActivityActionReq) String() string {
 func (*CreateActivityActionReq) ProtoMessage() {}
 
 func (x *CreateActivityActionReq) ProtoReflect() protoreflect.Message {
-	mi := &file_activity_proto_msgTypes[55]
+	mi := &file_activity_proto_msgTypes[165]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3994,7 +11767,7 @@ func (x *CreateActivityActionReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateActivityActionReq.ProtoReflect.Descriptor instead.
 func (*CreateActivityActionReq) Descriptor() ([]byte, []int) {
-	return file_activity_proto_rawDescGZIP(), []int{55}
+	return file_activity_proto_rawDescGZIP(), []int{165}
 }
 
 func (x *CreateActivityActionReq) GetTitle() string {
@@ -4183,7 +11956,7 @@ type CreateActivityActionResp struct {
 
 func (x *CreateActivityActionResp) Reset() {
 	*x = CreateActivityActionResp{}
-	mi := &file_activity_proto_msgTypes[56]
+	mi := &file_activity_proto_msgTypes[166]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4195,7 +11968,7 @@ func (x *CreateActivityActionResp) String() string {
 func (*CreateActivityActionResp) ProtoMessage() {}
 
 func (x *CreateActivityActionResp) ProtoReflect() protoreflect.Message {
-	mi := &file_activity_proto_msgTypes[56]
+	mi := &file_activity_proto_msgTypes[166]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4208,7 +11981,7 @@ func (x *CreateActivityActionResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateActivityActionResp.ProtoReflect.Descriptor instead.
 func (*CreateActivityActionResp) Descriptor() ([]byte, []int) {
-	return file_activity_proto_rawDescGZIP(), []int{56}
+	return file_activity_proto_rawDescGZIP(), []int{166}
 }
 
 func (x *CreateActivityActionResp) GetActivityId() int64 {
@@ -4236,7 +12009,7 @@ type CreateActivityCompensateReq struct {
 
 func (x *CreateActivityCompensateReq) Reset() {
 	*x = CreateActivityCompensateReq{}
-	mi := &file_activity_proto_msgTypes[57]
+	mi := &file_activity_proto_msgTypes[167]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4248,7 +12021,7 @@ func (x *CreateActivityCompensateReq) String() string {
 func (*CreateActivityCompensateReq) ProtoMessage() {}
 
 func (x *CreateActivityCompensateReq) ProtoReflect() protoreflect.Message {
-	mi := &file_activity_proto_msgTypes[57]
+	mi := &file_activity_proto_msgTypes[167]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4261,7 +12034,7 @@ func (x *CreateActivityCompensateReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateActivityCompensateReq.ProtoReflect.Descriptor instead.
 func (*CreateActivityCompensateReq) Descriptor() ([]byte, []int) {
-	return file_activity_proto_rawDescGZIP(), []int{57}
+	return file_activity_proto_rawDescGZIP(), []int{167}
 }
 
 func (x *CreateActivityCompensateReq) GetActivityId() int64 {
@@ -4288,7 +12061,7 @@ type CreateActivityCompensateResp struct {
 
 func (x *CreateActivityCompensateResp) Reset() {
 	*x = CreateActivityCompensateResp{}
-	mi := &file_activity_proto_msgTypes[58]
+	mi := &file_activity_proto_msgTypes[168]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4300,7 +12073,7 @@ func (x *CreateActivityCompensateResp) String() string {
 func (*CreateActivityCompensateResp) ProtoMessage() {}
 
 func (x *CreateActivityCompensateResp) ProtoReflect() protoreflect.Message {
-	mi := &file_activity_proto_msgTypes[58]
+	mi := &file_activity_proto_msgTypes[168]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4313,7 +12086,7 @@ func (x *CreateActivityCompensateResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateActivityCompensateResp.ProtoReflect.Descriptor instead.
 func (*CreateActivityCompensateResp) Descriptor() ([]byte, []int) {
-	return file_activity_proto_rawDescGZIP(), []int{58}
+	return file_activity_proto_rawDescGZIP(), []int{168}
 }
 
 func (x *CreateActivityCompensateResp) GetSuccess() bool {
@@ -4336,7 +12109,7 @@ type DeleteActivityActionReq struct {
 
 func (x *DeleteActivityActionReq) Reset() {
 	*x = DeleteActivityActionReq{}
-	mi := &file_activity_proto_msgTypes[59]
+	mi := &file_activity_proto_msgTypes[169]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4348,7 +12121,7 @@ func (x *DeleteActivityActionReq) String() string {
 func (*DeleteActivityActionReq) ProtoMessage() {}
 
 func (x *DeleteActivityActionReq) ProtoReflect() protoreflect.Message {
-	mi := &file_activity_proto_msgTypes[59]
+	mi := &file_activity_proto_msgTypes[169]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4361,7 +12134,7 @@ func (x *DeleteActivityActionReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteActivityActionReq.ProtoReflect.Descriptor instead.
 func (*DeleteActivityActionReq) Descriptor() ([]byte, []int) {
-	return file_activity_proto_rawDescGZIP(), []int{59}
+	return file_activity_proto_rawDescGZIP(), []int{169}
 }
 
 func (x *DeleteActivityActionReq) GetActivityId() int64 {
@@ -4402,7 +12175,7 @@ type DeleteActivityActionResp struct {
 
 func (x *DeleteActivityActionResp) Reset() {
 	*x = DeleteActivityActionResp{}
-	mi := &file_activity_proto_msgTypes[60]
+	mi := &file_activity_proto_msgTypes[170]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4414,7 +12187,7 @@ func (x *DeleteActivityActionResp) String() string {
 func (*DeleteActivityActionResp) ProtoMessage() {}
 
 func (x *DeleteActivityActionResp) ProtoReflect() protoreflect.Message {
-	mi := &file_activity_proto_msgTypes[60]
+	mi := &file_activity_proto_msgTypes[170]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4427,7 +12200,7 @@ func (x *DeleteActivityActionResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteActivityActionResp.ProtoReflect.Descriptor instead.
 func (*DeleteActivityActionResp) Descriptor() ([]byte, []int) {
-	return file_activity_proto_rawDescGZIP(), []int{60}
+	return file_activity_proto_rawDescGZIP(), []int{170}
 }
 
 func (x *DeleteActivityActionResp) GetSuccess() bool {
@@ -4448,7 +12221,7 @@ type DeleteActivityCompensateReq struct {
 
 func (x *DeleteActivityCompensateReq) Reset() {
 	*x = DeleteActivityCompensateReq{}
-	mi := &file_activity_proto_msgTypes[61]
+	mi := &file_activity_proto_msgTypes[171]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4460,7 +12233,7 @@ func (x *DeleteActivityCompensateReq) String() string {
 func (*DeleteActivityCompensateReq) ProtoMessage() {}
 
 func (x *DeleteActivityCompensateReq) ProtoReflect() protoreflect.Message {
-	mi := &file_activity_proto_msgTypes[61]
+	mi := &file_activity_proto_msgTypes[171]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4473,7 +12246,7 @@ func (x *DeleteActivityCompensateReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteActivityCompensateReq.ProtoReflect.Descriptor instead.
 func (*DeleteActivityCompensateReq) Descriptor() ([]byte, []int) {
-	return file_activity_proto_rawDescGZIP(), []int{61}
+	return file_activity_proto_rawDescGZIP(), []int{171}
 }
 
 func (x *DeleteActivityCompensateReq) GetActivityId() int64 {
@@ -4500,7 +12273,7 @@ type DeleteActivityCompensateResp struct {
 
 func (x *DeleteActivityCompensateResp) Reset() {
 	*x = DeleteActivityCompensateResp{}
-	mi := &file_activity_proto_msgTypes[62]
+	mi := &file_activity_proto_msgTypes[172]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4512,7 +12285,7 @@ func (x *DeleteActivityCompensateResp) String() string {
 func (*DeleteActivityCompensateResp) ProtoMessage() {}
 
 func (x *DeleteActivityCompensateResp) ProtoReflect() protoreflect.Message {
-	mi := &file_activity_proto_msgTypes[62]
+	mi := &file_activity_proto_msgTypes[172]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4525,7 +12298,7 @@ func (x *DeleteActivityCompensateResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteActivityCompensateResp.ProtoReflect.Descriptor instead.
 func (*DeleteActivityCompensateResp) Descriptor() ([]byte, []int) {
-	return file_activity_proto_rawDescGZIP(), []int{62}
+	return file_activity_proto_rawDescGZIP(), []int{172}
 }
 
 func (x *DeleteActivityCompensateResp) GetSuccess() bool {
@@ -4556,7 +12329,7 @@ const file_activity_proto_rawDesc = "" +
 	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x14\n" +
 	"\x05total\x18\x03 \x01(\x03R\x05total\x12\x1f\n" +
 	"\vtotal_pages\x18\x04 \x01(\x05R\n" +
-	"totalPages\"\x8c\n" +
+	"totalPages\"\xce\n" +
 	"\n" +
 	"\x0eActivityDetail\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
@@ -4601,7 +12374,10 @@ const file_activity_proto_rawDesc = "" +
 	"updated_at\x18  \x01(\x03R\tupdatedAt\x12\x18\n" +
 	"\aversion\x18! \x01(\x05R\aversion\x12/\n" +
 	"\x13registration_status\x18\" \x01(\x05R\x12registrationStatus\x128\n" +
-	"\x18registration_status_text\x18# \x01(\tR\x16registrationStatusText\"\x9a\x05\n" +
+	"\x18registration_status_text\x18# \x01(\tR\x16registrationStatusText\x12\x1d\n" +
+	"\n" +
+	"rating_avg\x18$ \x01(\x01R\tratingAvg\x12!\n" +
+	"\frating_count\x18% \x01(\x05R\vratingCount\"\xd6\x06\n" +
 	"\x10ActivityListItem\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1b\n" +
@@ -4625,14 +12401,21 @@ const file_activity_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x10 \x01(\x03R\tcreatedAt\x12/\n" +
 	"\x13registration_status\x18\x11 \x01(\x05R\x12registrationStatus\x128\n" +
-	"\x18registration_status_text\x18\x12 \x01(\tR\x16registrationStatusText\"S\n" +
+	"\x18registration_status_text\x18\x12 \x01(\tR\x16registrationStatusText\x12\x1a\n" +
+	"\bdistance\x18\x13 \x01(\x01R\bdistance\x12'\n" +
+	"\x0ftitle_highlight\x18\x14 \x01(\tR\x0etitleHighlight\x123\n" +
+	"\x15description_highlight\x18\x15 \x01(\tR\x14descriptionHighlight\x12\x1d\n" +
+	"\n" +
+	"rating_avg\x18\x16 \x01(\x01R\tratingAvg\x12!\n" +
+	"\frating_count\x18\x17 \x01(\x05R\vratingCount\"S\n" +
 	"\x17RegisterActivityRequest\x12\x1f\n" +
 	"\vactivity_id\x18\x01 \x01(\x03R\n" +
 	"activityId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\x03R\x06userId\"J\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\"\x8d\x01\n" +
 	"\x18RegisterActivityResponse\x12\x16\n" +
 	"\x06result\x18\x01 \x01(\tR\x06result\x12\x16\n" +
-	"\x06reason\x18\x02 \x01(\tR\x06reason\"Q\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12A\n" +
+	"\ffailed_rules\x18\x03 \x03(\v2\x1e.activity.EligibilityCheckItemR\vfailedRules\"Q\n" +
 	"\x15CancelActivityRequest\x12\x1f\n" +
 	"\vactivity_id\x18\x01 \x01(\x03R\n" +
 	"activityId\x12\x17\n" +
@@ -4696,7 +12479,120 @@ const file_activity_proto_rawDesc = "" +
 	"\x19GetRegisteredCountRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\"2\n" +
 	"\x1aGetRegisteredCountResponse\x12\x14\n" +
-	"\x05count\x18\x01 \x01(\x05R\x05count\"\xff\x06\n" +
+	"\x05count\x18\x01 \x01(\x05R\x05count\"\xa2\x01\n" +
+	"\x0fEligibilityRule\x12\x1b\n" +
+	"\trule_type\x18\x01 \x01(\tR\bruleType\x12\x1b\n" +
+	"\tmin_value\x18\x02 \x01(\x05R\bminValue\x12\x1b\n" +
+	"\tmax_value\x18\x03 \x01(\x05R\bmaxValue\x12\x16\n" +
+	"\x06values\x18\x04 \x03(\tR\x06values\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\"\x85\x01\n" +
+	"\x14EligibilityCheckItem\x12\x1b\n" +
+	"\trule_type\x18\x01 \x01(\tR\bruleType\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06passed\x18\x03 \x01(\bR\x06passed\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"\x8b\x01\n" +
+	"\x16SetEligibilityRulesReq\x12\x1f\n" +
+	"\vactivity_id\x18\x01 \x01(\x03R\n" +
+	"activityId\x12\x1f\n" +
+	"\voperator_id\x18\x02 \x01(\x03R\n" +
+	"operatorId\x12/\n" +
+	"\x05rules\x18\x03 \x03(\v2\x19.activity.EligibilityRuleR\x05rules\"J\n" +
+	"\x17SetEligibilityRulesResp\x12/\n" +
+	"\x05rules\x18\x01 \x03(\v2\x19.activity.EligibilityRuleR\x05rules\"9\n" +
+	"\x16GetEligibilityRulesReq\x12\x1f\n" +
+	"\vactivity_id\x18\x01 \x01(\x03R\n" +
+	"activityId\"\xaa\x01\n" +
+	"\x17GetEligibilityRulesResp\x12/\n" +
+	"\x05rules\x18\x01 \x03(\v2\x19.activity.EligibilityRuleR\x05rules\x12(\n" +
+	"\x10min_credit_score\x18\x02 \x01(\x05R\x0eminCreditScore\x124\n" +
+	"\x16require_student_verify\x18\x03 \x01(\bR\x14requireStudentVerify\"O\n" +
+	"\x13CheckEligibilityReq\x12\x1f\n" +
+	"\vactivity_id\x18\x01 \x01(\x03R\n" +
+	"activityId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\"\xa3\x01\n" +
+	"\x14CheckEligibilityResp\x12\x1a\n" +
+	"\beligible\x18\x01 \x01(\bR\beligible\x124\n" +
+	"\x05items\x18\x02 \x03(\v2\x1e.activity.EligibilityCheckItemR\x05items\x12!\n" +
+	"\fcan_register\x18\x03 \x01(\bR\vcanRegister\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"\x7f\n" +
+	"\x11SubmitFeedbackReq\x12\x1f\n" +
+	"\vactivity_id\x18\x01 \x01(\x03R\n" +
+	"activityId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06rating\x18\x03 \x01(\x05R\x06rating\x12\x18\n" +
+	"\acomment\x18\x04 \x01(\tR\acomment\"\x97\x01\n" +
+	"\x12SubmitFeedbackResp\x12\x1d\n" +
+	"\n" +
+	"rating_avg\x18\x01 \x01(\x01R\tratingAvg\x12!\n" +
+	"\frating_count\x18\x02 \x01(\x05R\vratingCount\x12%\n" +
+	"\x0ecomment_status\x18\x03 \x01(\x05R\rcommentStatus\x12\x18\n" +
+	"\acomment\x18\x04 \x01(\tR\acomment\"\xaa\x01\n" +
+	"\x0fFeedbackComment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06rating\x18\x03 \x01(\x05R\x06rating\x12\x18\n" +
+	"\acomment\x18\x04 \x01(\tR\acomment\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\x03R\tupdatedAt\"\x8a\x01\n" +
+	"\x15GetFeedbackSummaryReq\x12\x1f\n" +
+	"\vactivity_id\x18\x01 \x01(\x03R\n" +
+	"activityId\x12\x1f\n" +
+	"\voperator_id\x18\x02 \x01(\x03R\n" +
+	"operatorId\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xcc\x02\n" +
+	"\x16GetFeedbackSummaryResp\x12\x1d\n" +
+	"\n" +
+	"rating_avg\x18\x01 \x01(\x01R\tratingAvg\x12!\n" +
+	"\frating_count\x18\x02 \x01(\x05R\vratingCount\x12\"\n" +
+	"\fdistribution\x18\x03 \x03(\x03R\fdistribution\x122\n" +
+	"\x15pending_comment_count\x18\x04 \x01(\x03R\x13pendingCommentCount\x125\n" +
+	"\bcomments\x18\x05 \x03(\v2\x19.activity.FeedbackCommentR\bcomments\x124\n" +
+	"\n" +
+	"pagination\x18\x06 \x01(\v2\x14.activity.PaginationR\n" +
+	"pagination\x12+\n" +
+	"\x11feedback_deadline\x18\a \x01(\x03R\x10feedbackDeadline\"a\n" +
+	"\x16ListFeedbackReviewsReq\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\x05R\x06status\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"\x9e\x03\n" +
+	"\x12FeedbackReviewItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
+	"\vactivity_id\x18\x02 \x01(\x03R\n" +
+	"activityId\x12%\n" +
+	"\x0eactivity_title\x18\x03 \x01(\tR\ractivityTitle\x12\x1f\n" +
+	"\vfeedback_id\x18\x04 \x01(\x03R\n" +
+	"feedbackId\x12\x17\n" +
+	"\auser_id\x18\x05 \x01(\x03R\x06userId\x12\x18\n" +
+	"\acomment\x18\x06 \x01(\tR\acomment\x12%\n" +
+	"\x0ecomment_status\x18\a \x01(\x05R\rcommentStatus\x12\x1b\n" +
+	"\thit_words\x18\b \x03(\tR\bhitWords\x12\x16\n" +
+	"\x06status\x18\t \x01(\x05R\x06status\x12\x1f\n" +
+	"\vreviewer_id\x18\n" +
+	" \x01(\x03R\n" +
+	"reviewerId\x12\x1f\n" +
+	"\vreview_note\x18\v \x01(\tR\n" +
+	"reviewNote\x12\x1f\n" +
+	"\vreviewed_at\x18\f \x01(\x03R\n" +
+	"reviewedAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\r \x01(\x03R\tcreatedAt\"\x81\x01\n" +
+	"\x17ListFeedbackReviewsResp\x120\n" +
+	"\x04list\x18\x01 \x03(\v2\x1c.activity.FeedbackReviewItemR\x04list\x124\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x14.activity.PaginationR\n" +
+	"pagination\"\x86\x01\n" +
+	"\x18ReviewFeedbackCommentReq\x12\x1b\n" +
+	"\treview_id\x18\x01 \x01(\x03R\breviewId\x12\x1f\n" +
+	"\voperator_id\x18\x02 \x01(\x03R\n" +
+	"operatorId\x12\x18\n" +
+	"\aapprove\x18\x03 \x01(\bR\aapprove\x12\x12\n" +
+	"\x04note\x18\x04 \x01(\tR\x04note\"\\\n" +
+	"\x19ReviewFeedbackCommentResp\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\x05R\x06status\x12'\n" +
+	"\x0fcomment_updated\x18\x02 \x01(\bR\x0ecommentUpdated\"\xff\x06\n" +
 	"\x11CreateActivityReq\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12\x1b\n" +
 	"\tcover_url\x18\x02 \x01(\tR\bcoverUrl\x12\x1d\n" +
@@ -4832,7 +12728,60 @@ const file_activity_proto_rawDesc = "" +
 	"\voperator_id\x18\x03 \x01(\x03R\n" +
 	"operatorId\",\n" +
 	"\x12RejectActivityResp\x12\x16\n" +
-	"\x06status\x18\x01 \x01(\x05R\x06status\"w\n" +
+	"\x06status\x18\x01 \x01(\x05R\x06status\"\x9f\x01\n" +
+	"\x12ListReviewQueueReq\x12\x1f\n" +
+	"\voperator_id\x18\x01 \x01(\x03R\n" +
+	"operatorId\x12\x14\n" +
+	"\x05scope\x18\x02 \x01(\tR\x05scope\x12!\n" +
+	"\foverdue_only\x18\x03 \x01(\bR\voverdueOnly\x12\x12\n" +
+	"\x04page\x18\x04 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\"w\n" +
+	"\x0fReviewFieldDiff\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12\x1b\n" +
+	"\told_value\x18\x03 \x01(\tR\boldValue\x12\x1b\n" +
+	"\tnew_value\x18\x04 \x01(\tR\bnewValue\"\x86\x05\n" +
+	"\x0fReviewQueueItem\x12\x1b\n" +
+	"\treview_id\x18\x01 \x01(\x03R\breviewId\x12\x1f\n" +
+	"\vactivity_id\x18\x02 \x01(\x03R\n" +
+	"activityId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x1b\n" +
+	"\tcover_url\x18\x04 \x01(\tR\bcoverUrl\x12#\n" +
+	"\rcategory_name\x18\x05 \x01(\tR\fcategoryName\x12!\n" +
+	"\forganizer_id\x18\x06 \x01(\x03R\vorganizerId\x12%\n" +
+	"\x0eorganizer_name\x18\a \x01(\tR\rorganizerName\x12.\n" +
+	"\x13activity_start_time\x18\b \x01(\x03R\x11activityStartTime\x12#\n" +
+	"\rpolicy_reason\x18\t \x01(\tR\fpolicyReason\x12\x18\n" +
+	"\aflagged\x18\n" +
+	" \x01(\bR\aflagged\x12!\n" +
+	"\fsubmitted_at\x18\v \x01(\x03R\vsubmittedAt\x12\x15\n" +
+	"\x06due_at\x18\f \x01(\x03R\x05dueAt\x12+\n" +
+	"\x11remaining_seconds\x18\r \x01(\x03R\x10remainingSeconds\x12\x18\n" +
+	"\aoverdue\x18\x0e \x01(\bR\aoverdue\x12\x1f\n" +
+	"\vassignee_id\x18\x0f \x01(\x03R\n" +
+	"assigneeId\x12\x1f\n" +
+	"\vassigned_at\x18\x10 \x01(\x03R\n" +
+	"assignedAt\x120\n" +
+	"\x14has_approved_version\x18\x11 \x01(\bR\x12hasApprovedVersion\x12/\n" +
+	"\x05diffs\x18\x12 \x03(\v2\x19.activity.ReviewFieldDiffR\x05diffs\"z\n" +
+	"\x13ListReviewQueueResp\x12-\n" +
+	"\x04list\x18\x01 \x03(\v2\x19.activity.ReviewQueueItemR\x04list\x124\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x14.activity.PaginationR\n" +
+	"pagination\"\x96\x01\n" +
+	"\x17AssignActivityReviewReq\x12\x1f\n" +
+	"\vactivity_id\x18\x01 \x01(\x03R\n" +
+	"activityId\x12\x1f\n" +
+	"\voperator_id\x18\x02 \x01(\x03R\n" +
+	"operatorId\x12\x1f\n" +
+	"\vassignee_id\x18\x03 \x01(\x03R\n" +
+	"assigneeId\x12\x18\n" +
+	"\arelease\x18\x04 \x01(\bR\arelease\"\\\n" +
+	"\x18AssignActivityReviewResp\x12\x1f\n" +
+	"\vassignee_id\x18\x01 \x01(\x03R\n" +
+	"assigneeId\x12\x1f\n" +
+	"\vassigned_at\x18\x02 \x01(\x03R\n" +
+	"assignedAt\"w\n" +
 	"\x11CancelActivityReq\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
 	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x1f\n" +
@@ -4840,29 +12789,590 @@ const file_activity_proto_rawDesc = "" +
 	"operatorId\x12\x19\n" +
 	"\bis_admin\x18\x04 \x01(\bR\aisAdmin\",\n" +
 	"\x12CancelActivityResp\x12\x16\n" +
-	"\x06status\x18\x01 \x01(\x05R\x06status\"\x95\x01\n" +
+	"\x06status\x18\x01 \x01(\x05R\x06status\"\x9a\x04\n" +
+	"\x17SubmitActivityChangeReq\x12\x1f\n" +
+	"\vactivity_id\x18\x01 \x01(\x03R\n" +
+	"activityId\x12\x1f\n" +
+	"\voperator_id\x18\x02 \x01(\x03R\n" +
+	"operatorId\x12/\n" +
+	"\x11register_end_time\x18\x03 \x01(\x03H\x00R\x0fregisterEndTime\x88\x01\x01\x123\n" +
+	"\x13activity_start_time\x18\x04 \x01(\x03H\x01R\x11activityStartTime\x88\x01\x01\x12/\n" +
+	"\x11activity_end_time\x18\x05 \x01(\x03H\x02R\x0factivityEndTime\x88\x01\x01\x12\x1f\n" +
+	"\blocation\x18\x06 \x01(\tH\x03R\blocation\x88\x01\x01\x12*\n" +
+	"\x0eaddress_detail\x18\a \x01(\tH\x04R\raddressDetail\x88\x01\x01\x12!\n" +
+	"\tlongitude\x18\b \x01(\x01H\x05R\tlongitude\x88\x01\x01\x12\x1f\n" +
+	"\blatitude\x18\t \x01(\x01H\x06R\blatitude\x88\x01\x01\x12\x16\n" +
+	"\x06reason\x18\n" +
+	" \x01(\tR\x06reasonB\x14\n" +
+	"\x12_register_end_timeB\x16\n" +
+	"\x14_activity_start_timeB\x14\n" +
+	"\x12_activity_end_timeB\v\n" +
+	"\t_locationB\x11\n" +
+	"\x0f_address_detailB\f\n" +
+	"\n" +
+	"_longitudeB\v\n" +
+	"\t_latitude\"Q\n" +
+	"\x18SubmitActivityChangeResp\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\x03R\trequestId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\x05R\x06status\"\xc5\x03\n" +
+	"\x12ActivityChangeInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
+	"\vactivity_id\x18\x02 \x01(\x03R\n" +
+	"activityId\x12%\n" +
+	"\x0eactivity_title\x18\x03 \x01(\tR\ractivityTitle\x12!\n" +
+	"\forganizer_id\x18\x04 \x01(\x03R\vorganizerId\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\x05R\x06status\x12\x1f\n" +
+	"\vstatus_text\x18\x06 \x01(\tR\n" +
+	"statusText\x12\x16\n" +
+	"\x06reason\x18\a \x01(\tR\x06reason\x123\n" +
+	"\achanges\x18\b \x03(\v2\x19.activity.ReviewFieldDiffR\achanges\x12\x1f\n" +
+	"\vreviewer_id\x18\t \x01(\x03R\n" +
+	"reviewerId\x12#\n" +
+	"\rreject_reason\x18\n" +
+	" \x01(\tR\frejectReason\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\v \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"decided_at\x18\f \x01(\x03R\tdecidedAt\x12*\n" +
+	"\x11free_cancel_until\x18\r \x01(\x03R\x0ffreeCancelUntil\"\xbe\x01\n" +
+	"\x16ListActivityChangesReq\x12\x1f\n" +
+	"\voperator_id\x18\x01 \x01(\x03R\n" +
+	"operatorId\x12\x19\n" +
+	"\bis_admin\x18\x02 \x01(\bR\aisAdmin\x12\x1f\n" +
+	"\vactivity_id\x18\x03 \x01(\x03R\n" +
+	"activityId\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\x05R\x06status\x12\x12\n" +
+	"\x04page\x18\x05 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x06 \x01(\x05R\bpageSize\"\x81\x01\n" +
+	"\x17ListActivityChangesResp\x120\n" +
+	"\x04list\x18\x01 \x03(\v2\x1c.activity.ActivityChangeInfoR\x04list\x124\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x14.activity.PaginationR\n" +
+	"pagination\"\x8b\x01\n" +
+	"\x17ReviewActivityChangeReq\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\x03R\trequestId\x12\x1f\n" +
+	"\voperator_id\x18\x02 \x01(\x03R\n" +
+	"operatorId\x12\x18\n" +
+	"\aapprove\x18\x03 \x01(\bR\aapprove\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"\x85\x01\n" +
+	"\x18ReviewActivityChangeResp\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\x05R\x06status\x12*\n" +
+	"\x11free_cancel_until\x18\x02 \x01(\x03R\x0ffreeCancelUntil\x12%\n" +
+	"\x0enotified_count\x18\x03 \x01(\x05R\rnotifiedCount\"k\n" +
+	"\x13FavoriteActivityReq\x12\x1f\n" +
+	"\vactivity_id\x18\x01 \x01(\x03R\n" +
+	"activityId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x1a\n" +
+	"\bfavorite\x18\x03 \x01(\bR\bfavorite\"4\n" +
+	"\x14FavoriteActivityResp\x12\x1c\n" +
+	"\tfavorited\x18\x01 \x01(\bR\tfavorited\"\x9c\x01\n" +
+	"\x17CreateActivitySeriesReq\x127\n" +
+	"\btemplate\x18\x01 \x01(\v2\x1b.activity.CreateActivityReqR\btemplate\x12\x14\n" +
+	"\x05rrule\x18\x02 \x01(\tR\x05rrule\x122\n" +
+	"\x15allow_series_register\x18\x03 \x01(\bR\x13allowSeriesRegister\"\x87\x01\n" +
+	"\x18CreateActivitySeriesResp\x12\x1b\n" +
+	"\tseries_id\x18\x01 \x01(\x03R\bseriesId\x12!\n" +
+	"\factivity_ids\x18\x02 \x03(\x03R\vactivityIds\x12+\n" +
+	"\x11total_occurrences\x18\x03 \x01(\x05R\x10totalOccurrences\"\xdc\x02\n" +
+	"\x10SeriesOccurrence\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x1f\n" +
+	"\vactivity_id\x18\x02 \x01(\x03R\n" +
+	"activityId\x12.\n" +
+	"\x13activity_start_time\x18\x03 \x01(\x03R\x11activityStartTime\x12*\n" +
+	"\x11activity_end_time\x18\x04 \x01(\x03R\x0factivityEndTime\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\x05R\x06status\x12\x1f\n" +
+	"\vstatus_text\x18\x06 \x01(\tR\n" +
+	"statusText\x12\x1e\n" +
+	"\n" +
+	"overridden\x18\a \x01(\bR\n" +
+	"overridden\x121\n" +
+	"\x14current_participants\x18\b \x01(\x05R\x13currentParticipants\x12)\n" +
+	"\x10max_participants\x18\t \x01(\x05R\x0fmaxParticipants\"\xd6\x04\n" +
+	"\x12ActivitySeriesInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x14\n" +
+	"\x05rrule\x18\x03 \x01(\tR\x05rrule\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\x05R\x06status\x12\x1f\n" +
+	"\vstatus_text\x18\x05 \x01(\tR\n" +
+	"statusText\x12!\n" +
+	"\forganizer_id\x18\x06 \x01(\x03R\vorganizerId\x12%\n" +
+	"\x0eorganizer_name\x18\a \x01(\tR\rorganizerName\x12\x1b\n" +
+	"\tcover_url\x18\b \x01(\tR\bcoverUrl\x12\x1f\n" +
+	"\vcategory_id\x18\t \x01(\x03R\n" +
+	"categoryId\x12\x1a\n" +
+	"\blocation\x18\n" +
+	" \x01(\tR\blocation\x12(\n" +
+	"\x10first_start_time\x18\v \x01(\x03R\x0efirstStartTime\x12)\n" +
+	"\x10duration_seconds\x18\f \x01(\x03R\x0fdurationSeconds\x122\n" +
+	"\x15allow_series_register\x18\r \x01(\bR\x13allowSeriesRegister\x12+\n" +
+	"\x11total_occurrences\x18\x0e \x01(\x05R\x10totalOccurrences\x12'\n" +
+	"\x0fgenerated_count\x18\x0f \x01(\x05R\x0egeneratedCount\x12)\n" +
+	"\x10subscriber_count\x18\x10 \x01(\x03R\x0fsubscriberCount\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x11 \x01(\x03R\tcreatedAt\"C\n" +
+	"\x14GetActivitySeriesReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1b\n" +
+	"\tviewer_id\x18\x02 \x01(\x03R\bviewerId\"\xab\x01\n" +
+	"\x15GetActivitySeriesResp\x124\n" +
+	"\x06series\x18\x01 \x01(\v2\x1c.activity.ActivitySeriesInfoR\x06series\x12<\n" +
+	"\voccurrences\x18\x02 \x03(\v2\x1a.activity.SeriesOccurrenceR\voccurrences\x12\x1e\n" +
+	"\n" +
+	"subscribed\x18\x03 \x01(\bR\n" +
+	"subscribed\"\xfa\a\n" +
+	"\x17UpdateActivitySeriesReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
+	"\voperator_id\x18\x02 \x01(\x03R\n" +
+	"operatorId\x12\x19\n" +
+	"\x05title\x18\x03 \x01(\tH\x00R\x05title\x88\x01\x01\x12\x1d\n" +
+	"\acontent\x18\x04 \x01(\tH\x01R\acontent\x88\x01\x01\x12$\n" +
+	"\vcategory_id\x18\x05 \x01(\x03H\x02R\n" +
+	"categoryId\x88\x01\x01\x12(\n" +
+	"\rcontact_phone\x18\x06 \x01(\tH\x03R\fcontactPhone\x88\x01\x01\x12)\n" +
+	"\x0ecover_image_id\x18\a \x01(\x03H\x04R\fcoverImageId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"cover_type\x18\b \x01(\x05H\x05R\tcoverType\x88\x01\x01\x12\x1f\n" +
+	"\blocation\x18\t \x01(\tH\x06R\blocation\x88\x01\x01\x12*\n" +
+	"\x0eaddress_detail\x18\n" +
+	" \x01(\tH\aR\raddressDetail\x88\x01\x01\x12!\n" +
+	"\tlongitude\x18\v \x01(\x01H\bR\tlongitude\x88\x01\x01\x12\x1f\n" +
+	"\blatitude\x18\f \x01(\x01H\tR\blatitude\x88\x01\x01\x12.\n" +
+	"\x10max_participants\x18\r \x01(\x05H\n" +
+	"R\x0fmaxParticipants\x88\x01\x01\x12.\n" +
+	"\x10require_approval\x18\x0e \x01(\bH\vR\x0frequireApproval\x88\x01\x01\x129\n" +
+	"\x16require_student_verify\x18\x0f \x01(\bH\fR\x14requireStudentVerify\x88\x01\x01\x12-\n" +
+	"\x10min_credit_score\x18\x10 \x01(\x05H\rR\x0eminCreditScore\x88\x01\x01\x12\x17\n" +
+	"\atag_ids\x18\x11 \x03(\x03R\x06tagIds\x12\x1f\n" +
+	"\vupdate_tags\x18\x12 \x01(\bR\n" +
+	"updateTags\x127\n" +
+	"\x15allow_series_register\x18\x13 \x01(\bH\x0eR\x13allowSeriesRegister\x88\x01\x01B\b\n" +
+	"\x06_titleB\n" +
+	"\n" +
+	"\b_contentB\x0e\n" +
+	"\f_category_idB\x10\n" +
+	"\x0e_contact_phoneB\x11\n" +
+	"\x0f_cover_image_idB\r\n" +
+	"\v_cover_typeB\v\n" +
+	"\t_locationB\x11\n" +
+	"\x0f_address_detailB\f\n" +
+	"\n" +
+	"_longitudeB\v\n" +
+	"\t_latitudeB\x13\n" +
+	"\x11_max_participantsB\x13\n" +
+	"\x11_require_approvalB\x19\n" +
+	"\x17_require_student_verifyB\x13\n" +
+	"\x11_min_credit_scoreB\x18\n" +
+	"\x16_allow_series_register\"R\n" +
+	"\x17SeriesSkippedOccurrence\x12\x1f\n" +
+	"\vactivity_id\x18\x01 \x01(\x03R\n" +
+	"activityId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"q\n" +
+	"\x18UpdateActivitySeriesResp\x12\x18\n" +
+	"\aupdated\x18\x01 \x01(\x05R\aupdated\x12;\n" +
+	"\askipped\x18\x02 \x03(\v2!.activity.SeriesSkippedOccurrenceR\askipped\"\xa2\x01\n" +
+	"\x17CancelActivitySeriesReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
+	"\voperator_id\x18\x02 \x01(\x03R\n" +
+	"operatorId\x12\x19\n" +
+	"\bis_admin\x18\x03 \x01(\bR\aisAdmin\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12#\n" +
+	"\rcancel_future\x18\x05 \x01(\bR\fcancelFuture\"u\n" +
+	"\x18CancelActivitySeriesResp\x12\x1c\n" +
+	"\tcancelled\x18\x01 \x01(\x05R\tcancelled\x12;\n" +
+	"\askipped\x18\x02 \x03(\v2!.activity.SeriesSkippedOccurrenceR\askipped\"m\n" +
+	"\x19RegisterActivitySeriesReq\x12\x1b\n" +
+	"\tseries_id\x18\x01 \x01(\x03R\bseriesId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x1a\n" +
+	"\bregister\x18\x03 \x01(\bR\bregister\"X\n" +
+	"\x1aRegisterActivitySeriesResp\x12\x1e\n" +
+	"\n" +
+	"subscribed\x18\x01 \x01(\bR\n" +
+	"subscribed\x12\x1a\n" +
+	"\benrolled\x18\x02 \x01(\x05R\benrolled\"H\n" +
+	"\x17GetCalendarFeedTokenReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x14\n" +
+	"\x05reset\x18\x02 \x01(\bR\x05reset\"0\n" +
+	"\x18GetCalendarFeedTokenResp\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"*\n" +
+	"\x12GetCalendarFeedReq\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"/\n" +
+	"\x13GetCalendarFeedResp\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\tR\acontent\"T\n" +
+	"\x14ExportActivityIcsReq\x12\x1f\n" +
+	"\vactivity_id\x18\x01 \x01(\x03R\n" +
+	"activityId\x12\x1b\n" +
+	"\tviewer_id\x18\x02 \x01(\x03R\bviewerId\"M\n" +
+	"\x15ExportActivityIcsResp\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\tR\acontent\x12\x1a\n" +
+	"\bfilename\x18\x02 \x01(\tR\bfilename\"\x94\x01\n" +
+	"\x11SetSelfCheckInReq\x12\x1f\n" +
+	"\vactivity_id\x18\x01 \x01(\x03R\n" +
+	"activityId\x12\x1f\n" +
+	"\voperator_id\x18\x02 \x01(\x03R\n" +
+	"operatorId\x12\x18\n" +
+	"\aenabled\x18\x03 \x01(\bR\aenabled\x12#\n" +
+	"\rradius_meters\x18\x04 \x01(\x05R\fradiusMeters\"S\n" +
+	"\x12SetSelfCheckInResp\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12#\n" +
+	"\rradius_meters\x18\x02 \x01(\x05R\fradiusMeters\"Y\n" +
+	"\x15GetSelfCheckInCodeReq\x12\x1f\n" +
+	"\vactivity_id\x18\x01 \x01(\x03R\n" +
+	"activityId\x12\x1f\n" +
+	"\voperator_id\x18\x02 \x01(\x03R\n" +
+	"operatorId\"\x9a\x01\n" +
+	"\x16GetSelfCheckInCodeResp\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\x03R\texpiresAt\x12#\n" +
+	"\rradius_meters\x18\x03 \x01(\x05R\fradiusMeters\x12(\n" +
+	"\x10checked_in_count\x18\x04 \x01(\x03R\x0echeckedInCount\"\x8b\x02\n" +
+	"\x0eSelfCheckInReq\x12\x1f\n" +
+	"\vactivity_id\x18\x01 \x01(\x03R\n" +
+	"activityId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x1f\n" +
+	"\vticket_code\x18\x03 \x01(\tR\n" +
+	"ticketCode\x12\x1b\n" +
+	"\troom_code\x18\x04 \x01(\tR\broomCode\x12\x1a\n" +
+	"\blatitude\x18\x05 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x06 \x01(\x01R\tlongitude\x12\x1b\n" +
+	"\tdevice_id\x18\a \x01(\tR\bdeviceId\x12*\n" +
+	"\x11client_request_id\x18\b \x01(\tR\x0fclientRequestId\"v\n" +
+	"\x0fSelfCheckInResp\x12\x16\n" +
+	"\x06result\x18\x01 \x01(\tR\x06result\x12'\n" +
+	"\x0fdistance_meters\x18\x02 \x01(\x05R\x0edistanceMeters\x12\"\n" +
+	"\rcheck_in_time\x18\x03 \x01(\x03R\vcheckInTime\"\xad\x01\n" +
+	"\x15ListCheckInRecordsReq\x12\x1f\n" +
+	"\vactivity_id\x18\x01 \x01(\x03R\n" +
+	"activityId\x12\x1f\n" +
+	"\voperator_id\x18\x02 \x01(\x03R\n" +
+	"operatorId\x12!\n" +
+	"\fflagged_only\x18\x03 \x01(\bR\vflaggedOnly\x12\x12\n" +
+	"\x04page\x18\x04 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\"\xd4\x02\n" +
+	"\x11CheckInRecordItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x1f\n" +
+	"\vticket_code\x18\x03 \x01(\tR\n" +
+	"ticketCode\x12\x16\n" +
+	"\x06method\x18\x04 \x01(\x05R\x06method\x12\"\n" +
+	"\rcheck_in_time\x18\x05 \x01(\x03R\vcheckInTime\x12\x1a\n" +
+	"\blatitude\x18\x06 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\a \x01(\x01R\tlongitude\x12'\n" +
+	"\x0fdistance_meters\x18\b \x01(\x05R\x0edistanceMeters\x12\x1b\n" +
+	"\tdevice_id\x18\t \x01(\tR\bdeviceId\x12\x18\n" +
+	"\aflagged\x18\n" +
+	" \x01(\bR\aflagged\x12\x1f\n" +
+	"\vflag_reason\x18\v \x01(\tR\n" +
+	"flagReason\"\x7f\n" +
+	"\x16ListCheckInRecordsResp\x12/\n" +
+	"\x04list\x18\x01 \x03(\v2\x1b.activity.CheckInRecordItemR\x04list\x124\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x14.activity.PaginationR\n" +
+	"pagination\"\xd3\x04\n" +
+	"\x10ActivityTemplate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
+	"\x05scope\x18\x02 \x01(\x05R\x05scope\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1f\n" +
+	"\vcategory_id\x18\x04 \x01(\x03R\n" +
+	"categoryId\x12#\n" +
+	"\rcategory_name\x18\x05 \x01(\tR\fcategoryName\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\x05R\x06status\x12\x14\n" +
+	"\x05title\x18\a \x01(\tR\x05title\x12\x1b\n" +
+	"\tcover_url\x18\b \x01(\tR\bcoverUrl\x12\x1a\n" +
+	"\blocation\x18\t \x01(\tR\blocation\x12)\n" +
+	"\x10max_participants\x18\n" +
+	" \x01(\x05R\x0fmaxParticipants\x12)\n" +
+	"\x10duration_seconds\x18\v \x01(\x03R\x0fdurationSeconds\x120\n" +
+	"\x14register_open_before\x18\f \x01(\x03R\x12registerOpenBefore\x122\n" +
+	"\x15register_close_before\x18\r \x01(\x03R\x13registerCloseBefore\x12\x17\n" +
+	"\atag_ids\x18\x0e \x03(\x03R\x06tagIds\x12,\n" +
+	"\x12source_activity_id\x18\x0f \x01(\x03R\x10sourceActivityId\x12\x1b\n" +
+	"\tuse_count\x18\x10 \x01(\x03R\buseCount\x12\x19\n" +
+	"\bowner_id\x18\x11 \x01(\x03R\aownerId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x12 \x01(\x03R\tcreatedAt\"N\n" +
+	"\x14ActivityTemplateResp\x126\n" +
+	"\btemplate\x18\x01 \x01(\v2\x1a.activity.ActivityTemplateR\btemplate\"\x9a\x01\n" +
+	"\x10CloneActivityReq\x12\x1f\n" +
+	"\vactivity_id\x18\x01 \x01(\x03R\n" +
+	"activityId\x12\x1f\n" +
+	"\voperator_id\x18\x02 \x01(\x03R\n" +
+	"operatorId\x12.\n" +
+	"\x13activity_start_time\x18\x03 \x01(\x03R\x11activityStartTime\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\"o\n" +
+	"\x17SaveActivityTemplateReq\x12\x1f\n" +
+	"\vactivity_id\x18\x01 \x01(\x03R\n" +
+	"activityId\x12\x1f\n" +
+	"\voperator_id\x18\x02 \x01(\x03R\n" +
+	"operatorId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\"\xbe\x01\n" +
+	"\x18ListActivityTemplatesReq\x12\x1f\n" +
+	"\voperator_id\x18\x01 \x01(\x03R\n" +
+	"operatorId\x12\x14\n" +
+	"\x05scope\x18\x02 \x01(\x05R\x05scope\x12\x1f\n" +
+	"\vcategory_id\x18\x03 \x01(\x03R\n" +
+	"categoryId\x12\x19\n" +
+	"\bis_admin\x18\x04 \x01(\bR\aisAdmin\x12\x12\n" +
+	"\x04page\x18\x05 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x06 \x01(\x05R\bpageSize\"\x81\x01\n" +
+	"\x19ListActivityTemplatesResp\x12.\n" +
+	"\x04list\x18\x01 \x03(\v2\x1a.activity.ActivityTemplateR\x04list\x124\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x14.activity.PaginationR\n" +
+	"pagination\"\xa7\x01\n" +
+	"\x1dCreateActivityFromTemplateReq\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\x03R\n" +
+	"templateId\x12\x1f\n" +
+	"\voperator_id\x18\x02 \x01(\x03R\n" +
+	"operatorId\x12.\n" +
+	"\x13activity_start_time\x18\x03 \x01(\x03R\x11activityStartTime\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\"g\n" +
+	"\x19DeleteActivityTemplateReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
+	"\voperator_id\x18\x02 \x01(\x03R\n" +
+	"operatorId\x12\x19\n" +
+	"\bis_admin\x18\x03 \x01(\bR\aisAdmin\"\x1c\n" +
+	"\x1aDeleteActivityTemplateResp\"\xa2\x01\n" +
+	"\x1cAdminCreatePublicTemplateReq\x12,\n" +
+	"\x12source_activity_id\x18\x01 \x01(\x03R\x10sourceActivityId\x12\x1f\n" +
+	"\voperator_id\x18\x02 \x01(\x03R\n" +
+	"operatorId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1f\n" +
+	"\vcategory_id\x18\x04 \x01(\x03R\n" +
+	"categoryId\"\xa6\x01\n" +
+	"\x16AdminUpdateTemplateReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
+	"\voperator_id\x18\x02 \x01(\x03R\n" +
+	"operatorId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1f\n" +
+	"\vcategory_id\x18\x04 \x01(\x03R\n" +
+	"categoryId\x12\x1b\n" +
+	"\x06status\x18\x05 \x01(\x05H\x00R\x06status\x88\x01\x01B\t\n" +
+	"\a_status\"\xb9\x02\n" +
+	"\x0fAnalyticsFunnel\x12\x1d\n" +
+	"\n" +
+	"page_views\x18\x01 \x01(\x03R\tpageViews\x12'\n" +
+	"\x0funique_visitors\x18\x02 \x01(\x03R\x0euniqueVisitors\x12$\n" +
+	"\rregistrations\x18\x03 \x01(\x03R\rregistrations\x12$\n" +
+	"\rcancellations\x18\x04 \x01(\x03R\rcancellations\x121\n" +
+	"\x14active_registrations\x18\x05 \x01(\x03R\x13activeRegistrations\x12\x1b\n" +
+	"\tcheck_ins\x18\x06 \x01(\x03R\bcheckIns\x12\x19\n" +
+	"\bno_shows\x18\a \x01(\x03R\anoShows\x12'\n" +
+	"\x0fattendance_rate\x18\b \x01(\x01R\x0eattendanceRate\"\xc0\x01\n" +
+	"\x14RegistrationVelocity\x126\n" +
+	"\x17first_24h_registrations\x18\x01 \x01(\x03R\x15first24hRegistrations\x128\n" +
+	"\x18peak_daily_registrations\x18\x02 \x01(\x03R\x16peakDailyRegistrations\x126\n" +
+	"\x17avg_daily_registrations\x18\x03 \x01(\x01R\x15avgDailyRegistrations\"\xd5\x01\n" +
+	"\x0eAnalyticsDaily\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x1d\n" +
+	"\n" +
+	"page_views\x18\x02 \x01(\x03R\tpageViews\x12'\n" +
+	"\x0funique_visitors\x18\x03 \x01(\x03R\x0euniqueVisitors\x12$\n" +
+	"\rregistrations\x18\x04 \x01(\x03R\rregistrations\x12$\n" +
+	"\rcancellations\x18\x05 \x01(\x03R\rcancellations\x12\x1b\n" +
+	"\tcheck_ins\x18\x06 \x01(\x03R\bcheckIns\"<\n" +
+	"\x0eAudienceBucket\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"\xc5\x01\n" +
+	"\x11AudienceBreakdown\x121\n" +
+	"\x14verified_registrants\x18\x01 \x01(\x03R\x13verifiedRegistrants\x12:\n" +
+	"\vdepartments\x18\x02 \x03(\v2\x18.activity.AudienceBucketR\vdepartments\x12A\n" +
+	"\x0fadmission_years\x18\x03 \x03(\v2\x18.activity.AudienceBucketR\x0eadmissionYears\"\x95\x01\n" +
+	"\x17GetActivityAnalyticsReq\x12\x1f\n" +
+	"\vactivity_id\x18\x01 \x01(\x03R\n" +
+	"activityId\x12\x1f\n" +
+	"\voperator_id\x18\x02 \x01(\x03R\n" +
+	"operatorId\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x03 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x04 \x01(\tR\aendDate\"\xe5\x02\n" +
+	"\x18GetActivityAnalyticsResp\x12\x1f\n" +
+	"\vactivity_id\x18\x01 \x01(\x03R\n" +
+	"activityId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x121\n" +
+	"\x06funnel\x18\x03 \x01(\v2\x19.activity.AnalyticsFunnelR\x06funnel\x12:\n" +
+	"\bvelocity\x18\x04 \x01(\v2\x1e.activity.RegistrationVelocityR\bvelocity\x12.\n" +
+	"\x05daily\x18\x05 \x03(\v2\x18.activity.AnalyticsDailyR\x05daily\x127\n" +
+	"\baudience\x18\x06 \x01(\v2\x1b.activity.AudienceBreakdownR\baudience\x12\x1b\n" +
+	"\tstat_date\x18\a \x01(\tR\bstatDate\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\x03R\tupdatedAt\"\xe4\x01\n" +
+	"\x16ActivityAnalyticsBrief\x12\x1f\n" +
+	"\vactivity_id\x18\x01 \x01(\x03R\n" +
+	"activityId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12'\n" +
+	"\x0funique_visitors\x18\x03 \x01(\x03R\x0euniqueVisitors\x12$\n" +
+	"\rregistrations\x18\x04 \x01(\x03R\rregistrations\x12\x1b\n" +
+	"\tcheck_ins\x18\x05 \x01(\x03R\bcheckIns\x12'\n" +
+	"\x0fattendance_rate\x18\x06 \x01(\x01R\x0eattendanceRate\"w\n" +
+	"\x18GetOrganizerAnalyticsReq\x12!\n" +
+	"\forganizer_id\x18\x01 \x01(\x03R\vorganizerId\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x02 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x03 \x01(\tR\aendDate\"\xa7\x02\n" +
+	"\x19GetOrganizerAnalyticsResp\x12%\n" +
+	"\x0eactivity_count\x18\x01 \x01(\x03R\ractivityCount\x121\n" +
+	"\x06funnel\x18\x02 \x01(\v2\x19.activity.AnalyticsFunnelR\x06funnel\x12.\n" +
+	"\x05daily\x18\x03 \x03(\v2\x18.activity.AnalyticsDailyR\x05daily\x127\n" +
+	"\baudience\x18\x04 \x01(\v2\x1b.activity.AudienceBreakdownR\baudience\x12G\n" +
+	"\x0etop_activities\x18\x05 \x03(\v2 .activity.ActivityAnalyticsBriefR\rtopActivities\"\xde\x03\n" +
 	"\x13SearchActivitiesReq\x12\x18\n" +
 	"\akeyword\x18\x01 \x01(\tR\akeyword\x12\x1f\n" +
 	"\vcategory_id\x18\x02 \x01(\x03R\n" +
 	"categoryId\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
 	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x12\n" +
-	"\x04sort\x18\x05 \x01(\tR\x04sort\"\x80\x01\n" +
+	"\x04sort\x18\x05 \x01(\tR\x04sort\x12\x1c\n" +
+	"\tlongitude\x18\x06 \x01(\x01R\tlongitude\x12\x1a\n" +
+	"\blatitude\x18\a \x01(\x01R\blatitude\x12\x1b\n" +
+	"\tradius_km\x18\b \x01(\x01R\bradiusKm\x12\x17\n" +
+	"\atag_ids\x18\t \x03(\x03R\x06tagIds\x12&\n" +
+	"\x0fstart_time_from\x18\n" +
+	" \x01(\x03R\rstartTimeFrom\x12\"\n" +
+	"\rstart_time_to\x18\v \x01(\x03R\vstartTimeTo\x12\x1b\n" +
+	"\thas_seats\x18\f \x01(\bR\bhasSeats\x12*\n" +
+	"\x11no_student_verify\x18\r \x01(\bR\x0fnoStudentVerify\x12-\n" +
+	"\x10max_credit_score\x18\x0e \x01(\x05H\x00R\x0emaxCreditScore\x88\x01\x01B\x13\n" +
+	"\x11_max_credit_score\"\xb0\x01\n" +
 	"\x14SearchActivitiesResp\x12.\n" +
 	"\x04list\x18\x01 \x03(\v2\x1a.activity.ActivityListItemR\x04list\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x03R\x05total\x12\"\n" +
-	"\rquery_time_ms\x18\x03 \x01(\x05R\vqueryTimeMs\"+\n" +
+	"\rquery_time_ms\x18\x03 \x01(\x05R\vqueryTimeMs\x12.\n" +
+	"\x06facets\x18\x04 \x01(\v2\x16.activity.SearchFacetsR\x06facets\"G\n" +
+	"\vFacetBucket\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x03R\x05count\"\xa3\x01\n" +
+	"\fSearchFacets\x125\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\v2\x15.activity.FacetBucketR\n" +
+	"categories\x12)\n" +
+	"\x04tags\x18\x02 \x03(\v2\x15.activity.FacetBucketR\x04tags\x121\n" +
+	"\bstatuses\x18\x03 \x03(\v2\x15.activity.FacetBucketR\bstatuses\"+\n" +
 	"\x13GetHotActivitiesReq\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\x05R\x05limit\"F\n" +
 	"\x14GetHotActivitiesResp\x12.\n" +
-	"\x04list\x18\x01 \x03(\v2\x1a.activity.ActivityListItemR\x04list\"\x13\n" +
+	"\x04list\x18\x01 \x03(\v2\x1a.activity.ActivityListItemR\x04list\"\xec\x01\n" +
+	"\x13NearbyActivitiesReq\x12\x1c\n" +
+	"\tlongitude\x18\x01 \x01(\x01R\tlongitude\x12\x1a\n" +
+	"\blatitude\x18\x02 \x01(\x01R\blatitude\x12\x1b\n" +
+	"\tradius_km\x18\x03 \x01(\x01R\bradiusKm\x12\x1f\n" +
+	"\vcategory_id\x18\x04 \x01(\x03R\n" +
+	"categoryId\x12\x18\n" +
+	"\akeyword\x18\x05 \x01(\tR\akeyword\x12\x12\n" +
+	"\x04page\x18\x06 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\a \x01(\x05R\bpageSize\x12\x12\n" +
+	"\x04sort\x18\b \x01(\tR\x04sort\"\x98\x01\n" +
+	"\x14NearbyActivitiesResp\x12.\n" +
+	"\x04list\x18\x01 \x03(\v2\x1a.activity.ActivityListItemR\x04list\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\x12\"\n" +
+	"\rquery_time_ms\x18\x03 \x01(\x05R\vqueryTimeMs\x12\x16\n" +
+	"\x06source\x18\x04 \x01(\tR\x06source\"c\n" +
+	"\x14SuggestActivitiesReq\x12\x16\n" +
+	"\x06prefix\x18\x01 \x01(\tR\x06prefix\x12\x1f\n" +
+	"\vcategory_id\x18\x02 \x01(\x03R\n" +
+	"categoryId\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x05R\x04size\"9\n" +
+	"\x15SuggestActivitiesResp\x12 \n" +
+	"\vsuggestions\x18\x01 \x03(\tR\vsuggestions\"\x13\n" +
 	"\x11ListCategoriesReq\"<\n" +
 	"\x12ListCategoriesResp\x12&\n" +
 	"\x04list\x18\x01 \x03(\v2\x12.activity.CategoryR\x04list\"#\n" +
 	"\vListTagsReq\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\x05R\x05limit\"1\n" +
 	"\fListTagsResp\x12!\n" +
-	"\x04list\x18\x01 \x03(\v2\r.activity.TagR\x04list\"X\n" +
+	"\x04list\x18\x01 \x03(\v2\r.activity.TagR\x04list\"\xd8\x01\n" +
+	"\rAdminCategory\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04icon\x18\x03 \x01(\tR\x04icon\x12\x12\n" +
+	"\x04sort\x18\x04 \x01(\x05R\x04sort\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\x05R\x06status\x12%\n" +
+	"\x0eactivity_count\x18\x06 \x01(\x03R\ractivityCount\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\x03R\tupdatedAt\"\x18\n" +
+	"\x16AdminListCategoriesReq\"F\n" +
+	"\x17AdminListCategoriesResp\x12+\n" +
+	"\x04list\x18\x01 \x03(\v2\x17.activity.AdminCategoryR\x04list\"p\n" +
+	"\x11CreateCategoryReq\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04icon\x18\x02 \x01(\tR\x04icon\x12\x12\n" +
+	"\x04sort\x18\x03 \x01(\x05R\x04sort\x12\x1f\n" +
+	"\voperator_id\x18\x04 \x01(\x03R\n" +
+	"operatorId\"l\n" +
+	"\x11UpdateCategoryReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04icon\x18\x03 \x01(\tR\x04icon\x12\x1f\n" +
+	"\voperator_id\x18\x04 \x01(\x03R\n" +
+	"operatorId\"_\n" +
+	"\x14SetCategoryStatusReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\x05R\x06status\x12\x1f\n" +
+	"\voperator_id\x18\x03 \x01(\x03R\n" +
+	"operatorId\"H\n" +
+	"\x11AdminCategoryResp\x123\n" +
+	"\bcategory\x18\x01 \x01(\v2\x17.activity.AdminCategoryR\bcategory\"6\n" +
+	"\x10CategorySortItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04sort\x18\x02 \x01(\x05R\x04sort\"f\n" +
+	"\x11SortCategoriesReq\x120\n" +
+	"\x05items\x18\x01 \x03(\v2\x1a.activity.CategorySortItemR\x05items\x12\x1f\n" +
+	"\voperator_id\x18\x02 \x01(\x03R\n" +
+	"operatorId\".\n" +
+	"\x12SortCategoriesResp\x12\x18\n" +
+	"\aupdated\x18\x01 \x01(\x05R\aupdated\"D\n" +
+	"\x11DeleteCategoryReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
+	"\voperator_id\x18\x02 \x01(\x03R\n" +
+	"operatorId\"\x14\n" +
+	"\x12DeleteCategoryResp\"\xb9\x01\n" +
+	"\bAdminTag\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05color\x18\x03 \x01(\tR\x05color\x12\x12\n" +
+	"\x04icon\x18\x04 \x01(\tR\x04icon\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\x05R\x06status\x12%\n" +
+	"\x0eactivity_count\x18\a \x01(\x03R\ractivityCount\"\x12\n" +
+	"\x10AdminListTagsReq\";\n" +
+	"\x11AdminListTagsResp\x12&\n" +
+	"\x04list\x18\x01 \x03(\v2\x12.activity.AdminTagR\x04list\"\x8f\x01\n" +
+	"\fCreateTagReq\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05color\x18\x02 \x01(\tR\x05color\x12\x12\n" +
+	"\x04icon\x18\x03 \x01(\tR\x04icon\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1f\n" +
+	"\voperator_id\x18\x05 \x01(\x03R\n" +
+	"operatorId\"\x9f\x01\n" +
+	"\fUpdateTagReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05color\x18\x03 \x01(\tR\x05color\x12\x12\n" +
+	"\x04icon\x18\x04 \x01(\tR\x04icon\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\x1f\n" +
+	"\voperator_id\x18\x06 \x01(\x03R\n" +
+	"operatorId\"Z\n" +
+	"\x0fSetTagStatusReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\x05R\x06status\x12\x1f\n" +
+	"\voperator_id\x18\x03 \x01(\x03R\n" +
+	"operatorId\"4\n" +
+	"\fAdminTagResp\x12$\n" +
+	"\x03tag\x18\x01 \x01(\v2\x12.activity.AdminTagR\x03tag\"i\n" +
+	"\fMergeTagsReq\x12\x1b\n" +
+	"\tsource_id\x18\x01 \x01(\x03R\bsourceId\x12\x1b\n" +
+	"\ttarget_id\x18\x02 \x01(\x03R\btargetId\x12\x1f\n" +
+	"\voperator_id\x18\x03 \x01(\x03R\n" +
+	"operatorId\"\x87\x01\n" +
+	"\rMergeTagsResp\x12*\n" +
+	"\x06target\x18\x01 \x01(\v2\x12.activity.AdminTagR\x06target\x12)\n" +
+	"\x10moved_activities\x18\x02 \x01(\x03R\x0fmovedActivities\x12\x1f\n" +
+	"\vmoved_users\x18\x03 \x01(\x03R\n" +
+	"movedUsers\"X\n" +
 	"\x10IncrViewCountReq\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x1b\n" +
@@ -4899,12 +13409,17 @@ const file_activity_proto_rawDesc = "" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x12\n" +
 	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
 	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x16\n" +
-	"\x06status\x18\x04 \x01(\x05R\x06status\"\x86\x01\n" +
+	"\x06status\x18\x04 \x01(\x05R\x06status\"\xcc\x01\n" +
 	"\x1eGetUserPublishedActivitiesResp\x12.\n" +
 	"\x04list\x18\x01 \x03(\v2\x1a.activity.ActivityListItemR\x04list\x124\n" +
 	"\n" +
 	"pagination\x18\x02 \x01(\v2\x14.activity.PaginationR\n" +
-	"pagination\"\x9d\a\n" +
+	"pagination\x12D\n" +
+	"\x10organizer_rating\x18\x03 \x01(\v2\x19.activity.OrganizerRatingR\x0forganizerRating\"S\n" +
+	"\x0fOrganizerRating\x12\x1d\n" +
+	"\n" +
+	"rating_avg\x18\x01 \x01(\x01R\tratingAvg\x12!\n" +
+	"\frating_count\x18\x02 \x01(\x03R\vratingCount\"\x9d\a\n" +
 	"\x17CreateActivityActionReq\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12\x1b\n" +
 	"\tcover_url\x18\x02 \x01(\tR\bcoverUrl\x12\x1d\n" +
@@ -4958,7 +13473,7 @@ const file_activity_proto_rawDesc = "" +
 	"activityId\x12\x17\n" +
 	"\atag_ids\x18\x02 \x03(\x03R\x06tagIds\"8\n" +
 	"\x1cDeleteActivityCompensateResp\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess2\xc2\x0f\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess2\x8c/\n" +
 	"\x0fActivityService\x12Y\n" +
 	"\x10RegisterActivity\x12!.activity.RegisterActivityRequest\x1a\".activity.RegisterActivityResponse\x12U\n" +
 	"\x10CancelActivities\x12\x1f.activity.CancelActivityRequest\x1a .activity.CancelActivityResponse\x12V\n" +
@@ -4966,7 +13481,14 @@ const file_activity_proto_rawDesc = "" +
 	"\fVerifyTicket\x12\x1d.activity.VerifyTicketRequest\x1a\x1e.activity.VerifyTicketResponse\x12P\n" +
 	"\rGetTicketList\x12\x1e.activity.GetTicketListRequest\x1a\x1f.activity.GetTicketListResponse\x12V\n" +
 	"\x0fGetTicketDetail\x12 .activity.GetTicketDetailRequest\x1a!.activity.GetTicketDetailResponse\x12_\n" +
-	"\x12GetRegisteredCount\x12#.activity.GetRegisteredCountRequest\x1a$.activity.GetRegisteredCountResponse\x12K\n" +
+	"\x12GetRegisteredCount\x12#.activity.GetRegisteredCountRequest\x1a$.activity.GetRegisteredCountResponse\x12Z\n" +
+	"\x13SetEligibilityRules\x12 .activity.SetEligibilityRulesReq\x1a!.activity.SetEligibilityRulesResp\x12Z\n" +
+	"\x13GetEligibilityRules\x12 .activity.GetEligibilityRulesReq\x1a!.activity.GetEligibilityRulesResp\x12Q\n" +
+	"\x10CheckEligibility\x12\x1d.activity.CheckEligibilityReq\x1a\x1e.activity.CheckEligibilityResp\x12K\n" +
+	"\x0eSubmitFeedback\x12\x1b.activity.SubmitFeedbackReq\x1a\x1c.activity.SubmitFeedbackResp\x12W\n" +
+	"\x12GetFeedbackSummary\x12\x1f.activity.GetFeedbackSummaryReq\x1a .activity.GetFeedbackSummaryResp\x12Z\n" +
+	"\x13ListFeedbackReviews\x12 .activity.ListFeedbackReviewsReq\x1a!.activity.ListFeedbackReviewsResp\x12`\n" +
+	"\x15ReviewFeedbackComment\x12\".activity.ReviewFeedbackCommentReq\x1a#.activity.ReviewFeedbackCommentResp\x12K\n" +
 	"\x0eCreateActivity\x12\x1b.activity.CreateActivityReq\x1a\x1c.activity.CreateActivityResp\x12K\n" +
 	"\x0eUpdateActivity\x12\x1b.activity.UpdateActivityReq\x1a\x1c.activity.UpdateActivityResp\x12K\n" +
 	"\x0eDeleteActivity\x12\x1b.activity.DeleteActivityReq\x1a\x1c.activity.DeleteActivityResp\x12B\n" +
@@ -4975,11 +13497,51 @@ const file_activity_proto_rawDesc = "" +
 	"\x0eSubmitActivity\x12\x1b.activity.SubmitActivityReq\x1a\x1c.activity.SubmitActivityResp\x12N\n" +
 	"\x0fApproveActivity\x12\x1c.activity.ApproveActivityReq\x1a\x1d.activity.ApproveActivityResp\x12K\n" +
 	"\x0eRejectActivity\x12\x1b.activity.RejectActivityReq\x1a\x1c.activity.RejectActivityResp\x12K\n" +
-	"\x0eCancelActivity\x12\x1b.activity.CancelActivityReq\x1a\x1c.activity.CancelActivityResp\x12Q\n" +
+	"\x0eCancelActivity\x12\x1b.activity.CancelActivityReq\x1a\x1c.activity.CancelActivityResp\x12N\n" +
+	"\x0fListReviewQueue\x12\x1c.activity.ListReviewQueueReq\x1a\x1d.activity.ListReviewQueueResp\x12]\n" +
+	"\x14AssignActivityReview\x12!.activity.AssignActivityReviewReq\x1a\".activity.AssignActivityReviewResp\x12]\n" +
+	"\x14SubmitActivityChange\x12!.activity.SubmitActivityChangeReq\x1a\".activity.SubmitActivityChangeResp\x12Z\n" +
+	"\x13ListActivityChanges\x12 .activity.ListActivityChangesReq\x1a!.activity.ListActivityChangesResp\x12]\n" +
+	"\x14ReviewActivityChange\x12!.activity.ReviewActivityChangeReq\x1a\".activity.ReviewActivityChangeResp\x12]\n" +
+	"\x14CreateActivitySeries\x12!.activity.CreateActivitySeriesReq\x1a\".activity.CreateActivitySeriesResp\x12T\n" +
+	"\x11GetActivitySeries\x12\x1e.activity.GetActivitySeriesReq\x1a\x1f.activity.GetActivitySeriesResp\x12]\n" +
+	"\x14UpdateActivitySeries\x12!.activity.UpdateActivitySeriesReq\x1a\".activity.UpdateActivitySeriesResp\x12]\n" +
+	"\x14CancelActivitySeries\x12!.activity.CancelActivitySeriesReq\x1a\".activity.CancelActivitySeriesResp\x12c\n" +
+	"\x16RegisterActivitySeries\x12#.activity.RegisterActivitySeriesReq\x1a$.activity.RegisterActivitySeriesResp\x12]\n" +
+	"\x14GetCalendarFeedToken\x12!.activity.GetCalendarFeedTokenReq\x1a\".activity.GetCalendarFeedTokenResp\x12N\n" +
+	"\x0fGetCalendarFeed\x12\x1c.activity.GetCalendarFeedReq\x1a\x1d.activity.GetCalendarFeedResp\x12T\n" +
+	"\x11ExportActivityIcs\x12\x1e.activity.ExportActivityIcsReq\x1a\x1f.activity.ExportActivityIcsResp\x12K\n" +
+	"\x0eSetSelfCheckIn\x12\x1b.activity.SetSelfCheckInReq\x1a\x1c.activity.SetSelfCheckInResp\x12W\n" +
+	"\x12GetSelfCheckInCode\x12\x1f.activity.GetSelfCheckInCodeReq\x1a .activity.GetSelfCheckInCodeResp\x12B\n" +
+	"\vSelfCheckIn\x12\x18.activity.SelfCheckInReq\x1a\x19.activity.SelfCheckInResp\x12W\n" +
+	"\x12ListCheckInRecords\x12\x1f.activity.ListCheckInRecordsReq\x1a .activity.ListCheckInRecordsResp\x12I\n" +
+	"\rCloneActivity\x12\x1a.activity.CloneActivityReq\x1a\x1c.activity.CreateActivityResp\x12Y\n" +
+	"\x14SaveActivityTemplate\x12!.activity.SaveActivityTemplateReq\x1a\x1e.activity.ActivityTemplateResp\x12`\n" +
+	"\x15ListActivityTemplates\x12\".activity.ListActivityTemplatesReq\x1a#.activity.ListActivityTemplatesResp\x12c\n" +
+	"\x1aCreateActivityFromTemplate\x12'.activity.CreateActivityFromTemplateReq\x1a\x1c.activity.CreateActivityResp\x12c\n" +
+	"\x16DeleteActivityTemplate\x12#.activity.DeleteActivityTemplateReq\x1a$.activity.DeleteActivityTemplateResp\x12c\n" +
+	"\x19AdminCreatePublicTemplate\x12&.activity.AdminCreatePublicTemplateReq\x1a\x1e.activity.ActivityTemplateResp\x12W\n" +
+	"\x13AdminUpdateTemplate\x12 .activity.AdminUpdateTemplateReq\x1a\x1e.activity.ActivityTemplateResp\x12]\n" +
+	"\x14GetActivityAnalytics\x12!.activity.GetActivityAnalyticsReq\x1a\".activity.GetActivityAnalyticsResp\x12`\n" +
+	"\x15GetOrganizerAnalytics\x12\".activity.GetOrganizerAnalyticsReq\x1a#.activity.GetOrganizerAnalyticsResp\x12Q\n" +
+	"\x10FavoriteActivity\x12\x1d.activity.FavoriteActivityReq\x1a\x1e.activity.FavoriteActivityResp\x12Q\n" +
 	"\x10SearchActivities\x12\x1d.activity.SearchActivitiesReq\x1a\x1e.activity.SearchActivitiesResp\x12Q\n" +
-	"\x10GetHotActivities\x12\x1d.activity.GetHotActivitiesReq\x1a\x1e.activity.GetHotActivitiesResp\x12K\n" +
+	"\x10GetHotActivities\x12\x1d.activity.GetHotActivitiesReq\x1a\x1e.activity.GetHotActivitiesResp\x12Q\n" +
+	"\x10NearbyActivities\x12\x1d.activity.NearbyActivitiesReq\x1a\x1e.activity.NearbyActivitiesResp\x12T\n" +
+	"\x11SuggestActivities\x12\x1e.activity.SuggestActivitiesReq\x1a\x1f.activity.SuggestActivitiesResp\x12K\n" +
 	"\x0eListCategories\x12\x1b.activity.ListCategoriesReq\x1a\x1c.activity.ListCategoriesResp\x129\n" +
-	"\bListTags\x12\x15.activity.ListTagsReq\x1a\x16.activity.ListTagsResp\x12H\n" +
+	"\bListTags\x12\x15.activity.ListTagsReq\x1a\x16.activity.ListTagsResp\x12Z\n" +
+	"\x13AdminListCategories\x12 .activity.AdminListCategoriesReq\x1a!.activity.AdminListCategoriesResp\x12J\n" +
+	"\x0eCreateCategory\x12\x1b.activity.CreateCategoryReq\x1a\x1b.activity.AdminCategoryResp\x12J\n" +
+	"\x0eUpdateCategory\x12\x1b.activity.UpdateCategoryReq\x1a\x1b.activity.AdminCategoryResp\x12P\n" +
+	"\x11SetCategoryStatus\x12\x1e.activity.SetCategoryStatusReq\x1a\x1b.activity.AdminCategoryResp\x12K\n" +
+	"\x0eSortCategories\x12\x1b.activity.SortCategoriesReq\x1a\x1c.activity.SortCategoriesResp\x12K\n" +
+	"\x0eDeleteCategory\x12\x1b.activity.DeleteCategoryReq\x1a\x1c.activity.DeleteCategoryResp\x12H\n" +
+	"\rAdminListTags\x12\x1a.activity.AdminListTagsReq\x1a\x1b.activity.AdminListTagsResp\x12;\n" +
+	"\tCreateTag\x12\x16.activity.CreateTagReq\x1a\x16.activity.AdminTagResp\x12;\n" +
+	"\tUpdateTag\x12\x16.activity.UpdateTagReq\x1a\x16.activity.AdminTagResp\x12A\n" +
+	"\fSetTagStatus\x12\x19.activity.SetTagStatusReq\x1a\x16.activity.AdminTagResp\x12<\n" +
+	"\tMergeTags\x12\x16.activity.MergeTagsReq\x1a\x17.activity.MergeTagsResp\x12H\n" +
 	"\rIncrViewCount\x12\x1a.activity.IncrViewCountReq\x1a\x1b.activity.IncrViewCountResp\x12Q\n" +
 	"\x10GetActivityBasic\x12\x1d.activity.GetActivityBasicReq\x1a\x1e.activity.GetActivityBasicResp\x12`\n" +
 	"\x15BatchGetActivityBasic\x12\".activity.BatchGetActivityBasicReq\x1a#.activity.BatchGetActivityBasicResp\x12o\n" +
@@ -5003,7 +13565,7 @@ func file_activity_proto_rawDescGZIP() []byte {
 	return file_activity_proto_rawDescData
 }
 
-var file_activity_proto_msgTypes = make([]protoimpl.MessageInfo, 63)
+var file_activity_proto_msgTypes = make([]protoimpl.MessageInfo, 173)
 var file_activity_proto_goTypes = []any{
 	(*Tag)(nil),                            // 0: activity.Tag
 	(*Category)(nil),                       // 1: activity.Category
@@ -5026,125 +13588,376 @@ var file_activity_proto_goTypes = []any{
 	(*GetTicketDetailResponse)(nil),        // 18: activity.GetTicketDetailResponse
 	(*GetRegisteredCountRequest)(nil),      // 19: activity.GetRegisteredCountRequest
 	(*GetRegisteredCountResponse)(nil),     // 20: activity.GetRegisteredCountResponse
-	(*CreateActivityReq)(nil),              // 21: activity.CreateActivityReq
-	(*CreateActivityResp)(nil),             // 22: activity.CreateActivityResp
-	(*UpdateActivityReq)(nil),              // 23: activity.UpdateActivityReq
-	(*UpdateActivityResp)(nil),             // 24: activity.UpdateActivityResp
-	(*DeleteActivityReq)(nil),              // 25: activity.DeleteActivityReq
-	(*DeleteActivityResp)(nil),             // 26: activity.DeleteActivityResp
-	(*GetActivityReq)(nil),                 // 27: activity.GetActivityReq
-	(*GetActivityResp)(nil),                // 28: activity.GetActivityResp
-	(*ListActivitiesReq)(nil),              // 29: activity.ListActivitiesReq
-	(*ListActivitiesResp)(nil),             // 30: activity.ListActivitiesResp
-	(*SubmitActivityReq)(nil),              // 31: activity.SubmitActivityReq
-	(*SubmitActivityResp)(nil),             // 32: activity.SubmitActivityResp
-	(*ApproveActivityReq)(nil),             // 33: activity.ApproveActivityReq
-	(*ApproveActivityResp)(nil),            // 34: activity.ApproveActivityResp
-	(*RejectActivityReq)(nil),              // 35: activity.RejectActivityReq
-	(*RejectActivityResp)(nil),             // 36: activity.RejectActivityResp
-	(*CancelActivityReq)(nil),              // 37: activity.CancelActivityReq
-	(*CancelActivityResp)(nil),             // 38: activity.CancelActivityResp
-	(*SearchActivitiesReq)(nil),            // 39: activity.SearchActivitiesReq
-	(*SearchActivitiesResp)(nil),           // 40: activity.SearchActivitiesResp
-	(*GetHotActivitiesReq)(nil),            // 41: activity.GetHotActivitiesReq
-	(*GetHotActivitiesResp)(nil),           // 42: activity.GetHotActivitiesResp
-	(*ListCategoriesReq)(nil),              // 43: activity.ListCategoriesReq
-	(*ListCategoriesResp)(nil),             // 44: activity.ListCategoriesResp
-	(*ListTagsReq)(nil),                    // 45: activity.ListTagsReq
-	(*ListTagsResp)(nil),                   // 46: activity.ListTagsResp
-	(*IncrViewCountReq)(nil),               // 47: activity.IncrViewCountReq
-	(*IncrViewCountResp)(nil),              // 48: activity.IncrViewCountResp
-	(*GetActivityBasicReq)(nil),            // 49: activity.GetActivityBasicReq
-	(*GetActivityBasicResp)(nil),           // 50: activity.GetActivityBasicResp
-	(*BatchGetActivityBasicReq)(nil),       // 51: activity.BatchGetActivityBasicReq
-	(*BatchGetActivityBasicResp)(nil),      // 52: activity.BatchGetActivityBasicResp
-	(*GetUserPublishedActivitiesReq)(nil),  // 53: activity.GetUserPublishedActivitiesReq
-	(*GetUserPublishedActivitiesResp)(nil), // 54: activity.GetUserPublishedActivitiesResp
-	(*CreateActivityActionReq)(nil),        // 55: activity.CreateActivityActionReq
-	(*CreateActivityActionResp)(nil),       // 56: activity.CreateActivityActionResp
-	(*CreateActivityCompensateReq)(nil),    // 57: activity.CreateActivityCompensateReq
-	(*CreateActivityCompensateResp)(nil),   // 58: activity.CreateActivityCompensateResp
-	(*DeleteActivityActionReq)(nil),        // 59: activity.DeleteActivityActionReq
-	(*DeleteActivityActionResp)(nil),       // 60: activity.DeleteActivityActionResp
-	(*DeleteActivityCompensateReq)(nil),    // 61: activity.DeleteActivityCompensateReq
-	(*DeleteActivityCompensateResp)(nil),   // 62: activity.DeleteActivityCompensateResp
+	(*EligibilityRule)(nil),                // 21: activity.EligibilityRule
+	(*EligibilityCheckItem)(nil),           // 22: activity.EligibilityCheckItem
+	(*SetEligibilityRulesReq)(nil),         // 23: activity.SetEligibilityRulesReq
+	(*SetEligibilityRulesResp)(nil),        // 24: activity.SetEligibilityRulesResp
+	(*GetEligibilityRulesReq)(nil),         // 25: activity.GetEligibilityRulesReq
+	(*GetEligibilityRulesResp)(nil),        // 26: activity.GetEligibilityRulesResp
+	(*CheckEligibilityReq)(nil),            // 27: activity.CheckEligibilityReq
+	(*CheckEligibilityResp)(nil),           // 28: activity.CheckEligibilityResp
+	(*SubmitFeedbackReq)(nil),              // 29: activity.SubmitFeedbackReq
+	(*SubmitFeedbackResp)(nil),             // 30: activity.SubmitFeedbackResp
+	(*FeedbackComment)(nil),                // 31: activity.FeedbackComment
+	(*GetFeedbackSummaryReq)(nil),          // 32: activity.GetFeedbackSummaryReq
+	(*GetFeedbackSummaryResp)(nil),         // 33: activity.GetFeedbackSummaryResp
+	(*ListFeedbackReviewsReq)(nil),         // 34: activity.ListFeedbackReviewsReq
+	(*FeedbackReviewItem)(nil),             // 35: activity.FeedbackReviewItem
+	(*ListFeedbackReviewsResp)(nil),        // 36: activity.ListFeedbackReviewsResp
+	(*ReviewFeedbackCommentReq)(nil),       // 37: activity.ReviewFeedbackCommentReq
+	(*ReviewFeedbackCommentResp)(nil),      // 38: activity.ReviewFeedbackCommentResp
+	(*CreateActivityReq)(nil),              // 39: activity.CreateActivityReq
+	(*CreateActivityResp)(nil),             // 40: activity.CreateActivityResp
+	(*UpdateActivityReq)(nil),              // 41: activity.UpdateActivityReq
+	(*UpdateActivityResp)(nil),             // 42: activity.UpdateActivityResp
+	(*DeleteActivityReq)(nil),              // 43: activity.DeleteActivityReq
+	(*DeleteActivityResp)(nil),             // 44: activity.DeleteActivityResp
+	(*GetActivityReq)(nil),                 // 45: activity.GetActivityReq
+	(*GetActivityResp)(nil),                // 46: activity.GetActivityResp
+	(*ListActivitiesReq)(nil),              // 47: activity.ListActivitiesReq
+	(*ListActivitiesResp)(nil),             // 48: activity.ListActivitiesResp
+	(*SubmitActivityReq)(nil),              // 49: activity.SubmitActivityReq
+	(*SubmitActivityResp)(nil),             // 50: activity.SubmitActivityResp
+	(*ApproveActivityReq)(nil),             // 51: activity.ApproveActivityReq
+	(*ApproveActivityResp)(nil),            // 52: activity.ApproveActivityResp
+	(*RejectActivityReq)(nil),              // 53: activity.RejectActivityReq
+	(*RejectActivityResp)(nil),             // 54: activity.RejectActivityResp
+	(*ListReviewQueueReq)(nil),             // 55: activity.ListReviewQueueReq
+	(*ReviewFieldDiff)(nil),                // 56: activity.ReviewFieldDiff
+	(*ReviewQueueItem)(nil),                // 57: activity.ReviewQueueItem
+	(*ListReviewQueueResp)(nil),            // 58: activity.ListReviewQueueResp
+	(*AssignActivityReviewReq)(nil),        // 59: activity.AssignActivityReviewReq
+	(*AssignActivityReviewResp)(nil),       // 60: activity.AssignActivityReviewResp
+	(*CancelActivityReq)(nil),              // 61: activity.CancelActivityReq
+	(*CancelActivityResp)(nil),             // 62: activity.CancelActivityResp
+	(*SubmitActivityChangeReq)(nil),        // 63: activity.SubmitActivityChangeReq
+	(*SubmitActivityChangeResp)(nil),       // 64: activity.SubmitActivityChangeResp
+	(*ActivityChangeInfo)(nil),             // 65: activity.ActivityChangeInfo
+	(*ListActivityChangesReq)(nil),         // 66: activity.ListActivityChangesReq
+	(*ListActivityChangesResp)(nil),        // 67: activity.ListActivityChangesResp
+	(*ReviewActivityChangeReq)(nil),        // 68: activity.ReviewActivityChangeReq
+	(*ReviewActivityChangeResp)(nil),       // 69: activity.ReviewActivityChangeResp
+	(*FavoriteActivityReq)(nil),            // 70: activity.FavoriteActivityReq
+	(*FavoriteActivityResp)(nil),           // 71: activity.FavoriteActivityResp
+	(*CreateActivitySeriesReq)(nil),        // 72: activity.CreateActivitySeriesReq
+	(*CreateActivitySeriesResp)(nil),       // 73: activity.CreateActivitySeriesResp
+	(*SeriesOccurrence)(nil),               // 74: activity.SeriesOccurrence
+	(*ActivitySeriesInfo)(nil),             // 75: activity.ActivitySeriesInfo
+	(*GetActivitySeriesReq)(nil),           // 76: activity.GetActivitySeriesReq
+	(*GetActivitySeriesResp)(nil),          // 77: activity.GetActivitySeriesResp
+	(*UpdateActivitySeriesReq)(nil),        // 78: activity.UpdateActivitySeriesReq
+	(*SeriesSkippedOccurrence)(nil),        // 79: activity.SeriesSkippedOccurrence
+	(*UpdateActivitySeriesResp)(nil),       // 80: activity.UpdateActivitySeriesResp
+	(*CancelActivitySeriesReq)(nil),        // 81: activity.CancelActivitySeriesReq
+	(*CancelActivitySeriesResp)(nil),       // 82: activity.CancelActivitySeriesResp
+	(*RegisterActivitySeriesReq)(nil),      // 83: activity.RegisterActivitySeriesReq
+	(*RegisterActivitySeriesResp)(nil),     // 84: activity.RegisterActivitySeriesResp
+	(*GetCalendarFeedTokenReq)(nil),        // 85: activity.GetCalendarFeedTokenReq
+	(*GetCalendarFeedTokenResp)(nil),       // 86: activity.GetCalendarFeedTokenResp
+	(*GetCalendarFeedReq)(nil),             // 87: activity.GetCalendarFeedReq
+	(*GetCalendarFeedResp)(nil),            // 88: activity.GetCalendarFeedResp
+	(*ExportActivityIcsReq)(nil),           // 89: activity.ExportActivityIcsReq
+	(*ExportActivityIcsResp)(nil),          // 90: activity.ExportActivityIcsResp
+	(*SetSelfCheckInReq)(nil),              // 91: activity.SetSelfCheckInReq
+	(*SetSelfCheckInResp)(nil),             // 92: activity.SetSelfCheckInResp
+	(*GetSelfCheckInCodeReq)(nil),          // 93: activity.GetSelfCheckInCodeReq
+	(*GetSelfCheckInCodeResp)(nil),         // 94: activity.GetSelfCheckInCodeResp
+	(*SelfCheckInReq)(nil),                 // 95: activity.SelfCheckInReq
+	(*SelfCheckInResp)(nil),                // 96: activity.SelfCheckInResp
+	(*ListCheckInRecordsReq)(nil),          // 97: activity.ListCheckInRecordsReq
+	(*CheckInRecordItem)(nil),              // 98: activity.CheckInRecordItem
+	(*ListCheckInRecordsResp)(nil),         // 99: activity.ListCheckInRecordsResp
+	(*ActivityTemplate)(nil),               // 100: activity.ActivityTemplate
+	(*ActivityTemplateResp)(nil),           // 101: activity.ActivityTemplateResp
+	(*CloneActivityReq)(nil),               // 102: activity.CloneActivityReq
+	(*SaveActivityTemplateReq)(nil),        // 103: activity.SaveActivityTemplateReq
+	(*ListActivityTemplatesReq)(nil),       // 104: activity.ListActivityTemplatesReq
+	(*ListActivityTemplatesResp)(nil),      // 105: activity.ListActivityTemplatesResp
+	(*CreateActivityFromTemplateReq)(nil),  // 106: activity.CreateActivityFromTemplateReq
+	(*DeleteActivityTemplateReq)(nil),      // 107: activity.DeleteActivityTemplateReq
+	(*DeleteActivityTemplateResp)(nil),     // 108: activity.DeleteActivityTemplateResp
+	(*AdminCreatePublicTemplateReq)(nil),   // 109: activity.AdminCreatePublicTemplateReq
+	(*AdminUpdateTemplateReq)(nil),         // 110: activity.AdminUpdateTemplateReq
+	(*AnalyticsFunnel)(nil),                // 111: activity.AnalyticsFunnel
+	(*RegistrationVelocity)(nil),           // 112: activity.RegistrationVelocity
+	(*AnalyticsDaily)(nil),                 // 113: activity.AnalyticsDaily
+	(*AudienceBucket)(nil),                 // 114: activity.AudienceBucket
+	(*AudienceBreakdown)(nil),              // 115: activity.AudienceBreakdown
+	(*GetActivityAnalyticsReq)(nil),        // 116: activity.GetActivityAnalyticsReq
+	(*GetActivityAnalyticsResp)(nil),       // 117: activity.GetActivityAnalyticsResp
+	(*ActivityAnalyticsBrief)(nil),         // 118: activity.ActivityAnalyticsBrief
+	(*GetOrganizerAnalyticsReq)(nil),       // 119: activity.GetOrganizerAnalyticsReq
+	(*GetOrganizerAnalyticsResp)(nil),      // 120: activity.GetOrganizerAnalyticsResp
+	(*SearchActivitiesReq)(nil),            // 121: activity.SearchActivitiesReq
+	(*SearchActivitiesResp)(nil),           // 122: activity.SearchActivitiesResp
+	(*FacetBucket)(nil),                    // 123: activity.FacetBucket
+	(*SearchFacets)(nil),                   // 124: activity.SearchFacets
+	(*GetHotActivitiesReq)(nil),            // 125: activity.GetHotActivitiesReq
+	(*GetHotActivitiesResp)(nil),           // 126: activity.GetHotActivitiesResp
+	(*NearbyActivitiesReq)(nil),            // 127: activity.NearbyActivitiesReq
+	(*NearbyActivitiesResp)(nil),           // 128: activity.NearbyActivitiesResp
+	(*SuggestActivitiesReq)(nil),           // 129: activity.SuggestActivitiesReq
+	(*SuggestActivitiesResp)(nil),          // 130: activity.SuggestActivitiesResp
+	(*ListCategoriesReq)(nil),              // 131: activity.ListCategoriesReq
+	(*ListCategoriesResp)(nil),             // 132: activity.ListCategoriesResp
+	(*ListTagsReq)(nil),                    // 133: activity.ListTagsReq
+	(*ListTagsResp)(nil),                   // 134: activity.ListTagsResp
+	(*AdminCategory)(nil),                  // 135: activity.AdminCategory
+	(*AdminListCategoriesReq)(nil),         // 136: activity.AdminListCategoriesReq
+	(*AdminListCategoriesResp)(nil),        // 137: activity.AdminListCategoriesResp
+	(*CreateCategoryReq)(nil),              // 138: activity.CreateCategoryReq
+	(*UpdateCategoryReq)(nil),              // 139: activity.UpdateCategoryReq
+	(*SetCategoryStatusReq)(nil),           // 140: activity.SetCategoryStatusReq
+	(*AdminCategoryResp)(nil),              // 141: activity.AdminCategoryResp
+	(*CategorySortItem)(nil),               // 142: activity.CategorySortItem
+	(*SortCategoriesReq)(nil),              // 143: activity.SortCategoriesReq
+	(*SortCategoriesResp)(nil),             // 144: activity.SortCategoriesResp
+	(*DeleteCategoryReq)(nil),              // 145: activity.DeleteCategoryReq
+	(*DeleteCategoryResp)(nil),             // 146: activity.DeleteCategoryResp
+	(*AdminTag)(nil),                       // 147: activity.AdminTag
+	(*AdminListTagsReq)(nil),               // 148: activity.AdminListTagsReq
+	(*AdminListTagsResp)(nil),              // 149: activity.AdminListTagsResp
+	(*CreateTagReq)(nil),                   // 150: activity.CreateTagReq
+	(*UpdateTagReq)(nil),                   // 151: activity.UpdateTagReq
+	(*SetTagStatusReq)(nil),                // 152: activity.SetTagStatusReq
+	(*AdminTagResp)(nil),                   // 153: activity.AdminTagResp
+	(*MergeTagsReq)(nil),                   // 154: activity.MergeTagsReq
+	(*MergeTagsResp)(nil),                  // 155: activity.MergeTagsResp
+	(*IncrViewCountReq)(nil),               // 156: activity.IncrViewCountReq
+	(*IncrViewCountResp)(nil),              // 157: activity.IncrViewCountResp
+	(*GetActivityBasicReq)(nil),            // 158: activity.GetActivityBasicReq
+	(*GetActivityBasicResp)(nil),           // 159: activity.GetActivityBasicResp
+	(*BatchGetActivityBasicReq)(nil),       // 160: activity.BatchGetActivityBasicReq
+	(*BatchGetActivityBasicResp)(nil),      // 161: activity.BatchGetActivityBasicResp
+	(*GetUserPublishedActivitiesReq)(nil),  // 162: activity.GetUserPublishedActivitiesReq
+	(*GetUserPublishedActivitiesResp)(nil), // 163: activity.GetUserPublishedActivitiesResp
+	(*OrganizerRating)(nil),                // 164: activity.OrganizerRating
+	(*CreateActivityActionReq)(nil),        // 165: activity.CreateActivityActionReq
+	(*CreateActivityActionResp)(nil),       // 166: activity.CreateActivityActionResp
+	(*CreateActivityCompensateReq)(nil),    // 167: activity.CreateActivityCompensateReq
+	(*CreateActivityCompensateResp)(nil),   // 168: activity.CreateActivityCompensateResp
+	(*DeleteActivityActionReq)(nil),        // 169: activity.DeleteActivityActionReq
+	(*DeleteActivityActionResp)(nil),       // 170: activity.DeleteActivityActionResp
+	(*DeleteActivityCompensateReq)(nil),    // 171: activity.DeleteActivityCompensateReq
+	(*DeleteActivityCompensateResp)(nil),   // 172: activity.DeleteActivityCompensateResp
 }
 var file_activity_proto_depIdxs = []int32{
-	0,  // 0: activity.ActivityDetail.tags:type_name -> activity.Tag
-	0,  // 1: activity.ActivityListItem.tags:type_name -> activity.Tag
-	11, // 2: activity.GetActivityListResponse.items:type_name -> activity.ActivityListItems
-	16, // 3: activity.GetTicketListResponse.items:type_name -> activity.TicketListItem
-	3,  // 4: activity.GetActivityResp.activity:type_name -> activity.ActivityDetail
-	4,  // 5: activity.ListActivitiesResp.list:type_name -> activity.ActivityListItem
-	2,  // 6: activity.ListActivitiesResp.pagination:type_name -> activity.Pagination
-	4,  // 7: activity.SearchActivitiesResp.list:type_name -> activity.ActivityListItem
-	4,  // 8: activity.GetHotActivitiesResp.list:type_name -> activity.ActivityListItem
-	1,  // 9: activity.ListCategoriesResp.list:type_name -> activity.Category
-	0,  // 10: activity.ListTagsResp.list:type_name -> activity.Tag
-	50, // 11: activity.BatchGetActivityBasicResp.activities:type_name -> activity.GetActivityBasicResp
-	4,  // 12: activity.GetUserPublishedActivitiesResp.list:type_name -> activity.ActivityListItem
-	2,  // 13: activity.GetUserPublishedActivitiesResp.pagination:type_name -> activity.Pagination
-	5,  // 14: activity.ActivityService.RegisterActivity:input_type -> activity.RegisterActivityRequest
-	7,  // 15: activity.ActivityService.CancelActivities:input_type -> activity.CancelActivityRequest
-	9,  // 16: activity.ActivityService.GetActivityList:input_type -> activity.GetActivityListRequest
-	12, // 17: activity.ActivityService.VerifyTicket:input_type -> activity.VerifyTicketRequest
-	14, // 18: activity.ActivityService.GetTicketList:input_type -> activity.GetTicketListRequest
-	17, // 19: activity.ActivityService.GetTicketDetail:input_type -> activity.GetTicketDetailRequest
-	19, // 20: activity.ActivityService.GetRegisteredCount:input_type -> activity.GetRegisteredCountRequest
-	21, // 21: activity.ActivityService.CreateActivity:input_type -> activity.CreateActivityReq
-	23, // 22: activity.ActivityService.UpdateActivity:input_type -> activity.UpdateActivityReq
-	25, // 23: activity.ActivityService.DeleteActivity:input_type -> activity.DeleteActivityReq
-	27, // 24: activity.ActivityService.GetActivity:input_type -> activity.GetActivityReq
-	29, // 25: activity.ActivityService.ListActivities:input_type -> activity.ListActivitiesReq
-	31, // 26: activity.ActivityService.SubmitActivity:input_type -> activity.SubmitActivityReq
-	33, // 27: activity.ActivityService.ApproveActivity:input_type -> activity.ApproveActivityReq
-	35, // 28: activity.ActivityService.RejectActivity:input_type -> activity.RejectActivityReq
-	37, // 29: activity.ActivityService.CancelActivity:input_type -> activity.CancelActivityReq
-	39, // 30: activity.ActivityService.SearchActivities:input_type -> activity.SearchActivitiesReq
-	41, // 31: activity.ActivityService.GetHotActivities:input_type -> activity.GetHotActivitiesReq
-	43, // 32: activity.ActivityService.ListCategories:input_type -> activity.ListCategoriesReq
-	45, // 33: activity.ActivityService.ListTags:input_type -> activity.ListTagsReq
-	47, // 34: activity.ActivityService.IncrViewCount:input_type -> activity.IncrViewCountReq
-	49, // 35: activity.ActivityService.GetActivityBasic:input_type -> activity.GetActivityBasicReq
-	51, // 36: activity.ActivityService.BatchGetActivityBasic:input_type -> activity.BatchGetActivityBasicReq
-	53, // 37: activity.ActivityService.GetUserPublishedActivities:input_type -> activity.GetUserPublishedActivitiesReq
-	55, // 38: activity.ActivityBranchService.CreateActivityAction:input_type -> activity.CreateActivityActionReq
-	57, // 39: activity.ActivityBranchService.CreateActivityCompensate:input_type -> activity.CreateActivityCompensateReq
-	59, // 40: activity.ActivityBranchService.DeleteActivityAction:input_type -> activity.DeleteActivityActionReq
-	61, // 41: activity.ActivityBranchService.DeleteActivityCompensate:input_type -> activity.DeleteActivityCompensateReq
-	6,  // 42: activity.ActivityService.RegisterActivity:output_type -> activity.RegisterActivityResponse
-	8,  // 43: activity.ActivityService.CancelActivities:output_type -> activity.CancelActivityResponse
-	10, // 44: activity.ActivityService.GetActivityList:output_type -> activity.GetActivityListResponse
-	13, // 45: activity.ActivityService.VerifyTicket:output_type -> activity.VerifyTicketResponse
-	15, // 46: activity.ActivityService.GetTicketList:output_type -> activity.GetTicketListResponse
-	18, // 47: activity.ActivityService.GetTicketDetail:output_type -> activity.GetTicketDetailResponse
-	20, // 48: activity.ActivityService.GetRegisteredCount:output_type -> activity.GetRegisteredCountResponse
-	22, // 49: activity.ActivityService.CreateActivity:output_type -> activity.CreateActivityResp
-	24, // 50: activity.ActivityService.UpdateActivity:output_type -> activity.UpdateActivityResp
-	26, // 51: activity.ActivityService.DeleteActivity:output_type -> activity.DeleteActivityResp
-	28, // 52: activity.ActivityService.GetActivity:output_type -> activity.GetActivityResp
-	30, // 53: activity.ActivityService.ListActivities:output_type -> activity.ListActivitiesResp
-	32, // 54: activity.ActivityService.SubmitActivity:output_type -> activity.SubmitActivityResp
-	34, // 55: activity.ActivityService.ApproveActivity:output_type -> activity.ApproveActivityResp
-	36, // 56: activity.ActivityService.RejectActivity:output_type -> activity.RejectActivityResp
-	38, // 57: activity.ActivityService.CancelActivity:output_type -> activity.CancelActivityResp
-	40, // 58: activity.ActivityService.SearchActivities:output_type -> activity.SearchActivitiesResp
-	42, // 59: activity.ActivityService.GetHotActivities:output_type -> activity.GetHotActivitiesResp
-	44, // 60: activity.ActivityService.ListCategories:output_type -> activity.ListCategoriesResp
-	46, // 61: activity.ActivityService.ListTags:output_type -> activity.ListTagsResp
-	48, // 62: activity.ActivityService.IncrViewCount:output_type -> activity.IncrViewCountResp
-	50, // 63: activity.ActivityService.GetActivityBasic:output_type -> activity.GetActivityBasicResp
-	52, // 64: activity.ActivityService.BatchGetActivityBasic:output_type -> activity.BatchGetActivityBasicResp
-	54, // 65: activity.ActivityService.GetUserPublishedActivities:output_type -> activity.GetUserPublishedActivitiesResp
-	56, // 66: activity.ActivityBranchService.CreateActivityAction:output_type -> activity.CreateActivityActionResp
-	58, // 67: activity.ActivityBranchService.CreateActivityCompensate:output_type -> activity.CreateActivityCompensateResp
-	60, // 68: activity.ActivityBranchService.DeleteActivityAction:output_type -> activity.DeleteActivityActionResp
-	62, // 69: activity.ActivityBranchService.DeleteActivityCompensate:output_type -> activity.DeleteActivityCompensateResp
-	42, // [42:70] is the sub-list for method output_type
-	14, // [14:42] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	0,   // 0: activity.ActivityDetail.tags:type_name -> activity.Tag
+	0,   // 1: activity.ActivityListItem.tags:type_name -> activity.Tag
+	22,  // 2: activity.RegisterActivityResponse.failed_rules:type_name -> activity.EligibilityCheckItem
+	11,  // 3: activity.GetActivityListResponse.items:type_name -> activity.ActivityListItems
+	16,  // 4: activity.GetTicketListResponse.items:type_name -> activity.TicketListItem
+	21,  // 5: activity.SetEligibilityRulesReq.rules:type_name -> activity.EligibilityRule
+	21,  // 6: activity.SetEligibilityRulesResp.rules:type_name -> activity.EligibilityRule
+	21,  // 7: activity.GetEligibilityRulesResp.rules:type_name -> activity.EligibilityRule
+	22,  // 8: activity.CheckEligibilityResp.items:type_name -> activity.EligibilityCheckItem
+	31,  // 9: activity.GetFeedbackSummaryResp.comments:type_name -> activity.FeedbackComment
+	2,   // 10: activity.GetFeedbackSummaryResp.pagination:type_name -> activity.Pagination
+	35,  // 11: activity.ListFeedbackReviewsResp.list:type_name -> activity.FeedbackReviewItem
+	2,   // 12: activity.ListFeedbackReviewsResp.pagination:type_name -> activity.Pagination
+	3,   // 13: activity.GetActivityResp.activity:type_name -> activity.ActivityDetail
+	4,   // 14: activity.ListActivitiesResp.list:type_name -> activity.ActivityListItem
+	2,   // 15: activity.ListActivitiesResp.pagination:type_name -> activity.Pagination
+	56,  // 16: activity.ReviewQueueItem.diffs:type_name -> activity.ReviewFieldDiff
+	57,  // 17: activity.ListReviewQueueResp.list:type_name -> activity.ReviewQueueItem
+	2,   // 18: activity.ListReviewQueueResp.pagination:type_name -> activity.Pagination
+	56,  // 19: activity.ActivityChangeInfo.changes:type_name -> activity.ReviewFieldDiff
+	65,  // 20: activity.ListActivityChangesResp.list:type_name -> activity.ActivityChangeInfo
+	2,   // 21: activity.ListActivityChangesResp.pagination:type_name -> activity.Pagination
+	39,  // 22: activity.CreateActivitySeriesReq.template:type_name -> activity.CreateActivityReq
+	75,  // 23: activity.GetActivitySeriesResp.series:type_name -> activity.ActivitySeriesInfo
+	74,  // 24: activity.GetActivitySeriesResp.occurrences:type_name -> activity.SeriesOccurrence
+	79,  // 25: activity.UpdateActivitySeriesResp.skipped:type_name -> activity.SeriesSkippedOccurrence
+	79,  // 26: activity.CancelActivitySeriesResp.skipped:type_name -> activity.SeriesSkippedOccurrence
+	98,  // 27: activity.ListCheckInRecordsResp.list:type_name -> activity.CheckInRecordItem
+	2,   // 28: activity.ListCheckInRecordsResp.pagination:type_name -> activity.Pagination
+	100, // 29: activity.ActivityTemplateResp.template:type_name -> activity.ActivityTemplate
+	100, // 30: activity.ListActivityTemplatesResp.list:type_name -> activity.ActivityTemplate
+	2,   // 31: activity.ListActivityTemplatesResp.pagination:type_name -> activity.Pagination
+	114, // 32: activity.AudienceBreakdown.departments:type_name -> activity.AudienceBucket
+	114, // 33: activity.AudienceBreakdown.admission_years:type_name -> activity.AudienceBucket
+	111, // 34: activity.GetActivityAnalyticsResp.funnel:type_name -> activity.AnalyticsFunnel
+	112, // 35: activity.GetActivityAnalyticsResp.velocity:type_name -> activity.RegistrationVelocity
+	113, // 36: activity.GetActivityAnalyticsResp.daily:type_name -> activity.AnalyticsDaily
+	115, // 37: activity.GetActivityAnalyticsResp.audience:type_name -> activity.AudienceBreakdown
+	111, // 38: activity.GetOrganizerAnalyticsResp.funnel:type_name -> activity.AnalyticsFunnel
+	113, // 39: activity.GetOrganizerAnalyticsResp.daily:type_name -> activity.AnalyticsDaily
+	115, // 40: activity.GetOrganizerAnalyticsResp.audience:type_name -> activity.AudienceBreakdown
+	118, // 41: activity.GetOrganizerAnalyticsResp.top_activities:type_name -> activity.ActivityAnalyticsBrief
+	4,   // 42: activity.SearchActivitiesResp.list:type_name -> activity.ActivityListItem
+	124, // 43: activity.SearchActivitiesResp.facets:type_name -> activity.SearchFacets
+	123, // 44: activity.SearchFacets.categories:type_name -> activity.FacetBucket
+	123, // 45: activity.SearchFacets.tags:type_name -> activity.FacetBucket
+	123, // 46: activity.SearchFacets.statuses:type_name -> activity.FacetBucket
+	4,   // 47: activity.GetHotActivitiesResp.list:type_name -> activity.ActivityListItem
+	4,   // 48: activity.NearbyActivitiesResp.list:type_name -> activity.ActivityListItem
+	1,   // 49: activity.ListCategoriesResp.list:type_name -> activity.Category
+	0,   // 50: activity.ListTagsResp.list:type_name -> activity.Tag
+	135, // 51: activity.AdminListCategoriesResp.list:type_name -> activity.AdminCategory
+	135, // 52: activity.AdminCategoryResp.category:type_name -> activity.AdminCategory
+	142, // 53: activity.SortCategoriesReq.items:type_name -> activity.CategorySortItem
+	147, // 54: activity.AdminListTagsResp.list:type_name -> activity.AdminTag
+	147, // 55: activity.AdminTagResp.tag:type_name -> activity.AdminTag
+	147, // 56: activity.MergeTagsResp.target:type_name -> activity.AdminTag
+	159, // 57: activity.BatchGetActivityBasicResp.activities:type_name -> activity.GetActivityBasicResp
+	4,   // 58: activity.GetUserPublishedActivitiesResp.list:type_name -> activity.ActivityListItem
+	2,   // 59: activity.GetUserPublishedActivitiesResp.pagination:type_name -> activity.Pagination
+	164, // 60: activity.GetUserPublishedActivitiesResp.organizer_rating:type_name -> activity.OrganizerRating
+	5,   // 61: activity.ActivityService.RegisterActivity:input_type -> activity.RegisterActivityRequest
+	7,   // 62: activity.ActivityService.CancelActivities:input_type -> activity.CancelActivityRequest
+	9,   // 63: activity.ActivityService.GetActivityList:input_type -> activity.GetActivityListRequest
+	12,  // 64: activity.ActivityService.VerifyTicket:input_type -> activity.VerifyTicketRequest
+	14,  // 65: activity.ActivityService.GetTicketList:input_type -> activity.GetTicketListRequest
+	17,  // 66: activity.ActivityService.GetTicketDetail:input_type -> activity.GetTicketDetailRequest
+	19,  // 67: activity.ActivityService.GetRegisteredCount:input_type -> activity.GetRegisteredCountRequest
+	23,  // 68: activity.ActivityService.SetEligibilityRules:input_type -> activity.SetEligibilityRulesReq
+	25,  // 69: activity.ActivityService.GetEligibilityRules:input_type -> activity.GetEligibilityRulesReq
+	27,  // 70: activity.ActivityService.CheckEligibility:input_type -> activity.CheckEligibilityReq
+	29,  // 71: activity.ActivityService.SubmitFeedback:input_type -> activity.SubmitFeedbackReq
+	32,  // 72: activity.ActivityService.GetFeedbackSummary:input_type -> activity.GetFeedbackSummaryReq
+	34,  // 73: activity.ActivityService.ListFeedbackReviews:input_type -> activity.ListFeedbackReviewsReq
+	37,  // 74: activity.ActivityService.ReviewFeedbackComment:input_type -> activity.ReviewFeedbackCommentReq
+	39,  // 75: activity.ActivityService.CreateActivity:input_type -> activity.CreateActivityReq
+	41,  // 76: activity.ActivityService.UpdateActivity:input_type -> activity.UpdateActivityReq
+	43,  // 77: activity.ActivityService.DeleteActivity:input_type -> activity.DeleteActivityReq
+	45,  // 78: activity.ActivityService.GetActivity:input_type -> activity.GetActivityReq
+	47,  // 79: activity.ActivityService.ListActivities:input_type -> activity.ListActivitiesReq
+	49,  // 80: activity.ActivityService.SubmitActivity:input_type -> activity.SubmitActivityReq
+	51,  // 81: activity.ActivityService.ApproveActivity:input_type -> activity.ApproveActivityReq
+	53,  // 82: activity.ActivityService.RejectActivity:input_type -> activity.RejectActivityReq
+	61,  // 83: activity.ActivityService.CancelActivity:input_type -> activity.CancelActivityReq
+	55,  // 84: activity.ActivityService.ListReviewQueue:input_type -> activity.ListReviewQueueReq
+	59,  // 85: activity.ActivityService.AssignActivityReview:input_type -> activity.AssignActivityReviewReq
+	63,  // 86: activity.ActivityService.SubmitActivityChange:input_type -> activity.SubmitActivityChangeReq
+	66,  // 87: activity.ActivityService.ListActivityChanges:input_type -> activity.ListActivityChangesReq
+	68,  // 88: activity.ActivityService.ReviewActivityChange:input_type -> activity.ReviewActivityChangeReq
+	72,  // 89: activity.ActivityService.CreateActivitySeries:input_type -> activity.CreateActivitySeriesReq
+	76,  // 90: activity.ActivityService.GetActivitySeries:input_type -> activity.GetActivitySeriesReq
+	78,  // 91: activity.ActivityService.UpdateActivitySeries:input_type -> activity.UpdateActivitySeriesReq
+	81,  // 92: activity.ActivityService.CancelActivitySeries:input_type -> activity.CancelActivitySeriesReq
+	83,  // 93: activity.ActivityService.RegisterActivitySeries:input_type -> activity.RegisterActivitySeriesReq
+	85,  // 94: activity.ActivityService.GetCalendarFeedToken:input_type -> activity.GetCalendarFeedTokenReq
+	87,  // 95: activity.ActivityService.GetCalendarFeed:input_type -> activity.GetCalendarFeedReq
+	89,  // 96: activity.ActivityService.ExportActivityIcs:input_type -> activity.ExportActivityIcsReq
+	91,  // 97: activity.ActivityService.SetSelfCheckIn:input_type -> activity.SetSelfCheckInReq
+	93,  // 98: activity.ActivityService.GetSelfCheckInCode:input_type -> activity.GetSelfCheckInCodeReq
+	95,  // 99: activity.ActivityService.SelfCheckIn:input_type -> activity.SelfCheckInReq
+	97,  // 100: activity.ActivityService.ListCheckInRecords:input_type -> activity.ListCheckInRecordsReq
+	102, // 101: activity.ActivityService.CloneActivity:input_type -> activity.CloneActivityReq
+	103, // 102: activity.ActivityService.SaveActivityTemplate:input_type -> activity.SaveActivityTemplateReq
+	104, // 103: activity.ActivityService.ListActivityTemplates:input_type -> activity.ListActivityTemplatesReq
+	106, // 104: activity.ActivityService.CreateActivityFromTemplate:input_type -> activity.CreateActivityFromTemplateReq
+	107, // 105: activity.ActivityService.DeleteActivityTemplate:input_type -> activity.DeleteActivityTemplateReq
+	109, // 106: activity.ActivityService.AdminCreatePublicTemplate:input_type -> activity.AdminCreatePublicTemplateReq
+	110, // 107: activity.ActivityService.AdminUpdateTemplate:input_type -> activity.AdminUpdateTemplateReq
+	116, // 108: activity.ActivityService.GetActivityAnalytics:input_type -> activity.GetActivityAnalyticsReq
+	119, // 109: activity.ActivityService.GetOrganizerAnalytics:input_type -> activity.GetOrganizerAnalyticsReq
+	70,  // 110: activity.ActivityService.FavoriteActivity:input_type -> activity.FavoriteActivityReq
+	121, // 111: activity.ActivityService.SearchActivities:input_type -> activity.SearchActivitiesReq
+	125, // 112: activity.ActivityService.GetHotActivities:input_type -> activity.GetHotActivitiesReq
+	127, // 113: activity.ActivityService.NearbyActivities:input_type -> activity.NearbyActivitiesReq
+	129, // 114: activity.ActivityService.SuggestActivities:input_type -> activity.SuggestActivitiesReq
+	131, // 115: activity.ActivityService.ListCategories:input_type -> activity.ListCategoriesReq
+	133, // 116: activity.ActivityService.ListTags:input_type -> activity.ListTagsReq
+	136, // 117: activity.ActivityService.AdminListCategories:input_type -> activity.AdminListCategoriesReq
+	138, // 118: activity.ActivityService.CreateCategory:input_type -> activity.CreateCategoryReq
+	139, // 119: activity.ActivityService.UpdateCategory:input_type -> activity.UpdateCategoryReq
+	140, // 120: activity.ActivityService.SetCategoryStatus:input_type -> activity.SetCategoryStatusReq
+	143, // 121: activity.ActivityService.SortCategories:input_type -> activity.SortCategoriesReq
+	145, // 122: activity.ActivityService.DeleteCategory:input_type -> activity.DeleteCategoryReq
+	148, // 123: activity.ActivityService.AdminListTags:input_type -> activity.AdminListTagsReq
+	150, // 124: activity.ActivityService.CreateTag:input_type -> activity.CreateTagReq
+	151, // 125: activity.ActivityService.UpdateTag:input_type -> activity.UpdateTagReq
+	152, // 126: activity.ActivityService.SetTagStatus:input_type -> activity.SetTagStatusReq
+	154, // 127: activity.ActivityService.MergeTags:input_type -> activity.MergeTagsReq
+	156, // 128: activity.ActivityService.IncrViewCount:input_type -> activity.IncrViewCountReq
+	158, // 129: activity.ActivityService.GetActivityBasic:input_type -> activity.GetActivityBasicReq
+	160, // 130: activity.ActivityService.BatchGetActivityBasic:input_type -> activity.BatchGetActivityBasicReq
+	162, // 131: activity.ActivityService.GetUserPublishedActivities:input_type -> activity.GetUserPublishedActivitiesReq
+	165, // 132: activity.ActivityBranchService.CreateActivityAction:input_type -> activity.CreateActivityActionReq
+	167, // 133: activity.ActivityBranchService.CreateActivityCompensate:input_type -> activity.CreateActivityCompensateReq
+	169, // 134: activity.ActivityBranchService.DeleteActivityAction:input_type -> activity.DeleteActivityActionReq
+	171, // 135: activity.ActivityBranchService.DeleteActivityCompensate:input_type -> activity.DeleteActivityCompensateReq
+	6,   // 136: activity.ActivityService.RegisterActivity:output_type -> activity.RegisterActivityResponse
+	8,   // 137: activity.ActivityService.CancelActivities:output_type -> activity.CancelActivityResponse
+	10,  // 138: activity.ActivityService.GetActivityList:output_type -> activity.GetActivityListResponse
+	13,  // 139: activity.ActivityService.VerifyTicket:output_type -> activity.VerifyTicketResponse
+	15,  // 140: activity.ActivityService.GetTicketList:output_type -> activity.GetTicketListResponse
+	18,  // 141: activity.ActivityService.GetTicketDetail:output_type -> activity.GetTicketDetailResponse
+	20,  // 142: activity.ActivityService.GetRegisteredCount:output_type -> activity.GetRegisteredCountResponse
+	24,  // 143: activity.ActivityService.SetEligibilityRules:output_type -> activity.SetEligibilityRulesResp
+	26,  // 144: activity.ActivityService.GetEligibilityRules:output_type -> activity.GetEligibilityRulesResp
+	28,  // 145: activity.ActivityService.CheckEligibility:output_type -> activity.CheckEligibilityResp
+	30,  // 146: activity.ActivityService.SubmitFeedback:output_type -> activity.SubmitFeedbackResp
+	33,  // 147: activity.ActivityService.GetFeedbackSummary:output_type -> activity.GetFeedbackSummaryResp
+	36,  // 148: activity.ActivityService.ListFeedbackReviews:output_type -> activity.ListFeedbackReviewsResp
+	38,  // 149: activity.ActivityService.ReviewFeedbackComment:output_type -> activity.ReviewFeedbackCommentResp
+	40,  // 150: activity.ActivityService.CreateActivity:output_type -> activity.CreateActivityResp
+	42,  // 151: activity.ActivityService.UpdateActivity:output_type -> activity.UpdateActivityResp
+	44,  // 152: activity.ActivityService.DeleteActivity:output_type -> activity.DeleteActivityResp
+	46,  // 153: activity.ActivityService.GetActivity:output_type -> activity.GetActivityResp
+	48,  // 154: activity.ActivityService.ListActivities:output_type -> activity.ListActivitiesResp
+	50,  // 155: activity.ActivityService.SubmitActivity:output_type -> activity.SubmitActivityResp
+	52,  // 156: activity.ActivityService.ApproveActivity:output_type -> activity.ApproveActivityResp
+	54,  // 157: activity.ActivityService.RejectActivity:output_type -> activity.RejectActivityResp
+	62,  // 158: activity.ActivityService.CancelActivity:output_type -> activity.CancelActivityResp
+	58,  // 159: activity.ActivityService.ListReviewQueue:output_type -> activity.ListReviewQueueResp
+	60,  // 160: activity.ActivityService.AssignActivityReview:output_type -> activity.AssignActivityReviewResp
+	64,  // 161: activity.ActivityService.SubmitActivityChange:output_type -> activity.SubmitActivityChangeResp
+	67,  // 162: activity.ActivityService.ListActivityChanges:output_type -> activity.ListActivityChangesResp
+	69,  // 163: activity.ActivityService.ReviewActivityChange:output_type -> activity.ReviewActivityChangeResp
+	73,  // 164: activity.ActivityService.CreateActivitySeries:output_type -> activity.CreateActivitySeriesResp
+	77,  // 165: activity.ActivityService.GetActivitySeries:output_type -> activity.GetActivitySeriesResp
+	80,  // 166: activity.ActivityService.UpdateActivitySeries:output_type -> activity.UpdateActivitySeriesResp
+	82,  // 167: activity.ActivityService.CancelActivitySeries:output_type -> activity.CancelActivitySeriesResp
+	84,  // 168: activity.ActivityService.RegisterActivitySeries:output_type -> activity.RegisterActivitySeriesResp
+	86,  // 169: activity.ActivityService.GetCalendarFeedToken:output_type -> activity.GetCalendarFeedTokenResp
+	88,  // 170: activity.ActivityService.GetCalendarFeed:output_type -> activity.GetCalendarFeedResp
+	90,  // 171: activity.ActivityService.ExportActivityIcs:output_type -> activity.ExportActivityIcsResp
+	92,  // 172: activity.ActivityService.SetSelfCheckIn:output_type -> activity.SetSelfCheckInResp
+	94,  // 173: activity.ActivityService.GetSelfCheckInCode:output_type -> activity.GetSelfCheckInCodeResp
+	96,  // 174: activity.ActivityService.SelfCheckIn:output_type -> activity.SelfCheckInResp
+	99,  // 175: activity.ActivityService.ListCheckInRecords:output_type -> activity.ListCheckInRecordsResp
+	40,  // 176: activity.ActivityService.CloneActivity:output_type -> activity.CreateActivityResp
+	101, // 177: activity.ActivityService.SaveActivityTemplate:output_type -> activity.ActivityTemplateResp
+	105, // 178: activity.ActivityService.ListActivityTemplates:output_type -> activity.ListActivityTemplatesResp
+	40,  // 179: activity.ActivityService.CreateActivityFromTemplate:output_type -> activity.CreateActivityResp
+	108, // 180: activity.ActivityService.DeleteActivityTemplate:output_type -> activity.DeleteActivityTemplateResp
+	101, // 181: activity.ActivityService.AdminCreatePublicTemplate:output_type -> activity.ActivityTemplateResp
+	101, // 182: activity.ActivityService.AdminUpdateTemplate:output_type -> activity.ActivityTemplateResp
+	117, // 183: activity.ActivityService.GetActivityAnalytics:output_type -> activity.GetActivityAnalyticsResp
+	120, // 184: activity.ActivityService.GetOrganizerAnalytics:output_type -> activity.GetOrganizerAnalyticsResp
+	71,  // 185: activity.ActivityService.FavoriteActivity:output_type -> activity.FavoriteActivityResp
+	122, // 186: activity.ActivityService.SearchActivities:output_type -> activity.SearchActivitiesResp
+	126, // 187: activity.ActivityService.GetHotActivities:output_type -> activity.GetHotActivitiesResp
+	128, // 188: activity.ActivityService.NearbyActivities:output_type -> activity.NearbyActivitiesResp
+	130, // 189: activity.ActivityService.SuggestActivities:output_type -> activity.SuggestActivitiesResp
+	132, // 190: activity.ActivityService.ListCategories:output_type -> activity.ListCategoriesResp
+	134, // 191: activity.ActivityService.ListTags:output_type -> activity.ListTagsResp
+	137, // 192: activity.ActivityService.AdminListCategories:output_type -> activity.AdminListCategoriesResp
+	141, // 193: activity.ActivityService.CreateCategory:output_type -> activity.AdminCategoryResp
+	141, // 194: activity.ActivityService.UpdateCategory:output_type -> activity.AdminCategoryResp
+	141, // 195: activity.ActivityService.SetCategoryStatus:output_type -> activity.AdminCategoryResp
+	144, // 196: activity.ActivityService.SortCategories:output_type -> activity.SortCategoriesResp
+	146, // 197: activity.ActivityService.DeleteCategory:output_type -> activity.DeleteCategoryResp
+	149, // 198: activity.ActivityService.AdminListTags:output_type -> activity.AdminListTagsResp
+	153, // 199: activity.ActivityService.CreateTag:output_type -> activity.AdminTagResp
+	153, // 200: activity.ActivityService.UpdateTag:output_type -> activity.AdminTagResp
+	153, // 201: activity.ActivityService.SetTagStatus:output_type -> activity.AdminTagResp
+	155, // 202: activity.ActivityService.MergeTags:output_type -> activity.MergeTagsResp
+	157, // 203: activity.ActivityService.IncrViewCount:output_type -> activity.IncrViewCountResp
+	159, // 204: activity.ActivityService.GetActivityBasic:output_type -> activity.GetActivityBasicResp
+	161, // 205: activity.ActivityService.BatchGetActivityBasic:output_type -> activity.BatchGetActivityBasicResp
+	163, // 206: activity.ActivityService.GetUserPublishedActivities:output_type -> activity.GetUserPublishedActivitiesResp
+	166, // 207: activity.ActivityBranchService.CreateActivityAction:output_type -> activity.CreateActivityActionResp
+	168, // 208: activity.ActivityBranchService.CreateActivityCompensate:output_type -> activity.CreateActivityCompensateResp
+	170, // 209: activity.ActivityBranchService.DeleteActivityAction:output_type -> activity.DeleteActivityActionResp
+	172, // 210: activity.ActivityBranchService.DeleteActivityCompensate:output_type -> activity.DeleteActivityCompensateResp
+	136, // [136:211] is the sub-list for method output_type
+	61,  // [61:136] is the sub-list for method input_type
+	61,  // [61:61] is the sub-list for extension type_name
+	61,  // [61:61] is the sub-list for extension extendee
+	0,   // [0:61] is the sub-list for field type_name
 }
 
 func init() { file_activity_proto_init() }
@@ -5152,14 +13965,18 @@ func file_activity_proto_init() {
 	if File_activity_proto != nil {
 		return
 	}
-	file_activity_proto_msgTypes[23].OneofWrappers = []any{}
+	file_activity_proto_msgTypes[41].OneofWrappers = []any{}
+	file_activity_proto_msgTypes[63].OneofWrappers = []any{}
+	file_activity_proto_msgTypes[78].OneofWrappers = []any{}
+	file_activity_proto_msgTypes[110].OneofWrappers = []any{}
+	file_activity_proto_msgTypes[121].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_activity_proto_rawDesc), len(file_activity_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   63,
+			NumMessages:   173,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
@@ -28,6 +28,13 @@ const (
 	ActivityService_GetTicketList_FullMethodName              = "/activity.ActivityService/GetTicketList"
 	ActivityService_GetTicketDetail_FullMethodName            = "/activity.ActivityService/GetTicketDetail"
 	ActivityService_GetRegisteredCount_FullMethodName         = "/activity.ActivityService/GetRegisteredCount"
+	ActivityService_SetEligibilityRules_FullMethodName        = "/activity.ActivityService/SetEligibilityRules"
+	ActivityService_GetEligibilityRules_FullMethodName        = "/activity.ActivityService/GetEligibilityRules"
+	ActivityService_CheckEligibility_FullMethodName           = "/activity.ActivityService/CheckEligibility"
+	ActivityService_SubmitFeedback_FullMethodName             = "/activity.ActivityService/SubmitFeedback"
+	ActivityService_GetFeedbackSummary_FullMethodName         = "/activity.ActivityService/GetFeedbackSummary"
+	ActivityService_ListFeedbackReviews_FullMethodName        = "/activity.ActivityService/ListFeedbackReviews"
+	ActivityService_ReviewFeedbackComment_FullMethodName      = "/activity.ActivityService/ReviewFeedbackComment"
 	ActivityService_CreateActivity_FullMethodName             = "/activity.ActivityService/CreateActivity"
 	ActivityService_UpdateActivity_FullMethodName             = "/activity.ActivityService/UpdateActivity"
 	ActivityService_DeleteActivity_FullMethodName             = "/activity.ActivityService/DeleteActivity"
@@ -37,10 +44,50 @@ const (
 	ActivityService_ApproveActivity_FullMethodName            = "/activity.ActivityService/ApproveActivity"
 	ActivityService_RejectActivity_FullMethodName             = "/activity.ActivityService/RejectActivity"
 	ActivityService_CancelActivity_FullMethodName             = "/activity.ActivityService/CancelActivity"
+	ActivityService_ListReviewQueue_FullMethodName            = "/activity.ActivityService/ListReviewQueue"
+	ActivityService_AssignActivityReview_FullMethodName       = "/activity.ActivityService/AssignActivityReview"
+	ActivityService_SubmitActivityChange_FullMethodName       = "/activity.ActivityService/SubmitActivityChange"
+	ActivityService_ListActivityChanges_FullMethodName        = "/activity.ActivityService/ListActivityChanges"
+	ActivityService_ReviewActivityChange_FullMethodName       = "/activity.ActivityService/ReviewActivityChange"
+	ActivityService_CreateActivitySeries_FullMethodName       = "/activity.ActivityService/CreateActivitySeries"
+	ActivityService_GetActivitySeries_FullMethodName          = "/activity.ActivityService/GetActivitySeries"
+	ActivityService_UpdateActivitySeries_FullMethodName       = "/activity.ActivityService/UpdateActivitySeries"
+	ActivityService_CancelActivitySeries_FullMethodName       = "/activity.ActivityService/CancelActivitySeries"
+	ActivityService_RegisterActivitySeries_FullMethodName     = "/activity.ActivityService/RegisterActivitySeries"
+	ActivityService_GetCalendarFeedToken_FullMethodName       = "/activity.ActivityService/GetCalendarFeedToken"
+	ActivityService_GetCalendarFeed_FullMethodName            = "/activity.ActivityService/GetCalendarFeed"
+	ActivityService_ExportActivityIcs_FullMethodName          = "/activity.ActivityService/ExportActivityIcs"
+	ActivityService_SetSelfCheckIn_FullMethodName             = "/activity.ActivityService/SetSelfCheckIn"
+	ActivityService_GetSelfCheckInCode_FullMethodName         = "/activity.ActivityService/GetSelfCheckInCode"
+	ActivityService_SelfCheckIn_FullMethodName                = "/activity.ActivityService/SelfCheckIn"
+	ActivityService_ListCheckInRecords_FullMethodName         = "/activity.ActivityService/ListCheckInRecords"
+	ActivityService_CloneActivity_FullMethodName              = "/activity.ActivityService/CloneActivity"
+	ActivityService_SaveActivityTemplate_FullMethodName       = "/activity.ActivityService/SaveActivityTemplate"
+	ActivityService_ListActivityTemplates_FullMethodName      = "/activity.ActivityService/ListActivityTemplates"
+	ActivityService_CreateActivityFromTemplate_FullMethodName = "/activity.ActivityService/CreateActivityFromTemplate"
+	ActivityService_DeleteActivityTemplate_FullMethodName     = "/activity.ActivityService/DeleteActivityTemplate"
+	ActivityService_AdminCreatePublicTemplate_FullMethodName  = "/activity.ActivityService/AdminCreatePublicTemplate"
+	ActivityService_AdminUpdateTemplate_FullMethodName        = "/activity.ActivityService/AdminUpdateTemplate"
+	ActivityService_GetActivityAnalytics_FullMethodName       = "/activity.ActivityService/GetActivityAnalytics"
+	ActivityService_GetOrganizerAnalytics_FullMethodName      = "/activity.ActivityService/GetOrganizerAnalytics"
+	ActivityService_FavoriteActivity_FullMethodName           = "/activity.ActivityService/FavoriteActivity"
 	ActivityService_SearchActivities_FullMethodName           = "/activity.ActivityService/SearchActivities"
 	ActivityService_GetHotActivities_FullMethodName           = "/activity.ActivityService/GetHotActivities"
+	ActivityService_NearbyActivities_FullMethodName           = "/activity.ActivityService/NearbyActivities"
+	ActivityService_SuggestActivities_FullMethodName          = "/activity.ActivityService/SuggestActivities"
 	ActivityService_ListCategories_FullMethodName             = "/activity.ActivityService/ListCategories"
 	ActivityService_ListTags_FullMethodName                   = "/activity.ActivityService/ListTags"
+	ActivityService_AdminListCategories_FullMethodName        = "/activity.ActivityService/AdminListCategories"
+	ActivityService_CreateCategory_FullMethodName             = "/activity.ActivityService/CreateCategory"
+	ActivityService_UpdateCategory_FullMethodName             = "/activity.ActivityService/UpdateCategory"
+	ActivityService_SetCategoryStatus_FullMethodName          = "/activity.ActivityService/SetCategoryStatus"
+	ActivityService_SortCategories_FullMethodName             = "/activity.ActivityService/SortCategories"
+	ActivityService_DeleteCategory_FullMethodName             = "/activity.ActivityService/DeleteCategory"
+	ActivityService_AdminListTags_FullMethodName              = "/activity.ActivityService/AdminListTags"
+	ActivityService_CreateTag_FullMethodName                  = "/activity.ActivityService/CreateTag"
+	ActivityService_UpdateTag_FullMethodName                  = "/activity.ActivityService/UpdateTag"
+	ActivityService_SetTagStatus_FullMethodName               = "/activity.ActivityService/SetTagStatus"
+	ActivityService_MergeTags_FullMethodName                  = "/activity.ActivityService/MergeTags"
 	ActivityService_IncrViewCount_FullMethodName              = "/activity.ActivityService/IncrViewCount"
 	ActivityService_GetActivityBasic_FullMethodName           = "/activity.ActivityService/GetActivityBasic"
 	ActivityService_BatchGetActivityBasic_FullMethodName      = "/activity.ActivityService/BatchGetActivityBasic"
@@ -65,6 +112,20 @@ type ActivityServiceClient interface {
 	GetTicketDetail(ctx context.Context, in *GetTicketDetailRequest, opts ...grpc.CallOption) (*GetTicketDetailResponse, error)
 	// GetRegisteredCount 获取报名数量
 	GetRegisteredCount(ctx context.Context, in *GetRegisteredCountRequest, opts ...grpc.CallOption) (*GetRegisteredCountResponse, error)
+	// SetEligibilityRules 设置活动报名资格规则（覆盖式，仅组织者）
+	SetEligibilityRules(ctx context.Context, in *SetEligibilityRulesReq, opts ...grpc.CallOption) (*SetEligibilityRulesResp, error)
+	// GetEligibilityRules 获取活动报名资格规则
+	GetEligibilityRules(ctx context.Context, in *GetEligibilityRulesReq, opts ...grpc.CallOption) (*GetEligibilityRulesResp, error)
+	// CheckEligibility 报名资格预检（不写入任何数据，供详情页展示"能否报名"）
+	CheckEligibility(ctx context.Context, in *CheckEligibilityReq, opts ...grpc.CallOption) (*CheckEligibilityResp, error)
+	// SubmitFeedback 提交活动评价（活动结束后评价窗口内，仅已核销票据的参与者；重复提交视为修改）
+	SubmitFeedback(ctx context.Context, in *SubmitFeedbackReq, opts ...grpc.CallOption) (*SubmitFeedbackResp, error)
+	// GetFeedbackSummary 活动评价汇总（评分分布 + 公开短评，仅组织者）
+	GetFeedbackSummary(ctx context.Context, in *GetFeedbackSummaryReq, opts ...grpc.CallOption) (*GetFeedbackSummaryResp, error)
+	// ListFeedbackReviews 评价短评审核列表（管理员，命中送审词的短评）
+	ListFeedbackReviews(ctx context.Context, in *ListFeedbackReviewsReq, opts ...grpc.CallOption) (*ListFeedbackReviewsResp, error)
+	// ReviewFeedbackComment 审核评价短评（管理员；通过后公开，驳回后隐藏）
+	ReviewFeedbackComment(ctx context.Context, in *ReviewFeedbackCommentReq, opts ...grpc.CallOption) (*ReviewFeedbackCommentResp, error)
 	// ==================== CRUD 接口 ====================
 	CreateActivity(ctx context.Context, in *CreateActivityReq, opts ...grpc.CallOption) (*CreateActivityResp, error)
 	UpdateActivity(ctx context.Context, in *UpdateActivityReq, opts ...grpc.CallOption) (*UpdateActivityResp, error)
@@ -76,12 +137,94 @@ type ActivityServiceClient interface {
 	ApproveActivity(ctx context.Context, in *ApproveActivityReq, opts ...grpc.CallOption) (*ApproveActivityResp, error)
 	RejectActivity(ctx context.Context, in *RejectActivityReq, opts ...grpc.CallOption) (*RejectActivityResp, error)
 	CancelActivity(ctx context.Context, in *CancelActivityReq, opts ...grpc.CallOption) (*CancelActivityResp, error)
+	// ==================== 发布审核接口（管理员）====================
+	// ListReviewQueue 审核队列（按 SLA 截止时间升序，附带与最近一次通过版本的差异）
+	ListReviewQueue(ctx context.Context, in *ListReviewQueueReq, opts ...grpc.CallOption) (*ListReviewQueueResp, error)
+	// AssignActivityReview 分配/领取/释放审核任务
+	AssignActivityReview(ctx context.Context, in *AssignActivityReviewReq, opts ...grpc.CallOption) (*AssignActivityReviewResp, error)
+	// ==================== 已发布活动变更申请 ====================
+	// SubmitActivityChange 组织者提交时间/地点变更申请（需管理员重新审核）
+	SubmitActivityChange(ctx context.Context, in *SubmitActivityChangeReq, opts ...grpc.CallOption) (*SubmitActivityChangeResp, error)
+	// ListActivityChanges 变更申请列表（组织者查看本活动 / 管理员查看全部）
+	ListActivityChanges(ctx context.Context, in *ListActivityChangesReq, opts ...grpc.CallOption) (*ListActivityChangesResp, error)
+	// ReviewActivityChange 管理员审核变更申请（通过后立即生效并通知报名者）
+	ReviewActivityChange(ctx context.Context, in *ReviewActivityChangeReq, opts ...grpc.CallOption) (*ReviewActivityChangeResp, error)
+	// ==================== 系列活动 ====================
+	// CreateActivitySeries 按重复规则创建系列活动（以首场信息为模板，立即生成近期场次）
+	CreateActivitySeries(ctx context.Context, in *CreateActivitySeriesReq, opts ...grpc.CallOption) (*CreateActivitySeriesResp, error)
+	// GetActivitySeries 系列详情（含已生成的场次）
+	GetActivitySeries(ctx context.Context, in *GetActivitySeriesReq, opts ...grpc.CallOption) (*GetActivitySeriesResp, error)
+	// UpdateActivitySeries 整期修改（同步到未开始且未单独修改过的场次）
+	UpdateActivitySeries(ctx context.Context, in *UpdateActivitySeriesReq, opts ...grpc.CallOption) (*UpdateActivitySeriesResp, error)
+	// CancelActivitySeries 取消系列（停止生成，可选取消未开始的场次）
+	CancelActivitySeries(ctx context.Context, in *CancelActivitySeriesReq, opts ...grpc.CallOption) (*CancelActivitySeriesResp, error)
+	// RegisterActivitySeries 整期报名 / 退出整期报名
+	RegisterActivitySeries(ctx context.Context, in *RegisterActivitySeriesReq, opts ...grpc.CallOption) (*RegisterActivitySeriesResp, error)
+	// ==================== 日历订阅 ====================
+	// GetCalendarFeedToken 获取/重置用户的日历订阅令牌（首次调用时生成）
+	GetCalendarFeedToken(ctx context.Context, in *GetCalendarFeedTokenReq, opts ...grpc.CallOption) (*GetCalendarFeedTokenResp, error)
+	// GetCalendarFeed 按订阅令牌输出用户已报名活动的 iCalendar 内容
+	GetCalendarFeed(ctx context.Context, in *GetCalendarFeedReq, opts ...grpc.CallOption) (*GetCalendarFeedResp, error)
+	// ExportActivityIcs 导出单个活动的 .ics 文件内容
+	ExportActivityIcs(ctx context.Context, in *ExportActivityIcsReq, opts ...grpc.CallOption) (*ExportActivityIcsResp, error)
+	// ==================== 自助签到 ====================
+	// SetSelfCheckIn 组织者开启/关闭自助签到并设置签到半径
+	SetSelfCheckIn(ctx context.Context, in *SetSelfCheckInReq, opts ...grpc.CallOption) (*SetSelfCheckInResp, error)
+	// GetSelfCheckInCode 组织者获取当前轮换签到码（现场大屏展示，30 秒轮换）
+	GetSelfCheckInCode(ctx context.Context, in *GetSelfCheckInCodeReq, opts ...grpc.CallOption) (*GetSelfCheckInCodeResp, error)
+	// SelfCheckIn 参与者自助签到（校验票券、时间窗口、签到码与定位距离）
+	SelfCheckIn(ctx context.Context, in *SelfCheckInReq, opts ...grpc.CallOption) (*SelfCheckInResp, error)
+	// ListCheckInRecords 组织者查看签到记录（可只看疑似异常记录）
+	ListCheckInRecords(ctx context.Context, in *ListCheckInRecordsReq, opts ...grpc.CallOption) (*ListCheckInRecordsResp, error)
+	// ==================== 活动模板 ====================
+	// CloneActivity 复制组织者自己的活动为新草稿（按新开始时间平移各时间点）
+	CloneActivity(ctx context.Context, in *CloneActivityReq, opts ...grpc.CallOption) (*CreateActivityResp, error)
+	// SaveActivityTemplate 将组织者自己的活动另存为个人模板
+	SaveActivityTemplate(ctx context.Context, in *SaveActivityTemplateReq, opts ...grpc.CallOption) (*ActivityTemplateResp, error)
+	// ListActivityTemplates 模板列表（用户：个人模板 + 可用公共模板；管理员：全部公共模板）
+	ListActivityTemplates(ctx context.Context, in *ListActivityTemplatesReq, opts ...grpc.CallOption) (*ListActivityTemplatesResp, error)
+	// CreateActivityFromTemplate 使用模板创建草稿活动
+	CreateActivityFromTemplate(ctx context.Context, in *CreateActivityFromTemplateReq, opts ...grpc.CallOption) (*CreateActivityResp, error)
+	// DeleteActivityTemplate 删除模板（用户删除个人模板，管理员删除公共模板）
+	DeleteActivityTemplate(ctx context.Context, in *DeleteActivityTemplateReq, opts ...grpc.CallOption) (*DeleteActivityTemplateResp, error)
+	// AdminCreatePublicTemplate 管理员以已有活动为蓝本创建公共模板
+	AdminCreatePublicTemplate(ctx context.Context, in *AdminCreatePublicTemplateReq, opts ...grpc.CallOption) (*ActivityTemplateResp, error)
+	// AdminUpdateTemplate 管理员修改公共模板名称、分类或上下架
+	AdminUpdateTemplate(ctx context.Context, in *AdminUpdateTemplateReq, opts ...grpc.CallOption) (*ActivityTemplateResp, error)
+	// ==================== 活动分析 ====================
+	// GetActivityAnalytics 组织者查看单个活动的数据看板（只读统计汇总表）
+	GetActivityAnalytics(ctx context.Context, in *GetActivityAnalyticsReq, opts ...grpc.CallOption) (*GetActivityAnalyticsResp, error)
+	// GetOrganizerAnalytics 组织者查看全部活动的数据总览
+	GetOrganizerAnalytics(ctx context.Context, in *GetOrganizerAnalyticsReq, opts ...grpc.CallOption) (*GetOrganizerAnalyticsResp, error)
+	// ==================== 活动收藏 ====================
+	// FavoriteActivity 收藏/取消收藏活动（收藏后报名即将截止时提醒）
+	FavoriteActivity(ctx context.Context, in *FavoriteActivityReq, opts ...grpc.CallOption) (*FavoriteActivityResp, error)
 	// ==================== 搜索接口 ====================
 	SearchActivities(ctx context.Context, in *SearchActivitiesReq, opts ...grpc.CallOption) (*SearchActivitiesResp, error)
 	GetHotActivities(ctx context.Context, in *GetHotActivitiesReq, opts ...grpc.CallOption) (*GetHotActivitiesResp, error)
+	// NearbyActivities 附近活动（按距离筛选/排序，ES 不可用时降级 MySQL）
+	NearbyActivities(ctx context.Context, in *NearbyActivitiesReq, opts ...grpc.CallOption) (*NearbyActivitiesResp, error)
+	// SuggestActivities 搜索建议（输入联想，ES 不可用时降级 MySQL 标题前缀匹配）
+	SuggestActivities(ctx context.Context, in *SuggestActivitiesReq, opts ...grpc.CallOption) (*SuggestActivitiesResp, error)
 	// ==================== 分类标签接口 ====================
 	ListCategories(ctx context.Context, in *ListCategoriesReq, opts ...grpc.CallOption) (*ListCategoriesResp, error)
 	ListTags(ctx context.Context, in *ListTagsReq, opts ...grpc.CallOption) (*ListTagsResp, error)
+	// ==================== 分类标签管理接口（管理端）====================
+	// 分类：活动服务自有数据，变更后失效 CategoryCache 并重建该分类下活动的搜索索引
+	AdminListCategories(ctx context.Context, in *AdminListCategoriesReq, opts ...grpc.CallOption) (*AdminListCategoriesResp, error)
+	CreateCategory(ctx context.Context, in *CreateCategoryReq, opts ...grpc.CallOption) (*AdminCategoryResp, error)
+	UpdateCategory(ctx context.Context, in *UpdateCategoryReq, opts ...grpc.CallOption) (*AdminCategoryResp, error)
+	SetCategoryStatus(ctx context.Context, in *SetCategoryStatusReq, opts ...grpc.CallOption) (*AdminCategoryResp, error)
+	SortCategories(ctx context.Context, in *SortCategoriesReq, opts ...grpc.CallOption) (*SortCategoriesResp, error)
+	// DeleteCategory 删除分类（仍有活动引用时拒绝，可改为禁用）
+	DeleteCategory(ctx context.Context, in *DeleteCategoryReq, opts ...grpc.CallOption) (*DeleteCategoryResp, error)
+	// 标签：写入用户服务后同步 tag_cache，并重建使用该标签的活动的搜索索引
+	AdminListTags(ctx context.Context, in *AdminListTagsReq, opts ...grpc.CallOption) (*AdminListTagsResp, error)
+	CreateTag(ctx context.Context, in *CreateTagReq, opts ...grpc.CallOption) (*AdminTagResp, error)
+	UpdateTag(ctx context.Context, in *UpdateTagReq, opts ...grpc.CallOption) (*AdminTagResp, error)
+	SetTagStatus(ctx context.Context, in *SetTagStatusReq, opts ...grpc.CallOption) (*AdminTagResp, error)
+	// MergeTags 合并标签（活动关联与用户兴趣改绑到目标标签，源标签禁用）
+	MergeTags(ctx context.Context, in *MergeTagsReq, opts ...grpc.CallOption) (*MergeTagsResp, error)
 	// ==================== 浏览量接口 ====================
 	IncrViewCount(ctx context.Context, in *IncrViewCountReq, opts ...grpc.CallOption) (*IncrViewCountResp, error)
 	// ==================== 内部接口（供其他微服务调用）====================
@@ -171,6 +314,76 @@ func (c *activityServiceClient) GetRegisteredCount(ctx context.Context, in *GetR
 	return out, nil
 }
 
+func (c *activityServiceClient) SetEligibilityRules(ctx context.Context, in *SetEligibilityRulesReq, opts ...grpc.CallOption) (*SetEligibilityRulesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetEligibilityRulesResp)
+	err := c.cc.Invoke(ctx, ActivityService_SetEligibilityRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *activityServiceClient) GetEligibilityRules(ctx context.Context, in *GetEligibilityRulesReq, opts ...grpc.CallOption) (*GetEligibilityRulesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEligibilityRulesResp)
+	err := c.cc.Invoke(ctx, ActivityService_GetEligibilityRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *activityServiceClient) CheckEligibility(ctx context.Context, in *CheckEligibilityReq, opts ...grpc.CallOption) (*CheckEligibilityResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckEligibilityResp)
+	err := c.cc.Invoke(ctx, ActivityService_CheckEligibility_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *activityServiceClient) SubmitFeedback(ctx context.Context, in *SubmitFeedbackReq, opts ...grpc.CallOption) (*SubmitFeedbackResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitFeedbackResp)
+	err := c.cc.Invoke(ctx, ActivityService_SubmitFeedback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *activityServiceClient) GetFeedbackSummary(ctx context.Context, in *GetFeedbackSummaryReq, opts ...grpc.CallOption) (*GetFeedbackSummaryResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFeedbackSummaryResp)
+	err := c.cc.Invoke(ctx, ActivityService_GetFeedbackSummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *activityServiceClient) ListFeedbackReviews(ctx context.Context, in *ListFeedbackReviewsReq, opts ...grpc.CallOption) (*ListFeedbackReviewsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFeedbackReviewsResp)
+	err := c.cc.Invoke(ctx, ActivityService_ListFeedbackReviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *activityServiceClient) ReviewFeedbackComment(ctx context.Context, in *ReviewFeedbackCommentReq, opts ...grpc.CallOption) (*ReviewFeedbackCommentResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewFeedbackCommentResp)
+	err := c.cc.Invoke(ctx, ActivityService_ReviewFeedbackComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *activityServiceClient) CreateActivity(ctx context.Context, in *CreateActivityReq, opts ...grpc.CallOption) (*CreateActivityResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateActivityResp)
@@ -261,589 +474,2072 @@ func (c *activityServiceClient) CancelActivity(ctx context.Context, in *CancelAc
 	return out, nil
 }
 
-func (c *activityServiceClient) SearchActivities(ctx context.Context, in *SearchActivitiesReq, opts ...grpc.CallOption) (*SearchActivitiesResp, error) {
+func (c *activityServiceClient) ListReviewQueue(ctx context.Context, in *ListReviewQueueReq, opts ...grpc.CallOption) (*ListReviewQueueResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(SearchActivitiesResp)
-	err := c.cc.Invoke(ctx, ActivityService_SearchActivities_FullMethodName, in, out, cOpts...)
+	out := new(ListReviewQueueResp)
+	err := c.cc.Invoke(ctx, ActivityService_ListReviewQueue_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *activityServiceClient) GetHotActivities(ctx context.Context, in *GetHotActivitiesReq, opts ...grpc.CallOption) (*GetHotActivitiesResp, error) {
+func (c *activityServiceClient) AssignActivityReview(ctx context.Context, in *AssignActivityReviewReq, opts ...grpc.CallOption) (*AssignActivityReviewResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetHotActivitiesResp)
-	err := c.cc.Invoke(ctx, ActivityService_GetHotActivities_FullMethodName, in, out, cOpts...)
+	out := new(AssignActivityReviewResp)
+	err := c.cc.Invoke(ctx, ActivityService_AssignActivityReview_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *activityServiceClient) ListCategories(ctx context.Context, in *ListCategoriesReq, opts ...grpc.CallOption) (*ListCategoriesResp, error) {
+func (c *activityServiceClient) SubmitActivityChange(ctx context.Context, in *SubmitActivityChangeReq, opts ...grpc.CallOption) (*SubmitActivityChangeResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListCategoriesResp)
-	err := c.cc.Invoke(ctx, ActivityService_ListCategories_FullMethodName, in, out, cOpts...)
+	out := new(SubmitActivityChangeResp)
+	err := c.cc.Invoke(ctx, ActivityService_SubmitActivityChange_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *activityServiceClient) ListTags(ctx context.Context, in *ListTagsReq, opts ...grpc.CallOption) (*ListTagsResp, error) {
+func (c *activityServiceClient) ListActivityChanges(ctx context.Context, in *ListActivityChangesReq, opts ...grpc.CallOption) (*ListActivityChangesResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListTagsResp)
-	err := c.cc.Invoke(ctx, ActivityService_ListTags_FullMethodName, in, out, cOpts...)
+	out := new(ListActivityChangesResp)
+	err := c.cc.Invoke(ctx, ActivityService_ListActivityChanges_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *activityServiceClient) IncrViewCount(ctx context.Context, in *IncrViewCountReq, opts ...grpc.CallOption) (*IncrViewCountResp, error) {
+func (c *activityServiceClient) ReviewActivityChange(ctx context.Context, in *ReviewActivityChangeReq, opts ...grpc.CallOption) (*ReviewActivityChangeResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(IncrViewCountResp)
-	err := c.cc.Invoke(ctx, ActivityService_IncrViewCount_FullMethodName, in, out, cOpts...)
+	out := new(ReviewActivityChangeResp)
+	err := c.cc.Invoke(ctx, ActivityService_ReviewActivityChange_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *activityServiceClient) GetActivityBasic(ctx context.Context, in *GetActivityBasicReq, opts ...grpc.CallOption) (*GetActivityBasicResp, error) {
+func (c *activityServiceClient) CreateActivitySeries(ctx context.Context, in *CreateActivitySeriesReq, opts ...grpc.CallOption) (*CreateActivitySeriesResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetActivityBasicResp)
-	err := c.cc.Invoke(ctx, ActivityService_GetActivityBasic_FullMethodName, in, out, cOpts...)
+	out := new(CreateActivitySeriesResp)
+	err := c.cc.Invoke(ctx, ActivityService_CreateActivitySeries_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *activityServiceClient) BatchGetActivityBasic(ctx context.Context, in *BatchGetActivityBasicReq, opts ...grpc.CallOption) (*BatchGetActivityBasicResp, error) {
+func (c *activityServiceClient) GetActivitySeries(ctx context.Context, in *GetActivitySeriesReq, opts ...grpc.CallOption) (*GetActivitySeriesResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(BatchGetActivityBasicResp)
-	err := c.cc.Invoke(ctx, ActivityService_BatchGetActivityBasic_FullMethodName, in, out, cOpts...)
+	out := new(GetActivitySeriesResp)
+	err := c.cc.Invoke(ctx, ActivityService_GetActivitySeries_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *activityServiceClient) GetUserPublishedActivities(ctx context.Context, in *GetUserPublishedActivitiesReq, opts ...grpc.CallOption) (*GetUserPublishedActivitiesResp, error) {
+func (c *activityServiceClient) UpdateActivitySeries(ctx context.Context, in *UpdateActivitySeriesReq, opts ...grpc.CallOption) (*UpdateActivitySeriesResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetUserPublishedActivitiesResp)
-	err := c.cc.Invoke(ctx, ActivityService_GetUserPublishedActivities_FullMethodName, in, out, cOpts...)
+	out := new(UpdateActivitySeriesResp)
+	err := c.cc.Invoke(ctx, ActivityService_UpdateActivitySeries_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// ActivityServiceServer is the server API for ActivityService service.
-// All implementations must embed UnimplementedActivityServiceServer
-// for forward compatibility.
-type ActivityServiceServer interface {
-	// RegisterActivity 报名活动
-	RegisterActivity(context.Context, *RegisterActivityRequest) (*RegisterActivityResponse, error)
-	// CancelActivities 取消报名活动
-	CancelActivities(context.Context, *CancelActivityRequest) (*CancelActivityResponse, error)
-	// GetActivityList 获取待参加/已参加活动信息列表
-	GetActivityList(context.Context, *GetActivityListRequest) (*GetActivityListResponse, error)
-	// VerifyTicket 核销票券
-	VerifyTicket(context.Context, *VerifyTicketRequest) (*VerifyTicketResponse, error)
-	// GetTicketList 获取个人票券列表
-	GetTicketList(context.Context, *GetTicketListRequest) (*GetTicketListResponse, error)
-	// GetTicketDetail 获取票券详情
-	GetTicketDetail(context.Context, *GetTicketDetailRequest) (*GetTicketDetailResponse, error)
-	// GetRegisteredCount 获取报名数量
-	GetRegisteredCount(context.Context, *GetRegisteredCountRequest) (*GetRegisteredCountResponse, error)
-	// ==================== CRUD 接口 ====================
-	CreateActivity(context.Context, *CreateActivityReq) (*CreateActivityResp, error)
-	UpdateActivity(context.Context, *UpdateActivityReq) (*UpdateActivityResp, error)
-	DeleteActivity(context.Context, *DeleteActivityReq) (*DeleteActivityResp, error)
-	GetActivity(context.Context, *GetActivityReq) (*GetActivityResp, error)
-	ListActivities(context.Context, *ListActivitiesReq) (*ListActivitiesResp, error)
-	// ==================== 状态操作接口 ====================
-	SubmitActivity(context.Context, *SubmitActivityReq) (*SubmitActivityResp, error)
-	ApproveActivity(context.Context, *ApproveActivityReq) (*ApproveActivityResp, error)
-	RejectActivity(context.Context, *RejectActivityReq) (*RejectActivityResp, error)
-	CancelActivity(context.Context, *CancelActivityReq) (*CancelActivityResp, error)
-	// ==================== 搜索接口 ====================
-	SearchActivities(context.Context, *SearchActivitiesReq) (*SearchActivitiesResp, error)
-	GetHotActivities(context.Context, *GetHotActivitiesReq) (*GetHotActivitiesResp, error)
-	// ==================== 分类标签接口 ====================
-	ListCategories(context.Context, *ListCategoriesReq) (*ListCategoriesResp, error)
-	ListTags(context.Context, *ListTagsReq) (*ListTagsResp, error)
-	// ==================== 浏览量接口 ====================
-	IncrViewCount(context.Context, *IncrViewCountReq) (*IncrViewCountResp, error)
-	// ==================== 内部接口（供其他微服务调用）====================
-	// 获取活动基本信息（User/Chat 服务调用）
-	GetActivityBasic(context.Context, *GetActivityBasicReq) (*GetActivityBasicResp, error)
-	// 批量获取活动基本信息
-	BatchGetActivityBasic(context.Context, *BatchGetActivityBasicReq) (*BatchGetActivityBasicResp, error)
-	// 获取用户已发布的活动列表（User 服务调用，用于展示用户主页）
-	GetUserPublishedActivities(context.Context, *GetUserPublishedActivitiesReq) (*GetUserPublishedActivitiesResp, error)
-	mustEmbedUnimplementedActivityServiceServer()
+func (c *activityServiceClient) CancelActivitySeries(ctx context.Context, in *CancelActivitySeriesReq, opts ...grpc.CallOption) (*CancelActivitySeriesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelActivitySeriesResp)
+	err := c.cc.Invoke(ctx, ActivityService_CancelActivitySeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedActivityServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedActivityServiceServer struct{}
+func (c *activityServiceClient) RegisterActivitySeries(ctx context.Context, in *RegisterActivitySeriesReq, opts ...grpc.CallOption) (*RegisterActivitySeriesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegisterActivitySeriesResp)
+	err := c.cc.Invoke(ctx, ActivityService_RegisterActivitySeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-func (UnimplementedActivityServiceServer) RegisterActivity(context.Context, *RegisterActivityRequest) (*RegisterActivityResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method RegisterActivity not implemented")
+func (c *activityServiceClient) GetCalendarFeedToken(ctx context.Context, in *GetCalendarFeedTokenReq, opts ...grpc.CallOption) (*GetCalendarFeedTokenResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCalendarFeedTokenResp)
+	err := c.cc.Invoke(ctx, ActivityService_GetCalendarFeedToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedActivityServiceServer) CancelActivities(context.Context, *CancelActivityRequest) (*CancelActivityResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method CancelActivities not implemented")
+
+func (c *activityServiceClient) GetCalendarFeed(ctx context.Context, in *GetCalendarFeedReq, opts ...grpc.CallOption) (*GetCalendarFeedResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCalendarFeedResp)
+	err := c.cc.Invoke(ctx, ActivityService_GetCalendarFeed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedActivityServiceServer) GetActivityList(context.Context, *GetActivityListRequest) (*GetActivityListResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetActivityList not implemented")
+
+func (c *activityServiceClient) ExportActivityIcs(ctx context.Context, in *ExportActivityIcsReq, opts ...grpc.CallOption) (*ExportActivityIcsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportActivityIcsResp)
+	err := c.cc.Invoke(ctx, ActivityService_ExportActivityIcs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedActivityServiceServer) VerifyTicket(context.Context, *VerifyTicketRequest) (*VerifyTicketResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method VerifyTicket not implemented")
+
+func (c *activityServiceClient) SetSelfCheckIn(ctx context.Context, in *SetSelfCheckInReq, opts ...grpc.CallOption) (*SetSelfCheckInResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetSelfCheckInResp)
+	err := c.cc.Invoke(ctx, ActivityService_SetSelfCheckIn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedActivityServiceServer) GetTicketList(context.Context, *GetTicketListRequest) (*GetTicketListResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetTicketList not implemented")
+
+func (c *activityServiceClient) GetSelfCheckInCode(ctx context.Context, in *GetSelfCheckInCodeReq, opts ...grpc.CallOption) (*GetSelfCheckInCodeResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSelfCheckInCodeResp)
+	err := c.cc.Invoke(ctx, ActivityService_GetSelfCheckInCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedActivityServiceServer) GetTicketDetail(context.Context, *GetTicketDetailRequest) (*GetTicketDetailResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetTicketDetail not implemented")
+
+func (c *activityServiceClient) SelfCheckIn(ctx context.Context, in *SelfCheckInReq, opts ...grpc.CallOption) (*SelfCheckInResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SelfCheckInResp)
+	err := c.cc.Invoke(ctx, ActivityService_SelfCheckIn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedActivityServiceServer) GetRegisteredCount(context.Context, *GetRegisteredCountRequest) (*GetRegisteredCountResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetRegisteredCount not implemented")
+
+func (c *activityServiceClient) ListCheckInRecords(ctx context.Context, in *ListCheckInRecordsReq, opts ...grpc.CallOption) (*ListCheckInRecordsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCheckInRecordsResp)
+	err := c.cc.Invoke(ctx, ActivityService_ListCheckInRecords_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedActivityServiceServer) CreateActivity(context.Context, *CreateActivityReq) (*CreateActivityResp, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreateActivity not implemented")
+
+func (c *activityServiceClient) CloneActivity(ctx context.Context, in *CloneActivityReq, opts ...grpc.CallOption) (*CreateActivityResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateActivityResp)
+	err := c.cc.Invoke(ctx, ActivityService_CloneActivity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedActivityServiceServer) UpdateActivity(context.Context, *UpdateActivityReq) (*UpdateActivityResp, error) {
-	return nil, status.Error(codes.Unimplemented, "method UpdateActivity not implemented")
+
+func (c *activityServiceClient) SaveActivityTemplate(ctx context.Context, in *SaveActivityTemplateReq, opts ...grpc.CallOption) (*ActivityTemplateResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ActivityTemplateResp)
+	err := c.cc.Invoke(ctx, ActivityService_SaveActivityTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedActivityServiceServer) DeleteActivity(context.Context, *DeleteActivityReq) (*DeleteActivityResp, error) {
-	return nil, status.Error(codes.Unimplemented, "method DeleteActivity not implemented")
+
+func (c *activityServiceClient) ListActivityTemplates(ctx context.Context, in *ListActivityTemplatesReq, opts ...grpc.CallOption) (*ListActivityTemplatesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListActivityTemplatesResp)
+	err := c.cc.Invoke(ctx, ActivityService_ListActivityTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedActivityServiceServer) GetActivity(context.Context, *GetActivityReq) (*GetActivityResp, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetActivity not implemented")
+
+func (c *activityServiceClient) CreateActivityFromTemplate(ctx context.Context, in *CreateActivityFromTemplateReq, opts ...grpc.CallOption) (*CreateActivityResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateActivityResp)
+	err := c.cc.Invoke(ctx, ActivityService_CreateActivityFromTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedActivityServiceServer) ListActivities(context.Context, *ListActivitiesReq) (*ListActivitiesResp, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListActivities not implemented")
+
+func (c *activityServiceClient) DeleteActivityTemplate(ctx context.Context, in *DeleteActivityTemplateReq, opts ...grpc.CallOption) (*DeleteActivityTemplateResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteActivityTemplateResp)
+	err := c.cc.Invoke(ctx, ActivityService_DeleteActivityTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedActivityServiceServer) SubmitActivity(context.Context, *SubmitActivityReq) (*SubmitActivityResp, error) {
-	return nil, status.Error(codes.Unimplemented, "method SubmitActivity not implemented")
+
+func (c *activityServiceClient) AdminCreatePublicTemplate(ctx context.Context, in *AdminCreatePublicTemplateReq, opts ...grpc.CallOption) (*ActivityTemplateResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ActivityTemplateResp)
+	err := c.cc.Invoke(ctx, ActivityService_AdminCreatePublicTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedActivityServiceServer) ApproveActivity(context.Context, *ApproveActivityReq) (*ApproveActivityResp, error) {
-	return nil, status.Error(codes.Unimplemented, "method ApproveActivity not implemented")
+
+func (c *activityServiceClient) AdminUpdateTemplate(ctx context.Context, in *AdminUpdateTemplateReq, opts ...grpc.CallOption) (*ActivityTemplateResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ActivityTemplateResp)
+	err := c.cc.Invoke(ctx, ActivityService_AdminUpdateTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedActivityServiceServer) RejectActivity(context.Context, *RejectActivityReq) (*RejectActivityResp, error) {
-	return nil, status.Error(codes.Unimplemented, "method RejectActivity not implemented")
+
+func (c *activityServiceClient) GetActivityAnalytics(ctx context.Context, in *GetActivityAnalyticsReq, opts ...grpc.CallOption) (*GetActivityAnalyticsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetActivityAnalyticsResp)
+	err := c.cc.Invoke(ctx, ActivityService_GetActivityAnalytics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *activityServiceClient) GetOrganizerAnalytics(ctx context.Context, in *GetOrganizerAnalyticsReq, opts ...grpc.CallOption) (*GetOrganizerAnalyticsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOrganizerAnalyticsResp)
+	err := c.cc.Invoke(ctx, ActivityService_GetOrganizerAnalytics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *activityServiceClient) FavoriteActivity(ctx context.Context, in *FavoriteActivityReq, opts ...grpc.CallOption) (*FavoriteActivityResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FavoriteActivityResp)
+	err := c.cc.Invoke(ctx, ActivityService_FavoriteActivity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *activityServiceClient) SearchActivities(ctx context.Context, in *SearchActivitiesReq, opts ...grpc.CallOption) (*SearchActivitiesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchActivitiesResp)
+	err := c.cc.Invoke(ctx, ActivityService_SearchActivities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *activityServiceClient) GetHotActivities(ctx context.Context, in *GetHotActivitiesReq, opts ...grpc.CallOption) (*GetHotActivitiesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetHotActivitiesResp)
+	err := c.cc.Invoke(ctx, ActivityService_GetHotActivities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *activityServiceClient) NearbyActivities(ctx context.Context, in *NearbyActivitiesReq, opts ...grpc.CallOption) (*NearbyActivitiesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NearbyActivitiesResp)
+	err := c.cc.Invoke(ctx, ActivityService_NearbyActivities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *activityServiceClient) SuggestActivities(ctx context.Context, in *SuggestActivitiesReq, opts ...grpc.CallOption) (*SuggestActivitiesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuggestActivitiesResp)
+	err := c.cc.Invoke(ctx, ActivityService_SuggestActivities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *activityServiceClient) ListCategories(ctx context.Context, in *ListCategoriesReq, opts ...grpc.CallOption) (*ListCategoriesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCategoriesResp)
+	err := c.cc.Invoke(ctx, ActivityService_ListCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *activityServiceClient) ListTags(ctx context.Context, in *ListTagsReq, opts ...grpc.CallOption) (*ListTagsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTagsResp)
+	err := c.cc.Invoke(ctx, ActivityService_ListTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *activityServiceClient) AdminListCategories(ctx context.Context, in *AdminListCategoriesReq, opts ...grpc.CallOption) (*AdminListCategoriesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminListCategoriesResp)
+	err := c.cc.Invoke(ctx, ActivityService_AdminListCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *activityServiceClient) CreateCategory(ctx context.Context, in *CreateCategoryReq, opts ...grpc.CallOption) (*AdminCategoryResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminCategoryResp)
+	err := c.cc.Invoke(ctx, ActivityService_CreateCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *activityServiceClient) UpdateCategory(ctx context.Context, in *UpdateCategoryReq, opts ...grpc.CallOption) (*AdminCategoryResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminCategoryResp)
+	err := c.cc.Invoke(ctx, ActivityService_UpdateCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *activityServiceClient) SetCategoryStatus(ctx context.Context, in *SetCategoryStatusReq, opts ...grpc.CallOption) (*AdminCategoryResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminCategoryResp)
+	err := c.cc.Invoke(ctx, ActivityService_SetCategoryStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *activityServiceClient) SortCategories(ctx context.Context, in *SortCategoriesReq, opts ...grpc.CallOption) (*SortCategoriesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SortCategoriesResp)
+	err := c.cc.Invoke(ctx, ActivityService_SortCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *activityServiceClient) DeleteCategory(ctx context.Context, in *DeleteCategoryReq, opts ...grpc.CallOption) (*DeleteCategoryResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCategoryResp)
+	err := c.cc.Invoke(ctx, ActivityService_DeleteCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *activityServiceClient) AdminListTags(ctx context.Context, in *AdminListTagsReq, opts ...grpc.CallOption) (*AdminListTagsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminListTagsResp)
+	err := c.cc.Invoke(ctx, ActivityService_AdminListTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *activityServiceClient) CreateTag(ctx context.Context, in *CreateTagReq, opts ...grpc.CallOption) (*AdminTagResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminTagResp)
+	err := c.cc.Invoke(ctx, ActivityService_CreateTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *activityServiceClient) UpdateTag(ctx context.Context, in *UpdateTagReq, opts ...grpc.CallOption) (*AdminTagResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminTagResp)
+	err := c.cc.Invoke(ctx, ActivityService_UpdateTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *activityServiceClient) SetTagStatus(ctx context.Context, in *SetTagStatusReq, opts ...grpc.CallOption) (*AdminTagResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminTagResp)
+	err := c.cc.Invoke(ctx, ActivityService_SetTagStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *activityServiceClient) MergeTags(ctx context.Context, in *MergeTagsReq, opts ...grpc.CallOption) (*MergeTagsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeTagsResp)
+	err := c.cc.Invoke(ctx, ActivityService_MergeTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *activityServiceClient) IncrViewCount(ctx context.Context, in *IncrViewCountReq, opts ...grpc.CallOption) (*IncrViewCountResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IncrViewCountResp)
+	err := c.cc.Invoke(ctx, ActivityService_IncrViewCount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *activityServiceClient) GetActivityBasic(ctx context.Context, in *GetActivityBasicReq, opts ...grpc.CallOption) (*GetActivityBasicResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetActivityBasicResp)
+	err := c.cc.Invoke(ctx, ActivityService_GetActivityBasic_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *activityServiceClient) BatchGetActivityBasic(ctx context.Context, in *BatchGetActivityBasicReq, opts ...grpc.CallOption) (*BatchGetActivityBasicResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGetActivityBasicResp)
+	err := c.cc.Invoke(ctx, ActivityService_BatchGetActivityBasic_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *activityServiceClient) GetUserPublishedActivities(ctx context.Context, in *GetUserPublishedActivitiesReq, opts ...grpc.CallOption) (*GetUserPublishedActivitiesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserPublishedActivitiesResp)
+	err := c.cc.Invoke(ctx, ActivityService_GetUserPublishedActivities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ActivityServiceServer is the server API for ActivityService service.
+// All implementations must embed UnimplementedActivityServiceServer
+// for forward compatibility.
+type ActivityServiceServer interface {
+	// RegisterActivity 报名活动
+	RegisterActivity(context.Context, *RegisterActivityRequest) (*RegisterActivityResponse, error)
+	// CancelActivities 取消报名活动
+	CancelActivities(context.Context, *CancelActivityRequest) (*CancelActivityResponse, error)
+	// GetActivityList 获取待参加/已参加活动信息列表
+	GetActivityList(context.Context, *GetActivityListRequest) (*GetActivityListResponse, error)
+	// VerifyTicket 核销票券
+	VerifyTicket(context.Context, *VerifyTicketRequest) (*VerifyTicketResponse, error)
+	// GetTicketList 获取个人票券列表
+	GetTicketList(context.Context, *GetTicketListRequest) (*GetTicketListResponse, error)
+	// GetTicketDetail 获取票券详情
+	GetTicketDetail(context.Context, *GetTicketDetailRequest) (*GetTicketDetailResponse, error)
+	// GetRegisteredCount 获取报名数量
+	GetRegisteredCount(context.Context, *GetRegisteredCountRequest) (*GetRegisteredCountResponse, error)
+	// SetEligibilityRules 设置活动报名资格规则（覆盖式，仅组织者）
+	SetEligibilityRules(context.Context, *SetEligibilityRulesReq) (*SetEligibilityRulesResp, error)
+	// GetEligibilityRules 获取活动报名资格规则
+	GetEligibilityRules(context.Context, *GetEligibilityRulesReq) (*GetEligibilityRulesResp, error)
+	// CheckEligibility 报名资格预检（不写入任何数据，供详情页展示"能否报名"）
+	CheckEligibility(context.Context, *CheckEligibilityReq) (*CheckEligibilityResp, error)
+	// SubmitFeedback 提交活动评价（活动结束后评价窗口内，仅已核销票据的参与者；重复提交视为修改）
+	SubmitFeedback(context.Context, *SubmitFeedbackReq) (*SubmitFeedbackResp, error)
+	// GetFeedbackSummary 活动评价汇总（评分分布 + 公开短评，仅组织者）
+	GetFeedbackSummary(context.Context, *GetFeedbackSummaryReq) (*GetFeedbackSummaryResp, error)
+	// ListFeedbackReviews 评价短评审核列表（管理员，命中送审词的短评）
+	ListFeedbackReviews(context.Context, *ListFeedbackReviewsReq) (*ListFeedbackReviewsResp, error)
+	// ReviewFeedbackComment 审核评价短评（管理员；通过后公开，驳回后隐藏）
+	ReviewFeedbackComment(context.Context, *ReviewFeedbackCommentReq) (*ReviewFeedbackCommentResp, error)
+	// ==================== CRUD 接口 ====================
+	CreateActivity(context.Context, *CreateActivityReq) (*CreateActivityResp, error)
+	UpdateActivity(context.Context, *UpdateActivityReq) (*UpdateActivityResp, error)
+	DeleteActivity(context.Context, *DeleteActivityReq) (*DeleteActivityResp, error)
+	GetActivity(context.Context, *GetActivityReq) (*GetActivityResp, error)
+	ListActivities(context.Context, *ListActivitiesReq) (*ListActivitiesResp, error)
+	// ==================== 状态操作接口 ====================
+	SubmitActivity(context.Context, *SubmitActivityReq) (*SubmitActivityResp, error)
+	ApproveActivity(context.Context, *ApproveActivityReq) (*ApproveActivityResp, error)
+	RejectActivity(context.Context, *RejectActivityReq) (*RejectActivityResp, error)
+	CancelActivity(context.Context, *CancelActivityReq) (*CancelActivityResp, error)
+	// ==================== 发布审核接口（管理员）====================
+	// ListReviewQueue 审核队列（按 SLA 截止时间升序，附带与最近一次通过版本的差异）
+	ListReviewQueue(context.Context, *ListReviewQueueReq) (*ListReviewQueueResp, error)
+	// AssignActivityReview 分配/领取/释放审核任务
+	AssignActivityReview(context.Context, *AssignActivityReviewReq) (*AssignActivityReviewResp, error)
+	// ==================== 已发布活动变更申请 ====================
+	// SubmitActivityChange 组织者提交时间/地点变更申请（需管理员重新审核）
+	SubmitActivityChange(context.Context, *SubmitActivityChangeReq) (*SubmitActivityChangeResp, error)
+	// ListActivityChanges 变更申请列表（组织者查看本活动 / 管理员查看全部）
+	ListActivityChanges(context.Context, *ListActivityChangesReq) (*ListActivityChangesResp, error)
+	// ReviewActivityChange 管理员审核变更申请（通过后立即生效并通知报名者）
+	ReviewActivityChange(context.Context, *ReviewActivityChangeReq) (*ReviewActivityChangeResp, error)
+	// ==================== 系列活动 ====================
+	// CreateActivitySeries 按重复规则创建系列活动（以首场信息为模板，立即生成近期场次）
+	CreateActivitySeries(context.Context, *CreateActivitySeriesReq) (*CreateActivitySeriesResp, error)
+	// GetActivitySeries 系列详情（含已生成的场次）
+	GetActivitySeries(context.Context, *GetActivitySeriesReq) (*GetActivitySeriesResp, error)
+	// UpdateActivitySeries 整期修改（同步到未开始且未单独修改过的场次）
+	UpdateActivitySeries(context.Context, *UpdateActivitySeriesReq) (*UpdateActivitySeriesResp, error)
+	// CancelActivitySeries 取消系列（停止生成，可选取消未开始的场次）
+	CancelActivitySeries(context.Context, *CancelActivitySeriesReq) (*CancelActivitySeriesResp, error)
+	// RegisterActivitySeries 整期报名 / 退出整期报名
+	RegisterActivitySeries(context.Context, *RegisterActivitySeriesReq) (*RegisterActivitySeriesResp, error)
+	// ==================== 日历订阅 ====================
+	// GetCalendarFeedToken 获取/重置用户的日历订阅令牌（首次调用时生成）
+	GetCalendarFeedToken(context.Context, *GetCalendarFeedTokenReq) (*GetCalendarFeedTokenResp, error)
+	// GetCalendarFeed 按订阅令牌输出用户已报名活动的 iCalendar 内容
+	GetCalendarFeed(context.Context, *GetCalendarFeedReq) (*GetCalendarFeedResp, error)
+	// ExportActivityIcs 导出单个活动的 .ics 文件内容
+	ExportActivityIcs(context.Context, *ExportActivityIcsReq) (*ExportActivityIcsResp, error)
+	// ==================== 自助签到 ====================
+	// SetSelfCheckIn 组织者开启/关闭自助签到并设置签到半径
+	SetSelfCheckIn(context.Context, *SetSelfCheckInReq) (*SetSelfCheckInResp, error)
+	// GetSelfCheckInCode 组织者获取当前轮换签到码（现场大屏展示，30 秒轮换）
+	GetSelfCheckInCode(context.Context, *GetSelfCheckInCodeReq) (*GetSelfCheckInCodeResp, error)
+	// SelfCheckIn 参与者自助签到（校验票券、时间窗口、签到码与定位距离）
+	SelfCheckIn(context.Context, *SelfCheckInReq) (*SelfCheckInResp, error)
+	// ListCheckInRecords 组织者查看签到记录（可只看疑似异常记录）
+	ListCheckInRecords(context.Context, *ListCheckInRecordsReq) (*ListCheckInRecordsResp, error)
+	// ==================== 活动模板 ====================
+	// CloneActivity 复制组织者自己的活动为新草稿（按新开始时间平移各时间点）
+	CloneActivity(context.Context, *CloneActivityReq) (*CreateActivityResp, error)
+	// SaveActivityTemplate 将组织者自己的活动另存为个人模板
+	SaveActivityTemplate(context.Context, *SaveActivityTemplateReq) (*ActivityTemplateResp, error)
+	// ListActivityTemplates 模板列表（用户：个人模板 + 可用公共模板；管理员：全部公共模板）
+	ListActivityTemplates(context.Context, *ListActivityTemplatesReq) (*ListActivityTemplatesResp, error)
+	// CreateActivityFromTemplate 使用模板创建草稿活动
+	CreateActivityFromTemplate(context.Context, *CreateActivityFromTemplateReq) (*CreateActivityResp, error)
+	// DeleteActivityTemplate 删除模板（用户删除个人模板，管理员删除公共模板）
+	DeleteActivityTemplate(context.Context, *DeleteActivityTemplateReq) (*DeleteActivityTemplateResp, error)
+	// AdminCreatePublicTemplate 管理员以已有活动为蓝本创建公共模板
+	AdminCreatePublicTemplate(context.Context, *AdminCreatePublicTemplateReq) (*ActivityTemplateResp, error)
+	// AdminUpdateTemplate 管理员修改公共模板名称、分类或上下架
+	AdminUpdateTemplate(context.Context, *AdminUpdateTemplateReq) (*ActivityTemplateResp, error)
+	// ==================== 活动分析 ====================
+	// GetActivityAnalytics 组织者查看单个活动的数据看板（只读统计汇总表）
+	GetActivityAnalytics(context.Context, *GetActivityAnalyticsReq) (*GetActivityAnalyticsResp, error)
+	// GetOrganizerAnalytics 组织者查看全部活动的数据总览
+	GetOrganizerAnalytics(context.Context, *GetOrganizerAnalyticsReq) (*GetOrganizerAnalyticsResp, error)
+	// ==================== 活动收藏 ====================
+	// FavoriteActivity 收藏/取消收藏活动（收藏后报名即将截止时提醒）
+	FavoriteActivity(context.Context, *FavoriteActivityReq) (*FavoriteActivityResp, error)
+	// ==================== 搜索接口 ====================
+	SearchActivities(context.Context, *SearchActivitiesReq) (*SearchActivitiesResp, error)
+	GetHotActivities(context.Context, *GetHotActivitiesReq) (*GetHotActivitiesResp, error)
+	// NearbyActivities 附近活动（按距离筛选/排序，ES 不可用时降级 MySQL）
+	NearbyActivities(context.Context, *NearbyActivitiesReq) (*NearbyActivitiesResp, error)
+	// SuggestActivities 搜索建议（输入联想，ES 不可用时降级 MySQL 标题前缀匹配）
+	SuggestActivities(context.Context, *SuggestActivitiesReq) (*SuggestActivitiesResp, error)
+	// ==================== 分类标签接口 ====================
+	ListCategories(context.Context, *ListCategoriesReq) (*ListCategoriesResp, error)
+	ListTags(context.Context, *ListTagsReq) (*ListTagsResp, error)
+	// ==================== 分类标签管理接口（管理端）====================
+	// 分类：活动服务自有数据，变更后失效 CategoryCache 并重建该分类下活动的搜索索引
+	AdminListCategories(context.Context, *AdminListCategoriesReq) (*AdminListCategoriesResp, error)
+	CreateCategory(context.Context, *CreateCategoryReq) (*AdminCategoryResp, error)
+	UpdateCategory(context.Context, *UpdateCategoryReq) (*AdminCategoryResp, error)
+	SetCategoryStatus(context.Context, *SetCategoryStatusReq) (*AdminCategoryResp, error)
+	SortCategories(context.Context, *SortCategoriesReq) (*SortCategoriesResp, error)
+	// DeleteCategory 删除分类（仍有活动引用时拒绝，可改为禁用）
+	DeleteCategory(context.Context, *DeleteCategoryReq) (*DeleteCategoryResp, error)
+	// 标签：写入用户服务后同步 tag_cache，并重建使用该标签的活动的搜索索引
+	AdminListTags(context.Context, *AdminListTagsReq) (*AdminListTagsResp, error)
+	CreateTag(context.Context, *CreateTagReq) (*AdminTagResp, error)
+	UpdateTag(context.Context, *UpdateTagReq) (*AdminTagResp, error)
+	SetTagStatus(context.Context, *SetTagStatusReq) (*AdminTagResp, error)
+	// MergeTags 合并标签（活动关联与用户兴趣改绑到目标标签，源标签禁用）
+	MergeTags(context.Context, *MergeTagsReq) (*MergeTagsResp, error)
+	// ==================== 浏览量接口 ====================
+	IncrViewCount(context.Context, *IncrViewCountReq) (*IncrViewCountResp, error)
+	// ==================== 内部接口（供其他微服务调用）====================
+	// 获取活动基本信息（User/Chat 服务调用）
+	GetActivityBasic(context.Context, *GetActivityBasicReq) (*GetActivityBasicResp, error)
+	// 批量获取活动基本信息
+	BatchGetActivityBasic(context.Context, *BatchGetActivityBasicReq) (*BatchGetActivityBasicResp, error)
+	// 获取用户已发布的活动列表（User 服务调用，用于展示用户主页）
+	GetUserPublishedActivities(context.Context, *GetUserPublishedActivitiesReq) (*GetUserPublishedActivitiesResp, error)
+	mustEmbedUnimplementedActivityServiceServer()
+}
+
+// UnimplementedActivityServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedActivityServiceServer struct{}
+
+func (UnimplementedActivityServiceServer) RegisterActivity(context.Context, *RegisterActivityRequest) (*RegisterActivityResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RegisterActivity not implemented")
+}
+func (UnimplementedActivityServiceServer) CancelActivities(context.Context, *CancelActivityRequest) (*CancelActivityResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelActivities not implemented")
+}
+func (UnimplementedActivityServiceServer) GetActivityList(context.Context, *GetActivityListRequest) (*GetActivityListResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetActivityList not implemented")
+}
+func (UnimplementedActivityServiceServer) VerifyTicket(context.Context, *VerifyTicketRequest) (*VerifyTicketResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method VerifyTicket not implemented")
+}
+func (UnimplementedActivityServiceServer) GetTicketList(context.Context, *GetTicketListRequest) (*GetTicketListResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTicketList not implemented")
+}
+func (UnimplementedActivityServiceServer) GetTicketDetail(context.Context, *GetTicketDetailRequest) (*GetTicketDetailResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTicketDetail not implemented")
+}
+func (UnimplementedActivityServiceServer) GetRegisteredCount(context.Context, *GetRegisteredCountRequest) (*GetRegisteredCountResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRegisteredCount not implemented")
+}
+func (UnimplementedActivityServiceServer) SetEligibilityRules(context.Context, *SetEligibilityRulesReq) (*SetEligibilityRulesResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetEligibilityRules not implemented")
+}
+func (UnimplementedActivityServiceServer) GetEligibilityRules(context.Context, *GetEligibilityRulesReq) (*GetEligibilityRulesResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetEligibilityRules not implemented")
+}
+func (UnimplementedActivityServiceServer) CheckEligibility(context.Context, *CheckEligibilityReq) (*CheckEligibilityResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method CheckEligibility not implemented")
+}
+func (UnimplementedActivityServiceServer) SubmitFeedback(context.Context, *SubmitFeedbackReq) (*SubmitFeedbackResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method SubmitFeedback not implemented")
+}
+func (UnimplementedActivityServiceServer) GetFeedbackSummary(context.Context, *GetFeedbackSummaryReq) (*GetFeedbackSummaryResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetFeedbackSummary not implemented")
+}
+func (UnimplementedActivityServiceServer) ListFeedbackReviews(context.Context, *ListFeedbackReviewsReq) (*ListFeedbackReviewsResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListFeedbackReviews not implemented")
+}
+func (UnimplementedActivityServiceServer) ReviewFeedbackComment(context.Context, *ReviewFeedbackCommentReq) (*ReviewFeedbackCommentResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReviewFeedbackComment not implemented")
+}
+func (UnimplementedActivityServiceServer) CreateActivity(context.Context, *CreateActivityReq) (*CreateActivityResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateActivity not implemented")
+}
+func (UnimplementedActivityServiceServer) UpdateActivity(context.Context, *UpdateActivityReq) (*UpdateActivityResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateActivity not implemented")
+}
+func (UnimplementedActivityServiceServer) DeleteActivity(context.Context, *DeleteActivityReq) (*DeleteActivityResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteActivity not implemented")
+}
+func (UnimplementedActivityServiceServer) GetActivity(context.Context, *GetActivityReq) (*GetActivityResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetActivity not implemented")
+}
+func (UnimplementedActivityServiceServer) ListActivities(context.Context, *ListActivitiesReq) (*ListActivitiesResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListActivities not implemented")
+}
+func (UnimplementedActivityServiceServer) SubmitActivity(context.Context, *SubmitActivityReq) (*SubmitActivityResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method SubmitActivity not implemented")
+}
+func (UnimplementedActivityServiceServer) ApproveActivity(context.Context, *ApproveActivityReq) (*ApproveActivityResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method ApproveActivity not implemented")
+}
+func (UnimplementedActivityServiceServer) RejectActivity(context.Context, *RejectActivityReq) (*RejectActivityResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method RejectActivity not implemented")
 }
 func (UnimplementedActivityServiceServer) CancelActivity(context.Context, *CancelActivityReq) (*CancelActivityResp, error) {
 	return nil, status.Error(codes.Unimplemented, "method CancelActivity not implemented")
 }
-func (UnimplementedActivityServiceServer) SearchActivities(context.Context, *SearchActivitiesReq) (*SearchActivitiesResp, error) {
-	return nil, status.Error(codes.Unimplemented, "method SearchActivities not implemented")
+func (UnimplementedActivityServiceServer) ListReviewQueue(context.Context, *ListReviewQueueReq) (*ListReviewQueueResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListReviewQueue not implemented")
+}
+func (UnimplementedActivityServiceServer) AssignActivityReview(context.Context, *AssignActivityReviewReq) (*AssignActivityReviewResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method AssignActivityReview not implemented")
+}
+func (UnimplementedActivityServiceServer) SubmitActivityChange(context.Context, *SubmitActivityChangeReq) (*SubmitActivityChangeResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method SubmitActivityChange not implemented")
+}
+func (UnimplementedActivityServiceServer) ListActivityChanges(context.Context, *ListActivityChangesReq) (*ListActivityChangesResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListActivityChanges not implemented")
+}
+func (UnimplementedActivityServiceServer) ReviewActivityChange(context.Context, *ReviewActivityChangeReq) (*ReviewActivityChangeResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReviewActivityChange not implemented")
+}
+func (UnimplementedActivityServiceServer) CreateActivitySeries(context.Context, *CreateActivitySeriesReq) (*CreateActivitySeriesResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateActivitySeries not implemented")
+}
+func (UnimplementedActivityServiceServer) GetActivitySeries(context.Context, *GetActivitySeriesReq) (*GetActivitySeriesResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetActivitySeries not implemented")
+}
+func (UnimplementedActivityServiceServer) UpdateActivitySeries(context.Context, *UpdateActivitySeriesReq) (*UpdateActivitySeriesResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateActivitySeries not implemented")
+}
+func (UnimplementedActivityServiceServer) CancelActivitySeries(context.Context, *CancelActivitySeriesReq) (*CancelActivitySeriesResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelActivitySeries not implemented")
+}
+func (UnimplementedActivityServiceServer) RegisterActivitySeries(context.Context, *RegisterActivitySeriesReq) (*RegisterActivitySeriesResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method RegisterActivitySeries not implemented")
+}
+func (UnimplementedActivityServiceServer) GetCalendarFeedToken(context.Context, *GetCalendarFeedTokenReq) (*GetCalendarFeedTokenResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCalendarFeedToken not implemented")
+}
+func (UnimplementedActivityServiceServer) GetCalendarFeed(context.Context, *GetCalendarFeedReq) (*GetCalendarFeedResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCalendarFeed not implemented")
+}
+func (UnimplementedActivityServiceServer) ExportActivityIcs(context.Context, *ExportActivityIcsReq) (*ExportActivityIcsResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportActivityIcs not implemented")
+}
+func (UnimplementedActivityServiceServer) SetSelfCheckIn(context.Context, *SetSelfCheckInReq) (*SetSelfCheckInResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetSelfCheckIn not implemented")
+}
+func (UnimplementedActivityServiceServer) GetSelfCheckInCode(context.Context, *GetSelfCheckInCodeReq) (*GetSelfCheckInCodeResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSelfCheckInCode not implemented")
+}
+func (UnimplementedActivityServiceServer) SelfCheckIn(context.Context, *SelfCheckInReq) (*SelfCheckInResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method SelfCheckIn not implemented")
+}
+func (UnimplementedActivityServiceServer) ListCheckInRecords(context.Context, *ListCheckInRecordsReq) (*ListCheckInRecordsResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCheckInRecords not implemented")
+}
+func (UnimplementedActivityServiceServer) CloneActivity(context.Context, *CloneActivityReq) (*CreateActivityResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method CloneActivity not implemented")
+}
+func (UnimplementedActivityServiceServer) SaveActivityTemplate(context.Context, *SaveActivityTemplateReq) (*ActivityTemplateResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method SaveActivityTemplate not implemented")
+}
+func (UnimplementedActivityServiceServer) ListActivityTemplates(context.Context, *ListActivityTemplatesReq) (*ListActivityTemplatesResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListActivityTemplates not implemented")
+}
+func (UnimplementedActivityServiceServer) CreateActivityFromTemplate(context.Context, *CreateActivityFromTemplateReq) (*CreateActivityResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateActivityFromTemplate not implemented")
+}
+func (UnimplementedActivityServiceServer) DeleteActivityTemplate(context.Context, *DeleteActivityTemplateReq) (*DeleteActivityTemplateResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteActivityTemplate not implemented")
+}
+func (UnimplementedActivityServiceServer) AdminCreatePublicTemplate(context.Context, *AdminCreatePublicTemplateReq) (*ActivityTemplateResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method AdminCreatePublicTemplate not implemented")
+}
+func (UnimplementedActivityServiceServer) AdminUpdateTemplate(context.Context, *AdminUpdateTemplateReq) (*ActivityTemplateResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method AdminUpdateTemplate not implemented")
+}
+func (UnimplementedActivityServiceServer) GetActivityAnalytics(context.Context, *GetActivityAnalyticsReq) (*GetActivityAnalyticsResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetActivityAnalytics not implemented")
+}
+func (UnimplementedActivityServiceServer) GetOrganizerAnalytics(context.Context, *GetOrganizerAnalyticsReq) (*GetOrganizerAnalyticsResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetOrganizerAnalytics not implemented")
+}
+func (UnimplementedActivityServiceServer) FavoriteActivity(context.Context, *FavoriteActivityReq) (*FavoriteActivityResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method FavoriteActivity not implemented")
+}
+func (UnimplementedActivityServiceServer) SearchActivities(context.Context, *SearchActivitiesReq) (*SearchActivitiesResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method SearchActivities not implemented")
+}
+func (UnimplementedActivityServiceServer) GetHotActivities(context.Context, *GetHotActivitiesReq) (*GetHotActivitiesResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetHotActivities not implemented")
+}
+func (UnimplementedActivityServiceServer) NearbyActivities(context.Context, *NearbyActivitiesReq) (*NearbyActivitiesResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method NearbyActivities not implemented")
+}
+func (UnimplementedActivityServiceServer) SuggestActivities(context.Context, *SuggestActivitiesReq) (*SuggestActivitiesResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method SuggestActivities not implemented")
+}
+func (UnimplementedActivityServiceServer) ListCategories(context.Context, *ListCategoriesReq) (*ListCategoriesResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCategories not implemented")
+}
+func (UnimplementedActivityServiceServer) ListTags(context.Context, *ListTagsReq) (*ListTagsResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTags not implemented")
+}
+func (UnimplementedActivityServiceServer) AdminListCategories(context.Context, *AdminListCategoriesReq) (*AdminListCategoriesResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method AdminListCategories not implemented")
+}
+func (UnimplementedActivityServiceServer) CreateCategory(context.Context, *CreateCategoryReq) (*AdminCategoryResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateCategory not implemented")
+}
+func (UnimplementedActivityServiceServer) UpdateCategory(context.Context, *UpdateCategoryReq) (*AdminCategoryResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateCategory not implemented")
+}
+func (UnimplementedActivityServiceServer) SetCategoryStatus(context.Context, *SetCategoryStatusReq) (*AdminCategoryResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetCategoryStatus not implemented")
+}
+func (UnimplementedActivityServiceServer) SortCategories(context.Context, *SortCategoriesReq) (*SortCategoriesResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method SortCategories not implemented")
+}
+func (UnimplementedActivityServiceServer) DeleteCategory(context.Context, *DeleteCategoryReq) (*DeleteCategoryResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteCategory not implemented")
+}
+func (UnimplementedActivityServiceServer) AdminListTags(context.Context, *AdminListTagsReq) (*AdminListTagsResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method AdminListTags not implemented")
+}
+func (UnimplementedActivityServiceServer) CreateTag(context.Context, *CreateTagReq) (*AdminTagResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateTag not implemented")
+}
+func (UnimplementedActivityServiceServer) UpdateTag(context.Context, *UpdateTagReq) (*AdminTagResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateTag not implemented")
+}
+func (UnimplementedActivityServiceServer) SetTagStatus(context.Context, *SetTagStatusReq) (*AdminTagResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetTagStatus not implemented")
+}
+func (UnimplementedActivityServiceServer) MergeTags(context.Context, *MergeTagsReq) (*MergeTagsResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method MergeTags not implemented")
+}
+func (UnimplementedActivityServiceServer) IncrViewCount(context.Context, *IncrViewCountReq) (*IncrViewCountResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method IncrViewCount not implemented")
+}
+func (UnimplementedActivityServiceServer) GetActivityBasic(context.Context, *GetActivityBasicReq) (*GetActivityBasicResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetActivityBasic not implemented")
+}
+func (UnimplementedActivityServiceServer) BatchGetActivityBasic(context.Context, *BatchGetActivityBasicReq) (*BatchGetActivityBasicResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method BatchGetActivityBasic not implemented")
+}
+func (UnimplementedActivityServiceServer) GetUserPublishedActivities(context.Context, *GetUserPublishedActivitiesReq) (*GetUserPublishedActivitiesResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUserPublishedActivities not implemented")
+}
+func (UnimplementedActivityServiceServer) mustEmbedUnimplementedActivityServiceServer() {}
+func (UnimplementedActivityServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeActivityServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ActivityServiceServer will
+// result in compilation errors.
+type UnsafeActivityServiceServer interface {
+	mustEmbedUnimplementedActivityServiceServer()
+}
+
+func RegisterActivityServiceServer(s grpc.ServiceRegistrar, srv ActivityServiceServer) {
+	// If the following call panics, it indicates UnimplementedActivityServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ActivityService_ServiceDesc, srv)
+}
+
+func _ActivityService_RegisterActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterActivityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServiceServer).RegisterActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActivityService_RegisterActivity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServiceServer).RegisterActivity(ctx, req.(*RegisterActivityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ActivityService_CancelActivities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelActivityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServiceServer).CancelActivities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActivityService_CancelActivities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServiceServer).CancelActivities(ctx, req.(*CancelActivityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ActivityService_GetActivityList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetActivityListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServiceServer).GetActivityList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActivityService_GetActivityList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServiceServer).GetActivityList(ctx, req.(*GetActivityListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ActivityService_VerifyTicket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyTicketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServiceServer).VerifyTicket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActivityService_VerifyTicket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServiceServer).VerifyTicket(ctx, req.(*VerifyTicketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ActivityService_GetTicketList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTicketListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServiceServer).GetTicketList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActivityService_GetTicketList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServiceServer).GetTicketList(ctx, req.(*GetTicketListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ActivityService_GetTicketDetail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTicketDetailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServiceServer).GetTicketDetail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActivityService_GetTicketDetail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServiceServer).GetTicketDetail(ctx, req.(*GetTicketDetailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ActivityService_GetRegisteredCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRegisteredCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServiceServer).GetRegisteredCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActivityService_GetRegisteredCount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServiceServer).GetRegisteredCount(ctx, req.(*GetRegisteredCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ActivityService_SetEligibilityRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetEligibilityRulesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServiceServer).SetEligibilityRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActivityService_SetEligibilityRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServiceServer).SetEligibilityRules(ctx, req.(*SetEligibilityRulesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ActivityService_GetEligibilityRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEligibilityRulesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServiceServer).GetEligibilityRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActivityService_GetEligibilityRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServiceServer).GetEligibilityRules(ctx, req.(*GetEligibilityRulesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ActivityService_CheckEligibility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckEligibilityReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServiceServer).CheckEligibility(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActivityService_CheckEligibility_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServiceServer).CheckEligibility(ctx, req.(*CheckEligibilityReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ActivityService_SubmitFeedback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitFeedbackReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServiceServer).SubmitFeedback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActivityService_SubmitFeedback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServiceServer).SubmitFeedback(ctx, req.(*SubmitFeedbackReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ActivityService_GetFeedbackSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFeedbackSummaryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServiceServer).GetFeedbackSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActivityService_GetFeedbackSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServiceServer).GetFeedbackSummary(ctx, req.(*GetFeedbackSummaryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ActivityService_ListFeedbackReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFeedbackReviewsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServiceServer).ListFeedbackReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActivityService_ListFeedbackReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServiceServer).ListFeedbackReviews(ctx, req.(*ListFeedbackReviewsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ActivityService_ReviewFeedbackComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewFeedbackCommentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServiceServer).ReviewFeedbackComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActivityService_ReviewFeedbackComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServiceServer).ReviewFeedbackComment(ctx, req.(*ReviewFeedbackCommentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ActivityService_CreateActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateActivityReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServiceServer).CreateActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActivityService_CreateActivity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServiceServer).CreateActivity(ctx, req.(*CreateActivityReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ActivityService_UpdateActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateActivityReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServiceServer).UpdateActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActivityService_UpdateActivity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServiceServer).UpdateActivity(ctx, req.(*UpdateActivityReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ActivityService_DeleteActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteActivityReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServiceServer).DeleteActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActivityService_DeleteActivity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServiceServer).DeleteActivity(ctx, req.(*DeleteActivityReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ActivityService_GetActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetActivityReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServiceServer).GetActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActivityService_GetActivity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServiceServer).GetActivity(ctx, req.(*GetActivityReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ActivityService_ListActivities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListActivitiesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServiceServer).ListActivities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActivityService_ListActivities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServiceServer).ListActivities(ctx, req.(*ListActivitiesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ActivityService_SubmitActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitActivityReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServiceServer).SubmitActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActivityService_SubmitActivity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServiceServer).SubmitActivity(ctx, req.(*SubmitActivityReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ActivityService_ApproveActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveActivityReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServiceServer).ApproveActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActivityService_ApproveActivity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServiceServer).ApproveActivity(ctx, req.(*ApproveActivityReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ActivityService_RejectActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectActivityReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServiceServer).RejectActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActivityService_RejectActivity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServiceServer).RejectActivity(ctx, req.(*RejectActivityReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ActivityService_CancelActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelActivityReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServiceServer).CancelActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActivityService_CancelActivity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServiceServer).CancelActivity(ctx, req.(*CancelActivityReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ActivityService_ListReviewQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReviewQueueReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServiceServer).ListReviewQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActivityService_ListReviewQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServiceServer).ListReviewQueue(ctx, req.(*ListReviewQueueReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ActivityService_AssignActivityReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignActivityReviewReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServiceServer).AssignActivityReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActivityService_AssignActivityReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServiceServer).AssignActivityReview(ctx, req.(*AssignActivityReviewReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ActivityService_SubmitActivityChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitActivityChangeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServiceServer).SubmitActivityChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActivityService_SubmitActivityChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServiceServer).SubmitActivityChange(ctx, req.(*SubmitActivityChangeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ActivityService_ListActivityChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListActivityChangesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServiceServer).ListActivityChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActivityService_ListActivityChanges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServiceServer).ListActivityChanges(ctx, req.(*ListActivityChangesReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedActivityServiceServer) GetHotActivities(context.Context, *GetHotActivitiesReq) (*GetHotActivitiesResp, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetHotActivities not implemented")
+
+func _ActivityService_ReviewActivityChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewActivityChangeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServiceServer).ReviewActivityChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActivityService_ReviewActivityChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServiceServer).ReviewActivityChange(ctx, req.(*ReviewActivityChangeReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedActivityServiceServer) ListCategories(context.Context, *ListCategoriesReq) (*ListCategoriesResp, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListCategories not implemented")
+
+func _ActivityService_CreateActivitySeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateActivitySeriesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServiceServer).CreateActivitySeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActivityService_CreateActivitySeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServiceServer).CreateActivitySeries(ctx, req.(*CreateActivitySeriesReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedActivityServiceServer) ListTags(context.Context, *ListTagsReq) (*ListTagsResp, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListTags not implemented")
+
+func _ActivityService_GetActivitySeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetActivitySeriesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServiceServer).GetActivitySeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActivityService_GetActivitySeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServiceServer).GetActivitySeries(ctx, req.(*GetActivitySeriesReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedActivityServiceServer) IncrViewCount(context.Context, *IncrViewCountReq) (*IncrViewCountResp, error) {
-	return nil, status.Error(codes.Unimplemented, "method IncrViewCount not implemented")
+
+func _ActivityService_UpdateActivitySeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateActivitySeriesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServiceServer).UpdateActivitySeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActivityService_UpdateActivitySeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServiceServer).UpdateActivitySeries(ctx, req.(*UpdateActivitySeriesReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedActivityServiceServer) GetActivityBasic(context.Context, *GetActivityBasicReq) (*GetActivityBasicResp, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetActivityBasic not implemented")
+
+func _ActivityService_CancelActivitySeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelActivitySeriesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServiceServer).CancelActivitySeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActivityService_CancelActivitySeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServiceServer).CancelActivitySeries(ctx, req.(*CancelActivitySeriesReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedActivityServiceServer) BatchGetActivityBasic(context.Context, *BatchGetActivityBasicReq) (*BatchGetActivityBasicResp, error) {
-	return nil, status.Error(codes.Unimplemented, "method BatchGetActivityBasic not implemented")
+
+func _ActivityService_RegisterActivitySeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterActivitySeriesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServiceServer).RegisterActivitySeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActivityService_RegisterActivitySeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServiceServer).RegisterActivitySeries(ctx, req.(*RegisterActivitySeriesReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedActivityServiceServer) GetUserPublishedActivities(context.Context, *GetUserPublishedActivitiesReq) (*GetUserPublishedActivitiesResp, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetUserPublishedActivities not implemented")
+
+func _ActivityService_GetCalendarFeedToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCalendarFeedTokenReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServiceServer).GetCalendarFeedToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActivityService_GetCalendarFeedToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServiceServer).GetCalendarFeedToken(ctx, req.(*GetCalendarFeedTokenReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ActivityService_GetCalendarFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCalendarFeedReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServiceServer).GetCalendarFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActivityService_GetCalendarFeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServiceServer).GetCalendarFeed(ctx, req.(*GetCalendarFeedReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ActivityService_ExportActivityIcs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportActivityIcsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServiceServer).ExportActivityIcs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActivityService_ExportActivityIcs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServiceServer).ExportActivityIcs(ctx, req.(*ExportActivityIcsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ActivityService_SetSelfCheckIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSelfCheckInReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServiceServer).SetSelfCheckIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActivityService_SetSelfCheckIn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServiceServer).SetSelfCheckIn(ctx, req.(*SetSelfCheckInReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ActivityService_GetSelfCheckInCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSelfCheckInCodeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServiceServer).GetSelfCheckInCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActivityService_GetSelfCheckInCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServiceServer).GetSelfCheckInCode(ctx, req.(*GetSelfCheckInCodeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ActivityService_SelfCheckIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SelfCheckInReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServiceServer).SelfCheckIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActivityService_SelfCheckIn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServiceServer).SelfCheckIn(ctx, req.(*SelfCheckInReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ActivityService_ListCheckInRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCheckInRecordsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServiceServer).ListCheckInRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActivityService_ListCheckInRecords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServiceServer).ListCheckInRecords(ctx, req.(*ListCheckInRecordsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ActivityService_CloneActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneActivityReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServiceServer).CloneActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActivityService_CloneActivity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServiceServer).CloneActivity(ctx, req.(*CloneActivityReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ActivityService_SaveActivityTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveActivityTemplateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServiceServer).SaveActivityTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActivityService_SaveActivityTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServiceServer).SaveActivityTemplate(ctx, req.(*SaveActivityTemplateReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ActivityService_ListActivityTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListActivityTemplatesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServiceServer).ListActivityTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActivityService_ListActivityTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServiceServer).ListActivityTemplates(ctx, req.(*ListActivityTemplatesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ActivityService_CreateActivityFromTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateActivityFromTemplateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServiceServer).CreateActivityFromTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActivityService_CreateActivityFromTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServiceServer).CreateActivityFromTemplate(ctx, req.(*CreateActivityFromTemplateReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ActivityService_DeleteActivityTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteActivityTemplateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServiceServer).DeleteActivityTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActivityService_DeleteActivityTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServiceServer).DeleteActivityTemplate(ctx, req.(*DeleteActivityTemplateReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedActivityServiceServer) mustEmbedUnimplementedActivityServiceServer() {}
-func (UnimplementedActivityServiceServer) testEmbeddedByValue()                         {}
 
-// UnsafeActivityServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to ActivityServiceServer will
-// result in compilation errors.
-type UnsafeActivityServiceServer interface {
-	mustEmbedUnimplementedActivityServiceServer()
+func _ActivityService_AdminCreatePublicTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminCreatePublicTemplateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServiceServer).AdminCreatePublicTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActivityService_AdminCreatePublicTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServiceServer).AdminCreatePublicTemplate(ctx, req.(*AdminCreatePublicTemplateReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterActivityServiceServer(s grpc.ServiceRegistrar, srv ActivityServiceServer) {
-	// If the following call panics, it indicates UnimplementedActivityServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _ActivityService_AdminUpdateTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminUpdateTemplateReq)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&ActivityService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(ActivityServiceServer).AdminUpdateTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActivityService_AdminUpdateTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServiceServer).AdminUpdateTemplate(ctx, req.(*AdminUpdateTemplateReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _ActivityService_RegisterActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RegisterActivityRequest)
+func _ActivityService_GetActivityAnalytics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetActivityAnalyticsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ActivityServiceServer).RegisterActivity(ctx, in)
+		return srv.(ActivityServiceServer).GetActivityAnalytics(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ActivityService_RegisterActivity_FullMethodName,
+		FullMethod: ActivityService_GetActivityAnalytics_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ActivityServiceServer).RegisterActivity(ctx, req.(*RegisterActivityRequest))
+		return srv.(ActivityServiceServer).GetActivityAnalytics(ctx, req.(*GetActivityAnalyticsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ActivityService_CancelActivities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CancelActivityRequest)
+func _ActivityService_GetOrganizerAnalytics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrganizerAnalyticsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ActivityServiceServer).CancelActivities(ctx, in)
+		return srv.(ActivityServiceServer).GetOrganizerAnalytics(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ActivityService_CancelActivities_FullMethodName,
+		FullMethod: ActivityService_GetOrganizerAnalytics_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ActivityServiceServer).CancelActivities(ctx, req.(*CancelActivityRequest))
+		return srv.(ActivityServiceServer).GetOrganizerAnalytics(ctx, req.(*GetOrganizerAnalyticsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ActivityService_GetActivityList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetActivityListRequest)
+func _ActivityService_FavoriteActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FavoriteActivityReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ActivityServiceServer).GetActivityList(ctx, in)
+		return srv.(ActivityServiceServer).FavoriteActivity(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ActivityService_GetActivityList_FullMethodName,
+		FullMethod: ActivityService_FavoriteActivity_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ActivityServiceServer).GetActivityList(ctx, req.(*GetActivityListRequest))
+		return srv.(ActivityServiceServer).FavoriteActivity(ctx, req.(*FavoriteActivityReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ActivityService_VerifyTicket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(VerifyTicketRequest)
+func _ActivityService_SearchActivities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchActivitiesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ActivityServiceServer).VerifyTicket(ctx, in)
+		return srv.(ActivityServiceServer).SearchActivities(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ActivityService_VerifyTicket_FullMethodName,
+		FullMethod: ActivityService_SearchActivities_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ActivityServiceServer).VerifyTicket(ctx, req.(*VerifyTicketRequest))
+		return srv.(ActivityServiceServer).SearchActivities(ctx, req.(*SearchActivitiesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ActivityService_GetTicketList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTicketListRequest)
+func _ActivityService_GetHotActivities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHotActivitiesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ActivityServiceServer).GetTicketList(ctx, in)
+		return srv.(ActivityServiceServer).GetHotActivities(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ActivityService_GetTicketList_FullMethodName,
+		FullMethod: ActivityService_GetHotActivities_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ActivityServiceServer).GetTicketList(ctx, req.(*GetTicketListRequest))
+		return srv.(ActivityServiceServer).GetHotActivities(ctx, req.(*GetHotActivitiesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ActivityService_GetTicketDetail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTicketDetailRequest)
+func _ActivityService_NearbyActivities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NearbyActivitiesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ActivityServiceServer).GetTicketDetail(ctx, in)
+		return srv.(ActivityServiceServer).NearbyActivities(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ActivityService_GetTicketDetail_FullMethodName,
+		FullMethod: ActivityService_NearbyActivities_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ActivityServiceServer).GetTicketDetail(ctx, req.(*GetTicketDetailRequest))
+		return srv.(ActivityServiceServer).NearbyActivities(ctx, req.(*NearbyActivitiesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ActivityService_GetRegisteredCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRegisteredCountRequest)
+func _ActivityService_SuggestActivities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestActivitiesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ActivityServiceServer).GetRegisteredCount(ctx, in)
+		return srv.(ActivityServiceServer).SuggestActivities(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ActivityService_GetRegisteredCount_FullMethodName,
+		FullMethod: ActivityService_SuggestActivities_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ActivityServiceServer).GetRegisteredCount(ctx, req.(*GetRegisteredCountRequest))
+		return srv.(ActivityServiceServer).SuggestActivities(ctx, req.(*SuggestActivitiesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ActivityService_CreateActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateActivityReq)
+func _ActivityService_ListCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCategoriesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ActivityServiceServer).CreateActivity(ctx, in)
+		return srv.(ActivityServiceServer).ListCategories(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ActivityService_CreateActivity_FullMethodName,
+		FullMethod: ActivityService_ListCategories_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ActivityServiceServer).CreateActivity(ctx, req.(*CreateActivityReq))
+		return srv.(ActivityServiceServer).ListCategories(ctx, req.(*ListCategoriesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ActivityService_UpdateActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateActivityReq)
+func _ActivityService_ListTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTagsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ActivityServiceServer).UpdateActivity(ctx, in)
+		return srv.(ActivityServiceServer).ListTags(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ActivityService_UpdateActivity_FullMethodName,
+		FullMethod: ActivityService_ListTags_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ActivityServiceServer).UpdateActivity(ctx, req.(*UpdateActivityReq))
+		return srv.(ActivityServiceServer).ListTags(ctx, req.(*ListTagsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ActivityService_DeleteActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteActivityReq)
+func _ActivityService_AdminListCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminListCategoriesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ActivityServiceServer).DeleteActivity(ctx, in)
+		return srv.(ActivityServiceServer).AdminListCategories(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ActivityService_DeleteActivity_FullMethodName,
+		FullMethod: ActivityService_AdminListCategories_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ActivityServiceServer).DeleteActivity(ctx, req.(*DeleteActivityReq))
+		return srv.(ActivityServiceServer).AdminListCategories(ctx, req.(*AdminListCategoriesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ActivityService_GetActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetActivityReq)
+func _ActivityService_CreateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCategoryReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ActivityServiceServer).GetActivity(ctx, in)
+		return srv.(ActivityServiceServer).CreateCategory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ActivityService_GetActivity_FullMethodName,
+		FullMethod: ActivityService_CreateCategory_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ActivityServiceServer).GetActivity(ctx, req.(*GetActivityReq))
+		return srv.(ActivityServiceServer).CreateCategory(ctx, req.(*CreateCategoryReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ActivityService_ListActivities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListActivitiesReq)
+func _ActivityService_UpdateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCategoryReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ActivityServiceServer).ListActivities(ctx, in)
+		return srv.(ActivityServiceServer).UpdateCategory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ActivityService_ListActivities_FullMethodName,
+		FullMethod: ActivityService_UpdateCategory_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ActivityServiceServer).ListActivities(ctx, req.(*ListActivitiesReq))
+		return srv.(ActivityServiceServer).UpdateCategory(ctx, req.(*UpdateCategoryReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ActivityService_SubmitActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SubmitActivityReq)
+func _ActivityService_SetCategoryStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCategoryStatusReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ActivityServiceServer).SubmitActivity(ctx, in)
+		return srv.(ActivityServiceServer).SetCategoryStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ActivityService_SubmitActivity_FullMethodName,
+		FullMethod: ActivityService_SetCategoryStatus_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ActivityServiceServer).SubmitActivity(ctx, req.(*SubmitActivityReq))
+		return srv.(ActivityServiceServer).SetCategoryStatus(ctx, req.(*SetCategoryStatusReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ActivityService_ApproveActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ApproveActivityReq)
+func _ActivityService_SortCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SortCategoriesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ActivityServiceServer).ApproveActivity(ctx, in)
+		return srv.(ActivityServiceServer).SortCategories(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ActivityService_ApproveActivity_FullMethodName,
+		FullMethod: ActivityService_SortCategories_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ActivityServiceServer).ApproveActivity(ctx, req.(*ApproveActivityReq))
+		return srv.(ActivityServiceServer).SortCategories(ctx, req.(*SortCategoriesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ActivityService_RejectActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RejectActivityReq)
+func _ActivityService_DeleteCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCategoryReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ActivityServiceServer).RejectActivity(ctx, in)
+		return srv.(ActivityServiceServer).DeleteCategory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ActivityService_RejectActivity_FullMethodName,
+		FullMethod: ActivityService_DeleteCategory_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ActivityServiceServer).RejectActivity(ctx, req.(*RejectActivityReq))
+		return srv.(ActivityServiceServer).DeleteCategory(ctx, req.(*DeleteCategoryReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ActivityService_CancelActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CancelActivityReq)
+func _ActivityService_AdminListTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminListTagsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ActivityServiceServer).CancelActivity(ctx, in)
+		return srv.(ActivityServiceServer).AdminListTags(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ActivityService_CancelActivity_FullMethodName,
+		FullMethod: ActivityService_AdminListTags_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ActivityServiceServer).CancelActivity(ctx, req.(*CancelActivityReq))
+		return srv.(ActivityServiceServer).AdminListTags(ctx, req.(*AdminListTagsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ActivityService_SearchActivities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SearchActivitiesReq)
+func _ActivityService_CreateTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTagReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ActivityServiceServer).SearchActivities(ctx, in)
+		return srv.(ActivityServiceServer).CreateTag(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ActivityService_SearchActivities_FullMethodName,
+		FullMethod: ActivityService_CreateTag_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ActivityServiceServer).SearchActivities(ctx, req.(*SearchActivitiesReq))
+		return srv.(ActivityServiceServer).CreateTag(ctx, req.(*CreateTagReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ActivityService_GetHotActivities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetHotActivitiesReq)
+func _ActivityService_UpdateTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTagReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ActivityServiceServer).GetHotActivities(ctx, in)
+		return srv.(ActivityServiceServer).UpdateTag(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ActivityService_GetHotActivities_FullMethodName,
+		FullMethod: ActivityService_UpdateTag_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ActivityServiceServer).GetHotActivities(ctx, req.(*GetHotActivitiesReq))
+		return srv.(ActivityServiceServer).UpdateTag(ctx, req.(*UpdateTagReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ActivityService_ListCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListCategoriesReq)
+func _ActivityService_SetTagStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTagStatusReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ActivityServiceServer).ListCategories(ctx, in)
+		return srv.(ActivityServiceServer).SetTagStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ActivityService_ListCategories_FullMethodName,
+		FullMethod: ActivityService_SetTagStatus_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ActivityServiceServer).ListCategories(ctx, req.(*ListCategoriesReq))
+		return srv.(ActivityServiceServer).SetTagStatus(ctx, req.(*SetTagStatusReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ActivityService_ListTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTagsReq)
+func _ActivityService_MergeTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeTagsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ActivityServiceServer).ListTags(ctx, in)
+		return srv.(ActivityServiceServer).MergeTags(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ActivityService_ListTags_FullMethodName,
+		FullMethod: ActivityService_MergeTags_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ActivityServiceServer).ListTags(ctx, req.(*ListTagsReq))
+		return srv.(ActivityServiceServer).MergeTags(ctx, req.(*MergeTagsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -955,6 +2651,34 @@ var ActivityService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetRegisteredCount",
 			Handler:    _ActivityService_GetRegisteredCount_Handler,
 		},
+		{
+			MethodName: "SetEligibilityRules",
+			Handler:    _ActivityService_SetEligibilityRules_Handler,
+		},
+		{
+			MethodName: "GetEligibilityRules",
+			Handler:    _ActivityService_GetEligibilityRules_Handler,
+		},
+		{
+			MethodName: "CheckEligibility",
+			Handler:    _ActivityService_CheckEligibility_Handler,
+		},
+		{
+			MethodName: "SubmitFeedback",
+			Handler:    _ActivityService_SubmitFeedback_Handler,
+		},
+		{
+			MethodName: "GetFeedbackSummary",
+			Handler:    _ActivityService_GetFeedbackSummary_Handler,
+		},
+		{
+			MethodName: "ListFeedbackReviews",
+			Handler:    _ActivityService_ListFeedbackReviews_Handler,
+		},
+		{
+			MethodName: "ReviewFeedbackComment",
+			Handler:    _ActivityService_ReviewFeedbackComment_Handler,
+		},
 		{
 			MethodName: "CreateActivity",
 			Handler:    _ActivityService_CreateActivity_Handler,
@@ -991,6 +2715,114 @@ var ActivityService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelActivity",
 			Handler:    _ActivityService_CancelActivity_Handler,
 		},
+		{
+			MethodName: "ListReviewQueue",
+			Handler:    _ActivityService_ListReviewQueue_Handler,
+		},
+		{
+			MethodName: "AssignActivityReview",
+			Handler:    _ActivityService_AssignActivityReview_Handler,
+		},
+		{
+			MethodName: "SubmitActivityChange",
+			Handler:    _ActivityService_SubmitActivityChange_Handler,
+		},
+		{
+			MethodName: "ListActivityChanges",
+			Handler:    _ActivityService_ListActivityChanges_Handler,
+		},
+		{
+			MethodName: "ReviewActivityChange",
+			Handler:    _ActivityService_ReviewActivityChange_Handler,
+		},
+		{
+			MethodName: "CreateActivitySeries",
+			Handler:    _ActivityService_CreateActivitySeries_Handler,
+		},
+		{
+			MethodName: "GetActivitySeries",
+			Handler:    _ActivityService_GetActivitySeries_Handler,
+		},
+		{
+			MethodName: "UpdateActivitySeries",
+			Handler:    _ActivityService_UpdateActivitySeries_Handler,
+		},
+		{
+			MethodName: "CancelActivitySeries",
+			Handler:    _ActivityService_CancelActivitySeries_Handler,
+		},
+		{
+			MethodName: "RegisterActivitySeries",
+			Handler:    _ActivityService_RegisterActivitySeries_Handler,
+		},
+		{
+			MethodName: "GetCalendarFeedToken",
+			Handler:    _ActivityService_GetCalendarFeedToken_Handler,
+		},
+		{
+			MethodName: "GetCalendarFeed",
+			Handler:    _ActivityService_GetCalendarFeed_Handler,
+		},
+		{
+			MethodName: "ExportActivityIcs",
+			Handler:    _ActivityService_ExportActivityIcs_Handler,
+		},
+		{
+			MethodName: "SetSelfCheckIn",
+			Handler:    _ActivityService_SetSelfCheckIn_Handler,
+		},
+		{
+			MethodName: "GetSelfCheckInCode",
+			Handler:    _ActivityService_GetSelfCheckInCode_Handler,
+		},
+		{
+			MethodName: "SelfCheckIn",
+			Handler:    _ActivityService_SelfCheckIn_Handler,
+		},
+		{
+			MethodName: "ListCheckInRecords",
+			Handler:    _ActivityService_ListCheckInRecords_Handler,
+		},
+		{
+			MethodName: "CloneActivity",
+			Handler:    _ActivityService_CloneActivity_Handler,
+		},
+		{
+			MethodName: "SaveActivityTemplate",
+			Handler:    _ActivityService_SaveActivityTemplate_Handler,
+		},
+		{
+			MethodName: "ListActivityTemplates",
+			Handler:    _ActivityService_ListActivityTemplates_Handler,
+		},
+		{
+			MethodName: "CreateActivityFromTemplate",
+			Handler:    _ActivityService_CreateActivityFromTemplate_Handler,
+		},
+		{
+			MethodName: "DeleteActivityTemplate",
+			Handler:    _ActivityService_DeleteActivityTemplate_Handler,
+		},
+		{
+			MethodName: "AdminCreatePublicTemplate",
+			Handler:    _ActivityService_AdminCreatePublicTemplate_Handler,
+		},
+		{
+			MethodName: "AdminUpdateTemplate",
+			Handler:    _ActivityService_AdminUpdateTemplate_Handler,
+		},
+		{
+			MethodName: "GetActivityAnalytics",
+			Handler:    _ActivityService_GetActivityAnalytics_Handler,
+		},
+		{
+			MethodName: "GetOrganizerAnalytics",
+			Handler:    _ActivityService_GetOrganizerAnalytics_Handler,
+		},
+		{
+			MethodName: "FavoriteActivity",
+			Handler:    _ActivityService_FavoriteActivity_Handler,
+		},
 		{
 			MethodName: "SearchActivities",
 			Handler:    _ActivityService_SearchActivities_Handler,
@@ -999,6 +2831,14 @@ var ActivityService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetHotActivities",
 			Handler:    _ActivityService_GetHotActivities_Handler,
 		},
+		{
+			MethodName: "NearbyActivities",
+			Handler:    _ActivityService_NearbyActivities_Handler,
+		},
+		{
+			MethodName: "SuggestActivities",
+			Handler:    _ActivityService_SuggestActivities_Handler,
+		},
 		{
 			MethodName: "ListCategories",
 			Handler:    _ActivityService_ListCategories_Handler,
@@ -1007,6 +2847,50 @@ var ActivityService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListTags",
 			Handler:    _ActivityService_ListTags_Handler,
 		},
+		{
+			MethodName: "AdminListCategories",
+			Handler:    _ActivityService_AdminListCategories_Handler,
+		},
+		{
+			MethodName: "CreateCategory",
+			Handler:    _ActivityService_CreateCategory_Handler,
+		},
+		{
+			MethodName: "UpdateCategory",
+			Handler:    _ActivityService_UpdateCategory_Handler,
+		},
+		{
+			MethodName: "SetCategoryStatus",
+			Handler:    _ActivityService_SetCategoryStatus_Handler,
+		},
+		{
+			MethodName: "SortCategories",
+			Handler:    _ActivityService_SortCategories_Handler,
+		},
+		{
+			MethodName: "DeleteCategory",
+			Handler:    _ActivityService_DeleteCategory_Handler,
+		},
+		{
+			MethodName: "AdminListTags",
+			Handler:    _ActivityService_AdminListTags_Handler,
+		},
+		{
+			MethodName: "CreateTag",
+			Handler:    _ActivityService_CreateTag_Handler,
+		},
+		{
+			MethodName: "UpdateTag",
+			Handler:    _ActivityService_UpdateTag_Handler,
+		},
+		{
+			MethodName: "SetTagStatus",
+			Handler:    _ActivityService_SetTagStatus_Handler,
+		},
+		{
+			MethodName: "MergeTags",
+			Handler:    _ActivityService_MergeTags_Handler,
+		},
 		{
 			MethodName: "IncrViewCount",
 			Handler:    _ActivityService_IncrViewCount_Handler,
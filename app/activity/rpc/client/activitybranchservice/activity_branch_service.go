@@ -14,40 +14,102 @@ import (
 )
 
 type (
+	ActivityAnalyticsBrief         = activity.ActivityAnalyticsBrief
+	ActivityChangeInfo             = activity.ActivityChangeInfo
 	ActivityDetail                 = activity.ActivityDetail
 	ActivityListItem               = activity.ActivityListItem
 	ActivityListItems              = activity.ActivityListItems
+	ActivitySeriesInfo             = activity.ActivitySeriesInfo
+	ActivityTemplate               = activity.ActivityTemplate
+	ActivityTemplateResp           = activity.ActivityTemplateResp
+	AdminCategory                  = activity.AdminCategory
+	AdminCategoryResp              = activity.AdminCategoryResp
+	AdminCreatePublicTemplateReq   = activity.AdminCreatePublicTemplateReq
+	AdminListCategoriesReq         = activity.AdminListCategoriesReq
+	AdminListCategoriesResp        = activity.AdminListCategoriesResp
+	AdminListTagsReq               = activity.AdminListTagsReq
+	AdminListTagsResp              = activity.AdminListTagsResp
+	AdminTag                       = activity.AdminTag
+	AdminTagResp                   = activity.AdminTagResp
+	AdminUpdateTemplateReq         = activity.AdminUpdateTemplateReq
+	AnalyticsDaily                 = activity.AnalyticsDaily
+	AnalyticsFunnel                = activity.AnalyticsFunnel
 	ApproveActivityReq             = activity.ApproveActivityReq
 	ApproveActivityResp            = activity.ApproveActivityResp
+	AssignActivityReviewReq        = activity.AssignActivityReviewReq
+	AssignActivityReviewResp       = activity.AssignActivityReviewResp
+	AudienceBreakdown              = activity.AudienceBreakdown
+	AudienceBucket                 = activity.AudienceBucket
 	BatchGetActivityBasicReq       = activity.BatchGetActivityBasicReq
 	BatchGetActivityBasicResp      = activity.BatchGetActivityBasicResp
 	CancelActivityReq              = activity.CancelActivityReq
 	CancelActivityRequest          = activity.CancelActivityRequest
 	CancelActivityResp             = activity.CancelActivityResp
 	CancelActivityResponse         = activity.CancelActivityResponse
+	CancelActivitySeriesReq        = activity.CancelActivitySeriesReq
+	CancelActivitySeriesResp       = activity.CancelActivitySeriesResp
 	Category                       = activity.Category
+	CategorySortItem               = activity.CategorySortItem
+	CheckEligibilityReq            = activity.CheckEligibilityReq
+	CheckEligibilityResp           = activity.CheckEligibilityResp
+	CheckInRecordItem              = activity.CheckInRecordItem
+	CloneActivityReq               = activity.CloneActivityReq
 	CreateActivityActionReq        = activity.CreateActivityActionReq
 	CreateActivityActionResp       = activity.CreateActivityActionResp
 	CreateActivityCompensateReq    = activity.CreateActivityCompensateReq
 	CreateActivityCompensateResp   = activity.CreateActivityCompensateResp
+	CreateActivityFromTemplateReq  = activity.CreateActivityFromTemplateReq
 	CreateActivityReq              = activity.CreateActivityReq
 	CreateActivityResp             = activity.CreateActivityResp
+	CreateActivitySeriesReq        = activity.CreateActivitySeriesReq
+	CreateActivitySeriesResp       = activity.CreateActivitySeriesResp
+	CreateCategoryReq              = activity.CreateCategoryReq
+	CreateTagReq                   = activity.CreateTagReq
 	DeleteActivityActionReq        = activity.DeleteActivityActionReq
 	DeleteActivityActionResp       = activity.DeleteActivityActionResp
 	DeleteActivityCompensateReq    = activity.DeleteActivityCompensateReq
 	DeleteActivityCompensateResp   = activity.DeleteActivityCompensateResp
 	DeleteActivityReq              = activity.DeleteActivityReq
 	DeleteActivityResp             = activity.DeleteActivityResp
+	DeleteActivityTemplateReq      = activity.DeleteActivityTemplateReq
+	DeleteActivityTemplateResp     = activity.DeleteActivityTemplateResp
+	DeleteCategoryReq              = activity.DeleteCategoryReq
+	DeleteCategoryResp             = activity.DeleteCategoryResp
+	EligibilityCheckItem           = activity.EligibilityCheckItem
+	EligibilityRule                = activity.EligibilityRule
+	ExportActivityIcsReq           = activity.ExportActivityIcsReq
+	ExportActivityIcsResp          = activity.ExportActivityIcsResp
+	FacetBucket                    = activity.FacetBucket
+	FavoriteActivityReq            = activity.FavoriteActivityReq
+	FavoriteActivityResp           = activity.FavoriteActivityResp
+	FeedbackComment                = activity.FeedbackComment
+	FeedbackReviewItem             = activity.FeedbackReviewItem
+	GetActivityAnalyticsReq        = activity.GetActivityAnalyticsReq
+	GetActivityAnalyticsResp       = activity.GetActivityAnalyticsResp
 	GetActivityBasicReq            = activity.GetActivityBasicReq
 	GetActivityBasicResp           = activity.GetActivityBasicResp
 	GetActivityListRequest         = activity.GetActivityListRequest
 	GetActivityListResponse        = activity.GetActivityListResponse
 	GetActivityReq                 = activity.GetActivityReq
 	GetActivityResp                = activity.GetActivityResp
+	GetActivitySeriesReq           = activity.GetActivitySeriesReq
+	GetActivitySeriesResp          = activity.GetActivitySeriesResp
+	GetCalendarFeedReq             = activity.GetCalendarFeedReq
+	GetCalendarFeedResp            = activity.GetCalendarFeedResp
+	GetCalendarFeedTokenReq        = activity.GetCalendarFeedTokenReq
+	GetCalendarFeedTokenResp       = activity.GetCalendarFeedTokenResp
+	GetEligibilityRulesReq         = activity.GetEligibilityRulesReq
+	GetEligibilityRulesResp        = activity.GetEligibilityRulesResp
+	GetFeedbackSummaryReq          = activity.GetFeedbackSummaryReq
+	GetFeedbackSummaryResp         = activity.GetFeedbackSummaryResp
 	GetHotActivitiesReq            = activity.GetHotActivitiesReq
 	GetHotActivitiesResp           = activity.GetHotActivitiesResp
+	GetOrganizerAnalyticsReq       = activity.GetOrganizerAnalyticsReq
+	GetOrganizerAnalyticsResp      = activity.GetOrganizerAnalyticsResp
 	GetRegisteredCountRequest      = activity.GetRegisteredCountRequest
 	GetRegisteredCountResponse     = activity.GetRegisteredCountResponse
+	GetSelfCheckInCodeReq          = activity.GetSelfCheckInCodeReq
+	GetSelfCheckInCodeResp         = activity.GetSelfCheckInCodeResp
 	GetTicketDetailRequest         = activity.GetTicketDetailRequest
 	GetTicketDetailResponse        = activity.GetTicketDetailResponse
 	GetTicketListRequest           = activity.GetTicketListRequest
@@ -58,23 +120,71 @@ type (
 	IncrViewCountResp              = activity.IncrViewCountResp
 	ListActivitiesReq              = activity.ListActivitiesReq
 	ListActivitiesResp             = activity.ListActivitiesResp
+	ListActivityChangesReq         = activity.ListActivityChangesReq
+	ListActivityChangesResp        = activity.ListActivityChangesResp
+	ListActivityTemplatesReq       = activity.ListActivityTemplatesReq
+	ListActivityTemplatesResp      = activity.ListActivityTemplatesResp
 	ListCategoriesReq              = activity.ListCategoriesReq
 	ListCategoriesResp             = activity.ListCategoriesResp
+	ListCheckInRecordsReq          = activity.ListCheckInRecordsReq
+	ListCheckInRecordsResp         = activity.ListCheckInRecordsResp
+	ListFeedbackReviewsReq         = activity.ListFeedbackReviewsReq
+	ListFeedbackReviewsResp        = activity.ListFeedbackReviewsResp
+	ListReviewQueueReq             = activity.ListReviewQueueReq
+	ListReviewQueueResp            = activity.ListReviewQueueResp
 	ListTagsReq                    = activity.ListTagsReq
 	ListTagsResp                   = activity.ListTagsResp
+	MergeTagsReq                   = activity.MergeTagsReq
+	MergeTagsResp                  = activity.MergeTagsResp
+	NearbyActivitiesReq            = activity.NearbyActivitiesReq
+	NearbyActivitiesResp           = activity.NearbyActivitiesResp
+	OrganizerRating                = activity.OrganizerRating
 	Pagination                     = activity.Pagination
 	RegisterActivityRequest        = activity.RegisterActivityRequest
 	RegisterActivityResponse       = activity.RegisterActivityResponse
+	RegisterActivitySeriesReq      = activity.RegisterActivitySeriesReq
+	RegisterActivitySeriesResp     = activity.RegisterActivitySeriesResp
+	RegistrationVelocity           = activity.RegistrationVelocity
 	RejectActivityReq              = activity.RejectActivityReq
 	RejectActivityResp             = activity.RejectActivityResp
+	ReviewActivityChangeReq        = activity.ReviewActivityChangeReq
+	ReviewActivityChangeResp       = activity.ReviewActivityChangeResp
+	ReviewFeedbackCommentReq       = activity.ReviewFeedbackCommentReq
+	ReviewFeedbackCommentResp      = activity.ReviewFeedbackCommentResp
+	ReviewFieldDiff                = activity.ReviewFieldDiff
+	ReviewQueueItem                = activity.ReviewQueueItem
+	SaveActivityTemplateReq        = activity.SaveActivityTemplateReq
 	SearchActivitiesReq            = activity.SearchActivitiesReq
 	SearchActivitiesResp           = activity.SearchActivitiesResp
+	SearchFacets                   = activity.SearchFacets
+	SelfCheckInReq                 = activity.SelfCheckInReq
+	SelfCheckInResp                = activity.SelfCheckInResp
+	SeriesOccurrence               = activity.SeriesOccurrence
+	SeriesSkippedOccurrence        = activity.SeriesSkippedOccurrence
+	SetCategoryStatusReq           = activity.SetCategoryStatusReq
+	SetEligibilityRulesReq         = activity.SetEligibilityRulesReq
+	SetEligibilityRulesResp        = activity.SetEligibilityRulesResp
+	SetSelfCheckInReq              = activity.SetSelfCheckInReq
+	SetSelfCheckInResp             = activity.SetSelfCheckInResp
+	SetTagStatusReq                = activity.SetTagStatusReq
+	SortCategoriesReq              = activity.SortCategoriesReq
+	SortCategoriesResp             = activity.SortCategoriesResp
+	SubmitActivityChangeReq        = activity.SubmitActivityChangeReq
+	SubmitActivityChangeResp       = activity.SubmitActivityChangeResp
 	SubmitActivityReq              = activity.SubmitActivityReq
 	SubmitActivityResp             = activity.SubmitActivityResp
+	SubmitFeedbackReq              = activity.SubmitFeedbackReq
+	SubmitFeedbackResp             = activity.SubmitFeedbackResp
+	SuggestActivitiesReq           = activity.SuggestActivitiesReq
+	SuggestActivitiesResp          = activity.SuggestActivitiesResp
 	Tag                            = activity.Tag
 	TicketListItem                 = activity.TicketListItem
 	UpdateActivityReq              = activity.UpdateActivityReq
 	UpdateActivityResp             = activity.UpdateActivityResp
+	UpdateActivitySeriesReq        = activity.UpdateActivitySeriesReq
+	UpdateActivitySeriesResp       = activity.UpdateActivitySeriesResp
+	UpdateCategoryReq              = activity.UpdateCategoryReq
+	UpdateTagReq                   = activity.UpdateTagReq
 	VerifyTicketRequest            = activity.VerifyTicketRequest
 	VerifyTicketResponse           = activity.VerifyTicketResponse
 
@@ -14,40 +14,102 @@ import (
 )
 
 type (
+	ActivityAnalyticsBrief         = activity.ActivityAnalyticsBrief
+	ActivityChangeInfo             = activity.ActivityChangeInfo
 	ActivityDetail                 = activity.ActivityDetail
 	ActivityListItem               = activity.ActivityListItem
 	ActivityListItems              = activity.ActivityListItems
+	ActivitySeriesInfo             = activity.ActivitySeriesInfo
+	ActivityTemplate               = activity.ActivityTemplate
+	ActivityTemplateResp           = activity.ActivityTemplateResp
+	AdminCategory                  = activity.AdminCategory
+	AdminCategoryResp              = activity.AdminCategoryResp
+	AdminCreatePublicTemplateReq   = activity.AdminCreatePublicTemplateReq
+	AdminListCategoriesReq         = activity.AdminListCategoriesReq
+	AdminListCategoriesResp        = activity.AdminListCategoriesResp
+	AdminListTagsReq               = activity.AdminListTagsReq
+	AdminListTagsResp              = activity.AdminListTagsResp
+	AdminTag                       = activity.AdminTag
+	AdminTagResp                   = activity.AdminTagResp
+	AdminUpdateTemplateReq         = activity.AdminUpdateTemplateReq
+	AnalyticsDaily                 = activity.AnalyticsDaily
+	AnalyticsFunnel                = activity.AnalyticsFunnel
 	ApproveActivityReq             = activity.ApproveActivityReq
 	ApproveActivityResp            = activity.ApproveActivityResp
+	AssignActivityReviewReq        = activity.AssignActivityReviewReq
+	AssignActivityReviewResp       = activity.AssignActivityReviewResp
+	AudienceBreakdown              = activity.AudienceBreakdown
+	AudienceBucket                 = activity.AudienceBucket
 	BatchGetActivityBasicReq       = activity.BatchGetActivityBasicReq
 	BatchGetActivityBasicResp      = activity.BatchGetActivityBasicResp
 	CancelActivityReq              = activity.CancelActivityReq
 	CancelActivityRequest          = activity.CancelActivityRequest
 	CancelActivityResp             = activity.CancelActivityResp
 	CancelActivityResponse         = activity.CancelActivityResponse
+	CancelActivitySeriesReq        = activity.CancelActivitySeriesReq
+	CancelActivitySeriesResp       = activity.CancelActivitySeriesResp
 	Category                       = activity.Category
+	CategorySortItem               = activity.CategorySortItem
+	CheckEligibilityReq            = activity.CheckEligibilityReq
+	CheckEligibilityResp           = activity.CheckEligibilityResp
+	CheckInRecordItem              = activity.CheckInRecordItem
+	CloneActivityReq               = activity.CloneActivityReq
 	CreateActivityActionReq        = activity.CreateActivityActionReq
 	CreateActivityActionResp       = activity.CreateActivityActionResp
 	CreateActivityCompensateReq    = activity.CreateActivityCompensateReq
 	CreateActivityCompensateResp   = activity.CreateActivityCompensateResp
+	CreateActivityFromTemplateReq  = activity.CreateActivityFromTemplateReq
 	CreateActivityReq              = activity.CreateActivityReq
 	CreateActivityResp             = activity.CreateActivityResp
+	CreateActivitySeriesReq        = activity.CreateActivitySeriesReq
+	CreateActivitySeriesResp       = activity.CreateActivitySeriesResp
+	CreateCategoryReq              = activity.CreateCategoryReq
+	CreateTagReq                   = activity.CreateTagReq
 	DeleteActivityActionReq        = activity.DeleteActivityActionReq
 	DeleteActivityActionResp       = activity.DeleteActivityActionResp
 	DeleteActivityCompensateReq    = activity.DeleteActivityCompensateReq
 	DeleteActivityCompensateResp   = activity.DeleteActivityCompensateResp
 	DeleteActivityReq              = activity.DeleteActivityReq
 	DeleteActivityResp             = activity.DeleteActivityResp
+	DeleteActivityTemplateReq      = activity.DeleteActivityTemplateReq
+	DeleteActivityTemplateResp     = activity.DeleteActivityTemplateResp
+	DeleteCategoryReq              = activity.DeleteCategoryReq
+	DeleteCategoryResp             = activity.DeleteCategoryResp
+	EligibilityCheckItem           = activity.EligibilityCheckItem
+	EligibilityRule                = activity.EligibilityRule
+	ExportActivityIcsReq           = activity.ExportActivityIcsReq
+	ExportActivityIcsResp          = activity.ExportActivityIcsResp
+	FacetBucket                    = activity.FacetBucket
+	FavoriteActivityReq            = activity.FavoriteActivityReq
+	FavoriteActivityResp           = activity.FavoriteActivityResp
+	FeedbackComment                = activity.FeedbackComment
+	FeedbackReviewItem             = activity.FeedbackReviewItem
+	GetActivityAnalyticsReq        = activity.GetActivityAnalyticsReq
+	GetActivityAnalyticsResp       = activity.GetActivityAnalyticsResp
 	GetActivityBasicReq            = activity.GetActivityBasicReq
 	GetActivityBasicResp           = activity.GetActivityBasicResp
 	GetActivityListRequest         = activity.GetActivityListRequest
 	GetActivityListResponse        = activity.GetActivityListResponse
 	GetActivityReq                 = activity.GetActivityReq
 	GetActivityResp                = activity.GetActivityResp
+	GetActivitySeriesReq           = activity.GetActivitySeriesReq
+	GetActivitySeriesResp          = activity.GetActivitySeriesResp
+	GetCalendarFeedReq             = activity.GetCalendarFeedReq
+	GetCalendarFeedResp            = activity.GetCalendarFeedResp
+	GetCalendarFeedTokenReq        = activity.GetCalendarFeedTokenReq
+	GetCalendarFeedTokenResp       = activity.GetCalendarFeedTokenResp
+	GetEligibilityRulesReq         = activity.GetEligibilityRulesReq
+	GetEligibilityRulesResp        = activity.GetEligibilityRulesResp
+	GetFeedbackSummaryReq          = activity.GetFeedbackSummaryReq
+	GetFeedbackSummaryResp         = activity.GetFeedbackSummaryResp
 	GetHotActivitiesReq            = activity.GetHotActivitiesReq
 	GetHotActivitiesResp           = activity.GetHotActivitiesResp
+	GetOrganizerAnalyticsReq       = activity.GetOrganizerAnalyticsReq
+	GetOrganizerAnalyticsResp      = activity.GetOrganizerAnalyticsResp
 	GetRegisteredCountRequest      = activity.GetRegisteredCountRequest
 	GetRegisteredCountResponse     = activity.GetRegisteredCountResponse
+	GetSelfCheckInCodeReq          = activity.GetSelfCheckInCodeReq
+	GetSelfCheckInCodeResp         = activity.GetSelfCheckInCodeResp
 	GetTicketDetailRequest         = activity.GetTicketDetailRequest
 	GetTicketDetailResponse        = activity.GetTicketDetailResponse
 	GetTicketListRequest           = activity.GetTicketListRequest
@@ -58,23 +120,71 @@ type (
 	IncrViewCountResp              = activity.IncrViewCountResp
 	ListActivitiesReq              = activity.ListActivitiesReq
 	ListActivitiesResp             = activity.ListActivitiesResp
+	ListActivityChangesReq         = activity.ListActivityChangesReq
+	ListActivityChangesResp        = activity.ListActivityChangesResp
+	ListActivityTemplatesReq       = activity.ListActivityTemplatesReq
+	ListActivityTemplatesResp      = activity.ListActivityTemplatesResp
 	ListCategoriesReq              = activity.ListCategoriesReq
 	ListCategoriesResp             = activity.ListCategoriesResp
+	ListCheckInRecordsReq          = activity.ListCheckInRecordsReq
+	ListCheckInRecordsResp         = activity.ListCheckInRecordsResp
+	ListFeedbackReviewsReq         = activity.ListFeedbackReviewsReq
+	ListFeedbackReviewsResp        = activity.ListFeedbackReviewsResp
+	ListReviewQueueReq             = activity.ListReviewQueueReq
+	ListReviewQueueResp            = activity.ListReviewQueueResp
 	ListTagsReq                    = activity.ListTagsReq
 	ListTagsResp                   = activity.ListTagsResp
+	MergeTagsReq                   = activity.MergeTagsReq
+	MergeTagsResp                  = activity.MergeTagsResp
+	NearbyActivitiesReq            = activity.NearbyActivitiesReq
+	NearbyActivitiesResp           = activity.NearbyActivitiesResp
+	OrganizerRating                = activity.OrganizerRating
 	Pagination                     = activity.Pagination
 	RegisterActivityRequest        = activity.RegisterActivityRequest
 	RegisterActivityResponse       = activity.RegisterActivityResponse
+	RegisterActivitySeriesReq      = activity.RegisterActivitySeriesReq
+	RegisterActivitySeriesResp     = activity.RegisterActivitySeriesResp
+	RegistrationVelocity           = activity.RegistrationVelocity
 	RejectActivityReq              = activity.RejectActivityReq
 	RejectActivityResp             = activity.RejectActivityResp
+	ReviewActivityChangeReq        = activity.ReviewActivityChangeReq
+	ReviewActivityChangeResp       = activity.ReviewActivityChangeResp
+	ReviewFeedbackCommentReq       = activity.ReviewFeedbackCommentReq
+	ReviewFeedbackCommentResp      = activity.ReviewFeedbackCommentResp
+	ReviewFieldDiff                = activity.ReviewFieldDiff
+	ReviewQueueItem                = activity.ReviewQueueItem
+	SaveActivityTemplateReq        = activity.SaveActivityTemplateReq
 	SearchActivitiesReq            = activity.SearchActivitiesReq
 	SearchActivitiesResp           = activity.SearchActivitiesResp
+	SearchFacets                   = activity.SearchFacets
+	SelfCheckInReq                 = activity.SelfCheckInReq
+	SelfCheckInResp                = activity.SelfCheckInResp
+	SeriesOccurrence               = activity.SeriesOccurrence
+	SeriesSkippedOccurrence        = activity.SeriesSkippedOccurrence
+	SetCategoryStatusReq           = activity.SetCategoryStatusReq
+	SetEligibilityRulesReq         = activity.SetEligibilityRulesReq
+	SetEligibilityRulesResp        = activity.SetEligibilityRulesResp
+	SetSelfCheckInReq              = activity.SetSelfCheckInReq
+	SetSelfCheckInResp             = activity.SetSelfCheckInResp
+	SetTagStatusReq                = activity.SetTagStatusReq
+	SortCategoriesReq              = activity.SortCategoriesReq
+	SortCategoriesResp             = activity.SortCategoriesResp
+	SubmitActivityChangeReq        = activity.SubmitActivityChangeReq
+	SubmitActivityChangeResp       = activity.SubmitActivityChangeResp
 	SubmitActivityReq              = activity.SubmitActivityReq
 	SubmitActivityResp             = activity.SubmitActivityResp
+	SubmitFeedbackReq              = activity.SubmitFeedbackReq
+	SubmitFeedbackResp             = activity.SubmitFeedbackResp
+	SuggestActivitiesReq           = activity.SuggestActivitiesReq
+	SuggestActivitiesResp          = activity.SuggestActivitiesResp
 	Tag                            = activity.Tag
 	TicketListItem                 = activity.TicketListItem
 	UpdateActivityReq              = activity.UpdateActivityReq
 	UpdateActivityResp             = activity.UpdateActivityResp
+	UpdateActivitySeriesReq        = activity.UpdateActivitySeriesReq
+	UpdateActivitySeriesResp       = activity.UpdateActivitySeriesResp
+	UpdateCategoryReq              = activity.UpdateCategoryReq
+	UpdateTagReq                   = activity.UpdateTagReq
 	VerifyTicketRequest            = activity.VerifyTicketRequest
 	VerifyTicketResponse           = activity.VerifyTicketResponse
 
@@ -93,6 +203,20 @@ type (
 		GetTicketDetail(ctx context.Context, in *GetTicketDetailRequest, opts ...grpc.CallOption) (*GetTicketDetailResponse, error)
 		// GetRegisteredCount 获取报名数量
 		GetRegisteredCount(ctx context.Context, in *GetRegisteredCountRequest, opts ...grpc.CallOption) (*GetRegisteredCountResponse, error)
+		// SetEligibilityRules 设置活动报名资格规则（覆盖式，仅组织者）
+		SetEligibilityRules(ctx context.Context, in *SetEligibilityRulesReq, opts ...grpc.CallOption) (*SetEligibilityRulesResp, error)
+		// GetEligibilityRules 获取活动报名资格规则
+		GetEligibilityRules(ctx context.Context, in *GetEligibilityRulesReq, opts ...grpc.CallOption) (*GetEligibilityRulesResp, error)
+		// CheckEligibility 报名资格预检（不写入任何数据，供详情页展示"能否报名"）
+		CheckEligibility(ctx context.Context, in *CheckEligibilityReq, opts ...grpc.CallOption) (*CheckEligibilityResp, error)
+		// SubmitFeedback 提交活动评价（活动结束后评价窗口内，仅已核销票据的参与者；重复提交视为修改）
+		SubmitFeedback(ctx context.Context, in *SubmitFeedbackReq, opts ...grpc.CallOption) (*SubmitFeedbackResp, error)
+		// GetFeedbackSummary 活动评价汇总（评分分布 + 公开短评，仅组织者）
+		GetFeedbackSummary(ctx context.Context, in *GetFeedbackSummaryReq, opts ...grpc.CallOption) (*GetFeedbackSummaryResp, error)
+		// ListFeedbackReviews 评价短评审核列表（管理员，命中送审词的短评）
+		ListFeedbackReviews(ctx context.Context, in *ListFeedbackReviewsReq, opts ...grpc.CallOption) (*ListFeedbackReviewsResp, error)
+		// ReviewFeedbackComment 审核评价短评（管理员；通过后公开，驳回后隐藏）
+		ReviewFeedbackComment(ctx context.Context, in *ReviewFeedbackCommentReq, opts ...grpc.CallOption) (*ReviewFeedbackCommentResp, error)
 		// ==================== CRUD 接口 ====================
 		CreateActivity(ctx context.Context, in *CreateActivityReq, opts ...grpc.CallOption) (*CreateActivityResp, error)
 		UpdateActivity(ctx context.Context, in *UpdateActivityReq, opts ...grpc.CallOption) (*UpdateActivityResp, error)
@@ -104,12 +228,85 @@ type (
 		ApproveActivity(ctx context.Context, in *ApproveActivityReq, opts ...grpc.CallOption) (*ApproveActivityResp, error)
 		RejectActivity(ctx context.Context, in *RejectActivityReq, opts ...grpc.CallOption) (*RejectActivityResp, error)
 		CancelActivity(ctx context.Context, in *CancelActivityReq, opts ...grpc.CallOption) (*CancelActivityResp, error)
+		// ==================== 发布审核接口（管理员）====================
+		ListReviewQueue(ctx context.Context, in *ListReviewQueueReq, opts ...grpc.CallOption) (*ListReviewQueueResp, error)
+		// AssignActivityReview 分配/领取/释放审核任务
+		AssignActivityReview(ctx context.Context, in *AssignActivityReviewReq, opts ...grpc.CallOption) (*AssignActivityReviewResp, error)
+		// ==================== 已发布活动变更申请 ====================
+		SubmitActivityChange(ctx context.Context, in *SubmitActivityChangeReq, opts ...grpc.CallOption) (*SubmitActivityChangeResp, error)
+		// ListActivityChanges 变更申请列表（组织者查看本活动 / 管理员查看全部）
+		ListActivityChanges(ctx context.Context, in *ListActivityChangesReq, opts ...grpc.CallOption) (*ListActivityChangesResp, error)
+		// ReviewActivityChange 管理员审核变更申请（通过后立即生效并通知报名者）
+		ReviewActivityChange(ctx context.Context, in *ReviewActivityChangeReq, opts ...grpc.CallOption) (*ReviewActivityChangeResp, error)
+		// ==================== 系列活动 ====================
+		CreateActivitySeries(ctx context.Context, in *CreateActivitySeriesReq, opts ...grpc.CallOption) (*CreateActivitySeriesResp, error)
+		// GetActivitySeries 系列详情（含已生成的场次）
+		GetActivitySeries(ctx context.Context, in *GetActivitySeriesReq, opts ...grpc.CallOption) (*GetActivitySeriesResp, error)
+		// UpdateActivitySeries 整期修改（同步到未开始且未单独修改过的场次）
+		UpdateActivitySeries(ctx context.Context, in *UpdateActivitySeriesReq, opts ...grpc.CallOption) (*UpdateActivitySeriesResp, error)
+		// CancelActivitySeries 取消系列（停止生成，可选取消未开始的场次）
+		CancelActivitySeries(ctx context.Context, in *CancelActivitySeriesReq, opts ...grpc.CallOption) (*CancelActivitySeriesResp, error)
+		// RegisterActivitySeries 整期报名 / 退出整期报名
+		RegisterActivitySeries(ctx context.Context, in *RegisterActivitySeriesReq, opts ...grpc.CallOption) (*RegisterActivitySeriesResp, error)
+		// ==================== 日历订阅 ====================
+		GetCalendarFeedToken(ctx context.Context, in *GetCalendarFeedTokenReq, opts ...grpc.CallOption) (*GetCalendarFeedTokenResp, error)
+		// GetCalendarFeed 按订阅令牌输出用户已报名活动的 iCalendar 内容
+		GetCalendarFeed(ctx context.Context, in *GetCalendarFeedReq, opts ...grpc.CallOption) (*GetCalendarFeedResp, error)
+		// ExportActivityIcs 导出单个活动的 .ics 文件内容
+		ExportActivityIcs(ctx context.Context, in *ExportActivityIcsReq, opts ...grpc.CallOption) (*ExportActivityIcsResp, error)
+		// ==================== 自助签到 ====================
+		SetSelfCheckIn(ctx context.Context, in *SetSelfCheckInReq, opts ...grpc.CallOption) (*SetSelfCheckInResp, error)
+		// GetSelfCheckInCode 组织者获取当前轮换签到码（现场大屏展示，30 秒轮换）
+		GetSelfCheckInCode(ctx context.Context, in *GetSelfCheckInCodeReq, opts ...grpc.CallOption) (*GetSelfCheckInCodeResp, error)
+		// SelfCheckIn 参与者自助签到（校验票券、时间窗口、签到码与定位距离）
+		SelfCheckIn(ctx context.Context, in *SelfCheckInReq, opts ...grpc.CallOption) (*SelfCheckInResp, error)
+		// ListCheckInRecords 组织者查看签到记录（可只看疑似异常记录）
+		ListCheckInRecords(ctx context.Context, in *ListCheckInRecordsReq, opts ...grpc.CallOption) (*ListCheckInRecordsResp, error)
+		// ==================== 活动模板 ====================
+		CloneActivity(ctx context.Context, in *CloneActivityReq, opts ...grpc.CallOption) (*CreateActivityResp, error)
+		// SaveActivityTemplate 将组织者自己的活动另存为个人模板
+		SaveActivityTemplate(ctx context.Context, in *SaveActivityTemplateReq, opts ...grpc.CallOption) (*ActivityTemplateResp, error)
+		// ListActivityTemplates 模板列表（用户：个人模板 + 可用公共模板；管理员：全部公共模板）
+		ListActivityTemplates(ctx context.Context, in *ListActivityTemplatesReq, opts ...grpc.CallOption) (*ListActivityTemplatesResp, error)
+		// CreateActivityFromTemplate 使用模板创建草稿活动
+		CreateActivityFromTemplate(ctx context.Context, in *CreateActivityFromTemplateReq, opts ...grpc.CallOption) (*CreateActivityResp, error)
+		// DeleteActivityTemplate 删除模板（用户删除个人模板，管理员删除公共模板）
+		DeleteActivityTemplate(ctx context.Context, in *DeleteActivityTemplateReq, opts ...grpc.CallOption) (*DeleteActivityTemplateResp, error)
+		// AdminCreatePublicTemplate 管理员以已有活动为蓝本创建公共模板
+		AdminCreatePublicTemplate(ctx context.Context, in *AdminCreatePublicTemplateReq, opts ...grpc.CallOption) (*ActivityTemplateResp, error)
+		// AdminUpdateTemplate 管理员修改公共模板名称、分类或上下架
+		AdminUpdateTemplate(ctx context.Context, in *AdminUpdateTemplateReq, opts ...grpc.CallOption) (*ActivityTemplateResp, error)
+		// ==================== 活动分析 ====================
+		GetActivityAnalytics(ctx context.Context, in *GetActivityAnalyticsReq, opts ...grpc.CallOption) (*GetActivityAnalyticsResp, error)
+		// GetOrganizerAnalytics 组织者查看全部活动的数据总览
+		GetOrganizerAnalytics(ctx context.Context, in *GetOrganizerAnalyticsReq, opts ...grpc.CallOption) (*GetOrganizerAnalyticsResp, error)
+		// ==================== 活动收藏 ====================
+		FavoriteActivity(ctx context.Context, in *FavoriteActivityReq, opts ...grpc.CallOption) (*FavoriteActivityResp, error)
 		// ==================== 搜索接口 ====================
 		SearchActivities(ctx context.Context, in *SearchActivitiesReq, opts ...grpc.CallOption) (*SearchActivitiesResp, error)
 		GetHotActivities(ctx context.Context, in *GetHotActivitiesReq, opts ...grpc.CallOption) (*GetHotActivitiesResp, error)
+		// NearbyActivities 附近活动（按距离筛选/排序，ES 不可用时降级 MySQL）
+		NearbyActivities(ctx context.Context, in *NearbyActivitiesReq, opts ...grpc.CallOption) (*NearbyActivitiesResp, error)
+		// SuggestActivities 搜索建议（输入联想，ES 不可用时降级 MySQL 标题前缀匹配）
+		SuggestActivities(ctx context.Context, in *SuggestActivitiesReq, opts ...grpc.CallOption) (*SuggestActivitiesResp, error)
 		// ==================== 分类标签接口 ====================
 		ListCategories(ctx context.Context, in *ListCategoriesReq, opts ...grpc.CallOption) (*ListCategoriesResp, error)
 		ListTags(ctx context.Context, in *ListTagsReq, opts ...grpc.CallOption) (*ListTagsResp, error)
+		// ==================== 分类标签管理接口（管理端）====================
+		AdminListCategories(ctx context.Context, in *AdminListCategoriesReq, opts ...grpc.CallOption) (*AdminListCategoriesResp, error)
+		CreateCategory(ctx context.Context, in *CreateCategoryReq, opts ...grpc.CallOption) (*AdminCategoryResp, error)
+		UpdateCategory(ctx context.Context, in *UpdateCategoryReq, opts ...grpc.CallOption) (*AdminCategoryResp, error)
+		SetCategoryStatus(ctx context.Context, in *SetCategoryStatusReq, opts ...grpc.CallOption) (*AdminCategoryResp, error)
+		SortCategories(ctx context.Context, in *SortCategoriesReq, opts ...grpc.CallOption) (*SortCategoriesResp, error)
+		// DeleteCategory 删除分类（仍有活动引用时拒绝，可改为禁用）
+		DeleteCategory(ctx context.Context, in *DeleteCategoryReq, opts ...grpc.CallOption) (*DeleteCategoryResp, error)
+		// 标签：写入用户服务后同步 tag_cache，并重建使用该标签的活动的搜索索引
+		AdminListTags(ctx context.Context, in *AdminListTagsReq, opts ...grpc.CallOption) (*AdminListTagsResp, error)
+		CreateTag(ctx context.Context, in *CreateTagReq, opts ...grpc.CallOption) (*AdminTagResp, error)
+		UpdateTag(ctx context.Context, in *UpdateTagReq, opts ...grpc.CallOption) (*AdminTagResp, error)
+		SetTagStatus(ctx context.Context, in *SetTagStatusReq, opts ...grpc.CallOption) (*AdminTagResp, error)
+		// MergeTags 合并标签（活动关联与用户兴趣改绑到目标标签，源标签禁用）
+		MergeTags(ctx context.Context, in *MergeTagsReq, opts ...grpc.CallOption) (*MergeTagsResp, error)
 		// ==================== 浏览量接口 ====================
 		IncrViewCount(ctx context.Context, in *IncrViewCountReq, opts ...grpc.CallOption) (*IncrViewCountResp, error)
 		// ==================== 内部接口（供其他微服务调用）====================
@@ -173,6 +370,48 @@ func (m *defaultActivityService) GetRegisteredCount(ctx context.Context, in *Get
 	return client.GetRegisteredCount(ctx, in, opts...)
 }
 
+// SetEligibilityRules 设置活动报名资格规则（覆盖式，仅组织者）
+func (m *defaultActivityService) SetEligibilityRules(ctx context.Context, in *SetEligibilityRulesReq, opts ...grpc.CallOption) (*SetEligibilityRulesResp, error) {
+	client := activity.NewActivityServiceClient(m.cli.Conn())
+	return client.SetEligibilityRules(ctx, in, opts...)
+}
+
+// GetEligibilityRules 获取活动报名资格规则
+func (m *defaultActivityService) GetEligibilityRules(ctx context.Context, in *GetEligibilityRulesReq, opts ...grpc.CallOption) (*GetEligibilityRulesResp, error) {
+	client := activity.NewActivityServiceClient(m.cli.Conn())
+	return client.GetEligibilityRules(ctx, in, opts...)
+}
+
+// CheckEligibility 报名资格预检（不写入任何数据，供详情页展示"能否报名"）
+func (m *defaultActivityService) CheckEligibility(ctx context.Context, in *CheckEligibilityReq, opts ...grpc.CallOption) (*CheckEligibilityResp, error) {
+	client := activity.NewActivityServiceClient(m.cli.Conn())
+	return client.CheckEligibility(ctx, in, opts...)
+}
+
+// SubmitFeedback 提交活动评价（活动结束后评价窗口内，仅已核销票据的参与者；重复提交视为修改）
+func (m *defaultActivityService) SubmitFeedback(ctx context.Context, in *SubmitFeedbackReq, opts ...grpc.CallOption) (*SubmitFeedbackResp, error) {
+	client := activity.NewActivityServiceClient(m.cli.Conn())
+	return client.SubmitFeedback(ctx, in, opts...)
+}
+
+// GetFeedbackSummary 活动评价汇总（评分分布 + 公开短评，仅组织者）
+func (m *defaultActivityService) GetFeedbackSummary(ctx context.Context, in *GetFeedbackSummaryReq, opts ...grpc.CallOption) (*GetFeedbackSummaryResp, error) {
+	client := activity.NewActivityServiceClient(m.cli.Conn())
+	return client.GetFeedbackSummary(ctx, in, opts...)
+}
+
+// ListFeedbackReviews 评价短评审核列表（管理员，命中送审词的短评）
+func (m *defaultActivityService) ListFeedbackReviews(ctx context.Context, in *ListFeedbackReviewsReq, opts ...grpc.CallOption) (*ListFeedbackReviewsResp, error) {
+	client := activity.NewActivityServiceClient(m.cli.Conn())
+	return client.ListFeedbackReviews(ctx, in, opts...)
+}
+
+// ReviewFeedbackComment 审核评价短评（管理员；通过后公开，驳回后隐藏）
+func (m *defaultActivityService) ReviewFeedbackComment(ctx context.Context, in *ReviewFeedbackCommentReq, opts ...grpc.CallOption) (*ReviewFeedbackCommentResp, error) {
+	client := activity.NewActivityServiceClient(m.cli.Conn())
+	return client.ReviewFeedbackComment(ctx, in, opts...)
+}
+
 // ==================== CRUD 接口 ====================
 func (m *defaultActivityService) CreateActivity(ctx context.Context, in *CreateActivityReq, opts ...grpc.CallOption) (*CreateActivityResp, error) {
 	client := activity.NewActivityServiceClient(m.cli.Conn())
@@ -220,6 +459,168 @@ func (m *defaultActivityService) CancelActivity(ctx context.Context, in *CancelA
 	return client.CancelActivity(ctx, in, opts...)
 }
 
+// ==================== 发布审核接口（管理员）====================
+func (m *defaultActivityService) ListReviewQueue(ctx context.Context, in *ListReviewQueueReq, opts ...grpc.CallOption) (*ListReviewQueueResp, error) {
+	client := activity.NewActivityServiceClient(m.cli.Conn())
+	return client.ListReviewQueue(ctx, in, opts...)
+}
+
+// AssignActivityReview 分配/领取/释放审核任务
+func (m *defaultActivityService) AssignActivityReview(ctx context.Context, in *AssignActivityReviewReq, opts ...grpc.CallOption) (*AssignActivityReviewResp, error) {
+	client := activity.NewActivityServiceClient(m.cli.Conn())
+	return client.AssignActivityReview(ctx, in, opts...)
+}
+
+// ==================== 已发布活动变更申请 ====================
+func (m *defaultActivityService) SubmitActivityChange(ctx context.Context, in *SubmitActivityChangeReq, opts ...grpc.CallOption) (*SubmitActivityChangeResp, error) {
+	client := activity.NewActivityServiceClient(m.cli.Conn())
+	return client.SubmitActivityChange(ctx, in, opts...)
+}
+
+// ListActivityChanges 变更申请列表（组织者查看本活动 / 管理员查看全部）
+func (m *defaultActivityService) ListActivityChanges(ctx context.Context, in *ListActivityChangesReq, opts ...grpc.CallOption) (*ListActivityChangesResp, error) {
+	client := activity.NewActivityServiceClient(m.cli.Conn())
+	return client.ListActivityChanges(ctx, in, opts...)
+}
+
+// ReviewActivityChange 管理员审核变更申请（通过后立即生效并通知报名者）
+func (m *defaultActivityService) ReviewActivityChange(ctx context.Context, in *ReviewActivityChangeReq, opts ...grpc.CallOption) (*ReviewActivityChangeResp, error) {
+	client := activity.NewActivityServiceClient(m.cli.Conn())
+	return client.ReviewActivityChange(ctx, in, opts...)
+}
+
+// ==================== 系列活动 ====================
+func (m *defaultActivityService) CreateActivitySeries(ctx context.Context, in *CreateActivitySeriesReq, opts ...grpc.CallOption) (*CreateActivitySeriesResp, error) {
+	client := activity.NewActivityServiceClient(m.cli.Conn())
+	return client.CreateActivitySeries(ctx, in, opts...)
+}
+
+// GetActivitySeries 系列详情（含已生成的场次）
+func (m *defaultActivityService) GetActivitySeries(ctx context.Context, in *GetActivitySeriesReq, opts ...grpc.CallOption) (*GetActivitySeriesResp, error) {
+	client := activity.NewActivityServiceClient(m.cli.Conn())
+	return client.GetActivitySeries(ctx, in, opts...)
+}
+
+// UpdateActivitySeries 整期修改（同步到未开始且未单独修改过的场次）
+func (m *defaultActivityService) UpdateActivitySeries(ctx context.Context, in *UpdateActivitySeriesReq, opts ...grpc.CallOption) (*UpdateActivitySeriesResp, error) {
+	client := activity.NewActivityServiceClient(m.cli.Conn())
+	return client.UpdateActivitySeries(ctx, in, opts...)
+}
+
+// CancelActivitySeries 取消系列（停止生成，可选取消未开始的场次）
+func (m *defaultActivityService) CancelActivitySeries(ctx context.Context, in *CancelActivitySeriesReq, opts ...grpc.CallOption) (*CancelActivitySeriesResp, error) {
+	client := activity.NewActivityServiceClient(m.cli.Conn())
+	return client.CancelActivitySeries(ctx, in, opts...)
+}
+
+// RegisterActivitySeries 整期报名 / 退出整期报名
+func (m *defaultActivityService) RegisterActivitySeries(ctx context.Context, in *RegisterActivitySeriesReq, opts ...grpc.CallOption) (*RegisterActivitySeriesResp, error) {
+	client := activity.NewActivityServiceClient(m.cli.Conn())
+	return client.RegisterActivitySeries(ctx, in, opts...)
+}
+
+// ==================== 日历订阅 ====================
+func (m *defaultActivityService) GetCalendarFeedToken(ctx context.Context, in *GetCalendarFeedTokenReq, opts ...grpc.CallOption) (*GetCalendarFeedTokenResp, error) {
+	client := activity.NewActivityServiceClient(m.cli.Conn())
+	return client.GetCalendarFeedToken(ctx, in, opts...)
+}
+
+// GetCalendarFeed 按订阅令牌输出用户已报名活动的 iCalendar 内容
+func (m *defaultActivityService) GetCalendarFeed(ctx context.Context, in *GetCalendarFeedReq, opts ...grpc.CallOption) (*GetCalendarFeedResp, error) {
+	client := activity.NewActivityServiceClient(m.cli.Conn())
+	return client.GetCalendarFeed(ctx, in, opts...)
+}
+
+// ExportActivityIcs 导出单个活动的 .ics 文件内容
+func (m *defaultActivityService) ExportActivityIcs(ctx context.Context, in *ExportActivityIcsReq, opts ...grpc.CallOption) (*ExportActivityIcsResp, error) {
+	client := activity.NewActivityServiceClient(m.cli.Conn())
+	return client.ExportActivityIcs(ctx, in, opts...)
+}
+
+// ==================== 自助签到 ====================
+func (m *defaultActivityService) SetSelfCheckIn(ctx context.Context, in *SetSelfCheckInReq, opts ...grpc.CallOption) (*SetSelfCheckInResp, error) {
+	client := activity.NewActivityServiceClient(m.cli.Conn())
+	return client.SetSelfCheckIn(ctx, in, opts...)
+}
+
+// GetSelfCheckInCode 组织者获取当前轮换签到码（现场大屏展示，30 秒轮换）
+func (m *defaultActivityService) GetSelfCheckInCode(ctx context.Context, in *GetSelfCheckInCodeReq, opts ...grpc.CallOption) (*GetSelfCheckInCodeResp, error) {
+	client := activity.NewActivityServiceClient(m.cli.Conn())
+	return client.GetSelfCheckInCode(ctx, in, opts...)
+}
+
+// SelfCheckIn 参与者自助签到（校验票券、时间窗口、签到码与定位距离）
+func (m *defaultActivityService) SelfCheckIn(ctx context.Context, in *SelfCheckInReq, opts ...grpc.CallOption) (*SelfCheckInResp, error) {
+	client := activity.NewActivityServiceClient(m.cli.Conn())
+	return client.SelfCheckIn(ctx, in, opts...)
+}
+
+// ListCheckInRecords 组织者查看签到记录（可只看疑似异常记录）
+func (m *defaultActivityService) ListCheckInRecords(ctx context.Context, in *ListCheckInRecordsReq, opts ...grpc.CallOption) (*ListCheckInRecordsResp, error) {
+	client := activity.NewActivityServiceClient(m.cli.Conn())
+	return client.ListCheckInRecords(ctx, in, opts...)
+}
+
+// ==================== 活动模板 ====================
+func (m *defaultActivityService) CloneActivity(ctx context.Context, in *CloneActivityReq, opts ...grpc.CallOption) (*CreateActivityResp, error) {
+	client := activity.NewActivityServiceClient(m.cli.Conn())
+	return client.CloneActivity(ctx, in, opts...)
+}
+
+// SaveActivityTemplate 将组织者自己的活动另存为个人模板
+func (m *defaultActivityService) SaveActivityTemplate(ctx context.Context, in *SaveActivityTemplateReq, opts ...grpc.CallOption) (*ActivityTemplateResp, error) {
+	client := activity.NewActivityServiceClient(m.cli.Conn())
+	return client.SaveActivityTemplate(ctx, in, opts...)
+}
+
+// ListActivityTemplates 模板列表（用户：个人模板 + 可用公共模板；管理员：全部公共模板）
+func (m *defaultActivityService) ListActivityTemplates(ctx context.Context, in *ListActivityTemplatesReq, opts ...grpc.CallOption) (*ListActivityTemplatesResp, error) {
+	client := activity.NewActivityServiceClient(m.cli.Conn())
+	return client.ListActivityTemplates(ctx, in, opts...)
+}
+
+// CreateActivityFromTemplate 使用模板创建草稿活动
+func (m *defaultActivityService) CreateActivityFromTemplate(ctx context.Context, in *CreateActivityFromTemplateReq, opts ...grpc.CallOption) (*CreateActivityResp, error) {
+	client := activity.NewActivityServiceClient(m.cli.Conn())
+	return client.CreateActivityFromTemplate(ctx, in, opts...)
+}
+
+// DeleteActivityTemplate 删除模板（用户删除个人模板，管理员删除公共模板）
+func (m *defaultActivityService) DeleteActivityTemplate(ctx context.Context, in *DeleteActivityTemplateReq, opts ...grpc.CallOption) (*DeleteActivityTemplateResp, error) {
+	client := activity.NewActivityServiceClient(m.cli.Conn())
+	return client.DeleteActivityTemplate(ctx, in, opts...)
+}
+
+// AdminCreatePublicTemplate 管理员以已有活动为蓝本创建公共模板
+func (m *defaultActivityService) AdminCreatePublicTemplate(ctx context.Context, in *AdminCreatePublicTemplateReq, opts ...grpc.CallOption) (*ActivityTemplateResp, error) {
+	client := activity.NewActivityServiceClient(m.cli.Conn())
+	return client.AdminCreatePublicTemplate(ctx, in, opts...)
+}
+
+// AdminUpdateTemplate 管理员修改公共模板名称、分类或上下架
+func (m *defaultActivityService) AdminUpdateTemplate(ctx context.Context, in *AdminUpdateTemplateReq, opts ...grpc.CallOption) (*ActivityTemplateResp, error) {
+	client := activity.NewActivityServiceClient(m.cli.Conn())
+	return client.AdminUpdateTemplate(ctx, in, opts...)
+}
+
+// ==================== 活动分析 ====================
+func (m *defaultActivityService) GetActivityAnalytics(ctx context.Context, in *GetActivityAnalyticsReq, opts ...grpc.CallOption) (*GetActivityAnalyticsResp, error) {
+	client := activity.NewActivityServiceClient(m.cli.Conn())
+	return client.GetActivityAnalytics(ctx, in, opts...)
+}
+
+// GetOrganizerAnalytics 组织者查看全部活动的数据总览
+func (m *defaultActivityService) GetOrganizerAnalytics(ctx context.Context, in *GetOrganizerAnalyticsReq, opts ...grpc.CallOption) (*GetOrganizerAnalyticsResp, error) {
+	client := activity.NewActivityServiceClient(m.cli.Conn())
+	return client.GetOrganizerAnalytics(ctx, in, opts...)
+}
+
+// ==================== 活动收藏 ====================
+func (m *defaultActivityService) FavoriteActivity(ctx context.Context, in *FavoriteActivityReq, opts ...grpc.CallOption) (*FavoriteActivityResp, error) {
+	client := activity.NewActivityServiceClient(m.cli.Conn())
+	return client.FavoriteActivity(ctx, in, opts...)
+}
+
 // ==================== 搜索接口 ====================
 func (m *defaultActivityService) SearchActivities(ctx context.Context, in *SearchActivitiesReq, opts ...grpc.CallOption) (*SearchActivitiesResp, error) {
 	client := activity.NewActivityServiceClient(m.cli.Conn())
@@ -231,6 +632,18 @@ func (m *defaultActivityService) GetHotActivities(ctx context.Context, in *GetHo
 	return client.GetHotActivities(ctx, in, opts...)
 }
 
+// NearbyActivities 附近活动（按距离筛选/排序，ES 不可用时降级 MySQL）
+func (m *defaultActivityService) NearbyActivities(ctx context.Context, in *NearbyActivitiesReq, opts ...grpc.CallOption) (*NearbyActivitiesResp, error) {
+	client := activity.NewActivityServiceClient(m.cli.Conn())
+	return client.NearbyActivities(ctx, in, opts...)
+}
+
+// SuggestActivities 搜索建议（输入联想，ES 不可用时降级 MySQL 标题前缀匹配）
+func (m *defaultActivityService) SuggestActivities(ctx context.Context, in *SuggestActivitiesReq, opts ...grpc.CallOption) (*SuggestActivitiesResp, error) {
+	client := activity.NewActivityServiceClient(m.cli.Conn())
+	return client.SuggestActivities(ctx, in, opts...)
+}
+
 // ==================== 分类标签接口 ====================
 func (m *defaultActivityService) ListCategories(ctx context.Context, in *ListCategoriesReq, opts ...grpc.CallOption) (*ListCategoriesResp, error) {
 	client := activity.NewActivityServiceClient(m.cli.Conn())
@@ -242,6 +655,65 @@ func (m *defaultActivityService) ListTags(ctx context.Context, in *ListTagsReq,
 	return client.ListTags(ctx, in, opts...)
 }
 
+// ==================== 分类标签管理接口（管理端）====================
+func (m *defaultActivityService) AdminListCategories(ctx context.Context, in *AdminListCategoriesReq, opts ...grpc.CallOption) (*AdminListCategoriesResp, error) {
+	client := activity.NewActivityServiceClient(m.cli.Conn())
+	return client.AdminListCategories(ctx, in, opts...)
+}
+
+func (m *defaultActivityService) CreateCategory(ctx context.Context, in *CreateCategoryReq, opts ...grpc.CallOption) (*AdminCategoryResp, error) {
+	client := activity.NewActivityServiceClient(m.cli.Conn())
+	return client.CreateCategory(ctx, in, opts...)
+}
+
+func (m *defaultActivityService) UpdateCategory(ctx context.Context, in *UpdateCategoryReq, opts ...grpc.CallOption) (*AdminCategoryResp, error) {
+	client := activity.NewActivityServiceClient(m.cli.Conn())
+	return client.UpdateCategory(ctx, in, opts...)
+}
+
+func (m *defaultActivityService) SetCategoryStatus(ctx context.Context, in *SetCategoryStatusReq, opts ...grpc.CallOption) (*AdminCategoryResp, error) {
+	client := activity.NewActivityServiceClient(m.cli.Conn())
+	return client.SetCategoryStatus(ctx, in, opts...)
+}
+
+func (m *defaultActivityService) SortCategories(ctx context.Context, in *SortCategoriesReq, opts ...grpc.CallOption) (*SortCategoriesResp, error) {
+	client := activity.NewActivityServiceClient(m.cli.Conn())
+	return client.SortCategories(ctx, in, opts...)
+}
+
+// DeleteCategory 删除分类（仍有活动引用时拒绝，可改为禁用）
+func (m *defaultActivityService) DeleteCategory(ctx context.Context, in *DeleteCategoryReq, opts ...grpc.CallOption) (*DeleteCategoryResp, error) {
+	client := activity.NewActivityServiceClient(m.cli.Conn())
+	return client.DeleteCategory(ctx, in, opts...)
+}
+
+// 标签：写入用户服务后同步 tag_cache，并重建使用该标签的活动的搜索索引
+func (m *defaultActivityService) AdminListTags(ctx context.Context, in *AdminListTagsReq, opts ...grpc.CallOption) (*AdminListTagsResp, error) {
+	client := activity.NewActivityServiceClient(m.cli.Conn())
+	return client.AdminListTags(ctx, in, opts...)
+}
+
+func (m *defaultActivityService) CreateTag(ctx context.Context, in *CreateTagReq, opts ...grpc.CallOption) (*AdminTagResp, error) {
+	client := activity.NewActivityServiceClient(m.cli.Conn())
+	return client.CreateTag(ctx, in, opts...)
+}
+
+func (m *defaultActivityService) UpdateTag(ctx context.Context, in *UpdateTagReq, opts ...grpc.CallOption) (*AdminTagResp, error) {
+	client := activity.NewActivityServiceClient(m.cli.Conn())
+	return client.UpdateTag(ctx, in, opts...)
+}
+
+func (m *defaultActivityService) SetTagStatus(ctx context.Context, in *SetTagStatusReq, opts ...grpc.CallOption) (*AdminTagResp, error) {
+	client := activity.NewActivityServiceClient(m.cli.Conn())
+	return client.SetTagStatus(ctx, in, opts...)
+}
+
+// MergeTags 合并标签（活动关联与用户兴趣改绑到目标标签，源标签禁用）
+func (m *defaultActivityService) MergeTags(ctx context.Context, in *MergeTagsReq, opts ...grpc.CallOption) (*MergeTagsResp, error) {
+	client := activity.NewActivityServiceClient(m.cli.Conn())
+	return client.MergeTags(ctx, in, opts...)
+}
+
 // ==================== 浏览量接口 ====================
 func (m *defaultActivityService) IncrViewCount(ctx context.Context, in *IncrViewCountReq, opts ...grpc.CallOption) (*IncrViewCountResp, error) {
 	client := activity.NewActivityServiceClient(m.cli.Conn())
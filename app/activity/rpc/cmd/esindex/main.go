@@ -0,0 +1,103 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"activity-platform/app/activity/rpc/internal/config"
+	"activity-platform/app/activity/rpc/internal/search"
+	"activity-platform/app/activity/rpc/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/conf"
+)
+
+// 活动搜索索引运维命令
+// 说明：
+//   - status:        查看读/写/双写别名指向与已存在的版本
+//   - reindex:       以当前 Mapping 新建版本索引，全量同步 + 双写，校验后原子切换别名
+//   - rollback:      读写别名切回上一个版本
+//   - drop-previous: 确认新索引无误后删除上一个版本
+//
+// 启动命令：
+//   cd app/activity/rpc
+//   go run ./cmd/esindex -f etc/activity.yaml -action reindex
+
+var (
+	configFile = flag.String("f", "etc/activity.yaml", "配置文件路径")
+	action     = flag.String("action", "status", "操作：status | reindex | rollback | drop-previous")
+	tolerance  = flag.Float64("tolerance", 0, "reindex 文档数允许的偏差比例，如 0.001")
+)
+
+func main() {
+	flag.Parse()
+
+	var c config.Config
+	conf.MustLoad(*configFile, &c)
+
+	reindexer, err := svc.NewSearchReindexer(c)
+	if err != nil {
+		fail(err)
+	}
+
+	ctx := context.Background()
+	switch *action {
+	case "status":
+		state, err := reindexer.Status(ctx)
+		if err != nil {
+			fail(err)
+		}
+		printState(state)
+
+	case "reindex":
+		result, err := reindexer.Reindex(ctx, search.ReindexOptions{Tolerance: *tolerance})
+		if err != nil {
+			fail(err)
+		}
+		fmt.Printf("重建完成: %s -> %s\n", result.OldIndex, result.NewIndex)
+		fmt.Printf("文档数: ES=%d, MySQL=%d, 耗时=%v\n", result.DocCount, result.MySQLCount, result.Duration)
+		fmt.Println("确认无误后执行 -action drop-previous 删除旧索引，如需回滚执行 -action rollback")
+
+	case "rollback":
+		state, err := reindexer.Rollback(ctx)
+		if err != nil {
+			fail(err)
+		}
+		fmt.Println("回滚完成")
+		printState(state)
+
+	case "drop-previous":
+		index, err := reindexer.DropPrevious(ctx)
+		if err != nil {
+			fail(err)
+		}
+		fmt.Printf("已删除旧索引: %s\n", index)
+
+	default:
+		fail(fmt.Errorf("未知操作: %s", *action))
+	}
+}
+
+func printState(state *search.IndexState) {
+	fmt.Printf("读索引:   %s\n", state.ReadIndex)
+	fmt.Printf("写索引:   %s\n", state.WriteIndex)
+	fmt.Printf("双写索引: %s\n", orDash(state.DualWriteIndex))
+	fmt.Printf("全部索引: %s\n", strings.Join(state.Indices, ", "))
+	if state.LegacyIndexUsed {
+		fmt.Println("提示: 仍在使用旧版固定索引，执行 -action reindex 迁移到版本化索引")
+	}
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+func fail(err error) {
+	fmt.Fprintf(os.Stderr, "错误: %v\n", err)
+	os.Exit(1)
+}
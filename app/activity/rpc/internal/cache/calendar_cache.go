@@ -0,0 +1,97 @@
+package cache
+
+import (
+	"context"
+	"errors"
+
+	"activity-platform/app/activity/model"
+	commonCache "activity-platform/common/cache"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+	"golang.org/x/sync/singleflight"
+)
+
+// ==================== CalendarCache 日历订阅缓存 ====================
+//
+// 功能说明：
+//   - 缓存渲染好的用户 iCalendar 订阅内容
+//   - 日历客户端会定时轮询订阅地址，缓存避免每次都查库渲染
+//
+// 缓存策略：
+//   - Key: activity:calendar:feed:{user_id}
+//   - TTL: 30min ± 10%
+//   - 失效时机: 用户报名/取消报名时删除；活动变更时由 ChangeRelay 删除其报名用户的缓存
+
+// CalendarFeedLoader 缓存未命中时渲染订阅内容
+type CalendarFeedLoader func(ctx context.Context) (string, error)
+
+// CalendarCache 日历订阅缓存服务
+type CalendarCache struct {
+	rds               *redis.Redis
+	registrationModel *model.ActivityRegistrationModel
+	sfGroup           singleflight.Group
+}
+
+// NewCalendarCache 创建日历订阅缓存服务
+func NewCalendarCache(rds *redis.Redis, registrationModel *model.ActivityRegistrationModel) *CalendarCache {
+	return &CalendarCache{
+		rds:               rds,
+		registrationModel: registrationModel,
+	}
+}
+
+// Get 获取用户订阅内容（带缓存）
+func (c *CalendarCache) Get(ctx context.Context, userID uint64, loader CalendarFeedLoader) (string, error) {
+	key := commonCache.CalendarFeedKey(userID)
+
+	val, err := c.rds.GetCtx(ctx, key)
+	if err != nil && !errors.Is(err, redis.Nil) {
+		logx.WithContext(ctx).Errorf("[CalendarCache] Redis 错误，直接渲染: err=%v", err)
+		return loader(ctx)
+	}
+	if val != "" {
+		return val, nil
+	}
+
+	result, err, _ := c.sfGroup.Do(key, func() (interface{}, error) {
+		content, err := loader(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ttl := commonCache.RandomTTLSeconds(commonCache.LongTTL)
+		if err := c.rds.SetexCtx(ctx, key, content, ttl); err != nil {
+			logx.WithContext(ctx).Errorf("[CalendarCache] 写入缓存失败: key=%s, err=%v", key, err)
+		}
+		return content, nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return result.(string), nil
+}
+
+// Invalidate 删除用户订阅缓存（报名/取消报名后调用）
+func (c *CalendarCache) Invalidate(ctx context.Context, userIDs ...uint64) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	keys := make([]string, len(userIDs))
+	for i, id := range userIDs {
+		keys[i] = commonCache.CalendarFeedKey(id)
+	}
+	if _, err := c.rds.DelCtx(ctx, keys...); err != nil {
+		logx.WithContext(ctx).Errorf("[CalendarCache] 删除缓存失败: users=%d, err=%v", len(userIDs), err)
+		return err
+	}
+	return nil
+}
+
+// InvalidateActivities 活动变更后删除其报名用户的订阅缓存（ChangeRelay 订阅者）
+func (c *CalendarCache) InvalidateActivities(ctx context.Context, activityIDs []uint64) error {
+	userIDs, err := c.registrationModel.ListUserIDsByActivityIDs(ctx, activityIDs)
+	if err != nil {
+		return err
+	}
+	return c.Invalidate(ctx, userIDs...)
+}
@@ -0,0 +1,89 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	commonCache "activity-platform/common/cache"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+	"golang.org/x/sync/singleflight"
+)
+
+// ==================== SuggestCache 搜索建议缓存 ====================
+//
+// 功能说明：
+//   - 缓存输入联想（自动补全）结果
+//   - 前端防抖后仍会产生大量相同前缀请求，缓存 + singleflight 合并
+//
+// 缓存策略：
+//   - Key: activity:suggest:{category_id}:{size}:{prefix}
+//   - TTL: 1min ± 10%
+//   - 空结果同样缓存，防止无结果前缀穿透到 ES/MySQL
+//   - 失效时机: 通过 TTL 自动过期（不主动删除）
+
+// SuggestLoader 缓存未命中时加载建议列表
+type SuggestLoader func(ctx context.Context) ([]string, error)
+
+// SuggestCache 搜索建议缓存服务
+type SuggestCache struct {
+	rds     *redis.Redis
+	sfGroup singleflight.Group
+}
+
+// NewSuggestCache 创建搜索建议缓存服务
+func NewSuggestCache(rds *redis.Redis) *SuggestCache {
+	return &SuggestCache{
+		rds: rds,
+	}
+}
+
+// Get 获取搜索建议（带缓存）
+//
+// 前缀统一转小写后作为 Key，"Go" 与 "go" 共用缓存
+func (c *SuggestCache) Get(ctx context.Context, categoryID uint64, size int, prefix string, loader SuggestLoader) ([]string, error) {
+	key := commonCache.SuggestKey(categoryID, size, strings.ToLower(prefix))
+
+	// 1. 尝试从缓存获取
+	val, err := c.rds.GetCtx(ctx, key)
+	if err != nil && !errors.Is(err, redis.Nil) {
+		// Redis 错误，直接加载
+		logx.WithContext(ctx).Errorf("[SuggestCache] Redis 错误，直接加载: err=%v", err)
+		return loader(ctx)
+	}
+
+	// 2. 缓存命中
+	if val != "" {
+		var suggestions []string
+		if err := json.Unmarshal([]byte(val), &suggestions); err == nil {
+			return suggestions, nil
+		}
+		logx.WithContext(ctx).Errorf("[SuggestCache] 反序列化失败: key=%s, err=%v", key, err)
+		_, _ = c.rds.DelCtx(ctx, key)
+	}
+
+	// 3. 缓存未命中，singleflight 合并相同前缀的并发请求
+	result, err, _ := c.sfGroup.Do(key, func() (interface{}, error) {
+		suggestions, err := loader(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if suggestions == nil {
+			suggestions = []string{}
+		}
+
+		data, _ := json.Marshal(suggestions)
+		ttl := commonCache.RandomTTLSeconds(commonCache.SuggestTTL)
+		if err := c.rds.SetexCtx(ctx, key, string(data), ttl); err != nil {
+			logx.WithContext(ctx).Errorf("[SuggestCache] 写入缓存失败: key=%s, err=%v", key, err)
+		}
+		return suggestions, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]string), nil
+}
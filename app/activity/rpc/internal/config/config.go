@@ -26,6 +26,34 @@ type Config struct {
 	// ==================== 消息队列配置 ====================
 	Messaging MessagingConfig `json:",optional"` // 消息队列配置（可选，不配置则不发布事件）
 
+	// ==================== 内容安全配置 ====================
+	ContentFilter ContentFilterConfig `json:",optional"` // 敏感词过滤（可选，不配置则不检测）
+
+	// ==================== 发布审核配置 ====================
+	Review        ReviewConfig        `json:",optional"` // 发布审核策略（默认：优秀及以上信用等级自动通过，其余人工审核）
+	ChangeRequest ChangeRequestConfig `json:",optional"` // 已发布活动变更申请（默认生效后 48 小时内无责取消）
+
+	// ==================== 活动评价配置 ====================
+	Feedback FeedbackConfig `json:",optional"` // 活动评价（可选，默认活动结束后 7 天内可评价）
+
+	// ==================== 定时提醒配置 ====================
+	Reminder ReminderConfig `json:",optional"` // 活动开始前提醒、报名截止提醒（默认启用）
+
+	// ==================== 系列活动配置 ====================
+	Series SeriesConfig `json:",optional"` // 重复活动的场次生成与整期报名
+
+	// ==================== 日历订阅配置 ====================
+	Calendar CalendarConfig `json:",optional"` // iCalendar 订阅与 .ics 导出
+
+	// ==================== 自助签到配置 ====================
+	SelfCheckIn SelfCheckInConfig `json:",optional"` // 定位 + 现场签到码的自助签到
+
+	// ==================== 活动模板配置 ====================
+	Template TemplateConfig `json:",optional"` // 个人模板与公共模板
+
+	// ==================== 活动分析配置 ====================
+	Analytics AnalyticsConfig `json:",optional"` // 组织者数据看板的统计聚合
+
 	// ==================== 高并发、熔断限流配置 ====================
 	RegistrationLimit struct {
 		Rate  int `json:",default=100"` // 每秒允许的请求数
@@ -45,7 +73,7 @@ type Config struct {
 // 配置说明：
 // - Enabled: 是否启用 ES 搜索（false 时降级到 MySQL LIKE）
 // - Hosts: ES 集群地址列表
-// - IndexName: 活动索引名称
+// - IndexName: 活动索引读别名（物理索引为 {IndexName}_v{N}，写别名为 {IndexName}_write）
 //
 // 示例配置：
 //
@@ -59,7 +87,7 @@ type ESConfig struct {
 	Hosts         []string `json:",default=[http://localhost:9200]"` // ES 地址
 	Username      string   `json:",optional"`                        // 认证用户名（可选）
 	Password      string   `json:",optional"`                        // 认证密码（可选）
-	IndexName     string   `json:",default=activities"`              // 索引名
+	IndexName     string   `json:",default=activities"`              // 索引名（读别名）
 	MaxRetries    int      `json:",default=3"`                       // 最大重试次数
 	HealthTimeout int      `json:",default=5"`                       // 健康检查超时（秒）
 }
@@ -123,3 +151,161 @@ type DTMConfig struct {
 	ActivityRpcURL string `json:",default=localhost:9002"`  // Activity RPC 地址
 	UserRpcURL     string `json:",default=localhost:9001"`  // User RPC 地址
 }
+
+// ContentFilterConfig 敏感词过滤配置
+//
+// 词库存放在 BizRedis（Key 设计见 common/contentfilter），各实例定时比对版本号热加载。
+//
+// 示例配置：
+//
+//	ContentFilter:
+//	  Enabled: true
+//	  KeyPrefix: contentfilter
+//	  ReloadInterval: 30
+type ContentFilterConfig struct {
+	Enabled        bool   `json:",default=false"`         // 是否启用敏感词过滤
+	KeyPrefix      string `json:",default=contentfilter"` // 词库 Redis Key 前缀
+	ReloadInterval int    `json:",default=30"`            // 热加载检查间隔（秒）
+}
+
+// ReviewConfig 发布审核策略
+//
+// 组织者提交发布时按 Mode 判定：
+//   - policy：信用等级 >= AutoApproveMinLevel 且内容未命中送审词时自动通过，否则人工审核
+//   - manual：全部人工审核
+//   - auto：全部自动通过（命中送审词的内容仍进入人工审核）
+//
+// 示例配置：
+//
+//	Review:
+//	  Mode: policy
+//	  AutoApproveMinLevel: 3
+//	  SLAHours: 24
+type ReviewConfig struct {
+	Mode                string `json:",default=policy,options=policy|manual|auto"` // 审核模式
+	AutoApproveMinLevel int    `json:",default=3"`                                 // 自动通过的最低信用等级（3=优秀用户）
+	SLAHours            int    `json:",default=24"`                                // 人工审核时限（小时）
+}
+
+// ChangeRequestConfig 已发布活动变更申请配置
+//
+// 时间/地点变更经管理员审核生效后，报名者在 FreeCancelHours 小时内取消报名
+// 按提前取消（cancel_early）处理，不受开始前 24 小时规则限制；窗口不晚于新的开始时间。
+//
+// 示例配置：
+//
+//	ChangeRequest:
+//	  FreeCancelHours: 48
+type ChangeRequestConfig struct {
+	FreeCancelHours int `json:",default=48"` // 无责取消窗口（小时）
+}
+
+// FeedbackConfig 活动评价配置
+//
+// 活动结束（activity_end_time）后 WindowDays 天内，已核销票据的参与者可提交/修改评价。
+//
+// 示例配置：
+//
+//	Feedback:
+//	  WindowDays: 7
+type FeedbackConfig struct {
+	WindowDays int `json:",default=7"` // 评价窗口（天）
+}
+
+// ReminderConfig 定时提醒配置
+//
+// 提醒任务存放在 BizRedis 的有序集合（延迟队列），由 ReminderCron 轮询到期任务：
+//   - 报名成功：活动开始前 24 小时、1 小时各提醒一次
+//   - 收藏未报名：报名截止前 RegisterClosingMinutes 分钟提醒一次
+//
+// 示例配置：
+//
+//	Reminder:
+//	  Enabled: true
+//	  RegisterClosingMinutes: 120
+//	  PollIntervalSeconds: 5
+type ReminderConfig struct {
+	Enabled                bool `json:",default=true"` // 是否启用定时提醒
+	RegisterClosingMinutes int  `json:",default=120"`  // 报名截止提醒提前量（分钟）
+	PollIntervalSeconds    int  `json:",default=5"`    // 到期任务轮询间隔（秒）
+}
+
+// SeriesConfig 系列活动配置
+//
+// SeriesCron 定时按重复规则生成未来 GenerateAheadDays 天内的场次，
+// 并为整期报名的用户在各场开放报名后逐场报名出票。
+//
+// 示例配置：
+//
+//	Series:
+//	  GenerateAheadDays: 28
+//	  MaxOccurrences: 52
+//	  IntervalSeconds: 300
+type SeriesConfig struct {
+	GenerateAheadDays int `json:",default=28"`  // 提前生成多少天内的场次
+	MaxOccurrences    int `json:",default=52"`  // 单个系列最多场次
+	IntervalSeconds   int `json:",default=300"` // 生成/整期报名任务执行间隔（秒）
+}
+
+// CalendarConfig 日历订阅配置
+//
+// ActivityURL 为活动详情页地址模板（%d 替换为活动ID），写入 VEVENT 的 URL，
+// 为空时不输出链接；订阅只包含最近 PastDays 天内结束及未来的活动。
+//
+// 示例配置：
+//
+//	Calendar:
+//	  ActivityURL: "https://campushub.example.com/activity/%d"
+//	  PastDays: 90
+//	  MaxEvents: 500
+type CalendarConfig struct {
+	ActivityURL string `json:",optional"`    // 活动详情页地址模板
+	PastDays    int    `json:",default=90"`  // 订阅保留已结束活动的天数
+	MaxEvents   int    `json:",default=500"` // 单个订阅最多事件数
+}
+
+// SelfCheckInConfig 自助签到配置
+//
+// 组织者开启时未指定半径则使用 DefaultRadiusMeters，指定值不得超过 MaxRadiusMeters；
+// 同一设备在同一活动中已为 DeviceUserLimit 个其他用户签到过时，后续签到记录标记为疑似异常
+// （签到仍然成功，由组织者在签到记录中复核）。
+//
+// 示例配置：
+//
+//	SelfCheckIn:
+//	  DefaultRadiusMeters: 200
+//	  MaxRadiusMeters: 2000
+//	  DeviceUserLimit: 1
+type SelfCheckInConfig struct {
+	DefaultRadiusMeters int `json:",default=200"`  // 默认签到半径（米）
+	MaxRadiusMeters     int `json:",default=2000"` // 最大签到半径（米）
+	DeviceUserLimit     int `json:",default=1"`    // 单设备可为其他用户签到的次数上限，超出即标记异常
+}
+
+// TemplateConfig 活动模板配置
+//
+// 每位用户最多保存 MaxPrivatePerUser 个个人模板；公共模板由管理员维护，不受限制。
+//
+// 示例配置：
+//
+//	Template:
+//	  MaxPrivatePerUser: 50
+type TemplateConfig struct {
+	MaxPrivatePerUser int `json:",default=50"` // 单个用户个人模板上限
+}
+
+// AnalyticsConfig 活动分析配置
+//
+// AnalyticsCron 每 IntervalSeconds 秒把浏览埋点与报名、签到记录聚合进统计汇总表，
+// 当天的数据每轮刷新；最近 BackfillDays 天内尚未完成聚合的日期会补算（最多 7 天，浏览埋点只保留 8 天）。
+// 同一任务每轮还会把最近 BackfillDays 天按分类的活动发布、取消、结束数上报到平台统计表。
+//
+// 示例配置：
+//
+//	Analytics:
+//	  IntervalSeconds: 3600
+//	  BackfillDays: 3
+type AnalyticsConfig struct {
+	IntervalSeconds int `json:",default=3600"` // 聚合任务执行间隔（秒）
+	BackfillDays    int `json:",default=3"`    // 回补天数（含当天）
+}
@@ -0,0 +1,154 @@
+package cron
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+// ==================== 常量定义 ====================
+
+const (
+	// 分布式锁配置
+	analyticsLockKey        = "activity:cron:analytics"
+	analyticsLockExpire     = 600  // 锁过期时间（秒），聚合需逐个活动刷新并调用用户服务
+	analyticsDefaultSeconds = 3600 // 默认执行间隔：1 小时
+)
+
+// AnalyticsTaskFunc 统计聚合任务函数
+//
+// 由 logic 层注入（cron 包不能依赖 logic 包），处理应当幂等
+type AnalyticsTaskFunc func(ctx context.Context) error
+
+// ==================== AnalyticsCron 活动统计聚合定时任务 ====================
+
+// AnalyticsCron 活动统计聚合定时任务
+//
+// 功能说明：
+//   - 把浏览埋点与报名、签到记录聚合进统计汇总表，供组织者数据看板查询
+//   - 统计按分类的活动发布、取消、结束数，上报到用户服务的平台统计表（管理后台运营报表）
+//
+// 执行策略：
+//   - 启动时立即执行一次
+//   - 之后按配置间隔执行（默认 1 小时，当天数据每轮刷新）
+//   - 使用 Redis 分布式锁，多实例部署时只有一个实例执行
+type AnalyticsCron struct {
+	redis     *redis.Redis
+	aggregate AnalyticsTaskFunc
+	report    AnalyticsTaskFunc
+
+	intervalSeconds int
+	stopChan        chan struct{}
+	running         atomic.Bool
+	stopOnce        sync.Once
+	ownerID         string
+}
+
+// NewAnalyticsCron 创建活动统计聚合定时任务
+func NewAnalyticsCron(rds *redis.Redis, aggregate, report AnalyticsTaskFunc) *AnalyticsCron {
+	return &AnalyticsCron{
+		redis:           rds,
+		aggregate:       aggregate,
+		report:          report,
+		intervalSeconds: analyticsDefaultSeconds,
+		stopChan:        make(chan struct{}),
+		ownerID:         uuid.New().String(),
+	}
+}
+
+// SetInterval 设置执行间隔（秒）
+func (c *AnalyticsCron) SetInterval(seconds int) {
+	if seconds > 0 {
+		c.intervalSeconds = seconds
+	}
+}
+
+// Start 启动定时任务
+func (c *AnalyticsCron) Start() {
+	if !c.running.CompareAndSwap(false, true) {
+		logx.Info("[AnalyticsCron] 定时任务已在运行中，跳过重复启动")
+		return
+	}
+
+	logx.Infof("[AnalyticsCron] 启动活动统计聚合定时任务，执行间隔: %d 秒, owner: %s",
+		c.intervalSeconds, c.ownerID)
+
+	go func() {
+		c.execute()
+
+		ticker := time.NewTicker(time.Duration(c.intervalSeconds) * time.Second)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				c.execute()
+			case <-c.stopChan:
+				logx.Info("[AnalyticsCron] 活动统计聚合定时任务已停止")
+				return
+			}
+		}
+	}()
+}
+
+// Stop 停止定时任务
+func (c *AnalyticsCron) Stop() {
+	if !c.running.Load() {
+		return
+	}
+	c.stopOnce.Do(func() {
+		close(c.stopChan)
+	})
+	c.running.Store(false)
+}
+
+// execute 执行一轮聚合
+func (c *AnalyticsCron) execute() {
+	ctx := context.Background()
+
+	locked, err := c.redis.SetnxExCtx(ctx, analyticsLockKey, c.ownerID, analyticsLockExpire)
+	if err != nil {
+		logx.Errorf("[AnalyticsCron] 获取锁失败: %v", err)
+		return
+	}
+	if !locked {
+		return // 其他实例正在执行
+	}
+	defer c.releaseLock(ctx)
+
+	defer func() {
+		if r := recover(); r != nil {
+			logx.Errorf("[AnalyticsCron] 任务 panic: %v", r)
+		}
+	}()
+	start := time.Now()
+	if c.aggregate != nil {
+		if err := c.aggregate(ctx); err != nil {
+			logx.Errorf("[AnalyticsCron] 聚合失败: %v", err)
+		}
+	}
+	if c.report != nil {
+		if err := c.report(ctx); err != nil {
+			logx.Errorf("[AnalyticsCron] 平台统计上报失败: %v", err)
+		}
+	}
+	logx.Infof("[AnalyticsCron] 本轮执行完成，耗时: %v", time.Since(start))
+}
+
+// releaseLock 释放分布式锁（仅 owner 匹配时才删除）
+func (c *AnalyticsCron) releaseLock(ctx context.Context) {
+	result, err := c.redis.EvalCtx(ctx, unlockScript, []string{analyticsLockKey}, c.ownerID)
+	if err != nil {
+		logx.Errorf("[AnalyticsCron] 释放锁失败: %v", err)
+		return
+	}
+	if fmt.Sprintf("%v", result) == "0" {
+		logx.Infof("[AnalyticsCron] 锁已被其他实例持有，跳过释放")
+	}
+}
@@ -0,0 +1,318 @@
+package cron
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"activity-platform/app/activity/model"
+
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+// ==================== 常量定义 ====================
+
+const (
+	// 分布式锁配置
+	changeRelayLockKey    = "activity:cron:change_relay"
+	changeRelayLockExpire = 30 // 锁过期时间（秒）
+
+	// 轮询配置
+	changeRelayInterval  = time.Second // 轮询间隔
+	changeRelayBatchSize = 200         // 每批事件数
+	changeRelayMaxBatch  = 20          // 每轮最多处理批数（避免长时间持锁）
+	changeRelayMaxRetry  = 20          // 单个事件最大重试次数，超过后保留在表中人工排查
+
+	// 单个事件失败退避上限
+	changeRelayMaxBackoff = time.Minute
+)
+
+// ChangeHandleFunc 活动变更订阅者处理函数
+//
+// activityIDs 已去重；处理应当幂等（按 ID 回查最新数据 / 删除缓存）
+type ChangeHandleFunc func(ctx context.Context, activityIDs []uint64) error
+
+// changeSubscriber 订阅者
+type changeSubscriber struct {
+	name        string
+	changeTypes map[string]struct{} // 关注的变更类型（nil 表示全部）
+	handle      ChangeHandleFunc
+}
+
+// accepts 订阅者是否关注该变更类型
+func (s changeSubscriber) accepts(changeType string) bool {
+	if s.changeTypes == nil {
+		return true
+	}
+	_, ok := s.changeTypes[changeType]
+	return ok
+}
+
+// ==================== ChangeRelay 活动变更事件消费 ====================
+
+// ChangeRelay 活动变更事件消费（Outbox 轮询）
+//
+// 功能说明：
+//   - 轮询 activity_change_events 表，将变更分发给所有订阅者
+//   - 订阅者：ES 索引、活动详情缓存、热门缓存、推荐缓存
+//   - 所有写入方（活动服务、用户服务封面上传、状态定时任务、DTM 分支）统一走这一条更新路径
+//
+// 执行策略：
+//   - 默认每秒轮询一次，使用 Redis 分布式锁保证多实例只有一个消费者
+//   - 同一批内按活动 ID 去重
+//   - 订阅者批量处理失败时逐个重试，隔离失败的活动
+//   - 成功的事件删除；失败的事件 retry_count + 1，并按该事件的失败次数推迟 next_retry_at
+//     （退避只作用于失败的事件，其他活动的变更照常按轮询间隔消费）
+type ChangeRelay struct {
+	redis       *redis.Redis
+	eventModel  *model.ActivityChangeEventModel
+	subscribers []changeSubscriber
+
+	stopChan chan struct{} // 停止信号
+	running  atomic.Bool   // 运行状态（原子操作，并发安全）
+	stopOnce sync.Once     // 保证 close(stopChan) 只执行一次
+	ownerID  string        // 分布式锁 owner 标识（防止误删他人锁）
+}
+
+// NewChangeRelay 创建活动变更事件消费任务
+func NewChangeRelay(rds *redis.Redis, eventModel *model.ActivityChangeEventModel) *ChangeRelay {
+	return &ChangeRelay{
+		redis:      rds,
+		eventModel: eventModel,
+		stopChan:   make(chan struct{}),
+		ownerID:    uuid.New().String(),
+	}
+}
+
+// Subscribe 注册订阅者，接收全部变更类型（需在 Start 之前调用）
+func (c *ChangeRelay) Subscribe(name string, handle ChangeHandleFunc) {
+	c.subscribers = append(c.subscribers, changeSubscriber{name: name, handle: handle})
+}
+
+// SubscribeTypes 注册只关注部分变更类型的订阅者（需在 Start 之前调用）
+//
+// 批次中没有关注类型的事件时不会调用该订阅者
+func (c *ChangeRelay) SubscribeTypes(name string, changeTypes []string, handle ChangeHandleFunc) {
+	types := make(map[string]struct{}, len(changeTypes))
+	for _, t := range changeTypes {
+		types[t] = struct{}{}
+	}
+	c.subscribers = append(c.subscribers, changeSubscriber{name: name, changeTypes: types, handle: handle})
+}
+
+// Start 启动消费任务
+func (c *ChangeRelay) Start() {
+	if !c.running.CompareAndSwap(false, true) {
+		logx.Info("[ChangeRelay] 任务已在运行中，跳过重复启动")
+		return
+	}
+
+	names := make([]string, 0, len(c.subscribers))
+	for _, sub := range c.subscribers {
+		names = append(names, sub.name)
+	}
+	logx.Infof("[ChangeRelay] 启动活动变更事件消费，订阅者: %s, owner: %s", strings.Join(names, ","), c.ownerID)
+
+	go func() {
+		ticker := time.NewTicker(changeRelayInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				c.execute()
+			case <-c.stopChan:
+				logx.Info("[ChangeRelay] 任务已停止")
+				return
+			}
+		}
+	}()
+}
+
+// Stop 停止消费任务
+func (c *ChangeRelay) Stop() {
+	if !c.running.Load() {
+		return
+	}
+	c.stopOnce.Do(func() {
+		close(c.stopChan)
+	})
+	c.running.Store(false)
+}
+
+// execute 执行一轮消费
+func (c *ChangeRelay) execute() {
+	ctx := context.Background()
+
+	locked, err := c.tryLock(ctx)
+	if err != nil {
+		logx.Errorf("[ChangeRelay] 获取锁失败: %v", err)
+		return
+	}
+	if !locked {
+		return
+	}
+	defer c.unlock(ctx)
+
+	// 失败的事件已推迟 next_retry_at，不会出现在下一批中，可继续处理
+	for i := 0; i < changeRelayMaxBatch; i++ {
+		processed, err := c.processBatch(ctx)
+		if err != nil {
+			logx.Errorf("[ChangeRelay] 处理事件失败: %v", err)
+			return
+		}
+		if processed < changeRelayBatchSize {
+			return
+		}
+	}
+}
+
+// processBatch 处理一批事件，返回事件数
+func (c *ChangeRelay) processBatch(ctx context.Context) (int, error) {
+	now := time.Now()
+	events, err := c.eventModel.FetchPending(ctx, changeRelayMaxRetry, now.Unix(), changeRelayBatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("查询变更事件失败: %w", err)
+	}
+	if len(events) == 0 {
+		return 0, nil
+	}
+
+	// 1. 分发给所有订阅者
+	failedIDs := c.dispatch(ctx, events)
+
+	// 2. 成功的事件删除，失败的事件按各自的失败次数推迟重试
+	var doneIDs []uint64
+	retryIDs := make(map[int64][]uint64)
+	retryCount := 0
+	for _, event := range events {
+		if _, ok := failedIDs[event.ActivityID]; ok {
+			nextRetryAt := now.Add(retryDelay(event.RetryCount)).Unix()
+			retryIDs[nextRetryAt] = append(retryIDs[nextRetryAt], event.ID)
+			retryCount++
+			continue
+		}
+		doneIDs = append(doneIDs, event.ID)
+	}
+
+	if err := c.eventModel.DeleteByIDs(ctx, doneIDs); err != nil {
+		return len(events), fmt.Errorf("删除已消费事件失败: %w", err)
+	}
+	if retryCount > 0 {
+		reason := joinFailReasons(failedIDs)
+		for nextRetryAt, ids := range retryIDs {
+			if err := c.eventModel.MarkFailed(ctx, ids, reason, nextRetryAt); err != nil {
+				// 未能推迟的事件会在下一轮被立即重新消费（订阅者幂等）
+				logx.Errorf("[ChangeRelay] 记录失败事件失败: %v", err)
+			}
+		}
+		logx.Errorf("[ChangeRelay] %d 个事件处理失败，将重试: activityIDs=%v", retryCount, mapKeys(failedIDs))
+	}
+
+	return len(events), nil
+}
+
+// dispatch 分发给所有订阅者，返回处理失败的活动 ID 及原因
+func (c *ChangeRelay) dispatch(ctx context.Context, events []model.ActivityChangeEvent) map[uint64]string {
+	failedIDs := make(map[uint64]string)
+
+	for _, sub := range c.subscribers {
+		activityIDs := subscribedActivityIDs(sub, events)
+		if len(activityIDs) == 0 {
+			continue
+		}
+
+		err := c.safeHandle(ctx, sub, activityIDs)
+		if err == nil {
+			continue
+		}
+		if len(activityIDs) == 1 {
+			failedIDs[activityIDs[0]] = fmt.Sprintf("%s: %v", sub.name, err)
+			continue
+		}
+
+		// 批量失败：逐个重试，隔离失败的活动
+		logx.Errorf("[ChangeRelay] 订阅者 %s 批量处理失败，逐个重试: %v", sub.name, err)
+		for _, id := range activityIDs {
+			if err := c.safeHandle(ctx, sub, []uint64{id}); err != nil {
+				failedIDs[id] = fmt.Sprintf("%s: %v", sub.name, err)
+			}
+		}
+	}
+	return failedIDs
+}
+
+// safeHandle 调用订阅者（捕获 panic，避免单个订阅者拖垮消费协程）
+func (c *ChangeRelay) safeHandle(ctx context.Context, sub changeSubscriber, activityIDs []uint64) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return sub.handle(ctx, activityIDs)
+}
+
+// subscribedActivityIDs 订阅者关注的活动 ID（按活动 ID 去重，保持事件顺序）
+func subscribedActivityIDs(sub changeSubscriber, events []model.ActivityChangeEvent) []uint64 {
+	activityIDs := make([]uint64, 0, len(events))
+	seen := make(map[uint64]struct{}, len(events))
+	for _, event := range events {
+		if !sub.accepts(event.ChangeType) {
+			continue
+		}
+		if _, ok := seen[event.ActivityID]; ok {
+			continue
+		}
+		seen[event.ActivityID] = struct{}{}
+		activityIDs = append(activityIDs, event.ActivityID)
+	}
+	return activityIDs
+}
+
+// retryDelay 单个事件的失败退避：1s, 2s, 4s ... 最长 1min（retryCount 为此前已失败次数）
+func retryDelay(retryCount uint32) time.Duration {
+	delay := changeRelayInterval << min(retryCount, 6)
+	if delay > changeRelayMaxBackoff {
+		delay = changeRelayMaxBackoff
+	}
+	return delay
+}
+
+// tryLock 尝试获取分布式锁（带 owner 标识）
+func (c *ChangeRelay) tryLock(ctx context.Context) (bool, error) {
+	return c.redis.SetnxExCtx(ctx, changeRelayLockKey, c.ownerID, changeRelayLockExpire)
+}
+
+// unlock 释放分布式锁（仅 owner 匹配时才删除）
+func (c *ChangeRelay) unlock(ctx context.Context) {
+	if _, err := c.redis.EvalCtx(ctx, unlockScript, []string{changeRelayLockKey}, c.ownerID); err != nil {
+		logx.Errorf("[ChangeRelay] 释放锁失败: err=%v", err)
+	}
+}
+
+// joinFailReasons 合并失败原因（写入 last_error）
+func joinFailReasons(failed map[uint64]string) string {
+	reasons := make([]string, 0, len(failed))
+	seen := make(map[string]struct{}, len(failed))
+	for _, reason := range failed {
+		if _, ok := seen[reason]; ok {
+			continue
+		}
+		seen[reason] = struct{}{}
+		reasons = append(reasons, reason)
+	}
+	return strings.Join(reasons, "; ")
+}
+
+func mapKeys(m map[uint64]string) []uint64 {
+	keys := make([]uint64, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	return keys
+}
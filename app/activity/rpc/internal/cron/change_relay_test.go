@@ -0,0 +1,60 @@
+package cron
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"activity-platform/app/activity/model"
+)
+
+func TestSubscribedActivityIDs(t *testing.T) {
+	events := []model.ActivityChangeEvent{
+		{ID: 1, ActivityID: 10, ChangeType: model.ChangeTypeParticipants},
+		{ID: 2, ActivityID: 11, ChangeType: model.ChangeTypeStatus},
+		{ID: 3, ActivityID: 10, ChangeType: model.ChangeTypeStatus},
+		{ID: 4, ActivityID: 12, ChangeType: model.ChangeTypeRating},
+		{ID: 5, ActivityID: 11, ChangeType: model.ChangeTypeParticipants},
+	}
+
+	relay := &ChangeRelay{}
+	noop := func(context.Context, []uint64) error { return nil }
+	relay.Subscribe("all", noop)
+	relay.SubscribeTypes("status_only", []string{model.ChangeTypeStatus}, noop)
+	relay.SubscribeTypes("delete_only", []string{model.ChangeTypeDelete}, noop)
+
+	tests := []struct {
+		sub  changeSubscriber
+		want []uint64
+	}{
+		{sub: relay.subscribers[0], want: []uint64{10, 11, 12}},
+		{sub: relay.subscribers[1], want: []uint64{11, 10}},
+		{sub: relay.subscribers[2], want: []uint64{}},
+	}
+
+	for _, tt := range tests {
+		if got := subscribedActivityIDs(tt.sub, events); !reflect.DeepEqual(got, tt.want) {
+			t.Fatalf("%s: activityIDs = %v, want %v", tt.sub.name, got, tt.want)
+		}
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	tests := []struct {
+		retryCount uint32
+		want       time.Duration
+	}{
+		{retryCount: 0, want: time.Second},
+		{retryCount: 1, want: 2 * time.Second},
+		{retryCount: 5, want: 32 * time.Second},
+		{retryCount: 6, want: changeRelayMaxBackoff},
+		{retryCount: changeRelayMaxRetry, want: changeRelayMaxBackoff},
+	}
+
+	for _, tt := range tests {
+		if got := retryDelay(tt.retryCount); got != tt.want {
+			t.Fatalf("retryDelay(%d) = %v, want %v", tt.retryCount, got, tt.want)
+		}
+	}
+}
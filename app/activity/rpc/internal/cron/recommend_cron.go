@@ -362,6 +362,80 @@ func (c *RecommendCron) unlock(ctx context.Context, key string) {
 	}
 }
 
+// EvictInactive 从推荐缓存中移除已不可见的活动（活动变更事件订阅者）
+//
+// 说明：
+//   - 取消/删除/驳回的活动立即从推荐列表移除，避免推荐点进去 404
+//   - 新发布的活动不插入，等待下一次定时计算（评分依赖全量归一化）
+func (c *RecommendCron) EvictInactive(ctx context.Context, activityIDs []uint64) error {
+	if len(activityIDs) == 0 {
+		return nil
+	}
+
+	// 1. 找出已不公开的活动（含软删除）
+	var visible []uint64
+	err := c.db.WithContext(ctx).
+		Model(&model.Activity{}).
+		Where("id IN ? AND status IN ?", activityIDs,
+			[]int8{model.StatusPublished, model.StatusOngoing}).
+		Pluck("id", &visible).Error
+	if err != nil {
+		return err
+	}
+	visibleSet := make(map[uint64]struct{}, len(visible))
+	for _, id := range visible {
+		visibleSet[id] = struct{}{}
+	}
+	evict := make(map[uint64]struct{}, len(activityIDs))
+	for _, id := range activityIDs {
+		if _, ok := visibleSet[id]; !ok {
+			evict[id] = struct{}{}
+		}
+	}
+	if len(evict) == 0 {
+		return nil
+	}
+
+	// 2. 读取缓存并过滤
+	cacheKey := recommendListCacheKeyPrefix + "global"
+	cached, err := c.redis.GetCtx(ctx, cacheKey)
+	if err != nil || cached == "" {
+		return err
+	}
+	var scoredList []ActivityScoreDTO
+	if err := json.Unmarshal([]byte(cached), &scoredList); err != nil {
+		// 缓存损坏直接删除，读取方降级为热度排序
+		_, err = c.redis.DelCtx(ctx, cacheKey)
+		return err
+	}
+
+	filtered := scoredList[:0]
+	for _, item := range scoredList {
+		if _, ok := evict[item.ActivityID]; !ok {
+			filtered = append(filtered, item)
+		}
+	}
+	if len(filtered) == len(scoredList) {
+		return nil
+	}
+
+	// 3. 写回（保留剩余 TTL）
+	ttl, err := c.redis.TtlCtx(ctx, cacheKey)
+	if err != nil || ttl <= 0 {
+		ttl = recommendDefaultInterval
+	}
+	data, err := json.Marshal(filtered)
+	if err != nil {
+		return err
+	}
+	if err := c.redis.SetexCtx(ctx, cacheKey, string(data), ttl); err != nil {
+		return err
+	}
+
+	logx.Infof("[RecommendCron] 推荐缓存移除 %d 个活动", len(scoredList)-len(filtered))
+	return nil
+}
+
 // RunOnce 手动执行一次推荐列表缓存计算
 func (c *RecommendCron) RunOnce() {
 	logx.Info("[RecommendCron] 手动触发推荐列表缓存计算")
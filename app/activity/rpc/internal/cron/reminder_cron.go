@@ -0,0 +1,262 @@
+package cron
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"activity-platform/app/activity/model"
+	"activity-platform/app/activity/rpc/internal/mq"
+	"activity-platform/app/activity/rpc/internal/reminder"
+	"activity-platform/common/messaging"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// ==================== 常量定义 ====================
+
+const (
+	reminderDefaultInterval = 5   // 默认轮询间隔（秒）
+	reminderBatchSize       = 200 // 每轮最多取出的到期任务数
+	reminderRetryDelay      = 60  // 处理失败后重新入队的延迟（秒）
+)
+
+// ==================== ReminderCron 定时提醒 ====================
+
+// ReminderCron 活动定时提醒（消费 Redis 延迟队列）
+//
+// 功能说明：
+//   - 轮询 reminder.Queue 中已到期的任务，发布 activity.reminder 事件
+//   - Chat MQ 消费后创建系统通知，并经 notification:push 推送到 WS
+//
+// 触发前按最新数据校验（入队后活动可能取消、改期，报名可能取消）：
+//   - 活动必须仍为已发布状态，否则丢弃
+//   - 按活动当前时间重算触发时间，已后移则按新时间重新入队
+//   - 开始前提醒：报名仍有效；活动已开始则丢弃，24 小时提醒错过到 1 小时内则交给 1 小时提醒
+//   - 报名截止提醒：仍收藏且未报名，报名尚未截止
+//
+// 多实例部署时由 ZREM 抢占任务，无需分布式锁
+type ReminderCron struct {
+	queue             *reminder.Queue
+	activityModel     *model.ActivityModel
+	registrationModel *model.ActivityRegistrationModel
+	favoriteModel     *model.ActivityFavoriteModel
+	msgProducer       *mq.Producer
+
+	intervalSeconds int           // 轮询间隔（秒）
+	stopChan        chan struct{} // 停止信号
+	running         atomic.Bool   // 运行状态（原子操作，并发安全）
+	stopOnce        sync.Once     // 保证 close(stopChan) 只执行一次
+}
+
+// NewReminderCron 创建定时提醒任务
+func NewReminderCron(
+	queue *reminder.Queue,
+	activityModel *model.ActivityModel,
+	registrationModel *model.ActivityRegistrationModel,
+	favoriteModel *model.ActivityFavoriteModel,
+	msgProducer *mq.Producer,
+) *ReminderCron {
+	return &ReminderCron{
+		queue:             queue,
+		activityModel:     activityModel,
+		registrationModel: registrationModel,
+		favoriteModel:     favoriteModel,
+		msgProducer:       msgProducer,
+		intervalSeconds:   reminderDefaultInterval,
+		stopChan:          make(chan struct{}),
+	}
+}
+
+// SetInterval 设置轮询间隔（秒）
+func (c *ReminderCron) SetInterval(seconds int) {
+	if seconds > 0 {
+		c.intervalSeconds = seconds
+	}
+}
+
+// Start 启动定时任务（队列未启用时不启动）
+func (c *ReminderCron) Start() {
+	if c.queue == nil {
+		logx.Info("[ReminderCron] 定时提醒未启用，跳过启动")
+		return
+	}
+	if !c.running.CompareAndSwap(false, true) {
+		logx.Info("[ReminderCron] 定时任务已在运行中，跳过重复启动")
+		return
+	}
+
+	logx.Infof("[ReminderCron] 启动定时提醒任务，轮询间隔: %d 秒", c.intervalSeconds)
+
+	go func() {
+		ticker := time.NewTicker(time.Duration(c.intervalSeconds) * time.Second)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				c.execute()
+			case <-c.stopChan:
+				logx.Info("[ReminderCron] 定时任务已停止")
+				return
+			}
+		}
+	}()
+}
+
+// Stop 停止定时任务
+func (c *ReminderCron) Stop() {
+	if !c.running.Load() {
+		return
+	}
+	c.stopOnce.Do(func() {
+		close(c.stopChan)
+	})
+	c.running.Store(false)
+}
+
+// execute 处理一轮到期任务
+func (c *ReminderCron) execute() {
+	defer func() {
+		if r := recover(); r != nil {
+			logx.Errorf("[ReminderCron] panic recovered: %v", r)
+		}
+	}()
+
+	ctx := context.Background()
+	now := time.Now().Unix()
+
+	jobs, err := c.queue.PopDue(ctx, now, reminderBatchSize)
+	if err != nil {
+		logx.Errorf("[ReminderCron] 取出到期任务失败: %v", err)
+	}
+	if len(jobs) == 0 {
+		return
+	}
+
+	// 1. 批量加载活动
+	activityIDs := make([]uint64, 0, len(jobs))
+	seen := make(map[uint64]struct{}, len(jobs))
+	for _, job := range jobs {
+		if _, ok := seen[job.ActivityID]; !ok {
+			seen[job.ActivityID] = struct{}{}
+			activityIDs = append(activityIDs, job.ActivityID)
+		}
+	}
+	activities, err := c.activityModel.FindByIDs(ctx, activityIDs)
+	if err != nil {
+		logx.Errorf("[ReminderCron] 查询活动失败，任务延后重试: count=%d, err=%v", len(jobs), err)
+		c.retry(ctx, jobs, now)
+		return
+	}
+	activityMap := make(map[uint64]*model.Activity, len(activities))
+	for i := range activities {
+		activityMap[activities[i].ID] = &activities[i]
+	}
+
+	// 2. 逐个校验并发送
+	var sent, dropped int
+	for _, job := range jobs {
+		ok, err := c.handle(ctx, job, activityMap[job.ActivityID], now)
+		if err != nil {
+			logx.Errorf("[ReminderCron] 处理提醒失败，延后重试: kind=%s, activityId=%d, userId=%d, err=%v",
+				job.Kind, job.ActivityID, job.UserID, err)
+			c.retry(ctx, []reminder.Job{job}, now)
+			continue
+		}
+		if ok {
+			sent++
+		} else {
+			dropped++
+		}
+	}
+
+	logx.Infof("[ReminderCron] 本轮提醒处理完成: sent=%d, dropped=%d", sent, dropped)
+}
+
+// handle 校验并发送单个提醒，返回是否已发送
+func (c *ReminderCron) handle(ctx context.Context, job reminder.Job, act *model.Activity, now int64) (bool, error) {
+	// 1. 活动已删除 / 不在报名中（取消、已开始等）
+	if act == nil || act.Status != model.StatusPublished {
+		return false, nil
+	}
+
+	// 2. 按活动当前时间校正触发时间
+	fireAt := c.queue.FireTime(job.Kind, act)
+	if fireAt == 0 {
+		return false, nil
+	}
+	if fireAt > now {
+		// 活动改期后移，按新时间重新入队
+		return false, c.queue.Requeue(ctx, job, fireAt)
+	}
+
+	// 3. 按提醒类型校验时效与接收人
+	switch job.Kind {
+	case messaging.ReminderKindStart24h, messaging.ReminderKindStart1h:
+		if now >= act.ActivityStartTime {
+			return false, nil
+		}
+		if job.Kind == messaging.ReminderKindStart24h && now >= c.queue.FireTime(messaging.ReminderKindStart1h, act) {
+			return false, nil
+		}
+		registered, err := c.isRegistered(ctx, job.ActivityID, job.UserID)
+		if err != nil || !registered {
+			return false, err
+		}
+	case messaging.ReminderKindRegisterClosing:
+		if now >= act.RegisterEndTime {
+			return false, nil
+		}
+		favorited, err := c.favoriteModel.Exists(ctx, job.ActivityID, job.UserID)
+		if err != nil || !favorited {
+			return false, err
+		}
+		registered, err := c.isRegistered(ctx, job.ActivityID, job.UserID)
+		if err != nil || registered {
+			return false, err
+		}
+	default:
+		logx.Errorf("[ReminderCron] 未知提醒类型，已丢弃: kind=%s", job.Kind)
+		return false, nil
+	}
+
+	// 4. 发布提醒事件（失败返回错误，由调用方重新入队）
+	err := c.msgProducer.PublishActivityReminder(ctx, messaging.ActivityReminderEvent{
+		ActivityID:      act.ID,
+		UserID:          job.UserID,
+		Kind:            job.Kind,
+		Title:           act.Title,
+		Location:        act.Location,
+		StartTime:       time.Unix(act.ActivityStartTime, 0),
+		RegisterEndTime: time.Unix(act.RegisterEndTime, 0),
+	})
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// isRegistered 用户是否报名成功
+func (c *ReminderCron) isRegistered(ctx context.Context, activityID, userID uint64) (bool, error) {
+	reg, err := c.registrationModel.FindByActivityUser(ctx, activityID, userID)
+	if err != nil {
+		if errors.Is(err, model.ErrRegistrationNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	return reg.Status == model.RegistrationStatusSuccess, nil
+}
+
+// retry 处理失败的任务延后重新入队
+func (c *ReminderCron) retry(ctx context.Context, jobs []reminder.Job, now int64) {
+	for _, job := range jobs {
+		if err := c.queue.Requeue(ctx, job, now+reminderRetryDelay); err != nil {
+			logx.Errorf("[ReminderCron] 任务重新入队失败: kind=%s, activityId=%d, userId=%d, err=%v",
+				job.Kind, job.ActivityID, job.UserID, err)
+		}
+	}
+}
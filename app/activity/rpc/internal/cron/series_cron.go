@@ -0,0 +1,154 @@
+package cron
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+// ==================== 常量定义 ====================
+
+const (
+	// 分布式锁配置
+	seriesLockKey        = "activity:cron:series"
+	seriesLockExpire     = 120 // 锁过期时间（秒），生成场次需调用用户服务，预留更长时间
+	seriesDefaultSeconds = 300 // 默认执行间隔：5 分钟
+)
+
+// SeriesTaskFunc 系列活动任务函数
+//
+// 由 logic 层注入（cron 包不能依赖 logic 包），处理应当幂等
+type SeriesTaskFunc func(ctx context.Context) error
+
+// ==================== SeriesCron 系列活动定时任务 ====================
+
+// SeriesCron 系列活动定时任务
+//
+// 功能说明：
+//   - generate：按重复规则提前生成未来一段时间内的场次
+//   - enroll：为整期报名的用户在场次开放报名后逐场出票
+//
+// 执行策略：
+//   - 启动时立即执行一次
+//   - 之后按配置间隔执行（默认 5 分钟）
+//   - 使用 Redis 分布式锁，多实例部署时只有一个实例执行
+type SeriesCron struct {
+	redis    *redis.Redis
+	generate SeriesTaskFunc
+	enroll   SeriesTaskFunc
+
+	intervalSeconds int
+	stopChan        chan struct{}
+	running         atomic.Bool
+	stopOnce        sync.Once
+	ownerID         string
+}
+
+// NewSeriesCron 创建系列活动定时任务
+func NewSeriesCron(rds *redis.Redis, generate, enroll SeriesTaskFunc) *SeriesCron {
+	return &SeriesCron{
+		redis:           rds,
+		generate:        generate,
+		enroll:          enroll,
+		intervalSeconds: seriesDefaultSeconds,
+		stopChan:        make(chan struct{}),
+		ownerID:         uuid.New().String(),
+	}
+}
+
+// SetInterval 设置执行间隔（秒）
+func (c *SeriesCron) SetInterval(seconds int) {
+	if seconds > 0 {
+		c.intervalSeconds = seconds
+	}
+}
+
+// Start 启动定时任务
+func (c *SeriesCron) Start() {
+	if !c.running.CompareAndSwap(false, true) {
+		logx.Info("[SeriesCron] 定时任务已在运行中，跳过重复启动")
+		return
+	}
+
+	logx.Infof("[SeriesCron] 启动系列活动定时任务，执行间隔: %d 秒, owner: %s",
+		c.intervalSeconds, c.ownerID)
+
+	go func() {
+		c.execute()
+
+		ticker := time.NewTicker(time.Duration(c.intervalSeconds) * time.Second)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				c.execute()
+			case <-c.stopChan:
+				logx.Info("[SeriesCron] 系列活动定时任务已停止")
+				return
+			}
+		}
+	}()
+}
+
+// Stop 停止定时任务
+func (c *SeriesCron) Stop() {
+	if !c.running.Load() {
+		return
+	}
+	c.stopOnce.Do(func() {
+		close(c.stopChan)
+	})
+	c.running.Store(false)
+}
+
+// execute 执行一轮：先生成场次，再为整期报名用户出票
+func (c *SeriesCron) execute() {
+	ctx := context.Background()
+
+	locked, err := c.redis.SetnxExCtx(ctx, seriesLockKey, c.ownerID, seriesLockExpire)
+	if err != nil {
+		logx.Errorf("[SeriesCron] 获取锁失败: %v", err)
+		return
+	}
+	if !locked {
+		return // 其他实例正在执行
+	}
+	defer c.releaseLock(ctx)
+
+	c.safeRun(ctx, "generate", c.generate)
+	c.safeRun(ctx, "enroll", c.enroll)
+}
+
+// safeRun 执行任务并兜底 panic，避免单个任务异常导致定时任务退出
+func (c *SeriesCron) safeRun(ctx context.Context, name string, task SeriesTaskFunc) {
+	if task == nil {
+		return
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			logx.Errorf("[SeriesCron] 任务 panic: task=%s, err=%v", name, r)
+		}
+	}()
+	if err := task(ctx); err != nil {
+		logx.Errorf("[SeriesCron] 任务执行失败: task=%s, err=%v", name, err)
+	}
+}
+
+// releaseLock 释放分布式锁（仅 owner 匹配时才删除）
+func (c *SeriesCron) releaseLock(ctx context.Context) {
+	result, err := c.redis.EvalCtx(ctx, unlockScript, []string{seriesLockKey}, c.ownerID)
+	if err != nil {
+		logx.Errorf("[SeriesCron] 释放锁失败: %v", err)
+		return
+	}
+	if fmt.Sprintf("%v", result) == "0" {
+		logx.Infof("[SeriesCron] 锁已被其他实例持有，跳过释放")
+	}
+}
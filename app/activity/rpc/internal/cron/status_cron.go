@@ -10,7 +10,6 @@ import (
 	"activity-platform/app/activity/model"
 	"activity-platform/app/activity/rpc/internal/cache"
 	"activity-platform/app/activity/rpc/internal/mq"
-	"activity-platform/app/activity/rpc/internal/search"
 	"activity-platform/common/messaging"
 
 	"github.com/google/uuid"
@@ -51,9 +50,9 @@ type StatusCron struct {
 	db             *gorm.DB
 	activityModel  *model.ActivityModel
 	statusLogModel *model.ActivityStatusLogModel
-	activityCache  *cache.ActivityCache // 活动缓存（状态变更后删除缓存）
-	syncService    *search.SyncService  // ES 同步服务（可为 nil）
-	msgProducer    *mq.Producer         // 消息发布器（可为 nil）
+	activityCache  *cache.ActivityCache            // 活动缓存（状态变更后删除缓存）
+	changeEvents   *model.ActivityChangeEventModel // 活动变更事件（驱动 ES 同步）
+	msgProducer    *mq.Producer                    // 消息发布器（可为 nil）
 
 	intervalSeconds int           // 执行间隔（秒）
 	stopChan        chan struct{} // 停止信号
@@ -69,7 +68,7 @@ func NewStatusCron(
 	activityModel *model.ActivityModel,
 	statusLogModel *model.ActivityStatusLogModel,
 	activityCache *cache.ActivityCache,
-	syncService *search.SyncService,
+	changeEvents *model.ActivityChangeEventModel,
 	msgProducer *mq.Producer,
 ) *StatusCron {
 	return &StatusCron{
@@ -78,7 +77,7 @@ func NewStatusCron(
 		activityModel:   activityModel,
 		statusLogModel:  statusLogModel,
 		activityCache:   activityCache,
-		syncService:     syncService,
+		changeEvents:    changeEvents,
 		msgProducer:     msgProducer,
 		intervalSeconds: defaultIntervalSeconds,
 		stopChan:        make(chan struct{}),
@@ -313,13 +312,19 @@ func (c *StatusCron) transitionOne(
 			return fmt.Errorf("记录日志失败: %w", err)
 		}
 
+		// 3. 记录变更事件（同一事务，由 ChangeRelay 同步 ES / 缓存）
+		if err := c.changeEvents.Record(ctx, tx, act.ID, model.ChangeTypeStatus, model.ChangeSourceStatusCron); err != nil {
+			return fmt.Errorf("记录变更事件失败: %w", err)
+		}
+
 		return nil
 	})
 	if err != nil {
 		return err
 	}
 
-	// 3. 删除活动缓存（状态变更后缓存中的 status 已过时）
+	// 4. 删除活动缓存（状态变更后缓存中的 status 已过时）
+	// ChangeRelay 也会删除，这里同步删除可以让后续读取立即看到新状态
 	if c.activityCache != nil {
 		if err := c.activityCache.Invalidate(ctx, act.ID); err != nil {
 			logx.Errorf("[StatusCron] 删除活动缓存失败: id=%d, err=%v", act.ID, err)
@@ -327,14 +332,6 @@ func (c *StatusCron) transitionOne(
 		}
 	}
 
-	// 4. 异步更新 ES 索引（状态变更后 ES 中 status 字段已过时）
-	if c.syncService != nil {
-		updatedActivity, err := c.activityModel.FindByID(ctx, act.ID)
-		if err == nil {
-			c.syncService.IndexActivityAsync(updatedActivity)
-		}
-	}
-
 	// 5. 活动结束时异步处理信用事件（Ongoing→Finished）
 	if fromStatus == model.StatusOngoing && toStatus == model.StatusFinished {
 		go c.processFinishedCreditEvents(act.ID, act.OrganizerID)
@@ -32,6 +32,7 @@ const (
 //   - 定期从用户服务（TagRpc）拉取所有兴趣标签
 //   - 同步到活动服务本地的 tag_cache 表
 //   - 保证活动列表/详情返回的标签数据（含 icon、color）是最新的
+//   - 用户服务未返回的标签（已禁用/已合并）在本地同步禁用
 //
 // 执行策略：
 //   - 启动时立即执行一次全量同步
@@ -154,7 +155,17 @@ func (c *TagSyncCron) execute() {
 		return
 	}
 
-	logx.Infof("[TagSyncCron] 标签同步完成: 同步 %d 个标签", len(tagCaches))
+	// 5. 对账：用户服务已禁用/合并的标签在本地同步禁用
+	enabledIDs := make([]uint64, len(tagCaches))
+	for i := range tagCaches {
+		enabledIDs[i] = tagCaches[i].ID
+	}
+	disabled, err := c.tagCacheModel.DisableExcept(ctx, enabledIDs)
+	if err != nil {
+		logx.Errorf("[TagSyncCron] 禁用失效标签失败: %v", err)
+	}
+
+	logx.Infof("[TagSyncCron] 标签同步完成: 同步 %d 个标签, 禁用 %d 个标签", len(tagCaches), disabled)
 }
 
 // releaseLock 释放分布式锁（仅 owner 匹配时才删除）
@@ -0,0 +1,263 @@
+package eligibility
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"activity-platform/app/activity/model"
+	"activity-platform/app/user/rpc/client/creditservice"
+	"activity-platform/app/user/rpc/client/verifyservice"
+)
+
+// ==================== 报名资格规则引擎 ====================
+//
+// 规则来源（按顺序校验）：
+//   1. 平台信用规则：始终生效（黑名单/风险区间限流，由 user-rpc CanParticipate 判定）
+//   2. 活动字段隐式规则：MinCreditScore > 0、RequireStudentVerify
+//   3. 组织者声明的规则：activity_eligibility_rules 表
+//
+// 所有规则均会执行（不短路），便于一次性告诉用户全部不满足的条件。
+// 用户数据按需懒加载：只有规则需要认证信息时才调用 IsVerified。
+
+// RuleTypePlatformCredit 平台信用规则（内置，不可配置）
+const RuleTypePlatformCredit = "platform_credit"
+
+// CheckItem 单条规则校验结果
+type CheckItem struct {
+	RuleType    string // 规则类型
+	Description string // 规则说明（如 "信用分不低于 80"）
+	Passed      bool   // 是否通过
+	Reason      string // 未通过原因
+}
+
+// Result 资格校验结果
+type Result struct {
+	Eligible bool        // 是否满足全部规则
+	Items    []CheckItem // 逐条校验结果
+}
+
+// Failed 返回未通过的规则
+func (r *Result) Failed() []CheckItem {
+	failed := make([]CheckItem, 0)
+	for _, item := range r.Items {
+		if !item.Passed {
+			failed = append(failed, item)
+		}
+	}
+	return failed
+}
+
+// Reason 汇总未通过原因（全部通过时为空）
+func (r *Result) Reason() string {
+	reasons := make([]string, 0)
+	for _, item := range r.Items {
+		if !item.Passed && item.Reason != "" {
+			reasons = append(reasons, item.Reason)
+		}
+	}
+	return strings.Join(reasons, "；")
+}
+
+// Engine 报名资格规则引擎
+type Engine struct {
+	ruleModel *model.ActivityEligibilityRuleModel
+	creditRpc creditservice.CreditService
+	verifyRpc verifyservice.VerifyService
+}
+
+// NewEngine 创建规则引擎
+func NewEngine(
+	ruleModel *model.ActivityEligibilityRuleModel,
+	creditRpc creditservice.CreditService,
+	verifyRpc verifyservice.VerifyService,
+) *Engine {
+	return &Engine{
+		ruleModel: ruleModel,
+		creditRpc: creditRpc,
+		verifyRpc: verifyRpc,
+	}
+}
+
+// Evaluate 校验用户是否满足活动的全部报名规则
+//
+// 返回 error 仅表示依赖服务/数据库异常，规则不满足通过 Result.Eligible 表达
+func (e *Engine) Evaluate(ctx context.Context, act *model.Activity, userID int64) (*Result, error) {
+	rules, err := e.ruleModel.FindByActivityID(ctx, act.ID)
+	if err != nil {
+		return nil, fmt.Errorf("查询报名规则失败: %w", err)
+	}
+
+	subject := &subject{
+		userID:    userID,
+		creditRpc: e.creditRpc,
+		verifyRpc: e.verifyRpc,
+	}
+	return evaluateAll(ctx, EffectiveRules(act, rules), subject)
+}
+
+// evaluateAll 依次校验全部规则（不短路）
+func evaluateAll(ctx context.Context, rules []model.ActivityEligibilityRule, s *subject) (*Result, error) {
+	result := &Result{Eligible: true}
+	for _, rule := range rules {
+		item, err := evaluate(ctx, rule, s)
+		if err != nil {
+			return nil, err
+		}
+		if !item.Passed {
+			result.Eligible = false
+		}
+		result.Items = append(result.Items, item)
+	}
+	return result, nil
+}
+
+// EffectiveRules 合并平台规则、活动字段隐式规则与声明规则
+func EffectiveRules(act *model.Activity, declared []model.ActivityEligibilityRule) []model.ActivityEligibilityRule {
+	rules := make([]model.ActivityEligibilityRule, 0, len(declared)+3)
+	rules = append(rules, model.ActivityEligibilityRule{RuleType: RuleTypePlatformCredit})
+
+	hasVerified := false
+	for _, r := range declared {
+		if r.RuleType == model.RuleTypeVerifiedOnly {
+			hasVerified = true
+		}
+	}
+	if act.MinCreditScore > 0 {
+		rules = append(rules, model.ActivityEligibilityRule{
+			ActivityID: act.ID,
+			RuleType:   model.RuleTypeMinCredit,
+			MinValue:   act.MinCreditScore,
+		})
+	}
+	if act.RequireStudentVerify && !hasVerified {
+		rules = append(rules, model.ActivityEligibilityRule{
+			ActivityID: act.ID,
+			RuleType:   model.RuleTypeVerifiedOnly,
+		})
+	}
+	return append(rules, declared...)
+}
+
+// Describe 规则的可读说明
+func Describe(rule model.ActivityEligibilityRule) string {
+	switch rule.RuleType {
+	case RuleTypePlatformCredit:
+		return "信用状态正常"
+	case model.RuleTypeMinCredit:
+		return fmt.Sprintf("信用分不低于 %d", rule.MinValue)
+	case model.RuleTypeVerifiedOnly:
+		return "已完成学生认证"
+	case model.RuleTypeSchool:
+		return "限" + strings.Join(rule.Values, "、") + "学生"
+	case model.RuleTypeDepartment:
+		return "限" + strings.Join(rule.Values, "、") + "学生"
+	case model.RuleTypeAdmissionYear:
+		switch {
+		case rule.MinValue > 0 && rule.MaxValue > 0 && rule.MinValue == rule.MaxValue:
+			return fmt.Sprintf("限 %d 级学生", rule.MinValue)
+		case rule.MinValue > 0 && rule.MaxValue > 0:
+			return fmt.Sprintf("限 %d-%d 级学生", rule.MinValue, rule.MaxValue)
+		case rule.MinValue > 0:
+			return fmt.Sprintf("限 %d 级及以后入学的学生", rule.MinValue)
+		default:
+			return fmt.Sprintf("限 %d 级及以前入学的学生", rule.MaxValue)
+		}
+	default:
+		return rule.RuleType
+	}
+}
+
+// evaluate 校验单条规则
+func evaluate(ctx context.Context, rule model.ActivityEligibilityRule, s *subject) (CheckItem, error) {
+	item := CheckItem{
+		RuleType:    rule.RuleType,
+		Description: Describe(rule),
+	}
+	fail := func(reason string) (CheckItem, error) {
+		item.Reason = reason
+		return item, nil
+	}
+
+	switch rule.RuleType {
+	case RuleTypePlatformCredit:
+		credit, err := s.credit(ctx)
+		if err != nil {
+			return item, err
+		}
+		if !credit.GetAllowed() {
+			return fail(credit.GetReason())
+		}
+
+	case model.RuleTypeMinCredit:
+		credit, err := s.credit(ctx)
+		if err != nil {
+			return item, err
+		}
+		if credit.GetScore() < int64(rule.MinValue) {
+			return fail(fmt.Sprintf("信用分不足（当前 %d，要求不低于 %d）", credit.GetScore(), rule.MinValue))
+		}
+
+	case model.RuleTypeVerifiedOnly:
+		verify, err := s.verify(ctx)
+		if err != nil {
+			return item, err
+		}
+		if !verify.GetIsVerified() {
+			return fail("请先完成学生认证")
+		}
+
+	case model.RuleTypeSchool, model.RuleTypeDepartment:
+		verify, err := s.verify(ctx)
+		if err != nil {
+			return item, err
+		}
+		if !verify.GetIsVerified() {
+			return fail("该活动限定报名范围，请先完成学生认证")
+		}
+		actual := verify.GetSchoolName()
+		if rule.RuleType == model.RuleTypeDepartment {
+			actual = verify.GetDepartment()
+		}
+		if !containsFold(rule.Values, actual) {
+			return fail("该活动" + item.Description + "报名")
+		}
+
+	case model.RuleTypeAdmissionYear:
+		verify, err := s.verify(ctx)
+		if err != nil {
+			return item, err
+		}
+		if !verify.GetIsVerified() {
+			return fail("该活动限定入学年份，请先完成学生认证")
+		}
+		year, err := strconv.Atoi(strings.TrimSpace(verify.GetAdmissionYear()))
+		if err != nil ||
+			(rule.MinValue > 0 && year < rule.MinValue) ||
+			(rule.MaxValue > 0 && year > rule.MaxValue) {
+			return fail("该活动" + item.Description + "报名")
+		}
+
+	default:
+		// 未知规则类型（旧数据/新版本回滚）：放行并保留记录，避免误拦截
+		item.Description = "未知规则: " + rule.RuleType
+	}
+
+	item.Passed = true
+	return item, nil
+}
+
+// containsFold 判断取值是否命中（忽略首尾空白与大小写）
+func containsFold(values []string, actual string) bool {
+	actual = strings.TrimSpace(actual)
+	if actual == "" {
+		return false
+	}
+	for _, v := range values {
+		if strings.EqualFold(strings.TrimSpace(v), actual) {
+			return true
+		}
+	}
+	return false
+}
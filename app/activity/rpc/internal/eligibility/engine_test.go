@@ -0,0 +1,300 @@
+package eligibility
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"activity-platform/app/activity/model"
+	"activity-platform/app/user/rpc/client/creditservice"
+	"activity-platform/app/user/rpc/client/verifyservice"
+
+	"google.golang.org/grpc"
+)
+
+// fakeCreditRpc 只实现 CanParticipate，其余方法未使用
+type fakeCreditRpc struct {
+	creditservice.CreditService
+	resp  *creditservice.CanParticipateResp
+	err   error
+	calls int
+}
+
+func (f *fakeCreditRpc) CanParticipate(_ context.Context, _ *creditservice.CanParticipateReq, _ ...grpc.CallOption) (*creditservice.CanParticipateResp, error) {
+	f.calls++
+	return f.resp, f.err
+}
+
+// fakeVerifyRpc 只实现 IsVerified，其余方法未使用
+type fakeVerifyRpc struct {
+	verifyservice.VerifyService
+	resp  *verifyservice.IsVerifiedResp
+	err   error
+	calls int
+}
+
+func (f *fakeVerifyRpc) IsVerified(_ context.Context, _ *verifyservice.IsVerifiedReq, _ ...grpc.CallOption) (*verifyservice.IsVerifiedResp, error) {
+	f.calls++
+	return f.resp, f.err
+}
+
+func allowedCredit(score int64) *fakeCreditRpc {
+	return &fakeCreditRpc{resp: &creditservice.CanParticipateResp{Allowed: true, Score: score}}
+}
+
+func verifiedUser(school, department, year string) *fakeVerifyRpc {
+	return &fakeVerifyRpc{resp: &verifyservice.IsVerifiedResp{
+		IsVerified:    true,
+		SchoolName:    school,
+		Department:    department,
+		AdmissionYear: year,
+	}}
+}
+
+func newTestSubject(credit *fakeCreditRpc, verify *fakeVerifyRpc) *subject {
+	return &subject{userID: 1, creditRpc: credit, verifyRpc: verify}
+}
+
+func ruleTypes(rules []model.ActivityEligibilityRule) []string {
+	types := make([]string, 0, len(rules))
+	for _, r := range rules {
+		types = append(types, r.RuleType)
+	}
+	return types
+}
+
+func TestEffectiveRules(t *testing.T) {
+	school := model.ActivityEligibilityRule{RuleType: model.RuleTypeSchool, Values: []string{"清华大学"}}
+	verified := model.ActivityEligibilityRule{RuleType: model.RuleTypeVerifiedOnly}
+
+	tests := []struct {
+		name     string
+		act      *model.Activity
+		declared []model.ActivityEligibilityRule
+		want     []string
+	}{
+		{
+			name: "无任何规则时仅平台信用规则",
+			act:  &model.Activity{ID: 1},
+			want: []string{RuleTypePlatformCredit},
+		},
+		{
+			name: "MinCreditScore 转为最低信用分规则",
+			act:  &model.Activity{ID: 1, MinCreditScore: 80},
+			want: []string{RuleTypePlatformCredit, model.RuleTypeMinCredit},
+		},
+		{
+			name:     "RequireStudentVerify 转为认证规则并排在声明规则之前",
+			act:      &model.Activity{ID: 1, RequireStudentVerify: true},
+			declared: []model.ActivityEligibilityRule{school},
+			want:     []string{RuleTypePlatformCredit, model.RuleTypeVerifiedOnly, model.RuleTypeSchool},
+		},
+		{
+			name:     "已声明认证规则时不重复添加",
+			act:      &model.Activity{ID: 1, MinCreditScore: 60, RequireStudentVerify: true},
+			declared: []model.ActivityEligibilityRule{verified},
+			want:     []string{RuleTypePlatformCredit, model.RuleTypeMinCredit, model.RuleTypeVerifiedOnly},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ruleTypes(EffectiveRules(tt.act, tt.declared))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("rule types = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	rules := EffectiveRules(&model.Activity{ID: 7, MinCreditScore: 80}, nil)
+	if rules[1].MinValue != 80 || rules[1].ActivityID != 7 {
+		t.Fatalf("implicit min credit rule = %+v, want min_value=80 activity_id=7", rules[1])
+	}
+}
+
+func TestEvaluateAllRunsEveryRule(t *testing.T) {
+	credit := &fakeCreditRpc{resp: &creditservice.CanParticipateResp{Allowed: false, Reason: "信用分过低", Score: 50}}
+	verify := &fakeVerifyRpc{resp: &verifyservice.IsVerifiedResp{IsVerified: false}}
+	rules := EffectiveRules(
+		&model.Activity{ID: 1, MinCreditScore: 80, RequireStudentVerify: true},
+		[]model.ActivityEligibilityRule{{RuleType: model.RuleTypeSchool, Values: []string{"清华大学"}}},
+	)
+
+	result, err := evaluateAll(context.Background(), rules, newTestSubject(credit, verify))
+	if err != nil {
+		t.Fatalf("evaluateAll returned error: %v", err)
+	}
+	if result.Eligible {
+		t.Fatal("expected not eligible")
+	}
+	if len(result.Items) != 4 || len(result.Failed()) != 4 {
+		t.Fatalf("items=%d failed=%d, want 4 and 4", len(result.Items), len(result.Failed()))
+	}
+
+	wantReason := "信用分过低；信用分不足（当前 50，要求不低于 80）；请先完成学生认证；该活动限定报名范围，请先完成学生认证"
+	if result.Reason() != wantReason {
+		t.Fatalf("reason = %q, want %q", result.Reason(), wantReason)
+	}
+
+	// 用户数据懒加载，同一次校验内只查询一次
+	if credit.calls != 1 || verify.calls != 1 {
+		t.Fatalf("credit calls=%d verify calls=%d, want 1 and 1", credit.calls, verify.calls)
+	}
+}
+
+func TestEvaluateAllSkipsVerifyWhenNotNeeded(t *testing.T) {
+	credit := allowedCredit(90)
+	verify := verifiedUser("清华大学", "计算机系", "2023")
+
+	result, err := evaluateAll(context.Background(),
+		EffectiveRules(&model.Activity{ID: 1, MinCreditScore: 80}, nil),
+		newTestSubject(credit, verify))
+	if err != nil {
+		t.Fatalf("evaluateAll returned error: %v", err)
+	}
+	if !result.Eligible || result.Reason() != "" {
+		t.Fatalf("expected eligible with empty reason, got %+v", result)
+	}
+	if verify.calls != 0 {
+		t.Fatalf("verify calls = %d, want 0", verify.calls)
+	}
+}
+
+func TestEvaluateRules(t *testing.T) {
+	tests := []struct {
+		name       string
+		rule       model.ActivityEligibilityRule
+		verify     *fakeVerifyRpc
+		wantPassed bool
+		wantDesc   string
+	}{
+		{
+			name:       "学校匹配忽略大小写与首尾空白",
+			rule:       model.ActivityEligibilityRule{RuleType: model.RuleTypeSchool, Values: []string{" Tsinghua University "}},
+			verify:     verifiedUser("tsinghua university", "", ""),
+			wantPassed: true,
+			wantDesc:   "限 Tsinghua University 学生",
+		},
+		{
+			name:       "学校不匹配",
+			rule:       model.ActivityEligibilityRule{RuleType: model.RuleTypeSchool, Values: []string{"清华大学", "北京大学"}},
+			verify:     verifiedUser("复旦大学", "", ""),
+			wantPassed: false,
+			wantDesc:   "限清华大学、北京大学学生",
+		},
+		{
+			name:       "院系匹配",
+			rule:       model.ActivityEligibilityRule{RuleType: model.RuleTypeDepartment, Values: []string{"计算机系"}},
+			verify:     verifiedUser("清华大学", "计算机系", ""),
+			wantPassed: true,
+			wantDesc:   "限计算机系学生",
+		},
+		{
+			name:       "院系为空视为不匹配",
+			rule:       model.ActivityEligibilityRule{RuleType: model.RuleTypeDepartment, Values: []string{"计算机系"}},
+			verify:     verifiedUser("清华大学", "", ""),
+			wantPassed: false,
+			wantDesc:   "限计算机系学生",
+		},
+		{
+			name:       "入学年份在区间内",
+			rule:       model.ActivityEligibilityRule{RuleType: model.RuleTypeAdmissionYear, MinValue: 2021, MaxValue: 2023},
+			verify:     verifiedUser("", "", "2022"),
+			wantPassed: true,
+			wantDesc:   "限 2021-2023 级学生",
+		},
+		{
+			name:       "入学年份等于区间上限",
+			rule:       model.ActivityEligibilityRule{RuleType: model.RuleTypeAdmissionYear, MinValue: 2021, MaxValue: 2023},
+			verify:     verifiedUser("", "", "2023"),
+			wantPassed: true,
+			wantDesc:   "限 2021-2023 级学生",
+		},
+		{
+			name:       "入学年份早于下限",
+			rule:       model.ActivityEligibilityRule{RuleType: model.RuleTypeAdmissionYear, MinValue: 2021, MaxValue: 2023},
+			verify:     verifiedUser("", "", "2020"),
+			wantPassed: false,
+			wantDesc:   "限 2021-2023 级学生",
+		},
+		{
+			name:       "单一年级",
+			rule:       model.ActivityEligibilityRule{RuleType: model.RuleTypeAdmissionYear, MinValue: 2024, MaxValue: 2024},
+			verify:     verifiedUser("", "", "2024"),
+			wantPassed: true,
+			wantDesc:   "限 2024 级学生",
+		},
+		{
+			name:       "仅下限",
+			rule:       model.ActivityEligibilityRule{RuleType: model.RuleTypeAdmissionYear, MinValue: 2022},
+			verify:     verifiedUser("", "", "2030"),
+			wantPassed: true,
+			wantDesc:   "限 2022 级及以后入学的学生",
+		},
+		{
+			name:       "仅上限",
+			rule:       model.ActivityEligibilityRule{RuleType: model.RuleTypeAdmissionYear, MaxValue: 2022},
+			verify:     verifiedUser("", "", "2023"),
+			wantPassed: false,
+			wantDesc:   "限 2022 级及以前入学的学生",
+		},
+		{
+			name:       "入学年份无法解析",
+			rule:       model.ActivityEligibilityRule{RuleType: model.RuleTypeAdmissionYear, MinValue: 2021},
+			verify:     verifiedUser("", "", "未知"),
+			wantPassed: false,
+			wantDesc:   "限 2021 级及以后入学的学生",
+		},
+		{
+			name:       "未认证用户不满足年份规则",
+			rule:       model.ActivityEligibilityRule{RuleType: model.RuleTypeAdmissionYear, MinValue: 2021},
+			verify:     &fakeVerifyRpc{resp: &verifyservice.IsVerifiedResp{}},
+			wantPassed: false,
+			wantDesc:   "限 2021 级及以后入学的学生",
+		},
+		{
+			name:       "未知规则类型放行",
+			rule:       model.ActivityEligibilityRule{RuleType: "gpa"},
+			verify:     verifiedUser("", "", ""),
+			wantPassed: true,
+			wantDesc:   "未知规则: gpa",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			item, err := evaluate(context.Background(), tt.rule, newTestSubject(allowedCredit(100), tt.verify))
+			if err != nil {
+				t.Fatalf("evaluate returned error: %v", err)
+			}
+			if item.Passed != tt.wantPassed {
+				t.Fatalf("passed = %v, want %v (reason=%q)", item.Passed, tt.wantPassed, item.Reason)
+			}
+			if item.Description != tt.wantDesc {
+				t.Fatalf("description = %q, want %q", item.Description, tt.wantDesc)
+			}
+			if !item.Passed && item.Reason == "" {
+				t.Fatal("failed item should carry a reason")
+			}
+		})
+	}
+}
+
+func TestEvaluateAllReturnsRpcError(t *testing.T) {
+	rpcErr := errors.New("rpc unavailable")
+
+	_, err := evaluateAll(context.Background(),
+		EffectiveRules(&model.Activity{ID: 1, RequireStudentVerify: true}, nil),
+		newTestSubject(allowedCredit(100), &fakeVerifyRpc{err: rpcErr}))
+	if !errors.Is(err, rpcErr) {
+		t.Fatalf("err = %v, want wrapping %v", err, rpcErr)
+	}
+
+	_, err = evaluateAll(context.Background(),
+		EffectiveRules(&model.Activity{ID: 1}, nil),
+		newTestSubject(&fakeCreditRpc{}, verifiedUser("", "", "")))
+	if err == nil {
+		t.Fatal("expected error for nil credit response")
+	}
+}
@@ -0,0 +1,56 @@
+package eligibility
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"activity-platform/app/user/rpc/client/creditservice"
+	"activity-platform/app/user/rpc/client/verifyservice"
+)
+
+// subject 被校验的用户（数据按需懒加载，同一次校验内只查询一次）
+type subject struct {
+	userID    int64
+	creditRpc creditservice.CreditService
+	verifyRpc verifyservice.VerifyService
+
+	creditResp *creditservice.CanParticipateResp
+	verifyResp *verifyservice.IsVerifiedResp
+}
+
+// credit 获取信用信息
+func (s *subject) credit(ctx context.Context) (*creditservice.CanParticipateResp, error) {
+	if s.creditResp != nil {
+		return s.creditResp, nil
+	}
+	resp, err := s.creditRpc.CanParticipate(ctx, &creditservice.CanParticipateReq{
+		UserId: s.userID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("信誉校验失败: %w", err)
+	}
+	if resp == nil {
+		return nil, errors.New("信誉校验返回空响应")
+	}
+	s.creditResp = resp
+	return resp, nil
+}
+
+// verify 获取学生认证信息
+func (s *subject) verify(ctx context.Context) (*verifyservice.IsVerifiedResp, error) {
+	if s.verifyResp != nil {
+		return s.verifyResp, nil
+	}
+	resp, err := s.verifyRpc.IsVerified(ctx, &verifyservice.IsVerifiedReq{
+		UserId: s.userID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("实名校验失败: %w", err)
+	}
+	if resp == nil {
+		return nil, errors.New("实名校验返回空响应")
+	}
+	s.verifyResp = resp
+	return resp, nil
+}
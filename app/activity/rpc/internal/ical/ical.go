@@ -0,0 +1,140 @@
+package ical
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// ==================== iCalendar（RFC 5545）编码 ====================
+//
+// 只实现订阅与单个活动导出需要的子集：VCALENDAR + VEVENT。
+//   - 时间统一输出为 UTC（...Z），无需附带 VTIMEZONE
+//   - UID 固定为活动维度，活动改期/取消时客户端按 UID + SEQUENCE 更新同一事件
+//   - 行按 75 字节折行（不拆分 UTF-8 字符），换行统一为 CRLF
+
+const (
+	prodID       = "-//CampusHub//Activity Calendar//ZH"
+	maxLineBytes = 75
+	timeLayout   = "20060102T150405Z"
+)
+
+// Calendar 日历
+type Calendar struct {
+	Name            string        // 日历名称（X-WR-CALNAME）
+	RefreshInterval time.Duration // 建议客户端刷新间隔（订阅时使用，0 表示不输出）
+	Events          []Event
+}
+
+// Event 日历事件
+type Event struct {
+	UID         string
+	Sequence    int // 修订号，活动每次修改递增
+	Summary     string
+	Description string
+	Location    string
+	Latitude    float64
+	Longitude   float64
+	URL         string
+	Start       time.Time
+	End         time.Time
+	Stamp       time.Time // 最后修改时间
+	Cancelled   bool      // STATUS:CANCELLED
+}
+
+// Encode 编码为 iCalendar 文本
+func (c *Calendar) Encode() string {
+	w := &writer{}
+	w.line("BEGIN:VCALENDAR")
+	w.line("VERSION:2.0")
+	w.line("PRODID:" + prodID)
+	w.line("CALSCALE:GREGORIAN")
+	w.line("METHOD:PUBLISH")
+	if c.Name != "" {
+		w.line("X-WR-CALNAME:" + escapeText(c.Name))
+	}
+	if c.RefreshInterval > 0 {
+		minutes := int(c.RefreshInterval.Minutes())
+		w.line(fmt.Sprintf("REFRESH-INTERVAL;VALUE=DURATION:PT%dM", minutes))
+		w.line(fmt.Sprintf("X-PUBLISHED-TTL:PT%dM", minutes))
+	}
+	for i := range c.Events {
+		c.Events[i].encode(w)
+	}
+	w.line("END:VCALENDAR")
+	return w.String()
+}
+
+// encode 编码单个事件
+func (e *Event) encode(w *writer) {
+	w.line("BEGIN:VEVENT")
+	w.line("UID:" + e.UID)
+	w.line("DTSTAMP:" + formatTime(e.Stamp))
+	w.line("LAST-MODIFIED:" + formatTime(e.Stamp))
+	w.line(fmt.Sprintf("SEQUENCE:%d", e.Sequence))
+	w.line("DTSTART:" + formatTime(e.Start))
+	w.line("DTEND:" + formatTime(e.End))
+	w.line("SUMMARY:" + escapeText(e.Summary))
+	if e.Description != "" {
+		w.line("DESCRIPTION:" + escapeText(e.Description))
+	}
+	if e.Location != "" {
+		w.line("LOCATION:" + escapeText(e.Location))
+	}
+	if e.Latitude != 0 || e.Longitude != 0 {
+		w.line(fmt.Sprintf("GEO:%.6f;%.6f", e.Latitude, e.Longitude))
+	}
+	if e.URL != "" {
+		w.line("URL:" + e.URL)
+	}
+	if e.Cancelled {
+		w.line("STATUS:CANCELLED")
+	} else {
+		w.line("STATUS:CONFIRMED")
+	}
+	w.line("END:VEVENT")
+}
+
+// writer 按 RFC 5545 折行写入
+type writer struct {
+	sb strings.Builder
+}
+
+// line 写入一个内容行（超过 75 字节时折行，续行以空格开头）
+func (w *writer) line(s string) {
+	limit := maxLineBytes
+	for len(s) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(s[cut]) {
+			cut--
+		}
+		w.sb.WriteString(s[:cut])
+		w.sb.WriteString("\r\n ")
+		s = s[cut:]
+		limit = maxLineBytes - 1 // 续行的前导空格占 1 字节
+	}
+	w.sb.WriteString(s)
+	w.sb.WriteString("\r\n")
+}
+
+func (w *writer) String() string {
+	return w.sb.String()
+}
+
+// escapeText 转义 TEXT 类型的值（反斜杠、分号、逗号、换行）
+func escapeText(s string) string {
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\n", `\n`,
+		"\r", `\n`,
+	).Replace(s)
+}
+
+// formatTime UTC 时间格式
+func formatTime(t time.Time) string {
+	return t.UTC().Format(timeLayout)
+}
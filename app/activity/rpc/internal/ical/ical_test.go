@@ -0,0 +1,167 @@
+package ical
+
+import (
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+)
+
+// unfold 还原折行（CRLF + 空格）
+func unfold(s string) string {
+	return strings.ReplaceAll(s, "\r\n ", "")
+}
+
+// physicalLines 按 CRLF 拆分物理行（去掉末尾空行）
+func physicalLines(t *testing.T, s string) []string {
+	t.Helper()
+
+	if !strings.HasSuffix(s, "\r\n") {
+		t.Fatalf("output should end with CRLF: %q", s)
+	}
+	if strings.Contains(strings.ReplaceAll(s, "\r\n", ""), "\n") {
+		t.Fatalf("output contains bare LF: %q", s)
+	}
+	return strings.Split(strings.TrimSuffix(s, "\r\n"), "\r\n")
+}
+
+func testEvent() Event {
+	loc := time.FixedZone("UTC+8", 8*3600)
+	return Event{
+		UID:      "activity-42@campushub",
+		Sequence: 0,
+		Summary:  "读书会",
+		Start:    time.Date(2026, 10, 20, 19, 0, 0, 0, loc),
+		End:      time.Date(2026, 10, 20, 21, 0, 0, 0, loc),
+		Stamp:    time.Date(2026, 10, 19, 8, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestWriterFoldsLongCJKLines(t *testing.T) {
+	summary := strings.Repeat("校园读书会活动", 12) // 84 个汉字，252 字节
+	w := &writer{}
+	w.line("SUMMARY:" + summary)
+	out := w.String()
+
+	lines := physicalLines(t, out)
+	if len(lines) < 4 {
+		t.Fatalf("expected long line to be folded, got %d lines", len(lines))
+	}
+	for i, line := range lines {
+		if len(line) > maxLineBytes {
+			t.Fatalf("line %d has %d bytes, exceeds %d", i, len(line), maxLineBytes)
+		}
+		if !utf8.ValidString(line) {
+			t.Fatalf("line %d splits a UTF-8 character: %q", i, line)
+		}
+		if i > 0 && !strings.HasPrefix(line, " ") {
+			t.Fatalf("continuation line %d should start with a space: %q", i, line)
+		}
+	}
+	if got := unfold(out); got != "SUMMARY:"+summary+"\r\n" {
+		t.Fatalf("unfolded line = %q", got)
+	}
+}
+
+func TestWriterLineBoundary(t *testing.T) {
+	tests := []struct {
+		name      string
+		length    int
+		wantLines int
+	}{
+		{name: "恰好 75 字节不折行", length: 75, wantLines: 1},
+		{name: "76 字节折为两行", length: 76, wantLines: 2},
+		{name: "续行上限 74 字节", length: 75 + 74, wantLines: 2},
+		{name: "超过续行上限", length: 75 + 75, wantLines: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &writer{}
+			w.line(strings.Repeat("a", tt.length))
+			lines := physicalLines(t, w.String())
+			if len(lines) != tt.wantLines {
+				t.Fatalf("lines = %d, want %d", len(lines), tt.wantLines)
+			}
+			for i, line := range lines {
+				if len(line) > maxLineBytes {
+					t.Fatalf("line %d has %d bytes", i, len(line))
+				}
+			}
+		})
+	}
+}
+
+func TestEscapeText(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{in: "普通文本", want: "普通文本"},
+		{in: "时间;地点", want: `时间\;地点`},
+		{in: "篮球,足球", want: `篮球\,足球`},
+		{in: `C:\path`, want: `C:\\path`},
+		{in: "第一行\n第二行", want: `第一行\n第二行`},
+		{in: "第一行\r\n第二行", want: `第一行\n第二行`},
+		{in: "第一行\r第二行", want: `第一行\n第二行`},
+		{in: `a\;b`, want: `a\\\;b`},
+	}
+
+	for _, tt := range tests {
+		if got := escapeText(tt.in); got != tt.want {
+			t.Fatalf("escapeText(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestEventEncode(t *testing.T) {
+	e := testEvent()
+	e.Sequence = 3
+	e.Description = "地点：图书馆;请带学生证,准时到场\n联系人：张三"
+	e.Location = "图书馆 3 楼"
+	e.Latitude, e.Longitude = 30.5123456, 114.4123456
+	e.Cancelled = true
+
+	cal := &Calendar{Name: "我的活动", RefreshInterval: time.Hour, Events: []Event{e}}
+	out := cal.Encode()
+	physicalLines(t, out)
+	text := unfold(out)
+
+	for _, want := range []string{
+		"BEGIN:VCALENDAR\r\nVERSION:2.0\r\n",
+		"X-WR-CALNAME:我的活动\r\n",
+		"REFRESH-INTERVAL;VALUE=DURATION:PT60M\r\n",
+		"UID:activity-42@campushub\r\n",
+		"SEQUENCE:3\r\n",
+		"DTSTART:20261020T110000Z\r\n",
+		"DTEND:20261020T130000Z\r\n",
+		"DTSTAMP:20261019T080000Z\r\n",
+		`DESCRIPTION:地点：图书馆\;请带学生证\,准时到场\n联系人：张三` + "\r\n",
+		"GEO:30.512346;114.412346\r\n",
+		"STATUS:CANCELLED\r\n",
+		"END:VEVENT\r\nEND:VCALENDAR\r\n",
+	} {
+		if !strings.Contains(text, want) {
+			t.Fatalf("encoded calendar missing %q:\n%s", want, text)
+		}
+	}
+	if strings.Contains(text, "STATUS:CONFIRMED") {
+		t.Fatal("cancelled event should not be CONFIRMED")
+	}
+}
+
+func TestEventEncodeConfirmedOmitsEmptyFields(t *testing.T) {
+	cal := &Calendar{Events: []Event{testEvent()}}
+	text := unfold(cal.Encode())
+
+	for _, want := range []string{"SEQUENCE:0\r\n", "STATUS:CONFIRMED\r\n"} {
+		if !strings.Contains(text, want) {
+			t.Fatalf("encoded calendar missing %q:\n%s", want, text)
+		}
+	}
+	for _, unwanted := range []string{"X-WR-CALNAME", "REFRESH-INTERVAL", "DESCRIPTION:", "LOCATION:", "GEO:", "URL:", "STATUS:CANCELLED"} {
+		if strings.Contains(text, unwanted) {
+			t.Fatalf("encoded calendar should not contain %q:\n%s", unwanted, text)
+		}
+	}
+}
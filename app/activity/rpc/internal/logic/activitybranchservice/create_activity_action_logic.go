@@ -153,6 +153,12 @@ func (l *CreateActivityActionLogic) CreateActivityAction(in *activity.CreateActi
 			l.Infof("[DTM-Branch] 标签绑定成功: activity_id=%d, tags=%v", activityID, in.TagIds)
 		}
 
+		// 3.6 记录变更事件（由 ChangeRelay 同步 ES）
+		if err := model.RecordChangeEventTx(l.ctx, tx, uint64(activityID), model.ChangeTypeCreate, model.ChangeSourceActivityRpc); err != nil {
+			l.Errorf("[DTM-Branch] 记录变更事件失败: activity_id=%d, err=%v", activityID, err)
+			return err
+		}
+
 		l.Infof("[DTM-Branch] 活动创建成功: activity_id=%d, status=%d", activityID, activityStatus)
 		return nil
 	})
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"time"
 
+	"activity-platform/app/activity/model"
 	"activity-platform/app/activity/rpc/activity"
 	"activity-platform/app/activity/rpc/internal/svc"
 
@@ -106,6 +107,12 @@ func (l *DeleteActivityActionLogic) DeleteActivityAction(in *activity.DeleteActi
 			return err
 		}
 
+		// 3.3 记录变更事件（由 ChangeRelay 从 ES 删除）
+		if err := model.RecordChangeEventTx(l.ctx, tx, uint64(in.ActivityId), model.ChangeTypeDelete, model.ChangeSourceActivityRpc); err != nil {
+			l.Errorf("[DTM-Branch] 记录变更事件失败: %v", err)
+			return err
+		}
+
 		l.Infof("[DTM-Branch] 活动删除成功: activity_id=%d", in.ActivityId)
 		return nil
 	})
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 
+	"activity-platform/app/activity/model"
 	"activity-platform/app/activity/rpc/activity"
 	"activity-platform/app/activity/rpc/internal/svc"
 
@@ -100,6 +101,12 @@ func (l *DeleteActivityCompensateLogic) DeleteActivityCompensate(in *activity.De
 			l.Infof("[DTM-Branch] 恢复了 %d 个标签关联", len(in.TagIds))
 		}
 
+		// 3.3 记录变更事件（由 ChangeRelay 重新索引到 ES）
+		if err := model.RecordChangeEventTx(l.ctx, tx, uint64(in.ActivityId), model.ChangeTypeRestore, model.ChangeSourceActivityRpc); err != nil {
+			l.Errorf("[DTM-Branch] 记录变更事件失败: %v", err)
+			return err
+		}
+
 		l.Infof("[DTM-Branch] 活动补偿成功（已恢复）: activity_id=%d", in.ActivityId)
 		return nil
 	})
@@ -0,0 +1,78 @@
+package logic
+
+import (
+	"context"
+	"errors"
+
+	"activity-platform/app/activity/model"
+	"activity-platform/app/activity/rpc/activity"
+	"activity-platform/app/activity/rpc/internal/svc"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AdminCreatePublicTemplateLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewAdminCreatePublicTemplateLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AdminCreatePublicTemplateLogic {
+	return &AdminCreatePublicTemplateLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// AdminCreatePublicTemplate 管理员以已有活动为蓝本创建公共模板
+//
+// 公共模板面向所有用户，不保留原组织者的联系电话；可指定与来源活动不同的分类
+func (l *AdminCreatePublicTemplateLogic) AdminCreatePublicTemplate(in *activity.AdminCreatePublicTemplateReq) (*activity.ActivityTemplateResp, error) {
+	// 1. 查询来源活动
+	if in.SourceActivityId <= 0 {
+		return nil, errorx.ErrInvalidParams("来源活动ID无效")
+	}
+	source, err := l.svcCtx.ActivityModel.FindByID(l.ctx, uint64(in.SourceActivityId))
+	if err != nil {
+		if errors.Is(err, model.ErrActivityNotFound) {
+			return nil, errorx.New(errorx.CodeActivityNotFound)
+		}
+		return nil, errorx.ErrDBError(err)
+	}
+	name, err := normalizeTemplateName(in.Name, source.Title)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. 生成模板
+	tpl, err := templateFromActivity(l.ctx, l.svcCtx, source)
+	if err != nil {
+		return nil, err
+	}
+	if in.CategoryId > 0 {
+		cat, err := findCategoryAny(l.ctx, l.svcCtx, in.CategoryId)
+		if err != nil {
+			return nil, err
+		}
+		tpl.CategoryID = cat.ID
+	}
+	tpl.Scope = model.TemplateScopePublic
+	tpl.OwnerID = uint64(in.OperatorId)
+	tpl.Name = name
+	tpl.ContactPhone = ""
+
+	// 3. 保存
+	if err := l.svcCtx.TemplateModel.Create(l.ctx, tpl); err != nil {
+		l.Errorf("创建公共模板失败: sourceId=%d, err=%v", source.ID, err)
+		return nil, errorx.ErrDBError(err)
+	}
+	adjustCoverRef(l.ctx, l.svcCtx, tpl.CoverImageID, 1)
+
+	l.Infof("[Template] 创建公共模板: id=%d, sourceId=%d, categoryId=%d, operatorId=%d",
+		tpl.ID, source.ID, tpl.CategoryID, in.OperatorId)
+	return &activity.ActivityTemplateResp{
+		Template: toActivityTemplate(tpl, templateCategoryName(l.ctx, l.svcCtx, tpl.CategoryID)),
+	}, nil
+}
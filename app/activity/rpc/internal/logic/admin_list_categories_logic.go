@@ -0,0 +1,45 @@
+package logic
+
+import (
+	"context"
+
+	"activity-platform/app/activity/rpc/activity"
+	"activity-platform/app/activity/rpc/internal/svc"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AdminListCategoriesLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewAdminListCategoriesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AdminListCategoriesLogic {
+	return &AdminListCategoriesLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// AdminListCategories 管理端分类列表（包含已禁用分类与活动数，不走缓存）
+func (l *AdminListCategoriesLogic) AdminListCategories(in *activity.AdminListCategoriesReq) (*activity.AdminListCategoriesResp, error) {
+	categories, err := l.svcCtx.CategoryModel.ListAll(l.ctx)
+	if err != nil {
+		l.Errorf("查询分类失败: %v", err)
+		return nil, errorx.ErrDBError(err)
+	}
+	counts, err := l.svcCtx.CategoryModel.CountActivitiesByCategory(l.ctx)
+	if err != nil {
+		l.Errorf("统计分类活动数失败: %v", err)
+		return nil, errorx.ErrDBError(err)
+	}
+
+	list := make([]*activity.AdminCategory, len(categories))
+	for i := range categories {
+		list[i] = toAdminCategory(&categories[i], counts[categories[i].ID])
+	}
+	return &activity.AdminListCategoriesResp{List: list}, nil
+}
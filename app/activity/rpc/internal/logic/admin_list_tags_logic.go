@@ -0,0 +1,50 @@
+package logic
+
+import (
+	"context"
+
+	"activity-platform/app/activity/rpc/activity"
+	"activity-platform/app/activity/rpc/internal/svc"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AdminListTagsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewAdminListTagsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AdminListTagsLogic {
+	return &AdminListTagsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// AdminListTags 管理端标签列表（包含已禁用标签与活动数）
+//
+// 数据来源 tag_cache：管理端的标签变更会同步写入，其余变更由 TagSyncCron 定时对账
+func (l *AdminListTagsLogic) AdminListTags(in *activity.AdminListTagsReq) (*activity.AdminListTagsResp, error) {
+	tags, err := l.svcCtx.TagCacheModel.FindAllWithStats(l.ctx)
+	if err != nil {
+		l.Errorf("查询标签失败: %v", err)
+		return nil, errorx.ErrDBError(err)
+	}
+
+	list := make([]*activity.AdminTag, len(tags))
+	for i, tag := range tags {
+		list[i] = &activity.AdminTag{
+			Id:            int64(tag.ID),
+			Name:          tag.Name,
+			Color:         tag.Color,
+			Icon:          tag.Icon,
+			Description:   tag.Description,
+			Status:        int32(tag.Status),
+			ActivityCount: int64(tag.ActivityCount),
+		}
+	}
+	return &activity.AdminListTagsResp{List: list}, nil
+}
@@ -0,0 +1,77 @@
+package logic
+
+import (
+	"context"
+	"strings"
+
+	"activity-platform/app/activity/model"
+	"activity-platform/app/activity/rpc/activity"
+	"activity-platform/app/activity/rpc/internal/svc"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AdminUpdateTemplateLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewAdminUpdateTemplateLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AdminUpdateTemplateLogic {
+	return &AdminUpdateTemplateLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// AdminUpdateTemplate 管理员修改公共模板（名称、分类、上下架）
+func (l *AdminUpdateTemplateLogic) AdminUpdateTemplate(in *activity.AdminUpdateTemplateReq) (*activity.ActivityTemplateResp, error) {
+	// 1. 查询模板
+	tpl, err := findTemplate(l.ctx, l.svcCtx, in.Id)
+	if err != nil {
+		return nil, err
+	}
+	if tpl.Scope != model.TemplateScopePublic {
+		return nil, errorx.New(errorx.CodeActivityTemplateNotFound)
+	}
+
+	// 2. 组装更新字段
+	fields := make(map[string]interface{})
+	if strings.TrimSpace(in.Name) != "" {
+		name, err := normalizeTemplateName(in.Name, "")
+		if err != nil {
+			return nil, err
+		}
+		fields["name"], tpl.Name = name, name
+	}
+	if in.CategoryId > 0 && uint64(in.CategoryId) != tpl.CategoryID {
+		cat, err := findCategoryAny(l.ctx, l.svcCtx, in.CategoryId)
+		if err != nil {
+			return nil, err
+		}
+		fields["category_id"], tpl.CategoryID = cat.ID, cat.ID
+	}
+	if in.Status != nil {
+		status := int8(*in.Status)
+		if status != model.TemplateStatusEnabled && status != model.TemplateStatusDisabled {
+			return nil, errorx.ErrInvalidParams("模板状态无效")
+		}
+		fields["status"], tpl.Status = status, status
+	}
+	if len(fields) == 0 {
+		return nil, errorx.ErrInvalidParams("没有需要修改的内容")
+	}
+
+	// 3. 保存
+	if err := l.svcCtx.TemplateModel.UpdateFields(l.ctx, tpl.ID, fields); err != nil {
+		l.Errorf("修改公共模板失败: id=%d, err=%v", tpl.ID, err)
+		return nil, errorx.ErrDBError(err)
+	}
+
+	l.Infof("[Template] 修改公共模板: id=%d, fields=%v, operatorId=%d", tpl.ID, fields, in.OperatorId)
+	return &activity.ActivityTemplateResp{
+		Template: toActivityTemplate(tpl, templateCategoryName(l.ctx, l.svcCtx, tpl.CategoryID)),
+	}, nil
+}
@@ -0,0 +1,432 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"activity-platform/app/activity/model"
+	"activity-platform/app/activity/rpc/activity"
+	"activity-platform/app/activity/rpc/internal/svc"
+	userpb "activity-platform/app/user/rpc/pb/pb"
+	"activity-platform/common/cache"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+// ==================== 活动分析公共方法 ====================
+//
+// 数据流：IncrViewCount 记录浏览埋点（Redis）→ AnalyticsCron 按天聚合浏览埋点与报名、签到记录
+// → activity_daily_stats / activity_stats_summary / activity_audience_stats → 数据看板只读汇总表
+
+const (
+	analyticsDateLayout      = "2006-01-02"
+	analyticsDefaultDays     = 30  // 看板默认展示最近 30 天
+	analyticsMaxDays         = 180 // 看板单次查询的最大天数
+	analyticsMaxBackfillDays = 7   // 聚合回补上限（浏览埋点只保留 8 天）
+	analyticsViewKeyTTL      = 8 * 24 * 3600
+	analyticsTotalUVTTL      = 90 * 24 * 3600
+	analyticsProfileBatch    = 500 // 批量查询认证画像的单批用户数（与用户服务上限一致）
+	analyticsTopActivities   = 10  // 总览展示报名数最多的活动数
+)
+
+// recordViewStats 记录活动浏览埋点
+//
+// 每次浏览都计入 PV（不受浏览量防刷影响），UV 用 HyperLogLog 去重；
+// 埋点失败只记录日志，不影响浏览量接口
+func recordViewStats(ctx context.Context, svcCtx *svc.ServiceContext, activityID uint64, viewerKey string) {
+	date := time.Now().Format(analyticsDateLayout)
+	err := svcCtx.Redis.PipelinedCtx(ctx, func(pipe redis.Pipeliner) error {
+		pvKey := cache.ActivityStatsPVKey(date, activityID)
+		uvKey := cache.ActivityStatsUVKey(date, activityID)
+		totalUVKey := cache.ActivityStatsTotalUVKey(activityID)
+		viewedKey := cache.ActivityStatsViewedKey(date)
+
+		pipe.Incr(ctx, pvKey)
+		pipe.Expire(ctx, pvKey, analyticsViewKeyTTL*time.Second)
+		pipe.PFAdd(ctx, uvKey, viewerKey)
+		pipe.Expire(ctx, uvKey, analyticsViewKeyTTL*time.Second)
+		pipe.PFAdd(ctx, totalUVKey, viewerKey)
+		pipe.Expire(ctx, totalUVKey, analyticsTotalUVTTL*time.Second)
+		pipe.SAdd(ctx, viewedKey, activityID)
+		pipe.Expire(ctx, viewedKey, analyticsViewKeyTTL*time.Second)
+		return nil
+	})
+	if err != nil {
+		logx.WithContext(ctx).Infof("[WARNING] 记录浏览埋点失败: activity_id=%d, err=%v", activityID, err)
+	}
+}
+
+// parseAnalyticsRange 解析看板日期范围（为空默认最近 30 天）
+func parseAnalyticsRange(startDate, endDate string) (string, string, error) {
+	today := time.Now().Format(analyticsDateLayout)
+	if endDate == "" {
+		endDate = today
+	}
+	end, err := time.ParseInLocation(analyticsDateLayout, endDate, time.Local)
+	if err != nil {
+		return "", "", errorx.ErrInvalidParams("结束日期格式应为 YYYY-MM-DD")
+	}
+	if startDate == "" {
+		startDate = end.AddDate(0, 0, 1-analyticsDefaultDays).Format(analyticsDateLayout)
+	}
+	start, err := time.ParseInLocation(analyticsDateLayout, startDate, time.Local)
+	if err != nil {
+		return "", "", errorx.ErrInvalidParams("开始日期格式应为 YYYY-MM-DD")
+	}
+	if start.After(end) {
+		return "", "", errorx.ErrInvalidParams("开始日期不能晚于结束日期")
+	}
+	if end.Sub(start) >= analyticsMaxDays*24*time.Hour {
+		return "", "", errorx.ErrInvalidParams("查询范围不能超过180天")
+	}
+	return startDate, endDate, nil
+}
+
+// toAnalyticsFunnel 转换报名转化漏斗
+func toAnalyticsFunnel(pv, uv, registrations, cancellations, active, checkIns, noShows int64) *activity.AnalyticsFunnel {
+	return &activity.AnalyticsFunnel{
+		PageViews:           pv,
+		UniqueVisitors:      uv,
+		Registrations:       registrations,
+		Cancellations:       cancellations,
+		ActiveRegistrations: active,
+		CheckIns:            checkIns,
+		NoShows:             noShows,
+		AttendanceRate:      attendanceRate(checkIns, active),
+	}
+}
+
+// toAnalyticsDaily 转换每日统计，缺少数据的日期补零，保证曲线连续
+func toAnalyticsDaily(list []model.ActivityDailyStats, startDate, endDate string) []*activity.AnalyticsDaily {
+	byDate := make(map[string]model.ActivityDailyStats, len(list))
+	for _, d := range list {
+		byDate[d.StatDate] = d
+	}
+
+	start, _ := time.ParseInLocation(analyticsDateLayout, startDate, time.Local)
+	end, _ := time.ParseInLocation(analyticsDateLayout, endDate, time.Local)
+	result := make([]*activity.AnalyticsDaily, 0, len(list))
+	for day := start; !day.After(end); day = day.AddDate(0, 0, 1) {
+		date := day.Format(analyticsDateLayout)
+		d := byDate[date]
+		result = append(result, &activity.AnalyticsDaily{
+			Date:           date,
+			PageViews:      d.PV,
+			UniqueVisitors: d.UV,
+			Registrations:  d.Registrations,
+			Cancellations:  d.Cancellations,
+			CheckIns:       d.CheckIns,
+		})
+	}
+	return result
+}
+
+// toAudienceBreakdown 转换报名者构成
+func toAudienceBreakdown(list []model.ActivityAudienceStats, verified int64) *activity.AudienceBreakdown {
+	breakdown := &activity.AudienceBreakdown{
+		VerifiedRegistrants: verified,
+		Departments:         []*activity.AudienceBucket{},
+		AdmissionYears:      []*activity.AudienceBucket{},
+	}
+	for _, a := range list {
+		bucket := &activity.AudienceBucket{Value: a.Value, Count: a.Count}
+		switch a.Dimension {
+		case model.AudienceDimensionDepartment:
+			breakdown.Departments = append(breakdown.Departments, bucket)
+		case model.AudienceDimensionAdmissionYear:
+			breakdown.AdmissionYears = append(breakdown.AdmissionYears, bucket)
+		}
+	}
+	return breakdown
+}
+
+// attendanceRate 到场率（保留 4 位小数）
+func attendanceRate(checkIns, active int64) float64 {
+	if active <= 0 {
+		return 0
+	}
+	rate := float64(checkIns) / float64(active)
+	if rate > 1 {
+		rate = 1
+	}
+	return math.Round(rate*10000) / 10000
+}
+
+// ==================== AnalyticsAggregator 统计聚合 ====================
+
+// AnalyticsAggregator 活动统计聚合
+//
+// 由 AnalyticsCron 定时调用。每轮聚合当天及最近几天尚未完成的日期：
+//  1. 确定当天有变化的活动：浏览埋点、新增报名、取消、签到、当天结束
+//  2. 写入这些活动的每日统计（按活动+日期覆盖，重复执行结果一致）
+//  3. 重新计算这些活动的累计统计与报名者构成
+//
+// 已结束的日期聚合成功后打完成标记，之后不再重复聚合
+type AnalyticsAggregator struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewAnalyticsAggregator(ctx context.Context, svcCtx *svc.ServiceContext) *AnalyticsAggregator {
+	return &AnalyticsAggregator{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// RunAll 聚合最近 BackfillDays 天（含当天）尚未完成的日期（单个日期失败不影响其他日期）
+func (a *AnalyticsAggregator) RunAll() error {
+	days := a.svcCtx.Config.Analytics.BackfillDays
+	if days <= 0 {
+		days = 1
+	}
+	if days > analyticsMaxBackfillDays {
+		days = analyticsMaxBackfillDays
+	}
+
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
+	for i := days - 1; i >= 0; i-- {
+		day := today.AddDate(0, 0, -i)
+		date := day.Format(analyticsDateLayout)
+		finished := i > 0
+
+		if finished {
+			done, err := a.svcCtx.Redis.ExistsCtx(a.ctx, cache.AnalyticsDoneKey(date))
+			if err == nil && done {
+				continue
+			}
+		}
+		if err := a.AggregateDay(day); err != nil {
+			a.Errorf("[AnalyticsAggregator] 聚合失败: date=%s, err=%v", date, err)
+			continue
+		}
+		if finished {
+			if err := a.svcCtx.Redis.SetexCtx(a.ctx, cache.AnalyticsDoneKey(date), "1", analyticsViewKeyTTL); err != nil {
+				a.Errorf("[AnalyticsAggregator] 设置完成标记失败: date=%s, err=%v", date, err)
+			}
+		}
+	}
+	return nil
+}
+
+// AggregateDay 聚合指定日期（day 为当天零点）
+func (a *AnalyticsAggregator) AggregateDay(day time.Time) error {
+	date := day.Format(analyticsDateLayout)
+	start, end := day.Unix(), day.AddDate(0, 0, 1).Unix()
+
+	// 1. 当天的原始数据
+	registrations, err := a.svcCtx.AnalyticsModel.CountRegistrationsBetween(a.ctx, start, end)
+	if err != nil {
+		return err
+	}
+	cancellations, err := a.svcCtx.AnalyticsModel.CountCancellationsBetween(a.ctx, start, end)
+	if err != nil {
+		return err
+	}
+	checkIns, err := a.svcCtx.AnalyticsModel.CountCheckInsBetween(a.ctx, start, end)
+	if err != nil {
+		return err
+	}
+	ended, err := a.svcCtx.AnalyticsModel.ListActivityIDsEndedBetween(a.ctx, start, end)
+	if err != nil {
+		return err
+	}
+	viewed, err := a.svcCtx.Redis.SmembersCtx(a.ctx, cache.ActivityStatsViewedKey(date))
+	if err != nil {
+		return err
+	}
+
+	// 2. 汇总当天有变化的活动
+	touched := make(map[uint64]struct{})
+	for _, m := range []map[uint64]int64{registrations, cancellations, checkIns} {
+		for id := range m {
+			touched[id] = struct{}{}
+		}
+	}
+	for _, id := range ended {
+		touched[id] = struct{}{}
+	}
+	for _, v := range viewed {
+		if id, err := strconv.ParseUint(v, 10, 64); err == nil && id > 0 {
+			touched[id] = struct{}{}
+		}
+	}
+	if len(touched) == 0 {
+		return nil
+	}
+	ids := make([]uint64, 0, len(touched))
+	for id := range touched {
+		ids = append(ids, id)
+	}
+	activities, err := a.svcCtx.ActivityModel.FindByIDs(a.ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	// 3. 逐个活动写入每日统计并刷新累计统计
+	for i := range activities {
+		act := &activities[i]
+		pv, uv := a.loadViewStats(date, act.ID)
+		err := a.svcCtx.AnalyticsModel.UpsertDaily(a.ctx, &model.ActivityDailyStats{
+			ActivityID:    act.ID,
+			StatDate:      date,
+			OrganizerID:   act.OrganizerID,
+			PV:            pv,
+			UV:            uv,
+			Registrations: registrations[act.ID],
+			Cancellations: cancellations[act.ID],
+			CheckIns:      checkIns[act.ID],
+		})
+		if err != nil {
+			a.Errorf("[AnalyticsAggregator] 写入每日统计失败: activityId=%d, date=%s, err=%v", act.ID, date, err)
+			continue
+		}
+		if err := a.RefreshActivity(act, date); err != nil {
+			a.Errorf("[AnalyticsAggregator] 刷新累计统计失败: activityId=%d, err=%v", act.ID, err)
+		}
+	}
+	a.Infof("[AnalyticsAggregator] 聚合完成: date=%s, activities=%d", date, len(activities))
+	return nil
+}
+
+// loadViewStats 读取活动当天的浏览埋点（读取失败按 0 处理）
+func (a *AnalyticsAggregator) loadViewStats(date string, activityID uint64) (pv, uv int64) {
+	if val, err := a.svcCtx.Redis.GetCtx(a.ctx, cache.ActivityStatsPVKey(date, activityID)); err == nil && val != "" {
+		pv, _ = strconv.ParseInt(val, 10, 64)
+	}
+	uv, _ = a.svcCtx.Redis.PfcountCtx(a.ctx, cache.ActivityStatsUVKey(date, activityID))
+	return pv, uv
+}
+
+// RefreshActivity 重新计算活动的累计统计与报名者构成
+func (a *AnalyticsAggregator) RefreshActivity(act *model.Activity, date string) error {
+	now := time.Now().Unix()
+
+	// 1. 报名与签到
+	totals, err := a.svcCtx.AnalyticsModel.SumDaily(a.ctx, act.ID)
+	if err != nil {
+		return err
+	}
+	active, canceled, err := a.svcCtx.AnalyticsModel.CountActivityRegistrations(a.ctx, act.ID)
+	if err != nil {
+		return err
+	}
+	checkedIn, err := a.svcCtx.AnalyticsModel.CountActivityCheckedInUsers(a.ctx, act.ID)
+	if err != nil {
+		return err
+	}
+	first24h, err := a.svcCtx.AnalyticsModel.CountActivityRegistrationsWithin(a.ctx, act.ID,
+		act.RegisterStartTime, act.RegisterStartTime+24*3600)
+	if err != nil {
+		return err
+	}
+	var noShows int64
+	if act.ActivityEndTime <= now && active > checkedIn {
+		noShows = active - checkedIn
+	}
+
+	// 2. 累计浏览人数：HyperLogLog 过期后保留已统计的值
+	uv, _ := a.svcCtx.Redis.PfcountCtx(a.ctx, cache.ActivityStatsTotalUVKey(act.ID))
+	if prev, err := a.svcCtx.AnalyticsModel.FindSummary(a.ctx, act.ID); err == nil && prev.UV > uv {
+		uv = prev.UV
+	}
+
+	// 3. 报名者构成
+	audience, verified, err := a.buildAudience(act)
+	if err != nil {
+		return err
+	}
+	if err := a.svcCtx.AnalyticsModel.ReplaceAudience(a.ctx, act.ID, audience); err != nil {
+		return err
+	}
+
+	return a.svcCtx.AnalyticsModel.UpsertSummary(a.ctx, &model.ActivityStatsSummary{
+		ActivityID:             act.ID,
+		OrganizerID:            act.OrganizerID,
+		PV:                     totals.PV,
+		UV:                     uv,
+		Registrations:          active + canceled,
+		Cancellations:          canceled,
+		ActiveRegistrations:    active,
+		CheckIns:               checkedIn,
+		NoShows:                noShows,
+		AttendanceRate:         attendanceRate(checkedIn, active),
+		First24hRegistrations:  first24h,
+		PeakDailyRegistrations: totals.PeakDailyRegistrations,
+		AvgDailyRegistrations:  avgDailyRegistrations(act, active+canceled, now),
+		VerifiedRegistrants:    verified,
+		StatDate:               date,
+	})
+}
+
+// buildAudience 统计有效报名者中已认证用户的院系与入学年份分布
+func (a *AnalyticsAggregator) buildAudience(act *model.Activity) ([]model.ActivityAudienceStats, int64, error) {
+	userIDs, err := a.svcCtx.ActivityRegistrationModel.ListSuccessUserIDs(a.ctx, act.ID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	counts := map[string]map[string]int64{
+		model.AudienceDimensionDepartment:    {},
+		model.AudienceDimensionAdmissionYear: {},
+	}
+	var verified int64
+	for i := 0; i < len(userIDs); i += analyticsProfileBatch {
+		batch := userIDs[i:min(i+analyticsProfileBatch, len(userIDs))]
+		ids := make([]int64, 0, len(batch))
+		for _, id := range batch {
+			ids = append(ids, int64(id))
+		}
+		resp, err := a.svcCtx.VerifyService.BatchGetVerifyProfiles(a.ctx, &userpb.BatchGetVerifyProfilesReq{UserIds: ids})
+		if err != nil {
+			return nil, 0, fmt.Errorf("查询认证画像失败: %w", err)
+		}
+		for _, p := range resp.List {
+			verified++
+			if p.Department != "" {
+				counts[model.AudienceDimensionDepartment][p.Department]++
+			}
+			if p.AdmissionYear != "" {
+				counts[model.AudienceDimensionAdmissionYear][p.AdmissionYear]++
+			}
+		}
+	}
+
+	rows := make([]model.ActivityAudienceStats, 0)
+	for dimension, values := range counts {
+		for value, count := range values {
+			rows = append(rows, model.ActivityAudienceStats{
+				ActivityID:  act.ID,
+				Dimension:   dimension,
+				Value:       value,
+				OrganizerID: act.OrganizerID,
+				Count:       count,
+			})
+		}
+	}
+	return rows, verified, nil
+}
+
+// avgDailyRegistrations 报名期日均报名数（报名期按开放报名到截止/当前时间计算，不足一天按一天）
+func avgDailyRegistrations(act *model.Activity, registrations, now int64) float64 {
+	if registrations == 0 || act.RegisterStartTime <= 0 {
+		return 0
+	}
+	end := act.RegisterEndTime
+	if end <= 0 || end > now {
+		end = now
+	}
+	days := math.Ceil(float64(end-act.RegisterStartTime) / (24 * 3600))
+	if days < 1 {
+		days = 1
+	}
+	return math.Round(float64(registrations)/days*100) / 100
+}
@@ -24,7 +24,19 @@ func NewApproveActivityLogic(ctx context.Context, svcCtx *svc.ServiceContext) *A
 	}
 }
 
+// ApproveActivity 审核通过（管理员）
+// 状态流转：Pending(1) → Published(2)，保存内容快照作为后续审核的差异基准，并通知组织者
 func (l *ApproveActivityLogic) ApproveActivity(in *activity.ApproveActivityReq) (*activity.ApproveActivityResp, error) {
-	// MVP 版本无后台管理系统，审批功能暂不支持
-	return nil, errorx.NewWithMessage(errorx.CodeServiceUnavailable, "审批功能暂未开放")
+	if in.Id <= 0 {
+		return nil, errorx.ErrInvalidParams("活动ID无效")
+	}
+	if in.OperatorId <= 0 {
+		return nil, errorx.ErrInvalidParams("操作者信息缺失")
+	}
+
+	status, err := applyReviewDecision(l.ctx, l.svcCtx, uint64(in.Id), uint64(in.OperatorId), true, "")
+	if err != nil {
+		return nil, err
+	}
+	return &activity.ApproveActivityResp{Status: int32(status)}, nil
 }
@@ -0,0 +1,102 @@
+package logic
+
+import (
+	"context"
+	"errors"
+
+	"activity-platform/app/activity/model"
+	"activity-platform/app/activity/rpc/activity"
+	"activity-platform/app/activity/rpc/internal/svc"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AssignActivityReviewLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewAssignActivityReviewLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AssignActivityReviewLogic {
+	return &AssignActivityReviewLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// AssignActivityReview 分配/领取/释放审核任务（管理员）
+//
+// 业务逻辑：
+//   - 领取（assignee_id=0）：未分配的任务领取给自己；已被他人领取时返回错误
+//   - 分配（assignee_id>0）：将任务指派给指定审核员（可覆盖原分配）
+//   - 释放（release=true）：仅当前审核员本人可释放
+//
+// 分配信息仅影响队列展示与审核权限，不改变活动状态
+func (l *AssignActivityReviewLogic) AssignActivityReview(in *activity.AssignActivityReviewReq) (*activity.AssignActivityReviewResp, error) {
+	// 1. 参数校验
+	if in.ActivityId <= 0 {
+		return nil, errorx.ErrInvalidParams("活动ID无效")
+	}
+	if in.OperatorId <= 0 {
+		return nil, errorx.ErrInvalidParams("操作者信息缺失")
+	}
+	if in.AssigneeId < 0 {
+		return nil, errorx.ErrInvalidParams("审核员ID无效")
+	}
+	operatorID := uint64(in.OperatorId)
+
+	// 2. 查询待审核的审核单
+	review, err := l.svcCtx.ReviewModel.FindPendingByActivityID(l.ctx, nil, uint64(in.ActivityId))
+	if err != nil {
+		if errors.Is(err, model.ErrReviewNotFound) {
+			return nil, errorx.New(errorx.CodeActivityReviewNotFound)
+		}
+		l.Errorf("查询审核单失败: activityId=%d, err=%v", in.ActivityId, err)
+		return nil, errorx.ErrDBError(err)
+	}
+
+	// 3. 确定目标审核员
+	var assignee uint64
+	switch {
+	case in.Release:
+		if review.AssigneeID != operatorID {
+			return nil, errorx.New(errorx.CodeActivityReviewAssigned)
+		}
+		assignee = 0
+	case in.AssigneeId > 0:
+		assignee = uint64(in.AssigneeId)
+	default:
+		if review.AssigneeID != 0 && review.AssigneeID != operatorID {
+			return nil, errorx.New(errorx.CodeActivityReviewAssigned)
+		}
+		assignee = operatorID
+	}
+
+	// 4. 分配未变化时直接返回（本人重复领取）
+	if assignee == review.AssigneeID {
+		return &activity.AssignActivityReviewResp{
+			AssigneeId: int64(review.AssigneeID),
+			AssignedAt: review.AssignedAt,
+		}, nil
+	}
+
+	// 5. 更新分配（以读到的原分配为条件，防止并发领取互相覆盖）
+	assignedAt, err := l.svcCtx.ReviewModel.Assign(l.ctx, review.ID, review.AssigneeID, assignee)
+	if err != nil {
+		if errors.Is(err, model.ErrActivityConcurrentUpdate) {
+			return nil, errorx.New(errorx.CodeActivityConcurrentUpdate)
+		}
+		l.Errorf("分配审核任务失败: reviewId=%d, err=%v", review.ID, err)
+		return nil, errorx.ErrDBError(err)
+	}
+
+	l.Infof("审核任务分配变更: activityId=%d, reviewId=%d, operatorId=%d, assignee=%d->%d",
+		in.ActivityId, review.ID, operatorID, review.AssigneeID, assignee)
+
+	return &activity.AssignActivityReviewResp{
+		AssigneeId: int64(assignee),
+		AssignedAt: assignedAt,
+	}, nil
+}
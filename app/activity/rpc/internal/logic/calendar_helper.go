@@ -0,0 +1,78 @@
+package logic
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"activity-platform/app/activity/model"
+	"activity-platform/app/activity/rpc/internal/ical"
+	"activity-platform/app/activity/rpc/internal/svc"
+)
+
+// ==================== 日历订阅公共方法 ====================
+
+const (
+	calendarFeedName            = "CampusHub 我的活动"
+	calendarFeedRefreshInterval = time.Hour
+	calendarTokenBytes          = 24 // 令牌 hex 编码后 48 位
+)
+
+// generateCalendarToken 生成订阅令牌（不可猜测，作为订阅地址的唯一凭证）
+func generateCalendarToken() (string, error) {
+	buf := make([]byte, calendarTokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// calendarEventUID 活动对应的事件 UID（稳定不变，改期/取消时客户端据此更新同一事件）
+func calendarEventUID(activityID uint64) string {
+	return fmt.Sprintf("activity-%d@campushub", activityID)
+}
+
+// buildCalendarEvent 活动转换为日历事件
+//
+// 活动每次修改 Version 都会递增，直接作为 SEQUENCE；
+// withdrawn=true 表示用户已取消报名，事件标记为取消并在活动修订号基础上 +1，
+// 确保客户端以新修订覆盖此前的确认事件
+func buildCalendarEvent(svcCtx *svc.ServiceContext, act *model.Activity, withdrawn bool, withdrawnAt int64) ical.Event {
+	location := act.Location
+	if act.AddressDetail != "" && act.AddressDetail != act.Location {
+		location = strings.TrimSpace(location + " " + act.AddressDetail)
+	}
+
+	event := ical.Event{
+		UID:       calendarEventUID(act.ID),
+		Sequence:  int(act.Version),
+		Summary:   act.Title,
+		Location:  location,
+		Latitude:  act.Latitude,
+		Longitude: act.Longitude,
+		Start:     time.Unix(act.ActivityStartTime, 0),
+		End:       time.Unix(act.ActivityEndTime, 0),
+		Stamp:     time.Unix(act.UpdatedAt, 0),
+		Cancelled: act.Status == model.StatusCancelled || withdrawn,
+	}
+	if withdrawn {
+		event.Sequence++
+		if withdrawnAt > act.UpdatedAt {
+			event.Stamp = time.Unix(withdrawnAt, 0)
+		}
+	}
+
+	// 正文为富文本，不写入日历；只附带组织者与详情链接
+	var desc []string
+	if act.OrganizerName != "" {
+		desc = append(desc, "组织者："+act.OrganizerName)
+	}
+	if tpl := svcCtx.Config.Calendar.ActivityURL; tpl != "" {
+		event.URL = fmt.Sprintf(tpl, act.ID)
+		desc = append(desc, "活动详情："+event.URL)
+	}
+	event.Description = strings.Join(desc, "\n")
+	return event
+}
@@ -56,6 +56,7 @@ func (l *CancelActivitiesLogic) CancelActivities(in *activity.CancelActivityRequ
 	errRegistrationInvalid := errors.New("registration status invalid")
 	errTicketUsed := errors.New("ticket already used")
 	errCountUpdate := errors.New("participant count update failed")
+	var registeredAt int64 // 最近一次报名成功的时间（含重新报名）
 
 	err = l.svcCtx.DB.WithContext(l.ctx).Transaction(func(tx *gorm.DB) error {
 		// 3.1 查询报名记录并校验状态
@@ -72,6 +73,7 @@ func (l *CancelActivitiesLogic) CancelActivities(in *activity.CancelActivityRequ
 		default:
 			return errRegistrationInvalid
 		}
+		registeredAt = reg.UpdatedAt
 
 		// 3.2 查询关联票券，已核销则禁止取消
 		ticket, err := l.svcCtx.ActivityTicketModel.FindByRegistrationIDTx(l.ctx, tx, reg.ID)
@@ -116,7 +118,8 @@ func (l *CancelActivitiesLogic) CancelActivities(in *activity.CancelActivityRequ
 			return errCountUpdate
 		}
 
-		return nil
+		// 3.6 记录活动变更事件（刷新 ES 报名人数与详情/热门缓存）
+		return l.svcCtx.ChangeEventModel.Record(l.ctx, tx, uint64(activityID), model.ChangeTypeParticipants, model.ChangeSourceActivityRpc)
 	})
 	if err != nil {
 		// 4) 幂等与业务错误处理
@@ -132,13 +135,18 @@ func (l *CancelActivitiesLogic) CancelActivities(in *activity.CancelActivityRequ
 		return &activity.CancelActivityResponse{Result: "fail"}, nil
 	}
 
-	// 5) 异步发布取消报名事件
+	// 5) 异步发布取消报名事件，移除开始前提醒并刷新日历订阅
 	l.publishMemberLeftEvent(activityID, userID)
+	if err := l.svcCtx.Reminders.CancelRegistration(l.ctx, uint64(activityID), uint64(userID)); err != nil {
+		l.Infof("[WARNING] 移除活动提醒失败: activityId=%d, userId=%d, err=%v", activityID, userID, err)
+	}
+	_ = l.svcCtx.CalendarCache.Invalidate(l.ctx, uint64(userID))
 
 	// 6) 发布信用事件：根据距活动开始时间判断 cancel_early 或 cancel_late
+	//    活动时间/地点变更生效后的无责取消窗口内，变更前已报名的用户一律按 cancel_early 处理
 	if activityData.ActivityStartTime > 0 {
 		hoursBeforeStart := (activityData.ActivityStartTime - now) / 3600
-		if hoursBeforeStart >= 24 {
+		if hoursBeforeStart >= 24 || l.inFreeCancelWindow(uint64(activityID), registeredAt, now) {
 			l.publishCreditEvent(messaging.CreditEventCancelEarly, activityID, userID)
 		} else {
 			l.publishCreditEvent(messaging.CreditEventCancelLate, activityID, userID)
@@ -149,6 +157,23 @@ func (l *CancelActivitiesLogic) CancelActivities(in *activity.CancelActivityRequ
 	return &activity.CancelActivityResponse{Result: "success"}, nil
 }
 
+// inFreeCancelWindow 是否处于活动变更后的无责取消窗口内
+// - 仅对变更生效前已报名的用户有效
+// - 查询失败按不在窗口内处理（沿用 24 小时规则）
+func (l *CancelActivitiesLogic) inFreeCancelWindow(activityID uint64, registeredAt, now int64) bool {
+	if l.svcCtx.ChangeRequestModel == nil {
+		return false
+	}
+	change, err := l.svcCtx.ChangeRequestModel.FindActiveFreeCancel(l.ctx, activityID, now)
+	if err != nil {
+		if !errors.Is(err, model.ErrChangeRequestNotFound) {
+			l.Errorf("查询无责取消窗口失败: activityId=%d, err=%v", activityID, err)
+		}
+		return false
+	}
+	return registeredAt > 0 && registeredAt <= change.DecidedAt
+}
+
 // publishMemberLeftEvent 发布取消报名事件（topic: activity.member.left）
 // - Producer 未启用时直接跳过
 // - 发布失败由 Producer 内部记录，不影响主流程
@@ -110,7 +110,8 @@ func (l *CancelActivityLogic) CancelActivity(in *activity.CancelActivityReq) (*a
 			// 日志记录失败不影响主流程
 		}
 
-		return nil
+		// 5.3 记录变更事件（取消后不应被搜索到，由 ChangeRelay 从 ES 删除）
+		return l.svcCtx.ChangeEventModel.Record(l.ctx, tx, uint64(in.Id), model.ChangeTypeStatus, model.ChangeSourceActivityRpc)
 	})
 
 	if err != nil {
@@ -128,11 +129,6 @@ func (l *CancelActivityLogic) CancelActivity(in *activity.CancelActivityReq) (*a
 		}
 	}
 
-	// 异步从 ES 删除文档（取消后不应被搜索到）
-	if l.svcCtx.SyncService != nil {
-		l.svcCtx.SyncService.DeleteActivityAsync(uint64(in.Id))
-	}
-
 	// 异步发布活动取消事件（通知所有已报名参与者）
 	if l.svcCtx.MsgProducer != nil {
 		l.svcCtx.MsgProducer.PublishActivityCancelled(
@@ -0,0 +1,87 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"activity-platform/app/activity/model"
+	"activity-platform/app/activity/rpc/activity"
+	"activity-platform/app/activity/rpc/internal/svc"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CancelActivitySeriesLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCancelActivitySeriesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CancelActivitySeriesLogic {
+	return &CancelActivitySeriesLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// CancelActivitySeries 取消系列活动
+//
+// 系列取消后不再生成场次、不再为整期报名用户出票；
+// cancel_future=true 时按单场取消流程逐场取消未开始的场次（通知已报名用户），
+// 已开始的场次不受影响
+func (l *CancelActivitySeriesLogic) CancelActivitySeries(in *activity.CancelActivitySeriesReq) (*activity.CancelActivitySeriesResp, error) {
+	if in.OperatorId <= 0 {
+		return nil, errorx.ErrInvalidParams("操作者信息缺失")
+	}
+	series, err := findSeries(l.ctx, l.svcCtx, in.Id)
+	if err != nil {
+		return nil, err
+	}
+	if !in.IsAdmin && series.OrganizerID != uint64(in.OperatorId) {
+		return nil, errorx.New(errorx.CodeActivityPermissionDenied)
+	}
+	if series.Status == model.SeriesStatusCancelled {
+		return nil, errorx.New(errorx.CodeActivitySeriesClosed)
+	}
+
+	// 1. 停止生成
+	if err := l.svcCtx.SeriesModel.UpdateFields(l.ctx, series.ID, map[string]interface{}{
+		"status": model.SeriesStatusCancelled,
+	}); err != nil {
+		l.Errorf("取消系列活动失败: id=%d, err=%v", series.ID, err)
+		return nil, errorx.ErrDBError(err)
+	}
+
+	// 2. 逐场取消未开始的场次
+	resp := &activity.CancelActivitySeriesResp{}
+	if in.CancelFuture {
+		occurrences, err := l.svcCtx.SeriesModel.ListFutureOccurrences(l.ctx, series.ID, time.Now().Unix())
+		if err != nil {
+			l.Errorf("查询系列场次失败: seriesId=%d, err=%v", series.ID, err)
+			return nil, errorx.ErrDBError(err)
+		}
+		reason := in.Reason
+		if reason == "" {
+			reason = "系列活动已取消"
+		}
+		for _, occ := range occurrences {
+			_, err := NewCancelActivityLogic(l.ctx, l.svcCtx).CancelActivity(&activity.CancelActivityReq{
+				Id:         int64(occ.ActivityID),
+				Reason:     reason,
+				OperatorId: in.OperatorId,
+				IsAdmin:    in.IsAdmin,
+			})
+			if err != nil {
+				resp.Skipped = append(resp.Skipped, skippedOccurrence(occ.ActivityID, errorx.FromError(err).GetMessage()))
+				continue
+			}
+			resp.Cancelled++
+		}
+	}
+
+	l.Infof("系列活动已取消: id=%d, operatorId=%d, isAdmin=%v, cancelFuture=%v, cancelled=%d, skipped=%d",
+		series.ID, in.OperatorId, in.IsAdmin, in.CancelFuture, resp.Cancelled, len(resp.Skipped))
+	return resp, nil
+}
@@ -0,0 +1,75 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"activity-platform/app/activity/model"
+	"activity-platform/app/activity/rpc/activity"
+	"activity-platform/app/activity/rpc/internal/svc"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CheckEligibilityLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCheckEligibilityLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CheckEligibilityLogic {
+	return &CheckEligibilityLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// CheckEligibility 报名资格预检（"我能报名吗？"）
+//
+// 与 RegisterActivity 使用同一套规则，但不写入报名记录、不消耗限流配额。
+//   - eligible：是否满足全部资格规则（信用、认证、学校/院系/年级）
+//   - can_register：在 eligible 基础上，再叠加活动状态、报名时间、名额的判断
+func (l *CheckEligibilityLogic) CheckEligibility(in *activity.CheckEligibilityReq) (*activity.CheckEligibilityResp, error) {
+	if in.ActivityId <= 0 {
+		return nil, errorx.ErrInvalidParams("活动ID无效")
+	}
+	if in.UserId <= 0 {
+		return nil, errorx.ErrInvalidParams("用户ID无效")
+	}
+
+	activityData, err := l.svcCtx.ActivityModel.FindByID(l.ctx, uint64(in.ActivityId))
+	if err != nil {
+		if errors.Is(err, model.ErrActivityNotFound) {
+			return nil, errorx.New(errorx.CodeActivityNotFound)
+		}
+		l.Errorf("查询活动失败: id=%d, err=%v", in.ActivityId, err)
+		return nil, errorx.ErrDBError(err)
+	}
+
+	result, err := l.svcCtx.EligibilityEngine.Evaluate(l.ctx, activityData, in.UserId)
+	if err != nil {
+		l.Errorf("资格校验失败: activityId=%d, userId=%d, err=%v", in.ActivityId, in.UserId, err)
+		return nil, errorx.NewWithMessage(errorx.CodeServiceUnavailable, "资格校验失败，请稍后重试")
+	}
+
+	resp := &activity.CheckEligibilityResp{
+		Eligible: result.Eligible,
+		Items:    toEligibilityCheckItemPbList(result.Items),
+	}
+
+	// 活动本身的报名条件优先于资格规则
+	if reason := checkRegisterWindow(activityData, in.UserId, time.Now().Unix()); reason != "" {
+		resp.Reason = reason
+		return resp, nil
+	}
+	if !result.Eligible {
+		resp.Reason = result.Reason()
+		return resp, nil
+	}
+
+	resp.CanRegister = true
+	return resp, nil
+}
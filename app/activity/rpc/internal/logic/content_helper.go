@@ -0,0 +1,140 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"activity-platform/app/activity/model"
+	"activity-platform/app/activity/rpc/internal/svc"
+	"activity-platform/common/contentfilter"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// ActivityTextScreen 活动文本（标题、详情）敏感词检测结果
+type ActivityTextScreen struct {
+	Title   string               // 处置后的标题（替换类命中已掩码）
+	Content string               // 处置后的详情
+	Action  contentfilter.Action // 最严重的处置动作
+	Hits    []model.ContentHit   // 命中明细
+}
+
+// Blocked 是否需要拒绝
+func (s *ActivityTextScreen) Blocked() bool {
+	return s.Action == contentfilter.ActionBlock
+}
+
+// Flagged 是否命中送审词（需人工审核）
+func (s *ActivityTextScreen) Flagged() bool {
+	return s.Action == contentfilter.ActionFlag
+}
+
+// ViolationError 拦截时返回给组织者的错误（附带命中的拦截词）
+func (s *ActivityTextScreen) ViolationError() error {
+	seen := make(map[string]struct{}, len(s.Hits))
+	words := make([]string, 0, len(s.Hits))
+	for _, h := range s.Hits {
+		if h.Action != string(contentfilter.ActionBlock) {
+			continue
+		}
+		if _, ok := seen[h.Word]; ok {
+			continue
+		}
+		seen[h.Word] = struct{}{}
+		words = append(words, h.Word)
+	}
+	return errorx.NewWithMessage(errorx.CodeActivityContentViolation,
+		fmt.Sprintf("活动内容包含违规词：%s，请修改后重试", strings.Join(words, "、")))
+}
+
+// screenActivityText 检测活动标题与详情（未启用敏感词过滤时原样返回）
+func screenActivityText(svcCtx *svc.ServiceContext, title, content string) *ActivityTextScreen {
+	screen := &ActivityTextScreen{Title: title, Content: content}
+	if svcCtx.ContentFilter == nil {
+		return screen
+	}
+
+	results, action := svcCtx.ContentFilter.CheckAll(title, content)
+	screen.Action = action
+	screen.Title = results[0].Text
+	screen.Content = results[1].Text
+	for i, field := range []string{"title", "content"} {
+		for _, h := range results[i].Hits {
+			screen.Hits = append(screen.Hits, model.ContentHit{
+				Field:    field,
+				Word:     h.Word,
+				Category: h.Category,
+				Action:   string(h.Action),
+			})
+		}
+	}
+	return screen
+}
+
+// recordContentReview 记录命中结果供管理员审核（无命中时跳过；写入失败只记录日志）
+//
+// 送审（flag）记录为待审核，替换/拦截记录为已自动处置
+func recordContentReview(ctx context.Context, svcCtx *svc.ServiceContext, activityID, organizerID uint64, scene, title string, screen *ActivityTextScreen) {
+	if screen == nil || len(screen.Hits) == 0 {
+		return
+	}
+	saveContentReview(ctx, svcCtx, newContentReview(activityID, organizerID, scene, title, screen))
+}
+
+// screenFeedbackComment 检测评价短评（未启用敏感词过滤时原样返回）
+func screenFeedbackComment(svcCtx *svc.ServiceContext, comment string) *contentfilter.Result {
+	if svcCtx.ContentFilter == nil || comment == "" {
+		return &contentfilter.Result{Text: comment}
+	}
+	return svcCtx.ContentFilter.Check(comment)
+}
+
+// recordFeedbackReview 记录短评命中结果（与活动内容共用审核记录，title 存短评原文摘要）
+func recordFeedbackReview(ctx context.Context, svcCtx *svc.ServiceContext, feedback *model.ActivityFeedback, rawComment string, result *contentfilter.Result) {
+	if result == nil || !result.Hit() {
+		return
+	}
+	screen := &ActivityTextScreen{Action: result.Action}
+	for _, h := range result.Hits {
+		screen.Hits = append(screen.Hits, model.ContentHit{
+			Field:    "comment",
+			Word:     h.Word,
+			Category: h.Category,
+			Action:   string(h.Action),
+		})
+	}
+	review := newContentReview(feedback.ActivityID, feedback.OrganizerID, model.ContentSceneFeedback, rawComment, screen)
+	review.FeedbackID = feedback.ID
+	saveContentReview(ctx, svcCtx, review)
+}
+
+// newContentReview 构建审核记录
+func newContentReview(activityID, organizerID uint64, scene, title string, screen *ActivityTextScreen) *model.ActivityContentReview {
+	status := model.ContentReviewAuto
+	if screen.Action == contentfilter.ActionFlag {
+		status = model.ContentReviewPending
+	}
+	if runes := []rune(title); len(runes) > 100 {
+		title = string(runes[:100])
+	}
+
+	return &model.ActivityContentReview{
+		ActivityID:  activityID,
+		OrganizerID: organizerID,
+		Scene:       scene,
+		Action:      string(screen.Action),
+		Title:       title,
+		Status:      status,
+		Hits:        screen.Hits,
+	}
+}
+
+// saveContentReview 写入审核记录（失败只记录日志）
+func saveContentReview(ctx context.Context, svcCtx *svc.ServiceContext, review *model.ActivityContentReview) {
+	if err := svcCtx.ContentReviewModel.Create(ctx, review); err != nil {
+		logx.WithContext(ctx).Errorf("[ContentReview] 记录审核结果失败: activityId=%d, scene=%s, err=%v",
+			review.ActivityID, review.Scene, err)
+	}
+}
@@ -0,0 +1,60 @@
+package logic
+
+import (
+	"context"
+
+	"activity-platform/app/activity/model"
+	"activity-platform/app/activity/rpc/activity"
+	"activity-platform/app/activity/rpc/internal/svc"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateActivityFromTemplateLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCreateActivityFromTemplateLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateActivityFromTemplateLogic {
+	return &CreateActivityFromTemplateLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// CreateActivityFromTemplate 使用模板创建草稿活动
+//
+// 个人模板仅创建者可用，公共模板需处于上架状态；创建成功后模板使用次数 +1
+func (l *CreateActivityFromTemplateLogic) CreateActivityFromTemplate(in *activity.CreateActivityFromTemplateReq) (*activity.CreateActivityResp, error) {
+	// 1. 查询并校验模板
+	if in.OperatorId <= 0 {
+		return nil, errorx.ErrInvalidParams("操作者信息缺失")
+	}
+	tpl, err := findTemplate(l.ctx, l.svcCtx, in.TemplateId)
+	if err != nil {
+		return nil, err
+	}
+	if tpl.Scope == model.TemplateScopePrivate && tpl.OwnerID != uint64(in.OperatorId) {
+		return nil, errorx.New(errorx.CodeActivityTemplateNotFound)
+	}
+	if tpl.Status != model.TemplateStatusEnabled {
+		return nil, errorx.New(errorx.CodeActivityTemplateNotFound)
+	}
+
+	// 2. 创建草稿
+	resp, err := createDraftFromTemplate(l.ctx, l.svcCtx, tpl, in.OperatorId, in.ActivityStartTime, in.Title)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. 统计使用次数（失败不影响创建结果）
+	if err := l.svcCtx.TemplateModel.IncrUseCount(l.ctx, tpl.ID); err != nil {
+		l.Errorf("[Template] 更新模板使用次数失败: id=%d, err=%v", tpl.ID, err)
+	}
+
+	l.Infof("[Template] 使用模板创建活动: templateId=%d, activityId=%d, operatorId=%d", tpl.ID, resp.Id, in.OperatorId)
+	return resp, nil
+}
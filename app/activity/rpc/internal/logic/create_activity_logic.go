@@ -42,7 +42,18 @@ func (l *CreateActivityLogic) CreateActivity(in *activity.CreateActivityReq) (*a
 		return nil, err
 	}
 
-	// 2. 校验发布资格（信用分）—— 仅非草稿模式需要校验
+	// 2. 敏感词检测：拦截词直接拒绝，替换词掩码后继续创建
+	originalTitle := in.Title
+	screen := screenActivityText(l.svcCtx, in.Title, in.Content)
+	if screen.Blocked() {
+		l.Infof("[CreateActivity] 活动内容命中拦截词: organizerId=%d, title=%s", in.OrganizerId, in.Title)
+		recordContentReview(l.ctx, l.svcCtx, 0, uint64(in.OrganizerId), model.ContentSceneCreate, originalTitle, screen)
+		return nil, screen.ViolationError()
+	}
+	in.Title, in.Content = screen.Title, screen.Content
+
+	// 3. 校验发布资格（信用分）—— 仅非草稿模式需要校验
+	creditLevel := unknownCreditLevel
 	if !in.IsDraft {
 		canPublishResp, err := l.svcCtx.CreditRpc.CanPublish(l.ctx, &userpb.CanPublishReq{
 			UserId: in.OrganizerId,
@@ -58,9 +69,10 @@ func (l *CreateActivityLogic) CreateActivity(in *activity.CreateActivityReq) (*a
 		}
 		l.Infof("[CreateActivity] 信用分校验通过: userID=%d, score=%d, level=%d",
 			in.OrganizerId, canPublishResp.Score, canPublishResp.Level)
+		creditLevel = canPublishResp.Level
 	}
 
-	// 3. 获取组织者信息（昵称、头像）—— 非关键路径，失败不阻塞创建
+	// 4. 获取组织者信息（昵称、头像）—— 非关键路径，失败不阻塞创建
 	if in.OrganizerName == "" {
 		userInfoResp, err := l.svcCtx.UserBasicRpc.GetUserInfo(l.ctx, &userpb.GetUserInfoReq{
 			UserId: in.OrganizerId,
@@ -73,7 +85,7 @@ func (l *CreateActivityLogic) CreateActivity(in *activity.CreateActivityReq) (*a
 		}
 	}
 
-	// 4. 验证分类是否存在且启用
+	// 5. 验证分类是否存在且启用
 	_, err := l.svcCtx.CategoryModel.FindByID(l.ctx, uint64(in.CategoryId))
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -83,21 +95,64 @@ func (l *CreateActivityLogic) CreateActivity(in *activity.CreateActivityReq) (*a
 		return nil, errorx.ErrDBError(err)
 	}
 
-	// 5. 确定初始状态：草稿 or 已发布
+	// 6. 确定初始状态：草稿，或按审核策略判定为已发布 / 待审核
 	status := model.StatusDraft
+	var decision reviewDecision
 	if !in.IsDraft {
-		status = model.StatusPublished // MVP: 跳过待审核，直接发布
+		decision = decideReview(l.ctx, l.svcCtx, uint64(in.OrganizerId), creditLevel, screen.Flagged())
+		status = decision.TargetStatus()
 	}
 
-	// 6. 检查 DTM 是否可用
+	// 7. 检查 DTM 是否可用
+	var resp *activity.CreateActivityResp
 	if l.svcCtx.DTMClient != nil && l.svcCtx.DTMClient.IsHealthy() {
 		// 使用 DTM SAGA 创建活动
-		return l.createActivityWithDTM(in, int32(status))
+		resp, err = l.createActivityWithDTM(in, int32(status))
+	} else {
+		// DTM 不可用，使用本地事务（降级模式）
+		l.Infof("[CreateActivity] DTM 不可用，使用本地事务")
+		resp, err = l.createActivityLocal(in, int8(status))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// 8. 非草稿：写入审核单与状态日志
+	if !in.IsDraft {
+		l.openReview(uint64(resp.Id), uint64(in.OrganizerId), decision, screen.Flagged())
 	}
 
-	// DTM 不可用，使用本地事务（降级模式）
-	l.Infof("[CreateActivity] DTM 不可用，使用本地事务")
-	return l.createActivityLocal(in, int8(status))
+	// 9. 记录敏感词命中（送审词待管理员审核）
+	recordContentReview(l.ctx, l.svcCtx, uint64(resp.Id), uint64(in.OrganizerId), model.ContentSceneCreate, originalTitle, screen)
+	return resp, nil
+}
+
+// openReview 创建即发布时写入审核单与状态日志
+//
+// 活动已在 SAGA / 本地事务中落库，此处失败不回滚创建，仅记录错误；
+// 待审核活动缺失审核单时，管理员审核时会补建
+func (l *CreateActivityLogic) openReview(activityID, organizerID uint64, decision reviewDecision, flagged bool) {
+	act, err := l.svcCtx.ActivityModel.FindByID(l.ctx, activityID)
+	if err != nil {
+		l.Errorf("[CreateActivity] 查询新建活动失败，未写入审核单: id=%d, err=%v", activityID, err)
+		return
+	}
+	err = l.svcCtx.DB.WithContext(l.ctx).Transaction(func(tx *gorm.DB) error {
+		if err := l.svcCtx.ReviewModel.Create(l.ctx, tx, newActivityReview(l.svcCtx, act, decision, flagged)); err != nil {
+			return err
+		}
+		return l.svcCtx.StatusLogModel.Create(l.ctx, tx, &model.ActivityStatusLog{
+			ActivityID:   activityID,
+			FromStatus:   model.StatusDraft,
+			ToStatus:     act.Status,
+			OperatorID:   organizerID,
+			OperatorType: model.OperatorTypeUser,
+			Reason:       "创建并提交发布：" + decision.Reason,
+		})
+	})
+	if err != nil {
+		l.Errorf("[CreateActivity] 写入审核单失败: id=%d, err=%v", activityID, err)
+	}
 }
 
 // resolveCoverURL 通过 SysImage 服务解析封面图片 URL
@@ -201,11 +256,6 @@ func (l *CreateActivityLogic) createActivityWithDTM(in *activity.CreateActivityR
 		return nil, errorx.ErrDBError(err)
 	}
 
-	// 6. 异步同步到 ES（仅发布状态需要同步）
-	if createdActivity.Status == model.StatusPublished && l.svcCtx.SyncService != nil {
-		l.svcCtx.SyncService.IndexActivityAsync(&createdActivity)
-	}
-
 	// 7. 异步发布活动创建事件（仅已发布状态，草稿不需要通知）
 	if createdActivity.Status == model.StatusPublished && l.svcCtx.MsgProducer != nil {
 		l.svcCtx.MsgProducer.PublishActivityCreated(
@@ -268,7 +318,8 @@ func (l *CreateActivityLogic) createActivityLocal(in *activity.CreateActivityReq
 			}
 		}
 
-		return nil
+		// 记录变更事件（由 ChangeRelay 同步 ES）
+		return l.svcCtx.ChangeEventModel.Record(l.ctx, tx, activityData.ID, model.ChangeTypeCreate, model.ChangeSourceActivityRpc)
 	})
 
 	if err != nil {
@@ -278,11 +329,6 @@ func (l *CreateActivityLogic) createActivityLocal(in *activity.CreateActivityReq
 
 	l.Infof("活动创建成功（本地事务）: id=%d, title=%s, status=%d", activityData.ID, activityData.Title, activityData.Status)
 
-	// 异步同步到 ES（仅发布状态需要同步）
-	if activityData.Status == model.StatusPublished && l.svcCtx.SyncService != nil {
-		l.svcCtx.SyncService.IndexActivityAsync(activityData)
-	}
-
 	// 异步发布活动创建事件（仅已发布状态，草稿不需要通知）
 	if activityData.Status == model.StatusPublished && l.svcCtx.MsgProducer != nil {
 		l.svcCtx.MsgProducer.PublishActivityCreated(
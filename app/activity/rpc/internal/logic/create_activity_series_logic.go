@@ -0,0 +1,149 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"activity-platform/app/activity/model"
+	"activity-platform/app/activity/rpc/activity"
+	"activity-platform/app/activity/rpc/internal/recurrence"
+	"activity-platform/app/activity/rpc/internal/svc"
+	userpb "activity-platform/app/user/rpc/pb/pb"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+)
+
+type CreateActivitySeriesLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCreateActivitySeriesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateActivitySeriesLogic {
+	return &CreateActivitySeriesLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// CreateActivitySeries 按重复规则创建系列活动
+//
+// 模板中的时间字段为首场时间：报名开始/截止相对活动开始的提前量与活动时长由首场推算，
+// 后续场次沿用。系列保存后立即生成提前生成窗口内的场次，其余由 SeriesCron 陆续生成；
+// 首批场次生成失败不影响系列创建（下一轮重试）
+func (l *CreateActivitySeriesLogic) CreateActivitySeries(in *activity.CreateActivitySeriesReq) (*activity.CreateActivitySeriesResp, error) {
+	t := in.Template
+	if t == nil {
+		return nil, errorx.ErrInvalidParams("请填写活动信息")
+	}
+	t.IsDraft = false
+
+	// 1. 解析重复规则
+	rule, err := recurrence.Parse(in.Rrule)
+	if err != nil {
+		return nil, errorx.NewWithMessage(errorx.CodeActivitySeriesRuleInvalid, err.Error())
+	}
+
+	// 2. 首场参数校验（与单场创建一致）
+	if err := NewCreateActivityLogic(l.ctx, l.svcCtx).validateParams(t); err != nil {
+		return nil, err
+	}
+
+	// 3. 计算场次
+	maxOccurrences := l.svcCtx.Config.Series.MaxOccurrences
+	starts := rule.Occurrences(time.Unix(t.ActivityStartTime, 0), maxOccurrences+1)
+	if len(starts) == 0 {
+		return nil, errorx.NewWithMessage(errorx.CodeActivitySeriesRuleInvalid, "按该规则没有可生成的场次")
+	}
+	if len(starts) > maxOccurrences {
+		return nil, errorx.NewWithMessage(errorx.CodeActivitySeriesRuleInvalid,
+			fmt.Sprintf("系列活动最多 %d 场，请提前截止日期或减少场次", maxOccurrences))
+	}
+
+	// 4. 敏感词检测（拦截词直接拒绝；替换词由各场次创建时处理）
+	if screen := screenActivityText(l.svcCtx, t.Title, t.Content); screen.Blocked() {
+		l.Infof("[CreateActivitySeries] 活动内容命中拦截词: organizerId=%d, title=%s", t.OrganizerId, t.Title)
+		return nil, screen.ViolationError()
+	}
+
+	// 5. 发布资格与分类
+	canPublishResp, err := l.svcCtx.CreditRpc.CanPublish(l.ctx, &userpb.CanPublishReq{UserId: t.OrganizerId})
+	if err != nil {
+		l.Errorf("[CreateActivitySeries] 信用分校验失败: userID=%d, err=%v", t.OrganizerId, err)
+		return nil, errorx.NewWithMessage(errorx.CodeInternalError, "信用分校验服务异常，请稍后重试")
+	}
+	if !canPublishResp.Allowed {
+		return nil, errorx.NewWithMessage(errorx.CodeCreditCannotPublish, canPublishResp.Reason)
+	}
+	if _, err := l.svcCtx.CategoryModel.FindByID(l.ctx, uint64(t.CategoryId)); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errorx.New(errorx.CodeCategoryNotFound)
+		}
+		l.Errorf("查询分类失败: %v", err)
+		return nil, errorx.ErrDBError(err)
+	}
+
+	// 组织者昵称、头像快照（非关键路径，失败不阻塞创建）
+	if t.OrganizerName == "" {
+		userInfoResp, err := l.svcCtx.UserBasicRpc.GetUserInfo(l.ctx, &userpb.GetUserInfoReq{UserId: t.OrganizerId})
+		if err != nil {
+			l.Errorf("[CreateActivitySeries] 获取用户信息失败（不影响创建）: userID=%d, err=%v", t.OrganizerId, err)
+		} else if userInfoResp.UserInfo != nil {
+			t.OrganizerName = userInfoResp.UserInfo.Nickname
+			t.OrganizerAvatar = userInfoResp.UserInfo.AvatarUrl
+		}
+	}
+
+	// 6. 保存系列
+	series := &model.ActivitySeries{
+		OrganizerID:          uint64(t.OrganizerId),
+		Status:               model.SeriesStatusActive,
+		RRule:                rule.String(),
+		FirstStartTime:       t.ActivityStartTime,
+		DurationSeconds:      t.ActivityEndTime - t.ActivityStartTime,
+		RegisterOpenBefore:   t.ActivityStartTime - t.RegisterStartTime,
+		RegisterCloseBefore:  t.ActivityStartTime - t.RegisterEndTime,
+		AllowSeriesRegister:  in.AllowSeriesRegister,
+		Title:                t.Title,
+		Description:          t.Content,
+		CoverImageID:         t.CoverImageId,
+		CoverType:            int8(t.CoverType),
+		CategoryID:           uint64(t.CategoryId),
+		ContactPhone:         t.ContactPhone,
+		Location:             t.Location,
+		AddressDetail:        t.AddressDetail,
+		Longitude:            t.Longitude,
+		Latitude:             t.Latitude,
+		MaxParticipants:      uint32(t.MaxParticipants),
+		RequireApproval:      t.RequireApproval,
+		RequireStudentVerify: t.RequireStudentVerify,
+		MinCreditScore:       int(t.MinCreditScore),
+		TagIDs:               model.EncodeTagIDs(t.TagIds),
+		OrganizerName:        t.OrganizerName,
+		OrganizerAvatar:      t.OrganizerAvatar,
+	}
+	if err := l.svcCtx.SeriesModel.Create(l.ctx, series); err != nil {
+		l.Errorf("创建系列活动失败: organizerId=%d, err=%v", t.OrganizerId, err)
+		return nil, errorx.ErrDBError(err)
+	}
+
+	// 7. 立即生成首批场次
+	activityIDs, err := NewSeriesScheduler(l.ctx, l.svcCtx).Generate(series)
+	if err != nil {
+		l.Errorf("[CreateActivitySeries] 首批场次生成失败（稍后重试）: seriesId=%d, err=%v", series.ID, err)
+	}
+
+	l.Infof("系列活动创建成功: id=%d, organizerId=%d, rrule=%s, total=%d, generated=%d",
+		series.ID, series.OrganizerID, series.RRule, len(starts), len(activityIDs))
+
+	return &activity.CreateActivitySeriesResp{
+		SeriesId:         int64(series.ID),
+		ActivityIds:      activityIDs,
+		TotalOccurrences: int32(len(starts)),
+	}, nil
+}
@@ -0,0 +1,52 @@
+package logic
+
+import (
+	"context"
+
+	"activity-platform/app/activity/rpc/activity"
+	"activity-platform/app/activity/rpc/internal/svc"
+	userpb "activity-platform/app/user/rpc/pb/pb"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateTagLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCreateTagLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateTagLogic {
+	return &CreateTagLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// CreateTag 创建标签（管理员）
+//
+// 标签归属用户服务，创建成功后立即写入 tag_cache，活动编辑页无需等待定时同步
+func (l *CreateTagLogic) CreateTag(in *activity.CreateTagReq) (*activity.AdminTagResp, error) {
+	name, color, icon, desc := trimTagReq(in.Name, in.Color, in.Icon, in.Description)
+	if name == "" {
+		return nil, errorx.ErrInvalidParams("标签名称不能为空")
+	}
+
+	resp, err := l.svcCtx.TagRpc.CreateInterestTag(l.ctx, &userpb.CreateInterestTagReq{
+		Name:        name,
+		Color:       color,
+		Icon:        icon,
+		Description: desc,
+	})
+	if err != nil {
+		l.Errorf("[Tag] 创建标签失败: name=%s, err=%v", name, err)
+		return nil, errorx.FromError(err)
+	}
+
+	propagateTagChange(l.ctx, l.svcCtx, resp.Tag)
+
+	l.Infof("[Tag] 创建标签: id=%d, name=%s, operatorId=%d", resp.Tag.GetId(), name, in.OperatorId)
+	return &activity.AdminTagResp{Tag: toAdminTag(l.ctx, l.svcCtx, resp.Tag)}, nil
+}
@@ -95,7 +95,8 @@ func (l *DeleteActivityLogic) DeleteActivity(in *activity.DeleteActivityReq) (*a
 			}
 		}
 
-		return nil
+		// 5.5 记录变更事件（由 ChangeRelay 从 ES 删除）
+		return l.svcCtx.ChangeEventModel.Record(l.ctx, tx, uint64(in.Id), model.ChangeTypeDelete, model.ChangeSourceActivityRpc)
 	})
 
 	if err != nil {
@@ -111,11 +112,6 @@ func (l *DeleteActivityLogic) DeleteActivity(in *activity.DeleteActivityReq) (*a
 		}
 	}
 
-	// 异步从 ES 删除文档
-	if l.svcCtx.SyncService != nil {
-		l.svcCtx.SyncService.DeleteActivityAsync(uint64(in.Id))
-	}
-
 	// 有报名记录时，发布删除活动信用事件（扣组织者信用分）
 	if activityData.CurrentParticipants > 0 && l.svcCtx.MsgProducer != nil {
 		l.svcCtx.MsgProducer.PublishCreditEvent(
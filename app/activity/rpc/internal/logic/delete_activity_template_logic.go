@@ -0,0 +1,55 @@
+package logic
+
+import (
+	"context"
+
+	"activity-platform/app/activity/model"
+	"activity-platform/app/activity/rpc/activity"
+	"activity-platform/app/activity/rpc/internal/svc"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeleteActivityTemplateLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewDeleteActivityTemplateLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteActivityTemplateLogic {
+	return &DeleteActivityTemplateLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// DeleteActivityTemplate 删除模板
+//
+// 用户只能删除自己的个人模板，管理员只能删除公共模板；删除后封面引用计数 -1。
+// 已由模板创建的活动不受影响
+func (l *DeleteActivityTemplateLogic) DeleteActivityTemplate(in *activity.DeleteActivityTemplateReq) (*activity.DeleteActivityTemplateResp, error) {
+	// 1. 查询并校验权限
+	tpl, err := findTemplate(l.ctx, l.svcCtx, in.Id)
+	if err != nil {
+		return nil, err
+	}
+	if in.IsAdmin {
+		if tpl.Scope != model.TemplateScopePublic {
+			return nil, errorx.New(errorx.CodeActivityTemplateNotFound)
+		}
+	} else if tpl.Scope != model.TemplateScopePrivate || tpl.OwnerID != uint64(in.OperatorId) {
+		return nil, errorx.New(errorx.CodeActivityTemplateNotFound)
+	}
+
+	// 2. 删除并释放封面引用
+	if err := l.svcCtx.TemplateModel.Delete(l.ctx, tpl.ID); err != nil {
+		l.Errorf("删除活动模板失败: id=%d, err=%v", tpl.ID, err)
+		return nil, errorx.ErrDBError(err)
+	}
+	adjustCoverRef(l.ctx, l.svcCtx, tpl.CoverImageID, -1)
+
+	l.Infof("[Template] 删除模板: id=%d, scope=%d, operatorId=%d", tpl.ID, tpl.Scope, in.OperatorId)
+	return &activity.DeleteActivityTemplateResp{}, nil
+}
@@ -0,0 +1,56 @@
+package logic
+
+import (
+	"context"
+
+	"activity-platform/app/activity/rpc/activity"
+	"activity-platform/app/activity/rpc/internal/svc"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeleteCategoryLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewDeleteCategoryLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteCategoryLogic {
+	return &DeleteCategoryLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// DeleteCategory 删除分类（管理员）
+//
+// 仍有活动（含已删除、可恢复的活动）引用时拒绝删除，避免活动分类悬空，可改为禁用
+func (l *DeleteCategoryLogic) DeleteCategory(in *activity.DeleteCategoryReq) (*activity.DeleteCategoryResp, error) {
+	// 1. 查询分类
+	cat, err := findCategoryAny(l.ctx, l.svcCtx, in.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. 检查引用
+	count, err := l.svcCtx.CategoryModel.CountActivities(l.ctx, cat.ID)
+	if err != nil {
+		l.Errorf("统计分类活动数失败: id=%d, err=%v", cat.ID, err)
+		return nil, errorx.ErrDBError(err)
+	}
+	if count > 0 {
+		return nil, errorx.New(errorx.CodeCategoryInUse)
+	}
+
+	// 3. 删除并失效缓存
+	if err := l.svcCtx.CategoryModel.Delete(l.ctx, cat.ID); err != nil {
+		l.Errorf("删除分类失败: id=%d, err=%v", cat.ID, err)
+		return nil, errorx.ErrDBError(err)
+	}
+	propagateCategoryChange(l.ctx, l.svcCtx, cat.ID, false)
+
+	l.Infof("[Category] 删除分类: id=%d, name=%s, operatorId=%d", cat.ID, cat.Name, in.OperatorId)
+	return &activity.DeleteCategoryResp{}, nil
+}
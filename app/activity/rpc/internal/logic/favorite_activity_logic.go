@@ -0,0 +1,85 @@
+package logic
+
+import (
+	"context"
+	"errors"
+
+	"activity-platform/app/activity/model"
+	"activity-platform/app/activity/rpc/activity"
+	"activity-platform/app/activity/rpc/internal/svc"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type FavoriteActivityLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewFavoriteActivityLogic(ctx context.Context, svcCtx *svc.ServiceContext) *FavoriteActivityLogic {
+	return &FavoriteActivityLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// FavoriteActivity 收藏/取消收藏活动
+//
+// 收藏与取消均幂等；收藏后登记报名截止提醒（报名截止前提醒收藏但未报名的用户），
+// 取消收藏时移除提醒。提醒登记失败只记录日志，不影响收藏结果。
+func (l *FavoriteActivityLogic) FavoriteActivity(in *activity.FavoriteActivityReq) (*activity.FavoriteActivityResp, error) {
+	// 1. 参数校验
+	if in.ActivityId <= 0 {
+		return nil, errorx.ErrInvalidParams("活动ID无效")
+	}
+	if in.UserId <= 0 {
+		return nil, errorx.ErrInvalidParams("用户信息缺失")
+	}
+	activityID, userID := uint64(in.ActivityId), uint64(in.UserId)
+
+	// 2. 取消收藏
+	if !in.Favorite {
+		if _, err := l.svcCtx.FavoriteModel.Remove(l.ctx, activityID, userID); err != nil {
+			l.Errorf("取消收藏失败: activityId=%d, userId=%d, err=%v", activityID, userID, err)
+			return nil, errorx.ErrDBError(err)
+		}
+		if err := l.svcCtx.Reminders.CancelFavorite(l.ctx, activityID, userID); err != nil {
+			l.Infof("[WARNING] 移除报名截止提醒失败: activityId=%d, userId=%d, err=%v", activityID, userID, err)
+		}
+		return &activity.FavoriteActivityResp{Favorited: false}, nil
+	}
+
+	// 3. 收藏：仅公开可见的活动（已发布及之后的状态）可收藏
+	activityData, err := l.svcCtx.ActivityModel.FindByID(l.ctx, activityID)
+	if err != nil {
+		if errors.Is(err, model.ErrActivityNotFound) {
+			return nil, errorx.New(errorx.CodeActivityNotFound)
+		}
+		l.Errorf("查询活动失败: id=%d, err=%v", activityID, err)
+		return nil, errorx.ErrDBError(err)
+	}
+	if !activityData.IsPublic() {
+		return nil, errorx.New(errorx.CodeActivityNotFound)
+	}
+
+	added, err := l.svcCtx.FavoriteModel.Add(l.ctx, activityID, userID)
+	if err != nil {
+		l.Errorf("收藏活动失败: activityId=%d, userId=%d, err=%v", activityID, userID, err)
+		return nil, errorx.ErrDBError(err)
+	}
+
+	// 4. 登记报名截止提醒（仍在报名中的活动）
+	if activityData.Status == model.StatusPublished {
+		if err := l.svcCtx.Reminders.ScheduleFavorite(l.ctx, activityData, userID); err != nil {
+			l.Infof("[WARNING] 登记报名截止提醒失败: activityId=%d, userId=%d, err=%v", activityID, userID, err)
+		}
+	}
+
+	if added {
+		l.Infof("[Favorite] 收藏活动: activityId=%d, userId=%d", activityID, userID)
+	}
+	return &activity.FavoriteActivityResp{Favorited: true}, nil
+}
@@ -168,6 +168,8 @@ func (l *GetActivityLogic) buildActivityDetail(act *model.Activity, categoryName
 		Version:                int32(act.Version),
 		RegistrationStatus:     regStatus,
 		RegistrationStatusText: regStatusText,
+		RatingAvg:              act.RatingAvg,
+		RatingCount:            int32(act.RatingCount),
 	}
 }
 
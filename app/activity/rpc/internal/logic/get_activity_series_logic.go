@@ -0,0 +1,118 @@
+package logic
+
+import (
+	"context"
+
+	"activity-platform/app/activity/model"
+	"activity-platform/app/activity/rpc/activity"
+	"activity-platform/app/activity/rpc/internal/svc"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetActivitySeriesLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetActivitySeriesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetActivitySeriesLogic {
+	return &GetActivitySeriesLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// GetActivitySeries 系列详情
+//
+// 组织者可看到全部场次；其他用户只能看到公开状态（已发布/进行中/已结束）的场次
+func (l *GetActivitySeriesLogic) GetActivitySeries(in *activity.GetActivitySeriesReq) (*activity.GetActivitySeriesResp, error) {
+	series, err := findSeries(l.ctx, l.svcCtx, in.Id)
+	if err != nil {
+		return nil, err
+	}
+	isOrganizer := in.ViewerId > 0 && uint64(in.ViewerId) == series.OrganizerID
+
+	// 1. 场次与对应活动
+	occurrences, err := l.svcCtx.SeriesModel.ListOccurrences(l.ctx, series.ID)
+	if err != nil {
+		l.Errorf("查询系列场次失败: seriesId=%d, err=%v", series.ID, err)
+		return nil, errorx.ErrDBError(err)
+	}
+	activityIDs := make([]uint64, len(occurrences))
+	for i, occ := range occurrences {
+		activityIDs[i] = occ.ActivityID
+	}
+	activities, err := l.svcCtx.ActivityModel.FindByIDs(l.ctx, activityIDs)
+	if err != nil {
+		l.Errorf("查询系列场次活动失败: seriesId=%d, err=%v", series.ID, err)
+		return nil, errorx.ErrDBError(err)
+	}
+	activityMap := make(map[uint64]*model.Activity, len(activities))
+	for i := range activities {
+		activityMap[activities[i].ID] = &activities[i]
+	}
+
+	var coverURL string
+	list := make([]*activity.SeriesOccurrence, 0, len(occurrences))
+	for _, occ := range occurrences {
+		act, ok := activityMap[occ.ActivityID]
+		if !ok || (!isOrganizer && !act.IsPublic()) {
+			continue
+		}
+		coverURL = act.CoverURL
+		list = append(list, &activity.SeriesOccurrence{
+			Index:               int32(occ.OccurrenceIndex),
+			ActivityId:          int64(act.ID),
+			ActivityStartTime:   act.ActivityStartTime,
+			ActivityEndTime:     act.ActivityEndTime,
+			Status:              int32(act.Status),
+			StatusText:          act.StatusText(),
+			Overridden:          occ.Overridden,
+			CurrentParticipants: int32(act.CurrentParticipants),
+			MaxParticipants:     int32(act.MaxParticipants),
+		})
+	}
+
+	// 2. 总场次与整期报名
+	var total int
+	if starts, err := seriesOccurrenceTimes(l.svcCtx, series); err == nil {
+		total = len(starts)
+	}
+	subscriberCount, err := l.svcCtx.SeriesRegistrationModel.CountSubscribers(l.ctx, series.ID)
+	if err != nil {
+		l.Errorf("统计整期报名人数失败: seriesId=%d, err=%v", series.ID, err)
+	}
+	var subscribed bool
+	if in.ViewerId > 0 {
+		if subscribed, err = l.svcCtx.SeriesRegistrationModel.IsSubscribed(l.ctx, series.ID, uint64(in.ViewerId)); err != nil {
+			l.Errorf("查询整期报名状态失败: seriesId=%d, userId=%d, err=%v", series.ID, in.ViewerId, err)
+		}
+	}
+
+	return &activity.GetActivitySeriesResp{
+		Series: &activity.ActivitySeriesInfo{
+			Id:                  int64(series.ID),
+			Title:               series.Title,
+			Rrule:               series.RRule,
+			Status:              int32(series.Status),
+			StatusText:          model.SeriesStatusText[series.Status],
+			OrganizerId:         int64(series.OrganizerID),
+			OrganizerName:       series.OrganizerName,
+			CoverUrl:            coverURL,
+			CategoryId:          int64(series.CategoryID),
+			Location:            series.Location,
+			FirstStartTime:      series.FirstStartTime,
+			DurationSeconds:     series.DurationSeconds,
+			AllowSeriesRegister: series.AllowSeriesRegister,
+			TotalOccurrences:    int32(total),
+			GeneratedCount:      int32(series.GeneratedCount),
+			SubscriberCount:     subscriberCount,
+			CreatedAt:           series.CreatedAt,
+		},
+		Occurrences: list,
+		Subscribed:  subscribed,
+	}, nil
+}
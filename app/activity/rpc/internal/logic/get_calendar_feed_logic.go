@@ -0,0 +1,109 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"activity-platform/app/activity/model"
+	"activity-platform/app/activity/rpc/activity"
+	"activity-platform/app/activity/rpc/internal/ical"
+	"activity-platform/app/activity/rpc/internal/svc"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetCalendarFeedLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetCalendarFeedLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetCalendarFeedLogic {
+	return &GetCalendarFeedLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// GetCalendarFeed 按订阅令牌渲染用户的 iCalendar 订阅内容
+//
+// 包含报名成功的活动；已取消的活动、已取消报名（活动未结束）的活动以 STATUS:CANCELLED 输出，
+// 让客户端删除/划掉此前同步的事件。结束超过 PastDays 天的活动不再输出
+func (l *GetCalendarFeedLogic) GetCalendarFeed(in *activity.GetCalendarFeedReq) (*activity.GetCalendarFeedResp, error) {
+	if in.Token == "" {
+		return nil, errorx.New(errorx.CodeCalendarFeedInvalid)
+	}
+	feedToken, err := l.svcCtx.CalendarTokenModel.FindByToken(l.ctx, in.Token)
+	if err != nil {
+		if errors.Is(err, model.ErrCalendarTokenNotFound) {
+			return nil, errorx.New(errorx.CodeCalendarFeedInvalid)
+		}
+		l.Errorf("查询日历订阅令牌失败: err=%v", err)
+		return nil, errorx.ErrDBError(err)
+	}
+
+	content, err := l.svcCtx.CalendarCache.Get(l.ctx, feedToken.UserID, func(ctx context.Context) (string, error) {
+		return l.render(feedToken.UserID)
+	})
+	if err != nil {
+		l.Errorf("渲染日历订阅失败: userId=%d, err=%v", feedToken.UserID, err)
+		return nil, errorx.ErrDBError(err)
+	}
+
+	return &activity.GetCalendarFeedResp{Content: content}, nil
+}
+
+// render 查询报名记录并渲染订阅内容
+func (l *GetCalendarFeedLogic) render(userID uint64) (string, error) {
+	cfg := l.svcCtx.Config.Calendar
+	regs, err := l.svcCtx.ActivityRegistrationModel.ListCalendarRegistrations(l.ctx, userID, cfg.MaxEvents)
+	if err != nil {
+		return "", err
+	}
+
+	ids := make([]uint64, 0, len(regs))
+	for _, reg := range regs {
+		ids = append(ids, reg.ActivityID)
+	}
+	activities, err := l.svcCtx.ActivityModel.FindByIDs(l.ctx, ids)
+	if err != nil {
+		return "", err
+	}
+	activityMap := make(map[uint64]*model.Activity, len(activities))
+	for i := range activities {
+		activityMap[activities[i].ID] = &activities[i]
+	}
+
+	now := time.Now().Unix()
+	since := now - int64(cfg.PastDays)*86400
+	events := make([]ical.Event, 0, len(regs))
+	for _, reg := range regs {
+		act, ok := activityMap[reg.ActivityID]
+		if !ok || act.ActivityEndTime < since {
+			continue
+		}
+		if !act.IsPublic() && act.Status != model.StatusCancelled {
+			continue
+		}
+		withdrawn := reg.Status == model.RegistrationStatusCanceled
+		// 已结束活动的取消报名记录没有同步意义
+		if withdrawn && act.ActivityEndTime < now {
+			continue
+		}
+		events = append(events, buildCalendarEvent(l.svcCtx, act, withdrawn, reg.UpdatedAt))
+	}
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].Start.Before(events[j].Start)
+	})
+
+	calendar := &ical.Calendar{
+		Name:            calendarFeedName,
+		RefreshInterval: calendarFeedRefreshInterval,
+		Events:          events,
+	}
+	return calendar.Encode(), nil
+}
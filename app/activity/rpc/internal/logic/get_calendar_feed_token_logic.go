@@ -0,0 +1,62 @@
+package logic
+
+import (
+	"context"
+
+	"activity-platform/app/activity/rpc/activity"
+	"activity-platform/app/activity/rpc/internal/svc"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetCalendarFeedTokenLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetCalendarFeedTokenLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetCalendarFeedTokenLogic {
+	return &GetCalendarFeedTokenLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// GetCalendarFeedToken 获取用户日历订阅令牌
+//
+// 首次获取时生成；reset=true 时重新生成，旧订阅地址立即失效
+func (l *GetCalendarFeedTokenLogic) GetCalendarFeedToken(in *activity.GetCalendarFeedTokenReq) (*activity.GetCalendarFeedTokenResp, error) {
+	if in.UserId <= 0 {
+		return nil, errorx.ErrInvalidParams("用户信息缺失")
+	}
+	userID := uint64(in.UserId)
+
+	if !in.Reset_ {
+		feedToken, err := l.svcCtx.CalendarTokenModel.FindByUserID(l.ctx, userID)
+		if err != nil {
+			l.Errorf("查询日历订阅令牌失败: userId=%d, err=%v", userID, err)
+			return nil, errorx.ErrDBError(err)
+		}
+		if feedToken != nil {
+			return &activity.GetCalendarFeedTokenResp{Token: feedToken.Token}, nil
+		}
+	}
+
+	token, err := generateCalendarToken()
+	if err != nil {
+		l.Errorf("生成日历订阅令牌失败: userId=%d, err=%v", userID, err)
+		return nil, errorx.New(errorx.CodeInternalError)
+	}
+	if err := l.svcCtx.CalendarTokenModel.Upsert(l.ctx, userID, token); err != nil {
+		l.Errorf("保存日历订阅令牌失败: userId=%d, err=%v", userID, err)
+		return nil, errorx.ErrDBError(err)
+	}
+	if in.Reset_ {
+		_ = l.svcCtx.CalendarCache.Invalidate(l.ctx, userID)
+		l.Infof("日历订阅令牌已重置: userId=%d", userID)
+	}
+
+	return &activity.GetCalendarFeedTokenResp{Token: token}, nil
+}
@@ -0,0 +1,55 @@
+package logic
+
+import (
+	"context"
+	"errors"
+
+	"activity-platform/app/activity/model"
+	"activity-platform/app/activity/rpc/activity"
+	"activity-platform/app/activity/rpc/internal/svc"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetEligibilityRulesLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetEligibilityRulesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetEligibilityRulesLogic {
+	return &GetEligibilityRulesLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// GetEligibilityRules 获取活动报名资格规则
+func (l *GetEligibilityRulesLogic) GetEligibilityRules(in *activity.GetEligibilityRulesReq) (*activity.GetEligibilityRulesResp, error) {
+	if in.ActivityId <= 0 {
+		return nil, errorx.ErrInvalidParams("活动ID无效")
+	}
+
+	activityData, err := l.svcCtx.ActivityModel.FindByID(l.ctx, uint64(in.ActivityId))
+	if err != nil {
+		if errors.Is(err, model.ErrActivityNotFound) {
+			return nil, errorx.New(errorx.CodeActivityNotFound)
+		}
+		l.Errorf("查询活动失败: id=%d, err=%v", in.ActivityId, err)
+		return nil, errorx.ErrDBError(err)
+	}
+
+	rules, err := l.svcCtx.EligibilityRuleModel.FindByActivityID(l.ctx, activityData.ID)
+	if err != nil {
+		l.Errorf("查询报名规则失败: activityId=%d, err=%v", in.ActivityId, err)
+		return nil, errorx.ErrDBError(err)
+	}
+
+	return &activity.GetEligibilityRulesResp{
+		Rules:                toEligibilityRulePbList(rules),
+		MinCreditScore:       int32(activityData.MinCreditScore),
+		RequireStudentVerify: activityData.RequireStudentVerify,
+	}, nil
+}
@@ -0,0 +1,121 @@
+package logic
+
+import (
+	"context"
+	"errors"
+
+	"activity-platform/app/activity/model"
+	"activity-platform/app/activity/rpc/activity"
+	"activity-platform/app/activity/rpc/internal/svc"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetFeedbackSummaryLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetFeedbackSummaryLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetFeedbackSummaryLogic {
+	return &GetFeedbackSummaryLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// GetFeedbackSummary 活动评价汇总（仅组织者）
+//
+// 业务逻辑：
+//  1. 参数校验、权限校验（仅组织者）
+//  2. 实时统计评分分布（与 activities 冗余字段一致，此处额外返回分布）
+//  3. 分页返回公开短评；待审核短评只返回数量，审核通过前不展示
+func (l *GetFeedbackSummaryLogic) GetFeedbackSummary(in *activity.GetFeedbackSummaryReq) (*activity.GetFeedbackSummaryResp, error) {
+	// 1. 参数校验
+	if in.ActivityId <= 0 {
+		return nil, errorx.ErrInvalidParams("活动ID无效")
+	}
+	if in.OperatorId <= 0 {
+		return nil, errorx.ErrInvalidParams("操作者信息缺失")
+	}
+	page := int(in.Page)
+	if page <= 0 {
+		page = model.DefaultPage
+	}
+	pageSize := int(in.PageSize)
+	if pageSize <= 0 {
+		pageSize = model.DefaultPageSize
+	}
+	if pageSize > model.MaxPageSize {
+		pageSize = model.MaxPageSize
+	}
+
+	// 2. 查询活动 + 权限校验
+	activityData, err := l.svcCtx.ActivityModel.FindByID(l.ctx, uint64(in.ActivityId))
+	if err != nil {
+		if errors.Is(err, model.ErrActivityNotFound) {
+			return nil, errorx.New(errorx.CodeActivityNotFound)
+		}
+		l.Errorf("查询活动失败: id=%d, err=%v", in.ActivityId, err)
+		return nil, errorx.ErrDBError(err)
+	}
+	if activityData.OrganizerID != uint64(in.OperatorId) {
+		l.Infof("[权限拒绝] 无权限查看评价汇总: activityId=%d, organizerId=%d, operatorId=%d",
+			in.ActivityId, activityData.OrganizerID, in.OperatorId)
+		return nil, errorx.New(errorx.CodeActivityPermissionDenied)
+	}
+
+	// 3. 评分分布
+	summary, err := l.svcCtx.FeedbackModel.SummaryByActivity(l.ctx, nil, activityData.ID)
+	if err != nil {
+		l.Errorf("统计评分失败: activityId=%d, err=%v", in.ActivityId, err)
+		return nil, errorx.ErrDBError(err)
+	}
+
+	// 4. 待审核短评数（查询失败不影响主流程）
+	pending, err := l.svcCtx.FeedbackModel.CountPendingComments(l.ctx, activityData.ID)
+	if err != nil {
+		l.Infof("[WARNING] 统计待审核短评失败: activityId=%d, err=%v", in.ActivityId, err)
+	}
+
+	// 5. 公开短评
+	comments, total, err := l.svcCtx.FeedbackModel.ListComments(l.ctx, activityData.ID, page, pageSize)
+	if err != nil {
+		l.Errorf("查询评价短评失败: activityId=%d, err=%v", in.ActivityId, err)
+		return nil, errorx.ErrDBError(err)
+	}
+
+	list := make([]*activity.FeedbackComment, 0, len(comments))
+	for _, c := range comments {
+		list = append(list, &activity.FeedbackComment{
+			Id:        int64(c.ID),
+			UserId:    int64(c.UserID),
+			Rating:    int32(c.Rating),
+			Comment:   c.Comment,
+			CreatedAt: c.CreatedAt,
+			UpdatedAt: c.UpdatedAt,
+		})
+	}
+
+	totalPages := int(total) / pageSize
+	if int(total)%pageSize > 0 {
+		totalPages++
+	}
+
+	return &activity.GetFeedbackSummaryResp{
+		RatingAvg:           summary.Avg,
+		RatingCount:         int32(summary.Count),
+		Distribution:        summary.Distribution[:],
+		PendingCommentCount: pending,
+		Comments:            list,
+		Pagination: &activity.Pagination{
+			Page:       int32(page),
+			PageSize:   int32(pageSize),
+			Total:      total,
+			TotalPages: int32(totalPages),
+		},
+		FeedbackDeadline: feedbackDeadline(l.svcCtx, activityData),
+	}, nil
+}
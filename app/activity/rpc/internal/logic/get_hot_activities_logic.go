@@ -181,6 +181,8 @@ func (l *GetHotActivitiesLogic) buildActivityListItem(
 		Tags:                   l.convertTagCaches(tags),
 		ViewCount:              int64(act.ViewCount),
 		CreatedAt:              act.CreatedAt,
+		RatingAvg:              act.RatingAvg,
+		RatingCount:            int32(act.RatingCount),
 		RegistrationStatus:     regStatus,
 		RegistrationStatusText: regStatusText,
 	}
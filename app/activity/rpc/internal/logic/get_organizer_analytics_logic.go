@@ -0,0 +1,97 @@
+package logic
+
+import (
+	"context"
+
+	"activity-platform/app/activity/rpc/activity"
+	"activity-platform/app/activity/rpc/internal/svc"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetOrganizerAnalyticsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetOrganizerAnalyticsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetOrganizerAnalyticsLogic {
+	return &GetOrganizerAnalyticsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// GetOrganizerAnalytics 组织者查看全部活动的数据总览
+//
+// 累计指标与报名者构成按组织者汇总各活动的统计（同一用户报名多个活动会重复计入构成），
+// 每日趋势按日期汇总各活动的每日统计
+func (l *GetOrganizerAnalyticsLogic) GetOrganizerAnalytics(in *activity.GetOrganizerAnalyticsReq) (*activity.GetOrganizerAnalyticsResp, error) {
+	if in.OrganizerId <= 0 {
+		return nil, errorx.ErrInvalidParams("组织者信息缺失")
+	}
+	startDate, endDate, err := parseAnalyticsRange(in.StartDate, in.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	organizerID := uint64(in.OrganizerId)
+
+	totals, err := l.svcCtx.AnalyticsModel.SumSummariesByOrganizer(l.ctx, organizerID)
+	if err != nil {
+		l.Errorf("汇总组织者统计失败: organizerId=%d, err=%v", organizerID, err)
+		return nil, errorx.ErrDBError(err)
+	}
+	daily, err := l.svcCtx.AnalyticsModel.ListOrganizerDaily(l.ctx, organizerID, startDate, endDate)
+	if err != nil {
+		l.Errorf("查询组织者每日统计失败: organizerId=%d, err=%v", organizerID, err)
+		return nil, errorx.ErrDBError(err)
+	}
+	audience, err := l.svcCtx.AnalyticsModel.ListOrganizerAudience(l.ctx, organizerID)
+	if err != nil {
+		l.Errorf("查询组织者报名者构成失败: organizerId=%d, err=%v", organizerID, err)
+		return nil, errorx.ErrDBError(err)
+	}
+	top, err := l.svcCtx.AnalyticsModel.ListSummariesByOrganizer(l.ctx, organizerID, analyticsTopActivities)
+	if err != nil {
+		l.Errorf("查询组织者活动统计失败: organizerId=%d, err=%v", organizerID, err)
+		return nil, errorx.ErrDBError(err)
+	}
+
+	// 活动标题（查询失败只影响展示）
+	titles := make(map[uint64]string, len(top))
+	if len(top) > 0 {
+		ids := make([]uint64, 0, len(top))
+		for _, s := range top {
+			ids = append(ids, s.ActivityID)
+		}
+		if activities, err := l.svcCtx.ActivityModel.FindByIDs(l.ctx, ids); err != nil {
+			l.Errorf("查询活动标题失败: organizerId=%d, err=%v", organizerID, err)
+		} else {
+			for _, a := range activities {
+				titles[a.ID] = a.Title
+			}
+		}
+	}
+	topActivities := make([]*activity.ActivityAnalyticsBrief, 0, len(top))
+	for _, s := range top {
+		topActivities = append(topActivities, &activity.ActivityAnalyticsBrief{
+			ActivityId:     int64(s.ActivityID),
+			Title:          titles[s.ActivityID],
+			UniqueVisitors: s.UV,
+			Registrations:  s.Registrations,
+			CheckIns:       s.CheckIns,
+			AttendanceRate: s.AttendanceRate,
+		})
+	}
+
+	return &activity.GetOrganizerAnalyticsResp{
+		ActivityCount: totals.Activities,
+		Funnel: toAnalyticsFunnel(totals.PV, totals.UV, totals.Registrations, totals.Cancellations,
+			totals.ActiveRegistrations, totals.CheckIns, totals.NoShows),
+		Daily:         toAnalyticsDaily(daily, startDate, endDate),
+		Audience:      toAudienceBreakdown(audience, totals.VerifiedRegistrants),
+		TopActivities: topActivities,
+	}, nil
+}
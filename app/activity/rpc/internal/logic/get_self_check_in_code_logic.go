@@ -0,0 +1,62 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"activity-platform/app/activity/rpc/activity"
+	"activity-platform/app/activity/rpc/internal/svc"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetSelfCheckInCodeLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetSelfCheckInCodeLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetSelfCheckInCodeLogic {
+	return &GetSelfCheckInCodeLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// GetSelfCheckInCode 组织者获取当前签到码（大屏在 expires_at 后重新拉取）
+func (l *GetSelfCheckInCodeLogic) GetSelfCheckInCode(in *activity.GetSelfCheckInCodeReq) (*activity.GetSelfCheckInCodeResp, error) {
+	activityData, err := findOrganizerActivity(l.ctx, l.svcCtx, in.ActivityId, in.OperatorId)
+	if err != nil {
+		return nil, err
+	}
+
+	setting, err := l.svcCtx.SelfCheckInSettingModel.FindByActivityID(l.ctx, activityData.ID)
+	if err != nil {
+		l.Errorf("查询自助签到设置失败: activityId=%d, err=%v", activityData.ID, err)
+		return nil, errorx.ErrDBError(err)
+	}
+	if setting == nil || !setting.Enabled {
+		return nil, errorx.New(errorx.CodeSelfCheckInDisabled)
+	}
+
+	code, expiresAt, err := currentSelfCheckInCode(setting.CodeSecret, time.Now())
+	if err != nil {
+		l.Errorf("生成签到码失败: activityId=%d, err=%v", activityData.ID, err)
+		return nil, errorx.New(errorx.CodeInternalError)
+	}
+
+	checkedIn, err := l.svcCtx.ActivityTicketModel.CountUsedByActivity(l.ctx, activityData.ID)
+	if err != nil {
+		// 签到人数仅用于展示，失败不影响签到码
+		l.Errorf("统计签到人数失败: activityId=%d, err=%v", activityData.ID, err)
+	}
+
+	return &activity.GetSelfCheckInCodeResp{
+		Code:           code,
+		ExpiresAt:      expiresAt,
+		RadiusMeters:   int32(setting.RadiusMeters),
+		CheckedInCount: checkedIn,
+	}, nil
+}
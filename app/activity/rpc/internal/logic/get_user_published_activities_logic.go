@@ -37,7 +37,7 @@ func NewGetUserPublishedActivitiesLogic(ctx context.Context, svcCtx *svc.Service
 //     - status >= 0：指定状态
 //  3. 调用 Model 层分页查询
 //  4. 批量查询关联数据（分类名称、标签列表）
-//  5. 构建响应
+//  5. 构建响应（附带组织者评分汇总）
 //
 // 设计说明：
 //   - 内部接口，供 User 服务调用
@@ -89,10 +89,14 @@ func (l *GetUserPublishedActivitiesLogic) GetUserPublishedActivities(in *activit
 		return nil, errorx.ErrDBError(err)
 	}
 
+	// 组织者评分汇总（查询失败降级为空）
+	organizerRating := l.loadOrganizerRating(uint64(in.GetUserId()))
+
 	// 5. 空列表直接返回
 	if len(result.List) == 0 {
 		return &activity.GetUserPublishedActivitiesResp{
-			List: []*activity.ActivityListItem{},
+			List:            []*activity.ActivityListItem{},
+			OrganizerRating: organizerRating,
 			Pagination: &activity.Pagination{
 				Page:       int32(result.Page),
 				PageSize:   int32(result.PageSize),
@@ -142,7 +146,8 @@ func (l *GetUserPublishedActivitiesLogic) GetUserPublishedActivities(in *activit
 		in.GetUserId(), result.Page, result.Total, len(list))
 
 	return &activity.GetUserPublishedActivitiesResp{
-		List: list,
+		List:            list,
+		OrganizerRating: organizerRating,
 		Pagination: &activity.Pagination{
 			Page:       int32(result.Page),
 			PageSize:   int32(result.PageSize),
@@ -152,6 +157,19 @@ func (l *GetUserPublishedActivitiesLogic) GetUserPublishedActivities(in *activit
 	}, nil
 }
 
+// loadOrganizerRating 查询组织者评分汇总（所有活动的评价按人数加权）
+func (l *GetUserPublishedActivitiesLogic) loadOrganizerRating(organizerID uint64) *activity.OrganizerRating {
+	avg, count, err := l.svcCtx.FeedbackModel.SummaryByOrganizer(l.ctx, organizerID)
+	if err != nil {
+		l.Infof("[WARNING] 查询组织者评分失败: organizerId=%d, err=%v", organizerID, err)
+		return &activity.OrganizerRating{}
+	}
+	return &activity.OrganizerRating{
+		RatingAvg:   avg,
+		RatingCount: count,
+	}
+}
+
 // loadCategoryMap 加载分类映射表（优先从缓存获取）
 func (l *GetUserPublishedActivitiesLogic) loadCategoryMap() map[uint64]string {
 	// 优先使用缓存
@@ -215,6 +233,8 @@ func (l *GetUserPublishedActivitiesLogic) buildActivityListItem(
 		Tags:                   l.convertTagCaches(tags),
 		ViewCount:              int64(act.ViewCount),
 		CreatedAt:              act.CreatedAt,
+		RatingAvg:              act.RatingAvg,
+		RatingCount:            int32(act.RatingCount),
 		RegistrationStatus:     regStatus,
 		RegistrationStatusText: regStatusText,
 	}
@@ -47,6 +47,7 @@ func NewIncrViewCountLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Inc
 //   - 浏览量只增不减
 //   - 使用原子操作避免并发问题
 //   - 防刷失败不影响正常返回（降级处理）
+//   - 防刷检查前记录浏览埋点（活动分析的 PV/UV），埋点失败不影响计数
 func (l *IncrViewCountLogic) IncrViewCount(in *activity.IncrViewCountReq) (*activity.IncrViewCountResp, error) {
 	// 1. 参数校验
 	if in.GetId() <= 0 {
@@ -91,6 +92,9 @@ func (l *IncrViewCountLogic) IncrViewCount(in *activity.IncrViewCountReq) (*acti
 		}, nil
 	}
 
+	// 记录浏览埋点（供活动分析统计 PV/UV，每次浏览都记录）
+	recordViewStats(l.ctx, l.svcCtx, activityID, viewerKey)
+
 	// 检查是否已浏览过
 	redisKey := fmt.Sprintf("activity:view:%d:%s", activityID, viewerKey)
 	exists, err := l.svcCtx.Redis.ExistsCtx(l.ctx, redisKey)
@@ -241,6 +241,8 @@ func (l *ListActivitiesLogic) buildActivityListItem(
 		Tags:                   convertTagCachesForList(tags),
 		ViewCount:              int64(act.ViewCount),
 		CreatedAt:              act.CreatedAt,
+		RatingAvg:              act.RatingAvg,
+		RatingCount:            int32(act.RatingCount),
 		RegistrationStatus:     regStatus,
 		RegistrationStatusText: regStatusText,
 	}
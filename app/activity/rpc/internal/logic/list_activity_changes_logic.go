@@ -0,0 +1,149 @@
+package logic
+
+import (
+	"context"
+	"errors"
+
+	"activity-platform/app/activity/model"
+	"activity-platform/app/activity/rpc/activity"
+	"activity-platform/app/activity/rpc/internal/svc"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListActivityChangesLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListActivityChangesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListActivityChangesLogic {
+	return &ListActivityChangesLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ListActivityChanges 变更申请列表
+//
+// 组织者：必须指定活动，仅能查看自己的活动；管理员：可查看全部（activity_id 可选）
+func (l *ListActivityChangesLogic) ListActivityChanges(in *activity.ListActivityChangesReq) (*activity.ListActivityChangesResp, error) {
+	// 1. 参数与权限校验
+	if in.OperatorId <= 0 {
+		return nil, errorx.ErrInvalidParams("操作者信息缺失")
+	}
+	if !in.IsAdmin {
+		if in.ActivityId <= 0 {
+			return nil, errorx.ErrInvalidParams("活动ID无效")
+		}
+		activityData, err := l.svcCtx.ActivityModel.FindByID(l.ctx, uint64(in.ActivityId))
+		if err != nil {
+			if errors.Is(err, model.ErrActivityNotFound) {
+				return nil, errorx.New(errorx.CodeActivityNotFound)
+			}
+			l.Errorf("查询活动失败: id=%d, err=%v", in.ActivityId, err)
+			return nil, errorx.ErrDBError(err)
+		}
+		if activityData.OrganizerID != uint64(in.OperatorId) {
+			return nil, errorx.New(errorx.CodeActivityPermissionDenied)
+		}
+	}
+	status := int8(in.Status)
+	if in.Status < 0 {
+		status = -1
+	} else if _, ok := model.ChangeRequestStatusText[status]; !ok {
+		return nil, errorx.ErrInvalidParams("状态参数无效")
+	}
+	page := int(in.Page)
+	if page <= 0 {
+		page = model.DefaultPage
+	}
+	pageSize := int(in.PageSize)
+	if pageSize <= 0 {
+		pageSize = model.DefaultPageSize
+	}
+	if pageSize > model.MaxPageSize {
+		pageSize = model.MaxPageSize
+	}
+
+	// 2. 查询申请
+	list, total, err := l.svcCtx.ChangeRequestModel.List(l.ctx, &model.ChangeRequestQuery{
+		ActivityID: uint64(in.ActivityId),
+		Status:     status,
+		Page:       page,
+		PageSize:   pageSize,
+	})
+	if err != nil {
+		l.Errorf("查询变更申请失败: activityId=%d, err=%v", in.ActivityId, err)
+		return nil, errorx.ErrDBError(err)
+	}
+
+	totalPages := int(total) / pageSize
+	if int(total)%pageSize > 0 {
+		totalPages++
+	}
+	resp := &activity.ListActivityChangesResp{
+		List: make([]*activity.ActivityChangeInfo, 0, len(list)),
+		Pagination: &activity.Pagination{
+			Page:       int32(page),
+			PageSize:   int32(pageSize),
+			Total:      total,
+			TotalPages: int32(totalPages),
+		},
+	}
+	if len(list) == 0 {
+		return resp, nil
+	}
+
+	// 3. 批量加载活动标题
+	activityIDs := make([]uint64, 0, len(list))
+	for _, r := range list {
+		activityIDs = append(activityIDs, r.ActivityID)
+	}
+	titleMap := make(map[uint64]string, len(activityIDs))
+	activities, err := l.svcCtx.ActivityModel.FindByIDs(l.ctx, activityIDs)
+	if err != nil {
+		// 标题缺失不影响列表展示
+		l.Infof("[WARNING] 批量查询活动失败: err=%v", err)
+	}
+	for _, act := range activities {
+		titleMap[act.ID] = act.Title
+	}
+
+	// 4. 构建列表
+	for i := range list {
+		r := &list[i]
+		resp.List = append(resp.List, &activity.ActivityChangeInfo{
+			Id:              int64(r.ID),
+			ActivityId:      int64(r.ActivityID),
+			ActivityTitle:   titleMap[r.ActivityID],
+			OrganizerId:     int64(r.OrganizerID),
+			Status:          int32(r.Status),
+			StatusText:      model.ChangeRequestStatusText[r.Status],
+			Reason:          r.Reason,
+			Changes:         toReviewFieldDiffs(r.Changes()),
+			ReviewerId:      int64(r.ReviewerID),
+			RejectReason:    r.RejectReason,
+			CreatedAt:       r.CreatedAt,
+			DecidedAt:       r.DecidedAt,
+			FreeCancelUntil: r.FreeCancelUntil,
+		})
+	}
+	return resp, nil
+}
+
+// toReviewFieldDiffs 字段变更转换为 RPC 差异结构
+func toReviewFieldDiffs(changes []model.ReviewFieldChange) []*activity.ReviewFieldDiff {
+	diffs := make([]*activity.ReviewFieldDiff, 0, len(changes))
+	for _, c := range changes {
+		diffs = append(diffs, &activity.ReviewFieldDiff{
+			Field:    c.Field,
+			Label:    c.Label,
+			OldValue: c.OldValue,
+			NewValue: c.NewValue,
+		})
+	}
+	return diffs
+}
@@ -0,0 +1,111 @@
+package logic
+
+import (
+	"context"
+
+	"activity-platform/app/activity/model"
+	"activity-platform/app/activity/rpc/activity"
+	"activity-platform/app/activity/rpc/internal/svc"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListActivityTemplatesLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListActivityTemplatesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListActivityTemplatesLogic {
+	return &ListActivityTemplatesLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ListActivityTemplates 模板列表
+//
+// 用户：scope=0 返回自己的个人模板与可用公共模板，1 仅个人模板，2 仅公共模板；
+// 管理员：返回全部公共模板（含已下架），用于维护
+func (l *ListActivityTemplatesLogic) ListActivityTemplates(in *activity.ListActivityTemplatesReq) (*activity.ListActivityTemplatesResp, error) {
+	// 1. 组装筛选条件
+	if in.OperatorId <= 0 {
+		return nil, errorx.ErrInvalidParams("操作者信息缺失")
+	}
+	filter := model.TemplateFilter{CategoryID: uint64(in.CategoryId)}
+	switch {
+	case in.IsAdmin:
+		filter.IncludePublic, filter.IncludeDisabled = true, true
+	case in.Scope == 0:
+		filter.OwnerID, filter.IncludePublic = uint64(in.OperatorId), true
+	case in.Scope == int32(model.TemplateScopePrivate):
+		filter.OwnerID = uint64(in.OperatorId)
+	case in.Scope == int32(model.TemplateScopePublic):
+		filter.IncludePublic = true
+	default:
+		return nil, errorx.ErrInvalidParams("模板范围无效")
+	}
+
+	page := int(in.Page)
+	if page <= 0 {
+		page = model.DefaultPage
+	}
+	pageSize := int(in.PageSize)
+	if pageSize <= 0 {
+		pageSize = model.DefaultPageSize
+	}
+	if pageSize > model.MaxPageSize {
+		pageSize = model.MaxPageSize
+	}
+
+	// 2. 查询
+	templates, total, err := l.svcCtx.TemplateModel.List(l.ctx, filter, (page-1)*pageSize, pageSize)
+	if err != nil {
+		l.Errorf("查询活动模板失败: operatorId=%d, err=%v", in.OperatorId, err)
+		return nil, errorx.ErrDBError(err)
+	}
+
+	// 3. 转换
+	categoryMap := l.loadCategoryMap()
+	list := make([]*activity.ActivityTemplate, 0, len(templates))
+	for i := range templates {
+		list = append(list, toActivityTemplate(&templates[i], categoryMap[templates[i].CategoryID]))
+	}
+	totalPages := int(total) / pageSize
+	if int(total)%pageSize != 0 {
+		totalPages++
+	}
+	return &activity.ListActivityTemplatesResp{
+		List: list,
+		Pagination: &activity.Pagination{
+			Page:       int32(page),
+			PageSize:   int32(pageSize),
+			Total:      total,
+			TotalPages: int32(totalPages),
+		},
+	}, nil
+}
+
+// loadCategoryMap 加载分类映射表（优先从缓存获取）
+func (l *ListActivityTemplatesLogic) loadCategoryMap() map[uint64]string {
+	if l.svcCtx.CategoryCache != nil {
+		categoryMap, err := l.svcCtx.CategoryCache.GetNameMap(l.ctx)
+		if err == nil {
+			return categoryMap
+		}
+		l.Infof("[WARNING] 从缓存加载分类失败，降级查 DB: %v", err)
+	}
+
+	categoryMap := make(map[uint64]string)
+	categories, err := l.svcCtx.CategoryModel.ListAll(l.ctx)
+	if err != nil {
+		l.Infof("[WARNING] 加载分类列表失败: %v", err)
+		return categoryMap
+	}
+	for _, cat := range categories {
+		categoryMap[cat.ID] = cat.Name
+	}
+	return categoryMap
+}
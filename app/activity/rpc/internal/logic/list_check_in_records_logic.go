@@ -0,0 +1,83 @@
+package logic
+
+import (
+	"context"
+
+	"activity-platform/app/activity/model"
+	"activity-platform/app/activity/rpc/activity"
+	"activity-platform/app/activity/rpc/internal/svc"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListCheckInRecordsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListCheckInRecordsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListCheckInRecordsLogic {
+	return &ListCheckInRecordsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ListCheckInRecords 组织者查看签到记录（疑似异常的自助签到由组织者复核）
+func (l *ListCheckInRecordsLogic) ListCheckInRecords(in *activity.ListCheckInRecordsReq) (*activity.ListCheckInRecordsResp, error) {
+	activityData, err := findOrganizerActivity(l.ctx, l.svcCtx, in.ActivityId, in.OperatorId)
+	if err != nil {
+		return nil, err
+	}
+
+	page := int(in.Page)
+	if page <= 0 {
+		page = model.DefaultPage
+	}
+	pageSize := int(in.PageSize)
+	if pageSize <= 0 {
+		pageSize = model.DefaultPageSize
+	}
+	if pageSize > model.MaxPageSize {
+		pageSize = model.MaxPageSize
+	}
+
+	records, total, err := l.svcCtx.CheckInRecordModel.ListByActivityFiltered(
+		l.ctx, activityData.ID, in.FlaggedOnly, (page-1)*pageSize, pageSize)
+	if err != nil {
+		l.Errorf("查询签到记录失败: activityId=%d, err=%v", activityData.ID, err)
+		return nil, errorx.ErrDBError(err)
+	}
+
+	list := make([]*activity.CheckInRecordItem, 0, len(records))
+	for _, r := range records {
+		list = append(list, &activity.CheckInRecordItem{
+			Id:             int64(r.ID),
+			UserId:         int64(r.UserID),
+			TicketCode:     r.TicketCode,
+			Method:         int32(r.CheckInMethod),
+			CheckInTime:    r.CheckInTime,
+			Latitude:       r.Latitude,
+			Longitude:      r.Longitude,
+			DistanceMeters: int32(r.DistanceMeters),
+			DeviceId:       r.DeviceID,
+			Flagged:        r.Flagged,
+			FlagReason:     r.FlagReason,
+		})
+	}
+	totalPages := int(total) / pageSize
+	if int(total)%pageSize != 0 {
+		totalPages++
+	}
+	return &activity.ListCheckInRecordsResp{
+		List: list,
+		Pagination: &activity.Pagination{
+			Page:       int32(page),
+			PageSize:   int32(pageSize),
+			Total:      total,
+			TotalPages: int32(totalPages),
+		},
+	}, nil
+}
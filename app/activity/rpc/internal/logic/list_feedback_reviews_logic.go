@@ -0,0 +1,127 @@
+package logic
+
+import (
+	"context"
+
+	"activity-platform/app/activity/model"
+	"activity-platform/app/activity/rpc/activity"
+	"activity-platform/app/activity/rpc/internal/svc"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListFeedbackReviewsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListFeedbackReviewsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListFeedbackReviewsLogic {
+	return &ListFeedbackReviewsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ListFeedbackReviews 评价短评审核列表（管理员）
+//
+// 返回审核记录的同时附带评价当前的短评内容与状态（审核记录只保留检测时的前 100 字）
+func (l *ListFeedbackReviewsLogic) ListFeedbackReviews(in *activity.ListFeedbackReviewsReq) (*activity.ListFeedbackReviewsResp, error) {
+	// 1. 参数校验
+	status := int8(in.Status)
+	switch status {
+	case model.ContentReviewPending, model.ContentReviewPassed, model.ContentReviewRejected:
+	default:
+		return nil, errorx.ErrInvalidParams("状态参数无效")
+	}
+	page := int(in.Page)
+	if page <= 0 {
+		page = model.DefaultPage
+	}
+	pageSize := int(in.PageSize)
+	if pageSize <= 0 {
+		pageSize = model.DefaultPageSize
+	}
+	if pageSize > model.MaxPageSize {
+		pageSize = model.MaxPageSize
+	}
+
+	// 2. 查询审核记录
+	reviews, total, err := l.svcCtx.ContentReviewModel.ListByStatus(l.ctx, model.ContentSceneFeedback, status, page, pageSize)
+	if err != nil {
+		l.Errorf("查询短评审核记录失败: status=%d, err=%v", status, err)
+		return nil, errorx.ErrDBError(err)
+	}
+
+	totalPages := int(total) / pageSize
+	if int(total)%pageSize > 0 {
+		totalPages++
+	}
+	resp := &activity.ListFeedbackReviewsResp{
+		List: make([]*activity.FeedbackReviewItem, 0, len(reviews)),
+		Pagination: &activity.Pagination{
+			Page:       int32(page),
+			PageSize:   int32(pageSize),
+			Total:      total,
+			TotalPages: int32(totalPages),
+		},
+	}
+	if len(reviews) == 0 {
+		return resp, nil
+	}
+
+	// 3. 批量加载评价与活动标题
+	feedbackIDs := make([]uint64, 0, len(reviews))
+	activityIDs := make([]uint64, 0, len(reviews))
+	for _, r := range reviews {
+		feedbackIDs = append(feedbackIDs, r.FeedbackID)
+		activityIDs = append(activityIDs, r.ActivityID)
+	}
+	feedbacks, err := l.svcCtx.FeedbackModel.FindByIDs(l.ctx, feedbackIDs)
+	if err != nil {
+		l.Errorf("批量查询评价失败: err=%v", err)
+		return nil, errorx.ErrDBError(err)
+	}
+	feedbackMap := make(map[uint64]*model.ActivityFeedback, len(feedbacks))
+	for i := range feedbacks {
+		feedbackMap[feedbacks[i].ID] = &feedbacks[i]
+	}
+	titleMap := make(map[uint64]string, len(activityIDs))
+	activities, err := l.svcCtx.ActivityModel.FindByIDs(l.ctx, activityIDs)
+	if err != nil {
+		// 标题缺失不影响审核
+		l.Infof("[WARNING] 批量查询活动失败: err=%v", err)
+	}
+	for _, act := range activities {
+		titleMap[act.ID] = act.Title
+	}
+
+	// 4. 构建列表
+	for i := range reviews {
+		r := &reviews[i]
+		item := &activity.FeedbackReviewItem{
+			Id:            int64(r.ID),
+			ActivityId:    int64(r.ActivityID),
+			ActivityTitle: titleMap[r.ActivityID],
+			FeedbackId:    int64(r.FeedbackID),
+			Status:        int32(r.Status),
+			ReviewerId:    int64(r.ReviewerID),
+			ReviewNote:    r.ReviewNote,
+			ReviewedAt:    r.ReviewedAt,
+			CreatedAt:     r.CreatedAt,
+		}
+		if fb, ok := feedbackMap[r.FeedbackID]; ok {
+			item.UserId = int64(fb.UserID)
+			item.Comment = fb.Comment
+			item.CommentStatus = int32(fb.CommentStatus)
+		}
+		for _, h := range r.Hits {
+			item.HitWords = append(item.HitWords, h.Word)
+		}
+		resp.List = append(resp.List, item)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,202 @@
+package logic
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"activity-platform/app/activity/model"
+	"activity-platform/app/activity/rpc/activity"
+	"activity-platform/app/activity/rpc/internal/svc"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListReviewQueueLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListReviewQueueLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListReviewQueueLogic {
+	return &ListReviewQueueLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ListReviewQueue 审核队列（管理员）
+//
+// 业务逻辑：
+//  1. 按范围（全部 / 分配给我 / 未分配）与是否超时筛选待审核活动，按 SLA 截止时间升序
+//  2. 计算 SLA 剩余时间
+//  3. 与最近一次审核通过的内容快照对比，返回字段差异（首次提交无差异）
+func (l *ListReviewQueueLogic) ListReviewQueue(in *activity.ListReviewQueueReq) (*activity.ListReviewQueueResp, error) {
+	// 1. 参数校验
+	if in.OperatorId <= 0 {
+		return nil, errorx.ErrInvalidParams("操作者信息缺失")
+	}
+	scope := in.Scope
+	switch scope {
+	case "":
+		scope = model.ReviewScopeAll
+	case model.ReviewScopeAll, model.ReviewScopeMine, model.ReviewScopeUnassigned:
+	default:
+		return nil, errorx.ErrInvalidParams("无效的队列范围，可选值：all/mine/unassigned")
+	}
+	page := int(in.Page)
+	if page <= 0 {
+		page = model.DefaultPage
+	}
+	pageSize := int(in.PageSize)
+	if pageSize <= 0 {
+		pageSize = model.DefaultPageSize
+	}
+	if pageSize > model.MaxPageSize {
+		pageSize = model.MaxPageSize
+	}
+
+	// 2. 查询审核队列
+	reviews, total, err := l.svcCtx.ReviewModel.ListQueue(l.ctx, &model.ReviewQueueQuery{
+		Scope:       scope,
+		ReviewerID:  uint64(in.OperatorId),
+		OverdueOnly: in.OverdueOnly,
+		Page:        page,
+		PageSize:    pageSize,
+	})
+	if err != nil {
+		l.Errorf("查询审核队列失败: err=%v", err)
+		return nil, errorx.ErrDBError(err)
+	}
+
+	totalPages := int(total) / pageSize
+	if int(total)%pageSize > 0 {
+		totalPages++
+	}
+	resp := &activity.ListReviewQueueResp{
+		List: []*activity.ReviewQueueItem{},
+		Pagination: &activity.Pagination{
+			Page:       int32(page),
+			PageSize:   int32(pageSize),
+			Total:      total,
+			TotalPages: int32(totalPages),
+		},
+	}
+	if len(reviews) == 0 {
+		return resp, nil
+	}
+
+	// 3. 批量加载活动与最近一次通过的快照
+	activityIDs := make([]uint64, len(reviews))
+	for i, r := range reviews {
+		activityIDs[i] = r.ActivityID
+	}
+	activities, err := l.svcCtx.ActivityModel.FindByIDs(l.ctx, activityIDs)
+	if err != nil {
+		l.Errorf("批量查询活动失败: err=%v", err)
+		return nil, errorx.ErrDBError(err)
+	}
+	activityMap := make(map[uint64]*model.Activity, len(activities))
+	for i := range activities {
+		activityMap[activities[i].ID] = &activities[i]
+	}
+	approvedMap, err := l.svcCtx.ReviewModel.FindLastApprovedByActivityIDs(l.ctx, activityIDs)
+	if err != nil {
+		// 差异对比失败不影响队列展示
+		l.Infof("[WARNING] 查询审核通过快照失败: err=%v", err)
+		approvedMap = map[uint64]*model.ActivityReview{}
+	}
+	categoryMap := l.loadCategoryMap()
+
+	// 4. 构建队列项
+	now := time.Now().Unix()
+	for i := range reviews {
+		r := &reviews[i]
+		act, ok := activityMap[r.ActivityID]
+		if !ok {
+			continue
+		}
+		item := &activity.ReviewQueueItem{
+			ReviewId:          int64(r.ID),
+			ActivityId:        int64(act.ID),
+			Title:             act.Title,
+			CoverUrl:          act.CoverURL,
+			CategoryName:      categoryMap[act.CategoryID],
+			OrganizerId:       int64(act.OrganizerID),
+			OrganizerName:     act.OrganizerName,
+			ActivityStartTime: act.ActivityStartTime,
+			PolicyReason:      r.PolicyReason,
+			Flagged:           r.Flagged,
+			SubmittedAt:       r.SubmittedAt,
+			DueAt:             r.DueAt,
+			RemainingSeconds:  r.DueAt - now,
+			Overdue:           r.Overdue(now),
+			AssigneeId:        int64(r.AssigneeID),
+			AssignedAt:        r.AssignedAt,
+		}
+		if approved, ok := approvedMap[act.ID]; ok {
+			if base, ok := model.DecodeReviewSnapshot(approved.Snapshot); ok {
+				item.HasApprovedVersion = true
+				item.Diffs = l.buildDiffs(base, model.NewReviewSnapshot(act), categoryMap)
+			}
+		}
+		resp.List = append(resp.List, item)
+	}
+
+	return resp, nil
+}
+
+// buildDiffs 构建字段差异（分类 ID 转换为分类名称）
+func (l *ListReviewQueueLogic) buildDiffs(base, current model.ReviewSnapshot, categoryMap map[uint64]string) []*activity.ReviewFieldDiff {
+	changes := model.DiffReviewSnapshot(base, current)
+	diffs := make([]*activity.ReviewFieldDiff, 0, len(changes))
+	for _, c := range changes {
+		if c.Field == "category_id" {
+			c.OldValue = categoryName(categoryMap, c.OldValue)
+			c.NewValue = categoryName(categoryMap, c.NewValue)
+		}
+		diffs = append(diffs, &activity.ReviewFieldDiff{
+			Field:    c.Field,
+			Label:    c.Label,
+			OldValue: c.OldValue,
+			NewValue: c.NewValue,
+		})
+	}
+	return diffs
+}
+
+// categoryName 分类 ID 字符串转换为分类名称（未知分类原样返回）
+func categoryName(categoryMap map[uint64]string, id string) string {
+	v, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return id
+	}
+	if name, ok := categoryMap[v]; ok {
+		return name
+	}
+	return id
+}
+
+// loadCategoryMap 加载分类映射表（优先从缓存获取）
+func (l *ListReviewQueueLogic) loadCategoryMap() map[uint64]string {
+	if l.svcCtx.CategoryCache != nil {
+		categoryMap, err := l.svcCtx.CategoryCache.GetNameMap(l.ctx)
+		if err == nil {
+			return categoryMap
+		}
+		l.Infof("[WARNING] 从缓存加载分类失败，降级查 DB: %v", err)
+	}
+
+	categoryMap := make(map[uint64]string)
+	categories, err := l.svcCtx.CategoryModel.FindAll(l.ctx)
+	if err != nil {
+		l.Infof("[WARNING] 加载分类列表失败: %v", err)
+		return categoryMap
+	}
+	for _, cat := range categories {
+		categoryMap[cat.ID] = cat.Name
+	}
+	return categoryMap
+}
@@ -0,0 +1,97 @@
+package logic
+
+import (
+	"context"
+
+	"activity-platform/app/activity/model"
+	"activity-platform/app/activity/rpc/activity"
+	"activity-platform/app/activity/rpc/internal/svc"
+	userpb "activity-platform/app/user/rpc/pb/pb"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+)
+
+type MergeTagsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewMergeTagsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *MergeTagsLogic {
+	return &MergeTagsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// MergeTags 合并标签（管理员）
+//
+// 执行顺序：
+//  1. 用户服务合并：用户兴趣改绑到目标标签，源标签禁用并记录 merged_into
+//  2. 本地事务：activity_tags 改绑、重算两个标签的统计、写活动变更事件（重建 ES 文档）
+//  3. 同步 tag_cache（源标签禁用、目标标签最新数据）
+//
+// 两侧均幂等：第 2 步失败时直接重试整个请求即可
+func (l *MergeTagsLogic) MergeTags(in *activity.MergeTagsReq) (*activity.MergeTagsResp, error) {
+	// 1. 参数校验
+	if in.SourceId <= 0 || in.TargetId <= 0 {
+		return nil, errorx.ErrInvalidParams("标签ID无效")
+	}
+	if in.SourceId == in.TargetId {
+		return nil, errorx.New(errorx.CodeInterestTagMergeInvalid)
+	}
+	sourceID, targetID := uint64(in.SourceId), uint64(in.TargetId)
+
+	// 2. 用户服务合并
+	resp, err := l.svcCtx.TagRpc.MergeInterestTags(l.ctx, &userpb.MergeInterestTagsReq{
+		SourceId: in.SourceId,
+		TargetId: in.TargetId,
+	})
+	if err != nil {
+		l.Errorf("[Tag] 用户服务合并标签失败: source=%d, target=%d, err=%v", sourceID, targetID, err)
+		return nil, errorx.FromError(err)
+	}
+
+	// 3. 本地改绑活动标签
+	activityIDs, err := l.svcCtx.ActivityTagModel.ListActivityIDsByTag(l.ctx, sourceID)
+	if err != nil {
+		l.Errorf("[Tag] 查询源标签关联活动失败: source=%d, err=%v", sourceID, err)
+		return nil, errorx.ErrDBError(err)
+	}
+	err = l.svcCtx.DB.WithContext(l.ctx).Transaction(func(tx *gorm.DB) error {
+		if err := l.svcCtx.ActivityTagModel.RebindTag(l.ctx, tx, sourceID, targetID); err != nil {
+			return err
+		}
+		if err := l.svcCtx.TagStatsModel.RecalculateByTagIDs(l.ctx, tx, []uint64{sourceID, targetID}); err != nil {
+			return err
+		}
+		return l.svcCtx.ChangeEventModel.RecordBatch(l.ctx, tx, activityIDs,
+			model.ChangeTypeTaxonomy, model.ChangeSourceActivityRpc)
+	})
+	if err != nil {
+		l.Errorf("[Tag] 改绑活动标签失败: source=%d, target=%d, err=%v", sourceID, targetID, err)
+		return nil, errorx.ErrDBError(err)
+	}
+
+	// 4. 同步标签缓存（活动索引已在事务内记录，这里只写 tag_cache）
+	for _, tag := range []*userpb.TagInfo{resp.Source, resp.Target} {
+		if tag == nil {
+			continue
+		}
+		cache := tagCacheFromInfo(tag)
+		if err := l.svcCtx.TagCacheModel.Upsert(l.ctx, &cache); err != nil {
+			l.Errorf("[Tag] 同步标签缓存失败: tagId=%d, err=%v", tag.Id, err)
+		}
+	}
+
+	l.Infof("[Tag] 合并标签: source=%d, target=%d, activities=%d, users=%d, operatorId=%d",
+		sourceID, targetID, len(activityIDs), resp.MovedUsers, in.OperatorId)
+	return &activity.MergeTagsResp{
+		Target:          toAdminTag(l.ctx, l.svcCtx, resp.Target),
+		MovedActivities: int64(len(activityIDs)),
+		MovedUsers:      resp.MovedUsers,
+	}, nil
+}
@@ -0,0 +1,128 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"activity-platform/app/activity/model"
+	"activity-platform/app/activity/rpc/activity"
+	"activity-platform/app/activity/rpc/internal/svc"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type NearbyActivitiesLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewNearbyActivitiesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *NearbyActivitiesLogic {
+	return &NearbyActivitiesLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// NearbyActivities 附近活动
+//
+// 业务逻辑：
+//  1. 参数校验（经纬度必填，半径默认 5km、最大 50km）
+//  2. 优先使用 ES geo_distance 查询
+//  3. ES 不可用时降级到 MySQL（外接矩形 + ST_Distance_Sphere）
+//
+// 设计说明：
+//   - 复用 SearchActivities 的 ES / MySQL 查询链路，关键词可选
+//   - 默认按距离升序，每个列表项返回与用户的距离（米）
+//   - 未设置坐标的活动不会出现在结果中
+func (l *NearbyActivitiesLogic) NearbyActivities(in *activity.NearbyActivitiesReq) (*activity.NearbyActivitiesResp, error) {
+	startTime := time.Now()
+
+	// 1. 参数校验
+	if err := l.validateParams(in); err != nil {
+		return nil, err
+	}
+
+	// 2. 转换为搜索请求，复用搜索链路
+	searchReq := &activity.SearchActivitiesReq{
+		Keyword:    in.Keyword,
+		CategoryId: in.CategoryId,
+		Page:       in.Page,
+		PageSize:   in.PageSize,
+		Sort:       in.Sort,
+		Longitude:  in.Longitude,
+		Latitude:   in.Latitude,
+		RadiusKm:   in.RadiusKm,
+	}
+	searchLogic := NewSearchActivitiesLogic(l.ctx, l.svcCtx)
+
+	// 3. 优先 ES
+	if l.svcCtx.ESClient != nil && l.svcCtx.ESClient.IsEnabled() {
+		resp, err := searchLogic.searchWithES(searchReq)
+		if err == nil {
+			return l.buildResp(resp, "es"), nil
+		}
+		l.Errorf("[NearbyActivities] ES 查询失败，降级到 MySQL: %v", err)
+	}
+
+	// 4. 降级 MySQL
+	resp, err := searchLogic.searchWithMySQL(searchReq, startTime)
+	if err != nil {
+		return nil, err
+	}
+
+	l.Infof("[NearbyActivities] MySQL 查询成功: lon=%f, lat=%f, radius_km=%g, total=%d",
+		in.Longitude, in.Latitude, in.RadiusKm, resp.Total)
+
+	return l.buildResp(resp, "mysql"), nil
+}
+
+// validateParams 参数校验与规范化
+func (l *NearbyActivitiesLogic) validateParams(in *activity.NearbyActivitiesReq) error {
+	// 1. 坐标校验
+	if !model.ValidCoordinate(in.Longitude, in.Latitude) {
+		return errorx.ErrInvalidParams("经纬度不合法")
+	}
+
+	// 2. 半径规范化
+	in.RadiusKm = normalizeRadiusKm(in.RadiusKm)
+
+	// 3. 关键词长度限制（可选）
+	if len([]rune(in.Keyword)) > 50 {
+		return errorx.ErrInvalidParams("搜索关键词不能超过50个字符")
+	}
+
+	// 4. 分页参数规范化
+	if in.Page <= 0 {
+		in.Page = 1
+	}
+	if in.PageSize <= 0 {
+		in.PageSize = 10
+	}
+	if in.PageSize > 50 {
+		in.PageSize = 50
+	}
+
+	// 5. 排序方式（默认按距离）
+	switch in.Sort {
+	case "":
+		in.Sort = "distance"
+	case "distance", "time", "hot":
+	default:
+		return errorx.ErrInvalidParams("无效的排序方式，可选值：distance/time/hot")
+	}
+
+	return nil
+}
+
+// buildResp 构建响应
+func (l *NearbyActivitiesLogic) buildResp(resp *activity.SearchActivitiesResp, source string) *activity.NearbyActivitiesResp {
+	return &activity.NearbyActivitiesResp{
+		List:        resp.List,
+		Total:       resp.Total,
+		QueryTimeMs: resp.QueryTimeMs,
+		Source:      source,
+	}
+}
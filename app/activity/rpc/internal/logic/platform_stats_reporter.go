@@ -0,0 +1,131 @@
+package logic
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"activity-platform/app/activity/model"
+	"activity-platform/app/activity/rpc/internal/svc"
+	userpb "activity-platform/app/user/rpc/pb/pb"
+	"activity-platform/common/constants"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// ==================== 平台统计上报 ====================
+//
+// 管理后台运营报表中的活动指标（按分类统计的发布、取消、结束数）由本服务按状态变更日志统计，
+// 再通过用户服务 PlatformStatsService.ReportDailyStats 写入平台统计表。
+// 上报按 日期 + 指标 整体替换，重复上报幂等；与活动分析共用 AnalyticsCron 的调度与分布式锁，
+// 每轮上报最近 Analytics.BackfillDays 天（含当天）
+
+// platformStatsMetrics 活动状态与平台统计指标的对应关系
+var platformStatsMetrics = map[int8]string{
+	model.StatusPublished: constants.StatsMetricActivityPublished,
+	model.StatusCancelled: constants.StatsMetricActivityCancelled,
+	model.StatusFinished:  constants.StatsMetricActivityFinished,
+}
+
+// PlatformStatsReporter 平台统计上报
+type PlatformStatsReporter struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewPlatformStatsReporter(ctx context.Context, svcCtx *svc.ServiceContext) *PlatformStatsReporter {
+	return &PlatformStatsReporter{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ReportRecent 上报最近 BackfillDays 天（含当天）的活动指标（单个日期失败不影响其他日期）
+func (r *PlatformStatsReporter) ReportRecent() error {
+	days := r.svcCtx.Config.Analytics.BackfillDays
+	if days <= 0 {
+		days = 1
+	}
+	if days > analyticsMaxBackfillDays {
+		days = analyticsMaxBackfillDays
+	}
+
+	categories, err := r.categoryNames()
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
+	for i := days - 1; i >= 0; i-- {
+		day := today.AddDate(0, 0, -i)
+		if err := r.ReportDay(day, categories); err != nil {
+			r.Errorf("[PlatformStats] 上报活动指标失败: date=%s, err=%v", day.Format(analyticsDateLayout), err)
+		}
+	}
+	return nil
+}
+
+// ReportDay 统计并上报某一天的活动指标（维度为空的行为当天总数）
+func (r *PlatformStatsReporter) ReportDay(day time.Time, categories map[uint64]string) error {
+	date := day.Format(analyticsDateLayout)
+	start, end := day.Unix(), day.AddDate(0, 0, 1).Unix()
+
+	statuses := make([]int8, 0, len(platformStatsMetrics))
+	metrics := make([]string, 0, len(platformStatsMetrics))
+	for status, metric := range platformStatsMetrics {
+		statuses = append(statuses, status)
+		metrics = append(metrics, metric)
+	}
+
+	list, err := r.svcCtx.StatusLogModel.CountTransitionsByCategory(r.ctx, start, end, statuses)
+	if err != nil {
+		return err
+	}
+
+	totals := make(map[string]int64, len(metrics))
+	items := make([]*userpb.StatsMetricItem, 0, len(list)+len(metrics))
+	for _, c := range list {
+		metric := platformStatsMetrics[c.ToStatus]
+		totals[metric] += c.Total
+		items = append(items, &userpb.StatsMetricItem{
+			Metric:    metric,
+			Dimension: categoryDimension(categories, c.CategoryID),
+			Value:     float64(c.Total),
+		})
+	}
+	for metric, total := range totals {
+		items = append(items, &userpb.StatsMetricItem{Metric: metric, Value: float64(total)})
+	}
+
+	_, err = r.svcCtx.StatsRpc.ReportDailyStats(r.ctx, &userpb.ReportDailyStatsReq{
+		Source:   constants.StatsSourceActivity,
+		StatDate: date,
+		Metrics:  metrics,
+		Items:    items,
+	})
+	return err
+}
+
+// categoryNames 查询全部分类名称（包含已禁用的分类，历史活动仍可能属于这些分类）
+func (r *PlatformStatsReporter) categoryNames() (map[uint64]string, error) {
+	list, err := r.svcCtx.CategoryModel.ListAll(r.ctx)
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[uint64]string, len(list))
+	for _, c := range list {
+		names[c.ID] = c.Name
+	}
+	return names, nil
+}
+
+// categoryDimension 分类维度（分类已删除时使用分类ID）
+func categoryDimension(categories map[uint64]string, categoryID uint64) string {
+	if name, ok := categories[categoryID]; ok && name != "" {
+		return name
+	}
+	return "分类" + strconv.FormatUint(categoryID, 10)
+}
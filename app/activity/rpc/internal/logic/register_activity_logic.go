@@ -16,8 +16,6 @@ import (
 	"activity-platform/app/activity/model"
 	"activity-platform/app/activity/rpc/activity"
 	"activity-platform/app/activity/rpc/internal/svc"
-	"activity-platform/app/user/rpc/client/creditservice"
-	"activity-platform/app/user/rpc/client/verifyservice"
 
 	"github.com/zeromicro/go-zero/core/breaker"
 	"github.com/zeromicro/go-zero/core/logx"
@@ -89,102 +87,37 @@ func (l *RegisterActivityLogic) RegisterActivity(in *activity.RegisterActivityRe
 		}, nil
 	}
 
-	// 检查是否是活动发布者
-	if activityData.OrganizerID == uint64(userID) {
+	if reason := checkRegisterWindow(activityData, userID, time.Now().Unix()); reason != "" {
 		return &activity.RegisterActivityResponse{
 			Result: "fail",
-			Reason: "活动发布者无法报名",
+			Reason: reason,
 		}, nil
 	}
 
-	now := time.Now().Unix()
-	if activityData.Status != model.StatusPublished {
-		return &activity.RegisterActivityResponse{
-			Result: "fail",
-			Reason: "活动不在报名中",
-		}, nil
-	}
-	if activityData.RegisterStartTime > 0 && now < activityData.RegisterStartTime {
-		return &activity.RegisterActivityResponse{
-			Result: "fail",
-			Reason: "报名未开始",
-		}, nil
-	}
-	if activityData.RegisterEndTime > 0 && now > activityData.RegisterEndTime {
-		return &activity.RegisterActivityResponse{
-			Result: "fail",
-			Reason: "报名已结束",
-		}, nil
-	}
-	if activityData.MaxParticipants > 0 && activityData.CurrentParticipants >= activityData.MaxParticipants {
-		return &activity.RegisterActivityResponse{
-			Result: "fail",
-			Reason: "活动名额已满",
-		}, nil
-	}
-
-	// ==================== 信誉校验 ====================
-	creditResp, err := l.svcCtx.CreditRpc.CanParticipate(l.ctx, &creditservice.CanParticipateReq{
-		UserId: userID,
-	})
+	// ==================== 资格规则校验 ====================
+	// 平台信用、最低信用分、学生认证及组织者声明的学校/院系/年级规则，
+	// 全部执行后一次性返回所有未通过的规则
+	eligibilityResult, err := l.svcCtx.EligibilityEngine.Evaluate(l.ctx, activityData, userID)
 	if err != nil {
-		l.Errorf("信誉校验失败: userId=%d, err=%v", userID, err)
+		l.Errorf("资格校验失败: userId=%d, activityId=%d, err=%v", userID, activityID, err)
 		return &activity.RegisterActivityResponse{
 			Result: "fail",
-			Reason: "信誉校验失败，请稍后重试",
+			Reason: "资格校验失败，请稍后重试",
 		}, nil
 	}
-	if creditResp == nil {
-		l.Errorf("信誉校验返回空响应: userId=%d", userID)
-		return &activity.RegisterActivityResponse{
-			Result: "fail",
-			Reason: "信誉校验失败，请稍后重试",
-		}, nil
-	}
-	if !creditResp.GetAllowed() {
+	if !eligibilityResult.Eligible {
 		_ = l.svcCtx.ActivityRegistrationModel.Create(l.ctx, &model.ActivityRegistration{
 			ActivityID: uint64(activityID),
 			UserID:     uint64(userID),
 			Status:     model.RegistrationStatusFailed,
 		})
 		return &activity.RegisterActivityResponse{
-			Result: "fail",
-			Reason: creditResp.GetReason(),
+			Result:      "fail",
+			Reason:      eligibilityResult.Reason(),
+			FailedRules: toEligibilityCheckItemPbList(eligibilityResult.Failed()),
 		}, nil
 	}
 
-	// ==================== 实名校验 ====================
-	if activityData.RequireStudentVerify {
-		verifyResp, err := l.svcCtx.VerifyService.IsVerified(l.ctx, &verifyservice.IsVerifiedReq{
-			UserId: userID,
-		})
-		if err != nil {
-			l.Errorf("实名校验失败: userId=%d, err=%v", userID, err)
-			return &activity.RegisterActivityResponse{
-				Result: "fail",
-				Reason: "实名校验失败，请稍后重试",
-			}, nil
-		}
-		if verifyResp == nil {
-			l.Errorf("实名校验返回空响应: userId=%d", userID)
-			return &activity.RegisterActivityResponse{
-				Result: "fail",
-				Reason: "实名校验失败，请稍后重试",
-			}, nil
-		}
-		if !verifyResp.GetIsVerified() {
-			_ = l.svcCtx.ActivityRegistrationModel.Create(l.ctx, &model.ActivityRegistration{
-				ActivityID: uint64(activityID),
-				UserID:     uint64(userID),
-				Status:     model.RegistrationStatusFailed,
-			})
-			return &activity.RegisterActivityResponse{
-				Result: "fail",
-				Reason: "请先完成学生认证",
-			}, nil
-		}
-	}
-
 	// ==================== 第二步：熔断保护 ====================
 	alreadyRegistered := false
 	genTicketPayload := func() (*model.TicketPayload, error) {
@@ -247,12 +180,41 @@ func (l *RegisterActivityLogic) RegisterActivity(in *activity.RegisterActivityRe
 	// 事件发布为异步执行，失败不会影响报名主流程
 	l.publishMemberJoinedEvent(activityData.ID, userID)
 
+	// 登记开始前提醒（T-24h、T-1h），失败不影响报名结果
+	if err := l.svcCtx.Reminders.ScheduleRegistration(l.ctx, activityData, uint64(userID)); err != nil {
+		l.Infof("[WARNING] 登记活动提醒失败: activityId=%d, userId=%d, err=%v", activityData.ID, userID, err)
+	}
+
+	// 刷新日历订阅（失败时等待缓存自然过期）
+	_ = l.svcCtx.CalendarCache.Invalidate(l.ctx, uint64(userID))
+
 	return &activity.RegisterActivityResponse{
 		Result: "success",
 		Reason: "",
 	}, nil
 }
 
+// checkRegisterWindow 校验活动当前是否接受该用户报名（发布者、状态、报名时间、名额）
+// 返回空字符串表示通过，否则为失败原因
+func checkRegisterWindow(activityData *model.Activity, userID int64, now int64) string {
+	if activityData.OrganizerID == uint64(userID) {
+		return "活动发布者无法报名"
+	}
+	if activityData.Status != model.StatusPublished {
+		return "活动不在报名中"
+	}
+	if activityData.RegisterStartTime > 0 && now < activityData.RegisterStartTime {
+		return "报名未开始"
+	}
+	if activityData.RegisterEndTime > 0 && now > activityData.RegisterEndTime {
+		return "报名已结束"
+	}
+	if activityData.MaxParticipants > 0 && activityData.CurrentParticipants >= activityData.MaxParticipants {
+		return "活动名额已满"
+	}
+	return ""
+}
+
 // publishMemberJoinedEvent 发布用户报名成功事件
 // - 仅处理有效 ID
 // - Producer 未启用时直接跳过
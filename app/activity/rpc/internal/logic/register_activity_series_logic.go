@@ -0,0 +1,77 @@
+package logic
+
+import (
+	"context"
+
+	"activity-platform/app/activity/model"
+	"activity-platform/app/activity/rpc/activity"
+	"activity-platform/app/activity/rpc/internal/svc"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RegisterActivitySeriesLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRegisterActivitySeriesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RegisterActivitySeriesLogic {
+	return &RegisterActivitySeriesLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// RegisterActivitySeries 整期报名 / 退出整期报名
+//
+// 整期报名后立即为当前已开放报名的场次出票，之后的场次开放报名时由 SeriesCron 逐场出票；
+// 退出整期报名只停止后续出票，已出票的场次需按单场取消报名
+func (l *RegisterActivitySeriesLogic) RegisterActivitySeries(in *activity.RegisterActivitySeriesReq) (*activity.RegisterActivitySeriesResp, error) {
+	if in.UserId <= 0 {
+		return nil, errorx.ErrInvalidParams("用户信息缺失")
+	}
+	series, err := findSeries(l.ctx, l.svcCtx, in.SeriesId)
+	if err != nil {
+		return nil, err
+	}
+	userID := uint64(in.UserId)
+
+	// 1. 退出整期报名
+	if !in.Register {
+		if err := l.svcCtx.SeriesRegistrationModel.Subscribe(l.ctx, series.ID, userID, model.SeriesSubscriptionCancelled); err != nil {
+			l.Errorf("退出整期报名失败: seriesId=%d, userId=%d, err=%v", series.ID, userID, err)
+			return nil, errorx.ErrDBError(err)
+		}
+		return &activity.RegisterActivitySeriesResp{Subscribed: false}, nil
+	}
+
+	// 2. 整期报名
+	if series.Status == model.SeriesStatusCancelled {
+		return nil, errorx.New(errorx.CodeActivitySeriesClosed)
+	}
+	if !series.AllowSeriesRegister {
+		return nil, errorx.New(errorx.CodeActivitySeriesRegisterClosed)
+	}
+	if series.OrganizerID == userID {
+		return nil, errorx.ErrInvalidParams("活动发布者无法报名")
+	}
+	if err := l.svcCtx.SeriesRegistrationModel.Subscribe(l.ctx, series.ID, userID, model.SeriesSubscriptionActive); err != nil {
+		l.Errorf("整期报名失败: seriesId=%d, userId=%d, err=%v", series.ID, userID, err)
+		return nil, errorx.ErrDBError(err)
+	}
+
+	// 3. 立即为已开放报名的场次出票（失败由定时任务补齐）
+	enrolled, err := NewSeriesScheduler(l.ctx, l.svcCtx).Enroll(series, []uint64{userID})
+	if err != nil {
+		l.Errorf("[RegisterActivitySeries] 整期报名出票失败（稍后重试）: seriesId=%d, userId=%d, err=%v", series.ID, userID, err)
+	}
+
+	l.Infof("整期报名成功: seriesId=%d, userId=%d, enrolled=%d", series.ID, userID, enrolled)
+	return &activity.RegisterActivitySeriesResp{
+		Subscribed: true,
+		Enrolled:   int32(enrolled),
+	}, nil
+}
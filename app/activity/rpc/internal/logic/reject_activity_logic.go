@@ -2,6 +2,7 @@ package logic
 
 import (
 	"context"
+	"strings"
 
 	"activity-platform/app/activity/rpc/activity"
 	"activity-platform/app/activity/rpc/internal/svc"
@@ -24,7 +25,26 @@ func NewRejectActivityLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Re
 	}
 }
 
+// RejectActivity 审核驳回（管理员）
+// 状态流转：Pending(1) → Rejected(5)，驳回原因展示给组织者，修改后可重新提交
 func (l *RejectActivityLogic) RejectActivity(in *activity.RejectActivityReq) (*activity.RejectActivityResp, error) {
-	// MVP 版本无后台管理系统，拒绝功能暂不支持
-	return nil, errorx.NewWithMessage(errorx.CodeServiceUnavailable, "拒绝功能暂未开放")
+	if in.Id <= 0 {
+		return nil, errorx.ErrInvalidParams("活动ID无效")
+	}
+	if in.OperatorId <= 0 {
+		return nil, errorx.ErrInvalidParams("操作者信息缺失")
+	}
+	reason := strings.TrimSpace(in.Reason)
+	if reason == "" {
+		return nil, errorx.ErrInvalidParams("请填写驳回原因")
+	}
+	if len([]rune(reason)) > 500 {
+		return nil, errorx.ErrInvalidParams("驳回原因不能超过500字")
+	}
+
+	status, err := applyReviewDecision(l.ctx, l.svcCtx, uint64(in.Id), uint64(in.OperatorId), false, reason)
+	if err != nil {
+		return nil, err
+	}
+	return &activity.RejectActivityResp{Status: int32(status)}, nil
 }
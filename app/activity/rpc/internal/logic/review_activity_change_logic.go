@@ -0,0 +1,285 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"activity-platform/app/activity/model"
+	"activity-platform/app/activity/rpc/activity"
+	"activity-platform/app/activity/rpc/internal/svc"
+	"activity-platform/common/errorx"
+	"activity-platform/common/messaging"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+)
+
+type ReviewActivityChangeLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewReviewActivityChangeLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ReviewActivityChangeLogic {
+	return &ReviewActivityChangeLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ReviewActivityChange 管理员审核变更申请
+//
+// 通过时在同一事务内：
+//  1. 写入活动新的时间/地点（乐观锁）
+//  2. 按新的活动时间重算未使用票据的可核销时间窗
+//  3. 申请置为已生效，开启无责取消窗口（ChangeRequest.FreeCancelHours，不晚于新的开始时间）
+//  4. 记录状态日志与变更事件（同步 ES、详情缓存）
+//
+// 提交后通知组织者审核结果；通过时逐一通知报名者新旧取值与无责取消截止时间，
+// 并按新的时间重排报名者的开始前提醒与收藏者的报名截止提醒
+func (l *ReviewActivityChangeLogic) ReviewActivityChange(in *activity.ReviewActivityChangeReq) (*activity.ReviewActivityChangeResp, error) {
+	// 1. 参数校验
+	if in.RequestId <= 0 {
+		return nil, errorx.ErrInvalidParams("变更申请ID无效")
+	}
+	if in.OperatorId <= 0 {
+		return nil, errorx.ErrInvalidParams("操作者信息缺失")
+	}
+	reason := strings.TrimSpace(in.Reason)
+	if !in.Approve && reason == "" {
+		return nil, errorx.ErrInvalidParams("请填写驳回原因")
+	}
+	if len([]rune(reason)) > maxChangeReasonLen {
+		return nil, errorx.ErrInvalidParams("驳回原因不能超过500字")
+	}
+
+	// 2. 查询申请与活动
+	req, err := l.svcCtx.ChangeRequestModel.FindByID(l.ctx, uint64(in.RequestId))
+	if err != nil {
+		if errors.Is(err, model.ErrChangeRequestNotFound) {
+			return nil, errorx.New(errorx.CodeActivityChangeNotFound)
+		}
+		l.Errorf("查询变更申请失败: id=%d, err=%v", in.RequestId, err)
+		return nil, errorx.ErrDBError(err)
+	}
+	if req.Status != model.ChangeRequestStatusPending {
+		return nil, errorx.New(errorx.CodeActivityChangeNotFound)
+	}
+	activityData, err := l.svcCtx.ActivityModel.FindByID(l.ctx, req.ActivityID)
+	if err != nil {
+		if errors.Is(err, model.ErrActivityNotFound) {
+			return nil, errorx.New(errorx.CodeActivityNotFound)
+		}
+		l.Errorf("查询活动失败: id=%d, err=%v", req.ActivityID, err)
+		return nil, errorx.ErrDBError(err)
+	}
+
+	if !in.Approve {
+		return l.reject(req, activityData, uint64(in.OperatorId), reason)
+	}
+	return l.apply(req, activityData, uint64(in.OperatorId))
+}
+
+// reject 驳回申请（活动信息不变）
+func (l *ReviewActivityChangeLogic) reject(req *model.ActivityChangeRequest, activityData *model.Activity,
+	operatorID uint64, reason string) (*activity.ReviewActivityChangeResp, error) {
+	err := l.svcCtx.ChangeRequestModel.Decide(l.ctx, l.svcCtx.DB, req.ID, model.ChangeRequestStatusRejected,
+		operatorID, reason, time.Now().Unix(), 0)
+	if err != nil {
+		if errors.Is(err, model.ErrActivityConcurrentUpdate) {
+			return nil, errorx.New(errorx.CodeActivityChangeNotFound)
+		}
+		l.Errorf("驳回变更申请失败: id=%d, err=%v", req.ID, err)
+		return nil, errorx.ErrDBError(err)
+	}
+
+	if l.svcCtx.MsgProducer != nil {
+		l.svcCtx.MsgProducer.PublishActivityChangeReviewed(l.ctx, messaging.ActivityChangeReviewedEvent{
+			ActivityID:  activityData.ID,
+			OrganizerID: activityData.OrganizerID,
+			Title:       activityData.Title,
+			Approved:    false,
+			Reason:      reason,
+			Changes:     toFieldChangeEvents(req.Changes()),
+		})
+	}
+
+	l.Infof("[ActivityChange] 驳回变更申请: requestId=%d, activityId=%d, operatorId=%d", req.ID, activityData.ID, operatorID)
+	return &activity.ReviewActivityChangeResp{Status: int32(model.ChangeRequestStatusRejected)}, nil
+}
+
+// apply 审核通过并使变更生效
+func (l *ReviewActivityChangeLogic) apply(req *model.ActivityChangeRequest, activityData *model.Activity,
+	operatorID uint64) (*activity.ReviewActivityChangeResp, error) {
+	// 1. 活动状态与变更内容复核（申请提交后活动可能已开始或取消）
+	if activityData.Status != model.StatusPublished {
+		return nil, errorx.NewWithMessage(errorx.CodeActivityStatusInvalid, "活动已不是已发布状态，请驳回该申请")
+	}
+	newFields, ok := model.DecodeActivityChangeFields(req.NewValues)
+	if !ok {
+		l.Errorf("变更申请内容解析失败: id=%d", req.ID)
+		return nil, errorx.NewWithMessage(errorx.CodeInternalError, "变更申请内容无效")
+	}
+	if err := validateChangeTimes(activityData.RegisterStartTime, newFields); err != nil {
+		return nil, err
+	}
+	changes := model.DiffActivityChangeFields(model.NewActivityChangeFields(activityData), newFields)
+
+	// 2. 计算无责取消窗口与票据核销时间窗
+	now := time.Now().Unix()
+	freeCancelUntil := now + int64(l.freeCancelHours())*3600
+	if freeCancelUntil > newFields.ActivityStartTime {
+		freeCancelUntil = newFields.ActivityStartTime
+	}
+	validStart, validEnd, ok := ticketVerifyWindow(newFields.ActivityStartTime, newFields.ActivityEndTime)
+	if !ok {
+		return nil, errorx.NewWithMessage(errorx.CodeActivityTimeInvalid, "变更后的活动时间无效")
+	}
+
+	// 3. 事务：活动字段 + 票据时间窗 + 申请结论 + 状态日志 + 变更事件
+	var ticketCount int64
+	err := l.svcCtx.DB.WithContext(l.ctx).Transaction(func(tx *gorm.DB) error {
+		updates := newFields.Updates()
+		updates["version"] = gorm.Expr("version + 1")
+		result := tx.Model(&model.Activity{}).
+			Where("id = ? AND version = ? AND status = ?", activityData.ID, activityData.Version, model.StatusPublished).
+			Updates(updates)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return model.ErrActivityConcurrentUpdate
+		}
+
+		var err error
+		ticketCount, err = l.svcCtx.ActivityTicketModel.UpdateValidWindowByActivity(l.ctx, tx, activityData.ID, validStart, validEnd)
+		if err != nil {
+			return err
+		}
+		if err := l.svcCtx.ChangeRequestModel.Decide(l.ctx, tx, req.ID, model.ChangeRequestStatusApplied,
+			operatorID, "", now, freeCancelUntil); err != nil {
+			return err
+		}
+		if err := l.svcCtx.StatusLogModel.Create(l.ctx, tx, &model.ActivityStatusLog{
+			ActivityID:   activityData.ID,
+			FromStatus:   activityData.Status,
+			ToStatus:     activityData.Status,
+			OperatorID:   operatorID,
+			OperatorType: model.OperatorTypeAdmin,
+			Reason:       truncateStatusLogReason("变更申请生效：" + summarizeChanges(changes)),
+		}); err != nil {
+			return err
+		}
+		return l.svcCtx.ChangeEventModel.Record(l.ctx, tx, activityData.ID, model.ChangeTypeUpdate, model.ChangeSourceActivityRpc)
+	})
+	if err != nil {
+		if errors.Is(err, model.ErrActivityConcurrentUpdate) {
+			return nil, errorx.New(errorx.CodeActivityConcurrentUpdate)
+		}
+		l.Errorf("变更申请生效失败: id=%d, activityId=%d, err=%v", req.ID, activityData.ID, err)
+		return nil, errorx.ErrDBError(err)
+	}
+
+	// 4. 删除缓存
+	if l.svcCtx.ActivityCache != nil {
+		if err := l.svcCtx.ActivityCache.Invalidate(l.ctx, activityData.ID); err != nil {
+			l.Infof("[WARNING] 删除活动缓存失败: id=%d, err=%v", activityData.ID, err)
+		}
+	}
+
+	// 系列场次改期/改址后不再跟随整期修改
+	markOccurrenceOverridden(l.ctx, l.svcCtx, activityData.ID)
+
+	// 5. 通知组织者与报名者
+	registrantIDs, err := l.svcCtx.ActivityRegistrationModel.ListSuccessUserIDs(l.ctx, activityData.ID)
+	if err != nil {
+		// 变更已生效，通知名单查询失败只记录日志
+		l.Errorf("查询报名者失败，变更通知未发送: activityId=%d, err=%v", activityData.ID, err)
+	}
+	l.rescheduleReminders(activityData, newFields, registrantIDs)
+	if l.svcCtx.MsgProducer != nil {
+		l.svcCtx.MsgProducer.PublishActivityChangeReviewed(l.ctx, messaging.ActivityChangeReviewedEvent{
+			ActivityID:      activityData.ID,
+			OrganizerID:     activityData.OrganizerID,
+			Title:           activityData.Title,
+			Approved:        true,
+			Reason:          req.Reason,
+			Changes:         toFieldChangeEvents(changes),
+			RegistrantIDs:   registrantIDs,
+			FreeCancelUntil: time.Unix(freeCancelUntil, 0),
+		})
+	}
+
+	l.Infof("[ActivityChange] 变更申请生效: requestId=%d, activityId=%d, operatorId=%d, tickets=%d, registrants=%d, freeCancelUntil=%d",
+		req.ID, activityData.ID, operatorID, ticketCount, len(registrantIDs), freeCancelUntil)
+
+	return &activity.ReviewActivityChangeResp{
+		Status:          int32(model.ChangeRequestStatusApplied),
+		FreeCancelUntil: freeCancelUntil,
+		NotifiedCount:   int32(len(registrantIDs)),
+	}, nil
+}
+
+// rescheduleReminders 按变更后的时间重排提醒
+// 报名者名单查询失败时不重排开始前提醒，旧任务到期后由 ReminderCron 按活动最新时间校正
+func (l *ReviewActivityChangeLogic) rescheduleReminders(activityData *model.Activity,
+	newFields model.ActivityChangeFields, registrantIDs []uint64) {
+	if l.svcCtx.Reminders == nil {
+		return
+	}
+	updated := *activityData
+	updated.RegisterEndTime = newFields.RegisterEndTime
+	updated.ActivityStartTime = newFields.ActivityStartTime
+	updated.ActivityEndTime = newFields.ActivityEndTime
+
+	favoriteUserIDs, err := l.svcCtx.FavoriteModel.ListUserIDs(l.ctx, activityData.ID)
+	if err != nil {
+		l.Errorf("查询收藏用户失败: activityId=%d, err=%v", activityData.ID, err)
+	}
+	if err := l.svcCtx.Reminders.Reschedule(l.ctx, &updated, registrantIDs, favoriteUserIDs); err != nil {
+		l.Infof("[WARNING] 重排活动提醒失败: activityId=%d, err=%v", activityData.ID, err)
+	}
+}
+
+// freeCancelHours 无责取消窗口（小时）
+func (l *ReviewActivityChangeLogic) freeCancelHours() int {
+	if l.svcCtx.Config.ChangeRequest.FreeCancelHours <= 0 {
+		return 48
+	}
+	return l.svcCtx.Config.ChangeRequest.FreeCancelHours
+}
+
+// summarizeChanges 变更摘要（写入状态日志）
+func summarizeChanges(changes []model.ReviewFieldChange) string {
+	parts := make([]string, 0, len(changes))
+	for _, c := range changes {
+		parts = append(parts, fmt.Sprintf("%s %s→%s", c.Label, c.OldValue, c.NewValue))
+	}
+	return strings.Join(parts, "；")
+}
+
+// truncateStatusLogReason 截断状态日志原因（reason 字段最长 500 字）
+func truncateStatusLogReason(reason string) string {
+	runes := []rune(reason)
+	if len(runes) <= 500 {
+		return reason
+	}
+	return string(runes[:500])
+}
+
+// toFieldChangeEvents 字段变更转换为消息结构
+func toFieldChangeEvents(changes []model.ReviewFieldChange) []messaging.ActivityFieldChange {
+	events := make([]messaging.ActivityFieldChange, 0, len(changes))
+	for _, c := range changes {
+		events = append(events, messaging.ActivityFieldChange{
+			Label:    c.Label,
+			OldValue: c.OldValue,
+			NewValue: c.NewValue,
+		})
+	}
+	return events
+}
@@ -0,0 +1,113 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"activity-platform/app/activity/model"
+	"activity-platform/app/activity/rpc/activity"
+	"activity-platform/app/activity/rpc/internal/svc"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+)
+
+// maxFeedbackReviewNoteLen 审核备注最大长度（与 activity_content_reviews.review_note 列一致）
+const maxFeedbackReviewNoteLen = 500
+
+// errFeedbackReviewResolved 审核记录已被处理（并发审核）
+var errFeedbackReviewResolved = errors.New("feedback review already resolved")
+
+type ReviewFeedbackCommentLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewReviewFeedbackCommentLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ReviewFeedbackCommentLogic {
+	return &ReviewFeedbackCommentLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ReviewFeedbackComment 管理员审核评价短评
+//
+// 同一事务内：
+//  1. 审核记录 待审核 → 通过/驳回（条件更新，并发审核只有一方成功）
+//  2. 短评 待审核 → 公开/隐藏
+//
+// 用户在审核前重新提交了评价时，旧审核记录不再对应当前短评：只处理审核记录，
+// 短评状态以新提交的检测结果为准（新短评命中送审词时有新的审核记录）
+func (l *ReviewFeedbackCommentLogic) ReviewFeedbackComment(in *activity.ReviewFeedbackCommentReq) (*activity.ReviewFeedbackCommentResp, error) {
+	// 1. 参数校验
+	if in.ReviewId <= 0 {
+		return nil, errorx.ErrInvalidParams("审核记录ID无效")
+	}
+	if in.OperatorId <= 0 {
+		return nil, errorx.ErrInvalidParams("操作者信息缺失")
+	}
+	note := strings.TrimSpace(in.Note)
+	if len([]rune(note)) > maxFeedbackReviewNoteLen {
+		return nil, errorx.ErrInvalidParams("审核备注不能超过500字")
+	}
+
+	// 2. 查询审核记录
+	review, err := l.svcCtx.ContentReviewModel.FindByID(l.ctx, uint64(in.ReviewId))
+	if err != nil {
+		if errors.Is(err, model.ErrContentReviewNotFound) {
+			return nil, errorx.New(errorx.CodeFeedbackReviewNotFound)
+		}
+		l.Errorf("查询审核记录失败: id=%d, err=%v", in.ReviewId, err)
+		return nil, errorx.ErrDBError(err)
+	}
+	if review.Scene != model.ContentSceneFeedback || review.FeedbackID == 0 ||
+		review.Status != model.ContentReviewPending {
+		return nil, errorx.New(errorx.CodeFeedbackReviewNotFound)
+	}
+
+	reviewStatus, commentStatus := model.ContentReviewRejected, model.FeedbackCommentHidden
+	if in.Approve {
+		reviewStatus, commentStatus = model.ContentReviewPassed, model.FeedbackCommentVisible
+	}
+
+	// 3. 事务内处理审核记录与短评状态
+	var commentUpdated bool
+	err = l.svcCtx.DB.WithContext(l.ctx).Transaction(func(tx *gorm.DB) error {
+		resolved, err := l.svcCtx.ContentReviewModel.Resolve(l.ctx, tx, review.ID, reviewStatus, uint64(in.OperatorId), note)
+		if err != nil {
+			return err
+		}
+		if !resolved {
+			return errFeedbackReviewResolved
+		}
+
+		superseded, err := l.svcCtx.ContentReviewModel.HasNewerFeedbackReview(l.ctx, tx, review.FeedbackID, review.ID)
+		if err != nil {
+			return err
+		}
+		if superseded {
+			return nil
+		}
+		commentUpdated, err = l.svcCtx.FeedbackModel.ResolvePendingComment(l.ctx, tx, review.FeedbackID, commentStatus)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, errFeedbackReviewResolved) {
+			return nil, errorx.New(errorx.CodeFeedbackReviewNotFound)
+		}
+		l.Errorf("审核评价短评失败: reviewId=%d, err=%v", in.ReviewId, err)
+		return nil, errorx.ErrDBError(err)
+	}
+
+	l.Infof("评价短评已审核: reviewId=%d, feedbackId=%d, operatorId=%d, approve=%v, commentUpdated=%v",
+		review.ID, review.FeedbackID, in.OperatorId, in.Approve, commentUpdated)
+
+	return &activity.ReviewFeedbackCommentResp{
+		Status:         int32(reviewStatus),
+		CommentUpdated: commentUpdated,
+	}, nil
+}
@@ -0,0 +1,203 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"activity-platform/app/activity/model"
+	"activity-platform/app/activity/rpc/internal/svc"
+	userpb "activity-platform/app/user/rpc/pb/pb"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+)
+
+// 审核模式（对应 Review.Mode 配置）
+const (
+	reviewModePolicy = "policy" // 按信用等级判定
+	reviewModeManual = "manual" // 全部人工审核
+	reviewModeAuto   = "auto"   // 全部自动通过
+)
+
+// unknownCreditLevel 调用方未获取信用等级时传入，由判定逻辑自行查询
+const unknownCreditLevel int32 = -1
+
+// reviewDecision 发布审核判定结果
+type reviewDecision struct {
+	AutoApprove bool
+	Reason      string // 判定说明（写入审核单与状态日志）
+}
+
+// TargetStatus 判定后活动应进入的状态
+func (d reviewDecision) TargetStatus() int8 {
+	if d.AutoApprove {
+		return model.StatusPublished
+	}
+	return model.StatusPending
+}
+
+// decideReview 按审核策略判定提交发布是否自动通过
+//
+// 命中送审词的内容无论何种模式都进入人工审核；
+// policy 模式下信用等级查询失败时保守处理，转人工审核
+func decideReview(ctx context.Context, svcCtx *svc.ServiceContext, organizerID uint64, creditLevel int32, flagged bool) reviewDecision {
+	if flagged {
+		return reviewDecision{Reason: "内容命中送审词，需人工审核"}
+	}
+
+	cfg := svcCtx.Config.Review
+	switch cfg.Mode {
+	case reviewModeAuto:
+		return reviewDecision{AutoApprove: true, Reason: "审核策略：自动通过"}
+	case reviewModeManual:
+		return reviewDecision{Reason: "审核策略：人工审核"}
+	}
+
+	if creditLevel == unknownCreditLevel {
+		resp, err := svcCtx.CreditRpc.GetCreditInfo(ctx, &userpb.GetCreditInfoReq{UserId: int64(organizerID)})
+		if err != nil {
+			logx.WithContext(ctx).Errorf("[Review] 查询组织者信用等级失败，转人工审核: organizerId=%d, err=%v", organizerID, err)
+			return reviewDecision{Reason: "信用等级查询失败，需人工审核"}
+		}
+		creditLevel = resp.Level
+	}
+	if int(creditLevel) >= cfg.AutoApproveMinLevel {
+		return reviewDecision{AutoApprove: true, Reason: fmt.Sprintf("信用等级 Lv%d，自动通过", creditLevel)}
+	}
+	return reviewDecision{Reason: fmt.Sprintf("信用等级 Lv%d 低于自动通过要求 Lv%d，需人工审核",
+		creditLevel, cfg.AutoApproveMinLevel)}
+}
+
+// newActivityReview 根据判定结果构建审核单
+//
+// 自动通过的审核单同时保存内容快照，作为后续审核的差异基准
+func newActivityReview(svcCtx *svc.ServiceContext, act *model.Activity, decision reviewDecision, flagged bool) *model.ActivityReview {
+	now := time.Now().Unix()
+	review := &model.ActivityReview{
+		ActivityID:   act.ID,
+		OrganizerID:  act.OrganizerID,
+		Status:       model.ReviewStatusPending,
+		PolicyReason: decision.Reason,
+		Flagged:      flagged,
+		SubmittedAt:  now,
+		DueAt:        now + int64(reviewSLAHours(svcCtx))*3600,
+	}
+	if decision.AutoApprove {
+		review.Status = model.ReviewStatusAutoApproved
+		review.DueAt = now
+		review.DecidedAt = now
+		review.Snapshot = model.NewReviewSnapshot(act).Encode()
+	}
+	return review
+}
+
+// reviewSLAHours 人工审核时限（小时）
+func reviewSLAHours(svcCtx *svc.ServiceContext) int {
+	if svcCtx.Config.Review.SLAHours <= 0 {
+		return 24
+	}
+	return svcCtx.Config.Review.SLAHours
+}
+
+// ==================== 管理员审核 ====================
+
+// applyReviewDecision 管理员审核待审核活动（通过 / 驳回）
+//
+// 事务内完成：活动状态流转、审核单结论（通过时保存内容快照）、状态变更日志、
+// 关联的送审内容记录、变更事件；提交后通知组织者审核结果
+func applyReviewDecision(ctx context.Context, svcCtx *svc.ServiceContext, activityID, operatorID uint64, approve bool, reason string) (int8, error) {
+	logger := logx.WithContext(ctx)
+
+	// 1. 查询活动并校验状态
+	act, err := svcCtx.ActivityModel.FindByID(ctx, activityID)
+	if err != nil {
+		if errors.Is(err, model.ErrActivityNotFound) {
+			return 0, errorx.New(errorx.CodeActivityNotFound)
+		}
+		logger.Errorf("[Review] 查询活动失败: id=%d, err=%v", activityID, err)
+		return 0, errorx.ErrDBError(err)
+	}
+	if act.Status != model.StatusPending {
+		return 0, errorx.NewWithMessage(errorx.CodeActivityStatusInvalid, "只有待审核的活动可以审核")
+	}
+	if approve && act.ActivityStartTime <= time.Now().Unix() {
+		return 0, errorx.NewWithMessage(errorx.CodeActivityTimeInvalid, "活动开始时间已过，无法通过审核，请驳回")
+	}
+
+	// 2. 查询审核单并校验分配（已分配给他人的任务不能处理）
+	review, err := svcCtx.ReviewModel.FindPendingByActivityID(ctx, nil, activityID)
+	if err != nil && !errors.Is(err, model.ErrReviewNotFound) {
+		logger.Errorf("[Review] 查询审核单失败: activityId=%d, err=%v", activityID, err)
+		return 0, errorx.ErrDBError(err)
+	}
+	if review != nil && review.AssigneeID != 0 && review.AssigneeID != operatorID {
+		return 0, errorx.New(errorx.CodeActivityReviewAssigned)
+	}
+
+	newStatus, reviewStatus, contentStatus := model.StatusPublished, model.ReviewStatusApproved, model.ContentReviewPassed
+	logReason, snapshot := "管理员审核通过", model.NewReviewSnapshot(act).Encode()
+	if !approve {
+		newStatus, reviewStatus, contentStatus = model.StatusRejected, model.ReviewStatusRejected, model.ContentReviewRejected
+		logReason, snapshot = "管理员审核驳回："+reason, ""
+	}
+
+	// 3. 事务：状态流转 + 审核单 + 日志 + 送审记录 + 变更事件
+	err = svcCtx.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := svcCtx.ActivityModel.UpdateStatus(ctx, tx, act.ID, act.Version, newStatus, reason); err != nil {
+			return err
+		}
+		// 缺失审核单（如创建时写入失败）时补建
+		if review == nil {
+			review = newActivityReview(svcCtx, act, reviewDecision{Reason: "补建审核单"}, false)
+			if err := svcCtx.ReviewModel.Create(ctx, tx, review); err != nil {
+				return err
+			}
+		}
+		if err := svcCtx.ReviewModel.Decide(ctx, tx, review.ID, reviewStatus, operatorID, reason, snapshot); err != nil {
+			return err
+		}
+		if err := svcCtx.StatusLogModel.Create(ctx, tx, &model.ActivityStatusLog{
+			ActivityID:   act.ID,
+			FromStatus:   act.Status,
+			ToStatus:     newStatus,
+			OperatorID:   operatorID,
+			OperatorType: model.OperatorTypeAdmin,
+			Reason:       logReason,
+		}); err != nil {
+			return err
+		}
+		if err := svcCtx.ContentReviewModel.ResolvePendingByActivity(ctx, tx, act.ID, contentStatus, operatorID, logReason); err != nil {
+			return err
+		}
+		return svcCtx.ChangeEventModel.Record(ctx, tx, act.ID, model.ChangeTypeStatus, model.ChangeSourceActivityRpc)
+	})
+	if err != nil {
+		if errors.Is(err, model.ErrActivityConcurrentUpdate) {
+			return 0, errorx.New(errorx.CodeActivityConcurrentUpdate)
+		}
+		logger.Errorf("[Review] 审核活动失败: id=%d, approve=%v, err=%v", activityID, approve, err)
+		return 0, errorx.ErrDBError(err)
+	}
+
+	// 4. 删除缓存
+	if svcCtx.ActivityCache != nil {
+		if err := svcCtx.ActivityCache.Invalidate(ctx, act.ID); err != nil {
+			logger.Infof("[WARNING] 删除活动缓存失败: id=%d, err=%v", act.ID, err)
+		}
+	}
+
+	// 5. 异步通知：审核结果通知组织者；通过后发布活动创建事件（Chat 创建群聊）
+	if svcCtx.MsgProducer != nil {
+		svcCtx.MsgProducer.PublishActivityReviewed(ctx, act.ID, act.OrganizerID, act.Title, approve, reason)
+		if approve {
+			svcCtx.MsgProducer.PublishActivityCreated(ctx, act.ID, act.OrganizerID, act.Title, act.CoverURL)
+		}
+	}
+
+	logger.Infof("[Review] 活动审核完成: id=%d, operatorId=%d, approve=%v, status=%d->%d",
+		act.ID, operatorID, approve, act.Status, newStatus)
+	return newStatus, nil
+}
@@ -0,0 +1,70 @@
+package logic
+
+import (
+	"context"
+
+	"activity-platform/app/activity/model"
+	"activity-platform/app/activity/rpc/activity"
+	"activity-platform/app/activity/rpc/internal/svc"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SaveActivityTemplateLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSaveActivityTemplateLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SaveActivityTemplateLogic {
+	return &SaveActivityTemplateLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// SaveActivityTemplate 将活动另存为个人模板
+//
+// 只能保存自己组织的活动；模板引用原封面图片，封面引用计数 +1
+func (l *SaveActivityTemplateLogic) SaveActivityTemplate(in *activity.SaveActivityTemplateReq) (*activity.ActivityTemplateResp, error) {
+	// 1. 查询并校验原活动
+	source, err := findOrganizerActivity(l.ctx, l.svcCtx, in.ActivityId, in.OperatorId)
+	if err != nil {
+		return nil, err
+	}
+	name, err := normalizeTemplateName(in.Name, source.Title)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. 个人模板数量上限
+	count, err := l.svcCtx.TemplateModel.CountByOwner(l.ctx, uint64(in.OperatorId))
+	if err != nil {
+		l.Errorf("统计个人模板数失败: operatorId=%d, err=%v", in.OperatorId, err)
+		return nil, errorx.ErrDBError(err)
+	}
+	if count >= int64(l.svcCtx.Config.Template.MaxPrivatePerUser) {
+		return nil, errorx.New(errorx.CodeActivityTemplateLimit)
+	}
+
+	// 3. 生成模板并保存
+	tpl, err := templateFromActivity(l.ctx, l.svcCtx, source)
+	if err != nil {
+		return nil, err
+	}
+	tpl.Scope = model.TemplateScopePrivate
+	tpl.OwnerID = uint64(in.OperatorId)
+	tpl.Name = name
+	if err := l.svcCtx.TemplateModel.Create(l.ctx, tpl); err != nil {
+		l.Errorf("保存活动模板失败: activityId=%d, err=%v", source.ID, err)
+		return nil, errorx.ErrDBError(err)
+	}
+	adjustCoverRef(l.ctx, l.svcCtx, tpl.CoverImageID, 1)
+
+	l.Infof("[Template] 保存个人模板: id=%d, activityId=%d, operatorId=%d", tpl.ID, source.ID, in.OperatorId)
+	return &activity.ActivityTemplateResp{
+		Template: toActivityTemplate(tpl, templateCategoryName(l.ctx, l.svcCtx, tpl.CategoryID)),
+	}, nil
+}
@@ -3,6 +3,7 @@ package logic
 import (
 	"context"
 	"errors"
+	"sort"
 	"time"
 
 	"activity-platform/app/activity/model"
@@ -37,8 +38,10 @@ func NewSearchActivitiesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *
 // 搜索规则：
 //   - 关键词匹配：标题、描述、地点（OR 关系）
 //   - 只搜索公开状态：已发布(2)/进行中(3)/已结束(4)
-//   - 支持分类筛选
-//   - 支持多种排序：相关性/时间/热度
+//   - 支持分类、标签、时间范围、剩余名额、报名门槛筛选
+//   - 支持地理范围筛选（传入经纬度时）
+//   - 支持多种排序：相关性/时间/热度/距离
+//   - 返回分类/标签/状态分面统计和标题、描述高亮
 func (l *SearchActivitiesLogic) SearchActivities(in *activity.SearchActivitiesReq) (*activity.SearchActivitiesResp, error) {
 	startTime := time.Now()
 
@@ -58,7 +61,19 @@ func (l *SearchActivitiesLogic) SearchActivities(in *activity.SearchActivitiesRe
 	}
 
 	// 3. 降级到 MySQL LIKE 搜索
-	return l.searchWithMySQL(in, startTime)
+	resp, err := l.searchWithMySQL(in, startTime)
+	if err != nil {
+		return nil, err
+	}
+
+	// 4. MySQL 分面统计（失败不影响搜索结果）
+	facets, err := l.svcCtx.ActivityModel.SearchFacets(l.ctx, l.buildMySQLQuery(in))
+	if err != nil {
+		l.Errorf("[SearchActivities] MySQL 分面统计失败: %v", err)
+		return resp, nil
+	}
+	resp.Facets = l.buildFacets(facets.Categories, facets.Tags, facets.Statuses)
+	return resp, nil
 }
 
 // ==================== ES 搜索 ====================
@@ -75,6 +90,22 @@ func (l *SearchActivitiesLogic) searchWithES(in *activity.SearchActivitiesReq) (
 		Page:       int(in.Page),
 		PageSize:   int(in.PageSize),
 	}
+	if geo := geoCircleFromReq(in); geo != nil {
+		req.Geo = &search.GeoFilter{Lat: geo.Lat, Lon: geo.Lon, RadiusKm: geo.RadiusKm}
+	}
+	req.TagIDs = toUint64s(in.TagIds)
+	if in.StartTimeFrom > 0 {
+		req.StartTime = &in.StartTimeFrom
+	}
+	if in.StartTimeTo > 0 {
+		req.EndTime = &in.StartTimeTo
+	}
+	req.HasSeats = in.HasSeats
+	req.NoStudentVerify = in.NoStudentVerify
+	if in.MaxCreditScore != nil {
+		maxCredit := int(*in.MaxCreditScore)
+		req.MaxCreditScore = &maxCredit
+	}
 
 	// 2. 执行搜索
 	result, err := l.svcCtx.ESClient.SearchWithFallback(l.ctx, req)
@@ -104,11 +135,15 @@ func (l *SearchActivitiesLogic) searchWithES(in *activity.SearchActivitiesReq) (
 	l.Infof("[SearchActivities] ES 搜索成功: keyword=%s, total=%d, returned=%d, took_ms=%d",
 		in.Keyword, result.Total, len(list), queryTimeMs)
 
-	return &activity.SearchActivitiesResp{
+	resp := &activity.SearchActivitiesResp{
 		List:        list,
 		Total:       result.Total,
 		QueryTimeMs: int32(queryTimeMs),
-	}, nil
+	}
+	if result.Facets != nil {
+		resp.Facets = l.buildFacets(result.Facets.Categories, result.Facets.Tags, result.Facets.Statuses)
+	}
+	return resp, nil
 }
 
 // convertESDocToListItem 转换 ES 文档为列表项
@@ -120,14 +155,14 @@ func (l *SearchActivitiesLogic) convertESDocToListItem(doc *search.ActivityDoc)
 
 	return &activity.ActivityListItem{
 		Id:                     int64(doc.ID),
-		Title:                  doc.Title, // 可能包含高亮标签 <em>
+		Title:                  doc.Title,
 		CoverUrl:               doc.CoverURL,
 		CoverType:              int32(doc.CoverType),
 		CategoryName:           doc.CategoryName,
 		OrganizerName:          doc.OrganizerName,
 		OrganizerAvatar:        doc.OrganizerAvatar,
 		ActivityStartTime:      doc.ActivityStartTime,
-		Location:               doc.Location, // 可能包含高亮标签 <em>
+		Location:               doc.Location,
 		MaxParticipants:        int32(doc.MaxParticipants),
 		CurrentParticipants:    int32(doc.CurrentParticipants),
 		Status:                 int32(doc.Status),
@@ -135,8 +170,13 @@ func (l *SearchActivitiesLogic) convertESDocToListItem(doc *search.ActivityDoc)
 		Tags:                   l.convertTagStrings(doc.Tags),
 		ViewCount:              int64(doc.ViewCount),
 		CreatedAt:              doc.CreatedAt,
+		RatingAvg:              doc.RatingAvg,
+		RatingCount:            int32(doc.RatingCount),
 		RegistrationStatus:     regStatus,
 		RegistrationStatusText: regStatusText,
+		Distance:               doc.Distance,
+		TitleHighlight:         doc.TitleHighlight,
+		DescriptionHighlight:   doc.DescriptionHighlight,
 	}
 }
 
@@ -183,13 +223,7 @@ func (l *SearchActivitiesLogic) getStatusText(status int8) string {
 // searchWithMySQL 使用 MySQL LIKE 搜索（降级方案）
 func (l *SearchActivitiesLogic) searchWithMySQL(in *activity.SearchActivitiesReq, startTime time.Time) (*activity.SearchActivitiesResp, error) {
 	// 1. 构建搜索条件
-	query := &model.SearchQuery{
-		Keyword:    in.Keyword,
-		CategoryID: uint64(in.CategoryId),
-		Page:       int(in.Page),
-		PageSize:   int(in.PageSize),
-		Sort:       in.Sort,
-	}
+	query := l.buildMySQLQuery(in)
 
 	// 2. 执行搜索
 	result, err := l.svcCtx.ActivityModel.Search(l.ctx, query)
@@ -247,6 +281,13 @@ func (l *SearchActivitiesLogic) searchWithMySQL(in *activity.SearchActivitiesReq
 	list := make([]*activity.ActivityListItem, len(result.List))
 	for i, act := range result.List {
 		list[i] = l.buildActivityListItem(&act, categoryMap, tagsMap)
+		if query.Geo != nil {
+			list[i].Distance = model.DistanceMeters(query.Geo.Lat, query.Geo.Lon, act.Latitude, act.Longitude)
+		}
+		if in.Keyword != "" {
+			list[i].TitleHighlight = highlightKeyword(act.Title, in.Keyword)
+			list[i].DescriptionHighlight = highlightFragment(act.Description, in.Keyword, highlightFragmentSize)
+		}
 	}
 
 	// 6. 计算查询耗时
@@ -262,6 +303,98 @@ func (l *SearchActivitiesLogic) searchWithMySQL(in *activity.SearchActivitiesReq
 	}, nil
 }
 
+// buildMySQLQuery 构建 MySQL 搜索条件
+func (l *SearchActivitiesLogic) buildMySQLQuery(in *activity.SearchActivitiesReq) *model.SearchQuery {
+	query := &model.SearchQuery{
+		Keyword:         in.Keyword,
+		CategoryID:      uint64(in.CategoryId),
+		Page:            int(in.Page),
+		PageSize:        int(in.PageSize),
+		Sort:            in.Sort,
+		Geo:             geoCircleFromReq(in),
+		TagIDs:          toUint64s(in.TagIds),
+		StartTimeFrom:   in.StartTimeFrom,
+		StartTimeTo:     in.StartTimeTo,
+		HasSeats:        in.HasSeats,
+		NoStudentVerify: in.NoStudentVerify,
+	}
+	if in.MaxCreditScore != nil {
+		maxCredit := int(*in.MaxCreditScore)
+		query.MaxCreditScore = &maxCredit
+	}
+	return query
+}
+
+// ==================== 分面统计 ====================
+
+// buildFacets 构建分面统计响应（补充分类名、标签名、状态文本，按数量降序）
+func (l *SearchActivitiesLogic) buildFacets(categories, tags map[uint64]int64, statuses map[int8]int64) *activity.SearchFacets {
+	facets := &activity.SearchFacets{
+		Categories: make([]*activity.FacetBucket, 0, len(categories)),
+		Tags:       make([]*activity.FacetBucket, 0, len(tags)),
+		Statuses:   make([]*activity.FacetBucket, 0, len(statuses)),
+	}
+
+	// 1. 分类
+	if len(categories) > 0 {
+		categoryMap := l.loadCategoryMap()
+		for id, count := range categories {
+			facets.Categories = append(facets.Categories, &activity.FacetBucket{
+				Id:    int64(id),
+				Name:  categoryMap[id],
+				Count: count,
+			})
+		}
+	}
+
+	// 2. 标签
+	if len(tags) > 0 {
+		tagIDs := make([]uint64, 0, len(tags))
+		for id := range tags {
+			tagIDs = append(tagIDs, id)
+		}
+		tagNames := make(map[uint64]string, len(tagIDs))
+		if tagList, err := l.svcCtx.TagCacheModel.FindByIDs(l.ctx, tagIDs); err == nil {
+			for _, t := range tagList {
+				tagNames[t.ID] = t.Name
+			}
+		} else {
+			l.Infof("[WARNING] 查询标签名称失败: %v", err)
+		}
+		for id, count := range tags {
+			facets.Tags = append(facets.Tags, &activity.FacetBucket{
+				Id:    int64(id),
+				Name:  tagNames[id],
+				Count: count,
+			})
+		}
+	}
+
+	// 3. 状态
+	for status, count := range statuses {
+		facets.Statuses = append(facets.Statuses, &activity.FacetBucket{
+			Id:    int64(status),
+			Name:  l.getStatusText(status),
+			Count: count,
+		})
+	}
+
+	sortFacetBuckets(facets.Categories)
+	sortFacetBuckets(facets.Tags)
+	sortFacetBuckets(facets.Statuses)
+	return facets
+}
+
+// sortFacetBuckets 按数量降序排序，数量相同按 ID 升序（保证结果稳定）
+func sortFacetBuckets(buckets []*activity.FacetBucket) {
+	sort.Slice(buckets, func(i, j int) bool {
+		if buckets[i].Count != buckets[j].Count {
+			return buckets[i].Count > buckets[j].Count
+		}
+		return buckets[i].Id < buckets[j].Id
+	})
+}
+
 // ==================== 公共方法 ====================
 
 // validateParams 参数校验
@@ -292,14 +425,76 @@ func (l *SearchActivitiesLogic) validateParams(in *activity.SearchActivitiesReq)
 		"relevance": true,
 		"time":      true,
 		"hot":       true,
+		"distance":  true,
+		"rating":    true,
 	}
 	if !validSorts[in.Sort] {
-		return errorx.ErrInvalidParams("无效的排序方式，可选值：relevance/time/hot")
+		return errorx.ErrInvalidParams("无效的排序方式，可选值：relevance/time/hot/distance/rating")
+	}
+
+	// 4. 地理参数校验（经纬度可选，但传了就必须合法）
+	hasGeo := in.Longitude != 0 || in.Latitude != 0
+	if hasGeo {
+		if !model.ValidCoordinate(in.Longitude, in.Latitude) {
+			return errorx.ErrInvalidParams("经纬度不合法")
+		}
+		in.RadiusKm = normalizeRadiusKm(in.RadiusKm)
+	}
+	if in.Sort == "distance" && !hasGeo {
+		return errorx.ErrInvalidParams("按距离排序需要提供经纬度")
+	}
+
+	// 5. 高级筛选校验
+	if len(in.TagIds) > 5 {
+		return errorx.ErrInvalidParams("标签筛选最多5个")
+	}
+	if in.StartTimeFrom > 0 && in.StartTimeTo > 0 && in.StartTimeFrom > in.StartTimeTo {
+		return errorx.ErrInvalidParams("开始时间范围不合法")
+	}
+	if in.MaxCreditScore != nil && *in.MaxCreditScore < 0 {
+		return errorx.ErrInvalidParams("信用分门槛不能为负数")
 	}
 
 	return nil
 }
 
+// geoCircleFromReq 从搜索请求中提取地理范围（未传坐标时返回 nil）
+func geoCircleFromReq(in *activity.SearchActivitiesReq) *model.GeoCircle {
+	if in.Longitude == 0 && in.Latitude == 0 {
+		return nil
+	}
+	return &model.GeoCircle{
+		Lat:      in.Latitude,
+		Lon:      in.Longitude,
+		RadiusKm: in.RadiusKm,
+	}
+}
+
+// toUint64s 转换 ID 列表（过滤非法 ID）
+func toUint64s(ids []int64) []uint64 {
+	if len(ids) == 0 {
+		return nil
+	}
+	result := make([]uint64, 0, len(ids))
+	for _, id := range ids {
+		if id > 0 {
+			result = append(result, uint64(id))
+		}
+	}
+	return result
+}
+
+// normalizeRadiusKm 规范化搜索半径（默认 5km，最大 50km）
+func normalizeRadiusKm(radiusKm float64) float64 {
+	if radiusKm <= 0 {
+		return model.DefaultGeoRadiusKm
+	}
+	if radiusKm > model.MaxGeoRadiusKm {
+		return model.MaxGeoRadiusKm
+	}
+	return radiusKm
+}
+
 // loadCategoryMap 加载分类映射表
 func (l *SearchActivitiesLogic) loadCategoryMap() map[uint64]string {
 	// 优先使用缓存
@@ -362,6 +557,8 @@ func (l *SearchActivitiesLogic) buildActivityListItem(
 		Tags:                   l.convertTagCaches(tags),
 		ViewCount:              int64(act.ViewCount),
 		CreatedAt:              act.CreatedAt,
+		RatingAvg:              act.RatingAvg,
+		RatingCount:            int32(act.RatingCount),
 		RegistrationStatus:     regStatus,
 		RegistrationStatusText: regStatusText,
 	}
@@ -0,0 +1,120 @@
+package logic
+
+import (
+	"html"
+	"strings"
+)
+
+// ==================== MySQL 搜索高亮 ====================
+//
+// ES 使用 IK 分词器返回命中片段；MySQL 降级时只能做关键词原文匹配，
+// 这里生成与 ES 相同格式（<em> 包裹）的高亮结果，前端无需区分数据来源。
+// 标题、描述为用户输入，前端按 HTML 渲染高亮结果，因此原文必须先做 HTML 转义（与 ES encoder=html 一致）
+
+const (
+	highlightPreTag  = "<em>"
+	highlightPostTag = "</em>"
+
+	// highlightFragmentSize 描述高亮片段长度（字符数，与 ES fragment_size 对齐）
+	highlightFragmentSize = 60
+)
+
+// highlightKeyword 用 <em> 包裹文本中所有命中的关键词（不区分大小写）
+// 返回结果中除高亮标签外的文本均已 HTML 转义；未命中时返回空字符串
+func highlightKeyword(text, keyword string) string {
+	if text == "" || keyword == "" {
+		return ""
+	}
+
+	lowerText := strings.ToLower(text)
+	lowerKeyword := strings.ToLower(keyword)
+	// 大小写转换后长度变化（少见的 Unicode 字符）时无法按字节下标对齐，放弃高亮
+	if len(lowerText) != len(text) || len(lowerKeyword) != len(keyword) {
+		return ""
+	}
+
+	var b strings.Builder
+	matched := false
+	for {
+		idx := strings.Index(lowerText, lowerKeyword)
+		if idx < 0 {
+			break
+		}
+		matched = true
+		b.WriteString(html.EscapeString(text[:idx]))
+		b.WriteString(highlightPreTag)
+		b.WriteString(html.EscapeString(text[idx : idx+len(keyword)]))
+		b.WriteString(highlightPostTag)
+		text = text[idx+len(keyword):]
+		lowerText = lowerText[idx+len(keyword):]
+	}
+	if !matched {
+		return ""
+	}
+	b.WriteString(html.EscapeString(text))
+	return b.String()
+}
+
+// highlightFragment 截取首个命中位置附近的片段并高亮
+// 片段总长度约为 size 个字符，关键词尽量居中；未命中时返回空字符串
+func highlightFragment(text, keyword string, size int) string {
+	if text == "" || keyword == "" {
+		return ""
+	}
+
+	runes := []rune(text)
+	lowerRunes := []rune(strings.ToLower(text))
+	keywordRunes := []rune(strings.ToLower(keyword))
+	if len(lowerRunes) != len(runes) {
+		return ""
+	}
+
+	// 1. 定位首个命中位置（按字符）
+	pos := indexRunes(lowerRunes, keywordRunes)
+	if pos < 0 {
+		return ""
+	}
+
+	// 2. 计算片段窗口
+	start := pos - (size-len(keywordRunes))/2
+	if start < 0 {
+		start = 0
+	}
+	end := start + size
+	if end > len(runes) {
+		end = len(runes)
+		if start = end - size; start < 0 {
+			start = 0
+		}
+	}
+
+	// 3. 高亮片段内的关键词
+	fragment := highlightKeyword(string(runes[start:end]), keyword)
+	if start > 0 {
+		fragment = "..." + fragment
+	}
+	if end < len(runes) {
+		fragment += "..."
+	}
+	return fragment
+}
+
+// indexRunes 在 s 中查找 sub 的首个位置（字符下标）
+func indexRunes(s, sub []rune) int {
+	if len(sub) == 0 || len(sub) > len(s) {
+		return -1
+	}
+	for i := 0; i+len(sub) <= len(s); i++ {
+		match := true
+		for j := range sub {
+			if s[i+j] != sub[j] {
+				match = false
+				break
+			}
+		}
+		if match {
+			return i
+		}
+	}
+	return -1
+}
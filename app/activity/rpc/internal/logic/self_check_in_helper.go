@@ -0,0 +1,86 @@
+package logic
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"activity-platform/app/activity/model"
+	"activity-platform/app/activity/rpc/internal/svc"
+	"activity-platform/common/errorx"
+)
+
+// ==================== 自助签到公共方法 ====================
+//
+// 现场签到码与票券 TOTP 使用同一算法（30 秒一轮），密钥为活动维度的 CodeSecret；
+// 校验时允许前后各一轮的偏差，兼容大屏刷新与网络延迟
+
+// findOrganizerActivity 查询活动并校验操作者为组织者
+func findOrganizerActivity(ctx context.Context, svcCtx *svc.ServiceContext, activityID, operatorID int64) (*model.Activity, error) {
+	if activityID <= 0 {
+		return nil, errorx.ErrInvalidParams("活动ID无效")
+	}
+	if operatorID <= 0 {
+		return nil, errorx.ErrInvalidParams("操作者信息缺失")
+	}
+	activityData, err := svcCtx.ActivityModel.FindByID(ctx, uint64(activityID))
+	if err != nil {
+		if errors.Is(err, model.ErrActivityNotFound) {
+			return nil, errorx.New(errorx.CodeActivityNotFound)
+		}
+		return nil, errorx.ErrDBError(err)
+	}
+	if activityData.OrganizerID != uint64(operatorID) {
+		return nil, errorx.New(errorx.CodeActivityPermissionDenied)
+	}
+	return activityData, nil
+}
+
+// generateSelfCheckInSecret 生成签到码密钥
+func generateSelfCheckInSecret() (string, error) {
+	buf := make([]byte, 20)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(buf), nil
+}
+
+// currentSelfCheckInCode 当前轮次的签到码及其失效时间
+func currentSelfCheckInCode(secret string, now time.Time) (string, int64, error) {
+	code, err := generateTotpCode(secret, now)
+	if err != nil {
+		return "", 0, err
+	}
+	expiresAt := (now.Unix()/totpStepSeconds + 1) * totpStepSeconds
+	return code, expiresAt, nil
+}
+
+// verifySelfCheckInCode 校验签到码（允许前后各一轮偏差）
+func verifySelfCheckInCode(secret, input string, now time.Time) bool {
+	code := strings.TrimSpace(input)
+	if secret == "" || code == "" {
+		return false
+	}
+	for offset := -verifyTotpSkewStep; offset <= verifyTotpSkewStep; offset++ {
+		t := now.Add(time.Duration(offset*totpStepSeconds) * time.Second)
+		expected, err := generateTotpCode(secret, t)
+		if err == nil && expected == code {
+			return true
+		}
+	}
+	return false
+}
+
+// generateCheckInNo 生成签到流水号
+func generateCheckInNo(now time.Time) (string, error) {
+	buf := make([]byte, 4)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return "CI" + strconv.FormatInt(now.UnixMilli(), 10) + hex.EncodeToString(buf), nil
+}
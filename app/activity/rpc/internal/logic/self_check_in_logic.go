@@ -0,0 +1,202 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"activity-platform/app/activity/model"
+	"activity-platform/app/activity/rpc/activity"
+	"activity-platform/app/activity/rpc/internal/svc"
+	"activity-platform/common/errorx"
+	"activity-platform/common/messaging"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+)
+
+type SelfCheckInLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSelfCheckInLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SelfCheckInLogic {
+	return &SelfCheckInLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// SelfCheckIn 参与者自助签到
+//
+// 依次校验：票券归属与状态 → 活动已开启自助签到 → 核销时间窗口（与扫码核销一致）
+// → 现场签到码 → 定位与活动地点的距离。通过后核销票券并写入签到记录；
+// 同一设备为多名用户签到时记录标记为疑似异常，签到本身不受影响
+func (l *SelfCheckInLogic) SelfCheckIn(in *activity.SelfCheckInReq) (*activity.SelfCheckInResp, error) {
+	ticketCode := strings.TrimSpace(in.TicketCode)
+	deviceID := strings.TrimSpace(in.DeviceId)
+	if in.ActivityId <= 0 || in.UserId <= 0 || ticketCode == "" {
+		return nil, errorx.ErrInvalidParams("签到参数不完整")
+	}
+	if strings.TrimSpace(in.RoomCode) == "" {
+		return nil, errorx.ErrInvalidParams("请输入现场签到码")
+	}
+	if !model.ValidCoordinate(in.Longitude, in.Latitude) {
+		return nil, errorx.ErrInvalidParams("无法获取定位，请开启定位权限后重试")
+	}
+	userID := uint64(in.UserId)
+
+	// 1) 幂等：同一请求重复提交返回首次结果
+	if in.ClientRequestId != "" {
+		record, err := l.svcCtx.CheckInRecordModel.FindByClientRequestID(l.ctx, in.ClientRequestId)
+		if err == nil {
+			// 请求ID已被其他用户使用：不能继续写入（唯一索引冲突），按参数错误返回
+			if record.UserID != userID {
+				return nil, errorx.ErrInvalidParams("请求ID已被使用，请重新提交")
+			}
+			return &activity.SelfCheckInResp{
+				Result:         "success",
+				DistanceMeters: int32(record.DistanceMeters),
+				CheckInTime:    record.CheckInTime,
+			}, nil
+		}
+		if !errors.Is(err, model.ErrCheckInRecordNotFound) {
+			l.Errorf("查询签到记录失败: clientRequestId=%s, err=%v", in.ClientRequestId, err)
+			return nil, errorx.ErrDBError(err)
+		}
+	}
+
+	// 2) 票券：必须是本人在该活动下未使用的票
+	ticket, err := l.svcCtx.ActivityTicketModel.FindByCode(l.ctx, ticketCode)
+	if err != nil {
+		if errors.Is(err, model.ErrTicketNotFound) {
+			return nil, errorx.New(errorx.CodeSelfCheckInTicketInvalid)
+		}
+		l.Errorf("自助签到查询票据失败: activityId=%d, ticketCode=%s, err=%v", in.ActivityId, ticketCode, err)
+		return nil, errorx.ErrDBError(err)
+	}
+	if ticket.ActivityID != uint64(in.ActivityId) || ticket.UserID != userID || ticket.Status != model.TicketStatusUnused {
+		return nil, errorx.New(errorx.CodeSelfCheckInTicketInvalid)
+	}
+
+	// 3) 活动与自助签到设置
+	activityInfo, err := l.svcCtx.ActivityModel.FindByID(l.ctx, ticket.ActivityID)
+	if err != nil {
+		if errors.Is(err, model.ErrActivityNotFound) {
+			return nil, errorx.New(errorx.CodeActivityNotFound)
+		}
+		l.Errorf("自助签到查询活动失败: activityId=%d, err=%v", ticket.ActivityID, err)
+		return nil, errorx.ErrDBError(err)
+	}
+	setting, err := l.svcCtx.SelfCheckInSettingModel.FindByActivityID(l.ctx, activityInfo.ID)
+	if err != nil {
+		l.Errorf("查询自助签到设置失败: activityId=%d, err=%v", activityInfo.ID, err)
+		return nil, errorx.ErrDBError(err)
+	}
+	if setting == nil || !setting.Enabled {
+		return nil, errorx.New(errorx.CodeSelfCheckInDisabled)
+	}
+
+	// 4) 时间窗口
+	now := time.Now()
+	nowUnix := now.Unix()
+	windowStart, windowEnd, ok := resolveVerifyWindow(ticket, activityInfo)
+	if !ok || nowUnix < windowStart || nowUnix > windowEnd {
+		return nil, errorx.New(errorx.CodeSelfCheckInWindowClosed)
+	}
+
+	// 5) 现场签到码
+	if !verifySelfCheckInCode(setting.CodeSecret, in.RoomCode, now) {
+		return nil, errorx.New(errorx.CodeSelfCheckInCodeInvalid)
+	}
+
+	// 6) 定位距离
+	distance := int(math.Round(model.DistanceMeters(in.Latitude, in.Longitude, activityInfo.Latitude, activityInfo.Longitude)))
+	if distance > setting.RadiusMeters {
+		return nil, errorx.NewWithMessage(errorx.CodeSelfCheckInOutOfRange,
+			fmt.Sprintf("您距离活动地点约 %d 米，超出签到范围（%d 米）", distance, setting.RadiusMeters))
+	}
+
+	// 7) 异常检测（不阻断签到）
+	flagReason := l.detectAnomaly(activityInfo.ID, userID, deviceID)
+
+	// 8) 核销票券并写入签到记录
+	checkInNo, err := generateCheckInNo(now)
+	if err != nil {
+		l.Errorf("生成签到流水号失败: err=%v", err)
+		return nil, errorx.New(errorx.CodeInternalError)
+	}
+	clientRequestID := in.ClientRequestId
+	if clientRequestID == "" {
+		clientRequestID = checkInNo
+	}
+	record := &model.CheckInRecord{
+		CheckInNo:       checkInNo,
+		TicketID:        ticket.ID,
+		TicketCode:      ticket.TicketCode,
+		ActivityID:      ticket.ActivityID,
+		UserID:          userID,
+		CheckInTime:     nowUnix,
+		Longitude:       in.Longitude,
+		Latitude:        in.Latitude,
+		ClientRequestID: clientRequestID,
+		CheckInSnapshot: buildVerifySnapshot(activityInfo, ticket, nowUnix),
+		CheckInMethod:   model.CheckInMethodSelf,
+		DeviceID:        deviceID,
+		DistanceMeters:  distance,
+		Flagged:         flagReason != "",
+		FlagReason:      flagReason,
+	}
+	err = l.svcCtx.DB.WithContext(l.ctx).Transaction(func(tx *gorm.DB) error {
+		if err := l.svcCtx.ActivityTicketModel.MarkUsedTx(l.ctx, tx, ticket.ID, nowUnix, activityInfo.Location, record.CheckInSnapshot); err != nil {
+			return err
+		}
+		return l.svcCtx.CheckInRecordModel.CreateTx(l.ctx, tx, record)
+	})
+	if err != nil {
+		if errors.Is(err, model.ErrTicketNotFound) {
+			// 并发下票券已被核销
+			return nil, errorx.New(errorx.CodeSelfCheckInTicketInvalid)
+		}
+		l.Errorf("自助签到写入失败: activityId=%d, userId=%d, err=%v", ticket.ActivityID, userID, err)
+		return nil, errorx.ErrDBError(err)
+	}
+
+	if record.Flagged {
+		l.Infof("[SelfCheckIn] 疑似异常签到: activityId=%d, userId=%d, deviceId=%s, reason=%s",
+			ticket.ActivityID, userID, deviceID, flagReason)
+	}
+
+	// 异步发布签到信用事件
+	l.svcCtx.MsgProducer.PublishCreditEvent(
+		l.ctx, messaging.CreditEventCheckin, int64(ticket.ActivityID), int64(ticket.UserID),
+	)
+
+	return &activity.SelfCheckInResp{
+		Result:         "success",
+		DistanceMeters: int32(distance),
+		CheckInTime:    nowUnix,
+	}, nil
+}
+
+// detectAnomaly 检测疑似代签，返回异常原因（空字符串表示正常）
+// 查询失败按正常处理，不影响签到
+func (l *SelfCheckInLogic) detectAnomaly(activityID, userID uint64, deviceID string) string {
+	if deviceID == "" {
+		return "未提供设备标识"
+	}
+	others, err := l.svcCtx.CheckInRecordModel.CountOtherUsersByDevice(l.ctx, activityID, deviceID, userID)
+	if err != nil {
+		l.Errorf("[SelfCheckIn] 设备签到统计失败: activityId=%d, deviceId=%s, err=%v", activityID, deviceID, err)
+		return ""
+	}
+	if others >= int64(l.svcCtx.Config.SelfCheckIn.DeviceUserLimit) {
+		return fmt.Sprintf("同一设备已为 %d 名其他用户签到", others)
+	}
+	return ""
+}
@@ -0,0 +1,325 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"activity-platform/app/activity/model"
+	"activity-platform/app/activity/rpc/activity"
+	"activity-platform/app/activity/rpc/internal/recurrence"
+	"activity-platform/app/activity/rpc/internal/svc"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// seriesRegisterStartDelay 场次报名开始时间至少晚于生成时刻（单场创建要求报名开始在当前时间之后）
+const seriesRegisterStartDelay = 60
+
+// seriesRetryableReasons 报名接口返回的临时性失败原因：不记录出票结果，下一轮重试
+var seriesRetryableReasons = map[string]struct{}{
+	"请求过于频繁，请稍后再试": {},
+	"报名失败，请稍后重试":   {},
+	"活动查询失败，请稍后重试": {},
+	"资格校验失败，请稍后重试": {},
+}
+
+// seriesPropagationKey 整期修改同步场次时的上下文标记
+type seriesPropagationKey struct{}
+
+// withSeriesPropagation 标记本次修改来自整期修改（场次不会被标记为单独修改）
+func withSeriesPropagation(ctx context.Context) context.Context {
+	return context.WithValue(ctx, seriesPropagationKey{}, true)
+}
+
+// isSeriesPropagation 是否为整期修改触发的场次更新
+func isSeriesPropagation(ctx context.Context) bool {
+	v, _ := ctx.Value(seriesPropagationKey{}).(bool)
+	return v
+}
+
+// markOccurrenceOverridden 单场被组织者单独修改后，不再跟随整期修改
+//
+// 非系列活动无影响；失败只记录日志，不影响单场修改结果
+func markOccurrenceOverridden(ctx context.Context, svcCtx *svc.ServiceContext, activityID uint64) {
+	if isSeriesPropagation(ctx) {
+		return
+	}
+	if err := svcCtx.SeriesModel.MarkOverridden(ctx, activityID); err != nil {
+		logx.WithContext(ctx).Errorf("[Series] 标记场次单独修改失败: activityId=%d, err=%v", activityID, err)
+	}
+}
+
+// findSeries 查询系列（不存在返回业务错误）
+func findSeries(ctx context.Context, svcCtx *svc.ServiceContext, id int64) (*model.ActivitySeries, error) {
+	if id <= 0 {
+		return nil, errorx.ErrInvalidParams("系列ID无效")
+	}
+	series, err := svcCtx.SeriesModel.FindByID(ctx, uint64(id))
+	if err != nil {
+		if errors.Is(err, model.ErrSeriesNotFound) {
+			return nil, errorx.New(errorx.CodeActivitySeriesNotFound)
+		}
+		logx.WithContext(ctx).Errorf("查询系列活动失败: id=%d, err=%v", id, err)
+		return nil, errorx.ErrDBError(err)
+	}
+	return series, nil
+}
+
+// seriesOccurrenceTimes 按规则计算系列全部场次的开始时间
+func seriesOccurrenceTimes(svcCtx *svc.ServiceContext, series *model.ActivitySeries) ([]time.Time, error) {
+	rule, err := recurrence.Parse(series.RRule)
+	if err != nil {
+		return nil, err
+	}
+	return rule.Occurrences(time.Unix(series.FirstStartTime, 0), svcCtx.Config.Series.MaxOccurrences), nil
+}
+
+// ==================== SeriesScheduler 场次生成与整期报名出票 ====================
+
+// SeriesScheduler 系列活动调度
+//
+// 由 SeriesCron 定时调用，创建系列与整期报名时也会立即执行一次；
+// 场次通过单场创建 / 报名流程落库，审核、信用分、名额规则与普通活动一致
+type SeriesScheduler struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSeriesScheduler(ctx context.Context, svcCtx *svc.ServiceContext) *SeriesScheduler {
+	return &SeriesScheduler{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// GenerateAll 为所有生成中的系列补齐未来场次（单个系列失败不影响其他系列）
+func (s *SeriesScheduler) GenerateAll() error {
+	ids, err := s.svcCtx.SeriesModel.ListActiveIDs(s.ctx)
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		series, err := s.svcCtx.SeriesModel.FindByID(s.ctx, id)
+		if err != nil {
+			s.Errorf("[SeriesScheduler] 查询系列失败: seriesId=%d, err=%v", id, err)
+			continue
+		}
+		if _, err := s.Generate(series); err != nil {
+			s.Errorf("[SeriesScheduler] 生成场次失败: seriesId=%d, err=%v", id, err)
+		}
+	}
+	return nil
+}
+
+// Generate 生成系列在提前生成窗口内的场次，返回本次新建的活动ID
+//
+// 流程：占位场次 → 单场创建 → 回填活动ID；创建失败释放占位并停止，下一轮从该场次重试。
+// 报名窗口已来不及开放的场次（如服务停机导致生成滞后）直接跳过
+func (s *SeriesScheduler) Generate(series *model.ActivitySeries) ([]int64, error) {
+	if series.Status != model.SeriesStatusActive {
+		return nil, nil
+	}
+	starts, err := seriesOccurrenceTimes(s.svcCtx, series)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	horizon := now.AddDate(0, 0, s.svcCtx.Config.Series.GenerateAheadDays)
+	generated, lastStart := series.GeneratedCount, series.LastGeneratedStartAt
+	var activityIDs []int64
+	var genErr error
+
+	for idx := series.GeneratedCount; idx < len(starts); idx++ {
+		start := starts[idx]
+		if start.After(horizon) {
+			break
+		}
+		startAt := start.Unix()
+		registerStart := startAt - series.RegisterOpenBefore
+		if earliest := now.Unix() + seriesRegisterStartDelay; registerStart < earliest {
+			registerStart = earliest
+		}
+		registerEnd := startAt - series.RegisterCloseBefore
+		if registerEnd <= registerStart {
+			s.Infof("[SeriesScheduler] 场次报名窗口已过，跳过: seriesId=%d, index=%d, start=%d", series.ID, idx, startAt)
+			generated = idx + 1
+			continue
+		}
+
+		claimed, err := s.svcCtx.SeriesModel.ClaimOccurrence(s.ctx, series.ID, idx, startAt)
+		if err != nil {
+			genErr = err
+			break
+		}
+		if claimed {
+			resp, err := NewCreateActivityLogic(s.ctx, s.svcCtx).CreateActivity(s.buildCreateReq(series, registerStart, registerEnd, startAt))
+			if err != nil {
+				if relErr := s.svcCtx.SeriesModel.ReleaseOccurrence(s.ctx, series.ID, idx); relErr != nil {
+					s.Errorf("[SeriesScheduler] 释放场次占位失败: seriesId=%d, index=%d, err=%v", series.ID, idx, relErr)
+				}
+				genErr = err
+				break
+			}
+			if err := s.svcCtx.SeriesModel.BindOccurrence(s.ctx, series.ID, idx, uint64(resp.Id)); err != nil {
+				// 活动已创建，回填失败会导致该场次不在系列中展示，只能人工处理
+				s.Errorf("[SeriesScheduler] 回填场次活动ID失败: seriesId=%d, index=%d, activityId=%d, err=%v",
+					series.ID, idx, resp.Id, err)
+			}
+			activityIDs = append(activityIDs, resp.Id)
+		}
+		generated, lastStart = idx+1, startAt
+	}
+
+	if generated != series.GeneratedCount {
+		finished := generated >= len(starts)
+		if err := s.svcCtx.SeriesModel.MarkGenerated(s.ctx, series.ID, generated, lastStart, finished); err != nil {
+			s.Errorf("[SeriesScheduler] 记录生成进度失败: seriesId=%d, generated=%d, err=%v", series.ID, generated, err)
+		}
+		series.GeneratedCount, series.LastGeneratedStartAt = generated, lastStart
+		if finished {
+			series.Status = model.SeriesStatusFinished
+		}
+		s.Infof("[SeriesScheduler] 场次生成完成: seriesId=%d, created=%d, generated=%d/%d",
+			series.ID, len(activityIDs), generated, len(starts))
+	}
+	return activityIDs, genErr
+}
+
+// buildCreateReq 按系列模板构造单场创建请求
+func (s *SeriesScheduler) buildCreateReq(series *model.ActivitySeries, registerStart, registerEnd, startAt int64) *activity.CreateActivityReq {
+	return &activity.CreateActivityReq{
+		Title:                series.Title,
+		CoverType:            int32(series.CoverType),
+		Content:              series.Description,
+		CategoryId:           int64(series.CategoryID),
+		ContactPhone:         series.ContactPhone,
+		RegisterStartTime:    registerStart,
+		RegisterEndTime:      registerEnd,
+		ActivityStartTime:    startAt,
+		ActivityEndTime:      startAt + series.DurationSeconds,
+		Location:             series.Location,
+		AddressDetail:        series.AddressDetail,
+		Longitude:            series.Longitude,
+		Latitude:             series.Latitude,
+		MaxParticipants:      int32(series.MaxParticipants),
+		RequireApproval:      series.RequireApproval,
+		RequireStudentVerify: series.RequireStudentVerify,
+		MinCreditScore:       int32(series.MinCreditScore),
+		TagIds:               series.Tags(),
+		OrganizerId:          int64(series.OrganizerID),
+		OrganizerName:        series.OrganizerName,
+		OrganizerAvatar:      series.OrganizerAvatar,
+		CoverImageId:         series.CoverImageID,
+	}
+}
+
+// EnrollAll 为所有开放整期报名的系列出票
+func (s *SeriesScheduler) EnrollAll() error {
+	ids, err := s.svcCtx.SeriesModel.ListIDsAllowSeriesRegister(s.ctx)
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		series, err := s.svcCtx.SeriesModel.FindByID(s.ctx, id)
+		if err != nil {
+			s.Errorf("[SeriesScheduler] 查询系列失败: seriesId=%d, err=%v", id, err)
+			continue
+		}
+		if _, err := s.Enroll(series, nil); err != nil {
+			s.Errorf("[SeriesScheduler] 整期报名出票失败: seriesId=%d, err=%v", id, err)
+		}
+	}
+	return nil
+}
+
+// Enroll 为整期报名用户在已开放报名的场次出票，返回成功出票数
+//
+// userIDs 为空时处理全部整期报名用户。每个 (场次, 用户) 只处理一次，
+// 名额已满、资格不满足等失败会被记录，不再重复尝试
+func (s *SeriesScheduler) Enroll(series *model.ActivitySeries, userIDs []uint64) (int, error) {
+	if !series.AllowSeriesRegister || series.Status == model.SeriesStatusCancelled {
+		return 0, nil
+	}
+	if userIDs == nil {
+		var err error
+		if userIDs, err = s.svcCtx.SeriesRegistrationModel.ListSubscriberIDs(s.ctx, series.ID); err != nil {
+			return 0, err
+		}
+	}
+	if len(userIDs) == 0 {
+		return 0, nil
+	}
+
+	now := time.Now().Unix()
+	occurrences, err := s.svcCtx.SeriesModel.ListFutureOccurrences(s.ctx, series.ID, now)
+	if err != nil {
+		return 0, err
+	}
+
+	enrolled := 0
+	for _, occ := range occurrences {
+		act, err := s.svcCtx.ActivityModel.FindByID(s.ctx, occ.ActivityID)
+		if err != nil {
+			s.Errorf("[SeriesScheduler] 查询场次失败: seriesId=%d, activityId=%d, err=%v", series.ID, occ.ActivityID, err)
+			continue
+		}
+		if act.Status != model.StatusPublished || now < act.RegisterStartTime || now > act.RegisterEndTime {
+			continue
+		}
+
+		done, err := s.svcCtx.SeriesRegistrationModel.ListEnrolledUserIDs(s.ctx, occ.ActivityID)
+		if err != nil {
+			s.Errorf("[SeriesScheduler] 查询出票记录失败: activityId=%d, err=%v", occ.ActivityID, err)
+			continue
+		}
+		doneSet := make(map[uint64]struct{}, len(done))
+		for _, uid := range done {
+			doneSet[uid] = struct{}{}
+		}
+
+		for _, uid := range userIDs {
+			if _, ok := doneSet[uid]; ok {
+				continue
+			}
+			if s.enrollOne(series.ID, occ.ActivityID, uid) {
+				enrolled++
+			}
+		}
+	}
+	return enrolled, nil
+}
+
+// enrollOne 为单个用户报名单个场次并记录结果
+func (s *SeriesScheduler) enrollOne(seriesID, activityID, userID uint64) bool {
+	resp, err := NewRegisterActivityLogic(s.ctx, s.svcCtx).RegisterActivity(&activity.RegisterActivityRequest{
+		ActivityId: int64(activityID),
+		UserId:     int64(userID),
+	})
+	if err != nil {
+		s.Errorf("[SeriesScheduler] 整期报名出票失败: activityId=%d, userId=%d, err=%v", activityID, userID, err)
+		return false
+	}
+	if _, retry := seriesRetryableReasons[resp.Reason]; retry && resp.Result != "success" {
+		return false
+	}
+
+	result := model.SeriesEnrollSuccess
+	if resp.Result != "success" {
+		result = model.SeriesEnrollFailed
+	}
+	if err := s.svcCtx.SeriesRegistrationModel.RecordEnrollment(s.ctx, &model.ActivitySeriesEnrollment{
+		SeriesID:   seriesID,
+		ActivityID: activityID,
+		UserID:     userID,
+		Result:     result,
+		Reason:     resp.Reason,
+	}); err != nil {
+		s.Errorf("[SeriesScheduler] 记录出票结果失败: activityId=%d, userId=%d, err=%v", activityID, userID, err)
+	}
+	return result == model.SeriesEnrollSuccess
+}
@@ -0,0 +1,69 @@
+package logic
+
+import (
+	"context"
+
+	"activity-platform/app/activity/model"
+	"activity-platform/app/activity/rpc/activity"
+	"activity-platform/app/activity/rpc/internal/svc"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SetCategoryStatusLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSetCategoryStatusLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SetCategoryStatusLogic {
+	return &SetCategoryStatusLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// SetCategoryStatus 启用/禁用分类（管理员）
+//
+// 禁用后分类不再出现在前台分类列表，也不能用于新建/编辑活动；
+// 已有活动保留原分类，其搜索文档中的分类名按启用状态重建
+func (l *SetCategoryStatusLogic) SetCategoryStatus(in *activity.SetCategoryStatusReq) (*activity.AdminCategoryResp, error) {
+	// 1. 参数校验
+	status := int8(in.Status)
+	if status != model.CategoryStatusEnabled && status != model.CategoryStatusDisabled {
+		return nil, errorx.ErrInvalidParams("分类状态无效")
+	}
+
+	// 2. 查询分类
+	cat, err := findCategoryAny(l.ctx, l.svcCtx, in.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. 更新并传播（状态未变时幂等返回）
+	if cat.Status != status {
+		if err := l.svcCtx.CategoryModel.UpdateFields(l.ctx, cat.ID, map[string]interface{}{"status": status}); err != nil {
+			l.Errorf("修改分类状态失败: id=%d, err=%v", cat.ID, err)
+			return nil, errorx.ErrDBError(err)
+		}
+		propagateCategoryChange(l.ctx, l.svcCtx, cat.ID, true)
+		l.Infof("[Category] 修改分类状态: id=%d, status=%d, operatorId=%d", cat.ID, status, in.OperatorId)
+	}
+
+	return buildCategoryResp(l.ctx, l.svcCtx, cat.ID)
+}
+
+// buildCategoryResp 查询最新分类数据组装响应
+func buildCategoryResp(ctx context.Context, svcCtx *svc.ServiceContext, id uint64) (*activity.AdminCategoryResp, error) {
+	cat, err := findCategoryAny(ctx, svcCtx, int64(id))
+	if err != nil {
+		return nil, err
+	}
+	count, err := svcCtx.CategoryModel.CountActivities(ctx, id)
+	if err != nil {
+		return nil, errorx.ErrDBError(err)
+	}
+	return &activity.AdminCategoryResp{Category: toAdminCategory(cat, count)}, nil
+}
@@ -0,0 +1,139 @@
+package logic
+
+import (
+	"context"
+	"errors"
+
+	"activity-platform/app/activity/model"
+	"activity-platform/app/activity/rpc/activity"
+	"activity-platform/app/activity/rpc/internal/eligibility"
+	"activity-platform/app/activity/rpc/internal/svc"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// eligibilityEditableStatuses 允许修改报名资格规则的活动状态
+// - 已发布仍可修改：收紧规则只影响后续报名，已报名用户不受影响
+// - 进行中/已结束/已取消：报名已结束，修改无意义
+var eligibilityEditableStatuses = map[int8]bool{
+	model.StatusDraft:     true,
+	model.StatusPending:   true,
+	model.StatusPublished: true,
+	model.StatusRejected:  true,
+}
+
+type SetEligibilityRulesLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSetEligibilityRulesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SetEligibilityRulesLogic {
+	return &SetEligibilityRulesLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// SetEligibilityRules 设置活动报名资格规则（覆盖式）
+//
+// 业务逻辑：
+//  1. 参数校验（规则数量、类型、取值）
+//  2. 权限校验：仅组织者
+//  3. 状态校验：草稿/待审核/已发布/已拒绝
+//  4. 事务内先删后插
+func (l *SetEligibilityRulesLogic) SetEligibilityRules(in *activity.SetEligibilityRulesReq) (*activity.SetEligibilityRulesResp, error) {
+	// 1. 参数校验
+	if in.ActivityId <= 0 {
+		return nil, errorx.ErrInvalidParams("活动ID无效")
+	}
+	if in.OperatorId <= 0 {
+		return nil, errorx.ErrInvalidParams("操作者信息缺失")
+	}
+	if len(in.Rules) > model.MaxEligibilityRules {
+		return nil, errorx.ErrInvalidParams("报名规则过多")
+	}
+
+	rules := make([]model.ActivityEligibilityRule, 0, len(in.Rules))
+	for _, r := range in.Rules {
+		if r == nil {
+			continue
+		}
+		rule := model.ActivityEligibilityRule{
+			RuleType: r.RuleType,
+			MinValue: int(r.MinValue),
+			MaxValue: int(r.MaxValue),
+			Values:   r.Values,
+		}
+		if err := rule.Normalize(); err != nil {
+			return nil, errorx.ErrInvalidParams(err.Error())
+		}
+		rules = append(rules, rule)
+	}
+
+	// 2. 查询活动
+	activityData, err := l.svcCtx.ActivityModel.FindByID(l.ctx, uint64(in.ActivityId))
+	if err != nil {
+		if errors.Is(err, model.ErrActivityNotFound) {
+			return nil, errorx.New(errorx.CodeActivityNotFound)
+		}
+		l.Errorf("查询活动失败: id=%d, err=%v", in.ActivityId, err)
+		return nil, errorx.ErrDBError(err)
+	}
+
+	// 3. 权限校验
+	if activityData.OrganizerID != uint64(in.OperatorId) {
+		l.Infof("[权限拒绝] 无权限设置报名规则: activityId=%d, organizerId=%d, operatorId=%d",
+			in.ActivityId, activityData.OrganizerID, in.OperatorId)
+		return nil, errorx.New(errorx.CodeActivityPermissionDenied)
+	}
+
+	// 4. 状态校验
+	if !eligibilityEditableStatuses[activityData.Status] {
+		return nil, errorx.NewWithMessage(errorx.CodeActivityStatusInvalid,
+			"当前活动状态不允许修改报名规则")
+	}
+
+	// 5. 覆盖写入
+	if err := l.svcCtx.EligibilityRuleModel.ReplaceByActivityID(l.ctx, activityData.ID, rules); err != nil {
+		l.Errorf("保存报名规则失败: activityId=%d, err=%v", in.ActivityId, err)
+		return nil, errorx.ErrDBError(err)
+	}
+
+	l.Infof("报名规则已更新: activityId=%d, operatorId=%d, count=%d", in.ActivityId, in.OperatorId, len(rules))
+
+	return &activity.SetEligibilityRulesResp{
+		Rules: toEligibilityRulePbList(rules),
+	}, nil
+}
+
+// toEligibilityRulePbList 规则转换为 Proto 格式
+func toEligibilityRulePbList(rules []model.ActivityEligibilityRule) []*activity.EligibilityRule {
+	list := make([]*activity.EligibilityRule, 0, len(rules))
+	for _, r := range rules {
+		list = append(list, &activity.EligibilityRule{
+			RuleType:    r.RuleType,
+			MinValue:    int32(r.MinValue),
+			MaxValue:    int32(r.MaxValue),
+			Values:      r.Values,
+			Description: eligibility.Describe(r),
+		})
+	}
+	return list
+}
+
+// toEligibilityCheckItemPbList 校验结果转换为 Proto 格式
+func toEligibilityCheckItemPbList(items []eligibility.CheckItem) []*activity.EligibilityCheckItem {
+	list := make([]*activity.EligibilityCheckItem, 0, len(items))
+	for _, item := range items {
+		list = append(list, &activity.EligibilityCheckItem{
+			RuleType:    item.RuleType,
+			Description: item.Description,
+			Passed:      item.Passed,
+			Reason:      item.Reason,
+		})
+	}
+	return list
+}
@@ -0,0 +1,92 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+
+	"activity-platform/app/activity/model"
+	"activity-platform/app/activity/rpc/activity"
+	"activity-platform/app/activity/rpc/internal/svc"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SetSelfCheckInLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSetSelfCheckInLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SetSelfCheckInLogic {
+	return &SetSelfCheckInLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// SetSelfCheckIn 组织者开启/关闭自助签到
+//
+// 开启要求活动已设置坐标且处于报名中/进行中；从关闭变为开启时更换签到码密钥，
+// 之前展示过的签到码全部失效。关闭不影响已完成的签到
+func (l *SetSelfCheckInLogic) SetSelfCheckIn(in *activity.SetSelfCheckInReq) (*activity.SetSelfCheckInResp, error) {
+	activityData, err := findOrganizerActivity(l.ctx, l.svcCtx, in.ActivityId, in.OperatorId)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := l.svcCtx.Config.SelfCheckIn
+	radius := int(in.RadiusMeters)
+	if radius == 0 {
+		radius = cfg.DefaultRadiusMeters
+	}
+	if radius < 0 || radius > cfg.MaxRadiusMeters {
+		return nil, errorx.ErrInvalidParams(fmt.Sprintf("签到半径需在 1-%d 米之间", cfg.MaxRadiusMeters))
+	}
+
+	existing, err := l.svcCtx.SelfCheckInSettingModel.FindByActivityID(l.ctx, activityData.ID)
+	if err != nil {
+		l.Errorf("查询自助签到设置失败: activityId=%d, err=%v", activityData.ID, err)
+		return nil, errorx.ErrDBError(err)
+	}
+
+	setting := &model.SelfCheckInSetting{
+		ActivityID:   activityData.ID,
+		Enabled:      in.Enabled,
+		RadiusMeters: radius,
+		OperatorID:   uint64(in.OperatorId),
+	}
+	if existing != nil {
+		setting.CodeSecret = existing.CodeSecret
+	}
+
+	if in.Enabled {
+		if !model.ValidCoordinate(activityData.Longitude, activityData.Latitude) {
+			return nil, errorx.New(errorx.CodeSelfCheckInNoLocation)
+		}
+		if activityData.Status != model.StatusPublished && activityData.Status != model.StatusOngoing {
+			return nil, errorx.New(errorx.CodeActivityStatusInvalid)
+		}
+		if existing == nil || !existing.Enabled || existing.CodeSecret == "" {
+			secret, err := generateSelfCheckInSecret()
+			if err != nil {
+				l.Errorf("生成签到码密钥失败: activityId=%d, err=%v", activityData.ID, err)
+				return nil, errorx.New(errorx.CodeInternalError)
+			}
+			setting.CodeSecret = secret
+		}
+	}
+
+	if err := l.svcCtx.SelfCheckInSettingModel.Upsert(l.ctx, setting); err != nil {
+		l.Errorf("保存自助签到设置失败: activityId=%d, err=%v", activityData.ID, err)
+		return nil, errorx.ErrDBError(err)
+	}
+
+	l.Infof("自助签到设置已更新: activityId=%d, operatorId=%d, enabled=%v, radius=%d",
+		activityData.ID, in.OperatorId, in.Enabled, radius)
+	return &activity.SetSelfCheckInResp{
+		Enabled:      setting.Enabled,
+		RadiusMeters: int32(setting.RadiusMeters),
+	}, nil
+}
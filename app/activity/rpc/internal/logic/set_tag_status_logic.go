@@ -0,0 +1,52 @@
+package logic
+
+import (
+	"context"
+
+	"activity-platform/app/activity/rpc/activity"
+	"activity-platform/app/activity/rpc/internal/svc"
+	userpb "activity-platform/app/user/rpc/pb/pb"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SetTagStatusLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSetTagStatusLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SetTagStatusLogic {
+	return &SetTagStatusLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// SetTagStatus 启用/禁用标签（管理员）
+//
+// 禁用后标签不能再被新活动选用，已有活动的关联保留，但不再出现在活动详情与搜索文档中
+func (l *SetTagStatusLogic) SetTagStatus(in *activity.SetTagStatusReq) (*activity.AdminTagResp, error) {
+	if in.Id <= 0 {
+		return nil, errorx.ErrInvalidParams("标签ID无效")
+	}
+	if in.Status != 0 && in.Status != 1 {
+		return nil, errorx.ErrInvalidParams("标签状态无效")
+	}
+
+	resp, err := l.svcCtx.TagRpc.SetInterestTagStatus(l.ctx, &userpb.SetInterestTagStatusReq{
+		Id:     in.Id,
+		Status: int64(in.Status),
+	})
+	if err != nil {
+		l.Errorf("[Tag] 修改标签状态失败: id=%d, err=%v", in.Id, err)
+		return nil, errorx.FromError(err)
+	}
+
+	propagateTagChange(l.ctx, l.svcCtx, resp.Tag)
+
+	l.Infof("[Tag] 修改标签状态: id=%d, status=%d, operatorId=%d", in.Id, in.Status, in.OperatorId)
+	return &activity.AdminTagResp{Tag: toAdminTag(l.ctx, l.svcCtx, resp.Tag)}, nil
+}
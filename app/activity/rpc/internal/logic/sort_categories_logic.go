@@ -0,0 +1,54 @@
+package logic
+
+import (
+	"context"
+
+	"activity-platform/app/activity/rpc/activity"
+	"activity-platform/app/activity/rpc/internal/svc"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SortCategoriesLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSortCategoriesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SortCategoriesLogic {
+	return &SortCategoriesLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// SortCategories 批量调整分类排序（管理员）
+//
+// 排序只影响分类列表展示，不需要重建活动索引
+func (l *SortCategoriesLogic) SortCategories(in *activity.SortCategoriesReq) (*activity.SortCategoriesResp, error) {
+	// 1. 参数校验
+	if len(in.Items) == 0 {
+		return nil, errorx.ErrInvalidParams("排序列表不能为空")
+	}
+	sorts := make(map[uint64]int, len(in.Items))
+	for _, item := range in.Items {
+		if item.Id <= 0 {
+			return nil, errorx.ErrInvalidParams("分类ID无效")
+		}
+		sorts[uint64(item.Id)] = int(item.Sort)
+	}
+
+	// 2. 批量更新
+	if err := l.svcCtx.CategoryModel.UpdateSorts(l.ctx, sorts); err != nil {
+		l.Errorf("调整分类排序失败: count=%d, err=%v", len(sorts), err)
+		return nil, errorx.ErrDBError(err)
+	}
+
+	// 3. 失效分类缓存
+	propagateCategoryChange(l.ctx, l.svcCtx, 0, false)
+
+	l.Infof("[Category] 调整分类排序: count=%d, operatorId=%d", len(sorts), in.OperatorId)
+	return &activity.SortCategoriesResp{Updated: int32(len(sorts))}, nil
+}
@@ -0,0 +1,170 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"activity-platform/app/activity/model"
+	"activity-platform/app/activity/rpc/activity"
+	"activity-platform/app/activity/rpc/internal/svc"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// maxChangeReasonLen 变更原因最大长度（字）
+const maxChangeReasonLen = 500
+
+type SubmitActivityChangeLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSubmitActivityChangeLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SubmitActivityChangeLogic {
+	return &SubmitActivityChangeLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// SubmitActivityChange 组织者提交已发布活动的时间/地点变更申请
+//
+// 业务逻辑：
+//  1. 仅组织者本人、仅已发布且未开始的活动可申请
+//  2. 同一活动同时只能有一条待审核申请
+//  3. 变更后的时间需满足：报名截止 < 活动开始 < 活动结束，且活动开始在当前时间之后
+//  4. 申请保存变更前后字段，审核通过前活动信息保持不变
+func (l *SubmitActivityChangeLogic) SubmitActivityChange(in *activity.SubmitActivityChangeReq) (*activity.SubmitActivityChangeResp, error) {
+	// 1. 参数校验
+	if in.ActivityId <= 0 {
+		return nil, errorx.ErrInvalidParams("活动ID无效")
+	}
+	if in.OperatorId <= 0 {
+		return nil, errorx.ErrInvalidParams("操作者信息缺失")
+	}
+	reason := strings.TrimSpace(in.Reason)
+	if reason == "" {
+		return nil, errorx.ErrInvalidParams("请填写变更原因")
+	}
+	if len([]rune(reason)) > maxChangeReasonLen {
+		return nil, errorx.ErrInvalidParams("变更原因不能超过500字")
+	}
+
+	// 2. 查询活动并校验权限与状态
+	activityData, err := l.svcCtx.ActivityModel.FindByID(l.ctx, uint64(in.ActivityId))
+	if err != nil {
+		if errors.Is(err, model.ErrActivityNotFound) {
+			return nil, errorx.New(errorx.CodeActivityNotFound)
+		}
+		l.Errorf("查询活动失败: id=%d, err=%v", in.ActivityId, err)
+		return nil, errorx.ErrDBError(err)
+	}
+	if activityData.OrganizerID != uint64(in.OperatorId) {
+		return nil, errorx.New(errorx.CodeActivityPermissionDenied)
+	}
+	if activityData.Status != model.StatusPublished {
+		return nil, errorx.NewWithMessage(errorx.CodeActivityStatusInvalid, "只有已发布且未开始的活动可以申请变更")
+	}
+
+	// 3. 合并变更字段并校验
+	oldFields := model.NewActivityChangeFields(activityData)
+	newFields, err := mergeChangeFields(in, oldFields)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateChangeTimes(activityData.RegisterStartTime, newFields); err != nil {
+		return nil, err
+	}
+	if len(model.DiffActivityChangeFields(oldFields, newFields)) == 0 {
+		return nil, errorx.New(errorx.CodeActivityChangeEmpty)
+	}
+
+	// 4. 同一活动只允许一条待审核申请
+	pending, err := l.svcCtx.ChangeRequestModel.ExistsPending(l.ctx, activityData.ID)
+	if err != nil {
+		l.Errorf("查询待审核变更申请失败: activityId=%d, err=%v", activityData.ID, err)
+		return nil, errorx.ErrDBError(err)
+	}
+	if pending {
+		return nil, errorx.New(errorx.CodeActivityChangePending)
+	}
+
+	// 5. 保存申请
+	req := &model.ActivityChangeRequest{
+		ActivityID:  activityData.ID,
+		OrganizerID: activityData.OrganizerID,
+		Status:      model.ChangeRequestStatusPending,
+		Reason:      reason,
+		OldValues:   oldFields.Encode(),
+		NewValues:   newFields.Encode(),
+	}
+	if err := l.svcCtx.ChangeRequestModel.Create(l.ctx, req); err != nil {
+		l.Errorf("创建变更申请失败: activityId=%d, err=%v", activityData.ID, err)
+		return nil, errorx.ErrDBError(err)
+	}
+
+	l.Infof("[ActivityChange] 提交变更申请: requestId=%d, activityId=%d, organizerId=%d",
+		req.ID, activityData.ID, activityData.OrganizerID)
+
+	return &activity.SubmitActivityChangeResp{
+		RequestId: int64(req.ID),
+		Status:    int32(req.Status),
+	}, nil
+}
+
+// mergeChangeFields 在当前字段基础上应用本次申请的变更
+func mergeChangeFields(in *activity.SubmitActivityChangeReq, fields model.ActivityChangeFields) (model.ActivityChangeFields, error) {
+	if in.RegisterEndTime != nil {
+		fields.RegisterEndTime = *in.RegisterEndTime
+	}
+	if in.ActivityStartTime != nil {
+		fields.ActivityStartTime = *in.ActivityStartTime
+	}
+	if in.ActivityEndTime != nil {
+		fields.ActivityEndTime = *in.ActivityEndTime
+	}
+	if in.Location != nil {
+		location := strings.TrimSpace(*in.Location)
+		if location == "" {
+			return fields, errorx.ErrInvalidParams("活动地点不能为空")
+		}
+		fields.Location = location
+	}
+	if in.AddressDetail != nil {
+		fields.AddressDetail = strings.TrimSpace(*in.AddressDetail)
+	}
+	if in.Longitude != nil {
+		if *in.Longitude < -180 || *in.Longitude > 180 {
+			return fields, errorx.ErrInvalidParams("经度范围无效")
+		}
+		fields.Longitude = *in.Longitude
+	}
+	if in.Latitude != nil {
+		if *in.Latitude < -90 || *in.Latitude > 90 {
+			return fields, errorx.ErrInvalidParams("纬度范围无效")
+		}
+		fields.Latitude = *in.Latitude
+	}
+	return fields, nil
+}
+
+// validateChangeTimes 校验变更后的时间逻辑（提交与审核生效时各校验一次）
+func validateChangeTimes(registerStartTime int64, fields model.ActivityChangeFields) error {
+	if fields.ActivityStartTime <= time.Now().Unix() {
+		return errorx.NewWithMessage(errorx.CodeActivityTimeInvalid, "活动开始时间必须在当前时间之后")
+	}
+	if fields.RegisterEndTime <= registerStartTime {
+		return errorx.NewWithMessage(errorx.CodeActivityTimeInvalid, "报名截止时间必须在报名开始时间之后")
+	}
+	if fields.ActivityStartTime <= fields.RegisterEndTime {
+		return errorx.NewWithMessage(errorx.CodeActivityTimeInvalid, "活动开始时间必须在报名截止时间之后，请同时调整报名截止时间")
+	}
+	if fields.ActivityEndTime <= fields.ActivityStartTime {
+		return errorx.NewWithMessage(errorx.CodeActivityTimeInvalid, "活动结束时间必须在活动开始时间之后")
+	}
+	return nil
+}
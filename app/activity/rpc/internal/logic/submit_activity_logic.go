@@ -28,9 +28,12 @@ func NewSubmitActivityLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Su
 	}
 }
 
-// SubmitActivity 提交活动（草稿 → 已发布）
-// MVP 版本：没有后台管理系统，审批自动通过，直接发布
-// 状态流转：Draft(0) → Published(2)，跳过 Pending(1)
+// SubmitActivity 提交活动（草稿/已驳回 → 已发布 或 待审核）
+// 按发布审核策略判定（见 ReviewConfig）：
+//   - 自动通过：Draft(0)/Rejected(5) → Published(2)
+//   - 人工审核：Draft(0)/Rejected(5) → Pending(1)，进入管理员审核队列
+//
+// 每次提交都会生成一条审核单，判定结果同时写入状态变更日志
 func (l *SubmitActivityLogic) SubmitActivity(in *activity.SubmitActivityReq) (*activity.SubmitActivityResp, error) {
 	// 1. 参数校验
 	if in.Id <= 0 {
@@ -57,8 +60,7 @@ func (l *SubmitActivityLogic) SubmitActivity(in *activity.SubmitActivityReq) (*a
 		return nil, errorx.New(errorx.CodeActivityPermissionDenied)
 	}
 
-	// 4. 状态校验：只有草稿状态可以提交
-	// MVP 版本也支持从"已拒绝"状态重新提交（虽然MVP没有拒绝功能，但保留兼容性）
+	// 4. 状态校验：草稿或已驳回的活动可以提交
 	if activityData.Status != model.StatusDraft && activityData.Status != model.StatusRejected {
 		l.Infof("[状态拒绝] 活动状态不允许提交: id=%d, currentStatus=%d",
 			in.Id, activityData.Status)
@@ -81,16 +83,34 @@ func (l *SubmitActivityLogic) SubmitActivity(in *activity.SubmitActivityReq) (*a
 			"报名截止时间已过期，请修改后重新提交")
 	}
 
-	// 6. 事务：更新状态 + 记录日志
+	// 6. 敏感词检测：草稿可能在启用过滤前保存，发布前需重新检测
+	screen := screenActivityText(l.svcCtx, activityData.Title, activityData.Description)
+	if screen.Blocked() {
+		l.Infof("[SubmitActivity] 活动内容命中拦截词: id=%d, organizerId=%d", in.Id, activityData.OrganizerID)
+		recordContentReview(l.ctx, l.svcCtx, activityData.ID, activityData.OrganizerID, model.ContentSceneSubmit, activityData.Title, screen)
+		return nil, screen.ViolationError()
+	}
+	textReplaced := screen.Title != activityData.Title || screen.Content != activityData.Description
+
+	// 7. 审核策略判定：自动通过直接发布，否则进入待审核
+	decision := decideReview(l.ctx, l.svcCtx, activityData.OrganizerID, unknownCreditLevel, screen.Flagged())
+	newStatus := decision.TargetStatus()
+	oldStatus := activityData.Status
+
+	// 审核单快照使用处置后的文本
+	submitted := *activityData
+	submitted.Title, submitted.Description = screen.Title, screen.Content
+	review := newActivityReview(l.svcCtx, &submitted, decision, screen.Flagged())
+
+	// 8. 事务：更新状态 + 审核单 + 记录日志
 	err = l.svcCtx.DB.WithContext(l.ctx).Transaction(func(tx *gorm.DB) error {
-		// 6.1 更新状态为已发布（MVP: 跳过待审核）
-		oldStatus := activityData.Status
+		// 8.1 更新状态（清空上次驳回原因）
 		err := l.svcCtx.ActivityModel.UpdateStatus(
 			l.ctx, tx,
 			uint64(in.Id),
 			activityData.Version,
-			model.StatusPublished, // MVP: 直接发布
-			"",                    // 无拒绝原因
+			newStatus,
+			"",
 		)
 		if err != nil {
 			if errors.Is(err, model.ErrActivityConcurrentUpdate) {
@@ -99,14 +119,19 @@ func (l *SubmitActivityLogic) SubmitActivity(in *activity.SubmitActivityReq) (*a
 			return err
 		}
 
-		// 6.2 记录状态变更日志
+		// 8.2 写入审核单
+		if err := l.svcCtx.ReviewModel.Create(l.ctx, tx, review); err != nil {
+			return err
+		}
+
+		// 8.3 记录状态变更日志（含审核判定说明）
 		statusLog := &model.ActivityStatusLog{
 			ActivityID:   uint64(in.Id),
 			FromStatus:   oldStatus,
-			ToStatus:     model.StatusPublished,
+			ToStatus:     newStatus,
 			OperatorID:   uint64(in.OperatorId),
 			OperatorType: model.OperatorTypeUser,
-			Reason:       "MVP自动审批通过",
+			Reason:       "提交发布：" + decision.Reason,
 		}
 		if err := l.svcCtx.StatusLogModel.Create(l.ctx, tx, statusLog); err != nil {
 			l.Errorf("记录状态日志失败: %v", err)
@@ -114,7 +139,21 @@ func (l *SubmitActivityLogic) SubmitActivity(in *activity.SubmitActivityReq) (*a
 			// 如果要求严格一致性，可以 return err
 		}
 
-		return nil
+		// 8.4 替换类敏感词：写回掩码后的文本
+		if textReplaced {
+			err := tx.Model(&model.Activity{}).
+				Where("id = ?", in.Id).
+				Updates(map[string]interface{}{
+					"title":       screen.Title,
+					"description": screen.Content,
+				}).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		// 8.5 记录变更事件（发布后需要被搜索到，由 ChangeRelay 同步 ES）
+		return l.svcCtx.ChangeEventModel.Record(l.ctx, tx, uint64(in.Id), model.ChangeTypeStatus, model.ChangeSourceActivityRpc)
 	})
 
 	if err != nil {
@@ -125,6 +164,12 @@ func (l *SubmitActivityLogic) SubmitActivity(in *activity.SubmitActivityReq) (*a
 		return nil, errorx.ErrDBError(err)
 	}
 
+	// 记录敏感词命中（送审词待管理员审核）
+	recordContentReview(l.ctx, l.svcCtx, activityData.ID, activityData.OrganizerID, model.ContentSceneSubmit, activityData.Title, screen)
+	if textReplaced {
+		activityData.Title = screen.Title
+	}
+
 	// 删除缓存（状态变更成功后）
 	if l.svcCtx.ActivityCache != nil {
 		if err := l.svcCtx.ActivityCache.Invalidate(l.ctx, uint64(in.Id)); err != nil {
@@ -132,26 +177,17 @@ func (l *SubmitActivityLogic) SubmitActivity(in *activity.SubmitActivityReq) (*a
 		}
 	}
 
-	// 异步同步到 ES（发布后需要被搜索到）
-	if l.svcCtx.SyncService != nil {
-		// 重新查询最新数据用于同步
-		updatedActivity, err := l.svcCtx.ActivityModel.FindByID(l.ctx, uint64(in.Id))
-		if err == nil {
-			l.svcCtx.SyncService.IndexActivityAsync(updatedActivity)
-		}
-	}
-
-	// 异步发布活动创建事件（草稿提交发布后通知 Chat 创建群聊）
-	if l.svcCtx.MsgProducer != nil {
+	// 异步发布活动创建事件（提交后直接发布时通知 Chat 创建群聊；待审核的在审核通过时发布）
+	if newStatus == model.StatusPublished && l.svcCtx.MsgProducer != nil {
 		l.svcCtx.MsgProducer.PublishActivityCreated(
 			l.ctx, uint64(in.Id), activityData.OrganizerID, activityData.Title, activityData.CoverURL,
 		)
 	}
 
-	l.Infof("活动提交成功（MVP自动发布）: id=%d, status=%d->%d",
-		in.Id, activityData.Status, model.StatusPublished)
+	l.Infof("活动提交成功: id=%d, status=%d->%d, reason=%s",
+		in.Id, oldStatus, newStatus, decision.Reason)
 
 	return &activity.SubmitActivityResp{
-		Status: int32(model.StatusPublished),
+		Status: int32(newStatus),
 	}, nil
 }
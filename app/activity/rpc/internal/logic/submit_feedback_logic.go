@@ -0,0 +1,151 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"activity-platform/app/activity/model"
+	"activity-platform/app/activity/rpc/activity"
+	"activity-platform/app/activity/rpc/internal/svc"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+)
+
+type SubmitFeedbackLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSubmitFeedbackLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SubmitFeedbackLogic {
+	return &SubmitFeedbackLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// SubmitFeedback 提交活动评价
+//
+// 业务逻辑：
+//  1. 参数校验（评分 1-5，短评最多 200 字）
+//  2. 活动必须已结束，且在评价窗口内（结束后 Feedback.WindowDays 天）
+//  3. 仅已核销票据的参与者可评价
+//  4. 短评敏感词处置：拦截词直接拒绝；替换词掩码后保存；送审词保存为待审核（审核通过前不公开）
+//  5. 事务内写入评价、重新统计评分汇总、记录变更事件（同步 ES 与详情缓存）
+//
+// 同一用户重复提交视为修改，评分汇总按全量重新统计，天然幂等
+func (l *SubmitFeedbackLogic) SubmitFeedback(in *activity.SubmitFeedbackReq) (*activity.SubmitFeedbackResp, error) {
+	// 1. 参数校验
+	if in.ActivityId <= 0 {
+		return nil, errorx.ErrInvalidParams("活动ID无效")
+	}
+	if in.UserId <= 0 {
+		return nil, errorx.ErrInvalidParams("用户信息缺失")
+	}
+	if in.Rating < model.MinFeedbackRating || in.Rating > model.MaxFeedbackRating {
+		return nil, errorx.ErrInvalidParams(fmt.Sprintf("评分需在 %d-%d 之间",
+			model.MinFeedbackRating, model.MaxFeedbackRating))
+	}
+	rawComment := strings.TrimSpace(in.Comment)
+	if len([]rune(rawComment)) > model.MaxFeedbackCommentLen {
+		return nil, errorx.ErrInvalidParams(fmt.Sprintf("评价内容不能超过%d个字", model.MaxFeedbackCommentLen))
+	}
+
+	// 2. 查询活动
+	activityData, err := l.svcCtx.ActivityModel.FindByID(l.ctx, uint64(in.ActivityId))
+	if err != nil {
+		if errors.Is(err, model.ErrActivityNotFound) {
+			return nil, errorx.New(errorx.CodeActivityNotFound)
+		}
+		l.Errorf("查询活动失败: id=%d, err=%v", in.ActivityId, err)
+		return nil, errorx.ErrDBError(err)
+	}
+
+	// 3. 状态与评价窗口校验
+	if activityData.Status != model.StatusFinished {
+		return nil, errorx.NewWithMessage(errorx.CodeActivityStatusInvalid, "活动结束后才能评价")
+	}
+	if time.Now().Unix() > feedbackDeadline(l.svcCtx, activityData) {
+		return nil, errorx.New(errorx.CodeFeedbackWindowClosed)
+	}
+
+	// 4. 参与资格校验（票据已核销）
+	attended, err := l.svcCtx.ActivityTicketModel.HasUsedTicket(l.ctx, activityData.ID, uint64(in.UserId))
+	if err != nil {
+		l.Errorf("查询核销记录失败: activityId=%d, userId=%d, err=%v", in.ActivityId, in.UserId, err)
+		return nil, errorx.ErrDBError(err)
+	}
+	if !attended {
+		return nil, errorx.New(errorx.CodeFeedbackNotAllowed)
+	}
+
+	// 5. 短评敏感词检测
+	screen := screenFeedbackComment(l.svcCtx, rawComment)
+	if screen.Blocked() {
+		l.Infof("评价短评命中拦截词: activityId=%d, userId=%d, words=%v", in.ActivityId, in.UserId, screen.Words())
+		return nil, errorx.NewWithMessage(errorx.CodeActivityContentViolation,
+			fmt.Sprintf("评价内容包含违规词：%s，请修改后重试", strings.Join(screen.Words(), "、")))
+	}
+	commentStatus := model.FeedbackCommentVisible
+	if screen.Flagged() {
+		commentStatus = model.FeedbackCommentPending
+	}
+
+	feedback := &model.ActivityFeedback{
+		ActivityID:    activityData.ID,
+		UserID:        uint64(in.UserId),
+		OrganizerID:   activityData.OrganizerID,
+		Rating:        int8(in.Rating),
+		Comment:       screen.Text,
+		CommentStatus: commentStatus,
+	}
+
+	// 6. 事务内写入评价 + 更新评分汇总 + 记录变更事件
+	var summary *model.RatingSummary
+	err = l.svcCtx.DB.WithContext(l.ctx).Transaction(func(tx *gorm.DB) error {
+		if err := l.svcCtx.FeedbackModel.Upsert(l.ctx, tx, feedback); err != nil {
+			return err
+		}
+		var err error
+		summary, err = l.svcCtx.FeedbackModel.SummaryByActivity(l.ctx, tx, activityData.ID)
+		if err != nil {
+			return err
+		}
+		if err := l.svcCtx.ActivityModel.UpdateRating(l.ctx, tx, activityData.ID, summary.Avg, summary.Count); err != nil {
+			return err
+		}
+		return l.svcCtx.ChangeEventModel.Record(l.ctx, tx, activityData.ID, model.ChangeTypeRating, model.ChangeSourceActivityRpc)
+	})
+	if err != nil {
+		l.Errorf("保存活动评价失败: activityId=%d, userId=%d, err=%v", in.ActivityId, in.UserId, err)
+		return nil, errorx.ErrDBError(err)
+	}
+
+	// 7. 命中敏感词的短评记录审核（送审待管理员处理，替换记为已自动处置）
+	recordFeedbackReview(l.ctx, l.svcCtx, feedback, rawComment, screen)
+
+	l.Infof("活动评价已保存: activityId=%d, userId=%d, rating=%d, commentStatus=%d, avg=%.2f, count=%d",
+		in.ActivityId, in.UserId, in.Rating, commentStatus, summary.Avg, summary.Count)
+
+	return &activity.SubmitFeedbackResp{
+		RatingAvg:     summary.Avg,
+		RatingCount:   int32(summary.Count),
+		CommentStatus: int32(commentStatus),
+		Comment:       feedback.Comment,
+	}, nil
+}
+
+// feedbackDeadline 活动评价截止时间（活动结束时间 + 评价窗口）
+func feedbackDeadline(svcCtx *svc.ServiceContext, act *model.Activity) int64 {
+	windowDays := svcCtx.Config.Feedback.WindowDays
+	if windowDays <= 0 {
+		windowDays = 7
+	}
+	return act.ActivityEndTime + int64(windowDays)*24*3600
+}
@@ -0,0 +1,85 @@
+package logic
+
+import (
+	"context"
+	"strings"
+
+	"activity-platform/app/activity/rpc/activity"
+	"activity-platform/app/activity/rpc/internal/search"
+	"activity-platform/app/activity/rpc/internal/svc"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SuggestActivitiesLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSuggestActivitiesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SuggestActivitiesLogic {
+	return &SuggestActivitiesLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// SuggestActivities 搜索建议（输入联想）
+//
+// 业务逻辑：
+//  1. 参数校验（前缀 1-20 字，size 默认 10、最大 20）
+//  2. 查询缓存（1min TTL，singleflight 合并并发请求）
+//  3. 缓存未命中：优先 ES completion suggester，失败降级 MySQL 标题前缀匹配
+func (l *SuggestActivitiesLogic) SuggestActivities(in *activity.SuggestActivitiesReq) (*activity.SuggestActivitiesResp, error) {
+	// 1. 参数校验
+	prefix := strings.TrimSpace(in.Prefix)
+	prefixLen := len([]rune(prefix))
+	if prefixLen == 0 {
+		return nil, errorx.ErrInvalidParams("搜索前缀不能为空")
+	}
+	if prefixLen > 20 {
+		return nil, errorx.ErrInvalidParams("搜索前缀不能超过20个字符")
+	}
+	if in.Size <= 0 {
+		in.Size = 10
+	}
+	if in.Size > 20 {
+		in.Size = 20
+	}
+
+	// 2. 查询（带缓存）
+	loader := func(ctx context.Context) ([]string, error) {
+		return l.loadSuggestions(ctx, prefix, uint64(in.CategoryId), int(in.Size))
+	}
+
+	suggestions, err := l.svcCtx.SuggestCache.Get(l.ctx, uint64(in.CategoryId), int(in.Size), prefix, loader)
+	if err != nil {
+		l.Errorf("[SuggestActivities] 查询搜索建议失败: prefix=%s, err=%v", prefix, err)
+		return nil, errorx.ErrDBError(err)
+	}
+
+	return &activity.SuggestActivitiesResp{
+		Suggestions: suggestions,
+	}, nil
+}
+
+// loadSuggestions 加载搜索建议（ES 优先，失败降级 MySQL）
+func (l *SuggestActivitiesLogic) loadSuggestions(ctx context.Context, prefix string, categoryID uint64, size int) ([]string, error) {
+	// 1. 优先 ES
+	if l.svcCtx.ESClient != nil && l.svcCtx.ESClient.IsEnabled() {
+		resp, err := l.svcCtx.ESClient.SuggestWithFallback(ctx, search.SuggestRequest{
+			Prefix:     prefix,
+			CategoryID: categoryID,
+			Size:       size,
+		})
+		if err == nil {
+			return resp.Suggestions, nil
+		}
+		l.Errorf("[SuggestActivities] ES 查询失败，降级到 MySQL: %v", err)
+	}
+
+	// 2. 降级 MySQL（仅匹配标题前缀）
+	return l.svcCtx.ActivityModel.SuggestTitlesByPrefix(ctx, prefix, categoryID, size)
+}
@@ -0,0 +1,164 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"activity-platform/app/activity/model"
+	"activity-platform/app/activity/rpc/activity"
+	"activity-platform/app/activity/rpc/internal/svc"
+	userpb "activity-platform/app/user/rpc/pb/pb"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+)
+
+// ==================== 分类/标签管理公共方法 ====================
+//
+// 传播链路：
+//   - 分类：写 categories → 失效 CategoryCache → 分类下活动写变更事件
+//   - 标签：写用户服务 interest_tags → Upsert tag_cache → 使用该标签的活动写变更事件
+//
+// 变更事件由 ChangeRelay 消费，按活动ID增量重建 ES 文档并失效活动缓存，
+// 因此这里不直接调用 ES；写事件失败只记录日志，TagSyncCron / 定时全量同步兜底
+
+const maxCategoryNameLen = 50 // 分类名称最大长度（与 categories.name 一致）
+
+// findCategoryAny 查询分类（包含已禁用）
+func findCategoryAny(ctx context.Context, svcCtx *svc.ServiceContext, id int64) (*model.Category, error) {
+	if id <= 0 {
+		return nil, errorx.ErrInvalidParams("分类ID无效")
+	}
+	cat, err := svcCtx.CategoryModel.FindByIDAny(ctx, uint64(id))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errorx.New(errorx.CodeCategoryNotFound)
+		}
+		return nil, errorx.ErrDBError(err)
+	}
+	return cat, nil
+}
+
+// checkCategoryName 校验分类名称（长度与唯一性）
+func checkCategoryName(ctx context.Context, svcCtx *svc.ServiceContext, name string, excludeID uint64) error {
+	if name == "" {
+		return errorx.ErrInvalidParams("分类名称不能为空")
+	}
+	if len([]rune(name)) > maxCategoryNameLen {
+		return errorx.ErrInvalidParams("分类名称不能超过50个字符")
+	}
+	exists, err := svcCtx.CategoryModel.NameExists(ctx, name, excludeID)
+	if err != nil {
+		return errorx.ErrDBError(err)
+	}
+	if exists {
+		return errorx.New(errorx.CodeCategoryNameExists)
+	}
+	return nil
+}
+
+// propagateCategoryChange 分类变更后失效分类缓存，并重建分类下活动的搜索索引
+//
+// reindex=false 时只失效缓存（排序调整不影响活动文档）
+func propagateCategoryChange(ctx context.Context, svcCtx *svc.ServiceContext, categoryID uint64, reindex bool) {
+	if svcCtx.CategoryCache != nil {
+		if err := svcCtx.CategoryCache.Invalidate(ctx); err != nil {
+			logx.WithContext(ctx).Infof("[WARNING] 分类缓存失效失败: categoryId=%d, err=%v", categoryID, err)
+		}
+	}
+	if !reindex {
+		return
+	}
+
+	activityIDs, err := svcCtx.CategoryModel.ListActivityIDs(ctx, categoryID)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("查询分类下活动失败: categoryId=%d, err=%v", categoryID, err)
+		return
+	}
+	recordTaxonomyEvents(ctx, svcCtx, activityIDs)
+}
+
+// propagateTagChange 标签变更后同步 tag_cache，并重建使用该标签的活动的搜索索引
+func propagateTagChange(ctx context.Context, svcCtx *svc.ServiceContext, tag *userpb.TagInfo) {
+	if tag == nil {
+		return
+	}
+	cache := tagCacheFromInfo(tag)
+	if err := svcCtx.TagCacheModel.Upsert(ctx, &cache); err != nil {
+		// TagSyncCron 定时全量同步兜底
+		logx.WithContext(ctx).Errorf("同步标签缓存失败: tagId=%d, err=%v", tag.Id, err)
+	}
+
+	activityIDs, err := svcCtx.ActivityTagModel.ListActivityIDsByTag(ctx, tag.Id)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("查询标签关联活动失败: tagId=%d, err=%v", tag.Id, err)
+		return
+	}
+	recordTaxonomyEvents(ctx, svcCtx, activityIDs)
+}
+
+// recordTaxonomyEvents 批量写入活动变更事件（触发 ES 增量重建与缓存失效）
+func recordTaxonomyEvents(ctx context.Context, svcCtx *svc.ServiceContext, activityIDs []uint64) {
+	if len(activityIDs) == 0 {
+		return
+	}
+	if err := svcCtx.ChangeEventModel.RecordBatch(ctx, nil, activityIDs,
+		model.ChangeTypeTaxonomy, model.ChangeSourceActivityRpc); err != nil {
+		logx.WithContext(ctx).Errorf("记录分类/标签变更事件失败: count=%d, err=%v", len(activityIDs), err)
+	}
+}
+
+// tagCacheFromInfo 用户服务标签转换为 tag_cache 记录
+func tagCacheFromInfo(tag *userpb.TagInfo) model.TagCache {
+	return model.TagCache{
+		ID:          tag.Id,
+		Name:        tag.Name,
+		Color:       tag.Color,
+		Icon:        tag.Icon,
+		Status:      int8(tag.Status),
+		Description: tag.Description,
+		CreatedAt:   tag.CreatedAt,
+		UpdatedAt:   tag.UpdatedAt,
+	}
+}
+
+// toAdminTag 用户服务标签转换为管理端标签
+func toAdminTag(ctx context.Context, svcCtx *svc.ServiceContext, tag *userpb.TagInfo) *activity.AdminTag {
+	if tag == nil {
+		return nil
+	}
+	var count int64
+	if stats, err := svcCtx.TagStatsModel.FindByTagID(ctx, tag.Id); err == nil && stats != nil {
+		count = int64(stats.ActivityCount)
+	}
+	return &activity.AdminTag{
+		Id:            int64(tag.Id),
+		Name:          tag.Name,
+		Color:         tag.Color,
+		Icon:          tag.Icon,
+		Description:   tag.Description,
+		Status:        int32(tag.Status),
+		ActivityCount: count,
+	}
+}
+
+// toAdminCategory 分类转换为管理端分类
+func toAdminCategory(cat *model.Category, activityCount int64) *activity.AdminCategory {
+	return &activity.AdminCategory{
+		Id:            int64(cat.ID),
+		Name:          cat.Name,
+		Icon:          cat.Icon,
+		Sort:          int32(cat.Sort),
+		Status:        int32(cat.Status),
+		ActivityCount: activityCount,
+		CreatedAt:     cat.CreatedAt,
+		UpdatedAt:     cat.UpdatedAt,
+	}
+}
+
+// trimTagReq 去除标签字段首尾空白
+func trimTagReq(name, color, icon, desc string) (string, string, string, string) {
+	return strings.TrimSpace(name), strings.TrimSpace(color), strings.TrimSpace(icon), strings.TrimSpace(desc)
+}
@@ -0,0 +1,224 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"activity-platform/app/activity/model"
+	"activity-platform/app/activity/rpc/activity"
+	"activity-platform/app/activity/rpc/internal/svc"
+	userpb "activity-platform/app/user/rpc/pb/pb"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// ==================== 活动模板 / 复制活动公共方法 ====================
+//
+// 复制活动与使用模板走同一条路径：活动 → 模板快照 → 按新开始时间推算时间点 → 单场创建（草稿）。
+// 创建通过 CreateActivityLogic 完成（DTM 可用时走 CreateActivitySaga），标签使用计数与普通创建一致；
+// 新活动与模板都会引用原封面图片，引用时 SysImage.RefCount +1，删除模板时 -1
+
+const (
+	maxTemplateNameLen         = 50 // 模板名称最大长度（与 activity_templates.name 一致）
+	templateRegisterStartDelay = 60 // 报名开始时间至少晚于创建时刻（单场创建要求报名开始在当前时间之后）
+)
+
+// findTemplate 查询模板（不存在返回业务错误）
+func findTemplate(ctx context.Context, svcCtx *svc.ServiceContext, id int64) (*model.ActivityTemplate, error) {
+	if id <= 0 {
+		return nil, errorx.ErrInvalidParams("模板ID无效")
+	}
+	tpl, err := svcCtx.TemplateModel.FindByID(ctx, uint64(id))
+	if err != nil {
+		if errors.Is(err, model.ErrTemplateNotFound) {
+			return nil, errorx.New(errorx.CodeActivityTemplateNotFound)
+		}
+		logx.WithContext(ctx).Errorf("查询活动模板失败: id=%d, err=%v", id, err)
+		return nil, errorx.ErrDBError(err)
+	}
+	return tpl, nil
+}
+
+// normalizeTemplateName 校验模板名称（为空时使用活动标题）
+func normalizeTemplateName(name, fallback string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		name = fallback
+	}
+	if len([]rune(name)) > maxTemplateNameLen {
+		return "", errorx.ErrInvalidParams("模板名称不能超过50个字符")
+	}
+	return name, nil
+}
+
+// templateFromActivity 以活动为蓝本生成模板快照（时间点换算为相对开始时间的秒数）
+func templateFromActivity(ctx context.Context, svcCtx *svc.ServiceContext, act *model.Activity) (*model.ActivityTemplate, error) {
+	tagIDs, err := svcCtx.ActivityTagModel.FindIDsByActivityID(ctx, act.ID)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("查询活动标签失败: activityId=%d, err=%v", act.ID, err)
+		return nil, errorx.ErrDBError(err)
+	}
+	tags := make([]int64, 0, len(tagIDs))
+	for _, id := range tagIDs {
+		tags = append(tags, int64(id))
+	}
+	rules, err := svcCtx.EligibilityRuleModel.FindByActivityID(ctx, act.ID)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("查询报名资格规则失败: activityId=%d, err=%v", act.ID, err)
+		return nil, errorx.ErrDBError(err)
+	}
+
+	return &model.ActivityTemplate{
+		CategoryID:           act.CategoryID,
+		SourceActivityID:     act.ID,
+		Status:               model.TemplateStatusEnabled,
+		DurationSeconds:      act.ActivityEndTime - act.ActivityStartTime,
+		RegisterOpenBefore:   act.ActivityStartTime - act.RegisterStartTime,
+		RegisterCloseBefore:  act.ActivityStartTime - act.RegisterEndTime,
+		Title:                act.Title,
+		Description:          act.Description,
+		CoverImageID:         act.CoverImageID,
+		CoverURL:             act.CoverURL,
+		CoverType:            act.CoverType,
+		ContactPhone:         act.ContactPhone,
+		Location:             act.Location,
+		AddressDetail:        act.AddressDetail,
+		Longitude:            act.Longitude,
+		Latitude:             act.Latitude,
+		MaxParticipants:      act.MaxParticipants,
+		RequireApproval:      act.RequireApproval,
+		RequireStudentVerify: act.RequireStudentVerify,
+		MinCreditScore:       act.MinCreditScore,
+		TagIDs:               model.EncodeTagIDs(tags),
+		EligibilityRules:     encodeTemplateRules(rules),
+	}, nil
+}
+
+// encodeTemplateRules 序列化报名资格规则
+func encodeTemplateRules(rules []model.ActivityEligibilityRule) string {
+	if len(rules) == 0 {
+		return ""
+	}
+	raw, _ := json.Marshal(rules)
+	return string(raw)
+}
+
+// decodeTemplateRules 反序列化报名资格规则（损坏时忽略，不影响创建）
+func decodeTemplateRules(raw string) []model.ActivityEligibilityRule {
+	var rules []model.ActivityEligibilityRule
+	if raw != "" {
+		_ = json.Unmarshal([]byte(raw), &rules)
+	}
+	return rules
+}
+
+// createDraftFromTemplate 按模板与新的活动开始时间创建草稿活动
+//
+// 报名开放/截止、结束时间按模板中的相对时间推算；报名开放时间已过时顺延到当前时间之后，
+// 顺延后报名窗口不足则拒绝。资格规则在活动创建后复制，失败只记录日志（组织者可在草稿中重新设置）
+func createDraftFromTemplate(ctx context.Context, svcCtx *svc.ServiceContext, tpl *model.ActivityTemplate,
+	organizerID, startAt int64, title string) (*activity.CreateActivityResp, error) {
+	now := time.Now().Unix()
+	if startAt <= now {
+		return nil, errorx.NewWithMessage(errorx.CodeActivityTimeInvalid, "活动开始时间必须在当前时间之后")
+	}
+	registerStart := startAt - tpl.RegisterOpenBefore
+	if earliest := now + templateRegisterStartDelay; registerStart < earliest {
+		registerStart = earliest
+	}
+	registerEnd := startAt - tpl.RegisterCloseBefore
+	if registerEnd <= registerStart {
+		return nil, errorx.NewWithMessage(errorx.CodeActivityTimeInvalid, "活动开始时间过近，无法保留原有的报名时长，请选择更晚的开始时间")
+	}
+	if title = strings.TrimSpace(title); title == "" {
+		title = tpl.Title
+	}
+
+	resp, err := NewCreateActivityLogic(ctx, svcCtx).CreateActivity(&activity.CreateActivityReq{
+		Title:                title,
+		CoverType:            int32(tpl.CoverType),
+		Content:              tpl.Description,
+		CategoryId:           int64(tpl.CategoryID),
+		ContactPhone:         tpl.ContactPhone,
+		RegisterStartTime:    registerStart,
+		RegisterEndTime:      registerEnd,
+		ActivityStartTime:    startAt,
+		ActivityEndTime:      startAt + tpl.DurationSeconds,
+		Location:             tpl.Location,
+		AddressDetail:        tpl.AddressDetail,
+		Longitude:            tpl.Longitude,
+		Latitude:             tpl.Latitude,
+		MaxParticipants:      int32(tpl.MaxParticipants),
+		RequireApproval:      tpl.RequireApproval,
+		RequireStudentVerify: tpl.RequireStudentVerify,
+		MinCreditScore:       int32(tpl.MinCreditScore),
+		TagIds:               tpl.Tags(),
+		IsDraft:              true,
+		OrganizerId:          organizerID,
+		CoverImageId:         tpl.CoverImageID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if rules := decodeTemplateRules(tpl.EligibilityRules); len(rules) > 0 {
+		if err := svcCtx.EligibilityRuleModel.ReplaceByActivityID(ctx, uint64(resp.Id), rules); err != nil {
+			logx.WithContext(ctx).Errorf("[Template] 复制报名资格规则失败: activityId=%d, err=%v", resp.Id, err)
+		}
+	}
+	adjustCoverRef(ctx, svcCtx, tpl.CoverImageID, 1)
+	return resp, nil
+}
+
+// adjustCoverRef 调整封面图片引用计数
+//
+// 引用计数只用于图片清理时判断是否仍被使用，失败只记录日志，不影响主流程
+func adjustCoverRef(ctx context.Context, svcCtx *svc.ServiceContext, imageID int64, delta int32) {
+	if imageID <= 0 {
+		return
+	}
+	_, err := svcCtx.UserBasicRpc.UpdateSysImageRefCount(ctx, &userpb.UpdateSysImageRefCountReq{
+		ImageId: imageID,
+		Delta:   delta,
+	})
+	if err != nil {
+		logx.WithContext(ctx).Errorf("[Template] 更新封面引用计数失败: imageId=%d, delta=%d, err=%v", imageID, delta, err)
+	}
+}
+
+// toActivityTemplate 转换为 RPC 模板结构
+func toActivityTemplate(tpl *model.ActivityTemplate, categoryName string) *activity.ActivityTemplate {
+	return &activity.ActivityTemplate{
+		Id:                  int64(tpl.ID),
+		Scope:               int32(tpl.Scope),
+		Name:                tpl.Name,
+		CategoryId:          int64(tpl.CategoryID),
+		CategoryName:        categoryName,
+		Status:              int32(tpl.Status),
+		Title:               tpl.Title,
+		CoverUrl:            tpl.CoverURL,
+		Location:            tpl.Location,
+		MaxParticipants:     int32(tpl.MaxParticipants),
+		DurationSeconds:     tpl.DurationSeconds,
+		RegisterOpenBefore:  tpl.RegisterOpenBefore,
+		RegisterCloseBefore: tpl.RegisterCloseBefore,
+		TagIds:              tpl.Tags(),
+		SourceActivityId:    int64(tpl.SourceActivityID),
+		UseCount:            tpl.UseCount,
+		OwnerId:             int64(tpl.OwnerID),
+		CreatedAt:           tpl.CreatedAt,
+	}
+}
+
+// templateCategoryName 查询模板分类名称（失败返回空，不影响结果）
+func templateCategoryName(ctx context.Context, svcCtx *svc.ServiceContext, categoryID uint64) string {
+	cat, err := svcCtx.CategoryModel.FindByIDAny(ctx, categoryID)
+	if err != nil {
+		return ""
+	}
+	return cat.Name
+}
@@ -71,6 +71,12 @@ func (l *UpdateActivityLogic) UpdateActivity(in *activity.UpdateActivityReq) (*a
 		return nil, err
 	}
 
+	// 敏感词检测（仅检测本次修改的标题/详情）
+	screen, err := l.screenUpdates(updates, activityData)
+	if err != nil {
+		return nil, err
+	}
+
 	// 如果没有任何字段需要更新（只传了 id 和 version）
 	if len(updates) == 0 && !in.UpdateTags {
 		return &activity.UpdateActivityResp{
@@ -111,7 +117,8 @@ func (l *UpdateActivityLogic) UpdateActivity(in *activity.UpdateActivityReq) (*a
 			}
 		}
 
-		return nil
+		// 6.3 记录变更事件（由 ChangeRelay 同步 ES）
+		return l.svcCtx.ChangeEventModel.Record(l.ctx, tx, uint64(in.Id), model.ChangeTypeUpdate, model.ChangeSourceActivityRpc)
 	})
 
 	if err != nil {
@@ -136,14 +143,11 @@ func (l *UpdateActivityLogic) UpdateActivity(in *activity.UpdateActivityReq) (*a
 		}
 	}
 
-	// 异步同步到 ES（公开状态需要同步）
-	if l.svcCtx.SyncService != nil {
-		// 重新查询最新数据用于同步
-		updatedActivity, err := l.svcCtx.ActivityModel.FindByID(l.ctx, uint64(in.Id))
-		if err == nil && updatedActivity.IsPublic() {
-			l.svcCtx.SyncService.IndexActivityAsync(updatedActivity)
-		}
-	}
+	// 记录敏感词命中（送审词待管理员审核）
+	recordContentReview(l.ctx, l.svcCtx, activityData.ID, activityData.OrganizerID, model.ContentSceneUpdate, activityData.Title, screen)
+
+	// 系列场次被单独修改后不再跟随整期修改
+	markOccurrenceOverridden(l.ctx, l.svcCtx, activityData.ID)
 
 	l.Infof("活动更新成功: id=%d, status=%d, newVersion=%d", in.Id, finalStatus, finalVersion)
 
@@ -153,6 +157,31 @@ func (l *UpdateActivityLogic) UpdateActivity(in *activity.UpdateActivityReq) (*a
 	}, nil
 }
 
+// screenUpdates 检测待更新的标题/详情
+//
+// 命中拦截词返回错误；替换词直接改写 updates 中的值
+func (l *UpdateActivityLogic) screenUpdates(updates map[string]interface{}, activityData *model.Activity) (*ActivityTextScreen, error) {
+	title, hasTitle := updates["title"].(string)
+	content, hasContent := updates["description"].(string)
+	if !hasTitle && !hasContent {
+		return nil, nil
+	}
+
+	screen := screenActivityText(l.svcCtx, title, content)
+	if screen.Blocked() {
+		l.Infof("[UpdateActivity] 活动内容命中拦截词: id=%d, organizerId=%d", activityData.ID, activityData.OrganizerID)
+		recordContentReview(l.ctx, l.svcCtx, activityData.ID, activityData.OrganizerID, model.ContentSceneUpdate, activityData.Title, screen)
+		return nil, screen.ViolationError()
+	}
+	if hasTitle {
+		updates["title"] = screen.Title
+	}
+	if hasContent {
+		updates["description"] = screen.Content
+	}
+	return screen, nil
+}
+
 // validateBasicParams 基础参数校验
 func (l *UpdateActivityLogic) validateBasicParams(in *activity.UpdateActivityReq) error {
 	if in.Id <= 0 {
@@ -170,28 +199,28 @@ func (l *UpdateActivityLogic) validateBasicParams(in *activity.UpdateActivityReq
 // buildUpdates 根据状态构建更新字段
 // 返回：更新字段map、新状态、错误
 //
-// MVP 版本说明：
-// - 待审核状态(1)在MVP中不会出现（自动审批），但保留代码兼容性
-// - 已拒绝状态(5)在MVP中不会出现（无后台管理），但保留代码兼容性
+// 审核相关状态说明：
+// - 待审核(1)：可继续修改，审核队列展示的是修改后的最新内容
+// - 已驳回(5)：修改后回到草稿，需重新提交审核
 func (l *UpdateActivityLogic) buildUpdates(in *activity.UpdateActivityReq, activityData *model.Activity) (map[string]interface{}, int8, error) {
 	updates := make(map[string]interface{})
 	newStatus := activityData.Status
 
 	switch activityData.Status {
 	case model.StatusDraft:
-		// 草稿：可编辑所有字段（MVP 主要使用状态）
+		// 草稿：可编辑所有字段
 		if err := l.buildAllFieldUpdates(in, activityData, updates); err != nil {
 			return nil, 0, err
 		}
 
 	case model.StatusPending:
-		// 待审核：可编辑所有字段（MVP中不会出现此状态，保留兼容性）
+		// 待审核：可编辑所有字段，状态保持待审核
 		if err := l.buildAllFieldUpdates(in, activityData, updates); err != nil {
 			return nil, 0, err
 		}
 
 	case model.StatusRejected:
-		// 已拒绝：可编辑所有字段，编辑后变为草稿状态（MVP中不会出现，保留兼容性）
+		// 已驳回：可编辑所有字段，编辑后变为草稿状态
 		if err := l.buildAllFieldUpdates(in, activityData, updates); err != nil {
 			return nil, 0, err
 		}
@@ -202,7 +231,7 @@ func (l *UpdateActivityLogic) buildUpdates(in *activity.UpdateActivityReq, activ
 		}
 
 	case model.StatusPublished:
-		// 已发布：只能修改特定字段（MVP 主要使用状态）
+		// 已发布：只能修改特定字段
 		if err := l.buildPublishedUpdates(in, activityData, updates); err != nil {
 			return nil, 0, err
 		}
@@ -359,6 +388,7 @@ func (l *UpdateActivityLogic) buildAllFieldUpdates(in *activity.UpdateActivityRe
 // buildPublishedUpdates 构建已发布状态的更新（只能修改特定字段）
 func (l *UpdateActivityLogic) buildPublishedUpdates(in *activity.UpdateActivityReq, activityData *model.Activity, updates map[string]interface{}) error {
 	// 已发布状态只能修改：description, cover_url, cover_type
+	// 时间与地点需走变更申请（SubmitActivityChange），经管理员审核后生效并通知报名者
 
 	// 检查是否尝试修改不允许的字段
 	if in.Title != nil {
@@ -369,11 +399,11 @@ func (l *UpdateActivityLogic) buildPublishedUpdates(in *activity.UpdateActivityR
 	}
 	if in.RegisterStartTime != nil || in.RegisterEndTime != nil ||
 		in.ActivityStartTime != nil || in.ActivityEndTime != nil {
-		return errorx.NewWithMessage(errorx.CodeActivityStatusInvalid, "已发布的活动不能修改时间")
+		return errorx.NewWithMessage(errorx.CodeActivityStatusInvalid, "已发布的活动不能直接修改时间，请提交变更申请")
 	}
 	if in.Location != nil || in.AddressDetail != nil ||
 		in.Longitude != nil || in.Latitude != nil {
-		return errorx.NewWithMessage(errorx.CodeActivityStatusInvalid, "已发布的活动不能修改地点")
+		return errorx.NewWithMessage(errorx.CodeActivityStatusInvalid, "已发布的活动不能直接修改地点，请提交变更申请")
 	}
 	if in.RequireApproval != nil || in.RequireStudentVerify != nil || in.MinCreditScore != nil {
 		return errorx.NewWithMessage(errorx.CodeActivityStatusInvalid, "已发布的活动不能修改报名规则")
@@ -0,0 +1,254 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"activity-platform/app/activity/model"
+	"activity-platform/app/activity/rpc/activity"
+	"activity-platform/app/activity/rpc/internal/svc"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+)
+
+type UpdateActivitySeriesLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUpdateActivitySeriesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateActivitySeriesLogic {
+	return &UpdateActivitySeriesLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// UpdateActivitySeries 整期修改
+//
+// 先更新系列模板（影响之后生成的场次），再逐场同步到未开始且未单独修改过的场次：
+//   - 草稿/待审核/已驳回场次：按单场修改规则同步全部字段
+//   - 已发布场次：只同步详情、封面、联系电话、人数上限（时间地点需走变更申请）
+//
+// 单场同步失败不影响其他场次，未同步的场次及原因在响应中返回
+func (l *UpdateActivitySeriesLogic) UpdateActivitySeries(in *activity.UpdateActivitySeriesReq) (*activity.UpdateActivitySeriesResp, error) {
+	if in.OperatorId <= 0 {
+		return nil, errorx.ErrInvalidParams("操作者信息缺失")
+	}
+	series, err := findSeries(l.ctx, l.svcCtx, in.Id)
+	if err != nil {
+		return nil, err
+	}
+	if series.OrganizerID != uint64(in.OperatorId) {
+		return nil, errorx.New(errorx.CodeActivityPermissionDenied)
+	}
+	if series.Status == model.SeriesStatusCancelled {
+		return nil, errorx.New(errorx.CodeActivitySeriesClosed)
+	}
+
+	// 1. 校验并构建模板更新
+	fields, err := l.buildTemplateUpdates(in)
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) == 0 {
+		return &activity.UpdateActivitySeriesResp{}, nil
+	}
+	if err := l.svcCtx.SeriesModel.UpdateFields(l.ctx, series.ID, fields); err != nil {
+		l.Errorf("更新系列模板失败: id=%d, err=%v", series.ID, err)
+		return nil, errorx.ErrDBError(err)
+	}
+
+	// 2. 同步到未开始的场次（仅整期报名开关变化时无需同步）
+	resp := &activity.UpdateActivitySeriesResp{}
+	if len(fields) == 1 && in.AllowSeriesRegister != nil {
+		return resp, nil
+	}
+	occurrences, err := l.svcCtx.SeriesModel.ListFutureOccurrences(l.ctx, series.ID, time.Now().Unix())
+	if err != nil {
+		l.Errorf("查询系列场次失败: seriesId=%d, err=%v", series.ID, err)
+		return nil, errorx.ErrDBError(err)
+	}
+	propagateCtx := withSeriesPropagation(l.ctx)
+	for _, occ := range occurrences {
+		if occ.Overridden {
+			resp.Skipped = append(resp.Skipped, skippedOccurrence(occ.ActivityID, "该场次已单独修改"))
+			continue
+		}
+		act, err := l.svcCtx.ActivityModel.FindByID(l.ctx, occ.ActivityID)
+		if err != nil {
+			resp.Skipped = append(resp.Skipped, skippedOccurrence(occ.ActivityID, "场次查询失败"))
+			continue
+		}
+
+		req, partial := l.buildOccurrenceUpdate(in, act)
+		if req == nil {
+			resp.Skipped = append(resp.Skipped, skippedOccurrence(act.ID, partial))
+			continue
+		}
+		if _, err := NewUpdateActivityLogic(propagateCtx, l.svcCtx).UpdateActivity(req); err != nil {
+			resp.Skipped = append(resp.Skipped, skippedOccurrence(act.ID, errorx.FromError(err).GetMessage()))
+			continue
+		}
+		resp.Updated++
+		if partial != "" {
+			resp.Skipped = append(resp.Skipped, skippedOccurrence(act.ID, partial))
+		}
+	}
+
+	l.Infof("系列活动整期修改: id=%d, operatorId=%d, updated=%d, skipped=%d",
+		series.ID, in.OperatorId, resp.Updated, len(resp.Skipped))
+	return resp, nil
+}
+
+// buildTemplateUpdates 校验修改字段并构建系列模板的更新
+func (l *UpdateActivitySeriesLogic) buildTemplateUpdates(in *activity.UpdateActivitySeriesReq) (map[string]interface{}, error) {
+	fields := make(map[string]interface{})
+
+	if in.Title != nil {
+		if n := len([]rune(*in.Title)); n < 2 || n > 100 {
+			return nil, errorx.ErrInvalidParams("标题长度需在2-100字符之间")
+		}
+		fields["title"] = *in.Title
+	}
+	if in.Content != nil {
+		fields["description"] = *in.Content
+	}
+	if in.Title != nil || in.Content != nil {
+		screen := screenActivityText(l.svcCtx, in.GetTitle(), in.GetContent())
+		if screen.Blocked() {
+			return nil, screen.ViolationError()
+		}
+	}
+	if in.CategoryId != nil {
+		if _, err := l.svcCtx.CategoryModel.FindByID(l.ctx, uint64(*in.CategoryId)); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, errorx.New(errorx.CodeCategoryNotFound)
+			}
+			return nil, errorx.ErrDBError(err)
+		}
+		fields["category_id"] = *in.CategoryId
+	}
+	if in.ContactPhone != nil {
+		fields["contact_phone"] = *in.ContactPhone
+	}
+	if in.CoverImageId != nil {
+		if *in.CoverImageId <= 0 {
+			return nil, errorx.ErrInvalidParams("封面图片ID无效")
+		}
+		if _, err := NewCreateActivityLogic(l.ctx, l.svcCtx).resolveCoverURL(in.OperatorId, *in.CoverImageId); err != nil {
+			return nil, err
+		}
+		fields["cover_image_id"] = *in.CoverImageId
+	}
+	if in.CoverType != nil {
+		if *in.CoverType != 1 && *in.CoverType != 2 {
+			return nil, errorx.ErrInvalidParams("封面类型无效")
+		}
+		fields["cover_type"] = *in.CoverType
+	}
+	if in.Location != nil {
+		if *in.Location == "" {
+			return nil, errorx.ErrInvalidParams("请填写活动地点")
+		}
+		fields["location"] = *in.Location
+	}
+	if in.AddressDetail != nil {
+		fields["address_detail"] = *in.AddressDetail
+	}
+	if in.Longitude != nil {
+		fields["longitude"] = *in.Longitude
+	}
+	if in.Latitude != nil {
+		fields["latitude"] = *in.Latitude
+	}
+	if in.MaxParticipants != nil {
+		if *in.MaxParticipants < 0 {
+			return nil, errorx.ErrInvalidParams("人数上限不能为负数")
+		}
+		fields["max_participants"] = *in.MaxParticipants
+	}
+	if in.RequireApproval != nil {
+		fields["require_approval"] = *in.RequireApproval
+	}
+	if in.RequireStudentVerify != nil {
+		fields["require_student_verify"] = *in.RequireStudentVerify
+	}
+	if in.MinCreditScore != nil {
+		if *in.MinCreditScore < 0 || *in.MinCreditScore > 100 {
+			return nil, errorx.ErrInvalidParams("信用分要求需在0-100之间")
+		}
+		fields["min_credit_score"] = *in.MinCreditScore
+	}
+	if in.UpdateTags {
+		if len(in.TagIds) > 5 {
+			return nil, errorx.New(errorx.CodeTagLimitExceeded)
+		}
+		fields["tag_ids"] = model.EncodeTagIDs(in.TagIds)
+	}
+	if in.AllowSeriesRegister != nil {
+		fields["allow_series_register"] = *in.AllowSeriesRegister
+	}
+	return fields, nil
+}
+
+// buildOccurrenceUpdate 按场次状态构造单场修改请求
+//
+// 返回 nil 表示该场次无需/无法同步，第二个返回值为原因；
+// 已发布场次只同步允许修改的字段，有字段未同步时返回提示
+func (l *UpdateActivitySeriesLogic) buildOccurrenceUpdate(in *activity.UpdateActivitySeriesReq, act *model.Activity) (*activity.UpdateActivityReq, string) {
+	req := &activity.UpdateActivityReq{
+		Id:              int64(act.ID),
+		Version:         int32(act.Version),
+		OperatorId:      in.OperatorId,
+		Content:         in.Content,
+		ContactPhone:    in.ContactPhone,
+		CoverImageId:    in.CoverImageId,
+		CoverType:       in.CoverType,
+		MaxParticipants: in.MaxParticipants,
+	}
+
+	switch {
+	case act.CanEdit():
+		req.Title = in.Title
+		req.CategoryId = in.CategoryId
+		req.Location = in.Location
+		req.AddressDetail = in.AddressDetail
+		req.Longitude = in.Longitude
+		req.Latitude = in.Latitude
+		req.RequireApproval = in.RequireApproval
+		req.RequireStudentVerify = in.RequireStudentVerify
+		req.MinCreditScore = in.MinCreditScore
+		req.TagIds = in.TagIds
+		req.UpdateTags = in.UpdateTags
+		return req, ""
+
+	case act.Status == model.StatusPublished:
+		var partial string
+		if in.Title != nil || in.CategoryId != nil || in.Location != nil || in.AddressDetail != nil ||
+			in.Longitude != nil || in.Latitude != nil || in.RequireApproval != nil ||
+			in.RequireStudentVerify != nil || in.MinCreditScore != nil || in.UpdateTags {
+			partial = "场次已发布，标题、分类、地点、报名规则与标签未同步"
+		}
+		if req.Content == nil && req.ContactPhone == nil && req.CoverImageId == nil &&
+			req.CoverType == nil && req.MaxParticipants == nil {
+			return nil, partial
+		}
+		return req, partial
+
+	default:
+		return nil, "场次" + act.StatusText() + "，不可修改"
+	}
+}
+
+// skippedOccurrence 未同步的场次
+func skippedOccurrence(activityID uint64, reason string) *activity.SeriesSkippedOccurrence {
+	return &activity.SeriesSkippedOccurrence{
+		ActivityId: int64(activityID),
+		Reason:     reason,
+	}
+}
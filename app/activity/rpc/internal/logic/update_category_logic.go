@@ -0,0 +1,64 @@
+package logic
+
+import (
+	"context"
+	"strings"
+
+	"activity-platform/app/activity/rpc/activity"
+	"activity-platform/app/activity/rpc/internal/svc"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdateCategoryLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUpdateCategoryLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateCategoryLogic {
+	return &UpdateCategoryLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// UpdateCategory 修改分类名称/图标（管理员）
+//
+// 重命名后分类下活动的搜索文档中的分类名随之更新
+func (l *UpdateCategoryLogic) UpdateCategory(in *activity.UpdateCategoryReq) (*activity.AdminCategoryResp, error) {
+	// 1. 查询分类
+	cat, err := findCategoryAny(l.ctx, l.svcCtx, in.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. 组装修改字段
+	fields := make(map[string]interface{})
+	renamed := false
+	if name := strings.TrimSpace(in.Name); name != "" && name != cat.Name {
+		if err := checkCategoryName(l.ctx, l.svcCtx, name, cat.ID); err != nil {
+			return nil, err
+		}
+		fields["name"] = name
+		renamed = true
+	}
+	if icon := strings.TrimSpace(in.Icon); icon != "" && icon != cat.Icon {
+		fields["icon"] = icon
+	}
+
+	// 3. 更新并传播
+	if len(fields) > 0 {
+		if err := l.svcCtx.CategoryModel.UpdateFields(l.ctx, cat.ID, fields); err != nil {
+			l.Errorf("修改分类失败: id=%d, err=%v", cat.ID, err)
+			return nil, errorx.ErrDBError(err)
+		}
+		// 只有名称进入搜索文档，改图标无需重建索引
+		propagateCategoryChange(l.ctx, l.svcCtx, cat.ID, renamed)
+		l.Infof("[Category] 修改分类: id=%d, fields=%d, operatorId=%d", cat.ID, len(fields), in.OperatorId)
+	}
+
+	return buildCategoryResp(l.ctx, l.svcCtx, cat.ID)
+}
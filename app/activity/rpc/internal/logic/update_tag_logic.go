@@ -0,0 +1,53 @@
+package logic
+
+import (
+	"context"
+
+	"activity-platform/app/activity/rpc/activity"
+	"activity-platform/app/activity/rpc/internal/svc"
+	userpb "activity-platform/app/user/rpc/pb/pb"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdateTagLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUpdateTagLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateTagLogic {
+	return &UpdateTagLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// UpdateTag 修改标签（管理员，空字段不修改）
+//
+// 修改后同步 tag_cache，并重建使用该标签的活动的搜索文档（标签名进入 ES）
+func (l *UpdateTagLogic) UpdateTag(in *activity.UpdateTagReq) (*activity.AdminTagResp, error) {
+	if in.Id <= 0 {
+		return nil, errorx.ErrInvalidParams("标签ID无效")
+	}
+	name, color, icon, desc := trimTagReq(in.Name, in.Color, in.Icon, in.Description)
+
+	resp, err := l.svcCtx.TagRpc.UpdateInterestTag(l.ctx, &userpb.UpdateInterestTagReq{
+		Id:          in.Id,
+		Name:        name,
+		Color:       color,
+		Icon:        icon,
+		Description: desc,
+	})
+	if err != nil {
+		l.Errorf("[Tag] 修改标签失败: id=%d, err=%v", in.Id, err)
+		return nil, errorx.FromError(err)
+	}
+
+	propagateTagChange(l.ctx, l.svcCtx, resp.Tag)
+
+	l.Infof("[Tag] 修改标签: id=%d, operatorId=%d", in.Id, in.OperatorId)
+	return &activity.AdminTagResp{Tag: toAdminTag(l.ctx, l.svcCtx, resp.Tag)}, nil
+}
@@ -135,15 +135,20 @@ func resolveVerifyWindow(ticket *model.ActivityTicket, activityInfo *model.Activ
 		}
 		return ticket.ValidStartTime, ticket.ValidEndTime, true
 	}
-	if activityInfo.ActivityStartTime <= 0 || activityInfo.ActivityEndTime <= 0 {
+	return ticketVerifyWindow(activityInfo.ActivityStartTime, activityInfo.ActivityEndTime)
+}
+
+// ticketVerifyWindow 根据活动起止时间计算票据可核销时间窗
+func ticketVerifyWindow(activityStart, activityEnd int64) (int64, int64, bool) {
+	if activityStart <= 0 || activityEnd <= 0 {
 		return 0, 0, false
 	}
-	if activityInfo.ActivityEndTime < activityInfo.ActivityStartTime {
+	if activityEnd < activityStart {
 		return 0, 0, false
 	}
 
-	windowStart := activityInfo.ActivityStartTime - int64(verifyWindowBefore/time.Second)
-	windowEnd := activityInfo.ActivityEndTime + int64(verifyWindowAfter/time.Second)
+	windowStart := activityStart - int64(verifyWindowBefore/time.Second)
+	windowEnd := activityEnd + int64(verifyWindowAfter/time.Second)
 	if windowStart <= 0 || windowEnd <= 0 || windowEnd < windowStart {
 		return 0, 0, false
 	}
@@ -3,6 +3,7 @@ package mq
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"time"
 
 	"activity-platform/common/messaging"
@@ -10,6 +11,9 @@ import (
 	"github.com/zeromicro/go-zero/core/logx"
 )
 
+// publishTimeout 单次发布超时
+const publishTimeout = 3 * time.Second
+
 // Producer 活动服务消息发布器
 // nil 安全：Producer 或 Client 为 nil 时所有方法静默返回
 type Producer struct {
@@ -47,7 +51,7 @@ func (p *Producer) publishAsync(topic string, payload interface{}) {
 			return
 		}
 
-		pubCtx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+		pubCtx, cancel := context.WithTimeout(context.Background(), publishTimeout)
 		defer cancel()
 
 		if err := p.client.Publish(pubCtx, topic, data); err != nil {
@@ -59,6 +63,29 @@ func (p *Producer) publishAsync(topic string, payload interface{}) {
 	}()
 }
 
+// publishSync 同步发布事件并返回发布结果（调用方需要在失败时重试）
+// Producer 未配置时与 publishAsync 一致，静默返回
+func (p *Producer) publishSync(ctx context.Context, topic string, payload interface{}) error {
+	if p == nil || p.client == nil {
+		return nil
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("序列化失败: topic=%s, err=%w", topic, err)
+	}
+
+	pubCtx, cancel := context.WithTimeout(ctx, publishTimeout)
+	defer cancel()
+
+	if err := p.client.Publish(pubCtx, topic, data); err != nil {
+		return fmt.Errorf("发布失败: topic=%s, err=%w", topic, err)
+	}
+
+	logx.Infof("[MQ-Producer] 发布成功: topic=%s, size=%d", topic, len(data))
+	return nil
+}
+
 // ==================== 活动事件（Chat MQ 消费）====================
 
 // PublishActivityCreated 发布活动创建事件
@@ -100,6 +127,31 @@ func (p *Producer) PublishActivityCancelled(ctx context.Context, activityID uint
 	})
 }
 
+// PublishActivityReviewed 发布活动审核结果事件（通知组织者）
+func (p *Producer) PublishActivityReviewed(ctx context.Context, activityID uint64, organizerID uint64, title string, approved bool, reason string) {
+	p.publishAsync(messaging.TopicActivityReviewed, messaging.ActivityReviewedEvent{
+		ActivityID:  activityID,
+		OrganizerID: organizerID,
+		Title:       title,
+		Approved:    approved,
+		Reason:      reason,
+		ReviewedAt:  time.Now(),
+	})
+}
+
+// PublishActivityChangeReviewed 发布变更申请审核结果事件（通知组织者与报名者）
+func (p *Producer) PublishActivityChangeReviewed(ctx context.Context, event messaging.ActivityChangeReviewedEvent) {
+	event.ReviewedAt = time.Now()
+	p.publishAsync(messaging.TopicActivityChangeReviewed, event)
+}
+
+// PublishActivityReminder 发布活动定时提醒事件（开始前提醒、报名截止提醒）
+// 同步发布：提醒任务出队后只有这一次投递机会，失败时由调用方重新入队
+func (p *Producer) PublishActivityReminder(ctx context.Context, event messaging.ActivityReminderEvent) error {
+	event.FiredAt = time.Now()
+	return p.publishSync(ctx, messaging.TopicActivityReminder, event)
+}
+
 // ==================== 信用事件（User MQ 消费）====================
 // Credit 事件需要 RawMessage 包装，ID 是 int64
 
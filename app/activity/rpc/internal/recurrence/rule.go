@@ -0,0 +1,273 @@
+package recurrence
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ==================== 重复规则（RRULE 子集） ====================
+//
+// 支持 RFC 5545 RRULE 的一个子集，分号分隔，大小写不敏感：
+//   - FREQ=WEEKLY：必填，仅支持按周重复
+//   - INTERVAL=1|2：每周 / 隔周，默认 1
+//   - BYDAY=MO,TU,...：每周的哪几天，默认取首场时间所在的星期
+//   - UNTIL=20261231 / 20261231T180000 / 20261231T100000Z：截止时间（RFC 5545 DATE / DATE-TIME）
+//     DATE 表示含当天；不带 Z 的时间按首场时间所在时区解释，带 Z 为 UTC
+//   - COUNT=N：总场次
+//
+// UNTIL 与 COUNT 必须且只能指定一个。
+// 首场时间只作为锚点（确定起始周与每天的开始时刻），
+// 实际场次为锚点之后第一个匹配 BYDAY 的日期起，按 INTERVAL 周循环。
+
+const (
+	// MaxInterval 最大间隔周数（每周 / 隔周）
+	MaxInterval = 2
+	// maxWeeks 枚举场次的最大周数，防止规则异常时死循环
+	maxWeeks = 106
+)
+
+// dayNames RRULE 星期缩写
+var dayNames = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+// Rule 解析后的重复规则
+type Rule struct {
+	Interval int            // 间隔周数
+	ByDay    []time.Weekday // 每周的哪几天（周一在前），为空时取首场所在星期
+	Until    *Until         // 截止时间，nil 表示未指定
+	Count    int            // 总场次，0 表示未指定
+}
+
+// Parse 解析重复规则
+func Parse(s string) (*Rule, error) {
+	s = strings.TrimPrefix(strings.TrimSpace(s), "RRULE:")
+	if s == "" {
+		return nil, errors.New("重复规则不能为空")
+	}
+
+	rule := &Rule{Interval: 1}
+	var hasFreq bool
+	for _, part := range strings.Split(s, ";") {
+		if part = strings.TrimSpace(part); part == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, fmt.Errorf("无法解析规则片段: %s", part)
+		}
+		key, value = strings.ToUpper(strings.TrimSpace(key)), strings.ToUpper(strings.TrimSpace(value))
+
+		switch key {
+		case "FREQ":
+			if value != "WEEKLY" {
+				return nil, errors.New("仅支持按周重复（FREQ=WEEKLY）")
+			}
+			hasFreq = true
+		case "INTERVAL":
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 1 || n > MaxInterval {
+				return nil, errors.New("INTERVAL 仅支持 1（每周）或 2（隔周）")
+			}
+			rule.Interval = n
+		case "BYDAY":
+			days, err := parseByDay(value)
+			if err != nil {
+				return nil, err
+			}
+			rule.ByDay = days
+		case "UNTIL":
+			until, err := parseUntil(value)
+			if err != nil {
+				return nil, err
+			}
+			rule.Until = until
+		case "COUNT":
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 1 {
+				return nil, errors.New("COUNT 必须为正整数")
+			}
+			rule.Count = n
+		default:
+			return nil, fmt.Errorf("不支持的规则字段: %s", key)
+		}
+	}
+
+	if !hasFreq {
+		return nil, errors.New("缺少 FREQ")
+	}
+	if (rule.Until == nil) == (rule.Count == 0) {
+		return nil, errors.New("UNTIL 与 COUNT 必须且只能指定一个")
+	}
+	return rule, nil
+}
+
+// String 规范化输出（用于持久化与展示）
+func (r *Rule) String() string {
+	parts := []string{"FREQ=WEEKLY", "INTERVAL=" + strconv.Itoa(r.Interval)}
+	if len(r.ByDay) > 0 {
+		names := make([]string, len(r.ByDay))
+		for i, d := range r.ByDay {
+			names[i] = weekdayName(d)
+		}
+		parts = append(parts, "BYDAY="+strings.Join(names, ","))
+	}
+	if r.Until != nil {
+		parts = append(parts, "UNTIL="+r.Until.String())
+	}
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+	return strings.Join(parts, ";")
+}
+
+// Occurrences 从首场时间开始枚举全部场次的开始时间（最多 limit 场）
+func (r *Rule) Occurrences(first time.Time, limit int) []time.Time {
+	days := r.ByDay
+	if len(days) == 0 {
+		days = []time.Weekday{first.Weekday()}
+	}
+
+	// 起始周的周一 00:00（保持首场的时区与时刻）
+	y, m, d := first.Date()
+	weekStart := time.Date(y, m, d, 0, 0, 0, 0, first.Location()).
+		AddDate(0, 0, -mondayOffset(first.Weekday()))
+	hour, minute, sec := first.Clock()
+
+	var until time.Time
+	if r.Until != nil {
+		until = r.Until.In(first.Location())
+	}
+
+	var result []time.Time
+	for week := 0; week < maxWeeks; week += r.Interval {
+		for _, day := range days {
+			date := weekStart.AddDate(0, 0, week*7+mondayOffset(day))
+			t := time.Date(date.Year(), date.Month(), date.Day(), hour, minute, sec, 0, first.Location())
+			if t.Before(first) {
+				continue
+			}
+			if !until.IsZero() && t.After(until) {
+				return result
+			}
+			result = append(result, t)
+			if (r.Count > 0 && len(result) >= r.Count) || (limit > 0 && len(result) >= limit) {
+				return result
+			}
+		}
+	}
+	return result
+}
+
+// parseByDay 解析 BYDAY（去重并按周一在前排序）
+func parseByDay(value string) ([]time.Weekday, error) {
+	seen := make(map[time.Weekday]struct{})
+	var days []time.Weekday
+	for _, name := range strings.Split(value, ",") {
+		day, ok := dayNames[strings.TrimSpace(name)]
+		if !ok {
+			return nil, fmt.Errorf("无效的 BYDAY: %s", name)
+		}
+		if _, dup := seen[day]; dup {
+			continue
+		}
+		seen[day] = struct{}{}
+		days = append(days, day)
+	}
+	if len(days) == 0 {
+		return nil, errors.New("BYDAY 不能为空")
+	}
+	sort.Slice(days, func(i, j int) bool { return mondayOffset(days[i]) < mondayOffset(days[j]) })
+	return days, nil
+}
+
+// ==================== UNTIL ====================
+
+const (
+	untilDateLayout     = "20060102"
+	untilDateTimeLayout = "20060102T150405"
+)
+
+// untilPattern RFC 5545 DATE / DATE-TIME（本地或 UTC）
+var untilPattern = regexp.MustCompile(`^\d{8}(T\d{6}Z?)?$`)
+
+// Until RRULE 截止时间（RFC 5545 DATE 或 DATE-TIME）
+type Until struct {
+	Value   time.Time // 截止时间：UTC 为 true 时为绝对时间；否则仅取年月日时分秒（墙上时间）
+	HasTime bool      // DATE-TIME 形式；DATE 形式表示截止到当天结束
+	UTC     bool      // DATE-TIME 以 Z 结尾（UTC 时间）
+}
+
+// In 按首场时间所在时区解释截止时间
+func (u *Until) In(loc *time.Location) time.Time {
+	if u.UTC {
+		return u.Value
+	}
+	y, m, d := u.Value.Date()
+	if !u.HasTime {
+		return time.Date(y, m, d, 23, 59, 59, 0, loc)
+	}
+	hour, minute, sec := u.Value.Clock()
+	return time.Date(y, m, d, hour, minute, sec, 0, loc)
+}
+
+// String 按 RFC 5545 格式输出
+func (u *Until) String() string {
+	switch {
+	case !u.HasTime:
+		return u.Value.Format(untilDateLayout)
+	case u.UTC:
+		return u.Value.Format(untilDateTimeLayout) + "Z"
+	default:
+		return u.Value.Format(untilDateTimeLayout)
+	}
+}
+
+// parseUntil 解析 UNTIL：YYYYMMDD、YYYYMMDDTHHMMSS 或 YYYYMMDDTHHMMSSZ，其余格式一律拒绝
+func parseUntil(value string) (*Until, error) {
+	invalid := errors.New("UNTIL 格式应为 YYYYMMDD、YYYYMMDDTHHMMSS 或 YYYYMMDDTHHMMSSZ")
+	if !untilPattern.MatchString(value) {
+		return nil, invalid
+	}
+
+	u := &Until{
+		HasTime: len(value) > len(untilDateLayout),
+		UTC:     strings.HasSuffix(value, "Z"),
+	}
+	layout := untilDateLayout
+	if u.HasTime {
+		layout = untilDateTimeLayout
+	}
+	t, err := time.ParseInLocation(layout, strings.TrimSuffix(value, "Z"), time.UTC)
+	if err != nil {
+		return nil, invalid
+	}
+	u.Value = t
+	return u, nil
+}
+
+// mondayOffset 星期相对周一的偏移（周一=0，周日=6）
+func mondayOffset(d time.Weekday) int {
+	return (int(d) + 6) % 7
+}
+
+// weekdayName 星期转 RRULE 缩写
+func weekdayName(d time.Weekday) string {
+	for name, day := range dayNames {
+		if day == d {
+			return name
+		}
+	}
+	return ""
+}
@@ -0,0 +1,188 @@
+package recurrence
+
+import (
+	"testing"
+	"time"
+)
+
+// testLoc 首场时间所在时区（与服务器时区无关）
+var testLoc = time.FixedZone("UTC+8", 8*3600)
+
+func at(month time.Month, day int) time.Time {
+	return time.Date(2026, month, day, 19, 0, 0, 0, testLoc)
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{name: "规范化输出", in: "freq=weekly;byday=we,mo,we;count=3", want: "FREQ=WEEKLY;INTERVAL=1;BYDAY=MO,WE;COUNT=3"},
+		{name: "RRULE 前缀", in: "RRULE:FREQ=WEEKLY;INTERVAL=2;COUNT=5", want: "FREQ=WEEKLY;INTERVAL=2;COUNT=5"},
+		{name: "UNTIL 日期", in: "FREQ=WEEKLY;UNTIL=20261231", want: "FREQ=WEEKLY;INTERVAL=1;UNTIL=20261231"},
+		{name: "UNTIL 本地时间", in: "FREQ=WEEKLY;UNTIL=20261231t180000", want: "FREQ=WEEKLY;INTERVAL=1;UNTIL=20261231T180000"},
+		{name: "UNTIL UTC 时间", in: "FREQ=WEEKLY;UNTIL=20261231T100000Z", want: "FREQ=WEEKLY;INTERVAL=1;UNTIL=20261231T100000Z"},
+		{name: "缺少 FREQ", in: "COUNT=3", wantErr: true},
+		{name: "不支持按天", in: "FREQ=DAILY;COUNT=3", wantErr: true},
+		{name: "INTERVAL 超限", in: "FREQ=WEEKLY;INTERVAL=3;COUNT=3", wantErr: true},
+		{name: "BYDAY 无效", in: "FREQ=WEEKLY;BYDAY=XX;COUNT=3", wantErr: true},
+		{name: "COUNT 非正", in: "FREQ=WEEKLY;COUNT=0", wantErr: true},
+		{name: "UNTIL 与 COUNT 同时指定", in: "FREQ=WEEKLY;COUNT=3;UNTIL=20261231", wantErr: true},
+		{name: "UNTIL 与 COUNT 均未指定", in: "FREQ=WEEKLY", wantErr: true},
+		{name: "未知字段", in: "FREQ=WEEKLY;COUNT=3;WKST=MO", wantErr: true},
+		{name: "UNTIL 时间不完整", in: "FREQ=WEEKLY;UNTIL=20261231T1800", wantErr: true},
+		{name: "UNTIL 尾部多余字符", in: "FREQ=WEEKLY;UNTIL=20261231X", wantErr: true},
+		{name: "UNTIL 时间尾部多余字符", in: "FREQ=WEEKLY;UNTIL=20261231T180000ZZ", wantErr: true},
+		{name: "UNTIL 日期过短", in: "FREQ=WEEKLY;UNTIL=2026123", wantErr: true},
+		{name: "UNTIL 日期无效", in: "FREQ=WEEKLY;UNTIL=20261332", wantErr: true},
+		{name: "UNTIL 时刻无效", in: "FREQ=WEEKLY;UNTIL=20261231T250000", wantErr: true},
+		{name: "UNTIL 带符号", in: "FREQ=WEEKLY;UNTIL=-0261231", wantErr: true},
+		{name: "UNTIL 带时区参数", in: "FREQ=WEEKLY;UNTIL=20261231T180000+0800", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := Parse(tt.in)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("Parse(%q) = %s, want error", tt.in, rule)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Parse(%q) returned error: %v", tt.in, err)
+			}
+			if got := rule.String(); got != tt.want {
+				t.Fatalf("String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRuleOccurrences(t *testing.T) {
+	tests := []struct {
+		name  string
+		rule  string
+		first time.Time
+		limit int
+		want  []time.Time
+	}{
+		{
+			name:  "每周按首场星期",
+			rule:  "FREQ=WEEKLY;COUNT=3",
+			first: at(time.October, 19),
+			want:  []time.Time{at(time.October, 19), at(time.October, 26), at(time.November, 2)},
+		},
+		{
+			name:  "隔周",
+			rule:  "FREQ=WEEKLY;INTERVAL=2;COUNT=3",
+			first: at(time.October, 19),
+			want:  []time.Time{at(time.October, 19), at(time.November, 2), at(time.November, 16)},
+		},
+		{
+			name:  "每周多天",
+			rule:  "FREQ=WEEKLY;BYDAY=MO,WE;COUNT=4",
+			first: at(time.October, 19),
+			want:  []time.Time{at(time.October, 19), at(time.October, 21), at(time.October, 26), at(time.October, 28)},
+		},
+		{
+			name:  "跳过首场之前的日期",
+			rule:  "FREQ=WEEKLY;BYDAY=MO,FR;COUNT=3",
+			first: at(time.October, 21),
+			want:  []time.Time{at(time.October, 23), at(time.October, 26), at(time.October, 30)},
+		},
+		{
+			name:  "隔周多天",
+			rule:  "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,FR;COUNT=4",
+			first: at(time.October, 19),
+			want:  []time.Time{at(time.October, 19), at(time.October, 23), at(time.November, 2), at(time.November, 6)},
+		},
+		{
+			name:  "UNTIL 日期含当天",
+			rule:  "FREQ=WEEKLY;UNTIL=20261102",
+			first: at(time.October, 19),
+			want:  []time.Time{at(time.October, 19), at(time.October, 26), at(time.November, 2)},
+		},
+		{
+			name:  "UNTIL 本地时间按首场时区解释",
+			rule:  "FREQ=WEEKLY;UNTIL=20261102T185959",
+			first: at(time.October, 19),
+			want:  []time.Time{at(time.October, 19), at(time.October, 26)},
+		},
+		{
+			name:  "UNTIL 本地时间与场次相同时包含",
+			rule:  "FREQ=WEEKLY;UNTIL=20261102T190000",
+			first: at(time.October, 19),
+			want:  []time.Time{at(time.October, 19), at(time.October, 26), at(time.November, 2)},
+		},
+		{
+			name:  "UNTIL UTC 时间",
+			rule:  "FREQ=WEEKLY;UNTIL=20261102T110000Z",
+			first: at(time.October, 19),
+			want:  []time.Time{at(time.October, 19), at(time.October, 26), at(time.November, 2)},
+		},
+		{
+			name:  "UNTIL UTC 时间早于场次",
+			rule:  "FREQ=WEEKLY;UNTIL=20261102T105959Z",
+			first: at(time.October, 19),
+			want:  []time.Time{at(time.October, 19), at(time.October, 26)},
+		},
+		{
+			name:  "UNTIL 早于首场",
+			rule:  "FREQ=WEEKLY;UNTIL=20261018",
+			first: at(time.October, 19),
+			want:  nil,
+		},
+		{
+			name:  "limit 截断",
+			rule:  "FREQ=WEEKLY;COUNT=10",
+			first: at(time.October, 19),
+			limit: 2,
+			want:  []time.Time{at(time.October, 19), at(time.October, 26)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := Parse(tt.rule)
+			if err != nil {
+				t.Fatalf("Parse(%q) returned error: %v", tt.rule, err)
+			}
+			got := rule.Occurrences(tt.first, tt.limit)
+			if len(got) != len(tt.want) {
+				t.Fatalf("occurrences = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if !got[i].Equal(tt.want[i]) {
+					t.Fatalf("occurrences[%d] = %v, want %v", i, got[i], tt.want[i])
+				}
+				if got[i].Location() != testLoc {
+					t.Fatalf("occurrences[%d] location = %v, want %v", i, got[i].Location(), testLoc)
+				}
+			}
+		})
+	}
+}
+
+func TestRuleOccurrencesWeekCap(t *testing.T) {
+	tests := []struct {
+		rule string
+		want int
+	}{
+		{rule: "FREQ=WEEKLY;UNTIL=20991231", want: maxWeeks},
+		{rule: "FREQ=WEEKLY;INTERVAL=2;UNTIL=20991231", want: maxWeeks / 2},
+		{rule: "FREQ=WEEKLY;COUNT=1000", want: maxWeeks},
+	}
+
+	for _, tt := range tests {
+		rule, err := Parse(tt.rule)
+		if err != nil {
+			t.Fatalf("Parse(%q) returned error: %v", tt.rule, err)
+		}
+		if got := len(rule.Occurrences(at(time.October, 19), 0)); got != tt.want {
+			t.Fatalf("%s: occurrences = %d, want %d", tt.rule, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,230 @@
+package reminder
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"activity-platform/app/activity/model"
+	"activity-platform/common/messaging"
+
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+// ==================== Queue 定时提醒延迟队列 ====================
+//
+// 存储结构：
+//   - Key: activity:reminder:queue（ZSET）
+//   - Member: {kind}:{activityID}:{userID}
+//   - Score: 提醒触发时间（Unix 秒）
+//
+// 同一用户同一活动同一类型只有一个成员，重复入队只会更新触发时间；
+// 活动时间变更后按新时间重新入队即可覆盖旧的触发时间。
+// 到期任务由 ReminderCron 取出：先 ZRANGEBYSCORE 再逐个 ZREM，ZREM 成功才算抢到（多实例安全）。
+
+const (
+	queueKey = "activity:reminder:queue"
+
+	// DefaultRegisterClosingLead 默认报名截止提醒提前量
+	DefaultRegisterClosingLead = 2 * time.Hour
+)
+
+// startKinds 报名者的开始前提醒
+var startKinds = []string{messaging.ReminderKindStart24h, messaging.ReminderKindStart1h}
+
+// Job 到期的提醒任务
+type Job struct {
+	Kind       string
+	ActivityID uint64
+	UserID     uint64
+}
+
+// Queue 提醒延迟队列
+// nil 安全：Queue 为 nil 时所有写操作静默返回
+type Queue struct {
+	rds                 *redis.Redis
+	registerClosingLead time.Duration
+}
+
+// NewQueue 创建提醒延迟队列
+func NewQueue(rds *redis.Redis, registerClosingLead time.Duration) *Queue {
+	if rds == nil {
+		return nil
+	}
+	if registerClosingLead <= 0 {
+		registerClosingLead = DefaultRegisterClosingLead
+	}
+	return &Queue{rds: rds, registerClosingLead: registerClosingLead}
+}
+
+// FireTime 按活动当前时间计算提醒触发时间（0 表示该类型不适用）
+func (q *Queue) FireTime(kind string, act *model.Activity) int64 {
+	switch kind {
+	case messaging.ReminderKindStart24h:
+		if act.ActivityStartTime <= 0 {
+			return 0
+		}
+		return act.ActivityStartTime - int64((24 * time.Hour).Seconds())
+	case messaging.ReminderKindStart1h:
+		if act.ActivityStartTime <= 0 {
+			return 0
+		}
+		return act.ActivityStartTime - int64(time.Hour.Seconds())
+	case messaging.ReminderKindRegisterClosing:
+		if act.RegisterEndTime <= 0 {
+			return 0
+		}
+		return act.RegisterEndTime - int64(q.registerClosingLead.Seconds())
+	}
+	return 0
+}
+
+// ScheduleRegistration 报名成功后登记开始前提醒（已错过的提醒不入队）
+func (q *Queue) ScheduleRegistration(ctx context.Context, act *model.Activity, userID uint64) error {
+	if q == nil {
+		return nil
+	}
+	return q.schedule(ctx, act, startKinds, []uint64{userID})
+}
+
+// CancelRegistration 取消报名后移除开始前提醒
+func (q *Queue) CancelRegistration(ctx context.Context, activityID, userID uint64) error {
+	if q == nil {
+		return nil
+	}
+	return q.remove(ctx, activityID, startKinds, userID)
+}
+
+// ScheduleFavorite 收藏活动后登记报名截止提醒
+func (q *Queue) ScheduleFavorite(ctx context.Context, act *model.Activity, userID uint64) error {
+	if q == nil {
+		return nil
+	}
+	return q.schedule(ctx, act, []string{messaging.ReminderKindRegisterClosing}, []uint64{userID})
+}
+
+// CancelFavorite 取消收藏后移除报名截止提醒
+func (q *Queue) CancelFavorite(ctx context.Context, activityID, userID uint64) error {
+	if q == nil {
+		return nil
+	}
+	return q.remove(ctx, activityID, []string{messaging.ReminderKindRegisterClosing}, userID)
+}
+
+// Reschedule 活动时间变更后按新时间重排提醒
+// 已错过的提醒直接移除，避免旧的触发时间继续生效
+func (q *Queue) Reschedule(ctx context.Context, act *model.Activity, registrantIDs, favoriteUserIDs []uint64) error {
+	if q == nil {
+		return nil
+	}
+	if err := q.schedule(ctx, act, startKinds, registrantIDs); err != nil {
+		return err
+	}
+	return q.schedule(ctx, act, []string{messaging.ReminderKindRegisterClosing}, favoriteUserIDs)
+}
+
+// Requeue 重新入队（处理失败重试、触发时间后移）
+func (q *Queue) Requeue(ctx context.Context, job Job, fireAt int64) error {
+	if q == nil {
+		return nil
+	}
+	_, err := q.rds.ZaddCtx(ctx, queueKey, fireAt, member(job.Kind, job.ActivityID, job.UserID))
+	return err
+}
+
+// PopDue 取出到期任务（最多 limit 个）
+func (q *Queue) PopDue(ctx context.Context, now int64, limit int) ([]Job, error) {
+	if q == nil {
+		return nil, nil
+	}
+	pairs, err := q.rds.ZrangebyscoreWithScoresAndLimitCtx(ctx, queueKey, 0, now, 0, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	jobs := make([]Job, 0, len(pairs))
+	for _, pair := range pairs {
+		removed, err := q.rds.ZremCtx(ctx, queueKey, pair.Key)
+		if err != nil {
+			return jobs, err
+		}
+		if removed == 0 {
+			// 已被其他实例取走
+			continue
+		}
+		job, ok := parseMember(pair.Key)
+		if !ok {
+			continue
+		}
+		jobs = append(jobs, job)
+	}
+	return jobs, nil
+}
+
+// schedule 批量入队（ZADD 覆盖旧的触发时间）
+func (q *Queue) schedule(ctx context.Context, act *model.Activity, kinds []string, userIDs []uint64) error {
+	if act == nil || len(userIDs) == 0 {
+		return nil
+	}
+	now := time.Now().Unix()
+
+	var pairs []redis.Pair
+	var stale []any
+	for _, kind := range kinds {
+		fireAt := q.FireTime(kind, act)
+		for _, userID := range userIDs {
+			m := member(kind, act.ID, userID)
+			if fireAt <= now {
+				stale = append(stale, m)
+				continue
+			}
+			pairs = append(pairs, redis.Pair{Key: m, Score: fireAt})
+		}
+	}
+
+	if len(pairs) > 0 {
+		if _, err := q.rds.ZaddsCtx(ctx, queueKey, pairs...); err != nil {
+			return err
+		}
+	}
+	if len(stale) > 0 {
+		if _, err := q.rds.ZremCtx(ctx, queueKey, stale...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// remove 移除指定类型的提醒
+func (q *Queue) remove(ctx context.Context, activityID uint64, kinds []string, userID uint64) error {
+	members := make([]any, 0, len(kinds))
+	for _, kind := range kinds {
+		members = append(members, member(kind, activityID, userID))
+	}
+	_, err := q.rds.ZremCtx(ctx, queueKey, members...)
+	return err
+}
+
+// member 生成队列成员
+func member(kind string, activityID, userID uint64) string {
+	return fmt.Sprintf("%s:%d:%d", kind, activityID, userID)
+}
+
+// parseMember 解析队列成员
+func parseMember(m string) (Job, bool) {
+	parts := strings.Split(m, ":")
+	if len(parts) != 3 {
+		return Job{}, false
+	}
+	activityID, err := strconv.ParseUint(parts[1], 10, 64)
+	if err != nil {
+		return Job{}, false
+	}
+	userID, err := strconv.ParseUint(parts[2], 10, 64)
+	if err != nil {
+		return Job{}, false
+	}
+	return Job{Kind: parts[0], ActivityID: activityID, UserID: userID}, true
+}
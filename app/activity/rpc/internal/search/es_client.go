@@ -17,7 +17,7 @@ type ESConfig struct {
 	Hosts         []string `json:",default=[http://localhost:9200]"` // ES 地址
 	Username      string   `json:",optional"`                        // 认证用户名
 	Password      string   `json:",optional"`                        // 认证密码
-	IndexName     string   `json:",default=activities"`              // 索引名
+	IndexName     string   `json:",default=activities"`              // 索引名（读别名，物理索引为 {IndexName}_v{N}）
 	MaxRetries    int      `json:",default=3"`                       // 最大重试次数
 	HealthTimeout int      `json:",default=5"`                       // 健康检查超时（秒）
 }
@@ -27,8 +27,9 @@ type ESConfig struct {
 // ESClient ES 客户端封装
 type ESClient struct {
 	client    *elastic.Client
-	indexName string
+	indexName string // 读别名（旧版布局下为物理索引名）
 	config    ESConfig
+	targets   writeTargetCache // 写入目标缓存（见 es_index.go）
 }
 
 // NewESClient 创建 ES 客户端
@@ -82,7 +83,7 @@ func NewESClient(cfg ESConfig) (*ESClient, error) {
 		config:    cfg,
 	}
 
-	// 5. 确保索引存在
+	// 5. 确保索引与别名存在
 	if err := esClient.ensureIndex(ctx); err != nil {
 		logx.Errorf("[ESClient] 创建索引失败: %v", err)
 		// 索引创建失败不阻塞启动，后续可手动创建
@@ -91,38 +92,12 @@ func NewESClient(cfg ESConfig) (*ESClient, error) {
 	return esClient, nil
 }
 
-// ensureIndex 确保索引存在
-func (c *ESClient) ensureIndex(ctx context.Context) error {
-	exists, err := c.client.IndexExists(c.indexName).Do(ctx)
-	if err != nil {
-		return fmt.Errorf("检查索引存在失败: %w", err)
-	}
-
-	if !exists {
-		// 创建索引
-		createIndex, err := c.client.CreateIndex(c.indexName).
-			BodyString(IndexMapping).
-			Do(ctx)
-		if err != nil {
-			return fmt.Errorf("创建索引失败: %w", err)
-		}
-		if !createIndex.Acknowledged {
-			return fmt.Errorf("创建索引未被确认")
-		}
-		logx.Infof("[ESClient] 索引 %s 创建成功", c.indexName)
-	} else {
-		logx.Infof("[ESClient] 索引 %s 已存在", c.indexName)
-	}
-
-	return nil
-}
-
 // Client 获取原始客户端（用于高级操作）
 func (c *ESClient) Client() *elastic.Client {
 	return c.client
 }
 
-// IndexName 获取索引名
+// IndexName 获取索引名（读别名）
 func (c *ESClient) IndexName() string {
 	return c.indexName
 }
@@ -0,0 +1,247 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/olivere/elastic/v7"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// ==================== 版本化索引与别名 ====================
+//
+// 索引布局（以 IndexName=activities 为例）：
+//
+//	物理索引：activities_v1、activities_v2 ...     —— 每次修改 Mapping 新建一个版本
+//	读别名：  activities                           —— 搜索、建议
+//	写别名：  activities_write                     —— 同步写入
+//	双写别名：activities_dualwrite                 —— 重建期间指向新索引；切换后指向旧索引（回滚用）
+//
+// 写入时解析「写别名 + 双写别名」对应的全部物理索引，逐个写入，
+// 保证重建期间新旧索引都能收到增量变更。
+//
+// 兼容说明：
+//   - 旧版本直接使用名为 activities 的物理索引，读写照常进行
+//   - 执行一次 reindex 后自动迁移为版本化布局（旧物理索引在切换时删除，无法回滚）
+
+const (
+	writeAliasSuffix     = "_write"
+	dualWriteAliasSuffix = "_dualwrite"
+	versionInfix         = "_v"
+
+	// writeTargetsTTL 写入目标缓存时间
+	// 重建开始后，所有实例最迟在该时间后开始双写
+	writeTargetsTTL = 10 * time.Second
+)
+
+// ErrNoWriteIndex 没有可写入的索引（写别名未挂载）
+var ErrNoWriteIndex = errors.New("ES 写别名未挂载任何索引")
+
+// IndexState 索引别名状态
+type IndexState struct {
+	ReadIndex       string   // 读别名指向的物理索引
+	WriteIndex      string   // 写别名指向的物理索引
+	DualWriteIndex  string   // 双写别名指向的物理索引（可为空）
+	Versions        []int    // 已存在的版本号（升序）
+	LegacyIndexUsed bool     // 是否仍在使用旧版固定索引
+	Indices         []string // 所有相关物理索引
+}
+
+// writeTargetCache 写入目标缓存
+type writeTargetCache struct {
+	mu       sync.Mutex
+	targets  []string
+	expireAt time.Time
+}
+
+// readAlias 读别名（= 配置的 IndexName）
+func (c *ESClient) readAlias() string {
+	return c.indexName
+}
+
+// writeAlias 写别名
+func (c *ESClient) writeAlias() string {
+	return c.indexName + writeAliasSuffix
+}
+
+// dualWriteAlias 双写别名
+func (c *ESClient) dualWriteAlias() string {
+	return c.indexName + dualWriteAliasSuffix
+}
+
+// versionedIndex 版本化物理索引名
+func (c *ESClient) versionedIndex(version int) string {
+	return fmt.Sprintf("%s%s%d", c.indexName, versionInfix, version)
+}
+
+// parseVersion 从物理索引名解析版本号
+func (c *ESClient) parseVersion(index string) (int, bool) {
+	prefix := c.indexName + versionInfix
+	if !strings.HasPrefix(index, prefix) {
+		return 0, false
+	}
+	v, err := strconv.Atoi(strings.TrimPrefix(index, prefix))
+	if err != nil || v <= 0 {
+		return 0, false
+	}
+	return v, true
+}
+
+// IndexState 查询当前索引与别名状态
+func (c *ESClient) IndexState(ctx context.Context) (*IndexState, error) {
+	// GET /_alias 返回所有索引及其别名（无别名的索引也会返回）
+	result, err := c.client.Aliases().Do(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查询索引别名失败: %w", err)
+	}
+
+	state := &IndexState{}
+	for index, info := range result.Indices {
+		if index == c.indexName {
+			// 与读别名同名的物理索引：旧版布局
+			state.LegacyIndexUsed = true
+			state.ReadIndex = index
+			state.Indices = append(state.Indices, index)
+			continue
+		}
+		v, ok := c.parseVersion(index)
+		if !ok {
+			continue
+		}
+		state.Versions = append(state.Versions, v)
+		state.Indices = append(state.Indices, index)
+
+		if info.HasAlias(c.readAlias()) {
+			state.ReadIndex = index
+		}
+		if info.HasAlias(c.writeAlias()) {
+			state.WriteIndex = index
+		}
+		if info.HasAlias(c.dualWriteAlias()) {
+			state.DualWriteIndex = index
+		}
+	}
+	sort.Ints(state.Versions)
+	sort.Strings(state.Indices)
+
+	if state.LegacyIndexUsed && state.WriteIndex == "" {
+		state.WriteIndex = c.indexName
+	}
+	return state, nil
+}
+
+// ensureIndex 确保索引与别名存在
+//
+// 启动时调用：
+//   - 读别名已存在：补齐缺失的写别名
+//   - 旧版固定索引：保持原样（执行 reindex 迁移）
+//   - 都不存在：创建 v1 并挂载读写别名
+func (c *ESClient) ensureIndex(ctx context.Context) error {
+	state, err := c.IndexState(ctx)
+	if err != nil {
+		return err
+	}
+
+	// 1. 旧版布局
+	if state.LegacyIndexUsed {
+		logx.Infof("[ESClient] 使用旧版固定索引 %s，建议执行 reindex 迁移到版本化索引", c.indexName)
+		return nil
+	}
+
+	// 2. 已是版本化布局
+	if state.ReadIndex != "" {
+		if state.WriteIndex == "" {
+			if _, err := c.client.Alias().Add(state.ReadIndex, c.writeAlias()).Do(ctx); err != nil {
+				return fmt.Errorf("补齐写别名失败: %w", err)
+			}
+			logx.Infof("[ESClient] 补齐写别名 %s -> %s", c.writeAlias(), state.ReadIndex)
+		}
+		logx.Infof("[ESClient] 索引 %s -> %s 已存在", c.readAlias(), state.ReadIndex)
+		return nil
+	}
+
+	// 3. 全新环境：创建 v1
+	index := c.versionedIndex(1)
+	if len(state.Versions) > 0 {
+		index = c.versionedIndex(state.Versions[len(state.Versions)-1] + 1)
+	}
+	if err := c.createIndex(ctx, index); err != nil {
+		return err
+	}
+	_, err = c.client.Alias().
+		Action(
+			elastic.NewAliasAddAction(c.readAlias()).Index(index),
+			elastic.NewAliasAddAction(c.writeAlias()).Index(index),
+		).
+		Do(ctx)
+	if err != nil {
+		return fmt.Errorf("挂载别名失败: %w", err)
+	}
+
+	logx.Infof("[ESClient] 索引 %s 创建成功，别名 %s / %s", index, c.readAlias(), c.writeAlias())
+	return nil
+}
+
+// createIndex 使用当前 Mapping 创建物理索引
+func (c *ESClient) createIndex(ctx context.Context, index string) error {
+	createIndex, err := c.client.CreateIndex(index).
+		BodyString(IndexMapping).
+		Do(ctx)
+	if err != nil {
+		return fmt.Errorf("创建索引 %s 失败: %w", index, err)
+	}
+	if !createIndex.Acknowledged {
+		return fmt.Errorf("创建索引 %s 未被确认", index)
+	}
+	return nil
+}
+
+// writeTargets 获取当前写入目标（物理索引列表，带缓存）
+//
+// 解析失败时沿用上一次的结果。
+// 注意：不能退化为直接写别名名称——别名不存在时 ES 会自动创建同名索引（动态 Mapping）
+func (c *ESClient) writeTargets(ctx context.Context) ([]string, error) {
+	c.targets.mu.Lock()
+	defer c.targets.mu.Unlock()
+
+	if time.Now().Before(c.targets.expireAt) && len(c.targets.targets) > 0 {
+		return c.targets.targets, nil
+	}
+
+	state, err := c.IndexState(ctx)
+	if err != nil {
+		if len(c.targets.targets) > 0 {
+			logx.Errorf("[ESClient] 解析写入目标失败，沿用缓存: %v", err)
+			return c.targets.targets, nil
+		}
+		return nil, err
+	}
+
+	targets := make([]string, 0, 2)
+	if state.WriteIndex != "" {
+		targets = append(targets, state.WriteIndex)
+	}
+	if state.DualWriteIndex != "" && state.DualWriteIndex != state.WriteIndex {
+		targets = append(targets, state.DualWriteIndex)
+	}
+	if len(targets) == 0 {
+		return nil, ErrNoWriteIndex
+	}
+
+	c.targets.targets = targets
+	c.targets.expireAt = time.Now().Add(writeTargetsTTL)
+	return targets, nil
+}
+
+// invalidateWriteTargets 清除写入目标缓存（别名变更后调用）
+func (c *ESClient) invalidateWriteTargets() {
+	c.targets.mu.Lock()
+	c.targets.expireAt = time.Time{}
+	c.targets.mu.Unlock()
+}
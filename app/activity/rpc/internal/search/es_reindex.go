@@ -0,0 +1,279 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"activity-platform/app/activity/model"
+
+	"github.com/olivere/elastic/v7"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// ==================== 索引重建（零停机） ====================
+//
+// 重建流程：
+//  1. 创建新版本物理索引（使用当前 IndexMapping）
+//  2. 双写别名指向新索引，增量变更同时写入新旧索引
+//  3. 从 MySQL 全量同步到新索引
+//  4. 等待所有实例的写入目标缓存过期后，补一次增量同步（覆盖双写生效前的变更）
+//  5. 校验文档数与 MySQL 公开活动数一致
+//  6. 原子切换：读/写别名 -> 新索引，双写别名 -> 旧索引（保持旧索引最新，用于回滚）
+//
+// 校验失败时删除新索引，读写不受影响。
+// 确认无误后执行 DropPrevious 删除旧索引。
+
+// ErrNoPreviousIndex 没有可回滚/可删除的旧索引
+var ErrNoPreviousIndex = errors.New("没有可用的旧版本索引")
+
+// ReindexOptions 重建参数
+type ReindexOptions struct {
+	// Tolerance 允许的文档数偏差比例（0 表示必须完全一致）
+	// 重建期间仍有活动发布/下线，线上执行时可设置为 0.001 等小值
+	Tolerance float64
+}
+
+// ReindexResult 重建结果
+type ReindexResult struct {
+	OldIndex   string
+	NewIndex   string
+	DocCount   int64 // 新索引文档数
+	MySQLCount int64 // MySQL 公开活动数
+	Duration   time.Duration
+}
+
+// Reindexer 索引重建器
+type Reindexer struct {
+	es   *ESClient
+	sync *SyncService
+}
+
+// NewReindexer 创建索引重建器
+func NewReindexer(es *ESClient, sync *SyncService) *Reindexer {
+	return &Reindexer{
+		es:   es,
+		sync: sync,
+	}
+}
+
+// Status 查询当前索引状态
+func (r *Reindexer) Status(ctx context.Context) (*IndexState, error) {
+	return r.es.IndexState(ctx)
+}
+
+// Reindex 重建索引并原子切换别名
+func (r *Reindexer) Reindex(ctx context.Context, opts ReindexOptions) (*ReindexResult, error) {
+	startTime := time.Now()
+
+	// 1. 解析当前状态
+	state, err := r.es.IndexState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if state.ReadIndex == "" {
+		return nil, fmt.Errorf("读别名 %s 未挂载任何索引，请先启动服务完成初始化", r.es.readAlias())
+	}
+	if state.DualWriteIndex != "" && state.DualWriteIndex != state.ReadIndex {
+		// 上一次切换保留的旧索引，或中断的重建
+		return nil, fmt.Errorf("双写别名仍指向 %s，请先执行 drop-previous", state.DualWriteIndex)
+	}
+
+	newVersion := 1
+	if len(state.Versions) > 0 {
+		newVersion = state.Versions[len(state.Versions)-1] + 1
+	}
+	newIndex := r.es.versionedIndex(newVersion)
+	result := &ReindexResult{
+		OldIndex: state.ReadIndex,
+		NewIndex: newIndex,
+	}
+	logx.Infof("[Reindex] 开始重建: %s -> %s", result.OldIndex, newIndex)
+
+	// 2. 创建新索引
+	if err := r.es.createIndex(ctx, newIndex); err != nil {
+		return nil, err
+	}
+
+	// 3. 开启双写
+	if _, err := r.es.client.Alias().Add(newIndex, r.es.dualWriteAlias()).Do(ctx); err != nil {
+		r.abort(newIndex)
+		return nil, fmt.Errorf("挂载双写别名失败: %w", err)
+	}
+	r.es.invalidateWriteTargets()
+	dualWriteAt := time.Now()
+
+	// 4. 全量同步到新索引
+	if _, err := r.sync.fullSync(ctx, []string{newIndex}); err != nil {
+		r.abort(newIndex)
+		return nil, fmt.Errorf("全量同步失败: %w", err)
+	}
+
+	// 5. 等待其他实例开始双写，补齐双写生效前的增量
+	// 增量起点回退一个缓存周期，覆盖各实例写入目标缓存未过期的窗口
+	if wait := writeTargetsTTL - time.Since(dualWriteAt); wait > 0 {
+		logx.Infof("[Reindex] 等待写入目标缓存过期 %v", wait)
+		select {
+		case <-ctx.Done():
+			r.abort(newIndex)
+			return nil, ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+	if err := r.sync.IncrementalSync(ctx, dualWriteAt.Add(-writeTargetsTTL)); err != nil {
+		r.abort(newIndex)
+		return nil, fmt.Errorf("增量同步失败: %w", err)
+	}
+
+	// 6. 校验文档数
+	if _, err := r.es.client.Refresh(newIndex).Do(ctx); err != nil {
+		r.abort(newIndex)
+		return nil, fmt.Errorf("刷新新索引失败: %w", err)
+	}
+	result.DocCount, err = r.es.client.Count(newIndex).Do(ctx)
+	if err != nil {
+		r.abort(newIndex)
+		return nil, fmt.Errorf("统计新索引文档数失败: %w", err)
+	}
+	result.MySQLCount, err = r.sync.countPublic(ctx)
+	if err != nil {
+		r.abort(newIndex)
+		return nil, err
+	}
+	if !countMatches(result.DocCount, result.MySQLCount, opts.Tolerance) {
+		r.abort(newIndex)
+		return nil, fmt.Errorf("文档数校验失败: ES=%d, MySQL=%d, tolerance=%.4f",
+			result.DocCount, result.MySQLCount, opts.Tolerance)
+	}
+
+	// 7. 原子切换别名
+	actions := []elastic.AliasAction{
+		elastic.NewAliasAddAction(r.es.readAlias()).Index(newIndex),
+		elastic.NewAliasAddAction(r.es.writeAlias()).Index(newIndex),
+		elastic.NewAliasRemoveAction(r.es.dualWriteAlias()).Index(newIndex),
+	}
+	if state.LegacyIndexUsed {
+		// 旧版固定索引与读别名同名，必须在同一请求中删除才能挂载别名
+		actions = append(actions, elastic.NewAliasRemoveIndexAction(result.OldIndex))
+	} else {
+		actions = append(actions,
+			elastic.NewAliasRemoveAction(r.es.readAlias()).Index(result.OldIndex),
+			elastic.NewAliasRemoveAction(r.es.writeAlias()).Index(result.OldIndex),
+			elastic.NewAliasAddAction(r.es.dualWriteAlias()).Index(result.OldIndex),
+		)
+	}
+	if _, err := r.es.client.Alias().Action(actions...).Do(ctx); err != nil {
+		r.abort(newIndex)
+		return nil, fmt.Errorf("切换别名失败: %w", err)
+	}
+	r.es.invalidateWriteTargets()
+
+	result.Duration = time.Since(startTime)
+	logx.Infof("[Reindex] 重建完成: %s -> %s, 文档数=%d, 耗时=%v",
+		result.OldIndex, newIndex, result.DocCount, result.Duration)
+	return result, nil
+}
+
+// Rollback 回滚到上一个版本
+//
+// 读/写别名切回旧索引，双写别名指向当前索引（保持其最新，便于再次切换）
+func (r *Reindexer) Rollback(ctx context.Context) (*IndexState, error) {
+	state, err := r.es.IndexState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	previous := state.DualWriteIndex
+	if previous == "" || previous == state.ReadIndex || state.LegacyIndexUsed {
+		return nil, ErrNoPreviousIndex
+	}
+
+	current := state.ReadIndex
+	_, err = r.es.client.Alias().
+		Action(
+			elastic.NewAliasRemoveAction(r.es.readAlias()).Index(current),
+			elastic.NewAliasRemoveAction(r.es.writeAlias()).Index(current),
+			elastic.NewAliasRemoveAction(r.es.dualWriteAlias()).Index(previous),
+			elastic.NewAliasAddAction(r.es.readAlias()).Index(previous),
+			elastic.NewAliasAddAction(r.es.writeAlias()).Index(previous),
+			elastic.NewAliasAddAction(r.es.dualWriteAlias()).Index(current),
+		).
+		Do(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("回滚别名失败: %w", err)
+	}
+	r.es.invalidateWriteTargets()
+
+	logx.Infof("[Reindex] 已回滚: %s -> %s", current, previous)
+	return r.es.IndexState(ctx)
+}
+
+// DropPrevious 删除旧版本索引（确认新索引无误后执行）
+func (r *Reindexer) DropPrevious(ctx context.Context) (string, error) {
+	state, err := r.es.IndexState(ctx)
+	if err != nil {
+		return "", err
+	}
+	previous := state.DualWriteIndex
+	if previous == "" || previous == state.ReadIndex {
+		return "", ErrNoPreviousIndex
+	}
+
+	// 先摘除双写别名，等待缓存过期后再删除，避免其他实例写入已删除的索引时自动创建
+	if _, err := r.es.client.Alias().Remove(previous, r.es.dualWriteAlias()).Do(ctx); err != nil {
+		return "", fmt.Errorf("摘除双写别名失败: %w", err)
+	}
+	r.es.invalidateWriteTargets()
+
+	select {
+	case <-ctx.Done():
+		return "", ctx.Err()
+	case <-time.After(writeTargetsTTL):
+	}
+
+	if _, err := r.es.client.DeleteIndex(previous).Do(ctx); err != nil {
+		return "", fmt.Errorf("删除旧索引 %s 失败: %w", previous, err)
+	}
+
+	logx.Infof("[Reindex] 已删除旧索引 %s", previous)
+	return previous, nil
+}
+
+// abort 重建失败时清理新索引（删除索引会同时移除其上的别名）
+func (r *Reindexer) abort(index string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	if _, err := r.es.client.DeleteIndex(index).Do(ctx); err != nil {
+		logx.Errorf("[Reindex] 清理新索引 %s 失败，请手动删除: %v", index, err)
+	} else {
+		logx.Infof("[Reindex] 已清理新索引 %s", index)
+	}
+	r.es.invalidateWriteTargets()
+}
+
+// countMatches 文档数是否在允许偏差内
+func countMatches(esCount, dbCount int64, tolerance float64) bool {
+	if esCount == dbCount {
+		return true
+	}
+	if tolerance <= 0 {
+		return false
+	}
+	diff := math.Abs(float64(esCount - dbCount))
+	return diff <= math.Ceil(float64(dbCount)*tolerance)
+}
+
+// countPublic 统计 MySQL 中应被索引的公开活动数
+func (s *SyncService) countPublic(ctx context.Context) (int64, error) {
+	var count int64
+	err := s.db.WithContext(ctx).
+		Model(&model.Activity{}).
+		Where("status IN ?", []int8{model.StatusPublished, model.StatusOngoing, model.StatusFinished}).
+		Count(&count).Error
+	if err != nil {
+		return 0, fmt.Errorf("统计公开活动数失败: %w", err)
+	}
+	return count, nil
+}
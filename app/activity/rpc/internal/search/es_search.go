@@ -4,12 +4,19 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"strings"
 	"time"
 
+	"activity-platform/app/activity/model"
+
 	"github.com/olivere/elastic/v7"
 	"github.com/zeromicro/go-zero/core/logx"
 )
 
+// facetSize 分类/标签分面最多返回的桶数
+const facetSize = 50
+
 // ==================== 错误定义 ====================
 
 var (
@@ -23,10 +30,11 @@ var (
 //
 // 搜索流程：
 // 1. 构建查询条件（dis_max 优化）
-// 2. 添加过滤条件（状态、分类、时间）
-// 3. 添加排序和高亮
-// 4. 执行搜索
-// 5. 解析结果
+// 2. 添加过滤条件（状态、时间、地理、报名门槛）
+// 3. 分类/标签筛选放入 post_filter（不影响分面统计）
+// 4. 添加排序、高亮和分面聚合
+// 5. 执行搜索
+// 6. 解析结果
 //
 // 性能优化：
 // - 使用 dis_max 替代 multi_match，性能提升 30-50%
@@ -48,6 +56,7 @@ func (c *ESClient) Search(ctx context.Context, req SearchRequest) (*SearchRespon
 
 	// 2. 构建查询
 	query := c.buildQuery(req)
+	categoryFilter, tagFilter := c.buildFacetFilters(req)
 
 	// 3. 构建搜索请求
 	searchService := c.client.Search().
@@ -57,25 +66,39 @@ func (c *ESClient) Search(ctx context.Context, req SearchRequest) (*SearchRespon
 		Size(req.PageSize).
 		TrackTotalHits(true) // 精确统计总数
 
-	// 4. 添加高亮
+	// 分类/标签筛选使用 post_filter：只过滤命中结果，不影响聚合
+	if categoryFilter != nil || tagFilter != nil {
+		searchService = searchService.PostFilter(andQuery(categoryFilter, tagFilter))
+	}
+
+	// 4. 添加高亮（IK 分词命中片段）
+	// 标题、描述为用户输入且前端按 HTML 渲染高亮结果，encoder=html 转义原文防止 XSS
 	searchService = searchService.Highlight(
 		elastic.NewHighlight().
+			Encoder("html").
 			PreTags("<em>").
 			PostTags("</em>").
 			Fields(
-				elastic.NewHighlighterField("title"),
-				elastic.NewHighlighterField("description").NumOfFragments(2),
-				elastic.NewHighlighterField("location"),
+				elastic.NewHighlighterField("title").NumOfFragments(0), // 0 = 返回完整标题
+				elastic.NewHighlighterField("description").FragmentSize(60).NumOfFragments(2),
 			),
 	)
 
 	// 5. 添加排序
-	searchService = c.addSort(searchService, req.SortBy, req.Query)
-
-	// 6. 添加聚合（分类统计，可选）
-	searchService = searchService.Aggregation("category_count",
-		elastic.NewTermsAggregation().Field("category_id").Size(10),
-	)
+	searchService = c.addSort(searchService, req)
+
+	// 6. 添加分面聚合
+	// 每个维度只应用「其他维度」的筛选，保证多选时本维度的其他选项数量可见
+	searchService = searchService.
+		Aggregation("category_facet", elastic.NewFilterAggregation().
+			Filter(andQuery(tagFilter)).
+			SubAggregation("terms", elastic.NewTermsAggregation().Field("category_id").Size(facetSize))).
+		Aggregation("tag_facet", elastic.NewFilterAggregation().
+			Filter(andQuery(categoryFilter)).
+			SubAggregation("terms", elastic.NewTermsAggregation().Field("tag_ids").Size(facetSize))).
+		Aggregation("status_facet", elastic.NewFilterAggregation().
+			Filter(andQuery(categoryFilter, tagFilter)).
+			SubAggregation("terms", elastic.NewTermsAggregation().Field("status").Size(10)))
 
 	// 7. 执行搜索
 	result, err := searchService.Do(ctx)
@@ -98,31 +121,32 @@ func (c *ESClient) Search(ctx context.Context, req SearchRequest) (*SearchRespon
 			continue
 		}
 
-		// 处理高亮（替换原文）
+		// 处理高亮（单独字段返回，原文保持不变）
 		if hit.Highlight != nil {
 			if titles, ok := hit.Highlight["title"]; ok && len(titles) > 0 {
-				doc.Title = titles[0]
+				doc.TitleHighlight = titles[0]
 			}
 			if descs, ok := hit.Highlight["description"]; ok && len(descs) > 0 {
-				doc.Description = descs[0]
-			}
-			if locations, ok := hit.Highlight["location"]; ok && len(locations) > 0 {
-				doc.Location = locations[0]
+				doc.DescriptionHighlight = strings.Join(descs, "...")
 			}
 		}
 
+		// 地理搜索：回填距离（按距离排序时取 sort 值，否则本地计算）
+		if req.Geo != nil {
+			doc.Distance = hitDistance(hit, &doc, req)
+		}
+
 		response.Activities = append(response.Activities, doc)
 	}
 
-	// 9. 解析聚合
-	if agg, found := result.Aggregations.Terms("category_count"); found {
-		categoryCount := make(map[string]interface{})
-		for _, bucket := range agg.Buckets {
-			categoryCount[json.Number(bucket.KeyNumber.String()).String()] = bucket.DocCount
-		}
-		response.Aggregations = map[string]interface{}{
-			"category_count": categoryCount,
-		}
+	// 9. 解析分面聚合
+	response.Facets = &Facets{
+		Categories: filterTermsCounts(result.Aggregations, "category_facet"),
+		Tags:       filterTermsCounts(result.Aggregations, "tag_facet"),
+		Statuses:   make(map[int8]int64),
+	}
+	for status, count := range filterTermsCounts(result.Aggregations, "status_facet") {
+		response.Facets.Statuses[int8(status)] = count
 	}
 
 	logx.Infof("[ESSearch] 搜索成功: query=%s, total=%d, returned=%d, took_ms=%d",
@@ -136,8 +160,9 @@ func (c *ESClient) Search(ctx context.Context, req SearchRequest) (*SearchRespon
 // 查询策略：
 // - 关键词搜索：使用 dis_max 选择最佳匹配字段
 // - 状态筛选：使用 filter（不计算评分，可被 ES 缓存）
-// - 分类筛选：使用 filter
 // - 时间范围：使用 filter
+// - 地理范围、报名门槛：使用 filter
+// - 分类、标签：见 buildFacetFilters（post_filter）
 func (c *ESClient) buildQuery(req SearchRequest) elastic.Query {
 	boolQuery := elastic.NewBoolQuery()
 
@@ -173,12 +198,7 @@ func (c *ESClient) buildQuery(req SearchRequest) elastic.Query {
 			StatusPublished, StatusOngoing, StatusFinished))
 	}
 
-	// 3. 分类筛选
-	if req.CategoryID > 0 {
-		boolQuery.Filter(elastic.NewTermQuery("category_id", req.CategoryID))
-	}
-
-	// 4. 时间范围筛选
+	// 3. 时间范围筛选
 	// ⚠️ ES mapping 使用 epoch_second，直接传 int64 时间戳
 	if req.StartTime != nil || req.EndTime != nil {
 		rangeQuery := elastic.NewRangeQuery("activity_start_time")
@@ -191,9 +211,91 @@ func (c *ESClient) buildQuery(req SearchRequest) elastic.Query {
 		boolQuery.Filter(rangeQuery)
 	}
 
+	// 4. 地理范围筛选（geo_distance，无坐标的文档自然被排除）
+	if req.Geo != nil {
+		boolQuery.Filter(elastic.NewGeoDistanceQuery("geo_location").
+			Lat(req.Geo.Lat).
+			Lon(req.Geo.Lon).
+			Distance(fmt.Sprintf("%gkm", req.Geo.RadiusKm)))
+	}
+
+	// 5. 报名门槛筛选
+	if req.HasSeats {
+		// 不限人数（max_participants=0）或未报满
+		boolQuery.Filter(elastic.NewScriptQuery(elastic.NewScript(
+			"doc['max_participants'].value == 0 || doc['current_participants'].value < doc['max_participants'].value",
+		)))
+	}
+	if req.NoStudentVerify {
+		boolQuery.Filter(elastic.NewTermQuery("require_student_verify", false))
+	}
+	if req.MaxCreditScore != nil {
+		boolQuery.Filter(elastic.NewRangeQuery("min_credit_score").Lte(*req.MaxCreditScore))
+	}
+
+	return boolQuery
+}
+
+// buildFacetFilters 构建分面维度的筛选条件（分类、标签）
+//
+// 这两个条件不放入主查询，而是作为 post_filter 和聚合过滤器使用，
+// 未设置时返回 nil
+func (c *ESClient) buildFacetFilters(req SearchRequest) (categoryFilter, tagFilter elastic.Query) {
+	if req.CategoryID > 0 {
+		categoryFilter = elastic.NewTermQuery("category_id", req.CategoryID)
+	}
+	if len(req.TagIDs) > 0 {
+		tagValues := make([]interface{}, len(req.TagIDs))
+		for i, id := range req.TagIDs {
+			tagValues[i] = id
+		}
+		tagFilter = elastic.NewTermsQuery("tag_ids", tagValues...)
+	}
+	return categoryFilter, tagFilter
+}
+
+// andQuery 组合多个过滤条件（忽略 nil），全部为 nil 时返回 match_all
+func andQuery(filters ...elastic.Query) elastic.Query {
+	boolQuery := elastic.NewBoolQuery()
+	hasFilter := false
+	for _, f := range filters {
+		if f != nil {
+			boolQuery.Filter(f)
+			hasFilter = true
+		}
+	}
+	if !hasFilter {
+		return elastic.NewMatchAllQuery()
+	}
 	return boolQuery
 }
 
+// filterTermsCounts 解析「filter + terms」结构的聚合结果
+func filterTermsCounts(aggs elastic.Aggregations, name string) map[uint64]int64 {
+	filterAgg, found := aggs.Filter(name)
+	if !found {
+		return make(map[uint64]int64)
+	}
+	return termsCounts(filterAgg.Aggregations, "terms")
+}
+
+// termsCounts 解析 terms 聚合结果（数值型 key）
+func termsCounts(aggs elastic.Aggregations, name string) map[uint64]int64 {
+	counts := make(map[uint64]int64)
+	agg, found := aggs.Terms(name)
+	if !found {
+		return counts
+	}
+	for _, bucket := range agg.Buckets {
+		key, err := bucket.KeyNumber.Int64()
+		if err != nil {
+			continue
+		}
+		counts[uint64(key)] = bucket.DocCount
+	}
+	return counts
+}
+
 // addSort 添加排序
 //
 // 排序策略：
@@ -201,8 +303,22 @@ func (c *ESClient) buildQuery(req SearchRequest) elastic.Query {
 // - time：按活动开始时间
 // - hot：按报名人数
 // - newest：按创建时间
-func (c *ESClient) addSort(s *elastic.SearchService, sortBy string, query string) *elastic.SearchService {
-	switch sortBy {
+// - rating：按评分（均分相同时评价人数多的优先）
+// - distance：按与查询坐标的距离（需要 Geo 条件，单位：米）
+func (c *ESClient) addSort(s *elastic.SearchService, req SearchRequest) *elastic.SearchService {
+	switch req.SortBy {
+	case "distance":
+		if req.Geo != nil {
+			return s.SortBy(
+				elastic.NewGeoDistanceSort("geo_location").
+					Point(req.Geo.Lat, req.Geo.Lon).
+					Unit("m").
+					Asc(),
+				elastic.NewFieldSort("id").Desc(),
+			)
+		}
+		// 无坐标时退化为按创建时间排序
+		return s.Sort("created_at", false).Sort("id", false)
 	case "time":
 		// 按活动开始时间排序（即将开始的优先）
 		return s.Sort("activity_start_time", true).Sort("id", false)
@@ -212,11 +328,18 @@ func (c *ESClient) addSort(s *elastic.SearchService, sortBy string, query string
 	case "newest":
 		// 按创建时间排序
 		return s.Sort("created_at", false).Sort("id", false)
+	case "rating":
+		// 按评分排序（旧索引无评分字段时排在最后）
+		return s.SortBy(
+			elastic.NewFieldSort("rating_avg").Desc().Missing("_last").UnmappedType("float"),
+			elastic.NewFieldSort("rating_count").Desc().Missing("_last").UnmappedType("integer"),
+			elastic.NewFieldSort("id").Desc(),
+		)
 	case "relevance":
 		fallthrough
 	default:
 		// 按相关性排序
-		if query != "" {
+		if req.Query != "" {
 			// 有关键词：按评分排序 + id 作为 tie-breaker（保证分页一致性）
 			return s.Sort("_score", false).Sort("id", false)
 		}
@@ -225,6 +348,22 @@ func (c *ESClient) addSort(s *elastic.SearchService, sortBy string, query string
 	}
 }
 
+// hitDistance 获取命中文档与查询坐标的距离（米）
+//
+// 按距离排序时 ES 已在 sort[0] 中返回精确距离，直接使用；
+// 其他排序方式下使用 Haversine 公式本地计算
+func hitDistance(hit *elastic.SearchHit, doc *ActivityDoc, req SearchRequest) float64 {
+	if req.SortBy == "distance" && len(hit.Sort) > 0 {
+		if d, ok := hit.Sort[0].(float64); ok {
+			return d
+		}
+	}
+	if doc.GeoLocation == nil {
+		return 0
+	}
+	return model.DistanceMeters(req.Geo.Lat, req.Geo.Lon, doc.GeoLocation.Lat, doc.GeoLocation.Lon)
+}
+
 // ==================== 高级搜索功能 ====================
 
 // SearchByIDs 根据 ID 批量获取文档
@@ -276,14 +415,5 @@ func (c *ESClient) CountByCategory(ctx context.Context) (map[uint64]int64, error
 		return nil, err
 	}
 
-	counts := make(map[uint64]int64)
-	if agg, found := result.Aggregations.Terms("category_count"); found {
-		for _, bucket := range agg.Buckets {
-			if categoryID, ok := bucket.Key.(float64); ok {
-				counts[uint64(categoryID)] = bucket.DocCount
-			}
-		}
-	}
-
-	return counts, nil
+	return termsCounts(result.Aggregations, "category_count"), nil
 }
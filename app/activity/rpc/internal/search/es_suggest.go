@@ -0,0 +1,90 @@
+package search
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/olivere/elastic/v7"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// ==================== 搜索建议（自动补全）====================
+
+const suggestName = "activity_suggest"
+
+// Suggest 搜索建议
+//
+// 使用 completion suggester（FST 内存结构，前缀查询毫秒级）：
+// - 前缀匹配 suggest 字段（标题、标签、组织者名称）
+// - 按分类 context 过滤（CategoryID > 0 时）
+// - 按 weight（报名人数）排序，skip_duplicates 去重
+func (c *ESClient) Suggest(ctx context.Context, req SuggestRequest) (*SuggestResponse, error) {
+	startTime := time.Now()
+
+	// 1. 参数规范化
+	prefix := strings.TrimSpace(req.Prefix)
+	if prefix == "" {
+		return &SuggestResponse{Suggestions: []string{}}, nil
+	}
+	if req.Size <= 0 {
+		req.Size = 10
+	}
+	if req.Size > 20 {
+		req.Size = 20
+	}
+
+	// 2. 构建 completion suggester
+	suggester := elastic.NewCompletionSuggester(suggestName).
+		Field("suggest").
+		Prefix(prefix).
+		Size(req.Size).
+		SkipDuplicates(true)
+	if req.CategoryID > 0 {
+		suggester = suggester.ContextQuery(
+			elastic.NewSuggesterCategoryQuery("category", fmt.Sprintf("%d", req.CategoryID)),
+		)
+	}
+
+	// 3. 执行查询（不需要 hits，只取 suggest 结果）
+	result, err := c.client.Search().
+		Index(c.indexName).
+		Suggester(suggester).
+		FetchSource(false).
+		Size(0).
+		Do(ctx)
+	if err != nil {
+		logx.Errorf("[ESSuggest] 查询失败: prefix=%s, err=%v", prefix, err)
+		return nil, err
+	}
+
+	// 4. 解析结果
+	response := &SuggestResponse{Suggestions: make([]string, 0, req.Size)}
+	seen := make(map[string]bool, req.Size)
+	for _, s := range result.Suggest[suggestName] {
+		for _, opt := range s.Options {
+			if opt.Text == "" || seen[opt.Text] {
+				continue
+			}
+			seen[opt.Text] = true
+			response.Suggestions = append(response.Suggestions, opt.Text)
+		}
+	}
+
+	logx.Infof("[ESSuggest] 查询成功: prefix=%s, returned=%d, took_ms=%d",
+		prefix, len(response.Suggestions), time.Since(startTime).Milliseconds())
+
+	return response, nil
+}
+
+// SuggestWithFallback 带降级的搜索建议
+//
+// ES 未启用时返回错误让上层降级到 MySQL
+func (c *ESClientWithBreaker) SuggestWithFallback(ctx context.Context, req SuggestRequest) (*SuggestResponse, error) {
+	if !c.IsEnabled() {
+		return nil, ErrESNotEnabled
+	}
+
+	return c.ESClient.Suggest(ctx, req)
+}
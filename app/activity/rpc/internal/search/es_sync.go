@@ -3,6 +3,8 @@ package search
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"strings"
 	"time"
 
 	"activity-platform/app/activity/model"
@@ -17,14 +19,14 @@ import (
 // SyncService 数据同步服务
 //
 // 职责：
-// - 单个活动同步（创建/更新后）
+// - 单个活动同步（由活动变更事件驱动，见 cron.ChangeRelay）
 // - 批量同步（全量同步）
 // - 删除同步
 //
 // 同步策略：
 // - 使用外部版本控制，防止旧数据覆盖新数据
-// - 异步同步 + 重试机制
-// - 失败记录（后续可扩展失败队列）
+// - 变更事件 Outbox 保证不丢：失败的事件由 ChangeRelay 重试
+// - IncrementalSync 仅用于重建索引时追平双写窗口内的变更（见 Reindexer）
 type SyncService struct {
 	es       *ESClient
 	db       *gorm.DB
@@ -48,8 +50,11 @@ func NewSyncService(es *ESClient, db *gorm.DB, tagModel *model.TagCacheModel, ca
 //
 // 使用外部版本控制：
 // - 版本号 = UpdatedAt 时间戳
-// - 只有版本更大时才会更新
+// - 版本大于等于已有版本时才会更新（external_gte）
 // - 防止并发写入时旧数据覆盖新数据
+//
+// UpdatedAt 精度为秒，同一秒内的两次修改版本号相同，
+// 使用 external 会丢弃后一次修改，因此单条同步使用 external_gte
 func (s *SyncService) IndexActivity(ctx context.Context, activity *model.Activity) error {
 	if s.es == nil {
 		return nil // ES 未启用
@@ -65,60 +70,36 @@ func (s *SyncService) IndexActivity(ctx context.Context, activity *model.Activit
 	// 2. 使用 UpdatedAt 作为外部版本号
 	version := activity.UpdatedAt
 
-	// 3. 索引文档
-	_, err = s.es.client.Index().
-		Index(s.es.indexName).
-		Id(DocID(activity.ID)).
-		BodyJson(doc).
-		VersionType("external"). // 使用外部版本控制
-		Version(version).        // 版本号 = 更新时间戳
-		Refresh("false").        // 不立即刷新，提高写入性能
-		Do(ctx)
-
+	// 3. 索引文档（写入全部写入目标：写别名 + 重建期间的双写索引）
+	targets, err := s.es.writeTargets(ctx)
 	if err != nil {
-		// 版本冲突不是错误，说明已有更新的数据
-		if elastic.IsConflict(err) {
-			logx.Infof("[ESSync] 跳过旧版本数据 id=%d", activity.ID)
-			return nil
-		}
-		logx.Errorf("[ESSync] 索引活动失败 id=%d: %v", activity.ID, err)
+		logx.Errorf("[ESSync] 解析写入目标失败 id=%d: %v", activity.ID, err)
 		return err
 	}
 
-	logx.Infof("[ESSync] 索引活动成功 id=%d, version=%d", activity.ID, version)
-	return nil
-}
+	for _, index := range targets {
+		_, err = s.es.client.Index().
+			Index(index).
+			Id(DocID(activity.ID)).
+			BodyJson(doc).
+			VersionType("external_gte"). // 使用外部版本控制
+			Version(version).            // 版本号 = 更新时间戳
+			Refresh("false").            // 不立即刷新，提高写入性能
+			Do(ctx)
 
-// IndexActivityAsync 异步索引活动（带重试）
-//
-// 用于 Create/Update Logic 中，不阻塞主流程
-// 失败时记录日志，后续可扩展失败队列
-func (s *SyncService) IndexActivityAsync(activity *model.Activity) {
-	if s.es == nil {
-		return
-	}
-
-	// 值拷贝，防止调用方后续修改导致 goroutine 读到不一致数据
-	activityCopy := *activity
-	go func() {
-		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-		defer cancel()
-
-		// 重试 3 次
-		var err error
-		for i := 0; i < 3; i++ {
-			err = s.IndexActivity(ctx, &activityCopy)
-			if err == nil {
-				return
+		if err != nil {
+			// 版本冲突不是错误，说明已有更新的数据
+			if elastic.IsConflict(err) {
+				logx.Infof("[ESSync] 跳过旧版本数据 id=%d, index=%s", activity.ID, index)
+				continue
 			}
-			logx.Errorf("[ESSync] 异步索引重试 %d/3, id=%d: %v", i+1, activityCopy.ID, err)
-			time.Sleep(time.Duration(i+1) * time.Second) // 1s, 2s, 3s
+			logx.Errorf("[ESSync] 索引活动失败 id=%d, index=%s: %v", activity.ID, index, err)
+			return err
 		}
+	}
 
-		// 3 次重试失败，记录日志
-		// TODO: 写入失败队列表，由定时任务重试
-		logx.Errorf("[ESSync] 异步索引最终失败 id=%d: %v", activityCopy.ID, err)
-	}()
+	logx.Infof("[ESSync] 索引活动成功 id=%d, version=%d", activity.ID, version)
+	return nil
 }
 
 // DeleteActivity 删除活动索引
@@ -127,40 +108,73 @@ func (s *SyncService) DeleteActivity(ctx context.Context, id uint64) error {
 		return nil
 	}
 
-	_, err := s.es.client.Delete().
-		Index(s.es.indexName).
-		Id(DocID(id)).
-		Refresh("false").
-		Do(ctx)
-
+	targets, err := s.es.writeTargets(ctx)
 	if err != nil {
-		// 忽略文档不存在的错误
-		if elastic.IsNotFound(err) {
-			logx.Infof("[ESSync] 文档不存在，跳过删除 id=%d", id)
-			return nil
-		}
-		logx.Errorf("[ESSync] 删除活动索引失败 id=%d: %v", id, err)
+		logx.Errorf("[ESSync] 解析写入目标失败 id=%d: %v", id, err)
 		return err
 	}
 
+	for _, index := range targets {
+		_, err = s.es.client.Delete().
+			Index(index).
+			Id(DocID(id)).
+			Refresh("false").
+			Do(ctx)
+
+		if err != nil {
+			// 忽略文档不存在的错误
+			if elastic.IsNotFound(err) {
+				logx.Infof("[ESSync] 文档不存在，跳过删除 id=%d, index=%s", id, index)
+				continue
+			}
+			logx.Errorf("[ESSync] 删除活动索引失败 id=%d, index=%s: %v", id, index, err)
+			return err
+		}
+	}
+
 	logx.Infof("[ESSync] 删除活动索引成功 id=%d", id)
 	return nil
 }
 
-// DeleteActivityAsync 异步删除活动索引
-func (s *SyncService) DeleteActivityAsync(id uint64) {
-	if s.es == nil {
-		return
+// SyncActivities 按活动 ID 同步（变更事件消费方调用）
+//
+// 回查 MySQL 最新数据（含软删除）：
+// - 公开状态：索引到 ES
+// - 非公开 / 已删除 / 不存在：从 ES 删除
+//
+// 返回第一个失败的错误，其余活动继续处理
+func (s *SyncService) SyncActivities(ctx context.Context, ids []uint64) error {
+	if s.es == nil || len(ids) == 0 {
+		return nil
+	}
+
+	var activities []model.Activity
+	err := s.db.WithContext(ctx).
+		Unscoped().
+		Where("id IN ?", ids).
+		Find(&activities).Error
+	if err != nil {
+		return fmt.Errorf("查询活动失败: %w", err)
 	}
 
-	go func() {
-		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-		defer cancel()
+	activityMap := make(map[uint64]*model.Activity, len(activities))
+	for i := range activities {
+		activityMap[activities[i].ID] = &activities[i]
+	}
 
-		if err := s.DeleteActivity(ctx, id); err != nil {
-			logx.Errorf("[ESSync] 异步删除失败 id=%d: %v", id, err)
+	var firstErr error
+	for _, id := range ids {
+		act, ok := activityMap[id]
+		if ok && !act.DeletedAt.Valid && act.IsPublic() {
+			err = s.IndexActivity(ctx, act)
+		} else {
+			err = s.DeleteActivity(ctx, id)
+		}
+		if err != nil && firstErr == nil {
+			firstErr = err
 		}
-	}()
+	}
+	return firstErr
 }
 
 // ==================== 批量同步 ====================
@@ -176,8 +190,20 @@ func (s *SyncService) FullSync(ctx context.Context) error {
 		return nil
 	}
 
+	targets, err := s.es.writeTargets(ctx)
+	if err != nil {
+		return fmt.Errorf("解析写入目标失败: %w", err)
+	}
+	_, err = s.fullSync(ctx, targets)
+	return err
+}
+
+// fullSync 全量同步到指定的物理索引，返回成功写入的文档数
+//
+// 重建索引时只写入新索引，避免对旧索引重复全量写入
+func (s *SyncService) fullSync(ctx context.Context, targets []string) (int, error) {
 	startTime := time.Now()
-	logx.Info("[ESSync] 开始全量同步...")
+	logx.Infof("[ESSync] 开始全量同步 -> %v", targets)
 
 	const batchSize = 500
 	var (
@@ -201,7 +227,7 @@ func (s *SyncService) FullSync(ctx context.Context) error {
 			Find(&activities).Error
 
 		if err != nil {
-			return fmt.Errorf("查询活动失败: %w", err)
+			return totalCount, fmt.Errorf("查询活动失败: %w", err)
 		}
 
 		// 没有更多数据，退出循环
@@ -235,31 +261,37 @@ func (s *SyncService) FullSync(ctx context.Context) error {
 			})
 		}
 
-		// 4. 批量索引
-		bulkRequest := s.es.client.Bulk().Index(s.es.indexName)
-		for _, entry := range entries {
-			req := elastic.NewBulkIndexRequest().
-				Id(DocID(entry.id)).
-				VersionType("external").
-				Version(entry.version).
-				Doc(entry.doc)
-			bulkRequest.Add(req)
+		// 4. 批量索引（每个写入目标各一份）
+		bulkRequest := s.es.client.Bulk()
+		for _, index := range targets {
+			for _, entry := range entries {
+				req := elastic.NewBulkIndexRequest().
+					Index(index).
+					Id(DocID(entry.id)).
+					VersionType("external").
+					Version(entry.version).
+					Doc(entry.doc)
+				bulkRequest.Add(req)
+			}
 		}
 
 		// 5. 执行批量索引
 		response, err := bulkRequest.Do(ctx)
 		if err != nil {
 			logx.Errorf("[ESSync] 批量索引失败 lastID=%d: %v", lastID, err)
-			failCount += len(entries)
+			failCount += len(entries) * len(targets)
 			continue
 		}
 
 		// 6. 统计
 		totalCount += len(response.Succeeded())
-		failCount += len(response.Failed())
 
-		// 记录失败项
+		// 记录失败项（版本冲突说明目标索引已有更新的数据，重建期间双写会产生，不计为失败）
 		for _, item := range response.Failed() {
+			if item.Status == http.StatusConflict {
+				continue
+			}
+			failCount++
 			logx.Errorf("[ESSync] 索引失败 id=%s: %s", item.Id, item.Error.Reason)
 		}
 
@@ -277,7 +309,7 @@ func (s *SyncService) FullSync(ctx context.Context) error {
 	logx.Infof("[ESSync] 全量同步完成: 成功=%d, 失败=%d, 耗时=%v",
 		totalCount, failCount, time.Since(startTime))
 
-	return nil
+	return totalCount, nil
 }
 
 // ==================== 文档转换 ====================
@@ -312,6 +344,13 @@ func (s *SyncService) convertToDoc(ctx context.Context, activity *model.Activity
 		CurrentParticipants: activity.CurrentParticipants,
 		ViewCount:           activity.ViewCount,
 
+		// 评分汇总
+		RatingAvg:   activity.RatingAvg,
+		RatingCount: activity.RatingCount,
+
+		RequireStudentVerify: activity.RequireStudentVerify,
+		MinCreditScore:       activity.MinCreditScore,
+
 		// 封面
 		CoverURL:  activity.CoverURL,
 		CoverType: activity.CoverType,
@@ -339,22 +378,61 @@ func (s *SyncService) convertToDoc(ctx context.Context, activity *model.Activity
 	// 获取标签（容错处理）
 	if s.tagModel != nil {
 		if tags, err := s.tagModel.FindByActivityID(ctx, activity.ID); err == nil {
-			doc.Tags = make([]string, len(tags))
-			for i, tag := range tags {
-				doc.Tags[i] = tag.Name
-			}
+			doc.Tags, doc.TagIDs = splitTags(tags)
 		}
 	}
 
 	// 添加搜索建议
-	doc.Suggest = &Suggest{
-		Input: []string{activity.Title},
+	doc.Suggest = buildSuggest(activity, doc.Tags)
+
+	return doc, nil
+}
+
+// buildSuggest 构建搜索建议字段
+//
+// 说明：
+//   - 输入来源：标题、标签名、组织者名称（去重、去空）
+//   - 只有公开状态的活动才生成建议，草稿/待审核/已拒绝/已取消不参与联想
+//   - 分类作为 context，支持按分类过滤建议
+func buildSuggest(activity *model.Activity, tags []string) *Suggest {
+	switch activity.Status {
+	case model.StatusPublished, model.StatusOngoing, model.StatusFinished:
+	default:
+		return nil
+	}
+
+	inputs := make([]string, 0, len(tags)+2)
+	seen := make(map[string]bool, len(tags)+2)
+	for _, in := range append([]string{activity.Title, activity.OrganizerName}, tags...) {
+		in = strings.TrimSpace(in)
+		if in == "" || seen[in] {
+			continue
+		}
+		seen[in] = true
+		inputs = append(inputs, in)
+	}
+	if len(inputs) == 0 {
+		return nil
+	}
+
+	return &Suggest{
+		Input:  inputs,
+		Weight: int(activity.CurrentParticipants),
 		Contexts: map[string]string{
 			"category": fmt.Sprintf("%d", activity.CategoryID),
 		},
 	}
+}
 
-	return doc, nil
+// splitTags 拆分标签为名称列表和 ID 列表
+func splitTags(tags []model.TagCache) ([]string, []uint64) {
+	names := make([]string, len(tags))
+	ids := make([]uint64, len(tags))
+	for i, tag := range tags {
+		names[i] = tag.Name
+		ids[i] = tag.ID
+	}
+	return names, ids
 }
 
 // ==================== 批量同步辅助方法 ====================
@@ -417,6 +495,12 @@ func (s *SyncService) buildDocFromMaps(activity *model.Activity, catNameMap map[
 		CurrentParticipants: activity.CurrentParticipants,
 		ViewCount:           activity.ViewCount,
 
+		RatingAvg:   activity.RatingAvg,
+		RatingCount: activity.RatingCount,
+
+		RequireStudentVerify: activity.RequireStudentVerify,
+		MinCreditScore:       activity.MinCreditScore,
+
 		CoverURL:  activity.CoverURL,
 		CoverType: activity.CoverType,
 
@@ -437,19 +521,11 @@ func (s *SyncService) buildDocFromMaps(activity *model.Activity, catNameMap map[
 
 	// 标签（从预加载 map 查找）
 	if tags, ok := tagsMap[activity.ID]; ok {
-		doc.Tags = make([]string, len(tags))
-		for i, tag := range tags {
-			doc.Tags[i] = tag.Name
-		}
+		doc.Tags, doc.TagIDs = splitTags(tags)
 	}
 
 	// 搜索建议
-	doc.Suggest = &Suggest{
-		Input: []string{activity.Title},
-		Contexts: map[string]string{
-			"category": fmt.Sprintf("%d", activity.CategoryID),
-		},
-	}
+	doc.Suggest = buildSuggest(activity, doc.Tags)
 
 	return doc
 }
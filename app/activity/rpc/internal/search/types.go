@@ -50,9 +50,18 @@ type ActivityDoc struct {
 	CurrentParticipants uint32 `json:"current_participants"` // Activity.CurrentParticipants
 	ViewCount           uint32 `json:"view_count"`           // Activity.ViewCount
 
+	// ===== 评分汇总 =====
+	RatingAvg   float64 `json:"rating_avg"`   // Activity.RatingAvg
+	RatingCount uint32  `json:"rating_count"` // Activity.RatingCount
+
+	// ===== 报名门槛 =====
+	RequireStudentVerify bool `json:"require_student_verify"` // Activity.RequireStudentVerify
+	MinCreditScore       int  `json:"min_credit_score"`       // Activity.MinCreditScore
+
 	// ===== 冗余字段（需要关联查询填充）=====
 	CategoryName string   `json:"category_name,omitempty"` // 从 Category 表查询
 	Tags         []string `json:"tags,omitempty"`          // 标签名称数组
+	TagIDs       []uint64 `json:"tag_ids,omitempty"`       // 标签 ID 数组（筛选与分面统计）
 	CoverURL     string   `json:"cover_url"`               // Activity.CoverURL
 	CoverType    int8     `json:"cover_type"`              // Activity.CoverType
 
@@ -62,6 +71,11 @@ type ActivityDoc struct {
 
 	// ===== 搜索建议（可选）=====
 	Suggest *Suggest `json:"suggest,omitempty"`
+
+	// ===== 查询时计算（不写入 ES）=====
+	Distance             float64 `json:"-"` // 与查询坐标的距离（米），仅地理搜索时有值
+	TitleHighlight       string  `json:"-"` // 标题高亮
+	DescriptionHighlight string  `json:"-"` // 描述高亮片段
 }
 
 // GeoPoint 地理坐标
@@ -71,8 +85,12 @@ type GeoPoint struct {
 }
 
 // Suggest 搜索建议结构
+//
+// Input 由活动标题、标签、组织者名称组成；
+// Weight 使用报名人数，热门活动的建议排在前面
 type Suggest struct {
 	Input    []string          `json:"input"`
+	Weight   int               `json:"weight,omitempty"`
 	Contexts map[string]string `json:"contexts,omitempty"`
 }
 
@@ -85,17 +103,39 @@ type SearchRequest struct {
 	Status     []int8 // 状态筛选（对应 Activity.Status）
 	StartTime  *int64 // 开始时间筛选（Unix 时间戳）
 	EndTime    *int64 // 结束时间筛选（Unix 时间戳）
-	SortBy     string // 排序：relevance, time, hot, newest
+	SortBy     string // 排序：relevance, time, hot, newest, distance
 	Page       int    // 页码
 	PageSize   int    // 每页数量
+
+	Geo *GeoFilter // 地理范围筛选（可选）
+
+	// ===== 报名相关筛选 =====
+	TagIDs          []uint64 // 标签筛选（命中任一即可）
+	HasSeats        bool     // 只看还有名额的活动
+	NoStudentVerify bool     // 只看不需要学生认证的活动
+	MaxCreditScore  *int     // 信用分门槛上限（nil=不限）
+}
+
+// GeoFilter 地理范围筛选（以查询点为圆心）
+type GeoFilter struct {
+	Lat      float64 // 纬度
+	Lon      float64 // 经度
+	RadiusKm float64 // 半径（公里）
 }
 
 // SearchResponse 搜索响应
 type SearchResponse struct {
-	Total        int64                  `json:"total"`
-	Activities   []ActivityDoc          `json:"activities"`
-	Aggregations map[string]interface{} `json:"aggregations,omitempty"`
-	TookMs       int64                  `json:"took_ms"`
+	Total      int64         `json:"total"`
+	Activities []ActivityDoc `json:"activities"`
+	Facets     *Facets       `json:"facets,omitempty"`
+	TookMs     int64         `json:"took_ms"`
+}
+
+// Facets 分面统计（key 为分类ID / 标签ID / 状态值）
+type Facets struct {
+	Categories map[uint64]int64 `json:"categories"`
+	Tags       map[uint64]int64 `json:"tags"`
+	Statuses   map[int8]int64   `json:"statuses"`
 }
 
 // ==================== 搜索建议请求/响应 ====================
@@ -103,7 +143,7 @@ type SearchResponse struct {
 // SuggestRequest 搜索建议请求
 type SuggestRequest struct {
 	Prefix     string // 输入前缀
-	CategoryID uint64 // 分类上下文
+	CategoryID uint64 // 分类上下文（0=全部分类）
 	Size       int    // 返回数量
 }
 
@@ -198,14 +238,21 @@ const IndexMapping = `{
       "max_participants": {"type": "integer"},
       "current_participants": {"type": "integer"},
       "view_count": {"type": "integer"},
+      "rating_avg": {"type": "float"},
+      "rating_count": {"type": "integer"},
       "tags": {"type": "keyword"},
+      "tag_ids": {"type": "unsigned_long"},
+      "require_student_verify": {"type": "boolean"},
+      "min_credit_score": {"type": "integer"},
       "cover_url": {"type": "keyword", "index": false},
       "cover_type": {"type": "byte"},
       "created_at": {"type": "date", "format": "epoch_second"},
       "updated_at": {"type": "date", "format": "epoch_second"},
       "suggest": {
         "type": "completion",
-        "analyzer": "ik_smart",
+        "analyzer": "standard",
+        "preserve_separators": false,
+        "max_input_length": 50,
         "contexts": [{"name": "category", "type": "category"}]
       }
     }
@@ -65,6 +65,48 @@ func (s *ActivityServiceServer) GetRegisteredCount(ctx context.Context, in *acti
 	return l.GetRegisteredCount(in)
 }
 
+// SetEligibilityRules 设置活动报名资格规则（覆盖式，仅组织者）
+func (s *ActivityServiceServer) SetEligibilityRules(ctx context.Context, in *activity.SetEligibilityRulesReq) (*activity.SetEligibilityRulesResp, error) {
+	l := logic.NewSetEligibilityRulesLogic(ctx, s.svcCtx)
+	return l.SetEligibilityRules(in)
+}
+
+// GetEligibilityRules 获取活动报名资格规则
+func (s *ActivityServiceServer) GetEligibilityRules(ctx context.Context, in *activity.GetEligibilityRulesReq) (*activity.GetEligibilityRulesResp, error) {
+	l := logic.NewGetEligibilityRulesLogic(ctx, s.svcCtx)
+	return l.GetEligibilityRules(in)
+}
+
+// CheckEligibility 报名资格预检（不写入任何数据，供详情页展示"能否报名"）
+func (s *ActivityServiceServer) CheckEligibility(ctx context.Context, in *activity.CheckEligibilityReq) (*activity.CheckEligibilityResp, error) {
+	l := logic.NewCheckEligibilityLogic(ctx, s.svcCtx)
+	return l.CheckEligibility(in)
+}
+
+// SubmitFeedback 提交活动评价（活动结束后评价窗口内，仅已核销票据的参与者；重复提交视为修改）
+func (s *ActivityServiceServer) SubmitFeedback(ctx context.Context, in *activity.SubmitFeedbackReq) (*activity.SubmitFeedbackResp, error) {
+	l := logic.NewSubmitFeedbackLogic(ctx, s.svcCtx)
+	return l.SubmitFeedback(in)
+}
+
+// GetFeedbackSummary 活动评价汇总（评分分布 + 公开短评，仅组织者）
+func (s *ActivityServiceServer) GetFeedbackSummary(ctx context.Context, in *activity.GetFeedbackSummaryReq) (*activity.GetFeedbackSummaryResp, error) {
+	l := logic.NewGetFeedbackSummaryLogic(ctx, s.svcCtx)
+	return l.GetFeedbackSummary(in)
+}
+
+// ListFeedbackReviews 评价短评审核列表（管理员，命中送审词的短评）
+func (s *ActivityServiceServer) ListFeedbackReviews(ctx context.Context, in *activity.ListFeedbackReviewsReq) (*activity.ListFeedbackReviewsResp, error) {
+	l := logic.NewListFeedbackReviewsLogic(ctx, s.svcCtx)
+	return l.ListFeedbackReviews(in)
+}
+
+// ReviewFeedbackComment 审核评价短评（管理员；通过后公开，驳回后隐藏）
+func (s *ActivityServiceServer) ReviewFeedbackComment(ctx context.Context, in *activity.ReviewFeedbackCommentReq) (*activity.ReviewFeedbackCommentResp, error) {
+	l := logic.NewReviewFeedbackCommentLogic(ctx, s.svcCtx)
+	return l.ReviewFeedbackComment(in)
+}
+
 // ==================== CRUD 接口 ====================
 func (s *ActivityServiceServer) CreateActivity(ctx context.Context, in *activity.CreateActivityReq) (*activity.CreateActivityResp, error) {
 	l := logic.NewCreateActivityLogic(ctx, s.svcCtx)
@@ -112,6 +154,168 @@ func (s *ActivityServiceServer) CancelActivity(ctx context.Context, in *activity
 	return l.CancelActivity(in)
 }
 
+// ==================== 发布审核接口（管理员）====================
+func (s *ActivityServiceServer) ListReviewQueue(ctx context.Context, in *activity.ListReviewQueueReq) (*activity.ListReviewQueueResp, error) {
+	l := logic.NewListReviewQueueLogic(ctx, s.svcCtx)
+	return l.ListReviewQueue(in)
+}
+
+// AssignActivityReview 分配/领取/释放审核任务
+func (s *ActivityServiceServer) AssignActivityReview(ctx context.Context, in *activity.AssignActivityReviewReq) (*activity.AssignActivityReviewResp, error) {
+	l := logic.NewAssignActivityReviewLogic(ctx, s.svcCtx)
+	return l.AssignActivityReview(in)
+}
+
+// ==================== 已发布活动变更申请 ====================
+func (s *ActivityServiceServer) SubmitActivityChange(ctx context.Context, in *activity.SubmitActivityChangeReq) (*activity.SubmitActivityChangeResp, error) {
+	l := logic.NewSubmitActivityChangeLogic(ctx, s.svcCtx)
+	return l.SubmitActivityChange(in)
+}
+
+// ListActivityChanges 变更申请列表（组织者查看本活动 / 管理员查看全部）
+func (s *ActivityServiceServer) ListActivityChanges(ctx context.Context, in *activity.ListActivityChangesReq) (*activity.ListActivityChangesResp, error) {
+	l := logic.NewListActivityChangesLogic(ctx, s.svcCtx)
+	return l.ListActivityChanges(in)
+}
+
+// ReviewActivityChange 管理员审核变更申请（通过后立即生效并通知报名者）
+func (s *ActivityServiceServer) ReviewActivityChange(ctx context.Context, in *activity.ReviewActivityChangeReq) (*activity.ReviewActivityChangeResp, error) {
+	l := logic.NewReviewActivityChangeLogic(ctx, s.svcCtx)
+	return l.ReviewActivityChange(in)
+}
+
+// ==================== 系列活动 ====================
+func (s *ActivityServiceServer) CreateActivitySeries(ctx context.Context, in *activity.CreateActivitySeriesReq) (*activity.CreateActivitySeriesResp, error) {
+	l := logic.NewCreateActivitySeriesLogic(ctx, s.svcCtx)
+	return l.CreateActivitySeries(in)
+}
+
+// GetActivitySeries 系列详情（含已生成的场次）
+func (s *ActivityServiceServer) GetActivitySeries(ctx context.Context, in *activity.GetActivitySeriesReq) (*activity.GetActivitySeriesResp, error) {
+	l := logic.NewGetActivitySeriesLogic(ctx, s.svcCtx)
+	return l.GetActivitySeries(in)
+}
+
+// UpdateActivitySeries 整期修改（同步到未开始且未单独修改过的场次）
+func (s *ActivityServiceServer) UpdateActivitySeries(ctx context.Context, in *activity.UpdateActivitySeriesReq) (*activity.UpdateActivitySeriesResp, error) {
+	l := logic.NewUpdateActivitySeriesLogic(ctx, s.svcCtx)
+	return l.UpdateActivitySeries(in)
+}
+
+// CancelActivitySeries 取消系列（停止生成，可选取消未开始的场次）
+func (s *ActivityServiceServer) CancelActivitySeries(ctx context.Context, in *activity.CancelActivitySeriesReq) (*activity.CancelActivitySeriesResp, error) {
+	l := logic.NewCancelActivitySeriesLogic(ctx, s.svcCtx)
+	return l.CancelActivitySeries(in)
+}
+
+// RegisterActivitySeries 整期报名 / 退出整期报名
+func (s *ActivityServiceServer) RegisterActivitySeries(ctx context.Context, in *activity.RegisterActivitySeriesReq) (*activity.RegisterActivitySeriesResp, error) {
+	l := logic.NewRegisterActivitySeriesLogic(ctx, s.svcCtx)
+	return l.RegisterActivitySeries(in)
+}
+
+// ==================== 日历订阅 ====================
+func (s *ActivityServiceServer) GetCalendarFeedToken(ctx context.Context, in *activity.GetCalendarFeedTokenReq) (*activity.GetCalendarFeedTokenResp, error) {
+	l := logic.NewGetCalendarFeedTokenLogic(ctx, s.svcCtx)
+	return l.GetCalendarFeedToken(in)
+}
+
+// GetCalendarFeed 按订阅令牌输出用户已报名活动的 iCalendar 内容
+func (s *ActivityServiceServer) GetCalendarFeed(ctx context.Context, in *activity.GetCalendarFeedReq) (*activity.GetCalendarFeedResp, error) {
+	l := logic.NewGetCalendarFeedLogic(ctx, s.svcCtx)
+	return l.GetCalendarFeed(in)
+}
+
+// ExportActivityIcs 导出单个活动的 .ics 文件内容
+func (s *ActivityServiceServer) ExportActivityIcs(ctx context.Context, in *activity.ExportActivityIcsReq) (*activity.ExportActivityIcsResp, error) {
+	l := logic.NewExportActivityIcsLogic(ctx, s.svcCtx)
+	return l.ExportActivityIcs(in)
+}
+
+// ==================== 自助签到 ====================
+func (s *ActivityServiceServer) SetSelfCheckIn(ctx context.Context, in *activity.SetSelfCheckInReq) (*activity.SetSelfCheckInResp, error) {
+	l := logic.NewSetSelfCheckInLogic(ctx, s.svcCtx)
+	return l.SetSelfCheckIn(in)
+}
+
+// GetSelfCheckInCode 组织者获取当前轮换签到码（现场大屏展示，30 秒轮换）
+func (s *ActivityServiceServer) GetSelfCheckInCode(ctx context.Context, in *activity.GetSelfCheckInCodeReq) (*activity.GetSelfCheckInCodeResp, error) {
+	l := logic.NewGetSelfCheckInCodeLogic(ctx, s.svcCtx)
+	return l.GetSelfCheckInCode(in)
+}
+
+// SelfCheckIn 参与者自助签到（校验票券、时间窗口、签到码与定位距离）
+func (s *ActivityServiceServer) SelfCheckIn(ctx context.Context, in *activity.SelfCheckInReq) (*activity.SelfCheckInResp, error) {
+	l := logic.NewSelfCheckInLogic(ctx, s.svcCtx)
+	return l.SelfCheckIn(in)
+}
+
+// ListCheckInRecords 组织者查看签到记录（可只看疑似异常记录）
+func (s *ActivityServiceServer) ListCheckInRecords(ctx context.Context, in *activity.ListCheckInRecordsReq) (*activity.ListCheckInRecordsResp, error) {
+	l := logic.NewListCheckInRecordsLogic(ctx, s.svcCtx)
+	return l.ListCheckInRecords(in)
+}
+
+// ==================== 活动模板 ====================
+func (s *ActivityServiceServer) CloneActivity(ctx context.Context, in *activity.CloneActivityReq) (*activity.CreateActivityResp, error) {
+	l := logic.NewCloneActivityLogic(ctx, s.svcCtx)
+	return l.CloneActivity(in)
+}
+
+// SaveActivityTemplate 将组织者自己的活动另存为个人模板
+func (s *ActivityServiceServer) SaveActivityTemplate(ctx context.Context, in *activity.SaveActivityTemplateReq) (*activity.ActivityTemplateResp, error) {
+	l := logic.NewSaveActivityTemplateLogic(ctx, s.svcCtx)
+	return l.SaveActivityTemplate(in)
+}
+
+// ListActivityTemplates 模板列表（用户：个人模板 + 可用公共模板；管理员：全部公共模板）
+func (s *ActivityServiceServer) ListActivityTemplates(ctx context.Context, in *activity.ListActivityTemplatesReq) (*activity.ListActivityTemplatesResp, error) {
+	l := logic.NewListActivityTemplatesLogic(ctx, s.svcCtx)
+	return l.ListActivityTemplates(in)
+}
+
+// CreateActivityFromTemplate 使用模板创建草稿活动
+func (s *ActivityServiceServer) CreateActivityFromTemplate(ctx context.Context, in *activity.CreateActivityFromTemplateReq) (*activity.CreateActivityResp, error) {
+	l := logic.NewCreateActivityFromTemplateLogic(ctx, s.svcCtx)
+	return l.CreateActivityFromTemplate(in)
+}
+
+// DeleteActivityTemplate 删除模板（用户删除个人模板，管理员删除公共模板）
+func (s *ActivityServiceServer) DeleteActivityTemplate(ctx context.Context, in *activity.DeleteActivityTemplateReq) (*activity.DeleteActivityTemplateResp, error) {
+	l := logic.NewDeleteActivityTemplateLogic(ctx, s.svcCtx)
+	return l.DeleteActivityTemplate(in)
+}
+
+// AdminCreatePublicTemplate 管理员以已有活动为蓝本创建公共模板
+func (s *ActivityServiceServer) AdminCreatePublicTemplate(ctx context.Context, in *activity.AdminCreatePublicTemplateReq) (*activity.ActivityTemplateResp, error) {
+	l := logic.NewAdminCreatePublicTemplateLogic(ctx, s.svcCtx)
+	return l.AdminCreatePublicTemplate(in)
+}
+
+// AdminUpdateTemplate 管理员修改公共模板名称、分类或上下架
+func (s *ActivityServiceServer) AdminUpdateTemplate(ctx context.Context, in *activity.AdminUpdateTemplateReq) (*activity.ActivityTemplateResp, error) {
+	l := logic.NewAdminUpdateTemplateLogic(ctx, s.svcCtx)
+	return l.AdminUpdateTemplate(in)
+}
+
+// ==================== 活动分析 ====================
+func (s *ActivityServiceServer) GetActivityAnalytics(ctx context.Context, in *activity.GetActivityAnalyticsReq) (*activity.GetActivityAnalyticsResp, error) {
+	l := logic.NewGetActivityAnalyticsLogic(ctx, s.svcCtx)
+	return l.GetActivityAnalytics(in)
+}
+
+// GetOrganizerAnalytics 组织者查看全部活动的数据总览
+func (s *ActivityServiceServer) GetOrganizerAnalytics(ctx context.Context, in *activity.GetOrganizerAnalyticsReq) (*activity.GetOrganizerAnalyticsResp, error) {
+	l := logic.NewGetOrganizerAnalyticsLogic(ctx, s.svcCtx)
+	return l.GetOrganizerAnalytics(in)
+}
+
+// ==================== 活动收藏 ====================
+func (s *ActivityServiceServer) FavoriteActivity(ctx context.Context, in *activity.FavoriteActivityReq) (*activity.FavoriteActivityResp, error) {
+	l := logic.NewFavoriteActivityLogic(ctx, s.svcCtx)
+	return l.FavoriteActivity(in)
+}
+
 // ==================== 搜索接口 ====================
 func (s *ActivityServiceServer) SearchActivities(ctx context.Context, in *activity.SearchActivitiesReq) (*activity.SearchActivitiesResp, error) {
 	l := logic.NewSearchActivitiesLogic(ctx, s.svcCtx)
@@ -123,6 +327,18 @@ func (s *ActivityServiceServer) GetHotActivities(ctx context.Context, in *activi
 	return l.GetHotActivities(in)
 }
 
+// NearbyActivities 附近活动（按距离筛选/排序，ES 不可用时降级 MySQL）
+func (s *ActivityServiceServer) NearbyActivities(ctx context.Context, in *activity.NearbyActivitiesReq) (*activity.NearbyActivitiesResp, error) {
+	l := logic.NewNearbyActivitiesLogic(ctx, s.svcCtx)
+	return l.NearbyActivities(in)
+}
+
+// SuggestActivities 搜索建议（输入联想，ES 不可用时降级 MySQL 标题前缀匹配）
+func (s *ActivityServiceServer) SuggestActivities(ctx context.Context, in *activity.SuggestActivitiesReq) (*activity.SuggestActivitiesResp, error) {
+	l := logic.NewSuggestActivitiesLogic(ctx, s.svcCtx)
+	return l.SuggestActivities(in)
+}
+
 // ==================== 分类标签接口 ====================
 func (s *ActivityServiceServer) ListCategories(ctx context.Context, in *activity.ListCategoriesReq) (*activity.ListCategoriesResp, error) {
 	l := logic.NewListCategoriesLogic(ctx, s.svcCtx)
@@ -134,6 +350,65 @@ func (s *ActivityServiceServer) ListTags(ctx context.Context, in *activity.ListT
 	return l.ListTags(in)
 }
 
+// ==================== 分类标签管理接口（管理端）====================
+func (s *ActivityServiceServer) AdminListCategories(ctx context.Context, in *activity.AdminListCategoriesReq) (*activity.AdminListCategoriesResp, error) {
+	l := logic.NewAdminListCategoriesLogic(ctx, s.svcCtx)
+	return l.AdminListCategories(in)
+}
+
+func (s *ActivityServiceServer) CreateCategory(ctx context.Context, in *activity.CreateCategoryReq) (*activity.AdminCategoryResp, error) {
+	l := logic.NewCreateCategoryLogic(ctx, s.svcCtx)
+	return l.CreateCategory(in)
+}
+
+func (s *ActivityServiceServer) UpdateCategory(ctx context.Context, in *activity.UpdateCategoryReq) (*activity.AdminCategoryResp, error) {
+	l := logic.NewUpdateCategoryLogic(ctx, s.svcCtx)
+	return l.UpdateCategory(in)
+}
+
+func (s *ActivityServiceServer) SetCategoryStatus(ctx context.Context, in *activity.SetCategoryStatusReq) (*activity.AdminCategoryResp, error) {
+	l := logic.NewSetCategoryStatusLogic(ctx, s.svcCtx)
+	return l.SetCategoryStatus(in)
+}
+
+func (s *ActivityServiceServer) SortCategories(ctx context.Context, in *activity.SortCategoriesReq) (*activity.SortCategoriesResp, error) {
+	l := logic.NewSortCategoriesLogic(ctx, s.svcCtx)
+	return l.SortCategories(in)
+}
+
+// DeleteCategory 删除分类（仍有活动引用时拒绝，可改为禁用）
+func (s *ActivityServiceServer) DeleteCategory(ctx context.Context, in *activity.DeleteCategoryReq) (*activity.DeleteCategoryResp, error) {
+	l := logic.NewDeleteCategoryLogic(ctx, s.svcCtx)
+	return l.DeleteCategory(in)
+}
+
+// 标签：写入用户服务后同步 tag_cache，并重建使用该标签的活动的搜索索引
+func (s *ActivityServiceServer) AdminListTags(ctx context.Context, in *activity.AdminListTagsReq) (*activity.AdminListTagsResp, error) {
+	l := logic.NewAdminListTagsLogic(ctx, s.svcCtx)
+	return l.AdminListTags(in)
+}
+
+func (s *ActivityServiceServer) CreateTag(ctx context.Context, in *activity.CreateTagReq) (*activity.AdminTagResp, error) {
+	l := logic.NewCreateTagLogic(ctx, s.svcCtx)
+	return l.CreateTag(in)
+}
+
+func (s *ActivityServiceServer) UpdateTag(ctx context.Context, in *activity.UpdateTagReq) (*activity.AdminTagResp, error) {
+	l := logic.NewUpdateTagLogic(ctx, s.svcCtx)
+	return l.UpdateTag(in)
+}
+
+func (s *ActivityServiceServer) SetTagStatus(ctx context.Context, in *activity.SetTagStatusReq) (*activity.AdminTagResp, error) {
+	l := logic.NewSetTagStatusLogic(ctx, s.svcCtx)
+	return l.SetTagStatus(in)
+}
+
+// MergeTags 合并标签（活动关联与用户兴趣改绑到目标标签，源标签禁用）
+func (s *ActivityServiceServer) MergeTags(ctx context.Context, in *activity.MergeTagsReq) (*activity.MergeTagsResp, error) {
+	l := logic.NewMergeTagsLogic(ctx, s.svcCtx)
+	return l.MergeTags(in)
+}
+
 // ==================== 浏览量接口 ====================
 func (s *ActivityServiceServer) IncrViewCount(ctx context.Context, in *activity.IncrViewCountReq) (*activity.IncrViewCountResp, error) {
 	l := logic.NewIncrViewCountLogic(ctx, s.svcCtx)
@@ -0,0 +1,35 @@
+package svc
+
+import (
+	"fmt"
+
+	"activity-platform/app/activity/model"
+	"activity-platform/app/activity/rpc/internal/config"
+	"activity-platform/app/activity/rpc/internal/search"
+)
+
+// NewSearchReindexer 创建索引重建器（供 cmd/esindex 运维命令使用）
+//
+// 只初始化 MySQL 与 ES，不依赖 Redis / 下游 RPC，便于在独立环境中执行
+func NewSearchReindexer(c config.Config) (*search.Reindexer, error) {
+	if !c.Elasticsearch.Enabled {
+		return nil, fmt.Errorf("Elasticsearch 未启用")
+	}
+
+	esClient, err := search.NewESClient(search.ESConfig{
+		Enabled:       c.Elasticsearch.Enabled,
+		Hosts:         c.Elasticsearch.Hosts,
+		Username:      c.Elasticsearch.Username,
+		Password:      c.Elasticsearch.Password,
+		IndexName:     c.Elasticsearch.IndexName,
+		MaxRetries:    c.Elasticsearch.MaxRetries,
+		HealthTimeout: c.Elasticsearch.HealthTimeout,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	db := initDB(c.MySQL, c.Mode)
+	syncService := search.NewSyncService(esClient, db, model.NewTagCacheModel(db), model.NewCategoryModel(db))
+	return search.NewReindexer(esClient, syncService), nil
+}
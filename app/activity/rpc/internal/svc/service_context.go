@@ -8,13 +8,17 @@ import (
 	"activity-platform/app/activity/rpc/internal/cache"
 	"activity-platform/app/activity/rpc/internal/config"
 	"activity-platform/app/activity/rpc/internal/dtm"
+	"activity-platform/app/activity/rpc/internal/eligibility"
 	"activity-platform/app/activity/rpc/internal/mq"
+	"activity-platform/app/activity/rpc/internal/reminder"
 	"activity-platform/app/activity/rpc/internal/search"
 	"activity-platform/app/user/rpc/client/creditservice"
+	"activity-platform/app/user/rpc/client/platformstatsservice"
 	"activity-platform/app/user/rpc/client/tagservice"
 	"activity-platform/app/user/rpc/client/userbasicservice"
 	"activity-platform/app/user/rpc/client/verifyservice"
 	"activity-platform/common/breakerx"
+	"activity-platform/common/contentfilter"
 	"activity-platform/common/messaging"
 
 	"github.com/zeromicro/go-zero/core/breaker"
@@ -48,11 +52,36 @@ type ServiceContext struct {
 	StatusLogModel            *model.ActivityStatusLogModel
 	ActivityRegistrationModel *model.ActivityRegistrationModel
 	ActivityTicketModel       *model.ActivityTicketModel
+	CheckInRecordModel        *model.CheckInRecordModel              // 签到记录
+	SelfCheckInSettingModel   *model.SelfCheckInSettingModel         // 自助签到设置
+	ChangeEventModel          *model.ActivityChangeEventModel        // 活动变更事件（Outbox）
+	EligibilityRuleModel      *model.ActivityEligibilityRuleModel    // 报名资格规则
+	ContentReviewModel        *model.ActivityContentReviewModel      // 内容审核记录
+	FeedbackModel             *model.ActivityFeedbackModel           // 活动评价
+	ReviewModel               *model.ActivityReviewModel             // 发布审核单
+	ChangeRequestModel        *model.ActivityChangeRequestModel      // 已发布活动变更申请
+	FavoriteModel             *model.ActivityFavoriteModel           // 活动收藏
+	SeriesModel               *model.ActivitySeriesModel             // 系列活动（模板 + 场次）
+	SeriesRegistrationModel   *model.ActivitySeriesRegistrationModel // 整期报名
+	CalendarTokenModel        *model.CalendarFeedTokenModel          // 日历订阅令牌
+	TemplateModel             *model.ActivityTemplateModel           // 活动模板
+	AnalyticsModel            *model.ActivityAnalyticsModel          // 活动分析统计
 
 	// ==================== 缓存服务 ====================
 	ActivityCache *cache.ActivityCache // 活动详情缓存
 	CategoryCache *cache.CategoryCache // 分类列表缓存
 	HotCache      *cache.HotCache      // 热门活动缓存
+	SuggestCache  *cache.SuggestCache  // 搜索建议缓存
+	CalendarCache *cache.CalendarCache // 日历订阅缓存
+
+	// ==================== 报名资格规则引擎 ====================
+	EligibilityEngine *eligibility.Engine
+
+	// ==================== 定时提醒 ====================
+	Reminders *reminder.Queue // 提醒延迟队列（可为 nil，表示未启用）
+
+	// ==================== 敏感词过滤 ====================
+	ContentFilter *contentfilter.Filter // 敏感词过滤器（可为 nil，表示未启用）
 
 	// ==================== ES 搜索服务 ====================
 	ESClient    *search.ESClientWithBreaker // ES 客户端（带熔断器）
@@ -65,10 +94,11 @@ type ServiceContext struct {
 	MsgProducer *mq.Producer // 消息发布器（可为 nil，表示未启用）
 
 	// RPC 客户端（调用其他微服务）
-	CreditRpc     creditservice.CreditService       // 信用分服务
-	VerifyService verifyservice.VerifyService       // 学生认证服务
-	TagRpc        tagservice.TagService             // 标签服务（用于同步标签数据）
-	UserBasicRpc  userbasicservice.UserBasicService // 用户基础服务（获取昵称/头像）
+	CreditRpc     creditservice.CreditService               // 信用分服务
+	VerifyService verifyservice.VerifyService               // 学生认证服务
+	TagRpc        tagservice.TagService                     // 标签服务（用于同步标签数据）
+	UserBasicRpc  userbasicservice.UserBasicService         // 用户基础服务（获取昵称/头像）
+	StatsRpc      platformstatsservice.PlatformStatsService // 平台统计服务（上报活动指标）
 }
 
 func NewServiceContext(c config.Config) *ServiceContext {
@@ -96,17 +126,21 @@ func NewServiceContext(c config.Config) *ServiceContext {
 	userRpcClient := zrpc.MustNewClient(c.UserRpc)
 	creditRpc := creditservice.NewCreditService(userRpcClient)
 	verifyRpc := verifyservice.NewVerifyService(userRpcClient)
-	tagRpc := tagservice.NewTagService(userRpcClient)                   // 标签服务客户端
-	userBasicRpc := userbasicservice.NewUserBasicService(userRpcClient) // 用户基础服务
+	tagRpc := tagservice.NewTagService(userRpcClient)                       // 标签服务客户端
+	userBasicRpc := userbasicservice.NewUserBasicService(userRpcClient)     // 用户基础服务
+	statsRpc := platformstatsservice.NewPlatformStatsService(userRpcClient) // 平台统计服务
 
 	// 5. 初始化缓存服务
 	activityCache := cache.NewActivityCache(rds, db)
 	categoryCache := cache.NewCategoryCache(rds, db)
 	hotCache := cache.NewHotCache(rds, db)
+	suggestCache := cache.NewSuggestCache(rds)
 
-	// 6. 初始化 Model 层（提前初始化，供 ES 同步服务使用）
+	// 6. 初始化 Model 层（提前初始化，供 ES 同步服务、资格规则引擎使用）
 	tagCacheModel := model.NewTagCacheModel(db)
 	categoryModel := model.NewCategoryModel(db)
+	eligibilityRuleModel := model.NewActivityEligibilityRuleModel(db)
+	activityRegistrationModel := model.NewActivityRegistrationModel(db)
 
 	// 7. 初始化 ES 搜索服务（可选）
 	var esClient *search.ESClientWithBreaker
@@ -165,7 +199,29 @@ func NewServiceContext(c config.Config) *ServiceContext {
 		logx.Info("[ServiceContext] 消息队列未启用，事件将不会发布")
 	}
 
-	// 10. 返回 ServiceContext
+	// 10. 初始化敏感词过滤器（可选）
+	var contentFilter *contentfilter.Filter
+	if c.ContentFilter.Enabled {
+		source := contentfilter.NewRedisSource(contentfilter.GoZeroRedis(rds), c.ContentFilter.KeyPrefix)
+		contentFilter = contentfilter.New(source, contentfilter.Options{
+			ReloadInterval: time.Duration(c.ContentFilter.ReloadInterval) * time.Second,
+		})
+		contentFilter.Start()
+		logx.Infof("[ServiceContext] 敏感词过滤已启用: words=%d", contentFilter.Size())
+	} else {
+		logx.Info("[ServiceContext] 敏感词过滤未启用，活动文本将不做检测")
+	}
+
+	// 11. 初始化提醒延迟队列（可选，提醒经消息队列送达，未启用消息发布时不登记）
+	var reminders *reminder.Queue
+	if c.Reminder.Enabled && msgProducer != nil {
+		reminders = reminder.NewQueue(rds, time.Duration(c.Reminder.RegisterClosingMinutes)*time.Minute)
+		logx.Info("[ServiceContext] 定时提醒已启用")
+	} else {
+		logx.Info("[ServiceContext] 定时提醒未启用，报名者将不会收到开始前提醒")
+	}
+
+	// 12. 返回 ServiceContext
 	return &ServiceContext{
 		Config: c,
 
@@ -185,13 +241,38 @@ func NewServiceContext(c config.Config) *ServiceContext {
 		TagStatsModel:             model.NewActivityTagStatsModel(db), // 标签统计
 		StatusLogModel:            model.NewActivityStatusLogModel(db),
 		TagModel:                  model.NewTagModel(db),
-		ActivityRegistrationModel: model.NewActivityRegistrationModel(db),
+		ActivityRegistrationModel: activityRegistrationModel,
 		ActivityTicketModel:       model.NewActivityTicketModel(db),
+		CheckInRecordModel:        model.NewCheckInRecordModel(db),
+		SelfCheckInSettingModel:   model.NewSelfCheckInSettingModel(db),
+		ChangeEventModel:          model.NewActivityChangeEventModel(db),
+		EligibilityRuleModel:      eligibilityRuleModel,
+		ContentReviewModel:        model.NewActivityContentReviewModel(db),
+		FeedbackModel:             model.NewActivityFeedbackModel(db),
+		ReviewModel:               model.NewActivityReviewModel(db),
+		ChangeRequestModel:        model.NewActivityChangeRequestModel(db),
+		FavoriteModel:             model.NewActivityFavoriteModel(db),
+		SeriesModel:               model.NewActivitySeriesModel(db),
+		SeriesRegistrationModel:   model.NewActivitySeriesRegistrationModel(db),
+		CalendarTokenModel:        model.NewCalendarFeedTokenModel(db),
+		TemplateModel:             model.NewActivityTemplateModel(db),
+		AnalyticsModel:            model.NewActivityAnalyticsModel(db),
 
 		// 缓存服务
 		ActivityCache: activityCache,
 		CategoryCache: categoryCache,
 		HotCache:      hotCache,
+		SuggestCache:  suggestCache,
+		CalendarCache: cache.NewCalendarCache(rds, activityRegistrationModel),
+
+		// 报名资格规则引擎
+		EligibilityEngine: eligibility.NewEngine(eligibilityRuleModel, creditRpc, verifyRpc),
+
+		// 定时提醒
+		Reminders: reminders,
+
+		// 敏感词过滤
+		ContentFilter: contentFilter,
 
 		// ES 搜索服务
 		ESClient:    esClient,
@@ -208,6 +289,7 @@ func NewServiceContext(c config.Config) *ServiceContext {
 		VerifyService: verifyRpc,
 		TagRpc:        tagRpc,
 		UserBasicRpc:  userBasicRpc,
+		StatsRpc:      statsRpc,
 	}
 }
 
@@ -10,18 +10,23 @@ import (
 // Group 群聊模型
 // 对应数据库表：groups
 type Group struct {
-	ID          uint64    `gorm:"primaryKey;autoIncrement;column:id" json:"id"`                                          // 自增主键
-	GroupID     string    `gorm:"uniqueIndex:uk_group_id;column:group_id;type:varchar(64);not null" json:"group_id"`     // 群聊唯一标识
-	ActivityID  uint64    `gorm:"uniqueIndex:uk_activity_id;column:activity_id;type:bigint;not null" json:"activity_id"` // 关联活动ID
-	Name        string    `gorm:"column:name;type:varchar(255);not null" json:"name"`                                    // 群聊名称
-	CoverUrl    string    `gorm:"column:cover_url;type:varchar(500);not null;default:''" json:"cover_url"`               // 封面图URL（活动封面）
-	OwnerID     uint64    `gorm:"index:idx_owner_id;column:owner_id;type:bigint;not null" json:"owner_id"`               // 群主用户ID
-	Status      int8      `gorm:"index:idx_status;column:status;type:tinyint;not null;default:1" json:"status"`          // 状态: 1-正常 2-已解散
-	MaxMembers  int32     `gorm:"column:max_members;type:int;not null" json:"max_members"`                               // 最大成员数
-	MemberCount int32     `gorm:"column:member_count;type:int;not null;default:0" json:"member_count"`                   // 成员数量
-	CreatedAt   time.Time `gorm:"column:created_at;type:datetime;not null;default:CURRENT_TIMESTAMP" json:"created_at"`
-	UpdatedAt   time.Time `gorm:"column:updated_at;type:datetime;not null;default:CURRENT_TIMESTAMP" json:"updated_at"`
-	DeletedAt   time.Time `gorm:"column:deleted_at;type:datetime;not null;default:NULL" json:"deleted_at"`
+	ID          uint64 `gorm:"primaryKey;autoIncrement;column:id" json:"id"`                                          // 自增主键
+	GroupID     string `gorm:"uniqueIndex:uk_group_id;column:group_id;type:varchar(64);not null" json:"group_id"`     // 群聊唯一标识
+	ActivityID  uint64 `gorm:"uniqueIndex:uk_activity_id;column:activity_id;type:bigint;not null" json:"activity_id"` // 关联活动ID
+	Name        string `gorm:"column:name;type:varchar(255);not null" json:"name"`                                    // 群聊名称
+	CoverUrl    string `gorm:"column:cover_url;type:varchar(500);not null;default:''" json:"cover_url"`               // 封面图URL（活动封面）
+	OwnerID     uint64 `gorm:"index:idx_owner_id;column:owner_id;type:bigint;not null" json:"owner_id"`               // 群主用户ID
+	Status      int8   `gorm:"index:idx_status;column:status;type:tinyint;not null;default:1" json:"status"`          // 状态: 1-正常 2-已解散
+	MaxMembers  int32  `gorm:"column:max_members;type:int;not null" json:"max_members"`                               // 最大成员数
+	MemberCount int32  `gorm:"column:member_count;type:int;not null;default:0" json:"member_count"`                   // 成员数量
+	AllMuted    bool   `gorm:"column:all_muted;type:tinyint(1);not null;default:0" json:"all_muted"`                  // 是否全员禁言（群主/管理员除外）
+
+	Announcement   string     `gorm:"column:announcement;type:varchar(1000);not null;default:''" json:"announcement"` // 群公告
+	AnnouncementAt *time.Time `gorm:"column:announcement_at;type:datetime" json:"announcement_at,omitempty"`          // 公告更新时间
+
+	CreatedAt time.Time `gorm:"column:created_at;type:datetime;not null;default:CURRENT_TIMESTAMP" json:"created_at"`
+	UpdatedAt time.Time `gorm:"column:updated_at;type:datetime;not null;default:CURRENT_TIMESTAMP" json:"updated_at"`
+	DeletedAt time.Time `gorm:"column:deleted_at;type:datetime;not null;default:NULL" json:"deleted_at"`
 }
 
 // TableName 指定 GORM 使用的表名
@@ -38,6 +43,8 @@ type GroupModel interface {
 	Delete(ctx context.Context, groupID string) error
 	UpdateStatus(ctx context.Context, groupID string, status int8) error
 	IncrementMemberCount(ctx context.Context, groupID string, delta int32) error
+	UpdateAllMuted(ctx context.Context, groupID string, muted bool) error
+	UpdateAnnouncement(ctx context.Context, groupID string, content string, at time.Time) error
 }
 
 // defaultGroupModel 群聊模型默认实现
@@ -109,3 +116,22 @@ func (m *defaultGroupModel) IncrementMemberCount(ctx context.Context, groupID st
 		Where("group_id = ?", groupID).
 		UpdateColumn("member_count", gorm.Expr("member_count + ?", delta)).Error
 }
+
+// UpdateAllMuted 设置/解除全员禁言
+func (m *defaultGroupModel) UpdateAllMuted(ctx context.Context, groupID string, muted bool) error {
+	return m.db.WithContext(ctx).
+		Model(&Group{}).
+		Where("group_id = ?", groupID).
+		Update("all_muted", muted).Error
+}
+
+// UpdateAnnouncement 更新群公告（content 为空表示清除公告）
+func (m *defaultGroupModel) UpdateAnnouncement(ctx context.Context, groupID string, content string, at time.Time) error {
+	return m.db.WithContext(ctx).
+		Model(&Group{}).
+		Where("group_id = ?", groupID).
+		Updates(map[string]interface{}{
+			"announcement":    content,
+			"announcement_at": &at,
+		}).Error
+}
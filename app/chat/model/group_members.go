@@ -7,18 +7,28 @@ import (
 	"gorm.io/gorm"
 )
 
+// 群成员角色
+const (
+	GroupRoleMember int8 = 1 // 普通成员
+	GroupRoleOwner  int8 = 2 // 群主
+	GroupRoleAdmin  int8 = 3 // 管理员（可禁言、踢出普通成员、发布公告）
+)
+
 // GroupMember 群成员模型
 // 对应数据库表：group_members
 type GroupMember struct {
 	ID       uint64    `gorm:"primaryKey;autoIncrement;column:id" json:"id"` // group_id 和 user_id 构成联合唯一索引 uk_group_user
 	GroupID  string    `gorm:"uniqueIndex:uk_group_user;column:group_id;type:varchar(64);not null" json:"group_id"`
 	UserID   uint64    `gorm:"uniqueIndex:uk_group_user;index:idx_user_id;column:user_id;type:bigint;not null" json:"user_id"`
-	Role     int8      `gorm:"column:role;type:tinyint;not null;default:1" json:"role"`                      // 1-普通成员 2-群主
+	Role     int8      `gorm:"column:role;type:tinyint;not null;default:1" json:"role"`                      // 1-普通成员 2-群主 3-管理员
 	Status   int8      `gorm:"index:idx_status;column:status;type:tinyint;not null;default:1" json:"status"` // 1-正常 2-已退出
 	JoinedAt time.Time `gorm:"column:joined_at;type:datetime;not null;default:CURRENT_TIMESTAMP" json:"joined_at"`
 
 	// LeftAt 在 SQL 中允许为 NULL，在 Go 中建议使用 *time.Time 或 sql.NullTime
 	LeftAt *time.Time `gorm:"column:left_at;type:datetime" json:"left_at,omitempty"`
+
+	// MutedUntil 禁言截止时间，NULL 或早于当前时间表示未禁言
+	MutedUntil *time.Time `gorm:"column:muted_until;type:datetime" json:"muted_until,omitempty"`
 }
 
 // TableName 指定表名
@@ -26,6 +36,32 @@ func (GroupMember) TableName() string {
 	return "group_members"
 }
 
+// IsModerator 是否拥有群管理权限（群主或管理员）
+func (m *GroupMember) IsModerator() bool {
+	return m.Role == GroupRoleOwner || m.Role == GroupRoleAdmin
+}
+
+// IsMuted 当前是否处于禁言中
+func (m *GroupMember) IsMuted(now time.Time) bool {
+	return m.MutedUntil != nil && m.MutedUntil.After(now)
+}
+
+// CanManage 是否可以管理目标成员
+// 群主可管理所有其他成员；管理员只能管理普通成员
+func (m *GroupMember) CanManage(target *GroupMember) bool {
+	if m.UserID == target.UserID {
+		return false
+	}
+	switch m.Role {
+	case GroupRoleOwner:
+		return true
+	case GroupRoleAdmin:
+		return target.Role == GroupRoleMember
+	default:
+		return false
+	}
+}
+
 // GroupMemberModel 群成员模型接口
 type GroupMemberModel interface {
 	Insert(ctx context.Context, data *GroupMember) error
@@ -36,6 +72,7 @@ type GroupMemberModel interface {
 	Delete(ctx context.Context, groupID string, userID uint64) error
 	UpdateRole(ctx context.Context, groupID string, userID uint64, role int8) error
 	UpdateStatus(ctx context.Context, groupID string, userID uint64, status int8) error
+	UpdateMutedUntil(ctx context.Context, groupID string, userID uint64, mutedUntil *time.Time) error
 }
 
 // defaultGroupMemberModel 群成员模型默认实现
@@ -147,3 +184,11 @@ func (m *defaultGroupMemberModel) UpdateStatus(ctx context.Context, groupID stri
 		Where("group_id = ? AND user_id = ?", groupID, userID).
 		Update("status", status).Error
 }
+
+// UpdateMutedUntil 更新成员禁言截止时间（nil 表示解除禁言）
+func (m *defaultGroupMemberModel) UpdateMutedUntil(ctx context.Context, groupID string, userID uint64, mutedUntil *time.Time) error {
+	return m.db.WithContext(ctx).
+		Model(&GroupMember{}).
+		Where("group_id = ? AND user_id = ? AND status = 1", groupID, userID).
+		Update("muted_until", mutedUntil).Error
+}
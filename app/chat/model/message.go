@@ -35,6 +35,7 @@ type MessageModel interface {
 	FindByGroupID(ctx context.Context, groupID, beforeID string, limit int32) ([]*Message, error)
 	FindOfflineMessages(ctx context.Context, userID uint64, afterTime int64) ([]*Message, error)
 	UpdateStatus(ctx context.Context, messageID string, status int8) error
+	CountByTypeBetween(ctx context.Context, since, until time.Time) (map[int8]int64, error)
 }
 
 // defaultMessageModel 消息模型默认实现
@@ -116,3 +117,27 @@ func (m *defaultMessageModel) UpdateStatus(ctx context.Context, messageID string
 		Where("message_id = ?", messageID).
 		Update("status", status).Error
 }
+
+// CountByTypeBetween 统计时间范围内各类型的消息数（含已撤回的消息）
+// created_at 为分区键，按时间范围查询只扫描对应分区
+func (m *defaultMessageModel) CountByTypeBetween(ctx context.Context, since, until time.Time) (map[int8]int64, error) {
+	var rows []struct {
+		MsgType int8  `gorm:"column:msg_type"`
+		Total   int64 `gorm:"column:total"`
+	}
+	err := m.db.WithContext(ctx).
+		Model(&Message{}).
+		Select("msg_type, COUNT(*) AS total").
+		Where("created_at >= ? AND created_at < ?", since, until).
+		Group("msg_type").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[int8]int64, len(rows))
+	for _, r := range rows {
+		result[r.MsgType] = r.Total
+	}
+	return result, nil
+}
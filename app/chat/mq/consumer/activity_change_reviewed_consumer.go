@@ -0,0 +1,133 @@
+/**
+ * @projectName: CampusHub
+ * @package: consumer
+ * @className: ActivityChangeReviewedConsumer
+ * @description: 活动变更申请审核结果消费者（通知组织者；通过时通知所有报名者新旧取值）
+ * @date: 2026-10-19
+ * @version: 1.0
+ *
+ * 消息来源: Activity RPC 管理员审核变更申请
+ * Topic: activity.change.reviewed
+ */
+
+package consumer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"activity-platform/app/chat/rpc/chat"
+	"activity-platform/common/messaging"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// ActivityChangeReviewedConsumer 活动变更申请审核结果消费者
+type ActivityChangeReviewedConsumer struct {
+	chatRpc chat.ChatServiceClient
+	logger  logx.Logger
+}
+
+// NewActivityChangeReviewedConsumer 创建活动变更申请审核结果消费者
+func NewActivityChangeReviewedConsumer(chatRpc chat.ChatServiceClient) *ActivityChangeReviewedConsumer {
+	return &ActivityChangeReviewedConsumer{
+		chatRpc: chatRpc,
+		logger:  logx.WithContext(context.Background()),
+	}
+}
+
+// Subscribe 订阅活动变更申请审核结果主题
+func (c *ActivityChangeReviewedConsumer) Subscribe(msgClient *messaging.Client) {
+	msgClient.Subscribe(messaging.TopicActivityChangeReviewed, "chat-activity-change-notify", c.handleChangeReviewed)
+	c.logger.Info("已订阅 activity.change.reviewed 事件")
+}
+
+// handleChangeReviewed 处理变更申请审核结果事件
+//
+// 组织者通知失败时重试；报名者通知逐条发送，失败只记录日志（避免重试导致重复通知）
+func (c *ActivityChangeReviewedConsumer) handleChangeReviewed(msg *message.Message) error {
+	ctx := msg.Context()
+
+	var event messaging.ActivityChangeReviewedEvent
+	if err := json.Unmarshal(msg.Payload, &event); err != nil {
+		c.logger.Errorf("解析活动变更审核事件失败: %v", err)
+		return messaging.NewNonRetryableError(fmt.Errorf("解析事件失败: %w", err))
+	}
+	if event.ActivityID == 0 || event.OrganizerID == 0 {
+		return messaging.NewNonRetryableError(fmt.Errorf("无效的事件: activity_id=%d, organizer_id=%d",
+			event.ActivityID, event.OrganizerID))
+	}
+
+	changeText := formatFieldChanges(event.Changes)
+
+	// 1. 通知组织者
+	title := "活动变更已生效"
+	content := fmt.Sprintf("您的活动「%s」变更申请已通过审核并生效：%s。已通知 %d 位报名者。",
+		event.Title, changeText, len(event.RegistrantIDs))
+	if !event.Approved {
+		title = "活动变更申请未通过"
+		content = fmt.Sprintf("您的活动「%s」变更申请未通过审核，原因：%s。活动信息保持不变。", event.Title, event.Reason)
+	}
+	_, err := c.chatRpc.CreateNotification(ctx, &chat.CreateNotificationReq{
+		UserId:  event.OrganizerID,
+		Type:    "activity_change",
+		Title:   title,
+		Content: content,
+	})
+	if err != nil {
+		c.logger.Errorf("发送活动变更审核通知失败: activity_id=%d, organizer_id=%d, err=%v",
+			event.ActivityID, event.OrganizerID, err)
+		return messaging.NewRetryableError(fmt.Errorf("发送通知失败: %w", err))
+	}
+	if !event.Approved {
+		return nil
+	}
+
+	// 2. 通知所有报名者
+	registrantContent := fmt.Sprintf("您报名的活动「%s」信息已变更：%s。", event.Title, changeText)
+	if event.Reason != "" {
+		registrantContent += fmt.Sprintf("变更原因：%s。", event.Reason)
+	}
+	if !event.FreeCancelUntil.IsZero() {
+		registrantContent += fmt.Sprintf("如无法参加，可在 %s 前取消报名，不扣信用分。",
+			event.FreeCancelUntil.Local().Format("2006-01-02 15:04"))
+	}
+
+	notified, failed := 0, 0
+	for _, userID := range event.RegistrantIDs {
+		_, err := c.chatRpc.CreateNotification(ctx, &chat.CreateNotificationReq{
+			UserId:  userID,
+			Type:    "activity_change",
+			Title:   "报名活动信息变更",
+			Content: registrantContent,
+		})
+		if err != nil {
+			c.logger.Errorf("发送活动变更通知失败: activity_id=%d, user_id=%d, err=%v", event.ActivityID, userID, err)
+			failed++
+			continue
+		}
+		notified++
+	}
+
+	c.logger.Infof("活动变更通知发送完成: activity_id=%d, 成功=%d, 失败=%d", event.ActivityID, notified, failed)
+	return nil
+}
+
+// formatFieldChanges 变更明细格式化为「字段：旧值 → 新值」
+func formatFieldChanges(changes []messaging.ActivityFieldChange) string {
+	parts := make([]string, 0, len(changes))
+	for _, ch := range changes {
+		oldValue, newValue := ch.OldValue, ch.NewValue
+		if oldValue == "" {
+			oldValue = "未设置"
+		}
+		if newValue == "" {
+			newValue = "未设置"
+		}
+		parts = append(parts, fmt.Sprintf("%s：%s → %s", ch.Label, oldValue, newValue))
+	}
+	return strings.Join(parts, "；")
+}
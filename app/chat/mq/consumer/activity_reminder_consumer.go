@@ -0,0 +1,96 @@
+/**
+ * @projectName: CampusHub
+ * @package: consumer
+ * @className: ActivityReminderConsumer
+ * @description: 活动定时提醒消费者（活动开始前提醒报名者、报名截止前提醒收藏者）
+ * @date: 2026-10-19
+ * @version: 1.0
+ *
+ * 消息来源: Activity RPC 定时提醒任务（ReminderCron）
+ * Topic: activity.reminder
+ */
+
+package consumer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"activity-platform/app/chat/rpc/chat"
+	"activity-platform/common/messaging"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// ActivityReminderConsumer 活动定时提醒消费者
+type ActivityReminderConsumer struct {
+	chatRpc chat.ChatServiceClient
+	logger  logx.Logger
+}
+
+// NewActivityReminderConsumer 创建活动定时提醒消费者
+func NewActivityReminderConsumer(chatRpc chat.ChatServiceClient) *ActivityReminderConsumer {
+	return &ActivityReminderConsumer{
+		chatRpc: chatRpc,
+		logger:  logx.WithContext(context.Background()),
+	}
+}
+
+// Subscribe 订阅活动定时提醒主题
+func (c *ActivityReminderConsumer) Subscribe(msgClient *messaging.Client) {
+	msgClient.Subscribe(messaging.TopicActivityReminder, "chat-activity-reminder-notify", c.handleReminder)
+	c.logger.Info("已订阅 activity.reminder 事件")
+}
+
+// handleReminder 处理活动定时提醒事件
+//
+// 创建系统通知（CreateNotification 同时经 notification:push 推送到 WS）
+func (c *ActivityReminderConsumer) handleReminder(msg *message.Message) error {
+	ctx := msg.Context()
+
+	var event messaging.ActivityReminderEvent
+	if err := json.Unmarshal(msg.Payload, &event); err != nil {
+		c.logger.Errorf("解析活动提醒事件失败: %v", err)
+		return messaging.NewNonRetryableError(fmt.Errorf("解析事件失败: %w", err))
+	}
+	if event.ActivityID == 0 || event.UserID == 0 {
+		return messaging.NewNonRetryableError(fmt.Errorf("无效的事件: activity_id=%d, user_id=%d",
+			event.ActivityID, event.UserID))
+	}
+
+	var title, content string
+	startTime := event.StartTime.Local().Format("2006-01-02 15:04")
+	switch event.Kind {
+	case messaging.ReminderKindStart24h:
+		title = "活动明天开始"
+		content = fmt.Sprintf("您报名的活动「%s」将于 %s 开始，地点：%s。如无法参加请提前取消报名。",
+			event.Title, startTime, event.Location)
+	case messaging.ReminderKindStart1h:
+		title = "活动即将开始"
+		content = fmt.Sprintf("您报名的活动「%s」将于 %s 开始，地点：%s，请准时到场并出示票券。",
+			event.Title, startTime, event.Location)
+	case messaging.ReminderKindRegisterClosing:
+		title = "收藏的活动报名即将截止"
+		content = fmt.Sprintf("您收藏的活动「%s」将于 %s 截止报名，活动时间：%s，感兴趣请尽快报名。",
+			event.Title, event.RegisterEndTime.Local().Format("2006-01-02 15:04"), startTime)
+	default:
+		return messaging.NewNonRetryableError(fmt.Errorf("未知的提醒类型: %s", event.Kind))
+	}
+
+	_, err := c.chatRpc.CreateNotification(ctx, &chat.CreateNotificationReq{
+		UserId:  event.UserID,
+		Type:    "activity_reminder",
+		Title:   title,
+		Content: content,
+	})
+	if err != nil {
+		c.logger.Errorf("发送活动提醒失败: activity_id=%d, user_id=%d, kind=%s, err=%v",
+			event.ActivityID, event.UserID, event.Kind, err)
+		return messaging.NewRetryableError(fmt.Errorf("发送通知失败: %w", err))
+	}
+
+	c.logger.Infof("活动提醒发送成功: activity_id=%d, user_id=%d, kind=%s", event.ActivityID, event.UserID, event.Kind)
+	return nil
+}
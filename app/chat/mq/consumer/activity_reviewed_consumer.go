@@ -0,0 +1,83 @@
+/**
+ * @projectName: CampusHub
+ * @package: consumer
+ * @className: ActivityReviewedConsumer
+ * @description: 活动审核结果消费者（审核通过/驳回 → 通知组织者）
+ * @date: 2026-10-19
+ * @version: 1.0
+ *
+ * 消息来源: Activity RPC 管理员审核
+ * Topic: activity.reviewed
+ */
+
+package consumer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"activity-platform/app/chat/rpc/chat"
+	"activity-platform/common/messaging"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// ActivityReviewedConsumer 活动审核结果消费者
+type ActivityReviewedConsumer struct {
+	chatRpc chat.ChatServiceClient
+	logger  logx.Logger
+}
+
+// NewActivityReviewedConsumer 创建活动审核结果消费者
+func NewActivityReviewedConsumer(chatRpc chat.ChatServiceClient) *ActivityReviewedConsumer {
+	return &ActivityReviewedConsumer{
+		chatRpc: chatRpc,
+		logger:  logx.WithContext(context.Background()),
+	}
+}
+
+// Subscribe 订阅活动审核结果主题
+func (c *ActivityReviewedConsumer) Subscribe(msgClient *messaging.Client) {
+	msgClient.Subscribe(messaging.TopicActivityReviewed, "chat-activity-review-notify", c.handleActivityReviewed)
+	c.logger.Info("已订阅 activity.reviewed 事件")
+}
+
+// handleActivityReviewed 处理活动审核结果事件
+func (c *ActivityReviewedConsumer) handleActivityReviewed(msg *message.Message) error {
+	ctx := msg.Context()
+
+	var event messaging.ActivityReviewedEvent
+	if err := json.Unmarshal(msg.Payload, &event); err != nil {
+		c.logger.Errorf("解析活动审核结果事件失败: %v", err)
+		return messaging.NewNonRetryableError(fmt.Errorf("解析事件失败: %w", err))
+	}
+	if event.ActivityID == 0 || event.OrganizerID == 0 {
+		return messaging.NewNonRetryableError(fmt.Errorf("无效的事件: activity_id=%d, organizer_id=%d",
+			event.ActivityID, event.OrganizerID))
+	}
+
+	title := "活动审核通过"
+	content := fmt.Sprintf("您提交的活动「%s」已通过审核并发布。", event.Title)
+	if !event.Approved {
+		title = "活动审核未通过"
+		content = fmt.Sprintf("您提交的活动「%s」未通过审核，原因：%s。请修改后重新提交。", event.Title, event.Reason)
+	}
+
+	_, err := c.chatRpc.CreateNotification(ctx, &chat.CreateNotificationReq{
+		UserId:  event.OrganizerID,
+		Type:    "activity_review",
+		Title:   title,
+		Content: content,
+	})
+	if err != nil {
+		c.logger.Errorf("发送活动审核通知失败: activity_id=%d, organizer_id=%d, err=%v",
+			event.ActivityID, event.OrganizerID, err)
+		return messaging.NewRetryableError(fmt.Errorf("发送通知失败: %w", err))
+	}
+
+	c.logger.Infof("活动审核通知已发送: activity_id=%d, organizer_id=%d, approved=%v",
+		event.ActivityID, event.OrganizerID, event.Approved)
+	return nil
+}
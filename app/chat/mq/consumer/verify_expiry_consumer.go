@@ -0,0 +1,88 @@
+/**
+ * @projectName: CampusHub
+ * @package: consumer
+ * @className: VerifyExpiryConsumer
+ * @description: 学生认证过期消费者（即将过期提醒/已过期 → 创建系统通知）
+ * @date: 2026-10-18
+ * @version: 1.0
+ *
+ * 消息来源: User RPC 认证过期任务
+ * Topic: verify:expiry
+ */
+
+package consumer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"activity-platform/app/chat/rpc/chat"
+	"activity-platform/common/messaging"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// VerifyExpiryConsumer 学生认证过期消费者
+type VerifyExpiryConsumer struct {
+	chatRpc chat.ChatServiceClient
+	logger  logx.Logger
+}
+
+// NewVerifyExpiryConsumer 创建学生认证过期消费者
+func NewVerifyExpiryConsumer(chatRpc chat.ChatServiceClient) *VerifyExpiryConsumer {
+	return &VerifyExpiryConsumer{
+		chatRpc: chatRpc,
+		logger:  logx.WithContext(context.Background()),
+	}
+}
+
+// Subscribe 订阅认证过期主题
+func (c *VerifyExpiryConsumer) Subscribe(msgClient *messaging.Client) {
+	msgClient.Subscribe(messaging.TopicVerifyExpiry, "chat-verify-expiry-notify", c.handleVerifyExpiry)
+	c.logger.Info("已订阅 verify:expiry 事件")
+}
+
+// handleVerifyExpiry 处理认证过期事件
+func (c *VerifyExpiryConsumer) handleVerifyExpiry(msg *message.Message) error {
+	ctx := msg.Context()
+
+	var event messaging.VerifyExpiryEventData
+	if err := json.Unmarshal(msg.Payload, &event); err != nil {
+		c.logger.Errorf("解析认证过期事件失败: %v", err)
+		return messaging.NewNonRetryableError(fmt.Errorf("解析事件失败: %w", err))
+	}
+	if event.UserID <= 0 {
+		return messaging.NewNonRetryableError(fmt.Errorf("无效的用户ID: %d", event.UserID))
+	}
+
+	expireDate := time.Unix(event.ExpireAt, 0).Format("2006-01-02")
+	var title, content string
+	switch event.Type {
+	case messaging.VerifyExpiryRemind:
+		title = "学生认证即将过期"
+		content = fmt.Sprintf("您的学生认证将于 %s 过期，过期后将无法参与仅限学生的活动。如仍在读，请届时上传新的学生证重新认证。", expireDate)
+	case messaging.VerifyExpiryExpired:
+		title = "学生认证已过期"
+		content = fmt.Sprintf("您的学生认证已于 %s 过期。如仍在读，请在认证页面上传新的学生证重新认证。", expireDate)
+	default:
+		c.logger.Errorf("未知的认证过期事件类型: %s", event.Type)
+		return nil
+	}
+
+	_, err := c.chatRpc.CreateNotification(ctx, &chat.CreateNotificationReq{
+		UserId:  uint64(event.UserID),
+		Type:    "verify_expiry",
+		Title:   title,
+		Content: content,
+	})
+	if err != nil {
+		c.logger.Errorf("发送认证过期通知失败: user_id=%d, type=%s, err=%v", event.UserID, event.Type, err)
+		return messaging.NewRetryableError(fmt.Errorf("发送通知失败: %w", err))
+	}
+
+	c.logger.Infof("认证过期通知已发送: user_id=%d, verify_id=%d, type=%s", event.UserID, event.VerifyID, event.Type)
+	return nil
+}
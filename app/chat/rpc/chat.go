@@ -21,6 +21,7 @@ import (
 	"activity-platform/app/chat/mq/consumer"
 	"activity-platform/app/chat/rpc/chat"
 	"activity-platform/app/chat/rpc/internal/config"
+	"activity-platform/app/chat/rpc/internal/cron"
 	"activity-platform/app/chat/rpc/internal/server"
 	"activity-platform/app/chat/rpc/internal/svc"
 	"activity-platform/common/interceptor/rpcserver"
@@ -65,6 +66,13 @@ func main() {
 	// 异步启动 MQ 消费者
 	startMQConsumer(ctx)
 
+	// 启动平台统计上报任务（User RPC 不可用时跳过）
+	if c.Stats.Enabled && ctx.UserStatsRpc != nil {
+		statsReporter := cron.NewStatsReporter(ctx, c.Stats)
+		statsReporter.Start()
+		defer statsReporter.Stop()
+	}
+
 	// 监听系统信号，优雅关闭
 	go handleShutdown(s, ctx)
 
@@ -120,32 +128,56 @@ func registerConsumers(svcCtx *svc.ServiceContext, chatRpcServer chat.ChatServic
 	activityCancelledConsumer := consumer.NewActivityCancelledConsumer(chatRpcClient)
 	activityCancelledConsumer.Subscribe(svcCtx.MsgClient)
 
+	// 5. 学生认证过期事件 → 发送系统通知
+	verifyExpiryConsumer := consumer.NewVerifyExpiryConsumer(chatRpcClient)
+	verifyExpiryConsumer.Subscribe(svcCtx.MsgClient)
+
+	// 6. 活动审核结果事件 → 通知组织者
+	activityReviewedConsumer := consumer.NewActivityReviewedConsumer(chatRpcClient)
+	activityReviewedConsumer.Subscribe(svcCtx.MsgClient)
+
+	// 7. 活动变更申请审核结果 → 通知组织者与报名者
+	activityChangeReviewedConsumer := consumer.NewActivityChangeReviewedConsumer(chatRpcClient)
+	activityChangeReviewedConsumer.Subscribe(svcCtx.MsgClient)
+
+	// 8. 活动定时提醒 → 通知报名者/收藏者
+	activityReminderConsumer := consumer.NewActivityReminderConsumer(chatRpcClient)
+	activityReminderConsumer.Subscribe(svcCtx.MsgClient)
+
 	// ==================== User 域消费者（调 User RPC）====================
 
 	// 只有当 User RPC 客户端可用时，才注册 User 域消费者
 	if svcCtx.UserCreditRpc != nil && svcCtx.UserVerifyRpc != nil {
-		// 5. 信用分变更事件 → 调 UserRpc.UpdateScore
+		// 9. 信用分变更事件 → 调 UserRpc.UpdateScore
 		creditConsumer := consumer.NewCreditChangeConsumer(svcCtx.UserCreditRpc)
 		creditConsumer.Subscribe(svcCtx.MsgClient)
 
-		// 6. OCR 认证事件 → 调 UserRpc.ProcessOcrVerify
+		// 10. OCR 认证事件 → 调 UserRpc.ProcessOcrVerify
 		verifyConsumer := consumer.NewVerifyOcrConsumer(svcCtx.UserVerifyRpc)
 		verifyConsumer.Subscribe(svcCtx.MsgClient)
 
-		logx.Info("已注册 6 个 MQ 消费者:")
+		logx.Info("已注册 10 个 MQ 消费者:")
 		logx.Info("  - activity.created       -> chat-auto-create-group")
 		logx.Info("  - activity.member.joined -> chat-auto-add-member")
 		logx.Info("  - activity.member.left   -> chat-auto-remove-member")
 		logx.Info("  - activity.cancelled     -> chat-auto-disband-group")
+		logx.Info("  - verify:expiry          -> chat-verify-expiry-notify")
+		logx.Info("  - activity.reviewed      -> chat-activity-review-notify")
+		logx.Info("  - activity.change.reviewed -> chat-activity-change-notify")
+		logx.Info("  - activity.reminder      -> chat-activity-reminder-notify")
 		logx.Info("  - credit:events          -> credit-event-handler")
 		logx.Info("  - verify:events          -> verify-event-handler")
 	} else {
 		logx.Infof("[WARN] User RPC 不可用，已跳过 User 域消费者注册")
-		logx.Info("已注册 4 个 MQ 消费者:")
+		logx.Info("已注册 8 个 MQ 消费者:")
 		logx.Info("  - activity.created       -> chat-auto-create-group")
 		logx.Info("  - activity.member.joined -> chat-auto-add-member")
 		logx.Info("  - activity.member.left   -> chat-auto-remove-member")
 		logx.Info("  - activity.cancelled     -> chat-auto-disband-group")
+		logx.Info("  - verify:expiry          -> chat-verify-expiry-notify")
+		logx.Info("  - activity.reviewed      -> chat-activity-review-notify")
+		logx.Info("  - activity.change.reviewed -> chat-activity-change-notify")
+		logx.Info("  - activity.reminder      -> chat-activity-reminder-notify")
 	}
 }
 
@@ -186,6 +218,30 @@ func (c *localChatServiceClient) GetGroupByActivityId(ctx context.Context, req *
 	return c.server.GetGroupByActivityId(ctx, req)
 }
 
+func (c *localChatServiceClient) MuteMember(ctx context.Context, req *chat.MuteMemberReq, opts ...grpc.CallOption) (*chat.MuteMemberResp, error) {
+	return c.server.MuteMember(ctx, req)
+}
+
+func (c *localChatServiceClient) MuteAll(ctx context.Context, req *chat.MuteAllReq, opts ...grpc.CallOption) (*chat.MuteAllResp, error) {
+	return c.server.MuteAll(ctx, req)
+}
+
+func (c *localChatServiceClient) KickMember(ctx context.Context, req *chat.KickMemberReq, opts ...grpc.CallOption) (*chat.KickMemberResp, error) {
+	return c.server.KickMember(ctx, req)
+}
+
+func (c *localChatServiceClient) SetAnnouncement(ctx context.Context, req *chat.SetAnnouncementReq, opts ...grpc.CallOption) (*chat.SetAnnouncementResp, error) {
+	return c.server.SetAnnouncement(ctx, req)
+}
+
+func (c *localChatServiceClient) SetGroupAdmin(ctx context.Context, req *chat.SetGroupAdminReq, opts ...grpc.CallOption) (*chat.SetGroupAdminResp, error) {
+	return c.server.SetGroupAdmin(ctx, req)
+}
+
+func (c *localChatServiceClient) CheckSendPermission(ctx context.Context, req *chat.CheckSendPermissionReq, opts ...grpc.CallOption) (*chat.CheckSendPermissionResp, error) {
+	return c.server.CheckSendPermission(ctx, req)
+}
+
 func (c *localChatServiceClient) SaveMessage(ctx context.Context, req *chat.SaveMessageReq, opts ...grpc.CallOption) (*chat.SaveMessageResp, error) {
 	return c.server.SaveMessage(ctx, req)
 }
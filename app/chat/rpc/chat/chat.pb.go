@@ -500,17 +500,20 @@ func (x *GetGroupInfoReq) GetGroupId() string {
 
 // GroupInfo 群聊信息
 type GroupInfo struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`              // 群聊ID
-	ActivityId    uint64                 `protobuf:"varint,2,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"`    // 关联活动ID
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                                   // 群聊名称
-	OwnerId       uint64                 `protobuf:"varint,4,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`             // 群主用户ID
-	Status        int32                  `protobuf:"varint,5,opt,name=status,proto3" json:"status,omitempty"`                              // 状态: 1-正常 2-已解散
-	MaxMembers    int32                  `protobuf:"varint,6,opt,name=max_members,json=maxMembers,proto3" json:"max_members,omitempty"`    // 最大成员数
-	MemberCount   int32                  `protobuf:"varint,7,opt,name=member_count,json=memberCount,proto3" json:"member_count,omitempty"` // 当前成员数
-	CreatedAt     int64                  `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`       // 创建时间（时间戳）
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	GroupId        string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`                        // 群聊ID
+	ActivityId     uint64                 `protobuf:"varint,2,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"`              // 关联活动ID
+	Name           string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                                             // 群聊名称
+	OwnerId        uint64                 `protobuf:"varint,4,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`                       // 群主用户ID
+	Status         int32                  `protobuf:"varint,5,opt,name=status,proto3" json:"status,omitempty"`                                        // 状态: 1-正常 2-已解散
+	MaxMembers     int32                  `protobuf:"varint,6,opt,name=max_members,json=maxMembers,proto3" json:"max_members,omitempty"`              // 最大成员数
+	MemberCount    int32                  `protobuf:"varint,7,opt,name=member_count,json=memberCount,proto3" json:"member_count,omitempty"`           // 当前成员数
+	CreatedAt      int64                  `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                 // 创建时间（时间戳）
+	AllMuted       bool                   `protobuf:"varint,9,opt,name=all_muted,json=allMuted,proto3" json:"all_muted,omitempty"`                    // 是否全员禁言
+	Announcement   string                 `protobuf:"bytes,10,opt,name=announcement,proto3" json:"announcement,omitempty"`                            // 群公告
+	AnnouncementAt int64                  `protobuf:"varint,11,opt,name=announcement_at,json=announcementAt,proto3" json:"announcement_at,omitempty"` // 公告更新时间（时间戳，无公告为0）
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *GroupInfo) Reset() {
@@ -599,6 +602,27 @@ func (x *GroupInfo) GetCreatedAt() int64 {
 	return 0
 }
 
+func (x *GroupInfo) GetAllMuted() bool {
+	if x != nil {
+		return x.AllMuted
+	}
+	return false
+}
+
+func (x *GroupInfo) GetAnnouncement() string {
+	if x != nil {
+		return x.Announcement
+	}
+	return ""
+}
+
+func (x *GroupInfo) GetAnnouncementAt() int64 {
+	if x != nil {
+		return x.AnnouncementAt
+	}
+	return 0
+}
+
 // GetGroupInfoResp 获取群聊信息响应
 type GetGroupInfoResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -708,10 +732,11 @@ func (x *GetGroupMembersReq) GetPageSize() int32 {
 // GroupMember 群成员信息
 type GroupMember struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`       // 用户ID
-	GroupId       string                 `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`     // 群聊ID
-	Role          int32                  `protobuf:"varint,3,opt,name=role,proto3" json:"role,omitempty"`                         // 角色: 1-普通成员 2-群主
-	JoinedAt      int64                  `protobuf:"varint,4,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"` // 加入时间（时间戳）
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`             // 用户ID
+	GroupId       string                 `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`           // 群聊ID
+	Role          int32                  `protobuf:"varint,3,opt,name=role,proto3" json:"role,omitempty"`                               // 角色: 1-普通成员 2-群主 3-管理员
+	JoinedAt      int64                  `protobuf:"varint,4,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`       // 加入时间（时间戳）
+	MutedUntil    int64                  `protobuf:"varint,5,opt,name=muted_until,json=mutedUntil,proto3" json:"muted_until,omitempty"` // 禁言截止时间（时间戳，未禁言为0）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -774,6 +799,13 @@ func (x *GroupMember) GetJoinedAt() int64 {
 	return 0
 }
 
+func (x *GroupMember) GetMutedUntil() int64 {
+	if x != nil {
+		return x.MutedUntil
+	}
+	return 0
+}
+
 // GetGroupMembersResp 获取群成员列表响应
 type GetGroupMembersResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -960,107 +992,750 @@ func (x *UserGroupInfo) GetName() string {
 	return ""
 }
 
-func (x *UserGroupInfo) GetOwnerId() uint64 {
+func (x *UserGroupInfo) GetOwnerId() uint64 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+func (x *UserGroupInfo) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *UserGroupInfo) GetMaxMembers() int32 {
+	if x != nil {
+		return x.MaxMembers
+	}
+	return 0
+}
+
+func (x *UserGroupInfo) GetMemberCount() int32 {
+	if x != nil {
+		return x.MemberCount
+	}
+	return 0
+}
+
+func (x *UserGroupInfo) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *UserGroupInfo) GetRole() int32 {
+	if x != nil {
+		return x.Role
+	}
+	return 0
+}
+
+func (x *UserGroupInfo) GetJoinedAt() int64 {
+	if x != nil {
+		return x.JoinedAt
+	}
+	return 0
+}
+
+func (x *UserGroupInfo) GetLastMessage() string {
+	if x != nil {
+		return x.LastMessage
+	}
+	return ""
+}
+
+func (x *UserGroupInfo) GetLastMessageAt() int64 {
+	if x != nil {
+		return x.LastMessageAt
+	}
+	return 0
+}
+
+func (x *UserGroupInfo) GetCoverUrl() string {
+	if x != nil {
+		return x.CoverUrl
+	}
+	return ""
+}
+
+func (x *UserGroupInfo) GetLastSenderName() string {
+	if x != nil {
+		return x.LastSenderName
+	}
+	return ""
+}
+
+// GetUserGroupsResp 获取用户群列表响应
+type GetUserGroupsResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Groups        []*UserGroupInfo       `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"` // 群聊列表（包含用户特定信息）
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`  // 总数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserGroupsResp) Reset() {
+	*x = GetUserGroupsResp{}
+	mi := &file_app_chat_rpc_chat_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserGroupsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserGroupsResp) ProtoMessage() {}
+
+func (x *GetUserGroupsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_app_chat_rpc_chat_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserGroupsResp.ProtoReflect.Descriptor instead.
+func (*GetUserGroupsResp) Descriptor() ([]byte, []int) {
+	return file_app_chat_rpc_chat_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetUserGroupsResp) GetGroups() []*UserGroupInfo {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *GetUserGroupsResp) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// GetGroupByActivityIdReq 通过活动ID获取群聊请求
+type GetGroupByActivityIdReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActivityId    uint64                 `protobuf:"varint,1,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"` // 活动ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGroupByActivityIdReq) Reset() {
+	*x = GetGroupByActivityIdReq{}
+	mi := &file_app_chat_rpc_chat_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupByActivityIdReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupByActivityIdReq) ProtoMessage() {}
+
+func (x *GetGroupByActivityIdReq) ProtoReflect() protoreflect.Message {
+	mi := &file_app_chat_rpc_chat_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupByActivityIdReq.ProtoReflect.Descriptor instead.
+func (*GetGroupByActivityIdReq) Descriptor() ([]byte, []int) {
+	return file_app_chat_rpc_chat_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetGroupByActivityIdReq) GetActivityId() uint64 {
+	if x != nil {
+		return x.ActivityId
+	}
+	return 0
+}
+
+// GetGroupByActivityIdResp 通过活动ID获取群聊响应
+type GetGroupByActivityIdResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Group         *GroupInfo             `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"` // 群聊信息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGroupByActivityIdResp) Reset() {
+	*x = GetGroupByActivityIdResp{}
+	mi := &file_app_chat_rpc_chat_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupByActivityIdResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupByActivityIdResp) ProtoMessage() {}
+
+func (x *GetGroupByActivityIdResp) ProtoReflect() protoreflect.Message {
+	mi := &file_app_chat_rpc_chat_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupByActivityIdResp.ProtoReflect.Descriptor instead.
+func (*GetGroupByActivityIdResp) Descriptor() ([]byte, []int) {
+	return file_app_chat_rpc_chat_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetGroupByActivityIdResp) GetGroup() *GroupInfo {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+// MuteMemberReq 禁言成员请求
+type MuteMemberReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`           // 群聊ID
+	OperatorId    uint64                 `protobuf:"varint,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"` // 操作者ID（群主/管理员）
+	UserId        uint64                 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`             // 被禁言的用户ID
+	Duration      int64                  `protobuf:"varint,4,opt,name=duration,proto3" json:"duration,omitempty"`                       // 禁言时长（秒），0 表示解除禁言
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MuteMemberReq) Reset() {
+	*x = MuteMemberReq{}
+	mi := &file_app_chat_rpc_chat_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MuteMemberReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MuteMemberReq) ProtoMessage() {}
+
+func (x *MuteMemberReq) ProtoReflect() protoreflect.Message {
+	mi := &file_app_chat_rpc_chat_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MuteMemberReq.ProtoReflect.Descriptor instead.
+func (*MuteMemberReq) Descriptor() ([]byte, []int) {
+	return file_app_chat_rpc_chat_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *MuteMemberReq) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *MuteMemberReq) GetOperatorId() uint64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *MuteMemberReq) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *MuteMemberReq) GetDuration() int64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+// MuteMemberResp 禁言成员响应
+type MuteMemberResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MutedUntil    int64                  `protobuf:"varint,1,opt,name=muted_until,json=mutedUntil,proto3" json:"muted_until,omitempty"` // 禁言截止时间（时间戳，解除禁言为0）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MuteMemberResp) Reset() {
+	*x = MuteMemberResp{}
+	mi := &file_app_chat_rpc_chat_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MuteMemberResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MuteMemberResp) ProtoMessage() {}
+
+func (x *MuteMemberResp) ProtoReflect() protoreflect.Message {
+	mi := &file_app_chat_rpc_chat_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MuteMemberResp.ProtoReflect.Descriptor instead.
+func (*MuteMemberResp) Descriptor() ([]byte, []int) {
+	return file_app_chat_rpc_chat_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *MuteMemberResp) GetMutedUntil() int64 {
+	if x != nil {
+		return x.MutedUntil
+	}
+	return 0
+}
+
+// MuteAllReq 全员禁言请求
+type MuteAllReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`           // 群聊ID
+	OperatorId    uint64                 `protobuf:"varint,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"` // 操作者ID（群主/管理员）
+	Muted         bool                   `protobuf:"varint,3,opt,name=muted,proto3" json:"muted,omitempty"`                             // true-开启 false-关闭
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MuteAllReq) Reset() {
+	*x = MuteAllReq{}
+	mi := &file_app_chat_rpc_chat_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MuteAllReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MuteAllReq) ProtoMessage() {}
+
+func (x *MuteAllReq) ProtoReflect() protoreflect.Message {
+	mi := &file_app_chat_rpc_chat_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MuteAllReq.ProtoReflect.Descriptor instead.
+func (*MuteAllReq) Descriptor() ([]byte, []int) {
+	return file_app_chat_rpc_chat_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *MuteAllReq) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *MuteAllReq) GetOperatorId() uint64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *MuteAllReq) GetMuted() bool {
+	if x != nil {
+		return x.Muted
+	}
+	return false
+}
+
+// MuteAllResp 全员禁言响应
+type MuteAllResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // 是否成功
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MuteAllResp) Reset() {
+	*x = MuteAllResp{}
+	mi := &file_app_chat_rpc_chat_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MuteAllResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MuteAllResp) ProtoMessage() {}
+
+func (x *MuteAllResp) ProtoReflect() protoreflect.Message {
+	mi := &file_app_chat_rpc_chat_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MuteAllResp.ProtoReflect.Descriptor instead.
+func (*MuteAllResp) Descriptor() ([]byte, []int) {
+	return file_app_chat_rpc_chat_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *MuteAllResp) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// KickMemberReq 踢出成员请求
+type KickMemberReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`           // 群聊ID
+	OperatorId    uint64                 `protobuf:"varint,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"` // 操作者ID（群主/管理员）
+	UserId        uint64                 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`             // 被踢出的用户ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KickMemberReq) Reset() {
+	*x = KickMemberReq{}
+	mi := &file_app_chat_rpc_chat_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KickMemberReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickMemberReq) ProtoMessage() {}
+
+func (x *KickMemberReq) ProtoReflect() protoreflect.Message {
+	mi := &file_app_chat_rpc_chat_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickMemberReq.ProtoReflect.Descriptor instead.
+func (*KickMemberReq) Descriptor() ([]byte, []int) {
+	return file_app_chat_rpc_chat_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *KickMemberReq) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *KickMemberReq) GetOperatorId() uint64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *KickMemberReq) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// KickMemberResp 踢出成员响应
+type KickMemberResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // 是否成功
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KickMemberResp) Reset() {
+	*x = KickMemberResp{}
+	mi := &file_app_chat_rpc_chat_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KickMemberResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickMemberResp) ProtoMessage() {}
+
+func (x *KickMemberResp) ProtoReflect() protoreflect.Message {
+	mi := &file_app_chat_rpc_chat_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickMemberResp.ProtoReflect.Descriptor instead.
+func (*KickMemberResp) Descriptor() ([]byte, []int) {
+	return file_app_chat_rpc_chat_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *KickMemberResp) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// SetAnnouncementReq 设置群公告请求
+type SetAnnouncementReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`           // 群聊ID
+	OperatorId    uint64                 `protobuf:"varint,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"` // 操作者ID（群主/管理员）
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`                          // 公告内容（为空表示清除公告，最多1000字）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAnnouncementReq) Reset() {
+	*x = SetAnnouncementReq{}
+	mi := &file_app_chat_rpc_chat_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAnnouncementReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAnnouncementReq) ProtoMessage() {}
+
+func (x *SetAnnouncementReq) ProtoReflect() protoreflect.Message {
+	mi := &file_app_chat_rpc_chat_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAnnouncementReq.ProtoReflect.Descriptor instead.
+func (*SetAnnouncementReq) Descriptor() ([]byte, []int) {
+	return file_app_chat_rpc_chat_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SetAnnouncementReq) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *SetAnnouncementReq) GetOperatorId() uint64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *SetAnnouncementReq) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+// SetAnnouncementResp 设置群公告响应
+type SetAnnouncementResp struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AnnouncementAt int64                  `protobuf:"varint,1,opt,name=announcement_at,json=announcementAt,proto3" json:"announcement_at,omitempty"` // 公告更新时间（时间戳）
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SetAnnouncementResp) Reset() {
+	*x = SetAnnouncementResp{}
+	mi := &file_app_chat_rpc_chat_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAnnouncementResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAnnouncementResp) ProtoMessage() {}
+
+func (x *SetAnnouncementResp) ProtoReflect() protoreflect.Message {
+	mi := &file_app_chat_rpc_chat_proto_msgTypes[26]
 	if x != nil {
-		return x.OwnerId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *UserGroupInfo) GetStatus() int32 {
-	if x != nil {
-		return x.Status
-	}
-	return 0
+// Deprecated: Use SetAnnouncementResp.ProtoReflect.Descriptor instead.
+func (*SetAnnouncementResp) Descriptor() ([]byte, []int) {
+	return file_app_chat_rpc_chat_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *UserGroupInfo) GetMaxMembers() int32 {
+func (x *SetAnnouncementResp) GetAnnouncementAt() int64 {
 	if x != nil {
-		return x.MaxMembers
+		return x.AnnouncementAt
 	}
 	return 0
 }
 
-func (x *UserGroupInfo) GetMemberCount() int32 {
-	if x != nil {
-		return x.MemberCount
-	}
-	return 0
+// SetGroupAdminReq 设置/取消管理员请求
+type SetGroupAdminReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`           // 群聊ID
+	OperatorId    uint64                 `protobuf:"varint,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"` // 操作者ID（必须是群主）
+	UserId        uint64                 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`             // 目标用户ID
+	IsAdmin       bool                   `protobuf:"varint,4,opt,name=is_admin,json=isAdmin,proto3" json:"is_admin,omitempty"`          // true-设为管理员 false-取消管理员
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserGroupInfo) GetCreatedAt() int64 {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return 0
+func (x *SetGroupAdminReq) Reset() {
+	*x = SetGroupAdminReq{}
+	mi := &file_app_chat_rpc_chat_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserGroupInfo) GetRole() int32 {
-	if x != nil {
-		return x.Role
-	}
-	return 0
+func (x *SetGroupAdminReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UserGroupInfo) GetJoinedAt() int64 {
+func (*SetGroupAdminReq) ProtoMessage() {}
+
+func (x *SetGroupAdminReq) ProtoReflect() protoreflect.Message {
+	mi := &file_app_chat_rpc_chat_proto_msgTypes[27]
 	if x != nil {
-		return x.JoinedAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *UserGroupInfo) GetLastMessage() string {
+// Deprecated: Use SetGroupAdminReq.ProtoReflect.Descriptor instead.
+func (*SetGroupAdminReq) Descriptor() ([]byte, []int) {
+	return file_app_chat_rpc_chat_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SetGroupAdminReq) GetGroupId() string {
 	if x != nil {
-		return x.LastMessage
+		return x.GroupId
 	}
 	return ""
 }
 
-func (x *UserGroupInfo) GetLastMessageAt() int64 {
+func (x *SetGroupAdminReq) GetOperatorId() uint64 {
 	if x != nil {
-		return x.LastMessageAt
+		return x.OperatorId
 	}
 	return 0
 }
 
-func (x *UserGroupInfo) GetCoverUrl() string {
+func (x *SetGroupAdminReq) GetUserId() uint64 {
 	if x != nil {
-		return x.CoverUrl
+		return x.UserId
 	}
-	return ""
+	return 0
 }
 
-func (x *UserGroupInfo) GetLastSenderName() string {
+func (x *SetGroupAdminReq) GetIsAdmin() bool {
 	if x != nil {
-		return x.LastSenderName
+		return x.IsAdmin
 	}
-	return ""
+	return false
 }
 
-// GetUserGroupsResp 获取用户群列表响应
-type GetUserGroupsResp struct {
+// SetGroupAdminResp 设置/取消管理员响应
+type SetGroupAdminResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Groups        []*UserGroupInfo       `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"` // 群聊列表（包含用户特定信息）
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`  // 总数
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // 是否成功
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserGroupsResp) Reset() {
-	*x = GetUserGroupsResp{}
-	mi := &file_app_chat_rpc_chat_proto_msgTypes[16]
+func (x *SetGroupAdminResp) Reset() {
+	*x = SetGroupAdminResp{}
+	mi := &file_app_chat_rpc_chat_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserGroupsResp) String() string {
+func (x *SetGroupAdminResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserGroupsResp) ProtoMessage() {}
+func (*SetGroupAdminResp) ProtoMessage() {}
 
-func (x *GetUserGroupsResp) ProtoReflect() protoreflect.Message {
-	mi := &file_app_chat_rpc_chat_proto_msgTypes[16]
+func (x *SetGroupAdminResp) ProtoReflect() protoreflect.Message {
+	mi := &file_app_chat_rpc_chat_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1071,48 +1746,42 @@ func (x *GetUserGroupsResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserGroupsResp.ProtoReflect.Descriptor instead.
-func (*GetUserGroupsResp) Descriptor() ([]byte, []int) {
-	return file_app_chat_rpc_chat_proto_rawDescGZIP(), []int{16}
-}
-
-func (x *GetUserGroupsResp) GetGroups() []*UserGroupInfo {
-	if x != nil {
-		return x.Groups
-	}
-	return nil
+// Deprecated: Use SetGroupAdminResp.ProtoReflect.Descriptor instead.
+func (*SetGroupAdminResp) Descriptor() ([]byte, []int) {
+	return file_app_chat_rpc_chat_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *GetUserGroupsResp) GetTotal() int32 {
+func (x *SetGroupAdminResp) GetSuccess() bool {
 	if x != nil {
-		return x.Total
+		return x.Success
 	}
-	return 0
+	return false
 }
 
-// GetGroupByActivityIdReq 通过活动ID获取群聊请求
-type GetGroupByActivityIdReq struct {
+// CheckSendPermissionReq 校验发言权限请求
+type CheckSendPermissionReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ActivityId    uint64                 `protobuf:"varint,1,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"` // 活动ID
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"` // 群聊ID
+	UserId        uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`   // 发送者ID
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGroupByActivityIdReq) Reset() {
-	*x = GetGroupByActivityIdReq{}
-	mi := &file_app_chat_rpc_chat_proto_msgTypes[17]
+func (x *CheckSendPermissionReq) Reset() {
+	*x = CheckSendPermissionReq{}
+	mi := &file_app_chat_rpc_chat_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGroupByActivityIdReq) String() string {
+func (x *CheckSendPermissionReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGroupByActivityIdReq) ProtoMessage() {}
+func (*CheckSendPermissionReq) ProtoMessage() {}
 
-func (x *GetGroupByActivityIdReq) ProtoReflect() protoreflect.Message {
-	mi := &file_app_chat_rpc_chat_proto_msgTypes[17]
+func (x *CheckSendPermissionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_app_chat_rpc_chat_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1123,41 +1792,52 @@ func (x *GetGroupByActivityIdReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGroupByActivityIdReq.ProtoReflect.Descriptor instead.
-func (*GetGroupByActivityIdReq) Descriptor() ([]byte, []int) {
-	return file_app_chat_rpc_chat_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use CheckSendPermissionReq.ProtoReflect.Descriptor instead.
+func (*CheckSendPermissionReq) Descriptor() ([]byte, []int) {
+	return file_app_chat_rpc_chat_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *GetGroupByActivityIdReq) GetActivityId() uint64 {
+func (x *CheckSendPermissionReq) GetGroupId() string {
 	if x != nil {
-		return x.ActivityId
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *CheckSendPermissionReq) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
 	}
 	return 0
 }
 
-// GetGroupByActivityIdResp 通过活动ID获取群聊响应
-type GetGroupByActivityIdResp struct {
+// CheckSendPermissionResp 校验发言权限响应
+type CheckSendPermissionResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Group         *GroupInfo             `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"` // 群聊信息
+	IsMember      bool                   `protobuf:"varint,1,opt,name=is_member,json=isMember,proto3" json:"is_member,omitempty"`       // 是否为群成员
+	Allowed       bool                   `protobuf:"varint,2,opt,name=allowed,proto3" json:"allowed,omitempty"`                         // 是否允许发言
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`                            // 不允许发言的原因
+	MutedUntil    int64                  `protobuf:"varint,4,opt,name=muted_until,json=mutedUntil,proto3" json:"muted_until,omitempty"` // 个人禁言截止时间（时间戳，未禁言为0）
+	Role          int32                  `protobuf:"varint,5,opt,name=role,proto3" json:"role,omitempty"`                               // 成员角色（非成员为0）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGroupByActivityIdResp) Reset() {
-	*x = GetGroupByActivityIdResp{}
-	mi := &file_app_chat_rpc_chat_proto_msgTypes[18]
+func (x *CheckSendPermissionResp) Reset() {
+	*x = CheckSendPermissionResp{}
+	mi := &file_app_chat_rpc_chat_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGroupByActivityIdResp) String() string {
+func (x *CheckSendPermissionResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGroupByActivityIdResp) ProtoMessage() {}
+func (*CheckSendPermissionResp) ProtoMessage() {}
 
-func (x *GetGroupByActivityIdResp) ProtoReflect() protoreflect.Message {
-	mi := &file_app_chat_rpc_chat_proto_msgTypes[18]
+func (x *CheckSendPermissionResp) ProtoReflect() protoreflect.Message {
+	mi := &file_app_chat_rpc_chat_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1168,16 +1848,44 @@ func (x *GetGroupByActivityIdResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGroupByActivityIdResp.ProtoReflect.Descriptor instead.
-func (*GetGroupByActivityIdResp) Descriptor() ([]byte, []int) {
-	return file_app_chat_rpc_chat_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use CheckSendPermissionResp.ProtoReflect.Descriptor instead.
+func (*CheckSendPermissionResp) Descriptor() ([]byte, []int) {
+	return file_app_chat_rpc_chat_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *GetGroupByActivityIdResp) GetGroup() *GroupInfo {
+func (x *CheckSendPermissionResp) GetIsMember() bool {
 	if x != nil {
-		return x.Group
+		return x.IsMember
 	}
-	return nil
+	return false
+}
+
+func (x *CheckSendPermissionResp) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
+func (x *CheckSendPermissionResp) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CheckSendPermissionResp) GetMutedUntil() int64 {
+	if x != nil {
+		return x.MutedUntil
+	}
+	return 0
+}
+
+func (x *CheckSendPermissionResp) GetRole() int32 {
+	if x != nil {
+		return x.Role
+	}
+	return 0
 }
 
 // SaveMessageReq 保存消息请求
@@ -1195,7 +1903,7 @@ type SaveMessageReq struct {
 
 func (x *SaveMessageReq) Reset() {
 	*x = SaveMessageReq{}
-	mi := &file_app_chat_rpc_chat_proto_msgTypes[19]
+	mi := &file_app_chat_rpc_chat_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1207,7 +1915,7 @@ func (x *SaveMessageReq) String() string {
 func (*SaveMessageReq) ProtoMessage() {}
 
 func (x *SaveMessageReq) ProtoReflect() protoreflect.Message {
-	mi := &file_app_chat_rpc_chat_proto_msgTypes[19]
+	mi := &file_app_chat_rpc_chat_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1220,7 +1928,7 @@ func (x *SaveMessageReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SaveMessageReq.ProtoReflect.Descriptor instead.
 func (*SaveMessageReq) Descriptor() ([]byte, []int) {
-	return file_app_chat_rpc_chat_proto_rawDescGZIP(), []int{19}
+	return file_app_chat_rpc_chat_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *SaveMessageReq) GetMessageId() string {
@@ -1276,7 +1984,7 @@ type SaveMessageResp struct {
 
 func (x *SaveMessageResp) Reset() {
 	*x = SaveMessageResp{}
-	mi := &file_app_chat_rpc_chat_proto_msgTypes[20]
+	mi := &file_app_chat_rpc_chat_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1288,7 +1996,7 @@ func (x *SaveMessageResp) String() string {
 func (*SaveMessageResp) ProtoMessage() {}
 
 func (x *SaveMessageResp) ProtoReflect() protoreflect.Message {
-	mi := &file_app_chat_rpc_chat_proto_msgTypes[20]
+	mi := &file_app_chat_rpc_chat_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1301,7 +2009,7 @@ func (x *SaveMessageResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SaveMessageResp.ProtoReflect.Descriptor instead.
 func (*SaveMessageResp) Descriptor() ([]byte, []int) {
-	return file_app_chat_rpc_chat_proto_rawDescGZIP(), []int{20}
+	return file_app_chat_rpc_chat_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *SaveMessageResp) GetSuccess() bool {
@@ -1330,7 +2038,7 @@ type GetMessageHistoryReq struct {
 
 func (x *GetMessageHistoryReq) Reset() {
 	*x = GetMessageHistoryReq{}
-	mi := &file_app_chat_rpc_chat_proto_msgTypes[21]
+	mi := &file_app_chat_rpc_chat_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1342,7 +2050,7 @@ func (x *GetMessageHistoryReq) String() string {
 func (*GetMessageHistoryReq) ProtoMessage() {}
 
 func (x *GetMessageHistoryReq) ProtoReflect() protoreflect.Message {
-	mi := &file_app_chat_rpc_chat_proto_msgTypes[21]
+	mi := &file_app_chat_rpc_chat_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1355,7 +2063,7 @@ func (x *GetMessageHistoryReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMessageHistoryReq.ProtoReflect.Descriptor instead.
 func (*GetMessageHistoryReq) Descriptor() ([]byte, []int) {
-	return file_app_chat_rpc_chat_proto_rawDescGZIP(), []int{21}
+	return file_app_chat_rpc_chat_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *GetMessageHistoryReq) GetGroupId() string {
@@ -1397,7 +2105,7 @@ type Message struct {
 
 func (x *Message) Reset() {
 	*x = Message{}
-	mi := &file_app_chat_rpc_chat_proto_msgTypes[22]
+	mi := &file_app_chat_rpc_chat_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1409,7 +2117,7 @@ func (x *Message) String() string {
 func (*Message) ProtoMessage() {}
 
 func (x *Message) ProtoReflect() protoreflect.Message {
-	mi := &file_app_chat_rpc_chat_proto_msgTypes[22]
+	mi := &file_app_chat_rpc_chat_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1422,7 +2130,7 @@ func (x *Message) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Message.ProtoReflect.Descriptor instead.
 func (*Message) Descriptor() ([]byte, []int) {
-	return file_app_chat_rpc_chat_proto_rawDescGZIP(), []int{22}
+	return file_app_chat_rpc_chat_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *Message) GetMessageId() string {
@@ -1499,7 +2207,7 @@ type GetMessageHistoryResp struct {
 
 func (x *GetMessageHistoryResp) Reset() {
 	*x = GetMessageHistoryResp{}
-	mi := &file_app_chat_rpc_chat_proto_msgTypes[23]
+	mi := &file_app_chat_rpc_chat_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1511,7 +2219,7 @@ func (x *GetMessageHistoryResp) String() string {
 func (*GetMessageHistoryResp) ProtoMessage() {}
 
 func (x *GetMessageHistoryResp) ProtoReflect() protoreflect.Message {
-	mi := &file_app_chat_rpc_chat_proto_msgTypes[23]
+	mi := &file_app_chat_rpc_chat_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1524,7 +2232,7 @@ func (x *GetMessageHistoryResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMessageHistoryResp.ProtoReflect.Descriptor instead.
 func (*GetMessageHistoryResp) Descriptor() ([]byte, []int) {
-	return file_app_chat_rpc_chat_proto_rawDescGZIP(), []int{23}
+	return file_app_chat_rpc_chat_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *GetMessageHistoryResp) GetMessages() []*Message {
@@ -1552,7 +2260,7 @@ type GetOfflineMessagesReq struct {
 
 func (x *GetOfflineMessagesReq) Reset() {
 	*x = GetOfflineMessagesReq{}
-	mi := &file_app_chat_rpc_chat_proto_msgTypes[24]
+	mi := &file_app_chat_rpc_chat_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1564,7 +2272,7 @@ func (x *GetOfflineMessagesReq) String() string {
 func (*GetOfflineMessagesReq) ProtoMessage() {}
 
 func (x *GetOfflineMessagesReq) ProtoReflect() protoreflect.Message {
-	mi := &file_app_chat_rpc_chat_proto_msgTypes[24]
+	mi := &file_app_chat_rpc_chat_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1577,7 +2285,7 @@ func (x *GetOfflineMessagesReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOfflineMessagesReq.ProtoReflect.Descriptor instead.
 func (*GetOfflineMessagesReq) Descriptor() ([]byte, []int) {
-	return file_app_chat_rpc_chat_proto_rawDescGZIP(), []int{24}
+	return file_app_chat_rpc_chat_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *GetOfflineMessagesReq) GetUserId() uint64 {
@@ -1604,7 +2312,7 @@ type GetOfflineMessagesResp struct {
 
 func (x *GetOfflineMessagesResp) Reset() {
 	*x = GetOfflineMessagesResp{}
-	mi := &file_app_chat_rpc_chat_proto_msgTypes[25]
+	mi := &file_app_chat_rpc_chat_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1616,7 +2324,7 @@ func (x *GetOfflineMessagesResp) String() string {
 func (*GetOfflineMessagesResp) ProtoMessage() {}
 
 func (x *GetOfflineMessagesResp) ProtoReflect() protoreflect.Message {
-	mi := &file_app_chat_rpc_chat_proto_msgTypes[25]
+	mi := &file_app_chat_rpc_chat_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1629,7 +2337,7 @@ func (x *GetOfflineMessagesResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOfflineMessagesResp.ProtoReflect.Descriptor instead.
 func (*GetOfflineMessagesResp) Descriptor() ([]byte, []int) {
-	return file_app_chat_rpc_chat_proto_rawDescGZIP(), []int{25}
+	return file_app_chat_rpc_chat_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *GetOfflineMessagesResp) GetMessages() []*Message {
@@ -1652,7 +2360,7 @@ type CreateNotificationReq struct {
 
 func (x *CreateNotificationReq) Reset() {
 	*x = CreateNotificationReq{}
-	mi := &file_app_chat_rpc_chat_proto_msgTypes[26]
+	mi := &file_app_chat_rpc_chat_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1664,7 +2372,7 @@ func (x *CreateNotificationReq) String() string {
 func (*CreateNotificationReq) ProtoMessage() {}
 
 func (x *CreateNotificationReq) ProtoReflect() protoreflect.Message {
-	mi := &file_app_chat_rpc_chat_proto_msgTypes[26]
+	mi := &file_app_chat_rpc_chat_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1677,7 +2385,7 @@ func (x *CreateNotificationReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateNotificationReq.ProtoReflect.Descriptor instead.
 func (*CreateNotificationReq) Descriptor() ([]byte, []int) {
-	return file_app_chat_rpc_chat_proto_rawDescGZIP(), []int{26}
+	return file_app_chat_rpc_chat_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *CreateNotificationReq) GetUserId() uint64 {
@@ -1718,7 +2426,7 @@ type CreateNotificationResp struct {
 
 func (x *CreateNotificationResp) Reset() {
 	*x = CreateNotificationResp{}
-	mi := &file_app_chat_rpc_chat_proto_msgTypes[27]
+	mi := &file_app_chat_rpc_chat_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1730,7 +2438,7 @@ func (x *CreateNotificationResp) String() string {
 func (*CreateNotificationResp) ProtoMessage() {}
 
 func (x *CreateNotificationResp) ProtoReflect() protoreflect.Message {
-	mi := &file_app_chat_rpc_chat_proto_msgTypes[27]
+	mi := &file_app_chat_rpc_chat_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1743,7 +2451,7 @@ func (x *CreateNotificationResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateNotificationResp.ProtoReflect.Descriptor instead.
 func (*CreateNotificationResp) Descriptor() ([]byte, []int) {
-	return file_app_chat_rpc_chat_proto_rawDescGZIP(), []int{27}
+	return file_app_chat_rpc_chat_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *CreateNotificationResp) GetNotificationId() string {
@@ -1766,7 +2474,7 @@ type GetNotificationsReq struct {
 
 func (x *GetNotificationsReq) Reset() {
 	*x = GetNotificationsReq{}
-	mi := &file_app_chat_rpc_chat_proto_msgTypes[28]
+	mi := &file_app_chat_rpc_chat_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1778,7 +2486,7 @@ func (x *GetNotificationsReq) String() string {
 func (*GetNotificationsReq) ProtoMessage() {}
 
 func (x *GetNotificationsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_app_chat_rpc_chat_proto_msgTypes[28]
+	mi := &file_app_chat_rpc_chat_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1791,7 +2499,7 @@ func (x *GetNotificationsReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetNotificationsReq.ProtoReflect.Descriptor instead.
 func (*GetNotificationsReq) Descriptor() ([]byte, []int) {
-	return file_app_chat_rpc_chat_proto_rawDescGZIP(), []int{28}
+	return file_app_chat_rpc_chat_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *GetNotificationsReq) GetUserId() uint64 {
@@ -1838,7 +2546,7 @@ type Notification struct {
 
 func (x *Notification) Reset() {
 	*x = Notification{}
-	mi := &file_app_chat_rpc_chat_proto_msgTypes[29]
+	mi := &file_app_chat_rpc_chat_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1850,7 +2558,7 @@ func (x *Notification) String() string {
 func (*Notification) ProtoMessage() {}
 
 func (x *Notification) ProtoReflect() protoreflect.Message {
-	mi := &file_app_chat_rpc_chat_proto_msgTypes[29]
+	mi := &file_app_chat_rpc_chat_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1863,7 +2571,7 @@ func (x *Notification) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Notification.ProtoReflect.Descriptor instead.
 func (*Notification) Descriptor() ([]byte, []int) {
-	return file_app_chat_rpc_chat_proto_rawDescGZIP(), []int{29}
+	return file_app_chat_rpc_chat_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *Notification) GetNotificationId() string {
@@ -1927,7 +2635,7 @@ type GetNotificationsResp struct {
 
 func (x *GetNotificationsResp) Reset() {
 	*x = GetNotificationsResp{}
-	mi := &file_app_chat_rpc_chat_proto_msgTypes[30]
+	mi := &file_app_chat_rpc_chat_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1939,7 +2647,7 @@ func (x *GetNotificationsResp) String() string {
 func (*GetNotificationsResp) ProtoMessage() {}
 
 func (x *GetNotificationsResp) ProtoReflect() protoreflect.Message {
-	mi := &file_app_chat_rpc_chat_proto_msgTypes[30]
+	mi := &file_app_chat_rpc_chat_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1952,7 +2660,7 @@ func (x *GetNotificationsResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetNotificationsResp.ProtoReflect.Descriptor instead.
 func (*GetNotificationsResp) Descriptor() ([]byte, []int) {
-	return file_app_chat_rpc_chat_proto_rawDescGZIP(), []int{30}
+	return file_app_chat_rpc_chat_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *GetNotificationsResp) GetNotifications() []*Notification {
@@ -1987,7 +2695,7 @@ type MarkNotificationReadReq struct {
 
 func (x *MarkNotificationReadReq) Reset() {
 	*x = MarkNotificationReadReq{}
-	mi := &file_app_chat_rpc_chat_proto_msgTypes[31]
+	mi := &file_app_chat_rpc_chat_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1999,7 +2707,7 @@ func (x *MarkNotificationReadReq) String() string {
 func (*MarkNotificationReadReq) ProtoMessage() {}
 
 func (x *MarkNotificationReadReq) ProtoReflect() protoreflect.Message {
-	mi := &file_app_chat_rpc_chat_proto_msgTypes[31]
+	mi := &file_app_chat_rpc_chat_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2012,7 +2720,7 @@ func (x *MarkNotificationReadReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MarkNotificationReadReq.ProtoReflect.Descriptor instead.
 func (*MarkNotificationReadReq) Descriptor() ([]byte, []int) {
-	return file_app_chat_rpc_chat_proto_rawDescGZIP(), []int{31}
+	return file_app_chat_rpc_chat_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *MarkNotificationReadReq) GetUserId() uint64 {
@@ -2040,7 +2748,7 @@ type MarkNotificationReadResp struct {
 
 func (x *MarkNotificationReadResp) Reset() {
 	*x = MarkNotificationReadResp{}
-	mi := &file_app_chat_rpc_chat_proto_msgTypes[32]
+	mi := &file_app_chat_rpc_chat_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2052,7 +2760,7 @@ func (x *MarkNotificationReadResp) String() string {
 func (*MarkNotificationReadResp) ProtoMessage() {}
 
 func (x *MarkNotificationReadResp) ProtoReflect() protoreflect.Message {
-	mi := &file_app_chat_rpc_chat_proto_msgTypes[32]
+	mi := &file_app_chat_rpc_chat_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2065,7 +2773,7 @@ func (x *MarkNotificationReadResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MarkNotificationReadResp.ProtoReflect.Descriptor instead.
 func (*MarkNotificationReadResp) Descriptor() ([]byte, []int) {
-	return file_app_chat_rpc_chat_proto_rawDescGZIP(), []int{32}
+	return file_app_chat_rpc_chat_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *MarkNotificationReadResp) GetSuccess() bool {
@@ -2092,7 +2800,7 @@ type GetUnreadCountReq struct {
 
 func (x *GetUnreadCountReq) Reset() {
 	*x = GetUnreadCountReq{}
-	mi := &file_app_chat_rpc_chat_proto_msgTypes[33]
+	mi := &file_app_chat_rpc_chat_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2104,7 +2812,7 @@ func (x *GetUnreadCountReq) String() string {
 func (*GetUnreadCountReq) ProtoMessage() {}
 
 func (x *GetUnreadCountReq) ProtoReflect() protoreflect.Message {
-	mi := &file_app_chat_rpc_chat_proto_msgTypes[33]
+	mi := &file_app_chat_rpc_chat_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2117,7 +2825,7 @@ func (x *GetUnreadCountReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUnreadCountReq.ProtoReflect.Descriptor instead.
 func (*GetUnreadCountReq) Descriptor() ([]byte, []int) {
-	return file_app_chat_rpc_chat_proto_rawDescGZIP(), []int{33}
+	return file_app_chat_rpc_chat_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *GetUnreadCountReq) GetUserId() uint64 {
@@ -2137,7 +2845,7 @@ type GetUnreadCountResp struct {
 
 func (x *GetUnreadCountResp) Reset() {
 	*x = GetUnreadCountResp{}
-	mi := &file_app_chat_rpc_chat_proto_msgTypes[34]
+	mi := &file_app_chat_rpc_chat_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2149,7 +2857,7 @@ func (x *GetUnreadCountResp) String() string {
 func (*GetUnreadCountResp) ProtoMessage() {}
 
 func (x *GetUnreadCountResp) ProtoReflect() protoreflect.Message {
-	mi := &file_app_chat_rpc_chat_proto_msgTypes[34]
+	mi := &file_app_chat_rpc_chat_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2162,7 +2870,7 @@ func (x *GetUnreadCountResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUnreadCountResp.ProtoReflect.Descriptor instead.
 func (*GetUnreadCountResp) Descriptor() ([]byte, []int) {
-	return file_app_chat_rpc_chat_proto_rawDescGZIP(), []int{34}
+	return file_app_chat_rpc_chat_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *GetUnreadCountResp) GetUnreadCount() int32 {
@@ -2182,7 +2890,7 @@ type MarkAllReadReq struct {
 
 func (x *MarkAllReadReq) Reset() {
 	*x = MarkAllReadReq{}
-	mi := &file_app_chat_rpc_chat_proto_msgTypes[35]
+	mi := &file_app_chat_rpc_chat_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2194,7 +2902,7 @@ func (x *MarkAllReadReq) String() string {
 func (*MarkAllReadReq) ProtoMessage() {}
 
 func (x *MarkAllReadReq) ProtoReflect() protoreflect.Message {
-	mi := &file_app_chat_rpc_chat_proto_msgTypes[35]
+	mi := &file_app_chat_rpc_chat_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2207,7 +2915,7 @@ func (x *MarkAllReadReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MarkAllReadReq.ProtoReflect.Descriptor instead.
 func (*MarkAllReadReq) Descriptor() ([]byte, []int) {
-	return file_app_chat_rpc_chat_proto_rawDescGZIP(), []int{35}
+	return file_app_chat_rpc_chat_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *MarkAllReadReq) GetUserId() uint64 {
@@ -2228,7 +2936,7 @@ type MarkAllReadResp struct {
 
 func (x *MarkAllReadResp) Reset() {
 	*x = MarkAllReadResp{}
-	mi := &file_app_chat_rpc_chat_proto_msgTypes[36]
+	mi := &file_app_chat_rpc_chat_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2240,7 +2948,7 @@ func (x *MarkAllReadResp) String() string {
 func (*MarkAllReadResp) ProtoMessage() {}
 
 func (x *MarkAllReadResp) ProtoReflect() protoreflect.Message {
-	mi := &file_app_chat_rpc_chat_proto_msgTypes[36]
+	mi := &file_app_chat_rpc_chat_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2253,7 +2961,7 @@ func (x *MarkAllReadResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MarkAllReadResp.ProtoReflect.Descriptor instead.
 func (*MarkAllReadResp) Descriptor() ([]byte, []int) {
-	return file_app_chat_rpc_chat_proto_rawDescGZIP(), []int{36}
+	return file_app_chat_rpc_chat_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *MarkAllReadResp) GetSuccess() bool {
@@ -2305,7 +3013,7 @@ const file_app_chat_rpc_chat_proto_rawDesc = "" +
 	"\x10DisbandGroupResp\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\",\n" +
 	"\x0fGetGroupInfoReq\x12\x19\n" +
-	"\bgroup_id\x18\x01 \x01(\tR\agroupId\"\xf1\x01\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\"\xdb\x02\n" +
 	"\tGroupInfo\x12\x19\n" +
 	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x1f\n" +
 	"\vactivity_id\x18\x02 \x01(\x04R\n" +
@@ -2317,18 +3025,24 @@ const file_app_chat_rpc_chat_proto_rawDesc = "" +
 	"maxMembers\x12!\n" +
 	"\fmember_count\x18\a \x01(\x05R\vmemberCount\x12\x1d\n" +
 	"\n" +
-	"created_at\x18\b \x01(\x03R\tcreatedAt\"9\n" +
+	"created_at\x18\b \x01(\x03R\tcreatedAt\x12\x1b\n" +
+	"\tall_muted\x18\t \x01(\bR\ballMuted\x12\"\n" +
+	"\fannouncement\x18\n" +
+	" \x01(\tR\fannouncement\x12'\n" +
+	"\x0fannouncement_at\x18\v \x01(\x03R\x0eannouncementAt\"9\n" +
 	"\x10GetGroupInfoResp\x12%\n" +
 	"\x05group\x18\x01 \x01(\v2\x0f.chat.GroupInfoR\x05group\"`\n" +
 	"\x12GetGroupMembersReq\x12\x19\n" +
 	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x12\n" +
 	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"r\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"\x93\x01\n" +
 	"\vGroupMember\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x19\n" +
 	"\bgroup_id\x18\x02 \x01(\tR\agroupId\x12\x12\n" +
 	"\x04role\x18\x03 \x01(\x05R\x04role\x12\x1b\n" +
-	"\tjoined_at\x18\x04 \x01(\x03R\bjoinedAt\"X\n" +
+	"\tjoined_at\x18\x04 \x01(\x03R\bjoinedAt\x12\x1f\n" +
+	"\vmuted_until\x18\x05 \x01(\x03R\n" +
+	"mutedUntil\"X\n" +
 	"\x13GetGroupMembersResp\x12+\n" +
 	"\amembers\x18\x01 \x03(\v2\x11.chat.GroupMemberR\amembers\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\"\\\n" +
@@ -2362,7 +3076,56 @@ const file_app_chat_rpc_chat_proto_rawDesc = "" +
 	"\vactivity_id\x18\x01 \x01(\x04R\n" +
 	"activityId\"A\n" +
 	"\x18GetGroupByActivityIdResp\x12%\n" +
-	"\x05group\x18\x01 \x01(\v2\x0f.chat.GroupInfoR\x05group\"\xb9\x01\n" +
+	"\x05group\x18\x01 \x01(\v2\x0f.chat.GroupInfoR\x05group\"\x80\x01\n" +
+	"\rMuteMemberReq\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x1f\n" +
+	"\voperator_id\x18\x02 \x01(\x04R\n" +
+	"operatorId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x04R\x06userId\x12\x1a\n" +
+	"\bduration\x18\x04 \x01(\x03R\bduration\"1\n" +
+	"\x0eMuteMemberResp\x12\x1f\n" +
+	"\vmuted_until\x18\x01 \x01(\x03R\n" +
+	"mutedUntil\"^\n" +
+	"\n" +
+	"MuteAllReq\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x1f\n" +
+	"\voperator_id\x18\x02 \x01(\x04R\n" +
+	"operatorId\x12\x14\n" +
+	"\x05muted\x18\x03 \x01(\bR\x05muted\"'\n" +
+	"\vMuteAllResp\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"d\n" +
+	"\rKickMemberReq\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x1f\n" +
+	"\voperator_id\x18\x02 \x01(\x04R\n" +
+	"operatorId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x04R\x06userId\"*\n" +
+	"\x0eKickMemberResp\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"j\n" +
+	"\x12SetAnnouncementReq\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x1f\n" +
+	"\voperator_id\x18\x02 \x01(\x04R\n" +
+	"operatorId\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\">\n" +
+	"\x13SetAnnouncementResp\x12'\n" +
+	"\x0fannouncement_at\x18\x01 \x01(\x03R\x0eannouncementAt\"\x82\x01\n" +
+	"\x10SetGroupAdminReq\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x1f\n" +
+	"\voperator_id\x18\x02 \x01(\x04R\n" +
+	"operatorId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x04R\x06userId\x12\x19\n" +
+	"\bis_admin\x18\x04 \x01(\bR\aisAdmin\"-\n" +
+	"\x11SetGroupAdminResp\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"L\n" +
+	"\x16CheckSendPermissionReq\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x04R\x06userId\"\x9d\x01\n" +
+	"\x17CheckSendPermissionResp\x12\x1b\n" +
+	"\tis_member\x18\x01 \x01(\bR\bisMember\x12\x18\n" +
+	"\aallowed\x18\x02 \x01(\bR\aallowed\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x1f\n" +
+	"\vmuted_until\x18\x04 \x01(\x03R\n" +
+	"mutedUntil\x12\x12\n" +
+	"\x04role\x18\x05 \x01(\x05R\x04role\"\xb9\x01\n" +
 	"\x0eSaveMessageReq\x12\x1d\n" +
 	"\n" +
 	"message_id\x18\x01 \x01(\tR\tmessageId\x12\x19\n" +
@@ -2440,7 +3203,7 @@ const file_app_chat_rpc_chat_proto_rawDesc = "" +
 	"\auser_id\x18\x01 \x01(\x04R\x06userId\"R\n" +
 	"\x0fMarkAllReadResp\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12%\n" +
-	"\x0eaffected_count\x18\x02 \x01(\x05R\raffectedCount2\x8a\t\n" +
+	"\x0eaffected_count\x18\x02 \x01(\x05R\raffectedCount2\x8a\f\n" +
 	"\vChatService\x12:\n" +
 	"\vCreateGroup\x12\x14.chat.CreateGroupReq\x1a\x15.chat.CreateGroupResp\x12C\n" +
 	"\x0eAddGroupMember\x12\x17.chat.AddGroupMemberReq\x1a\x18.chat.AddGroupMemberResp\x12L\n" +
@@ -2449,7 +3212,15 @@ const file_app_chat_rpc_chat_proto_rawDesc = "" +
 	"\fGetGroupInfo\x12\x15.chat.GetGroupInfoReq\x1a\x16.chat.GetGroupInfoResp\x12F\n" +
 	"\x0fGetGroupMembers\x12\x18.chat.GetGroupMembersReq\x1a\x19.chat.GetGroupMembersResp\x12@\n" +
 	"\rGetUserGroups\x12\x16.chat.GetUserGroupsReq\x1a\x17.chat.GetUserGroupsResp\x12U\n" +
-	"\x14GetGroupByActivityId\x12\x1d.chat.GetGroupByActivityIdReq\x1a\x1e.chat.GetGroupByActivityIdResp\x12:\n" +
+	"\x14GetGroupByActivityId\x12\x1d.chat.GetGroupByActivityIdReq\x1a\x1e.chat.GetGroupByActivityIdResp\x127\n" +
+	"\n" +
+	"MuteMember\x12\x13.chat.MuteMemberReq\x1a\x14.chat.MuteMemberResp\x12.\n" +
+	"\aMuteAll\x12\x10.chat.MuteAllReq\x1a\x11.chat.MuteAllResp\x127\n" +
+	"\n" +
+	"KickMember\x12\x13.chat.KickMemberReq\x1a\x14.chat.KickMemberResp\x12F\n" +
+	"\x0fSetAnnouncement\x12\x18.chat.SetAnnouncementReq\x1a\x19.chat.SetAnnouncementResp\x12@\n" +
+	"\rSetGroupAdmin\x12\x16.chat.SetGroupAdminReq\x1a\x17.chat.SetGroupAdminResp\x12R\n" +
+	"\x13CheckSendPermission\x12\x1c.chat.CheckSendPermissionReq\x1a\x1d.chat.CheckSendPermissionResp\x12:\n" +
 	"\vSaveMessage\x12\x14.chat.SaveMessageReq\x1a\x15.chat.SaveMessageResp\x12L\n" +
 	"\x11GetMessageHistory\x12\x1a.chat.GetMessageHistoryReq\x1a\x1b.chat.GetMessageHistoryResp\x12O\n" +
 	"\x12GetOfflineMessages\x12\x1b.chat.GetOfflineMessagesReq\x1a\x1c.chat.GetOfflineMessagesResp\x12O\n" +
@@ -2471,7 +3242,7 @@ func file_app_chat_rpc_chat_proto_rawDescGZIP() []byte {
 	return file_app_chat_rpc_chat_proto_rawDescData
 }
 
-var file_app_chat_rpc_chat_proto_msgTypes = make([]protoimpl.MessageInfo, 37)
+var file_app_chat_rpc_chat_proto_msgTypes = make([]protoimpl.MessageInfo, 49)
 var file_app_chat_rpc_chat_proto_goTypes = []any{
 	(*CreateGroupReq)(nil),           // 0: chat.CreateGroupReq
 	(*CreateGroupResp)(nil),          // 1: chat.CreateGroupResp
@@ -2492,33 +3263,45 @@ var file_app_chat_rpc_chat_proto_goTypes = []any{
 	(*GetUserGroupsResp)(nil),        // 16: chat.GetUserGroupsResp
 	(*GetGroupByActivityIdReq)(nil),  // 17: chat.GetGroupByActivityIdReq
 	(*GetGroupByActivityIdResp)(nil), // 18: chat.GetGroupByActivityIdResp
-	(*SaveMessageReq)(nil),           // 19: chat.SaveMessageReq
-	(*SaveMessageResp)(nil),          // 20: chat.SaveMessageResp
-	(*GetMessageHistoryReq)(nil),     // 21: chat.GetMessageHistoryReq
-	(*Message)(nil),                  // 22: chat.Message
-	(*GetMessageHistoryResp)(nil),    // 23: chat.GetMessageHistoryResp
-	(*GetOfflineMessagesReq)(nil),    // 24: chat.GetOfflineMessagesReq
-	(*GetOfflineMessagesResp)(nil),   // 25: chat.GetOfflineMessagesResp
-	(*CreateNotificationReq)(nil),    // 26: chat.CreateNotificationReq
-	(*CreateNotificationResp)(nil),   // 27: chat.CreateNotificationResp
-	(*GetNotificationsReq)(nil),      // 28: chat.GetNotificationsReq
-	(*Notification)(nil),             // 29: chat.Notification
-	(*GetNotificationsResp)(nil),     // 30: chat.GetNotificationsResp
-	(*MarkNotificationReadReq)(nil),  // 31: chat.MarkNotificationReadReq
-	(*MarkNotificationReadResp)(nil), // 32: chat.MarkNotificationReadResp
-	(*GetUnreadCountReq)(nil),        // 33: chat.GetUnreadCountReq
-	(*GetUnreadCountResp)(nil),       // 34: chat.GetUnreadCountResp
-	(*MarkAllReadReq)(nil),           // 35: chat.MarkAllReadReq
-	(*MarkAllReadResp)(nil),          // 36: chat.MarkAllReadResp
+	(*MuteMemberReq)(nil),            // 19: chat.MuteMemberReq
+	(*MuteMemberResp)(nil),           // 20: chat.MuteMemberResp
+	(*MuteAllReq)(nil),               // 21: chat.MuteAllReq
+	(*MuteAllResp)(nil),              // 22: chat.MuteAllResp
+	(*KickMemberReq)(nil),            // 23: chat.KickMemberReq
+	(*KickMemberResp)(nil),           // 24: chat.KickMemberResp
+	(*SetAnnouncementReq)(nil),       // 25: chat.SetAnnouncementReq
+	(*SetAnnouncementResp)(nil),      // 26: chat.SetAnnouncementResp
+	(*SetGroupAdminReq)(nil),         // 27: chat.SetGroupAdminReq
+	(*SetGroupAdminResp)(nil),        // 28: chat.SetGroupAdminResp
+	(*CheckSendPermissionReq)(nil),   // 29: chat.CheckSendPermissionReq
+	(*CheckSendPermissionResp)(nil),  // 30: chat.CheckSendPermissionResp
+	(*SaveMessageReq)(nil),           // 31: chat.SaveMessageReq
+	(*SaveMessageResp)(nil),          // 32: chat.SaveMessageResp
+	(*GetMessageHistoryReq)(nil),     // 33: chat.GetMessageHistoryReq
+	(*Message)(nil),                  // 34: chat.Message
+	(*GetMessageHistoryResp)(nil),    // 35: chat.GetMessageHistoryResp
+	(*GetOfflineMessagesReq)(nil),    // 36: chat.GetOfflineMessagesReq
+	(*GetOfflineMessagesResp)(nil),   // 37: chat.GetOfflineMessagesResp
+	(*CreateNotificationReq)(nil),    // 38: chat.CreateNotificationReq
+	(*CreateNotificationResp)(nil),   // 39: chat.CreateNotificationResp
+	(*GetNotificationsReq)(nil),      // 40: chat.GetNotificationsReq
+	(*Notification)(nil),             // 41: chat.Notification
+	(*GetNotificationsResp)(nil),     // 42: chat.GetNotificationsResp
+	(*MarkNotificationReadReq)(nil),  // 43: chat.MarkNotificationReadReq
+	(*MarkNotificationReadResp)(nil), // 44: chat.MarkNotificationReadResp
+	(*GetUnreadCountReq)(nil),        // 45: chat.GetUnreadCountReq
+	(*GetUnreadCountResp)(nil),       // 46: chat.GetUnreadCountResp
+	(*MarkAllReadReq)(nil),           // 47: chat.MarkAllReadReq
+	(*MarkAllReadResp)(nil),          // 48: chat.MarkAllReadResp
 }
 var file_app_chat_rpc_chat_proto_depIdxs = []int32{
 	9,  // 0: chat.GetGroupInfoResp.group:type_name -> chat.GroupInfo
 	12, // 1: chat.GetGroupMembersResp.members:type_name -> chat.GroupMember
 	15, // 2: chat.GetUserGroupsResp.groups:type_name -> chat.UserGroupInfo
 	9,  // 3: chat.GetGroupByActivityIdResp.group:type_name -> chat.GroupInfo
-	22, // 4: chat.GetMessageHistoryResp.messages:type_name -> chat.Message
-	22, // 5: chat.GetOfflineMessagesResp.messages:type_name -> chat.Message
-	29, // 6: chat.GetNotificationsResp.notifications:type_name -> chat.Notification
+	34, // 4: chat.GetMessageHistoryResp.messages:type_name -> chat.Message
+	34, // 5: chat.GetOfflineMessagesResp.messages:type_name -> chat.Message
+	41, // 6: chat.GetNotificationsResp.notifications:type_name -> chat.Notification
 	0,  // 7: chat.ChatService.CreateGroup:input_type -> chat.CreateGroupReq
 	2,  // 8: chat.ChatService.AddGroupMember:input_type -> chat.AddGroupMemberReq
 	4,  // 9: chat.ChatService.RemoveGroupMember:input_type -> chat.RemoveGroupMemberReq
@@ -2527,32 +3310,44 @@ var file_app_chat_rpc_chat_proto_depIdxs = []int32{
 	11, // 12: chat.ChatService.GetGroupMembers:input_type -> chat.GetGroupMembersReq
 	14, // 13: chat.ChatService.GetUserGroups:input_type -> chat.GetUserGroupsReq
 	17, // 14: chat.ChatService.GetGroupByActivityId:input_type -> chat.GetGroupByActivityIdReq
-	19, // 15: chat.ChatService.SaveMessage:input_type -> chat.SaveMessageReq
-	21, // 16: chat.ChatService.GetMessageHistory:input_type -> chat.GetMessageHistoryReq
-	24, // 17: chat.ChatService.GetOfflineMessages:input_type -> chat.GetOfflineMessagesReq
-	26, // 18: chat.ChatService.CreateNotification:input_type -> chat.CreateNotificationReq
-	28, // 19: chat.ChatService.GetNotifications:input_type -> chat.GetNotificationsReq
-	31, // 20: chat.ChatService.MarkNotificationRead:input_type -> chat.MarkNotificationReadReq
-	33, // 21: chat.ChatService.GetUnreadCount:input_type -> chat.GetUnreadCountReq
-	35, // 22: chat.ChatService.MarkAllRead:input_type -> chat.MarkAllReadReq
-	1,  // 23: chat.ChatService.CreateGroup:output_type -> chat.CreateGroupResp
-	3,  // 24: chat.ChatService.AddGroupMember:output_type -> chat.AddGroupMemberResp
-	5,  // 25: chat.ChatService.RemoveGroupMember:output_type -> chat.RemoveGroupMemberResp
-	7,  // 26: chat.ChatService.DisbandGroup:output_type -> chat.DisbandGroupResp
-	10, // 27: chat.ChatService.GetGroupInfo:output_type -> chat.GetGroupInfoResp
-	13, // 28: chat.ChatService.GetGroupMembers:output_type -> chat.GetGroupMembersResp
-	16, // 29: chat.ChatService.GetUserGroups:output_type -> chat.GetUserGroupsResp
-	18, // 30: chat.ChatService.GetGroupByActivityId:output_type -> chat.GetGroupByActivityIdResp
-	20, // 31: chat.ChatService.SaveMessage:output_type -> chat.SaveMessageResp
-	23, // 32: chat.ChatService.GetMessageHistory:output_type -> chat.GetMessageHistoryResp
-	25, // 33: chat.ChatService.GetOfflineMessages:output_type -> chat.GetOfflineMessagesResp
-	27, // 34: chat.ChatService.CreateNotification:output_type -> chat.CreateNotificationResp
-	30, // 35: chat.ChatService.GetNotifications:output_type -> chat.GetNotificationsResp
-	32, // 36: chat.ChatService.MarkNotificationRead:output_type -> chat.MarkNotificationReadResp
-	34, // 37: chat.ChatService.GetUnreadCount:output_type -> chat.GetUnreadCountResp
-	36, // 38: chat.ChatService.MarkAllRead:output_type -> chat.MarkAllReadResp
-	23, // [23:39] is the sub-list for method output_type
-	7,  // [7:23] is the sub-list for method input_type
+	19, // 15: chat.ChatService.MuteMember:input_type -> chat.MuteMemberReq
+	21, // 16: chat.ChatService.MuteAll:input_type -> chat.MuteAllReq
+	23, // 17: chat.ChatService.KickMember:input_type -> chat.KickMemberReq
+	25, // 18: chat.ChatService.SetAnnouncement:input_type -> chat.SetAnnouncementReq
+	27, // 19: chat.ChatService.SetGroupAdmin:input_type -> chat.SetGroupAdminReq
+	29, // 20: chat.ChatService.CheckSendPermission:input_type -> chat.CheckSendPermissionReq
+	31, // 21: chat.ChatService.SaveMessage:input_type -> chat.SaveMessageReq
+	33, // 22: chat.ChatService.GetMessageHistory:input_type -> chat.GetMessageHistoryReq
+	36, // 23: chat.ChatService.GetOfflineMessages:input_type -> chat.GetOfflineMessagesReq
+	38, // 24: chat.ChatService.CreateNotification:input_type -> chat.CreateNotificationReq
+	40, // 25: chat.ChatService.GetNotifications:input_type -> chat.GetNotificationsReq
+	43, // 26: chat.ChatService.MarkNotificationRead:input_type -> chat.MarkNotificationReadReq
+	45, // 27: chat.ChatService.GetUnreadCount:input_type -> chat.GetUnreadCountReq
+	47, // 28: chat.ChatService.MarkAllRead:input_type -> chat.MarkAllReadReq
+	1,  // 29: chat.ChatService.CreateGroup:output_type -> chat.CreateGroupResp
+	3,  // 30: chat.ChatService.AddGroupMember:output_type -> chat.AddGroupMemberResp
+	5,  // 31: chat.ChatService.RemoveGroupMember:output_type -> chat.RemoveGroupMemberResp
+	7,  // 32: chat.ChatService.DisbandGroup:output_type -> chat.DisbandGroupResp
+	10, // 33: chat.ChatService.GetGroupInfo:output_type -> chat.GetGroupInfoResp
+	13, // 34: chat.ChatService.GetGroupMembers:output_type -> chat.GetGroupMembersResp
+	16, // 35: chat.ChatService.GetUserGroups:output_type -> chat.GetUserGroupsResp
+	18, // 36: chat.ChatService.GetGroupByActivityId:output_type -> chat.GetGroupByActivityIdResp
+	20, // 37: chat.ChatService.MuteMember:output_type -> chat.MuteMemberResp
+	22, // 38: chat.ChatService.MuteAll:output_type -> chat.MuteAllResp
+	24, // 39: chat.ChatService.KickMember:output_type -> chat.KickMemberResp
+	26, // 40: chat.ChatService.SetAnnouncement:output_type -> chat.SetAnnouncementResp
+	28, // 41: chat.ChatService.SetGroupAdmin:output_type -> chat.SetGroupAdminResp
+	30, // 42: chat.ChatService.CheckSendPermission:output_type -> chat.CheckSendPermissionResp
+	32, // 43: chat.ChatService.SaveMessage:output_type -> chat.SaveMessageResp
+	35, // 44: chat.ChatService.GetMessageHistory:output_type -> chat.GetMessageHistoryResp
+	37, // 45: chat.ChatService.GetOfflineMessages:output_type -> chat.GetOfflineMessagesResp
+	39, // 46: chat.ChatService.CreateNotification:output_type -> chat.CreateNotificationResp
+	42, // 47: chat.ChatService.GetNotifications:output_type -> chat.GetNotificationsResp
+	44, // 48: chat.ChatService.MarkNotificationRead:output_type -> chat.MarkNotificationReadResp
+	46, // 49: chat.ChatService.GetUnreadCount:output_type -> chat.GetUnreadCountResp
+	48, // 50: chat.ChatService.MarkAllRead:output_type -> chat.MarkAllReadResp
+	29, // [29:51] is the sub-list for method output_type
+	7,  // [7:29] is the sub-list for method input_type
 	7,  // [7:7] is the sub-list for extension type_name
 	7,  // [7:7] is the sub-list for extension extendee
 	0,  // [0:7] is the sub-list for field type_name
@@ -2569,7 +3364,7 @@ func file_app_chat_rpc_chat_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_app_chat_rpc_chat_proto_rawDesc), len(file_app_chat_rpc_chat_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   37,
+			NumMessages:   49,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -27,6 +27,12 @@ const (
 	ChatService_GetGroupMembers_FullMethodName      = "/chat.ChatService/GetGroupMembers"
 	ChatService_GetUserGroups_FullMethodName        = "/chat.ChatService/GetUserGroups"
 	ChatService_GetGroupByActivityId_FullMethodName = "/chat.ChatService/GetGroupByActivityId"
+	ChatService_MuteMember_FullMethodName           = "/chat.ChatService/MuteMember"
+	ChatService_MuteAll_FullMethodName              = "/chat.ChatService/MuteAll"
+	ChatService_KickMember_FullMethodName           = "/chat.ChatService/KickMember"
+	ChatService_SetAnnouncement_FullMethodName      = "/chat.ChatService/SetAnnouncement"
+	ChatService_SetGroupAdmin_FullMethodName        = "/chat.ChatService/SetGroupAdmin"
+	ChatService_CheckSendPermission_FullMethodName  = "/chat.ChatService/CheckSendPermission"
 	ChatService_SaveMessage_FullMethodName          = "/chat.ChatService/SaveMessage"
 	ChatService_GetMessageHistory_FullMethodName    = "/chat.ChatService/GetMessageHistory"
 	ChatService_GetOfflineMessages_FullMethodName   = "/chat.ChatService/GetOfflineMessages"
@@ -65,6 +71,24 @@ type ChatServiceClient interface {
 	// GetGroupByActivityId 通过活动ID获取群聊
 	// 根据活动ID查询对应的群聊信息
 	GetGroupByActivityId(ctx context.Context, in *GetGroupByActivityIdReq, opts ...grpc.CallOption) (*GetGroupByActivityIdResp, error)
+	// MuteMember 禁言/解除禁言成员
+	// 群主/管理员操作，duration 为 0 表示解除禁言
+	MuteMember(ctx context.Context, in *MuteMemberReq, opts ...grpc.CallOption) (*MuteMemberResp, error)
+	// MuteAll 开启/关闭全员禁言
+	// 全员禁言期间仅群主和管理员可以发言
+	MuteAll(ctx context.Context, in *MuteAllReq, opts ...grpc.CallOption) (*MuteAllResp, error)
+	// KickMember 踢出群成员
+	// 群主可踢出管理员和普通成员，管理员只能踢出普通成员
+	KickMember(ctx context.Context, in *KickMemberReq, opts ...grpc.CallOption) (*KickMemberResp, error)
+	// SetAnnouncement 设置群公告
+	// 群主/管理员操作，设置后向群内广播
+	SetAnnouncement(ctx context.Context, in *SetAnnouncementReq, opts ...grpc.CallOption) (*SetAnnouncementResp, error)
+	// SetGroupAdmin 设置/取消管理员
+	// 仅群主可操作
+	SetGroupAdmin(ctx context.Context, in *SetGroupAdminReq, opts ...grpc.CallOption) (*SetGroupAdminResp, error)
+	// CheckSendPermission 校验发言权限
+	// 用于 WebSocket 服务发送消息前校验成员身份与禁言状态
+	CheckSendPermission(ctx context.Context, in *CheckSendPermissionReq, opts ...grpc.CallOption) (*CheckSendPermissionResp, error)
 	// SaveMessage 保存消息
 	// 用于 WebSocket 服务发送消息时持久化到数据库
 	SaveMessage(ctx context.Context, in *SaveMessageReq, opts ...grpc.CallOption) (*SaveMessageResp, error)
@@ -179,6 +203,66 @@ func (c *chatServiceClient) GetGroupByActivityId(ctx context.Context, in *GetGro
 	return out, nil
 }
 
+func (c *chatServiceClient) MuteMember(ctx context.Context, in *MuteMemberReq, opts ...grpc.CallOption) (*MuteMemberResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MuteMemberResp)
+	err := c.cc.Invoke(ctx, ChatService_MuteMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) MuteAll(ctx context.Context, in *MuteAllReq, opts ...grpc.CallOption) (*MuteAllResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MuteAllResp)
+	err := c.cc.Invoke(ctx, ChatService_MuteAll_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) KickMember(ctx context.Context, in *KickMemberReq, opts ...grpc.CallOption) (*KickMemberResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(KickMemberResp)
+	err := c.cc.Invoke(ctx, ChatService_KickMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) SetAnnouncement(ctx context.Context, in *SetAnnouncementReq, opts ...grpc.CallOption) (*SetAnnouncementResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetAnnouncementResp)
+	err := c.cc.Invoke(ctx, ChatService_SetAnnouncement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) SetGroupAdmin(ctx context.Context, in *SetGroupAdminReq, opts ...grpc.CallOption) (*SetGroupAdminResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetGroupAdminResp)
+	err := c.cc.Invoke(ctx, ChatService_SetGroupAdmin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) CheckSendPermission(ctx context.Context, in *CheckSendPermissionReq, opts ...grpc.CallOption) (*CheckSendPermissionResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckSendPermissionResp)
+	err := c.cc.Invoke(ctx, ChatService_CheckSendPermission_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *chatServiceClient) SaveMessage(ctx context.Context, in *SaveMessageReq, opts ...grpc.CallOption) (*SaveMessageResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SaveMessageResp)
@@ -287,6 +371,24 @@ type ChatServiceServer interface {
 	// GetGroupByActivityId 通过活动ID获取群聊
 	// 根据活动ID查询对应的群聊信息
 	GetGroupByActivityId(context.Context, *GetGroupByActivityIdReq) (*GetGroupByActivityIdResp, error)
+	// MuteMember 禁言/解除禁言成员
+	// 群主/管理员操作，duration 为 0 表示解除禁言
+	MuteMember(context.Context, *MuteMemberReq) (*MuteMemberResp, error)
+	// MuteAll 开启/关闭全员禁言
+	// 全员禁言期间仅群主和管理员可以发言
+	MuteAll(context.Context, *MuteAllReq) (*MuteAllResp, error)
+	// KickMember 踢出群成员
+	// 群主可踢出管理员和普通成员，管理员只能踢出普通成员
+	KickMember(context.Context, *KickMemberReq) (*KickMemberResp, error)
+	// SetAnnouncement 设置群公告
+	// 群主/管理员操作，设置后向群内广播
+	SetAnnouncement(context.Context, *SetAnnouncementReq) (*SetAnnouncementResp, error)
+	// SetGroupAdmin 设置/取消管理员
+	// 仅群主可操作
+	SetGroupAdmin(context.Context, *SetGroupAdminReq) (*SetGroupAdminResp, error)
+	// CheckSendPermission 校验发言权限
+	// 用于 WebSocket 服务发送消息前校验成员身份与禁言状态
+	CheckSendPermission(context.Context, *CheckSendPermissionReq) (*CheckSendPermissionResp, error)
 	// SaveMessage 保存消息
 	// 用于 WebSocket 服务发送消息时持久化到数据库
 	SaveMessage(context.Context, *SaveMessageReq) (*SaveMessageResp, error)
@@ -345,6 +447,24 @@ func (UnimplementedChatServiceServer) GetUserGroups(context.Context, *GetUserGro
 func (UnimplementedChatServiceServer) GetGroupByActivityId(context.Context, *GetGroupByActivityIdReq) (*GetGroupByActivityIdResp, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetGroupByActivityId not implemented")
 }
+func (UnimplementedChatServiceServer) MuteMember(context.Context, *MuteMemberReq) (*MuteMemberResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method MuteMember not implemented")
+}
+func (UnimplementedChatServiceServer) MuteAll(context.Context, *MuteAllReq) (*MuteAllResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method MuteAll not implemented")
+}
+func (UnimplementedChatServiceServer) KickMember(context.Context, *KickMemberReq) (*KickMemberResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method KickMember not implemented")
+}
+func (UnimplementedChatServiceServer) SetAnnouncement(context.Context, *SetAnnouncementReq) (*SetAnnouncementResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetAnnouncement not implemented")
+}
+func (UnimplementedChatServiceServer) SetGroupAdmin(context.Context, *SetGroupAdminReq) (*SetGroupAdminResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetGroupAdmin not implemented")
+}
+func (UnimplementedChatServiceServer) CheckSendPermission(context.Context, *CheckSendPermissionReq) (*CheckSendPermissionResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method CheckSendPermission not implemented")
+}
 func (UnimplementedChatServiceServer) SaveMessage(context.Context, *SaveMessageReq) (*SaveMessageResp, error) {
 	return nil, status.Error(codes.Unimplemented, "method SaveMessage not implemented")
 }
@@ -534,6 +654,114 @@ func _ChatService_GetGroupByActivityId_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ChatService_MuteMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MuteMemberReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).MuteMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_MuteMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).MuteMember(ctx, req.(*MuteMemberReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_MuteAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MuteAllReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).MuteAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_MuteAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).MuteAll(ctx, req.(*MuteAllReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_KickMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KickMemberReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).KickMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_KickMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).KickMember(ctx, req.(*KickMemberReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_SetAnnouncement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAnnouncementReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).SetAnnouncement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_SetAnnouncement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).SetAnnouncement(ctx, req.(*SetAnnouncementReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_SetGroupAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGroupAdminReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).SetGroupAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_SetGroupAdmin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).SetGroupAdmin(ctx, req.(*SetGroupAdminReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_CheckSendPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckSendPermissionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).CheckSendPermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_CheckSendPermission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).CheckSendPermission(ctx, req.(*CheckSendPermissionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ChatService_SaveMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SaveMessageReq)
 	if err := dec(in); err != nil {
@@ -717,6 +945,30 @@ var ChatService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetGroupByActivityId",
 			Handler:    _ChatService_GetGroupByActivityId_Handler,
 		},
+		{
+			MethodName: "MuteMember",
+			Handler:    _ChatService_MuteMember_Handler,
+		},
+		{
+			MethodName: "MuteAll",
+			Handler:    _ChatService_MuteAll_Handler,
+		},
+		{
+			MethodName: "KickMember",
+			Handler:    _ChatService_KickMember_Handler,
+		},
+		{
+			MethodName: "SetAnnouncement",
+			Handler:    _ChatService_SetAnnouncement_Handler,
+		},
+		{
+			MethodName: "SetGroupAdmin",
+			Handler:    _ChatService_SetGroupAdmin_Handler,
+		},
+		{
+			MethodName: "CheckSendPermission",
+			Handler:    _ChatService_CheckSendPermission_Handler,
+		},
 		{
 			MethodName: "SaveMessage",
 			Handler:    _ChatService_SaveMessage_Handler,
@@ -16,6 +16,8 @@ import (
 type (
 	AddGroupMemberReq        = chat.AddGroupMemberReq
 	AddGroupMemberResp       = chat.AddGroupMemberResp
+	CheckSendPermissionReq   = chat.CheckSendPermissionReq
+	CheckSendPermissionResp  = chat.CheckSendPermissionResp
 	CreateGroupReq           = chat.CreateGroupReq
 	CreateGroupResp          = chat.CreateGroupResp
 	CreateNotificationReq    = chat.CreateNotificationReq
@@ -40,16 +42,26 @@ type (
 	GetUserGroupsResp        = chat.GetUserGroupsResp
 	GroupInfo                = chat.GroupInfo
 	GroupMember              = chat.GroupMember
+	KickMemberReq            = chat.KickMemberReq
+	KickMemberResp           = chat.KickMemberResp
 	MarkAllReadReq           = chat.MarkAllReadReq
 	MarkAllReadResp          = chat.MarkAllReadResp
 	MarkNotificationReadReq  = chat.MarkNotificationReadReq
 	MarkNotificationReadResp = chat.MarkNotificationReadResp
 	Message                  = chat.Message
+	MuteAllReq               = chat.MuteAllReq
+	MuteAllResp              = chat.MuteAllResp
+	MuteMemberReq            = chat.MuteMemberReq
+	MuteMemberResp           = chat.MuteMemberResp
 	Notification             = chat.Notification
 	RemoveGroupMemberReq     = chat.RemoveGroupMemberReq
 	RemoveGroupMemberResp    = chat.RemoveGroupMemberResp
 	SaveMessageReq           = chat.SaveMessageReq
 	SaveMessageResp          = chat.SaveMessageResp
+	SetAnnouncementReq       = chat.SetAnnouncementReq
+	SetAnnouncementResp      = chat.SetAnnouncementResp
+	SetGroupAdminReq         = chat.SetGroupAdminReq
+	SetGroupAdminResp        = chat.SetGroupAdminResp
 	UserGroupInfo            = chat.UserGroupInfo
 
 	ChatService interface {
@@ -69,6 +81,18 @@ type (
 		GetUserGroups(ctx context.Context, in *GetUserGroupsReq, opts ...grpc.CallOption) (*GetUserGroupsResp, error)
 		// GetGroupByActivityId 通过活动ID获取群聊
 		GetGroupByActivityId(ctx context.Context, in *GetGroupByActivityIdReq, opts ...grpc.CallOption) (*GetGroupByActivityIdResp, error)
+		// MuteMember 禁言/解除禁言成员
+		MuteMember(ctx context.Context, in *MuteMemberReq, opts ...grpc.CallOption) (*MuteMemberResp, error)
+		// MuteAll 开启/关闭全员禁言
+		MuteAll(ctx context.Context, in *MuteAllReq, opts ...grpc.CallOption) (*MuteAllResp, error)
+		// KickMember 踢出群成员
+		KickMember(ctx context.Context, in *KickMemberReq, opts ...grpc.CallOption) (*KickMemberResp, error)
+		// SetAnnouncement 设置群公告
+		SetAnnouncement(ctx context.Context, in *SetAnnouncementReq, opts ...grpc.CallOption) (*SetAnnouncementResp, error)
+		// SetGroupAdmin 设置/取消管理员
+		SetGroupAdmin(ctx context.Context, in *SetGroupAdminReq, opts ...grpc.CallOption) (*SetGroupAdminResp, error)
+		// CheckSendPermission 校验发言权限
+		CheckSendPermission(ctx context.Context, in *CheckSendPermissionReq, opts ...grpc.CallOption) (*CheckSendPermissionResp, error)
 		// SaveMessage 保存消息
 		SaveMessage(ctx context.Context, in *SaveMessageReq, opts ...grpc.CallOption) (*SaveMessageResp, error)
 		// GetMessageHistory 获取历史消息
@@ -146,6 +170,42 @@ func (m *defaultChatService) GetGroupByActivityId(ctx context.Context, in *GetGr
 	return client.GetGroupByActivityId(ctx, in, opts...)
 }
 
+// MuteMember 禁言/解除禁言成员
+func (m *defaultChatService) MuteMember(ctx context.Context, in *MuteMemberReq, opts ...grpc.CallOption) (*MuteMemberResp, error) {
+	client := chat.NewChatServiceClient(m.cli.Conn())
+	return client.MuteMember(ctx, in, opts...)
+}
+
+// MuteAll 开启/关闭全员禁言
+func (m *defaultChatService) MuteAll(ctx context.Context, in *MuteAllReq, opts ...grpc.CallOption) (*MuteAllResp, error) {
+	client := chat.NewChatServiceClient(m.cli.Conn())
+	return client.MuteAll(ctx, in, opts...)
+}
+
+// KickMember 踢出群成员
+func (m *defaultChatService) KickMember(ctx context.Context, in *KickMemberReq, opts ...grpc.CallOption) (*KickMemberResp, error) {
+	client := chat.NewChatServiceClient(m.cli.Conn())
+	return client.KickMember(ctx, in, opts...)
+}
+
+// SetAnnouncement 设置群公告
+func (m *defaultChatService) SetAnnouncement(ctx context.Context, in *SetAnnouncementReq, opts ...grpc.CallOption) (*SetAnnouncementResp, error) {
+	client := chat.NewChatServiceClient(m.cli.Conn())
+	return client.SetAnnouncement(ctx, in, opts...)
+}
+
+// SetGroupAdmin 设置/取消管理员
+func (m *defaultChatService) SetGroupAdmin(ctx context.Context, in *SetGroupAdminReq, opts ...grpc.CallOption) (*SetGroupAdminResp, error) {
+	client := chat.NewChatServiceClient(m.cli.Conn())
+	return client.SetGroupAdmin(ctx, in, opts...)
+}
+
+// CheckSendPermission 校验发言权限
+func (m *defaultChatService) CheckSendPermission(ctx context.Context, in *CheckSendPermissionReq, opts ...grpc.CallOption) (*CheckSendPermissionResp, error) {
+	client := chat.NewChatServiceClient(m.cli.Conn())
+	return client.CheckSendPermission(ctx, in, opts...)
+}
+
 // SaveMessage 保存消息
 func (m *defaultChatService) SaveMessage(ctx context.Context, in *SaveMessageReq, opts ...grpc.CallOption) (*SaveMessageResp, error) {
 	client := chat.NewChatServiceClient(m.cli.Conn())
@@ -22,6 +22,17 @@ type Config struct {
 
 	// 消息中间件配置
 	Messaging MessageConf
+
+	// 平台统计上报配置（可选，默认开启）
+	Stats StatsConf `json:",optional"`
+}
+
+// StatsConf 平台统计上报配置
+// 每轮统计当天及之前 BackfillDays 天的消息量，上报到用户服务的平台统计表
+type StatsConf struct {
+	Enabled         bool `json:",default=true"` // 是否启用
+	IntervalSeconds int  `json:",default=3600"` // 上报间隔（秒）
+	BackfillDays    int  `json:",default=2"`    // 回溯天数（不含当天）
 }
 
 // RetryConfig 重试配置
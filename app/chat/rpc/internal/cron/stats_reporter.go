@@ -0,0 +1,114 @@
+package cron
+
+import (
+	"context"
+	"time"
+
+	"activity-platform/app/chat/rpc/internal/config"
+	"activity-platform/app/chat/rpc/internal/svc"
+	"activity-platform/app/user/rpc/pb/pb"
+	"activity-platform/common/constants"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// messageTypeDimensions 消息类型与统计维度的对应关系（未知类型只计入总数）
+var messageTypeDimensions = map[int8]string{
+	1: "text",
+	2: "image",
+}
+
+// StatsReporter 平台统计上报任务
+//
+// 按天统计消息量（总数及按消息类型），通过 User 服务 PlatformStatsService.ReportDailyStats
+// 写入平台统计表，供管理后台运营报表查询。
+// 上报按 日期 + 指标 整体替换，多实例重复执行结果一致，因此不加分布式锁
+type StatsReporter struct {
+	svcCtx *svc.ServiceContext
+	conf   config.StatsConf
+	stopCh chan struct{}
+}
+
+// NewStatsReporter 创建平台统计上报任务
+func NewStatsReporter(svcCtx *svc.ServiceContext, conf config.StatsConf) *StatsReporter {
+	if conf.IntervalSeconds <= 0 {
+		conf.IntervalSeconds = 3600
+	}
+	if conf.BackfillDays < 0 {
+		conf.BackfillDays = 0
+	}
+	return &StatsReporter{
+		svcCtx: svcCtx,
+		conf:   conf,
+		stopCh: make(chan struct{}),
+	}
+}
+
+// Start 启动上报任务（启动后立即执行一次）
+func (r *StatsReporter) Start() {
+	go func() {
+		ticker := time.NewTicker(time.Duration(r.conf.IntervalSeconds) * time.Second)
+		defer ticker.Stop()
+
+		r.reportRecent()
+		for {
+			select {
+			case <-r.stopCh:
+				return
+			case <-ticker.C:
+				r.reportRecent()
+			}
+		}
+	}()
+	logx.Infof("[StatsReporter] 启动成功，上报间隔: %ds，回溯天数: %d", r.conf.IntervalSeconds, r.conf.BackfillDays)
+}
+
+// Stop 停止上报任务
+func (r *StatsReporter) Stop() {
+	close(r.stopCh)
+	logx.Info("[StatsReporter] 已停止")
+}
+
+// reportRecent 上报当天及之前 BackfillDays 天的消息量（单个日期失败不影响其他日期）
+func (r *StatsReporter) reportRecent() {
+	ctx := context.Background()
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	for i := r.conf.BackfillDays; i >= 0; i-- {
+		day := today.AddDate(0, 0, -i)
+		if err := r.reportDay(ctx, day); err != nil {
+			logx.Errorf("[StatsReporter] 上报消息量失败: date=%s, err=%v", day.Format(constants.StatsDateLayout), err)
+		}
+	}
+}
+
+// reportDay 统计并上报某一天的消息量（维度为空的行为当天总数）
+func (r *StatsReporter) reportDay(ctx context.Context, day time.Time) error {
+	counts, err := r.svcCtx.MessageModel.CountByTypeBetween(ctx, day, day.AddDate(0, 0, 1))
+	if err != nil {
+		return err
+	}
+
+	items := make([]*pb.StatsMetricItem, 0, len(counts)+1)
+	var total int64
+	for msgType, count := range counts {
+		total += count
+		if dimension, ok := messageTypeDimensions[msgType]; ok {
+			items = append(items, &pb.StatsMetricItem{
+				Metric:    constants.StatsMetricChatMessages,
+				Dimension: dimension,
+				Value:     float64(count),
+			})
+		}
+	}
+	items = append(items, &pb.StatsMetricItem{Metric: constants.StatsMetricChatMessages, Value: float64(total)})
+
+	_, err = r.svcCtx.UserStatsRpc.ReportDailyStats(ctx, &pb.ReportDailyStatsReq{
+		Source:   constants.StatsSourceChat,
+		StatDate: day.Format(constants.StatsDateLayout),
+		Metrics:  []string{constants.StatsMetricChatMessages},
+		Items:    items,
+	})
+	return err
+}
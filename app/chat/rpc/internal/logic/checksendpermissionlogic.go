@@ -0,0 +1,84 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"activity-platform/app/chat/rpc/chat"
+	"activity-platform/app/chat/rpc/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+type CheckSendPermissionLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCheckSendPermissionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CheckSendPermissionLogic {
+	return &CheckSendPermissionLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// CheckSendPermission 校验发言权限
+//
+// 校验顺序：群聊存在且正常 -> 发送者为有效成员 -> 个人禁言 -> 全员禁言（群主/管理员豁免）
+// 业务上的"不允许"通过 allowed=false 返回，只有系统错误才返回 error
+func (l *CheckSendPermissionLogic) CheckSendPermission(in *chat.CheckSendPermissionReq) (*chat.CheckSendPermissionResp, error) {
+	// 1. 参数验证
+	if in.GroupId == "" {
+		return nil, status.Error(codes.InvalidArgument, "群聊ID不能为空")
+	}
+	if in.UserId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "用户ID不能为空")
+	}
+
+	// 2. 检查群聊（已解散的群查询不到）
+	group, err := l.svcCtx.GroupModel.FindOne(l.ctx, in.GroupId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &chat.CheckSendPermissionResp{Reason: "群聊不存在或已解散"}, nil
+		}
+		l.Errorf("查询群聊失败: %v", err)
+		return nil, status.Error(codes.Internal, "查询群聊失败")
+	}
+
+	// 3. 检查成员身份
+	member, err := l.svcCtx.GroupMemberModel.FindOne(l.ctx, in.GroupId, in.UserId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &chat.CheckSendPermissionResp{Reason: "你不是该群成员"}, nil
+		}
+		l.Errorf("查询群成员失败: %v", err)
+		return nil, status.Error(codes.Internal, "查询群成员失败")
+	}
+
+	resp := &chat.CheckSendPermissionResp{
+		IsMember:   true,
+		Role:       int32(member.Role),
+		MutedUntil: mutedUntilUnix(member, time.Now()),
+	}
+
+	// 4. 个人禁言
+	if resp.MutedUntil > 0 {
+		resp.Reason = "你已被禁言，解除时间 " + time.Unix(resp.MutedUntil, 0).Format("2006-01-02 15:04")
+		return resp, nil
+	}
+
+	// 5. 全员禁言（群主/管理员豁免）
+	if group.AllMuted && !member.IsModerator() {
+		resp.Reason = "群主已开启全员禁言"
+		return resp, nil
+	}
+
+	resp.Allowed = true
+	return resp, nil
+}
@@ -46,15 +46,6 @@ func (l *GetGroupByActivityIdLogic) GetGroupByActivityId(in *chat.GetGroupByActi
 
 	// 3. 返回结果
 	return &chat.GetGroupByActivityIdResp{
-		Group: &chat.GroupInfo{
-			GroupId:     group.GroupID,
-			ActivityId:  group.ActivityID,
-			Name:        group.Name,
-			OwnerId:     group.OwnerID,
-			Status:      int32(group.Status),
-			MaxMembers:  group.MaxMembers,
-			MemberCount: group.MemberCount,
-			CreatedAt:   group.CreatedAt.Unix(),
-		},
+		Group: buildGroupInfo(group),
 	}, nil
 }
@@ -46,15 +46,6 @@ func (l *GetGroupInfoLogic) GetGroupInfo(in *chat.GetGroupInfoReq) (*chat.GetGro
 
 	// 3. 构造响应
 	return &chat.GetGroupInfoResp{
-		Group: &chat.GroupInfo{
-			GroupId:     group.GroupID,
-			ActivityId:  group.ActivityID,
-			Name:        group.Name,
-			OwnerId:     group.OwnerID,
-			Status:      int32(group.Status),
-			MaxMembers:  group.MaxMembers,
-			MemberCount: group.MemberCount,
-			CreatedAt:   group.CreatedAt.Unix(),
-		},
+		Group: buildGroupInfo(group),
 	}, nil
 }
@@ -2,6 +2,7 @@ package logic
 
 import (
 	"context"
+	"time"
 
 	"activity-platform/app/chat/rpc/chat"
 	"activity-platform/app/chat/rpc/internal/svc"
@@ -53,13 +54,15 @@ func (l *GetGroupMembersLogic) GetGroupMembers(in *chat.GetGroupMembersReq) (*ch
 	}
 
 	// 3. 构造响应
+	now := time.Now()
 	memberList := make([]*chat.GroupMember, 0, len(members))
 	for _, member := range members {
 		memberList = append(memberList, &chat.GroupMember{
-			UserId:   member.UserID,
-			GroupId:  member.GroupID,
-			Role:     int32(member.Role),
-			JoinedAt: member.JoinedAt.Unix(),
+			UserId:     member.UserID,
+			GroupId:    member.GroupID,
+			Role:       int32(member.Role),
+			JoinedAt:   member.JoinedAt.Unix(),
+			MutedUntil: mutedUntilUnix(member, now),
 		})
 	}
 
@@ -0,0 +1,123 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"activity-platform/app/chat/model"
+	"activity-platform/app/chat/rpc/chat"
+	"activity-platform/app/chat/rpc/internal/svc"
+	"activity-platform/common/messaging"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// buildGroupInfo 群聊模型转换为 RPC 响应
+func buildGroupInfo(group *model.Group) *chat.GroupInfo {
+	info := &chat.GroupInfo{
+		GroupId:      group.GroupID,
+		ActivityId:   group.ActivityID,
+		Name:         group.Name,
+		OwnerId:      group.OwnerID,
+		Status:       int32(group.Status),
+		MaxMembers:   group.MaxMembers,
+		MemberCount:  group.MemberCount,
+		CreatedAt:    group.CreatedAt.Unix(),
+		AllMuted:     group.AllMuted,
+		Announcement: group.Announcement,
+	}
+	if group.AnnouncementAt != nil {
+		info.AnnouncementAt = group.AnnouncementAt.Unix()
+	}
+	return info
+}
+
+// mutedUntilUnix 禁言截止时间戳（未禁言或已过期返回 0）
+func mutedUntilUnix(member *model.GroupMember, now time.Time) int64 {
+	if !member.IsMuted(now) {
+		return 0
+	}
+	return member.MutedUntil.Unix()
+}
+
+// loadModerator 查询群聊并校验操作者是否为群主/管理员
+func loadModerator(ctx context.Context, svcCtx *svc.ServiceContext, groupID string, operatorID uint64) (*model.Group, *model.GroupMember, error) {
+	group, err := svcCtx.GroupModel.FindOne(ctx, groupID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, status.Error(codes.NotFound, "群聊不存在")
+		}
+		logx.WithContext(ctx).Errorf("查询群聊失败: %v", err)
+		return nil, nil, status.Error(codes.Internal, "查询群聊失败")
+	}
+
+	operator, err := svcCtx.GroupMemberModel.FindOne(ctx, groupID, operatorID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, status.Error(codes.PermissionDenied, "你不是该群成员")
+		}
+		logx.WithContext(ctx).Errorf("查询群成员失败: %v", err)
+		return nil, nil, status.Error(codes.Internal, "查询群成员失败")
+	}
+	if !operator.IsModerator() {
+		return nil, nil, status.Error(codes.PermissionDenied, "只有群主或管理员可以执行此操作")
+	}
+	return group, operator, nil
+}
+
+// loadManageableTarget 查询目标成员并校验操作者是否有权管理
+func loadManageableTarget(ctx context.Context, svcCtx *svc.ServiceContext, operator *model.GroupMember, userID uint64) (*model.GroupMember, error) {
+	target, err := svcCtx.GroupMemberModel.FindOne(ctx, operator.GroupID, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "用户不在群中")
+		}
+		logx.WithContext(ctx).Errorf("查询群成员失败: %v", err)
+		return nil, status.Error(codes.Internal, "查询群成员失败")
+	}
+	if !operator.CanManage(target) {
+		return nil, status.Error(codes.PermissionDenied, "无权管理该成员")
+	}
+	return target, nil
+}
+
+// publishGroupMemberRemoved 发布群成员移除事件，通知 WS 取消订阅（best-effort，失败只记录日志）
+func publishGroupMemberRemoved(ctx context.Context, svcCtx *svc.ServiceContext, groupID string, userID uint64) {
+	if svcCtx.MsgClient == nil {
+		return
+	}
+	payload, err := json.Marshal(messaging.GroupMemberChangedEvent{
+		GroupID: groupID,
+		UserID:  userID,
+	})
+	if err != nil {
+		logx.WithContext(ctx).Errorf("序列化群成员移除事件失败: %v", err)
+		return
+	}
+	if err := svcCtx.MsgClient.Publish(ctx, messaging.TopicGroupMemberRemoved, payload); err != nil {
+		logx.WithContext(ctx).Errorf("发布群成员移除事件失败: group_id=%s, user_id=%d, err=%v", groupID, userID, err)
+	}
+}
+
+// publishGroupModeration 发布群管理事件（best-effort，失败只记录日志）
+func publishGroupModeration(ctx context.Context, svcCtx *svc.ServiceContext, event messaging.GroupModerationEvent) {
+	if svcCtx.MsgClient == nil {
+		return
+	}
+	if event.Timestamp == 0 {
+		event.Timestamp = time.Now().Unix()
+	}
+	payload, err := json.Marshal(event)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("序列化群管理事件失败: %v", err)
+		return
+	}
+	if err := svcCtx.MsgClient.Publish(ctx, messaging.TopicGroupModeration, payload); err != nil {
+		logx.WithContext(ctx).Errorf("发布群管理事件失败: group_id=%s, action=%s, err=%v", event.GroupID, event.Action, err)
+	}
+}
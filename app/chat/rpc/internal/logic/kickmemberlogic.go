@@ -0,0 +1,90 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+
+	"activity-platform/app/chat/rpc/chat"
+	"activity-platform/app/chat/rpc/internal/svc"
+	"activity-platform/common/messaging"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type KickMemberLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewKickMemberLogic(ctx context.Context, svcCtx *svc.ServiceContext) *KickMemberLogic {
+	return &KickMemberLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// KickMember 踢出群成员
+//
+// 与 RemoveGroupMember（系统调用，用户取消报名时自动退群）的区别：
+//   - 需要校验操作者为群主/管理员
+//   - 踢出后通知 WS 服务取消订阅，并给被踢用户发送通知
+func (l *KickMemberLogic) KickMember(in *chat.KickMemberReq) (*chat.KickMemberResp, error) {
+	// 1. 参数验证
+	if in.GroupId == "" {
+		return nil, status.Error(codes.InvalidArgument, "群聊ID不能为空")
+	}
+	if in.OperatorId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "操作者ID不能为空")
+	}
+	if in.UserId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "用户ID不能为空")
+	}
+
+	// 2. 权限校验
+	group, operator, err := loadModerator(l.ctx, l.svcCtx, in.GroupId, in.OperatorId)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := loadManageableTarget(l.ctx, l.svcCtx, operator, in.UserId); err != nil {
+		return nil, err
+	}
+
+	// 3. 移除群成员（软删除）
+	if err := l.svcCtx.GroupMemberModel.Delete(l.ctx, in.GroupId, in.UserId); err != nil {
+		l.Errorf("踢出群成员失败: %v", err)
+		return nil, status.Error(codes.Internal, "踢出群成员失败")
+	}
+
+	// 4. 更新群成员数量
+	if err := l.svcCtx.GroupModel.IncrementMemberCount(l.ctx, in.GroupId, -1); err != nil {
+		l.Errorf("更新群成员数量失败: %v", err)
+		// 不影响主流程，只记录日志
+	}
+
+	// 5. 通知 WS 服务：取消订阅 + 广播
+	publishGroupMemberRemoved(l.ctx, l.svcCtx, in.GroupId, in.UserId)
+	publishGroupModeration(l.ctx, l.svcCtx, messaging.GroupModerationEvent{
+		GroupID:    in.GroupId,
+		Action:     messaging.GroupModerationKick,
+		OperatorID: in.OperatorId,
+		UserID:     in.UserId,
+	})
+
+	// 6. 通知被踢用户（best-effort）
+	_, err = NewCreateNotificationLogic(l.ctx, l.svcCtx).CreateNotification(&chat.CreateNotificationReq{
+		UserId:  in.UserId,
+		Type:    "group_kicked",
+		Title:   "已被移出群聊",
+		Content: fmt.Sprintf("您已被管理员移出群聊「%s」", group.Name),
+	})
+	if err != nil {
+		l.Errorf("发送移出群聊通知失败: %v", err)
+	}
+
+	l.Infof("群成员已被踢出: group_id=%s, user_id=%d, operator_id=%d", in.GroupId, in.UserId, in.OperatorId)
+	return &chat.KickMemberResp{Success: true}, nil
+}
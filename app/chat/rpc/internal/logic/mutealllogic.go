@@ -0,0 +1,69 @@
+package logic
+
+import (
+	"context"
+
+	"activity-platform/app/chat/rpc/chat"
+	"activity-platform/app/chat/rpc/internal/svc"
+	"activity-platform/common/messaging"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type MuteAllLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewMuteAllLogic(ctx context.Context, svcCtx *svc.ServiceContext) *MuteAllLogic {
+	return &MuteAllLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// MuteAll 开启/关闭全员禁言
+func (l *MuteAllLogic) MuteAll(in *chat.MuteAllReq) (*chat.MuteAllResp, error) {
+	// 1. 参数验证
+	if in.GroupId == "" {
+		return nil, status.Error(codes.InvalidArgument, "群聊ID不能为空")
+	}
+	if in.OperatorId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "操作者ID不能为空")
+	}
+
+	// 2. 权限校验
+	group, _, err := loadModerator(l.ctx, l.svcCtx, in.GroupId, in.OperatorId)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. 状态未变化直接返回
+	if group.AllMuted == in.Muted {
+		return &chat.MuteAllResp{Success: true}, nil
+	}
+
+	// 4. 更新全员禁言状态
+	if err := l.svcCtx.GroupModel.UpdateAllMuted(l.ctx, in.GroupId, in.Muted); err != nil {
+		l.Errorf("更新全员禁言状态失败: %v", err)
+		return nil, status.Error(codes.Internal, "更新全员禁言状态失败")
+	}
+
+	// 5. 通知 WS 服务刷新发言权限
+	action := messaging.GroupModerationUnmuteAll
+	if in.Muted {
+		action = messaging.GroupModerationMuteAll
+	}
+	publishGroupModeration(l.ctx, l.svcCtx, messaging.GroupModerationEvent{
+		GroupID:    in.GroupId,
+		Action:     action,
+		OperatorID: in.OperatorId,
+	})
+
+	l.Infof("全员禁言状态更新: group_id=%s, muted=%v, operator_id=%d", in.GroupId, in.Muted, in.OperatorId)
+	return &chat.MuteAllResp{Success: true}, nil
+}
@@ -0,0 +1,73 @@
+package logic
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"activity-platform/app/chat/rpc/chat"
+	"activity-platform/app/chat/rpc/internal/svc"
+	"activity-platform/common/messaging"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxAnnouncementLength 群公告最大长度（字符）
+const maxAnnouncementLength = 1000
+
+type SetAnnouncementLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSetAnnouncementLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SetAnnouncementLogic {
+	return &SetAnnouncementLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// SetAnnouncement 设置群公告
+func (l *SetAnnouncementLogic) SetAnnouncement(in *chat.SetAnnouncementReq) (*chat.SetAnnouncementResp, error) {
+	// 1. 参数验证
+	if in.GroupId == "" {
+		return nil, status.Error(codes.InvalidArgument, "群聊ID不能为空")
+	}
+	if in.OperatorId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "操作者ID不能为空")
+	}
+	content := strings.TrimSpace(in.Content)
+	if len([]rune(content)) > maxAnnouncementLength {
+		return nil, status.Error(codes.InvalidArgument, "群公告不能超过1000字")
+	}
+
+	// 2. 权限校验
+	if _, _, err := loadModerator(l.ctx, l.svcCtx, in.GroupId, in.OperatorId); err != nil {
+		return nil, err
+	}
+
+	// 3. 更新公告
+	now := time.Now()
+	if err := l.svcCtx.GroupModel.UpdateAnnouncement(l.ctx, in.GroupId, content, now); err != nil {
+		l.Errorf("更新群公告失败: %v", err)
+		return nil, status.Error(codes.Internal, "更新群公告失败")
+	}
+
+	// 4. 向群内广播
+	publishGroupModeration(l.ctx, l.svcCtx, messaging.GroupModerationEvent{
+		GroupID:    in.GroupId,
+		Action:     messaging.GroupModerationAnnouncement,
+		OperatorID: in.OperatorId,
+		Content:    content,
+		Timestamp:  now.Unix(),
+	})
+
+	l.Infof("群公告已更新: group_id=%s, operator_id=%d", in.GroupId, in.OperatorId)
+	return &chat.SetAnnouncementResp{
+		AnnouncementAt: now.Unix(),
+	}, nil
+}
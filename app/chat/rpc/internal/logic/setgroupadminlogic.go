@@ -0,0 +1,94 @@
+package logic
+
+import (
+	"context"
+	"errors"
+
+	"activity-platform/app/chat/model"
+	"activity-platform/app/chat/rpc/chat"
+	"activity-platform/app/chat/rpc/internal/svc"
+	"activity-platform/common/messaging"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+type SetGroupAdminLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSetGroupAdminLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SetGroupAdminLogic {
+	return &SetGroupAdminLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// SetGroupAdmin 设置/取消管理员（仅群主）
+func (l *SetGroupAdminLogic) SetGroupAdmin(in *chat.SetGroupAdminReq) (*chat.SetGroupAdminResp, error) {
+	// 1. 参数验证
+	if in.GroupId == "" {
+		return nil, status.Error(codes.InvalidArgument, "群聊ID不能为空")
+	}
+	if in.OperatorId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "操作者ID不能为空")
+	}
+	if in.UserId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "用户ID不能为空")
+	}
+
+	// 2. 检查群聊是否存在
+	group, err := l.svcCtx.GroupModel.FindOne(l.ctx, in.GroupId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "群聊不存在")
+		}
+		l.Errorf("查询群聊失败: %v", err)
+		return nil, status.Error(codes.Internal, "查询群聊失败")
+	}
+
+	// 3. 检查操作者是否为群主
+	if group.OwnerID != in.OperatorId {
+		return nil, status.Error(codes.PermissionDenied, "只有群主可以设置管理员")
+	}
+
+	// 4. 检查目标成员
+	target, err := l.svcCtx.GroupMemberModel.FindOne(l.ctx, in.GroupId, in.UserId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "用户不在群中")
+		}
+		l.Errorf("查询群成员失败: %v", err)
+		return nil, status.Error(codes.Internal, "查询群成员失败")
+	}
+	if target.Role == model.GroupRoleOwner {
+		return nil, status.Error(codes.FailedPrecondition, "不能修改群主角色")
+	}
+
+	// 5. 更新角色
+	role := model.GroupRoleMember
+	action := messaging.GroupModerationUnsetAdmin
+	if in.IsAdmin {
+		role = model.GroupRoleAdmin
+		action = messaging.GroupModerationSetAdmin
+	}
+	if target.Role != role {
+		if err := l.svcCtx.GroupMemberModel.UpdateRole(l.ctx, in.GroupId, in.UserId, role); err != nil {
+			l.Errorf("更新成员角色失败: %v", err)
+			return nil, status.Error(codes.Internal, "更新成员角色失败")
+		}
+		publishGroupModeration(l.ctx, l.svcCtx, messaging.GroupModerationEvent{
+			GroupID:    in.GroupId,
+			Action:     action,
+			OperatorID: in.OperatorId,
+			UserID:     in.UserId,
+		})
+	}
+
+	return &chat.SetGroupAdminResp{Success: true}, nil
+}
@@ -71,6 +71,42 @@ func (s *ChatServiceServer) GetGroupByActivityId(ctx context.Context, in *chat.G
 	return l.GetGroupByActivityId(in)
 }
 
+// MuteMember 禁言/解除禁言成员
+func (s *ChatServiceServer) MuteMember(ctx context.Context, in *chat.MuteMemberReq) (*chat.MuteMemberResp, error) {
+	l := logic.NewMuteMemberLogic(ctx, s.svcCtx)
+	return l.MuteMember(in)
+}
+
+// MuteAll 开启/关闭全员禁言
+func (s *ChatServiceServer) MuteAll(ctx context.Context, in *chat.MuteAllReq) (*chat.MuteAllResp, error) {
+	l := logic.NewMuteAllLogic(ctx, s.svcCtx)
+	return l.MuteAll(in)
+}
+
+// KickMember 踢出群成员
+func (s *ChatServiceServer) KickMember(ctx context.Context, in *chat.KickMemberReq) (*chat.KickMemberResp, error) {
+	l := logic.NewKickMemberLogic(ctx, s.svcCtx)
+	return l.KickMember(in)
+}
+
+// SetAnnouncement 设置群公告
+func (s *ChatServiceServer) SetAnnouncement(ctx context.Context, in *chat.SetAnnouncementReq) (*chat.SetAnnouncementResp, error) {
+	l := logic.NewSetAnnouncementLogic(ctx, s.svcCtx)
+	return l.SetAnnouncement(in)
+}
+
+// SetGroupAdmin 设置/取消管理员
+func (s *ChatServiceServer) SetGroupAdmin(ctx context.Context, in *chat.SetGroupAdminReq) (*chat.SetGroupAdminResp, error) {
+	l := logic.NewSetGroupAdminLogic(ctx, s.svcCtx)
+	return l.SetGroupAdmin(in)
+}
+
+// CheckSendPermission 校验发言权限
+func (s *ChatServiceServer) CheckSendPermission(ctx context.Context, in *chat.CheckSendPermissionReq) (*chat.CheckSendPermissionResp, error) {
+	l := logic.NewCheckSendPermissionLogic(ctx, s.svcCtx)
+	return l.CheckSendPermission(in)
+}
+
 // SaveMessage 保存消息
 func (s *ChatServiceServer) SaveMessage(ctx context.Context, in *chat.SaveMessageReq) (*chat.SaveMessageResp, error) {
 	l := logic.NewSaveMessageLogic(ctx, s.svcCtx)
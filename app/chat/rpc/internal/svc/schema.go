@@ -14,6 +14,18 @@ const (
 	addGroupsCoverURLColumnSQL    = "ALTER TABLE `groups` ADD COLUMN `cover_url` VARCHAR(500) NOT NULL DEFAULT '' COMMENT '封面图URL（活动封面）' AFTER `name`"
 )
 
+// moderationColumns 群管理功能新增的字段（旧库启动时自动补齐）
+var moderationColumns = []struct {
+	table  string
+	column string
+	ddl    string
+}{
+	{"groups", "all_muted", "ALTER TABLE `groups` ADD COLUMN `all_muted` TINYINT(1) NOT NULL DEFAULT 0 COMMENT '是否全员禁言: 0-否 1-是（群主/管理员除外）' AFTER `member_count`"},
+	{"groups", "announcement", "ALTER TABLE `groups` ADD COLUMN `announcement` VARCHAR(1000) NOT NULL DEFAULT '' COMMENT '群公告' AFTER `all_muted`"},
+	{"groups", "announcement_at", "ALTER TABLE `groups` ADD COLUMN `announcement_at` DATETIME DEFAULT NULL COMMENT '公告更新时间' AFTER `announcement`"},
+	{"group_members", "muted_until", "ALTER TABLE `group_members` ADD COLUMN `muted_until` DATETIME DEFAULT NULL COMMENT '禁言截止时间（NULL 表示未禁言）' AFTER `left_at`"},
+}
+
 func ensureChatSchema(db *gorm.DB) error {
 	exists, err := columnExists(db, "groups", "cover_url")
 	if err != nil {
@@ -63,3 +75,21 @@ func isDuplicateColumnError(err error) bool {
 		strings.Contains(errText, "Error 1060") ||
 		errors.Is(err, gorm.ErrDuplicatedKey)
 }
+
+// ensureModerationSchema 补齐群管理（禁言/公告）相关字段
+func ensureModerationSchema(db *gorm.DB) error {
+	for _, col := range moderationColumns {
+		exists, err := columnExists(db, col.table, col.column)
+		if err != nil {
+			return fmt.Errorf("检查 %s.%s 字段失败: %w", col.table, col.column, err)
+		}
+		if exists {
+			continue
+		}
+		if err := db.Exec(col.ddl).Error; err != nil && !isDuplicateColumnError(err) {
+			return fmt.Errorf("补充 %s.%s 字段失败: %w", col.table, col.column, err)
+		}
+		log.Printf("[INFO] Chat schema 已修复，补充 %s.%s 字段成功", col.table, col.column)
+	}
+	return nil
+}
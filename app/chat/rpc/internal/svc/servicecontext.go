@@ -40,6 +40,9 @@ type ServiceContext struct {
 	// UserVerifyRpc User 认证服务 RPC 客户端
 	UserVerifyRpc pb.VerifyServiceClient
 
+	// UserStatsRpc User 平台统计服务 RPC 客户端（上报消息量）
+	UserStatsRpc pb.PlatformStatsServiceClient
+
 	// Model 层
 	GroupModel        model.GroupModel
 	GroupMemberModel  model.GroupMemberModel
@@ -68,6 +71,7 @@ func NewServiceContext(c config.Config) *ServiceContext {
 	var userBasicRpc pb.UserBasicServiceClient
 	var userCreditRpc pb.CreditServiceClient
 	var userVerifyRpc pb.VerifyServiceClient
+	var userStatsRpc pb.PlatformStatsServiceClient
 
 	userRpcClient, err := zrpc.NewClient(c.UserRpc)
 	if err != nil {
@@ -77,6 +81,7 @@ func NewServiceContext(c config.Config) *ServiceContext {
 		userBasicRpc = pb.NewUserBasicServiceClient(userRpcConn)
 		userCreditRpc = pb.NewCreditServiceClient(userRpcConn)
 		userVerifyRpc = pb.NewVerifyServiceClient(userRpcConn)
+		userStatsRpc = pb.NewPlatformStatsServiceClient(userRpcConn)
 		log.Printf("[INFO] User RPC 客户端初始化成功")
 	}
 
@@ -88,6 +93,7 @@ func NewServiceContext(c config.Config) *ServiceContext {
 		UserBasicRpc:      userBasicRpc,
 		UserCreditRpc:     userCreditRpc,
 		UserVerifyRpc:     userVerifyRpc,
+		UserStatsRpc:      userStatsRpc,
 		GroupModel:        model.NewGroupModel(db),
 		GroupMemberModel:  model.NewGroupMemberModel(db),
 		MessageModel:      model.NewMessageModel(db),
@@ -106,6 +112,10 @@ func initDB(c config.Config) (*gorm.DB, error) {
 		log.Printf("[ERROR] Chat schema 校验/修复失败: %v", err)
 		return nil, err
 	}
+	if err := ensureModerationSchema(db); err != nil {
+		log.Printf("[ERROR] Chat 群管理字段校验/修复失败: %v", err)
+		return nil, err
+	}
 
 	// 获取底层的 sql.DB 对象，配置连接池
 	sqlDB, err := db.DB()
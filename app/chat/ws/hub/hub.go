@@ -45,6 +45,9 @@ type Hub struct {
 	// Redis 客户端（用于存储用户状态）
 	redisClient *redis.Client
 
+	// 用户活跃记录器（可选，用户上线时调用，用于平台日活统计）
+	activeRecorder ActiveRecorder
+
 	mu sync.RWMutex
 }
 
@@ -54,6 +57,16 @@ type MessageHandler interface {
 	HandleSendMessage(client *Client, msg *types.WSMessage) error
 }
 
+// ActiveRecorder 用户活跃记录器（可选，实现方需保证不阻塞，Hub 持锁调用）
+type ActiveRecorder interface {
+	RecordActive(userID string)
+}
+
+// ModerationListener 群管理事件监听器（可选，消息处理器实现后用于刷新发言权限缓存）
+type ModerationListener interface {
+	OnGroupModeration(event *messaging.GroupModerationEvent)
+}
+
 // NewHub 创建新的 Hub
 func NewHub(handler MessageHandler, messagingClient *messaging.Client, redisClient *redis.Client) *Hub {
 	return &Hub{
@@ -67,6 +80,11 @@ func NewHub(handler MessageHandler, messagingClient *messaging.Client, redisClie
 	}
 }
 
+// SetActiveRecorder 设置用户活跃记录器（需在 Run 之前调用）
+func (h *Hub) SetActiveRecorder(recorder ActiveRecorder) {
+	h.activeRecorder = recorder
+}
+
 // Run 运行 Hub
 func (h *Hub) Run(ctx context.Context) {
 	// 订阅消息中间件的消息
@@ -290,6 +308,30 @@ func (h *Hub) subscribeMessages(ctx context.Context) {
 		return nil
 	})
 
+	// 订阅群管理事件（禁言/全员禁言/踢人/公告/管理员变更）
+	h.messagingClient.Subscribe(messaging.TopicGroupModeration, "ws-group-moderation", func(msg *message.Message) error {
+		var event messaging.GroupModerationEvent
+		if err := json.Unmarshal(msg.Payload, &event); err != nil {
+			return messaging.NewNonRetryableError(err)
+		}
+		if event.GroupID == "" {
+			return messaging.NewNonRetryableError(errors.New("无效的群管理事件"))
+		}
+
+		// 先刷新发言权限，再通知群成员
+		if listener, ok := h.messageHandler.(ModerationListener); ok {
+			listener.OnGroupModeration(&event)
+		}
+
+		h.BroadcastToGroup(event.GroupID, &types.WSMessage{
+			Type:      types.TypeGroupModeration,
+			MessageID: fmt.Sprintf("moderation_%s_%s_%d", event.GroupID, event.Action, event.Timestamp),
+			Timestamp: event.Timestamp,
+			Data:      json.RawMessage(msg.Payload),
+		})
+		return nil
+	})
+
 	// 订阅认证进度通知
 	h.messagingClient.Subscribe(messaging.TopicVerifyProgress, "ws-verify-progress-handler", func(msg *message.Message) error {
 		var progressEvent messaging.VerifyProgressEventData
@@ -420,6 +462,9 @@ func (h *Hub) updateUserStatus(userID string, isOnline bool) {
 
 	if isOnline {
 		data["last_online_at"] = now
+		if h.activeRecorder != nil {
+			h.activeRecorder.RecordActive(userID)
+		}
 	} else {
 		data["last_offline_at"] = now
 	}
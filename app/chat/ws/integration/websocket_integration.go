@@ -14,10 +14,13 @@ import (
 	"activity-platform/app/chat/rpc/chatservice"
 	"activity-platform/app/chat/ws/hub"
 	"activity-platform/app/chat/ws/internal/cache"
+	wsconfig "activity-platform/app/chat/ws/internal/config"
+	"activity-platform/app/chat/ws/internal/guard"
 	"activity-platform/app/chat/ws/internal/handler"
 	"activity-platform/app/chat/ws/internal/logic"
 	"activity-platform/app/chat/ws/internal/queue"
 	"activity-platform/app/chat/ws/internal/svc"
+	"activity-platform/app/user/rpc/client/platformstatsservice"
 	"activity-platform/app/user/rpc/client/userbasicservice"
 	"activity-platform/common/messaging"
 )
@@ -26,6 +29,7 @@ import (
 type WebSocketService struct {
 	Hub             *hub.Hub
 	SaveQueue       *queue.SaveQueue
+	ActiveQueue     *queue.ActiveQueue
 	MessagingClient *messaging.Client
 	RedisClient     *redis.Client
 	serviceContext  *svc.ServiceContext
@@ -58,7 +62,8 @@ func NewWebSocketService(config WebSocketConfig) (*WebSocketService, error) {
 
 	// 创建 RPC 客户端
 	chatRpc := chatservice.NewChatService(zrpc.MustNewClient(config.ChatRpcConfig))
-	userRpc := userbasicservice.NewUserBasicService(zrpc.MustNewClient(config.UserRpcConfig))
+	userRpcClient := zrpc.MustNewClient(config.UserRpcConfig)
+	userRpc := userbasicservice.NewUserBasicService(userRpcClient)
 
 	// 创建消息中间件客户端
 	// 每个实例使用唯一消费者组（hostname），确保所有实例都能收到广播消息
@@ -93,10 +98,13 @@ func NewWebSocketService(config WebSocketConfig) (*WebSocketService, error) {
 	// 创建消息保存队列
 	saveQueue := queue.NewSaveQueue(chatRpc, 10)
 
+	// 创建用户活跃上报队列（平台日活统计）
+	activeQueue := queue.NewActiveQueue(platformstatsservice.NewPlatformStatsService(userRpcClient), 2)
+
 	// 创建用户信息缓存
 	userCache := cache.NewUserCache(redisClient)
 
-	// 创建服务上下文
+	// 创建服务上下文（内容安全使用默认参数，词库前缀 contentfilter）
 	serviceContext := &svc.ServiceContext{
 		ChatRpc:         chatRpc,
 		UserRpc:         userRpc,
@@ -104,7 +112,12 @@ func NewWebSocketService(config WebSocketConfig) (*WebSocketService, error) {
 		JwtAuth:         svc.NewJwtAuth(config.JwtSecret),
 		RedisClient:     redisClient,
 		SaveQueue:       saveQueue,
+		ActiveQueue:     activeQueue,
 		UserCache:       userCache,
+		SendPermCache:   cache.NewSendPermissionCache(svc.SendPermissionTTL),
+		ContentFilter:   svc.NewContentFilter(redisClient, wsconfig.ContentConf{FilterEnabled: true}),
+		SpamGuard:       svc.NewSpamGuard(wsconfig.ContentConf{}),
+		ReviewQueue:     guard.NewReviewQueue(redisClient),
 	}
 
 	// 创建消息处理器
@@ -112,12 +125,14 @@ func NewWebSocketService(config WebSocketConfig) (*WebSocketService, error) {
 
 	// 创建 Hub
 	wsHub := hub.NewHub(messageHandler, messagingClient, redisClient)
+	wsHub.SetActiveRecorder(activeQueue)
 
 	logx.Info("WebSocket 服务初始化完成")
 
 	return &WebSocketService{
 		Hub:             wsHub,
 		SaveQueue:       saveQueue,
+		ActiveQueue:     activeQueue,
 		MessagingClient: messagingClient,
 		RedisClient:     redisClient,
 		serviceContext:  serviceContext,
@@ -162,6 +177,10 @@ func (s *WebSocketService) Close() error {
 		s.SaveQueue.Stop()
 	}
 
+	if s.ActiveQueue != nil {
+		s.ActiveQueue.Stop()
+	}
+
 	if s.MessagingClient != nil {
 		s.MessagingClient.CleanupConsumerGroups(context.Background())
 		if err := s.MessagingClient.Close(); err != nil {
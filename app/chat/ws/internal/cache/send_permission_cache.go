@@ -0,0 +1,90 @@
+package cache
+
+import (
+	"sync"
+	"time"
+)
+
+// SendPermission 发言权限校验结果
+type SendPermission struct {
+	IsMember bool   // 是否为群成员
+	Allowed  bool   // 是否允许发言
+	Reason   string // 不允许发言的原因
+}
+
+type sendPermissionEntry struct {
+	perm     SendPermission
+	expireAt time.Time
+}
+
+// SendPermissionCache 发言权限本地缓存
+//
+// 每条消息都调用 Chat RPC 校验成员身份/禁言状态成本较高，这里按 (群, 用户) 缓存校验结果：
+//   - TTL 兜底：禁言到期、多实例间的事件丢失，最迟 TTL 后自动恢复一致
+//   - 事件失效：收到群管理事件（禁言/全员禁言/踢人/角色变更）时立即删除对应条目
+//
+// 仅缓存在进程内：每个 WS 实例都订阅了群管理事件，无需跨实例共享
+type SendPermissionCache struct {
+	mu      sync.RWMutex
+	ttl     time.Duration
+	entries map[string]map[uint64]sendPermissionEntry // groupID -> userID -> entry
+}
+
+// NewSendPermissionCache 创建发言权限缓存
+func NewSendPermissionCache(ttl time.Duration) *SendPermissionCache {
+	return &SendPermissionCache{
+		ttl:     ttl,
+		entries: make(map[string]map[uint64]sendPermissionEntry),
+	}
+}
+
+// Get 获取缓存的校验结果
+func (c *SendPermissionCache) Get(groupID string, userID uint64) (SendPermission, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	entry, ok := c.entries[groupID][userID]
+	if !ok || time.Now().After(entry.expireAt) {
+		return SendPermission{}, false
+	}
+	return entry.perm, true
+}
+
+// Set 写入校验结果
+// expireAt 非零且早于默认 TTL 时使用 expireAt（如禁言到期时间），保证到期后立即恢复发言
+func (c *SendPermissionCache) Set(groupID string, userID uint64, perm SendPermission, expireAt time.Time) {
+	deadline := time.Now().Add(c.ttl)
+	if !expireAt.IsZero() && expireAt.Before(deadline) {
+		deadline = expireAt
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	users, ok := c.entries[groupID]
+	if !ok {
+		users = make(map[uint64]sendPermissionEntry)
+		c.entries[groupID] = users
+	}
+	users[userID] = sendPermissionEntry{perm: perm, expireAt: deadline}
+}
+
+// InvalidateMember 删除指定成员的缓存
+func (c *SendPermissionCache) InvalidateMember(groupID string, userID uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if users, ok := c.entries[groupID]; ok {
+		delete(users, userID)
+		if len(users) == 0 {
+			delete(c.entries, groupID)
+		}
+	}
+}
+
+// InvalidateGroup 删除整个群的缓存
+func (c *SendPermissionCache) InvalidateGroup(groupID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, groupID)
+}
@@ -23,6 +23,9 @@ type Config struct {
 
 	// WebSocket 配置
 	WebSocket WebSocketConf
+
+	// 内容安全配置（敏感词过滤、防刷屏）
+	Content ContentConf `json:",optional"`
 }
 
 // RedisConf Redis 配置
@@ -49,3 +52,21 @@ type WebSocketConf struct {
 	// 心跳间隔（秒）
 	HeartbeatInterval int `json:",default=30"`
 }
+
+// ContentConf 内容安全配置
+type ContentConf struct {
+	// 是否启用敏感词过滤
+	FilterEnabled bool `json:",default=true"`
+	// 词库 Redis Key 前缀
+	FilterKeyPrefix string `json:",default=contentfilter"`
+	// 词库热加载检查间隔（秒）
+	FilterReloadInterval int `json:",default=30"`
+	// 限流窗口（秒）
+	RateWindow int `json:",default=10"`
+	// 窗口内最多发送条数
+	RateLimit int `json:",default=20"`
+	// 重复消息检测窗口（秒）
+	DuplicateWindow int `json:",default=60"`
+	// 窗口内相同内容最多连续发送次数
+	MaxDuplicates int `json:",default=3"`
+}
@@ -0,0 +1,56 @@
+package guard
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"activity-platform/common/contentfilter"
+)
+
+const (
+	// ReviewQueueKey 待审核聊天消息队列（Redis List，新消息在表头）
+	ReviewQueueKey = "contentfilter:review:chat"
+
+	// reviewQueueMaxLen 队列最大长度（超出后丢弃最旧的记录）
+	reviewQueueMaxLen = 10000
+)
+
+// FlaggedMessage 命中送审词的聊天消息
+type FlaggedMessage struct {
+	MessageID string              `json:"message_id"`
+	GroupID   string              `json:"group_id"`
+	SenderID  uint64              `json:"sender_id"`
+	Content   string              `json:"content"` // 原文
+	Hits      []contentfilter.Hit `json:"hits"`
+	CreatedAt int64               `json:"created_at"`
+}
+
+// ReviewQueue 待审核消息队列
+type ReviewQueue struct {
+	client *redis.Client
+}
+
+// NewReviewQueue 创建待审核消息队列
+func NewReviewQueue(client *redis.Client) *ReviewQueue {
+	return &ReviewQueue{client: client}
+}
+
+// Push 写入待审核消息（写入失败只返回错误，不影响消息发送）
+func (q *ReviewQueue) Push(ctx context.Context, msg *FlaggedMessage) error {
+	if msg.CreatedAt == 0 {
+		msg.CreatedAt = time.Now().Unix()
+	}
+	payload, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	pipe := q.client.Pipeline()
+	pipe.LPush(ctx, ReviewQueueKey, payload)
+	pipe.LTrim(ctx, ReviewQueueKey, 0, reviewQueueMaxLen-1)
+	_, err = pipe.Exec(ctx)
+	return err
+}
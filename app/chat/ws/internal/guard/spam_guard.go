@@ -0,0 +1,144 @@
+package guard
+
+import (
+	"hash/fnv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// 默认限流参数（配置为零值时使用）
+const (
+	DefaultRateWindow      = 10 * time.Second
+	DefaultRateLimit       = 20
+	DefaultDuplicateWindow = 60 * time.Second
+	DefaultMaxDuplicates   = 3
+
+	// sweepInterval 清理空闲用户状态的间隔
+	sweepInterval = time.Minute
+)
+
+// SpamGuardConfig 防刷屏配置
+type SpamGuardConfig struct {
+	RateWindow      time.Duration // 限流窗口
+	RateLimit       int           // 窗口内最多发送条数
+	DuplicateWindow time.Duration // 重复消息检测窗口
+	MaxDuplicates   int           // 窗口内相同内容最多发送次数
+}
+
+// userActivity 单个用户的发送状态
+type userActivity struct {
+	sends    []time.Time // 限流窗口内的发送时间（升序）
+	lastHash uint64      // 最近一条消息内容哈希
+	dupCount int         // 连续相同内容次数
+	dupStart time.Time   // 本轮相同内容首次发送时间
+	lastSeen time.Time
+}
+
+// SpamGuard 聊天防刷屏（按用户的滑动窗口限流 + 重复内容检测）
+//
+// 仅在进程内统计：同一用户的连接固定落在一个 WS 实例上，
+// 多端登录分散到多个实例时限额按实例计算，可以接受。
+type SpamGuard struct {
+	mu        sync.Mutex
+	cfg       SpamGuardConfig
+	users     map[uint64]*userActivity
+	lastSweep time.Time
+}
+
+// NewSpamGuard 创建防刷屏守卫
+func NewSpamGuard(cfg SpamGuardConfig) *SpamGuard {
+	if cfg.RateWindow <= 0 {
+		cfg.RateWindow = DefaultRateWindow
+	}
+	if cfg.RateLimit <= 0 {
+		cfg.RateLimit = DefaultRateLimit
+	}
+	if cfg.DuplicateWindow <= 0 {
+		cfg.DuplicateWindow = DefaultDuplicateWindow
+	}
+	if cfg.MaxDuplicates <= 0 {
+		cfg.MaxDuplicates = DefaultMaxDuplicates
+	}
+	return &SpamGuard{
+		cfg:       cfg,
+		users:     make(map[uint64]*userActivity),
+		lastSweep: time.Now(),
+	}
+}
+
+// Allow 判断本次发送是否放行（放行时计入统计，拒绝时返回原因）
+func (g *SpamGuard) Allow(userID uint64, content string) (bool, string) {
+	now := time.Now()
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.sweep(now)
+
+	ua, ok := g.users[userID]
+	if !ok {
+		ua = &userActivity{}
+		g.users[userID] = ua
+	}
+	ua.lastSeen = now
+
+	// 1. 滑动窗口限流
+	cutoff := now.Add(-g.cfg.RateWindow)
+	i := 0
+	for i < len(ua.sends) && !ua.sends[i].After(cutoff) {
+		i++
+	}
+	ua.sends = ua.sends[i:]
+	if len(ua.sends) >= g.cfg.RateLimit {
+		return false, "发送过于频繁，请稍后再试"
+	}
+
+	// 2. 重复内容检测（窗口内连续发送相同内容）
+	if key := normalizeContent(content); key != "" {
+		h := hashContent(key)
+		if h == ua.lastHash && now.Sub(ua.dupStart) <= g.cfg.DuplicateWindow {
+			if ua.dupCount >= g.cfg.MaxDuplicates {
+				return false, "请勿重复发送相同内容"
+			}
+			ua.dupCount++
+		} else {
+			ua.lastHash = h
+			ua.dupCount = 1
+			ua.dupStart = now
+		}
+	}
+
+	ua.sends = append(ua.sends, now)
+	return true, ""
+}
+
+// sweep 清理长时间未发言的用户状态（调用方持有锁）
+func (g *SpamGuard) sweep(now time.Time) {
+	if now.Sub(g.lastSweep) < sweepInterval {
+		return
+	}
+	g.lastSweep = now
+
+	idle := g.cfg.RateWindow
+	if g.cfg.DuplicateWindow > idle {
+		idle = g.cfg.DuplicateWindow
+	}
+	for userID, ua := range g.users {
+		if now.Sub(ua.lastSeen) > idle {
+			delete(g.users, userID)
+		}
+	}
+}
+
+// normalizeContent 归一化消息内容（去除首尾空白、忽略大小写）
+func normalizeContent(content string) string {
+	return strings.ToLower(strings.TrimSpace(content))
+}
+
+// hashContent 内容哈希
+func hashContent(s string) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(s))
+	return h.Sum64()
+}
@@ -11,6 +11,8 @@ import (
 
 	"activity-platform/app/chat/rpc/chat"
 	"activity-platform/app/chat/ws/hub"
+	"activity-platform/app/chat/ws/internal/cache"
+	"activity-platform/app/chat/ws/internal/guard"
 	"activity-platform/app/chat/ws/internal/queue"
 	"activity-platform/app/chat/ws/internal/svc"
 	"activity-platform/app/chat/ws/internal/types"
@@ -90,6 +92,18 @@ func (l *MessageLogic) HandleSendMessage(client *hub.Client, msg *types.WSMessag
 		return err
 	}
 
+	// 校验成员身份与禁言状态（不通过时回复失败 ACK，不发布消息）
+	if perm := l.checkSendPermission(client, sendData.GroupID, senderID); !perm.Allowed {
+		l.rejectMessage(client, msg, perm.Reason)
+		return nil
+	}
+
+	// 内容安全检查（防刷屏、敏感词）
+	if reason := l.screenMessage(senderID, messageID, &sendData); reason != "" {
+		l.rejectMessage(client, msg, reason)
+		return nil
+	}
+
 	// 构造新消息数据
 	senderName, senderAvatar := l.getUserInfo(senderID)
 	newMsgData := types.NewMessageData{
@@ -163,6 +177,18 @@ func (l *MessageLogic) HandleSendMessageSync(client *hub.Client, msg *types.WSMe
 		return err
 	}
 
+	// 校验成员身份与禁言状态（不通过时回复失败 ACK，不发布消息）
+	if perm := l.checkSendPermission(client, sendData.GroupID, senderID); !perm.Allowed {
+		l.rejectMessage(client, msg, perm.Reason)
+		return nil
+	}
+
+	// 内容安全检查（防刷屏、敏感词）
+	if reason := l.screenMessage(senderID, messageID, &sendData); reason != "" {
+		l.rejectMessage(client, msg, reason)
+		return nil
+	}
+
 	// 构造新消息数据
 	senderName, senderAvatar := l.getUserInfo(senderID)
 	newMsgData := types.NewMessageData{
@@ -221,6 +247,127 @@ func (l *MessageLogic) HandleSendMessageSync(client *hub.Client, msg *types.WSMe
 	return nil
 }
 
+// checkSendPermission 校验发送者能否向群聊发言
+//
+//   - 连接已订阅该群（client.IsInGroup）时优先使用本地缓存
+//   - 未订阅时以 Chat RPC（GroupMemberModel）为准：自动入群尚未完成的成员补订阅，非成员拒绝
+//   - RPC 失败时拒绝发送（fail-closed），避免非成员借故障窗口发消息
+func (l *MessageLogic) checkSendPermission(client *hub.Client, groupID string, userID uint64) cache.SendPermission {
+	if groupID == "" {
+		return cache.SendPermission{Reason: "群聊ID不能为空"}
+	}
+
+	inGroup := client.IsInGroup(groupID)
+	if inGroup {
+		if perm, ok := l.svcCtx.SendPermCache.Get(groupID, userID); ok {
+			return perm
+		}
+	}
+
+	resp, err := l.svcCtx.ChatRpc.CheckSendPermission(l.ctx, &chat.CheckSendPermissionReq{
+		GroupId: groupID,
+		UserId:  userID,
+	})
+	if err != nil {
+		logx.Errorf("校验发言权限失败: group_id=%s, user_id=%d, err=%v", groupID, userID, err)
+		return cache.SendPermission{Reason: "发言权限校验失败，请稍后重试"}
+	}
+
+	perm := cache.SendPermission{
+		IsMember: resp.IsMember,
+		Allowed:  resp.Allowed,
+		Reason:   resp.Reason,
+	}
+
+	// 本地订阅与成员身份不一致时修正
+	switch {
+	case perm.IsMember && !inGroup:
+		client.GetHub().AddClientToGroup(client, groupID)
+	case !perm.IsMember && inGroup:
+		client.GetHub().RemoveClientFromGroup(client, groupID)
+	}
+
+	if perm.IsMember {
+		var expireAt time.Time
+		if resp.MutedUntil > 0 {
+			expireAt = time.Unix(resp.MutedUntil, 0)
+		}
+		l.svcCtx.SendPermCache.Set(groupID, userID, perm, expireAt)
+	}
+	return perm
+}
+
+// screenMessage 内容安全检查，返回非空字符串表示拒绝原因
+//
+// 敏感词处置：
+//   - block：拒绝发送
+//   - replace：命中部分替换为掩码后发送
+//   - flag：原文发送，同时写入待审核队列
+func (l *MessageLogic) screenMessage(senderID uint64, messageID string, data *types.SendMessageData) string {
+	// 1. 防刷屏（限流 + 重复内容）
+	if l.svcCtx.SpamGuard != nil {
+		if ok, reason := l.svcCtx.SpamGuard.Allow(senderID, data.Content+data.ImageURL); !ok {
+			logx.Infof("消息被防刷屏拦截: user_id=%d, group_id=%s, reason=%s", senderID, data.GroupID, reason)
+			return reason
+		}
+	}
+
+	// 2. 敏感词过滤（仅文本内容）
+	if l.svcCtx.ContentFilter == nil || data.Content == "" {
+		return ""
+	}
+	result := l.svcCtx.ContentFilter.Check(data.Content)
+	if !result.Hit() {
+		return ""
+	}
+
+	if result.Blocked() {
+		logx.Infof("消息命中拦截词: user_id=%d, group_id=%s, words=%v", senderID, data.GroupID, result.Words())
+		return "消息包含违规内容，发送失败"
+	}
+	if result.Flagged() && l.svcCtx.ReviewQueue != nil {
+		err := l.svcCtx.ReviewQueue.Push(l.ctx, &guard.FlaggedMessage{
+			MessageID: messageID,
+			GroupID:   data.GroupID,
+			SenderID:  senderID,
+			Content:   data.Content,
+			Hits:      result.Hits,
+		})
+		if err != nil {
+			logx.Errorf("写入待审核消息失败: message_id=%s, err=%v", messageID, err)
+		}
+	}
+	data.Content = result.Text
+	return ""
+}
+
+// rejectMessage 回复发送失败的 ACK
+func (l *MessageLogic) rejectMessage(client *hub.Client, msg *types.WSMessage, reason string) {
+	ackPayload, _ := json.Marshal(types.AckData{
+		MessageID: msg.MessageID,
+		Success:   false,
+		Reason:    reason,
+	})
+	client.SendMessage(&types.WSMessage{
+		Type:      types.TypeAck,
+		MessageID: msg.MessageID,
+		Timestamp: time.Now().Unix(),
+		Data:      ackPayload,
+	})
+}
+
+// OnGroupModeration 群管理事件：刷新发言权限缓存（实现 hub.ModerationListener）
+func (l *MessageLogic) OnGroupModeration(event *messaging.GroupModerationEvent) {
+	switch event.Action {
+	case messaging.GroupModerationMuteAll, messaging.GroupModerationUnmuteAll:
+		l.svcCtx.SendPermCache.InvalidateGroup(event.GroupID)
+	case messaging.GroupModerationAnnouncement:
+		// 公告不影响发言权限
+	default:
+		l.svcCtx.SendPermCache.InvalidateMember(event.GroupID, event.UserID)
+	}
+}
+
 // autoJoinUserGroups 自动加入用户的所有群聊
 func (l *MessageLogic) autoJoinUserGroups(client *hub.Client, userID string) {
 	defer func() {
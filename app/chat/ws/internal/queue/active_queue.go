@@ -0,0 +1,118 @@
+package queue
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"activity-platform/app/user/rpc/client/platformstatsservice"
+	"activity-platform/common/constants"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// ActiveQueue 用户活跃上报队列（计入平台日活）
+//
+// 用户 WebSocket 上线时由 Hub 调用 RecordActive，异步调用 User 服务 RecordUserActive。
+// 同一用户当天只上报一次（进程内去重，跨天清空），上报失败时移除去重标记，下次上线重试；
+// 队列满时直接丢弃，不阻塞 Hub
+type ActiveQueue struct {
+	queue    chan int64
+	statsRpc platformstatsservice.PlatformStatsService
+	wg       sync.WaitGroup
+	ctx      context.Context
+	cancel   context.CancelFunc
+
+	mu   sync.Mutex
+	date string
+	seen map[int64]struct{}
+}
+
+// NewActiveQueue 创建活跃上报队列
+func NewActiveQueue(statsRpc platformstatsservice.PlatformStatsService, workerCount int) *ActiveQueue {
+	ctx, cancel := context.WithCancel(context.Background())
+	aq := &ActiveQueue{
+		queue:    make(chan int64, 1000),
+		statsRpc: statsRpc,
+		ctx:      ctx,
+		cancel:   cancel,
+		seen:     make(map[int64]struct{}),
+	}
+
+	for i := 0; i < workerCount; i++ {
+		aq.wg.Add(1)
+		go aq.worker()
+	}
+
+	logx.Infof("ActiveQueue 启动成功，工作协程数：%d", workerCount)
+	return aq
+}
+
+// RecordActive 记录用户上线（实现 hub.ActiveRecorder）
+func (aq *ActiveQueue) RecordActive(userID string) {
+	uid, err := strconv.ParseInt(userID, 10, 64)
+	if err != nil || uid <= 0 {
+		return
+	}
+	if !aq.markSeen(uid) {
+		return
+	}
+
+	select {
+	case aq.queue <- uid:
+	default:
+		aq.unmarkSeen(uid)
+	}
+}
+
+// markSeen 标记当天已上报（已标记返回 false）
+func (aq *ActiveQueue) markSeen(userID int64) bool {
+	aq.mu.Lock()
+	defer aq.mu.Unlock()
+
+	if today := time.Now().Format(constants.StatsDateLayout); aq.date != today {
+		aq.date = today
+		aq.seen = make(map[int64]struct{})
+	}
+	if _, ok := aq.seen[userID]; ok {
+		return false
+	}
+	aq.seen[userID] = struct{}{}
+	return true
+}
+
+// unmarkSeen 移除去重标记（上报失败或入队失败时调用）
+func (aq *ActiveQueue) unmarkSeen(userID int64) {
+	aq.mu.Lock()
+	delete(aq.seen, userID)
+	aq.mu.Unlock()
+}
+
+// worker 工作协程
+func (aq *ActiveQueue) worker() {
+	defer aq.wg.Done()
+
+	for {
+		select {
+		case <-aq.ctx.Done():
+			return
+		case userID := <-aq.queue:
+			_, err := aq.statsRpc.RecordUserActive(aq.ctx, &platformstatsservice.RecordUserActiveReq{
+				UserId: userID,
+				Source: constants.StatsActiveSourceWS,
+			})
+			if err != nil {
+				logx.Errorf("上报用户活跃失败: userId=%d, err=%v", userID, err)
+				aq.unmarkSeen(userID)
+			}
+		}
+	}
+}
+
+// Stop 停止队列（未上报的记录直接丢弃）
+func (aq *ActiveQueue) Stop() {
+	aq.cancel()
+	aq.wg.Wait()
+	logx.Info("ActiveQueue 已停止")
+}
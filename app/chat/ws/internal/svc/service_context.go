@@ -11,11 +11,17 @@ import (
 	"activity-platform/app/chat/rpc/chatservice"
 	"activity-platform/app/chat/ws/internal/cache"
 	"activity-platform/app/chat/ws/internal/config"
+	"activity-platform/app/chat/ws/internal/guard"
 	"activity-platform/app/chat/ws/internal/queue"
+	"activity-platform/app/user/rpc/client/platformstatsservice"
 	"activity-platform/app/user/rpc/client/userbasicservice"
+	"activity-platform/common/contentfilter"
 	"activity-platform/common/messaging"
 )
 
+// SendPermissionTTL 发言权限缓存时间
+const SendPermissionTTL = 10 * time.Second
+
 // ServiceContext 服务上下文
 type ServiceContext struct {
 	Config          config.Config
@@ -24,15 +30,21 @@ type ServiceContext struct {
 	MessagingClient *messaging.Client
 	JwtAuth         *JwtAuth
 	RedisClient     *redis.Client
-	SaveQueue       *queue.SaveQueue // 新增：消息保存队列
-	UserCache       *cache.UserCache // 新增：用户信息缓存
+	SaveQueue       *queue.SaveQueue           // 新增：消息保存队列
+	ActiveQueue     *queue.ActiveQueue         // 用户活跃上报队列（平台日活统计）
+	UserCache       *cache.UserCache           // 新增：用户信息缓存
+	SendPermCache   *cache.SendPermissionCache // 发言权限缓存
+	ContentFilter   *contentfilter.Filter      // 敏感词过滤（未启用时为 nil）
+	SpamGuard       *guard.SpamGuard           // 防刷屏
+	ReviewQueue     *guard.ReviewQueue         // 待审核消息队列
 }
 
 // NewServiceContext 创建服务上下文
 func NewServiceContext(c config.Config) *ServiceContext {
 	// 创建 RPC 客户端
 	chatRpc := chatservice.NewChatService(zrpc.MustNewClient(c.ChatRpc))
-	userRpc := userbasicservice.NewUserBasicService(zrpc.MustNewClient(c.UserRpc))
+	userRpcClient := zrpc.MustNewClient(c.UserRpc)
+	userRpc := userbasicservice.NewUserBasicService(userRpcClient)
 
 	// 创建 Redis 客户端
 	redisClient := redis.NewClient(&redis.Options{
@@ -80,9 +92,15 @@ func NewServiceContext(c config.Config) *ServiceContext {
 	// 创建消息保存队列（10 个工作协程）
 	saveQueue := queue.NewSaveQueue(chatRpc, 10)
 
+	// 创建用户活跃上报队列（2 个工作协程）
+	activeQueue := queue.NewActiveQueue(platformstatsservice.NewPlatformStatsService(userRpcClient), 2)
+
 	// 创建用户信息缓存
 	userCache := cache.NewUserCache(redisClient)
 
+	// 创建发言权限缓存（群管理事件触发失效，TTL 兜底）
+	sendPermCache := cache.NewSendPermissionCache(SendPermissionTTL)
+
 	return &ServiceContext{
 		Config:          c,
 		ChatRpc:         chatRpc,
@@ -91,6 +109,34 @@ func NewServiceContext(c config.Config) *ServiceContext {
 		JwtAuth:         NewJwtAuth(c.Auth.AccessSecret),
 		RedisClient:     redisClient,
 		SaveQueue:       saveQueue,
+		ActiveQueue:     activeQueue,
 		UserCache:       userCache,
+		SendPermCache:   sendPermCache,
+		ContentFilter:   NewContentFilter(redisClient, c.Content),
+		SpamGuard:       NewSpamGuard(c.Content),
+		ReviewQueue:     guard.NewReviewQueue(redisClient),
+	}
+}
+
+// NewContentFilter 创建敏感词过滤器并启动热加载（未启用时返回 nil）
+func NewContentFilter(redisClient *redis.Client, c config.ContentConf) *contentfilter.Filter {
+	if !c.FilterEnabled {
+		return nil
 	}
+	source := contentfilter.NewRedisSource(contentfilter.GoRedis(redisClient), c.FilterKeyPrefix)
+	filter := contentfilter.New(source, contentfilter.Options{
+		ReloadInterval: time.Duration(c.FilterReloadInterval) * time.Second,
+	})
+	filter.Start()
+	return filter
+}
+
+// NewSpamGuard 创建防刷屏守卫（配置为零值时使用默认参数）
+func NewSpamGuard(c config.ContentConf) *guard.SpamGuard {
+	return guard.NewSpamGuard(guard.SpamGuardConfig{
+		RateWindow:      time.Duration(c.RateWindow) * time.Second,
+		RateLimit:       c.RateLimit,
+		DuplicateWindow: time.Duration(c.DuplicateWindow) * time.Second,
+		MaxDuplicates:   c.MaxDuplicates,
+	})
 }
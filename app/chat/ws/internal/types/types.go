@@ -12,14 +12,15 @@ const (
 	TypeSendMessage MessageType = "send_message" // 发送消息
 
 	// 服务端 -> 客户端
-	TypePong           MessageType = "pong"            // 心跳响应
-	TypeAuthSuccess    MessageType = "auth_success"    // 认证成功
-	TypeAuthFailed     MessageType = "auth_failed"     // 认证失败
-	TypeNewMessage     MessageType = "new_message"     // 新消息
-	TypeNotification   MessageType = "notification"    // 系统通知
-	TypeVerifyProgress MessageType = "verify_progress" // 认证进度更新
-	TypeError          MessageType = "error"           // 错误消息
-	TypeAck            MessageType = "ack"             // 消息确认
+	TypePong            MessageType = "pong"             // 心跳响应
+	TypeAuthSuccess     MessageType = "auth_success"     // 认证成功
+	TypeAuthFailed      MessageType = "auth_failed"      // 认证失败
+	TypeNewMessage      MessageType = "new_message"      // 新消息
+	TypeNotification    MessageType = "notification"     // 系统通知
+	TypeVerifyProgress  MessageType = "verify_progress"  // 认证进度更新
+	TypeGroupModeration MessageType = "group_moderation" // 群管理通知（禁言/踢人/公告等）
+	TypeError           MessageType = "error"            // 错误消息
+	TypeAck             MessageType = "ack"              // 消息确认
 )
 
 // WSMessage WebSocket 消息结构
@@ -79,6 +80,7 @@ type NotificationData struct {
 
 // AckData 确认数据
 type AckData struct {
-	MessageID string `json:"message_id"` // 确认的消息ID
-	Success   bool   `json:"success"`    // 是否成功
+	MessageID string `json:"message_id"`       // 确认的消息ID
+	Success   bool   `json:"success"`          // 是否成功
+	Reason    string `json:"reason,omitempty"` // 失败原因（如未入群、被禁言）
 }
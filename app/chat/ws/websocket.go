@@ -61,6 +61,7 @@ func main() {
 
 	// 创建 Hub
 	h := hub.NewHub(messageHandler, svcCtx.MessagingClient, svcCtx.RedisClient)
+	h.SetActiveRecorder(svcCtx.ActiveQueue)
 
 	// 启动 Hub
 	ctx, cancel := context.WithCancel(context.Background())
@@ -123,6 +124,11 @@ func main() {
 		svcCtx.SaveQueue.Stop()
 	}
 
+	// 停止用户活跃上报队列
+	if svcCtx.ActiveQueue != nil {
+		svcCtx.ActiveQueue.Stop()
+	}
+
 	// 关闭消息中间件客户端（先清理消费者组，再关闭连接）
 	svcCtx.MessagingClient.CleanupConsumerGroups(context.Background())
 	err := svcCtx.MessagingClient.Close()
@@ -0,0 +1,33 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package admin
+
+import (
+	"net/http"
+
+	"activity-platform/app/user/api/internal/logic/admin"
+	"activity-platform/app/user/api/internal/svc"
+	"activity-platform/app/user/api/internal/types"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// ClaimVerifyReviewHandler 领取认证审核任务
+func ClaimVerifyReviewHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.ClaimVerifyReviewReq
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := admin.NewClaimVerifyReviewLogic(r.Context(), svcCtx)
+		resp, err := l.ClaimVerifyReview(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
@@ -0,0 +1,39 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package admin
+
+import (
+	"fmt"
+	"net/http"
+
+	"activity-platform/app/user/api/internal/logic/admin"
+	"activity-platform/app/user/api/internal/svc"
+	"activity-platform/app/user/api/internal/types"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// ExportPlatformStatsHandler 导出平台统计报表（CSV）
+// 以附件形式输出 text/csv 正文
+func ExportPlatformStatsHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.GetPlatformStatsReq
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := admin.NewExportPlatformStatsLogic(r.Context(), svcCtx)
+		file, err := l.ExportPlatformStats(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", file.Filename))
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(file.Content)
+	}
+}
@@ -6,6 +6,7 @@ package handler
 import (
 	"net/http"
 
+	admin "activity-platform/app/user/api/internal/handler/admin"
 	base "activity-platform/app/user/api/internal/handler/base"
 	credit "activity-platform/app/user/api/internal/handler/credit"
 	user "activity-platform/app/user/api/internal/handler/user"
@@ -84,11 +85,119 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 				Path:    "/credit/logs",
 				Handler: credit.GetCreditLogsHandler(serverCtx),
 			},
+			{
+				// 提交信用申诉
+				Method:  http.MethodPost,
+				Path:    "/credit/appeals",
+				Handler: credit.SubmitCreditAppealHandler(serverCtx),
+			},
+			{
+				// 查询我的申诉
+				Method:  http.MethodGet,
+				Path:    "/credit/appeals",
+				Handler: credit.ListCreditAppealsHandler(serverCtx),
+			},
 		},
 		rest.WithJwt(serverCtx.Config.Auth.AccessSecret),
 		rest.WithPrefix("/api/v1"),
 	)
 
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.AdminRoleMiddleware},
+			[]rest.Route{
+				{
+					// 管理员调整信用分
+					Method:  http.MethodPost,
+					Path:    "/credit/adjust",
+					Handler: admin.AdminAdjustScoreHandler(serverCtx),
+				},
+				{
+					// 管理员查询申诉列表
+					Method:  http.MethodGet,
+					Path:    "/credit/appeals",
+					Handler: admin.AdminListCreditAppealsHandler(serverCtx),
+				},
+				{
+					// 管理员处理申诉
+					Method:  http.MethodPost,
+					Path:    "/credit/appeals/:id/review",
+					Handler: admin.ReviewCreditAppealHandler(serverCtx),
+				},
+				{
+					// 查询信用管理审计日志
+					Method:  http.MethodGet,
+					Path:    "/credit/audits",
+					Handler: admin.ListCreditAuditsHandler(serverCtx),
+				},
+			}...,
+		),
+		rest.WithJwt(serverCtx.Config.Auth.AccessSecret),
+		rest.WithPrefix("/api/v1/admin"),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.AdminRoleMiddleware},
+			[]rest.Route{
+				{
+					// 查询认证人工审核队列
+					Method:  http.MethodGet,
+					Path:    "/verify/reviews",
+					Handler: admin.ListVerifyReviewsHandler(serverCtx),
+				},
+				{
+					// 领取认证审核任务
+					Method:  http.MethodPost,
+					Path:    "/verify/reviews/:id/claim",
+					Handler: admin.ClaimVerifyReviewHandler(serverCtx),
+				},
+				{
+					// 释放认证审核任务
+					Method:  http.MethodPost,
+					Path:    "/verify/reviews/:id/release",
+					Handler: admin.ReleaseVerifyReviewHandler(serverCtx),
+				},
+				{
+					// 审核学生认证（通过/拒绝）
+					Method:  http.MethodPost,
+					Path:    "/verify/reviews/:id/review",
+					Handler: admin.ReviewStudentVerifyHandler(serverCtx),
+				},
+				{
+					// 查询审核员工作量统计
+					Method:  http.MethodGet,
+					Path:    "/verify/reviews/stats",
+					Handler: admin.GetVerifyReviewStatsHandler(serverCtx),
+				},
+			}...,
+		),
+		rest.WithJwt(serverCtx.Config.Auth.AccessSecret),
+		rest.WithPrefix("/api/v1/admin"),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.AdminRoleMiddleware},
+			[]rest.Route{
+				{
+					// 查询平台统计指标
+					Method:  http.MethodGet,
+					Path:    "/stats",
+					Handler: admin.GetPlatformStatsHandler(serverCtx),
+				},
+				{
+					// 导出平台统计报表（CSV）
+					Method:  http.MethodGet,
+					Path:    "/stats/export",
+					Handler: admin.ExportPlatformStatsHandler(serverCtx),
+				},
+			}...,
+		),
+		rest.WithJwt(serverCtx.Config.Auth.AccessSecret),
+		rest.WithPrefix("/api/v1/admin"),
+	)
+
 	server.AddRoutes(
 		rest.WithMiddlewares(
 			[]rest.Middleware{serverCtx.UserRoleMiddleware},
@@ -179,6 +288,12 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 				Path:    "/verify/student/current",
 				Handler: verify.GetVerifyCurrentHandler(serverCtx),
 			},
+			{
+				// 认证过期后重新认证
+				Method:  http.MethodPost,
+				Path:    "/verify/student/reverify",
+				Handler: verify.ReverifyStudentHandler(serverCtx),
+			},
 		},
 		rest.WithJwt(serverCtx.Config.Auth.AccessSecret),
 		rest.WithPrefix("/api/v1"),
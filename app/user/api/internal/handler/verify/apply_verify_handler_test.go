@@ -39,6 +39,10 @@ func (m *mockVerifyService) IsVerified(ctx context.Context, in *verifyservice.Is
 	panic("unexpected IsVerified call")
 }
 
+func (m *mockVerifyService) BatchGetVerifyProfiles(ctx context.Context, in *verifyservice.BatchGetVerifyProfilesReq, opts ...grpc.CallOption) (*verifyservice.BatchGetVerifyProfilesResp, error) {
+	panic("unexpected BatchGetVerifyProfiles call")
+}
+
 func (m *mockVerifyService) ApplyStudentVerify(ctx context.Context, in *verifyservice.ApplyStudentVerifyReq, opts ...grpc.CallOption) (*verifyservice.ApplyStudentVerifyResp, error) {
 	m.applyCalled++
 	m.applyReq = in
@@ -61,6 +65,26 @@ func (m *mockVerifyService) ProcessOcrVerify(ctx context.Context, in *verifyserv
 	panic("unexpected ProcessOcrVerify call")
 }
 
+func (m *mockVerifyService) ReverifyStudent(ctx context.Context, in *verifyservice.ReverifyStudentReq, opts ...grpc.CallOption) (*verifyservice.ApplyStudentVerifyResp, error) {
+	panic("unexpected ReverifyStudent call")
+}
+
+func (m *mockVerifyService) ListVerifyReviews(ctx context.Context, in *verifyservice.ListVerifyReviewsReq, opts ...grpc.CallOption) (*verifyservice.ListVerifyReviewsResp, error) {
+	panic("unexpected ListVerifyReviews call")
+}
+
+func (m *mockVerifyService) ClaimVerifyReview(ctx context.Context, in *verifyservice.ClaimVerifyReviewReq, opts ...grpc.CallOption) (*verifyservice.ClaimVerifyReviewResp, error) {
+	panic("unexpected ClaimVerifyReview call")
+}
+
+func (m *mockVerifyService) ReviewStudentVerify(ctx context.Context, in *verifyservice.ReviewStudentVerifyReq, opts ...grpc.CallOption) (*verifyservice.ReviewStudentVerifyResp, error) {
+	panic("unexpected ReviewStudentVerify call")
+}
+
+func (m *mockVerifyService) GetVerifyReviewStats(ctx context.Context, in *verifyservice.GetVerifyReviewStatsReq, opts ...grpc.CallOption) (*verifyservice.GetVerifyReviewStatsResp, error) {
+	panic("unexpected GetVerifyReviewStats call")
+}
+
 type apiResp struct {
 	Code    int             `json:"code"`
 	Message string          `json:"message"`
@@ -0,0 +1,67 @@
+/**
+ * @projectName: CampusHub
+ * @package: admin
+ * @className: AdminAdjustScoreLogic
+ * @author: lijunqi
+ * @description: 管理员调整信用分业务逻辑
+ * @date: 2026-10-18
+ * @version: 1.0
+ */
+
+package admin
+
+import (
+	"context"
+
+	"activity-platform/app/user/api/internal/svc"
+	"activity-platform/app/user/api/internal/types"
+	"activity-platform/app/user/rpc/client/creditservice"
+	"activity-platform/common/ctxdata"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// AdminAdjustScoreLogic 管理员调整信用分逻辑
+type AdminAdjustScoreLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// NewAdminAdjustScoreLogic 创建管理员调整信用分逻辑实例
+func NewAdminAdjustScoreLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AdminAdjustScoreLogic {
+	return &AdminAdjustScoreLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// AdminAdjustScore 管理员调整信用分
+// 注意：此接口受 AdminRoleMiddleware 保护，已验证管理员身份
+func (l *AdminAdjustScoreLogic) AdminAdjustScore(req *types.AdminAdjustScoreReq) (resp *types.AdminAdjustScoreResp, err error) {
+	adminId := ctxdata.GetUserIDFromCtx(l.ctx)
+	if adminId <= 0 {
+		return nil, errorx.ErrUnauthorized()
+	}
+
+	rpcResp, err := l.svcCtx.CreditServiceRpc.AdminAdjustScore(l.ctx, &creditservice.AdminAdjustScoreReq{
+		AdminId: adminId,
+		UserId:  req.UserId,
+		Delta:   req.Delta,
+		Reason:  req.Reason,
+	})
+	if err != nil {
+		l.Errorf("调用 CreditServiceRpc.AdminAdjustScore 失败: adminId=%d, userId=%d, err=%v",
+			adminId, req.UserId, err)
+		return nil, errorx.FromError(err)
+	}
+
+	return &types.AdminAdjustScoreResp{
+		BeforeScore: rpcResp.BeforeScore,
+		AfterScore:  rpcResp.AfterScore,
+		Delta:       rpcResp.Delta,
+		NewLevel:    rpcResp.NewLevel,
+	}, nil
+}
@@ -0,0 +1,57 @@
+/**
+ * @projectName: CampusHub
+ * @package: admin
+ * @className: AdminListCreditAppealsLogic
+ * @author: lijunqi
+ * @description: 管理员查询信用申诉业务逻辑
+ * @date: 2026-10-18
+ * @version: 1.0
+ */
+
+package admin
+
+import (
+	"context"
+
+	"activity-platform/app/user/api/internal/logic/credit"
+	"activity-platform/app/user/api/internal/svc"
+	"activity-platform/app/user/api/internal/types"
+	"activity-platform/app/user/rpc/client/creditservice"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// AdminListCreditAppealsLogic 管理员查询信用申诉逻辑
+type AdminListCreditAppealsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// NewAdminListCreditAppealsLogic 创建管理员查询信用申诉逻辑实例
+func NewAdminListCreditAppealsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AdminListCreditAppealsLogic {
+	return &AdminListCreditAppealsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// AdminListCreditAppeals 管理员查询信用申诉
+// 注意：此接口受 AdminRoleMiddleware 保护，已验证管理员身份
+func (l *AdminListCreditAppealsLogic) AdminListCreditAppeals(req *types.AdminListCreditAppealsReq) (resp *types.ListCreditAppealsResp, err error) {
+	rpcResp, err := l.svcCtx.CreditServiceRpc.ListCreditAppeals(l.ctx, &creditservice.ListCreditAppealsReq{
+		UserId:   req.UserId,
+		Status:   req.Status,
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	})
+	if err != nil {
+		l.Errorf("调用 CreditServiceRpc.ListCreditAppeals 失败: userId=%d, status=%d, err=%v",
+			req.UserId, req.Status, err)
+		return nil, errorx.FromError(err)
+	}
+
+	return credit.ConvertAppealList(rpcResp), nil
+}
@@ -0,0 +1,64 @@
+/**
+ * @projectName: CampusHub
+ * @package: admin
+ * @className: ClaimVerifyReviewLogic
+ * @author: lijunqi
+ * @description: 领取认证审核任务业务逻辑
+ * @date: 2026-10-18
+ * @version: 1.0
+ */
+
+package admin
+
+import (
+	"context"
+
+	"activity-platform/app/user/api/internal/svc"
+	"activity-platform/app/user/api/internal/types"
+	"activity-platform/app/user/rpc/client/verifyservice"
+	"activity-platform/common/ctxdata"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// ClaimVerifyReviewLogic 领取认证审核任务逻辑
+type ClaimVerifyReviewLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// NewClaimVerifyReviewLogic 创建领取认证审核任务逻辑实例
+func NewClaimVerifyReviewLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ClaimVerifyReviewLogic {
+	return &ClaimVerifyReviewLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// ClaimVerifyReview 领取认证审核任务
+// 注意：此接口受 AdminRoleMiddleware 保护，已验证管理员身份
+func (l *ClaimVerifyReviewLogic) ClaimVerifyReview(req *types.ClaimVerifyReviewReq) (resp *types.ClaimVerifyReviewResp, err error) {
+	reviewerId := ctxdata.GetUserIDFromCtx(l.ctx)
+	if reviewerId <= 0 {
+		return nil, errorx.ErrUnauthorized()
+	}
+
+	rpcResp, err := l.svcCtx.VerifyServiceRpc.ClaimVerifyReview(l.ctx, &verifyservice.ClaimVerifyReviewReq{
+		VerifyId:   req.Id,
+		ReviewerId: reviewerId,
+		Release:    false,
+	})
+	if err != nil {
+		l.Errorf("调用 VerifyServiceRpc.ClaimVerifyReview 失败: reviewerId=%d, verifyId=%d, release=false, err=%v",
+			reviewerId, req.Id, err)
+		return nil, errorx.FromError(err)
+	}
+
+	return &types.ClaimVerifyReviewResp{
+		ClaimedBy: rpcResp.ClaimedBy,
+		ExpireAt:  rpcResp.ExpireAt,
+	}, nil
+}
@@ -0,0 +1,125 @@
+/**
+ * @projectName: CampusHub
+ * @package: admin
+ * @className: ExportPlatformStatsLogic
+ * @author: lijunqi
+ * @description: 平台统计报表 CSV 导出业务逻辑
+ * @date: 2026-10-19
+ * @version: 1.0
+ */
+
+package admin
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"sort"
+	"strconv"
+	"time"
+
+	"activity-platform/app/user/api/internal/svc"
+	"activity-platform/app/user/api/internal/types"
+	"activity-platform/common/constants"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// utf8BOM CSV 文件头（Excel 打开含中文的 CSV 需要 BOM）
+const utf8BOM = "\xEF\xBB\xBF"
+
+// PlatformStatsFile 平台统计报表文件
+type PlatformStatsFile struct {
+	Filename string
+	Content  []byte
+}
+
+// ExportPlatformStatsLogic 平台统计报表导出逻辑
+type ExportPlatformStatsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// NewExportPlatformStatsLogic 创建平台统计报表导出逻辑实例
+func NewExportPlatformStatsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ExportPlatformStatsLogic {
+	return &ExportPlatformStatsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// ExportPlatformStats 导出平台统计报表
+// 查询条件与 GetPlatformStats 一致，输出宽表：每行一天，每列一个 指标[:维度]，缺失数据留空
+// 注意：此接口受 AdminRoleMiddleware 保护，已验证管理员身份
+func (l *ExportPlatformStatsLogic) ExportPlatformStats(req *types.GetPlatformStatsReq) (*PlatformStatsFile, error) {
+	// 1. 查询数据（日期默认值、参数校验与查询接口共用）
+	stats, err := NewGetPlatformStatsLogic(l.ctx, l.svcCtx).GetPlatformStats(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. 按 日期 -> 列 整理数据
+	columns := make([]string, 0)
+	values := make(map[string]map[string]float64)
+	for _, item := range stats.List {
+		column := item.Metric
+		if item.Dimension != "" {
+			column += ":" + item.Dimension
+		}
+		if _, ok := values[column]; !ok {
+			values[column] = make(map[string]float64)
+			columns = append(columns, column)
+		}
+		values[column][item.Date] = item.Value
+	}
+	sort.Strings(columns)
+
+	// 3. 生成 CSV
+	var buf bytes.Buffer
+	buf.WriteString(utf8BOM)
+	w := csv.NewWriter(&buf)
+	if err := w.Write(append([]string{"date"}, columns...)); err != nil {
+		return nil, err
+	}
+	for _, date := range statsDates(stats.StartDate, stats.EndDate) {
+		row := make([]string, 0, len(columns)+1)
+		row = append(row, date)
+		for _, column := range columns {
+			if v, ok := values[column][date]; ok {
+				row = append(row, strconv.FormatFloat(v, 'f', -1, 64))
+			} else {
+				row = append(row, "")
+			}
+		}
+		if err := w.Write(row); err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		l.Errorf("生成平台统计CSV失败: err=%v", err)
+		return nil, err
+	}
+
+	return &PlatformStatsFile{
+		Filename: "platform_stats_" + stats.StartDate + "_" + stats.EndDate + ".csv",
+		Content:  buf.Bytes(),
+	}, nil
+}
+
+// statsDates 生成日期范围内的所有日期（含首尾，RPC 已校验范围）
+func statsDates(startDate, endDate string) []string {
+	start, err1 := time.ParseInLocation(constants.StatsDateLayout, startDate, time.Local)
+	end, err2 := time.ParseInLocation(constants.StatsDateLayout, endDate, time.Local)
+	if err1 != nil || err2 != nil {
+		return nil
+	}
+
+	dates := make([]string, 0, int(end.Sub(start).Hours()/24)+1)
+	for d := start; !d.After(end); d = d.AddDate(0, 0, 1) {
+		dates = append(dates, d.Format(constants.StatsDateLayout))
+	}
+	return dates
+}
@@ -0,0 +1,101 @@
+/**
+ * @projectName: CampusHub
+ * @package: admin
+ * @className: GetPlatformStatsLogic
+ * @author: lijunqi
+ * @description: 平台统计指标查询业务逻辑
+ * @date: 2026-10-19
+ * @version: 1.0
+ */
+
+package admin
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"activity-platform/app/user/api/internal/svc"
+	"activity-platform/app/user/api/internal/types"
+	"activity-platform/app/user/rpc/client/platformstatsservice"
+	"activity-platform/common/constants"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// defaultStatsDays 未指定开始日期时默认查询的天数（含结束日期）
+const defaultStatsDays = 30
+
+// GetPlatformStatsLogic 平台统计指标查询逻辑
+type GetPlatformStatsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// NewGetPlatformStatsLogic 创建平台统计指标查询逻辑实例
+func NewGetPlatformStatsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetPlatformStatsLogic {
+	return &GetPlatformStatsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// GetPlatformStats 按日期范围查询平台统计指标
+// 注意：此接口受 AdminRoleMiddleware 保护，已验证管理员身份
+func (l *GetPlatformStatsLogic) GetPlatformStats(req *types.GetPlatformStatsReq) (resp *types.GetPlatformStatsResp, err error) {
+	// 1. 默认日期范围：最近30天
+	endDate := req.EndDate
+	if endDate == "" {
+		endDate = time.Now().Format(constants.StatsDateLayout)
+	}
+	startDate := req.StartDate
+	if startDate == "" {
+		end, err := time.ParseInLocation(constants.StatsDateLayout, endDate, time.Local)
+		if err != nil {
+			return nil, errorx.ErrInvalidParams("结束日期格式应为yyyy-MM-dd")
+		}
+		startDate = end.AddDate(0, 0, -(defaultStatsDays - 1)).Format(constants.StatsDateLayout)
+	}
+
+	// 2. 调用 RPC
+	rpcResp, err := l.svcCtx.PlatformStatsRpc.GetPlatformStats(l.ctx, &platformstatsservice.GetPlatformStatsReq{
+		StartDate: startDate,
+		EndDate:   endDate,
+		Metrics:   splitMetrics(req.Metrics),
+	})
+	if err != nil {
+		l.Errorf("调用 PlatformStatsRpc.GetPlatformStats 失败: %s ~ %s, err=%v", startDate, endDate, err)
+		return nil, errorx.FromError(err)
+	}
+
+	// 3. 转换响应
+	list := make([]types.PlatformStatItem, 0, len(rpcResp.List))
+	for _, item := range rpcResp.List {
+		list = append(list, types.PlatformStatItem{
+			Date:      item.StatDate,
+			Metric:    item.Metric,
+			Dimension: item.Dimension,
+			Value:     item.Value,
+		})
+	}
+
+	return &types.GetPlatformStatsResp{
+		StartDate: startDate,
+		EndDate:   endDate,
+		List:      list,
+	}, nil
+}
+
+// splitMetrics 解析逗号分隔的指标列表（忽略空项）
+func splitMetrics(raw string) []string {
+	var metrics []string
+	for _, m := range strings.Split(raw, ",") {
+		if m = strings.TrimSpace(m); m != "" {
+			metrics = append(metrics, m)
+		}
+	}
+	return metrics
+}
@@ -0,0 +1,66 @@
+/**
+ * @projectName: CampusHub
+ * @package: admin
+ * @className: GetVerifyReviewStatsLogic
+ * @author: lijunqi
+ * @description: 审核员工作量统计业务逻辑
+ * @date: 2026-10-18
+ * @version: 1.0
+ */
+
+package admin
+
+import (
+	"context"
+
+	"activity-platform/app/user/api/internal/svc"
+	"activity-platform/app/user/api/internal/types"
+	"activity-platform/app/user/rpc/client/verifyservice"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// GetVerifyReviewStatsLogic 审核员工作量统计逻辑
+type GetVerifyReviewStatsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// NewGetVerifyReviewStatsLogic 创建审核员工作量统计逻辑实例
+func NewGetVerifyReviewStatsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetVerifyReviewStatsLogic {
+	return &GetVerifyReviewStatsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// GetVerifyReviewStats 查询审核员工作量统计
+// 注意：此接口受 AdminRoleMiddleware 保护，已验证管理员身份
+func (l *GetVerifyReviewStatsLogic) GetVerifyReviewStats(req *types.GetVerifyReviewStatsReq) (resp *types.GetVerifyReviewStatsResp, err error) {
+	rpcResp, err := l.svcCtx.VerifyServiceRpc.GetVerifyReviewStats(l.ctx, &verifyservice.GetVerifyReviewStatsReq{
+		StartTime: req.StartTime,
+		EndTime:   req.EndTime,
+	})
+	if err != nil {
+		l.Errorf("调用 VerifyServiceRpc.GetVerifyReviewStats 失败: err=%v", err)
+		return nil, errorx.FromError(err)
+	}
+
+	list := make([]types.VerifyReviewerStat, 0, len(rpcResp.List))
+	for _, item := range rpcResp.List {
+		list = append(list, types.VerifyReviewerStat{
+			ReviewerId: item.ReviewerId,
+			Approved:   item.Approved,
+			Rejected:   item.Rejected,
+			Total:      item.Total,
+		})
+	}
+
+	return &types.GetVerifyReviewStatsResp{
+		List:    list,
+		Pending: rpcResp.Pending,
+	}, nil
+}
@@ -0,0 +1,76 @@
+/**
+ * @projectName: CampusHub
+ * @package: admin
+ * @className: ListCreditAuditsLogic
+ * @author: lijunqi
+ * @description: 查询信用管理审计日志业务逻辑
+ * @date: 2026-10-18
+ * @version: 1.0
+ */
+
+package admin
+
+import (
+	"context"
+
+	"activity-platform/app/user/api/internal/svc"
+	"activity-platform/app/user/api/internal/types"
+	"activity-platform/app/user/rpc/client/creditservice"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// ListCreditAuditsLogic 查询信用管理审计日志逻辑
+type ListCreditAuditsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// NewListCreditAuditsLogic 创建查询信用管理审计日志逻辑实例
+func NewListCreditAuditsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListCreditAuditsLogic {
+	return &ListCreditAuditsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// ListCreditAudits 查询信用管理审计日志
+// 注意：此接口受 AdminRoleMiddleware 保护，已验证管理员身份
+func (l *ListCreditAuditsLogic) ListCreditAudits(req *types.ListCreditAuditsReq) (resp *types.ListCreditAuditsResp, err error) {
+	rpcResp, err := l.svcCtx.CreditServiceRpc.ListCreditAudits(l.ctx, &creditservice.ListCreditAuditsReq{
+		UserId:     req.UserId,
+		OperatorId: req.OperatorId,
+		Action:     req.Action,
+		Page:       req.Page,
+		PageSize:   req.PageSize,
+	})
+	if err != nil {
+		l.Errorf("调用 CreditServiceRpc.ListCreditAudits 失败: err=%v", err)
+		return nil, errorx.FromError(err)
+	}
+
+	list := make([]types.CreditAuditItem, 0, len(rpcResp.List))
+	for _, item := range rpcResp.List {
+		list = append(list, types.CreditAuditItem{
+			Id:          item.Id,
+			OperatorId:  item.OperatorId,
+			UserId:      item.UserId,
+			Action:      item.Action,
+			TargetId:    item.TargetId,
+			SourceId:    item.SourceId,
+			Delta:       item.Delta,
+			BeforeScore: item.BeforeScore,
+			AfterScore:  item.AfterScore,
+			Reason:      item.Reason,
+			CreatedAt:   item.CreatedAt,
+		})
+	}
+
+	return &types.ListCreditAuditsResp{
+		List:  list,
+		Total: rpcResp.Total,
+	}, nil
+}
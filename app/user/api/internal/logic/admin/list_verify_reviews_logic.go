@@ -0,0 +1,84 @@
+/**
+ * @projectName: CampusHub
+ * @package: admin
+ * @className: ListVerifyReviewsLogic
+ * @author: lijunqi
+ * @description: 查询认证人工审核队列业务逻辑
+ * @date: 2026-10-18
+ * @version: 1.0
+ */
+
+package admin
+
+import (
+	"context"
+
+	"activity-platform/app/user/api/internal/svc"
+	"activity-platform/app/user/api/internal/types"
+	"activity-platform/app/user/rpc/client/verifyservice"
+	"activity-platform/common/ctxdata"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// ListVerifyReviewsLogic 查询认证人工审核队列逻辑
+type ListVerifyReviewsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// NewListVerifyReviewsLogic 创建查询认证人工审核队列逻辑实例
+func NewListVerifyReviewsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListVerifyReviewsLogic {
+	return &ListVerifyReviewsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// ListVerifyReviews 查询认证人工审核队列
+// 注意：此接口受 AdminRoleMiddleware 保护，已验证管理员身份；每次查询都会记录查看日志
+func (l *ListVerifyReviewsLogic) ListVerifyReviews(req *types.ListVerifyReviewsReq) (resp *types.ListVerifyReviewsResp, err error) {
+	reviewerId := ctxdata.GetUserIDFromCtx(l.ctx)
+	if reviewerId <= 0 {
+		return nil, errorx.ErrUnauthorized()
+	}
+
+	rpcResp, err := l.svcCtx.VerifyServiceRpc.ListVerifyReviews(l.ctx, &verifyservice.ListVerifyReviewsReq{
+		ReviewerId: reviewerId,
+		Page:       req.Page,
+		PageSize:   req.PageSize,
+	})
+	if err != nil {
+		l.Errorf("调用 VerifyServiceRpc.ListVerifyReviews 失败: reviewerId=%d, err=%v", reviewerId, err)
+		return nil, errorx.FromError(err)
+	}
+
+	list := make([]types.VerifyReviewItem, 0, len(rpcResp.List))
+	for _, item := range rpcResp.List {
+		list = append(list, types.VerifyReviewItem{
+			VerifyId:      item.VerifyId,
+			UserId:        item.UserId,
+			Status:        item.Status,
+			RealName:      item.RealName,
+			SchoolName:    item.SchoolName,
+			StudentId:     item.StudentId,
+			Department:    item.Department,
+			AdmissionYear: item.AdmissionYear,
+			FrontImageUrl: item.FrontImageUrl,
+			BackImageUrl:  item.BackImageUrl,
+			OcrPlatform:   item.OcrPlatform,
+			OcrConfidence: item.OcrConfidence,
+			ClaimedBy:     item.ClaimedBy,
+			CreatedAt:     item.CreatedAt,
+			UpdatedAt:     item.UpdatedAt,
+		})
+	}
+
+	return &types.ListVerifyReviewsResp{
+		List:  list,
+		Total: rpcResp.Total,
+	}, nil
+}
@@ -0,0 +1,64 @@
+/**
+ * @projectName: CampusHub
+ * @package: admin
+ * @className: ReleaseVerifyReviewLogic
+ * @author: lijunqi
+ * @description: 释放认证审核任务业务逻辑
+ * @date: 2026-10-18
+ * @version: 1.0
+ */
+
+package admin
+
+import (
+	"context"
+
+	"activity-platform/app/user/api/internal/svc"
+	"activity-platform/app/user/api/internal/types"
+	"activity-platform/app/user/rpc/client/verifyservice"
+	"activity-platform/common/ctxdata"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// ReleaseVerifyReviewLogic 释放认证审核任务逻辑
+type ReleaseVerifyReviewLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// NewReleaseVerifyReviewLogic 创建释放认证审核任务逻辑实例
+func NewReleaseVerifyReviewLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ReleaseVerifyReviewLogic {
+	return &ReleaseVerifyReviewLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// ReleaseVerifyReview 释放认证审核任务
+// 注意：此接口受 AdminRoleMiddleware 保护，已验证管理员身份
+func (l *ReleaseVerifyReviewLogic) ReleaseVerifyReview(req *types.ClaimVerifyReviewReq) (resp *types.ClaimVerifyReviewResp, err error) {
+	reviewerId := ctxdata.GetUserIDFromCtx(l.ctx)
+	if reviewerId <= 0 {
+		return nil, errorx.ErrUnauthorized()
+	}
+
+	rpcResp, err := l.svcCtx.VerifyServiceRpc.ClaimVerifyReview(l.ctx, &verifyservice.ClaimVerifyReviewReq{
+		VerifyId:   req.Id,
+		ReviewerId: reviewerId,
+		Release:    true,
+	})
+	if err != nil {
+		l.Errorf("调用 VerifyServiceRpc.ClaimVerifyReview 失败: reviewerId=%d, verifyId=%d, release=true, err=%v",
+			reviewerId, req.Id, err)
+		return nil, errorx.FromError(err)
+	}
+
+	return &types.ClaimVerifyReviewResp{
+		ClaimedBy: rpcResp.ClaimedBy,
+		ExpireAt:  rpcResp.ExpireAt,
+	}, nil
+}
@@ -0,0 +1,65 @@
+/**
+ * @projectName: CampusHub
+ * @package: admin
+ * @className: ReviewCreditAppealLogic
+ * @author: lijunqi
+ * @description: 管理员处理信用申诉业务逻辑
+ * @date: 2026-10-18
+ * @version: 1.0
+ */
+
+package admin
+
+import (
+	"context"
+
+	"activity-platform/app/user/api/internal/svc"
+	"activity-platform/app/user/api/internal/types"
+	"activity-platform/app/user/rpc/client/creditservice"
+	"activity-platform/common/ctxdata"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// ReviewCreditAppealLogic 管理员处理信用申诉逻辑
+type ReviewCreditAppealLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// NewReviewCreditAppealLogic 创建管理员处理信用申诉逻辑实例
+func NewReviewCreditAppealLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ReviewCreditAppealLogic {
+	return &ReviewCreditAppealLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// ReviewCreditAppeal 管理员处理信用申诉
+// 注意：此接口受 AdminRoleMiddleware 保护，已验证管理员身份
+func (l *ReviewCreditAppealLogic) ReviewCreditAppeal(req *types.ReviewCreditAppealReq) (resp *types.ReviewCreditAppealResp, err error) {
+	adminId := ctxdata.GetUserIDFromCtx(l.ctx)
+	if adminId <= 0 {
+		return nil, errorx.ErrUnauthorized()
+	}
+
+	rpcResp, err := l.svcCtx.CreditServiceRpc.ReviewCreditAppeal(l.ctx, &creditservice.ReviewCreditAppealReq{
+		AppealId: req.Id,
+		AdminId:  adminId,
+		Accept:   req.Accept,
+		Note:     req.Note,
+	})
+	if err != nil {
+		l.Errorf("调用 CreditServiceRpc.ReviewCreditAppeal 失败: adminId=%d, appealId=%d, err=%v",
+			adminId, req.Id, err)
+		return nil, errorx.FromError(err)
+	}
+
+	return &types.ReviewCreditAppealResp{
+		Status: rpcResp.Status,
+		Score:  rpcResp.Score,
+	}, nil
+}
@@ -0,0 +1,65 @@
+/**
+ * @projectName: CampusHub
+ * @package: admin
+ * @className: ReviewStudentVerifyLogic
+ * @author: lijunqi
+ * @description: 人工审核学生认证业务逻辑
+ * @date: 2026-10-18
+ * @version: 1.0
+ */
+
+package admin
+
+import (
+	"context"
+
+	"activity-platform/app/user/api/internal/svc"
+	"activity-platform/app/user/api/internal/types"
+	"activity-platform/app/user/rpc/client/verifyservice"
+	"activity-platform/common/ctxdata"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// ReviewStudentVerifyLogic 人工审核学生认证逻辑
+type ReviewStudentVerifyLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// NewReviewStudentVerifyLogic 创建人工审核学生认证逻辑实例
+func NewReviewStudentVerifyLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ReviewStudentVerifyLogic {
+	return &ReviewStudentVerifyLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// ReviewStudentVerify 人工审核学生认证（通过/拒绝）
+// 注意：此接口受 AdminRoleMiddleware 保护，已验证管理员身份
+func (l *ReviewStudentVerifyLogic) ReviewStudentVerify(req *types.ReviewStudentVerifyReq) (resp *types.ReviewStudentVerifyResp, err error) {
+	reviewerId := ctxdata.GetUserIDFromCtx(l.ctx)
+	if reviewerId <= 0 {
+		return nil, errorx.ErrUnauthorized()
+	}
+
+	rpcResp, err := l.svcCtx.VerifyServiceRpc.ReviewStudentVerify(l.ctx, &verifyservice.ReviewStudentVerifyReq{
+		VerifyId:   req.Id,
+		ReviewerId: reviewerId,
+		Approve:    req.Approve,
+		Reason:     req.Reason,
+	})
+	if err != nil {
+		l.Errorf("调用 VerifyServiceRpc.ReviewStudentVerify 失败: reviewerId=%d, verifyId=%d, err=%v",
+			reviewerId, req.Id, err)
+		return nil, errorx.FromError(err)
+	}
+
+	return &types.ReviewStudentVerifyResp{
+		BeforeStatus: rpcResp.BeforeStatus,
+		AfterStatus:  rpcResp.AfterStatus,
+	}, nil
+}
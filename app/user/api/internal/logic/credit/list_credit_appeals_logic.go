@@ -0,0 +1,86 @@
+/**
+ * @projectName: CampusHub
+ * @package: credit
+ * @className: ListCreditAppealsLogic
+ * @author: lijunqi
+ * @description: 查询我的信用申诉业务逻辑
+ * @date: 2026-10-18
+ * @version: 1.0
+ */
+
+package credit
+
+import (
+	"context"
+
+	"activity-platform/app/user/api/internal/svc"
+	"activity-platform/app/user/api/internal/types"
+	"activity-platform/app/user/rpc/client/creditservice"
+	"activity-platform/common/ctxdata"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// ListCreditAppealsLogic 查询我的信用申诉逻辑
+type ListCreditAppealsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// NewListCreditAppealsLogic 创建查询我的信用申诉逻辑实例
+func NewListCreditAppealsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListCreditAppealsLogic {
+	return &ListCreditAppealsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// ListCreditAppeals 查询我的信用申诉
+func (l *ListCreditAppealsLogic) ListCreditAppeals(req *types.ListCreditAppealsReq) (resp *types.ListCreditAppealsResp, err error) {
+	// 1. 从 JWT 中获取当前用户ID（只能查询本人的申诉）
+	userId := ctxdata.GetUserIDFromCtx(l.ctx)
+	if userId <= 0 {
+		return nil, errorx.ErrUnauthorized()
+	}
+
+	// 2. 调用 RPC 查询
+	rpcResp, err := l.svcCtx.CreditServiceRpc.ListCreditAppeals(l.ctx, &creditservice.ListCreditAppealsReq{
+		UserId:   userId,
+		Status:   req.Status,
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	})
+	if err != nil {
+		l.Errorf("调用 CreditServiceRpc.ListCreditAppeals 失败: userId=%d, err=%v", userId, err)
+		return nil, errorx.FromError(err)
+	}
+
+	return ConvertAppealList(rpcResp), nil
+}
+
+// ConvertAppealList 将 RPC 申诉列表转换为 API 响应（管理员接口复用）
+func ConvertAppealList(rpcResp *creditservice.ListCreditAppealsResp) *types.ListCreditAppealsResp {
+	list := make([]types.CreditAppealItem, 0, len(rpcResp.List))
+	for _, item := range rpcResp.List {
+		list = append(list, types.CreditAppealItem{
+			Id:          item.Id,
+			UserId:      item.UserId,
+			CreditLogId: item.CreditLogId,
+			Delta:       item.Delta,
+			LogReason:   item.LogReason,
+			Reason:      item.Reason,
+			Status:      item.Status,
+			StatusName:  item.StatusName,
+			ReviewNote:  item.ReviewNote,
+			ReviewedAt:  item.ReviewedAt,
+			CreatedAt:   item.CreatedAt,
+		})
+	}
+	return &types.ListCreditAppealsResp{
+		List:  list,
+		Total: rpcResp.Total,
+	}
+}
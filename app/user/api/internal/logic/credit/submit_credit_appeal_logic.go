@@ -0,0 +1,62 @@
+/**
+ * @projectName: CampusHub
+ * @package: credit
+ * @className: SubmitCreditAppealLogic
+ * @author: lijunqi
+ * @description: 提交信用申诉业务逻辑
+ * @date: 2026-10-18
+ * @version: 1.0
+ */
+
+package credit
+
+import (
+	"context"
+
+	"activity-platform/app/user/api/internal/svc"
+	"activity-platform/app/user/api/internal/types"
+	"activity-platform/app/user/rpc/client/creditservice"
+	"activity-platform/common/ctxdata"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// SubmitCreditAppealLogic 提交信用申诉逻辑
+type SubmitCreditAppealLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// NewSubmitCreditAppealLogic 创建提交信用申诉逻辑实例
+func NewSubmitCreditAppealLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SubmitCreditAppealLogic {
+	return &SubmitCreditAppealLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// SubmitCreditAppeal 提交信用申诉
+func (l *SubmitCreditAppealLogic) SubmitCreditAppeal(req *types.SubmitCreditAppealReq) (resp *types.SubmitCreditAppealResp, err error) {
+	// 1. 从 JWT 中获取当前用户ID
+	userId := ctxdata.GetUserIDFromCtx(l.ctx)
+	if userId <= 0 {
+		return nil, errorx.ErrUnauthorized()
+	}
+
+	// 2. 调用 RPC 提交申诉（记录归属、时效、重复等校验在 RPC 层完成）
+	rpcResp, err := l.svcCtx.CreditServiceRpc.SubmitCreditAppeal(l.ctx, &creditservice.SubmitCreditAppealReq{
+		UserId:      userId,
+		CreditLogId: req.CreditLogId,
+		Reason:      req.Reason,
+	})
+	if err != nil {
+		l.Errorf("调用 CreditServiceRpc.SubmitCreditAppeal 失败: userId=%d, logId=%d, err=%v",
+			userId, req.CreditLogId, err)
+		return nil, errorx.FromError(err)
+	}
+
+	return &types.SubmitCreditAppealResp{AppealId: rpcResp.AppealId}, nil
+}
@@ -62,6 +62,12 @@ func (l *GetUserHomeLogic) GetUserHome(req *types.GetUserHomeReq) (resp *types.G
 			List:  make([]types.UserHomeActivityItem, 0),
 		},
 	}
+	if rpcResp.OrganizerRating != nil {
+		resp.OrganizerRating = types.UserHomeOrganizerRating{
+			RatingAvg:   rpcResp.OrganizerRating.RatingAvg,
+			RatingCount: rpcResp.OrganizerRating.RatingCount,
+		}
+	}
 
 	// 转换标签
 	for _, tag := range rpcResp.Tags {
@@ -77,6 +77,9 @@ func (l *GetVerifyCurrentLogic) GetVerifyCurrent() (resp *types.GetVerifyCurrent
 	if rpcResp.UpdatedAt > 0 {
 		resp.UpdatedAt = time.Unix(rpcResp.UpdatedAt, 0).Format(time.RFC3339)
 	}
+	if rpcResp.ExpireAt > 0 {
+		resp.ExpireAt = time.Unix(rpcResp.ExpireAt, 0).Format(time.RFC3339)
+	}
 
 	// 5. 转换 OCR 识别数据
 	if rpcResp.VerifyData != nil {
@@ -0,0 +1,79 @@
+/**
+ * @projectName: CampusHub
+ * @package: verify
+ * @className: ReverifyStudentLogic
+ * @author: lijunqi
+ * @description: 认证过期后重新认证业务逻辑（沿用原认证信息，仅重新上传学生证）
+ * @date: 2026-10-18
+ * @version: 1.0
+ */
+
+package verify
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"activity-platform/app/user/api/internal/svc"
+	"activity-platform/app/user/api/internal/types"
+	"activity-platform/app/user/rpc/client/verifyservice"
+	"activity-platform/common/ctxdata"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// ReverifyStudentLogic 重新认证逻辑处理器
+type ReverifyStudentLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// NewReverifyStudentLogic 创建重新认证逻辑实例
+func NewReverifyStudentLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ReverifyStudentLogic {
+	return &ReverifyStudentLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// ReverifyStudent 认证过期后重新认证
+// 仅已过期状态可用，提交后与常规申请一样进入 OCR 识别流程
+func (l *ReverifyStudentLogic) ReverifyStudent(req *types.ReverifyReq) (resp *types.ApplyVerifyResp, err error) {
+	userId := ctxdata.GetUserIDFromCtx(l.ctx)
+	if userId <= 0 {
+		l.Errorf("ReverifyStudent 获取用户ID失败")
+		return nil, errorx.ErrUnauthorized()
+	}
+
+	if strings.TrimSpace(req.FrontImageUrl) == "" {
+		return nil, errorx.ErrInvalidParams("学生证正面图片不能为空")
+	}
+	if strings.TrimSpace(req.BackImageUrl) == "" {
+		return nil, errorx.ErrInvalidParams("学生证详情面图片不能为空")
+	}
+
+	rpcResp, err := l.svcCtx.VerifyServiceRpc.ReverifyStudent(l.ctx, &verifyservice.ReverifyStudentReq{
+		UserId:        userId,
+		FrontImageUrl: req.FrontImageUrl,
+		BackImageUrl:  req.BackImageUrl,
+		Department:    strings.TrimSpace(req.Department),
+		AdmissionYear: strings.TrimSpace(req.AdmissionYear),
+	})
+	if err != nil {
+		l.Errorf("ReverifyStudent 调用认证 RPC 失败: userId=%d, err=%v", userId, err)
+		return nil, errorx.FromError(err)
+	}
+
+	l.Infof("ReverifyStudent 提交成功: userId=%d, verifyId=%d", userId, rpcResp.VerifyId)
+
+	return &types.ApplyVerifyResp{
+		VerifyId:   rpcResp.VerifyId,
+		Status:     rpcResp.Status,
+		StatusDesc: rpcResp.StatusDesc,
+		CreatedAt:  time.Unix(rpcResp.CreatedAt, 0).Format(time.RFC3339),
+	}, nil
+}
@@ -9,11 +9,13 @@ import (
 	"activity-platform/app/user/model"
 	"activity-platform/app/user/rpc/client/captchaservice"
 	"activity-platform/app/user/rpc/client/creditservice"
+	"activity-platform/app/user/rpc/client/platformstatsservice"
 	"activity-platform/app/user/rpc/client/qqemail"
 	"activity-platform/app/user/rpc/client/tagservice"
 	"activity-platform/app/user/rpc/client/uploadtoqiniu"
 	"activity-platform/app/user/rpc/client/userbasicservice"
 	"activity-platform/app/user/rpc/client/verifyservice"
+	commonmw "activity-platform/common/middleware"
 	"time"
 
 	"github.com/go-redis/redis/v8"
@@ -28,6 +30,8 @@ import (
 type ServiceContext struct {
 	Config             config.Config
 	UserRoleMiddleware rest.Middleware
+	// AdminRoleMiddleware 管理员鉴权中间件（校验角色、令牌黑名单、账号状态）
+	AdminRoleMiddleware rest.Middleware
 
 	Redis *redis.Client
 	// DB GORM数据库连接
@@ -55,6 +59,9 @@ type ServiceContext struct {
 
 	// UploadToQiNiuRpc 七牛云上传服务 RPC 客户端
 	UploadToQiNiuRpc uploadtoqiniu.UploadToQiNiu
+
+	// PlatformStatsRpc 平台统计服务 RPC 客户端（管理后台运营报表）
+	PlatformStatsRpc platformstatsservice.PlatformStatsService
 }
 
 func NewServiceContext(c config.Config) *ServiceContext {
@@ -69,11 +76,12 @@ func NewServiceContext(c config.Config) *ServiceContext {
 	db := initDB(c)
 
 	return &ServiceContext{
-		Config:             c,
-		UserRoleMiddleware: middleware.NewUserRoleMiddleware().Handle,
-		Redis:              rdb,
-		DB:                 db,
-		UserModel:          model.NewUserModel(db),
+		Config:              c,
+		UserRoleMiddleware:  middleware.NewUserRoleMiddleware().Handle,
+		AdminRoleMiddleware: commonmw.NewAdminRoleMiddleware(db, rdb, c.Auth.AccessSecret).Handle,
+		Redis:               rdb,
+		DB:                  db,
+		UserModel:           model.NewUserModel(db),
 
 		// 初始化 RPC 客户端
 		CaptchaServiceRpc:   captchaservice.NewCaptchaService(userRpcClient),
@@ -83,6 +91,7 @@ func NewServiceContext(c config.Config) *ServiceContext {
 		UserBasicServiceRpc: userbasicservice.NewUserBasicService(userRpcClient),
 		QQEmailRpc:          qqemail.NewQQEmail(userRpcClient),
 		UploadToQiNiuRpc:    uploadtoqiniu.NewUploadToQiNiu(userRpcClient),
+		PlatformStatsRpc:    platformstatsservice.NewPlatformStatsService(userRpcClient),
 	}
 }
 
@@ -3,6 +3,26 @@
 
 package types
 
+type AdminAdjustScoreReq struct {
+	UserId int64  `json:"user_id"`
+	Delta  int64  `json:"delta"`
+	Reason string `json:"reason"`
+}
+
+type AdminAdjustScoreResp struct {
+	BeforeScore int64 `json:"before_score"`
+	AfterScore  int64 `json:"after_score"`
+	Delta       int64 `json:"delta"`
+	NewLevel    int32 `json:"new_level"`
+}
+
+type AdminListCreditAppealsReq struct {
+	UserId   int64 `form:"user_id,optional"`
+	Status   int32 `form:"status,optional"`
+	Page     int32 `form:"page,optional,default=1"`
+	PageSize int32 `form:"page_size,optional,default=20"`
+}
+
 type ApplyVerifyReq struct {
 	RealName      string `json:"real_name"`
 	SchoolName    string `json:"school_name"`
@@ -40,6 +60,15 @@ type ChangePasswordResp struct {
 	Success bool `json:"success"`
 }
 
+type ClaimVerifyReviewReq struct {
+	Id int64 `path:"id"`
+}
+
+type ClaimVerifyReviewResp struct {
+	ClaimedBy int64 `json:"claimed_by"`
+	ExpireAt  int64 `json:"expire_at"`
+}
+
 type ConfirmVerifyReq struct {
 	VerifyId     int64         `json:"verify_id"`
 	IsConfirmed  bool          `json:"is_confirmed"`
@@ -52,6 +81,34 @@ type ConfirmVerifyResp struct {
 	NewStatusDesc string `json:"new_status_desc"`
 }
 
+type CreditAppealItem struct {
+	Id          int64  `json:"id"`
+	UserId      int64  `json:"user_id"`
+	CreditLogId int64  `json:"credit_log_id"`
+	Delta       int32  `json:"delta"`
+	LogReason   string `json:"log_reason"`
+	Reason      string `json:"reason"`
+	Status      int32  `json:"status"`
+	StatusName  string `json:"status_name"`
+	ReviewNote  string `json:"review_note"`
+	ReviewedAt  int64  `json:"reviewed_at"`
+	CreatedAt   int64  `json:"created_at"`
+}
+
+type CreditAuditItem struct {
+	Id          int64  `json:"id"`
+	OperatorId  int64  `json:"operator_id"`
+	UserId      int64  `json:"user_id"`
+	Action      string `json:"action"`
+	TargetId    int64  `json:"target_id"`
+	SourceId    string `json:"source_id"`
+	Delta       int32  `json:"delta"`
+	BeforeScore int32  `json:"before_score"`
+	AfterScore  int32  `json:"after_score"`
+	Reason      string `json:"reason"`
+	CreatedAt   int64  `json:"created_at"`
+}
+
 type CreditLogItem struct {
 	Id             int64  `json:"id"`
 	UserId         int64  `json:"user_id"`
@@ -103,6 +160,18 @@ type GetInterestTagsResp struct {
 	InterestTags []InterestTag `json:"interestTags"`
 }
 
+type GetPlatformStatsReq struct {
+	StartDate string `form:"start_date,optional"`
+	EndDate   string `form:"end_date,optional"`
+	Metrics   string `form:"metrics,optional"`
+}
+
+type GetPlatformStatsResp struct {
+	StartDate string             `json:"start_date"`
+	EndDate   string             `json:"end_date"`
+	List      []PlatformStatItem `json:"list"`
+}
+
 type GetRegisterCodeReq struct {
 	QqEmail string `form:"qq_email"`
 }
@@ -116,10 +185,11 @@ type GetUserHomeReq struct {
 }
 
 type GetUserHomeResp struct {
-	UserInfo            UserHomeInfo         `json:"userInfo"`
-	Tags                []InterestTag        `json:"tags"`
-	JoinedActivities    UserHomeActivityList `json:"joinedActivities"`
-	PublishedActivities UserHomeActivityList `json:"publishedActivities"`
+	UserInfo            UserHomeInfo            `json:"userInfo"`
+	Tags                []InterestTag           `json:"tags"`
+	JoinedActivities    UserHomeActivityList    `json:"joinedActivities"`
+	PublishedActivities UserHomeActivityList    `json:"publishedActivities"`
+	OrganizerRating     UserHomeOrganizerRating `json:"organizerRating"`
 }
 
 type GetVerifyCurrentResp struct {
@@ -135,6 +205,17 @@ type GetVerifyCurrentResp struct {
 	RejectReason string      `json:"reject_reason,optional"`
 	CreatedAt    string      `json:"created_at,optional"`
 	UpdatedAt    string      `json:"updated_at,optional"`
+	ExpireAt     string      `json:"expire_at,optional"`
+}
+
+type GetVerifyReviewStatsReq struct {
+	StartTime int64 `form:"start_time,optional"`
+	EndTime   int64 `form:"end_time,optional"`
+}
+
+type GetVerifyReviewStatsResp struct {
+	List    []VerifyReviewerStat `json:"list"`
+	Pending int64                `json:"pending"`
 }
 
 type InterestTag struct {
@@ -145,6 +226,40 @@ type InterestTag struct {
 	TagDesc  string `json:"tagDesc"`
 }
 
+type ListCreditAppealsReq struct {
+	Status   int32 `form:"status,optional"`
+	Page     int32 `form:"page,optional,default=1"`
+	PageSize int32 `form:"page_size,optional,default=20"`
+}
+
+type ListCreditAppealsResp struct {
+	List  []CreditAppealItem `json:"list"`
+	Total int64              `json:"total"`
+}
+
+type ListCreditAuditsReq struct {
+	UserId     int64  `form:"user_id,optional"`
+	OperatorId int64  `form:"operator_id,optional"`
+	Action     string `form:"action,optional"`
+	Page       int32  `form:"page,optional,default=1"`
+	PageSize   int32  `form:"page_size,optional,default=20"`
+}
+
+type ListCreditAuditsResp struct {
+	List  []CreditAuditItem `json:"list"`
+	Total int64             `json:"total"`
+}
+
+type ListVerifyReviewsReq struct {
+	Page     int32 `form:"page,optional,default=1"`
+	PageSize int32 `form:"page_size,optional,default=20"`
+}
+
+type ListVerifyReviewsResp struct {
+	List  []VerifyReviewItem `json:"list"`
+	Total int64              `json:"total"`
+}
+
 type LoginReq struct {
 	QqEmail       string `json:"qqEmail"`
 	Password      string `json:"password"`
@@ -176,6 +291,13 @@ type ModifiedData struct {
 	AdmissionYear string `json:"admission_year,optional"`
 }
 
+type PlatformStatItem struct {
+	Date      string  `json:"date"`
+	Metric    string  `json:"metric"`
+	Dimension string  `json:"dimension"`
+	Value     float64 `json:"value"`
+}
+
 type RefreshTokenReq struct {
 	RefreshToken string `json:"refreshToken"`
 }
@@ -197,6 +319,44 @@ type RegisterResp struct {
 	UserInfo     UserInfo `json:"userInfo"`
 }
 
+type ReverifyReq struct {
+	FrontImageUrl string `json:"front_image_url"`
+	BackImageUrl  string `json:"back_image_url"`
+	Department    string `json:"department,optional"`
+	AdmissionYear string `json:"admission_year,optional"`
+}
+
+type ReviewCreditAppealReq struct {
+	Id     int64  `path:"id"`
+	Accept bool   `json:"accept"`
+	Note   string `json:"note,optional"`
+}
+
+type ReviewCreditAppealResp struct {
+	Status int32 `json:"status"`
+	Score  int64 `json:"score"`
+}
+
+type ReviewStudentVerifyReq struct {
+	Id      int64  `path:"id"`
+	Approve bool   `json:"approve"`
+	Reason  string `json:"reason,optional"`
+}
+
+type ReviewStudentVerifyResp struct {
+	BeforeStatus int32 `json:"before_status"`
+	AfterStatus  int32 `json:"after_status"`
+}
+
+type SubmitCreditAppealReq struct {
+	CreditLogId int64  `json:"credit_log_id"`
+	Reason      string `json:"reason"`
+}
+
+type SubmitCreditAppealResp struct {
+	AppealId int64 `json:"appeal_id"`
+}
+
 type UpdateInterestReq struct {
 	InterestTagIds []int64 `json:"interestTagIds"`
 }
@@ -245,6 +405,11 @@ type UserHomeActivityList struct {
 	List  []UserHomeActivityItem `json:"list"`
 }
 
+type UserHomeOrganizerRating struct {
+	RatingAvg   float64 `json:"ratingAvg"`
+	RatingCount int64   `json:"ratingCount"`
+}
+
 type UserHomeInfo struct {
 	UserId       int64  `json:"userId"`
 	Nickname     string `json:"nickname"`
@@ -289,3 +454,28 @@ type VerifyData struct {
 	AdmissionYear string `json:"admission_year"`
 	VerifiedAt    string `json:"verified_at,optional"`
 }
+
+type VerifyReviewItem struct {
+	VerifyId      int64   `json:"verify_id"`
+	UserId        int64   `json:"user_id"`
+	Status        int32   `json:"status"`
+	RealName      string  `json:"real_name"`
+	SchoolName    string  `json:"school_name"`
+	StudentId     string  `json:"student_id"`
+	Department    string  `json:"department"`
+	AdmissionYear string  `json:"admission_year"`
+	FrontImageUrl string  `json:"front_image_url"`
+	BackImageUrl  string  `json:"back_image_url"`
+	OcrPlatform   string  `json:"ocr_platform"`
+	OcrConfidence float64 `json:"ocr_confidence"`
+	ClaimedBy     int64   `json:"claimed_by"`
+	CreatedAt     int64   `json:"created_at"`
+	UpdatedAt     int64   `json:"updated_at"`
+}
+
+type VerifyReviewerStat struct {
+	ReviewerId int64 `json:"reviewer_id"`
+	Approved   int64 `json:"approved"`
+	Rejected   int64 `json:"rejected"`
+	Total      int64 `json:"total"`
+}
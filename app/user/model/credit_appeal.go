@@ -0,0 +1,188 @@
+/**
+ * @projectName: CampusHub
+ * @package: model
+ * @className: CreditAppeal
+ * @author: lijunqi
+ * @description: 信用申诉实体及数据访问层
+ * @date: 2026-10-18
+ * @version: 1.0
+ */
+
+package model
+
+import (
+	"context"
+	"time"
+
+	"activity-platform/common/constants"
+
+	"gorm.io/gorm"
+)
+
+// CreditAppeal 信用申诉实体
+// 用户对某条扣分记录（如误判的爽约）提出申诉，管理员通过后撤销该记录的扣分
+type CreditAppeal struct {
+	// 主键ID
+	ID int64 `gorm:"primaryKey;autoIncrement;column:id" json:"id"`
+	// 申诉用户ID
+	UserID int64 `gorm:"index:idx_user_status;column:user_id;not null" json:"user_id"`
+	// 被申诉的信用变更记录ID（每条记录只能申诉一次）
+	CreditLogID int64 `gorm:"uniqueIndex:uk_credit_log_id;column:credit_log_id;not null" json:"credit_log_id"`
+	// 申诉理由
+	Reason string `gorm:"column:reason;size:500;not null" json:"reason"`
+	// 状态：1待处理 2已通过 3已驳回
+	Status int8 `gorm:"index:idx_user_status;index:idx_status;column:status;not null;default:1" json:"status"`
+	// 处理人（管理员）ID
+	ReviewerID int64 `gorm:"column:reviewer_id;not null;default:0" json:"reviewer_id"`
+	// 处理意见
+	ReviewNote string `gorm:"column:review_note;size:500" json:"review_note"`
+	// 处理时间
+	ReviewedAt *time.Time `gorm:"column:reviewed_at" json:"reviewed_at"`
+	// 创建时间
+	CreatedAt time.Time `gorm:"column:created_at;autoCreateTime" json:"created_at"`
+	// 更新时间
+	UpdatedAt time.Time `gorm:"column:updated_at;autoUpdateTime" json:"updated_at"`
+}
+
+// TableName 指定表名
+func (CreditAppeal) TableName() string {
+	return "credit_appeals"
+}
+
+// CreditAppealQuery 申诉查询条件
+type CreditAppealQuery struct {
+	// UserID 用户ID（0表示全部）
+	UserID int64
+	// Status 状态（0表示全部）
+	Status int8
+	// Offset 偏移量
+	Offset int
+	// Limit 每页条数
+	Limit int
+}
+
+// ICreditAppealModel 信用申诉数据访问层接口
+type ICreditAppealModel interface {
+	// Create 创建申诉
+	Create(ctx context.Context, appeal *CreditAppeal) error
+	// FindByID 根据主键ID查询
+	FindByID(ctx context.Context, id int64) (*CreditAppeal, error)
+	// FindByCreditLogID 根据信用变更记录ID查询
+	FindByCreditLogID(ctx context.Context, creditLogID int64) (*CreditAppeal, error)
+	// CountPendingByUserID 统计用户待处理的申诉数量
+	CountPendingByUserID(ctx context.Context, userID int64) (int64, error)
+	// ListByQuery 按条件查询申诉列表
+	ListByQuery(ctx context.Context, query *CreditAppealQuery) ([]*CreditAppeal, error)
+	// CountByQuery 按条件统计申诉数量
+	CountByQuery(ctx context.Context, query *CreditAppealQuery) (int64, error)
+	// UpdateReview 处理申诉（仅当状态为 fromStatus 时更新，返回是否更新成功）
+	UpdateReview(ctx context.Context, id int64, fromStatus, toStatus int8, reviewerID int64, note string) (bool, error)
+}
+
+// 确保 CreditAppealModel 实现 ICreditAppealModel 接口
+var _ ICreditAppealModel = (*CreditAppealModel)(nil)
+
+// CreditAppealModel 信用申诉数据访问层
+type CreditAppealModel struct {
+	db *gorm.DB
+}
+
+// NewCreditAppealModel 创建信用申诉Model实例
+func NewCreditAppealModel(db *gorm.DB) ICreditAppealModel {
+	return &CreditAppealModel{db: db}
+}
+
+// Create 创建申诉
+func (m *CreditAppealModel) Create(ctx context.Context, appeal *CreditAppeal) error {
+	return m.db.WithContext(ctx).Create(appeal).Error
+}
+
+// FindByID 根据主键ID查询
+func (m *CreditAppealModel) FindByID(ctx context.Context, id int64) (*CreditAppeal, error) {
+	var appeal CreditAppeal
+	err := m.db.WithContext(ctx).First(&appeal, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &appeal, nil
+}
+
+// FindByCreditLogID 根据信用变更记录ID查询
+func (m *CreditAppealModel) FindByCreditLogID(ctx context.Context, creditLogID int64) (*CreditAppeal, error) {
+	var appeal CreditAppeal
+	err := m.db.WithContext(ctx).Where("credit_log_id = ?", creditLogID).First(&appeal).Error
+	if err != nil {
+		return nil, err
+	}
+	return &appeal, nil
+}
+
+// CountPendingByUserID 统计用户待处理的申诉数量
+func (m *CreditAppealModel) CountPendingByUserID(ctx context.Context, userID int64) (int64, error) {
+	var count int64
+	err := m.db.WithContext(ctx).
+		Model(&CreditAppeal{}).
+		Where("user_id = ? AND status = ?", userID, constants.CreditAppealStatusPending).
+		Count(&count).Error
+	return count, err
+}
+
+// buildQueryCondition 构建查询条件
+func (m *CreditAppealModel) buildQueryCondition(query *CreditAppealQuery) *gorm.DB {
+	db := m.db.Model(&CreditAppeal{})
+	if query.UserID > 0 {
+		db = db.Where("user_id = ?", query.UserID)
+	}
+	if query.Status > 0 {
+		db = db.Where("status = ?", query.Status)
+	}
+	return db
+}
+
+// ListByQuery 按条件查询申诉列表
+func (m *CreditAppealModel) ListByQuery(ctx context.Context, query *CreditAppealQuery) ([]*CreditAppeal, error) {
+	var appeals []*CreditAppeal
+	err := m.buildQueryCondition(query).
+		WithContext(ctx).
+		Order("id DESC").
+		Offset(query.Offset).
+		Limit(query.Limit).
+		Find(&appeals).Error
+	if err != nil {
+		return nil, err
+	}
+	return appeals, nil
+}
+
+// CountByQuery 按条件统计申诉数量
+func (m *CreditAppealModel) CountByQuery(ctx context.Context, query *CreditAppealQuery) (int64, error) {
+	var count int64
+	err := m.buildQueryCondition(query).
+		WithContext(ctx).
+		Count(&count).Error
+	return count, err
+}
+
+// UpdateReview 处理申诉（仅当状态为 fromStatus 时更新，返回是否更新成功）
+func (m *CreditAppealModel) UpdateReview(
+	ctx context.Context,
+	id int64,
+	fromStatus, toStatus int8,
+	reviewerID int64,
+	note string,
+) (bool, error) {
+	now := time.Now()
+	result := m.db.WithContext(ctx).
+		Model(&CreditAppeal{}).
+		Where("id = ? AND status = ?", id, fromStatus).
+		Updates(map[string]interface{}{
+			"status":      toStatus,
+			"reviewer_id": reviewerID,
+			"review_note": note,
+			"reviewed_at": &now,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
@@ -0,0 +1,131 @@
+/**
+ * @projectName: CampusHub
+ * @package: model
+ * @className: CreditAuditLog
+ * @author: lijunqi
+ * @description: 信用管理操作审计日志实体及数据访问层
+ * @date: 2026-10-18
+ * @version: 1.0
+ */
+
+package model
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// CreditAuditLog 信用管理操作审计日志
+// 记录管理员对信用分的所有人工操作（手动调整、申诉处理），只增不改
+type CreditAuditLog struct {
+	// 主键ID
+	ID int64 `gorm:"primaryKey;autoIncrement;column:id" json:"id"`
+	// 操作人（管理员）ID
+	OperatorID int64 `gorm:"index:idx_operator_id;column:operator_id;not null" json:"operator_id"`
+	// 被操作用户ID
+	UserID int64 `gorm:"index:idx_user_id;column:user_id;not null" json:"user_id"`
+	// 操作类型：adjust / appeal_accept / appeal_reject
+	Action string `gorm:"column:action;size:32;not null" json:"action"`
+	// 关联对象ID（申诉ID；手动调整为 0）
+	TargetID int64 `gorm:"column:target_id;not null;default:0" json:"target_id"`
+	// 关联的信用变更来源ID（未产生分数变动时为空）
+	SourceID string `gorm:"column:source_id;size:128" json:"source_id"`
+	// 实际分数变动
+	Delta int `gorm:"column:delta;not null;default:0" json:"delta"`
+	// 变更前分数
+	BeforeScore int `gorm:"column:before_score;not null;default:0" json:"before_score"`
+	// 变更后分数
+	AfterScore int `gorm:"column:after_score;not null;default:0" json:"after_score"`
+	// 操作原因/备注
+	Reason string `gorm:"column:reason;size:500" json:"reason"`
+	// 创建时间
+	CreatedAt time.Time `gorm:"column:created_at;autoCreateTime" json:"created_at"`
+}
+
+// TableName 指定表名
+func (CreditAuditLog) TableName() string {
+	return "credit_audit_logs"
+}
+
+// CreditAuditQuery 审计日志查询条件
+type CreditAuditQuery struct {
+	// UserID 被操作用户ID（0表示全部）
+	UserID int64
+	// OperatorID 操作人ID（0表示全部）
+	OperatorID int64
+	// Action 操作类型（空表示全部）
+	Action string
+	// Offset 偏移量
+	Offset int
+	// Limit 每页条数
+	Limit int
+}
+
+// ICreditAuditLogModel 信用审计日志数据访问层接口
+type ICreditAuditLogModel interface {
+	// Create 写入审计日志
+	Create(ctx context.Context, log *CreditAuditLog) error
+	// ListByQuery 按条件查询审计日志
+	ListByQuery(ctx context.Context, query *CreditAuditQuery) ([]*CreditAuditLog, error)
+	// CountByQuery 按条件统计审计日志数量
+	CountByQuery(ctx context.Context, query *CreditAuditQuery) (int64, error)
+}
+
+// 确保 CreditAuditLogModel 实现 ICreditAuditLogModel 接口
+var _ ICreditAuditLogModel = (*CreditAuditLogModel)(nil)
+
+// CreditAuditLogModel 信用审计日志数据访问层
+type CreditAuditLogModel struct {
+	db *gorm.DB
+}
+
+// NewCreditAuditLogModel 创建信用审计日志Model实例
+func NewCreditAuditLogModel(db *gorm.DB) ICreditAuditLogModel {
+	return &CreditAuditLogModel{db: db}
+}
+
+// Create 写入审计日志
+func (m *CreditAuditLogModel) Create(ctx context.Context, log *CreditAuditLog) error {
+	return m.db.WithContext(ctx).Create(log).Error
+}
+
+// buildQueryCondition 构建查询条件
+func (m *CreditAuditLogModel) buildQueryCondition(query *CreditAuditQuery) *gorm.DB {
+	db := m.db.Model(&CreditAuditLog{})
+	if query.UserID > 0 {
+		db = db.Where("user_id = ?", query.UserID)
+	}
+	if query.OperatorID > 0 {
+		db = db.Where("operator_id = ?", query.OperatorID)
+	}
+	if query.Action != "" {
+		db = db.Where("action = ?", query.Action)
+	}
+	return db
+}
+
+// ListByQuery 按条件查询审计日志
+func (m *CreditAuditLogModel) ListByQuery(ctx context.Context, query *CreditAuditQuery) ([]*CreditAuditLog, error) {
+	var logs []*CreditAuditLog
+	err := m.buildQueryCondition(query).
+		WithContext(ctx).
+		Order("id DESC").
+		Offset(query.Offset).
+		Limit(query.Limit).
+		Find(&logs).Error
+	if err != nil {
+		return nil, err
+	}
+	return logs, nil
+}
+
+// CountByQuery 按条件统计审计日志数量
+func (m *CreditAuditLogModel) CountByQuery(ctx context.Context, query *CreditAuditQuery) (int64, error) {
+	var count int64
+	err := m.buildQueryCondition(query).
+		WithContext(ctx).
+		Count(&count).Error
+	return count, err
+}
@@ -74,6 +74,8 @@ type ICreditLogModel interface {
 	Create(ctx context.Context, log *CreditLog) error
 	// FindByID 根据主键ID查询
 	FindByID(ctx context.Context, id int64) (*CreditLog, error)
+	// FindByIDs 根据主键ID批量查询
+	FindByIDs(ctx context.Context, ids []int64) ([]*CreditLog, error)
 	// FindBySourceID 根据来源ID查询（用于幂等检查）
 	FindBySourceID(ctx context.Context, sourceID string) (*CreditLog, error)
 	// ExistsBySourceID 检查来源ID是否已存在（幂等检查）
@@ -117,6 +119,19 @@ func (m *CreditLogModel) FindByID(ctx context.Context, id int64) (*CreditLog, er
 	return &log, nil
 }
 
+// FindByIDs 根据主键ID批量查询
+func (m *CreditLogModel) FindByIDs(ctx context.Context, ids []int64) ([]*CreditLog, error) {
+	if len(ids) == 0 {
+		return []*CreditLog{}, nil
+	}
+	var logs []*CreditLog
+	err := m.db.WithContext(ctx).Where("id IN ?", ids).Find(&logs).Error
+	if err != nil {
+		return nil, err
+	}
+	return logs, nil
+}
+
 // FindBySourceID 根据来源ID查询（用于幂等检查）
 func (m *CreditLogModel) FindBySourceID(ctx context.Context, sourceID string) (*CreditLog, error) {
 	var log CreditLog
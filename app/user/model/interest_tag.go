@@ -41,6 +41,8 @@ type InterestTag struct {
 	Status int64 `gorm:"column:status;default:1" json:"status"`
 	// 标签描述
 	TagDesc string `gorm:"column:tag_desc;size:200;default:''" json:"tag_desc"`
+	// 被合并到的标签ID（0-未合并）
+	MergedInto int64 `gorm:"column:merged_into;default:0" json:"merged_into"`
 	// 标签创建时间
 	CreateTime time.Time `gorm:"column:create_time;autoCreateTime" json:"create_time"`
 	// 标签更新时间
@@ -74,6 +76,10 @@ type IInterestTagModel interface {
 	Update(ctx context.Context, tag *InterestTag) error
 	// IncrementUsageCount 增加使用次数
 	IncrementUsageCount(ctx context.Context, tagID int64, delta int) error
+	// UpdateFields 按字段更新标签
+	UpdateFields(ctx context.Context, tagID int64, fields map[string]interface{}) error
+	// MergeInto 合并标签（事务内使用）：源标签禁用并记录合并目标，使用次数累加到目标标签
+	MergeInto(ctx context.Context, tx *gorm.DB, source *InterestTag, targetID int64) error
 }
 
 // 确保 InterestTagModel 实现 IInterestTagModel 接口
@@ -191,3 +197,31 @@ func (m *InterestTagModel) IncrementUsageCount(ctx context.Context, tagID int64,
 		Where("tag_id = ?", tagID).
 		UpdateColumn("usage_count", gorm.Expr("usage_count + ?", delta)).Error
 }
+
+// UpdateFields 按字段更新标签
+func (m *InterestTagModel) UpdateFields(ctx context.Context, tagID int64, fields map[string]interface{}) error {
+	return m.db.WithContext(ctx).
+		Model(&InterestTag{}).
+		Where("tag_id = ?", tagID).
+		Updates(fields).Error
+}
+
+// MergeInto 合并标签（事务内使用）
+//
+// 源标签：禁用、记录 merged_into、使用次数清零；目标标签：累加源标签的使用次数
+func (m *InterestTagModel) MergeInto(ctx context.Context, tx *gorm.DB, source *InterestTag, targetID int64) error {
+	if err := tx.WithContext(ctx).
+		Model(&InterestTag{}).
+		Where("tag_id = ?", targetID).
+		UpdateColumn("usage_count", gorm.Expr("usage_count + ?", source.UsageCount)).Error; err != nil {
+		return err
+	}
+	return tx.WithContext(ctx).
+		Model(&InterestTag{}).
+		Where("tag_id = ?", source.TagID).
+		Updates(map[string]interface{}{
+			"status":      TagStatusDisabled,
+			"merged_into": targetID,
+			"usage_count": 0,
+		}).Error
+}
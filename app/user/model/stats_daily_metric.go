@@ -0,0 +1,163 @@
+/**
+ * @projectName: CampusHub
+ * @package: model
+ * @className: StatsDailyMetric
+ * @author: lijunqi
+ * @description: 平台统计日指标实体及数据访问层（含用户域指标的聚合查询）
+ * @date: 2026-10-19
+ * @version: 1.0
+ */
+
+package model
+
+import (
+	"context"
+	"time"
+
+	"activity-platform/common/constants"
+
+	"gorm.io/gorm"
+)
+
+// StatsDailyMetric 平台统计日指标
+// 以 日期 + 指标 + 维度 唯一，由各服务的定时任务按天汇总写入，管理后台按日期范围查询与导出
+type StatsDailyMetric struct {
+	// 主键ID
+	ID int64 `gorm:"primaryKey;autoIncrement;column:id" json:"id"`
+	// 统计日期 yyyy-MM-dd
+	StatDate string `gorm:"uniqueIndex:uk_date_metric_dim,priority:1;column:stat_date;type:char(10);not null" json:"stat_date"`
+	// 指标名称
+	Metric string `gorm:"uniqueIndex:uk_date_metric_dim,priority:2;column:metric;size:50;not null" json:"metric"`
+	// 维度（为空表示总量）
+	Dimension string `gorm:"uniqueIndex:uk_date_metric_dim,priority:3;column:dimension;size:64;not null;default:''" json:"dimension"`
+	// 数值
+	Value float64 `gorm:"column:value;not null;default:0" json:"value"`
+	// 数据来源服务：user/activity/chat
+	Source string `gorm:"column:source;size:20;not null" json:"source"`
+	// 更新时间
+	UpdatedAt time.Time `gorm:"column:updated_at;autoUpdateTime" json:"updated_at"`
+}
+
+// TableName 指定表名
+func (StatsDailyMetric) TableName() string {
+	return "stats_daily_metrics"
+}
+
+// IStatsDailyMetricModel 平台统计日指标数据访问层接口
+type IStatsDailyMetricModel interface {
+	// ReplaceDay 整体替换某日指定指标的数据（事务内先删后插）
+	ReplaceDay(ctx context.Context, statDate string, metrics []string, rows []*StatsDailyMetric) error
+	// ListByRange 按日期范围查询（metrics 为空返回全部指标）
+	ListByRange(ctx context.Context, startDate, endDate string, metrics []string) ([]*StatsDailyMetric, error)
+
+	// ==================== 用户域指标聚合 ====================
+
+	// CountNewUsers 统计时间范围内注册的用户数
+	CountNewUsers(ctx context.Context, since, until time.Time) (int64, error)
+	// CountCreditLevels 统计当前各信用等级的用户数
+	CountCreditLevels(ctx context.Context) (map[int8]int64, error)
+	// CountVerifyPassed 统计时间范围内认证通过数（按 verified_at）
+	CountVerifyPassed(ctx context.Context, since, until time.Time) (int64, error)
+	// CountVerifyRejected 统计时间范围内认证拒绝数（按 reviewed_at）
+	CountVerifyRejected(ctx context.Context, since, until time.Time) (int64, error)
+}
+
+// 确保 StatsDailyMetricModel 实现 IStatsDailyMetricModel 接口
+var _ IStatsDailyMetricModel = (*StatsDailyMetricModel)(nil)
+
+// StatsDailyMetricModel 平台统计日指标数据访问层
+type StatsDailyMetricModel struct {
+	db *gorm.DB
+}
+
+// NewStatsDailyMetricModel 创建平台统计日指标Model实例
+func NewStatsDailyMetricModel(db *gorm.DB) IStatsDailyMetricModel {
+	return &StatsDailyMetricModel{db: db}
+}
+
+// ReplaceDay 整体替换某日指定指标的数据
+// 同一指标的维度集合可能变化（如分类改名），整体替换避免遗留过期维度
+func (m *StatsDailyMetricModel) ReplaceDay(ctx context.Context, statDate string, metrics []string, rows []*StatsDailyMetric) error {
+	if len(metrics) == 0 {
+		return nil
+	}
+	return m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("stat_date = ? AND metric IN ?", statDate, metrics).
+			Delete(&StatsDailyMetric{}).Error; err != nil {
+			return err
+		}
+		if len(rows) == 0 {
+			return nil
+		}
+		return tx.Create(&rows).Error
+	})
+}
+
+// ListByRange 按日期范围查询
+func (m *StatsDailyMetricModel) ListByRange(ctx context.Context, startDate, endDate string, metrics []string) ([]*StatsDailyMetric, error) {
+	db := m.db.WithContext(ctx).
+		Where("stat_date >= ? AND stat_date <= ?", startDate, endDate)
+	if len(metrics) > 0 {
+		db = db.Where("metric IN ?", metrics)
+	}
+
+	var list []*StatsDailyMetric
+	err := db.Order("stat_date ASC, metric ASC, dimension ASC").Find(&list).Error
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// CountNewUsers 统计时间范围内注册的用户数
+func (m *StatsDailyMetricModel) CountNewUsers(ctx context.Context, since, until time.Time) (int64, error) {
+	var count int64
+	err := m.db.WithContext(ctx).
+		Model(&User{}).
+		Where("create_time >= ? AND create_time < ?", since, until).
+		Count(&count).Error
+	return count, err
+}
+
+// CountCreditLevels 统计当前各信用等级的用户数
+func (m *StatsDailyMetricModel) CountCreditLevels(ctx context.Context) (map[int8]int64, error) {
+	var rows []struct {
+		Level int8  `gorm:"column:level"`
+		Total int64 `gorm:"column:total"`
+	}
+	err := m.db.WithContext(ctx).
+		Model(&UserCredit{}).
+		Select("level, COUNT(*) AS total").
+		Group("level").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[int8]int64, len(rows))
+	for _, r := range rows {
+		result[r.Level] = r.Total
+	}
+	return result, nil
+}
+
+// CountVerifyPassed 统计时间范围内认证通过数
+// 通过时写入 verified_at，之后过期（9）的记录同样计入当天的通过数
+func (m *StatsDailyMetricModel) CountVerifyPassed(ctx context.Context, since, until time.Time) (int64, error) {
+	var count int64
+	err := m.db.WithContext(ctx).
+		Model(&StudentVerification{}).
+		Where("verified_at >= ? AND verified_at < ?", since, until).
+		Count(&count).Error
+	return count, err
+}
+
+// CountVerifyRejected 统计时间范围内认证拒绝数
+func (m *StatsDailyMetricModel) CountVerifyRejected(ctx context.Context, since, until time.Time) (int64, error) {
+	var count int64
+	err := m.db.WithContext(ctx).
+		Model(&StudentVerification{}).
+		Where("status = ? AND reviewed_at >= ? AND reviewed_at < ?", constants.VerifyStatusRejected, since, until).
+		Count(&count).Error
+	return count, err
+}
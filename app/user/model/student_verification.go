@@ -38,8 +38,8 @@ type StudentVerification struct {
 
 	// ==================== 状态机字段 ====================
 
-	// 认证状态：0初始 1OCR中 2待确认 3人工审核 4通过 5拒绝 6超时 7取消 8OCR失败
-	Status int8 `gorm:"column:status;not null;default:0" json:"status"`
+	// 认证状态：0初始 1OCR中 2待确认 3人工审核 4通过 5拒绝 6超时 7取消 8OCR失败 9过期
+	Status int8 `gorm:"column:status;not null;default:0;index:idx_status_expire,priority:1" json:"status"`
 
 	// ==================== 认证信息字段（敏感数据加密存储） ====================
 
@@ -51,6 +51,8 @@ type StudentVerification struct {
 	StudentID string `gorm:"column:student_id;size:255" json:"student_id"`
 	// 学号哈希（HMAC-SHA256，用于唯一性索引）
 	StudentIDHash string `gorm:"column:student_id_hash;size:64;not null;default:'';uniqueIndex:uk_school_student_hash,priority:2" json:"student_id_hash"`
+	// 学号哈希所用密钥ID（空表示旧版单密钥）
+	HashKeyID string `gorm:"column:hash_key_id;size:16;not null;default:''" json:"hash_key_id"`
 	// 院系
 	Department string `gorm:"column:department;size:100" json:"department"`
 	// 入学年份
@@ -65,12 +67,18 @@ type StudentVerification struct {
 
 	// ==================== OCR审计字段 ====================
 
-	// OCR平台：tencent/aliyun
+	// OCR平台：tencent/aliyun/local
 	OcrPlatform string `gorm:"column:ocr_platform;size:20;not null;default:''" json:"ocr_platform"`
 	// OCR原始响应JSON（用于审计追溯）
 	OcrRawJSON sql.NullString `gorm:"column:ocr_raw_json;type:text" json:"ocr_raw_json"`
 	// OCR识别置信度（0-100）
 	OcrConfidence sql.NullFloat64 `gorm:"column:ocr_confidence;type:decimal(5,2)" json:"ocr_confidence"`
+	// 判定策略结论：approve/confirm/manual_review/reject（空表示未启用策略）
+	PolicyDecision string `gorm:"column:policy_decision;size:20;not null;default:''" json:"policy_decision"`
+	// 判定策略总分（0-100）
+	PolicyScore sql.NullFloat64 `gorm:"column:policy_score;type:decimal(5,2)" json:"policy_score"`
+	// 判定策略明细JSON（各字段得分与原因，用于审计）
+	PolicyDetail sql.NullString `gorm:"column:policy_detail;type:text" json:"policy_detail"`
 
 	// ==================== 审核相关字段 ====================
 
@@ -80,7 +88,7 @@ type StudentVerification struct {
 	CancelReason string `gorm:"column:cancel_reason;size:255" json:"cancel_reason"`
 	// 审核人ID（人工审核时）
 	ReviewerID sql.NullInt64 `gorm:"column:reviewer_id" json:"reviewer_id"`
-	// 操作来源：user_apply/ocr_callback/manual_review/timeout_job
+	// 操作来源：user_apply/ocr_callback/manual_review/timeout_job/expiry_job/auto_policy
 	Operator string `gorm:"column:operator;size:50" json:"operator"`
 
 	// ==================== 时间字段 ====================
@@ -91,6 +99,10 @@ type StudentVerification struct {
 	OcrCompletedAt *time.Time `gorm:"column:ocr_completed_at" json:"ocr_completed_at"`
 	// 人工审核时间
 	ReviewedAt *time.Time `gorm:"column:reviewed_at" json:"reviewed_at"`
+	// 认证过期时间（预计毕业时间，NULL 表示未设置）
+	ExpireAt *time.Time `gorm:"column:expire_at;index:idx_status_expire,priority:2" json:"expire_at"`
+	// 过期提醒发送时间
+	ExpiryRemindedAt *time.Time `gorm:"column:expiry_reminded_at" json:"expiry_reminded_at"`
 	// 创建时间
 	CreatedAt time.Time `gorm:"column:created_at;autoCreateTime" json:"created_at"`
 	// 更新时间
@@ -113,6 +125,17 @@ type SensitiveDataCodec interface {
 	Decrypt(field, ciphertext string) (string, error)
 	HashStudentID(schoolName, studentID string) (string, error)
 	IsEncrypted(value string) bool
+
+	// ActiveKeyID 当前加密密钥ID
+	ActiveKeyID() string
+	// ActiveCipherPrefix 当前密钥生成的密文前缀
+	ActiveCipherPrefix() string
+	// NeedsReencrypt 密文是否不是由当前密钥加密
+	NeedsReencrypt(value string) bool
+	// HashKeyID 当前学号哈希密钥ID
+	HashKeyID() string
+	// HashStudentIDCandidates 使用全部哈希密钥生成学号哈希（轮换期间用于唯一性检查）
+	HashStudentIDCandidates(schoolName, studentID string) ([]string, error)
 }
 
 // IStudentVerificationModel 学生认证数据访问层接口
@@ -136,6 +159,8 @@ type IStudentVerificationModel interface {
 	ExistsBySchoolAndStudentID(ctx context.Context, schoolName, studentID string, excludeUserID int64) (bool, error)
 	// IsVerified 检查用户是否已通过认证
 	IsVerified(ctx context.Context, userID int64) (bool, error)
+	// FindPassedProfiles 批量查询已通过认证用户的非敏感字段
+	FindPassedProfiles(ctx context.Context, userIDs []int64) ([]*StudentVerification, error)
 
 	// ==================== 状态更新 ====================
 
@@ -152,6 +177,28 @@ type IStudentVerificationModel interface {
 	FindTimeoutRecords(ctx context.Context, timeoutMinutes int) ([]*StudentVerification, error)
 	// FindByStatus 根据状态查询列表（分页）
 	FindByStatus(ctx context.Context, status int8, page, pageSize int) ([]*StudentVerification, int64, error)
+	// CountByStatus 统计指定状态的记录数
+	CountByStatus(ctx context.Context, status int8) (int64, error)
+
+	// ==================== 认证过期 ====================
+
+	// FindPassedWithoutExpiry 查询未设置过期时间的已通过记录（按ID游标分批）
+	FindPassedWithoutExpiry(ctx context.Context, afterID int64, limit int) ([]*StudentVerification, error)
+	// FindExpiring 查询在 deadline 之前过期且尚未提醒的已通过记录（按ID游标分批）
+	FindExpiring(ctx context.Context, deadline time.Time, afterID int64, limit int) ([]*StudentVerification, error)
+	// FindExpired 查询已到过期时间的已通过记录（按ID游标分批）
+	FindExpired(ctx context.Context, now time.Time, afterID int64, limit int) ([]*StudentVerification, error)
+	// UpdateExpireAt 设置过期时间（同时清空提醒时间）
+	UpdateExpireAt(ctx context.Context, id int64, expireAt *time.Time) error
+	// MarkExpiryReminded 标记已发送过期提醒
+	MarkExpiryReminded(ctx context.Context, id int64, remindedAt time.Time) error
+
+	// ==================== 密钥轮换 ====================
+
+	// FindNeedRekey 查询未使用当前密钥加密/哈希的记录（按ID游标分批，返回密文）
+	FindNeedRekey(ctx context.Context, afterID int64, limit int) ([]*StudentVerification, error)
+	// Rekey 使用当前密钥重新加密并重算学号哈希（乐观更新，记录已被并发修改时返回 false）
+	Rekey(ctx context.Context, raw *StudentVerification) (bool, error)
 }
 
 // OcrResultData OCR识别结果数据
@@ -172,6 +219,12 @@ type OcrResultData struct {
 	OcrConfidence float64
 	// OCR原始响应JSON
 	OcrRawJSON string
+	// 判定策略结论（为空时不更新策略字段）
+	PolicyDecision string
+	// 判定策略总分
+	PolicyScore float64
+	// 判定策略明细JSON
+	PolicyDetail string
 }
 
 // VerifyModifiedData 用户修改后的数据
@@ -302,7 +355,8 @@ func (m *StudentVerificationModel) ExistsBySchoolAndStudentID(
 	schoolName, studentID string,
 	excludeUserID int64,
 ) (bool, error) {
-	studentIDHash, err := m.codec.HashStudentID(schoolName, studentID)
+	// 哈希密钥轮换期间新旧哈希并存，需同时匹配全部候选哈希
+	studentIDHashes, err := m.codec.HashStudentIDCandidates(schoolName, studentID)
 	if err != nil {
 		return false, err
 	}
@@ -312,7 +366,7 @@ func (m *StudentVerificationModel) ExistsBySchoolAndStudentID(
 		Model(&StudentVerification{}).
 		Where("school_name = ?", schoolName).
 		Where("status = ?", constants.VerifyStatusPassed).
-		Where("student_id_hash = ?", studentIDHash)
+		Where("student_id_hash IN ?", studentIDHashes)
 
 	// 排除指定用户
 	if excludeUserID > 0 {
@@ -341,6 +395,23 @@ func (m *StudentVerificationModel) IsVerified(
 	return count > 0, nil
 }
 
+// FindPassedProfiles 批量查询已通过认证用户的非敏感字段（无需解密）
+func (m *StudentVerificationModel) FindPassedProfiles(
+	ctx context.Context,
+	userIDs []int64,
+) ([]*StudentVerification, error) {
+	var list []*StudentVerification
+	if len(userIDs) == 0 {
+		return list, nil
+	}
+	err := m.db.WithContext(ctx).
+		Select("id", "user_id", "status", "school_name", "department", "admission_year", "expire_at").
+		Where("user_id IN ? AND status = ?", userIDs, constants.VerifyStatusPassed).
+		Where("expire_at IS NULL OR expire_at > ?", time.Now()).
+		Find(&list).Error
+	return list, err
+}
+
 // ==================== 状态更新 ====================
 
 // UpdateStatus 更新认证状态
@@ -390,6 +461,7 @@ func (m *StudentVerificationModel) UpdateOcrResult(
 		"school_name":      ocrData.SchoolName,
 		"student_id":       encryptedStudentID,
 		"student_id_hash":  studentIDHash,
+		"hash_key_id":      m.codec.HashKeyID(),
 		"department":       ocrData.Department,
 		"ocr_platform":     ocrData.OcrPlatform,
 		"ocr_confidence":   sql.NullFloat64{Float64: ocrData.OcrConfidence, Valid: true},
@@ -397,6 +469,11 @@ func (m *StudentVerificationModel) UpdateOcrResult(
 		"ocr_completed_at": &now,
 		"operator":         constants.VerifyOperatorOcrCallback,
 	}
+	if ocrData.PolicyDecision != "" {
+		updates["policy_decision"] = ocrData.PolicyDecision
+		updates["policy_score"] = sql.NullFloat64{Float64: ocrData.PolicyScore, Valid: true}
+		updates["policy_detail"] = sql.NullString{String: ocrData.PolicyDetail, Valid: ocrData.PolicyDetail != ""}
+	}
 	// OCR 结果未识别到入学年份时，保留用户原先提交值，避免被空字符串覆盖。
 	if year := strings.TrimSpace(ocrData.AdmissionYear); year != "" {
 		updates["admission_year"] = year
@@ -436,6 +513,7 @@ func (m *StudentVerificationModel) UpdateToManualReview(
 		"school_name":     modifiedData.SchoolName,
 		"student_id":      encryptedStudentID,
 		"student_id_hash": studentIDHash,
+		"hash_key_id":     m.codec.HashKeyID(),
 		"department":      modifiedData.Department,
 		"admission_year":  modifiedData.AdmissionYear,
 		"operator":        constants.VerifyOperatorUserConfirm,
@@ -498,6 +576,192 @@ func (m *StudentVerificationModel) FindByStatus(
 	return list, total, nil
 }
 
+// CountByStatus 统计指定状态的记录数
+func (m *StudentVerificationModel) CountByStatus(
+	ctx context.Context,
+	status int8,
+) (int64, error) {
+	var count int64
+	err := m.db.WithContext(ctx).
+		Model(&StudentVerification{}).
+		Where("status = ?", status).
+		Count(&count).Error
+	return count, err
+}
+
+// ==================== 认证过期 ====================
+
+// expiryColumns 过期任务只需要的非敏感字段（无需解密）
+var expiryColumns = []string{"id", "user_id", "status", "admission_year", "expire_at", "expiry_reminded_at"}
+
+// FindPassedWithoutExpiry 查询未设置过期时间的已通过记录（按ID游标分批）
+func (m *StudentVerificationModel) FindPassedWithoutExpiry(
+	ctx context.Context,
+	afterID int64,
+	limit int,
+) ([]*StudentVerification, error) {
+	var list []*StudentVerification
+	err := m.db.WithContext(ctx).
+		Select(expiryColumns).
+		Where("status = ? AND expire_at IS NULL AND id > ?", constants.VerifyStatusPassed, afterID).
+		Order("id ASC").
+		Limit(limit).
+		Find(&list).Error
+	return list, err
+}
+
+// FindExpiring 查询在 deadline 之前过期且尚未提醒的已通过记录（按ID游标分批）
+func (m *StudentVerificationModel) FindExpiring(
+	ctx context.Context,
+	deadline time.Time,
+	afterID int64,
+	limit int,
+) ([]*StudentVerification, error) {
+	var list []*StudentVerification
+	err := m.db.WithContext(ctx).
+		Select(expiryColumns).
+		Where("status = ? AND expire_at <= ? AND expiry_reminded_at IS NULL AND id > ?",
+			constants.VerifyStatusPassed, deadline, afterID).
+		Order("id ASC").
+		Limit(limit).
+		Find(&list).Error
+	return list, err
+}
+
+// FindExpired 查询已到过期时间的已通过记录（按ID游标分批）
+func (m *StudentVerificationModel) FindExpired(
+	ctx context.Context,
+	now time.Time,
+	afterID int64,
+	limit int,
+) ([]*StudentVerification, error) {
+	var list []*StudentVerification
+	err := m.db.WithContext(ctx).
+		Select(expiryColumns).
+		Where("status = ? AND expire_at <= ? AND id > ?", constants.VerifyStatusPassed, now, afterID).
+		Order("id ASC").
+		Limit(limit).
+		Find(&list).Error
+	return list, err
+}
+
+// UpdateExpireAt 设置过期时间（同时清空提醒时间）
+func (m *StudentVerificationModel) UpdateExpireAt(
+	ctx context.Context,
+	id int64,
+	expireAt *time.Time,
+) error {
+	return m.db.WithContext(ctx).
+		Model(&StudentVerification{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"expire_at":          expireAt,
+			"expiry_reminded_at": nil,
+		}).Error
+}
+
+// MarkExpiryReminded 标记已发送过期提醒
+func (m *StudentVerificationModel) MarkExpiryReminded(
+	ctx context.Context,
+	id int64,
+	remindedAt time.Time,
+) error {
+	return m.db.WithContext(ctx).
+		Model(&StudentVerification{}).
+		Where("id = ?", id).
+		Update("expiry_reminded_at", &remindedAt).Error
+}
+
+// ==================== 密钥轮换 ====================
+
+// rekeyColumns 密钥轮换只需要的字段（保持密文，不经过解密）
+var rekeyColumns = []string{"id", "school_name", "real_name", "student_id", "student_id_hash", "hash_key_id"}
+
+// FindNeedRekey 查询未使用当前密钥加密/哈希的记录（按ID游标分批，返回密文）
+func (m *StudentVerificationModel) FindNeedRekey(
+	ctx context.Context,
+	afterID int64,
+	limit int,
+) ([]*StudentVerification, error) {
+	// 密钥ID仅包含字母数字与短横线，前缀中不存在 LIKE 通配符
+	prefix := m.codec.ActiveCipherPrefix() + "%"
+	var list []*StudentVerification
+	err := m.db.WithContext(ctx).
+		Select(rekeyColumns).
+		Where("id > ?", afterID).
+		Where(m.db.
+			Where("real_name <> '' AND real_name NOT LIKE ?", prefix).
+			Or("student_id <> '' AND student_id NOT LIKE ?", prefix).
+			Or("student_id <> '' AND hash_key_id <> ?", m.codec.HashKeyID())).
+		Order("id ASC").
+		Limit(limit).
+		Find(&list).Error
+	return list, err
+}
+
+// Rekey 使用当前密钥重新加密并重算学号哈希
+// 以读取时的密文作为更新条件，期间记录被业务修改则放弃本次更新（新写入已使用当前密钥）
+func (m *StudentVerificationModel) Rekey(
+	ctx context.Context,
+	raw *StudentVerification,
+) (bool, error) {
+	if raw == nil {
+		return false, fmt.Errorf("verification is nil")
+	}
+
+	updates := make(map[string]interface{})
+	var studentID string
+
+	if m.codec.NeedsReencrypt(raw.RealName) {
+		realName, err := m.codec.Decrypt(sensitiveFieldRealName, raw.RealName)
+		if err != nil {
+			return false, fmt.Errorf("decrypt real_name failed: %w", err)
+		}
+		encrypted, err := m.codec.Encrypt(sensitiveFieldRealName, realName)
+		if err != nil {
+			return false, fmt.Errorf("encrypt real_name failed: %w", err)
+		}
+		updates["real_name"] = encrypted
+	}
+
+	if raw.StudentID != "" {
+		plain, err := m.codec.Decrypt(sensitiveFieldStudentID, raw.StudentID)
+		if err != nil {
+			return false, fmt.Errorf("decrypt student_id failed: %w", err)
+		}
+		studentID = plain
+	}
+	if m.codec.NeedsReencrypt(raw.StudentID) {
+		encrypted, err := m.codec.Encrypt(sensitiveFieldStudentID, studentID)
+		if err != nil {
+			return false, fmt.Errorf("encrypt student_id failed: %w", err)
+		}
+		updates["student_id"] = encrypted
+	}
+	if studentID != "" && raw.HashKeyID != m.codec.HashKeyID() {
+		studentIDHash, err := m.codec.HashStudentID(raw.SchoolName, studentID)
+		if err != nil {
+			return false, fmt.Errorf("hash student_id failed: %w", err)
+		}
+		updates["student_id_hash"] = studentIDHash
+		updates["hash_key_id"] = m.codec.HashKeyID()
+	}
+
+	if len(updates) == 0 {
+		return true, nil
+	}
+
+	result := m.db.WithContext(ctx).
+		Model(&StudentVerification{}).
+		Where("id = ? AND real_name = ? AND student_id = ? AND student_id_hash = ? AND hash_key_id = ?",
+			raw.ID, raw.RealName, raw.StudentID, raw.StudentIDHash, raw.HashKeyID).
+		Updates(updates)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
 // encryptForWrite 在写库前加密敏感字段，并写入哈希索引。
 // 返回 restore 函数用于恢复调用方对象中的明文字段，避免副作用污染上层逻辑。
 func (m *StudentVerificationModel) encryptForWrite(v *StudentVerification) (func(), error) {
@@ -508,6 +772,7 @@ func (m *StudentVerificationModel) encryptForWrite(v *StudentVerification) (func
 	originalRealName := v.RealName
 	originalStudentID := v.StudentID
 	originalStudentIDHash := v.StudentIDHash
+	originalHashKeyID := v.HashKeyID
 
 	encryptedRealName, err := m.codec.Encrypt(sensitiveFieldRealName, v.RealName)
 	if err != nil {
@@ -525,11 +790,13 @@ func (m *StudentVerificationModel) encryptForWrite(v *StudentVerification) (func
 	v.RealName = encryptedRealName
 	v.StudentID = encryptedStudentID
 	v.StudentIDHash = studentIDHash
+	v.HashKeyID = m.codec.HashKeyID()
 
 	restore := func() {
 		v.RealName = originalRealName
 		v.StudentID = originalStudentID
 		v.StudentIDHash = originalStudentIDHash
+		v.HashKeyID = originalHashKeyID
 	}
 	return restore, nil
 }
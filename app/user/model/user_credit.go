@@ -52,6 +52,8 @@ type IUserCreditModel interface {
 	UpdateScore(ctx context.Context, userID int64, score int, level int8) error
 	// ExistsByUserID 检查用户信用记录是否存在
 	ExistsByUserID(ctx context.Context, userID int64) (bool, error)
+	// FindRecoveryCandidates 查询可进行信用修复的用户（按 user_id 游标分页）
+	FindRecoveryCandidates(ctx context.Context, scoreCap int, quietSince time.Time, afterUserID int64, limit int) ([]*UserCredit, error)
 }
 
 // 确保 UserCreditModel 实现 IUserCreditModel 接口
@@ -121,3 +123,33 @@ func (m *UserCreditModel) ExistsByUserID(ctx context.Context, userID int64) (boo
 	}
 	return count > 0, nil
 }
+
+// FindRecoveryCandidates 查询可进行信用修复的用户（按 user_id 游标分页）
+//
+// 条件：
+//   - 分数低于修复上限
+//   - quietSince 之后没有扣分记录，也没有修复记录（每个周期最多修复一次）
+func (m *UserCreditModel) FindRecoveryCandidates(
+	ctx context.Context,
+	scoreCap int,
+	quietSince time.Time,
+	afterUserID int64,
+	limit int,
+) ([]*UserCredit, error) {
+	var credits []*UserCredit
+	err := m.db.WithContext(ctx).
+		Where("score < ? AND user_id > ?", scoreCap, afterUserID).
+		Where(`NOT EXISTS (
+			SELECT 1 FROM credit_logs cl
+			WHERE cl.user_id = user_credits.user_id
+			  AND cl.created_at >= ?
+			  AND (cl.delta < 0 OR cl.source_id LIKE ?)
+		)`, quietSince, "recovery:%").
+		Order("user_id ASC").
+		Limit(limit).
+		Find(&credits).Error
+	if err != nil {
+		return nil, err
+	}
+	return credits, nil
+}
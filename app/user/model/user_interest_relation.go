@@ -50,6 +50,8 @@ type IUserInterestRelationModel interface {
 	ListByTagID(ctx context.Context, tagID int64, offset, limit int) ([]*UserInterestRelation, error)
 	// Exists 检查关联是否存在
 	Exists(ctx context.Context, userID, tagID int64) (bool, error)
+	// RebindTag 将源标签的用户关联改绑到目标标签（事务内使用），返回改绑数量
+	RebindTag(ctx context.Context, tx *gorm.DB, sourceTagID, targetTagID int64) (int64, error)
 }
 
 // 确保 UserInterestRelationModel 实现 IUserInterestRelationModel 接口
@@ -130,3 +132,24 @@ func (m *UserInterestRelationModel) Exists(ctx context.Context, userID, tagID in
 	}
 	return count > 0, nil
 }
+
+// RebindTag 将源标签的用户关联改绑到目标标签（事务内使用）
+//
+// 已同时关联两个标签的用户只删除源标签关联，避免同一用户重复关联目标标签
+func (m *UserInterestRelationModel) RebindTag(ctx context.Context, tx *gorm.DB, sourceTagID, targetTagID int64) (int64, error) {
+	db := tx.WithContext(ctx)
+
+	// 1. 删除重复关联
+	if err := db.Exec(`
+		DELETE s FROM user_interest_relations s
+		INNER JOIN user_interest_relations t ON t.user_id = s.user_id AND t.tag_id = ?
+		WHERE s.tag_id = ?`, targetTagID, sourceTagID).Error; err != nil {
+		return 0, err
+	}
+
+	// 2. 其余关联改绑
+	result := db.Model(&UserInterestRelation{}).
+		Where("tag_id = ?", sourceTagID).
+		Update("tag_id", targetTagID)
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,110 @@
+/**
+ * @projectName: CampusHub
+ * @package: model
+ * @className: VerifyReviewLog
+ * @author: lijunqi
+ * @description: 学生认证人工审核日志实体及数据访问层
+ * @date: 2026-10-18
+ * @version: 1.0
+ */
+
+package model
+
+import (
+	"context"
+	"time"
+
+	"activity-platform/common/constants"
+
+	"gorm.io/gorm"
+)
+
+// VerifyReviewLog 人工审核日志
+// 记录审核员对认证记录的每一步操作（查看、领取、释放、通过、拒绝），只增不改
+type VerifyReviewLog struct {
+	// 主键ID
+	ID int64 `gorm:"primaryKey;autoIncrement;column:id" json:"id"`
+	// 认证记录ID
+	VerifyID int64 `gorm:"index:idx_verify_id;column:verify_id;not null" json:"verify_id"`
+	// 被审核用户ID
+	UserID int64 `gorm:"column:user_id;not null" json:"user_id"`
+	// 审核员ID
+	ReviewerID int64 `gorm:"index:idx_reviewer_created,priority:1;column:reviewer_id;not null" json:"reviewer_id"`
+	// 操作类型：view/claim/release/approve/reject/auto_approve/auto_review/auto_reject（auto_* 审核员ID为0）
+	Action string `gorm:"column:action;size:20;not null" json:"action"`
+	// 备注（拒绝原因等）
+	Reason string `gorm:"column:reason;size:255" json:"reason"`
+	// 创建时间
+	CreatedAt time.Time `gorm:"index:idx_reviewer_created,priority:2;column:created_at;autoCreateTime" json:"created_at"`
+}
+
+// TableName 指定表名
+func (VerifyReviewLog) TableName() string {
+	return "verify_review_logs"
+}
+
+// ReviewerStat 审核员处理量统计
+type ReviewerStat struct {
+	// 审核员ID
+	ReviewerID int64 `gorm:"column:reviewer_id"`
+	// 通过数
+	Approved int64 `gorm:"column:approved"`
+	// 拒绝数
+	Rejected int64 `gorm:"column:rejected"`
+}
+
+// IVerifyReviewLogModel 人工审核日志数据访问层接口
+type IVerifyReviewLogModel interface {
+	// Create 写入审核日志
+	Create(ctx context.Context, log *VerifyReviewLog) error
+	// BatchCreate 批量写入审核日志
+	BatchCreate(ctx context.Context, logs []*VerifyReviewLog) error
+	// StatsByReviewer 按审核员统计时间范围内的处理量（审核结论 approve/reject）
+	StatsByReviewer(ctx context.Context, since, until time.Time) ([]*ReviewerStat, error)
+}
+
+// 确保 VerifyReviewLogModel 实现 IVerifyReviewLogModel 接口
+var _ IVerifyReviewLogModel = (*VerifyReviewLogModel)(nil)
+
+// VerifyReviewLogModel 人工审核日志数据访问层
+type VerifyReviewLogModel struct {
+	db *gorm.DB
+}
+
+// NewVerifyReviewLogModel 创建人工审核日志Model实例
+func NewVerifyReviewLogModel(db *gorm.DB) IVerifyReviewLogModel {
+	return &VerifyReviewLogModel{db: db}
+}
+
+// Create 写入审核日志
+func (m *VerifyReviewLogModel) Create(ctx context.Context, log *VerifyReviewLog) error {
+	return m.db.WithContext(ctx).Create(log).Error
+}
+
+// BatchCreate 批量写入审核日志
+func (m *VerifyReviewLogModel) BatchCreate(ctx context.Context, logs []*VerifyReviewLog) error {
+	if len(logs) == 0 {
+		return nil
+	}
+	return m.db.WithContext(ctx).Create(&logs).Error
+}
+
+// StatsByReviewer 按审核员统计时间范围内的处理量
+func (m *VerifyReviewLogModel) StatsByReviewer(ctx context.Context, since, until time.Time) ([]*ReviewerStat, error) {
+	var stats []*ReviewerStat
+	err := m.db.WithContext(ctx).
+		Model(&VerifyReviewLog{}).
+		Select("reviewer_id, "+
+			"SUM(CASE WHEN action = ? THEN 1 ELSE 0 END) AS approved, "+
+			"SUM(CASE WHEN action = ? THEN 1 ELSE 0 END) AS rejected",
+			constants.VerifyReviewActionApprove, constants.VerifyReviewActionReject).
+		Where("action IN ? AND created_at >= ? AND created_at < ?",
+			[]string{constants.VerifyReviewActionApprove, constants.VerifyReviewActionReject}, since, until).
+		Group("reviewer_id").
+		Order("approved + rejected DESC").
+		Scan(&stats).Error
+	if err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
@@ -3,15 +3,17 @@
  * @package: ocr
  * @className: factory
  * @author: lijunqi
- * @description: OCR策略工厂，实现双提供商故障转移和熔断机制
+ * @description: OCR策略工厂，实现按权重路由、故障转移和熔断机制
  * @date: 2026-01-31
- * @version: 1.0
+ * @version: 2.0
  */
 
 package ocr
 
 import (
 	"context"
+	"math/rand"
+	"sort"
 	"time"
 
 	"activity-platform/common/constants"
@@ -41,13 +43,10 @@ const (
 // ============================================================================
 
 // ProviderFactory OCR提供商工厂
-// 负责管理多个OCR提供商，实现故障转移和熔断逻辑
+// 负责管理多个OCR提供商，实现按权重路由、故障转移和熔断逻辑
 type ProviderFactory struct {
-	// 主提供商（腾讯云）
-	primary Provider
-
-	// 备用提供商（阿里云）
-	fallback Provider
+	// 提供商列表（保持配置顺序，作为同等条件下的优先顺序）
+	providers []WeightedProvider
 
 	// Redis客户端（用于熔断状态存储）
 	redis *redis.Client
@@ -55,54 +54,114 @@ type ProviderFactory struct {
 
 // NewProviderFactory 创建提供商工厂
 // 参数:
-//   - primary: 主提供商（必须）
-//   - fallback: 备用提供商（可选，传nil表示不使用备用）
+//   - providers: 带权重的提供商列表（由 Registry.Build 构建）
 //   - rdb: Redis客户端（用于熔断状态管理）
-func NewProviderFactory(primary, fallback Provider, rdb *redis.Client) *ProviderFactory {
+func NewProviderFactory(providers []WeightedProvider, rdb *redis.Client) *ProviderFactory {
 	return &ProviderFactory{
-		primary:  primary,
-		fallback: fallback,
-		redis:    rdb,
+		providers: providers,
+		redis:     rdb,
 	}
 }
 
 // ============================================================================
-// 故障转移逻辑
+// 路由与故障转移逻辑
 // ============================================================================
 
-// Recognize 执行OCR识别（带故障转移）
+// route 一次识别的候选提供商
+type route struct {
+	provider Provider
+	// weight 健康加权后的有效权重
+	weight float64
+}
+
+// Recognize 执行OCR识别（带路由与故障转移）
 // 逻辑：
-//  1. 检查主提供商是否被熔断
-//  2. 未熔断 -> 尝试主提供商
-//  3. 主提供商失败 -> 记录失败次数，达到阈值则熔断
-//  4. 主提供商熔断或失败 -> 尝试备用提供商
-//  5. 备用提供商也失败 -> 返回错误
+//  1. 根据熔断状态与失败计数筛选健康提供商并计算有效权重
+//  2. 按有效权重随机选出首选提供商，其余按有效权重降序作为备用
+//  3. 依次尝试，失败记录失败次数，达到阈值则熔断
+//  4. 不可重试的错误（如图片无效）直接返回，不再切换提供商
+//  5. 所有提供商都失败 -> 返回服务不可用
 func (f *ProviderFactory) Recognize(
 	ctx context.Context,
 	frontImageURL, backImageURL string,
 ) (*OcrResult, error) {
-	// 1. 尝试主提供商
-	if f.primary != nil {
-		result, err := f.tryProvider(ctx, f.primary, frontImageURL, backImageURL)
-		if err == nil {
-			return result, nil
-		}
-		logx.WithContext(ctx).Errorf("主OCR提供商[%s]识别失败: %v", f.primary.Name(), err)
+	routes := f.routes(ctx)
+	if len(routes) == 0 {
+		logx.WithContext(ctx).Errorf("无可用的OCR提供商")
+		return nil, errorx.ErrOcrServiceUnavailable()
 	}
 
-	// 2. 尝试备用提供商
-	if f.fallback != nil {
-		result, err := f.tryProvider(ctx, f.fallback, frontImageURL, backImageURL)
+	for i, r := range routes {
+		result, err := f.tryProvider(ctx, r.provider, frontImageURL, backImageURL)
 		if err == nil {
 			return result, nil
 		}
-		logx.WithContext(ctx).Errorf("备用OCR提供商[%s]识别失败: %v", f.fallback.Name(), err)
+		logx.WithContext(ctx).Errorf("OCR提供商[%s]识别失败（第%d/%d个）: %v",
+			r.provider.Name(), i+1, len(routes), err)
+		if !IsRetryable(err) {
+			return nil, err
+		}
 	}
 
-	// 3. 所有提供商都失败
+	// 所有提供商都失败
 	return nil, errorx.ErrOcrServiceUnavailable()
 }
 
+// routes 计算本次识别的候选提供商顺序
+// 熔断中或不可用的提供商被排除；有效权重 = 配置权重 / (1 + 窗口内失败次数)
+func (f *ProviderFactory) routes(ctx context.Context) []route {
+	routes := make([]route, 0, len(f.providers))
+	for _, wp := range f.providers {
+		name := wp.Provider.Name()
+		isOpen, failures := f.GetCircuitStatus(ctx, name)
+		if isOpen {
+			logx.WithContext(ctx).Infof("OCR提供商[%s]已熔断，跳过", name)
+			continue
+		}
+		if !wp.Provider.IsAvailable(ctx) {
+			logx.WithContext(ctx).Infof("OCR提供商[%s]不可用，跳过", name)
+			continue
+		}
+		routes = append(routes, route{
+			provider: wp.Provider,
+			weight:   float64(wp.Weight) / float64(1+failures),
+		})
+	}
+
+	// 有效权重降序（稳定排序，保持配置顺序）
+	sort.SliceStable(routes, func(i, j int) bool {
+		return routes[i].weight > routes[j].weight
+	})
+
+	// 按有效权重随机选择首选提供商，放到首位
+	if first := pickWeighted(routes); first > 0 {
+		picked := routes[first]
+		copy(routes[1:first+1], routes[:first])
+		routes[0] = picked
+	}
+	return routes
+}
+
+// pickWeighted 按权重随机选择下标（权重均为0时返回0）
+func pickWeighted(routes []route) int {
+	var total float64
+	for _, r := range routes {
+		total += r.weight
+	}
+	if total <= 0 {
+		return 0
+	}
+
+	n := rand.Float64() * total
+	for i, r := range routes {
+		if n < r.weight {
+			return i
+		}
+		n -= r.weight
+	}
+	return 0
+}
+
 // tryProvider 尝试使用指定提供商进行识别
 func (f *ProviderFactory) tryProvider(
 	ctx context.Context,
@@ -111,23 +170,14 @@ func (f *ProviderFactory) tryProvider(
 ) (*OcrResult, error) {
 	providerName := provider.Name()
 
-	// 检查是否被熔断
-	if f.isCircuitOpen(ctx, providerName) {
-		logx.WithContext(ctx).Infof("OCR提供商[%s]已熔断，跳过", providerName)
-		return nil, errorx.ErrOcrServiceUnavailable()
-	}
-
-	// 检查提供商是否可用
-	if !provider.IsAvailable(ctx) {
-		logx.WithContext(ctx).Infof("OCR提供商[%s]不可用，跳过", providerName)
-		return nil, errorx.ErrOcrServiceUnavailable()
-	}
-
 	// 执行识别
 	result, err := provider.Recognize(ctx, frontImageURL, backImageURL)
+	f.recordUsage(ctx, providerName, err == nil)
 	if err != nil {
-		// 失败，记录并检查是否需要熔断
-		f.recordFailure(ctx, providerName)
+		// 仅提供商侧故障计入熔断（用户图片问题不影响提供商健康度）
+		if IsRetryable(err) {
+			f.recordFailure(ctx, providerName)
+		}
 		return nil, err
 	}
 
@@ -136,22 +186,26 @@ func (f *ProviderFactory) tryProvider(
 	return result, nil
 }
 
-// ============================================================================
-// 熔断器实现
-// ============================================================================
+// recordUsage 记录提供商调用次数（按天计数，供平台统计任务汇总）
+func (f *ProviderFactory) recordUsage(ctx context.Context, providerName string, success bool) {
+	result := "success"
+	if !success {
+		result = "failure"
+	}
+	key := constants.StatsOcrCallsPrefix + time.Now().Format(constants.StatsDateLayout)
 
-// isCircuitOpen 检查熔断器是否打开
-func (f *ProviderFactory) isCircuitOpen(ctx context.Context, providerName string) bool {
-	key := constants.OcrCircuitBreakerPrefix + providerName
-	exists, err := f.redis.Exists(ctx, key).Result()
-	if err != nil {
-		logx.WithContext(ctx).Errorf("检查熔断器状态失败: %v", err)
-		// Redis异常时不熔断，允许尝试
-		return false
+	pipe := f.redis.Pipeline()
+	pipe.HIncrBy(ctx, key, providerName+":"+result, 1)
+	pipe.Expire(ctx, key, constants.StatsRawDataTTL)
+	if _, err := pipe.Exec(ctx); err != nil {
+		logx.WithContext(ctx).Errorf("记录OCR调用次数失败: %v", err)
 	}
-	return exists > 0
 }
 
+// ============================================================================
+// 熔断器实现
+// ============================================================================
+
 // recordFailure 记录失败次数
 func (f *ProviderFactory) recordFailure(ctx context.Context, providerName string) {
 	failureKey := constants.OcrCircuitFailuresPrefix + providerName
@@ -188,15 +242,13 @@ func (f *ProviderFactory) resetFailureCount(ctx context.Context, providerName st
 // 辅助方法
 // ============================================================================
 
-// GetAvailableProvider 获取当前可用的提供商（用于日志记录）
+// GetAvailableProvider 获取当前首选的提供商（用于日志记录）
 func (f *ProviderFactory) GetAvailableProvider(ctx context.Context) Provider {
-	if f.primary != nil && !f.isCircuitOpen(ctx, f.primary.Name()) && f.primary.IsAvailable(ctx) {
-		return f.primary
+	routes := f.routes(ctx)
+	if len(routes) == 0 {
+		return nil
 	}
-	if f.fallback != nil && !f.isCircuitOpen(ctx, f.fallback.Name()) && f.fallback.IsAvailable(ctx) {
-		return f.fallback
-	}
-	return nil
+	return routes[0].provider
 }
 
 // ResetCircuitBreaker 手动重置熔断器（用于测试或运维）
@@ -210,7 +262,7 @@ func (f *ProviderFactory) ResetCircuitBreaker(ctx context.Context, providerName
 	return nil
 }
 
-// GetCircuitStatus 获取熔断器状态（用于监控）
+// GetCircuitStatus 获取熔断器状态（用于监控与健康路由）
 func (f *ProviderFactory) GetCircuitStatus(ctx context.Context, providerName string) (isOpen bool, failureCount int64) {
 	circuitKey := constants.OcrCircuitBreakerPrefix + providerName
 	failureKey := constants.OcrCircuitFailuresPrefix + providerName
@@ -0,0 +1,273 @@
+package ocr
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"activity-platform/common/constants"
+	"activity-platform/common/errorx"
+)
+
+const (
+	testImageOK      = "https://cdn.example.com/verify/student-card-ok.jpg"
+	testImageBlurry  = "https://cdn.example.com/verify/student-card-blurry.jpg"
+	testImageDown    = "https://cdn.example.com/verify/provider-down.jpg"
+	testProviderDown = "down"
+)
+
+// stubProvider 固定返回结果的提供商（模拟云厂商）
+type stubProvider struct {
+	name  string
+	err   error
+	calls int
+}
+
+func (p *stubProvider) Name() string                         { return p.name }
+func (p *stubProvider) IsAvailable(ctx context.Context) bool { return true }
+
+func (p *stubProvider) Recognize(ctx context.Context, frontImageURL, backImageURL string) (*OcrResult, error) {
+	p.calls++
+	if p.err != nil {
+		return nil, p.err
+	}
+	return &OcrResult{Platform: p.name, StudentID: "stub"}, nil
+}
+
+// newTestRegistry 注册本地样例提供商与若干模拟提供商
+func newTestRegistry(t *testing.T, stubs ...*stubProvider) *Registry {
+	t.Helper()
+
+	registry := NewRegistry()
+	registry.Register(ProviderNameLocal, func() (Provider, error) {
+		return NewLocalProvider(LocalConfig{Enabled: true, FixtureDir: testFixtureDir})
+	})
+	for _, stub := range stubs {
+		stub := stub
+		registry.Register(stub.name, func() (Provider, error) { return stub, nil })
+	}
+	return registry
+}
+
+func newTestFactory(t *testing.T, registry *Registry, specs []ProviderSpec) (*ProviderFactory, *stubRedis) {
+	t.Helper()
+
+	providers, err := registry.Build(specs)
+	if err != nil {
+		t.Fatalf("registry.Build failed: %v", err)
+	}
+	rdb, stub := newStubRedis(t)
+	return NewProviderFactory(providers, rdb), stub
+}
+
+func TestRegistryBuild(t *testing.T) {
+	registry := newTestRegistry(t)
+	registry.Register("broken", func() (Provider, error) { return nil, errors.New("missing credentials") })
+
+	tests := []struct {
+		name      string
+		specs     []ProviderSpec
+		wantErr   bool
+		wantNames []string
+	}{
+		{name: "本地提供商", specs: []ProviderSpec{{Name: ProviderNameLocal, Weight: 1}}, wantNames: []string{ProviderNameLocal}},
+		{name: "未注册", specs: []ProviderSpec{{Name: "unknown", Weight: 1}}, wantErr: true},
+		{name: "负权重", specs: []ProviderSpec{{Name: ProviderNameLocal, Weight: -1}}, wantErr: true},
+		{name: "重复配置", specs: []ProviderSpec{{Name: ProviderNameLocal}, {Name: ProviderNameLocal}}, wantErr: true},
+		{
+			name:      "构造失败的提供商跳过",
+			specs:     []ProviderSpec{{Name: "broken", Weight: 5}, {Name: ProviderNameLocal, Weight: 1}},
+			wantNames: []string{ProviderNameLocal},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			providers, err := registry.Build(tt.specs)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Build returned error: %v", err)
+			}
+			if len(providers) != len(tt.wantNames) {
+				t.Fatalf("providers = %d, want %d", len(providers), len(tt.wantNames))
+			}
+			for i, name := range tt.wantNames {
+				if providers[i].Provider.Name() != name {
+					t.Fatalf("providers[%d] = %s, want %s", i, providers[i].Provider.Name(), name)
+				}
+			}
+		})
+	}
+}
+
+func TestProviderFactoryLocalFixtures(t *testing.T) {
+	ctx := context.Background()
+	factory, rds := newTestFactory(t, newTestRegistry(t), []ProviderSpec{{Name: ProviderNameLocal, Weight: 1}})
+	usageKey := constants.StatsOcrCallsPrefix + time.Now().Format(constants.StatsDateLayout)
+
+	// 1. 识别成功
+	result, err := factory.Recognize(ctx, testImageOK, "")
+	if err != nil {
+		t.Fatalf("Recognize ok fixture returned error: %v", err)
+	}
+	if result.StudentID != "U202300001" || result.Platform != ProviderNameLocal {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+
+	// 2. 图片问题直接返回，不计入熔断
+	if _, err := factory.Recognize(ctx, testImageBlurry, ""); bizCode(err) != errorx.CodeOcrImageInvalid {
+		t.Fatalf("blurry fixture err = %v, want image invalid", err)
+	}
+	if _, failures := factory.GetCircuitStatus(ctx, ProviderNameLocal); failures != 0 {
+		t.Fatalf("failures after user error = %d, want 0", failures)
+	}
+
+	// 3. 提供商故障：唯一提供商失败后返回服务不可用，达到阈值后熔断
+	for i := 1; i <= FailureThreshold; i++ {
+		if _, err := factory.Recognize(ctx, testImageDown, ""); bizCode(err) != errorx.CodeOcrServiceUnavailable {
+			t.Fatalf("provider-down #%d err = %v, want service unavailable", i, err)
+		}
+		isOpen, failures := factory.GetCircuitStatus(ctx, ProviderNameLocal)
+		if failures != int64(i) || isOpen != (i >= FailureThreshold) {
+			t.Fatalf("after %d failures: isOpen=%v failures=%d", i, isOpen, failures)
+		}
+	}
+
+	// 4. 熔断期间没有可用提供商
+	if _, err := factory.Recognize(ctx, testImageOK, ""); bizCode(err) != errorx.CodeOcrServiceUnavailable {
+		t.Fatalf("recognize during open circuit err = %v, want service unavailable", err)
+	}
+	if factory.GetAvailableProvider(ctx) != nil {
+		t.Fatal("expected no available provider while circuit is open")
+	}
+
+	// 5. 重置熔断后恢复
+	if err := factory.ResetCircuitBreaker(ctx, ProviderNameLocal); err != nil {
+		t.Fatalf("ResetCircuitBreaker failed: %v", err)
+	}
+	if _, err := factory.Recognize(ctx, testImageOK, ""); err != nil {
+		t.Fatalf("recognize after reset returned error: %v", err)
+	}
+
+	// 6. 调用次数统计（熔断期间未实际调用提供商）
+	if got := rds.hget(usageKey, ProviderNameLocal+":success"); got != 2 {
+		t.Fatalf("success calls = %d, want 2", got)
+	}
+	if got := rds.hget(usageKey, ProviderNameLocal+":failure"); got != 1+FailureThreshold {
+		t.Fatalf("failure calls = %d, want %d", got, 1+FailureThreshold)
+	}
+}
+
+func TestProviderFactorySuccessResetsFailures(t *testing.T) {
+	ctx := context.Background()
+	factory, _ := newTestFactory(t, newTestRegistry(t), []ProviderSpec{{Name: ProviderNameLocal, Weight: 1}})
+
+	_, _ = factory.Recognize(ctx, testImageDown, "")
+	if _, failures := factory.GetCircuitStatus(ctx, ProviderNameLocal); failures != 1 {
+		t.Fatalf("failures = %d, want 1", failures)
+	}
+	if _, err := factory.Recognize(ctx, testImageOK, ""); err != nil {
+		t.Fatalf("Recognize returned error: %v", err)
+	}
+	if _, failures := factory.GetCircuitStatus(ctx, ProviderNameLocal); failures != 0 {
+		t.Fatalf("failures after success = %d, want 0", failures)
+	}
+}
+
+func TestProviderFactoryFailover(t *testing.T) {
+	ctx := context.Background()
+	down := &stubProvider{name: testProviderDown, err: errorx.ErrOcrServiceUnavailable()}
+	factory, _ := newTestFactory(t, newTestRegistry(t, down), []ProviderSpec{
+		{Name: testProviderDown, Weight: 10},
+		{Name: ProviderNameLocal, Weight: 0}, // 仅备用
+	})
+
+	for i := 1; i <= FailureThreshold; i++ {
+		result, err := factory.Recognize(ctx, testImageOK, "")
+		if err != nil {
+			t.Fatalf("failover #%d returned error: %v", i, err)
+		}
+		if result.Platform != ProviderNameLocal {
+			t.Fatalf("failover #%d platform = %s, want local", i, result.Platform)
+		}
+	}
+	if down.calls != FailureThreshold {
+		t.Fatalf("down provider calls = %d, want %d", down.calls, FailureThreshold)
+	}
+
+	// 首选提供商熔断后直接路由到备用，不再调用
+	if isOpen, _ := factory.GetCircuitStatus(ctx, testProviderDown); !isOpen {
+		t.Fatal("expected down provider circuit to be open")
+	}
+	if p := factory.GetAvailableProvider(ctx); p == nil || p.Name() != ProviderNameLocal {
+		t.Fatalf("available provider = %v, want local", p)
+	}
+	if _, err := factory.Recognize(ctx, testImageOK, ""); err != nil {
+		t.Fatalf("Recognize returned error: %v", err)
+	}
+	if down.calls != FailureThreshold {
+		t.Fatalf("down provider called while circuit open: calls=%d", down.calls)
+	}
+}
+
+func TestProviderFactoryUserErrorDoesNotFailover(t *testing.T) {
+	ctx := context.Background()
+	backup := &stubProvider{name: "backup"}
+	factory, _ := newTestFactory(t, newTestRegistry(t, backup), []ProviderSpec{
+		{Name: ProviderNameLocal, Weight: 1},
+		{Name: backup.name, Weight: 0},
+	})
+
+	if _, err := factory.Recognize(ctx, testImageBlurry, ""); bizCode(err) != errorx.CodeOcrImageInvalid {
+		t.Fatalf("err = %v, want image invalid", err)
+	}
+	if backup.calls != 0 {
+		t.Fatalf("backup provider calls = %d, want 0", backup.calls)
+	}
+}
+
+func TestProviderFactoryWeightedRouting(t *testing.T) {
+	ctx := context.Background()
+	heavy := &stubProvider{name: "heavy"}
+	light := &stubProvider{name: "light"}
+	factory, rds := newTestFactory(t, newTestRegistry(t, heavy, light), []ProviderSpec{
+		{Name: heavy.name, Weight: 3},
+		{Name: light.name, Weight: 1},
+	})
+
+	// 1. 首选流量按权重分配（3:1）
+	const rounds = 2000
+	heavyFirst := 0
+	for i := 0; i < rounds; i++ {
+		if factory.routes(ctx)[0].provider.Name() == heavy.name {
+			heavyFirst++
+		}
+	}
+	if ratio := float64(heavyFirst) / rounds; ratio < 0.68 || ratio > 0.82 {
+		t.Fatalf("heavy provider preferred ratio = %.2f, want about 0.75", ratio)
+	}
+
+	// 2. 窗口内失败次数降低有效权重：3/(1+5) < 1
+	rds.set(constants.OcrCircuitFailuresPrefix+heavy.name, "5")
+	routes := factory.routes(ctx)
+	weights := make(map[string]float64, len(routes))
+	for _, r := range routes {
+		weights[r.provider.Name()] = r.weight
+	}
+	if weights[heavy.name] != 0.5 || weights[light.name] != 1 {
+		t.Fatalf("effective weights = %v, want heavy=0.5 light=1", weights)
+	}
+}
+
+func TestPickWeightedAllZero(t *testing.T) {
+	routes := []route{{weight: 0}, {weight: 0}}
+	if got := pickWeighted(routes); got != 0 {
+		t.Fatalf("pickWeighted = %d, want 0", got)
+	}
+}
@@ -35,7 +35,7 @@ type OcrResult struct {
 
 	// ==================== OCR元数据 ====================
 
-	// OCR平台：tencent / aliyun
+	// OCR平台：tencent / aliyun / local
 	Platform string `json:"platform"`
 	// 识别置信度（0-100）
 	Confidence float64 `json:"confidence"`
@@ -78,4 +78,6 @@ const (
 	ProviderNameTencent = "tencent"
 	// ProviderNameAliyun 阿里云
 	ProviderNameAliyun = "aliyun"
+	// ProviderNameLocal 本地离线识别（开发/CI 使用，基于样例文件）
+	ProviderNameLocal = "local"
 )
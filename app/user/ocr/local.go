@@ -0,0 +1,226 @@
+/**
+ * @projectName: CampusHub
+ * @package: ocr
+ * @className: local
+ * @author: lijunqi
+ * @description: 本地离线OCR提供商，基于样例文件返回确定性结果（开发/CI 使用，无需云厂商凭证）
+ * @date: 2026-10-18
+ * @version: 1.0
+ *
+ * 样例文件格式（FixtureDir 下的 *.json，按文件名顺序匹配）：
+ *   {
+ *     "match": "student-card-ok",      // 图片URL包含该子串即命中；"*" 表示兜底
+ *     "error": "",                     // 非空时返回对应错误：image_invalid / empty_result / timeout / unavailable / recognize_failed
+ *     "result": { "real_name": "...", "school_name": "...", "student_id": "...", "department": "...",
+ *                 "admission_year": "...", "confidence": 98.5 }
+ *   }
+ *
+ * 未命中任何样例时返回识别结果为空；仅在显式开启 AllowTemplate（开发环境）时使用内置模板：
+ * 学号由图片URL哈希生成，保证同一图片结果稳定、不同图片学号不同。
+ * 内置模板对任意图片都返回"识别成功"，生产环境切勿开启。
+ */
+
+package ocr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// ============================================================================
+// 本地OCR配置
+// ============================================================================
+
+// LocalConfig 本地OCR配置
+type LocalConfig struct {
+	// Enabled 是否启用（未启用时拒绝创建，避免误配置 Providers 后放行所有图片）
+	Enabled bool
+	// FixtureDir 样例文件目录
+	FixtureDir string
+	// AllowTemplate 未命中样例时是否使用内置模板（仅开发环境）
+	AllowTemplate bool
+}
+
+// localMatchAll 兜底匹配符
+const localMatchAll = "*"
+
+// localFixture 样例文件
+type localFixture struct {
+	// file 样例文件名（用于审计追溯）
+	file string
+
+	Match  string    `json:"match"`
+	Error  string    `json:"error"`
+	Result OcrResult `json:"result"`
+}
+
+// ============================================================================
+// 本地OCR提供商
+// ============================================================================
+
+// LocalProvider 本地离线OCR提供商
+type LocalProvider struct {
+	fixtures      []*localFixture
+	fallback      *localFixture
+	allowTemplate bool
+}
+
+// 确保实现 Provider 接口
+var _ Provider = (*LocalProvider)(nil)
+
+// NewLocalProvider 创建本地OCR提供商
+func NewLocalProvider(config LocalConfig) (*LocalProvider, error) {
+	if !config.Enabled {
+		return nil, fmt.Errorf("local ocr provider is disabled")
+	}
+
+	p := &LocalProvider{allowTemplate: config.AllowTemplate}
+	if config.FixtureDir == "" {
+		return p, nil
+	}
+
+	files, err := filepath.Glob(filepath.Join(config.FixtureDir, "*.json"))
+	if err != nil {
+		return nil, fmt.Errorf("list ocr fixtures failed: %w", err)
+	}
+	sort.Strings(files)
+
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("read ocr fixture %s failed: %w", file, err)
+		}
+		fixture := &localFixture{file: filepath.Base(file)}
+		if err := json.Unmarshal(data, fixture); err != nil {
+			return nil, fmt.Errorf("parse ocr fixture %s failed: %w", file, err)
+		}
+		if fixture.Error != "" && localFixtureError(fixture.Error) == nil {
+			return nil, fmt.Errorf("ocr fixture %s has unknown error %q", file, fixture.Error)
+		}
+
+		switch fixture.Match {
+		case "":
+			return nil, fmt.Errorf("ocr fixture %s missing match", file)
+		case localMatchAll:
+			p.fallback = fixture
+		default:
+			p.fixtures = append(p.fixtures, fixture)
+		}
+	}
+
+	return p, nil
+}
+
+// ============================================================================
+// Provider 接口实现
+// ============================================================================
+
+// Name 返回提供商名称
+func (p *LocalProvider) Name() string {
+	return ProviderNameLocal
+}
+
+// IsAvailable 本地提供商始终可用
+func (p *LocalProvider) IsAvailable(ctx context.Context) bool {
+	return true
+}
+
+// Recognize 执行OCR识别
+// 依次用正面、背面图片URL匹配样例，未命中时使用兜底样例；
+// 仍未命中时返回识别结果为空（开启 AllowTemplate 时使用内置模板）
+func (p *LocalProvider) Recognize(
+	ctx context.Context,
+	frontImageURL, backImageURL string,
+) (*OcrResult, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, errorx.ErrOcrNetworkTimeout()
+	}
+	if frontImageURL == "" {
+		return nil, errorx.ErrOcrImageInvalid()
+	}
+
+	fixture := p.match(frontImageURL, backImageURL)
+	if fixture == nil {
+		if !p.allowTemplate {
+			logx.WithContext(ctx).Infof("本地OCR未命中样例: front=%s", frontImageURL)
+			return nil, errorx.ErrOcrEmptyResult()
+		}
+		result := templateResult(frontImageURL)
+		logx.WithContext(ctx).Infof("本地OCR使用内置模板: front=%s, studentId=%s", frontImageURL, result.StudentID)
+		return result, nil
+	}
+
+	logx.WithContext(ctx).Infof("本地OCR命中样例: front=%s, fixture=%s", frontImageURL, fixture.file)
+	if fixture.Error != "" {
+		return nil, localFixtureError(fixture.Error)
+	}
+
+	result := fixture.Result
+	result.Platform = p.Name()
+	result.RawResponse = fmt.Sprintf(`{"fixture":%q}`, fixture.file)
+	return &result, nil
+}
+
+// ============================================================================
+// 内部方法
+// ============================================================================
+
+// match 查找命中的样例
+func (p *LocalProvider) match(frontImageURL, backImageURL string) *localFixture {
+	for _, url := range []string{frontImageURL, backImageURL} {
+		if url == "" {
+			continue
+		}
+		for _, fixture := range p.fixtures {
+			if strings.Contains(url, fixture.Match) {
+				return fixture
+			}
+		}
+	}
+	return p.fallback
+}
+
+// templateResult 内置模板结果（学号由图片URL哈希生成，结果稳定）
+func templateResult(frontImageURL string) *OcrResult {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(frontImageURL))
+
+	return &OcrResult{
+		RealName:      "测试学生",
+		SchoolName:    "CampusHub大学",
+		StudentID:     fmt.Sprintf("2023%06d", h.Sum32()%1000000),
+		Department:    "计算机学院",
+		AdmissionYear: "2023",
+		Platform:      ProviderNameLocal,
+		Confidence:    99,
+		RawResponse:   `{"fixture":"builtin"}`,
+	}
+}
+
+// localFixtureError 样例错误标识转换为业务错误
+func localFixtureError(code string) error {
+	switch code {
+	case "image_invalid":
+		return errorx.ErrOcrImageInvalid()
+	case "empty_result":
+		return errorx.ErrOcrEmptyResult()
+	case "timeout":
+		return errorx.ErrOcrNetworkTimeout()
+	case "unavailable":
+		return errorx.ErrOcrServiceUnavailable()
+	case "recognize_failed":
+		return errorx.ErrOcrRecognizeFailed()
+	default:
+		return nil
+	}
+}
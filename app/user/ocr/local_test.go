@@ -0,0 +1,160 @@
+package ocr
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"activity-platform/common/errorx"
+)
+
+// testFixtureDir 仓库自带的本地OCR样例（deploy/ocr/fixtures）
+const testFixtureDir = "../../../deploy/ocr/fixtures"
+
+func newTestLocalProvider(t *testing.T) *LocalProvider {
+	t.Helper()
+
+	p, err := NewLocalProvider(LocalConfig{Enabled: true, FixtureDir: testFixtureDir})
+	if err != nil {
+		t.Fatalf("NewLocalProvider failed: %v", err)
+	}
+	return p
+}
+
+// bizCode 提取业务错误码（非业务错误返回 0）
+func bizCode(err error) int {
+	var bizErr *errorx.BizError
+	if errors.As(err, &bizErr) {
+		return bizErr.Code
+	}
+	return 0
+}
+
+func TestLocalProviderFixtures(t *testing.T) {
+	p := newTestLocalProvider(t)
+
+	tests := []struct {
+		name        string
+		front, back string
+		wantCode    int
+		wantStudent string
+		wantConf    float64
+	}{
+		{
+			name:        "识别成功",
+			front:       "https://cdn.example.com/verify/student-card-ok-front.jpg",
+			wantStudent: "U202300001",
+			wantConf:    98.5,
+		},
+		{
+			name:     "图片模糊",
+			front:    "https://cdn.example.com/verify/student-card-blurry.jpg",
+			wantCode: errorx.CodeOcrImageInvalid,
+		},
+		{
+			name:        "部分字段缺失",
+			front:       "https://cdn.example.com/verify/student-card-partial.jpg",
+			wantStudent: "",
+			wantConf:    62,
+		},
+		{
+			name:     "提供商故障",
+			front:    "https://cdn.example.com/verify/provider-down.jpg",
+			wantCode: errorx.CodeOcrServiceUnavailable,
+		},
+		{
+			name:        "正面未命中时使用背面匹配",
+			front:       "https://cdn.example.com/verify/front.jpg",
+			back:        "https://cdn.example.com/verify/student-card-ok-back.jpg",
+			wantStudent: "U202300001",
+			wantConf:    98.5,
+		},
+		{
+			name:     "未命中样例返回识别结果为空",
+			front:    "https://cdn.example.com/verify/unknown.jpg",
+			wantCode: errorx.CodeOcrEmptyResult,
+		},
+		{
+			name:     "缺少正面图片",
+			front:    "",
+			wantCode: errorx.CodeOcrImageInvalid,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := p.Recognize(context.Background(), tt.front, tt.back)
+			if tt.wantCode != 0 {
+				if code := bizCode(err); code != tt.wantCode {
+					t.Fatalf("error code = %d (err=%v), want %d", code, err, tt.wantCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Recognize returned error: %v", err)
+			}
+			if result.StudentID != tt.wantStudent || result.Confidence != tt.wantConf {
+				t.Fatalf("result = %+v, want studentId=%q confidence=%v", result, tt.wantStudent, tt.wantConf)
+			}
+			if result.Platform != ProviderNameLocal {
+				t.Fatalf("platform = %q, want %q", result.Platform, ProviderNameLocal)
+			}
+		})
+	}
+}
+
+func TestNewLocalProviderDisabled(t *testing.T) {
+	if _, err := NewLocalProvider(LocalConfig{FixtureDir: testFixtureDir, AllowTemplate: true}); err == nil {
+		t.Fatal("expected error when local provider is disabled")
+	}
+}
+
+func TestLocalProviderTemplateIsStable(t *testing.T) {
+	p, err := NewLocalProvider(LocalConfig{Enabled: true, FixtureDir: testFixtureDir, AllowTemplate: true})
+	if err != nil {
+		t.Fatalf("NewLocalProvider failed: %v", err)
+	}
+	ctx := context.Background()
+
+	a1, err := p.Recognize(ctx, "https://cdn.example.com/verify/a.jpg", "")
+	if err != nil {
+		t.Fatalf("Recognize returned error: %v", err)
+	}
+	a2, _ := p.Recognize(ctx, "https://cdn.example.com/verify/a.jpg", "")
+	b, _ := p.Recognize(ctx, "https://cdn.example.com/verify/b.jpg", "")
+
+	if a1.StudentID != a2.StudentID {
+		t.Fatalf("same image got different student ids: %s vs %s", a1.StudentID, a2.StudentID)
+	}
+	if a1.StudentID == b.StudentID {
+		t.Fatalf("different images got the same student id: %s", a1.StudentID)
+	}
+	if a1.RawResponse != `{"fixture":"builtin"}` {
+		t.Fatalf("raw response = %s, want builtin marker", a1.RawResponse)
+	}
+}
+
+func TestNewLocalProviderRejectsInvalidFixtures(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{name: "缺少 match", content: `{"result":{"student_id":"1"}}`},
+		{name: "未知错误标识", content: `{"match":"x","error":"exploded"}`},
+		{name: "非法 JSON", content: `{`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			if err := os.WriteFile(filepath.Join(dir, "bad.json"), []byte(tt.content), 0o644); err != nil {
+				t.Fatalf("write fixture failed: %v", err)
+			}
+			if _, err := NewLocalProvider(LocalConfig{Enabled: true, FixtureDir: dir}); err == nil {
+				t.Fatal("expected error for invalid fixture")
+			}
+		})
+	}
+}
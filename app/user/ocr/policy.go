@@ -0,0 +1,423 @@
+/**
+ * @projectName: CampusHub
+ * @package: ocr
+ * @className: policy
+ * @author: lijunqi
+ * @description: OCR识别结果判定策略，逐字段打分并给出自动通过/用户确认/人工审核/自动拒绝的结论
+ * @date: 2026-10-18
+ * @version: 1.0
+ *
+ * ==================== 打分规则 ====================
+ *
+ * 字段（分值 0-100，按权重加权平均）：
+ *   - 学校名称（30）：与已知学校字典精确/别名匹配 100；标准名称 + 校区后缀（如"X大学(东校区)"）50，
+ *     低于单字段通过线，只能进入用户确认；其余（如"X大学附属中学"）视为未知 0
+ *   - 学号（30）：符合该校学号格式 100；该校未配置格式时按通用格式 60；不符合 0
+ *   - 入学年份（20）：在合理在读年限内 100，过于久远 20，未识别 50，晚于当前年份 0（明显无效）
+ *   - 姓名（10）：2-20 个中文字符（可含·）100，否则 40
+ *   - 识别置信度（10）：提供商返回的置信度（未返回时不计入）
+ *
+ * 判定（阈值可按学校覆盖）：
+ *   - 明显无效 或 总分 < RejectScore        -> 自动拒绝
+ *   - 总分 < ConfirmScore                   -> 人工审核
+ *   - 总分 >= AutoApproveScore 且学校已知、各字段均 >= 60 且允许自动通过 -> 自动通过
+ *   - 其余                                   -> 用户确认（原流程）
+ */
+
+package ocr
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ============================================================================
+// 判定结论
+// ============================================================================
+
+const (
+	// DecisionApprove 自动通过
+	DecisionApprove = "approve"
+	// DecisionConfirm 用户确认（原流程）
+	DecisionConfirm = "confirm"
+	// DecisionManualReview 转人工审核
+	DecisionManualReview = "manual_review"
+	// DecisionReject 自动拒绝
+	DecisionReject = "reject"
+)
+
+// 字段名称与权重
+const (
+	policyFieldSchool        = "school_name"
+	policyFieldStudentID     = "student_id"
+	policyFieldAdmissionYear = "admission_year"
+	policyFieldRealName      = "real_name"
+	policyFieldConfidence    = "ocr_confidence"
+
+	policyWeightSchool        = 30
+	policyWeightStudentID     = 30
+	policyWeightAdmissionYear = 20
+	policyWeightRealName      = 10
+	policyWeightConfidence    = 10
+
+	// policyFieldPassScore 自动通过要求的单字段最低分
+	policyFieldPassScore = 60
+	// policySchoolCampusScore 校区后缀匹配得分（低于单字段通过线，不能促成自动通过）
+	policySchoolCampusScore = 50
+)
+
+var (
+	// genericStudentIDPattern 未配置学校学号格式时的通用格式
+	genericStudentIDPattern = regexp.MustCompile(`^[A-Za-z0-9]{4,20}$`)
+	// yearPattern 提取四位年份
+	yearPattern = regexp.MustCompile(`(?:19|20)\d{2}`)
+	// campusSuffixPattern 校区后缀（归一化后，如 "(东校区)"、"主校区"）
+	campusSuffixPattern = regexp.MustCompile(`^\(?\p{Han}{1,8}校区\)?$`)
+)
+
+// ============================================================================
+// 策略配置
+// ============================================================================
+
+// Thresholds 判定阈值
+type Thresholds struct {
+	// AutoApproveScore 自动通过最低总分
+	AutoApproveScore float64
+	// ConfirmScore 进入用户确认的最低总分（低于则转人工审核）
+	ConfirmScore float64
+	// RejectScore 自动拒绝分数线（低于则自动拒绝）
+	RejectScore float64
+}
+
+// validate 校验阈值分段有序：reject <= confirm <= autoApprove
+func (t Thresholds) validate() error {
+	if !(t.RejectScore <= t.ConfirmScore && t.ConfirmScore <= t.AutoApproveScore) {
+		return fmt.Errorf("thresholds must satisfy reject <= confirm <= autoApprove, got %v/%v/%v",
+			t.RejectScore, t.ConfirmScore, t.AutoApproveScore)
+	}
+	return nil
+}
+
+// SchoolPolicy 单个学校的判定配置
+type SchoolPolicy struct {
+	// Name 学校标准名称
+	Name string
+	// Aliases 学校别名（简称、英文名等）
+	Aliases []string
+	// StudentIDPattern 学号正则（为空使用通用格式）
+	StudentIDPattern string
+	// Thresholds 阈值覆盖（为 0 的项使用全局阈值）
+	Thresholds Thresholds
+	// DisableAutoApprove 禁止自动通过（仍可转人工审核/拒绝）
+	DisableAutoApprove bool
+}
+
+// PolicyConfig 判定策略配置
+type PolicyConfig struct {
+	// Thresholds 全局阈值
+	Thresholds Thresholds
+	// MaxStudyYears 合理在读年限（入学年份距今超过该值视为可疑）
+	MaxStudyYears int
+	// Schools 已知学校字典
+	Schools []SchoolPolicy
+}
+
+// compiledSchool 预编译后的学校配置
+type compiledSchool struct {
+	policy    SchoolPolicy
+	pattern   *regexp.Regexp
+	names     []string // 标准名称 + 别名（已归一化）
+	canonical string
+}
+
+// Policy OCR识别结果判定策略
+type Policy struct {
+	thresholds    Thresholds
+	maxStudyYears int
+	schools       []*compiledSchool
+}
+
+// NewPolicy 创建判定策略
+func NewPolicy(conf PolicyConfig) (*Policy, error) {
+	t := conf.Thresholds
+	if err := t.validate(); err != nil {
+		return nil, fmt.Errorf("verify policy %w", err)
+	}
+	if conf.MaxStudyYears <= 0 {
+		conf.MaxStudyYears = 8
+	}
+
+	p := &Policy{
+		thresholds:    t,
+		maxStudyYears: conf.MaxStudyYears,
+	}
+	for _, s := range conf.Schools {
+		name := strings.TrimSpace(s.Name)
+		if name == "" {
+			return nil, fmt.Errorf("verify policy school name is empty")
+		}
+		// 学校阈值与全局阈值合并后同样需要保持分段有序
+		if err := mergeThresholds(t, s.Thresholds).validate(); err != nil {
+			return nil, fmt.Errorf("verify policy school %s %w", name, err)
+		}
+		cs := &compiledSchool{policy: s, canonical: name}
+		cs.names = append(cs.names, normalizeSchoolName(name))
+		for _, alias := range s.Aliases {
+			if alias = normalizeSchoolName(alias); alias != "" {
+				cs.names = append(cs.names, alias)
+			}
+		}
+		if s.StudentIDPattern != "" {
+			pattern, err := regexp.Compile(s.StudentIDPattern)
+			if err != nil {
+				return nil, fmt.Errorf("verify policy school %s student id pattern invalid: %w", name, err)
+			}
+			cs.pattern = pattern
+		}
+		p.schools = append(p.schools, cs)
+	}
+	return p, nil
+}
+
+// ============================================================================
+// 判定
+// ============================================================================
+
+// FieldScore 单字段得分
+type FieldScore struct {
+	Field  string  `json:"field"`
+	Score  float64 `json:"score"`
+	Weight float64 `json:"weight"`
+	Reason string  `json:"reason,omitempty"`
+}
+
+// Decision 判定结果（整体序列化后入库用于审计）
+type Decision struct {
+	// Action 结论：approve/confirm/manual_review/reject
+	Action string `json:"action"`
+	// Score 加权总分（0-100）
+	Score float64 `json:"score"`
+	// School 匹配到的学校标准名称（未匹配为空）
+	School string `json:"school,omitempty"`
+	// Thresholds 本次使用的阈值
+	Thresholds Thresholds `json:"thresholds"`
+	// Fields 各字段得分
+	Fields []FieldScore `json:"fields"`
+	// Reasons 判定原因
+	Reasons []string `json:"reasons"`
+}
+
+// Summary 判定原因摘要
+func (d *Decision) Summary() string {
+	return strings.Join(d.Reasons, "；")
+}
+
+// Evaluate 对识别结果进行打分并给出判定结论
+// admissionYear 为最终入库的入学年份（用户填写优先，OCR 结果兜底）
+func (p *Policy) Evaluate(result *OcrResult, admissionYear string) *Decision {
+	d := &Decision{Thresholds: p.thresholds}
+
+	// 1. 学校名称
+	school, schoolScore, schoolReason := p.scoreSchool(result.SchoolName)
+	d.addField(policyFieldSchool, schoolScore, policyWeightSchool, schoolReason)
+	if school != nil {
+		d.School = school.canonical
+		d.Thresholds = mergeThresholds(p.thresholds, school.policy.Thresholds)
+	}
+
+	// 2. 学号格式
+	idScore, idReason := scoreStudentID(school, result.StudentID)
+	d.addField(policyFieldStudentID, idScore, policyWeightStudentID, idReason)
+
+	// 3. 入学年份合理性
+	if strings.TrimSpace(admissionYear) == "" {
+		admissionYear = result.AdmissionYear
+	}
+	yearScore, yearReason, invalid := p.scoreAdmissionYear(admissionYear)
+	d.addField(policyFieldAdmissionYear, yearScore, policyWeightAdmissionYear, yearReason)
+
+	// 4. 姓名
+	nameScore, nameReason := scoreRealName(result.RealName)
+	d.addField(policyFieldRealName, nameScore, policyWeightRealName, nameReason)
+
+	// 5. 提供商置信度（未返回时不计入）
+	if result.Confidence > 0 {
+		reason := ""
+		if result.Confidence < policyFieldPassScore {
+			reason = fmt.Sprintf("识别置信度较低（%.1f）", result.Confidence)
+		}
+		d.addField(policyFieldConfidence, result.Confidence, policyWeightConfidence, reason)
+	}
+
+	d.Score = d.weightedScore()
+	d.Action = d.decide(school, invalid)
+	return d
+}
+
+// decide 根据总分、阈值与字段情况给出结论
+func (d *Decision) decide(school *compiledSchool, invalid bool) string {
+	t := d.Thresholds
+	switch {
+	case invalid:
+		return DecisionReject
+	case d.Score < t.RejectScore:
+		d.Reasons = append(d.Reasons, fmt.Sprintf("总分%.1f低于拒绝线%.1f", d.Score, t.RejectScore))
+		return DecisionReject
+	case d.Score < t.ConfirmScore:
+		d.Reasons = append(d.Reasons, fmt.Sprintf("总分%.1f低于确认线%.1f，转人工审核", d.Score, t.ConfirmScore))
+		return DecisionManualReview
+	case d.Score < t.AutoApproveScore:
+		return DecisionConfirm
+	}
+
+	// 达到自动通过分数线，仍需满足附加条件
+	if school == nil {
+		d.Reasons = append(d.Reasons, "学校未在字典中，不允许自动通过")
+		return DecisionConfirm
+	}
+	if school.policy.DisableAutoApprove {
+		d.Reasons = append(d.Reasons, "该校未开启自动通过")
+		return DecisionConfirm
+	}
+	for _, f := range d.Fields {
+		if f.Score < policyFieldPassScore {
+			d.Reasons = append(d.Reasons, fmt.Sprintf("字段%s得分%.1f过低，不允许自动通过", f.Field, f.Score))
+			return DecisionConfirm
+		}
+	}
+	d.Reasons = append(d.Reasons, fmt.Sprintf("总分%.1f达到自动通过线%.1f", d.Score, t.AutoApproveScore))
+	return DecisionApprove
+}
+
+// addField 记录字段得分（有原因时同时加入判定原因）
+func (d *Decision) addField(field string, score, weight float64, reason string) {
+	d.Fields = append(d.Fields, FieldScore{Field: field, Score: score, Weight: weight, Reason: reason})
+	if reason != "" {
+		d.Reasons = append(d.Reasons, reason)
+	}
+}
+
+// weightedScore 计算加权总分（保留两位小数）
+func (d *Decision) weightedScore() float64 {
+	var sum, weights float64
+	for _, f := range d.Fields {
+		sum += f.Score * f.Weight
+		weights += f.Weight
+	}
+	if weights == 0 {
+		return 0
+	}
+	score := sum / weights
+	return float64(int(score*100+0.5)) / 100
+}
+
+// ============================================================================
+// 字段打分
+// ============================================================================
+
+// scoreSchool 学校名称打分，返回匹配到的学校
+func (p *Policy) scoreSchool(schoolName string) (*compiledSchool, float64, string) {
+	name := normalizeSchoolName(schoolName)
+	if name == "" {
+		return nil, 0, "未识别学校名称"
+	}
+
+	// 精确/别名匹配
+	for _, s := range p.schools {
+		for _, n := range s.names {
+			if name == n {
+				return s, 100, ""
+			}
+		}
+	}
+	// 校区匹配：标准名称 + 校区后缀，其余包含关系（附属中学、继续教育学院等）不视为同一学校
+	for _, s := range p.schools {
+		suffix, ok := strings.CutPrefix(name, s.names[0])
+		if ok && campusSuffixPattern.MatchString(suffix) {
+			return s, policySchoolCampusScore, fmt.Sprintf("学校名称按校区匹配为%s", s.canonical)
+		}
+	}
+	return nil, 0, fmt.Sprintf("学校%s不在已知学校字典中", strings.TrimSpace(schoolName))
+}
+
+// scoreStudentID 学号格式打分
+func scoreStudentID(school *compiledSchool, studentID string) (float64, string) {
+	id := strings.TrimSpace(studentID)
+	if id == "" {
+		return 0, "未识别学号"
+	}
+	if school != nil && school.pattern != nil {
+		if school.pattern.MatchString(id) {
+			return 100, ""
+		}
+		return 0, fmt.Sprintf("学号不符合%s的学号格式", school.canonical)
+	}
+	if genericStudentIDPattern.MatchString(id) {
+		return 60, "未配置该校学号格式，按通用格式校验"
+	}
+	return 0, "学号格式无效"
+}
+
+// scoreAdmissionYear 入学年份打分，第三个返回值表示明显无效
+func (p *Policy) scoreAdmissionYear(admissionYear string) (float64, string, bool) {
+	yearStr := yearPattern.FindString(strings.TrimSpace(admissionYear))
+	if yearStr == "" {
+		return 50, "未识别入学年份", false
+	}
+	year, _ := strconv.Atoi(yearStr)
+	current := time.Now().Year()
+
+	switch {
+	case year > current:
+		return 0, fmt.Sprintf("入学年份%d晚于当前年份", year), true
+	case current-year > p.maxStudyYears:
+		return 20, fmt.Sprintf("入学年份%d距今超过%d年", year, p.maxStudyYears), false
+	default:
+		return 100, "", false
+	}
+}
+
+// scoreRealName 姓名打分
+func scoreRealName(realName string) (float64, string) {
+	name := strings.TrimSpace(realName)
+	count := utf8.RuneCountInString(name)
+	if count < 2 || count > 20 {
+		return 40, "姓名长度异常"
+	}
+	for _, r := range name {
+		if !unicode.Is(unicode.Han, r) && r != '·' {
+			return 40, "姓名包含非中文字符"
+		}
+	}
+	return 100, ""
+}
+
+// ============================================================================
+// 辅助函数
+// ============================================================================
+
+// normalizeSchoolName 学校名称归一化（去除空白、统一括号）
+func normalizeSchoolName(name string) string {
+	name = strings.Join(strings.Fields(name), "")
+	name = strings.NewReplacer("（", "(", "）", ")").Replace(name)
+	return name
+}
+
+// mergeThresholds 学校阈值覆盖全局阈值（为 0 的项沿用全局）
+func mergeThresholds(global, school Thresholds) Thresholds {
+	if school.AutoApproveScore > 0 {
+		global.AutoApproveScore = school.AutoApproveScore
+	}
+	if school.ConfirmScore > 0 {
+		global.ConfirmScore = school.ConfirmScore
+	}
+	if school.RejectScore > 0 {
+		global.RejectScore = school.RejectScore
+	}
+	return global
+}
@@ -0,0 +1,326 @@
+package ocr
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+var testThresholds = Thresholds{AutoApproveScore: 90, ConfirmScore: 70, RejectScore: 40}
+
+func newTestPolicy(t *testing.T, thresholds Thresholds) *Policy {
+	t.Helper()
+
+	p, err := NewPolicy(PolicyConfig{
+		Thresholds:    thresholds,
+		MaxStudyYears: 8,
+		Schools: []SchoolPolicy{
+			{
+				Name:             "华中科技大学",
+				Aliases:          []string{"华科", "HUST"},
+				StudentIDPattern: `^U\d{9}$`,
+			},
+			{
+				Name:               "武汉大学",
+				StudentIDPattern:   `^\d{13}$`,
+				DisableAutoApprove: true,
+			},
+			{
+				Name:       "清华大学",
+				Thresholds: Thresholds{AutoApproveScore: 99.9},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewPolicy failed: %v", err)
+	}
+	return p
+}
+
+// yearsAgo 距今 n 年的年份字符串（n 为负数表示未来）
+func yearsAgo(n int) string {
+	return strconv.Itoa(time.Now().Year() - n)
+}
+
+// validResult 各字段均有效的华中科技大学识别结果
+func validResult() *OcrResult {
+	return &OcrResult{
+		RealName:      "张三",
+		SchoolName:    "华中科技大学",
+		StudentID:     "U202300001",
+		AdmissionYear: yearsAgo(1),
+		Confidence:    98.5,
+	}
+}
+
+func TestPolicyEvaluate(t *testing.T) {
+	p := newTestPolicy(t, testThresholds)
+
+	tests := []struct {
+		name          string
+		mutate        func(r *OcrResult)
+		admissionYear string
+		wantAction    string
+		wantScore     float64
+		wantSchool    string
+		wantReason    string
+	}{
+		{
+			name:       "精确匹配自动通过",
+			wantAction: DecisionApprove,
+			wantScore:  99.85,
+			wantSchool: "华中科技大学",
+		},
+		{
+			name:       "别名匹配自动通过",
+			mutate:     func(r *OcrResult) { r.SchoolName = "HUST" },
+			wantAction: DecisionApprove,
+			wantScore:  99.85,
+			wantSchool: "华中科技大学",
+		},
+		{
+			name:       "别名忽略空白",
+			mutate:     func(r *OcrResult) { r.SchoolName = " 华 科 " },
+			wantAction: DecisionApprove,
+			wantScore:  99.85,
+			wantSchool: "华中科技大学",
+		},
+		{
+			name:       "校区匹配不允许自动通过",
+			mutate:     func(r *OcrResult) { r.SchoolName = "华中科技大学（主校区）" },
+			wantAction: DecisionConfirm,
+			wantScore:  84.85,
+			wantSchool: "华中科技大学",
+			wantReason: "学校名称按校区匹配为华中科技大学",
+		},
+		{
+			name:       "校区后缀不带括号",
+			mutate:     func(r *OcrResult) { r.SchoolName = "华中科技大学同济校区" },
+			wantAction: DecisionConfirm,
+			wantScore:  84.85,
+			wantSchool: "华中科技大学",
+		},
+		{
+			name:       "附属中学不匹配大学",
+			mutate:     func(r *OcrResult) { r.SchoolName = "华中科技大学附属中学" },
+			wantAction: DecisionManualReview,
+			wantScore:  57.85,
+			wantReason: "不在已知学校字典中",
+		},
+		{
+			name:       "继续教育学院不匹配大学",
+			mutate:     func(r *OcrResult) { r.SchoolName = "华中科技大学继续教育学院" },
+			wantAction: DecisionManualReview,
+			wantScore:  57.85,
+			wantReason: "不在已知学校字典中",
+		},
+		{
+			name:          "入学年份晚于当前年份自动拒绝",
+			admissionYear: yearsAgo(-1),
+			wantAction:    DecisionReject,
+			wantScore:     79.85,
+			wantSchool:    "华中科技大学",
+			wantReason:    "晚于当前年份",
+		},
+		{
+			name:          "用户填写的入学年份优先于识别结果",
+			mutate:        func(r *OcrResult) { r.AdmissionYear = yearsAgo(-2) },
+			admissionYear: yearsAgo(2),
+			wantAction:    DecisionApprove,
+			wantScore:     99.85,
+			wantSchool:    "华中科技大学",
+		},
+		{
+			name:       "入学年份过于久远转用户确认",
+			mutate:     func(r *OcrResult) { r.AdmissionYear = yearsAgo(10) },
+			wantAction: DecisionConfirm,
+			wantScore:  83.85,
+			wantSchool: "华中科技大学",
+			wantReason: "距今超过8年",
+		},
+		{
+			name:       "未识别入学年份",
+			mutate:     func(r *OcrResult) { r.AdmissionYear = "" },
+			wantAction: DecisionConfirm,
+			wantScore:  89.85,
+			wantSchool: "华中科技大学",
+			wantReason: "未识别入学年份",
+		},
+		{
+			name:       "学号不符合学校格式转人工审核",
+			mutate:     func(r *OcrResult) { r.StudentID = "12345" },
+			wantAction: DecisionManualReview,
+			wantScore:  69.85,
+			wantSchool: "华中科技大学",
+			wantReason: "学号不符合华中科技大学的学号格式",
+		},
+		{
+			name:       "单字段过低不允许自动通过",
+			mutate:     func(r *OcrResult) { r.RealName = "Zhang San" },
+			wantAction: DecisionConfirm,
+			wantScore:  93.85,
+			wantSchool: "华中科技大学",
+			wantReason: "字段real_name得分40.0过低",
+		},
+		{
+			name: "未知学校转人工审核",
+			mutate: func(r *OcrResult) {
+				r.SchoolName = "某某职业学院"
+			},
+			wantAction: DecisionManualReview,
+			wantScore:  57.85,
+			wantReason: "不在已知学校字典中",
+		},
+		{
+			name: "学校关闭自动通过",
+			mutate: func(r *OcrResult) {
+				r.SchoolName = "武汉大学"
+				r.StudentID = "2023301000001"
+			},
+			wantAction: DecisionConfirm,
+			wantScore:  99.85,
+			wantSchool: "武汉大学",
+			wantReason: "该校未开启自动通过",
+		},
+		{
+			name: "学校阈值覆盖",
+			mutate: func(r *OcrResult) {
+				r.SchoolName = "清华大学"
+				r.StudentID = "2023000001"
+			},
+			wantAction: DecisionConfirm,
+			wantScore:  87.85,
+			wantSchool: "清华大学",
+			wantReason: "未配置该校学号格式",
+		},
+		{
+			name: "关键字段全部缺失自动拒绝",
+			mutate: func(r *OcrResult) {
+				*r = OcrResult{}
+			},
+			wantAction: DecisionReject,
+			wantScore:  15.56,
+			wantReason: "低于拒绝线",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := validResult()
+			if tt.mutate != nil {
+				tt.mutate(result)
+			}
+			d := p.Evaluate(result, tt.admissionYear)
+
+			if d.Action != tt.wantAction {
+				t.Fatalf("action = %s, want %s (score=%.2f, reasons=%s)", d.Action, tt.wantAction, d.Score, d.Summary())
+			}
+			if d.Score != tt.wantScore {
+				t.Fatalf("score = %.2f, want %.2f", d.Score, tt.wantScore)
+			}
+			if d.School != tt.wantSchool {
+				t.Fatalf("school = %q, want %q", d.School, tt.wantSchool)
+			}
+			if tt.wantReason != "" && !strings.Contains(d.Summary(), tt.wantReason) {
+				t.Fatalf("reasons %q should contain %q", d.Summary(), tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestPolicyEvaluateUsesSchoolThresholds(t *testing.T) {
+	p := newTestPolicy(t, testThresholds)
+
+	result := validResult()
+	result.SchoolName = "清华大学"
+	d := p.Evaluate(result, "")
+
+	want := Thresholds{AutoApproveScore: 99.9, ConfirmScore: 70, RejectScore: 40}
+	if d.Thresholds != want {
+		t.Fatalf("thresholds = %+v, want %+v", d.Thresholds, want)
+	}
+
+	d = p.Evaluate(validResult(), "")
+	if d.Thresholds != testThresholds {
+		t.Fatalf("thresholds = %+v, want global %+v", d.Thresholds, testThresholds)
+	}
+}
+
+func TestPolicyUnknownSchoolNeverAutoApproves(t *testing.T) {
+	// 放宽阈值使未知学校的总分达到自动通过线
+	p := newTestPolicy(t, Thresholds{AutoApproveScore: 50, ConfirmScore: 40, RejectScore: 20})
+
+	result := validResult()
+	result.SchoolName = "某某职业学院"
+	d := p.Evaluate(result, "")
+
+	if d.Score < 50 {
+		t.Fatalf("score = %.2f, expected to reach auto approve threshold", d.Score)
+	}
+	if d.Action != DecisionConfirm {
+		t.Fatalf("action = %s, want %s", d.Action, DecisionConfirm)
+	}
+	if !strings.Contains(d.Summary(), "学校未在字典中，不允许自动通过") {
+		t.Fatalf("reasons = %q, want unknown school cap", d.Summary())
+	}
+}
+
+func TestNewPolicyValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		conf    PolicyConfig
+		wantErr bool
+	}{
+		{
+			name: "全局阈值有序",
+			conf: PolicyConfig{Thresholds: testThresholds},
+		},
+		{
+			name:    "全局阈值倒置",
+			conf:    PolicyConfig{Thresholds: Thresholds{AutoApproveScore: 60, ConfirmScore: 70, RejectScore: 40}},
+			wantErr: true,
+		},
+		{
+			name: "学校覆盖后仍有序",
+			conf: PolicyConfig{Thresholds: testThresholds, Schools: []SchoolPolicy{
+				{Name: "清华大学", Thresholds: Thresholds{AutoApproveScore: 95, ConfirmScore: 80}},
+			}},
+		},
+		{
+			name: "学校拒绝线高于全局确认线",
+			conf: PolicyConfig{Thresholds: testThresholds, Schools: []SchoolPolicy{
+				{Name: "清华大学", Thresholds: Thresholds{RejectScore: 80}},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "学校自动通过线低于全局确认线",
+			conf: PolicyConfig{Thresholds: testThresholds, Schools: []SchoolPolicy{
+				{Name: "清华大学", Thresholds: Thresholds{AutoApproveScore: 60}},
+			}},
+			wantErr: true,
+		},
+		{
+			name:    "学校名称为空",
+			conf:    PolicyConfig{Thresholds: testThresholds, Schools: []SchoolPolicy{{Name: " "}}},
+			wantErr: true,
+		},
+		{
+			name: "学号正则无效",
+			conf: PolicyConfig{Thresholds: testThresholds, Schools: []SchoolPolicy{
+				{Name: "清华大学", StudentIDPattern: "(["},
+			}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewPolicy(tt.conf)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,186 @@
+package ocr
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// stubRedis 内存版 Redis（仅实现熔断器与调用统计用到的命令），避免测试依赖外部 Redis
+type stubRedis struct {
+	mu      sync.Mutex
+	strings map[string]string
+	hashes  map[string]map[string]int64
+}
+
+// newStubRedis 启动本地 RESP 服务并返回连接到它的客户端
+func newStubRedis(t *testing.T) (*redis.Client, *stubRedis) {
+	t.Helper()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen stub redis failed: %v", err)
+	}
+	s := &stubRedis{
+		strings: make(map[string]string),
+		hashes:  make(map[string]map[string]int64),
+	}
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn)
+		}
+	}()
+
+	rdb := redis.NewClient(&redis.Options{Addr: ln.Addr().String()})
+	t.Cleanup(func() {
+		_ = rdb.Close()
+		_ = ln.Close()
+	})
+	return rdb, s
+}
+
+// get 读取字符串值
+func (s *stubRedis) get(key string) (string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	v, ok := s.strings[key]
+	return v, ok
+}
+
+// hget 读取哈希字段
+func (s *stubRedis) hget(key, field string) int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.hashes[key][field]
+}
+
+// set 写入字符串值
+func (s *stubRedis) set(key, value string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.strings[key] = value
+}
+
+func (s *stubRedis) serve(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	for {
+		args, err := readCommand(r)
+		if err != nil {
+			return
+		}
+		if _, err := io.WriteString(conn, s.exec(args)); err != nil {
+			return
+		}
+	}
+}
+
+// exec 执行命令，返回 RESP 编码的响应
+func (s *stubRedis) exec(args []string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch strings.ToUpper(args[0]) {
+	case "PING":
+		return "+PONG\r\n"
+	case "GET":
+		v, ok := s.strings[args[1]]
+		if !ok {
+			return "$-1\r\n"
+		}
+		return fmt.Sprintf("$%d\r\n%s\r\n", len(v), v)
+	case "SET":
+		s.strings[args[1]] = args[2]
+		return "+OK\r\n"
+	case "INCR":
+		n, _ := strconv.ParseInt(s.strings[args[1]], 10, 64)
+		n++
+		s.strings[args[1]] = strconv.FormatInt(n, 10)
+		return fmt.Sprintf(":%d\r\n", n)
+	case "HINCRBY":
+		delta, _ := strconv.ParseInt(args[3], 10, 64)
+		if s.hashes[args[1]] == nil {
+			s.hashes[args[1]] = make(map[string]int64)
+		}
+		s.hashes[args[1]][args[2]] += delta
+		return fmt.Sprintf(":%d\r\n", s.hashes[args[1]][args[2]])
+	case "EXPIRE":
+		return fmt.Sprintf(":%d\r\n", s.count(args[1:2]))
+	case "EXISTS":
+		return fmt.Sprintf(":%d\r\n", s.count(args[1:]))
+	case "DEL":
+		n := s.count(args[1:])
+		for _, key := range args[1:] {
+			delete(s.strings, key)
+			delete(s.hashes, key)
+		}
+		return fmt.Sprintf(":%d\r\n", n)
+	default:
+		return fmt.Sprintf("-ERR unknown command '%s'\r\n", args[0])
+	}
+}
+
+// count 统计存在的 key 数量（调用方持锁）
+func (s *stubRedis) count(keys []string) int {
+	n := 0
+	for _, key := range keys {
+		if _, ok := s.strings[key]; ok {
+			n++
+		} else if _, ok := s.hashes[key]; ok {
+			n++
+		}
+	}
+	return n
+}
+
+// readCommand 读取一条 RESP 数组命令
+func readCommand(r *bufio.Reader) ([]string, error) {
+	line, err := readLine(r)
+	if err != nil {
+		return nil, err
+	}
+	if len(line) < 2 || line[0] != '*' {
+		return nil, fmt.Errorf("unexpected command header %q", line)
+	}
+	n, err := strconv.Atoi(line[1:])
+	if err != nil {
+		return nil, err
+	}
+
+	args := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		header, err := readLine(r)
+		if err != nil {
+			return nil, err
+		}
+		size, err := strconv.Atoi(strings.TrimPrefix(header, "$"))
+		if err != nil {
+			return nil, err
+		}
+		buf := make([]byte, size+2)
+		if _, err := io.ReadFull(r, buf); err != nil {
+			return nil, err
+		}
+		args = append(args, string(buf[:size]))
+	}
+	return args, nil
+}
+
+func readLine(r *bufio.Reader) (string, error) {
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
@@ -0,0 +1,95 @@
+/**
+ * @projectName: CampusHub
+ * @package: ocr
+ * @className: registry
+ * @author: lijunqi
+ * @description: OCR提供商注册表，按名称注册构造函数并按配置的权重构建路由列表
+ * @date: 2026-10-18
+ * @version: 1.0
+ */
+
+package ocr
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// ============================================================================
+// 注册表
+// ============================================================================
+
+// Builder 提供商构造函数（延迟构造，仅在被配置引用时调用）
+type Builder func() (Provider, error)
+
+// ProviderSpec 提供商路由配置
+type ProviderSpec struct {
+	// Name 提供商名称（需已注册）
+	Name string
+	// Weight 权重：>0 按权重分配首选流量；=0 仅作为备用（故障转移时使用）
+	Weight int
+}
+
+// WeightedProvider 带权重的提供商
+type WeightedProvider struct {
+	Provider Provider
+	Weight   int
+}
+
+// Registry OCR提供商注册表
+type Registry struct {
+	builders map[string]Builder
+}
+
+// NewRegistry 创建提供商注册表
+func NewRegistry() *Registry {
+	return &Registry{builders: make(map[string]Builder)}
+}
+
+// Register 注册提供商构造函数（同名覆盖）
+func (r *Registry) Register(name string, builder Builder) {
+	r.builders[name] = builder
+}
+
+// Names 返回已注册的提供商名称（有序）
+func (r *Registry) Names() []string {
+	names := make([]string, 0, len(r.builders))
+	for name := range r.builders {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Build 按配置构建带权重的提供商列表
+// 未注册的名称、负权重、重复配置视为配置错误；单个提供商构造失败仅记录日志并跳过
+func (r *Registry) Build(specs []ProviderSpec) ([]WeightedProvider, error) {
+	providers := make([]WeightedProvider, 0, len(specs))
+	seen := make(map[string]bool, len(specs))
+
+	for _, spec := range specs {
+		builder, ok := r.builders[spec.Name]
+		if !ok {
+			return nil, fmt.Errorf("ocr provider %q is not registered, available: %v", spec.Name, r.Names())
+		}
+		if spec.Weight < 0 {
+			return nil, fmt.Errorf("ocr provider %q weight must be >= 0, got %d", spec.Name, spec.Weight)
+		}
+		if seen[spec.Name] {
+			return nil, fmt.Errorf("ocr provider %q is configured more than once", spec.Name)
+		}
+		seen[spec.Name] = true
+
+		provider, err := builder()
+		if err != nil {
+			logx.Errorf("初始化OCR提供商[%s]失败: %v", spec.Name, err)
+			continue
+		}
+		providers = append(providers, WeightedProvider{Provider: provider, Weight: spec.Weight})
+		logx.Infof("OCR提供商[%s]初始化成功，权重: %d", spec.Name, spec.Weight)
+	}
+
+	return providers, nil
+}
@@ -14,8 +14,12 @@ import (
 )
 
 type (
+	AdminAdjustScoreReq         = pb.AdminAdjustScoreReq
+	AdminAdjustScoreResp        = pb.AdminAdjustScoreResp
 	ApplyStudentVerifyReq       = pb.ApplyStudentVerifyReq
 	ApplyStudentVerifyResp      = pb.ApplyStudentVerifyResp
+	BatchGetVerifyProfilesReq   = pb.BatchGetVerifyProfilesReq
+	BatchGetVerifyProfilesResp  = pb.BatchGetVerifyProfilesResp
 	CanParticipateReq           = pb.CanParticipateReq
 	CanParticipateResp          = pb.CanParticipateResp
 	CanPublishReq               = pb.CanPublishReq
@@ -29,8 +33,13 @@ type (
 	CheckQQEmailResponse        = pb.CheckQQEmailResponse
 	CheckUserExistsReq          = pb.CheckUserExistsReq
 	CheckUserExistsResponse     = pb.CheckUserExistsResponse
+	ClaimVerifyReviewReq        = pb.ClaimVerifyReviewReq
+	ClaimVerifyReviewResp       = pb.ClaimVerifyReviewResp
 	ConfirmStudentVerifyReq     = pb.ConfirmStudentVerifyReq
 	ConfirmStudentVerifyResp    = pb.ConfirmStudentVerifyResp
+	CreateInterestTagReq        = pb.CreateInterestTagReq
+	CreditAppealItem            = pb.CreditAppealItem
+	CreditAuditItem             = pb.CreditAuditItem
 	CreditLogItem               = pb.CreditLogItem
 	DeleteUserReq               = pb.DeleteUserReq
 	DeleteUserResponse          = pb.DeleteUserResponse
@@ -48,6 +57,8 @@ type (
 	GetCreditLogsResp           = pb.GetCreditLogsResp
 	GetGroupUserReq             = pb.GetGroupUserReq
 	GetGroupUserResponse        = pb.GetGroupUserResponse
+	GetPlatformStatsReq         = pb.GetPlatformStatsReq
+	GetPlatformStatsResp        = pb.GetPlatformStatsResp
 	GetSysImageReq              = pb.GetSysImageReq
 	GetSysImageResp             = pb.GetSysImageResp
 	GetTagsByIdsReq             = pb.GetTagsByIdsReq
@@ -62,29 +73,54 @@ type (
 	GetVerifyCurrentResp        = pb.GetVerifyCurrentResp
 	GetVerifyInfoReq            = pb.GetVerifyInfoReq
 	GetVerifyInfoResp           = pb.GetVerifyInfoResp
+	GetVerifyReviewStatsReq     = pb.GetVerifyReviewStatsReq
+	GetVerifyReviewStatsResp    = pb.GetVerifyReviewStatsResp
 	GroupUserInfo               = pb.GroupUserInfo
 	InitCreditReq               = pb.InitCreditReq
 	InitCreditResp              = pb.InitCreditResp
 	InterestTag                 = pb.InterestTag
+	InterestTagResp             = pb.InterestTagResp
 	IsVerifiedReq               = pb.IsVerifiedReq
 	IsVerifiedResp              = pb.IsVerifiedResp
+	ListCreditAppealsReq        = pb.ListCreditAppealsReq
+	ListCreditAppealsResp       = pb.ListCreditAppealsResp
+	ListCreditAuditsReq         = pb.ListCreditAuditsReq
+	ListCreditAuditsResp        = pb.ListCreditAuditsResp
+	ListVerifyReviewsReq        = pb.ListVerifyReviewsReq
+	ListVerifyReviewsResp       = pb.ListVerifyReviewsResp
 	LoginReq                    = pb.LoginReq
 	LoginResponse               = pb.LoginResponse
 	LoginUserInfo               = pb.LoginUserInfo
 	LogoutReq                   = pb.LogoutReq
 	LogoutResponse              = pb.LogoutResponse
+	MergeInterestTagsReq        = pb.MergeInterestTagsReq
+	MergeInterestTagsResp       = pb.MergeInterestTagsResp
 	ProcessOcrVerifyReq         = pb.ProcessOcrVerifyReq
 	ProcessOcrVerifyResp        = pb.ProcessOcrVerifyResp
+	RecordUserActiveReq         = pb.RecordUserActiveReq
+	RecordUserActiveResp        = pb.RecordUserActiveResp
 	RefreshReq                  = pb.RefreshReq
 	RefreshResponse             = pb.RefreshResponse
 	RegisterReq                 = pb.RegisterReq
 	RegisterResponse            = pb.RegisterResponse
+	ReportDailyStatsReq         = pb.ReportDailyStatsReq
+	ReportDailyStatsResp        = pb.ReportDailyStatsResp
+	ReverifyStudentReq          = pb.ReverifyStudentReq
+	ReviewCreditAppealReq       = pb.ReviewCreditAppealReq
+	ReviewCreditAppealResp      = pb.ReviewCreditAppealResp
+	ReviewStudentVerifyReq      = pb.ReviewStudentVerifyReq
+	ReviewStudentVerifyResp     = pb.ReviewStudentVerifyResp
 	SendQQEmailReq              = pb.SendQQEmailReq
 	SendQQEmailResponse         = pb.SendQQEmailResponse
+	SetInterestTagStatusReq     = pb.SetInterestTagStatusReq
+	StatsMetricItem             = pb.StatsMetricItem
+	SubmitCreditAppealReq       = pb.SubmitCreditAppealReq
+	SubmitCreditAppealResp      = pb.SubmitCreditAppealResp
 	TagBasicInfo                = pb.TagBasicInfo
 	TagInfo                     = pb.TagInfo
 	TagUsageCountReq            = pb.TagUsageCountReq
 	TagUsageCountResp           = pb.TagUsageCountResp
+	UpdateInterestTagReq        = pb.UpdateInterestTagReq
 	UpdatePasswordReq           = pb.UpdatePasswordReq
 	UpdatePasswordResponse      = pb.UpdatePasswordResponse
 	UpdateScoreReq              = pb.UpdateScoreReq
@@ -108,11 +144,15 @@ type (
 	UserHomeActivityItem        = pb.UserHomeActivityItem
 	UserHomeActivityList        = pb.UserHomeActivityList
 	UserHomeInfo                = pb.UserHomeInfo
+	UserHomeOrganizerRating     = pb.UserHomeOrganizerRating
 	UserHomeTag                 = pb.UserHomeTag
 	UserInfo                    = pb.UserInfo
 	UserTag                     = pb.UserTag
 	VerifyModifiedData          = pb.VerifyModifiedData
 	VerifyOcrData               = pb.VerifyOcrData
+	VerifyProfile               = pb.VerifyProfile
+	VerifyReviewItem            = pb.VerifyReviewItem
+	VerifyReviewerStat          = pb.VerifyReviewerStat
 
 	CaptchaService interface {
 		GetCaptchaConfig(ctx context.Context, in *GetCaptchaConfigReq, opts ...grpc.CallOption) (*GetCaptchaConfigResponse, error)
@@ -14,8 +14,12 @@ import (
 )
 
 type (
+	AdminAdjustScoreReq         = pb.AdminAdjustScoreReq
+	AdminAdjustScoreResp        = pb.AdminAdjustScoreResp
 	ApplyStudentVerifyReq       = pb.ApplyStudentVerifyReq
 	ApplyStudentVerifyResp      = pb.ApplyStudentVerifyResp
+	BatchGetVerifyProfilesReq   = pb.BatchGetVerifyProfilesReq
+	BatchGetVerifyProfilesResp  = pb.BatchGetVerifyProfilesResp
 	CanParticipateReq           = pb.CanParticipateReq
 	CanParticipateResp          = pb.CanParticipateResp
 	CanPublishReq               = pb.CanPublishReq
@@ -29,8 +33,13 @@ type (
 	CheckQQEmailResponse        = pb.CheckQQEmailResponse
 	CheckUserExistsReq          = pb.CheckUserExistsReq
 	CheckUserExistsResponse     = pb.CheckUserExistsResponse
+	ClaimVerifyReviewReq        = pb.ClaimVerifyReviewReq
+	ClaimVerifyReviewResp       = pb.ClaimVerifyReviewResp
 	ConfirmStudentVerifyReq     = pb.ConfirmStudentVerifyReq
 	ConfirmStudentVerifyResp    = pb.ConfirmStudentVerifyResp
+	CreateInterestTagReq        = pb.CreateInterestTagReq
+	CreditAppealItem            = pb.CreditAppealItem
+	CreditAuditItem             = pb.CreditAuditItem
 	CreditLogItem               = pb.CreditLogItem
 	DeleteUserReq               = pb.DeleteUserReq
 	DeleteUserResponse          = pb.DeleteUserResponse
@@ -48,6 +57,8 @@ type (
 	GetCreditLogsResp           = pb.GetCreditLogsResp
 	GetGroupUserReq             = pb.GetGroupUserReq
 	GetGroupUserResponse        = pb.GetGroupUserResponse
+	GetPlatformStatsReq         = pb.GetPlatformStatsReq
+	GetPlatformStatsResp        = pb.GetPlatformStatsResp
 	GetSysImageReq              = pb.GetSysImageReq
 	GetSysImageResp             = pb.GetSysImageResp
 	GetTagsByIdsReq             = pb.GetTagsByIdsReq
@@ -62,29 +73,54 @@ type (
 	GetVerifyCurrentResp        = pb.GetVerifyCurrentResp
 	GetVerifyInfoReq            = pb.GetVerifyInfoReq
 	GetVerifyInfoResp           = pb.GetVerifyInfoResp
+	GetVerifyReviewStatsReq     = pb.GetVerifyReviewStatsReq
+	GetVerifyReviewStatsResp    = pb.GetVerifyReviewStatsResp
 	GroupUserInfo               = pb.GroupUserInfo
 	InitCreditReq               = pb.InitCreditReq
 	InitCreditResp              = pb.InitCreditResp
 	InterestTag                 = pb.InterestTag
+	InterestTagResp             = pb.InterestTagResp
 	IsVerifiedReq               = pb.IsVerifiedReq
 	IsVerifiedResp              = pb.IsVerifiedResp
+	ListCreditAppealsReq        = pb.ListCreditAppealsReq
+	ListCreditAppealsResp       = pb.ListCreditAppealsResp
+	ListCreditAuditsReq         = pb.ListCreditAuditsReq
+	ListCreditAuditsResp        = pb.ListCreditAuditsResp
+	ListVerifyReviewsReq        = pb.ListVerifyReviewsReq
+	ListVerifyReviewsResp       = pb.ListVerifyReviewsResp
 	LoginReq                    = pb.LoginReq
 	LoginResponse               = pb.LoginResponse
 	LoginUserInfo               = pb.LoginUserInfo
 	LogoutReq                   = pb.LogoutReq
 	LogoutResponse              = pb.LogoutResponse
+	MergeInterestTagsReq        = pb.MergeInterestTagsReq
+	MergeInterestTagsResp       = pb.MergeInterestTagsResp
 	ProcessOcrVerifyReq         = pb.ProcessOcrVerifyReq
 	ProcessOcrVerifyResp        = pb.ProcessOcrVerifyResp
+	RecordUserActiveReq         = pb.RecordUserActiveReq
+	RecordUserActiveResp        = pb.RecordUserActiveResp
 	RefreshReq                  = pb.RefreshReq
 	RefreshResponse             = pb.RefreshResponse
 	RegisterReq                 = pb.RegisterReq
 	RegisterResponse            = pb.RegisterResponse
+	ReportDailyStatsReq         = pb.ReportDailyStatsReq
+	ReportDailyStatsResp        = pb.ReportDailyStatsResp
+	ReverifyStudentReq          = pb.ReverifyStudentReq
+	ReviewCreditAppealReq       = pb.ReviewCreditAppealReq
+	ReviewCreditAppealResp      = pb.ReviewCreditAppealResp
+	ReviewStudentVerifyReq      = pb.ReviewStudentVerifyReq
+	ReviewStudentVerifyResp     = pb.ReviewStudentVerifyResp
 	SendQQEmailReq              = pb.SendQQEmailReq
 	SendQQEmailResponse         = pb.SendQQEmailResponse
+	SetInterestTagStatusReq     = pb.SetInterestTagStatusReq
+	StatsMetricItem             = pb.StatsMetricItem
+	SubmitCreditAppealReq       = pb.SubmitCreditAppealReq
+	SubmitCreditAppealResp      = pb.SubmitCreditAppealResp
 	TagBasicInfo                = pb.TagBasicInfo
 	TagInfo                     = pb.TagInfo
 	TagUsageCountReq            = pb.TagUsageCountReq
 	TagUsageCountResp           = pb.TagUsageCountResp
+	UpdateInterestTagReq        = pb.UpdateInterestTagReq
 	UpdatePasswordReq           = pb.UpdatePasswordReq
 	UpdatePasswordResponse      = pb.UpdatePasswordResponse
 	UpdateScoreReq              = pb.UpdateScoreReq
@@ -108,11 +144,15 @@ type (
 	UserHomeActivityItem        = pb.UserHomeActivityItem
 	UserHomeActivityList        = pb.UserHomeActivityList
 	UserHomeInfo                = pb.UserHomeInfo
+	UserHomeOrganizerRating     = pb.UserHomeOrganizerRating
 	UserHomeTag                 = pb.UserHomeTag
 	UserInfo                    = pb.UserInfo
 	UserTag                     = pb.UserTag
 	VerifyModifiedData          = pb.VerifyModifiedData
 	VerifyOcrData               = pb.VerifyOcrData
+	VerifyProfile               = pb.VerifyProfile
+	VerifyReviewItem            = pb.VerifyReviewItem
+	VerifyReviewerStat          = pb.VerifyReviewerStat
 
 	CreditService interface {
 		// GetCreditInfo 获取用户信用信息
@@ -127,6 +167,16 @@ type (
 		InitCredit(ctx context.Context, in *InitCreditReq, opts ...grpc.CallOption) (*InitCreditResp, error)
 		// UpdateScore 变更信用分
 		UpdateScore(ctx context.Context, in *UpdateScoreReq, opts ...grpc.CallOption) (*UpdateScoreResp, error)
+		// SubmitCreditAppeal 提交信用申诉
+		SubmitCreditAppeal(ctx context.Context, in *SubmitCreditAppealReq, opts ...grpc.CallOption) (*SubmitCreditAppealResp, error)
+		// ListCreditAppeals 查询申诉列表
+		ListCreditAppeals(ctx context.Context, in *ListCreditAppealsReq, opts ...grpc.CallOption) (*ListCreditAppealsResp, error)
+		// ReviewCreditAppeal 处理信用申诉
+		ReviewCreditAppeal(ctx context.Context, in *ReviewCreditAppealReq, opts ...grpc.CallOption) (*ReviewCreditAppealResp, error)
+		// AdminAdjustScore 管理员手动调整信用分
+		AdminAdjustScore(ctx context.Context, in *AdminAdjustScoreReq, opts ...grpc.CallOption) (*AdminAdjustScoreResp, error)
+		// ListCreditAudits 查询信用管理审计日志
+		ListCreditAudits(ctx context.Context, in *ListCreditAuditsReq, opts ...grpc.CallOption) (*ListCreditAuditsResp, error)
 	}
 
 	defaultCreditService struct {
@@ -175,3 +225,33 @@ func (m *defaultCreditService) UpdateScore(ctx context.Context, in *UpdateScoreR
 	client := pb.NewCreditServiceClient(m.cli.Conn())
 	return client.UpdateScore(ctx, in, opts...)
 }
+
+// SubmitCreditAppeal 提交信用申诉
+func (m *defaultCreditService) SubmitCreditAppeal(ctx context.Context, in *SubmitCreditAppealReq, opts ...grpc.CallOption) (*SubmitCreditAppealResp, error) {
+	client := pb.NewCreditServiceClient(m.cli.Conn())
+	return client.SubmitCreditAppeal(ctx, in, opts...)
+}
+
+// ListCreditAppeals 查询申诉列表
+func (m *defaultCreditService) ListCreditAppeals(ctx context.Context, in *ListCreditAppealsReq, opts ...grpc.CallOption) (*ListCreditAppealsResp, error) {
+	client := pb.NewCreditServiceClient(m.cli.Conn())
+	return client.ListCreditAppeals(ctx, in, opts...)
+}
+
+// ReviewCreditAppeal 处理信用申诉
+func (m *defaultCreditService) ReviewCreditAppeal(ctx context.Context, in *ReviewCreditAppealReq, opts ...grpc.CallOption) (*ReviewCreditAppealResp, error) {
+	client := pb.NewCreditServiceClient(m.cli.Conn())
+	return client.ReviewCreditAppeal(ctx, in, opts...)
+}
+
+// AdminAdjustScore 管理员手动调整信用分
+func (m *defaultCreditService) AdminAdjustScore(ctx context.Context, in *AdminAdjustScoreReq, opts ...grpc.CallOption) (*AdminAdjustScoreResp, error) {
+	client := pb.NewCreditServiceClient(m.cli.Conn())
+	return client.AdminAdjustScore(ctx, in, opts...)
+}
+
+// ListCreditAudits 查询信用管理审计日志
+func (m *defaultCreditService) ListCreditAudits(ctx context.Context, in *ListCreditAuditsReq, opts ...grpc.CallOption) (*ListCreditAuditsResp, error) {
+	client := pb.NewCreditServiceClient(m.cli.Conn())
+	return client.ListCreditAudits(ctx, in, opts...)
+}
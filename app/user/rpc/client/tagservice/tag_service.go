@@ -14,8 +14,12 @@ import (
 )
 
 type (
+	AdminAdjustScoreReq         = pb.AdminAdjustScoreReq
+	AdminAdjustScoreResp        = pb.AdminAdjustScoreResp
 	ApplyStudentVerifyReq       = pb.ApplyStudentVerifyReq
 	ApplyStudentVerifyResp      = pb.ApplyStudentVerifyResp
+	BatchGetVerifyProfilesReq   = pb.BatchGetVerifyProfilesReq
+	BatchGetVerifyProfilesResp  = pb.BatchGetVerifyProfilesResp
 	CanParticipateReq           = pb.CanParticipateReq
 	CanParticipateResp          = pb.CanParticipateResp
 	CanPublishReq               = pb.CanPublishReq
@@ -29,8 +33,13 @@ type (
 	CheckQQEmailResponse        = pb.CheckQQEmailResponse
 	CheckUserExistsReq          = pb.CheckUserExistsReq
 	CheckUserExistsResponse     = pb.CheckUserExistsResponse
+	ClaimVerifyReviewReq        = pb.ClaimVerifyReviewReq
+	ClaimVerifyReviewResp       = pb.ClaimVerifyReviewResp
 	ConfirmStudentVerifyReq     = pb.ConfirmStudentVerifyReq
 	ConfirmStudentVerifyResp    = pb.ConfirmStudentVerifyResp
+	CreateInterestTagReq        = pb.CreateInterestTagReq
+	CreditAppealItem            = pb.CreditAppealItem
+	CreditAuditItem             = pb.CreditAuditItem
 	CreditLogItem               = pb.CreditLogItem
 	DeleteUserReq               = pb.DeleteUserReq
 	DeleteUserResponse          = pb.DeleteUserResponse
@@ -48,6 +57,8 @@ type (
 	GetCreditLogsResp           = pb.GetCreditLogsResp
 	GetGroupUserReq             = pb.GetGroupUserReq
 	GetGroupUserResponse        = pb.GetGroupUserResponse
+	GetPlatformStatsReq         = pb.GetPlatformStatsReq
+	GetPlatformStatsResp        = pb.GetPlatformStatsResp
 	GetSysImageReq              = pb.GetSysImageReq
 	GetSysImageResp             = pb.GetSysImageResp
 	GetTagsByIdsReq             = pb.GetTagsByIdsReq
@@ -62,29 +73,54 @@ type (
 	GetVerifyCurrentResp        = pb.GetVerifyCurrentResp
 	GetVerifyInfoReq            = pb.GetVerifyInfoReq
 	GetVerifyInfoResp           = pb.GetVerifyInfoResp
+	GetVerifyReviewStatsReq     = pb.GetVerifyReviewStatsReq
+	GetVerifyReviewStatsResp    = pb.GetVerifyReviewStatsResp
 	GroupUserInfo               = pb.GroupUserInfo
 	InitCreditReq               = pb.InitCreditReq
 	InitCreditResp              = pb.InitCreditResp
 	InterestTag                 = pb.InterestTag
+	InterestTagResp             = pb.InterestTagResp
 	IsVerifiedReq               = pb.IsVerifiedReq
 	IsVerifiedResp              = pb.IsVerifiedResp
+	ListCreditAppealsReq        = pb.ListCreditAppealsReq
+	ListCreditAppealsResp       = pb.ListCreditAppealsResp
+	ListCreditAuditsReq         = pb.ListCreditAuditsReq
+	ListCreditAuditsResp        = pb.ListCreditAuditsResp
+	ListVerifyReviewsReq        = pb.ListVerifyReviewsReq
+	ListVerifyReviewsResp       = pb.ListVerifyReviewsResp
 	LoginReq                    = pb.LoginReq
 	LoginResponse               = pb.LoginResponse
 	LoginUserInfo               = pb.LoginUserInfo
 	LogoutReq                   = pb.LogoutReq
 	LogoutResponse              = pb.LogoutResponse
+	MergeInterestTagsReq        = pb.MergeInterestTagsReq
+	MergeInterestTagsResp       = pb.MergeInterestTagsResp
 	ProcessOcrVerifyReq         = pb.ProcessOcrVerifyReq
 	ProcessOcrVerifyResp        = pb.ProcessOcrVerifyResp
+	RecordUserActiveReq         = pb.RecordUserActiveReq
+	RecordUserActiveResp        = pb.RecordUserActiveResp
 	RefreshReq                  = pb.RefreshReq
 	RefreshResponse             = pb.RefreshResponse
 	RegisterReq                 = pb.RegisterReq
 	RegisterResponse            = pb.RegisterResponse
+	ReportDailyStatsReq         = pb.ReportDailyStatsReq
+	ReportDailyStatsResp        = pb.ReportDailyStatsResp
+	ReverifyStudentReq          = pb.ReverifyStudentReq
+	ReviewCreditAppealReq       = pb.ReviewCreditAppealReq
+	ReviewCreditAppealResp      = pb.ReviewCreditAppealResp
+	ReviewStudentVerifyReq      = pb.ReviewStudentVerifyReq
+	ReviewStudentVerifyResp     = pb.ReviewStudentVerifyResp
 	SendQQEmailReq              = pb.SendQQEmailReq
 	SendQQEmailResponse         = pb.SendQQEmailResponse
+	SetInterestTagStatusReq     = pb.SetInterestTagStatusReq
+	StatsMetricItem             = pb.StatsMetricItem
+	SubmitCreditAppealReq       = pb.SubmitCreditAppealReq
+	SubmitCreditAppealResp      = pb.SubmitCreditAppealResp
 	TagBasicInfo                = pb.TagBasicInfo
 	TagInfo                     = pb.TagInfo
 	TagUsageCountReq            = pb.TagUsageCountReq
 	TagUsageCountResp           = pb.TagUsageCountResp
+	UpdateInterestTagReq        = pb.UpdateInterestTagReq
 	UpdatePasswordReq           = pb.UpdatePasswordReq
 	UpdatePasswordResponse      = pb.UpdatePasswordResponse
 	UpdateScoreReq              = pb.UpdateScoreReq
@@ -108,11 +144,15 @@ type (
 	UserHomeActivityItem        = pb.UserHomeActivityItem
 	UserHomeActivityList        = pb.UserHomeActivityList
 	UserHomeInfo                = pb.UserHomeInfo
+	UserHomeOrganizerRating     = pb.UserHomeOrganizerRating
 	UserHomeTag                 = pb.UserHomeTag
 	UserInfo                    = pb.UserInfo
 	UserTag                     = pb.UserTag
 	VerifyModifiedData          = pb.VerifyModifiedData
 	VerifyOcrData               = pb.VerifyOcrData
+	VerifyProfile               = pb.VerifyProfile
+	VerifyReviewItem            = pb.VerifyReviewItem
+	VerifyReviewerStat          = pb.VerifyReviewerStat
 
 	TagService interface {
 		GetAllTags(ctx context.Context, in *GetAllTagsReq, opts ...grpc.CallOption) (*GetAllTagsResp, error)
@@ -122,6 +162,14 @@ type (
 		UpdateUserTag(ctx context.Context, in *UpdateUserTagReq, opts ...grpc.CallOption) (*UpdateUserTagResponse, error)
 		// 获取所有的兴趣标签
 		GetAllInterestTags(ctx context.Context, in *GetAllInterestTagsReq, opts ...grpc.CallOption) (*GetAllInterestTagsResp, error)
+		// ==================== 标签管理（管理员，经活动服务调用）====================
+		CreateInterestTag(ctx context.Context, in *CreateInterestTagReq, opts ...grpc.CallOption) (*InterestTagResp, error)
+		// 修改兴趣标签（重命名/颜色/图标/描述，空字段不修改）
+		UpdateInterestTag(ctx context.Context, in *UpdateInterestTagReq, opts ...grpc.CallOption) (*InterestTagResp, error)
+		// 启用/禁用兴趣标签
+		SetInterestTagStatus(ctx context.Context, in *SetInterestTagStatusReq, opts ...grpc.CallOption) (*InterestTagResp, error)
+		// 合并兴趣标签（用户关联改绑到目标标签，源标签禁用）
+		MergeInterestTags(ctx context.Context, in *MergeInterestTagsReq, opts ...grpc.CallOption) (*MergeInterestTagsResp, error)
 	}
 
 	defaultTagService struct {
@@ -161,3 +209,27 @@ func (m *defaultTagService) GetAllInterestTags(ctx context.Context, in *GetAllIn
 	client := pb.NewTagServiceClient(m.cli.Conn())
 	return client.GetAllInterestTags(ctx, in, opts...)
 }
+
+// ==================== 标签管理（管理员，经活动服务调用）====================
+func (m *defaultTagService) CreateInterestTag(ctx context.Context, in *CreateInterestTagReq, opts ...grpc.CallOption) (*InterestTagResp, error) {
+	client := pb.NewTagServiceClient(m.cli.Conn())
+	return client.CreateInterestTag(ctx, in, opts...)
+}
+
+// 修改兴趣标签（重命名/颜色/图标/描述，空字段不修改）
+func (m *defaultTagService) UpdateInterestTag(ctx context.Context, in *UpdateInterestTagReq, opts ...grpc.CallOption) (*InterestTagResp, error) {
+	client := pb.NewTagServiceClient(m.cli.Conn())
+	return client.UpdateInterestTag(ctx, in, opts...)
+}
+
+// 启用/禁用兴趣标签
+func (m *defaultTagService) SetInterestTagStatus(ctx context.Context, in *SetInterestTagStatusReq, opts ...grpc.CallOption) (*InterestTagResp, error) {
+	client := pb.NewTagServiceClient(m.cli.Conn())
+	return client.SetInterestTagStatus(ctx, in, opts...)
+}
+
+// 合并兴趣标签（用户关联改绑到目标标签，源标签禁用）
+func (m *defaultTagService) MergeInterestTags(ctx context.Context, in *MergeInterestTagsReq, opts ...grpc.CallOption) (*MergeInterestTagsResp, error) {
+	client := pb.NewTagServiceClient(m.cli.Conn())
+	return client.MergeInterestTags(ctx, in, opts...)
+}
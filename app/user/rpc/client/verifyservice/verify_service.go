@@ -14,8 +14,12 @@ import (
 )
 
 type (
+	AdminAdjustScoreReq         = pb.AdminAdjustScoreReq
+	AdminAdjustScoreResp        = pb.AdminAdjustScoreResp
 	ApplyStudentVerifyReq       = pb.ApplyStudentVerifyReq
 	ApplyStudentVerifyResp      = pb.ApplyStudentVerifyResp
+	BatchGetVerifyProfilesReq   = pb.BatchGetVerifyProfilesReq
+	BatchGetVerifyProfilesResp  = pb.BatchGetVerifyProfilesResp
 	CanParticipateReq           = pb.CanParticipateReq
 	CanParticipateResp          = pb.CanParticipateResp
 	CanPublishReq               = pb.CanPublishReq
@@ -29,8 +33,13 @@ type (
 	CheckQQEmailResponse        = pb.CheckQQEmailResponse
 	CheckUserExistsReq          = pb.CheckUserExistsReq
 	CheckUserExistsResponse     = pb.CheckUserExistsResponse
+	ClaimVerifyReviewReq        = pb.ClaimVerifyReviewReq
+	ClaimVerifyReviewResp       = pb.ClaimVerifyReviewResp
 	ConfirmStudentVerifyReq     = pb.ConfirmStudentVerifyReq
 	ConfirmStudentVerifyResp    = pb.ConfirmStudentVerifyResp
+	CreateInterestTagReq        = pb.CreateInterestTagReq
+	CreditAppealItem            = pb.CreditAppealItem
+	CreditAuditItem             = pb.CreditAuditItem
 	CreditLogItem               = pb.CreditLogItem
 	DeleteUserReq               = pb.DeleteUserReq
 	DeleteUserResponse          = pb.DeleteUserResponse
@@ -48,6 +57,8 @@ type (
 	GetCreditLogsResp           = pb.GetCreditLogsResp
 	GetGroupUserReq             = pb.GetGroupUserReq
 	GetGroupUserResponse        = pb.GetGroupUserResponse
+	GetPlatformStatsReq         = pb.GetPlatformStatsReq
+	GetPlatformStatsResp        = pb.GetPlatformStatsResp
 	GetSysImageReq              = pb.GetSysImageReq
 	GetSysImageResp             = pb.GetSysImageResp
 	GetTagsByIdsReq             = pb.GetTagsByIdsReq
@@ -62,29 +73,54 @@ type (
 	GetVerifyCurrentResp        = pb.GetVerifyCurrentResp
 	GetVerifyInfoReq            = pb.GetVerifyInfoReq
 	GetVerifyInfoResp           = pb.GetVerifyInfoResp
+	GetVerifyReviewStatsReq     = pb.GetVerifyReviewStatsReq
+	GetVerifyReviewStatsResp    = pb.GetVerifyReviewStatsResp
 	GroupUserInfo               = pb.GroupUserInfo
 	InitCreditReq               = pb.InitCreditReq
 	InitCreditResp              = pb.InitCreditResp
 	InterestTag                 = pb.InterestTag
+	InterestTagResp             = pb.InterestTagResp
 	IsVerifiedReq               = pb.IsVerifiedReq
 	IsVerifiedResp              = pb.IsVerifiedResp
+	ListCreditAppealsReq        = pb.ListCreditAppealsReq
+	ListCreditAppealsResp       = pb.ListCreditAppealsResp
+	ListCreditAuditsReq         = pb.ListCreditAuditsReq
+	ListCreditAuditsResp        = pb.ListCreditAuditsResp
+	ListVerifyReviewsReq        = pb.ListVerifyReviewsReq
+	ListVerifyReviewsResp       = pb.ListVerifyReviewsResp
 	LoginReq                    = pb.LoginReq
 	LoginResponse               = pb.LoginResponse
 	LoginUserInfo               = pb.LoginUserInfo
 	LogoutReq                   = pb.LogoutReq
 	LogoutResponse              = pb.LogoutResponse
+	MergeInterestTagsReq        = pb.MergeInterestTagsReq
+	MergeInterestTagsResp       = pb.MergeInterestTagsResp
 	ProcessOcrVerifyReq         = pb.ProcessOcrVerifyReq
 	ProcessOcrVerifyResp        = pb.ProcessOcrVerifyResp
+	RecordUserActiveReq         = pb.RecordUserActiveReq
+	RecordUserActiveResp        = pb.RecordUserActiveResp
 	RefreshReq                  = pb.RefreshReq
 	RefreshResponse             = pb.RefreshResponse
 	RegisterReq                 = pb.RegisterReq
 	RegisterResponse            = pb.RegisterResponse
+	ReportDailyStatsReq         = pb.ReportDailyStatsReq
+	ReportDailyStatsResp        = pb.ReportDailyStatsResp
+	ReverifyStudentReq          = pb.ReverifyStudentReq
+	ReviewCreditAppealReq       = pb.ReviewCreditAppealReq
+	ReviewCreditAppealResp      = pb.ReviewCreditAppealResp
+	ReviewStudentVerifyReq      = pb.ReviewStudentVerifyReq
+	ReviewStudentVerifyResp     = pb.ReviewStudentVerifyResp
 	SendQQEmailReq              = pb.SendQQEmailReq
 	SendQQEmailResponse         = pb.SendQQEmailResponse
+	SetInterestTagStatusReq     = pb.SetInterestTagStatusReq
+	StatsMetricItem             = pb.StatsMetricItem
+	SubmitCreditAppealReq       = pb.SubmitCreditAppealReq
+	SubmitCreditAppealResp      = pb.SubmitCreditAppealResp
 	TagBasicInfo                = pb.TagBasicInfo
 	TagInfo                     = pb.TagInfo
 	TagUsageCountReq            = pb.TagUsageCountReq
 	TagUsageCountResp           = pb.TagUsageCountResp
+	UpdateInterestTagReq        = pb.UpdateInterestTagReq
 	UpdatePasswordReq           = pb.UpdatePasswordReq
 	UpdatePasswordResponse      = pb.UpdatePasswordResponse
 	UpdateScoreReq              = pb.UpdateScoreReq
@@ -108,11 +144,15 @@ type (
 	UserHomeActivityItem        = pb.UserHomeActivityItem
 	UserHomeActivityList        = pb.UserHomeActivityList
 	UserHomeInfo                = pb.UserHomeInfo
+	UserHomeOrganizerRating     = pb.UserHomeOrganizerRating
 	UserHomeTag                 = pb.UserHomeTag
 	UserInfo                    = pb.UserInfo
 	UserTag                     = pb.UserTag
 	VerifyModifiedData          = pb.VerifyModifiedData
 	VerifyOcrData               = pb.VerifyOcrData
+	VerifyProfile               = pb.VerifyProfile
+	VerifyReviewItem            = pb.VerifyReviewItem
+	VerifyReviewerStat          = pb.VerifyReviewerStat
 
 	VerifyService interface {
 		// GetVerifyCurrent 获取当前认证进度
@@ -121,16 +161,28 @@ type (
 		GetVerifyInfo(ctx context.Context, in *GetVerifyInfoReq, opts ...grpc.CallOption) (*GetVerifyInfoResp, error)
 		// IsVerified 查询用户是否已完成学生认证
 		IsVerified(ctx context.Context, in *IsVerifiedReq, opts ...grpc.CallOption) (*IsVerifiedResp, error)
+		// BatchGetVerifyProfiles 批量查询已认证用户的院系与入学年份
+		BatchGetVerifyProfiles(ctx context.Context, in *BatchGetVerifyProfilesReq, opts ...grpc.CallOption) (*BatchGetVerifyProfilesResp, error)
 		// ApplyStudentVerify 提交学生认证申请
 		ApplyStudentVerify(ctx context.Context, in *ApplyStudentVerifyReq, opts ...grpc.CallOption) (*ApplyStudentVerifyResp, error)
 		// ConfirmStudentVerify 用户确认/修改认证信息
 		ConfirmStudentVerify(ctx context.Context, in *ConfirmStudentVerifyReq, opts ...grpc.CallOption) (*ConfirmStudentVerifyResp, error)
 		// CancelStudentVerify 取消认证申请
 		CancelStudentVerify(ctx context.Context, in *CancelStudentVerifyReq, opts ...grpc.CallOption) (*CancelStudentVerifyResp, error)
+		// ReverifyStudent 过期后重新认证
+		ReverifyStudent(ctx context.Context, in *ReverifyStudentReq, opts ...grpc.CallOption) (*ApplyStudentVerifyResp, error)
 		// UpdateVerifyStatus 更新认证状态
 		UpdateVerifyStatus(ctx context.Context, in *UpdateVerifyStatusReq, opts ...grpc.CallOption) (*UpdateVerifyStatusResp, error)
 		// ProcessOcrVerify 处理 OCR 识别（供统一 MQ Consumer 调用）
 		ProcessOcrVerify(ctx context.Context, in *ProcessOcrVerifyReq, opts ...grpc.CallOption) (*ProcessOcrVerifyResp, error)
+		// ListVerifyReviews 分页查询人工审核队列
+		ListVerifyReviews(ctx context.Context, in *ListVerifyReviewsReq, opts ...grpc.CallOption) (*ListVerifyReviewsResp, error)
+		// ClaimVerifyReview 领取/释放审核任务
+		ClaimVerifyReview(ctx context.Context, in *ClaimVerifyReviewReq, opts ...grpc.CallOption) (*ClaimVerifyReviewResp, error)
+		// ReviewStudentVerify 人工审核通过/拒绝
+		ReviewStudentVerify(ctx context.Context, in *ReviewStudentVerifyReq, opts ...grpc.CallOption) (*ReviewStudentVerifyResp, error)
+		// GetVerifyReviewStats 审核员处理量统计
+		GetVerifyReviewStats(ctx context.Context, in *GetVerifyReviewStatsReq, opts ...grpc.CallOption) (*GetVerifyReviewStatsResp, error)
 	}
 
 	defaultVerifyService struct {
@@ -162,6 +214,12 @@ func (m *defaultVerifyService) IsVerified(ctx context.Context, in *IsVerifiedReq
 	return client.IsVerified(ctx, in, opts...)
 }
 
+// BatchGetVerifyProfiles 批量查询已认证用户的院系与入学年份
+func (m *defaultVerifyService) BatchGetVerifyProfiles(ctx context.Context, in *BatchGetVerifyProfilesReq, opts ...grpc.CallOption) (*BatchGetVerifyProfilesResp, error) {
+	client := pb.NewVerifyServiceClient(m.cli.Conn())
+	return client.BatchGetVerifyProfiles(ctx, in, opts...)
+}
+
 // ApplyStudentVerify 提交学生认证申请
 func (m *defaultVerifyService) ApplyStudentVerify(ctx context.Context, in *ApplyStudentVerifyReq, opts ...grpc.CallOption) (*ApplyStudentVerifyResp, error) {
 	client := pb.NewVerifyServiceClient(m.cli.Conn())
@@ -180,6 +238,12 @@ func (m *defaultVerifyService) CancelStudentVerify(ctx context.Context, in *Canc
 	return client.CancelStudentVerify(ctx, in, opts...)
 }
 
+// ReverifyStudent 过期后重新认证
+func (m *defaultVerifyService) ReverifyStudent(ctx context.Context, in *ReverifyStudentReq, opts ...grpc.CallOption) (*ApplyStudentVerifyResp, error) {
+	client := pb.NewVerifyServiceClient(m.cli.Conn())
+	return client.ReverifyStudent(ctx, in, opts...)
+}
+
 // UpdateVerifyStatus 更新认证状态
 func (m *defaultVerifyService) UpdateVerifyStatus(ctx context.Context, in *UpdateVerifyStatusReq, opts ...grpc.CallOption) (*UpdateVerifyStatusResp, error) {
 	client := pb.NewVerifyServiceClient(m.cli.Conn())
@@ -191,3 +255,27 @@ func (m *defaultVerifyService) ProcessOcrVerify(ctx context.Context, in *Process
 	client := pb.NewVerifyServiceClient(m.cli.Conn())
 	return client.ProcessOcrVerify(ctx, in, opts...)
 }
+
+// ListVerifyReviews 分页查询人工审核队列
+func (m *defaultVerifyService) ListVerifyReviews(ctx context.Context, in *ListVerifyReviewsReq, opts ...grpc.CallOption) (*ListVerifyReviewsResp, error) {
+	client := pb.NewVerifyServiceClient(m.cli.Conn())
+	return client.ListVerifyReviews(ctx, in, opts...)
+}
+
+// ClaimVerifyReview 领取/释放审核任务
+func (m *defaultVerifyService) ClaimVerifyReview(ctx context.Context, in *ClaimVerifyReviewReq, opts ...grpc.CallOption) (*ClaimVerifyReviewResp, error) {
+	client := pb.NewVerifyServiceClient(m.cli.Conn())
+	return client.ClaimVerifyReview(ctx, in, opts...)
+}
+
+// ReviewStudentVerify 人工审核通过/拒绝
+func (m *defaultVerifyService) ReviewStudentVerify(ctx context.Context, in *ReviewStudentVerifyReq, opts ...grpc.CallOption) (*ReviewStudentVerifyResp, error) {
+	client := pb.NewVerifyServiceClient(m.cli.Conn())
+	return client.ReviewStudentVerify(ctx, in, opts...)
+}
+
+// GetVerifyReviewStats 审核员处理量统计
+func (m *defaultVerifyService) GetVerifyReviewStats(ctx context.Context, in *GetVerifyReviewStatsReq, opts ...grpc.CallOption) (*GetVerifyReviewStatsResp, error) {
+	client := pb.NewVerifyServiceClient(m.cli.Conn())
+	return client.GetVerifyReviewStats(ctx, in, opts...)
+}
@@ -51,6 +51,62 @@ type Config struct {
 
 	// SensitiveData 敏感数据加密配置（必填）
 	SensitiveData SensitiveDataConf
+
+	// CreditRecovery 信用分自动恢复配置（可选，默认关闭）
+	CreditRecovery CreditRecoveryConf `json:",optional"`
+
+	// VerifyExpiry 学生认证过期策略配置（可选，默认关闭）
+	VerifyExpiry VerifyExpiryConf `json:",optional"`
+
+	// PlatformStats 平台统计汇总任务配置（可选，默认开启）
+	PlatformStats PlatformStatsConf `json:",optional"`
+}
+
+// PlatformStatsConf 平台统计汇总任务配置
+// 每轮重算当天及之前 BackfillDays 天的用户域指标，写入统计表
+type PlatformStatsConf struct {
+	// Enabled 是否启用
+	Enabled bool `json:",default=true"`
+	// ScanInterval 汇总间隔（秒）
+	ScanInterval int `json:",default=3600"`
+	// BackfillDays 回溯重算天数（不含当天，日活等原始数据只保留 8 天，最多回溯 7 天）
+	BackfillDays int `json:",default=2"`
+}
+
+// VerifyExpiryConf 学生认证过期策略配置
+// 预计毕业时间 = (入学年份 + ProgramYears) 年 GraduationMonth 月月底，再宽限 GraceDays 天
+type VerifyExpiryConf struct {
+	// Enabled 是否启用
+	Enabled bool `json:",default=false"`
+	// ProgramYears 学制（年）
+	ProgramYears int `json:",default=4"`
+	// GraduationMonth 毕业月份（1-12）
+	GraduationMonth int `json:",default=7"`
+	// GraceDays 毕业后宽限天数
+	GraceDays int `json:",default=0"`
+	// RemindDays 过期前提前提醒天数
+	RemindDays int `json:",default=30"`
+	// ScanInterval 扫描间隔（秒）
+	ScanInterval int `json:",default=3600"`
+	// BatchSize 每批处理记录数
+	BatchSize int `json:",default=200"`
+}
+
+// CreditRecoveryConf 信用分自动恢复配置
+// 用户连续 QuietDays 天无扣分记录时，每个周期恢复 Points 分，直至 ScoreCap
+type CreditRecoveryConf struct {
+	// Enabled 是否启用
+	Enabled bool `json:",default=false"`
+	// QuietDays 无违规天数阈值
+	QuietDays int `json:",default=14"`
+	// Points 每次恢复分数
+	Points int `json:",default=3"`
+	// ScoreCap 恢复上限（不会通过自动恢复超过该分数）
+	ScoreCap int `json:",default=100"`
+	// ScanInterval 扫描间隔（秒）
+	ScanInterval int `json:",default=3600"`
+	// BatchSize 每批处理用户数
+	BatchSize int `json:",default=200"`
 }
 
 // MessagingConf 消息发布器配置
@@ -67,11 +123,43 @@ type RedisStreamConf struct {
 }
 
 // SensitiveDataConf 敏感字段加解密配置
+// 未配置 Keys/HashKeys 时使用单密钥 AesKey/HashKey（密钥ID固定为 legacy）；
+// 配置密钥环后，AesKey/HashKey 作为 legacy 密钥仅用于解密旧数据和兼容旧哈希。
 type SensitiveDataConf struct {
 	// AesKey AES-256-GCM 密钥（base64，解码后 32 字节）
-	AesKey string
+	AesKey string `json:",optional"`
 	// HashKey 学号哈希密钥（base64，解码后 32 字节）
-	HashKey string
+	HashKey string `json:",optional"`
+
+	// ActiveKeyId 当前加密密钥ID（为空时使用 legacy）
+	ActiveKeyId string `json:",optional"`
+	// Keys 加密密钥环（非当前密钥仅用于解密）
+	Keys []SensitiveKeyConf `json:",optional"`
+	// ActiveHashKeyId 当前学号哈希密钥ID（为空时使用 legacy）
+	ActiveHashKeyId string `json:",optional"`
+	// HashKeys 学号哈希密钥环（非当前密钥仅用于唯一性检查）
+	HashKeys []SensitiveKeyConf `json:",optional"`
+
+	// Rekey 存量数据重新加密任务（可选，默认关闭）
+	Rekey SensitiveRekeyConf `json:",optional"`
+}
+
+// SensitiveKeyConf 带ID的密钥配置
+type SensitiveKeyConf struct {
+	// Id 密钥ID（字母数字与短横线，最长 16 位，写入密文前缀）
+	Id string
+	// Key 密钥（base64，解码后 32 字节）
+	Key string
+}
+
+// SensitiveRekeyConf 存量数据重新加密任务配置
+type SensitiveRekeyConf struct {
+	// Enabled 是否启用
+	Enabled bool `json:",default=false"`
+	// Interval 批次间隔（毫秒），用于控制对数据库的压力
+	Interval int `json:",default=1000"`
+	// BatchSize 每批处理记录数
+	BatchSize int `json:",default=100"`
 }
 
 // QiniuConf 七牛云配置
@@ -123,10 +211,70 @@ type SMSConf struct {
 
 // OcrConf OCR 识别服务配置
 type OcrConf struct {
+	// Providers 提供商路由（按名称引用 tencent/aliyun/local，权重 0 表示仅作备用）
+	// 未配置时沿用默认路由：腾讯云为主、阿里云为备、本地仅在启用时加入
+	Providers []OcrProviderConf `json:",optional"`
 	// Tencent 腾讯云 OCR 配置
 	Tencent TencentOcrConf `json:",optional"`
 	// Aliyun 阿里云 OCR 配置
 	Aliyun AliyunOcrConf `json:",optional"`
+	// Local 本地离线 OCR 配置（开发/CI 使用）
+	Local LocalOcrConf `json:",optional"`
+	// Policy 识别结果判定策略（可选，默认关闭：识别成功一律进入用户确认）
+	Policy OcrPolicyConf `json:",optional"`
+}
+
+// OcrPolicyConf 识别结果判定策略配置
+// 总分 >= AutoApproveScore 自动通过；< ConfirmScore 转人工审核；< RejectScore 自动拒绝；其余进入用户确认
+type OcrPolicyConf struct {
+	// Enabled 是否启用
+	Enabled bool `json:",default=false"`
+	// AutoApproveScore 自动通过分数线
+	AutoApproveScore float64 `json:",default=90"`
+	// ConfirmScore 用户确认分数线
+	ConfirmScore float64 `json:",default=70"`
+	// RejectScore 自动拒绝分数线
+	RejectScore float64 `json:",default=30"`
+	// MaxStudyYears 合理在读年限（年）
+	MaxStudyYears int `json:",default=8"`
+	// Schools 已知学校字典（可按学校覆盖阈值）
+	Schools []OcrSchoolPolicyConf `json:",optional"`
+}
+
+// OcrSchoolPolicyConf 学校判定配置
+type OcrSchoolPolicyConf struct {
+	// Name 学校标准名称
+	Name string
+	// Aliases 别名（简称、英文名等）
+	Aliases []string `json:",optional"`
+	// StudentIdPattern 学号正则（为空按通用格式校验）
+	StudentIdPattern string `json:",optional"`
+	// AutoApproveScore 自动通过分数线（0 使用全局）
+	AutoApproveScore float64 `json:",optional"`
+	// ConfirmScore 用户确认分数线（0 使用全局）
+	ConfirmScore float64 `json:",optional"`
+	// RejectScore 自动拒绝分数线（0 使用全局）
+	RejectScore float64 `json:",optional"`
+	// DisableAutoApprove 禁止自动通过
+	DisableAutoApprove bool `json:",optional"`
+}
+
+// OcrProviderConf OCR 提供商路由配置
+type OcrProviderConf struct {
+	// Name 提供商名称
+	Name string
+	// Weight 权重（>0 分配首选流量，0 仅作备用）
+	Weight int `json:",default=100"`
+}
+
+// LocalOcrConf 本地离线 OCR 配置
+type LocalOcrConf struct {
+	// Enabled 是否启用
+	Enabled bool `json:",default=false"`
+	// FixtureDir 样例文件目录
+	FixtureDir string `json:",optional"`
+	// AllowTemplate 未命中样例时使用内置模板返回识别成功（仅开发环境，生产切勿开启）
+	AllowTemplate bool `json:",default=false"`
 }
 
 // TencentOcrConf 腾讯云 OCR 配置
@@ -0,0 +1,166 @@
+/**
+ * @projectName: CampusHub
+ * @package: cron
+ * @className: CreditRecovery
+ * @author: lijunqi
+ * @description: 信用分自动修复任务，定期为连续无违规的用户恢复少量分数
+ * @date: 2026-10-18
+ * @version: 1.0
+ *
+ * ==================== 业务说明 ====================
+ *
+ * 除签到 +2 外信用分只降不升，低于 60 分的用户会长期处于黑名单。
+ * 本任务为这部分用户提供修复通道：
+ *   - 连续 QuietDays 天没有扣分记录，也没有修复记录
+ *   - 当前分数低于 ScoreCap
+ *   - 满足条件时恢复 Points 分（不超过 ScoreCap），即每 QuietDays 天最多修复一次
+ *
+ * 幂等保证:
+ *   - 来源ID为 recovery:{userId}:{unixDay}，多实例同一天重复扫描只会生效一次
+ */
+
+package cron
+
+import (
+	"context"
+	"time"
+
+	"activity-platform/app/user/rpc/internal/config"
+	creditservicelogic "activity-platform/app/user/rpc/internal/logic/creditservice"
+	"activity-platform/app/user/rpc/internal/svc"
+	"activity-platform/app/user/rpc/pb/pb"
+	"activity-platform/common/constants"
+	"activity-platform/common/errorx"
+	"activity-platform/common/utils/idgen"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// CreditRecovery 信用分自动修复任务
+type CreditRecovery struct {
+	svcCtx *svc.ServiceContext
+	conf   config.CreditRecoveryConf
+	stopCh chan struct{} // 停止信号
+}
+
+// NewCreditRecovery 创建信用分自动修复任务
+func NewCreditRecovery(svcCtx *svc.ServiceContext, conf config.CreditRecoveryConf) *CreditRecovery {
+	if conf.QuietDays <= 0 {
+		conf.QuietDays = constants.CreditRecoveryQuietDays
+	}
+	if conf.Points <= 0 {
+		conf.Points = constants.CreditRecoveryPoints
+	}
+	if conf.ScoreCap <= 0 || conf.ScoreCap > constants.CreditScoreMax {
+		conf.ScoreCap = constants.CreditRecoveryScoreCap
+	}
+	if conf.ScanInterval <= 0 {
+		conf.ScanInterval = 3600
+	}
+	if conf.BatchSize <= 0 {
+		conf.BatchSize = 200
+	}
+	return &CreditRecovery{
+		svcCtx: svcCtx,
+		conf:   conf,
+		stopCh: make(chan struct{}),
+	}
+}
+
+// Start 启动修复任务（非阻塞，在后台 goroutine 运行）
+func (r *CreditRecovery) Start() {
+	go r.run()
+	logx.Infof("[CreditRecovery] 启动成功，扫描间隔: %ds，无违规天数: %d，每次恢复: %d，上限: %d",
+		r.conf.ScanInterval, r.conf.QuietDays, r.conf.Points, r.conf.ScoreCap)
+}
+
+// Stop 停止修复任务
+func (r *CreditRecovery) Stop() {
+	close(r.stopCh)
+	logx.Info("[CreditRecovery] 已停止")
+}
+
+// run 扫描主循环
+func (r *CreditRecovery) run() {
+	ticker := time.NewTicker(time.Duration(r.conf.ScanInterval) * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.stopCh:
+			return
+		case <-ticker.C:
+			r.scan()
+		}
+	}
+}
+
+// scan 执行一次修复扫描（按 user_id 游标分批处理）
+func (r *CreditRecovery) scan() {
+	ctx := context.Background()
+	logger := logx.WithContext(ctx)
+
+	now := time.Now()
+	quietSince := now.AddDate(0, 0, -r.conf.QuietDays)
+	var lastUserID int64
+	recovered := 0
+
+	for {
+		select {
+		case <-r.stopCh:
+			return
+		default:
+		}
+
+		candidates, err := r.svcCtx.UserCreditModel.FindRecoveryCandidates(
+			ctx, r.conf.ScoreCap, quietSince, lastUserID, r.conf.BatchSize)
+		if err != nil {
+			logger.Errorf("[CreditRecovery] 查询候选用户失败: afterUserId=%d, err=%v", lastUserID, err)
+			return
+		}
+
+		for _, credit := range candidates {
+			lastUserID = credit.UserID
+			if r.recover(ctx, credit.UserID, credit.Score, now) {
+				recovered++
+			}
+		}
+
+		if len(candidates) < r.conf.BatchSize {
+			break
+		}
+	}
+
+	if recovered > 0 {
+		logger.Infof("[CreditRecovery] 本轮修复完成: 用户数=%d", recovered)
+	}
+}
+
+// recover 为单个用户恢复分数，返回是否实际执行
+func (r *CreditRecovery) recover(ctx context.Context, userID int64, score int, now time.Time) bool {
+	logger := logx.WithContext(ctx)
+
+	points := r.conf.Points
+	if score+points > r.conf.ScoreCap {
+		points = r.conf.ScoreCap - score
+	}
+	if points <= 0 {
+		return false
+	}
+
+	_, err := creditservicelogic.NewUpdateScoreLogic(ctx, r.svcCtx).UpdateScore(&pb.UpdateScoreReq{
+		UserId:     userID,
+		ChangeType: constants.CreditChangeTypeRecovery,
+		SourceId:   idgen.GenRecoverySourceID(userID, now),
+		Reason:     "连续无违规，信用修复",
+		AdminDelta: int64(points),
+	})
+	if err != nil {
+		if errorx.Is(err, errorx.CodeCreditSourceDup) {
+			return false
+		}
+		logger.Errorf("[CreditRecovery] 修复失败: userId=%d, err=%v", userID, err)
+		return false
+	}
+	return true
+}
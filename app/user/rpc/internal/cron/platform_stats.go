@@ -0,0 +1,250 @@
+/**
+ * @projectName: CampusHub
+ * @package: cron
+ * @className: PlatformStats
+ * @author: lijunqi
+ * @description: 平台统计汇总任务，按天汇总用户域指标到统计表
+ * @date: 2026-10-19
+ * @version: 1.0
+ *
+ * ==================== 业务说明 ====================
+ *
+ * 管理后台运营报表的用户域指标由本任务汇总（活动、聊天指标由对应服务上报）：
+ *   - dau：日活（Redis HyperLogLog，登录与 WebSocket 上线时写入），维度为空/login/ws
+ *   - new_users：当天注册用户数
+ *   - verify_passed / verify_rejected / verify_pass_rate：学生认证通过、拒绝数与通过率
+ *   - ocr_calls：各 OCR 提供商调用次数（维度 {provider}:success / {provider}:failure）
+ *   - credit_level：信用等级分布，只能取当前快照，仅写入当天
+ *
+ * 每轮重算当天及之前 BackfillDays 天，当天数据随任务周期刷新，
+ * 跨天时前一天在下一轮补齐最终值。
+ *
+ * 幂等保证:
+ *   - 按 日期 + 指标 整体替换，多实例重复执行结果一致
+ *   - 日活、OCR 原始数据不存在（已过期或当天无数据）时跳过，不会用 0 覆盖已汇总的数据
+ */
+
+package cron
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"activity-platform/app/user/model"
+	"activity-platform/app/user/rpc/internal/config"
+	platformstatsservicelogic "activity-platform/app/user/rpc/internal/logic/platformstatsservice"
+	"activity-platform/app/user/rpc/internal/svc"
+	"activity-platform/common/constants"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// maxStatsBackfillDays 最大回溯天数（受日活、OCR 原始数据保留时间限制）
+const maxStatsBackfillDays = 7
+
+// PlatformStats 平台统计汇总任务
+type PlatformStats struct {
+	svcCtx *svc.ServiceContext
+	conf   config.PlatformStatsConf
+	stopCh chan struct{} // 停止信号
+}
+
+// NewPlatformStats 创建平台统计汇总任务
+func NewPlatformStats(svcCtx *svc.ServiceContext, conf config.PlatformStatsConf) *PlatformStats {
+	if conf.ScanInterval <= 0 {
+		conf.ScanInterval = 3600
+	}
+	if conf.BackfillDays < 0 {
+		conf.BackfillDays = 0
+	}
+	if conf.BackfillDays > maxStatsBackfillDays {
+		conf.BackfillDays = maxStatsBackfillDays
+	}
+	return &PlatformStats{
+		svcCtx: svcCtx,
+		conf:   conf,
+		stopCh: make(chan struct{}),
+	}
+}
+
+// Start 启动汇总任务（非阻塞，在后台 goroutine 运行）
+func (p *PlatformStats) Start() {
+	go p.run()
+	logx.Infof("[PlatformStats] 启动成功，汇总间隔: %ds，回溯天数: %d", p.conf.ScanInterval, p.conf.BackfillDays)
+}
+
+// Stop 停止汇总任务
+func (p *PlatformStats) Stop() {
+	close(p.stopCh)
+	logx.Info("[PlatformStats] 已停止")
+}
+
+// run 汇总主循环（启动后立即执行一次）
+func (p *PlatformStats) run() {
+	ticker := time.NewTicker(time.Duration(p.conf.ScanInterval) * time.Second)
+	defer ticker.Stop()
+
+	p.aggregate()
+	for {
+		select {
+		case <-p.stopCh:
+			return
+		case <-ticker.C:
+			p.aggregate()
+		}
+	}
+}
+
+// aggregate 执行一轮汇总（从最早的回溯日期到当天）
+func (p *PlatformStats) aggregate() {
+	ctx := context.Background()
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	for i := p.conf.BackfillDays; i >= 0; i-- {
+		p.aggregateDay(ctx, today.AddDate(0, 0, -i))
+	}
+	p.snapshotCreditLevels(ctx, today.Format(constants.StatsDateLayout))
+}
+
+// aggregateDay 汇总某一天的用户域指标（各指标独立，单个失败不影响其他指标）
+func (p *PlatformStats) aggregateDay(ctx context.Context, day time.Time) {
+	date := day.Format(constants.StatsDateLayout)
+	until := day.AddDate(0, 0, 1)
+
+	p.aggregateDAU(ctx, date)
+	p.aggregateNewUsers(ctx, date, day, until)
+	p.aggregateVerify(ctx, date, day, until)
+	p.aggregateOcrCalls(ctx, date)
+}
+
+// aggregateDAU 汇总日活（去重总数及各来源）
+func (p *PlatformStats) aggregateDAU(ctx context.Context, date string) {
+	logger := logx.WithContext(ctx)
+	rows := make([]*model.StatsDailyMetric, 0, 3)
+	for _, source := range []string{"", constants.StatsActiveSourceLogin, constants.StatsActiveSourceWS} {
+		key := platformstatsservicelogic.DAUKey(date, source)
+		exists, err := p.svcCtx.Redis.Exists(ctx, key).Result()
+		if err != nil {
+			logger.Errorf("[PlatformStats] 查询日活失败: key=%s, err=%v", key, err)
+			return
+		}
+		if exists == 0 {
+			if source == "" {
+				// 当天无活跃记录或原始数据已过期，保留已汇总的数据
+				return
+			}
+			continue
+		}
+		count, err := p.svcCtx.Redis.PFCount(ctx, key).Result()
+		if err != nil {
+			logger.Errorf("[PlatformStats] 统计日活失败: key=%s, err=%v", key, err)
+			return
+		}
+		rows = append(rows, userMetric(date, constants.StatsMetricDAU, source, float64(count)))
+	}
+	p.replace(ctx, date, []string{constants.StatsMetricDAU}, rows)
+}
+
+// aggregateNewUsers 汇总新注册用户数
+func (p *PlatformStats) aggregateNewUsers(ctx context.Context, date string, since, until time.Time) {
+	count, err := p.svcCtx.StatsDailyMetricModel.CountNewUsers(ctx, since, until)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("[PlatformStats] 统计新注册用户失败: date=%s, err=%v", date, err)
+		return
+	}
+	p.replace(ctx, date, []string{constants.StatsMetricNewUsers},
+		[]*model.StatsDailyMetric{userMetric(date, constants.StatsMetricNewUsers, "", float64(count))})
+}
+
+// aggregateVerify 汇总学生认证通过、拒绝数与通过率（当天无审核结论时不写通过率）
+func (p *PlatformStats) aggregateVerify(ctx context.Context, date string, since, until time.Time) {
+	logger := logx.WithContext(ctx)
+	passed, err := p.svcCtx.StatsDailyMetricModel.CountVerifyPassed(ctx, since, until)
+	if err != nil {
+		logger.Errorf("[PlatformStats] 统计认证通过数失败: date=%s, err=%v", date, err)
+		return
+	}
+	rejected, err := p.svcCtx.StatsDailyMetricModel.CountVerifyRejected(ctx, since, until)
+	if err != nil {
+		logger.Errorf("[PlatformStats] 统计认证拒绝数失败: date=%s, err=%v", date, err)
+		return
+	}
+
+	rows := []*model.StatsDailyMetric{
+		userMetric(date, constants.StatsMetricVerifyPassed, "", float64(passed)),
+		userMetric(date, constants.StatsMetricVerifyRejected, "", float64(rejected)),
+	}
+	if total := passed + rejected; total > 0 {
+		rate := math.Round(float64(passed)*10000/float64(total)) / 100
+		rows = append(rows, userMetric(date, constants.StatsMetricVerifyPassRate, "", rate))
+	}
+	p.replace(ctx, date, []string{
+		constants.StatsMetricVerifyPassed,
+		constants.StatsMetricVerifyRejected,
+		constants.StatsMetricVerifyPassRate,
+	}, rows)
+}
+
+// aggregateOcrCalls 汇总各 OCR 提供商调用次数（维度为空的行为当天总调用次数）
+func (p *PlatformStats) aggregateOcrCalls(ctx context.Context, date string) {
+	key := constants.StatsOcrCallsPrefix + date
+	fields, err := p.svcCtx.Redis.HGetAll(ctx, key).Result()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("[PlatformStats] 查询OCR调用次数失败: key=%s, err=%v", key, err)
+		return
+	}
+	if len(fields) == 0 {
+		return
+	}
+
+	rows := make([]*model.StatsDailyMetric, 0, len(fields)+1)
+	var total int64
+	for field, raw := range fields {
+		count, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil || !strings.Contains(field, ":") {
+			continue
+		}
+		total += count
+		rows = append(rows, userMetric(date, constants.StatsMetricOcrCalls, field, float64(count)))
+	}
+	rows = append(rows, userMetric(date, constants.StatsMetricOcrCalls, "", float64(total)))
+	p.replace(ctx, date, []string{constants.StatsMetricOcrCalls}, rows)
+}
+
+// snapshotCreditLevels 记录当前信用等级分布（维度为等级 0-4）
+func (p *PlatformStats) snapshotCreditLevels(ctx context.Context, date string) {
+	levels, err := p.svcCtx.StatsDailyMetricModel.CountCreditLevels(ctx)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("[PlatformStats] 统计信用等级分布失败: err=%v", err)
+		return
+	}
+
+	rows := make([]*model.StatsDailyMetric, 0, len(levels))
+	for level, count := range levels {
+		rows = append(rows, userMetric(date, constants.StatsMetricCreditLevel,
+			strconv.Itoa(int(level)), float64(count)))
+	}
+	p.replace(ctx, date, []string{constants.StatsMetricCreditLevel}, rows)
+}
+
+// replace 写入统计表（整体替换当天指定指标）
+func (p *PlatformStats) replace(ctx context.Context, date string, metrics []string, rows []*model.StatsDailyMetric) {
+	if err := p.svcCtx.StatsDailyMetricModel.ReplaceDay(ctx, date, metrics, rows); err != nil {
+		logx.WithContext(ctx).Errorf("[PlatformStats] 写入统计表失败: date=%s, metrics=%v, err=%v", date, metrics, err)
+	}
+}
+
+// userMetric 构建用户域指标行
+func userMetric(date, metric, dimension string, value float64) *model.StatsDailyMetric {
+	return &model.StatsDailyMetric{
+		StatDate:  date,
+		Metric:    metric,
+		Dimension: dimension,
+		Value:     value,
+		Source:    constants.StatsSourceUser,
+	}
+}
@@ -0,0 +1,156 @@
+/**
+ * @projectName: CampusHub
+ * @package: cron
+ * @className: SensitiveRekey
+ * @author: lijunqi
+ * @description: 敏感数据重新加密任务，密钥轮换后将存量记录迁移到当前密钥
+ * @date: 2026-10-18
+ * @version: 1.0
+ *
+ * ==================== 业务说明 ====================
+ *
+ * 密钥轮换流程：
+ *   1. 在 SensitiveData.Keys / HashKeys 中加入新密钥，并将 ActiveKeyId / ActiveHashKeyId 指向新密钥
+ *      （旧密钥保留在密钥环中，仅用于解密与兼容旧哈希）
+ *   2. 开启本任务，按ID游标分批将 real_name / student_id 重新加密、student_id_hash 重新计算
+ *   3. 日志输出"迁移完成"后，即可从密钥环中移除旧密钥
+ *
+ * 可恢复：
+ *   - 游标保存在 Redis（按当前密钥ID区分），服务重启后从上次位置继续
+ *   - 再次切换当前密钥时游标Key随之变化，自动从头开始
+ *
+ * 并发安全：
+ *   - Rekey 以读取时的密文作为更新条件，业务并发修改的记录会被跳过（新写入已使用当前密钥）
+ *   - 哈希轮换期间 ExistsBySchoolAndStudentID 同时匹配新旧哈希，唯一性检查不受影响
+ */
+
+package cron
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"activity-platform/app/user/rpc/internal/config"
+	"activity-platform/app/user/rpc/internal/svc"
+	"activity-platform/common/constants"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// rekeyIdleInterval 一轮迁移完成后的复查间隔（兼容滚动发布期间旧实例仍以旧密钥写入）
+const rekeyIdleInterval = 10 * time.Minute
+
+// SensitiveRekey 敏感数据重新加密任务
+type SensitiveRekey struct {
+	svcCtx *svc.ServiceContext
+	conf   config.SensitiveRekeyConf
+	stopCh chan struct{} // 停止信号
+}
+
+// NewSensitiveRekey 创建敏感数据重新加密任务
+func NewSensitiveRekey(svcCtx *svc.ServiceContext, conf config.SensitiveRekeyConf) *SensitiveRekey {
+	if conf.Interval <= 0 {
+		conf.Interval = 1000
+	}
+	if conf.BatchSize <= 0 {
+		conf.BatchSize = 100
+	}
+	return &SensitiveRekey{
+		svcCtx: svcCtx,
+		conf:   conf,
+		stopCh: make(chan struct{}),
+	}
+}
+
+// Start 启动重新加密任务（非阻塞，在后台 goroutine 运行）
+func (r *SensitiveRekey) Start() {
+	go r.run()
+	logx.Infof("[SensitiveRekey] 启动成功，当前加密密钥: %s，当前哈希密钥: %s，批次间隔: %dms，每批: %d",
+		r.svcCtx.SensitiveCodec.ActiveKeyID(), r.svcCtx.SensitiveCodec.HashKeyID(), r.conf.Interval, r.conf.BatchSize)
+}
+
+// Stop 停止重新加密任务
+func (r *SensitiveRekey) Stop() {
+	close(r.stopCh)
+	logx.Info("[SensitiveRekey] 已停止")
+}
+
+// run 主循环：逐批迁移，一轮完成后进入低频复查
+func (r *SensitiveRekey) run() {
+	interval := time.Duration(r.conf.Interval) * time.Millisecond
+	for {
+		wait := interval
+		if finished := r.processBatch(context.Background()); finished {
+			wait = rekeyIdleInterval
+		}
+		select {
+		case <-r.stopCh:
+			return
+		case <-time.After(wait):
+		}
+	}
+}
+
+// cursorKey 游标Key（按当前密钥ID区分，切换密钥后自动从头开始）
+func (r *SensitiveRekey) cursorKey() string {
+	return fmt.Sprintf("%s%s:%s", constants.VerifyRekeyCursorPrefix,
+		r.svcCtx.SensitiveCodec.ActiveKeyID(), r.svcCtx.SensitiveCodec.HashKeyID())
+}
+
+// processBatch 处理一批记录，返回本轮扫描是否已结束
+func (r *SensitiveRekey) processBatch(ctx context.Context) bool {
+	logger := logx.WithContext(ctx)
+	key := r.cursorKey()
+
+	lastID, err := r.svcCtx.Redis.Get(ctx, key).Int64()
+	if err != nil && err != redis.Nil {
+		logger.Errorf("[SensitiveRekey] 读取游标失败: key=%s, err=%v", key, err)
+		return false
+	}
+
+	list, err := r.svcCtx.StudentVerificationModel.FindNeedRekey(ctx, lastID, r.conf.BatchSize)
+	if err != nil {
+		logger.Errorf("[SensitiveRekey] 查询待迁移记录失败: afterId=%d, err=%v", lastID, err)
+		return false
+	}
+
+	if len(list) == 0 {
+		if lastID > 0 {
+			// 一轮扫描结束，游标归零后稍后复查（失败或滚动发布期间写入的记录会在复查中再次处理）
+			logger.Infof("[SensitiveRekey] 本轮扫描结束，游标归零待复查: lastId=%d", lastID)
+			if err := r.svcCtx.Redis.Del(ctx, key).Err(); err != nil {
+				logger.Errorf("[SensitiveRekey] 重置游标失败: key=%s, err=%v", key, err)
+			}
+			return true
+		}
+		logger.Infof("[SensitiveRekey] 迁移完成，所有记录均已使用当前密钥")
+		return true
+	}
+
+	migrated, skipped, failed := 0, 0, 0
+	for _, v := range list {
+		lastID = v.ID
+		ok, err := r.svcCtx.StudentVerificationModel.Rekey(ctx, v)
+		if err != nil {
+			// 单条失败（如无法解密、哈希冲突）不阻塞整体迁移，记录日志后继续
+			logger.Errorf("[SensitiveRekey] 重新加密失败: verifyId=%d, err=%v", v.ID, err)
+			failed++
+			continue
+		}
+		if !ok {
+			skipped++
+			continue
+		}
+		migrated++
+	}
+
+	if err := r.svcCtx.Redis.Set(ctx, key, strconv.FormatInt(lastID, 10), 0).Err(); err != nil {
+		logger.Errorf("[SensitiveRekey] 保存游标失败: key=%s, lastId=%d, err=%v", key, lastID, err)
+	}
+	logger.Infof("[SensitiveRekey] 批次完成: lastId=%d, 迁移=%d, 并发跳过=%d, 失败=%d",
+		lastID, migrated, skipped, failed)
+	return false
+}
@@ -0,0 +1,226 @@
+/**
+ * @projectName: CampusHub
+ * @package: cron
+ * @className: VerifyExpiry
+ * @author: lijunqi
+ * @description: 学生认证过期任务，按预计毕业时间提醒并将认证标记为已过期
+ * @date: 2026-10-18
+ * @version: 1.0
+ *
+ * ==================== 业务说明 ====================
+ *
+ * 认证通过（4）原本是永久状态，毕业生会一直保留"仅限学生"权限。
+ * 本任务按入学年份 + 学制推算预计毕业时间作为认证过期时间：
+ *   1. 回填：为未设置过期时间的已通过记录计算 expire_at（兼容存量数据）
+ *   2. 过期：expire_at 已到的记录更新为已过期（9），IsVerified 视为未认证
+ *   3. 提醒：expire_at 在 RemindDays 天内的记录发送一次过期提醒
+ *
+ * 过期后用户可通过 ReverifyStudent 仅上传新学生证图片重新走 OCR 认证。
+ *
+ * 幂等保证:
+ *   - 过期走 UpdateVerifyStatus 状态机（4 -> 9），重复执行会被状态校验拦截
+ *   - 提醒以 expiry_reminded_at 标记，发布成功后才标记，失败下一轮重试
+ */
+
+package cron
+
+import (
+	"context"
+	"time"
+
+	"activity-platform/app/user/rpc/internal/config"
+	verifyservicelogic "activity-platform/app/user/rpc/internal/logic/verifyservice"
+	"activity-platform/app/user/rpc/internal/svc"
+	"activity-platform/app/user/rpc/pb/pb"
+	"activity-platform/common/constants"
+	"activity-platform/common/errorx"
+	"activity-platform/common/messaging"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// VerifyExpiry 学生认证过期任务
+type VerifyExpiry struct {
+	svcCtx *svc.ServiceContext
+	conf   config.VerifyExpiryConf
+	stopCh chan struct{} // 停止信号
+}
+
+// NewVerifyExpiry 创建学生认证过期任务
+func NewVerifyExpiry(svcCtx *svc.ServiceContext, conf config.VerifyExpiryConf) *VerifyExpiry {
+	if conf.RemindDays < 0 {
+		conf.RemindDays = constants.VerifyExpiryRemindDays
+	}
+	if conf.ScanInterval <= 0 {
+		conf.ScanInterval = 3600
+	}
+	if conf.BatchSize <= 0 {
+		conf.BatchSize = 200
+	}
+	return &VerifyExpiry{
+		svcCtx: svcCtx,
+		conf:   conf,
+		stopCh: make(chan struct{}),
+	}
+}
+
+// Start 启动过期任务（非阻塞，在后台 goroutine 运行）
+func (e *VerifyExpiry) Start() {
+	go e.run()
+	logx.Infof("[VerifyExpiry] 启动成功，扫描间隔: %ds，学制: %d 年，毕业月份: %d，宽限: %d 天，提前提醒: %d 天",
+		e.conf.ScanInterval, e.conf.ProgramYears, e.conf.GraduationMonth, e.conf.GraceDays, e.conf.RemindDays)
+}
+
+// Stop 停止过期任务
+func (e *VerifyExpiry) Stop() {
+	close(e.stopCh)
+	logx.Info("[VerifyExpiry] 已停止")
+}
+
+// run 扫描主循环（启动后立即执行一次，便于回填存量数据）
+func (e *VerifyExpiry) run() {
+	ticker := time.NewTicker(time.Duration(e.conf.ScanInterval) * time.Second)
+	defer ticker.Stop()
+
+	e.scan()
+	for {
+		select {
+		case <-e.stopCh:
+			return
+		case <-ticker.C:
+			e.scan()
+		}
+	}
+}
+
+// scan 执行一次完整扫描
+func (e *VerifyExpiry) scan() {
+	ctx := context.Background()
+	now := time.Now()
+
+	e.backfill(ctx)
+	e.expire(ctx, now)
+	e.remind(ctx, now)
+}
+
+// stopped 是否已收到停止信号
+func (e *VerifyExpiry) stopped() bool {
+	select {
+	case <-e.stopCh:
+		return true
+	default:
+		return false
+	}
+}
+
+// backfill 为未设置过期时间的已通过记录回填 expire_at
+func (e *VerifyExpiry) backfill(ctx context.Context) {
+	logger := logx.WithContext(ctx)
+	var lastID int64
+	filled := 0
+
+	for !e.stopped() {
+		list, err := e.svcCtx.StudentVerificationModel.FindPassedWithoutExpiry(ctx, lastID, e.conf.BatchSize)
+		if err != nil {
+			logger.Errorf("[VerifyExpiry] 查询待回填记录失败: afterId=%d, err=%v", lastID, err)
+			return
+		}
+		for _, v := range list {
+			lastID = v.ID
+			expireAt := verifyservicelogic.CalcVerifyExpireAt(e.conf, v.AdmissionYear)
+			if expireAt == nil {
+				// 入学年份无法解析，保持不过期
+				continue
+			}
+			if err := e.svcCtx.StudentVerificationModel.UpdateExpireAt(ctx, v.ID, expireAt); err != nil {
+				logger.Errorf("[VerifyExpiry] 回填过期时间失败: verifyId=%d, err=%v", v.ID, err)
+				continue
+			}
+			filled++
+		}
+		if len(list) < e.conf.BatchSize {
+			break
+		}
+	}
+
+	if filled > 0 {
+		logger.Infof("[VerifyExpiry] 回填过期时间完成: 记录数=%d", filled)
+	}
+}
+
+// expire 将已到期的记录更新为已过期
+func (e *VerifyExpiry) expire(ctx context.Context, now time.Time) {
+	logger := logx.WithContext(ctx)
+	var lastID int64
+	expired := 0
+
+	for !e.stopped() {
+		list, err := e.svcCtx.StudentVerificationModel.FindExpired(ctx, now, lastID, e.conf.BatchSize)
+		if err != nil {
+			logger.Errorf("[VerifyExpiry] 查询已到期记录失败: afterId=%d, err=%v", lastID, err)
+			return
+		}
+		for _, v := range list {
+			lastID = v.ID
+			_, err := verifyservicelogic.NewUpdateVerifyStatusLogic(ctx, e.svcCtx).UpdateVerifyStatus(&pb.UpdateVerifyStatusReq{
+				VerifyId:  v.ID,
+				NewStatus: int32(constants.VerifyStatusExpired),
+				Operator:  constants.VerifyOperatorExpiryJob,
+			})
+			if err != nil {
+				if !errorx.Is(err, errorx.CodeVerifyInvalidTransit) {
+					logger.Errorf("[VerifyExpiry] 标记过期失败: verifyId=%d, err=%v", v.ID, err)
+				}
+				continue
+			}
+			verifyservicelogic.PublishVerifyExpiryEvent(ctx, e.svcCtx, v.UserID, v.ID,
+				messaging.VerifyExpiryExpired, *v.ExpireAt)
+			expired++
+		}
+		if len(list) < e.conf.BatchSize {
+			break
+		}
+	}
+
+	if expired > 0 {
+		logger.Infof("[VerifyExpiry] 本轮过期处理完成: 记录数=%d", expired)
+	}
+}
+
+// remind 为即将过期且未提醒的记录发送提醒
+func (e *VerifyExpiry) remind(ctx context.Context, now time.Time) {
+	if e.conf.RemindDays == 0 {
+		return
+	}
+	logger := logx.WithContext(ctx)
+	deadline := now.AddDate(0, 0, e.conf.RemindDays)
+	var lastID int64
+	reminded := 0
+
+	for !e.stopped() {
+		list, err := e.svcCtx.StudentVerificationModel.FindExpiring(ctx, deadline, lastID, e.conf.BatchSize)
+		if err != nil {
+			logger.Errorf("[VerifyExpiry] 查询即将过期记录失败: afterId=%d, err=%v", lastID, err)
+			return
+		}
+		for _, v := range list {
+			lastID = v.ID
+			if !verifyservicelogic.PublishVerifyExpiryEvent(ctx, e.svcCtx, v.UserID, v.ID,
+				messaging.VerifyExpiryRemind, *v.ExpireAt) {
+				continue
+			}
+			if err := e.svcCtx.StudentVerificationModel.MarkExpiryReminded(ctx, v.ID, now); err != nil {
+				logger.Errorf("[VerifyExpiry] 标记已提醒失败: verifyId=%d, err=%v", v.ID, err)
+				continue
+			}
+			reminded++
+		}
+		if len(list) < e.conf.BatchSize {
+			break
+		}
+	}
+
+	if reminded > 0 {
+		logger.Infof("[VerifyExpiry] 本轮过期提醒完成: 记录数=%d", reminded)
+	}
+}
@@ -0,0 +1,106 @@
+/**
+ * @projectName: CampusHub
+ * @package: creditservicelogic
+ * @className: AdminAdjustScoreLogic
+ * @author: lijunqi
+ * @description: 管理员手动调整信用分逻辑层
+ * @date: 2026-10-18
+ * @version: 1.0
+ */
+
+package creditservicelogic
+
+import (
+	"context"
+	"strings"
+
+	"activity-platform/app/user/model"
+	"activity-platform/app/user/rpc/internal/svc"
+	"activity-platform/app/user/rpc/pb/pb"
+	"activity-platform/common/constants"
+	"activity-platform/common/errorx"
+	"activity-platform/common/utils/idgen"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// AdminAdjustScoreLogic 管理员手动调整信用分逻辑处理器
+type AdminAdjustScoreLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+// NewAdminAdjustScoreLogic 创建管理员手动调整信用分逻辑实例
+func NewAdminAdjustScoreLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AdminAdjustScoreLogic {
+	return &AdminAdjustScoreLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// AdminAdjustScore 管理员手动调整信用分
+// 业务逻辑:
+//   - 以 change_type=99 调用 UpdateScore，分值限制在 ±100 以内
+//   - 调整成功后写入审计日志（操作人、原因、前后分数）
+func (l *AdminAdjustScoreLogic) AdminAdjustScore(in *pb.AdminAdjustScoreReq) (*pb.AdminAdjustScoreResp, error) {
+	// 1. 参数校验
+	reason := strings.TrimSpace(in.Reason)
+	if in.AdminId <= 0 || in.UserId <= 0 {
+		l.Errorf("AdminAdjustScore 参数错误: adminId=%d, userId=%d", in.AdminId, in.UserId)
+		return nil, errorx.ErrInvalidParams("管理员ID或用户ID无效")
+	}
+	if in.Delta == 0 || in.Delta > constants.CreditAdminAdjustMaxDelta || in.Delta < -constants.CreditAdminAdjustMaxDelta {
+		return nil, errorx.ErrInvalidParams("调整分值须在 -100 到 100 之间且不为 0")
+	}
+	if reason == "" {
+		return nil, errorx.ErrInvalidParams("调整原因不能为空")
+	}
+
+	// 2. 变更信用分（每次调整生成新的来源ID）
+	sourceID := idgen.GenAdminAdjustSourceID(in.UserId)
+	resp, err := NewUpdateScoreLogic(l.ctx, l.svcCtx).UpdateScore(&pb.UpdateScoreReq{
+		UserId:     in.UserId,
+		ChangeType: constants.CreditChangeTypeAdminAdjust,
+		SourceId:   sourceID,
+		Reason:     "管理员调整：" + reason,
+		AdminDelta: in.Delta,
+	})
+	if err != nil {
+		l.Errorf("AdminAdjustScore 调整失败: adminId=%d, userId=%d, delta=%d, err=%v",
+			in.AdminId, in.UserId, in.Delta, err)
+		return nil, err
+	}
+
+	// 3. 写入审计日志
+	writeCreditAudit(l.ctx, l.svcCtx, &model.CreditAuditLog{
+		OperatorID:  in.AdminId,
+		UserID:      in.UserId,
+		Action:      constants.CreditAuditActionAdjust,
+		SourceID:    sourceID,
+		Delta:       int(resp.Delta),
+		BeforeScore: int(resp.BeforeScore),
+		AfterScore:  int(resp.AfterScore),
+		Reason:      reason,
+	})
+
+	l.Infof("AdminAdjustScore 调整成功: adminId=%d, userId=%d, %d -> %d",
+		in.AdminId, in.UserId, resp.BeforeScore, resp.AfterScore)
+
+	return &pb.AdminAdjustScoreResp{
+		BeforeScore: resp.BeforeScore,
+		AfterScore:  resp.AfterScore,
+		Delta:       resp.Delta,
+		NewLevel:    resp.NewLevel,
+	}, nil
+}
+
+// writeCreditAudit 写入信用管理审计日志
+// 分数变更已落库，审计写入失败只记录日志，不回滚也不返回错误（避免调用方重试导致重复调整）
+func writeCreditAudit(ctx context.Context, svcCtx *svc.ServiceContext, audit *model.CreditAuditLog) {
+	if err := svcCtx.CreditAuditLogModel.Create(ctx, audit); err != nil {
+		logx.WithContext(ctx).Errorf("写入信用审计日志失败: operatorId=%d, userId=%d, action=%s, sourceId=%s, err=%v",
+			audit.OperatorID, audit.UserID, audit.Action, audit.SourceID, err)
+	}
+}
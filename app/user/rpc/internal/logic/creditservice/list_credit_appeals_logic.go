@@ -0,0 +1,131 @@
+/**
+ * @projectName: CampusHub
+ * @package: creditservicelogic
+ * @className: ListCreditAppealsLogic
+ * @author: lijunqi
+ * @description: 查询信用申诉列表逻辑层
+ * @date: 2026-10-18
+ * @version: 1.0
+ */
+
+package creditservicelogic
+
+import (
+	"context"
+
+	"activity-platform/app/user/model"
+	"activity-platform/app/user/rpc/internal/svc"
+	"activity-platform/app/user/rpc/pb/pb"
+	"activity-platform/common/constants"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// ListCreditAppealsLogic 查询信用申诉列表逻辑处理器
+type ListCreditAppealsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+// NewListCreditAppealsLogic 创建查询信用申诉列表逻辑实例
+func NewListCreditAppealsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListCreditAppealsLogic {
+	return &ListCreditAppealsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ListCreditAppeals 查询信用申诉列表
+// 业务逻辑:
+//   - user_id > 0 时仅查询该用户的申诉；user_id = 0 查询全部（管理员，由 API 层鉴权）
+//   - 附带被申诉记录的分值与原因，按申诉时间倒序
+func (l *ListCreditAppealsLogic) ListCreditAppeals(in *pb.ListCreditAppealsReq) (*pb.ListCreditAppealsResp, error) {
+	// 1. 处理分页参数
+	page, pageSize := normalizePage(in.Page, in.PageSize)
+	query := &model.CreditAppealQuery{
+		UserID: in.UserId,
+		Status: int8(in.Status),
+		Offset: int((page - 1) * pageSize),
+		Limit:  int(pageSize),
+	}
+
+	// 2. 查询总数
+	total, err := l.svcCtx.CreditAppealModel.CountByQuery(l.ctx, query)
+	if err != nil {
+		l.Errorf("ListCreditAppeals 统计申诉数失败: userId=%d, err=%v", in.UserId, err)
+		return nil, errorx.ErrDBError(err)
+	}
+	if total == 0 {
+		return &pb.ListCreditAppealsResp{List: []*pb.CreditAppealItem{}, Total: 0}, nil
+	}
+
+	// 3. 查询申诉列表
+	appeals, err := l.svcCtx.CreditAppealModel.ListByQuery(l.ctx, query)
+	if err != nil {
+		l.Errorf("ListCreditAppeals 查询申诉列表失败: userId=%d, err=%v", in.UserId, err)
+		return nil, errorx.ErrDBError(err)
+	}
+
+	// 4. 批量查询被申诉的信用记录
+	logIDs := make([]int64, 0, len(appeals))
+	for _, appeal := range appeals {
+		logIDs = append(logIDs, appeal.CreditLogID)
+	}
+	logs, err := l.svcCtx.CreditLogModel.FindByIDs(l.ctx, logIDs)
+	if err != nil {
+		l.Errorf("ListCreditAppeals 查询信用记录失败: err=%v", err)
+		return nil, errorx.ErrDBError(err)
+	}
+	logMap := make(map[int64]*model.CreditLog, len(logs))
+	for _, log := range logs {
+		logMap[log.ID] = log
+	}
+
+	// 5. 转换为响应格式
+	list := make([]*pb.CreditAppealItem, 0, len(appeals))
+	for _, appeal := range appeals {
+		list = append(list, convertAppealToProto(appeal, logMap[appeal.CreditLogID]))
+	}
+
+	return &pb.ListCreditAppealsResp{List: list, Total: total}, nil
+}
+
+// convertAppealToProto 将申诉 Model 转换为 Proto 格式
+func convertAppealToProto(appeal *model.CreditAppeal, log *model.CreditLog) *pb.CreditAppealItem {
+	item := &pb.CreditAppealItem{
+		Id:          appeal.ID,
+		UserId:      appeal.UserID,
+		CreditLogId: appeal.CreditLogID,
+		Reason:      appeal.Reason,
+		Status:      int32(appeal.Status),
+		StatusName:  constants.CreditAppealStatusNames[appeal.Status],
+		ReviewerId:  appeal.ReviewerID,
+		ReviewNote:  appeal.ReviewNote,
+		CreatedAt:   appeal.CreatedAt.Unix(),
+	}
+	if appeal.ReviewedAt != nil {
+		item.ReviewedAt = appeal.ReviewedAt.Unix()
+	}
+	if log != nil {
+		item.Delta = int32(log.Delta)
+		item.LogReason = log.Reason
+	}
+	return item
+}
+
+// normalizePage 规范化分页参数（默认20条，最大50条）
+func normalizePage(page, pageSize int32) (int32, int32) {
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+	if pageSize > 50 {
+		pageSize = 50
+	}
+	return page, pageSize
+}
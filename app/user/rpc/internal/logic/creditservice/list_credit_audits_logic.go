@@ -0,0 +1,89 @@
+/**
+ * @projectName: CampusHub
+ * @package: creditservicelogic
+ * @className: ListCreditAuditsLogic
+ * @author: lijunqi
+ * @description: 查询信用管理审计日志逻辑层
+ * @date: 2026-10-18
+ * @version: 1.0
+ */
+
+package creditservicelogic
+
+import (
+	"context"
+
+	"activity-platform/app/user/model"
+	"activity-platform/app/user/rpc/internal/svc"
+	"activity-platform/app/user/rpc/pb/pb"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// ListCreditAuditsLogic 查询信用管理审计日志逻辑处理器
+type ListCreditAuditsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+// NewListCreditAuditsLogic 创建查询信用管理审计日志逻辑实例
+func NewListCreditAuditsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListCreditAuditsLogic {
+	return &ListCreditAuditsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ListCreditAudits 查询信用管理审计日志
+// 业务逻辑: 支持按被操作用户、操作人、操作类型筛选，按时间倒序
+func (l *ListCreditAuditsLogic) ListCreditAudits(in *pb.ListCreditAuditsReq) (*pb.ListCreditAuditsResp, error) {
+	// 1. 处理分页参数
+	page, pageSize := normalizePage(in.Page, in.PageSize)
+	query := &model.CreditAuditQuery{
+		UserID:     in.UserId,
+		OperatorID: in.OperatorId,
+		Action:     in.Action,
+		Offset:     int((page - 1) * pageSize),
+		Limit:      int(pageSize),
+	}
+
+	// 2. 查询总数
+	total, err := l.svcCtx.CreditAuditLogModel.CountByQuery(l.ctx, query)
+	if err != nil {
+		l.Errorf("ListCreditAudits 统计审计日志失败: err=%v", err)
+		return nil, errorx.ErrDBError(err)
+	}
+	if total == 0 {
+		return &pb.ListCreditAuditsResp{List: []*pb.CreditAuditItem{}, Total: 0}, nil
+	}
+
+	// 3. 查询列表
+	logs, err := l.svcCtx.CreditAuditLogModel.ListByQuery(l.ctx, query)
+	if err != nil {
+		l.Errorf("ListCreditAudits 查询审计日志失败: err=%v", err)
+		return nil, errorx.ErrDBError(err)
+	}
+
+	// 4. 转换为响应格式
+	list := make([]*pb.CreditAuditItem, 0, len(logs))
+	for _, log := range logs {
+		list = append(list, &pb.CreditAuditItem{
+			Id:          log.ID,
+			OperatorId:  log.OperatorID,
+			UserId:      log.UserID,
+			Action:      log.Action,
+			TargetId:    log.TargetID,
+			SourceId:    log.SourceID,
+			Delta:       int32(log.Delta),
+			BeforeScore: int32(log.BeforeScore),
+			AfterScore:  int32(log.AfterScore),
+			Reason:      log.Reason,
+			CreatedAt:   log.CreatedAt.Unix(),
+		})
+	}
+
+	return &pb.ListCreditAuditsResp{List: list, Total: total}, nil
+}
@@ -0,0 +1,192 @@
+/**
+ * @projectName: CampusHub
+ * @package: creditservicelogic
+ * @className: ReviewCreditAppealLogic
+ * @author: lijunqi
+ * @description: 处理信用申诉逻辑层
+ * @date: 2026-10-18
+ * @version: 1.0
+ */
+
+package creditservicelogic
+
+import (
+	"context"
+	"strings"
+
+	"activity-platform/app/user/model"
+	"activity-platform/app/user/rpc/internal/svc"
+	"activity-platform/app/user/rpc/pb/pb"
+	"activity-platform/common/constants"
+	"activity-platform/common/errorx"
+	"activity-platform/common/utils/idgen"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+)
+
+// ReviewCreditAppealLogic 处理信用申诉逻辑处理器
+type ReviewCreditAppealLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+// NewReviewCreditAppealLogic 创建处理信用申诉逻辑实例
+func NewReviewCreditAppealLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ReviewCreditAppealLogic {
+	return &ReviewCreditAppealLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ReviewCreditAppeal 处理信用申诉
+// 业务逻辑:
+//   - 驳回：待处理 -> 已驳回
+//   - 通过：先将申诉置为已通过（条件更新，防止并发重复处理），再撤销被申诉记录的分数变动
+//   - 撤销以 appeal_reverse:{logId} 为来源ID，天然幂等；
+//     若置为已通过后撤销失败，再次提交「通过」会重试撤销，不会重复加分
+func (l *ReviewCreditAppealLogic) ReviewCreditAppeal(in *pb.ReviewCreditAppealReq) (*pb.ReviewCreditAppealResp, error) {
+	// 1. 参数校验
+	if in.AppealId <= 0 || in.AdminId <= 0 {
+		l.Errorf("ReviewCreditAppeal 参数错误: appealId=%d, adminId=%d", in.AppealId, in.AdminId)
+		return nil, errorx.ErrInvalidParams("申诉ID或管理员ID无效")
+	}
+	note := strings.TrimSpace(in.Note)
+	if len([]rune(note)) > constants.CreditAppealReasonMaxLen {
+		return nil, errorx.ErrInvalidParams("处理意见不能超过500字")
+	}
+
+	// 2. 查询申诉
+	appeal, err := l.svcCtx.CreditAppealModel.FindByID(l.ctx, in.AppealId)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errorx.ErrCreditAppealNotFound()
+		}
+		l.Errorf("ReviewCreditAppeal 查询申诉失败: appealId=%d, err=%v", in.AppealId, err)
+		return nil, errorx.ErrDBError(err)
+	}
+
+	if !in.Accept {
+		return l.reject(appeal, in.AdminId, note)
+	}
+	return l.accept(appeal, in.AdminId, note)
+}
+
+// reject 驳回申诉
+func (l *ReviewCreditAppealLogic) reject(appeal *model.CreditAppeal, adminID int64, note string) (*pb.ReviewCreditAppealResp, error) {
+	ok, err := l.svcCtx.CreditAppealModel.UpdateReview(l.ctx, appeal.ID,
+		constants.CreditAppealStatusPending, constants.CreditAppealStatusRejected, adminID, note)
+	if err != nil {
+		l.Errorf("ReviewCreditAppeal 驳回失败: appealId=%d, err=%v", appeal.ID, err)
+		return nil, errorx.ErrDBError(err)
+	}
+	if !ok {
+		return nil, errorx.ErrCreditAppealReviewed()
+	}
+
+	score := l.currentScore(appeal.UserID)
+	writeCreditAudit(l.ctx, l.svcCtx, &model.CreditAuditLog{
+		OperatorID:  adminID,
+		UserID:      appeal.UserID,
+		Action:      constants.CreditAuditActionAppealReject,
+		TargetID:    appeal.ID,
+		BeforeScore: score,
+		AfterScore:  score,
+		Reason:      note,
+	})
+
+	l.Infof("ReviewCreditAppeal 已驳回: appealId=%d, adminId=%d", appeal.ID, adminID)
+	return &pb.ReviewCreditAppealResp{
+		Status: int32(constants.CreditAppealStatusRejected),
+		Score:  int64(score),
+	}, nil
+}
+
+// accept 通过申诉并撤销扣分
+func (l *ReviewCreditAppealLogic) accept(appeal *model.CreditAppeal, adminID int64, note string) (*pb.ReviewCreditAppealResp, error) {
+	// 1. 抢占处理权：待处理 -> 已通过
+	if appeal.Status == constants.CreditAppealStatusPending {
+		ok, err := l.svcCtx.CreditAppealModel.UpdateReview(l.ctx, appeal.ID,
+			constants.CreditAppealStatusPending, constants.CreditAppealStatusAccepted, adminID, note)
+		if err != nil {
+			l.Errorf("ReviewCreditAppeal 更新申诉状态失败: appealId=%d, err=%v", appeal.ID, err)
+			return nil, errorx.ErrDBError(err)
+		}
+		if !ok {
+			// 并发处理：重新读取最新状态
+			latest, err := l.svcCtx.CreditAppealModel.FindByID(l.ctx, appeal.ID)
+			if err != nil {
+				l.Errorf("ReviewCreditAppeal 查询申诉失败: appealId=%d, err=%v", appeal.ID, err)
+				return nil, errorx.ErrDBError(err)
+			}
+			appeal = latest
+		} else {
+			appeal.Status = constants.CreditAppealStatusAccepted
+		}
+	}
+	// 已驳回的申诉不能再通过；已通过的申诉继续执行（幂等重试撤销）
+	if appeal.Status != constants.CreditAppealStatusAccepted {
+		return nil, errorx.ErrCreditAppealReviewed()
+	}
+
+	// 2. 查询被申诉的信用记录
+	creditLog, err := l.svcCtx.CreditLogModel.FindByID(l.ctx, appeal.CreditLogID)
+	if err != nil {
+		l.Errorf("ReviewCreditAppeal 查询信用记录失败: logId=%d, err=%v", appeal.CreditLogID, err)
+		return nil, errorx.ErrDBError(err)
+	}
+
+	// 3. 撤销分数变动（来源ID幂等）
+	sourceID := idgen.GenAppealReverseSourceID(creditLog.ID)
+	resp, err := NewUpdateScoreLogic(l.ctx, l.svcCtx).UpdateScore(&pb.UpdateScoreReq{
+		UserId:     appeal.UserID,
+		ChangeType: constants.CreditChangeTypeAppealReverse,
+		SourceId:   sourceID,
+		Reason:     "申诉通过，撤销：" + creditLog.Reason,
+		AdminDelta: int64(-creditLog.Delta),
+	})
+	if err != nil {
+		if errorx.Is(err, errorx.CodeCreditSourceDup) {
+			// 已撤销过（重复提交或重试），视为成功
+			l.Infof("ReviewCreditAppeal 扣分已撤销，跳过: appealId=%d, sourceId=%s", appeal.ID, sourceID)
+			return &pb.ReviewCreditAppealResp{
+				Status: int32(constants.CreditAppealStatusAccepted),
+				Score:  int64(l.currentScore(appeal.UserID)),
+			}, nil
+		}
+		l.Errorf("ReviewCreditAppeal 撤销扣分失败: appealId=%d, logId=%d, err=%v", appeal.ID, creditLog.ID, err)
+		return nil, err
+	}
+
+	// 4. 写入审计日志
+	writeCreditAudit(l.ctx, l.svcCtx, &model.CreditAuditLog{
+		OperatorID:  adminID,
+		UserID:      appeal.UserID,
+		Action:      constants.CreditAuditActionAppealAccept,
+		TargetID:    appeal.ID,
+		SourceID:    sourceID,
+		Delta:       int(resp.Delta),
+		BeforeScore: int(resp.BeforeScore),
+		AfterScore:  int(resp.AfterScore),
+		Reason:      note,
+	})
+
+	l.Infof("ReviewCreditAppeal 已通过: appealId=%d, adminId=%d, userId=%d, %d -> %d",
+		appeal.ID, adminID, appeal.UserID, resp.BeforeScore, resp.AfterScore)
+	return &pb.ReviewCreditAppealResp{
+		Status: int32(constants.CreditAppealStatusAccepted),
+		Score:  resp.AfterScore,
+	}, nil
+}
+
+// currentScore 查询用户当前分数（仅用于审计与响应展示，失败返回0）
+func (l *ReviewCreditAppealLogic) currentScore(userID int64) int {
+	credit, err := l.svcCtx.UserCreditModel.FindByUserID(l.ctx, userID)
+	if err != nil {
+		l.Errorf("ReviewCreditAppeal 查询信用分失败: userId=%d, err=%v", userID, err)
+		return 0
+	}
+	return credit.Score
+}
@@ -0,0 +1,117 @@
+/**
+ * @projectName: CampusHub
+ * @package: creditservicelogic
+ * @className: SubmitCreditAppealLogic
+ * @author: lijunqi
+ * @description: 提交信用申诉逻辑层
+ * @date: 2026-10-18
+ * @version: 1.0
+ */
+
+package creditservicelogic
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"activity-platform/app/user/model"
+	"activity-platform/app/user/rpc/internal/svc"
+	"activity-platform/app/user/rpc/pb/pb"
+	"activity-platform/common/constants"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+)
+
+// SubmitCreditAppealLogic 提交信用申诉逻辑处理器
+type SubmitCreditAppealLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+// NewSubmitCreditAppealLogic 创建提交信用申诉逻辑实例
+func NewSubmitCreditAppealLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SubmitCreditAppealLogic {
+	return &SubmitCreditAppealLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// SubmitCreditAppeal 提交信用申诉
+// 业务逻辑:
+//   - 只能申诉本人的扣分记录（delta < 0）
+//   - 扣分发生后 30 天内可申诉，每条记录仅能申诉一次
+//   - 每个用户同时待处理的申诉不超过 3 条
+func (l *SubmitCreditAppealLogic) SubmitCreditAppeal(in *pb.SubmitCreditAppealReq) (*pb.SubmitCreditAppealResp, error) {
+	// 1. 参数校验
+	reason := strings.TrimSpace(in.Reason)
+	if in.UserId <= 0 || in.CreditLogId <= 0 {
+		l.Errorf("SubmitCreditAppeal 参数错误: userId=%d, creditLogId=%d", in.UserId, in.CreditLogId)
+		return nil, errorx.ErrInvalidParams("用户ID或记录ID无效")
+	}
+	if reason == "" {
+		return nil, errorx.ErrInvalidParams("申诉理由不能为空")
+	}
+	if len([]rune(reason)) > constants.CreditAppealReasonMaxLen {
+		return nil, errorx.ErrInvalidParams("申诉理由不能超过500字")
+	}
+
+	// 2. 校验被申诉的信用记录
+	creditLog, err := l.svcCtx.CreditLogModel.FindByID(l.ctx, in.CreditLogId)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errorx.New(errorx.CodeCreditAppealNotAllowed)
+		}
+		l.Errorf("SubmitCreditAppeal 查询信用记录失败: logId=%d, err=%v", in.CreditLogId, err)
+		return nil, errorx.ErrDBError(err)
+	}
+	if creditLog.UserID != in.UserId || creditLog.Delta >= 0 {
+		l.Infof("SubmitCreditAppeal 记录不可申诉: userId=%d, logId=%d, owner=%d, delta=%d",
+			in.UserId, in.CreditLogId, creditLog.UserID, creditLog.Delta)
+		return nil, errorx.New(errorx.CodeCreditAppealNotAllowed)
+	}
+	deadline := creditLog.CreatedAt.AddDate(0, 0, constants.CreditAppealWindowDays)
+	if time.Now().After(deadline) {
+		return nil, errorx.NewWithMessage(errorx.CodeCreditAppealNotAllowed, "扣分已超过30天，无法申诉")
+	}
+
+	// 3. 校验是否已申诉
+	if _, err := l.svcCtx.CreditAppealModel.FindByCreditLogID(l.ctx, in.CreditLogId); err == nil {
+		return nil, errorx.New(errorx.CodeCreditAppealExists)
+	} else if err != gorm.ErrRecordNotFound {
+		l.Errorf("SubmitCreditAppeal 查询申诉记录失败: logId=%d, err=%v", in.CreditLogId, err)
+		return nil, errorx.ErrDBError(err)
+	}
+
+	// 4. 校验待处理数量
+	pending, err := l.svcCtx.CreditAppealModel.CountPendingByUserID(l.ctx, in.UserId)
+	if err != nil {
+		l.Errorf("SubmitCreditAppeal 统计待处理申诉失败: userId=%d, err=%v", in.UserId, err)
+		return nil, errorx.ErrDBError(err)
+	}
+	if pending >= constants.CreditAppealMaxPending {
+		return nil, errorx.New(errorx.CodeCreditAppealLimit)
+	}
+
+	// 5. 创建申诉（并发重复提交由唯一索引 uk_credit_log_id 拦截）
+	appeal := &model.CreditAppeal{
+		UserID:      in.UserId,
+		CreditLogID: in.CreditLogId,
+		Reason:      reason,
+		Status:      constants.CreditAppealStatusPending,
+	}
+	if err := l.svcCtx.CreditAppealModel.Create(l.ctx, appeal); err != nil {
+		if isDuplicateKeyError(err) {
+			return nil, errorx.New(errorx.CodeCreditAppealExists)
+		}
+		l.Errorf("SubmitCreditAppeal 创建申诉失败: userId=%d, logId=%d, err=%v", in.UserId, in.CreditLogId, err)
+		return nil, errorx.ErrDBError(err)
+	}
+
+	l.Infof("SubmitCreditAppeal 提交成功: appealId=%d, userId=%d, logId=%d", appeal.ID, in.UserId, in.CreditLogId)
+	return &pb.SubmitCreditAppealResp{AppealId: appeal.ID}, nil
+}
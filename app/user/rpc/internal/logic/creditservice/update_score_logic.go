@@ -180,7 +180,7 @@ func (l *UpdateScoreLogic) executeUpdate(in *pb.UpdateScoreReq, result *scoreCha
 		// 1. 先插入日志（利用唯一索引 uk_source_id 保证幂等）
 		if err := l.createCreditLog(tx, in, result); err != nil {
 			// 检查是否为唯一索引冲突（幂等场景）
-			if l.isDuplicateKeyError(err) {
+			if isDuplicateKeyError(err) {
 				l.Infof("UpdateScore 幂等拦截（唯一索引）: sourceId=%s", in.SourceId)
 				return errorx.ErrCreditSourceDup()
 			}
@@ -226,7 +226,7 @@ func (l *UpdateScoreLogic) executeUpdate(in *pb.UpdateScoreReq, result *scoreCha
 //   - 1205 (ER_LOCK_WAIT_TIMEOUT): 锁等待超时
 //
 // 本函数用于幂等场景：当 source_id 唯一索引冲突时，说明该操作已处理过
-func (l *UpdateScoreLogic) isDuplicateKeyError(err error) bool {
+func isDuplicateKeyError(err error) bool {
 	if err == nil {
 		return false
 	}
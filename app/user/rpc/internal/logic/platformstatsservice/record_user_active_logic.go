@@ -0,0 +1,56 @@
+/**
+ * @projectName: CampusHub
+ * @package: platformstatsservicelogic
+ * @className: RecordUserActiveLogic
+ * @author: lijunqi
+ * @description: 记录用户活跃（计入当日日活）
+ * @date: 2026-10-19
+ * @version: 1.0
+ */
+
+package platformstatsservicelogic
+
+import (
+	"context"
+
+	"activity-platform/app/user/rpc/internal/svc"
+	"activity-platform/app/user/rpc/pb/pb"
+	"activity-platform/common/constants"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// RecordUserActiveLogic 记录用户活跃逻辑处理器
+type RecordUserActiveLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+// NewRecordUserActiveLogic 创建记录用户活跃逻辑实例
+func NewRecordUserActiveLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RecordUserActiveLogic {
+	return &RecordUserActiveLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// RecordUserActive 记录用户活跃
+// 业务逻辑:
+//   - 供 WebSocket 网关在用户上线时调用（登录活跃在用户服务内直接记录）
+//   - 写入当日日活 HyperLogLog，由平台统计任务汇总到统计表
+func (l *RecordUserActiveLogic) RecordUserActive(in *pb.RecordUserActiveReq) (*pb.RecordUserActiveResp, error) {
+	// 1. 参数校验
+	if in.UserId <= 0 {
+		return nil, errorx.ErrInvalidParams("用户ID无效")
+	}
+	if in.Source != constants.StatsActiveSourceLogin && in.Source != constants.StatsActiveSourceWS {
+		return nil, errorx.ErrInvalidParams("活跃来源无效")
+	}
+
+	// 2. 记录活跃
+	MarkUserActive(l.ctx, l.svcCtx, in.UserId, in.Source)
+	return &pb.RecordUserActiveResp{}, nil
+}
@@ -0,0 +1,92 @@
+/**
+ * @projectName: CampusHub
+ * @package: platformstatsservicelogic
+ * @className: ReportDailyStatsLogic
+ * @author: lijunqi
+ * @description: 接收其他服务上报的日指标并写入统计表
+ * @date: 2026-10-19
+ * @version: 1.0
+ */
+
+package platformstatsservicelogic
+
+import (
+	"context"
+	"unicode/utf8"
+
+	"activity-platform/app/user/model"
+	"activity-platform/app/user/rpc/internal/svc"
+	"activity-platform/app/user/rpc/pb/pb"
+	"activity-platform/common/constants"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// maxStatsDimensionLen 维度最大长度（与 stats_daily_metrics.dimension 一致）
+const maxStatsDimensionLen = 64
+
+// ReportDailyStatsLogic 上报日指标逻辑处理器
+type ReportDailyStatsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+// NewReportDailyStatsLogic 创建上报日指标逻辑实例
+func NewReportDailyStatsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ReportDailyStatsLogic {
+	return &ReportDailyStatsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ReportDailyStats 上报某日指标
+// 业务逻辑:
+//   - 活动服务、聊天服务的统计任务按天上报本服务指标
+//   - 每个来源只能写入自己负责的指标（见 constants.StatsReportMetrics）
+//   - 同一日期下声明的指标整体替换，重复上报幂等
+func (l *ReportDailyStatsLogic) ReportDailyStats(in *pb.ReportDailyStatsReq) (*pb.ReportDailyStatsResp, error) {
+	// 1. 参数校验
+	allowed, ok := constants.StatsReportMetrics[in.Source]
+	if !ok {
+		return nil, errorx.ErrInvalidParams("上报来源无效")
+	}
+	if _, err := parseStatDate(in.StatDate, "统计日期"); err != nil {
+		return nil, err
+	}
+	if len(in.Metrics) == 0 {
+		return nil, errorx.ErrInvalidParams("上报指标不能为空")
+	}
+	for _, metric := range in.Metrics {
+		if !containsString(allowed, metric) {
+			return nil, errorx.ErrInvalidParams("来源" + in.Source + "不能上报指标" + metric)
+		}
+	}
+
+	// 2. 构建数据
+	rows := make([]*model.StatsDailyMetric, 0, len(in.Items))
+	for _, item := range in.Items {
+		if !containsString(in.Metrics, item.Metric) {
+			return nil, errorx.ErrInvalidParams("指标" + item.Metric + "未在本次上报中声明")
+		}
+		if utf8.RuneCountInString(item.Dimension) > maxStatsDimensionLen {
+			return nil, errorx.ErrInvalidParams("维度不能超过64个字符")
+		}
+		rows = append(rows, &model.StatsDailyMetric{
+			StatDate:  in.StatDate,
+			Metric:    item.Metric,
+			Dimension: item.Dimension,
+			Value:     item.Value,
+			Source:    in.Source,
+		})
+	}
+
+	// 3. 整体替换
+	if err := l.svcCtx.StatsDailyMetricModel.ReplaceDay(l.ctx, in.StatDate, in.Metrics, rows); err != nil {
+		l.Errorf("ReportDailyStats 写入失败: source=%s, date=%s, err=%v", in.Source, in.StatDate, err)
+		return nil, errorx.ErrDBError(err)
+	}
+	return &pb.ReportDailyStatsResp{Count: int32(len(rows))}, nil
+}
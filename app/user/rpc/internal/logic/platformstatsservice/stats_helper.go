@@ -0,0 +1,67 @@
+/**
+ * @projectName: CampusHub
+ * @package: platformstatsservicelogic
+ * @className: stats_helper
+ * @author: lijunqi
+ * @description: 平台统计公共方法（日活记录、日期解析）
+ * @date: 2026-10-19
+ * @version: 1.0
+ */
+
+package platformstatsservicelogic
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"activity-platform/app/user/rpc/internal/svc"
+	"activity-platform/common/constants"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// DAUKey 日活 HyperLogLog Key（source 为空表示去重总数）
+func DAUKey(date, source string) string {
+	if source == "" {
+		return constants.StatsDAUPrefix + date
+	}
+	return constants.StatsDAUPrefix + date + ":" + source
+}
+
+// MarkUserActive 记录用户活跃
+// 同时写入当日去重总数与来源维度的 HyperLogLog，失败只记录日志，不影响调用方主流程
+func MarkUserActive(ctx context.Context, svcCtx *svc.ServiceContext, userID int64, source string) {
+	date := time.Now().Format(constants.StatsDateLayout)
+	member := strconv.FormatInt(userID, 10)
+	totalKey, sourceKey := DAUKey(date, ""), DAUKey(date, source)
+
+	pipe := svcCtx.Redis.Pipeline()
+	pipe.PFAdd(ctx, totalKey, member)
+	pipe.Expire(ctx, totalKey, constants.StatsRawDataTTL)
+	pipe.PFAdd(ctx, sourceKey, member)
+	pipe.Expire(ctx, sourceKey, constants.StatsRawDataTTL)
+	if _, err := pipe.Exec(ctx); err != nil {
+		logx.WithContext(ctx).Errorf("[PlatformStats] 记录日活失败: userId=%d, source=%s, err=%v", userID, source, err)
+	}
+}
+
+// parseStatDate 解析统计日期（yyyy-MM-dd）
+func parseStatDate(date, field string) (time.Time, error) {
+	t, err := time.ParseInLocation(constants.StatsDateLayout, date, time.Local)
+	if err != nil {
+		return time.Time{}, errorx.ErrInvalidParams(field + "格式应为yyyy-MM-dd")
+	}
+	return t, nil
+}
+
+// containsString 判断字符串切片是否包含指定值
+func containsString(list []string, target string) bool {
+	for _, s := range list {
+		if s == target {
+			return true
+		}
+	}
+	return false
+}
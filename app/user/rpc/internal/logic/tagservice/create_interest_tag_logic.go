@@ -0,0 +1,127 @@
+package tagservicelogic
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"activity-platform/app/user/model"
+	"activity-platform/app/user/rpc/internal/svc"
+	"activity-platform/app/user/rpc/pb/pb"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+)
+
+const (
+	maxTagNameLen  = 50  // 标签名称最大长度（与 interest_tags.tag_name 一致）
+	maxTagColorLen = 20  // 颜色值最大长度
+	maxTagIconLen  = 255 // 图标URL最大长度
+	maxTagDescLen  = 200 // 描述最大长度
+)
+
+type CreateInterestTagLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCreateInterestTagLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateInterestTagLogic {
+	return &CreateInterestTagLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 创建兴趣标签
+func (l *CreateInterestTagLogic) CreateInterestTag(in *pb.CreateInterestTagReq) (*pb.InterestTagResp, error) {
+	// 1. 参数校验
+	name := strings.TrimSpace(in.Name)
+	if name == "" {
+		return nil, errorx.ErrInvalidParams("标签名称不能为空")
+	}
+	if err := validateTagFields(name, in.Color, in.Icon, in.Description); err != nil {
+		return nil, err
+	}
+
+	// 2. 名称唯一
+	if err := checkTagNameAvailable(l.ctx, l.svcCtx, name, 0); err != nil {
+		return nil, err
+	}
+
+	// 3. 创建标签
+	tag := &model.InterestTag{
+		TagName: name,
+		Color:   strings.TrimSpace(in.Color),
+		Icon:    strings.TrimSpace(in.Icon),
+		TagDesc: strings.TrimSpace(in.Description),
+		Status:  model.TagStatusNormal,
+	}
+	if err := l.svcCtx.InterestTagModel.Create(l.ctx, tag); err != nil {
+		l.Logger.Errorf("Create interest tag error: %v, name: %s", err, name)
+		return nil, errorx.ErrDBError(err)
+	}
+
+	l.Logger.Infof("创建兴趣标签: tagId=%d, name=%s", tag.TagID, tag.TagName)
+	return &pb.InterestTagResp{Tag: toTagInfo(tag)}, nil
+}
+
+// validateTagFields 校验标签字段长度（空字段视为不修改，不做校验）
+func validateTagFields(name, color, icon, desc string) error {
+	if len([]rune(name)) > maxTagNameLen {
+		return errorx.ErrInvalidParams("标签名称不能超过50个字符")
+	}
+	if len([]rune(strings.TrimSpace(color))) > maxTagColorLen {
+		return errorx.ErrInvalidParams("标签颜色格式无效")
+	}
+	if len(strings.TrimSpace(icon)) > maxTagIconLen {
+		return errorx.ErrInvalidParams("标签图标地址过长")
+	}
+	if len([]rune(strings.TrimSpace(desc))) > maxTagDescLen {
+		return errorx.ErrInvalidParams("标签描述不能超过200个字符")
+	}
+	return nil
+}
+
+// checkTagNameAvailable 校验标签名称未被其他标签占用（excludeID 为当前标签）
+func checkTagNameAvailable(ctx context.Context, svcCtx *svc.ServiceContext, name string, excludeID int64) error {
+	existing, err := svcCtx.InterestTagModel.FindByName(ctx, name)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return errorx.ErrDBError(err)
+	}
+	if existing.TagID != excludeID {
+		return errorx.New(errorx.CodeInterestTagNameExists)
+	}
+	return nil
+}
+
+// findInterestTag 查询标签（不存在返回业务错误）
+func findInterestTag(ctx context.Context, svcCtx *svc.ServiceContext, tagID int64) (*model.InterestTag, error) {
+	tag, err := svcCtx.InterestTagModel.FindByID(ctx, tagID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errorx.New(errorx.CodeInterestTagNotFound)
+		}
+		return nil, errorx.ErrDBError(err)
+	}
+	return tag, nil
+}
+
+// toTagInfo 标签实体转换为 Proto 结构
+func toTagInfo(tag *model.InterestTag) *pb.TagInfo {
+	return &pb.TagInfo{
+		Id:          uint64(tag.TagID),
+		Name:        tag.TagName,
+		Color:       tag.Color,
+		Icon:        tag.Icon,
+		Status:      uint64(tag.Status),
+		Description: tag.TagDesc,
+		CreatedAt:   tag.CreateTime.Unix(),
+		UpdatedAt:   tag.UpdateTime.Unix(),
+	}
+}
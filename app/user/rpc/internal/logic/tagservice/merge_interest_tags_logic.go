@@ -0,0 +1,88 @@
+package tagservicelogic
+
+import (
+	"context"
+
+	"activity-platform/app/user/model"
+	"activity-platform/app/user/rpc/internal/svc"
+	"activity-platform/app/user/rpc/pb/pb"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+)
+
+type MergeInterestTagsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewMergeInterestTagsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *MergeInterestTagsLogic {
+	return &MergeInterestTagsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 合并兴趣标签（用户关联改绑到目标标签，源标签禁用）
+//
+// 同一事务内：改绑 user_interest_relations、目标标签累加使用次数、源标签禁用并记录 merged_into。
+// 幂等：源标签已合并到同一目标时直接返回，便于活动服务侧同步失败后重试
+func (l *MergeInterestTagsLogic) MergeInterestTags(in *pb.MergeInterestTagsReq) (*pb.MergeInterestTagsResp, error) {
+	// 1. 参数校验
+	if in.SourceId <= 0 || in.TargetId <= 0 {
+		return nil, errorx.ErrInvalidParams("标签ID无效")
+	}
+	if in.SourceId == in.TargetId {
+		return nil, errorx.New(errorx.CodeInterestTagMergeInvalid)
+	}
+
+	// 2. 查询源标签与目标标签
+	source, err := findInterestTag(l.ctx, l.svcCtx, in.SourceId)
+	if err != nil {
+		return nil, err
+	}
+	target, err := findInterestTag(l.ctx, l.svcCtx, in.TargetId)
+	if err != nil {
+		return nil, err
+	}
+	if target.Status != model.TagStatusNormal {
+		return nil, errorx.New(errorx.CodeInterestTagMergeInvalid)
+	}
+	if source.Status == model.TagStatusDisabled && source.MergedInto == target.TagID {
+		// 已合并（重试）
+		return &pb.MergeInterestTagsResp{Source: toTagInfo(source), Target: toTagInfo(target)}, nil
+	}
+
+	// 3. 事务：改绑用户关联 + 合并使用次数 + 禁用源标签
+	var moved int64
+	err = l.svcCtx.DB.WithContext(l.ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		moved, err = l.svcCtx.UserInterestRelationModel.RebindTag(l.ctx, tx, source.TagID, target.TagID)
+		if err != nil {
+			return err
+		}
+		return l.svcCtx.InterestTagModel.MergeInto(l.ctx, tx, source, target.TagID)
+	})
+	if err != nil {
+		l.Logger.Errorf("Merge interest tags error: %v, source: %d, target: %d", err, source.TagID, target.TagID)
+		return nil, errorx.ErrDBError(err)
+	}
+
+	// 4. 返回合并后的标签
+	if source, err = findInterestTag(l.ctx, l.svcCtx, source.TagID); err != nil {
+		return nil, err
+	}
+	if target, err = findInterestTag(l.ctx, l.svcCtx, target.TagID); err != nil {
+		return nil, err
+	}
+
+	l.Logger.Infof("合并兴趣标签: source=%d, target=%d, movedUsers=%d", source.TagID, target.TagID, moved)
+	return &pb.MergeInterestTagsResp{
+		Source:     toTagInfo(source),
+		Target:     toTagInfo(target),
+		MovedUsers: moved,
+	}, nil
+}
@@ -0,0 +1,82 @@
+package tagservicelogic
+
+import (
+	"context"
+	"strings"
+
+	"activity-platform/app/user/rpc/internal/svc"
+	"activity-platform/app/user/rpc/pb/pb"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdateInterestTagLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUpdateInterestTagLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateInterestTagLogic {
+	return &UpdateInterestTagLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 修改兴趣标签（重命名/颜色/图标/描述，空字段不修改）
+func (l *UpdateInterestTagLogic) UpdateInterestTag(in *pb.UpdateInterestTagReq) (*pb.InterestTagResp, error) {
+	// 1. 参数校验
+	if in.Id <= 0 {
+		return nil, errorx.ErrInvalidParams("标签ID无效")
+	}
+	name := strings.TrimSpace(in.Name)
+	if err := validateTagFields(name, in.Color, in.Icon, in.Description); err != nil {
+		return nil, err
+	}
+
+	// 2. 查询标签
+	tag, err := findInterestTag(l.ctx, l.svcCtx, in.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. 组装修改字段
+	fields := make(map[string]interface{})
+	if name != "" && name != tag.TagName {
+		if err := checkTagNameAvailable(l.ctx, l.svcCtx, name, tag.TagID); err != nil {
+			return nil, err
+		}
+		fields["tag_name"] = name
+		tag.TagName = name
+	}
+	if color := strings.TrimSpace(in.Color); color != "" {
+		fields["color"] = color
+		tag.Color = color
+	}
+	if icon := strings.TrimSpace(in.Icon); icon != "" {
+		fields["icon"] = icon
+		tag.Icon = icon
+	}
+	if desc := strings.TrimSpace(in.Description); desc != "" {
+		fields["tag_desc"] = desc
+		tag.TagDesc = desc
+	}
+	if len(fields) == 0 {
+		return &pb.InterestTagResp{Tag: toTagInfo(tag)}, nil
+	}
+
+	// 4. 更新
+	if err := l.svcCtx.InterestTagModel.UpdateFields(l.ctx, tag.TagID, fields); err != nil {
+		l.Logger.Errorf("Update interest tag error: %v, tagId: %d", err, tag.TagID)
+		return nil, errorx.ErrDBError(err)
+	}
+
+	tag, err = findInterestTag(l.ctx, l.svcCtx, tag.TagID)
+	if err != nil {
+		return nil, err
+	}
+	l.Logger.Infof("修改兴趣标签: tagId=%d, fields=%d", tag.TagID, len(fields))
+	return &pb.InterestTagResp{Tag: toTagInfo(tag)}, nil
+}
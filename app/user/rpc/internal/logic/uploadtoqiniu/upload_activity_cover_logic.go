@@ -2,12 +2,14 @@ package uploadtoqiniulogic
 
 import (
 	"context"
+	"time"
 
 	"activity-platform/app/user/rpc/internal/svc"
 	"activity-platform/app/user/rpc/pb/pb"
 	"activity-platform/common/errorx"
 
 	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
 )
 
 type UploadActivityCoverLogic struct {
@@ -34,6 +36,20 @@ func (Activity) TableName() string {
 	return "campushub_main.activities"
 }
 
+// ActivityChangeEvent 活动变更事件（Outbox，由活动服务消费后同步 ES / 缓存）
+// 字段与 campushub_main.activity_change_events 保持一致
+type ActivityChangeEvent struct {
+	ID         uint64 `gorm:"primaryKey;column:id"`
+	ActivityID uint64 `gorm:"column:activity_id"`
+	ChangeType string `gorm:"column:change_type"`
+	Source     string `gorm:"column:source"`
+	CreatedAt  int64  `gorm:"column:created_at"`
+}
+
+func (ActivityChangeEvent) TableName() string {
+	return "campushub_main.activity_change_events"
+}
+
 // 上传活动封面图片（同时处理旧图删除和DB更新）
 func (l *UploadActivityCoverLogic) UploadActivityCover(in *pb.UploadActivityCoverReq) (*pb.UploadActivityCoverResp, error) {
 	if in.ActivityId == 0 {
@@ -65,8 +81,23 @@ func (l *UploadActivityCoverLogic) UploadActivityCover(in *pb.UploadActivityCove
 		return nil, err
 	}
 
-	// 4. 更新数据库
-	err = l.svcCtx.DB.Model(&activity).Update("cover_url", url).Error
+	// 4. 更新数据库（同一事务记录变更事件，活动服务据此更新 ES 与缓存）
+	// updated_at 同步刷新：ES 以其作为外部版本号
+	err = l.svcCtx.DB.WithContext(l.ctx).Transaction(func(tx *gorm.DB) error {
+		now := time.Now().Unix()
+		if err := tx.Model(&activity).Updates(map[string]interface{}{
+			"cover_url":  url,
+			"updated_at": now,
+		}).Error; err != nil {
+			return err
+		}
+		return tx.Create(&ActivityChangeEvent{
+			ActivityID: uint64(activity.ID),
+			ChangeType: "cover",
+			Source:     "user-rpc",
+			CreatedAt:  now,
+		}).Error
+	})
 	if err != nil {
 		l.Errorf("更新活动 %d cover_url 失败: %v", in.ActivityId, err)
 		return nil, errorx.New(errorx.CodeDBError)
@@ -174,6 +174,12 @@ func (l *GetUserHomeLogic) GetUserHome(in *pb.GetUserHomeReq) (*pb.GetUserHomeRe
 		}
 
 		resp.PublishedActivities.Total = int32(pubResp.Pagination.Total)
+		if pubResp.OrganizerRating != nil {
+			resp.OrganizerRating = &pb.UserHomeOrganizerRating{
+				RatingAvg:   pubResp.OrganizerRating.RatingAvg,
+				RatingCount: pubResp.OrganizerRating.RatingCount,
+			}
+		}
 		for _, item := range pubResp.List {
 			timeStr := ""
 			if item.ActivityStartTime > 0 {
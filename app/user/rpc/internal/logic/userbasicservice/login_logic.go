@@ -10,8 +10,10 @@ import (
 	"activity-platform/app/user/model"
 	captchaservicelogic "activity-platform/app/user/rpc/internal/logic/captchaservice"
 	creditservicelogic "activity-platform/app/user/rpc/internal/logic/creditservice"
+	platformstatsservicelogic "activity-platform/app/user/rpc/internal/logic/platformstatsservice"
 	"activity-platform/app/user/rpc/internal/svc"
 	"activity-platform/app/user/rpc/pb/pb"
+	"activity-platform/common/constants"
 	"activity-platform/common/errorx"
 	"activity-platform/common/utils/email"
 	"activity-platform/common/utils/encrypt"
@@ -98,6 +100,9 @@ func (l *LoginLogic) Login(in *pb.LoginReq) (*pb.LoginResponse, error) {
 		return nil, errorx.ErrCacheError(err)
 	}
 
+	// 记录登录活跃（计入当日日活，失败不影响登录）
+	platformstatsservicelogic.MarkUserActive(l.ctx, l.svcCtx, int64(user.UserID), constants.StatsActiveSourceLogin)
+
 	// 4. 获取用户详细信息 (调用 GetUserInfoLogic 复用逻辑)
 	// 4.1 获取信誉分 (独立调用，确保即使 GetUserInfo 失败也能尝试获取，或者补充 GetUserInfo 缺失的字段)
 	var creditScore int64
@@ -0,0 +1,74 @@
+/**
+ * @projectName: CampusHub
+ * @package: verifyservicelogic
+ * @className: BatchGetVerifyProfilesLogic
+ * @author: lijunqi
+ * @description: 批量查询已认证用户的院系与入学年份（供活动分析统计使用）
+ * @date: 2026-10-19
+ * @version: 1.0
+ */
+
+package verifyservicelogic
+
+import (
+	"context"
+
+	"activity-platform/app/user/rpc/internal/svc"
+	"activity-platform/app/user/rpc/pb/pb"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// maxVerifyProfileBatch 单次批量查询的最大用户数
+const maxVerifyProfileBatch = 500
+
+// BatchGetVerifyProfilesLogic 批量查询认证画像逻辑处理器
+type BatchGetVerifyProfilesLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+// NewBatchGetVerifyProfilesLogic 创建批量查询认证画像逻辑实例
+func NewBatchGetVerifyProfilesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *BatchGetVerifyProfilesLogic {
+	return &BatchGetVerifyProfilesLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// BatchGetVerifyProfiles 批量查询已认证用户的院系与入学年份
+// 业务逻辑:
+//   - 供 Activity 服务的活动分析日聚合任务调用，统计报名者构成
+//   - 只查询非敏感字段，不解密姓名、学号
+//   - 未认证、认证已过期的用户不返回
+func (l *BatchGetVerifyProfilesLogic) BatchGetVerifyProfiles(in *pb.BatchGetVerifyProfilesReq) (*pb.BatchGetVerifyProfilesResp, error) {
+	// 1. 参数校验
+	if len(in.UserIds) == 0 {
+		return &pb.BatchGetVerifyProfilesResp{}, nil
+	}
+	if len(in.UserIds) > maxVerifyProfileBatch {
+		return nil, errorx.ErrInvalidParams("单次最多查询500个用户")
+	}
+
+	// 2. 查询
+	list, err := l.svcCtx.StudentVerificationModel.FindPassedProfiles(l.ctx, in.UserIds)
+	if err != nil {
+		l.Errorf("BatchGetVerifyProfiles 查询失败: count=%d, err=%v", len(in.UserIds), err)
+		return nil, errorx.ErrDBError(err)
+	}
+
+	// 3. 构建响应
+	profiles := make([]*pb.VerifyProfile, 0, len(list))
+	for _, v := range list {
+		profiles = append(profiles, &pb.VerifyProfile{
+			UserId:        v.UserID,
+			SchoolName:    v.SchoolName,
+			Department:    v.Department,
+			AdmissionYear: v.AdmissionYear,
+		})
+	}
+	return &pb.BatchGetVerifyProfilesResp{List: profiles}, nil
+}
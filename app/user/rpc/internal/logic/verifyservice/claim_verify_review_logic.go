@@ -0,0 +1,101 @@
+/**
+ * @projectName: CampusHub
+ * @package: verifyservicelogic
+ * @className: ClaimVerifyReviewLogic
+ * @author: lijunqi
+ * @description: 领取/释放人工审核任务逻辑层
+ * @date: 2026-10-18
+ * @version: 1.0
+ */
+
+package verifyservicelogic
+
+import (
+	"context"
+	"time"
+
+	"activity-platform/app/user/rpc/internal/svc"
+	"activity-platform/app/user/rpc/pb/pb"
+	"activity-platform/common/constants"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+)
+
+// ClaimVerifyReviewLogic 领取/释放人工审核任务逻辑处理器
+type ClaimVerifyReviewLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+// NewClaimVerifyReviewLogic 创建领取/释放人工审核任务逻辑实例
+func NewClaimVerifyReviewLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ClaimVerifyReviewLogic {
+	return &ClaimVerifyReviewLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ClaimVerifyReview 领取/释放人工审核任务
+// 业务逻辑:
+//   - 领取：仅人工审核中的记录可领取；已被他人领取时返回错误；本人重复领取视为续期
+//   - 释放：仅领取人本人可释放；非领取人（或领取已过期）释放时返回错误，不写审核日志
+func (l *ClaimVerifyReviewLogic) ClaimVerifyReview(in *pb.ClaimVerifyReviewReq) (*pb.ClaimVerifyReviewResp, error) {
+	// 1. 参数校验
+	if in.VerifyId <= 0 || in.ReviewerId <= 0 {
+		l.Errorf("ClaimVerifyReview 参数错误: verifyId=%d, reviewerId=%d", in.VerifyId, in.ReviewerId)
+		return nil, errorx.ErrInvalidParams("认证ID或审核员ID无效")
+	}
+
+	// 2. 查询认证记录
+	verification, err := l.svcCtx.StudentVerificationModel.FindByID(l.ctx, in.VerifyId)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errorx.ErrVerifyNotFound()
+		}
+		l.Errorf("ClaimVerifyReview 查询认证记录失败: verifyId=%d, err=%v", in.VerifyId, err)
+		return nil, errorx.ErrDBError(err)
+	}
+
+	// 3. 释放
+	if in.Release {
+		released, err := releaseClaim(l.ctx, l.svcCtx, in.VerifyId, in.ReviewerId)
+		if err != nil {
+			l.Errorf("ClaimVerifyReview 释放失败: verifyId=%d, reviewerId=%d, err=%v", in.VerifyId, in.ReviewerId, err)
+			return nil, errorx.ErrCacheError(err)
+		}
+		if !released {
+			// 非领取人或领取已过期：未发生释放，不写审核日志
+			l.Infof("ClaimVerifyReview 非领取人释放: verifyId=%d, reviewerId=%d", in.VerifyId, in.ReviewerId)
+			return nil, errorx.ErrVerifyReviewClaimed()
+		}
+		writeReviewLog(l.ctx, l.svcCtx, in.VerifyId, verification.UserID, in.ReviewerId,
+			constants.VerifyReviewActionRelease, "")
+		return &pb.ClaimVerifyReviewResp{}, nil
+	}
+
+	// 4. 领取
+	if verification.Status != constants.VerifyStatusManualReview {
+		return nil, errorx.ErrVerifyInvalidTransit()
+	}
+	owner, err := claimReview(l.ctx, l.svcCtx, in.VerifyId, in.ReviewerId)
+	if err != nil {
+		l.Errorf("ClaimVerifyReview 领取失败: verifyId=%d, reviewerId=%d, err=%v", in.VerifyId, in.ReviewerId, err)
+		return nil, errorx.ErrCacheError(err)
+	}
+	if owner != in.ReviewerId {
+		l.Infof("ClaimVerifyReview 已被他人领取: verifyId=%d, owner=%d, reviewerId=%d", in.VerifyId, owner, in.ReviewerId)
+		return nil, errorx.ErrVerifyReviewClaimed()
+	}
+
+	writeReviewLog(l.ctx, l.svcCtx, in.VerifyId, verification.UserID, in.ReviewerId,
+		constants.VerifyReviewActionClaim, "")
+
+	return &pb.ClaimVerifyReviewResp{
+		ClaimedBy: owner,
+		ExpireAt:  time.Now().Add(time.Duration(constants.VerifyReviewClaimTTL) * time.Second).Unix(),
+	}, nil
+}
@@ -85,7 +85,7 @@ func (l *ConfirmStudentVerifyLogic) ConfirmStudentVerify(
 
 	if in.IsConfirmed {
 		// 5a. 用户确认无误，直接通过
-		newStatus, err = l.handleConfirmed(in.VerifyId, in.UserId)
+		newStatus, err = l.handleConfirmed(verification)
 		if err != nil {
 			return nil, err
 		}
@@ -105,12 +105,15 @@ func (l *ConfirmStudentVerifyLogic) ConfirmStudentVerify(
 }
 
 // handleConfirmed 处理用户确认无误的情况
-func (l *ConfirmStudentVerifyLogic) handleConfirmed(verifyID, userID int64) (int8, error) {
+func (l *ConfirmStudentVerifyLogic) handleConfirmed(verification *model.StudentVerification) (int8, error) {
+	verifyID, userID := verification.ID, verification.UserID
 	newStatus := constants.VerifyStatusPassed
 	now := time.Now()
 	updates := map[string]interface{}{
-		"verified_at": &now,
-		"operator":    constants.VerifyOperatorUserConfirm,
+		"verified_at":        &now,
+		"operator":           constants.VerifyOperatorUserConfirm,
+		"expire_at":          CalcVerifyExpireAt(l.svcCtx.Config.VerifyExpiry, verification.AdmissionYear),
+		"expiry_reminded_at": nil,
 	}
 
 	if err := l.svcCtx.StudentVerificationModel.UpdateStatus(
@@ -118,6 +121,12 @@ func (l *ConfirmStudentVerifyLogic) handleConfirmed(verifyID, userID int64) (int
 		l.Errorf("ConfirmStudentVerify 更新状态失败: verifyId=%d, err=%v", verifyID, err)
 		return 0, errorx.ErrDBError(err)
 	}
+
+	// 删除认证状态缓存（重新认证时缓存中可能仍为未认证）
+	if err := l.svcCtx.VerifyCache.Delete(l.ctx, userID); err != nil {
+		l.Errorf("ConfirmStudentVerify 删除认证缓存失败: userId=%d, err=%v", userID, err)
+	}
+
 	publishVerifyProgress(
 		l.ctx,
 		l.svcCtx,
@@ -85,6 +85,10 @@ func (l *GetVerifyCurrentLogic) GetVerifyCurrent(in *pb.GetVerifyCurrentReq) (*p
 		UpdatedAt:    verification.UpdatedAt.Unix(),
 	}
 
+	if verification.ExpireAt != nil {
+		resp.ExpireAt = verification.ExpireAt.Unix()
+	}
+
 	// 4. 待确认或已通过状态时，返回OCR识别数据
 	if ShouldReturnOcrData(verification.Status) {
 		resp.VerifyData = BuildVerifyOcrDataFromModel(verification)
@@ -17,7 +17,12 @@
  *   2. 查询认证记录，校验状态（必须为 OcrPending）
  *   3. 检查是否超时（>10min 直接标记超时）
  *   4. 调用 OCR 识别（主提供商 + 备用故障转移，30s 超时）
- *   5. OCR 成功 → 更新为 WaitConfirm + 回填 OCR 数据
+ *   5. OCR 成功 → 判定策略打分（启用时）+ 回填 OCR 数据（WaitConfirm）
+ *      - confirm       → 保持 WaitConfirm，等待用户确认（原流程）
+ *      - approve       → 自动通过（Passed）
+ *      - manual_review → 转人工审核（ManualReview）
+ *      - reject        → 自动拒绝（Rejected），拒绝原因为判定摘要
+ *      自动判定均写入审核日志（reviewer_id=0），判定明细入库 policy_detail
  *   6. OCR 失败 → 更新为 OcrFailed
  *   7. 清除认证缓存
  *
@@ -30,8 +35,11 @@ package verifyservicelogic
 
 import (
 	"context"
+	"encoding/json"
 	"time"
+	"unicode/utf8"
 
+	"activity-platform/app/user/ocr"
 	"activity-platform/app/user/rpc/internal/svc"
 	"activity-platform/app/user/rpc/pb/pb"
 	"activity-platform/common/constants"
@@ -277,6 +285,27 @@ func (l *ProcessOcrVerifyLogic) ProcessOcrVerify(in *pb.ProcessOcrVerifyReq) (*p
 		OcrRawJson:    ocrResult.RawResponse,
 	})
 
+	// 判定策略打分（未启用时走原流程：等待用户确认）
+	var decision *ocr.Decision
+	if l.svcCtx.OcrPolicy != nil {
+		decision = l.svcCtx.OcrPolicy.Evaluate(&ocr.OcrResult{
+			RealName:      realName,
+			SchoolName:    schoolName,
+			StudentID:     studentID,
+			Department:    department,
+			AdmissionYear: ocrResult.AdmissionYear,
+			Confidence:    ocrResult.Confidence,
+		}, verification.AdmissionYear)
+		l.demoteIfStudentIDUsed(in.UserId, decision, schoolName, studentID)
+		ocrData.PolicyDecision = decision.Action
+		ocrData.PolicyScore = decision.Score
+		if detail, jsonErr := json.Marshal(decision); jsonErr == nil {
+			ocrData.PolicyDetail = string(detail)
+		}
+		l.Infof("[ProcessOcr] 判定策略: verifyId=%d, action=%s, score=%.2f, school=%s",
+			in.VerifyId, decision.Action, decision.Score, decision.School)
+	}
+
 	if updateErr := l.svcCtx.StudentVerificationModel.UpdateOcrResult(
 		l.ctx, in.VerifyId, ocrData); updateErr != nil {
 		l.Errorf("[ProcessOcr] 更新OCR结果失败: verifyId=%d, err=%v", in.VerifyId, updateErr)
@@ -298,6 +327,12 @@ func (l *ProcessOcrVerifyLogic) ProcessOcrVerify(in *pb.ProcessOcrVerifyReq) (*p
 
 		return nil, errorx.ErrDBError(updateErr)
 	}
+
+	// ==================== Step 7: 执行自动判定 ====================
+	if decision != nil && decision.Action != ocr.DecisionConfirm {
+		return l.applyDecision(in, decision)
+	}
+
 	publishVerifyProgress(
 		l.ctx,
 		l.svcCtx,
@@ -319,6 +354,106 @@ func (l *ProcessOcrVerifyLogic) ProcessOcrVerify(in *pb.ProcessOcrVerifyReq) (*p
 	}, nil
 }
 
+// applyDecision 执行自动判定结论（WaitConfirm → Passed/ManualReview/Rejected）
+// 状态流转复用 UpdateVerifyStatus（含过期时间计算、缓存清理与进度推送），并写入审核日志
+func (l *ProcessOcrVerifyLogic) applyDecision(
+	in *pb.ProcessOcrVerifyReq,
+	decision *ocr.Decision,
+) (*pb.ProcessOcrVerifyResp, error) {
+	var (
+		newStatus int8
+		action    string
+		message   string
+	)
+	switch decision.Action {
+	case ocr.DecisionApprove:
+		newStatus, action, message = constants.VerifyStatusPassed, constants.VerifyReviewActionAutoApprove, "认证自动通过"
+	case ocr.DecisionReject:
+		newStatus, action, message = constants.VerifyStatusRejected, constants.VerifyReviewActionAutoReject, "认证自动拒绝"
+	default:
+		newStatus, action, message = constants.VerifyStatusManualReview, constants.VerifyReviewActionAutoReview, "已转人工审核"
+	}
+
+	reason := decision.Summary()
+	if utf8.RuneCountInString(reason) > constants.VerifyRejectReasonMaxLen {
+		reason = string([]rune(reason)[:constants.VerifyRejectReasonMaxLen])
+	}
+
+	req := &pb.UpdateVerifyStatusReq{
+		VerifyId:  in.VerifyId,
+		UserId:    in.UserId,
+		NewStatus: int32(newStatus),
+		Operator:  constants.VerifyOperatorAutoPolicy,
+	}
+	if newStatus == constants.VerifyStatusRejected {
+		req.RejectReason = reason
+	}
+	if _, err := NewUpdateVerifyStatusLogic(l.ctx, l.svcCtx).UpdateVerifyStatus(req); err != nil {
+		// 判定执行失败时保留 WaitConfirm，由用户确认兜底，不影响本次识别结果
+		l.Errorf("[ProcessOcr] 执行自动判定失败，保留待确认: verifyId=%d, action=%s, err=%v",
+			in.VerifyId, decision.Action, err)
+		publishVerifyProgress(
+			l.ctx,
+			l.svcCtx,
+			in.UserId,
+			in.VerifyId,
+			constants.VerifyStatusWaitConfirm,
+			constants.VerifyOperatorOcrCallback,
+		)
+		l.deleteVerifyCache(in.UserId)
+		return &pb.ProcessOcrVerifyResp{
+			Success:      true,
+			ResultStatus: int32(constants.VerifyStatusWaitConfirm),
+			Message:      "OCR识别成功",
+		}, nil
+	}
+
+	writeReviewLog(l.ctx, l.svcCtx, in.VerifyId, in.UserId, 0, action, reason)
+	l.deleteVerifyCache(in.UserId)
+
+	l.Infof("[ProcessOcr] 处理成功: verifyId=%d, userId=%d → %s(%s)",
+		in.VerifyId, in.UserId, constants.GetVerifyStatusName(newStatus), decision.Action)
+
+	return &pb.ProcessOcrVerifyResp{
+		Success:      true,
+		ResultStatus: int32(newStatus),
+		Message:      message,
+	}, nil
+}
+
+// demoteIfStudentIDUsed 自动通过前校验学号唯一性（与申请、用户确认流程一致）
+// 学号已被其他账号认证或校验失败时转人工审核，避免同一张学生证自动通过多个账号
+func (l *ProcessOcrVerifyLogic) demoteIfStudentIDUsed(
+	userID int64,
+	decision *ocr.Decision,
+	schoolName, studentID string,
+) {
+	if decision.Action != ocr.DecisionApprove {
+		return
+	}
+
+	// 识别出的学校名称可能是别名/校区写法，同时按字典标准名称校验
+	schoolNames := []string{schoolName}
+	if decision.School != "" && decision.School != schoolName {
+		schoolNames = append(schoolNames, decision.School)
+	}
+	for _, name := range schoolNames {
+		exists, err := l.svcCtx.StudentVerificationModel.ExistsBySchoolAndStudentID(l.ctx, name, studentID, userID)
+		if err != nil {
+			l.Errorf("[ProcessOcr] 学号唯一性校验失败，转人工审核: userId=%d, err=%v", userID, err)
+			decision.Action = ocr.DecisionManualReview
+			decision.Reasons = append(decision.Reasons, "学号唯一性校验失败，转人工审核")
+			return
+		}
+		if exists {
+			l.Infof("[WARN] [ProcessOcr] 学号已被其他账号认证，转人工审核: userId=%d, school=%s", userID, name)
+			decision.Action = ocr.DecisionManualReview
+			decision.Reasons = append(decision.Reasons, "学号已被其他账号认证，转人工审核")
+			return
+		}
+	}
+}
+
 // isStatusChanged 检查认证记录的状态是否已经不是 OcrPending
 func (l *ProcessOcrVerifyLogic) isStatusChanged(verifyID int64) bool {
 	fresh, err := l.svcCtx.StudentVerificationModel.FindByID(l.ctx, verifyID)
@@ -0,0 +1,78 @@
+/**
+ * @projectName: CampusHub
+ * @package: verifyservicelogic
+ * @className: ReverifyStudentLogic
+ * @author: lijunqi
+ * @description: 认证过期后重新认证逻辑层
+ * @date: 2026-10-18
+ * @version: 1.0
+ */
+
+package verifyservicelogic
+
+import (
+	"context"
+
+	"activity-platform/app/user/rpc/internal/svc"
+	"activity-platform/app/user/rpc/pb/pb"
+	"activity-platform/common/constants"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+)
+
+// ReverifyStudentLogic 重新认证逻辑处理器
+type ReverifyStudentLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+// NewReverifyStudentLogic 创建重新认证逻辑实例
+func NewReverifyStudentLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ReverifyStudentLogic {
+	return &ReverifyStudentLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ReverifyStudent 认证过期后重新认证
+// 业务逻辑:
+//   - 仅已过期（9）状态可用，其余状态请走常规申请
+//   - 沿用上一次认证的姓名/学校/学号，院系/入学年份可选覆盖
+//   - 复用 ApplyStudentVerify（限流、唯一性校验、OCR 事件发布）
+func (l *ReverifyStudentLogic) ReverifyStudent(in *pb.ReverifyStudentReq) (*pb.ApplyStudentVerifyResp, error) {
+	// 1. 参数校验
+	if in.UserId <= 0 {
+		return nil, errorx.ErrInvalidParams("用户ID无效")
+	}
+
+	// 2. 查询原认证记录
+	verification, err := l.svcCtx.StudentVerificationModel.FindByUserID(l.ctx, in.UserId)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errorx.ErrVerifyNotFound()
+		}
+		l.Errorf("ReverifyStudent 查询记录失败: userId=%d, err=%v", in.UserId, err)
+		return nil, errorx.ErrDBError(err)
+	}
+	if verification.Status != constants.VerifyStatusExpired {
+		l.Infof("[WARN] ReverifyStudent 当前状态不允许重新认证: userId=%d, status=%d",
+			in.UserId, verification.Status)
+		return nil, errorx.ErrVerifyCannotApply()
+	}
+
+	// 3. 复用申请流程
+	return NewApplyStudentVerifyLogic(l.ctx, l.svcCtx).ApplyStudentVerify(&pb.ApplyStudentVerifyReq{
+		UserId:        in.UserId,
+		RealName:      verification.RealName,
+		SchoolName:    verification.SchoolName,
+		StudentId:     verification.StudentID,
+		Department:    GetOrDefault(in.Department, verification.Department),
+		AdmissionYear: GetOrDefault(in.AdmissionYear, verification.AdmissionYear),
+		FrontImageUrl: in.FrontImageUrl,
+		BackImageUrl:  in.BackImageUrl,
+	})
+}
@@ -0,0 +1,129 @@
+/**
+ * @projectName: CampusHub
+ * @package: verifyservicelogic
+ * @className: ReviewStudentVerifyLogic
+ * @author: lijunqi
+ * @description: 人工审核学生认证（通过/拒绝）逻辑层
+ * @date: 2026-10-18
+ * @version: 1.0
+ */
+
+package verifyservicelogic
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"activity-platform/app/user/rpc/internal/svc"
+	"activity-platform/app/user/rpc/pb/pb"
+	"activity-platform/common/constants"
+	"activity-platform/common/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+)
+
+// ReviewStudentVerifyLogic 人工审核学生认证逻辑处理器
+type ReviewStudentVerifyLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+// NewReviewStudentVerifyLogic 创建人工审核学生认证逻辑实例
+func NewReviewStudentVerifyLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ReviewStudentVerifyLogic {
+	return &ReviewStudentVerifyLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ReviewStudentVerify 人工审核学生认证
+// 业务逻辑:
+//   - 仅人工审核中（3）的记录可审核，拒绝时必须填写原因
+//   - 未领取的记录自动领取；已被他人领取时拒绝操作
+//   - 状态变更复用 UpdateVerifyStatus（含信用初始化、缓存清理、进度推送）
+//   - 审核完成后释放领取锁并写入审核日志
+func (l *ReviewStudentVerifyLogic) ReviewStudentVerify(in *pb.ReviewStudentVerifyReq) (*pb.ReviewStudentVerifyResp, error) {
+	// 1. 参数校验
+	reason := strings.TrimSpace(in.Reason)
+	if err := l.validateParams(in, reason); err != nil {
+		return nil, err
+	}
+
+	// 2. 查询认证记录
+	verification, err := l.svcCtx.StudentVerificationModel.FindByID(l.ctx, in.VerifyId)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errorx.ErrVerifyNotFound()
+		}
+		l.Errorf("ReviewStudentVerify 查询认证记录失败: verifyId=%d, err=%v", in.VerifyId, err)
+		return nil, errorx.ErrDBError(err)
+	}
+	if verification.Status != constants.VerifyStatusManualReview {
+		l.Infof("[WARN] ReviewStudentVerify 记录不在人工审核中: verifyId=%d, status=%d",
+			in.VerifyId, verification.Status)
+		return nil, errorx.ErrVerifyInvalidTransit()
+	}
+
+	// 3. 领取校验（未领取则自动领取）
+	owner, err := claimReview(l.ctx, l.svcCtx, in.VerifyId, in.ReviewerId)
+	if err != nil {
+		l.Errorf("ReviewStudentVerify 领取失败: verifyId=%d, reviewerId=%d, err=%v", in.VerifyId, in.ReviewerId, err)
+		return nil, errorx.ErrCacheError(err)
+	}
+	if owner != in.ReviewerId {
+		l.Infof("ReviewStudentVerify 已被他人领取: verifyId=%d, owner=%d, reviewerId=%d", in.VerifyId, owner, in.ReviewerId)
+		return nil, errorx.ErrVerifyReviewClaimed()
+	}
+
+	// 4. 执行状态变更
+	newStatus := constants.VerifyStatusRejected
+	action := constants.VerifyReviewActionReject
+	if in.Approve {
+		newStatus = constants.VerifyStatusPassed
+		action = constants.VerifyReviewActionApprove
+		reason = ""
+	}
+	resp, err := NewUpdateVerifyStatusLogic(l.ctx, l.svcCtx).UpdateVerifyStatus(&pb.UpdateVerifyStatusReq{
+		VerifyId:     in.VerifyId,
+		NewStatus:    int32(newStatus),
+		RejectReason: reason,
+		Operator:     constants.VerifyOperatorManualReview,
+		ReviewerId:   in.ReviewerId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 5. 释放领取锁并写审核日志
+	if _, err := releaseClaim(l.ctx, l.svcCtx, in.VerifyId, in.ReviewerId); err != nil {
+		l.Errorf("ReviewStudentVerify 释放领取锁失败: verifyId=%d, err=%v", in.VerifyId, err)
+	}
+	writeReviewLog(l.ctx, l.svcCtx, in.VerifyId, verification.UserID, in.ReviewerId, action, reason)
+
+	l.Infof("ReviewStudentVerify 审核完成: verifyId=%d, reviewerId=%d, action=%s",
+		in.VerifyId, in.ReviewerId, action)
+
+	return &pb.ReviewStudentVerifyResp{
+		BeforeStatus: resp.BeforeStatus,
+		AfterStatus:  resp.AfterStatus,
+	}, nil
+}
+
+// validateParams 参数校验
+func (l *ReviewStudentVerifyLogic) validateParams(in *pb.ReviewStudentVerifyReq, reason string) error {
+	if in.VerifyId <= 0 || in.ReviewerId <= 0 {
+		l.Errorf("ReviewStudentVerify 参数错误: verifyId=%d, reviewerId=%d", in.VerifyId, in.ReviewerId)
+		return errorx.ErrInvalidParams("认证ID或审核员ID无效")
+	}
+	if !in.Approve && reason == "" {
+		return errorx.ErrInvalidParams("拒绝时必须填写原因")
+	}
+	if utf8.RuneCountInString(reason) > constants.VerifyRejectReasonMaxLen {
+		return errorx.ErrInvalidParams("拒绝原因过长")
+	}
+	return nil
+}
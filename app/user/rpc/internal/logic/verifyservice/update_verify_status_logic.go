@@ -14,6 +14,7 @@ import (
 	"context"
 	"time"
 
+	"activity-platform/app/user/model"
 	"activity-platform/app/user/rpc/internal/svc"
 	"activity-platform/app/user/rpc/pb/pb"
 	"activity-platform/common/constants"
@@ -73,7 +74,7 @@ func (l *UpdateVerifyStatusLogic) UpdateVerifyStatus(in *pb.UpdateVerifyStatusRe
 	}
 
 	// 5. 根据新状态执行不同的更新逻辑
-	if err := l.executeStatusUpdate(in, newStatus); err != nil {
+	if err := l.executeStatusUpdate(in, verification, newStatus); err != nil {
 		return nil, err
 	}
 
@@ -100,7 +101,7 @@ func (l *UpdateVerifyStatusLogic) validateParams(in *pb.UpdateVerifyStatusReq) e
 		l.Errorf("UpdateVerifyStatus 参数错误: verifyId=%d", in.VerifyId)
 		return errorx.ErrInvalidParams("认证ID无效")
 	}
-	if in.NewStatus < 0 || in.NewStatus > 8 {
+	if in.NewStatus < 0 || in.NewStatus > int32(constants.VerifyStatusExpired) {
 		l.Errorf("UpdateVerifyStatus 参数错误: newStatus=%d", in.NewStatus)
 		return errorx.ErrInvalidParams("无效的状态值")
 	}
@@ -119,14 +120,18 @@ func (l *UpdateVerifyStatusLogic) handleFindError(err error, verifyID int64) err
 
 // executeStatusUpdate 执行状态更新
 // 根据目标状态分发到不同的处理函数
-func (l *UpdateVerifyStatusLogic) executeStatusUpdate(in *pb.UpdateVerifyStatusReq, newStatus int8) error {
+func (l *UpdateVerifyStatusLogic) executeStatusUpdate(
+	in *pb.UpdateVerifyStatusReq,
+	verification *model.StudentVerification,
+	newStatus int8,
+) error {
 	switch newStatus {
 	case constants.VerifyStatusWaitConfirm:
 		// 状态2: OCR识别成功，等待用户确认
 		return l.handleOcrSuccess(in)
 	case constants.VerifyStatusPassed:
 		// 状态4: 认证通过（用户确认无误/人工审核通过）
-		return l.handlePassed(in, newStatus)
+		return l.handlePassed(in, verification, newStatus)
 	case constants.VerifyStatusRejected:
 		// 状态5: 认证拒绝（人工审核拒绝）
 		return l.handleRejected(in, newStatus)
@@ -136,6 +141,9 @@ func (l *UpdateVerifyStatusLogic) executeStatusUpdate(in *pb.UpdateVerifyStatusR
 	case constants.VerifyStatusOcrFailed:
 		// 状态8: OCR失败（双OCR都失败）
 		return l.handleOcrFailed(in, newStatus)
+	case constants.VerifyStatusExpired:
+		// 状态9: 认证过期（超过预计毕业时间）
+		return l.handleExpired(in, verification.UserID, newStatus)
 	default:
 		// 其他状态：通用更新处理
 		return l.handleDefault(in, newStatus)
@@ -169,12 +177,18 @@ func (l *UpdateVerifyStatusLogic) handleOcrSuccess(in *pb.UpdateVerifyStatusReq)
 }
 
 // handlePassed 处理认证通过
-func (l *UpdateVerifyStatusLogic) handlePassed(in *pb.UpdateVerifyStatusReq, newStatus int8) error {
+func (l *UpdateVerifyStatusLogic) handlePassed(
+	in *pb.UpdateVerifyStatusReq,
+	verification *model.StudentVerification,
+	newStatus int8,
+) error {
+	userID := verification.UserID
 	ctx := &StatusUpdateContext{
 		VerifyID:   in.VerifyId,
 		NewStatus:  newStatus,
 		Operator:   in.Operator,
-		ReviewerID: in.UserId,
+		ReviewerID: in.ReviewerId,
+		ExpireAt:   CalcVerifyExpireAt(l.svcCtx.Config.VerifyExpiry, verification.AdmissionYear),
 	}
 	updates := BuildPassedUpdates(ctx)
 
@@ -185,12 +199,11 @@ func (l *UpdateVerifyStatusLogic) handlePassed(in *pb.UpdateVerifyStatusReq, new
 	}
 
 	// 认证通过后，删除认证状态缓存，确保下次查询获取最新状态
-	if in.UserId > 0 {
-		if err := l.svcCtx.VerifyCache.Delete(l.ctx, in.UserId); err != nil {
-			l.Errorf("UpdateVerifyStatus 删除认证缓存失败: userId=%d, err=%v", in.UserId, err)
-		} else {
-			l.Infof("UpdateVerifyStatus 已删除认证缓存: userId=%d", in.UserId)
-		}
+	// 使用数据库记录中的 user_id（人工审核调用时请求中的 user_id 可能为空）
+	if err := l.svcCtx.VerifyCache.Delete(l.ctx, userID); err != nil {
+		l.Errorf("UpdateVerifyStatus 删除认证缓存失败: userId=%d, err=%v", userID, err)
+	} else {
+		l.Infof("UpdateVerifyStatus 已删除认证缓存: userId=%d", userID)
 	}
 
 	l.Infof("UpdateVerifyStatus 认证通过: verifyId=%d, operator=%s", in.VerifyId, in.Operator)
@@ -204,7 +217,7 @@ func (l *UpdateVerifyStatusLogic) handleRejected(in *pb.UpdateVerifyStatusReq, n
 		NewStatus:    newStatus,
 		Operator:     in.Operator,
 		RejectReason: in.RejectReason,
-		ReviewerID:   in.UserId,
+		ReviewerID:   in.ReviewerId,
 	}
 	updates := BuildRejectedUpdates(ctx)
 
@@ -241,6 +254,22 @@ func (l *UpdateVerifyStatusLogic) handleOcrFailed(in *pb.UpdateVerifyStatusReq,
 	return nil
 }
 
+// handleExpired 处理认证过期
+// 过期后用户不再视为已认证，需删除认证状态缓存
+func (l *UpdateVerifyStatusLogic) handleExpired(in *pb.UpdateVerifyStatusReq, userID int64, newStatus int8) error {
+	updates := map[string]interface{}{"operator": in.Operator}
+	if err := l.svcCtx.StudentVerificationModel.UpdateStatus(
+		l.ctx, in.VerifyId, newStatus, updates); err != nil {
+		l.Errorf("UpdateVerifyStatus 更新状态失败: verifyId=%d, err=%v", in.VerifyId, err)
+		return errorx.ErrDBError(err)
+	}
+	if err := l.svcCtx.VerifyCache.Delete(l.ctx, userID); err != nil {
+		l.Errorf("UpdateVerifyStatus 删除认证缓存失败: userId=%d, err=%v", userID, err)
+	}
+	l.Infof("UpdateVerifyStatus 认证过期: verifyId=%d, userId=%d", in.VerifyId, userID)
+	return nil
+}
+
 // handleDefault 处理其他状态
 func (l *UpdateVerifyStatusLogic) handleDefault(in *pb.UpdateVerifyStatusReq, newStatus int8) error {
 	updates := map[string]interface{}{"operator": in.Operator}
@@ -0,0 +1,104 @@
+/**
+ * @projectName: CampusHub
+ * @package: verifyservicelogic
+ * @className: verify_expiry_helper
+ * @author: lijunqi
+ * @description: 学生认证过期策略辅助函数（过期时间推算、过期事件发布）
+ * @date: 2026-10-18
+ * @version: 1.0
+ */
+
+package verifyservicelogic
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"activity-platform/app/user/rpc/internal/config"
+	"activity-platform/app/user/rpc/internal/svc"
+	"activity-platform/common/constants"
+	"activity-platform/common/messaging"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// CalcVerifyExpireAt 根据入学年份推算认证过期时间（预计毕业时间 + 宽限期）
+// 过期策略未启用或入学年份无法解析时返回 nil（表示不过期）
+//
+// 示例（学制4年，7月毕业，宽限0天）: "2023" -> 2027-07-31 23:59:59
+func CalcVerifyExpireAt(conf config.VerifyExpiryConf, admissionYear string) *time.Time {
+	if !conf.Enabled {
+		return nil
+	}
+	year, ok := parseAdmissionYear(admissionYear)
+	if !ok {
+		return nil
+	}
+
+	programYears := conf.ProgramYears
+	if programYears <= 0 {
+		programYears = constants.VerifyProgramYears
+	}
+	month := conf.GraduationMonth
+	if month < 1 || month > 12 {
+		month = constants.VerifyGraduationMonth
+	}
+
+	// 毕业月份的最后一秒：下月1日零点 - 1秒
+	firstOfNext := time.Date(year+programYears, time.Month(month)+1, 1, 0, 0, 0, 0, time.Local)
+	expireAt := firstOfNext.Add(-time.Second)
+	if conf.GraceDays > 0 {
+		expireAt = expireAt.AddDate(0, 0, conf.GraceDays)
+	}
+	return &expireAt
+}
+
+// parseAdmissionYear 解析入学年份（兼容 "2023"、"2023级"、"2023年" 等格式）
+func parseAdmissionYear(admissionYear string) (int, bool) {
+	s := strings.TrimSpace(admissionYear)
+	if len(s) < 4 {
+		return 0, false
+	}
+	year, err := strconv.Atoi(s[:4])
+	if err != nil || year < 1950 || year > time.Now().Year()+1 {
+		return 0, false
+	}
+	return year, true
+}
+
+// PublishVerifyExpiryEvent 发布认证过期事件（best-effort，失败只记录日志），返回是否发布成功
+func PublishVerifyExpiryEvent(
+	ctx context.Context,
+	svcCtx *svc.ServiceContext,
+	userID, verifyID int64,
+	eventType string,
+	expireAt time.Time,
+) bool {
+	logger := logx.WithContext(ctx)
+	if svcCtx.MsgClient == nil {
+		logger.Infof("[VerifyExpiry] 消息客户端未初始化，跳过发布: userId=%d, verifyId=%d, type=%s",
+			userID, verifyID, eventType)
+		return false
+	}
+
+	payload, err := json.Marshal(messaging.VerifyExpiryEventData{
+		UserID:    userID,
+		VerifyID:  verifyID,
+		Type:      eventType,
+		ExpireAt:  expireAt.Unix(),
+		Timestamp: time.Now().Unix(),
+	})
+	if err != nil {
+		logger.Errorf("[VerifyExpiry] 序列化失败: verifyId=%d, err=%v", verifyID, err)
+		return false
+	}
+	if err := svcCtx.MsgClient.Publish(ctx, messaging.TopicVerifyExpiry, payload); err != nil {
+		logger.Errorf("[VerifyExpiry] 发布失败: userId=%d, verifyId=%d, type=%s, err=%v",
+			userID, verifyID, eventType, err)
+		return false
+	}
+	return true
+}
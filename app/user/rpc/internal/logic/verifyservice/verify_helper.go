@@ -92,10 +92,13 @@ func BuildVerifyInfo(v *model.StudentVerification) *pb.GetVerifyInfoResp {
 		return &pb.GetVerifyInfoResp{IsVerified: false}
 	}
 
-	var verifiedAt int64
+	var verifiedAt, expireAt int64
 	if v.VerifiedAt != nil {
 		verifiedAt = v.VerifiedAt.Unix()
 	}
+	if v.ExpireAt != nil {
+		expireAt = v.ExpireAt.Unix()
+	}
 
 	return &pb.GetVerifyInfoResp{
 		IsVerified:    true,
@@ -106,6 +109,7 @@ func BuildVerifyInfo(v *model.StudentVerification) *pb.GetVerifyInfoResp {
 		Department:    v.Department,
 		AdmissionYear: v.AdmissionYear,
 		VerifiedAt:    verifiedAt,
+		ExpireAt:      expireAt,
 	}
 }
 
@@ -114,11 +118,18 @@ func BuildIsVerifiedResp(v *model.StudentVerification) *pb.IsVerifiedResp {
 	if v == nil || v.Status != constants.VerifyStatusPassed {
 		return &pb.IsVerifiedResp{IsVerified: false}
 	}
+	// 已到过期时间但过期任务尚未处理，同样视为未认证
+	if v.ExpireAt != nil && !time.Now().Before(*v.ExpireAt) {
+		return &pb.IsVerifiedResp{IsVerified: false}
+	}
 
-	var verifiedAt int64
+	var verifiedAt, expireAt int64
 	if v.VerifiedAt != nil {
 		verifiedAt = v.VerifiedAt.Unix()
 	}
+	if v.ExpireAt != nil {
+		expireAt = v.ExpireAt.Unix()
+	}
 
 	return &pb.IsVerifiedResp{
 		IsVerified:    true,
@@ -127,6 +138,7 @@ func BuildIsVerifiedResp(v *model.StudentVerification) *pb.IsVerifiedResp {
 		Department:    v.Department,
 		AdmissionYear: v.AdmissionYear,
 		VerifiedAt:    verifiedAt,
+		ExpireAt:      expireAt,
 	}
 }
 
@@ -165,14 +177,17 @@ type StatusUpdateContext struct {
 	OcrData      *pb.VerifyOcrData
 	RejectReason string
 	ReviewerID   int64
+	ExpireAt     *time.Time
 }
 
 // BuildPassedUpdates 构建通过状态的更新字段
 func BuildPassedUpdates(ctx *StatusUpdateContext) map[string]interface{} {
 	now := time.Now()
 	updates := map[string]interface{}{
-		"verified_at": &now,
-		"operator":    ctx.Operator,
+		"verified_at":        &now,
+		"operator":           ctx.Operator,
+		"expire_at":          ctx.ExpireAt,
+		"expiry_reminded_at": nil,
 	}
 	if ctx.Operator == constants.VerifyOperatorManualReview {
 		updates["reviewed_at"] = &now
@@ -0,0 +1,174 @@
+/**
+ * @projectName: CampusHub
+ * @package: verifyservicelogic
+ * @className: verify_review_helper
+ * @author: lijunqi
+ * @description: 学生认证人工审核公共辅助函数（领取锁、签名URL、审核日志）
+ * @date: 2026-10-18
+ * @version: 1.0
+ */
+
+package verifyservicelogic
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"activity-platform/app/user/model"
+	"activity-platform/app/user/rpc/internal/svc"
+	"activity-platform/common/constants"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/qiniu/go-sdk/v7/auth/qbox"
+	"github.com/qiniu/go-sdk/v7/storage"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// ============================================================================
+// 领取锁（Redis）
+// ============================================================================
+//
+// Key: verify:review:claim:{verifyId}  Value: reviewerId  TTL: 15 分钟
+// 领取人可重复领取以续期；审核完成或主动释放后删除。
+
+// releaseClaimScript 仅当领取人匹配时删除（避免误删他人领取）
+var releaseClaimScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// reviewClaimKey 领取锁Key
+func reviewClaimKey(verifyID int64) string {
+	return fmt.Sprintf("%s%d", constants.VerifyReviewClaimPrefix, verifyID)
+}
+
+// claimReview 领取审核任务
+// 返回当前领取人ID：等于 reviewerID 表示领取成功（或续期成功）
+func claimReview(ctx context.Context, svcCtx *svc.ServiceContext, verifyID, reviewerID int64) (int64, error) {
+	key := reviewClaimKey(verifyID)
+	ttl := time.Duration(constants.VerifyReviewClaimTTL) * time.Second
+
+	ok, err := svcCtx.Redis.SetNX(ctx, key, reviewerID, ttl).Result()
+	if err != nil {
+		return 0, err
+	}
+	if ok {
+		return reviewerID, nil
+	}
+
+	owner, err := getClaimOwner(ctx, svcCtx, verifyID)
+	if err != nil {
+		return 0, err
+	}
+	if owner == reviewerID {
+		// 本人重复领取：续期
+		if err := svcCtx.Redis.Expire(ctx, key, ttl).Err(); err != nil {
+			return 0, err
+		}
+	}
+	return owner, nil
+}
+
+// releaseClaim 释放审核任务（仅领取人本人可释放）
+// 返回是否确实删除了领取锁：非领取人或领取已过期时为 false
+func releaseClaim(ctx context.Context, svcCtx *svc.ServiceContext, verifyID, reviewerID int64) (bool, error) {
+	deleted, err := releaseClaimScript.Run(ctx, svcCtx.Redis,
+		[]string{reviewClaimKey(verifyID)}, strconv.FormatInt(reviewerID, 10)).Int64()
+	if err != nil {
+		return false, err
+	}
+	return deleted > 0, nil
+}
+
+// getClaimOwner 查询当前领取人（0=未领取）
+func getClaimOwner(ctx context.Context, svcCtx *svc.ServiceContext, verifyID int64) (int64, error) {
+	val, err := svcCtx.Redis.Get(ctx, reviewClaimKey(verifyID)).Result()
+	if err == redis.Nil {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	owner, _ := strconv.ParseInt(val, 10, 64)
+	return owner, nil
+}
+
+// batchGetClaimOwners 批量查询领取人（失败时返回空映射，不影响列表展示）
+func batchGetClaimOwners(ctx context.Context, svcCtx *svc.ServiceContext, verifyIDs []int64) map[int64]int64 {
+	owners := make(map[int64]int64, len(verifyIDs))
+	if len(verifyIDs) == 0 {
+		return owners
+	}
+	keys := make([]string, 0, len(verifyIDs))
+	for _, id := range verifyIDs {
+		keys = append(keys, reviewClaimKey(id))
+	}
+	vals, err := svcCtx.Redis.MGet(ctx, keys...).Result()
+	if err != nil {
+		logx.WithContext(ctx).Errorf("[VerifyReview] 批量查询领取人失败: err=%v", err)
+		return owners
+	}
+	for i, val := range vals {
+		if s, ok := val.(string); ok {
+			owner, _ := strconv.ParseInt(s, 10, 64)
+			owners[verifyIDs[i]] = owner
+		}
+	}
+	return owners
+}
+
+// ============================================================================
+// 学生证图片签名URL
+// ============================================================================
+
+// signImageURL 生成带时效的私有空间下载URL
+// 未配置七牛密钥或URL无法解析时原样返回
+func signImageURL(svcCtx *svc.ServiceContext, rawURL string) string {
+	qConfig := svcCtx.Config.Qiniu
+	if rawURL == "" || qConfig.AccessKey == "" || qConfig.SecretKey == "" {
+		return rawURL
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" {
+		return rawURL
+	}
+	key := strings.TrimPrefix(u.Path, "/")
+	if key == "" {
+		return rawURL
+	}
+
+	domain := u.Scheme + "://" + u.Host
+	mac := qbox.NewMac(qConfig.AccessKey, qConfig.SecretKey)
+	deadline := time.Now().Add(time.Duration(constants.VerifyReviewImageURLExpire) * time.Second).Unix()
+	return storage.MakePrivateURLv2(mac, domain, key, deadline)
+}
+
+// ============================================================================
+// 审核日志
+// ============================================================================
+
+// writeReviewLog 写入审核日志（失败只记录日志，不影响主流程）
+func writeReviewLog(
+	ctx context.Context,
+	svcCtx *svc.ServiceContext,
+	verifyID, userID, reviewerID int64,
+	action, reason string,
+) {
+	err := svcCtx.VerifyReviewLogModel.Create(ctx, &model.VerifyReviewLog{
+		VerifyID:   verifyID,
+		UserID:     userID,
+		ReviewerID: reviewerID,
+		Action:     action,
+		Reason:     reason,
+	})
+	if err != nil {
+		logx.WithContext(ctx).Errorf("[VerifyReview] 写入审核日志失败: verifyId=%d, reviewerId=%d, action=%s, err=%v",
+			verifyID, reviewerID, action, err)
+	}
+}
@@ -58,3 +58,33 @@ func (s *CreditServiceServer) UpdateScore(ctx context.Context, in *pb.UpdateScor
 	l := creditservicelogic.NewUpdateScoreLogic(ctx, s.svcCtx)
 	return l.UpdateScore(in)
 }
+
+// SubmitCreditAppeal 提交信用申诉
+func (s *CreditServiceServer) SubmitCreditAppeal(ctx context.Context, in *pb.SubmitCreditAppealReq) (*pb.SubmitCreditAppealResp, error) {
+	l := creditservicelogic.NewSubmitCreditAppealLogic(ctx, s.svcCtx)
+	return l.SubmitCreditAppeal(in)
+}
+
+// ListCreditAppeals 查询申诉列表
+func (s *CreditServiceServer) ListCreditAppeals(ctx context.Context, in *pb.ListCreditAppealsReq) (*pb.ListCreditAppealsResp, error) {
+	l := creditservicelogic.NewListCreditAppealsLogic(ctx, s.svcCtx)
+	return l.ListCreditAppeals(in)
+}
+
+// ReviewCreditAppeal 处理信用申诉
+func (s *CreditServiceServer) ReviewCreditAppeal(ctx context.Context, in *pb.ReviewCreditAppealReq) (*pb.ReviewCreditAppealResp, error) {
+	l := creditservicelogic.NewReviewCreditAppealLogic(ctx, s.svcCtx)
+	return l.ReviewCreditAppeal(in)
+}
+
+// AdminAdjustScore 管理员手动调整信用分
+func (s *CreditServiceServer) AdminAdjustScore(ctx context.Context, in *pb.AdminAdjustScoreReq) (*pb.AdminAdjustScoreResp, error) {
+	l := creditservicelogic.NewAdminAdjustScoreLogic(ctx, s.svcCtx)
+	return l.AdminAdjustScore(in)
+}
+
+// ListCreditAudits 查询信用管理审计日志
+func (s *CreditServiceServer) ListCreditAudits(ctx context.Context, in *pb.ListCreditAuditsReq) (*pb.ListCreditAuditsResp, error) {
+	l := creditservicelogic.NewListCreditAuditsLogic(ctx, s.svcCtx)
+	return l.ListCreditAudits(in)
+}
@@ -49,3 +49,27 @@ func (s *TagServiceServer) GetAllInterestTags(ctx context.Context, in *pb.GetAll
 	l := tagservicelogic.NewGetAllInterestTagsLogic(ctx, s.svcCtx)
 	return l.GetAllInterestTags(in)
 }
+
+// ==================== 标签管理（管理员，经活动服务调用）====================
+func (s *TagServiceServer) CreateInterestTag(ctx context.Context, in *pb.CreateInterestTagReq) (*pb.InterestTagResp, error) {
+	l := tagservicelogic.NewCreateInterestTagLogic(ctx, s.svcCtx)
+	return l.CreateInterestTag(in)
+}
+
+// 修改兴趣标签（重命名/颜色/图标/描述，空字段不修改）
+func (s *TagServiceServer) UpdateInterestTag(ctx context.Context, in *pb.UpdateInterestTagReq) (*pb.InterestTagResp, error) {
+	l := tagservicelogic.NewUpdateInterestTagLogic(ctx, s.svcCtx)
+	return l.UpdateInterestTag(in)
+}
+
+// 启用/禁用兴趣标签
+func (s *TagServiceServer) SetInterestTagStatus(ctx context.Context, in *pb.SetInterestTagStatusReq) (*pb.InterestTagResp, error) {
+	l := tagservicelogic.NewSetInterestTagStatusLogic(ctx, s.svcCtx)
+	return l.SetInterestTagStatus(in)
+}
+
+// 合并兴趣标签（用户关联改绑到目标标签，源标签禁用）
+func (s *TagServiceServer) MergeInterestTags(ctx context.Context, in *pb.MergeInterestTagsReq) (*pb.MergeInterestTagsResp, error) {
+	l := tagservicelogic.NewMergeInterestTagsLogic(ctx, s.svcCtx)
+	return l.MergeInterestTags(in)
+}
@@ -41,6 +41,12 @@ func (s *VerifyServiceServer) IsVerified(ctx context.Context, in *pb.IsVerifiedR
 	return l.IsVerified(in)
 }
 
+// BatchGetVerifyProfiles 批量查询已认证用户的院系与入学年份
+func (s *VerifyServiceServer) BatchGetVerifyProfiles(ctx context.Context, in *pb.BatchGetVerifyProfilesReq) (*pb.BatchGetVerifyProfilesResp, error) {
+	l := verifyservicelogic.NewBatchGetVerifyProfilesLogic(ctx, s.svcCtx)
+	return l.BatchGetVerifyProfiles(in)
+}
+
 // ApplyStudentVerify 提交学生认证申请
 func (s *VerifyServiceServer) ApplyStudentVerify(ctx context.Context, in *pb.ApplyStudentVerifyReq) (*pb.ApplyStudentVerifyResp, error) {
 	l := verifyservicelogic.NewApplyStudentVerifyLogic(ctx, s.svcCtx)
@@ -59,6 +65,12 @@ func (s *VerifyServiceServer) CancelStudentVerify(ctx context.Context, in *pb.Ca
 	return l.CancelStudentVerify(in)
 }
 
+// ReverifyStudent 过期后重新认证
+func (s *VerifyServiceServer) ReverifyStudent(ctx context.Context, in *pb.ReverifyStudentReq) (*pb.ApplyStudentVerifyResp, error) {
+	l := verifyservicelogic.NewReverifyStudentLogic(ctx, s.svcCtx)
+	return l.ReverifyStudent(in)
+}
+
 // UpdateVerifyStatus 更新认证状态
 func (s *VerifyServiceServer) UpdateVerifyStatus(ctx context.Context, in *pb.UpdateVerifyStatusReq) (*pb.UpdateVerifyStatusResp, error) {
 	l := verifyservicelogic.NewUpdateVerifyStatusLogic(ctx, s.svcCtx)
@@ -70,3 +82,27 @@ func (s *VerifyServiceServer) ProcessOcrVerify(ctx context.Context, in *pb.Proce
 	l := verifyservicelogic.NewProcessOcrVerifyLogic(ctx, s.svcCtx)
 	return l.ProcessOcrVerify(in)
 }
+
+// ListVerifyReviews 分页查询人工审核队列
+func (s *VerifyServiceServer) ListVerifyReviews(ctx context.Context, in *pb.ListVerifyReviewsReq) (*pb.ListVerifyReviewsResp, error) {
+	l := verifyservicelogic.NewListVerifyReviewsLogic(ctx, s.svcCtx)
+	return l.ListVerifyReviews(in)
+}
+
+// ClaimVerifyReview 领取/释放审核任务
+func (s *VerifyServiceServer) ClaimVerifyReview(ctx context.Context, in *pb.ClaimVerifyReviewReq) (*pb.ClaimVerifyReviewResp, error) {
+	l := verifyservicelogic.NewClaimVerifyReviewLogic(ctx, s.svcCtx)
+	return l.ClaimVerifyReview(in)
+}
+
+// ReviewStudentVerify 人工审核通过/拒绝
+func (s *VerifyServiceServer) ReviewStudentVerify(ctx context.Context, in *pb.ReviewStudentVerifyReq) (*pb.ReviewStudentVerifyResp, error) {
+	l := verifyservicelogic.NewReviewStudentVerifyLogic(ctx, s.svcCtx)
+	return l.ReviewStudentVerify(in)
+}
+
+// GetVerifyReviewStats 审核员处理量统计
+func (s *VerifyServiceServer) GetVerifyReviewStats(ctx context.Context, in *pb.GetVerifyReviewStatsReq) (*pb.GetVerifyReviewStatsResp, error) {
+	l := verifyservicelogic.NewGetVerifyReviewStatsLogic(ctx, s.svcCtx)
+	return l.GetVerifyReviewStats(in)
+}
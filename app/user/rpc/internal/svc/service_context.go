@@ -66,15 +66,27 @@ type ServiceContext struct {
 	// CreditLogModel 信用变更记录数据访问层
 	CreditLogModel model.ICreditLogModel
 
+	// CreditAppealModel 信用申诉数据访问层
+	CreditAppealModel model.ICreditAppealModel
+
+	// CreditAuditLogModel 信用管理审计日志数据访问层
+	CreditAuditLogModel model.ICreditAuditLogModel
+
 	// StudentVerificationModel 学生认证数据访问层
 	StudentVerificationModel model.IStudentVerificationModel
 
+	// VerifyReviewLogModel 学生认证人工审核日志数据访问层
+	VerifyReviewLogModel model.IVerifyReviewLogModel
+
 	// SensitiveCodec 学生认证敏感字段编解码器
 	SensitiveCodec model.SensitiveDataCodec
 
 	// SysImageModel 图片资源中心数据访问层
 	SysImageModel model.ISysImageModel
 
+	// StatsDailyMetricModel 平台统计日指标数据访问层
+	StatsDailyMetricModel model.IStatsDailyMetricModel
+
 	// ==================== RPC 服务 ====================
 
 	// ActivityRpc 活动服务 RPC 客户端
@@ -84,6 +96,8 @@ type ServiceContext struct {
 
 	// OcrFactory OCR提供商工厂
 	OcrFactory *ocr.ProviderFactory
+	// OcrPolicy OCR识别结果判定策略（未启用时为 nil）
+	OcrPolicy *ocr.Policy
 
 	// ==================== 消息客户端 ====================
 
@@ -110,8 +124,15 @@ func NewServiceContext(c config.Config) (*ServiceContext, error) {
 	// 初始化OCR工厂（可选，失败不影响服务启动）
 	ocrFactory := initOcrFactory(c, rdb)
 
+	// 初始化OCR判定策略（可选，配置错误直接阻断启动）
+	ocrPolicy, err := initOcrPolicy(c.Ocr.Policy)
+	if err != nil {
+		logx.Errorf("OCR判定策略初始化失败: %v", err)
+		return nil, err
+	}
+
 	// 初始化敏感数据编解码器（必填，失败直接阻断启动）
-	sensitiveCodec, err := sensitivedata.New(c.SensitiveData.AesKey, c.SensitiveData.HashKey)
+	sensitiveCodec, err := initSensitiveCodec(c.SensitiveData)
 	if err != nil {
 		logx.Errorf("敏感数据编解码器初始化失败: %v", err)
 		return nil, err
@@ -151,15 +172,20 @@ func NewServiceContext(c config.Config) (*ServiceContext, error) {
 		InterestTagModel:          model.NewInterestTagModel(db),
 		UserCreditModel:           model.NewUserCreditModel(db),
 		CreditLogModel:            model.NewCreditLogModel(db),
+		CreditAppealModel:         model.NewCreditAppealModel(db),
+		CreditAuditLogModel:       model.NewCreditAuditLogModel(db),
 		StudentVerificationModel:  studentVerificationModel,
+		VerifyReviewLogModel:      model.NewVerifyReviewLogModel(db),
 		SensitiveCodec:            sensitiveCodec,
 		SysImageModel:             model.NewSysImageModel(db),
+		StatsDailyMetricModel:     model.NewStatsDailyMetricModel(db),
 
 		// 注入 RPC 客户端（可能为 nil）
 		ActivityRpc: activityRpc,
 
 		// 注入 OCR 工厂
 		OcrFactory: ocrFactory,
+		OcrPolicy:  ocrPolicy,
 
 		// 注入消息客户端
 		MsgClient: msgClient,
@@ -250,13 +276,89 @@ func initMsgPublisher(c config.Config) *messaging.Client {
 	return client
 }
 
+// initSensitiveCodec 初始化敏感数据编解码器
+// AesKey/HashKey 作为 legacy 密钥加入密钥环，未指定当前密钥ID时使用 legacy
+func initSensitiveCodec(c config.SensitiveDataConf) (*sensitivedata.Codec, error) {
+	ring := sensitivedata.Keyring{
+		ActiveKeyID:     c.ActiveKeyId,
+		AesKeys:         make(map[string]string, len(c.Keys)+1),
+		ActiveHashKeyID: c.ActiveHashKeyId,
+		HashKeys:        make(map[string]string, len(c.HashKeys)+1),
+	}
+	if c.AesKey != "" {
+		ring.AesKeys[sensitivedata.LegacyKeyID] = c.AesKey
+	}
+	if c.HashKey != "" {
+		ring.HashKeys[sensitivedata.LegacyKeyID] = c.HashKey
+	}
+	for _, k := range c.Keys {
+		ring.AesKeys[k.Id] = k.Key
+	}
+	for _, k := range c.HashKeys {
+		ring.HashKeys[k.Id] = k.Key
+	}
+	if ring.ActiveKeyID == "" {
+		ring.ActiveKeyID = sensitivedata.LegacyKeyID
+	}
+	if ring.ActiveHashKeyID == "" {
+		ring.ActiveHashKeyID = sensitivedata.LegacyKeyID
+	}
+
+	codec, err := sensitivedata.NewWithKeyring(ring)
+	if err != nil {
+		return nil, err
+	}
+	logx.Infof("敏感数据编解码器初始化成功: 加密密钥=%s(共%d个)，哈希密钥=%s(共%d个)",
+		ring.ActiveKeyID, len(ring.AesKeys), ring.ActiveHashKeyID, len(ring.HashKeys))
+	return codec, nil
+}
+
+// initOcrPolicy 初始化OCR判定策略
+func initOcrPolicy(c config.OcrPolicyConf) (*ocr.Policy, error) {
+	if !c.Enabled {
+		return nil, nil
+	}
+
+	schools := make([]ocr.SchoolPolicy, 0, len(c.Schools))
+	for _, s := range c.Schools {
+		schools = append(schools, ocr.SchoolPolicy{
+			Name:             s.Name,
+			Aliases:          s.Aliases,
+			StudentIDPattern: s.StudentIdPattern,
+			Thresholds: ocr.Thresholds{
+				AutoApproveScore: s.AutoApproveScore,
+				ConfirmScore:     s.ConfirmScore,
+				RejectScore:      s.RejectScore,
+			},
+			DisableAutoApprove: s.DisableAutoApprove,
+		})
+	}
+
+	policy, err := ocr.NewPolicy(ocr.PolicyConfig{
+		Thresholds: ocr.Thresholds{
+			AutoApproveScore: c.AutoApproveScore,
+			ConfirmScore:     c.ConfirmScore,
+			RejectScore:      c.RejectScore,
+		},
+		MaxStudyYears: c.MaxStudyYears,
+		Schools:       schools,
+	})
+	if err != nil {
+		return nil, err
+	}
+	logx.Infof("OCR判定策略初始化成功: 已知学校%d所，阈值 %.0f/%.0f/%.0f",
+		len(schools), c.AutoApproveScore, c.ConfirmScore, c.RejectScore)
+	return policy, nil
+}
+
 // initOcrFactory 初始化OCR工厂
+// 注册所有提供商后按 Ocr.Providers 的名称与权重构建路由
 func initOcrFactory(c config.Config, rdb *redis.Client) *ocr.ProviderFactory {
-	var primary, fallback ocr.Provider
+	registry := ocr.NewRegistry()
 
-	// 初始化腾讯云OCR（主提供商）
-	if c.Ocr.Tencent.Enabled {
-		tencentProvider, err := ocr.NewTencentProvider(ocr.TencentConfig{
+	// 腾讯云OCR
+	registry.Register(ocr.ProviderNameTencent, func() (ocr.Provider, error) {
+		return ocr.NewTencentProvider(ocr.TencentConfig{
 			Enabled:   c.Ocr.Tencent.Enabled,
 			SecretId:  c.Ocr.Tencent.SecretId,
 			SecretKey: c.Ocr.Tencent.SecretKey,
@@ -264,41 +366,68 @@ func initOcrFactory(c config.Config, rdb *redis.Client) *ocr.ProviderFactory {
 			Endpoint:  c.Ocr.Tencent.Endpoint,
 			Timeout:   c.Ocr.Tencent.Timeout,
 		})
-		if err != nil {
-			logx.Errorf("初始化腾讯云OCR失败: %v", err)
-		} else {
-			primary = tencentProvider
-			logx.Info("腾讯云OCR初始化成功")
-		}
-	}
+	})
 
-	// 初始化阿里云OCR（备用提供商）
-	if c.Ocr.Aliyun.Enabled {
-		aliyunProvider, err := ocr.NewAliyunProvider(ocr.AliyunConfig{
+	// 阿里云OCR
+	registry.Register(ocr.ProviderNameAliyun, func() (ocr.Provider, error) {
+		return ocr.NewAliyunProvider(ocr.AliyunConfig{
 			Enabled:         c.Ocr.Aliyun.Enabled,
 			AccessKeyId:     c.Ocr.Aliyun.AccessKeyId,
 			AccessKeySecret: c.Ocr.Aliyun.AccessKeySecret,
 			Endpoint:        c.Ocr.Aliyun.Endpoint,
 			Timeout:         c.Ocr.Aliyun.Timeout,
 		})
-		if err != nil {
-			logx.Errorf("初始化阿里云OCR失败: %v", err)
-		} else {
-			fallback = aliyunProvider
-			logx.Info("阿里云OCR初始化成功")
-		}
-	}
+	})
 
-	// 如果主提供商为空，使用备用作为主
-	if primary == nil && fallback != nil {
-		primary = fallback
-		fallback = nil
-	}
+	// 本地离线OCR
+	registry.Register(ocr.ProviderNameLocal, func() (ocr.Provider, error) {
+		return ocr.NewLocalProvider(ocr.LocalConfig{
+			Enabled:       c.Ocr.Local.Enabled,
+			FixtureDir:    c.Ocr.Local.FixtureDir,
+			AllowTemplate: c.Ocr.Local.AllowTemplate,
+		})
+	})
 
-	if primary == nil {
+	providers, err := registry.Build(ocrProviderSpecs(c.Ocr))
+	if err != nil {
+		logx.Errorf("OCR提供商配置无效: %v", err)
+		return nil
+	}
+	if len(providers) == 0 {
 		logx.Infof("[WARN] OCR服务未配置任何提供商")
 		return nil
 	}
 
-	return ocr.NewProviderFactory(primary, fallback, rdb)
+	return ocr.NewProviderFactory(providers, rdb)
+}
+
+// ocrProviderSpecs 生成OCR提供商路由配置
+// 未显式配置 Providers 时按启用开关生成默认路由（腾讯云为主、阿里云为备）
+func ocrProviderSpecs(c config.OcrConf) []ocr.ProviderSpec {
+	specs := make([]ocr.ProviderSpec, 0, 3)
+	if len(c.Providers) > 0 {
+		for _, p := range c.Providers {
+			specs = append(specs, ocr.ProviderSpec{Name: p.Name, Weight: p.Weight})
+		}
+		return specs
+	}
+
+	if c.Tencent.Enabled {
+		specs = append(specs, ocr.ProviderSpec{Name: ocr.ProviderNameTencent, Weight: 100})
+	}
+	if c.Aliyun.Enabled {
+		weight := 0
+		if !c.Tencent.Enabled {
+			weight = 100
+		}
+		specs = append(specs, ocr.ProviderSpec{Name: ocr.ProviderNameAliyun, Weight: weight})
+	}
+	if c.Local.Enabled {
+		weight := 0
+		if len(specs) == 0 {
+			weight = 100
+		}
+		specs = append(specs, ocr.ProviderSpec{Name: ocr.ProviderNameLocal, Weight: weight})
+	}
+	return specs
 }
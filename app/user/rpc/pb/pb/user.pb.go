@@ -854,7 +854,7 @@ type UpdateScoreReq struct {
 	// 变动类型（详见枚举说明）
 	// 1-注册初始化, 2-正常履约(+2), 3-提前24h取消(0),
 	// 4-临期取消(-5), 5-爽约(-10), 6-圆满举办(+5),
-	// 7-删除活动(-10), 99-管理员调整
+	// 7-删除活动(-10), 8-信用修复, 9-申诉撤销, 99-管理员调整
 	ChangeType int32 `protobuf:"varint,2,opt,name=change_type,json=changeType,proto3" json:"change_type,omitempty"`
 	// 业务来源ID（幂等键，格式: "业务类型:业务ID"）
 	// 示例: "activity:1001", "checkin:1001:10001"
@@ -862,7 +862,7 @@ type UpdateScoreReq struct {
 	// 变动原因描述
 	// 示例: "活动[周末篮球赛]签到成功"
 	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
-	// 管理员调整时的分值（仅change_type=99时有效）
+	// 自定义分值（仅change_type=8/9/99时有效）
 	AdminDelta    int64 `protobuf:"varint,5,opt,name=admin_delta,json=adminDelta,proto3" json:"admin_delta,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -1015,29 +1015,33 @@ func (x *UpdateScoreResp) GetNewLevel() int32 {
 	return 0
 }
 
-// GetVerifyCurrentReq 获取当前认证进度请求
-type GetVerifyCurrentReq struct {
+// SubmitCreditAppealReq 提交信用申诉请求
+type SubmitCreditAppealReq struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// 用户ID
-	UserId        int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// 申诉用户ID
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// 被申诉的信用变更记录ID
+	CreditLogId int64 `protobuf:"varint,2,opt,name=credit_log_id,json=creditLogId,proto3" json:"credit_log_id,omitempty"`
+	// 申诉理由（最多500字）
+	Reason        string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetVerifyCurrentReq) Reset() {
-	*x = GetVerifyCurrentReq{}
+func (x *SubmitCreditAppealReq) Reset() {
+	*x = SubmitCreditAppealReq{}
 	mi := &file_user_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetVerifyCurrentReq) String() string {
+func (x *SubmitCreditAppealReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVerifyCurrentReq) ProtoMessage() {}
+func (*SubmitCreditAppealReq) ProtoMessage() {}
 
-func (x *GetVerifyCurrentReq) ProtoReflect() protoreflect.Message {
+func (x *SubmitCreditAppealReq) ProtoReflect() protoreflect.Message {
 	mi := &file_user_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1049,65 +1053,55 @@ func (x *GetVerifyCurrentReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVerifyCurrentReq.ProtoReflect.Descriptor instead.
-func (*GetVerifyCurrentReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use SubmitCreditAppealReq.ProtoReflect.Descriptor instead.
+func (*SubmitCreditAppealReq) Descriptor() ([]byte, []int) {
 	return file_user_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *GetVerifyCurrentReq) GetUserId() int64 {
+func (x *SubmitCreditAppealReq) GetUserId() int64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-// GetVerifyCurrentResp 获取当前认证进度响应
-type GetVerifyCurrentResp struct {
+func (x *SubmitCreditAppealReq) GetCreditLogId() int64 {
+	if x != nil {
+		return x.CreditLogId
+	}
+	return 0
+}
+
+func (x *SubmitCreditAppealReq) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// SubmitCreditAppealResp 提交信用申诉响应
+type SubmitCreditAppealResp struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// 是否有认证记录
-	HasRecord bool `protobuf:"varint,1,opt,name=has_record,json=hasRecord,proto3" json:"has_record,omitempty"`
-	// 当前认证ID（无记录时为0）
-	VerifyId int64 `protobuf:"varint,2,opt,name=verify_id,json=verifyId,proto3" json:"verify_id,omitempty"`
-	// 当前状态码（-1=未申请,0=初始,1=OCR中,2=待确认,3=人工审核,4=通过,5=拒绝,6=超时,7=取消,8=OCR失败）
-	Status int32 `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`
-	// 状态描述
-	StatusDesc string `protobuf:"bytes,4,opt,name=status_desc,json=statusDesc,proto3" json:"status_desc,omitempty"`
-	// 是否可以提交新申请
-	CanApply bool `protobuf:"varint,5,opt,name=can_apply,json=canApply,proto3" json:"can_apply,omitempty"`
-	// 是否可以确认/修改
-	CanConfirm bool `protobuf:"varint,6,opt,name=can_confirm,json=canConfirm,proto3" json:"can_confirm,omitempty"`
-	// 是否可以取消
-	CanCancel bool `protobuf:"varint,7,opt,name=can_cancel,json=canCancel,proto3" json:"can_cancel,omitempty"`
-	// 前端应执行的动作
-	// apply=显示申请表单, wait_ocr=等待OCR, confirm=显示确认页
-	// wait_manual=等待人工审核, done=认证完成, rejected=被拒绝可重新申请
-	NeedAction string `protobuf:"bytes,8,opt,name=need_action,json=needAction,proto3" json:"need_action,omitempty"`
-	// OCR识别数据（待确认/已通过时返回）
-	VerifyData *VerifyOcrData `protobuf:"bytes,9,opt,name=verify_data,json=verifyData,proto3" json:"verify_data,omitempty"`
-	// 拒绝原因（被拒绝时返回）
-	RejectReason string `protobuf:"bytes,10,opt,name=reject_reason,json=rejectReason,proto3" json:"reject_reason,omitempty"`
-	// 申请时间（Unix时间戳秒）
-	CreatedAt int64 `protobuf:"varint,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	// 最后更新时间（Unix时间戳秒）
-	UpdatedAt     int64 `protobuf:"varint,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// 申诉ID
+	AppealId      int64 `protobuf:"varint,1,opt,name=appeal_id,json=appealId,proto3" json:"appeal_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetVerifyCurrentResp) Reset() {
-	*x = GetVerifyCurrentResp{}
+func (x *SubmitCreditAppealResp) Reset() {
+	*x = SubmitCreditAppealResp{}
 	mi := &file_user_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetVerifyCurrentResp) String() string {
+func (x *SubmitCreditAppealResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVerifyCurrentResp) ProtoMessage() {}
+func (*SubmitCreditAppealResp) ProtoMessage() {}
 
-func (x *GetVerifyCurrentResp) ProtoReflect() protoreflect.Message {
+func (x *SubmitCreditAppealResp) ProtoReflect() protoreflect.Message {
 	mi := &file_user_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1119,119 +1113,193 @@ func (x *GetVerifyCurrentResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVerifyCurrentResp.ProtoReflect.Descriptor instead.
-func (*GetVerifyCurrentResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use SubmitCreditAppealResp.ProtoReflect.Descriptor instead.
+func (*SubmitCreditAppealResp) Descriptor() ([]byte, []int) {
 	return file_user_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *GetVerifyCurrentResp) GetHasRecord() bool {
+func (x *SubmitCreditAppealResp) GetAppealId() int64 {
 	if x != nil {
-		return x.HasRecord
+		return x.AppealId
 	}
-	return false
+	return 0
 }
 
-func (x *GetVerifyCurrentResp) GetVerifyId() int64 {
+// CreditAppealItem 信用申诉项
+type CreditAppealItem struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 申诉ID
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 申诉用户ID
+	UserId int64 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// 被申诉的信用变更记录ID
+	CreditLogId int64 `protobuf:"varint,3,opt,name=credit_log_id,json=creditLogId,proto3" json:"credit_log_id,omitempty"`
+	// 被申诉记录的变动分值
+	Delta int32 `protobuf:"varint,4,opt,name=delta,proto3" json:"delta,omitempty"`
+	// 被申诉记录的变动原因
+	LogReason string `protobuf:"bytes,5,opt,name=log_reason,json=logReason,proto3" json:"log_reason,omitempty"`
+	// 申诉理由
+	Reason string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	// 状态：1待处理 2已通过 3已驳回
+	Status int32 `protobuf:"varint,7,opt,name=status,proto3" json:"status,omitempty"`
+	// 状态名称
+	StatusName string `protobuf:"bytes,8,opt,name=status_name,json=statusName,proto3" json:"status_name,omitempty"`
+	// 处理人ID
+	ReviewerId int64 `protobuf:"varint,9,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	// 处理意见
+	ReviewNote string `protobuf:"bytes,10,opt,name=review_note,json=reviewNote,proto3" json:"review_note,omitempty"`
+	// 处理时间戳（秒），未处理为0
+	ReviewedAt int64 `protobuf:"varint,11,opt,name=reviewed_at,json=reviewedAt,proto3" json:"reviewed_at,omitempty"`
+	// 创建时间戳（秒）
+	CreatedAt     int64 `protobuf:"varint,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreditAppealItem) Reset() {
+	*x = CreditAppealItem{}
+	mi := &file_user_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreditAppealItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreditAppealItem) ProtoMessage() {}
+
+func (x *CreditAppealItem) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[15]
 	if x != nil {
-		return x.VerifyId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreditAppealItem.ProtoReflect.Descriptor instead.
+func (*CreditAppealItem) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CreditAppealItem) GetId() int64 {
+	if x != nil {
+		return x.Id
 	}
 	return 0
 }
 
-func (x *GetVerifyCurrentResp) GetStatus() int32 {
+func (x *CreditAppealItem) GetUserId() int64 {
 	if x != nil {
-		return x.Status
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *GetVerifyCurrentResp) GetStatusDesc() string {
+func (x *CreditAppealItem) GetCreditLogId() int64 {
 	if x != nil {
-		return x.StatusDesc
+		return x.CreditLogId
 	}
-	return ""
+	return 0
 }
 
-func (x *GetVerifyCurrentResp) GetCanApply() bool {
+func (x *CreditAppealItem) GetDelta() int32 {
 	if x != nil {
-		return x.CanApply
+		return x.Delta
 	}
-	return false
+	return 0
 }
 
-func (x *GetVerifyCurrentResp) GetCanConfirm() bool {
+func (x *CreditAppealItem) GetLogReason() string {
 	if x != nil {
-		return x.CanConfirm
+		return x.LogReason
 	}
-	return false
+	return ""
 }
 
-func (x *GetVerifyCurrentResp) GetCanCancel() bool {
+func (x *CreditAppealItem) GetReason() string {
 	if x != nil {
-		return x.CanCancel
+		return x.Reason
 	}
-	return false
+	return ""
 }
 
-func (x *GetVerifyCurrentResp) GetNeedAction() string {
+func (x *CreditAppealItem) GetStatus() int32 {
 	if x != nil {
-		return x.NeedAction
+		return x.Status
+	}
+	return 0
+}
+
+func (x *CreditAppealItem) GetStatusName() string {
+	if x != nil {
+		return x.StatusName
 	}
 	return ""
 }
 
-func (x *GetVerifyCurrentResp) GetVerifyData() *VerifyOcrData {
+func (x *CreditAppealItem) GetReviewerId() int64 {
 	if x != nil {
-		return x.VerifyData
+		return x.ReviewerId
 	}
-	return nil
+	return 0
 }
 
-func (x *GetVerifyCurrentResp) GetRejectReason() string {
+func (x *CreditAppealItem) GetReviewNote() string {
 	if x != nil {
-		return x.RejectReason
+		return x.ReviewNote
 	}
 	return ""
 }
 
-func (x *GetVerifyCurrentResp) GetCreatedAt() int64 {
+func (x *CreditAppealItem) GetReviewedAt() int64 {
 	if x != nil {
-		return x.CreatedAt
+		return x.ReviewedAt
 	}
 	return 0
 }
 
-func (x *GetVerifyCurrentResp) GetUpdatedAt() int64 {
+func (x *CreditAppealItem) GetCreatedAt() int64 {
 	if x != nil {
-		return x.UpdatedAt
+		return x.CreatedAt
 	}
 	return 0
 }
 
-// GetVerifyInfoReq 获取认证信息请求
-type GetVerifyInfoReq struct {
+// ListCreditAppealsReq 查询申诉列表请求
+type ListCreditAppealsReq struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// 用户ID
-	UserId        int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// 用户ID（0=全部，仅管理员）
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// 状态筛选（0=全部）
+	Status int32 `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	// 页码，从1开始
+	Page int32 `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	// 每页条数，默认20，最大50
+	PageSize      int32 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetVerifyInfoReq) Reset() {
-	*x = GetVerifyInfoReq{}
-	mi := &file_user_proto_msgTypes[15]
+func (x *ListCreditAppealsReq) Reset() {
+	*x = ListCreditAppealsReq{}
+	mi := &file_user_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetVerifyInfoReq) String() string {
+func (x *ListCreditAppealsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVerifyInfoReq) ProtoMessage() {}
+func (*ListCreditAppealsReq) ProtoMessage() {}
 
-func (x *GetVerifyInfoReq) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[15]
+func (x *ListCreditAppealsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1242,56 +1310,65 @@ func (x *GetVerifyInfoReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVerifyInfoReq.ProtoReflect.Descriptor instead.
-func (*GetVerifyInfoReq) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use ListCreditAppealsReq.ProtoReflect.Descriptor instead.
+func (*ListCreditAppealsReq) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *GetVerifyInfoReq) GetUserId() int64 {
+func (x *ListCreditAppealsReq) GetUserId() int64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-// GetVerifyInfoResp 获取认证信息响应
-type GetVerifyInfoResp struct {
+func (x *ListCreditAppealsReq) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *ListCreditAppealsReq) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListCreditAppealsReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// ListCreditAppealsResp 查询申诉列表响应
+type ListCreditAppealsResp struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// 是否已认证
-	IsVerified bool `protobuf:"varint,1,opt,name=is_verified,json=isVerified,proto3" json:"is_verified,omitempty"`
-	// 认证ID
-	VerifyId int64 `protobuf:"varint,2,opt,name=verify_id,json=verifyId,proto3" json:"verify_id,omitempty"`
-	// 真实姓名（全量回显）
-	RealName string `protobuf:"bytes,3,opt,name=real_name,json=realName,proto3" json:"real_name,omitempty"`
-	// 学校名称
-	SchoolName string `protobuf:"bytes,4,opt,name=school_name,json=schoolName,proto3" json:"school_name,omitempty"`
-	// 学号（全量回显）
-	StudentId string `protobuf:"bytes,5,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
-	// 院系
-	Department string `protobuf:"bytes,6,opt,name=department,proto3" json:"department,omitempty"`
-	// 入学年份
-	AdmissionYear string `protobuf:"bytes,7,opt,name=admission_year,json=admissionYear,proto3" json:"admission_year,omitempty"`
-	// 认证通过时间（Unix时间戳秒）
-	VerifiedAt    int64 `protobuf:"varint,8,opt,name=verified_at,json=verifiedAt,proto3" json:"verified_at,omitempty"`
+	// 申诉列表
+	List []*CreditAppealItem `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	// 总数
+	Total         int64 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetVerifyInfoResp) Reset() {
-	*x = GetVerifyInfoResp{}
-	mi := &file_user_proto_msgTypes[16]
+func (x *ListCreditAppealsResp) Reset() {
+	*x = ListCreditAppealsResp{}
+	mi := &file_user_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetVerifyInfoResp) String() string {
+func (x *ListCreditAppealsResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVerifyInfoResp) ProtoMessage() {}
+func (*ListCreditAppealsResp) ProtoMessage() {}
 
-func (x *GetVerifyInfoResp) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[16]
+func (x *ListCreditAppealsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1302,91 +1379,124 @@ func (x *GetVerifyInfoResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVerifyInfoResp.ProtoReflect.Descriptor instead.
-func (*GetVerifyInfoResp) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use ListCreditAppealsResp.ProtoReflect.Descriptor instead.
+func (*ListCreditAppealsResp) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *GetVerifyInfoResp) GetIsVerified() bool {
+func (x *ListCreditAppealsResp) GetList() []*CreditAppealItem {
 	if x != nil {
-		return x.IsVerified
+		return x.List
 	}
-	return false
+	return nil
 }
 
-func (x *GetVerifyInfoResp) GetVerifyId() int64 {
+func (x *ListCreditAppealsResp) GetTotal() int64 {
 	if x != nil {
-		return x.VerifyId
+		return x.Total
 	}
 	return 0
 }
 
-func (x *GetVerifyInfoResp) GetRealName() string {
+// ReviewCreditAppealReq 处理信用申诉请求
+type ReviewCreditAppealReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 申诉ID
+	AppealId int64 `protobuf:"varint,1,opt,name=appeal_id,json=appealId,proto3" json:"appeal_id,omitempty"`
+	// 处理人（管理员）ID
+	AdminId int64 `protobuf:"varint,2,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	// 是否通过
+	Accept bool `protobuf:"varint,3,opt,name=accept,proto3" json:"accept,omitempty"`
+	// 处理意见
+	Note          string `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewCreditAppealReq) Reset() {
+	*x = ReviewCreditAppealReq{}
+	mi := &file_user_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewCreditAppealReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewCreditAppealReq) ProtoMessage() {}
+
+func (x *ReviewCreditAppealReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[18]
 	if x != nil {
-		return x.RealName
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GetVerifyInfoResp) GetSchoolName() string {
+// Deprecated: Use ReviewCreditAppealReq.ProtoReflect.Descriptor instead.
+func (*ReviewCreditAppealReq) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ReviewCreditAppealReq) GetAppealId() int64 {
 	if x != nil {
-		return x.SchoolName
+		return x.AppealId
 	}
-	return ""
+	return 0
 }
 
-func (x *GetVerifyInfoResp) GetStudentId() string {
+func (x *ReviewCreditAppealReq) GetAdminId() int64 {
 	if x != nil {
-		return x.StudentId
+		return x.AdminId
 	}
-	return ""
+	return 0
 }
 
-func (x *GetVerifyInfoResp) GetDepartment() string {
+func (x *ReviewCreditAppealReq) GetAccept() bool {
 	if x != nil {
-		return x.Department
+		return x.Accept
 	}
-	return ""
+	return false
 }
 
-func (x *GetVerifyInfoResp) GetAdmissionYear() string {
+func (x *ReviewCreditAppealReq) GetNote() string {
 	if x != nil {
-		return x.AdmissionYear
+		return x.Note
 	}
 	return ""
 }
 
-func (x *GetVerifyInfoResp) GetVerifiedAt() int64 {
-	if x != nil {
-		return x.VerifiedAt
-	}
-	return 0
-}
-
-// IsVerifiedReq 查询学生认证状态请求
-type IsVerifiedReq struct {
+// ReviewCreditAppealResp 处理信用申诉响应
+type ReviewCreditAppealResp struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// 用户ID
-	UserId        int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// 处理后状态
+	Status int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	// 用户当前分数
+	Score         int64 `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *IsVerifiedReq) Reset() {
-	*x = IsVerifiedReq{}
-	mi := &file_user_proto_msgTypes[17]
+func (x *ReviewCreditAppealResp) Reset() {
+	*x = ReviewCreditAppealResp{}
+	mi := &file_user_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IsVerifiedReq) String() string {
+func (x *ReviewCreditAppealResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IsVerifiedReq) ProtoMessage() {}
+func (*ReviewCreditAppealResp) ProtoMessage() {}
 
-func (x *IsVerifiedReq) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[17]
+func (x *ReviewCreditAppealResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1397,52 +1507,55 @@ func (x *IsVerifiedReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IsVerifiedReq.ProtoReflect.Descriptor instead.
-func (*IsVerifiedReq) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use ReviewCreditAppealResp.ProtoReflect.Descriptor instead.
+func (*ReviewCreditAppealResp) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *IsVerifiedReq) GetUserId() int64 {
+func (x *ReviewCreditAppealResp) GetStatus() int32 {
 	if x != nil {
-		return x.UserId
+		return x.Status
 	}
 	return 0
 }
 
-// IsVerifiedResp 查询学生认证状态响应
-type IsVerifiedResp struct {
+func (x *ReviewCreditAppealResp) GetScore() int64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+// AdminAdjustScoreReq 管理员调整信用分请求
+type AdminAdjustScoreReq struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// 是否已完成学生认证
-	IsVerified bool `protobuf:"varint,1,opt,name=is_verified,json=isVerified,proto3" json:"is_verified,omitempty"`
-	// 学校名称（已认证时返回，未认证为空）
-	SchoolName string `protobuf:"bytes,2,opt,name=school_name,json=schoolName,proto3" json:"school_name,omitempty"`
-	// 学号-脱敏（已认证时返回，如 "202301****"）
-	StudentId string `protobuf:"bytes,3,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
-	// 院系（已认证时返回）
-	Department string `protobuf:"bytes,4,opt,name=department,proto3" json:"department,omitempty"`
-	// 入学年份（已认证时返回，如 "2023"）
-	AdmissionYear string `protobuf:"bytes,5,opt,name=admission_year,json=admissionYear,proto3" json:"admission_year,omitempty"`
-	// 认证通过时间（Unix时间戳秒，未认证时为0）
-	VerifiedAt    int64 `protobuf:"varint,6,opt,name=verified_at,json=verifiedAt,proto3" json:"verified_at,omitempty"`
+	// 操作人（管理员）ID
+	AdminId int64 `protobuf:"varint,1,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	// 被调整用户ID
+	UserId int64 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// 调整分值（正数加分，负数扣分，绝对值不超过100）
+	Delta int64 `protobuf:"varint,3,opt,name=delta,proto3" json:"delta,omitempty"`
+	// 调整原因（必填）
+	Reason        string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *IsVerifiedResp) Reset() {
-	*x = IsVerifiedResp{}
-	mi := &file_user_proto_msgTypes[18]
+func (x *AdminAdjustScoreReq) Reset() {
+	*x = AdminAdjustScoreReq{}
+	mi := &file_user_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IsVerifiedResp) String() string {
+func (x *AdminAdjustScoreReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IsVerifiedResp) ProtoMessage() {}
+func (*AdminAdjustScoreReq) ProtoMessage() {}
 
-func (x *IsVerifiedResp) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[18]
+func (x *AdminAdjustScoreReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1453,91 +1566,156 @@ func (x *IsVerifiedResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IsVerifiedResp.ProtoReflect.Descriptor instead.
-func (*IsVerifiedResp) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use AdminAdjustScoreReq.ProtoReflect.Descriptor instead.
+func (*AdminAdjustScoreReq) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *IsVerifiedResp) GetIsVerified() bool {
+func (x *AdminAdjustScoreReq) GetAdminId() int64 {
 	if x != nil {
-		return x.IsVerified
+		return x.AdminId
 	}
-	return false
+	return 0
 }
 
-func (x *IsVerifiedResp) GetSchoolName() string {
+func (x *AdminAdjustScoreReq) GetUserId() int64 {
 	if x != nil {
-		return x.SchoolName
+		return x.UserId
 	}
-	return ""
+	return 0
 }
 
-func (x *IsVerifiedResp) GetStudentId() string {
+func (x *AdminAdjustScoreReq) GetDelta() int64 {
 	if x != nil {
-		return x.StudentId
+		return x.Delta
 	}
-	return ""
+	return 0
 }
 
-func (x *IsVerifiedResp) GetDepartment() string {
+func (x *AdminAdjustScoreReq) GetReason() string {
 	if x != nil {
-		return x.Department
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *IsVerifiedResp) GetAdmissionYear() string {
+// AdminAdjustScoreResp 管理员调整信用分响应
+type AdminAdjustScoreResp struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 变动前分数
+	BeforeScore int64 `protobuf:"varint,1,opt,name=before_score,json=beforeScore,proto3" json:"before_score,omitempty"`
+	// 变动后分数
+	AfterScore int64 `protobuf:"varint,2,opt,name=after_score,json=afterScore,proto3" json:"after_score,omitempty"`
+	// 实际变动值
+	Delta int64 `protobuf:"varint,3,opt,name=delta,proto3" json:"delta,omitempty"`
+	// 变动后等级
+	NewLevel      int32 `protobuf:"varint,4,opt,name=new_level,json=newLevel,proto3" json:"new_level,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminAdjustScoreResp) Reset() {
+	*x = AdminAdjustScoreResp{}
+	mi := &file_user_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminAdjustScoreResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminAdjustScoreResp) ProtoMessage() {}
+
+func (x *AdminAdjustScoreResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[21]
 	if x != nil {
-		return x.AdmissionYear
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *IsVerifiedResp) GetVerifiedAt() int64 {
+// Deprecated: Use AdminAdjustScoreResp.ProtoReflect.Descriptor instead.
+func (*AdminAdjustScoreResp) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *AdminAdjustScoreResp) GetBeforeScore() int64 {
 	if x != nil {
-		return x.VerifiedAt
+		return x.BeforeScore
 	}
 	return 0
 }
 
-// ApplyStudentVerifyReq 提交认证申请请求
-type ApplyStudentVerifyReq struct {
+func (x *AdminAdjustScoreResp) GetAfterScore() int64 {
+	if x != nil {
+		return x.AfterScore
+	}
+	return 0
+}
+
+func (x *AdminAdjustScoreResp) GetDelta() int64 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *AdminAdjustScoreResp) GetNewLevel() int32 {
+	if x != nil {
+		return x.NewLevel
+	}
+	return 0
+}
+
+// CreditAuditItem 信用审计日志项
+type CreditAuditItem struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// 用户ID
-	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	// 真实姓名
-	RealName string `protobuf:"bytes,2,opt,name=real_name,json=realName,proto3" json:"real_name,omitempty"`
-	// 学校名称
-	SchoolName string `protobuf:"bytes,3,opt,name=school_name,json=schoolName,proto3" json:"school_name,omitempty"`
-	// 学号
-	StudentId string `protobuf:"bytes,4,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
-	// 院系
-	Department string `protobuf:"bytes,5,opt,name=department,proto3" json:"department,omitempty"`
-	// 入学年份
-	AdmissionYear string `protobuf:"bytes,6,opt,name=admission_year,json=admissionYear,proto3" json:"admission_year,omitempty"`
-	// 学生证正面图片URL
-	FrontImageUrl string `protobuf:"bytes,7,opt,name=front_image_url,json=frontImageUrl,proto3" json:"front_image_url,omitempty"`
-	// 学生证详情面图片URL
-	BackImageUrl  string `protobuf:"bytes,8,opt,name=back_image_url,json=backImageUrl,proto3" json:"back_image_url,omitempty"`
+	// 日志ID
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 操作人ID
+	OperatorId int64 `protobuf:"varint,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	// 被操作用户ID
+	UserId int64 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// 操作类型：adjust/appeal_accept/appeal_reject
+	Action string `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	// 关联对象ID（申诉ID）
+	TargetId int64 `protobuf:"varint,5,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	// 关联的信用变更来源ID
+	SourceId string `protobuf:"bytes,6,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	// 实际分数变动
+	Delta int32 `protobuf:"varint,7,opt,name=delta,proto3" json:"delta,omitempty"`
+	// 变更前分数
+	BeforeScore int32 `protobuf:"varint,8,opt,name=before_score,json=beforeScore,proto3" json:"before_score,omitempty"`
+	// 变更后分数
+	AfterScore int32 `protobuf:"varint,9,opt,name=after_score,json=afterScore,proto3" json:"after_score,omitempty"`
+	// 操作原因/备注
+	Reason string `protobuf:"bytes,10,opt,name=reason,proto3" json:"reason,omitempty"`
+	// 创建时间戳（秒）
+	CreatedAt     int64 `protobuf:"varint,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ApplyStudentVerifyReq) Reset() {
-	*x = ApplyStudentVerifyReq{}
-	mi := &file_user_proto_msgTypes[19]
+func (x *CreditAuditItem) Reset() {
+	*x = CreditAuditItem{}
+	mi := &file_user_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApplyStudentVerifyReq) String() string {
+func (x *CreditAuditItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApplyStudentVerifyReq) ProtoMessage() {}
+func (*CreditAuditItem) ProtoMessage() {}
 
-func (x *ApplyStudentVerifyReq) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[19]
+func (x *CreditAuditItem) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1548,97 +1726,120 @@ func (x *ApplyStudentVerifyReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApplyStudentVerifyReq.ProtoReflect.Descriptor instead.
-func (*ApplyStudentVerifyReq) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use CreditAuditItem.ProtoReflect.Descriptor instead.
+func (*CreditAuditItem) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *ApplyStudentVerifyReq) GetUserId() int64 {
+func (x *CreditAuditItem) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CreditAuditItem) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *CreditAuditItem) GetUserId() int64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *ApplyStudentVerifyReq) GetRealName() string {
+func (x *CreditAuditItem) GetAction() string {
 	if x != nil {
-		return x.RealName
+		return x.Action
 	}
 	return ""
 }
 
-func (x *ApplyStudentVerifyReq) GetSchoolName() string {
+func (x *CreditAuditItem) GetTargetId() int64 {
 	if x != nil {
-		return x.SchoolName
+		return x.TargetId
 	}
-	return ""
+	return 0
 }
 
-func (x *ApplyStudentVerifyReq) GetStudentId() string {
+func (x *CreditAuditItem) GetSourceId() string {
 	if x != nil {
-		return x.StudentId
+		return x.SourceId
 	}
 	return ""
 }
 
-func (x *ApplyStudentVerifyReq) GetDepartment() string {
+func (x *CreditAuditItem) GetDelta() int32 {
 	if x != nil {
-		return x.Department
+		return x.Delta
 	}
-	return ""
+	return 0
 }
 
-func (x *ApplyStudentVerifyReq) GetAdmissionYear() string {
+func (x *CreditAuditItem) GetBeforeScore() int32 {
 	if x != nil {
-		return x.AdmissionYear
+		return x.BeforeScore
 	}
-	return ""
+	return 0
 }
 
-func (x *ApplyStudentVerifyReq) GetFrontImageUrl() string {
+func (x *CreditAuditItem) GetAfterScore() int32 {
 	if x != nil {
-		return x.FrontImageUrl
+		return x.AfterScore
 	}
-	return ""
+	return 0
 }
 
-func (x *ApplyStudentVerifyReq) GetBackImageUrl() string {
+func (x *CreditAuditItem) GetReason() string {
 	if x != nil {
-		return x.BackImageUrl
+		return x.Reason
 	}
 	return ""
 }
 
-// ApplyStudentVerifyResp 提交认证申请响应
-type ApplyStudentVerifyResp struct {
+func (x *CreditAuditItem) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// ListCreditAuditsReq 查询审计日志请求
+type ListCreditAuditsReq struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// 认证申请ID
-	VerifyId int64 `protobuf:"varint,1,opt,name=verify_id,json=verifyId,proto3" json:"verify_id,omitempty"`
-	// 当前状态码（1=OCR审核中）
-	Status int32 `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
-	// 状态描述
-	StatusDesc string `protobuf:"bytes,3,opt,name=status_desc,json=statusDesc,proto3" json:"status_desc,omitempty"`
-	// 申请时间（Unix时间戳秒）
-	CreatedAt     int64 `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// 被操作用户ID（0=全部）
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// 操作人ID（0=全部）
+	OperatorId int64 `protobuf:"varint,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	// 操作类型（空=全部）
+	Action string `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	// 页码，从1开始
+	Page int32 `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	// 每页条数，默认20，最大50
+	PageSize      int32 `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ApplyStudentVerifyResp) Reset() {
-	*x = ApplyStudentVerifyResp{}
-	mi := &file_user_proto_msgTypes[20]
+func (x *ListCreditAuditsReq) Reset() {
+	*x = ListCreditAuditsReq{}
+	mi := &file_user_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApplyStudentVerifyResp) String() string {
+func (x *ListCreditAuditsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApplyStudentVerifyResp) ProtoMessage() {}
+func (*ListCreditAuditsReq) ProtoMessage() {}
 
-func (x *ApplyStudentVerifyResp) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[20]
+func (x *ListCreditAuditsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1649,47 +1850,1051 @@ func (x *ApplyStudentVerifyResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApplyStudentVerifyResp.ProtoReflect.Descriptor instead.
-func (*ApplyStudentVerifyResp) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use ListCreditAuditsReq.ProtoReflect.Descriptor instead.
+func (*ListCreditAuditsReq) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *ApplyStudentVerifyResp) GetVerifyId() int64 {
+func (x *ListCreditAuditsReq) GetUserId() int64 {
 	if x != nil {
-		return x.VerifyId
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *ApplyStudentVerifyResp) GetStatus() int32 {
+func (x *ListCreditAuditsReq) GetOperatorId() int64 {
 	if x != nil {
-		return x.Status
+		return x.OperatorId
 	}
 	return 0
 }
 
-func (x *ApplyStudentVerifyResp) GetStatusDesc() string {
+func (x *ListCreditAuditsReq) GetAction() string {
 	if x != nil {
-		return x.StatusDesc
+		return x.Action
 	}
 	return ""
 }
 
-func (x *ApplyStudentVerifyResp) GetCreatedAt() int64 {
+func (x *ListCreditAuditsReq) GetPage() int32 {
 	if x != nil {
-		return x.CreatedAt
+		return x.Page
 	}
 	return 0
 }
 
-// ConfirmStudentVerifyReq 确认/修改认证信息请求
-type ConfirmStudentVerifyReq struct {
+func (x *ListCreditAuditsReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// ListCreditAuditsResp 查询审计日志响应
+type ListCreditAuditsResp struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// 用户ID（用于鉴权校验）
-	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	// 认证申请ID
-	VerifyId int64 `protobuf:"varint,2,opt,name=verify_id,json=verifyId,proto3" json:"verify_id,omitempty"`
-	// true=确认无误，false=需要修改
+	// 日志列表
+	List []*CreditAuditItem `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	// 总数
+	Total         int64 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCreditAuditsResp) Reset() {
+	*x = ListCreditAuditsResp{}
+	mi := &file_user_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCreditAuditsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCreditAuditsResp) ProtoMessage() {}
+
+func (x *ListCreditAuditsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCreditAuditsResp.ProtoReflect.Descriptor instead.
+func (*ListCreditAuditsResp) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListCreditAuditsResp) GetList() []*CreditAuditItem {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *ListCreditAuditsResp) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// GetVerifyCurrentReq 获取当前认证进度请求
+type GetVerifyCurrentReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 用户ID
+	UserId        int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVerifyCurrentReq) Reset() {
+	*x = GetVerifyCurrentReq{}
+	mi := &file_user_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVerifyCurrentReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVerifyCurrentReq) ProtoMessage() {}
+
+func (x *GetVerifyCurrentReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVerifyCurrentReq.ProtoReflect.Descriptor instead.
+func (*GetVerifyCurrentReq) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetVerifyCurrentReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// GetVerifyCurrentResp 获取当前认证进度响应
+type GetVerifyCurrentResp struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 是否有认证记录
+	HasRecord bool `protobuf:"varint,1,opt,name=has_record,json=hasRecord,proto3" json:"has_record,omitempty"`
+	// 当前认证ID（无记录时为0）
+	VerifyId int64 `protobuf:"varint,2,opt,name=verify_id,json=verifyId,proto3" json:"verify_id,omitempty"`
+	// 当前状态码（-1=未申请,0=初始,1=OCR中,2=待确认,3=人工审核,4=通过,5=拒绝,6=超时,7=取消,8=OCR失败,9=已过期）
+	Status int32 `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`
+	// 状态描述
+	StatusDesc string `protobuf:"bytes,4,opt,name=status_desc,json=statusDesc,proto3" json:"status_desc,omitempty"`
+	// 是否可以提交新申请
+	CanApply bool `protobuf:"varint,5,opt,name=can_apply,json=canApply,proto3" json:"can_apply,omitempty"`
+	// 是否可以确认/修改
+	CanConfirm bool `protobuf:"varint,6,opt,name=can_confirm,json=canConfirm,proto3" json:"can_confirm,omitempty"`
+	// 是否可以取消
+	CanCancel bool `protobuf:"varint,7,opt,name=can_cancel,json=canCancel,proto3" json:"can_cancel,omitempty"`
+	// 前端应执行的动作
+	// apply=显示申请表单, wait_ocr=等待OCR, confirm=显示确认页
+	// wait_manual=等待人工审核, done=认证完成, rejected=被拒绝可重新申请
+	NeedAction string `protobuf:"bytes,8,opt,name=need_action,json=needAction,proto3" json:"need_action,omitempty"`
+	// OCR识别数据（待确认/已通过时返回）
+	VerifyData *VerifyOcrData `protobuf:"bytes,9,opt,name=verify_data,json=verifyData,proto3" json:"verify_data,omitempty"`
+	// 拒绝原因（被拒绝时返回）
+	RejectReason string `protobuf:"bytes,10,opt,name=reject_reason,json=rejectReason,proto3" json:"reject_reason,omitempty"`
+	// 申请时间（Unix时间戳秒）
+	CreatedAt int64 `protobuf:"varint,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// 最后更新时间（Unix时间戳秒）
+	UpdatedAt int64 `protobuf:"varint,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// 认证过期时间（Unix时间戳秒，未设置为0）
+	ExpireAt      int64 `protobuf:"varint,13,opt,name=expire_at,json=expireAt,proto3" json:"expire_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVerifyCurrentResp) Reset() {
+	*x = GetVerifyCurrentResp{}
+	mi := &file_user_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVerifyCurrentResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVerifyCurrentResp) ProtoMessage() {}
+
+func (x *GetVerifyCurrentResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVerifyCurrentResp.ProtoReflect.Descriptor instead.
+func (*GetVerifyCurrentResp) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetVerifyCurrentResp) GetHasRecord() bool {
+	if x != nil {
+		return x.HasRecord
+	}
+	return false
+}
+
+func (x *GetVerifyCurrentResp) GetVerifyId() int64 {
+	if x != nil {
+		return x.VerifyId
+	}
+	return 0
+}
+
+func (x *GetVerifyCurrentResp) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *GetVerifyCurrentResp) GetStatusDesc() string {
+	if x != nil {
+		return x.StatusDesc
+	}
+	return ""
+}
+
+func (x *GetVerifyCurrentResp) GetCanApply() bool {
+	if x != nil {
+		return x.CanApply
+	}
+	return false
+}
+
+func (x *GetVerifyCurrentResp) GetCanConfirm() bool {
+	if x != nil {
+		return x.CanConfirm
+	}
+	return false
+}
+
+func (x *GetVerifyCurrentResp) GetCanCancel() bool {
+	if x != nil {
+		return x.CanCancel
+	}
+	return false
+}
+
+func (x *GetVerifyCurrentResp) GetNeedAction() string {
+	if x != nil {
+		return x.NeedAction
+	}
+	return ""
+}
+
+func (x *GetVerifyCurrentResp) GetVerifyData() *VerifyOcrData {
+	if x != nil {
+		return x.VerifyData
+	}
+	return nil
+}
+
+func (x *GetVerifyCurrentResp) GetRejectReason() string {
+	if x != nil {
+		return x.RejectReason
+	}
+	return ""
+}
+
+func (x *GetVerifyCurrentResp) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *GetVerifyCurrentResp) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *GetVerifyCurrentResp) GetExpireAt() int64 {
+	if x != nil {
+		return x.ExpireAt
+	}
+	return 0
+}
+
+// GetVerifyInfoReq 获取认证信息请求
+type GetVerifyInfoReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 用户ID
+	UserId        int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVerifyInfoReq) Reset() {
+	*x = GetVerifyInfoReq{}
+	mi := &file_user_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVerifyInfoReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVerifyInfoReq) ProtoMessage() {}
+
+func (x *GetVerifyInfoReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVerifyInfoReq.ProtoReflect.Descriptor instead.
+func (*GetVerifyInfoReq) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetVerifyInfoReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// GetVerifyInfoResp 获取认证信息响应
+type GetVerifyInfoResp struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 是否已认证
+	IsVerified bool `protobuf:"varint,1,opt,name=is_verified,json=isVerified,proto3" json:"is_verified,omitempty"`
+	// 认证ID
+	VerifyId int64 `protobuf:"varint,2,opt,name=verify_id,json=verifyId,proto3" json:"verify_id,omitempty"`
+	// 真实姓名（全量回显）
+	RealName string `protobuf:"bytes,3,opt,name=real_name,json=realName,proto3" json:"real_name,omitempty"`
+	// 学校名称
+	SchoolName string `protobuf:"bytes,4,opt,name=school_name,json=schoolName,proto3" json:"school_name,omitempty"`
+	// 学号（全量回显）
+	StudentId string `protobuf:"bytes,5,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	// 院系
+	Department string `protobuf:"bytes,6,opt,name=department,proto3" json:"department,omitempty"`
+	// 入学年份
+	AdmissionYear string `protobuf:"bytes,7,opt,name=admission_year,json=admissionYear,proto3" json:"admission_year,omitempty"`
+	// 认证通过时间（Unix时间戳秒）
+	VerifiedAt int64 `protobuf:"varint,8,opt,name=verified_at,json=verifiedAt,proto3" json:"verified_at,omitempty"`
+	// 认证过期时间（Unix时间戳秒，未设置为0）
+	ExpireAt      int64 `protobuf:"varint,9,opt,name=expire_at,json=expireAt,proto3" json:"expire_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVerifyInfoResp) Reset() {
+	*x = GetVerifyInfoResp{}
+	mi := &file_user_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVerifyInfoResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVerifyInfoResp) ProtoMessage() {}
+
+func (x *GetVerifyInfoResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVerifyInfoResp.ProtoReflect.Descriptor instead.
+func (*GetVerifyInfoResp) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetVerifyInfoResp) GetIsVerified() bool {
+	if x != nil {
+		return x.IsVerified
+	}
+	return false
+}
+
+func (x *GetVerifyInfoResp) GetVerifyId() int64 {
+	if x != nil {
+		return x.VerifyId
+	}
+	return 0
+}
+
+func (x *GetVerifyInfoResp) GetRealName() string {
+	if x != nil {
+		return x.RealName
+	}
+	return ""
+}
+
+func (x *GetVerifyInfoResp) GetSchoolName() string {
+	if x != nil {
+		return x.SchoolName
+	}
+	return ""
+}
+
+func (x *GetVerifyInfoResp) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *GetVerifyInfoResp) GetDepartment() string {
+	if x != nil {
+		return x.Department
+	}
+	return ""
+}
+
+func (x *GetVerifyInfoResp) GetAdmissionYear() string {
+	if x != nil {
+		return x.AdmissionYear
+	}
+	return ""
+}
+
+func (x *GetVerifyInfoResp) GetVerifiedAt() int64 {
+	if x != nil {
+		return x.VerifiedAt
+	}
+	return 0
+}
+
+func (x *GetVerifyInfoResp) GetExpireAt() int64 {
+	if x != nil {
+		return x.ExpireAt
+	}
+	return 0
+}
+
+// IsVerifiedReq 查询学生认证状态请求
+type IsVerifiedReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 用户ID
+	UserId        int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IsVerifiedReq) Reset() {
+	*x = IsVerifiedReq{}
+	mi := &file_user_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IsVerifiedReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsVerifiedReq) ProtoMessage() {}
+
+func (x *IsVerifiedReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsVerifiedReq.ProtoReflect.Descriptor instead.
+func (*IsVerifiedReq) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *IsVerifiedReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// IsVerifiedResp 查询学生认证状态响应
+type IsVerifiedResp struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 是否已完成学生认证
+	IsVerified bool `protobuf:"varint,1,opt,name=is_verified,json=isVerified,proto3" json:"is_verified,omitempty"`
+	// 学校名称（已认证时返回，未认证为空）
+	SchoolName string `protobuf:"bytes,2,opt,name=school_name,json=schoolName,proto3" json:"school_name,omitempty"`
+	// 学号-脱敏（已认证时返回，如 "202301****"）
+	StudentId string `protobuf:"bytes,3,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	// 院系（已认证时返回）
+	Department string `protobuf:"bytes,4,opt,name=department,proto3" json:"department,omitempty"`
+	// 入学年份（已认证时返回，如 "2023"）
+	AdmissionYear string `protobuf:"bytes,5,opt,name=admission_year,json=admissionYear,proto3" json:"admission_year,omitempty"`
+	// 认证通过时间（Unix时间戳秒，未认证时为0）
+	VerifiedAt int64 `protobuf:"varint,6,opt,name=verified_at,json=verifiedAt,proto3" json:"verified_at,omitempty"`
+	// 认证过期时间（Unix时间戳秒，未设置为0）
+	ExpireAt      int64 `protobuf:"varint,7,opt,name=expire_at,json=expireAt,proto3" json:"expire_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IsVerifiedResp) Reset() {
+	*x = IsVerifiedResp{}
+	mi := &file_user_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IsVerifiedResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsVerifiedResp) ProtoMessage() {}
+
+func (x *IsVerifiedResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsVerifiedResp.ProtoReflect.Descriptor instead.
+func (*IsVerifiedResp) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *IsVerifiedResp) GetIsVerified() bool {
+	if x != nil {
+		return x.IsVerified
+	}
+	return false
+}
+
+func (x *IsVerifiedResp) GetSchoolName() string {
+	if x != nil {
+		return x.SchoolName
+	}
+	return ""
+}
+
+func (x *IsVerifiedResp) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *IsVerifiedResp) GetDepartment() string {
+	if x != nil {
+		return x.Department
+	}
+	return ""
+}
+
+func (x *IsVerifiedResp) GetAdmissionYear() string {
+	if x != nil {
+		return x.AdmissionYear
+	}
+	return ""
+}
+
+func (x *IsVerifiedResp) GetVerifiedAt() int64 {
+	if x != nil {
+		return x.VerifiedAt
+	}
+	return 0
+}
+
+func (x *IsVerifiedResp) GetExpireAt() int64 {
+	if x != nil {
+		return x.ExpireAt
+	}
+	return 0
+}
+
+// BatchGetVerifyProfilesReq 批量查询认证画像请求
+type BatchGetVerifyProfilesReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 用户ID列表（单次最多500个）
+	UserIds       []int64 `protobuf:"varint,1,rep,packed,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetVerifyProfilesReq) Reset() {
+	*x = BatchGetVerifyProfilesReq{}
+	mi := &file_user_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetVerifyProfilesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetVerifyProfilesReq) ProtoMessage() {}
+
+func (x *BatchGetVerifyProfilesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetVerifyProfilesReq.ProtoReflect.Descriptor instead.
+func (*BatchGetVerifyProfilesReq) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *BatchGetVerifyProfilesReq) GetUserIds() []int64 {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+// VerifyProfile 认证画像（仅非敏感字段）
+type VerifyProfile struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 用户ID
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// 学校名称
+	SchoolName string `protobuf:"bytes,2,opt,name=school_name,json=schoolName,proto3" json:"school_name,omitempty"`
+	// 院系
+	Department string `protobuf:"bytes,3,opt,name=department,proto3" json:"department,omitempty"`
+	// 入学年份
+	AdmissionYear string `protobuf:"bytes,4,opt,name=admission_year,json=admissionYear,proto3" json:"admission_year,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyProfile) Reset() {
+	*x = VerifyProfile{}
+	mi := &file_user_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyProfile) ProtoMessage() {}
+
+func (x *VerifyProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyProfile.ProtoReflect.Descriptor instead.
+func (*VerifyProfile) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *VerifyProfile) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *VerifyProfile) GetSchoolName() string {
+	if x != nil {
+		return x.SchoolName
+	}
+	return ""
+}
+
+func (x *VerifyProfile) GetDepartment() string {
+	if x != nil {
+		return x.Department
+	}
+	return ""
+}
+
+func (x *VerifyProfile) GetAdmissionYear() string {
+	if x != nil {
+		return x.AdmissionYear
+	}
+	return ""
+}
+
+// BatchGetVerifyProfilesResp 批量查询认证画像响应
+type BatchGetVerifyProfilesResp struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 认证有效的用户（未认证或已过期的用户不返回）
+	List          []*VerifyProfile `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetVerifyProfilesResp) Reset() {
+	*x = BatchGetVerifyProfilesResp{}
+	mi := &file_user_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetVerifyProfilesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetVerifyProfilesResp) ProtoMessage() {}
+
+func (x *BatchGetVerifyProfilesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetVerifyProfilesResp.ProtoReflect.Descriptor instead.
+func (*BatchGetVerifyProfilesResp) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *BatchGetVerifyProfilesResp) GetList() []*VerifyProfile {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+// ApplyStudentVerifyReq 提交认证申请请求
+type ApplyStudentVerifyReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 用户ID
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// 真实姓名
+	RealName string `protobuf:"bytes,2,opt,name=real_name,json=realName,proto3" json:"real_name,omitempty"`
+	// 学校名称
+	SchoolName string `protobuf:"bytes,3,opt,name=school_name,json=schoolName,proto3" json:"school_name,omitempty"`
+	// 学号
+	StudentId string `protobuf:"bytes,4,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	// 院系
+	Department string `protobuf:"bytes,5,opt,name=department,proto3" json:"department,omitempty"`
+	// 入学年份
+	AdmissionYear string `protobuf:"bytes,6,opt,name=admission_year,json=admissionYear,proto3" json:"admission_year,omitempty"`
+	// 学生证正面图片URL
+	FrontImageUrl string `protobuf:"bytes,7,opt,name=front_image_url,json=frontImageUrl,proto3" json:"front_image_url,omitempty"`
+	// 学生证详情面图片URL
+	BackImageUrl  string `protobuf:"bytes,8,opt,name=back_image_url,json=backImageUrl,proto3" json:"back_image_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyStudentVerifyReq) Reset() {
+	*x = ApplyStudentVerifyReq{}
+	mi := &file_user_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyStudentVerifyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyStudentVerifyReq) ProtoMessage() {}
+
+func (x *ApplyStudentVerifyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyStudentVerifyReq.ProtoReflect.Descriptor instead.
+func (*ApplyStudentVerifyReq) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ApplyStudentVerifyReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ApplyStudentVerifyReq) GetRealName() string {
+	if x != nil {
+		return x.RealName
+	}
+	return ""
+}
+
+func (x *ApplyStudentVerifyReq) GetSchoolName() string {
+	if x != nil {
+		return x.SchoolName
+	}
+	return ""
+}
+
+func (x *ApplyStudentVerifyReq) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *ApplyStudentVerifyReq) GetDepartment() string {
+	if x != nil {
+		return x.Department
+	}
+	return ""
+}
+
+func (x *ApplyStudentVerifyReq) GetAdmissionYear() string {
+	if x != nil {
+		return x.AdmissionYear
+	}
+	return ""
+}
+
+func (x *ApplyStudentVerifyReq) GetFrontImageUrl() string {
+	if x != nil {
+		return x.FrontImageUrl
+	}
+	return ""
+}
+
+func (x *ApplyStudentVerifyReq) GetBackImageUrl() string {
+	if x != nil {
+		return x.BackImageUrl
+	}
+	return ""
+}
+
+// ApplyStudentVerifyResp 提交认证申请响应
+type ApplyStudentVerifyResp struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 认证申请ID
+	VerifyId int64 `protobuf:"varint,1,opt,name=verify_id,json=verifyId,proto3" json:"verify_id,omitempty"`
+	// 当前状态码（1=OCR审核中）
+	Status int32 `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	// 状态描述
+	StatusDesc string `protobuf:"bytes,3,opt,name=status_desc,json=statusDesc,proto3" json:"status_desc,omitempty"`
+	// 申请时间（Unix时间戳秒）
+	CreatedAt     int64 `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyStudentVerifyResp) Reset() {
+	*x = ApplyStudentVerifyResp{}
+	mi := &file_user_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyStudentVerifyResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyStudentVerifyResp) ProtoMessage() {}
+
+func (x *ApplyStudentVerifyResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyStudentVerifyResp.ProtoReflect.Descriptor instead.
+func (*ApplyStudentVerifyResp) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ApplyStudentVerifyResp) GetVerifyId() int64 {
+	if x != nil {
+		return x.VerifyId
+	}
+	return 0
+}
+
+func (x *ApplyStudentVerifyResp) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *ApplyStudentVerifyResp) GetStatusDesc() string {
+	if x != nil {
+		return x.StatusDesc
+	}
+	return ""
+}
+
+func (x *ApplyStudentVerifyResp) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// ReverifyStudentReq 过期后重新认证请求
+// 沿用上一次认证的姓名/学校/学号，仅需重新上传学生证图片
+type ReverifyStudentReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 用户ID
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// 学生证正面图片URL
+	FrontImageUrl string `protobuf:"bytes,2,opt,name=front_image_url,json=frontImageUrl,proto3" json:"front_image_url,omitempty"`
+	// 学生证详情面图片URL
+	BackImageUrl string `protobuf:"bytes,3,opt,name=back_image_url,json=backImageUrl,proto3" json:"back_image_url,omitempty"`
+	// 院系（可选，不填沿用原值）
+	Department string `protobuf:"bytes,4,opt,name=department,proto3" json:"department,omitempty"`
+	// 入学年份（可选，不填沿用原值；如升学后入学年份变化）
+	AdmissionYear string `protobuf:"bytes,5,opt,name=admission_year,json=admissionYear,proto3" json:"admission_year,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReverifyStudentReq) Reset() {
+	*x = ReverifyStudentReq{}
+	mi := &file_user_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReverifyStudentReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReverifyStudentReq) ProtoMessage() {}
+
+func (x *ReverifyStudentReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReverifyStudentReq.ProtoReflect.Descriptor instead.
+func (*ReverifyStudentReq) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ReverifyStudentReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ReverifyStudentReq) GetFrontImageUrl() string {
+	if x != nil {
+		return x.FrontImageUrl
+	}
+	return ""
+}
+
+func (x *ReverifyStudentReq) GetBackImageUrl() string {
+	if x != nil {
+		return x.BackImageUrl
+	}
+	return ""
+}
+
+func (x *ReverifyStudentReq) GetDepartment() string {
+	if x != nil {
+		return x.Department
+	}
+	return ""
+}
+
+func (x *ReverifyStudentReq) GetAdmissionYear() string {
+	if x != nil {
+		return x.AdmissionYear
+	}
+	return ""
+}
+
+// ConfirmStudentVerifyReq 确认/修改认证信息请求
+type ConfirmStudentVerifyReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 用户ID（用于鉴权校验）
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// 认证申请ID
+	VerifyId int64 `protobuf:"varint,2,opt,name=verify_id,json=verifyId,proto3" json:"verify_id,omitempty"`
+	// true=确认无误，false=需要修改
 	IsConfirmed bool `protobuf:"varint,3,opt,name=is_confirmed,json=isConfirmed,proto3" json:"is_confirmed,omitempty"`
 	// 用户修改后的数据（is_confirmed=false时必填）
 	ModifiedData  *VerifyModifiedData `protobuf:"bytes,4,opt,name=modified_data,json=modifiedData,proto3" json:"modified_data,omitempty"`
@@ -1697,21 +2902,1070 @@ type ConfirmStudentVerifyReq struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ConfirmStudentVerifyReq) Reset() {
-	*x = ConfirmStudentVerifyReq{}
-	mi := &file_user_proto_msgTypes[21]
+func (x *ConfirmStudentVerifyReq) Reset() {
+	*x = ConfirmStudentVerifyReq{}
+	mi := &file_user_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmStudentVerifyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmStudentVerifyReq) ProtoMessage() {}
+
+func (x *ConfirmStudentVerifyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmStudentVerifyReq.ProtoReflect.Descriptor instead.
+func (*ConfirmStudentVerifyReq) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ConfirmStudentVerifyReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ConfirmStudentVerifyReq) GetVerifyId() int64 {
+	if x != nil {
+		return x.VerifyId
+	}
+	return 0
+}
+
+func (x *ConfirmStudentVerifyReq) GetIsConfirmed() bool {
+	if x != nil {
+		return x.IsConfirmed
+	}
+	return false
+}
+
+func (x *ConfirmStudentVerifyReq) GetModifiedData() *VerifyModifiedData {
+	if x != nil {
+		return x.ModifiedData
+	}
+	return nil
+}
+
+// VerifyModifiedData 用户修改后的数据
+type VerifyModifiedData struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 真实姓名
+	RealName string `protobuf:"bytes,1,opt,name=real_name,json=realName,proto3" json:"real_name,omitempty"`
+	// 学校名称
+	SchoolName string `protobuf:"bytes,2,opt,name=school_name,json=schoolName,proto3" json:"school_name,omitempty"`
+	// 学号
+	StudentId string `protobuf:"bytes,3,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	// 院系
+	Department string `protobuf:"bytes,4,opt,name=department,proto3" json:"department,omitempty"`
+	// 入学年份
+	AdmissionYear string `protobuf:"bytes,5,opt,name=admission_year,json=admissionYear,proto3" json:"admission_year,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyModifiedData) Reset() {
+	*x = VerifyModifiedData{}
+	mi := &file_user_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyModifiedData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyModifiedData) ProtoMessage() {}
+
+func (x *VerifyModifiedData) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyModifiedData.ProtoReflect.Descriptor instead.
+func (*VerifyModifiedData) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *VerifyModifiedData) GetRealName() string {
+	if x != nil {
+		return x.RealName
+	}
+	return ""
+}
+
+func (x *VerifyModifiedData) GetSchoolName() string {
+	if x != nil {
+		return x.SchoolName
+	}
+	return ""
+}
+
+func (x *VerifyModifiedData) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *VerifyModifiedData) GetDepartment() string {
+	if x != nil {
+		return x.Department
+	}
+	return ""
+}
+
+func (x *VerifyModifiedData) GetAdmissionYear() string {
+	if x != nil {
+		return x.AdmissionYear
+	}
+	return ""
+}
+
+// ConfirmStudentVerifyResp 确认/修改认证信息响应
+type ConfirmStudentVerifyResp struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 认证申请ID
+	VerifyId int64 `protobuf:"varint,1,opt,name=verify_id,json=verifyId,proto3" json:"verify_id,omitempty"`
+	// 更新后的状态码（4=通过，3=人工审核）
+	NewStatus int32 `protobuf:"varint,2,opt,name=new_status,json=newStatus,proto3" json:"new_status,omitempty"`
+	// 状态描述
+	NewStatusDesc string `protobuf:"bytes,3,opt,name=new_status_desc,json=newStatusDesc,proto3" json:"new_status_desc,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmStudentVerifyResp) Reset() {
+	*x = ConfirmStudentVerifyResp{}
+	mi := &file_user_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmStudentVerifyResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmStudentVerifyResp) ProtoMessage() {}
+
+func (x *ConfirmStudentVerifyResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmStudentVerifyResp.ProtoReflect.Descriptor instead.
+func (*ConfirmStudentVerifyResp) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ConfirmStudentVerifyResp) GetVerifyId() int64 {
+	if x != nil {
+		return x.VerifyId
+	}
+	return 0
+}
+
+func (x *ConfirmStudentVerifyResp) GetNewStatus() int32 {
+	if x != nil {
+		return x.NewStatus
+	}
+	return 0
+}
+
+func (x *ConfirmStudentVerifyResp) GetNewStatusDesc() string {
+	if x != nil {
+		return x.NewStatusDesc
+	}
+	return ""
+}
+
+// CancelStudentVerifyReq 取消认证申请请求
+type CancelStudentVerifyReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 用户ID（用于鉴权校验）
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// 认证申请ID
+	VerifyId int64 `protobuf:"varint,2,opt,name=verify_id,json=verifyId,proto3" json:"verify_id,omitempty"`
+	// 取消原因（可选）
+	CancelReason  string `protobuf:"bytes,3,opt,name=cancel_reason,json=cancelReason,proto3" json:"cancel_reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelStudentVerifyReq) Reset() {
+	*x = CancelStudentVerifyReq{}
+	mi := &file_user_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelStudentVerifyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelStudentVerifyReq) ProtoMessage() {}
+
+func (x *CancelStudentVerifyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelStudentVerifyReq.ProtoReflect.Descriptor instead.
+func (*CancelStudentVerifyReq) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *CancelStudentVerifyReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CancelStudentVerifyReq) GetVerifyId() int64 {
+	if x != nil {
+		return x.VerifyId
+	}
+	return 0
+}
+
+func (x *CancelStudentVerifyReq) GetCancelReason() string {
+	if x != nil {
+		return x.CancelReason
+	}
+	return ""
+}
+
+// CancelStudentVerifyResp 取消认证申请响应
+type CancelStudentVerifyResp struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 认证申请ID
+	VerifyId int64 `protobuf:"varint,1,opt,name=verify_id,json=verifyId,proto3" json:"verify_id,omitempty"`
+	// 更新后的状态码（7=已取消）
+	NewStatus int32 `protobuf:"varint,2,opt,name=new_status,json=newStatus,proto3" json:"new_status,omitempty"`
+	// 状态描述
+	NewStatusDesc string `protobuf:"bytes,3,opt,name=new_status_desc,json=newStatusDesc,proto3" json:"new_status_desc,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelStudentVerifyResp) Reset() {
+	*x = CancelStudentVerifyResp{}
+	mi := &file_user_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelStudentVerifyResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelStudentVerifyResp) ProtoMessage() {}
+
+func (x *CancelStudentVerifyResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelStudentVerifyResp.ProtoReflect.Descriptor instead.
+func (*CancelStudentVerifyResp) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *CancelStudentVerifyResp) GetVerifyId() int64 {
+	if x != nil {
+		return x.VerifyId
+	}
+	return 0
+}
+
+func (x *CancelStudentVerifyResp) GetNewStatus() int32 {
+	if x != nil {
+		return x.NewStatus
+	}
+	return 0
+}
+
+func (x *CancelStudentVerifyResp) GetNewStatusDesc() string {
+	if x != nil {
+		return x.NewStatusDesc
+	}
+	return ""
+}
+
+// UpdateVerifyStatusReq 更新认证状态请求
+type UpdateVerifyStatusReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 认证记录ID
+	VerifyId int64 `protobuf:"varint,1,opt,name=verify_id,json=verifyId,proto3" json:"verify_id,omitempty"`
+	// 用户ID
+	UserId int64 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// 新状态
+	// 1-OCR审核中, 2-待确认, 3-人工审核中, 4-已通过, 5-已拒绝, 6-已超时, 7-已取消, 8-OCR失败, 9-已过期
+	NewStatus int32 `protobuf:"varint,3,opt,name=new_status,json=newStatus,proto3" json:"new_status,omitempty"`
+	// OCR识别数据（状态为2-待确认时填充）
+	OcrData *VerifyOcrData `protobuf:"bytes,4,opt,name=ocr_data,json=ocrData,proto3" json:"ocr_data,omitempty"`
+	// 拒绝原因（状态为5-已拒绝时填充）
+	RejectReason string `protobuf:"bytes,5,opt,name=reject_reason,json=rejectReason,proto3" json:"reject_reason,omitempty"`
+	// 操作来源
+	// 示例: "ocr_callback", "manual_review", "timeout_job"
+	Operator string `protobuf:"bytes,6,opt,name=operator,proto3" json:"operator,omitempty"`
+	// 审核员ID（人工审核时填充）
+	ReviewerId    int64 `protobuf:"varint,7,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateVerifyStatusReq) Reset() {
+	*x = UpdateVerifyStatusReq{}
+	mi := &file_user_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateVerifyStatusReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateVerifyStatusReq) ProtoMessage() {}
+
+func (x *UpdateVerifyStatusReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateVerifyStatusReq.ProtoReflect.Descriptor instead.
+func (*UpdateVerifyStatusReq) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *UpdateVerifyStatusReq) GetVerifyId() int64 {
+	if x != nil {
+		return x.VerifyId
+	}
+	return 0
+}
+
+func (x *UpdateVerifyStatusReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpdateVerifyStatusReq) GetNewStatus() int32 {
+	if x != nil {
+		return x.NewStatus
+	}
+	return 0
+}
+
+func (x *UpdateVerifyStatusReq) GetOcrData() *VerifyOcrData {
+	if x != nil {
+		return x.OcrData
+	}
+	return nil
+}
+
+func (x *UpdateVerifyStatusReq) GetRejectReason() string {
+	if x != nil {
+		return x.RejectReason
+	}
+	return ""
+}
+
+func (x *UpdateVerifyStatusReq) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *UpdateVerifyStatusReq) GetReviewerId() int64 {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return 0
+}
+
+// VerifyOcrData OCR识别数据
+type VerifyOcrData struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 真实姓名
+	RealName string `protobuf:"bytes,1,opt,name=real_name,json=realName,proto3" json:"real_name,omitempty"`
+	// 学校名称
+	SchoolName string `protobuf:"bytes,2,opt,name=school_name,json=schoolName,proto3" json:"school_name,omitempty"`
+	// 学号
+	StudentId string `protobuf:"bytes,3,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	// 院系
+	Department string `protobuf:"bytes,4,opt,name=department,proto3" json:"department,omitempty"`
+	// 入学年份
+	AdmissionYear string `protobuf:"bytes,5,opt,name=admission_year,json=admissionYear,proto3" json:"admission_year,omitempty"`
+	// OCR平台
+	OcrPlatform string `protobuf:"bytes,6,opt,name=ocr_platform,json=ocrPlatform,proto3" json:"ocr_platform,omitempty"`
+	// OCR置信度
+	OcrConfidence float64 `protobuf:"fixed64,7,opt,name=ocr_confidence,json=ocrConfidence,proto3" json:"ocr_confidence,omitempty"`
+	// OCR原始响应JSON
+	OcrRawJson    string `protobuf:"bytes,8,opt,name=ocr_raw_json,json=ocrRawJson,proto3" json:"ocr_raw_json,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyOcrData) Reset() {
+	*x = VerifyOcrData{}
+	mi := &file_user_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyOcrData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyOcrData) ProtoMessage() {}
+
+func (x *VerifyOcrData) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyOcrData.ProtoReflect.Descriptor instead.
+func (*VerifyOcrData) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *VerifyOcrData) GetRealName() string {
+	if x != nil {
+		return x.RealName
+	}
+	return ""
+}
+
+func (x *VerifyOcrData) GetSchoolName() string {
+	if x != nil {
+		return x.SchoolName
+	}
+	return ""
+}
+
+func (x *VerifyOcrData) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *VerifyOcrData) GetDepartment() string {
+	if x != nil {
+		return x.Department
+	}
+	return ""
+}
+
+func (x *VerifyOcrData) GetAdmissionYear() string {
+	if x != nil {
+		return x.AdmissionYear
+	}
+	return ""
+}
+
+func (x *VerifyOcrData) GetOcrPlatform() string {
+	if x != nil {
+		return x.OcrPlatform
+	}
+	return ""
+}
+
+func (x *VerifyOcrData) GetOcrConfidence() float64 {
+	if x != nil {
+		return x.OcrConfidence
+	}
+	return 0
+}
+
+func (x *VerifyOcrData) GetOcrRawJson() string {
+	if x != nil {
+		return x.OcrRawJson
+	}
+	return ""
+}
+
+// UpdateVerifyStatusResp 更新认证状态响应
+type UpdateVerifyStatusResp struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 是否成功
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// 更新前状态
+	BeforeStatus int32 `protobuf:"varint,2,opt,name=before_status,json=beforeStatus,proto3" json:"before_status,omitempty"`
+	// 更新后状态
+	AfterStatus   int32 `protobuf:"varint,3,opt,name=after_status,json=afterStatus,proto3" json:"after_status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateVerifyStatusResp) Reset() {
+	*x = UpdateVerifyStatusResp{}
+	mi := &file_user_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateVerifyStatusResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateVerifyStatusResp) ProtoMessage() {}
+
+func (x *UpdateVerifyStatusResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateVerifyStatusResp.ProtoReflect.Descriptor instead.
+func (*UpdateVerifyStatusResp) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *UpdateVerifyStatusResp) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UpdateVerifyStatusResp) GetBeforeStatus() int32 {
+	if x != nil {
+		return x.BeforeStatus
+	}
+	return 0
+}
+
+func (x *UpdateVerifyStatusResp) GetAfterStatus() int32 {
+	if x != nil {
+		return x.AfterStatus
+	}
+	return 0
+}
+
+// ProcessOcrVerifyReq OCR识别处理请求
+// 由统一 MQ Consumer 消费 verify:events 后调用
+type ProcessOcrVerifyReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 认证记录ID
+	VerifyId int64 `protobuf:"varint,1,opt,name=verify_id,json=verifyId,proto3" json:"verify_id,omitempty"`
+	// 用户ID
+	UserId int64 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// 学生证正面图片URL
+	FrontImageUrl string `protobuf:"bytes,3,opt,name=front_image_url,json=frontImageUrl,proto3" json:"front_image_url,omitempty"`
+	// 学生证详情面图片URL
+	BackImageUrl  string `protobuf:"bytes,4,opt,name=back_image_url,json=backImageUrl,proto3" json:"back_image_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProcessOcrVerifyReq) Reset() {
+	*x = ProcessOcrVerifyReq{}
+	mi := &file_user_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessOcrVerifyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessOcrVerifyReq) ProtoMessage() {}
+
+func (x *ProcessOcrVerifyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessOcrVerifyReq.ProtoReflect.Descriptor instead.
+func (*ProcessOcrVerifyReq) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ProcessOcrVerifyReq) GetVerifyId() int64 {
+	if x != nil {
+		return x.VerifyId
+	}
+	return 0
+}
+
+func (x *ProcessOcrVerifyReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ProcessOcrVerifyReq) GetFrontImageUrl() string {
+	if x != nil {
+		return x.FrontImageUrl
+	}
+	return ""
+}
+
+func (x *ProcessOcrVerifyReq) GetBackImageUrl() string {
+	if x != nil {
+		return x.BackImageUrl
+	}
+	return ""
+}
+
+// ProcessOcrVerifyResp OCR识别处理响应
+type ProcessOcrVerifyResp struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 是否处理成功
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// 处理后的状态码（2=待确认, 6=超时, 8=OCR失败）
+	ResultStatus int32 `protobuf:"varint,2,opt,name=result_status,json=resultStatus,proto3" json:"result_status,omitempty"`
+	// 处理描述
+	Message       string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProcessOcrVerifyResp) Reset() {
+	*x = ProcessOcrVerifyResp{}
+	mi := &file_user_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessOcrVerifyResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessOcrVerifyResp) ProtoMessage() {}
+
+func (x *ProcessOcrVerifyResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessOcrVerifyResp.ProtoReflect.Descriptor instead.
+func (*ProcessOcrVerifyResp) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ProcessOcrVerifyResp) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ProcessOcrVerifyResp) GetResultStatus() int32 {
+	if x != nil {
+		return x.ResultStatus
+	}
+	return 0
+}
+
+func (x *ProcessOcrVerifyResp) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// ListVerifyReviewsReq 查询人工审核队列请求
+type ListVerifyReviewsReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 审核员ID（用于记录查看日志）
+	ReviewerId int64 `protobuf:"varint,1,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	// 页码，从1开始
+	Page int32 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	// 每页条数，默认20，最大50
+	PageSize      int32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVerifyReviewsReq) Reset() {
+	*x = ListVerifyReviewsReq{}
+	mi := &file_user_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVerifyReviewsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVerifyReviewsReq) ProtoMessage() {}
+
+func (x *ListVerifyReviewsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVerifyReviewsReq.ProtoReflect.Descriptor instead.
+func (*ListVerifyReviewsReq) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListVerifyReviewsReq) GetReviewerId() int64 {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return 0
+}
+
+func (x *ListVerifyReviewsReq) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListVerifyReviewsReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// VerifyReviewItem 人工审核队列项
+type VerifyReviewItem struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 认证记录ID
+	VerifyId int64 `protobuf:"varint,1,opt,name=verify_id,json=verifyId,proto3" json:"verify_id,omitempty"`
+	// 用户ID
+	UserId int64 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// 状态
+	Status int32 `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`
+	// 真实姓名（明文）
+	RealName string `protobuf:"bytes,4,opt,name=real_name,json=realName,proto3" json:"real_name,omitempty"`
+	// 学校名称
+	SchoolName string `protobuf:"bytes,5,opt,name=school_name,json=schoolName,proto3" json:"school_name,omitempty"`
+	// 学号（明文）
+	StudentId string `protobuf:"bytes,6,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	// 院系
+	Department string `protobuf:"bytes,7,opt,name=department,proto3" json:"department,omitempty"`
+	// 入学年份
+	AdmissionYear string `protobuf:"bytes,8,opt,name=admission_year,json=admissionYear,proto3" json:"admission_year,omitempty"`
+	// 学生证正面图片（签名URL，限时有效）
+	FrontImageUrl string `protobuf:"bytes,9,opt,name=front_image_url,json=frontImageUrl,proto3" json:"front_image_url,omitempty"`
+	// 学生证详情面图片（签名URL，限时有效）
+	BackImageUrl string `protobuf:"bytes,10,opt,name=back_image_url,json=backImageUrl,proto3" json:"back_image_url,omitempty"`
+	// OCR平台
+	OcrPlatform string `protobuf:"bytes,11,opt,name=ocr_platform,json=ocrPlatform,proto3" json:"ocr_platform,omitempty"`
+	// OCR置信度
+	OcrConfidence float64 `protobuf:"fixed64,12,opt,name=ocr_confidence,json=ocrConfidence,proto3" json:"ocr_confidence,omitempty"`
+	// 当前领取人ID（0=未领取）
+	ClaimedBy int64 `protobuf:"varint,13,opt,name=claimed_by,json=claimedBy,proto3" json:"claimed_by,omitempty"`
+	// 申请时间戳（秒）
+	CreatedAt int64 `protobuf:"varint,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// 提交人工审核时间戳（秒）
+	UpdatedAt     int64 `protobuf:"varint,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyReviewItem) Reset() {
+	*x = VerifyReviewItem{}
+	mi := &file_user_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyReviewItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyReviewItem) ProtoMessage() {}
+
+func (x *VerifyReviewItem) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyReviewItem.ProtoReflect.Descriptor instead.
+func (*VerifyReviewItem) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *VerifyReviewItem) GetVerifyId() int64 {
+	if x != nil {
+		return x.VerifyId
+	}
+	return 0
+}
+
+func (x *VerifyReviewItem) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *VerifyReviewItem) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *VerifyReviewItem) GetRealName() string {
+	if x != nil {
+		return x.RealName
+	}
+	return ""
+}
+
+func (x *VerifyReviewItem) GetSchoolName() string {
+	if x != nil {
+		return x.SchoolName
+	}
+	return ""
+}
+
+func (x *VerifyReviewItem) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *VerifyReviewItem) GetDepartment() string {
+	if x != nil {
+		return x.Department
+	}
+	return ""
+}
+
+func (x *VerifyReviewItem) GetAdmissionYear() string {
+	if x != nil {
+		return x.AdmissionYear
+	}
+	return ""
+}
+
+func (x *VerifyReviewItem) GetFrontImageUrl() string {
+	if x != nil {
+		return x.FrontImageUrl
+	}
+	return ""
+}
+
+func (x *VerifyReviewItem) GetBackImageUrl() string {
+	if x != nil {
+		return x.BackImageUrl
+	}
+	return ""
+}
+
+func (x *VerifyReviewItem) GetOcrPlatform() string {
+	if x != nil {
+		return x.OcrPlatform
+	}
+	return ""
+}
+
+func (x *VerifyReviewItem) GetOcrConfidence() float64 {
+	if x != nil {
+		return x.OcrConfidence
+	}
+	return 0
+}
+
+func (x *VerifyReviewItem) GetClaimedBy() int64 {
+	if x != nil {
+		return x.ClaimedBy
+	}
+	return 0
+}
+
+func (x *VerifyReviewItem) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *VerifyReviewItem) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+// ListVerifyReviewsResp 查询人工审核队列响应
+type ListVerifyReviewsResp struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 队列项
+	List []*VerifyReviewItem `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	// 总数
+	Total         int64 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVerifyReviewsResp) Reset() {
+	*x = ListVerifyReviewsResp{}
+	mi := &file_user_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVerifyReviewsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVerifyReviewsResp) ProtoMessage() {}
+
+func (x *ListVerifyReviewsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVerifyReviewsResp.ProtoReflect.Descriptor instead.
+func (*ListVerifyReviewsResp) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ListVerifyReviewsResp) GetList() []*VerifyReviewItem {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *ListVerifyReviewsResp) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// ClaimVerifyReviewReq 领取/释放审核任务请求
+type ClaimVerifyReviewReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 认证记录ID
+	VerifyId int64 `protobuf:"varint,1,opt,name=verify_id,json=verifyId,proto3" json:"verify_id,omitempty"`
+	// 审核员ID
+	ReviewerId int64 `protobuf:"varint,2,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	// 是否释放（false=领取，true=释放）
+	Release       bool `protobuf:"varint,3,opt,name=release,proto3" json:"release,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimVerifyReviewReq) Reset() {
+	*x = ClaimVerifyReviewReq{}
+	mi := &file_user_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ConfirmStudentVerifyReq) String() string {
+func (x *ClaimVerifyReviewReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConfirmStudentVerifyReq) ProtoMessage() {}
+func (*ClaimVerifyReviewReq) ProtoMessage() {}
 
-func (x *ConfirmStudentVerifyReq) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[21]
+func (x *ClaimVerifyReviewReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1722,71 +3976,58 @@ func (x *ConfirmStudentVerifyReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConfirmStudentVerifyReq.ProtoReflect.Descriptor instead.
-func (*ConfirmStudentVerifyReq) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use ClaimVerifyReviewReq.ProtoReflect.Descriptor instead.
+func (*ClaimVerifyReviewReq) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *ConfirmStudentVerifyReq) GetUserId() int64 {
+func (x *ClaimVerifyReviewReq) GetVerifyId() int64 {
 	if x != nil {
-		return x.UserId
+		return x.VerifyId
 	}
 	return 0
 }
 
-func (x *ConfirmStudentVerifyReq) GetVerifyId() int64 {
+func (x *ClaimVerifyReviewReq) GetReviewerId() int64 {
 	if x != nil {
-		return x.VerifyId
+		return x.ReviewerId
 	}
 	return 0
 }
 
-func (x *ConfirmStudentVerifyReq) GetIsConfirmed() bool {
+func (x *ClaimVerifyReviewReq) GetRelease() bool {
 	if x != nil {
-		return x.IsConfirmed
+		return x.Release
 	}
 	return false
 }
 
-func (x *ConfirmStudentVerifyReq) GetModifiedData() *VerifyModifiedData {
-	if x != nil {
-		return x.ModifiedData
-	}
-	return nil
-}
-
-// VerifyModifiedData 用户修改后的数据
-type VerifyModifiedData struct {
+// ClaimVerifyReviewResp 领取/释放审核任务响应
+type ClaimVerifyReviewResp struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// 真实姓名
-	RealName string `protobuf:"bytes,1,opt,name=real_name,json=realName,proto3" json:"real_name,omitempty"`
-	// 学校名称
-	SchoolName string `protobuf:"bytes,2,opt,name=school_name,json=schoolName,proto3" json:"school_name,omitempty"`
-	// 学号
-	StudentId string `protobuf:"bytes,3,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
-	// 院系
-	Department string `protobuf:"bytes,4,opt,name=department,proto3" json:"department,omitempty"`
-	// 入学年份
-	AdmissionYear string `protobuf:"bytes,5,opt,name=admission_year,json=admissionYear,proto3" json:"admission_year,omitempty"`
+	// 当前领取人ID（释放后为0）
+	ClaimedBy int64 `protobuf:"varint,1,opt,name=claimed_by,json=claimedBy,proto3" json:"claimed_by,omitempty"`
+	// 领取过期时间戳（秒，释放后为0）
+	ExpireAt      int64 `protobuf:"varint,2,opt,name=expire_at,json=expireAt,proto3" json:"expire_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VerifyModifiedData) Reset() {
-	*x = VerifyModifiedData{}
-	mi := &file_user_proto_msgTypes[22]
+func (x *ClaimVerifyReviewResp) Reset() {
+	*x = ClaimVerifyReviewResp{}
+	mi := &file_user_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VerifyModifiedData) String() string {
+func (x *ClaimVerifyReviewResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VerifyModifiedData) ProtoMessage() {}
+func (*ClaimVerifyReviewResp) ProtoMessage() {}
 
-func (x *VerifyModifiedData) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[22]
+func (x *ClaimVerifyReviewResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1797,74 +4038,124 @@ func (x *VerifyModifiedData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VerifyModifiedData.ProtoReflect.Descriptor instead.
-func (*VerifyModifiedData) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use ClaimVerifyReviewResp.ProtoReflect.Descriptor instead.
+func (*ClaimVerifyReviewResp) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *VerifyModifiedData) GetRealName() string {
+func (x *ClaimVerifyReviewResp) GetClaimedBy() int64 {
 	if x != nil {
-		return x.RealName
+		return x.ClaimedBy
 	}
-	return ""
+	return 0
 }
 
-func (x *VerifyModifiedData) GetSchoolName() string {
+func (x *ClaimVerifyReviewResp) GetExpireAt() int64 {
 	if x != nil {
-		return x.SchoolName
+		return x.ExpireAt
 	}
-	return ""
+	return 0
 }
 
-func (x *VerifyModifiedData) GetStudentId() string {
+// ReviewStudentVerifyReq 人工审核请求
+type ReviewStudentVerifyReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 认证记录ID
+	VerifyId int64 `protobuf:"varint,1,opt,name=verify_id,json=verifyId,proto3" json:"verify_id,omitempty"`
+	// 审核员ID
+	ReviewerId int64 `protobuf:"varint,2,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	// 是否通过
+	Approve bool `protobuf:"varint,3,opt,name=approve,proto3" json:"approve,omitempty"`
+	// 拒绝原因（拒绝时必填）
+	Reason        string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewStudentVerifyReq) Reset() {
+	*x = ReviewStudentVerifyReq{}
+	mi := &file_user_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewStudentVerifyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewStudentVerifyReq) ProtoMessage() {}
+
+func (x *ReviewStudentVerifyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[52]
 	if x != nil {
-		return x.StudentId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *VerifyModifiedData) GetDepartment() string {
+// Deprecated: Use ReviewStudentVerifyReq.ProtoReflect.Descriptor instead.
+func (*ReviewStudentVerifyReq) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ReviewStudentVerifyReq) GetVerifyId() int64 {
 	if x != nil {
-		return x.Department
+		return x.VerifyId
 	}
-	return ""
+	return 0
 }
 
-func (x *VerifyModifiedData) GetAdmissionYear() string {
+func (x *ReviewStudentVerifyReq) GetReviewerId() int64 {
 	if x != nil {
-		return x.AdmissionYear
+		return x.ReviewerId
+	}
+	return 0
+}
+
+func (x *ReviewStudentVerifyReq) GetApprove() bool {
+	if x != nil {
+		return x.Approve
+	}
+	return false
+}
+
+func (x *ReviewStudentVerifyReq) GetReason() string {
+	if x != nil {
+		return x.Reason
 	}
 	return ""
 }
 
-// ConfirmStudentVerifyResp 确认/修改认证信息响应
-type ConfirmStudentVerifyResp struct {
+// ReviewStudentVerifyResp 人工审核响应
+type ReviewStudentVerifyResp struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// 认证申请ID
-	VerifyId int64 `protobuf:"varint,1,opt,name=verify_id,json=verifyId,proto3" json:"verify_id,omitempty"`
-	// 更新后的状态码（4=通过，3=人工审核）
-	NewStatus int32 `protobuf:"varint,2,opt,name=new_status,json=newStatus,proto3" json:"new_status,omitempty"`
-	// 状态描述
-	NewStatusDesc string `protobuf:"bytes,3,opt,name=new_status_desc,json=newStatusDesc,proto3" json:"new_status_desc,omitempty"`
+	// 变更前状态
+	BeforeStatus int32 `protobuf:"varint,1,opt,name=before_status,json=beforeStatus,proto3" json:"before_status,omitempty"`
+	// 变更后状态
+	AfterStatus   int32 `protobuf:"varint,2,opt,name=after_status,json=afterStatus,proto3" json:"after_status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ConfirmStudentVerifyResp) Reset() {
-	*x = ConfirmStudentVerifyResp{}
-	mi := &file_user_proto_msgTypes[23]
+func (x *ReviewStudentVerifyResp) Reset() {
+	*x = ReviewStudentVerifyResp{}
+	mi := &file_user_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ConfirmStudentVerifyResp) String() string {
+func (x *ReviewStudentVerifyResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConfirmStudentVerifyResp) ProtoMessage() {}
+func (*ReviewStudentVerifyResp) ProtoMessage() {}
 
-func (x *ConfirmStudentVerifyResp) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[23]
+func (x *ReviewStudentVerifyResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1875,60 +4166,110 @@ func (x *ConfirmStudentVerifyResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConfirmStudentVerifyResp.ProtoReflect.Descriptor instead.
-func (*ConfirmStudentVerifyResp) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use ReviewStudentVerifyResp.ProtoReflect.Descriptor instead.
+func (*ReviewStudentVerifyResp) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *ConfirmStudentVerifyResp) GetVerifyId() int64 {
+func (x *ReviewStudentVerifyResp) GetBeforeStatus() int32 {
 	if x != nil {
-		return x.VerifyId
+		return x.BeforeStatus
 	}
 	return 0
 }
 
-func (x *ConfirmStudentVerifyResp) GetNewStatus() int32 {
+func (x *ReviewStudentVerifyResp) GetAfterStatus() int32 {
 	if x != nil {
-		return x.NewStatus
+		return x.AfterStatus
 	}
 	return 0
 }
 
-func (x *ConfirmStudentVerifyResp) GetNewStatusDesc() string {
+// GetVerifyReviewStatsReq 审核员处理量统计请求
+type GetVerifyReviewStatsReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 开始时间戳（秒），0表示近7天
+	StartTime int64 `protobuf:"varint,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// 结束时间戳（秒），0表示当前
+	EndTime       int64 `protobuf:"varint,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVerifyReviewStatsReq) Reset() {
+	*x = GetVerifyReviewStatsReq{}
+	mi := &file_user_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVerifyReviewStatsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVerifyReviewStatsReq) ProtoMessage() {}
+
+func (x *GetVerifyReviewStatsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[54]
 	if x != nil {
-		return x.NewStatusDesc
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-// CancelStudentVerifyReq 取消认证申请请求
-type CancelStudentVerifyReq struct {
+// Deprecated: Use GetVerifyReviewStatsReq.ProtoReflect.Descriptor instead.
+func (*GetVerifyReviewStatsReq) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetVerifyReviewStatsReq) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *GetVerifyReviewStatsReq) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+// VerifyReviewerStat 审核员处理量
+type VerifyReviewerStat struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// 用户ID（用于鉴权校验）
-	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	// 认证申请ID
-	VerifyId int64 `protobuf:"varint,2,opt,name=verify_id,json=verifyId,proto3" json:"verify_id,omitempty"`
-	// 取消原因（可选）
-	CancelReason  string `protobuf:"bytes,3,opt,name=cancel_reason,json=cancelReason,proto3" json:"cancel_reason,omitempty"`
+	// 审核员ID
+	ReviewerId int64 `protobuf:"varint,1,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	// 通过数
+	Approved int64 `protobuf:"varint,2,opt,name=approved,proto3" json:"approved,omitempty"`
+	// 拒绝数
+	Rejected int64 `protobuf:"varint,3,opt,name=rejected,proto3" json:"rejected,omitempty"`
+	// 合计
+	Total         int64 `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelStudentVerifyReq) Reset() {
-	*x = CancelStudentVerifyReq{}
-	mi := &file_user_proto_msgTypes[24]
+func (x *VerifyReviewerStat) Reset() {
+	*x = VerifyReviewerStat{}
+	mi := &file_user_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelStudentVerifyReq) String() string {
+func (x *VerifyReviewerStat) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelStudentVerifyReq) ProtoMessage() {}
+func (*VerifyReviewerStat) ProtoMessage() {}
 
-func (x *CancelStudentVerifyReq) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[24]
+func (x *VerifyReviewerStat) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1939,60 +4280,65 @@ func (x *CancelStudentVerifyReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelStudentVerifyReq.ProtoReflect.Descriptor instead.
-func (*CancelStudentVerifyReq) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use VerifyReviewerStat.ProtoReflect.Descriptor instead.
+func (*VerifyReviewerStat) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *CancelStudentVerifyReq) GetUserId() int64 {
+func (x *VerifyReviewerStat) GetReviewerId() int64 {
 	if x != nil {
-		return x.UserId
+		return x.ReviewerId
 	}
 	return 0
 }
 
-func (x *CancelStudentVerifyReq) GetVerifyId() int64 {
+func (x *VerifyReviewerStat) GetApproved() int64 {
 	if x != nil {
-		return x.VerifyId
+		return x.Approved
 	}
 	return 0
 }
 
-func (x *CancelStudentVerifyReq) GetCancelReason() string {
+func (x *VerifyReviewerStat) GetRejected() int64 {
 	if x != nil {
-		return x.CancelReason
+		return x.Rejected
 	}
-	return ""
+	return 0
 }
 
-// CancelStudentVerifyResp 取消认证申请响应
-type CancelStudentVerifyResp struct {
+func (x *VerifyReviewerStat) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// GetVerifyReviewStatsResp 审核员处理量统计响应
+type GetVerifyReviewStatsResp struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// 认证申请ID
-	VerifyId int64 `protobuf:"varint,1,opt,name=verify_id,json=verifyId,proto3" json:"verify_id,omitempty"`
-	// 更新后的状态码（7=已取消）
-	NewStatus int32 `protobuf:"varint,2,opt,name=new_status,json=newStatus,proto3" json:"new_status,omitempty"`
-	// 状态描述
-	NewStatusDesc string `protobuf:"bytes,3,opt,name=new_status_desc,json=newStatusDesc,proto3" json:"new_status_desc,omitempty"`
+	// 各审核员处理量
+	List []*VerifyReviewerStat `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	// 当前待审核数量
+	Pending       int64 `protobuf:"varint,2,opt,name=pending,proto3" json:"pending,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelStudentVerifyResp) Reset() {
-	*x = CancelStudentVerifyResp{}
-	mi := &file_user_proto_msgTypes[25]
+func (x *GetVerifyReviewStatsResp) Reset() {
+	*x = GetVerifyReviewStatsResp{}
+	mi := &file_user_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelStudentVerifyResp) String() string {
+func (x *GetVerifyReviewStatsResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelStudentVerifyResp) ProtoMessage() {}
+func (*GetVerifyReviewStatsResp) ProtoMessage() {}
 
-func (x *CancelStudentVerifyResp) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[25]
+func (x *GetVerifyReviewStatsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2003,68 +4349,51 @@ func (x *CancelStudentVerifyResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelStudentVerifyResp.ProtoReflect.Descriptor instead.
-func (*CancelStudentVerifyResp) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{25}
-}
-
-func (x *CancelStudentVerifyResp) GetVerifyId() int64 {
-	if x != nil {
-		return x.VerifyId
-	}
-	return 0
+// Deprecated: Use GetVerifyReviewStatsResp.ProtoReflect.Descriptor instead.
+func (*GetVerifyReviewStatsResp) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *CancelStudentVerifyResp) GetNewStatus() int32 {
+func (x *GetVerifyReviewStatsResp) GetList() []*VerifyReviewerStat {
 	if x != nil {
-		return x.NewStatus
+		return x.List
 	}
-	return 0
+	return nil
 }
 
-func (x *CancelStudentVerifyResp) GetNewStatusDesc() string {
+func (x *GetVerifyReviewStatsResp) GetPending() int64 {
 	if x != nil {
-		return x.NewStatusDesc
+		return x.Pending
 	}
-	return ""
+	return 0
 }
 
-// UpdateVerifyStatusReq 更新认证状态请求
-type UpdateVerifyStatusReq struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// 认证记录ID
-	VerifyId int64 `protobuf:"varint,1,opt,name=verify_id,json=verifyId,proto3" json:"verify_id,omitempty"`
-	// 用户ID
-	UserId int64 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	// 新状态
-	// 1-OCR审核中, 2-待确认, 3-人工审核中, 4-已通过, 5-已拒绝, 6-已超时, 7-已取消, 8-OCR失败
-	NewStatus int32 `protobuf:"varint,3,opt,name=new_status,json=newStatus,proto3" json:"new_status,omitempty"`
-	// OCR识别数据（状态为2-待确认时填充）
-	OcrData *VerifyOcrData `protobuf:"bytes,4,opt,name=ocr_data,json=ocrData,proto3" json:"ocr_data,omitempty"`
-	// 拒绝原因（状态为5-已拒绝时填充）
-	RejectReason string `protobuf:"bytes,5,opt,name=reject_reason,json=rejectReason,proto3" json:"reject_reason,omitempty"`
-	// 操作来源
-	// 示例: "ocr_callback", "manual_review", "timeout_job"
-	Operator      string `protobuf:"bytes,6,opt,name=operator,proto3" json:"operator,omitempty"`
+// 创建兴趣标签
+type CreateInterestTagReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Color         string                 `protobuf:"bytes,2,opt,name=color,proto3" json:"color,omitempty"`
+	Icon          string                 `protobuf:"bytes,3,opt,name=icon,proto3" json:"icon,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateVerifyStatusReq) Reset() {
-	*x = UpdateVerifyStatusReq{}
-	mi := &file_user_proto_msgTypes[26]
+func (x *CreateInterestTagReq) Reset() {
+	*x = CreateInterestTagReq{}
+	mi := &file_user_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateVerifyStatusReq) String() string {
+func (x *CreateInterestTagReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateVerifyStatusReq) ProtoMessage() {}
+func (*CreateInterestTagReq) ProtoMessage() {}
 
-func (x *UpdateVerifyStatusReq) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[26]
+func (x *CreateInterestTagReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2075,91 +4404,66 @@ func (x *UpdateVerifyStatusReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateVerifyStatusReq.ProtoReflect.Descriptor instead.
-func (*UpdateVerifyStatusReq) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{26}
-}
-
-func (x *UpdateVerifyStatusReq) GetVerifyId() int64 {
-	if x != nil {
-		return x.VerifyId
-	}
-	return 0
-}
-
-func (x *UpdateVerifyStatusReq) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+// Deprecated: Use CreateInterestTagReq.ProtoReflect.Descriptor instead.
+func (*CreateInterestTagReq) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *UpdateVerifyStatusReq) GetNewStatus() int32 {
+func (x *CreateInterestTagReq) GetName() string {
 	if x != nil {
-		return x.NewStatus
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *UpdateVerifyStatusReq) GetOcrData() *VerifyOcrData {
+func (x *CreateInterestTagReq) GetColor() string {
 	if x != nil {
-		return x.OcrData
+		return x.Color
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateVerifyStatusReq) GetRejectReason() string {
+func (x *CreateInterestTagReq) GetIcon() string {
 	if x != nil {
-		return x.RejectReason
+		return x.Icon
 	}
 	return ""
 }
 
-func (x *UpdateVerifyStatusReq) GetOperator() string {
+func (x *CreateInterestTagReq) GetDescription() string {
 	if x != nil {
-		return x.Operator
+		return x.Description
 	}
 	return ""
 }
 
-// VerifyOcrData OCR识别数据
-type VerifyOcrData struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// 真实姓名
-	RealName string `protobuf:"bytes,1,opt,name=real_name,json=realName,proto3" json:"real_name,omitempty"`
-	// 学校名称
-	SchoolName string `protobuf:"bytes,2,opt,name=school_name,json=schoolName,proto3" json:"school_name,omitempty"`
-	// 学号
-	StudentId string `protobuf:"bytes,3,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
-	// 院系
-	Department string `protobuf:"bytes,4,opt,name=department,proto3" json:"department,omitempty"`
-	// 入学年份
-	AdmissionYear string `protobuf:"bytes,5,opt,name=admission_year,json=admissionYear,proto3" json:"admission_year,omitempty"`
-	// OCR平台
-	OcrPlatform string `protobuf:"bytes,6,opt,name=ocr_platform,json=ocrPlatform,proto3" json:"ocr_platform,omitempty"`
-	// OCR置信度
-	OcrConfidence float64 `protobuf:"fixed64,7,opt,name=ocr_confidence,json=ocrConfidence,proto3" json:"ocr_confidence,omitempty"`
-	// OCR原始响应JSON
-	OcrRawJson    string `protobuf:"bytes,8,opt,name=ocr_raw_json,json=ocrRawJson,proto3" json:"ocr_raw_json,omitempty"`
+// 修改兴趣标签（空字段不修改）
+type UpdateInterestTagReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Color         string                 `protobuf:"bytes,3,opt,name=color,proto3" json:"color,omitempty"`
+	Icon          string                 `protobuf:"bytes,4,opt,name=icon,proto3" json:"icon,omitempty"`
+	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VerifyOcrData) Reset() {
-	*x = VerifyOcrData{}
-	mi := &file_user_proto_msgTypes[27]
+func (x *UpdateInterestTagReq) Reset() {
+	*x = UpdateInterestTagReq{}
+	mi := &file_user_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VerifyOcrData) String() string {
+func (x *UpdateInterestTagReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VerifyOcrData) ProtoMessage() {}
+func (*UpdateInterestTagReq) ProtoMessage() {}
 
-func (x *VerifyOcrData) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[27]
+func (x *UpdateInterestTagReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2170,95 +4474,70 @@ func (x *VerifyOcrData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VerifyOcrData.ProtoReflect.Descriptor instead.
-func (*VerifyOcrData) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{27}
-}
-
-func (x *VerifyOcrData) GetRealName() string {
-	if x != nil {
-		return x.RealName
-	}
-	return ""
-}
-
-func (x *VerifyOcrData) GetSchoolName() string {
-	if x != nil {
-		return x.SchoolName
-	}
-	return ""
+// Deprecated: Use UpdateInterestTagReq.ProtoReflect.Descriptor instead.
+func (*UpdateInterestTagReq) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *VerifyOcrData) GetStudentId() string {
+func (x *UpdateInterestTagReq) GetId() int64 {
 	if x != nil {
-		return x.StudentId
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *VerifyOcrData) GetDepartment() string {
+func (x *UpdateInterestTagReq) GetName() string {
 	if x != nil {
-		return x.Department
+		return x.Name
 	}
 	return ""
 }
 
-func (x *VerifyOcrData) GetAdmissionYear() string {
+func (x *UpdateInterestTagReq) GetColor() string {
 	if x != nil {
-		return x.AdmissionYear
+		return x.Color
 	}
 	return ""
 }
 
-func (x *VerifyOcrData) GetOcrPlatform() string {
+func (x *UpdateInterestTagReq) GetIcon() string {
 	if x != nil {
-		return x.OcrPlatform
+		return x.Icon
 	}
 	return ""
 }
 
-func (x *VerifyOcrData) GetOcrConfidence() float64 {
-	if x != nil {
-		return x.OcrConfidence
-	}
-	return 0
-}
-
-func (x *VerifyOcrData) GetOcrRawJson() string {
+func (x *UpdateInterestTagReq) GetDescription() string {
 	if x != nil {
-		return x.OcrRawJson
+		return x.Description
 	}
 	return ""
 }
 
-// UpdateVerifyStatusResp 更新认证状态响应
-type UpdateVerifyStatusResp struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// 是否成功
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	// 更新前状态
-	BeforeStatus int32 `protobuf:"varint,2,opt,name=before_status,json=beforeStatus,proto3" json:"before_status,omitempty"`
-	// 更新后状态
-	AfterStatus   int32 `protobuf:"varint,3,opt,name=after_status,json=afterStatus,proto3" json:"after_status,omitempty"`
+// 启用/禁用兴趣标签
+type SetInterestTagStatusReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        int64                  `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"` // 0-禁用，1-正常
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateVerifyStatusResp) Reset() {
-	*x = UpdateVerifyStatusResp{}
-	mi := &file_user_proto_msgTypes[28]
+func (x *SetInterestTagStatusReq) Reset() {
+	*x = SetInterestTagStatusReq{}
+	mi := &file_user_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateVerifyStatusResp) String() string {
+func (x *SetInterestTagStatusReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateVerifyStatusResp) ProtoMessage() {}
+func (*SetInterestTagStatusReq) ProtoMessage() {}
 
-func (x *UpdateVerifyStatusResp) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[28]
+func (x *SetInterestTagStatusReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2269,63 +4548,47 @@ func (x *UpdateVerifyStatusResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateVerifyStatusResp.ProtoReflect.Descriptor instead.
-func (*UpdateVerifyStatusResp) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{28}
-}
-
-func (x *UpdateVerifyStatusResp) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
+// Deprecated: Use SetInterestTagStatusReq.ProtoReflect.Descriptor instead.
+func (*SetInterestTagStatusReq) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *UpdateVerifyStatusResp) GetBeforeStatus() int32 {
+func (x *SetInterestTagStatusReq) GetId() int64 {
 	if x != nil {
-		return x.BeforeStatus
+		return x.Id
 	}
 	return 0
 }
 
-func (x *UpdateVerifyStatusResp) GetAfterStatus() int32 {
+func (x *SetInterestTagStatusReq) GetStatus() int64 {
 	if x != nil {
-		return x.AfterStatus
+		return x.Status
 	}
 	return 0
 }
 
-// ProcessOcrVerifyReq OCR识别处理请求
-// 由统一 MQ Consumer 消费 verify:events 后调用
-type ProcessOcrVerifyReq struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// 认证记录ID
-	VerifyId int64 `protobuf:"varint,1,opt,name=verify_id,json=verifyId,proto3" json:"verify_id,omitempty"`
-	// 用户ID
-	UserId int64 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	// 学生证正面图片URL
-	FrontImageUrl string `protobuf:"bytes,3,opt,name=front_image_url,json=frontImageUrl,proto3" json:"front_image_url,omitempty"`
-	// 学生证详情面图片URL
-	BackImageUrl  string `protobuf:"bytes,4,opt,name=back_image_url,json=backImageUrl,proto3" json:"back_image_url,omitempty"`
+type InterestTagResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tag           *TagInfo               `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProcessOcrVerifyReq) Reset() {
-	*x = ProcessOcrVerifyReq{}
-	mi := &file_user_proto_msgTypes[29]
+func (x *InterestTagResp) Reset() {
+	*x = InterestTagResp{}
+	mi := &file_user_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProcessOcrVerifyReq) String() string {
+func (x *InterestTagResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProcessOcrVerifyReq) ProtoMessage() {}
+func (*InterestTagResp) ProtoMessage() {}
 
-func (x *ProcessOcrVerifyReq) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[29]
+func (x *InterestTagResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2336,67 +4599,95 @@ func (x *ProcessOcrVerifyReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProcessOcrVerifyReq.ProtoReflect.Descriptor instead.
-func (*ProcessOcrVerifyReq) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use InterestTagResp.ProtoReflect.Descriptor instead.
+func (*InterestTagResp) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *ProcessOcrVerifyReq) GetVerifyId() int64 {
+func (x *InterestTagResp) GetTag() *TagInfo {
 	if x != nil {
-		return x.VerifyId
+		return x.Tag
 	}
-	return 0
+	return nil
 }
 
-func (x *ProcessOcrVerifyReq) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+// 合并兴趣标签
+type MergeInterestTagsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SourceId      int64                  `protobuf:"varint,1,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	TargetId      int64                  `protobuf:"varint,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProcessOcrVerifyReq) GetFrontImageUrl() string {
-	if x != nil {
-		return x.FrontImageUrl
-	}
-	return ""
+func (x *MergeInterestTagsReq) Reset() {
+	*x = MergeInterestTagsReq{}
+	mi := &file_user_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProcessOcrVerifyReq) GetBackImageUrl() string {
+func (x *MergeInterestTagsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeInterestTagsReq) ProtoMessage() {}
+
+func (x *MergeInterestTagsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[61]
 	if x != nil {
-		return x.BackImageUrl
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-// ProcessOcrVerifyResp OCR识别处理响应
-type ProcessOcrVerifyResp struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// 是否处理成功
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	// 处理后的状态码（2=待确认, 6=超时, 8=OCR失败）
-	ResultStatus int32 `protobuf:"varint,2,opt,name=result_status,json=resultStatus,proto3" json:"result_status,omitempty"`
-	// 处理描述
-	Message       string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+// Deprecated: Use MergeInterestTagsReq.ProtoReflect.Descriptor instead.
+func (*MergeInterestTagsReq) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *MergeInterestTagsReq) GetSourceId() int64 {
+	if x != nil {
+		return x.SourceId
+	}
+	return 0
+}
+
+func (x *MergeInterestTagsReq) GetTargetId() int64 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+type MergeInterestTagsResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Source        *TagInfo               `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Target        *TagInfo               `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	MovedUsers    int64                  `protobuf:"varint,3,opt,name=moved_users,json=movedUsers,proto3" json:"moved_users,omitempty"` // 改绑的用户关联数（重复关联直接删除，不计入）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProcessOcrVerifyResp) Reset() {
-	*x = ProcessOcrVerifyResp{}
-	mi := &file_user_proto_msgTypes[30]
+func (x *MergeInterestTagsResp) Reset() {
+	*x = MergeInterestTagsResp{}
+	mi := &file_user_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProcessOcrVerifyResp) String() string {
+func (x *MergeInterestTagsResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProcessOcrVerifyResp) ProtoMessage() {}
+func (*MergeInterestTagsResp) ProtoMessage() {}
 
-func (x *ProcessOcrVerifyResp) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[30]
+func (x *MergeInterestTagsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2407,30 +4698,30 @@ func (x *ProcessOcrVerifyResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProcessOcrVerifyResp.ProtoReflect.Descriptor instead.
-func (*ProcessOcrVerifyResp) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use MergeInterestTagsResp.ProtoReflect.Descriptor instead.
+func (*MergeInterestTagsResp) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *ProcessOcrVerifyResp) GetSuccess() bool {
+func (x *MergeInterestTagsResp) GetSource() *TagInfo {
 	if x != nil {
-		return x.Success
+		return x.Source
 	}
-	return false
+	return nil
 }
 
-func (x *ProcessOcrVerifyResp) GetResultStatus() int32 {
+func (x *MergeInterestTagsResp) GetTarget() *TagInfo {
 	if x != nil {
-		return x.ResultStatus
+		return x.Target
 	}
-	return 0
+	return nil
 }
 
-func (x *ProcessOcrVerifyResp) GetMessage() string {
+func (x *MergeInterestTagsResp) GetMovedUsers() int64 {
 	if x != nil {
-		return x.Message
+		return x.MovedUsers
 	}
-	return ""
+	return 0
 }
 
 // 修改用户兴趣
@@ -2444,7 +4735,7 @@ type UpdateUserTagReq struct {
 
 func (x *UpdateUserTagReq) Reset() {
 	*x = UpdateUserTagReq{}
-	mi := &file_user_proto_msgTypes[31]
+	mi := &file_user_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2456,7 +4747,7 @@ func (x *UpdateUserTagReq) String() string {
 func (*UpdateUserTagReq) ProtoMessage() {}
 
 func (x *UpdateUserTagReq) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[31]
+	mi := &file_user_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2469,7 +4760,7 @@ func (x *UpdateUserTagReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateUserTagReq.ProtoReflect.Descriptor instead.
 func (*UpdateUserTagReq) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{31}
+	return file_user_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *UpdateUserTagReq) GetIds() []int64 {
@@ -2495,7 +4786,7 @@ type UpdateUserTagResponse struct {
 
 func (x *UpdateUserTagResponse) Reset() {
 	*x = UpdateUserTagResponse{}
-	mi := &file_user_proto_msgTypes[32]
+	mi := &file_user_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2507,7 +4798,7 @@ func (x *UpdateUserTagResponse) String() string {
 func (*UpdateUserTagResponse) ProtoMessage() {}
 
 func (x *UpdateUserTagResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[32]
+	mi := &file_user_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2520,7 +4811,7 @@ func (x *UpdateUserTagResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateUserTagResponse.ProtoReflect.Descriptor instead.
 func (*UpdateUserTagResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{32}
+	return file_user_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *UpdateUserTagResponse) GetTags() []*TagBasicInfo {
@@ -2544,7 +4835,7 @@ type TagBasicInfo struct {
 
 func (x *TagBasicInfo) Reset() {
 	*x = TagBasicInfo{}
-	mi := &file_user_proto_msgTypes[33]
+	mi := &file_user_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2556,7 +4847,7 @@ func (x *TagBasicInfo) String() string {
 func (*TagBasicInfo) ProtoMessage() {}
 
 func (x *TagBasicInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[33]
+	mi := &file_user_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2569,7 +4860,7 @@ func (x *TagBasicInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TagBasicInfo.ProtoReflect.Descriptor instead.
 func (*TagBasicInfo) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{33}
+	return file_user_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *TagBasicInfo) GetId() uint64 {
@@ -2616,7 +4907,7 @@ type GetAllTagsReq struct {
 
 func (x *GetAllTagsReq) Reset() {
 	*x = GetAllTagsReq{}
-	mi := &file_user_proto_msgTypes[34]
+	mi := &file_user_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2628,7 +4919,7 @@ func (x *GetAllTagsReq) String() string {
 func (*GetAllTagsReq) ProtoMessage() {}
 
 func (x *GetAllTagsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[34]
+	mi := &file_user_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2641,7 +4932,7 @@ func (x *GetAllTagsReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllTagsReq.ProtoReflect.Descriptor instead.
 func (*GetAllTagsReq) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{34}
+	return file_user_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *GetAllTagsReq) GetSinceTimestamp() int64 {
@@ -2661,7 +4952,7 @@ type GetAllTagsResp struct {
 
 func (x *GetAllTagsResp) Reset() {
 	*x = GetAllTagsResp{}
-	mi := &file_user_proto_msgTypes[35]
+	mi := &file_user_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2673,7 +4964,7 @@ func (x *GetAllTagsResp) String() string {
 func (*GetAllTagsResp) ProtoMessage() {}
 
 func (x *GetAllTagsResp) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[35]
+	mi := &file_user_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2686,7 +4977,7 @@ func (x *GetAllTagsResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllTagsResp.ProtoReflect.Descriptor instead.
 func (*GetAllTagsResp) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{35}
+	return file_user_proto_rawDescGZIP(), []int{67}
 }
 
 func (x *GetAllTagsResp) GetTags() []*TagInfo {
@@ -2712,7 +5003,7 @@ type GetTagsByIdsReq struct {
 
 func (x *GetTagsByIdsReq) Reset() {
 	*x = GetTagsByIdsReq{}
-	mi := &file_user_proto_msgTypes[36]
+	mi := &file_user_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2724,7 +5015,7 @@ func (x *GetTagsByIdsReq) String() string {
 func (*GetTagsByIdsReq) ProtoMessage() {}
 
 func (x *GetTagsByIdsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[36]
+	mi := &file_user_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2737,7 +5028,7 @@ func (x *GetTagsByIdsReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTagsByIdsReq.ProtoReflect.Descriptor instead.
 func (*GetTagsByIdsReq) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{36}
+	return file_user_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *GetTagsByIdsReq) GetIds() []int64 {
@@ -2757,7 +5048,7 @@ type GetTagsByIdsResp struct {
 
 func (x *GetTagsByIdsResp) Reset() {
 	*x = GetTagsByIdsResp{}
-	mi := &file_user_proto_msgTypes[37]
+	mi := &file_user_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2769,7 +5060,7 @@ func (x *GetTagsByIdsResp) String() string {
 func (*GetTagsByIdsResp) ProtoMessage() {}
 
 func (x *GetTagsByIdsResp) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[37]
+	mi := &file_user_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2782,7 +5073,7 @@ func (x *GetTagsByIdsResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTagsByIdsResp.ProtoReflect.Descriptor instead.
 func (*GetTagsByIdsResp) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{37}
+	return file_user_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *GetTagsByIdsResp) GetTags() []*TagInfo {
@@ -2815,7 +5106,7 @@ type TagInfo struct {
 
 func (x *TagInfo) Reset() {
 	*x = TagInfo{}
-	mi := &file_user_proto_msgTypes[38]
+	mi := &file_user_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2827,7 +5118,7 @@ func (x *TagInfo) String() string {
 func (*TagInfo) ProtoMessage() {}
 
 func (x *TagInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[38]
+	mi := &file_user_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2840,7 +5131,7 @@ func (x *TagInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TagInfo.ProtoReflect.Descriptor instead.
 func (*TagInfo) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{38}
+	return file_user_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *TagInfo) GetId() uint64 {
@@ -2908,7 +5199,7 @@ type GetUserTagsReq struct {
 
 func (x *GetUserTagsReq) Reset() {
 	*x = GetUserTagsReq{}
-	mi := &file_user_proto_msgTypes[39]
+	mi := &file_user_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2920,7 +5211,7 @@ func (x *GetUserTagsReq) String() string {
 func (*GetUserTagsReq) ProtoMessage() {}
 
 func (x *GetUserTagsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[39]
+	mi := &file_user_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2933,7 +5224,7 @@ func (x *GetUserTagsReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserTagsReq.ProtoReflect.Descriptor instead.
 func (*GetUserTagsReq) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{39}
+	return file_user_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *GetUserTagsReq) GetUserId() int64 {
@@ -2952,7 +5243,7 @@ type GetUserTagsResponse struct {
 
 func (x *GetUserTagsResponse) Reset() {
 	*x = GetUserTagsResponse{}
-	mi := &file_user_proto_msgTypes[40]
+	mi := &file_user_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2964,7 +5255,7 @@ func (x *GetUserTagsResponse) String() string {
 func (*GetUserTagsResponse) ProtoMessage() {}
 
 func (x *GetUserTagsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[40]
+	mi := &file_user_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2977,7 +5268,7 @@ func (x *GetUserTagsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserTagsResponse.ProtoReflect.Descriptor instead.
 func (*GetUserTagsResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{40}
+	return file_user_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *GetUserTagsResponse) GetTags() []*UserTag {
@@ -2997,7 +5288,7 @@ type UserTag struct {
 
 func (x *UserTag) Reset() {
 	*x = UserTag{}
-	mi := &file_user_proto_msgTypes[41]
+	mi := &file_user_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3009,7 +5300,7 @@ func (x *UserTag) String() string {
 func (*UserTag) ProtoMessage() {}
 
 func (x *UserTag) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[41]
+	mi := &file_user_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3022,7 +5313,7 @@ func (x *UserTag) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserTag.ProtoReflect.Descriptor instead.
 func (*UserTag) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{41}
+	return file_user_proto_rawDescGZIP(), []int{73}
 }
 
 func (x *UserTag) GetId() uint64 {
@@ -3047,7 +5338,7 @@ type GetAllInterestTagsReq struct {
 
 func (x *GetAllInterestTagsReq) Reset() {
 	*x = GetAllInterestTagsReq{}
-	mi := &file_user_proto_msgTypes[42]
+	mi := &file_user_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3059,7 +5350,7 @@ func (x *GetAllInterestTagsReq) String() string {
 func (*GetAllInterestTagsReq) ProtoMessage() {}
 
 func (x *GetAllInterestTagsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[42]
+	mi := &file_user_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3072,7 +5363,7 @@ func (x *GetAllInterestTagsReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllInterestTagsReq.ProtoReflect.Descriptor instead.
 func (*GetAllInterestTagsReq) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{42}
+	return file_user_proto_rawDescGZIP(), []int{74}
 }
 
 type GetAllInterestTagsResp struct {
@@ -3084,7 +5375,7 @@ type GetAllInterestTagsResp struct {
 
 func (x *GetAllInterestTagsResp) Reset() {
 	*x = GetAllInterestTagsResp{}
-	mi := &file_user_proto_msgTypes[43]
+	mi := &file_user_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3096,7 +5387,7 @@ func (x *GetAllInterestTagsResp) String() string {
 func (*GetAllInterestTagsResp) ProtoMessage() {}
 
 func (x *GetAllInterestTagsResp) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[43]
+	mi := &file_user_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3109,7 +5400,7 @@ func (x *GetAllInterestTagsResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllInterestTagsResp.ProtoReflect.Descriptor instead.
 func (*GetAllInterestTagsResp) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{43}
+	return file_user_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *GetAllInterestTagsResp) GetInterestTags() []*InterestTag {
@@ -3130,7 +5421,7 @@ type GetSysImageReq struct {
 
 func (x *GetSysImageReq) Reset() {
 	*x = GetSysImageReq{}
-	mi := &file_user_proto_msgTypes[44]
+	mi := &file_user_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3142,7 +5433,7 @@ func (x *GetSysImageReq) String() string {
 func (*GetSysImageReq) ProtoMessage() {}
 
 func (x *GetSysImageReq) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[44]
+	mi := &file_user_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3155,7 +5446,7 @@ func (x *GetSysImageReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSysImageReq.ProtoReflect.Descriptor instead.
 func (*GetSysImageReq) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{44}
+	return file_user_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *GetSysImageReq) GetUserId() int64 {
@@ -3182,7 +5473,7 @@ type GetSysImageResp struct {
 
 func (x *GetSysImageResp) Reset() {
 	*x = GetSysImageResp{}
-	mi := &file_user_proto_msgTypes[45]
+	mi := &file_user_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3194,7 +5485,7 @@ func (x *GetSysImageResp) String() string {
 func (*GetSysImageResp) ProtoMessage() {}
 
 func (x *GetSysImageResp) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[45]
+	mi := &file_user_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3207,7 +5498,7 @@ func (x *GetSysImageResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSysImageResp.ProtoReflect.Descriptor instead.
 func (*GetSysImageResp) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{45}
+	return file_user_proto_rawDescGZIP(), []int{77}
 }
 
 func (x *GetSysImageResp) GetUrl() string {
@@ -3228,7 +5519,7 @@ type UpdateSysImageRefCountReq struct {
 
 func (x *UpdateSysImageRefCountReq) Reset() {
 	*x = UpdateSysImageRefCountReq{}
-	mi := &file_user_proto_msgTypes[46]
+	mi := &file_user_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3240,7 +5531,7 @@ func (x *UpdateSysImageRefCountReq) String() string {
 func (*UpdateSysImageRefCountReq) ProtoMessage() {}
 
 func (x *UpdateSysImageRefCountReq) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[46]
+	mi := &file_user_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3253,7 +5544,7 @@ func (x *UpdateSysImageRefCountReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateSysImageRefCountReq.ProtoReflect.Descriptor instead.
 func (*UpdateSysImageRefCountReq) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{46}
+	return file_user_proto_rawDescGZIP(), []int{78}
 }
 
 func (x *UpdateSysImageRefCountReq) GetImageId() int64 {
@@ -3280,7 +5571,7 @@ type UpdateSysImageRefCountResp struct {
 
 func (x *UpdateSysImageRefCountResp) Reset() {
 	*x = UpdateSysImageRefCountResp{}
-	mi := &file_user_proto_msgTypes[47]
+	mi := &file_user_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3292,7 +5583,7 @@ func (x *UpdateSysImageRefCountResp) String() string {
 func (*UpdateSysImageRefCountResp) ProtoMessage() {}
 
 func (x *UpdateSysImageRefCountResp) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[47]
+	mi := &file_user_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3305,7 +5596,7 @@ func (x *UpdateSysImageRefCountResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateSysImageRefCountResp.ProtoReflect.Descriptor instead.
 func (*UpdateSysImageRefCountResp) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{47}
+	return file_user_proto_rawDescGZIP(), []int{79}
 }
 
 func (x *UpdateSysImageRefCountResp) GetNewRefCount() int64 {
@@ -3332,7 +5623,7 @@ type GetUserHomeReq struct {
 
 func (x *GetUserHomeReq) Reset() {
 	*x = GetUserHomeReq{}
-	mi := &file_user_proto_msgTypes[48]
+	mi := &file_user_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3344,7 +5635,7 @@ func (x *GetUserHomeReq) String() string {
 func (*GetUserHomeReq) ProtoMessage() {}
 
 func (x *GetUserHomeReq) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[48]
+	mi := &file_user_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3357,7 +5648,7 @@ func (x *GetUserHomeReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserHomeReq.ProtoReflect.Descriptor instead.
 func (*GetUserHomeReq) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{48}
+	return file_user_proto_rawDescGZIP(), []int{80}
 }
 
 func (x *GetUserHomeReq) GetUserId() int64 {
@@ -3413,13 +5704,15 @@ type GetUserHomeResp struct {
 	JoinedActivities *UserHomeActivityList `protobuf:"bytes,3,opt,name=joined_activities,json=joinedActivities,proto3" json:"joined_activities,omitempty"`
 	// 4. 发起活动列表
 	PublishedActivities *UserHomeActivityList `protobuf:"bytes,4,opt,name=published_activities,json=publishedActivities,proto3" json:"published_activities,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+	// 5. 组织者评分（所有活动的评价汇总）
+	OrganizerRating *UserHomeOrganizerRating `protobuf:"bytes,5,opt,name=organizer_rating,json=organizerRating,proto3" json:"organizer_rating,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *GetUserHomeResp) Reset() {
 	*x = GetUserHomeResp{}
-	mi := &file_user_proto_msgTypes[49]
+	mi := &file_user_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3431,7 +5724,7 @@ func (x *GetUserHomeResp) String() string {
 func (*GetUserHomeResp) ProtoMessage() {}
 
 func (x *GetUserHomeResp) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[49]
+	mi := &file_user_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3444,7 +5737,7 @@ func (x *GetUserHomeResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserHomeResp.ProtoReflect.Descriptor instead.
 func (*GetUserHomeResp) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{49}
+	return file_user_proto_rawDescGZIP(), []int{81}
 }
 
 func (x *GetUserHomeResp) GetUserInfo() *UserHomeInfo {
@@ -3475,6 +5768,13 @@ func (x *GetUserHomeResp) GetPublishedActivities() *UserHomeActivityList {
 	return nil
 }
 
+func (x *GetUserHomeResp) GetOrganizerRating() *UserHomeOrganizerRating {
+	if x != nil {
+		return x.OrganizerRating
+	}
+	return nil
+}
+
 type UserHomeInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -3489,7 +5789,7 @@ type UserHomeInfo struct {
 
 func (x *UserHomeInfo) Reset() {
 	*x = UserHomeInfo{}
-	mi := &file_user_proto_msgTypes[50]
+	mi := &file_user_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3501,7 +5801,7 @@ func (x *UserHomeInfo) String() string {
 func (*UserHomeInfo) ProtoMessage() {}
 
 func (x *UserHomeInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[50]
+	mi := &file_user_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3514,7 +5814,7 @@ func (x *UserHomeInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserHomeInfo.ProtoReflect.Descriptor instead.
 func (*UserHomeInfo) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{50}
+	return file_user_proto_rawDescGZIP(), []int{82}
 }
 
 func (x *UserHomeInfo) GetUserId() int64 {
@@ -3572,7 +5872,7 @@ type UserHomeTag struct {
 
 func (x *UserHomeTag) Reset() {
 	*x = UserHomeTag{}
-	mi := &file_user_proto_msgTypes[51]
+	mi := &file_user_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3584,7 +5884,7 @@ func (x *UserHomeTag) String() string {
 func (*UserHomeTag) ProtoMessage() {}
 
 func (x *UserHomeTag) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[51]
+	mi := &file_user_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3597,7 +5897,7 @@ func (x *UserHomeTag) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserHomeTag.ProtoReflect.Descriptor instead.
 func (*UserHomeTag) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{51}
+	return file_user_proto_rawDescGZIP(), []int{83}
 }
 
 func (x *UserHomeTag) GetTagId() int64 {
@@ -3645,7 +5945,7 @@ type UserHomeActivityList struct {
 
 func (x *UserHomeActivityList) Reset() {
 	*x = UserHomeActivityList{}
-	mi := &file_user_proto_msgTypes[52]
+	mi := &file_user_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3657,7 +5957,7 @@ func (x *UserHomeActivityList) String() string {
 func (*UserHomeActivityList) ProtoMessage() {}
 
 func (x *UserHomeActivityList) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[52]
+	mi := &file_user_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3670,7 +5970,7 @@ func (x *UserHomeActivityList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserHomeActivityList.ProtoReflect.Descriptor instead.
 func (*UserHomeActivityList) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{52}
+	return file_user_proto_rawDescGZIP(), []int{84}
 }
 
 func (x *UserHomeActivityList) GetTotal() int32 {
@@ -3687,6 +5987,58 @@ func (x *UserHomeActivityList) GetList() []*UserHomeActivityItem {
 	return nil
 }
 
+type UserHomeOrganizerRating struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RatingAvg     float64                `protobuf:"fixed64,1,opt,name=rating_avg,json=ratingAvg,proto3" json:"rating_avg,omitempty"`      // 平均分
+	RatingCount   int64                  `protobuf:"varint,2,opt,name=rating_count,json=ratingCount,proto3" json:"rating_count,omitempty"` // 评价人数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserHomeOrganizerRating) Reset() {
+	*x = UserHomeOrganizerRating{}
+	mi := &file_user_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserHomeOrganizerRating) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserHomeOrganizerRating) ProtoMessage() {}
+
+func (x *UserHomeOrganizerRating) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserHomeOrganizerRating.ProtoReflect.Descriptor instead.
+func (*UserHomeOrganizerRating) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *UserHomeOrganizerRating) GetRatingAvg() float64 {
+	if x != nil {
+		return x.RatingAvg
+	}
+	return 0
+}
+
+func (x *UserHomeOrganizerRating) GetRatingCount() int64 {
+	if x != nil {
+		return x.RatingCount
+	}
+	return 0
+}
+
 type UserHomeActivityItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -3700,7 +6052,7 @@ type UserHomeActivityItem struct {
 
 func (x *UserHomeActivityItem) Reset() {
 	*x = UserHomeActivityItem{}
-	mi := &file_user_proto_msgTypes[53]
+	mi := &file_user_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3712,7 +6064,7 @@ func (x *UserHomeActivityItem) String() string {
 func (*UserHomeActivityItem) ProtoMessage() {}
 
 func (x *UserHomeActivityItem) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[53]
+	mi := &file_user_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3725,7 +6077,7 @@ func (x *UserHomeActivityItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserHomeActivityItem.ProtoReflect.Descriptor instead.
 func (*UserHomeActivityItem) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{53}
+	return file_user_proto_rawDescGZIP(), []int{86}
 }
 
 func (x *UserHomeActivityItem) GetId() int64 {
@@ -3773,7 +6125,7 @@ type CheckUserExistsReq struct {
 
 func (x *CheckUserExistsReq) Reset() {
 	*x = CheckUserExistsReq{}
-	mi := &file_user_proto_msgTypes[54]
+	mi := &file_user_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3785,7 +6137,7 @@ func (x *CheckUserExistsReq) String() string {
 func (*CheckUserExistsReq) ProtoMessage() {}
 
 func (x *CheckUserExistsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[54]
+	mi := &file_user_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3798,7 +6150,7 @@ func (x *CheckUserExistsReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckUserExistsReq.ProtoReflect.Descriptor instead.
 func (*CheckUserExistsReq) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{54}
+	return file_user_proto_rawDescGZIP(), []int{87}
 }
 
 func (x *CheckUserExistsReq) GetQqEmail() string {
@@ -3817,7 +6169,7 @@ type CheckUserExistsResponse struct {
 
 func (x *CheckUserExistsResponse) Reset() {
 	*x = CheckUserExistsResponse{}
-	mi := &file_user_proto_msgTypes[55]
+	mi := &file_user_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3829,7 +6181,7 @@ func (x *CheckUserExistsResponse) String() string {
 func (*CheckUserExistsResponse) ProtoMessage() {}
 
 func (x *CheckUserExistsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[55]
+	mi := &file_user_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3842,7 +6194,7 @@ func (x *CheckUserExistsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckUserExistsResponse.ProtoReflect.Descriptor instead.
 func (*CheckUserExistsResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{55}
+	return file_user_proto_rawDescGZIP(), []int{88}
 }
 
 func (x *CheckUserExistsResponse) GetExists() bool {
@@ -3864,7 +6216,7 @@ type ForgetPasswordReq struct {
 
 func (x *ForgetPasswordReq) Reset() {
 	*x = ForgetPasswordReq{}
-	mi := &file_user_proto_msgTypes[56]
+	mi := &file_user_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3876,7 +6228,7 @@ func (x *ForgetPasswordReq) String() string {
 func (*ForgetPasswordReq) ProtoMessage() {}
 
 func (x *ForgetPasswordReq) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[56]
+	mi := &file_user_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3889,7 +6241,7 @@ func (x *ForgetPasswordReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ForgetPasswordReq.ProtoReflect.Descriptor instead.
 func (*ForgetPasswordReq) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{56}
+	return file_user_proto_rawDescGZIP(), []int{89}
 }
 
 func (x *ForgetPasswordReq) GetQqCode() string {
@@ -3922,7 +6274,7 @@ type ForgetPasswordResponse struct {
 
 func (x *ForgetPasswordResponse) Reset() {
 	*x = ForgetPasswordResponse{}
-	mi := &file_user_proto_msgTypes[57]
+	mi := &file_user_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3934,7 +6286,7 @@ func (x *ForgetPasswordResponse) String() string {
 func (*ForgetPasswordResponse) ProtoMessage() {}
 
 func (x *ForgetPasswordResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[57]
+	mi := &file_user_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3947,7 +6299,7 @@ func (x *ForgetPasswordResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ForgetPasswordResponse.ProtoReflect.Descriptor instead.
 func (*ForgetPasswordResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{57}
+	return file_user_proto_rawDescGZIP(), []int{90}
 }
 
 func (x *ForgetPasswordResponse) GetSuccess() bool {
@@ -3968,7 +6320,7 @@ type DeleteUserReq struct {
 
 func (x *DeleteUserReq) Reset() {
 	*x = DeleteUserReq{}
-	mi := &file_user_proto_msgTypes[58]
+	mi := &file_user_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3980,7 +6332,7 @@ func (x *DeleteUserReq) String() string {
 func (*DeleteUserReq) ProtoMessage() {}
 
 func (x *DeleteUserReq) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[58]
+	mi := &file_user_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3993,7 +6345,7 @@ func (x *DeleteUserReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteUserReq.ProtoReflect.Descriptor instead.
 func (*DeleteUserReq) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{58}
+	return file_user_proto_rawDescGZIP(), []int{91}
 }
 
 func (x *DeleteUserReq) GetUserId() int64 {
@@ -4019,7 +6371,7 @@ type DeleteUserResponse struct {
 
 func (x *DeleteUserResponse) Reset() {
 	*x = DeleteUserResponse{}
-	mi := &file_user_proto_msgTypes[59]
+	mi := &file_user_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4031,7 +6383,7 @@ func (x *DeleteUserResponse) String() string {
 func (*DeleteUserResponse) ProtoMessage() {}
 
 func (x *DeleteUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[59]
+	mi := &file_user_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4044,7 +6396,7 @@ func (x *DeleteUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteUserResponse.ProtoReflect.Descriptor instead.
 func (*DeleteUserResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{59}
+	return file_user_proto_rawDescGZIP(), []int{92}
 }
 
 func (x *DeleteUserResponse) GetSuccess() bool {
@@ -4066,7 +6418,7 @@ type UpdatePasswordReq struct {
 
 func (x *UpdatePasswordReq) Reset() {
 	*x = UpdatePasswordReq{}
-	mi := &file_user_proto_msgTypes[60]
+	mi := &file_user_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4078,7 +6430,7 @@ func (x *UpdatePasswordReq) String() string {
 func (*UpdatePasswordReq) ProtoMessage() {}
 
 func (x *UpdatePasswordReq) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[60]
+	mi := &file_user_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4091,7 +6443,7 @@ func (x *UpdatePasswordReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdatePasswordReq.ProtoReflect.Descriptor instead.
 func (*UpdatePasswordReq) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{60}
+	return file_user_proto_rawDescGZIP(), []int{93}
 }
 
 func (x *UpdatePasswordReq) GetOriginPassword() string {
@@ -4124,7 +6476,7 @@ type UpdatePasswordResponse struct {
 
 func (x *UpdatePasswordResponse) Reset() {
 	*x = UpdatePasswordResponse{}
-	mi := &file_user_proto_msgTypes[61]
+	mi := &file_user_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4136,7 +6488,7 @@ func (x *UpdatePasswordResponse) String() string {
 func (*UpdatePasswordResponse) ProtoMessage() {}
 
 func (x *UpdatePasswordResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[61]
+	mi := &file_user_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4149,7 +6501,7 @@ func (x *UpdatePasswordResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdatePasswordResponse.ProtoReflect.Descriptor instead.
 func (*UpdatePasswordResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{61}
+	return file_user_proto_rawDescGZIP(), []int{94}
 }
 
 func (x *UpdatePasswordResponse) GetSuccess() bool {
@@ -4176,7 +6528,7 @@ type UpdateUserInfoReq struct {
 
 func (x *UpdateUserInfoReq) Reset() {
 	*x = UpdateUserInfoReq{}
-	mi := &file_user_proto_msgTypes[62]
+	mi := &file_user_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4188,7 +6540,7 @@ func (x *UpdateUserInfoReq) String() string {
 func (*UpdateUserInfoReq) ProtoMessage() {}
 
 func (x *UpdateUserInfoReq) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[62]
+	mi := &file_user_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4201,7 +6553,7 @@ func (x *UpdateUserInfoReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateUserInfoReq.ProtoReflect.Descriptor instead.
 func (*UpdateUserInfoReq) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{62}
+	return file_user_proto_rawDescGZIP(), []int{95}
 }
 
 func (x *UpdateUserInfoReq) GetUserId() int64 {
@@ -4275,21 +6627,277 @@ type UpdateUserInfoResponse struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateUserInfoResponse) Reset() {
-	*x = UpdateUserInfoResponse{}
-	mi := &file_user_proto_msgTypes[63]
+func (x *UpdateUserInfoResponse) Reset() {
+	*x = UpdateUserInfoResponse{}
+	mi := &file_user_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserInfoResponse) ProtoMessage() {}
+
+func (x *UpdateUserInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserInfoResponse.ProtoReflect.Descriptor instead.
+func (*UpdateUserInfoResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *UpdateUserInfoResponse) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpdateUserInfoResponse) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *UpdateUserInfoResponse) GetIntroduce() string {
+	if x != nil {
+		return x.Introduce
+	}
+	return ""
+}
+
+func (x *UpdateUserInfoResponse) GetGender() int64 {
+	if x != nil {
+		return x.Gender
+	}
+	return 0
+}
+
+func (x *UpdateUserInfoResponse) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *UpdateUserInfoResponse) GetAge() int64 {
+	if x != nil {
+		return x.Age
+	}
+	return 0
+}
+
+func (x *UpdateUserInfoResponse) GetTagIds() []int64 {
+	if x != nil {
+		return x.TagIds
+	}
+	return nil
+}
+
+func (x *UpdateUserInfoResponse) GetQqEmail() string {
+	if x != nil {
+		return x.QqEmail
+	}
+	return ""
+}
+
+func (x *UpdateUserInfoResponse) GetCredit() int64 {
+	if x != nil {
+		return x.Credit
+	}
+	return 0
+}
+
+type GetGroupUserReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 使用 repeated 表示数组/列表
+	Ids           []int64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGroupUserReq) Reset() {
+	*x = GetGroupUserReq{}
+	mi := &file_user_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupUserReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupUserReq) ProtoMessage() {}
+
+func (x *GetGroupUserReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupUserReq.ProtoReflect.Descriptor instead.
+func (*GetGroupUserReq) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *GetGroupUserReq) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type GetGroupUserResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 使用 repeated 返回对象列表
+	Users         []*GroupUserInfo `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGroupUserResponse) Reset() {
+	*x = GetGroupUserResponse{}
+	mi := &file_user_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupUserResponse) ProtoMessage() {}
+
+func (x *GetGroupUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupUserResponse.ProtoReflect.Descriptor instead.
+func (*GetGroupUserResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *GetGroupUserResponse) GetUsers() []*GroupUserInfo {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type GroupUserInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AvatarUrl     string                 `protobuf:"bytes,2,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	Nickname      string                 `protobuf:"bytes,3,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupUserInfo) Reset() {
+	*x = GroupUserInfo{}
+	mi := &file_user_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupUserInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupUserInfo) ProtoMessage() {}
+
+func (x *GroupUserInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupUserInfo.ProtoReflect.Descriptor instead.
+func (*GroupUserInfo) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *GroupUserInfo) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GroupUserInfo) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *GroupUserInfo) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+// 登录接口
+type LoginReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QqEmail       string                 `protobuf:"bytes,1,opt,name=qq_email,json=qqEmail,proto3" json:"qq_email,omitempty"`                   // 对应 "qqEmail"
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`                                // 对应 "password"
+	LotNumber     string                 `protobuf:"bytes,3,opt,name=lot_number,json=lotNumber,proto3" json:"lot_number,omitempty"`             // 对应 "lotNumber"
+	CaptchaOutput string                 `protobuf:"bytes,4,opt,name=captcha_output,json=captchaOutput,proto3" json:"captcha_output,omitempty"` // 对应 "captchaOutput"
+	PassToken     string                 `protobuf:"bytes,5,opt,name=pass_token,json=passToken,proto3" json:"pass_token,omitempty"`             // 对应 "passToken"
+	GenTime       string                 `protobuf:"bytes,6,opt,name=gen_time,json=genTime,proto3" json:"gen_time,omitempty"`                   // 对应 "genTime"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginReq) Reset() {
+	*x = LoginReq{}
+	mi := &file_user_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateUserInfoResponse) String() string {
+func (x *LoginReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateUserInfoResponse) ProtoMessage() {}
+func (*LoginReq) ProtoMessage() {}
 
-func (x *UpdateUserInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[63]
+func (x *LoginReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4300,97 +6908,77 @@ func (x *UpdateUserInfoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateUserInfoResponse.ProtoReflect.Descriptor instead.
-func (*UpdateUserInfoResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{63}
-}
-
-func (x *UpdateUserInfoResponse) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+// Deprecated: Use LoginReq.ProtoReflect.Descriptor instead.
+func (*LoginReq) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{100}
 }
 
-func (x *UpdateUserInfoResponse) GetNickname() string {
+func (x *LoginReq) GetQqEmail() string {
 	if x != nil {
-		return x.Nickname
+		return x.QqEmail
 	}
 	return ""
 }
 
-func (x *UpdateUserInfoResponse) GetIntroduce() string {
+func (x *LoginReq) GetPassword() string {
 	if x != nil {
-		return x.Introduce
+		return x.Password
 	}
 	return ""
 }
 
-func (x *UpdateUserInfoResponse) GetGender() int64 {
-	if x != nil {
-		return x.Gender
-	}
-	return 0
-}
-
-func (x *UpdateUserInfoResponse) GetAvatarUrl() string {
+func (x *LoginReq) GetLotNumber() string {
 	if x != nil {
-		return x.AvatarUrl
+		return x.LotNumber
 	}
 	return ""
 }
 
-func (x *UpdateUserInfoResponse) GetAge() int64 {
-	if x != nil {
-		return x.Age
-	}
-	return 0
-}
-
-func (x *UpdateUserInfoResponse) GetTagIds() []int64 {
+func (x *LoginReq) GetCaptchaOutput() string {
 	if x != nil {
-		return x.TagIds
+		return x.CaptchaOutput
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateUserInfoResponse) GetQqEmail() string {
+func (x *LoginReq) GetPassToken() string {
 	if x != nil {
-		return x.QqEmail
+		return x.PassToken
 	}
 	return ""
 }
 
-func (x *UpdateUserInfoResponse) GetCredit() int64 {
+func (x *LoginReq) GetGenTime() string {
 	if x != nil {
-		return x.Credit
+		return x.GenTime
 	}
-	return 0
+	return ""
 }
 
-type GetGroupUserReq struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// 使用 repeated 表示数组/列表
-	Ids           []int64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+type LoginResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken   string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`    // 对应 "accessToken"
+	RefreshToken  string                 `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"` // 对应 "refreshToken"
+	UserInfo      *LoginUserInfo         `protobuf:"bytes,3,opt,name=user_info,json=userInfo,proto3" json:"user_info,omitempty"`             // 对应 "userInfo"
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGroupUserReq) Reset() {
-	*x = GetGroupUserReq{}
-	mi := &file_user_proto_msgTypes[64]
+func (x *LoginResponse) Reset() {
+	*x = LoginResponse{}
+	mi := &file_user_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGroupUserReq) String() string {
+func (x *LoginResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGroupUserReq) ProtoMessage() {}
+func (*LoginResponse) ProtoMessage() {}
 
-func (x *GetGroupUserReq) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[64]
+func (x *LoginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4401,41 +6989,54 @@ func (x *GetGroupUserReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGroupUserReq.ProtoReflect.Descriptor instead.
-func (*GetGroupUserReq) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{64}
+// Deprecated: Use LoginResponse.ProtoReflect.Descriptor instead.
+func (*LoginResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{101}
 }
 
-func (x *GetGroupUserReq) GetIds() []int64 {
+func (x *LoginResponse) GetAccessToken() string {
 	if x != nil {
-		return x.Ids
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *LoginResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *LoginResponse) GetUserInfo() *LoginUserInfo {
+	if x != nil {
+		return x.UserInfo
 	}
 	return nil
 }
 
-type GetGroupUserResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// 使用 repeated 返回对象列表
-	Users         []*GroupUserInfo `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+type LoginUserInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserInfo      *UserInfo              `protobuf:"bytes,1,opt,name=user_info,json=userInfo,proto3" json:"user_info,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGroupUserResponse) Reset() {
-	*x = GetGroupUserResponse{}
-	mi := &file_user_proto_msgTypes[65]
+func (x *LoginUserInfo) Reset() {
+	*x = LoginUserInfo{}
+	mi := &file_user_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGroupUserResponse) String() string {
+func (x *LoginUserInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGroupUserResponse) ProtoMessage() {}
+func (*LoginUserInfo) ProtoMessage() {}
 
-func (x *GetGroupUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[65]
+func (x *LoginUserInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4446,42 +7047,42 @@ func (x *GetGroupUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGroupUserResponse.ProtoReflect.Descriptor instead.
-func (*GetGroupUserResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{65}
+// Deprecated: Use LoginUserInfo.ProtoReflect.Descriptor instead.
+func (*LoginUserInfo) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{102}
 }
 
-func (x *GetGroupUserResponse) GetUsers() []*GroupUserInfo {
+func (x *LoginUserInfo) GetUserInfo() *UserInfo {
 	if x != nil {
-		return x.Users
+		return x.UserInfo
 	}
 	return nil
 }
 
-type GroupUserInfo struct {
+// 用户登出
+type LogoutReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	AvatarUrl     string                 `protobuf:"bytes,2,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
-	Nickname      string                 `protobuf:"bytes,3,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`               // 从token解析的id
+	AccessToken   string                 `protobuf:"bytes,2,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"` // 从请求头中获取的token
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GroupUserInfo) Reset() {
-	*x = GroupUserInfo{}
-	mi := &file_user_proto_msgTypes[66]
+func (x *LogoutReq) Reset() {
+	*x = LogoutReq{}
+	mi := &file_user_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GroupUserInfo) String() string {
+func (x *LogoutReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GroupUserInfo) ProtoMessage() {}
+func (*LogoutReq) ProtoMessage() {}
 
-func (x *GroupUserInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[66]
+func (x *LogoutReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4492,60 +7093,46 @@ func (x *GroupUserInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GroupUserInfo.ProtoReflect.Descriptor instead.
-func (*GroupUserInfo) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{66}
+// Deprecated: Use LogoutReq.ProtoReflect.Descriptor instead.
+func (*LogoutReq) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{103}
 }
 
-func (x *GroupUserInfo) GetId() uint64 {
+func (x *LogoutReq) GetUserId() int64 {
 	if x != nil {
-		return x.Id
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *GroupUserInfo) GetAvatarUrl() string {
-	if x != nil {
-		return x.AvatarUrl
-	}
-	return ""
-}
-
-func (x *GroupUserInfo) GetNickname() string {
+func (x *LogoutReq) GetAccessToken() string {
 	if x != nil {
-		return x.Nickname
+		return x.AccessToken
 	}
 	return ""
 }
 
-// 登录接口
-type LoginReq struct {
+type LogoutResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	QqEmail       string                 `protobuf:"bytes,1,opt,name=qq_email,json=qqEmail,proto3" json:"qq_email,omitempty"`                   // 对应 "qqEmail"
-	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`                                // 对应 "password"
-	LotNumber     string                 `protobuf:"bytes,3,opt,name=lot_number,json=lotNumber,proto3" json:"lot_number,omitempty"`             // 对应 "lotNumber"
-	CaptchaOutput string                 `protobuf:"bytes,4,opt,name=captcha_output,json=captchaOutput,proto3" json:"captcha_output,omitempty"` // 对应 "captchaOutput"
-	PassToken     string                 `protobuf:"bytes,5,opt,name=pass_token,json=passToken,proto3" json:"pass_token,omitempty"`             // 对应 "passToken"
-	GenTime       string                 `protobuf:"bytes,6,opt,name=gen_time,json=genTime,proto3" json:"gen_time,omitempty"`                   // 对应 "genTime"
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LoginReq) Reset() {
-	*x = LoginReq{}
-	mi := &file_user_proto_msgTypes[67]
+func (x *LogoutResponse) Reset() {
+	*x = LogoutResponse{}
+	mi := &file_user_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LoginReq) String() string {
+func (x *LogoutResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoginReq) ProtoMessage() {}
+func (*LogoutResponse) ProtoMessage() {}
 
-func (x *LoginReq) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[67]
+func (x *LogoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4556,77 +7143,104 @@ func (x *LoginReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoginReq.ProtoReflect.Descriptor instead.
-func (*LoginReq) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{67}
+// Deprecated: Use LogoutResponse.ProtoReflect.Descriptor instead.
+func (*LogoutResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{104}
 }
 
-func (x *LoginReq) GetQqEmail() string {
-	if x != nil {
-		return x.QqEmail
-	}
-	return ""
+// 用户注册
+type RegisterReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QqEmail       string                 `protobuf:"bytes,1,opt,name=qq_email,json=qqEmail,proto3" json:"qq_email,omitempty"` // 对应 "qqEmail"
+	QqCode        string                 `protobuf:"bytes,2,opt,name=qq_code,json=qqCode,proto3" json:"qq_code,omitempty"`    // 对应 "qqCode" (通常是验证码)
+	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`              // 对应 "password"
+	Nickname      string                 `protobuf:"bytes,4,opt,name=nickname,proto3" json:"nickname,omitempty"`              // 对应 "nickname"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LoginReq) GetPassword() string {
+func (x *RegisterReq) Reset() {
+	*x = RegisterReq{}
+	mi := &file_user_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterReq) ProtoMessage() {}
+
+func (x *RegisterReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[105]
 	if x != nil {
-		return x.Password
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *LoginReq) GetLotNumber() string {
+// Deprecated: Use RegisterReq.ProtoReflect.Descriptor instead.
+func (*RegisterReq) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *RegisterReq) GetQqEmail() string {
 	if x != nil {
-		return x.LotNumber
+		return x.QqEmail
 	}
 	return ""
 }
 
-func (x *LoginReq) GetCaptchaOutput() string {
+func (x *RegisterReq) GetQqCode() string {
 	if x != nil {
-		return x.CaptchaOutput
+		return x.QqCode
 	}
 	return ""
 }
 
-func (x *LoginReq) GetPassToken() string {
+func (x *RegisterReq) GetPassword() string {
 	if x != nil {
-		return x.PassToken
+		return x.Password
 	}
 	return ""
 }
 
-func (x *LoginReq) GetGenTime() string {
+func (x *RegisterReq) GetNickname() string {
 	if x != nil {
-		return x.GenTime
+		return x.Nickname
 	}
 	return ""
 }
 
-type LoginResponse struct {
+type RegisterResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AccessToken   string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`    // 对应 "accessToken"
 	RefreshToken  string                 `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"` // 对应 "refreshToken"
-	UserInfo      *LoginUserInfo         `protobuf:"bytes,3,opt,name=user_info,json=userInfo,proto3" json:"user_info,omitempty"`             // 对应 "userInfo"
+	UserInfo      *UserInfo              `protobuf:"bytes,3,opt,name=user_info,json=userInfo,proto3" json:"user_info,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LoginResponse) Reset() {
-	*x = LoginResponse{}
-	mi := &file_user_proto_msgTypes[68]
+func (x *RegisterResponse) Reset() {
+	*x = RegisterResponse{}
+	mi := &file_user_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LoginResponse) String() string {
+func (x *RegisterResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoginResponse) ProtoMessage() {}
+func (*RegisterResponse) ProtoMessage() {}
 
-func (x *LoginResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[68]
+func (x *RegisterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4637,54 +7251,99 @@ func (x *LoginResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoginResponse.ProtoReflect.Descriptor instead.
-func (*LoginResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{68}
+// Deprecated: Use RegisterResponse.ProtoReflect.Descriptor instead.
+func (*RegisterResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{106}
 }
 
-func (x *LoginResponse) GetAccessToken() string {
+func (x *RegisterResponse) GetAccessToken() string {
 	if x != nil {
 		return x.AccessToken
 	}
 	return ""
 }
 
-func (x *LoginResponse) GetRefreshToken() string {
+func (x *RegisterResponse) GetRefreshToken() string {
 	if x != nil {
 		return x.RefreshToken
 	}
 	return ""
 }
 
-func (x *LoginResponse) GetUserInfo() *LoginUserInfo {
+func (x *RegisterResponse) GetUserInfo() *UserInfo {
 	if x != nil {
 		return x.UserInfo
 	}
 	return nil
 }
 
-type LoginUserInfo struct {
+// 获取用户信息
+type GetUserInfoReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserInfoReq) Reset() {
+	*x = GetUserInfoReq{}
+	mi := &file_user_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserInfoReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserInfoReq) ProtoMessage() {}
+
+func (x *GetUserInfoReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserInfoReq.ProtoReflect.Descriptor instead.
+func (*GetUserInfoReq) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *GetUserInfoReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetUserInfoResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserInfo      *UserInfo              `protobuf:"bytes,1,opt,name=user_info,json=userInfo,proto3" json:"user_info,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LoginUserInfo) Reset() {
-	*x = LoginUserInfo{}
-	mi := &file_user_proto_msgTypes[69]
+func (x *GetUserInfoResponse) Reset() {
+	*x = GetUserInfoResponse{}
+	mi := &file_user_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LoginUserInfo) String() string {
+func (x *GetUserInfoResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoginUserInfo) ProtoMessage() {}
+func (*GetUserInfoResponse) ProtoMessage() {}
 
-func (x *LoginUserInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[69]
+func (x *GetUserInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4695,42 +7354,45 @@ func (x *LoginUserInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoginUserInfo.ProtoReflect.Descriptor instead.
-func (*LoginUserInfo) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{69}
+// Deprecated: Use GetUserInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetUserInfoResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{108}
 }
 
-func (x *LoginUserInfo) GetUserInfo() *UserInfo {
+func (x *GetUserInfoResponse) GetUserInfo() *UserInfo {
 	if x != nil {
 		return x.UserInfo
 	}
 	return nil
 }
 
-// 用户登出
-type LogoutReq struct {
+// 公用结构体
+type InterestTag struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`               // 从token解析的id
-	AccessToken   string                 `protobuf:"bytes,2,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"` // 从请求头中获取的token
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TagName       string                 `protobuf:"bytes,2,opt,name=tag_name,json=tagName,proto3" json:"tag_name,omitempty"`    // 对应 "tagName"
+	TagIcon       string                 `protobuf:"bytes,3,opt,name=tag_icon,json=tagIcon,proto3" json:"tag_icon,omitempty"`    // 对应 "tagIcon"
+	TagDesc       string                 `protobuf:"bytes,4,opt,name=tag_desc,json=tagDesc,proto3" json:"tag_desc,omitempty"`    // 对应 "tagDesc"
+	TagColor      string                 `protobuf:"bytes,5,opt,name=tag_color,json=tagColor,proto3" json:"tag_color,omitempty"` // 对应 "tagColor"
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LogoutReq) Reset() {
-	*x = LogoutReq{}
-	mi := &file_user_proto_msgTypes[70]
+func (x *InterestTag) Reset() {
+	*x = InterestTag{}
+	mi := &file_user_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LogoutReq) String() string {
+func (x *InterestTag) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LogoutReq) ProtoMessage() {}
+func (*InterestTag) ProtoMessage() {}
 
-func (x *LogoutReq) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[70]
+func (x *InterestTag) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4741,46 +7403,80 @@ func (x *LogoutReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LogoutReq.ProtoReflect.Descriptor instead.
-func (*LogoutReq) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{70}
+// Deprecated: Use InterestTag.ProtoReflect.Descriptor instead.
+func (*InterestTag) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{109}
 }
 
-func (x *LogoutReq) GetUserId() int64 {
+func (x *InterestTag) GetId() uint64 {
 	if x != nil {
-		return x.UserId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *LogoutReq) GetAccessToken() string {
+func (x *InterestTag) GetTagName() string {
 	if x != nil {
-		return x.AccessToken
+		return x.TagName
 	}
 	return ""
 }
 
-type LogoutResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *InterestTag) GetTagIcon() string {
+	if x != nil {
+		return x.TagIcon
+	}
+	return ""
 }
 
-func (x *LogoutResponse) Reset() {
-	*x = LogoutResponse{}
-	mi := &file_user_proto_msgTypes[71]
+func (x *InterestTag) GetTagDesc() string {
+	if x != nil {
+		return x.TagDesc
+	}
+	return ""
+}
+
+func (x *InterestTag) GetTagColor() string {
+	if x != nil {
+		return x.TagColor
+	}
+	return ""
+}
+
+type UserInfo struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	UserId            uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                                     // 对应 "userId"
+	Nickname          string                 `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`                                                // 对应 "nickname"
+	AvatarUrl         string                 `protobuf:"bytes,3,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`                             // 对应 "avatarUrl"
+	Introduction      string                 `protobuf:"bytes,4,opt,name=introduction,proto3" json:"introduction,omitempty"`                                        // 对应 "introduction"
+	Gender            string                 `protobuf:"bytes,5,opt,name=gender,proto3" json:"gender,omitempty"`                                                    // 对应 "gender"
+	Age               string                 `protobuf:"bytes,6,opt,name=age,proto3" json:"age,omitempty"`                                                          // 对应 "age"
+	ActivitiesNum     uint32                 `protobuf:"varint,7,opt,name=activities_num,json=activitiesNum,proto3" json:"activities_num,omitempty"`                // 对应 "activitiesNum"
+	InitiateNum       uint32                 `protobuf:"varint,8,opt,name=initiate_num,json=initiateNum,proto3" json:"initiate_num,omitempty"`                      // 对应 "initiateNum"
+	Credit            int64                  `protobuf:"varint,9,opt,name=credit,proto3" json:"credit,omitempty"`                                                   // 对应 "credit" (参考你CreditService的定义)
+	IsStudentVerified bool                   `protobuf:"varint,10,opt,name=is_student_verified,json=isStudentVerified,proto3" json:"is_student_verified,omitempty"` // 对应 "isStudentVerified"
+	InterestTags      []*InterestTag         `protobuf:"bytes,11,rep,name=interest_tags,json=interestTags,proto3" json:"interest_tags,omitempty"`                   // 对应 "interestTags" 列表
+	QqEmail           string                 `protobuf:"bytes,12,opt,name=qq_email,json=qqEmail,proto3" json:"qq_email,omitempty"`                                  // 对应 "qqEmail"
+	AvatarId          int64                  `protobuf:"varint,13,opt,name=avatar_id,json=avatarId,proto3" json:"avatar_id,omitempty"`                              // 对应 "avatarId"
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *UserInfo) Reset() {
+	*x = UserInfo{}
+	mi := &file_user_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LogoutResponse) String() string {
+func (x *UserInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LogoutResponse) ProtoMessage() {}
+func (*UserInfo) ProtoMessage() {}
 
-func (x *LogoutResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[71]
+func (x *UserInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4791,104 +7487,125 @@ func (x *LogoutResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LogoutResponse.ProtoReflect.Descriptor instead.
-func (*LogoutResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{71}
+// Deprecated: Use UserInfo.ProtoReflect.Descriptor instead.
+func (*UserInfo) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{110}
 }
 
-// 用户注册
-type RegisterReq struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	QqEmail       string                 `protobuf:"bytes,1,opt,name=qq_email,json=qqEmail,proto3" json:"qq_email,omitempty"` // 对应 "qqEmail"
-	QqCode        string                 `protobuf:"bytes,2,opt,name=qq_code,json=qqCode,proto3" json:"qq_code,omitempty"`    // 对应 "qqCode" (通常是验证码)
-	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`              // 对应 "password"
-	Nickname      string                 `protobuf:"bytes,4,opt,name=nickname,proto3" json:"nickname,omitempty"`              // 对应 "nickname"
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *UserInfo) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
 }
 
-func (x *RegisterReq) Reset() {
-	*x = RegisterReq{}
-	mi := &file_user_proto_msgTypes[72]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *UserInfo) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
 }
 
-func (x *RegisterReq) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *UserInfo) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *UserInfo) GetIntroduction() string {
+	if x != nil {
+		return x.Introduction
+	}
+	return ""
+}
+
+func (x *UserInfo) GetGender() string {
+	if x != nil {
+		return x.Gender
+	}
+	return ""
+}
+
+func (x *UserInfo) GetAge() string {
+	if x != nil {
+		return x.Age
+	}
+	return ""
+}
+
+func (x *UserInfo) GetActivitiesNum() uint32 {
+	if x != nil {
+		return x.ActivitiesNum
+	}
+	return 0
 }
 
-func (*RegisterReq) ProtoMessage() {}
-
-func (x *RegisterReq) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[72]
+func (x *UserInfo) GetInitiateNum() uint32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.InitiateNum
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use RegisterReq.ProtoReflect.Descriptor instead.
-func (*RegisterReq) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{72}
+func (x *UserInfo) GetCredit() int64 {
+	if x != nil {
+		return x.Credit
+	}
+	return 0
 }
 
-func (x *RegisterReq) GetQqEmail() string {
+func (x *UserInfo) GetIsStudentVerified() bool {
 	if x != nil {
-		return x.QqEmail
+		return x.IsStudentVerified
 	}
-	return ""
+	return false
 }
 
-func (x *RegisterReq) GetQqCode() string {
+func (x *UserInfo) GetInterestTags() []*InterestTag {
 	if x != nil {
-		return x.QqCode
+		return x.InterestTags
 	}
-	return ""
+	return nil
 }
 
-func (x *RegisterReq) GetPassword() string {
+func (x *UserInfo) GetQqEmail() string {
 	if x != nil {
-		return x.Password
+		return x.QqEmail
 	}
 	return ""
 }
 
-func (x *RegisterReq) GetNickname() string {
+func (x *UserInfo) GetAvatarId() int64 {
 	if x != nil {
-		return x.Nickname
+		return x.AvatarId
 	}
-	return ""
+	return 0
 }
 
-type RegisterResponse struct {
+// 刷新token
+type RefreshReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AccessToken   string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`    // 对应 "accessToken"
-	RefreshToken  string                 `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"` // 对应 "refreshToken"
-	UserInfo      *UserInfo              `protobuf:"bytes,3,opt,name=user_info,json=userInfo,proto3" json:"user_info,omitempty"`
+	RefreshToken  string                 `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RegisterResponse) Reset() {
-	*x = RegisterResponse{}
-	mi := &file_user_proto_msgTypes[73]
+func (x *RefreshReq) Reset() {
+	*x = RefreshReq{}
+	mi := &file_user_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RegisterResponse) String() string {
+func (x *RefreshReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegisterResponse) ProtoMessage() {}
+func (*RefreshReq) ProtoMessage() {}
 
-func (x *RegisterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[73]
+func (x *RefreshReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4899,55 +7616,40 @@ func (x *RegisterResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisterResponse.ProtoReflect.Descriptor instead.
-func (*RegisterResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{73}
-}
-
-func (x *RegisterResponse) GetAccessToken() string {
-	if x != nil {
-		return x.AccessToken
-	}
-	return ""
+// Deprecated: Use RefreshReq.ProtoReflect.Descriptor instead.
+func (*RefreshReq) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{111}
 }
 
-func (x *RegisterResponse) GetRefreshToken() string {
+func (x *RefreshReq) GetRefreshToken() string {
 	if x != nil {
 		return x.RefreshToken
 	}
 	return ""
 }
 
-func (x *RegisterResponse) GetUserInfo() *UserInfo {
-	if x != nil {
-		return x.UserInfo
-	}
-	return nil
-}
-
-// 获取用户信息
-type GetUserInfoReq struct {
+type RefreshResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AccessToken   string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserInfoReq) Reset() {
-	*x = GetUserInfoReq{}
-	mi := &file_user_proto_msgTypes[74]
+func (x *RefreshResponse) Reset() {
+	*x = RefreshResponse{}
+	mi := &file_user_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserInfoReq) String() string {
+func (x *RefreshResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserInfoReq) ProtoMessage() {}
+func (*RefreshResponse) ProtoMessage() {}
 
-func (x *GetUserInfoReq) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[74]
+func (x *RefreshResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4958,40 +7660,42 @@ func (x *GetUserInfoReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserInfoReq.ProtoReflect.Descriptor instead.
-func (*GetUserInfoReq) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{74}
+// Deprecated: Use RefreshResponse.ProtoReflect.Descriptor instead.
+func (*RefreshResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{112}
 }
 
-func (x *GetUserInfoReq) GetUserId() int64 {
+func (x *RefreshResponse) GetAccessToken() string {
 	if x != nil {
-		return x.UserId
+		return x.AccessToken
 	}
-	return 0
+	return ""
 }
 
-type GetUserInfoResponse struct {
+// TagUsageCountReq 标签计数请求
+type TagUsageCountReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserInfo      *UserInfo              `protobuf:"bytes,1,opt,name=user_info,json=userInfo,proto3" json:"user_info,omitempty"`
+	TagIds        []int64                `protobuf:"varint,1,rep,packed,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty"` // 标签ID列表
+	Delta         int32                  `protobuf:"varint,2,opt,name=delta,proto3" json:"delta,omitempty"`                        // 变化量（正数增加，负数减少）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserInfoResponse) Reset() {
-	*x = GetUserInfoResponse{}
-	mi := &file_user_proto_msgTypes[75]
+func (x *TagUsageCountReq) Reset() {
+	*x = TagUsageCountReq{}
+	mi := &file_user_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserInfoResponse) String() string {
+func (x *TagUsageCountReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserInfoResponse) ProtoMessage() {}
+func (*TagUsageCountReq) ProtoMessage() {}
 
-func (x *GetUserInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[75]
+func (x *TagUsageCountReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5002,45 +7706,48 @@ func (x *GetUserInfoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserInfoResponse.ProtoReflect.Descriptor instead.
-func (*GetUserInfoResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{75}
+// Deprecated: Use TagUsageCountReq.ProtoReflect.Descriptor instead.
+func (*TagUsageCountReq) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{113}
 }
 
-func (x *GetUserInfoResponse) GetUserInfo() *UserInfo {
+func (x *TagUsageCountReq) GetTagIds() []int64 {
 	if x != nil {
-		return x.UserInfo
+		return x.TagIds
 	}
 	return nil
 }
 
-// 公用结构体
-type InterestTag struct {
+func (x *TagUsageCountReq) GetDelta() int32 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+// TagUsageCountResp 标签计数响应
+type TagUsageCountResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	TagName       string                 `protobuf:"bytes,2,opt,name=tag_name,json=tagName,proto3" json:"tag_name,omitempty"`    // 对应 "tagName"
-	TagIcon       string                 `protobuf:"bytes,3,opt,name=tag_icon,json=tagIcon,proto3" json:"tag_icon,omitempty"`    // 对应 "tagIcon"
-	TagDesc       string                 `protobuf:"bytes,4,opt,name=tag_desc,json=tagDesc,proto3" json:"tag_desc,omitempty"`    // 对应 "tagDesc"
-	TagColor      string                 `protobuf:"bytes,5,opt,name=tag_color,json=tagColor,proto3" json:"tag_color,omitempty"` // 对应 "tagColor"
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // 是否成功
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InterestTag) Reset() {
-	*x = InterestTag{}
-	mi := &file_user_proto_msgTypes[76]
+func (x *TagUsageCountResp) Reset() {
+	*x = TagUsageCountResp{}
+	mi := &file_user_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InterestTag) String() string {
+func (x *TagUsageCountResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InterestTag) ProtoMessage() {}
+func (*TagUsageCountResp) ProtoMessage() {}
 
-func (x *InterestTag) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[76]
+func (x *TagUsageCountResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5051,80 +7758,77 @@ func (x *InterestTag) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InterestTag.ProtoReflect.Descriptor instead.
-func (*InterestTag) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{76}
+// Deprecated: Use TagUsageCountResp.ProtoReflect.Descriptor instead.
+func (*TagUsageCountResp) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{114}
 }
 
-func (x *InterestTag) GetId() uint64 {
+func (x *TagUsageCountResp) GetSuccess() bool {
 	if x != nil {
-		return x.Id
+		return x.Success
 	}
-	return 0
+	return false
 }
 
-func (x *InterestTag) GetTagName() string {
-	if x != nil {
-		return x.TagName
-	}
-	return ""
+// 获取验证码配置信息
+type GetCaptchaConfigReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InterestTag) GetTagIcon() string {
-	if x != nil {
-		return x.TagIcon
-	}
-	return ""
+func (x *GetCaptchaConfigReq) Reset() {
+	*x = GetCaptchaConfigReq{}
+	mi := &file_user_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *InterestTag) GetTagDesc() string {
-	if x != nil {
-		return x.TagDesc
-	}
-	return ""
+func (x *GetCaptchaConfigReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *InterestTag) GetTagColor() string {
+func (*GetCaptchaConfigReq) ProtoMessage() {}
+
+func (x *GetCaptchaConfigReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[115]
 	if x != nil {
-		return x.TagColor
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-type UserInfo struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	UserId            uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                                     // 对应 "userId"
-	Nickname          string                 `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`                                                // 对应 "nickname"
-	AvatarUrl         string                 `protobuf:"bytes,3,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`                             // 对应 "avatarUrl"
-	Introduction      string                 `protobuf:"bytes,4,opt,name=introduction,proto3" json:"introduction,omitempty"`                                        // 对应 "introduction"
-	Gender            string                 `protobuf:"bytes,5,opt,name=gender,proto3" json:"gender,omitempty"`                                                    // 对应 "gender"
-	Age               string                 `protobuf:"bytes,6,opt,name=age,proto3" json:"age,omitempty"`                                                          // 对应 "age"
-	ActivitiesNum     uint32                 `protobuf:"varint,7,opt,name=activities_num,json=activitiesNum,proto3" json:"activities_num,omitempty"`                // 对应 "activitiesNum"
-	InitiateNum       uint32                 `protobuf:"varint,8,opt,name=initiate_num,json=initiateNum,proto3" json:"initiate_num,omitempty"`                      // 对应 "initiateNum"
-	Credit            int64                  `protobuf:"varint,9,opt,name=credit,proto3" json:"credit,omitempty"`                                                   // 对应 "credit" (参考你CreditService的定义)
-	IsStudentVerified bool                   `protobuf:"varint,10,opt,name=is_student_verified,json=isStudentVerified,proto3" json:"is_student_verified,omitempty"` // 对应 "isStudentVerified"
-	InterestTags      []*InterestTag         `protobuf:"bytes,11,rep,name=interest_tags,json=interestTags,proto3" json:"interest_tags,omitempty"`                   // 对应 "interestTags" 列表
-	QqEmail           string                 `protobuf:"bytes,12,opt,name=qq_email,json=qqEmail,proto3" json:"qq_email,omitempty"`                                  // 对应 "qqEmail"
-	AvatarId          int64                  `protobuf:"varint,13,opt,name=avatar_id,json=avatarId,proto3" json:"avatar_id,omitempty"`                              // 对应 "avatarId"
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+// Deprecated: Use GetCaptchaConfigReq.ProtoReflect.Descriptor instead.
+func (*GetCaptchaConfigReq) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{115}
 }
 
-func (x *UserInfo) Reset() {
-	*x = UserInfo{}
-	mi := &file_user_proto_msgTypes[77]
+type GetCaptchaConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CaptchaId     string                 `protobuf:"bytes,1,opt,name=captcha_id,json=captchaId,proto3" json:"captcha_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCaptchaConfigResponse) Reset() {
+	*x = GetCaptchaConfigResponse{}
+	mi := &file_user_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserInfo) String() string {
+func (x *GetCaptchaConfigResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserInfo) ProtoMessage() {}
+func (*GetCaptchaConfigResponse) ProtoMessage() {}
 
-func (x *UserInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[77]
+func (x *GetCaptchaConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5135,125 +7839,111 @@ func (x *UserInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserInfo.ProtoReflect.Descriptor instead.
-func (*UserInfo) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{77}
-}
-
-func (x *UserInfo) GetUserId() uint64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
-}
-
-func (x *UserInfo) GetNickname() string {
-	if x != nil {
-		return x.Nickname
-	}
-	return ""
+// Deprecated: Use GetCaptchaConfigResponse.ProtoReflect.Descriptor instead.
+func (*GetCaptchaConfigResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{116}
 }
 
-func (x *UserInfo) GetAvatarUrl() string {
+func (x *GetCaptchaConfigResponse) GetCaptchaId() string {
 	if x != nil {
-		return x.AvatarUrl
+		return x.CaptchaId
 	}
 	return ""
 }
 
-func (x *UserInfo) GetIntroduction() string {
-	if x != nil {
-		return x.Introduction
-	}
-	return ""
+// 校验验证码是否正确
+type CheckCaptchaReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LotNumber     string                 `protobuf:"bytes,1,opt,name=lotNumber,proto3" json:"lotNumber,omitempty"`
+	CaptchaOutput string                 `protobuf:"bytes,2,opt,name=captchaOutput,proto3" json:"captchaOutput,omitempty"`
+	PassToken     string                 `protobuf:"bytes,3,opt,name=passToken,proto3" json:"passToken,omitempty"`
+	GenTime       string                 `protobuf:"bytes,4,opt,name=genTime,proto3" json:"genTime,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserInfo) GetGender() string {
-	if x != nil {
-		return x.Gender
-	}
-	return ""
+func (x *CheckCaptchaReq) Reset() {
+	*x = CheckCaptchaReq{}
+	mi := &file_user_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserInfo) GetAge() string {
-	if x != nil {
-		return x.Age
-	}
-	return ""
+func (x *CheckCaptchaReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UserInfo) GetActivitiesNum() uint32 {
-	if x != nil {
-		return x.ActivitiesNum
-	}
-	return 0
-}
+func (*CheckCaptchaReq) ProtoMessage() {}
 
-func (x *UserInfo) GetInitiateNum() uint32 {
+func (x *CheckCaptchaReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[117]
 	if x != nil {
-		return x.InitiateNum
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *UserInfo) GetCredit() int64 {
-	if x != nil {
-		return x.Credit
-	}
-	return 0
+// Deprecated: Use CheckCaptchaReq.ProtoReflect.Descriptor instead.
+func (*CheckCaptchaReq) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{117}
 }
 
-func (x *UserInfo) GetIsStudentVerified() bool {
+func (x *CheckCaptchaReq) GetLotNumber() string {
 	if x != nil {
-		return x.IsStudentVerified
+		return x.LotNumber
 	}
-	return false
+	return ""
 }
 
-func (x *UserInfo) GetInterestTags() []*InterestTag {
+func (x *CheckCaptchaReq) GetCaptchaOutput() string {
 	if x != nil {
-		return x.InterestTags
+		return x.CaptchaOutput
 	}
-	return nil
+	return ""
 }
 
-func (x *UserInfo) GetQqEmail() string {
+func (x *CheckCaptchaReq) GetPassToken() string {
 	if x != nil {
-		return x.QqEmail
+		return x.PassToken
 	}
 	return ""
 }
 
-func (x *UserInfo) GetAvatarId() int64 {
+func (x *CheckCaptchaReq) GetGenTime() string {
 	if x != nil {
-		return x.AvatarId
+		return x.GenTime
 	}
-	return 0
+	return ""
 }
 
-// 刷新token
-type RefreshReq struct {
+type CheckCaptchaResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RefreshToken  string                 `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	Result        string                 `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`                              // "result": "success" 或 "fail"
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`                              // "reason": 失败原因
+	CaptchaArgs   *CaptchaArgs           `protobuf:"bytes,3,opt,name=captcha_args,json=captchaArgs,proto3" json:"captcha_args,omitempty"` // 嵌套对象 "captcha_args"
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RefreshReq) Reset() {
-	*x = RefreshReq{}
-	mi := &file_user_proto_msgTypes[78]
+func (x *CheckCaptchaResponse) Reset() {
+	*x = CheckCaptchaResponse{}
+	mi := &file_user_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RefreshReq) String() string {
+func (x *CheckCaptchaResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RefreshReq) ProtoMessage() {}
+func (*CheckCaptchaResponse) ProtoMessage() {}
 
-func (x *RefreshReq) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[78]
+func (x *CheckCaptchaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5264,40 +7954,58 @@ func (x *RefreshReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RefreshReq.ProtoReflect.Descriptor instead.
-func (*RefreshReq) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{78}
+// Deprecated: Use CheckCaptchaResponse.ProtoReflect.Descriptor instead.
+func (*CheckCaptchaResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{118}
 }
 
-func (x *RefreshReq) GetRefreshToken() string {
+func (x *CheckCaptchaResponse) GetResult() string {
 	if x != nil {
-		return x.RefreshToken
+		return x.Result
 	}
 	return ""
 }
 
-type RefreshResponse struct {
+func (x *CheckCaptchaResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CheckCaptchaResponse) GetCaptchaArgs() *CaptchaArgs {
+	if x != nil {
+		return x.CaptchaArgs
+	}
+	return nil
+}
+
+type CaptchaArgs struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AccessToken   string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	CaptchaId     string                 `protobuf:"bytes,1,opt,name=captcha_id,json=captchaId,proto3" json:"captcha_id,omitempty"`             // "captcha_id"
+	LotNumber     string                 `protobuf:"bytes,2,opt,name=lot_number,json=lotNumber,proto3" json:"lot_number,omitempty"`             // "lot_number"
+	CaptchaOutput string                 `protobuf:"bytes,3,opt,name=captcha_output,json=captchaOutput,proto3" json:"captcha_output,omitempty"` // "captcha_output"
+	PassToken     string                 `protobuf:"bytes,4,opt,name=pass_token,json=passToken,proto3" json:"pass_token,omitempty"`             // "pass_token"
+	GenTime       string                 `protobuf:"bytes,5,opt,name=gen_time,json=genTime,proto3" json:"gen_time,omitempty"`                   // "gen_time"
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RefreshResponse) Reset() {
-	*x = RefreshResponse{}
-	mi := &file_user_proto_msgTypes[79]
+func (x *CaptchaArgs) Reset() {
+	*x = CaptchaArgs{}
+	mi := &file_user_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RefreshResponse) String() string {
+func (x *CaptchaArgs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RefreshResponse) ProtoMessage() {}
+func (*CaptchaArgs) ProtoMessage() {}
 
-func (x *RefreshResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[79]
+func (x *CaptchaArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5308,94 +8016,70 @@ func (x *RefreshResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RefreshResponse.ProtoReflect.Descriptor instead.
-func (*RefreshResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{79}
+// Deprecated: Use CaptchaArgs.ProtoReflect.Descriptor instead.
+func (*CaptchaArgs) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{119}
 }
 
-func (x *RefreshResponse) GetAccessToken() string {
+func (x *CaptchaArgs) GetCaptchaId() string {
 	if x != nil {
-		return x.AccessToken
+		return x.CaptchaId
 	}
 	return ""
 }
 
-// TagUsageCountReq 标签计数请求
-type TagUsageCountReq struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TagIds        []int64                `protobuf:"varint,1,rep,packed,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty"` // 标签ID列表
-	Delta         int32                  `protobuf:"varint,2,opt,name=delta,proto3" json:"delta,omitempty"`                        // 变化量（正数增加，负数减少）
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *TagUsageCountReq) Reset() {
-	*x = TagUsageCountReq{}
-	mi := &file_user_proto_msgTypes[80]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *TagUsageCountReq) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*TagUsageCountReq) ProtoMessage() {}
-
-func (x *TagUsageCountReq) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[80]
+func (x *CaptchaArgs) GetLotNumber() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.LotNumber
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use TagUsageCountReq.ProtoReflect.Descriptor instead.
-func (*TagUsageCountReq) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{80}
+func (x *CaptchaArgs) GetCaptchaOutput() string {
+	if x != nil {
+		return x.CaptchaOutput
+	}
+	return ""
 }
 
-func (x *TagUsageCountReq) GetTagIds() []int64 {
+func (x *CaptchaArgs) GetPassToken() string {
 	if x != nil {
-		return x.TagIds
+		return x.PassToken
 	}
-	return nil
+	return ""
 }
 
-func (x *TagUsageCountReq) GetDelta() int32 {
+func (x *CaptchaArgs) GetGenTime() string {
 	if x != nil {
-		return x.Delta
+		return x.GenTime
 	}
-	return 0
+	return ""
 }
 
-// TagUsageCountResp 标签计数响应
-type TagUsageCountResp struct {
+// 发送QQ邮箱
+type SendQQEmailReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // 是否成功
+	QqEmail       string                 `protobuf:"bytes,1,opt,name=qq_email,json=qqEmail,proto3" json:"qq_email,omitempty"`
+	Scene         string                 `protobuf:"bytes,2,opt,name=scene,proto3" json:"scene,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TagUsageCountResp) Reset() {
-	*x = TagUsageCountResp{}
-	mi := &file_user_proto_msgTypes[81]
+func (x *SendQQEmailReq) Reset() {
+	*x = SendQQEmailReq{}
+	mi := &file_user_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TagUsageCountResp) String() string {
+func (x *SendQQEmailReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TagUsageCountResp) ProtoMessage() {}
+func (*SendQQEmailReq) ProtoMessage() {}
 
-func (x *TagUsageCountResp) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[81]
+func (x *SendQQEmailReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[120]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5406,40 +8090,46 @@ func (x *TagUsageCountResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TagUsageCountResp.ProtoReflect.Descriptor instead.
-func (*TagUsageCountResp) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{81}
+// Deprecated: Use SendQQEmailReq.ProtoReflect.Descriptor instead.
+func (*SendQQEmailReq) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{120}
 }
 
-func (x *TagUsageCountResp) GetSuccess() bool {
+func (x *SendQQEmailReq) GetQqEmail() string {
+	if x != nil {
+		return x.QqEmail
+	}
+	return ""
+}
+
+func (x *SendQQEmailReq) GetScene() string {
 	if x != nil {
-		return x.Success
+		return x.Scene
 	}
-	return false
+	return ""
 }
 
-// 获取验证码配置信息
-type GetCaptchaConfigReq struct {
+type SendQQEmailResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCaptchaConfigReq) Reset() {
-	*x = GetCaptchaConfigReq{}
-	mi := &file_user_proto_msgTypes[82]
+func (x *SendQQEmailResponse) Reset() {
+	*x = SendQQEmailResponse{}
+	mi := &file_user_proto_msgTypes[121]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCaptchaConfigReq) String() string {
+func (x *SendQQEmailResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCaptchaConfigReq) ProtoMessage() {}
+func (*SendQQEmailResponse) ProtoMessage() {}
 
-func (x *GetCaptchaConfigReq) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[82]
+func (x *SendQQEmailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[121]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5450,33 +8140,36 @@ func (x *GetCaptchaConfigReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCaptchaConfigReq.ProtoReflect.Descriptor instead.
-func (*GetCaptchaConfigReq) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{82}
+// Deprecated: Use SendQQEmailResponse.ProtoReflect.Descriptor instead.
+func (*SendQQEmailResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{121}
 }
 
-type GetCaptchaConfigResponse struct {
+// 校验QQ邮箱
+type CheckQQEmailReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CaptchaId     string                 `protobuf:"bytes,1,opt,name=captcha_id,json=captchaId,proto3" json:"captcha_id,omitempty"`
+	QqEmail       string                 `protobuf:"bytes,1,opt,name=qq_email,json=qqEmail,proto3" json:"qq_email,omitempty"`
+	QqCode        string                 `protobuf:"bytes,2,opt,name=qq_code,json=qqCode,proto3" json:"qq_code,omitempty"`
+	Scene         string                 `protobuf:"bytes,3,opt,name=scene,proto3" json:"scene,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCaptchaConfigResponse) Reset() {
-	*x = GetCaptchaConfigResponse{}
-	mi := &file_user_proto_msgTypes[83]
+func (x *CheckQQEmailReq) Reset() {
+	*x = CheckQQEmailReq{}
+	mi := &file_user_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCaptchaConfigResponse) String() string {
+func (x *CheckQQEmailReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCaptchaConfigResponse) ProtoMessage() {}
+func (*CheckQQEmailReq) ProtoMessage() {}
 
-func (x *GetCaptchaConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[83]
+func (x *CheckQQEmailReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5487,44 +8180,54 @@ func (x *GetCaptchaConfigResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCaptchaConfigResponse.ProtoReflect.Descriptor instead.
-func (*GetCaptchaConfigResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{83}
+// Deprecated: Use CheckQQEmailReq.ProtoReflect.Descriptor instead.
+func (*CheckQQEmailReq) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{122}
 }
 
-func (x *GetCaptchaConfigResponse) GetCaptchaId() string {
+func (x *CheckQQEmailReq) GetQqEmail() string {
 	if x != nil {
-		return x.CaptchaId
+		return x.QqEmail
 	}
 	return ""
 }
 
-// 校验验证码是否正确
-type CheckCaptchaReq struct {
+func (x *CheckQQEmailReq) GetQqCode() string {
+	if x != nil {
+		return x.QqCode
+	}
+	return ""
+}
+
+func (x *CheckQQEmailReq) GetScene() string {
+	if x != nil {
+		return x.Scene
+	}
+	return ""
+}
+
+type CheckQQEmailResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	LotNumber     string                 `protobuf:"bytes,1,opt,name=lotNumber,proto3" json:"lotNumber,omitempty"`
-	CaptchaOutput string                 `protobuf:"bytes,2,opt,name=captchaOutput,proto3" json:"captchaOutput,omitempty"`
-	PassToken     string                 `protobuf:"bytes,3,opt,name=passToken,proto3" json:"passToken,omitempty"`
-	GenTime       string                 `protobuf:"bytes,4,opt,name=genTime,proto3" json:"genTime,omitempty"`
+	IsValid       bool                   `protobuf:"varint,1,opt,name=isValid,proto3" json:"isValid,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CheckCaptchaReq) Reset() {
-	*x = CheckCaptchaReq{}
-	mi := &file_user_proto_msgTypes[84]
+func (x *CheckQQEmailResponse) Reset() {
+	*x = CheckQQEmailResponse{}
+	mi := &file_user_proto_msgTypes[123]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckCaptchaReq) String() string {
+func (x *CheckQQEmailResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckCaptchaReq) ProtoMessage() {}
+func (*CheckQQEmailResponse) ProtoMessage() {}
 
-func (x *CheckCaptchaReq) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[84]
+func (x *CheckQQEmailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[123]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5535,63 +8238,43 @@ func (x *CheckCaptchaReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckCaptchaReq.ProtoReflect.Descriptor instead.
-func (*CheckCaptchaReq) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{84}
-}
-
-func (x *CheckCaptchaReq) GetLotNumber() string {
-	if x != nil {
-		return x.LotNumber
-	}
-	return ""
-}
-
-func (x *CheckCaptchaReq) GetCaptchaOutput() string {
-	if x != nil {
-		return x.CaptchaOutput
-	}
-	return ""
-}
-
-func (x *CheckCaptchaReq) GetPassToken() string {
-	if x != nil {
-		return x.PassToken
-	}
-	return ""
+// Deprecated: Use CheckQQEmailResponse.ProtoReflect.Descriptor instead.
+func (*CheckQQEmailResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{123}
 }
 
-func (x *CheckCaptchaReq) GetGenTime() string {
+func (x *CheckQQEmailResponse) GetIsValid() bool {
 	if x != nil {
-		return x.GenTime
+		return x.IsValid
 	}
-	return ""
+	return false
 }
 
-type CheckCaptchaResponse struct {
+// 上传头像
+type UploadAvatarReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Result        string                 `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`                              // "result": "success" 或 "fail"
-	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`                              // "reason": 失败原因
-	CaptchaArgs   *CaptchaArgs           `protobuf:"bytes,3,opt,name=captcha_args,json=captchaArgs,proto3" json:"captcha_args,omitempty"` // 嵌套对象 "captcha_args"
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FileName      string                 `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	FileData      []byte                 `protobuf:"bytes,3,opt,name=file_data,json=fileData,proto3" json:"file_data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CheckCaptchaResponse) Reset() {
-	*x = CheckCaptchaResponse{}
-	mi := &file_user_proto_msgTypes[85]
+func (x *UploadAvatarReq) Reset() {
+	*x = UploadAvatarReq{}
+	mi := &file_user_proto_msgTypes[124]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckCaptchaResponse) String() string {
+func (x *UploadAvatarReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckCaptchaResponse) ProtoMessage() {}
+func (*UploadAvatarReq) ProtoMessage() {}
 
-func (x *CheckCaptchaResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[85]
+func (x *UploadAvatarReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[124]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5602,58 +8285,54 @@ func (x *CheckCaptchaResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckCaptchaResponse.ProtoReflect.Descriptor instead.
-func (*CheckCaptchaResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{85}
+// Deprecated: Use UploadAvatarReq.ProtoReflect.Descriptor instead.
+func (*UploadAvatarReq) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{124}
 }
 
-func (x *CheckCaptchaResponse) GetResult() string {
+func (x *UploadAvatarReq) GetUserId() int64 {
 	if x != nil {
-		return x.Result
+		return x.UserId
 	}
-	return ""
+	return 0
 }
 
-func (x *CheckCaptchaResponse) GetReason() string {
+func (x *UploadAvatarReq) GetFileName() string {
 	if x != nil {
-		return x.Reason
+		return x.FileName
 	}
 	return ""
 }
 
-func (x *CheckCaptchaResponse) GetCaptchaArgs() *CaptchaArgs {
+func (x *UploadAvatarReq) GetFileData() []byte {
 	if x != nil {
-		return x.CaptchaArgs
+		return x.FileData
 	}
 	return nil
 }
 
-type CaptchaArgs struct {
+type UploadAvatarResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CaptchaId     string                 `protobuf:"bytes,1,opt,name=captcha_id,json=captchaId,proto3" json:"captcha_id,omitempty"`             // "captcha_id"
-	LotNumber     string                 `protobuf:"bytes,2,opt,name=lot_number,json=lotNumber,proto3" json:"lot_number,omitempty"`             // "lot_number"
-	CaptchaOutput string                 `protobuf:"bytes,3,opt,name=captcha_output,json=captchaOutput,proto3" json:"captcha_output,omitempty"` // "captcha_output"
-	PassToken     string                 `protobuf:"bytes,4,opt,name=pass_token,json=passToken,proto3" json:"pass_token,omitempty"`             // "pass_token"
-	GenTime       string                 `protobuf:"bytes,5,opt,name=gen_time,json=genTime,proto3" json:"gen_time,omitempty"`                   // "gen_time"
+	AvatarUrl     string                 `protobuf:"bytes,1,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CaptchaArgs) Reset() {
-	*x = CaptchaArgs{}
-	mi := &file_user_proto_msgTypes[86]
+func (x *UploadAvatarResp) Reset() {
+	*x = UploadAvatarResp{}
+	mi := &file_user_proto_msgTypes[125]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CaptchaArgs) String() string {
+func (x *UploadAvatarResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CaptchaArgs) ProtoMessage() {}
+func (*UploadAvatarResp) ProtoMessage() {}
 
-func (x *CaptchaArgs) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[86]
+func (x *UploadAvatarResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[125]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5664,70 +8343,45 @@ func (x *CaptchaArgs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CaptchaArgs.ProtoReflect.Descriptor instead.
-func (*CaptchaArgs) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{86}
-}
-
-func (x *CaptchaArgs) GetCaptchaId() string {
-	if x != nil {
-		return x.CaptchaId
-	}
-	return ""
-}
-
-func (x *CaptchaArgs) GetLotNumber() string {
-	if x != nil {
-		return x.LotNumber
-	}
-	return ""
-}
-
-func (x *CaptchaArgs) GetCaptchaOutput() string {
-	if x != nil {
-		return x.CaptchaOutput
-	}
-	return ""
-}
-
-func (x *CaptchaArgs) GetPassToken() string {
-	if x != nil {
-		return x.PassToken
-	}
-	return ""
+// Deprecated: Use UploadAvatarResp.ProtoReflect.Descriptor instead.
+func (*UploadAvatarResp) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{125}
 }
 
-func (x *CaptchaArgs) GetGenTime() string {
+func (x *UploadAvatarResp) GetAvatarUrl() string {
 	if x != nil {
-		return x.GenTime
+		return x.AvatarUrl
 	}
 	return ""
 }
 
-// 发送QQ邮箱
-type SendQQEmailReq struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	QqEmail       string                 `protobuf:"bytes,1,opt,name=qq_email,json=qqEmail,proto3" json:"qq_email,omitempty"`
-	Scene         string                 `protobuf:"bytes,2,opt,name=scene,proto3" json:"scene,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// 上传学生认证图片请求
+type UploadStudentCardImagesReq struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FrontImageData []byte                 `protobuf:"bytes,2,opt,name=front_image_data,json=frontImageData,proto3" json:"front_image_data,omitempty"`
+	FrontImageName string                 `protobuf:"bytes,3,opt,name=front_image_name,json=frontImageName,proto3" json:"front_image_name,omitempty"`
+	BackImageData  []byte                 `protobuf:"bytes,4,opt,name=back_image_data,json=backImageData,proto3" json:"back_image_data,omitempty"`
+	BackImageName  string                 `protobuf:"bytes,5,opt,name=back_image_name,json=backImageName,proto3" json:"back_image_name,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *SendQQEmailReq) Reset() {
-	*x = SendQQEmailReq{}
-	mi := &file_user_proto_msgTypes[87]
+func (x *UploadStudentCardImagesReq) Reset() {
+	*x = UploadStudentCardImagesReq{}
+	mi := &file_user_proto_msgTypes[126]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SendQQEmailReq) String() string {
+func (x *UploadStudentCardImagesReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendQQEmailReq) ProtoMessage() {}
+func (*UploadStudentCardImagesReq) ProtoMessage() {}
 
-func (x *SendQQEmailReq) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[87]
+func (x *UploadStudentCardImagesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[126]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5735,49 +8389,73 @@ func (x *SendQQEmailReq) ProtoReflect() protoreflect.Message {
 		}
 		return ms
 	}
-	return mi.MessageOf(x)
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadStudentCardImagesReq.ProtoReflect.Descriptor instead.
+func (*UploadStudentCardImagesReq) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *UploadStudentCardImagesReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
 }
 
-// Deprecated: Use SendQQEmailReq.ProtoReflect.Descriptor instead.
-func (*SendQQEmailReq) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{87}
+func (x *UploadStudentCardImagesReq) GetFrontImageData() []byte {
+	if x != nil {
+		return x.FrontImageData
+	}
+	return nil
 }
 
-func (x *SendQQEmailReq) GetQqEmail() string {
+func (x *UploadStudentCardImagesReq) GetFrontImageName() string {
 	if x != nil {
-		return x.QqEmail
+		return x.FrontImageName
 	}
 	return ""
 }
 
-func (x *SendQQEmailReq) GetScene() string {
+func (x *UploadStudentCardImagesReq) GetBackImageData() []byte {
 	if x != nil {
-		return x.Scene
+		return x.BackImageData
+	}
+	return nil
+}
+
+func (x *UploadStudentCardImagesReq) GetBackImageName() string {
+	if x != nil {
+		return x.BackImageName
 	}
 	return ""
 }
 
-type SendQQEmailResponse struct {
+// 上传学生认证图片响应
+type UploadStudentCardImagesResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	FrontImageUrl string                 `protobuf:"bytes,1,opt,name=front_image_url,json=frontImageUrl,proto3" json:"front_image_url,omitempty"`
+	BackImageUrl  string                 `protobuf:"bytes,2,opt,name=back_image_url,json=backImageUrl,proto3" json:"back_image_url,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SendQQEmailResponse) Reset() {
-	*x = SendQQEmailResponse{}
-	mi := &file_user_proto_msgTypes[88]
+func (x *UploadStudentCardImagesResp) Reset() {
+	*x = UploadStudentCardImagesResp{}
+	mi := &file_user_proto_msgTypes[127]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SendQQEmailResponse) String() string {
+func (x *UploadStudentCardImagesResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendQQEmailResponse) ProtoMessage() {}
+func (*UploadStudentCardImagesResp) ProtoMessage() {}
 
-func (x *SendQQEmailResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[88]
+func (x *UploadStudentCardImagesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[127]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5788,36 +8466,50 @@ func (x *SendQQEmailResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendQQEmailResponse.ProtoReflect.Descriptor instead.
-func (*SendQQEmailResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{88}
+// Deprecated: Use UploadStudentCardImagesResp.ProtoReflect.Descriptor instead.
+func (*UploadStudentCardImagesResp) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{127}
 }
 
-// 校验QQ邮箱
-type CheckQQEmailReq struct {
+func (x *UploadStudentCardImagesResp) GetFrontImageUrl() string {
+	if x != nil {
+		return x.FrontImageUrl
+	}
+	return ""
+}
+
+func (x *UploadStudentCardImagesResp) GetBackImageUrl() string {
+	if x != nil {
+		return x.BackImageUrl
+	}
+	return ""
+}
+
+// 上传活动封面请求
+type UploadActivityCoverReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	QqEmail       string                 `protobuf:"bytes,1,opt,name=qq_email,json=qqEmail,proto3" json:"qq_email,omitempty"`
-	QqCode        string                 `protobuf:"bytes,2,opt,name=qq_code,json=qqCode,proto3" json:"qq_code,omitempty"`
-	Scene         string                 `protobuf:"bytes,3,opt,name=scene,proto3" json:"scene,omitempty"`
+	ActivityId    int64                  `protobuf:"varint,1,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"`
+	FileData      []byte                 `protobuf:"bytes,2,opt,name=file_data,json=fileData,proto3" json:"file_data,omitempty"`
+	FileName      string                 `protobuf:"bytes,3,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CheckQQEmailReq) Reset() {
-	*x = CheckQQEmailReq{}
-	mi := &file_user_proto_msgTypes[89]
+func (x *UploadActivityCoverReq) Reset() {
+	*x = UploadActivityCoverReq{}
+	mi := &file_user_proto_msgTypes[128]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckQQEmailReq) String() string {
+func (x *UploadActivityCoverReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckQQEmailReq) ProtoMessage() {}
+func (*UploadActivityCoverReq) ProtoMessage() {}
 
-func (x *CheckQQEmailReq) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[89]
+func (x *UploadActivityCoverReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[128]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5828,54 +8520,55 @@ func (x *CheckQQEmailReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckQQEmailReq.ProtoReflect.Descriptor instead.
-func (*CheckQQEmailReq) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{89}
+// Deprecated: Use UploadActivityCoverReq.ProtoReflect.Descriptor instead.
+func (*UploadActivityCoverReq) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{128}
 }
 
-func (x *CheckQQEmailReq) GetQqEmail() string {
+func (x *UploadActivityCoverReq) GetActivityId() int64 {
 	if x != nil {
-		return x.QqEmail
+		return x.ActivityId
 	}
-	return ""
+	return 0
 }
 
-func (x *CheckQQEmailReq) GetQqCode() string {
+func (x *UploadActivityCoverReq) GetFileData() []byte {
 	if x != nil {
-		return x.QqCode
+		return x.FileData
 	}
-	return ""
+	return nil
 }
 
-func (x *CheckQQEmailReq) GetScene() string {
+func (x *UploadActivityCoverReq) GetFileName() string {
 	if x != nil {
-		return x.Scene
+		return x.FileName
 	}
 	return ""
 }
 
-type CheckQQEmailResponse struct {
+// 上传活动封面响应
+type UploadActivityCoverResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	IsValid       bool                   `protobuf:"varint,1,opt,name=isValid,proto3" json:"isValid,omitempty"`
+	CoverUrl      string                 `protobuf:"bytes,1,opt,name=cover_url,json=coverUrl,proto3" json:"cover_url,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CheckQQEmailResponse) Reset() {
-	*x = CheckQQEmailResponse{}
-	mi := &file_user_proto_msgTypes[90]
+func (x *UploadActivityCoverResp) Reset() {
+	*x = UploadActivityCoverResp{}
+	mi := &file_user_proto_msgTypes[129]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckQQEmailResponse) String() string {
+func (x *UploadActivityCoverResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckQQEmailResponse) ProtoMessage() {}
+func (*UploadActivityCoverResp) ProtoMessage() {}
 
-func (x *CheckQQEmailResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[90]
+func (x *UploadActivityCoverResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[129]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5886,43 +8579,47 @@ func (x *CheckQQEmailResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckQQEmailResponse.ProtoReflect.Descriptor instead.
-func (*CheckQQEmailResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{90}
+// Deprecated: Use UploadActivityCoverResp.ProtoReflect.Descriptor instead.
+func (*UploadActivityCoverResp) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{129}
 }
 
-func (x *CheckQQEmailResponse) GetIsValid() bool {
+func (x *UploadActivityCoverResp) GetCoverUrl() string {
 	if x != nil {
-		return x.IsValid
+		return x.CoverUrl
 	}
-	return false
+	return ""
 }
 
-// 上传头像
-type UploadAvatarReq struct {
+// 上传通用图片请求
+type UploadSysImageReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	FileName      string                 `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
-	FileData      []byte                 `protobuf:"bytes,3,opt,name=file_data,json=fileData,proto3" json:"file_data,omitempty"`
+	OriginName    string                 `protobuf:"bytes,2,opt,name=origin_name,json=originName,proto3" json:"origin_name,omitempty"`
+	BizType       string                 `protobuf:"bytes,3,opt,name=biz_type,json=bizType,proto3" json:"biz_type,omitempty"`
+	FileSize      int64                  `protobuf:"varint,4,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
+	MimeType      string                 `protobuf:"bytes,5,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	Extension     string                 `protobuf:"bytes,6,opt,name=extension,proto3" json:"extension,omitempty"`
+	ImageData     []byte                 `protobuf:"bytes,7,opt,name=image_data,json=imageData,proto3" json:"image_data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UploadAvatarReq) Reset() {
-	*x = UploadAvatarReq{}
-	mi := &file_user_proto_msgTypes[91]
+func (x *UploadSysImageReq) Reset() {
+	*x = UploadSysImageReq{}
+	mi := &file_user_proto_msgTypes[130]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UploadAvatarReq) String() string {
+func (x *UploadSysImageReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadAvatarReq) ProtoMessage() {}
+func (*UploadSysImageReq) ProtoMessage() {}
 
-func (x *UploadAvatarReq) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[91]
+func (x *UploadSysImageReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[130]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5933,103 +8630,87 @@ func (x *UploadAvatarReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadAvatarReq.ProtoReflect.Descriptor instead.
-func (*UploadAvatarReq) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{91}
+// Deprecated: Use UploadSysImageReq.ProtoReflect.Descriptor instead.
+func (*UploadSysImageReq) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{130}
 }
 
-func (x *UploadAvatarReq) GetUserId() int64 {
+func (x *UploadSysImageReq) GetUserId() int64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *UploadAvatarReq) GetFileName() string {
+func (x *UploadSysImageReq) GetOriginName() string {
 	if x != nil {
-		return x.FileName
+		return x.OriginName
 	}
 	return ""
 }
 
-func (x *UploadAvatarReq) GetFileData() []byte {
+func (x *UploadSysImageReq) GetBizType() string {
 	if x != nil {
-		return x.FileData
+		return x.BizType
 	}
-	return nil
-}
-
-type UploadAvatarResp struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	AvatarUrl     string                 `protobuf:"bytes,1,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *UploadAvatarResp) Reset() {
-	*x = UploadAvatarResp{}
-	mi := &file_user_proto_msgTypes[92]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+	return ""
 }
 
-func (x *UploadAvatarResp) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *UploadSysImageReq) GetFileSize() int64 {
+	if x != nil {
+		return x.FileSize
+	}
+	return 0
 }
 
-func (*UploadAvatarResp) ProtoMessage() {}
-
-func (x *UploadAvatarResp) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[92]
+func (x *UploadSysImageReq) GetMimeType() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.MimeType
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use UploadAvatarResp.ProtoReflect.Descriptor instead.
-func (*UploadAvatarResp) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{92}
+func (x *UploadSysImageReq) GetExtension() string {
+	if x != nil {
+		return x.Extension
+	}
+	return ""
 }
 
-func (x *UploadAvatarResp) GetAvatarUrl() string {
+func (x *UploadSysImageReq) GetImageData() []byte {
 	if x != nil {
-		return x.AvatarUrl
+		return x.ImageData
 	}
-	return ""
+	return nil
 }
 
-// 上传学生认证图片请求
-type UploadStudentCardImagesReq struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	UserId         int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	FrontImageData []byte                 `protobuf:"bytes,2,opt,name=front_image_data,json=frontImageData,proto3" json:"front_image_data,omitempty"`
-	FrontImageName string                 `protobuf:"bytes,3,opt,name=front_image_name,json=frontImageName,proto3" json:"front_image_name,omitempty"`
-	BackImageData  []byte                 `protobuf:"bytes,4,opt,name=back_image_data,json=backImageData,proto3" json:"back_image_data,omitempty"`
-	BackImageName  string                 `protobuf:"bytes,5,opt,name=back_image_name,json=backImageName,proto3" json:"back_image_name,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// 上传通用图片响应
+type UploadSysImageResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	FileSize      int64                  `protobuf:"varint,3,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
+	OriginName    string                 `protobuf:"bytes,4,opt,name=origin_name,json=originName,proto3" json:"origin_name,omitempty"`
+	BizType       string                 `protobuf:"bytes,5,opt,name=biz_type,json=bizType,proto3" json:"biz_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UploadStudentCardImagesReq) Reset() {
-	*x = UploadStudentCardImagesReq{}
-	mi := &file_user_proto_msgTypes[93]
+func (x *UploadSysImageResp) Reset() {
+	*x = UploadSysImageResp{}
+	mi := &file_user_proto_msgTypes[131]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UploadStudentCardImagesReq) String() string {
+func (x *UploadSysImageResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadStudentCardImagesReq) ProtoMessage() {}
+func (*UploadSysImageResp) ProtoMessage() {}
 
-func (x *UploadStudentCardImagesReq) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[93]
+func (x *UploadSysImageResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[131]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6040,70 +8721,72 @@ func (x *UploadStudentCardImagesReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadStudentCardImagesReq.ProtoReflect.Descriptor instead.
-func (*UploadStudentCardImagesReq) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{93}
+// Deprecated: Use UploadSysImageResp.ProtoReflect.Descriptor instead.
+func (*UploadSysImageResp) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{131}
 }
 
-func (x *UploadStudentCardImagesReq) GetUserId() int64 {
+func (x *UploadSysImageResp) GetId() int64 {
 	if x != nil {
-		return x.UserId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *UploadStudentCardImagesReq) GetFrontImageData() []byte {
+func (x *UploadSysImageResp) GetUrl() string {
 	if x != nil {
-		return x.FrontImageData
+		return x.Url
 	}
-	return nil
+	return ""
 }
 
-func (x *UploadStudentCardImagesReq) GetFrontImageName() string {
+func (x *UploadSysImageResp) GetFileSize() int64 {
 	if x != nil {
-		return x.FrontImageName
+		return x.FileSize
 	}
-	return ""
+	return 0
 }
 
-func (x *UploadStudentCardImagesReq) GetBackImageData() []byte {
+func (x *UploadSysImageResp) GetOriginName() string {
 	if x != nil {
-		return x.BackImageData
+		return x.OriginName
 	}
-	return nil
+	return ""
 }
 
-func (x *UploadStudentCardImagesReq) GetBackImageName() string {
+func (x *UploadSysImageResp) GetBizType() string {
 	if x != nil {
-		return x.BackImageName
+		return x.BizType
 	}
 	return ""
 }
 
-// 上传学生认证图片响应
-type UploadStudentCardImagesResp struct {
+// StatsMetricItem 统计指标数据
+type StatsMetricItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	FrontImageUrl string                 `protobuf:"bytes,1,opt,name=front_image_url,json=frontImageUrl,proto3" json:"front_image_url,omitempty"`
-	BackImageUrl  string                 `protobuf:"bytes,2,opt,name=back_image_url,json=backImageUrl,proto3" json:"back_image_url,omitempty"`
+	StatDate      string                 `protobuf:"bytes,1,opt,name=stat_date,json=statDate,proto3" json:"stat_date,omitempty"` // 统计日期 yyyy-MM-dd
+	Metric        string                 `protobuf:"bytes,2,opt,name=metric,proto3" json:"metric,omitempty"`                     // 指标名称
+	Dimension     string                 `protobuf:"bytes,3,opt,name=dimension,proto3" json:"dimension,omitempty"`               // 维度（为空表示总量）
+	Value         float64                `protobuf:"fixed64,4,opt,name=value,proto3" json:"value,omitempty"`                     // 数值
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UploadStudentCardImagesResp) Reset() {
-	*x = UploadStudentCardImagesResp{}
-	mi := &file_user_proto_msgTypes[94]
+func (x *StatsMetricItem) Reset() {
+	*x = StatsMetricItem{}
+	mi := &file_user_proto_msgTypes[132]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UploadStudentCardImagesResp) String() string {
+func (x *StatsMetricItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadStudentCardImagesResp) ProtoMessage() {}
+func (*StatsMetricItem) ProtoMessage() {}
 
-func (x *UploadStudentCardImagesResp) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[94]
+func (x *StatsMetricItem) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[132]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6114,50 +8797,63 @@ func (x *UploadStudentCardImagesResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadStudentCardImagesResp.ProtoReflect.Descriptor instead.
-func (*UploadStudentCardImagesResp) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{94}
+// Deprecated: Use StatsMetricItem.ProtoReflect.Descriptor instead.
+func (*StatsMetricItem) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{132}
 }
 
-func (x *UploadStudentCardImagesResp) GetFrontImageUrl() string {
+func (x *StatsMetricItem) GetStatDate() string {
 	if x != nil {
-		return x.FrontImageUrl
+		return x.StatDate
 	}
 	return ""
 }
 
-func (x *UploadStudentCardImagesResp) GetBackImageUrl() string {
+func (x *StatsMetricItem) GetMetric() string {
 	if x != nil {
-		return x.BackImageUrl
+		return x.Metric
 	}
 	return ""
 }
 
-// 上传活动封面请求
-type UploadActivityCoverReq struct {
+func (x *StatsMetricItem) GetDimension() string {
+	if x != nil {
+		return x.Dimension
+	}
+	return ""
+}
+
+func (x *StatsMetricItem) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+// RecordUserActiveReq 记录用户活跃请求
+type RecordUserActiveReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ActivityId    int64                  `protobuf:"varint,1,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"`
-	FileData      []byte                 `protobuf:"bytes,2,opt,name=file_data,json=fileData,proto3" json:"file_data,omitempty"`
-	FileName      string                 `protobuf:"bytes,3,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Source        string                 `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"` // 活跃来源: login / ws
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UploadActivityCoverReq) Reset() {
-	*x = UploadActivityCoverReq{}
-	mi := &file_user_proto_msgTypes[95]
+func (x *RecordUserActiveReq) Reset() {
+	*x = RecordUserActiveReq{}
+	mi := &file_user_proto_msgTypes[133]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UploadActivityCoverReq) String() string {
+func (x *RecordUserActiveReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadActivityCoverReq) ProtoMessage() {}
+func (*RecordUserActiveReq) ProtoMessage() {}
 
-func (x *UploadActivityCoverReq) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[95]
+func (x *RecordUserActiveReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[133]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6168,55 +8864,47 @@ func (x *UploadActivityCoverReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadActivityCoverReq.ProtoReflect.Descriptor instead.
-func (*UploadActivityCoverReq) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{95}
+// Deprecated: Use RecordUserActiveReq.ProtoReflect.Descriptor instead.
+func (*RecordUserActiveReq) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{133}
 }
 
-func (x *UploadActivityCoverReq) GetActivityId() int64 {
+func (x *RecordUserActiveReq) GetUserId() int64 {
 	if x != nil {
-		return x.ActivityId
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *UploadActivityCoverReq) GetFileData() []byte {
-	if x != nil {
-		return x.FileData
-	}
-	return nil
-}
-
-func (x *UploadActivityCoverReq) GetFileName() string {
+func (x *RecordUserActiveReq) GetSource() string {
 	if x != nil {
-		return x.FileName
+		return x.Source
 	}
 	return ""
 }
 
-// 上传活动封面响应
-type UploadActivityCoverResp struct {
+// RecordUserActiveResp 记录用户活跃响应
+type RecordUserActiveResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CoverUrl      string                 `protobuf:"bytes,1,opt,name=cover_url,json=coverUrl,proto3" json:"cover_url,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UploadActivityCoverResp) Reset() {
-	*x = UploadActivityCoverResp{}
-	mi := &file_user_proto_msgTypes[96]
+func (x *RecordUserActiveResp) Reset() {
+	*x = RecordUserActiveResp{}
+	mi := &file_user_proto_msgTypes[134]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UploadActivityCoverResp) String() string {
+func (x *RecordUserActiveResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadActivityCoverResp) ProtoMessage() {}
+func (*RecordUserActiveResp) ProtoMessage() {}
 
-func (x *UploadActivityCoverResp) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[96]
+func (x *RecordUserActiveResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[134]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6227,47 +8915,37 @@ func (x *UploadActivityCoverResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadActivityCoverResp.ProtoReflect.Descriptor instead.
-func (*UploadActivityCoverResp) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{96}
-}
-
-func (x *UploadActivityCoverResp) GetCoverUrl() string {
-	if x != nil {
-		return x.CoverUrl
-	}
-	return ""
+// Deprecated: Use RecordUserActiveResp.ProtoReflect.Descriptor instead.
+func (*RecordUserActiveResp) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{134}
 }
 
-// 上传通用图片请求
-type UploadSysImageReq struct {
+// ReportDailyStatsReq 上报某日指标请求
+type ReportDailyStatsReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	OriginName    string                 `protobuf:"bytes,2,opt,name=origin_name,json=originName,proto3" json:"origin_name,omitempty"`
-	BizType       string                 `protobuf:"bytes,3,opt,name=biz_type,json=bizType,proto3" json:"biz_type,omitempty"`
-	FileSize      int64                  `protobuf:"varint,4,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
-	MimeType      string                 `protobuf:"bytes,5,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
-	Extension     string                 `protobuf:"bytes,6,opt,name=extension,proto3" json:"extension,omitempty"`
-	ImageData     []byte                 `protobuf:"bytes,7,opt,name=image_data,json=imageData,proto3" json:"image_data,omitempty"`
+	Source        string                 `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`                     // 上报来源: activity / chat
+	StatDate      string                 `protobuf:"bytes,2,opt,name=stat_date,json=statDate,proto3" json:"stat_date,omitempty"` // 统计日期 yyyy-MM-dd
+	Metrics       []string               `protobuf:"bytes,3,rep,name=metrics,proto3" json:"metrics,omitempty"`                   // 本次上报的指标（旧数据整体替换，items 为空时清空）
+	Items         []*StatsMetricItem     `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`                       // 指标数据（stat_date 以请求为准）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UploadSysImageReq) Reset() {
-	*x = UploadSysImageReq{}
-	mi := &file_user_proto_msgTypes[97]
+func (x *ReportDailyStatsReq) Reset() {
+	*x = ReportDailyStatsReq{}
+	mi := &file_user_proto_msgTypes[135]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UploadSysImageReq) String() string {
+func (x *ReportDailyStatsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadSysImageReq) ProtoMessage() {}
+func (*ReportDailyStatsReq) ProtoMessage() {}
 
-func (x *UploadSysImageReq) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[97]
+func (x *ReportDailyStatsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[135]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6278,87 +8956,109 @@ func (x *UploadSysImageReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadSysImageReq.ProtoReflect.Descriptor instead.
-func (*UploadSysImageReq) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{97}
+// Deprecated: Use ReportDailyStatsReq.ProtoReflect.Descriptor instead.
+func (*ReportDailyStatsReq) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{135}
 }
 
-func (x *UploadSysImageReq) GetUserId() int64 {
+func (x *ReportDailyStatsReq) GetSource() string {
 	if x != nil {
-		return x.UserId
+		return x.Source
 	}
-	return 0
+	return ""
 }
 
-func (x *UploadSysImageReq) GetOriginName() string {
+func (x *ReportDailyStatsReq) GetStatDate() string {
 	if x != nil {
-		return x.OriginName
+		return x.StatDate
 	}
 	return ""
 }
 
-func (x *UploadSysImageReq) GetBizType() string {
+func (x *ReportDailyStatsReq) GetMetrics() []string {
 	if x != nil {
-		return x.BizType
+		return x.Metrics
 	}
-	return ""
+	return nil
 }
 
-func (x *UploadSysImageReq) GetFileSize() int64 {
+func (x *ReportDailyStatsReq) GetItems() []*StatsMetricItem {
 	if x != nil {
-		return x.FileSize
+		return x.Items
 	}
-	return 0
+	return nil
 }
 
-func (x *UploadSysImageReq) GetMimeType() string {
-	if x != nil {
-		return x.MimeType
-	}
-	return ""
+// ReportDailyStatsResp 上报某日指标响应
+type ReportDailyStatsResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"` // 写入条数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UploadSysImageReq) GetExtension() string {
+func (x *ReportDailyStatsResp) Reset() {
+	*x = ReportDailyStatsResp{}
+	mi := &file_user_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportDailyStatsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportDailyStatsResp) ProtoMessage() {}
+
+func (x *ReportDailyStatsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[136]
 	if x != nil {
-		return x.Extension
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UploadSysImageReq) GetImageData() []byte {
+// Deprecated: Use ReportDailyStatsResp.ProtoReflect.Descriptor instead.
+func (*ReportDailyStatsResp) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *ReportDailyStatsResp) GetCount() int32 {
 	if x != nil {
-		return x.ImageData
+		return x.Count
 	}
-	return nil
+	return 0
 }
 
-// 上传通用图片响应
-type UploadSysImageResp struct {
+// GetPlatformStatsReq 查询平台指标请求
+type GetPlatformStatsReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
-	FileSize      int64                  `protobuf:"varint,3,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
-	OriginName    string                 `protobuf:"bytes,4,opt,name=origin_name,json=originName,proto3" json:"origin_name,omitempty"`
-	BizType       string                 `protobuf:"bytes,5,opt,name=biz_type,json=bizType,proto3" json:"biz_type,omitempty"`
+	StartDate     string                 `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // 开始日期 yyyy-MM-dd
+	EndDate       string                 `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`       // 结束日期 yyyy-MM-dd（含）
+	Metrics       []string               `protobuf:"bytes,3,rep,name=metrics,proto3" json:"metrics,omitempty"`                      // 指标过滤（为空返回全部）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UploadSysImageResp) Reset() {
-	*x = UploadSysImageResp{}
-	mi := &file_user_proto_msgTypes[98]
+func (x *GetPlatformStatsReq) Reset() {
+	*x = GetPlatformStatsReq{}
+	mi := &file_user_proto_msgTypes[137]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UploadSysImageResp) String() string {
+func (x *GetPlatformStatsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadSysImageResp) ProtoMessage() {}
+func (*GetPlatformStatsReq) ProtoMessage() {}
 
-func (x *UploadSysImageResp) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[98]
+func (x *GetPlatformStatsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[137]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6369,44 +9069,75 @@ func (x *UploadSysImageResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadSysImageResp.ProtoReflect.Descriptor instead.
-func (*UploadSysImageResp) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{98}
+// Deprecated: Use GetPlatformStatsReq.ProtoReflect.Descriptor instead.
+func (*GetPlatformStatsReq) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{137}
 }
 
-func (x *UploadSysImageResp) GetId() int64 {
+func (x *GetPlatformStatsReq) GetStartDate() string {
 	if x != nil {
-		return x.Id
+		return x.StartDate
 	}
-	return 0
+	return ""
 }
 
-func (x *UploadSysImageResp) GetUrl() string {
+func (x *GetPlatformStatsReq) GetEndDate() string {
 	if x != nil {
-		return x.Url
+		return x.EndDate
 	}
 	return ""
 }
 
-func (x *UploadSysImageResp) GetFileSize() int64 {
+func (x *GetPlatformStatsReq) GetMetrics() []string {
 	if x != nil {
-		return x.FileSize
+		return x.Metrics
 	}
-	return 0
+	return nil
 }
 
-func (x *UploadSysImageResp) GetOriginName() string {
+// GetPlatformStatsResp 查询平台指标响应
+type GetPlatformStatsResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          []*StatsMetricItem     `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"` // 按日期、指标、维度排序
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPlatformStatsResp) Reset() {
+	*x = GetPlatformStatsResp{}
+	mi := &file_user_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPlatformStatsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlatformStatsResp) ProtoMessage() {}
+
+func (x *GetPlatformStatsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[138]
 	if x != nil {
-		return x.OriginName
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UploadSysImageResp) GetBizType() string {
+// Deprecated: Use GetPlatformStatsResp.ProtoReflect.Descriptor instead.
+func (*GetPlatformStatsResp) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *GetPlatformStatsResp) GetList() []*StatsMetricItem {
 	if x != nil {
-		return x.BizType
+		return x.List
 	}
-	return ""
+	return nil
 }
 
 var File_user_proto protoreflect.FileDescriptor
@@ -6493,9 +9224,88 @@ const file_user_proto_rawDesc = "" +
 	"\vafter_score\x18\x03 \x01(\x03R\n" +
 	"afterScore\x12\x14\n" +
 	"\x05delta\x18\x04 \x01(\x03R\x05delta\x12\x1b\n" +
-	"\tnew_level\x18\x05 \x01(\x05R\bnewLevel\".\n" +
+	"\tnew_level\x18\x05 \x01(\x05R\bnewLevel\"l\n" +
+	"\x15SubmitCreditAppealReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\"\n" +
+	"\rcredit_log_id\x18\x02 \x01(\x03R\vcreditLogId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"5\n" +
+	"\x16SubmitCreditAppealResp\x12\x1b\n" +
+	"\tappeal_id\x18\x01 \x01(\x03R\bappealId\"\xe7\x02\n" +
+	"\x10CreditAppealItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\"\n" +
+	"\rcredit_log_id\x18\x03 \x01(\x03R\vcreditLogId\x12\x14\n" +
+	"\x05delta\x18\x04 \x01(\x05R\x05delta\x12\x1d\n" +
+	"\n" +
+	"log_reason\x18\x05 \x01(\tR\tlogReason\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x12\x16\n" +
+	"\x06status\x18\a \x01(\x05R\x06status\x12\x1f\n" +
+	"\vstatus_name\x18\b \x01(\tR\n" +
+	"statusName\x12\x1f\n" +
+	"\vreviewer_id\x18\t \x01(\x03R\n" +
+	"reviewerId\x12\x1f\n" +
+	"\vreview_note\x18\n" +
+	" \x01(\tR\n" +
+	"reviewNote\x12\x1f\n" +
+	"\vreviewed_at\x18\v \x01(\x03R\n" +
+	"reviewedAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\f \x01(\x03R\tcreatedAt\"x\n" +
+	"\x14ListCreditAppealsReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\x05R\x06status\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"Y\n" +
+	"\x15ListCreditAppealsResp\x12*\n" +
+	"\x04list\x18\x01 \x03(\v2\x16.user.CreditAppealItemR\x04list\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"{\n" +
+	"\x15ReviewCreditAppealReq\x12\x1b\n" +
+	"\tappeal_id\x18\x01 \x01(\x03R\bappealId\x12\x19\n" +
+	"\badmin_id\x18\x02 \x01(\x03R\aadminId\x12\x16\n" +
+	"\x06accept\x18\x03 \x01(\bR\x06accept\x12\x12\n" +
+	"\x04note\x18\x04 \x01(\tR\x04note\"F\n" +
+	"\x16ReviewCreditAppealResp\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\x05R\x06status\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x03R\x05score\"w\n" +
+	"\x13AdminAdjustScoreReq\x12\x19\n" +
+	"\badmin_id\x18\x01 \x01(\x03R\aadminId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x14\n" +
+	"\x05delta\x18\x03 \x01(\x03R\x05delta\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"\x8d\x01\n" +
+	"\x14AdminAdjustScoreResp\x12!\n" +
+	"\fbefore_score\x18\x01 \x01(\x03R\vbeforeScore\x12\x1f\n" +
+	"\vafter_score\x18\x02 \x01(\x03R\n" +
+	"afterScore\x12\x14\n" +
+	"\x05delta\x18\x03 \x01(\x03R\x05delta\x12\x1b\n" +
+	"\tnew_level\x18\x04 \x01(\x05R\bnewLevel\"\xbe\x02\n" +
+	"\x0fCreditAuditItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
+	"\voperator_id\x18\x02 \x01(\x03R\n" +
+	"operatorId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06action\x18\x04 \x01(\tR\x06action\x12\x1b\n" +
+	"\ttarget_id\x18\x05 \x01(\x03R\btargetId\x12\x1b\n" +
+	"\tsource_id\x18\x06 \x01(\tR\bsourceId\x12\x14\n" +
+	"\x05delta\x18\a \x01(\x05R\x05delta\x12!\n" +
+	"\fbefore_score\x18\b \x01(\x05R\vbeforeScore\x12\x1f\n" +
+	"\vafter_score\x18\t \x01(\x05R\n" +
+	"afterScore\x12\x16\n" +
+	"\x06reason\x18\n" +
+	" \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\v \x01(\x03R\tcreatedAt\"\x98\x01\n" +
+	"\x13ListCreditAuditsReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1f\n" +
+	"\voperator_id\x18\x02 \x01(\x03R\n" +
+	"operatorId\x12\x16\n" +
+	"\x06action\x18\x03 \x01(\tR\x06action\x12\x12\n" +
+	"\x04page\x18\x04 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\"W\n" +
+	"\x14ListCreditAuditsResp\x12)\n" +
+	"\x04list\x18\x01 \x03(\v2\x15.user.CreditAuditItemR\x04list\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\".\n" +
 	"\x13GetVerifyCurrentReq\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\"\xa2\x03\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"\xbf\x03\n" +
 	"\x14GetVerifyCurrentResp\x12\x1d\n" +
 	"\n" +
 	"has_record\x18\x01 \x01(\bR\thasRecord\x12\x1b\n" +
@@ -6517,9 +9327,10 @@ const file_user_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\v \x01(\x03R\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\f \x01(\x03R\tupdatedAt\"+\n" +
+	"updated_at\x18\f \x01(\x03R\tupdatedAt\x12\x1b\n" +
+	"\texpire_at\x18\r \x01(\x03R\bexpireAt\"+\n" +
 	"\x10GetVerifyInfoReq\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\"\x96\x02\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"\xb3\x02\n" +
 	"\x11GetVerifyInfoResp\x12\x1f\n" +
 	"\vis_verified\x18\x01 \x01(\bR\n" +
 	"isVerified\x12\x1b\n" +
@@ -6534,9 +9345,10 @@ const file_user_proto_rawDesc = "" +
 	"department\x12%\n" +
 	"\x0eadmission_year\x18\a \x01(\tR\radmissionYear\x12\x1f\n" +
 	"\vverified_at\x18\b \x01(\x03R\n" +
-	"verifiedAt\"(\n" +
+	"verifiedAt\x12\x1b\n" +
+	"\texpire_at\x18\t \x01(\x03R\bexpireAt\"(\n" +
 	"\rIsVerifiedReq\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\"\xd9\x01\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"\xf6\x01\n" +
 	"\x0eIsVerifiedResp\x12\x1f\n" +
 	"\vis_verified\x18\x01 \x01(\bR\n" +
 	"isVerified\x12\x1f\n" +
@@ -6549,7 +9361,20 @@ const file_user_proto_rawDesc = "" +
 	"department\x12%\n" +
 	"\x0eadmission_year\x18\x05 \x01(\tR\radmissionYear\x12\x1f\n" +
 	"\vverified_at\x18\x06 \x01(\x03R\n" +
-	"verifiedAt\"\xa2\x02\n" +
+	"verifiedAt\x12\x1b\n" +
+	"\texpire_at\x18\a \x01(\x03R\bexpireAt\"6\n" +
+	"\x19BatchGetVerifyProfilesReq\x12\x19\n" +
+	"\buser_ids\x18\x01 \x03(\x03R\auserIds\"\x90\x01\n" +
+	"\rVerifyProfile\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1f\n" +
+	"\vschool_name\x18\x02 \x01(\tR\n" +
+	"schoolName\x12\x1e\n" +
+	"\n" +
+	"department\x18\x03 \x01(\tR\n" +
+	"department\x12%\n" +
+	"\x0eadmission_year\x18\x04 \x01(\tR\radmissionYear\"E\n" +
+	"\x1aBatchGetVerifyProfilesResp\x12'\n" +
+	"\x04list\x18\x01 \x03(\v2\x13.user.VerifyProfileR\x04list\"\xa2\x02\n" +
 	"\x15ApplyStudentVerifyReq\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1b\n" +
 	"\treal_name\x18\x02 \x01(\tR\brealName\x12\x1f\n" +
@@ -6569,7 +9394,15 @@ const file_user_proto_rawDesc = "" +
 	"\vstatus_desc\x18\x03 \x01(\tR\n" +
 	"statusDesc\x12\x1d\n" +
 	"\n" +
-	"created_at\x18\x04 \x01(\x03R\tcreatedAt\"\xb1\x01\n" +
+	"created_at\x18\x04 \x01(\x03R\tcreatedAt\"\xc2\x01\n" +
+	"\x12ReverifyStudentReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12&\n" +
+	"\x0ffront_image_url\x18\x02 \x01(\tR\rfrontImageUrl\x12$\n" +
+	"\x0eback_image_url\x18\x03 \x01(\tR\fbackImageUrl\x12\x1e\n" +
+	"\n" +
+	"department\x18\x04 \x01(\tR\n" +
+	"department\x12%\n" +
+	"\x0eadmission_year\x18\x05 \x01(\tR\radmissionYear\"\xb1\x01\n" +
 	"\x17ConfirmStudentVerifyReq\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1b\n" +
 	"\tverify_id\x18\x02 \x01(\x03R\bverifyId\x12!\n" +
@@ -6598,7 +9431,7 @@ const file_user_proto_rawDesc = "" +
 	"\tverify_id\x18\x01 \x01(\x03R\bverifyId\x12\x1d\n" +
 	"\n" +
 	"new_status\x18\x02 \x01(\x05R\tnewStatus\x12&\n" +
-	"\x0fnew_status_desc\x18\x03 \x01(\tR\rnewStatusDesc\"\xdd\x01\n" +
+	"\x0fnew_status_desc\x18\x03 \x01(\tR\rnewStatusDesc\"\xfe\x01\n" +
 	"\x15UpdateVerifyStatusReq\x12\x1b\n" +
 	"\tverify_id\x18\x01 \x01(\x03R\bverifyId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x1d\n" +
@@ -6606,7 +9439,9 @@ const file_user_proto_rawDesc = "" +
 	"new_status\x18\x03 \x01(\x05R\tnewStatus\x12.\n" +
 	"\bocr_data\x18\x04 \x01(\v2\x13.user.VerifyOcrDataR\aocrData\x12#\n" +
 	"\rreject_reason\x18\x05 \x01(\tR\frejectReason\x12\x1a\n" +
-	"\boperator\x18\x06 \x01(\tR\boperator\"\x9f\x02\n" +
+	"\boperator\x18\x06 \x01(\tR\boperator\x12\x1f\n" +
+	"\vreviewer_id\x18\a \x01(\x03R\n" +
+	"reviewerId\"\x9f\x02\n" +
 	"\rVerifyOcrData\x12\x1b\n" +
 	"\treal_name\x18\x01 \x01(\tR\brealName\x12\x1f\n" +
 	"\vschool_name\x18\x02 \x01(\tR\n" +
@@ -6633,7 +9468,94 @@ const file_user_proto_rawDesc = "" +
 	"\x14ProcessOcrVerifyResp\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12#\n" +
 	"\rresult_status\x18\x02 \x01(\x05R\fresultStatus\x12\x18\n" +
-	"\amessage\x18\x03 \x01(\tR\amessage\"=\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"h\n" +
+	"\x14ListVerifyReviewsReq\x12\x1f\n" +
+	"\vreviewer_id\x18\x01 \x01(\x03R\n" +
+	"reviewerId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"\xf9\x03\n" +
+	"\x10VerifyReviewItem\x12\x1b\n" +
+	"\tverify_id\x18\x01 \x01(\x03R\bverifyId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\x05R\x06status\x12\x1b\n" +
+	"\treal_name\x18\x04 \x01(\tR\brealName\x12\x1f\n" +
+	"\vschool_name\x18\x05 \x01(\tR\n" +
+	"schoolName\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x06 \x01(\tR\tstudentId\x12\x1e\n" +
+	"\n" +
+	"department\x18\a \x01(\tR\n" +
+	"department\x12%\n" +
+	"\x0eadmission_year\x18\b \x01(\tR\radmissionYear\x12&\n" +
+	"\x0ffront_image_url\x18\t \x01(\tR\rfrontImageUrl\x12$\n" +
+	"\x0eback_image_url\x18\n" +
+	" \x01(\tR\fbackImageUrl\x12!\n" +
+	"\focr_platform\x18\v \x01(\tR\vocrPlatform\x12%\n" +
+	"\x0eocr_confidence\x18\f \x01(\x01R\rocrConfidence\x12\x1d\n" +
+	"\n" +
+	"claimed_by\x18\r \x01(\x03R\tclaimedBy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x0e \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x0f \x01(\x03R\tupdatedAt\"Y\n" +
+	"\x15ListVerifyReviewsResp\x12*\n" +
+	"\x04list\x18\x01 \x03(\v2\x16.user.VerifyReviewItemR\x04list\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"n\n" +
+	"\x14ClaimVerifyReviewReq\x12\x1b\n" +
+	"\tverify_id\x18\x01 \x01(\x03R\bverifyId\x12\x1f\n" +
+	"\vreviewer_id\x18\x02 \x01(\x03R\n" +
+	"reviewerId\x12\x18\n" +
+	"\arelease\x18\x03 \x01(\bR\arelease\"S\n" +
+	"\x15ClaimVerifyReviewResp\x12\x1d\n" +
+	"\n" +
+	"claimed_by\x18\x01 \x01(\x03R\tclaimedBy\x12\x1b\n" +
+	"\texpire_at\x18\x02 \x01(\x03R\bexpireAt\"\x88\x01\n" +
+	"\x16ReviewStudentVerifyReq\x12\x1b\n" +
+	"\tverify_id\x18\x01 \x01(\x03R\bverifyId\x12\x1f\n" +
+	"\vreviewer_id\x18\x02 \x01(\x03R\n" +
+	"reviewerId\x12\x18\n" +
+	"\aapprove\x18\x03 \x01(\bR\aapprove\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"a\n" +
+	"\x17ReviewStudentVerifyResp\x12#\n" +
+	"\rbefore_status\x18\x01 \x01(\x05R\fbeforeStatus\x12!\n" +
+	"\fafter_status\x18\x02 \x01(\x05R\vafterStatus\"S\n" +
+	"\x17GetVerifyReviewStatsReq\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x01 \x01(\x03R\tstartTime\x12\x19\n" +
+	"\bend_time\x18\x02 \x01(\x03R\aendTime\"\x83\x01\n" +
+	"\x12VerifyReviewerStat\x12\x1f\n" +
+	"\vreviewer_id\x18\x01 \x01(\x03R\n" +
+	"reviewerId\x12\x1a\n" +
+	"\bapproved\x18\x02 \x01(\x03R\bapproved\x12\x1a\n" +
+	"\brejected\x18\x03 \x01(\x03R\brejected\x12\x14\n" +
+	"\x05total\x18\x04 \x01(\x03R\x05total\"b\n" +
+	"\x18GetVerifyReviewStatsResp\x12,\n" +
+	"\x04list\x18\x01 \x03(\v2\x18.user.VerifyReviewerStatR\x04list\x12\x18\n" +
+	"\apending\x18\x02 \x01(\x03R\apending\"v\n" +
+	"\x14CreateInterestTagReq\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05color\x18\x02 \x01(\tR\x05color\x12\x12\n" +
+	"\x04icon\x18\x03 \x01(\tR\x04icon\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\"\x86\x01\n" +
+	"\x14UpdateInterestTagReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05color\x18\x03 \x01(\tR\x05color\x12\x12\n" +
+	"\x04icon\x18\x04 \x01(\tR\x04icon\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\"A\n" +
+	"\x17SetInterestTagStatusReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\x03R\x06status\"2\n" +
+	"\x0fInterestTagResp\x12\x1f\n" +
+	"\x03tag\x18\x01 \x01(\v2\r.user.TagInfoR\x03tag\"P\n" +
+	"\x14MergeInterestTagsReq\x12\x1b\n" +
+	"\tsource_id\x18\x01 \x01(\x03R\bsourceId\x12\x1b\n" +
+	"\ttarget_id\x18\x02 \x01(\x03R\btargetId\"\x86\x01\n" +
+	"\x15MergeInterestTagsResp\x12%\n" +
+	"\x06source\x18\x01 \x01(\v2\r.user.TagInfoR\x06source\x12%\n" +
+	"\x06target\x18\x02 \x01(\v2\r.user.TagInfoR\x06target\x12\x1f\n" +
+	"\vmoved_users\x18\x03 \x01(\x03R\n" +
+	"movedUsers\"=\n" +
 	"\x10UpdateUserTagReq\x12\x10\n" +
 	"\x03ids\x18\x01 \x03(\x03R\x03ids\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\x03R\x06userId\"?\n" +
@@ -6694,12 +9616,13 @@ const file_user_proto_rawDesc = "" +
 	"\x10joined_page_size\x18\x03 \x01(\x05R\x0ejoinedPageSize\x12%\n" +
 	"\x0epublished_page\x18\x05 \x01(\x05R\rpublishedPage\x12.\n" +
 	"\x13published_page_size\x18\x06 \x01(\x05R\x11publishedPageSize\x12)\n" +
-	"\x10published_status\x18\a \x01(\x05R\x0fpublishedStatusJ\x04\b\x04\x10\x05\"\x81\x02\n" +
+	"\x10published_status\x18\a \x01(\x05R\x0fpublishedStatusJ\x04\b\x04\x10\x05\"\xcb\x02\n" +
 	"\x0fGetUserHomeResp\x12/\n" +
 	"\tuser_info\x18\x01 \x01(\v2\x12.user.UserHomeInfoR\buserInfo\x12%\n" +
 	"\x04tags\x18\x02 \x03(\v2\x11.user.UserHomeTagR\x04tags\x12G\n" +
 	"\x11joined_activities\x18\x03 \x01(\v2\x1a.user.UserHomeActivityListR\x10joinedActivities\x12M\n" +
-	"\x14published_activities\x18\x04 \x01(\v2\x1a.user.UserHomeActivityListR\x13publishedActivities\"\xb0\x01\n" +
+	"\x14published_activities\x18\x04 \x01(\v2\x1a.user.UserHomeActivityListR\x13publishedActivities\x12H\n" +
+	"\x10organizer_rating\x18\x05 \x01(\v2\x1d.user.UserHomeOrganizerRatingR\x0forganizerRating\"\xb0\x01\n" +
 	"\fUserHomeInfo\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1a\n" +
 	"\bnickname\x18\x02 \x01(\tR\bnickname\x12\x1d\n" +
@@ -6716,7 +9639,11 @@ const file_user_proto_rawDesc = "" +
 	"\btag_desc\x18\x05 \x01(\tR\atagDesc\"\\\n" +
 	"\x14UserHomeActivityList\x12\x14\n" +
 	"\x05total\x18\x01 \x01(\x05R\x05total\x12.\n" +
-	"\x04list\x18\x02 \x03(\v2\x1a.user.UserHomeActivityItemR\x04list\"\x83\x01\n" +
+	"\x04list\x18\x02 \x03(\v2\x1a.user.UserHomeActivityItemR\x04list\"[\n" +
+	"\x17UserHomeOrganizerRating\x12\x1d\n" +
+	"\n" +
+	"rating_avg\x18\x01 \x01(\x01R\tratingAvg\x12!\n" +
+	"\frating_count\x18\x02 \x01(\x03R\vratingCount\"\x83\x01\n" +
 	"\x14UserHomeActivityItem\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
@@ -6909,7 +9836,30 @@ const file_user_proto_rawDesc = "" +
 	"\tfile_size\x18\x03 \x01(\x03R\bfileSize\x12\x1f\n" +
 	"\vorigin_name\x18\x04 \x01(\tR\n" +
 	"originName\x12\x19\n" +
-	"\bbiz_type\x18\x05 \x01(\tR\abizType2\x86\x03\n" +
+	"\bbiz_type\x18\x05 \x01(\tR\abizType\"z\n" +
+	"\x0fStatsMetricItem\x12\x1b\n" +
+	"\tstat_date\x18\x01 \x01(\tR\bstatDate\x12\x16\n" +
+	"\x06metric\x18\x02 \x01(\tR\x06metric\x12\x1c\n" +
+	"\tdimension\x18\x03 \x01(\tR\tdimension\x12\x14\n" +
+	"\x05value\x18\x04 \x01(\x01R\x05value\"F\n" +
+	"\x13RecordUserActiveReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06source\x18\x02 \x01(\tR\x06source\"\x16\n" +
+	"\x14RecordUserActiveResp\"\x91\x01\n" +
+	"\x13ReportDailyStatsReq\x12\x16\n" +
+	"\x06source\x18\x01 \x01(\tR\x06source\x12\x1b\n" +
+	"\tstat_date\x18\x02 \x01(\tR\bstatDate\x12\x18\n" +
+	"\ametrics\x18\x03 \x03(\tR\ametrics\x12+\n" +
+	"\x05items\x18\x04 \x03(\v2\x15.user.StatsMetricItemR\x05items\",\n" +
+	"\x14ReportDailyStatsResp\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x05R\x05count\"i\n" +
+	"\x13GetPlatformStatsReq\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x02 \x01(\tR\aendDate\x12\x18\n" +
+	"\ametrics\x18\x03 \x03(\tR\ametrics\"A\n" +
+	"\x14GetPlatformStatsResp\x12)\n" +
+	"\x04list\x18\x01 \x03(\v2\x15.user.StatsMetricItemR\x04list2\x8c\x06\n" +
 	"\rCreditService\x12@\n" +
 	"\rGetCreditInfo\x12\x16.user.GetCreditInfoReq\x1a\x17.user.GetCreditInfoResp\x12@\n" +
 	"\rGetCreditLogs\x12\x16.user.GetCreditLogsReq\x1a\x17.user.GetCreditLogsResp\x12C\n" +
@@ -6918,17 +9868,28 @@ const file_user_proto_rawDesc = "" +
 	"CanPublish\x12\x13.user.CanPublishReq\x1a\x14.user.CanPublishResp\x127\n" +
 	"\n" +
 	"InitCredit\x12\x13.user.InitCreditReq\x1a\x14.user.InitCreditResp\x12:\n" +
-	"\vUpdateScore\x12\x14.user.UpdateScoreReq\x1a\x15.user.UpdateScoreResp2\xed\x04\n" +
+	"\vUpdateScore\x12\x14.user.UpdateScoreReq\x1a\x15.user.UpdateScoreResp\x12O\n" +
+	"\x12SubmitCreditAppeal\x12\x1b.user.SubmitCreditAppealReq\x1a\x1c.user.SubmitCreditAppealResp\x12L\n" +
+	"\x11ListCreditAppeals\x12\x1a.user.ListCreditAppealsReq\x1a\x1b.user.ListCreditAppealsResp\x12O\n" +
+	"\x12ReviewCreditAppeal\x12\x1b.user.ReviewCreditAppealReq\x1a\x1c.user.ReviewCreditAppealResp\x12I\n" +
+	"\x10AdminAdjustScore\x12\x19.user.AdminAdjustScoreReq\x1a\x1a.user.AdminAdjustScoreResp\x12I\n" +
+	"\x10ListCreditAudits\x12\x19.user.ListCreditAuditsReq\x1a\x1a.user.ListCreditAuditsResp2\xdc\b\n" +
 	"\rVerifyService\x12I\n" +
 	"\x10GetVerifyCurrent\x12\x19.user.GetVerifyCurrentReq\x1a\x1a.user.GetVerifyCurrentResp\x12@\n" +
 	"\rGetVerifyInfo\x12\x16.user.GetVerifyInfoReq\x1a\x17.user.GetVerifyInfoResp\x127\n" +
 	"\n" +
-	"IsVerified\x12\x13.user.IsVerifiedReq\x1a\x14.user.IsVerifiedResp\x12O\n" +
+	"IsVerified\x12\x13.user.IsVerifiedReq\x1a\x14.user.IsVerifiedResp\x12[\n" +
+	"\x16BatchGetVerifyProfiles\x12\x1f.user.BatchGetVerifyProfilesReq\x1a .user.BatchGetVerifyProfilesResp\x12O\n" +
 	"\x12ApplyStudentVerify\x12\x1b.user.ApplyStudentVerifyReq\x1a\x1c.user.ApplyStudentVerifyResp\x12U\n" +
 	"\x14ConfirmStudentVerify\x12\x1d.user.ConfirmStudentVerifyReq\x1a\x1e.user.ConfirmStudentVerifyResp\x12R\n" +
-	"\x13CancelStudentVerify\x12\x1c.user.CancelStudentVerifyReq\x1a\x1d.user.CancelStudentVerifyResp\x12O\n" +
+	"\x13CancelStudentVerify\x12\x1c.user.CancelStudentVerifyReq\x1a\x1d.user.CancelStudentVerifyResp\x12I\n" +
+	"\x0fReverifyStudent\x12\x18.user.ReverifyStudentReq\x1a\x1c.user.ApplyStudentVerifyResp\x12O\n" +
 	"\x12UpdateVerifyStatus\x12\x1b.user.UpdateVerifyStatusReq\x1a\x1c.user.UpdateVerifyStatusResp\x12I\n" +
-	"\x10ProcessOcrVerify\x12\x19.user.ProcessOcrVerifyReq\x1a\x1a.user.ProcessOcrVerifyResp2\xdb\x02\n" +
+	"\x10ProcessOcrVerify\x12\x19.user.ProcessOcrVerifyReq\x1a\x1a.user.ProcessOcrVerifyResp\x12L\n" +
+	"\x11ListVerifyReviews\x12\x1a.user.ListVerifyReviewsReq\x1a\x1b.user.ListVerifyReviewsResp\x12L\n" +
+	"\x11ClaimVerifyReview\x12\x1a.user.ClaimVerifyReviewReq\x1a\x1b.user.ClaimVerifyReviewResp\x12R\n" +
+	"\x13ReviewStudentVerify\x12\x1c.user.ReviewStudentVerifyReq\x1a\x1d.user.ReviewStudentVerifyResp\x12U\n" +
+	"\x14GetVerifyReviewStats\x12\x1d.user.GetVerifyReviewStatsReq\x1a\x1e.user.GetVerifyReviewStatsResp2\x87\x05\n" +
 	"\n" +
 	"TagService\x127\n" +
 	"\n" +
@@ -6936,7 +9897,11 @@ const file_user_proto_rawDesc = "" +
 	"\fGetTagsByIds\x12\x15.user.GetTagsByIdsReq\x1a\x16.user.GetTagsByIdsResp\x12>\n" +
 	"\vGetUserTags\x12\x14.user.GetUserTagsReq\x1a\x19.user.GetUserTagsResponse\x12D\n" +
 	"\rUpdateUserTag\x12\x16.user.UpdateUserTagReq\x1a\x1b.user.UpdateUserTagResponse\x12O\n" +
-	"\x12GetAllInterestTags\x12\x1b.user.GetAllInterestTagsReq\x1a\x1c.user.GetAllInterestTagsResp2\x9d\a\n" +
+	"\x12GetAllInterestTags\x12\x1b.user.GetAllInterestTagsReq\x1a\x1c.user.GetAllInterestTagsResp\x12F\n" +
+	"\x11CreateInterestTag\x12\x1a.user.CreateInterestTagReq\x1a\x15.user.InterestTagResp\x12F\n" +
+	"\x11UpdateInterestTag\x12\x1a.user.UpdateInterestTagReq\x1a\x15.user.InterestTagResp\x12L\n" +
+	"\x14SetInterestTagStatus\x12\x1d.user.SetInterestTagStatusReq\x1a\x15.user.InterestTagResp\x12L\n" +
+	"\x11MergeInterestTags\x12\x1a.user.MergeInterestTagsReq\x1a\x1b.user.MergeInterestTagsResp2\x9d\a\n" +
 	"\x10UserBasicService\x12A\n" +
 	"\fGetGroupUser\x12\x15.user.GetGroupUserReq\x1a\x1a.user.GetGroupUserResponse\x12,\n" +
 	"\x05Login\x12\x0e.user.LoginReq\x1a\x13.user.LoginResponse\x12/\n" +
@@ -6966,7 +9931,11 @@ const file_user_proto_rawDesc = "" +
 	"\fUploadAvatar\x12\x15.user.UploadAvatarReq\x1a\x16.user.UploadAvatarResp\x12^\n" +
 	"\x17UploadStudentCardImages\x12 .user.UploadStudentCardImagesReq\x1a!.user.UploadStudentCardImagesResp\x12R\n" +
 	"\x13UploadActivityCover\x12\x1c.user.UploadActivityCoverReq\x1a\x1d.user.UploadActivityCoverResp\x12C\n" +
-	"\x0eUploadSysImage\x12\x17.user.UploadSysImageReq\x1a\x18.user.UploadSysImageRespB\x06Z\x04./pbb\x06proto3"
+	"\x0eUploadSysImage\x12\x17.user.UploadSysImageReq\x1a\x18.user.UploadSysImageResp2\xf7\x01\n" +
+	"\x14PlatformStatsService\x12I\n" +
+	"\x10RecordUserActive\x12\x19.user.RecordUserActiveReq\x1a\x1a.user.RecordUserActiveResp\x12I\n" +
+	"\x10ReportDailyStats\x12\x19.user.ReportDailyStatsReq\x1a\x1a.user.ReportDailyStatsResp\x12I\n" +
+	"\x10GetPlatformStats\x12\x19.user.GetPlatformStatsReq\x1a\x1a.user.GetPlatformStatsRespB\x06Z\x04./pbb\x06proto3"
 
 var (
 	file_user_proto_rawDescOnce sync.Once
@@ -6980,7 +9949,7 @@ func file_user_proto_rawDescGZIP() []byte {
 	return file_user_proto_rawDescData
 }
 
-var file_user_proto_msgTypes = make([]protoimpl.MessageInfo, 99)
+var file_user_proto_msgTypes = make([]protoimpl.MessageInfo, 139)
 var file_user_proto_goTypes = []any{
 	(*GetCreditInfoReq)(nil),            // 0: user.GetCreditInfoReq
 	(*GetCreditInfoResp)(nil),           // 1: user.GetCreditInfoResp
@@ -6995,206 +9964,293 @@ var file_user_proto_goTypes = []any{
 	(*InitCreditResp)(nil),              // 10: user.InitCreditResp
 	(*UpdateScoreReq)(nil),              // 11: user.UpdateScoreReq
 	(*UpdateScoreResp)(nil),             // 12: user.UpdateScoreResp
-	(*GetVerifyCurrentReq)(nil),         // 13: user.GetVerifyCurrentReq
-	(*GetVerifyCurrentResp)(nil),        // 14: user.GetVerifyCurrentResp
-	(*GetVerifyInfoReq)(nil),            // 15: user.GetVerifyInfoReq
-	(*GetVerifyInfoResp)(nil),           // 16: user.GetVerifyInfoResp
-	(*IsVerifiedReq)(nil),               // 17: user.IsVerifiedReq
-	(*IsVerifiedResp)(nil),              // 18: user.IsVerifiedResp
-	(*ApplyStudentVerifyReq)(nil),       // 19: user.ApplyStudentVerifyReq
-	(*ApplyStudentVerifyResp)(nil),      // 20: user.ApplyStudentVerifyResp
-	(*ConfirmStudentVerifyReq)(nil),     // 21: user.ConfirmStudentVerifyReq
-	(*VerifyModifiedData)(nil),          // 22: user.VerifyModifiedData
-	(*ConfirmStudentVerifyResp)(nil),    // 23: user.ConfirmStudentVerifyResp
-	(*CancelStudentVerifyReq)(nil),      // 24: user.CancelStudentVerifyReq
-	(*CancelStudentVerifyResp)(nil),     // 25: user.CancelStudentVerifyResp
-	(*UpdateVerifyStatusReq)(nil),       // 26: user.UpdateVerifyStatusReq
-	(*VerifyOcrData)(nil),               // 27: user.VerifyOcrData
-	(*UpdateVerifyStatusResp)(nil),      // 28: user.UpdateVerifyStatusResp
-	(*ProcessOcrVerifyReq)(nil),         // 29: user.ProcessOcrVerifyReq
-	(*ProcessOcrVerifyResp)(nil),        // 30: user.ProcessOcrVerifyResp
-	(*UpdateUserTagReq)(nil),            // 31: user.UpdateUserTagReq
-	(*UpdateUserTagResponse)(nil),       // 32: user.UpdateUserTagResponse
-	(*TagBasicInfo)(nil),                // 33: user.TagBasicInfo
-	(*GetAllTagsReq)(nil),               // 34: user.GetAllTagsReq
-	(*GetAllTagsResp)(nil),              // 35: user.GetAllTagsResp
-	(*GetTagsByIdsReq)(nil),             // 36: user.GetTagsByIdsReq
-	(*GetTagsByIdsResp)(nil),            // 37: user.GetTagsByIdsResp
-	(*TagInfo)(nil),                     // 38: user.TagInfo
-	(*GetUserTagsReq)(nil),              // 39: user.GetUserTagsReq
-	(*GetUserTagsResponse)(nil),         // 40: user.GetUserTagsResponse
-	(*UserTag)(nil),                     // 41: user.UserTag
-	(*GetAllInterestTagsReq)(nil),       // 42: user.GetAllInterestTagsReq
-	(*GetAllInterestTagsResp)(nil),      // 43: user.GetAllInterestTagsResp
-	(*GetSysImageReq)(nil),              // 44: user.GetSysImageReq
-	(*GetSysImageResp)(nil),             // 45: user.GetSysImageResp
-	(*UpdateSysImageRefCountReq)(nil),   // 46: user.UpdateSysImageRefCountReq
-	(*UpdateSysImageRefCountResp)(nil),  // 47: user.UpdateSysImageRefCountResp
-	(*GetUserHomeReq)(nil),              // 48: user.GetUserHomeReq
-	(*GetUserHomeResp)(nil),             // 49: user.GetUserHomeResp
-	(*UserHomeInfo)(nil),                // 50: user.UserHomeInfo
-	(*UserHomeTag)(nil),                 // 51: user.UserHomeTag
-	(*UserHomeActivityList)(nil),        // 52: user.UserHomeActivityList
-	(*UserHomeActivityItem)(nil),        // 53: user.UserHomeActivityItem
-	(*CheckUserExistsReq)(nil),          // 54: user.CheckUserExistsReq
-	(*CheckUserExistsResponse)(nil),     // 55: user.CheckUserExistsResponse
-	(*ForgetPasswordReq)(nil),           // 56: user.ForgetPasswordReq
-	(*ForgetPasswordResponse)(nil),      // 57: user.ForgetPasswordResponse
-	(*DeleteUserReq)(nil),               // 58: user.DeleteUserReq
-	(*DeleteUserResponse)(nil),          // 59: user.DeleteUserResponse
-	(*UpdatePasswordReq)(nil),           // 60: user.UpdatePasswordReq
-	(*UpdatePasswordResponse)(nil),      // 61: user.UpdatePasswordResponse
-	(*UpdateUserInfoReq)(nil),           // 62: user.UpdateUserInfoReq
-	(*UpdateUserInfoResponse)(nil),      // 63: user.UpdateUserInfoResponse
-	(*GetGroupUserReq)(nil),             // 64: user.GetGroupUserReq
-	(*GetGroupUserResponse)(nil),        // 65: user.GetGroupUserResponse
-	(*GroupUserInfo)(nil),               // 66: user.GroupUserInfo
-	(*LoginReq)(nil),                    // 67: user.LoginReq
-	(*LoginResponse)(nil),               // 68: user.LoginResponse
-	(*LoginUserInfo)(nil),               // 69: user.LoginUserInfo
-	(*LogoutReq)(nil),                   // 70: user.LogoutReq
-	(*LogoutResponse)(nil),              // 71: user.LogoutResponse
-	(*RegisterReq)(nil),                 // 72: user.RegisterReq
-	(*RegisterResponse)(nil),            // 73: user.RegisterResponse
-	(*GetUserInfoReq)(nil),              // 74: user.GetUserInfoReq
-	(*GetUserInfoResponse)(nil),         // 75: user.GetUserInfoResponse
-	(*InterestTag)(nil),                 // 76: user.InterestTag
-	(*UserInfo)(nil),                    // 77: user.UserInfo
-	(*RefreshReq)(nil),                  // 78: user.RefreshReq
-	(*RefreshResponse)(nil),             // 79: user.RefreshResponse
-	(*TagUsageCountReq)(nil),            // 80: user.TagUsageCountReq
-	(*TagUsageCountResp)(nil),           // 81: user.TagUsageCountResp
-	(*GetCaptchaConfigReq)(nil),         // 82: user.GetCaptchaConfigReq
-	(*GetCaptchaConfigResponse)(nil),    // 83: user.GetCaptchaConfigResponse
-	(*CheckCaptchaReq)(nil),             // 84: user.CheckCaptchaReq
-	(*CheckCaptchaResponse)(nil),        // 85: user.CheckCaptchaResponse
-	(*CaptchaArgs)(nil),                 // 86: user.CaptchaArgs
-	(*SendQQEmailReq)(nil),              // 87: user.SendQQEmailReq
-	(*SendQQEmailResponse)(nil),         // 88: user.SendQQEmailResponse
-	(*CheckQQEmailReq)(nil),             // 89: user.CheckQQEmailReq
-	(*CheckQQEmailResponse)(nil),        // 90: user.CheckQQEmailResponse
-	(*UploadAvatarReq)(nil),             // 91: user.UploadAvatarReq
-	(*UploadAvatarResp)(nil),            // 92: user.UploadAvatarResp
-	(*UploadStudentCardImagesReq)(nil),  // 93: user.UploadStudentCardImagesReq
-	(*UploadStudentCardImagesResp)(nil), // 94: user.UploadStudentCardImagesResp
-	(*UploadActivityCoverReq)(nil),      // 95: user.UploadActivityCoverReq
-	(*UploadActivityCoverResp)(nil),     // 96: user.UploadActivityCoverResp
-	(*UploadSysImageReq)(nil),           // 97: user.UploadSysImageReq
-	(*UploadSysImageResp)(nil),          // 98: user.UploadSysImageResp
+	(*SubmitCreditAppealReq)(nil),       // 13: user.SubmitCreditAppealReq
+	(*SubmitCreditAppealResp)(nil),      // 14: user.SubmitCreditAppealResp
+	(*CreditAppealItem)(nil),            // 15: user.CreditAppealItem
+	(*ListCreditAppealsReq)(nil),        // 16: user.ListCreditAppealsReq
+	(*ListCreditAppealsResp)(nil),       // 17: user.ListCreditAppealsResp
+	(*ReviewCreditAppealReq)(nil),       // 18: user.ReviewCreditAppealReq
+	(*ReviewCreditAppealResp)(nil),      // 19: user.ReviewCreditAppealResp
+	(*AdminAdjustScoreReq)(nil),         // 20: user.AdminAdjustScoreReq
+	(*AdminAdjustScoreResp)(nil),        // 21: user.AdminAdjustScoreResp
+	(*CreditAuditItem)(nil),             // 22: user.CreditAuditItem
+	(*ListCreditAuditsReq)(nil),         // 23: user.ListCreditAuditsReq
+	(*ListCreditAuditsResp)(nil),        // 24: user.ListCreditAuditsResp
+	(*GetVerifyCurrentReq)(nil),         // 25: user.GetVerifyCurrentReq
+	(*GetVerifyCurrentResp)(nil),        // 26: user.GetVerifyCurrentResp
+	(*GetVerifyInfoReq)(nil),            // 27: user.GetVerifyInfoReq
+	(*GetVerifyInfoResp)(nil),           // 28: user.GetVerifyInfoResp
+	(*IsVerifiedReq)(nil),               // 29: user.IsVerifiedReq
+	(*IsVerifiedResp)(nil),              // 30: user.IsVerifiedResp
+	(*BatchGetVerifyProfilesReq)(nil),   // 31: user.BatchGetVerifyProfilesReq
+	(*VerifyProfile)(nil),               // 32: user.VerifyProfile
+	(*BatchGetVerifyProfilesResp)(nil),  // 33: user.BatchGetVerifyProfilesResp
+	(*ApplyStudentVerifyReq)(nil),       // 34: user.ApplyStudentVerifyReq
+	(*ApplyStudentVerifyResp)(nil),      // 35: user.ApplyStudentVerifyResp
+	(*ReverifyStudentReq)(nil),          // 36: user.ReverifyStudentReq
+	(*ConfirmStudentVerifyReq)(nil),     // 37: user.ConfirmStudentVerifyReq
+	(*VerifyModifiedData)(nil),          // 38: user.VerifyModifiedData
+	(*ConfirmStudentVerifyResp)(nil),    // 39: user.ConfirmStudentVerifyResp
+	(*CancelStudentVerifyReq)(nil),      // 40: user.CancelStudentVerifyReq
+	(*CancelStudentVerifyResp)(nil),     // 41: user.CancelStudentVerifyResp
+	(*UpdateVerifyStatusReq)(nil),       // 42: user.UpdateVerifyStatusReq
+	(*VerifyOcrData)(nil),               // 43: user.VerifyOcrData
+	(*UpdateVerifyStatusResp)(nil),      // 44: user.UpdateVerifyStatusResp
+	(*ProcessOcrVerifyReq)(nil),         // 45: user.ProcessOcrVerifyReq
+	(*ProcessOcrVerifyResp)(nil),        // 46: user.ProcessOcrVerifyResp
+	(*ListVerifyReviewsReq)(nil),        // 47: user.ListVerifyReviewsReq
+	(*VerifyReviewItem)(nil),            // 48: user.VerifyReviewItem
+	(*ListVerifyReviewsResp)(nil),       // 49: user.ListVerifyReviewsResp
+	(*ClaimVerifyReviewReq)(nil),        // 50: user.ClaimVerifyReviewReq
+	(*ClaimVerifyReviewResp)(nil),       // 51: user.ClaimVerifyReviewResp
+	(*ReviewStudentVerifyReq)(nil),      // 52: user.ReviewStudentVerifyReq
+	(*ReviewStudentVerifyResp)(nil),     // 53: user.ReviewStudentVerifyResp
+	(*GetVerifyReviewStatsReq)(nil),     // 54: user.GetVerifyReviewStatsReq
+	(*VerifyReviewerStat)(nil),          // 55: user.VerifyReviewerStat
+	(*GetVerifyReviewStatsResp)(nil),    // 56: user.GetVerifyReviewStatsResp
+	(*CreateInterestTagReq)(nil),        // 57: user.CreateInterestTagReq
+	(*UpdateInterestTagReq)(nil),        // 58: user.UpdateInterestTagReq
+	(*SetInterestTagStatusReq)(nil),     // 59: user.SetInterestTagStatusReq
+	(*InterestTagResp)(nil),             // 60: user.InterestTagResp
+	(*MergeInterestTagsReq)(nil),        // 61: user.MergeInterestTagsReq
+	(*MergeInterestTagsResp)(nil),       // 62: user.MergeInterestTagsResp
+	(*UpdateUserTagReq)(nil),            // 63: user.UpdateUserTagReq
+	(*UpdateUserTagResponse)(nil),       // 64: user.UpdateUserTagResponse
+	(*TagBasicInfo)(nil),                // 65: user.TagBasicInfo
+	(*GetAllTagsReq)(nil),               // 66: user.GetAllTagsReq
+	(*GetAllTagsResp)(nil),              // 67: user.GetAllTagsResp
+	(*GetTagsByIdsReq)(nil),             // 68: user.GetTagsByIdsReq
+	(*GetTagsByIdsResp)(nil),            // 69: user.GetTagsByIdsResp
+	(*TagInfo)(nil),                     // 70: user.TagInfo
+	(*GetUserTagsReq)(nil),              // 71: user.GetUserTagsReq
+	(*GetUserTagsResponse)(nil),         // 72: user.GetUserTagsResponse
+	(*UserTag)(nil),                     // 73: user.UserTag
+	(*GetAllInterestTagsReq)(nil),       // 74: user.GetAllInterestTagsReq
+	(*GetAllInterestTagsResp)(nil),      // 75: user.GetAllInterestTagsResp
+	(*GetSysImageReq)(nil),              // 76: user.GetSysImageReq
+	(*GetSysImageResp)(nil),             // 77: user.GetSysImageResp
+	(*UpdateSysImageRefCountReq)(nil),   // 78: user.UpdateSysImageRefCountReq
+	(*UpdateSysImageRefCountResp)(nil),  // 79: user.UpdateSysImageRefCountResp
+	(*GetUserHomeReq)(nil),              // 80: user.GetUserHomeReq
+	(*GetUserHomeResp)(nil),             // 81: user.GetUserHomeResp
+	(*UserHomeInfo)(nil),                // 82: user.UserHomeInfo
+	(*UserHomeTag)(nil),                 // 83: user.UserHomeTag
+	(*UserHomeActivityList)(nil),        // 84: user.UserHomeActivityList
+	(*UserHomeOrganizerRating)(nil),     // 85: user.UserHomeOrganizerRating
+	(*UserHomeActivityItem)(nil),        // 86: user.UserHomeActivityItem
+	(*CheckUserExistsReq)(nil),          // 87: user.CheckUserExistsReq
+	(*CheckUserExistsResponse)(nil),     // 88: user.CheckUserExistsResponse
+	(*ForgetPasswordReq)(nil),           // 89: user.ForgetPasswordReq
+	(*ForgetPasswordResponse)(nil),      // 90: user.ForgetPasswordResponse
+	(*DeleteUserReq)(nil),               // 91: user.DeleteUserReq
+	(*DeleteUserResponse)(nil),          // 92: user.DeleteUserResponse
+	(*UpdatePasswordReq)(nil),           // 93: user.UpdatePasswordReq
+	(*UpdatePasswordResponse)(nil),      // 94: user.UpdatePasswordResponse
+	(*UpdateUserInfoReq)(nil),           // 95: user.UpdateUserInfoReq
+	(*UpdateUserInfoResponse)(nil),      // 96: user.UpdateUserInfoResponse
+	(*GetGroupUserReq)(nil),             // 97: user.GetGroupUserReq
+	(*GetGroupUserResponse)(nil),        // 98: user.GetGroupUserResponse
+	(*GroupUserInfo)(nil),               // 99: user.GroupUserInfo
+	(*LoginReq)(nil),                    // 100: user.LoginReq
+	(*LoginResponse)(nil),               // 101: user.LoginResponse
+	(*LoginUserInfo)(nil),               // 102: user.LoginUserInfo
+	(*LogoutReq)(nil),                   // 103: user.LogoutReq
+	(*LogoutResponse)(nil),              // 104: user.LogoutResponse
+	(*RegisterReq)(nil),                 // 105: user.RegisterReq
+	(*RegisterResponse)(nil),            // 106: user.RegisterResponse
+	(*GetUserInfoReq)(nil),              // 107: user.GetUserInfoReq
+	(*GetUserInfoResponse)(nil),         // 108: user.GetUserInfoResponse
+	(*InterestTag)(nil),                 // 109: user.InterestTag
+	(*UserInfo)(nil),                    // 110: user.UserInfo
+	(*RefreshReq)(nil),                  // 111: user.RefreshReq
+	(*RefreshResponse)(nil),             // 112: user.RefreshResponse
+	(*TagUsageCountReq)(nil),            // 113: user.TagUsageCountReq
+	(*TagUsageCountResp)(nil),           // 114: user.TagUsageCountResp
+	(*GetCaptchaConfigReq)(nil),         // 115: user.GetCaptchaConfigReq
+	(*GetCaptchaConfigResponse)(nil),    // 116: user.GetCaptchaConfigResponse
+	(*CheckCaptchaReq)(nil),             // 117: user.CheckCaptchaReq
+	(*CheckCaptchaResponse)(nil),        // 118: user.CheckCaptchaResponse
+	(*CaptchaArgs)(nil),                 // 119: user.CaptchaArgs
+	(*SendQQEmailReq)(nil),              // 120: user.SendQQEmailReq
+	(*SendQQEmailResponse)(nil),         // 121: user.SendQQEmailResponse
+	(*CheckQQEmailReq)(nil),             // 122: user.CheckQQEmailReq
+	(*CheckQQEmailResponse)(nil),        // 123: user.CheckQQEmailResponse
+	(*UploadAvatarReq)(nil),             // 124: user.UploadAvatarReq
+	(*UploadAvatarResp)(nil),            // 125: user.UploadAvatarResp
+	(*UploadStudentCardImagesReq)(nil),  // 126: user.UploadStudentCardImagesReq
+	(*UploadStudentCardImagesResp)(nil), // 127: user.UploadStudentCardImagesResp
+	(*UploadActivityCoverReq)(nil),      // 128: user.UploadActivityCoverReq
+	(*UploadActivityCoverResp)(nil),     // 129: user.UploadActivityCoverResp
+	(*UploadSysImageReq)(nil),           // 130: user.UploadSysImageReq
+	(*UploadSysImageResp)(nil),          // 131: user.UploadSysImageResp
+	(*StatsMetricItem)(nil),             // 132: user.StatsMetricItem
+	(*RecordUserActiveReq)(nil),         // 133: user.RecordUserActiveReq
+	(*RecordUserActiveResp)(nil),        // 134: user.RecordUserActiveResp
+	(*ReportDailyStatsReq)(nil),         // 135: user.ReportDailyStatsReq
+	(*ReportDailyStatsResp)(nil),        // 136: user.ReportDailyStatsResp
+	(*GetPlatformStatsReq)(nil),         // 137: user.GetPlatformStatsReq
+	(*GetPlatformStatsResp)(nil),        // 138: user.GetPlatformStatsResp
 }
 var file_user_proto_depIdxs = []int32{
-	3,  // 0: user.GetCreditLogsResp.list:type_name -> user.CreditLogItem
-	27, // 1: user.GetVerifyCurrentResp.verify_data:type_name -> user.VerifyOcrData
-	22, // 2: user.ConfirmStudentVerifyReq.modified_data:type_name -> user.VerifyModifiedData
-	27, // 3: user.UpdateVerifyStatusReq.ocr_data:type_name -> user.VerifyOcrData
-	33, // 4: user.UpdateUserTagResponse.tags:type_name -> user.TagBasicInfo
-	38, // 5: user.GetAllTagsResp.tags:type_name -> user.TagInfo
-	38, // 6: user.GetTagsByIdsResp.tags:type_name -> user.TagInfo
-	41, // 7: user.GetUserTagsResponse.tags:type_name -> user.UserTag
-	76, // 8: user.GetAllInterestTagsResp.interest_tags:type_name -> user.InterestTag
-	50, // 9: user.GetUserHomeResp.user_info:type_name -> user.UserHomeInfo
-	51, // 10: user.GetUserHomeResp.tags:type_name -> user.UserHomeTag
-	52, // 11: user.GetUserHomeResp.joined_activities:type_name -> user.UserHomeActivityList
-	52, // 12: user.GetUserHomeResp.published_activities:type_name -> user.UserHomeActivityList
-	53, // 13: user.UserHomeActivityList.list:type_name -> user.UserHomeActivityItem
-	66, // 14: user.GetGroupUserResponse.users:type_name -> user.GroupUserInfo
-	69, // 15: user.LoginResponse.user_info:type_name -> user.LoginUserInfo
-	77, // 16: user.LoginUserInfo.user_info:type_name -> user.UserInfo
-	77, // 17: user.RegisterResponse.user_info:type_name -> user.UserInfo
-	77, // 18: user.GetUserInfoResponse.user_info:type_name -> user.UserInfo
-	76, // 19: user.UserInfo.interest_tags:type_name -> user.InterestTag
-	86, // 20: user.CheckCaptchaResponse.captcha_args:type_name -> user.CaptchaArgs
-	0,  // 21: user.CreditService.GetCreditInfo:input_type -> user.GetCreditInfoReq
-	2,  // 22: user.CreditService.GetCreditLogs:input_type -> user.GetCreditLogsReq
-	5,  // 23: user.CreditService.CanParticipate:input_type -> user.CanParticipateReq
-	7,  // 24: user.CreditService.CanPublish:input_type -> user.CanPublishReq
-	9,  // 25: user.CreditService.InitCredit:input_type -> user.InitCreditReq
-	11, // 26: user.CreditService.UpdateScore:input_type -> user.UpdateScoreReq
-	13, // 27: user.VerifyService.GetVerifyCurrent:input_type -> user.GetVerifyCurrentReq
-	15, // 28: user.VerifyService.GetVerifyInfo:input_type -> user.GetVerifyInfoReq
-	17, // 29: user.VerifyService.IsVerified:input_type -> user.IsVerifiedReq
-	19, // 30: user.VerifyService.ApplyStudentVerify:input_type -> user.ApplyStudentVerifyReq
-	21, // 31: user.VerifyService.ConfirmStudentVerify:input_type -> user.ConfirmStudentVerifyReq
-	24, // 32: user.VerifyService.CancelStudentVerify:input_type -> user.CancelStudentVerifyReq
-	26, // 33: user.VerifyService.UpdateVerifyStatus:input_type -> user.UpdateVerifyStatusReq
-	29, // 34: user.VerifyService.ProcessOcrVerify:input_type -> user.ProcessOcrVerifyReq
-	34, // 35: user.TagService.GetAllTags:input_type -> user.GetAllTagsReq
-	36, // 36: user.TagService.GetTagsByIds:input_type -> user.GetTagsByIdsReq
-	39, // 37: user.TagService.GetUserTags:input_type -> user.GetUserTagsReq
-	31, // 38: user.TagService.UpdateUserTag:input_type -> user.UpdateUserTagReq
-	42, // 39: user.TagService.GetAllInterestTags:input_type -> user.GetAllInterestTagsReq
-	64, // 40: user.UserBasicService.GetGroupUser:input_type -> user.GetGroupUserReq
-	67, // 41: user.UserBasicService.Login:input_type -> user.LoginReq
-	70, // 42: user.UserBasicService.Logout:input_type -> user.LogoutReq
-	72, // 43: user.UserBasicService.Register:input_type -> user.RegisterReq
-	78, // 44: user.UserBasicService.RefreshToken:input_type -> user.RefreshReq
-	74, // 45: user.UserBasicService.GetUserInfo:input_type -> user.GetUserInfoReq
-	60, // 46: user.UserBasicService.UpdatePassword:input_type -> user.UpdatePasswordReq
-	62, // 47: user.UserBasicService.UpdateUserInfo:input_type -> user.UpdateUserInfoReq
-	58, // 48: user.UserBasicService.DeleteUser:input_type -> user.DeleteUserReq
-	56, // 49: user.UserBasicService.ForgetPassword:input_type -> user.ForgetPasswordReq
-	54, // 50: user.UserBasicService.CheckUserExists:input_type -> user.CheckUserExistsReq
-	48, // 51: user.UserBasicService.GetUserHome:input_type -> user.GetUserHomeReq
-	44, // 52: user.UserBasicService.GetSysImage:input_type -> user.GetSysImageReq
-	46, // 53: user.UserBasicService.UpdateSysImageRefCount:input_type -> user.UpdateSysImageRefCountReq
-	80, // 54: user.TagBranchService.IncrTagUsageCount:input_type -> user.TagUsageCountReq
-	80, // 55: user.TagBranchService.DecrTagUsageCount:input_type -> user.TagUsageCountReq
-	82, // 56: user.CaptchaService.GetCaptchaConfig:input_type -> user.GetCaptchaConfigReq
-	84, // 57: user.CaptchaService.CheckCaptcha:input_type -> user.CheckCaptchaReq
-	87, // 58: user.QQEmail.SendQQEmail:input_type -> user.SendQQEmailReq
-	89, // 59: user.QQEmail.CheckQQEmail:input_type -> user.CheckQQEmailReq
-	91, // 60: user.UploadToQiNiu.UploadAvatar:input_type -> user.UploadAvatarReq
-	93, // 61: user.UploadToQiNiu.UploadStudentCardImages:input_type -> user.UploadStudentCardImagesReq
-	95, // 62: user.UploadToQiNiu.UploadActivityCover:input_type -> user.UploadActivityCoverReq
-	97, // 63: user.UploadToQiNiu.UploadSysImage:input_type -> user.UploadSysImageReq
-	1,  // 64: user.CreditService.GetCreditInfo:output_type -> user.GetCreditInfoResp
-	4,  // 65: user.CreditService.GetCreditLogs:output_type -> user.GetCreditLogsResp
-	6,  // 66: user.CreditService.CanParticipate:output_type -> user.CanParticipateResp
-	8,  // 67: user.CreditService.CanPublish:output_type -> user.CanPublishResp
-	10, // 68: user.CreditService.InitCredit:output_type -> user.InitCreditResp
-	12, // 69: user.CreditService.UpdateScore:output_type -> user.UpdateScoreResp
-	14, // 70: user.VerifyService.GetVerifyCurrent:output_type -> user.GetVerifyCurrentResp
-	16, // 71: user.VerifyService.GetVerifyInfo:output_type -> user.GetVerifyInfoResp
-	18, // 72: user.VerifyService.IsVerified:output_type -> user.IsVerifiedResp
-	20, // 73: user.VerifyService.ApplyStudentVerify:output_type -> user.ApplyStudentVerifyResp
-	23, // 74: user.VerifyService.ConfirmStudentVerify:output_type -> user.ConfirmStudentVerifyResp
-	25, // 75: user.VerifyService.CancelStudentVerify:output_type -> user.CancelStudentVerifyResp
-	28, // 76: user.VerifyService.UpdateVerifyStatus:output_type -> user.UpdateVerifyStatusResp
-	30, // 77: user.VerifyService.ProcessOcrVerify:output_type -> user.ProcessOcrVerifyResp
-	35, // 78: user.TagService.GetAllTags:output_type -> user.GetAllTagsResp
-	37, // 79: user.TagService.GetTagsByIds:output_type -> user.GetTagsByIdsResp
-	40, // 80: user.TagService.GetUserTags:output_type -> user.GetUserTagsResponse
-	32, // 81: user.TagService.UpdateUserTag:output_type -> user.UpdateUserTagResponse
-	43, // 82: user.TagService.GetAllInterestTags:output_type -> user.GetAllInterestTagsResp
-	65, // 83: user.UserBasicService.GetGroupUser:output_type -> user.GetGroupUserResponse
-	68, // 84: user.UserBasicService.Login:output_type -> user.LoginResponse
-	71, // 85: user.UserBasicService.Logout:output_type -> user.LogoutResponse
-	73, // 86: user.UserBasicService.Register:output_type -> user.RegisterResponse
-	79, // 87: user.UserBasicService.RefreshToken:output_type -> user.RefreshResponse
-	75, // 88: user.UserBasicService.GetUserInfo:output_type -> user.GetUserInfoResponse
-	61, // 89: user.UserBasicService.UpdatePassword:output_type -> user.UpdatePasswordResponse
-	63, // 90: user.UserBasicService.UpdateUserInfo:output_type -> user.UpdateUserInfoResponse
-	59, // 91: user.UserBasicService.DeleteUser:output_type -> user.DeleteUserResponse
-	57, // 92: user.UserBasicService.ForgetPassword:output_type -> user.ForgetPasswordResponse
-	55, // 93: user.UserBasicService.CheckUserExists:output_type -> user.CheckUserExistsResponse
-	49, // 94: user.UserBasicService.GetUserHome:output_type -> user.GetUserHomeResp
-	45, // 95: user.UserBasicService.GetSysImage:output_type -> user.GetSysImageResp
-	47, // 96: user.UserBasicService.UpdateSysImageRefCount:output_type -> user.UpdateSysImageRefCountResp
-	81, // 97: user.TagBranchService.IncrTagUsageCount:output_type -> user.TagUsageCountResp
-	81, // 98: user.TagBranchService.DecrTagUsageCount:output_type -> user.TagUsageCountResp
-	83, // 99: user.CaptchaService.GetCaptchaConfig:output_type -> user.GetCaptchaConfigResponse
-	85, // 100: user.CaptchaService.CheckCaptcha:output_type -> user.CheckCaptchaResponse
-	88, // 101: user.QQEmail.SendQQEmail:output_type -> user.SendQQEmailResponse
-	90, // 102: user.QQEmail.CheckQQEmail:output_type -> user.CheckQQEmailResponse
-	92, // 103: user.UploadToQiNiu.UploadAvatar:output_type -> user.UploadAvatarResp
-	94, // 104: user.UploadToQiNiu.UploadStudentCardImages:output_type -> user.UploadStudentCardImagesResp
-	96, // 105: user.UploadToQiNiu.UploadActivityCover:output_type -> user.UploadActivityCoverResp
-	98, // 106: user.UploadToQiNiu.UploadSysImage:output_type -> user.UploadSysImageResp
-	64, // [64:107] is the sub-list for method output_type
-	21, // [21:64] is the sub-list for method input_type
-	21, // [21:21] is the sub-list for extension type_name
-	21, // [21:21] is the sub-list for extension extendee
-	0,  // [0:21] is the sub-list for field type_name
+	3,   // 0: user.GetCreditLogsResp.list:type_name -> user.CreditLogItem
+	15,  // 1: user.ListCreditAppealsResp.list:type_name -> user.CreditAppealItem
+	22,  // 2: user.ListCreditAuditsResp.list:type_name -> user.CreditAuditItem
+	43,  // 3: user.GetVerifyCurrentResp.verify_data:type_name -> user.VerifyOcrData
+	32,  // 4: user.BatchGetVerifyProfilesResp.list:type_name -> user.VerifyProfile
+	38,  // 5: user.ConfirmStudentVerifyReq.modified_data:type_name -> user.VerifyModifiedData
+	43,  // 6: user.UpdateVerifyStatusReq.ocr_data:type_name -> user.VerifyOcrData
+	48,  // 7: user.ListVerifyReviewsResp.list:type_name -> user.VerifyReviewItem
+	55,  // 8: user.GetVerifyReviewStatsResp.list:type_name -> user.VerifyReviewerStat
+	70,  // 9: user.InterestTagResp.tag:type_name -> user.TagInfo
+	70,  // 10: user.MergeInterestTagsResp.source:type_name -> user.TagInfo
+	70,  // 11: user.MergeInterestTagsResp.target:type_name -> user.TagInfo
+	65,  // 12: user.UpdateUserTagResponse.tags:type_name -> user.TagBasicInfo
+	70,  // 13: user.GetAllTagsResp.tags:type_name -> user.TagInfo
+	70,  // 14: user.GetTagsByIdsResp.tags:type_name -> user.TagInfo
+	73,  // 15: user.GetUserTagsResponse.tags:type_name -> user.UserTag
+	109, // 16: user.GetAllInterestTagsResp.interest_tags:type_name -> user.InterestTag
+	82,  // 17: user.GetUserHomeResp.user_info:type_name -> user.UserHomeInfo
+	83,  // 18: user.GetUserHomeResp.tags:type_name -> user.UserHomeTag
+	84,  // 19: user.GetUserHomeResp.joined_activities:type_name -> user.UserHomeActivityList
+	84,  // 20: user.GetUserHomeResp.published_activities:type_name -> user.UserHomeActivityList
+	85,  // 21: user.GetUserHomeResp.organizer_rating:type_name -> user.UserHomeOrganizerRating
+	86,  // 22: user.UserHomeActivityList.list:type_name -> user.UserHomeActivityItem
+	99,  // 23: user.GetGroupUserResponse.users:type_name -> user.GroupUserInfo
+	102, // 24: user.LoginResponse.user_info:type_name -> user.LoginUserInfo
+	110, // 25: user.LoginUserInfo.user_info:type_name -> user.UserInfo
+	110, // 26: user.RegisterResponse.user_info:type_name -> user.UserInfo
+	110, // 27: user.GetUserInfoResponse.user_info:type_name -> user.UserInfo
+	109, // 28: user.UserInfo.interest_tags:type_name -> user.InterestTag
+	119, // 29: user.CheckCaptchaResponse.captcha_args:type_name -> user.CaptchaArgs
+	132, // 30: user.ReportDailyStatsReq.items:type_name -> user.StatsMetricItem
+	132, // 31: user.GetPlatformStatsResp.list:type_name -> user.StatsMetricItem
+	0,   // 32: user.CreditService.GetCreditInfo:input_type -> user.GetCreditInfoReq
+	2,   // 33: user.CreditService.GetCreditLogs:input_type -> user.GetCreditLogsReq
+	5,   // 34: user.CreditService.CanParticipate:input_type -> user.CanParticipateReq
+	7,   // 35: user.CreditService.CanPublish:input_type -> user.CanPublishReq
+	9,   // 36: user.CreditService.InitCredit:input_type -> user.InitCreditReq
+	11,  // 37: user.CreditService.UpdateScore:input_type -> user.UpdateScoreReq
+	13,  // 38: user.CreditService.SubmitCreditAppeal:input_type -> user.SubmitCreditAppealReq
+	16,  // 39: user.CreditService.ListCreditAppeals:input_type -> user.ListCreditAppealsReq
+	18,  // 40: user.CreditService.ReviewCreditAppeal:input_type -> user.ReviewCreditAppealReq
+	20,  // 41: user.CreditService.AdminAdjustScore:input_type -> user.AdminAdjustScoreReq
+	23,  // 42: user.CreditService.ListCreditAudits:input_type -> user.ListCreditAuditsReq
+	25,  // 43: user.VerifyService.GetVerifyCurrent:input_type -> user.GetVerifyCurrentReq
+	27,  // 44: user.VerifyService.GetVerifyInfo:input_type -> user.GetVerifyInfoReq
+	29,  // 45: user.VerifyService.IsVerified:input_type -> user.IsVerifiedReq
+	31,  // 46: user.VerifyService.BatchGetVerifyProfiles:input_type -> user.BatchGetVerifyProfilesReq
+	34,  // 47: user.VerifyService.ApplyStudentVerify:input_type -> user.ApplyStudentVerifyReq
+	37,  // 48: user.VerifyService.ConfirmStudentVerify:input_type -> user.ConfirmStudentVerifyReq
+	40,  // 49: user.VerifyService.CancelStudentVerify:input_type -> user.CancelStudentVerifyReq
+	36,  // 50: user.VerifyService.ReverifyStudent:input_type -> user.ReverifyStudentReq
+	42,  // 51: user.VerifyService.UpdateVerifyStatus:input_type -> user.UpdateVerifyStatusReq
+	45,  // 52: user.VerifyService.ProcessOcrVerify:input_type -> user.ProcessOcrVerifyReq
+	47,  // 53: user.VerifyService.ListVerifyReviews:input_type -> user.ListVerifyReviewsReq
+	50,  // 54: user.VerifyService.ClaimVerifyReview:input_type -> user.ClaimVerifyReviewReq
+	52,  // 55: user.VerifyService.ReviewStudentVerify:input_type -> user.ReviewStudentVerifyReq
+	54,  // 56: user.VerifyService.GetVerifyReviewStats:input_type -> user.GetVerifyReviewStatsReq
+	66,  // 57: user.TagService.GetAllTags:input_type -> user.GetAllTagsReq
+	68,  // 58: user.TagService.GetTagsByIds:input_type -> user.GetTagsByIdsReq
+	71,  // 59: user.TagService.GetUserTags:input_type -> user.GetUserTagsReq
+	63,  // 60: user.TagService.UpdateUserTag:input_type -> user.UpdateUserTagReq
+	74,  // 61: user.TagService.GetAllInterestTags:input_type -> user.GetAllInterestTagsReq
+	57,  // 62: user.TagService.CreateInterestTag:input_type -> user.CreateInterestTagReq
+	58,  // 63: user.TagService.UpdateInterestTag:input_type -> user.UpdateInterestTagReq
+	59,  // 64: user.TagService.SetInterestTagStatus:input_type -> user.SetInterestTagStatusReq
+	61,  // 65: user.TagService.MergeInterestTags:input_type -> user.MergeInterestTagsReq
+	97,  // 66: user.UserBasicService.GetGroupUser:input_type -> user.GetGroupUserReq
+	100, // 67: user.UserBasicService.Login:input_type -> user.LoginReq
+	103, // 68: user.UserBasicService.Logout:input_type -> user.LogoutReq
+	105, // 69: user.UserBasicService.Register:input_type -> user.RegisterReq
+	111, // 70: user.UserBasicService.RefreshToken:input_type -> user.RefreshReq
+	107, // 71: user.UserBasicService.GetUserInfo:input_type -> user.GetUserInfoReq
+	93,  // 72: user.UserBasicService.UpdatePassword:input_type -> user.UpdatePasswordReq
+	95,  // 73: user.UserBasicService.UpdateUserInfo:input_type -> user.UpdateUserInfoReq
+	91,  // 74: user.UserBasicService.DeleteUser:input_type -> user.DeleteUserReq
+	89,  // 75: user.UserBasicService.ForgetPassword:input_type -> user.ForgetPasswordReq
+	87,  // 76: user.UserBasicService.CheckUserExists:input_type -> user.CheckUserExistsReq
+	80,  // 77: user.UserBasicService.GetUserHome:input_type -> user.GetUserHomeReq
+	76,  // 78: user.UserBasicService.GetSysImage:input_type -> user.GetSysImageReq
+	78,  // 79: user.UserBasicService.UpdateSysImageRefCount:input_type -> user.UpdateSysImageRefCountReq
+	113, // 80: user.TagBranchService.IncrTagUsageCount:input_type -> user.TagUsageCountReq
+	113, // 81: user.TagBranchService.DecrTagUsageCount:input_type -> user.TagUsageCountReq
+	115, // 82: user.CaptchaService.GetCaptchaConfig:input_type -> user.GetCaptchaConfigReq
+	117, // 83: user.CaptchaService.CheckCaptcha:input_type -> user.CheckCaptchaReq
+	120, // 84: user.QQEmail.SendQQEmail:input_type -> user.SendQQEmailReq
+	122, // 85: user.QQEmail.CheckQQEmail:input_type -> user.CheckQQEmailReq
+	124, // 86: user.UploadToQiNiu.UploadAvatar:input_type -> user.UploadAvatarReq
+	126, // 87: user.UploadToQiNiu.UploadStudentCardImages:input_type -> user.UploadStudentCardImagesReq
+	128, // 88: user.UploadToQiNiu.UploadActivityCover:input_type -> user.UploadActivityCoverReq
+	130, // 89: user.UploadToQiNiu.UploadSysImage:input_type -> user.UploadSysImageReq
+	133, // 90: user.PlatformStatsService.RecordUserActive:input_type -> user.RecordUserActiveReq
+	135, // 91: user.PlatformStatsService.ReportDailyStats:input_type -> user.ReportDailyStatsReq
+	137, // 92: user.PlatformStatsService.GetPlatformStats:input_type -> user.GetPlatformStatsReq
+	1,   // 93: user.CreditService.GetCreditInfo:output_type -> user.GetCreditInfoResp
+	4,   // 94: user.CreditService.GetCreditLogs:output_type -> user.GetCreditLogsResp
+	6,   // 95: user.CreditService.CanParticipate:output_type -> user.CanParticipateResp
+	8,   // 96: user.CreditService.CanPublish:output_type -> user.CanPublishResp
+	10,  // 97: user.CreditService.InitCredit:output_type -> user.InitCreditResp
+	12,  // 98: user.CreditService.UpdateScore:output_type -> user.UpdateScoreResp
+	14,  // 99: user.CreditService.SubmitCreditAppeal:output_type -> user.SubmitCreditAppealResp
+	17,  // 100: user.CreditService.ListCreditAppeals:output_type -> user.ListCreditAppealsResp
+	19,  // 101: user.CreditService.ReviewCreditAppeal:output_type -> user.ReviewCreditAppealResp
+	21,  // 102: user.CreditService.AdminAdjustScore:output_type -> user.AdminAdjustScoreResp
+	24,  // 103: user.CreditService.ListCreditAudits:output_type -> user.ListCreditAuditsResp
+	26,  // 104: user.VerifyService.GetVerifyCurrent:output_type -> user.GetVerifyCurrentResp
+	28,  // 105: user.VerifyService.GetVerifyInfo:output_type -> user.GetVerifyInfoResp
+	30,  // 106: user.VerifyService.IsVerified:output_type -> user.IsVerifiedResp
+	33,  // 107: user.VerifyService.BatchGetVerifyProfiles:output_type -> user.BatchGetVerifyProfilesResp
+	35,  // 108: user.VerifyService.ApplyStudentVerify:output_type -> user.ApplyStudentVerifyResp
+	39,  // 109: user.VerifyService.ConfirmStudentVerify:output_type -> user.ConfirmStudentVerifyResp
+	41,  // 110: user.VerifyService.CancelStudentVerify:output_type -> user.CancelStudentVerifyResp
+	35,  // 111: user.VerifyService.ReverifyStudent:output_type -> user.ApplyStudentVerifyResp
+	44,  // 112: user.VerifyService.UpdateVerifyStatus:output_type -> user.UpdateVerifyStatusResp
+	46,  // 113: user.VerifyService.ProcessOcrVerify:output_type -> user.ProcessOcrVerifyResp
+	49,  // 114: user.VerifyService.ListVerifyReviews:output_type -> user.ListVerifyReviewsResp
+	51,  // 115: user.VerifyService.ClaimVerifyReview:output_type -> user.ClaimVerifyReviewResp
+	53,  // 116: user.VerifyService.ReviewStudentVerify:output_type -> user.ReviewStudentVerifyResp
+	56,  // 117: user.VerifyService.GetVerifyReviewStats:output_type -> user.GetVerifyReviewStatsResp
+	67,  // 118: user.TagService.GetAllTags:output_type -> user.GetAllTagsResp
+	69,  // 119: user.TagService.GetTagsByIds:output_type -> user.GetTagsByIdsResp
+	72,  // 120: user.TagService.GetUserTags:output_type -> user.GetUserTagsResponse
+	64,  // 121: user.TagService.UpdateUserTag:output_type -> user.UpdateUserTagResponse
+	75,  // 122: user.TagService.GetAllInterestTags:output_type -> user.GetAllInterestTagsResp
+	60,  // 123: user.TagService.CreateInterestTag:output_type -> user.InterestTagResp
+	60,  // 124: user.TagService.UpdateInterestTag:output_type -> user.InterestTagResp
+	60,  // 125: user.TagService.SetInterestTagStatus:output_type -> user.InterestTagResp
+	62,  // 126: user.TagService.MergeInterestTags:output_type -> user.MergeInterestTagsResp
+	98,  // 127: user.UserBasicService.GetGroupUser:output_type -> user.GetGroupUserResponse
+	101, // 128: user.UserBasicService.Login:output_type -> user.LoginResponse
+	104, // 129: user.UserBasicService.Logout:output_type -> user.LogoutResponse
+	106, // 130: user.UserBasicService.Register:output_type -> user.RegisterResponse
+	112, // 131: user.UserBasicService.RefreshToken:output_type -> user.RefreshResponse
+	108, // 132: user.UserBasicService.GetUserInfo:output_type -> user.GetUserInfoResponse
+	94,  // 133: user.UserBasicService.UpdatePassword:output_type -> user.UpdatePasswordResponse
+	96,  // 134: user.UserBasicService.UpdateUserInfo:output_type -> user.UpdateUserInfoResponse
+	92,  // 135: user.UserBasicService.DeleteUser:output_type -> user.DeleteUserResponse
+	90,  // 136: user.UserBasicService.ForgetPassword:output_type -> user.ForgetPasswordResponse
+	88,  // 137: user.UserBasicService.CheckUserExists:output_type -> user.CheckUserExistsResponse
+	81,  // 138: user.UserBasicService.GetUserHome:output_type -> user.GetUserHomeResp
+	77,  // 139: user.UserBasicService.GetSysImage:output_type -> user.GetSysImageResp
+	79,  // 140: user.UserBasicService.UpdateSysImageRefCount:output_type -> user.UpdateSysImageRefCountResp
+	114, // 141: user.TagBranchService.IncrTagUsageCount:output_type -> user.TagUsageCountResp
+	114, // 142: user.TagBranchService.DecrTagUsageCount:output_type -> user.TagUsageCountResp
+	116, // 143: user.CaptchaService.GetCaptchaConfig:output_type -> user.GetCaptchaConfigResponse
+	118, // 144: user.CaptchaService.CheckCaptcha:output_type -> user.CheckCaptchaResponse
+	121, // 145: user.QQEmail.SendQQEmail:output_type -> user.SendQQEmailResponse
+	123, // 146: user.QQEmail.CheckQQEmail:output_type -> user.CheckQQEmailResponse
+	125, // 147: user.UploadToQiNiu.UploadAvatar:output_type -> user.UploadAvatarResp
+	127, // 148: user.UploadToQiNiu.UploadStudentCardImages:output_type -> user.UploadStudentCardImagesResp
+	129, // 149: user.UploadToQiNiu.UploadActivityCover:output_type -> user.UploadActivityCoverResp
+	131, // 150: user.UploadToQiNiu.UploadSysImage:output_type -> user.UploadSysImageResp
+	134, // 151: user.PlatformStatsService.RecordUserActive:output_type -> user.RecordUserActiveResp
+	136, // 152: user.PlatformStatsService.ReportDailyStats:output_type -> user.ReportDailyStatsResp
+	138, // 153: user.PlatformStatsService.GetPlatformStats:output_type -> user.GetPlatformStatsResp
+	93,  // [93:154] is the sub-list for method output_type
+	32,  // [32:93] is the sub-list for method input_type
+	32,  // [32:32] is the sub-list for extension type_name
+	32,  // [32:32] is the sub-list for extension extendee
+	0,   // [0:32] is the sub-list for field type_name
 }
 
 func init() { file_user_proto_init() }
@@ -7208,9 +10264,9 @@ func file_user_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_proto_rawDesc), len(file_user_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   99,
+			NumMessages:   139,
 			NumExtensions: 0,
-			NumServices:   8,
+			NumServices:   9,
 		},
 		GoTypes:           file_user_proto_goTypes,
 		DependencyIndexes: file_user_proto_depIdxs,
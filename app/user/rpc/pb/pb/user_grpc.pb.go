@@ -47,12 +47,17 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	CreditService_GetCreditInfo_FullMethodName  = "/user.CreditService/GetCreditInfo"
-	CreditService_GetCreditLogs_FullMethodName  = "/user.CreditService/GetCreditLogs"
-	CreditService_CanParticipate_FullMethodName = "/user.CreditService/CanParticipate"
-	CreditService_CanPublish_FullMethodName     = "/user.CreditService/CanPublish"
-	CreditService_InitCredit_FullMethodName     = "/user.CreditService/InitCredit"
-	CreditService_UpdateScore_FullMethodName    = "/user.CreditService/UpdateScore"
+	CreditService_GetCreditInfo_FullMethodName      = "/user.CreditService/GetCreditInfo"
+	CreditService_GetCreditLogs_FullMethodName      = "/user.CreditService/GetCreditLogs"
+	CreditService_CanParticipate_FullMethodName     = "/user.CreditService/CanParticipate"
+	CreditService_CanPublish_FullMethodName         = "/user.CreditService/CanPublish"
+	CreditService_InitCredit_FullMethodName         = "/user.CreditService/InitCredit"
+	CreditService_UpdateScore_FullMethodName        = "/user.CreditService/UpdateScore"
+	CreditService_SubmitCreditAppeal_FullMethodName = "/user.CreditService/SubmitCreditAppeal"
+	CreditService_ListCreditAppeals_FullMethodName  = "/user.CreditService/ListCreditAppeals"
+	CreditService_ReviewCreditAppeal_FullMethodName = "/user.CreditService/ReviewCreditAppeal"
+	CreditService_AdminAdjustScore_FullMethodName   = "/user.CreditService/AdminAdjustScore"
+	CreditService_ListCreditAudits_FullMethodName   = "/user.CreditService/ListCreditAudits"
 )
 
 // CreditServiceClient is the client API for CreditService service.
@@ -89,6 +94,24 @@ type CreditServiceClient interface {
 	// 业务逻辑: 签到、爽约、活动结束等事件触发信用分变更
 	// 幂等保证: 通过 source_id 实现幂等
 	UpdateScore(ctx context.Context, in *UpdateScoreReq, opts ...grpc.CallOption) (*UpdateScoreResp, error)
+	// SubmitCreditAppeal 提交信用申诉
+	// 调用方: User API（信用分明细页面）
+	// 业务逻辑: 用户对 30 天内的某条扣分记录提出申诉，每条记录仅能申诉一次
+	SubmitCreditAppeal(ctx context.Context, in *SubmitCreditAppealReq, opts ...grpc.CallOption) (*SubmitCreditAppealResp, error)
+	// ListCreditAppeals 查询申诉列表
+	// 调用方: User API（用户查看自己的申诉 / 管理员查看待处理申诉）
+	ListCreditAppeals(ctx context.Context, in *ListCreditAppealsReq, opts ...grpc.CallOption) (*ListCreditAppealsResp, error)
+	// ReviewCreditAppeal 处理信用申诉
+	// 调用方: User API（管理员）
+	// 业务逻辑: 通过时撤销被申诉记录的分数变动（幂等），并写入审计日志
+	ReviewCreditAppeal(ctx context.Context, in *ReviewCreditAppealReq, opts ...grpc.CallOption) (*ReviewCreditAppealResp, error)
+	// AdminAdjustScore 管理员手动调整信用分
+	// 调用方: User API（管理员）
+	// 业务逻辑: 以 change_type=99 变更信用分，并写入审计日志
+	AdminAdjustScore(ctx context.Context, in *AdminAdjustScoreReq, opts ...grpc.CallOption) (*AdminAdjustScoreResp, error)
+	// ListCreditAudits 查询信用管理审计日志
+	// 调用方: User API（管理员）
+	ListCreditAudits(ctx context.Context, in *ListCreditAuditsReq, opts ...grpc.CallOption) (*ListCreditAuditsResp, error)
 }
 
 type creditServiceClient struct {
@@ -159,6 +182,56 @@ func (c *creditServiceClient) UpdateScore(ctx context.Context, in *UpdateScoreRe
 	return out, nil
 }
 
+func (c *creditServiceClient) SubmitCreditAppeal(ctx context.Context, in *SubmitCreditAppealReq, opts ...grpc.CallOption) (*SubmitCreditAppealResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitCreditAppealResp)
+	err := c.cc.Invoke(ctx, CreditService_SubmitCreditAppeal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *creditServiceClient) ListCreditAppeals(ctx context.Context, in *ListCreditAppealsReq, opts ...grpc.CallOption) (*ListCreditAppealsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCreditAppealsResp)
+	err := c.cc.Invoke(ctx, CreditService_ListCreditAppeals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *creditServiceClient) ReviewCreditAppeal(ctx context.Context, in *ReviewCreditAppealReq, opts ...grpc.CallOption) (*ReviewCreditAppealResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewCreditAppealResp)
+	err := c.cc.Invoke(ctx, CreditService_ReviewCreditAppeal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *creditServiceClient) AdminAdjustScore(ctx context.Context, in *AdminAdjustScoreReq, opts ...grpc.CallOption) (*AdminAdjustScoreResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminAdjustScoreResp)
+	err := c.cc.Invoke(ctx, CreditService_AdminAdjustScore_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *creditServiceClient) ListCreditAudits(ctx context.Context, in *ListCreditAuditsReq, opts ...grpc.CallOption) (*ListCreditAuditsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCreditAuditsResp)
+	err := c.cc.Invoke(ctx, CreditService_ListCreditAudits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CreditServiceServer is the server API for CreditService service.
 // All implementations must embed UnimplementedCreditServiceServer
 // for forward compatibility.
@@ -193,6 +266,24 @@ type CreditServiceServer interface {
 	// 业务逻辑: 签到、爽约、活动结束等事件触发信用分变更
 	// 幂等保证: 通过 source_id 实现幂等
 	UpdateScore(context.Context, *UpdateScoreReq) (*UpdateScoreResp, error)
+	// SubmitCreditAppeal 提交信用申诉
+	// 调用方: User API（信用分明细页面）
+	// 业务逻辑: 用户对 30 天内的某条扣分记录提出申诉，每条记录仅能申诉一次
+	SubmitCreditAppeal(context.Context, *SubmitCreditAppealReq) (*SubmitCreditAppealResp, error)
+	// ListCreditAppeals 查询申诉列表
+	// 调用方: User API（用户查看自己的申诉 / 管理员查看待处理申诉）
+	ListCreditAppeals(context.Context, *ListCreditAppealsReq) (*ListCreditAppealsResp, error)
+	// ReviewCreditAppeal 处理信用申诉
+	// 调用方: User API（管理员）
+	// 业务逻辑: 通过时撤销被申诉记录的分数变动（幂等），并写入审计日志
+	ReviewCreditAppeal(context.Context, *ReviewCreditAppealReq) (*ReviewCreditAppealResp, error)
+	// AdminAdjustScore 管理员手动调整信用分
+	// 调用方: User API（管理员）
+	// 业务逻辑: 以 change_type=99 变更信用分，并写入审计日志
+	AdminAdjustScore(context.Context, *AdminAdjustScoreReq) (*AdminAdjustScoreResp, error)
+	// ListCreditAudits 查询信用管理审计日志
+	// 调用方: User API（管理员）
+	ListCreditAudits(context.Context, *ListCreditAuditsReq) (*ListCreditAuditsResp, error)
 	mustEmbedUnimplementedCreditServiceServer()
 }
 
@@ -221,6 +312,21 @@ func (UnimplementedCreditServiceServer) InitCredit(context.Context, *InitCreditR
 func (UnimplementedCreditServiceServer) UpdateScore(context.Context, *UpdateScoreReq) (*UpdateScoreResp, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateScore not implemented")
 }
+func (UnimplementedCreditServiceServer) SubmitCreditAppeal(context.Context, *SubmitCreditAppealReq) (*SubmitCreditAppealResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method SubmitCreditAppeal not implemented")
+}
+func (UnimplementedCreditServiceServer) ListCreditAppeals(context.Context, *ListCreditAppealsReq) (*ListCreditAppealsResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCreditAppeals not implemented")
+}
+func (UnimplementedCreditServiceServer) ReviewCreditAppeal(context.Context, *ReviewCreditAppealReq) (*ReviewCreditAppealResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReviewCreditAppeal not implemented")
+}
+func (UnimplementedCreditServiceServer) AdminAdjustScore(context.Context, *AdminAdjustScoreReq) (*AdminAdjustScoreResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method AdminAdjustScore not implemented")
+}
+func (UnimplementedCreditServiceServer) ListCreditAudits(context.Context, *ListCreditAuditsReq) (*ListCreditAuditsResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCreditAudits not implemented")
+}
 func (UnimplementedCreditServiceServer) mustEmbedUnimplementedCreditServiceServer() {}
 func (UnimplementedCreditServiceServer) testEmbeddedByValue()                       {}
 
@@ -350,6 +456,96 @@ func _CreditService_UpdateScore_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CreditService_SubmitCreditAppeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitCreditAppealReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CreditServiceServer).SubmitCreditAppeal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CreditService_SubmitCreditAppeal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CreditServiceServer).SubmitCreditAppeal(ctx, req.(*SubmitCreditAppealReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CreditService_ListCreditAppeals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCreditAppealsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CreditServiceServer).ListCreditAppeals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CreditService_ListCreditAppeals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CreditServiceServer).ListCreditAppeals(ctx, req.(*ListCreditAppealsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CreditService_ReviewCreditAppeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewCreditAppealReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CreditServiceServer).ReviewCreditAppeal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CreditService_ReviewCreditAppeal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CreditServiceServer).ReviewCreditAppeal(ctx, req.(*ReviewCreditAppealReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CreditService_AdminAdjustScore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminAdjustScoreReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CreditServiceServer).AdminAdjustScore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CreditService_AdminAdjustScore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CreditServiceServer).AdminAdjustScore(ctx, req.(*AdminAdjustScoreReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CreditService_ListCreditAudits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCreditAuditsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CreditServiceServer).ListCreditAudits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CreditService_ListCreditAudits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CreditServiceServer).ListCreditAudits(ctx, req.(*ListCreditAuditsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CreditService_ServiceDesc is the grpc.ServiceDesc for CreditService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -381,20 +577,46 @@ var CreditService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateScore",
 			Handler:    _CreditService_UpdateScore_Handler,
 		},
+		{
+			MethodName: "SubmitCreditAppeal",
+			Handler:    _CreditService_SubmitCreditAppeal_Handler,
+		},
+		{
+			MethodName: "ListCreditAppeals",
+			Handler:    _CreditService_ListCreditAppeals_Handler,
+		},
+		{
+			MethodName: "ReviewCreditAppeal",
+			Handler:    _CreditService_ReviewCreditAppeal_Handler,
+		},
+		{
+			MethodName: "AdminAdjustScore",
+			Handler:    _CreditService_AdminAdjustScore_Handler,
+		},
+		{
+			MethodName: "ListCreditAudits",
+			Handler:    _CreditService_ListCreditAudits_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "user.proto",
 }
 
 const (
-	VerifyService_GetVerifyCurrent_FullMethodName     = "/user.VerifyService/GetVerifyCurrent"
-	VerifyService_GetVerifyInfo_FullMethodName        = "/user.VerifyService/GetVerifyInfo"
-	VerifyService_IsVerified_FullMethodName           = "/user.VerifyService/IsVerified"
-	VerifyService_ApplyStudentVerify_FullMethodName   = "/user.VerifyService/ApplyStudentVerify"
-	VerifyService_ConfirmStudentVerify_FullMethodName = "/user.VerifyService/ConfirmStudentVerify"
-	VerifyService_CancelStudentVerify_FullMethodName  = "/user.VerifyService/CancelStudentVerify"
-	VerifyService_UpdateVerifyStatus_FullMethodName   = "/user.VerifyService/UpdateVerifyStatus"
-	VerifyService_ProcessOcrVerify_FullMethodName     = "/user.VerifyService/ProcessOcrVerify"
+	VerifyService_GetVerifyCurrent_FullMethodName       = "/user.VerifyService/GetVerifyCurrent"
+	VerifyService_GetVerifyInfo_FullMethodName          = "/user.VerifyService/GetVerifyInfo"
+	VerifyService_IsVerified_FullMethodName             = "/user.VerifyService/IsVerified"
+	VerifyService_BatchGetVerifyProfiles_FullMethodName = "/user.VerifyService/BatchGetVerifyProfiles"
+	VerifyService_ApplyStudentVerify_FullMethodName     = "/user.VerifyService/ApplyStudentVerify"
+	VerifyService_ConfirmStudentVerify_FullMethodName   = "/user.VerifyService/ConfirmStudentVerify"
+	VerifyService_CancelStudentVerify_FullMethodName    = "/user.VerifyService/CancelStudentVerify"
+	VerifyService_ReverifyStudent_FullMethodName        = "/user.VerifyService/ReverifyStudent"
+	VerifyService_UpdateVerifyStatus_FullMethodName     = "/user.VerifyService/UpdateVerifyStatus"
+	VerifyService_ProcessOcrVerify_FullMethodName       = "/user.VerifyService/ProcessOcrVerify"
+	VerifyService_ListVerifyReviews_FullMethodName      = "/user.VerifyService/ListVerifyReviews"
+	VerifyService_ClaimVerifyReview_FullMethodName      = "/user.VerifyService/ClaimVerifyReview"
+	VerifyService_ReviewStudentVerify_FullMethodName    = "/user.VerifyService/ReviewStudentVerify"
+	VerifyService_GetVerifyReviewStats_FullMethodName   = "/user.VerifyService/GetVerifyReviewStats"
 )
 
 // VerifyServiceClient is the client API for VerifyService service.
@@ -413,6 +635,10 @@ type VerifyServiceClient interface {
 	// 调用方: Activity服务（报名/发布活动前校验）
 	// 场景: 需要确认用户学生身份时调用
 	IsVerified(ctx context.Context, in *IsVerifiedReq, opts ...grpc.CallOption) (*IsVerifiedResp, error)
+	// BatchGetVerifyProfiles 批量查询已认证用户的院系与入学年份
+	// 调用方: Activity服务（活动分析日聚合任务，统计报名者构成）
+	// 业务逻辑: 只返回认证有效的用户，不含姓名、学号等敏感字段
+	BatchGetVerifyProfiles(ctx context.Context, in *BatchGetVerifyProfilesReq, opts ...grpc.CallOption) (*BatchGetVerifyProfilesResp, error)
 	// ApplyStudentVerify 提交学生认证申请
 	// 调用方: User API
 	// 业务逻辑:
@@ -431,6 +657,10 @@ type VerifyServiceClient interface {
 	// 调用方: User API
 	// 业务逻辑: 状态改为7（已取消）
 	CancelStudentVerify(ctx context.Context, in *CancelStudentVerifyReq, opts ...grpc.CallOption) (*CancelStudentVerifyResp, error)
+	// ReverifyStudent 过期后重新认证
+	// 调用方: User API
+	// 业务逻辑: 仅已过期(9)状态可用，沿用原认证信息 + 新图片，复用申请流程重新走 OCR
+	ReverifyStudent(ctx context.Context, in *ReverifyStudentReq, opts ...grpc.CallOption) (*ApplyStudentVerifyResp, error)
 	// UpdateVerifyStatus 更新认证状态
 	// 调用方: MQ Consumer（OCR回调、人工审核结果）、定时任务（超时处理）
 	// 业务逻辑: 处理认证流程中的状态流转
@@ -444,6 +674,17 @@ type VerifyServiceClient interface {
 	//  4. 成功 → 更新为 WaitConfirm + 回填OCR数据
 	//  5. 失败 → 更新为 OcrFailed
 	ProcessOcrVerify(ctx context.Context, in *ProcessOcrVerifyReq, opts ...grpc.CallOption) (*ProcessOcrVerifyResp, error)
+	// ListVerifyReviews 分页查询人工审核队列
+	// 业务逻辑: 返回解密后的认证信息与带时效签名的学生证图片URL，并记录查看日志
+	ListVerifyReviews(ctx context.Context, in *ListVerifyReviewsReq, opts ...grpc.CallOption) (*ListVerifyReviewsResp, error)
+	// ClaimVerifyReview 领取/释放审核任务
+	// 业务逻辑: 同一时间只能由一名审核员领取，领取15分钟后自动释放
+	ClaimVerifyReview(ctx context.Context, in *ClaimVerifyReviewReq, opts ...grpc.CallOption) (*ClaimVerifyReviewResp, error)
+	// ReviewStudentVerify 人工审核通过/拒绝
+	// 业务逻辑: 必须由当前领取人处理，通过 UpdateVerifyStatus 完成状态流转并记录审核日志
+	ReviewStudentVerify(ctx context.Context, in *ReviewStudentVerifyReq, opts ...grpc.CallOption) (*ReviewStudentVerifyResp, error)
+	// GetVerifyReviewStats 审核员处理量统计
+	GetVerifyReviewStats(ctx context.Context, in *GetVerifyReviewStatsReq, opts ...grpc.CallOption) (*GetVerifyReviewStatsResp, error)
 }
 
 type verifyServiceClient struct {
@@ -484,6 +725,16 @@ func (c *verifyServiceClient) IsVerified(ctx context.Context, in *IsVerifiedReq,
 	return out, nil
 }
 
+func (c *verifyServiceClient) BatchGetVerifyProfiles(ctx context.Context, in *BatchGetVerifyProfilesReq, opts ...grpc.CallOption) (*BatchGetVerifyProfilesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGetVerifyProfilesResp)
+	err := c.cc.Invoke(ctx, VerifyService_BatchGetVerifyProfiles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *verifyServiceClient) ApplyStudentVerify(ctx context.Context, in *ApplyStudentVerifyReq, opts ...grpc.CallOption) (*ApplyStudentVerifyResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ApplyStudentVerifyResp)
@@ -514,6 +765,16 @@ func (c *verifyServiceClient) CancelStudentVerify(ctx context.Context, in *Cance
 	return out, nil
 }
 
+func (c *verifyServiceClient) ReverifyStudent(ctx context.Context, in *ReverifyStudentReq, opts ...grpc.CallOption) (*ApplyStudentVerifyResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplyStudentVerifyResp)
+	err := c.cc.Invoke(ctx, VerifyService_ReverifyStudent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *verifyServiceClient) UpdateVerifyStatus(ctx context.Context, in *UpdateVerifyStatusReq, opts ...grpc.CallOption) (*UpdateVerifyStatusResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateVerifyStatusResp)
@@ -534,6 +795,46 @@ func (c *verifyServiceClient) ProcessOcrVerify(ctx context.Context, in *ProcessO
 	return out, nil
 }
 
+func (c *verifyServiceClient) ListVerifyReviews(ctx context.Context, in *ListVerifyReviewsReq, opts ...grpc.CallOption) (*ListVerifyReviewsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListVerifyReviewsResp)
+	err := c.cc.Invoke(ctx, VerifyService_ListVerifyReviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *verifyServiceClient) ClaimVerifyReview(ctx context.Context, in *ClaimVerifyReviewReq, opts ...grpc.CallOption) (*ClaimVerifyReviewResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClaimVerifyReviewResp)
+	err := c.cc.Invoke(ctx, VerifyService_ClaimVerifyReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *verifyServiceClient) ReviewStudentVerify(ctx context.Context, in *ReviewStudentVerifyReq, opts ...grpc.CallOption) (*ReviewStudentVerifyResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewStudentVerifyResp)
+	err := c.cc.Invoke(ctx, VerifyService_ReviewStudentVerify_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *verifyServiceClient) GetVerifyReviewStats(ctx context.Context, in *GetVerifyReviewStatsReq, opts ...grpc.CallOption) (*GetVerifyReviewStatsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetVerifyReviewStatsResp)
+	err := c.cc.Invoke(ctx, VerifyService_GetVerifyReviewStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // VerifyServiceServer is the server API for VerifyService service.
 // All implementations must embed UnimplementedVerifyServiceServer
 // for forward compatibility.
@@ -550,6 +851,10 @@ type VerifyServiceServer interface {
 	// 调用方: Activity服务（报名/发布活动前校验）
 	// 场景: 需要确认用户学生身份时调用
 	IsVerified(context.Context, *IsVerifiedReq) (*IsVerifiedResp, error)
+	// BatchGetVerifyProfiles 批量查询已认证用户的院系与入学年份
+	// 调用方: Activity服务（活动分析日聚合任务，统计报名者构成）
+	// 业务逻辑: 只返回认证有效的用户，不含姓名、学号等敏感字段
+	BatchGetVerifyProfiles(context.Context, *BatchGetVerifyProfilesReq) (*BatchGetVerifyProfilesResp, error)
 	// ApplyStudentVerify 提交学生认证申请
 	// 调用方: User API
 	// 业务逻辑:
@@ -568,6 +873,10 @@ type VerifyServiceServer interface {
 	// 调用方: User API
 	// 业务逻辑: 状态改为7（已取消）
 	CancelStudentVerify(context.Context, *CancelStudentVerifyReq) (*CancelStudentVerifyResp, error)
+	// ReverifyStudent 过期后重新认证
+	// 调用方: User API
+	// 业务逻辑: 仅已过期(9)状态可用，沿用原认证信息 + 新图片，复用申请流程重新走 OCR
+	ReverifyStudent(context.Context, *ReverifyStudentReq) (*ApplyStudentVerifyResp, error)
 	// UpdateVerifyStatus 更新认证状态
 	// 调用方: MQ Consumer（OCR回调、人工审核结果）、定时任务（超时处理）
 	// 业务逻辑: 处理认证流程中的状态流转
@@ -581,6 +890,17 @@ type VerifyServiceServer interface {
 	//  4. 成功 → 更新为 WaitConfirm + 回填OCR数据
 	//  5. 失败 → 更新为 OcrFailed
 	ProcessOcrVerify(context.Context, *ProcessOcrVerifyReq) (*ProcessOcrVerifyResp, error)
+	// ListVerifyReviews 分页查询人工审核队列
+	// 业务逻辑: 返回解密后的认证信息与带时效签名的学生证图片URL，并记录查看日志
+	ListVerifyReviews(context.Context, *ListVerifyReviewsReq) (*ListVerifyReviewsResp, error)
+	// ClaimVerifyReview 领取/释放审核任务
+	// 业务逻辑: 同一时间只能由一名审核员领取，领取15分钟后自动释放
+	ClaimVerifyReview(context.Context, *ClaimVerifyReviewReq) (*ClaimVerifyReviewResp, error)
+	// ReviewStudentVerify 人工审核通过/拒绝
+	// 业务逻辑: 必须由当前领取人处理，通过 UpdateVerifyStatus 完成状态流转并记录审核日志
+	ReviewStudentVerify(context.Context, *ReviewStudentVerifyReq) (*ReviewStudentVerifyResp, error)
+	// GetVerifyReviewStats 审核员处理量统计
+	GetVerifyReviewStats(context.Context, *GetVerifyReviewStatsReq) (*GetVerifyReviewStatsResp, error)
 	mustEmbedUnimplementedVerifyServiceServer()
 }
 
@@ -600,6 +920,9 @@ func (UnimplementedVerifyServiceServer) GetVerifyInfo(context.Context, *GetVerif
 func (UnimplementedVerifyServiceServer) IsVerified(context.Context, *IsVerifiedReq) (*IsVerifiedResp, error) {
 	return nil, status.Error(codes.Unimplemented, "method IsVerified not implemented")
 }
+func (UnimplementedVerifyServiceServer) BatchGetVerifyProfiles(context.Context, *BatchGetVerifyProfilesReq) (*BatchGetVerifyProfilesResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method BatchGetVerifyProfiles not implemented")
+}
 func (UnimplementedVerifyServiceServer) ApplyStudentVerify(context.Context, *ApplyStudentVerifyReq) (*ApplyStudentVerifyResp, error) {
 	return nil, status.Error(codes.Unimplemented, "method ApplyStudentVerify not implemented")
 }
@@ -609,12 +932,27 @@ func (UnimplementedVerifyServiceServer) ConfirmStudentVerify(context.Context, *C
 func (UnimplementedVerifyServiceServer) CancelStudentVerify(context.Context, *CancelStudentVerifyReq) (*CancelStudentVerifyResp, error) {
 	return nil, status.Error(codes.Unimplemented, "method CancelStudentVerify not implemented")
 }
+func (UnimplementedVerifyServiceServer) ReverifyStudent(context.Context, *ReverifyStudentReq) (*ApplyStudentVerifyResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReverifyStudent not implemented")
+}
 func (UnimplementedVerifyServiceServer) UpdateVerifyStatus(context.Context, *UpdateVerifyStatusReq) (*UpdateVerifyStatusResp, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateVerifyStatus not implemented")
 }
 func (UnimplementedVerifyServiceServer) ProcessOcrVerify(context.Context, *ProcessOcrVerifyReq) (*ProcessOcrVerifyResp, error) {
 	return nil, status.Error(codes.Unimplemented, "method ProcessOcrVerify not implemented")
 }
+func (UnimplementedVerifyServiceServer) ListVerifyReviews(context.Context, *ListVerifyReviewsReq) (*ListVerifyReviewsResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListVerifyReviews not implemented")
+}
+func (UnimplementedVerifyServiceServer) ClaimVerifyReview(context.Context, *ClaimVerifyReviewReq) (*ClaimVerifyReviewResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method ClaimVerifyReview not implemented")
+}
+func (UnimplementedVerifyServiceServer) ReviewStudentVerify(context.Context, *ReviewStudentVerifyReq) (*ReviewStudentVerifyResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReviewStudentVerify not implemented")
+}
+func (UnimplementedVerifyServiceServer) GetVerifyReviewStats(context.Context, *GetVerifyReviewStatsReq) (*GetVerifyReviewStatsResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetVerifyReviewStats not implemented")
+}
 func (UnimplementedVerifyServiceServer) mustEmbedUnimplementedVerifyServiceServer() {}
 func (UnimplementedVerifyServiceServer) testEmbeddedByValue()                       {}
 
@@ -690,6 +1028,24 @@ func _VerifyService_IsVerified_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VerifyService_BatchGetVerifyProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetVerifyProfilesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VerifyServiceServer).BatchGetVerifyProfiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VerifyService_BatchGetVerifyProfiles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VerifyServiceServer).BatchGetVerifyProfiles(ctx, req.(*BatchGetVerifyProfilesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _VerifyService_ApplyStudentVerify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ApplyStudentVerifyReq)
 	if err := dec(in); err != nil {
@@ -744,6 +1100,24 @@ func _VerifyService_CancelStudentVerify_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VerifyService_ReverifyStudent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReverifyStudentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VerifyServiceServer).ReverifyStudent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VerifyService_ReverifyStudent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VerifyServiceServer).ReverifyStudent(ctx, req.(*ReverifyStudentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _VerifyService_UpdateVerifyStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateVerifyStatusReq)
 	if err := dec(in); err != nil {
@@ -780,6 +1154,78 @@ func _VerifyService_ProcessOcrVerify_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VerifyService_ListVerifyReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVerifyReviewsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VerifyServiceServer).ListVerifyReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VerifyService_ListVerifyReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VerifyServiceServer).ListVerifyReviews(ctx, req.(*ListVerifyReviewsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VerifyService_ClaimVerifyReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimVerifyReviewReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VerifyServiceServer).ClaimVerifyReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VerifyService_ClaimVerifyReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VerifyServiceServer).ClaimVerifyReview(ctx, req.(*ClaimVerifyReviewReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VerifyService_ReviewStudentVerify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewStudentVerifyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VerifyServiceServer).ReviewStudentVerify(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VerifyService_ReviewStudentVerify_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VerifyServiceServer).ReviewStudentVerify(ctx, req.(*ReviewStudentVerifyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VerifyService_GetVerifyReviewStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVerifyReviewStatsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VerifyServiceServer).GetVerifyReviewStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VerifyService_GetVerifyReviewStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VerifyServiceServer).GetVerifyReviewStats(ctx, req.(*GetVerifyReviewStatsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // VerifyService_ServiceDesc is the grpc.ServiceDesc for VerifyService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -799,6 +1245,10 @@ var VerifyService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "IsVerified",
 			Handler:    _VerifyService_IsVerified_Handler,
 		},
+		{
+			MethodName: "BatchGetVerifyProfiles",
+			Handler:    _VerifyService_BatchGetVerifyProfiles_Handler,
+		},
 		{
 			MethodName: "ApplyStudentVerify",
 			Handler:    _VerifyService_ApplyStudentVerify_Handler,
@@ -811,6 +1261,10 @@ var VerifyService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelStudentVerify",
 			Handler:    _VerifyService_CancelStudentVerify_Handler,
 		},
+		{
+			MethodName: "ReverifyStudent",
+			Handler:    _VerifyService_ReverifyStudent_Handler,
+		},
 		{
 			MethodName: "UpdateVerifyStatus",
 			Handler:    _VerifyService_UpdateVerifyStatus_Handler,
@@ -819,17 +1273,37 @@ var VerifyService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ProcessOcrVerify",
 			Handler:    _VerifyService_ProcessOcrVerify_Handler,
 		},
+		{
+			MethodName: "ListVerifyReviews",
+			Handler:    _VerifyService_ListVerifyReviews_Handler,
+		},
+		{
+			MethodName: "ClaimVerifyReview",
+			Handler:    _VerifyService_ClaimVerifyReview_Handler,
+		},
+		{
+			MethodName: "ReviewStudentVerify",
+			Handler:    _VerifyService_ReviewStudentVerify_Handler,
+		},
+		{
+			MethodName: "GetVerifyReviewStats",
+			Handler:    _VerifyService_GetVerifyReviewStats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "user.proto",
 }
 
 const (
-	TagService_GetAllTags_FullMethodName         = "/user.TagService/GetAllTags"
-	TagService_GetTagsByIds_FullMethodName       = "/user.TagService/GetTagsByIds"
-	TagService_GetUserTags_FullMethodName        = "/user.TagService/GetUserTags"
-	TagService_UpdateUserTag_FullMethodName      = "/user.TagService/UpdateUserTag"
-	TagService_GetAllInterestTags_FullMethodName = "/user.TagService/GetAllInterestTags"
+	TagService_GetAllTags_FullMethodName           = "/user.TagService/GetAllTags"
+	TagService_GetTagsByIds_FullMethodName         = "/user.TagService/GetTagsByIds"
+	TagService_GetUserTags_FullMethodName          = "/user.TagService/GetUserTags"
+	TagService_UpdateUserTag_FullMethodName        = "/user.TagService/UpdateUserTag"
+	TagService_GetAllInterestTags_FullMethodName   = "/user.TagService/GetAllInterestTags"
+	TagService_CreateInterestTag_FullMethodName    = "/user.TagService/CreateInterestTag"
+	TagService_UpdateInterestTag_FullMethodName    = "/user.TagService/UpdateInterestTag"
+	TagService_SetInterestTagStatus_FullMethodName = "/user.TagService/SetInterestTagStatus"
+	TagService_MergeInterestTags_FullMethodName    = "/user.TagService/MergeInterestTags"
 )
 
 // TagServiceClient is the client API for TagService service.
@@ -843,6 +1317,15 @@ type TagServiceClient interface {
 	UpdateUserTag(ctx context.Context, in *UpdateUserTagReq, opts ...grpc.CallOption) (*UpdateUserTagResponse, error)
 	// 获取所有的兴趣标签
 	GetAllInterestTags(ctx context.Context, in *GetAllInterestTagsReq, opts ...grpc.CallOption) (*GetAllInterestTagsResp, error)
+	// ==================== 标签管理（管理员，经活动服务调用）====================
+	// 创建兴趣标签
+	CreateInterestTag(ctx context.Context, in *CreateInterestTagReq, opts ...grpc.CallOption) (*InterestTagResp, error)
+	// 修改兴趣标签（重命名/颜色/图标/描述，空字段不修改）
+	UpdateInterestTag(ctx context.Context, in *UpdateInterestTagReq, opts ...grpc.CallOption) (*InterestTagResp, error)
+	// 启用/禁用兴趣标签
+	SetInterestTagStatus(ctx context.Context, in *SetInterestTagStatusReq, opts ...grpc.CallOption) (*InterestTagResp, error)
+	// 合并兴趣标签（用户关联改绑到目标标签，源标签禁用）
+	MergeInterestTags(ctx context.Context, in *MergeInterestTagsReq, opts ...grpc.CallOption) (*MergeInterestTagsResp, error)
 }
 
 type tagServiceClient struct {
@@ -903,6 +1386,46 @@ func (c *tagServiceClient) GetAllInterestTags(ctx context.Context, in *GetAllInt
 	return out, nil
 }
 
+func (c *tagServiceClient) CreateInterestTag(ctx context.Context, in *CreateInterestTagReq, opts ...grpc.CallOption) (*InterestTagResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InterestTagResp)
+	err := c.cc.Invoke(ctx, TagService_CreateInterestTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tagServiceClient) UpdateInterestTag(ctx context.Context, in *UpdateInterestTagReq, opts ...grpc.CallOption) (*InterestTagResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InterestTagResp)
+	err := c.cc.Invoke(ctx, TagService_UpdateInterestTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tagServiceClient) SetInterestTagStatus(ctx context.Context, in *SetInterestTagStatusReq, opts ...grpc.CallOption) (*InterestTagResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InterestTagResp)
+	err := c.cc.Invoke(ctx, TagService_SetInterestTagStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tagServiceClient) MergeInterestTags(ctx context.Context, in *MergeInterestTagsReq, opts ...grpc.CallOption) (*MergeInterestTagsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeInterestTagsResp)
+	err := c.cc.Invoke(ctx, TagService_MergeInterestTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TagServiceServer is the server API for TagService service.
 // All implementations must embed UnimplementedTagServiceServer
 // for forward compatibility.
@@ -914,6 +1437,15 @@ type TagServiceServer interface {
 	UpdateUserTag(context.Context, *UpdateUserTagReq) (*UpdateUserTagResponse, error)
 	// 获取所有的兴趣标签
 	GetAllInterestTags(context.Context, *GetAllInterestTagsReq) (*GetAllInterestTagsResp, error)
+	// ==================== 标签管理（管理员，经活动服务调用）====================
+	// 创建兴趣标签
+	CreateInterestTag(context.Context, *CreateInterestTagReq) (*InterestTagResp, error)
+	// 修改兴趣标签（重命名/颜色/图标/描述，空字段不修改）
+	UpdateInterestTag(context.Context, *UpdateInterestTagReq) (*InterestTagResp, error)
+	// 启用/禁用兴趣标签
+	SetInterestTagStatus(context.Context, *SetInterestTagStatusReq) (*InterestTagResp, error)
+	// 合并兴趣标签（用户关联改绑到目标标签，源标签禁用）
+	MergeInterestTags(context.Context, *MergeInterestTagsReq) (*MergeInterestTagsResp, error)
 	mustEmbedUnimplementedTagServiceServer()
 }
 
@@ -939,6 +1471,18 @@ func (UnimplementedTagServiceServer) UpdateUserTag(context.Context, *UpdateUserT
 func (UnimplementedTagServiceServer) GetAllInterestTags(context.Context, *GetAllInterestTagsReq) (*GetAllInterestTagsResp, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetAllInterestTags not implemented")
 }
+func (UnimplementedTagServiceServer) CreateInterestTag(context.Context, *CreateInterestTagReq) (*InterestTagResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateInterestTag not implemented")
+}
+func (UnimplementedTagServiceServer) UpdateInterestTag(context.Context, *UpdateInterestTagReq) (*InterestTagResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateInterestTag not implemented")
+}
+func (UnimplementedTagServiceServer) SetInterestTagStatus(context.Context, *SetInterestTagStatusReq) (*InterestTagResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetInterestTagStatus not implemented")
+}
+func (UnimplementedTagServiceServer) MergeInterestTags(context.Context, *MergeInterestTagsReq) (*MergeInterestTagsResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method MergeInterestTags not implemented")
+}
 func (UnimplementedTagServiceServer) mustEmbedUnimplementedTagServiceServer() {}
 func (UnimplementedTagServiceServer) testEmbeddedByValue()                    {}
 
@@ -1050,6 +1594,78 @@ func _TagService_GetAllInterestTags_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TagService_CreateInterestTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInterestTagReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServiceServer).CreateInterestTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagService_CreateInterestTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServiceServer).CreateInterestTag(ctx, req.(*CreateInterestTagReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TagService_UpdateInterestTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateInterestTagReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServiceServer).UpdateInterestTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagService_UpdateInterestTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServiceServer).UpdateInterestTag(ctx, req.(*UpdateInterestTagReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TagService_SetInterestTagStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetInterestTagStatusReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServiceServer).SetInterestTagStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagService_SetInterestTagStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServiceServer).SetInterestTagStatus(ctx, req.(*SetInterestTagStatusReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TagService_MergeInterestTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeInterestTagsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServiceServer).MergeInterestTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagService_MergeInterestTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServiceServer).MergeInterestTags(ctx, req.(*MergeInterestTagsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TagService_ServiceDesc is the grpc.ServiceDesc for TagService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1077,6 +1693,22 @@ var TagService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAllInterestTags",
 			Handler:    _TagService_GetAllInterestTags_Handler,
 		},
+		{
+			MethodName: "CreateInterestTag",
+			Handler:    _TagService_CreateInterestTag_Handler,
+		},
+		{
+			MethodName: "UpdateInterestTag",
+			Handler:    _TagService_UpdateInterestTag_Handler,
+		},
+		{
+			MethodName: "SetInterestTagStatus",
+			Handler:    _TagService_SetInterestTagStatus_Handler,
+		},
+		{
+			MethodName: "MergeInterestTags",
+			Handler:    _TagService_MergeInterestTags_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "user.proto",
@@ -2357,3 +2989,187 @@ var UploadToQiNiu_ServiceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "user.proto",
 }
+
+const (
+	PlatformStatsService_RecordUserActive_FullMethodName = "/user.PlatformStatsService/RecordUserActive"
+	PlatformStatsService_ReportDailyStats_FullMethodName = "/user.PlatformStatsService/ReportDailyStats"
+	PlatformStatsService_GetPlatformStats_FullMethodName = "/user.PlatformStatsService/GetPlatformStats"
+)
+
+// PlatformStatsServiceClient is the client API for PlatformStatsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PlatformStatsServiceClient interface {
+	// RecordUserActive 记录用户活跃（计入当日日活）
+	RecordUserActive(ctx context.Context, in *RecordUserActiveReq, opts ...grpc.CallOption) (*RecordUserActiveResp, error)
+	// ReportDailyStats 上报某日指标（同一日期下声明的指标整体替换）
+	ReportDailyStats(ctx context.Context, in *ReportDailyStatsReq, opts ...grpc.CallOption) (*ReportDailyStatsResp, error)
+	// GetPlatformStats 按日期范围查询指标
+	GetPlatformStats(ctx context.Context, in *GetPlatformStatsReq, opts ...grpc.CallOption) (*GetPlatformStatsResp, error)
+}
+
+type platformStatsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPlatformStatsServiceClient(cc grpc.ClientConnInterface) PlatformStatsServiceClient {
+	return &platformStatsServiceClient{cc}
+}
+
+func (c *platformStatsServiceClient) RecordUserActive(ctx context.Context, in *RecordUserActiveReq, opts ...grpc.CallOption) (*RecordUserActiveResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordUserActiveResp)
+	err := c.cc.Invoke(ctx, PlatformStatsService_RecordUserActive_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *platformStatsServiceClient) ReportDailyStats(ctx context.Context, in *ReportDailyStatsReq, opts ...grpc.CallOption) (*ReportDailyStatsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportDailyStatsResp)
+	err := c.cc.Invoke(ctx, PlatformStatsService_ReportDailyStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *platformStatsServiceClient) GetPlatformStats(ctx context.Context, in *GetPlatformStatsReq, opts ...grpc.CallOption) (*GetPlatformStatsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPlatformStatsResp)
+	err := c.cc.Invoke(ctx, PlatformStatsService_GetPlatformStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PlatformStatsServiceServer is the server API for PlatformStatsService service.
+// All implementations must embed UnimplementedPlatformStatsServiceServer
+// for forward compatibility.
+type PlatformStatsServiceServer interface {
+	// RecordUserActive 记录用户活跃（计入当日日活）
+	RecordUserActive(context.Context, *RecordUserActiveReq) (*RecordUserActiveResp, error)
+	// ReportDailyStats 上报某日指标（同一日期下声明的指标整体替换）
+	ReportDailyStats(context.Context, *ReportDailyStatsReq) (*ReportDailyStatsResp, error)
+	// GetPlatformStats 按日期范围查询指标
+	GetPlatformStats(context.Context, *GetPlatformStatsReq) (*GetPlatformStatsResp, error)
+	mustEmbedUnimplementedPlatformStatsServiceServer()
+}
+
+// UnimplementedPlatformStatsServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPlatformStatsServiceServer struct{}
+
+func (UnimplementedPlatformStatsServiceServer) RecordUserActive(context.Context, *RecordUserActiveReq) (*RecordUserActiveResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method RecordUserActive not implemented")
+}
+func (UnimplementedPlatformStatsServiceServer) ReportDailyStats(context.Context, *ReportDailyStatsReq) (*ReportDailyStatsResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReportDailyStats not implemented")
+}
+func (UnimplementedPlatformStatsServiceServer) GetPlatformStats(context.Context, *GetPlatformStatsReq) (*GetPlatformStatsResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPlatformStats not implemented")
+}
+func (UnimplementedPlatformStatsServiceServer) mustEmbedUnimplementedPlatformStatsServiceServer() {}
+func (UnimplementedPlatformStatsServiceServer) testEmbeddedByValue()                              {}
+
+// UnsafePlatformStatsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PlatformStatsServiceServer will
+// result in compilation errors.
+type UnsafePlatformStatsServiceServer interface {
+	mustEmbedUnimplementedPlatformStatsServiceServer()
+}
+
+func RegisterPlatformStatsServiceServer(s grpc.ServiceRegistrar, srv PlatformStatsServiceServer) {
+	// If the following call panics, it indicates UnimplementedPlatformStatsServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PlatformStatsService_ServiceDesc, srv)
+}
+
+func _PlatformStatsService_RecordUserActive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordUserActiveReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlatformStatsServiceServer).RecordUserActive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlatformStatsService_RecordUserActive_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlatformStatsServiceServer).RecordUserActive(ctx, req.(*RecordUserActiveReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlatformStatsService_ReportDailyStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportDailyStatsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlatformStatsServiceServer).ReportDailyStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlatformStatsService_ReportDailyStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlatformStatsServiceServer).ReportDailyStats(ctx, req.(*ReportDailyStatsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlatformStatsService_GetPlatformStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlatformStatsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlatformStatsServiceServer).GetPlatformStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlatformStatsService_GetPlatformStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlatformStatsServiceServer).GetPlatformStats(ctx, req.(*GetPlatformStatsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PlatformStatsService_ServiceDesc is the grpc.ServiceDesc for PlatformStatsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PlatformStatsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "user.PlatformStatsService",
+	HandlerType: (*PlatformStatsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RecordUserActive",
+			Handler:    _PlatformStatsService_RecordUserActive_Handler,
+		},
+		{
+			MethodName: "ReportDailyStats",
+			Handler:    _PlatformStatsService_ReportDailyStats_Handler,
+		},
+		{
+			MethodName: "GetPlatformStats",
+			Handler:    _PlatformStatsService_GetPlatformStats_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "user.proto",
+}
@@ -20,6 +20,7 @@ import (
 	"activity-platform/app/user/rpc/internal/cron"
 	captchaserviceserver "activity-platform/app/user/rpc/internal/server/captchaservice"
 	creditserviceserver "activity-platform/app/user/rpc/internal/server/creditservice"
+	platformstatsserviceserver "activity-platform/app/user/rpc/internal/server/platformstatsservice"
 	qqemailserver "activity-platform/app/user/rpc/internal/server/qqemail"
 	tagbranchserviceserver "activity-platform/app/user/rpc/internal/server/tagbranchservice"
 	tagserviceserver "activity-platform/app/user/rpc/internal/server/tagservice"
@@ -81,6 +82,9 @@ func main() {
 		// 注册 UploadToQiNiuService
 		pb.RegisterUploadToQiNiuServer(grpcServer, uploadtoqiniuserver.NewUploadToQiNiuServer(ctx))
 
+		// 注册 PlatformStatsService（平台统计服务）
+		pb.RegisterPlatformStatsServiceServer(grpcServer, platformstatsserviceserver.NewPlatformStatsServiceServer(ctx))
+
 		// 开发环境开启 gRPC Reflection（便于 grpcurl 调试）
 		if c.Mode == service.DevMode || c.Mode == service.TestMode {
 			reflection.Register(grpcServer)
@@ -96,6 +100,34 @@ func main() {
 	scanner.Start()
 	defer scanner.Stop()
 
+	// 启动信用分自动修复任务（可选，默认关闭）
+	if c.CreditRecovery.Enabled {
+		recovery := cron.NewCreditRecovery(ctx, c.CreditRecovery)
+		recovery.Start()
+		defer recovery.Stop()
+	}
+
+	// 启动学生认证过期任务（可选，默认关闭）
+	if c.VerifyExpiry.Enabled {
+		expiry := cron.NewVerifyExpiry(ctx, c.VerifyExpiry)
+		expiry.Start()
+		defer expiry.Stop()
+	}
+
+	// 启动敏感数据重新加密任务（密钥轮换时开启，默认关闭）
+	if c.SensitiveData.Rekey.Enabled {
+		rekey := cron.NewSensitiveRekey(ctx, c.SensitiveData.Rekey)
+		rekey.Start()
+		defer rekey.Stop()
+	}
+
+	// 启动平台统计汇总任务（默认开启）
+	if c.PlatformStats.Enabled {
+		stats := cron.NewPlatformStats(ctx, c.PlatformStats)
+		stats.Start()
+		defer stats.Stop()
+	}
+
 	fmt.Printf("Starting user rpc server at %s...\n", c.ListenOn)
 	s.Start()
 }
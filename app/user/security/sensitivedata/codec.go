@@ -9,90 +9,182 @@ import (
 	"encoding/base64"
 	"encoding/hex"
 	"fmt"
+	"regexp"
 	"strings"
 
 	"activity-platform/app/user/model"
 )
 
 const (
-	// CipherPrefix 密文前缀，用于识别受支持的密文版本
-	CipherPrefix = "enc:v1:"
+	// CipherPrefix 当前密文前缀，完整格式为 enc:v2:{keyId}:{base64(nonce+ciphertext)}
+	CipherPrefix = "enc:v2:"
+	// LegacyCipherPrefix 旧版密文前缀（不含密钥ID），固定使用 LegacyKeyID 对应的密钥解密
+	LegacyCipherPrefix = "enc:v1:"
+
+	// LegacyKeyID 旧版单密钥配置（AesKey/HashKey）对应的密钥ID
+	LegacyKeyID = "legacy"
 
 	fieldRealName  = "real_name"
 	fieldStudentID = "student_id"
 )
 
-// Codec 提供敏感字段的加解密与学号哈希能力。
+// keyIDPattern 密钥ID格式：字母数字与短横线，最长 16 位（与 hash_key_id 列宽一致）
+var keyIDPattern = regexp.MustCompile(`^[A-Za-z0-9-]{1,16}$`)
+
+// Keyring 密钥环配置。
+// Active* 为当前用于加密/哈希的密钥，其余密钥仅用于解密或兼容旧哈希。
+// 密钥值均为 base64 编码后的 32 字节密钥。
+type Keyring struct {
+	ActiveKeyID     string
+	AesKeys         map[string]string
+	ActiveHashKeyID string
+	HashKeys        map[string]string
+}
+
+// Codec 提供敏感字段的加解密与学号哈希能力，支持按密钥ID轮换。
 type Codec struct {
-	gcm     cipher.AEAD
-	hashKey []byte
+	activeKeyID string
+	gcms        map[string]cipher.AEAD
+
+	activeHashKeyID string
+	hashKeys        map[string][]byte
+	// hashKeyOrder 哈希密钥顺序（当前密钥在前）
+	hashKeyOrder []string
 }
 
-// New 创建敏感数据编解码器。
+// New 创建单密钥的敏感数据编解码器（密钥ID固定为 LegacyKeyID）。
 // aesKeyBase64 与 hashKeyBase64 必须是 base64 编码后的 32 字节密钥。
 func New(aesKeyBase64, hashKeyBase64 string) (*Codec, error) {
-	aesKey, err := decodeBase64Key("SensitiveData.AesKey", aesKeyBase64)
-	if err != nil {
-		return nil, err
+	return NewWithKeyring(Keyring{
+		ActiveKeyID:     LegacyKeyID,
+		AesKeys:         map[string]string{LegacyKeyID: aesKeyBase64},
+		ActiveHashKeyID: LegacyKeyID,
+		HashKeys:        map[string]string{LegacyKeyID: hashKeyBase64},
+	})
+}
+
+// NewWithKeyring 根据密钥环创建敏感数据编解码器。
+func NewWithKeyring(ring Keyring) (*Codec, error) {
+	c := &Codec{
+		activeKeyID:     strings.TrimSpace(ring.ActiveKeyID),
+		gcms:            make(map[string]cipher.AEAD, len(ring.AesKeys)),
+		activeHashKeyID: strings.TrimSpace(ring.ActiveHashKeyID),
+		hashKeys:        make(map[string][]byte, len(ring.HashKeys)),
 	}
-	hashKey, err := decodeBase64Key("SensitiveData.HashKey", hashKeyBase64)
-	if err != nil {
-		return nil, err
+
+	for id, value := range ring.AesKeys {
+		if !keyIDPattern.MatchString(id) {
+			return nil, fmt.Errorf("SensitiveData key id %q is invalid", id)
+		}
+		key, err := decodeBase64Key(fmt.Sprintf("SensitiveData.AesKey[%s]", id), value)
+		if err != nil {
+			return nil, err
+		}
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return nil, fmt.Errorf("init aes cipher failed: %w", err)
+		}
+		gcm, err := cipher.NewGCM(block)
+		if err != nil {
+			return nil, fmt.Errorf("init gcm failed: %w", err)
+		}
+		c.gcms[id] = gcm
+	}
+	if _, ok := c.gcms[c.activeKeyID]; !ok {
+		return nil, fmt.Errorf("SensitiveData active key %q not found", c.activeKeyID)
 	}
 
-	block, err := aes.NewCipher(aesKey)
-	if err != nil {
-		return nil, fmt.Errorf("init aes cipher failed: %w", err)
+	for id, value := range ring.HashKeys {
+		if !keyIDPattern.MatchString(id) {
+			return nil, fmt.Errorf("SensitiveData hash key id %q is invalid", id)
+		}
+		key, err := decodeBase64Key(fmt.Sprintf("SensitiveData.HashKey[%s]", id), value)
+		if err != nil {
+			return nil, err
+		}
+		c.hashKeys[id] = key
+		if id != c.activeHashKeyID {
+			c.hashKeyOrder = append(c.hashKeyOrder, id)
+		}
 	}
-	gcm, err := cipher.NewGCM(block)
-	if err != nil {
-		return nil, fmt.Errorf("init gcm failed: %w", err)
+	if _, ok := c.hashKeys[c.activeHashKeyID]; !ok {
+		return nil, fmt.Errorf("SensitiveData active hash key %q not found", c.activeHashKeyID)
 	}
+	c.hashKeyOrder = append([]string{c.activeHashKeyID}, c.hashKeyOrder...)
 
-	return &Codec{
-		gcm:     gcm,
-		hashKey: hashKey,
-	}, nil
+	return c, nil
 }
 
 // IsEncrypted 判断值是否为受支持的密文格式。
 func (c *Codec) IsEncrypted(value string) bool {
-	return strings.HasPrefix(value, CipherPrefix)
+	return strings.HasPrefix(value, CipherPrefix) || strings.HasPrefix(value, LegacyCipherPrefix)
+}
+
+// ActiveKeyID 返回当前加密密钥ID。
+func (c *Codec) ActiveKeyID() string {
+	return c.activeKeyID
+}
+
+// ActiveCipherPrefix 返回当前密钥生成的密文前缀，用于定位需要重新加密的记录。
+func (c *Codec) ActiveCipherPrefix() string {
+	return CipherPrefix + c.activeKeyID + ":"
 }
 
-// Encrypt 对字段进行 AES-256-GCM 加密。
+// NeedsReencrypt 判断密文是否不是由当前密钥加密。
+func (c *Codec) NeedsReencrypt(value string) bool {
+	return value != "" && !strings.HasPrefix(value, c.ActiveCipherPrefix())
+}
+
+// Encrypt 使用当前密钥对字段进行 AES-256-GCM 加密。
 func (c *Codec) Encrypt(field, plaintext string) (string, error) {
 	if plaintext == "" {
 		return "", nil
 	}
 
-	nonce := make([]byte, c.gcm.NonceSize())
+	gcm := c.gcms[c.activeKeyID]
+	nonce := make([]byte, gcm.NonceSize())
 	if _, err := rand.Read(nonce); err != nil {
 		return "", fmt.Errorf("generate nonce failed: %w", err)
 	}
 
-	ciphertext := c.gcm.Seal(nil, nonce, []byte(plaintext), fieldAAD(field))
+	ciphertext := gcm.Seal(nil, nonce, []byte(plaintext), fieldAAD(field))
 	raw := append(nonce, ciphertext...)
 
-	return CipherPrefix + base64.StdEncoding.EncodeToString(raw), nil
+	return c.ActiveCipherPrefix() + base64.StdEncoding.EncodeToString(raw), nil
 }
 
-// Decrypt 对字段进行 AES-256-GCM 解密。
+// Decrypt 根据密文中的密钥ID选择密钥进行 AES-256-GCM 解密。
 func (c *Codec) Decrypt(field, value string) (string, error) {
 	if value == "" {
 		return "", nil
 	}
-	if !c.IsEncrypted(value) {
+
+	var keyID, encoded string
+	switch {
+	case strings.HasPrefix(value, CipherPrefix):
+		rest := strings.TrimPrefix(value, CipherPrefix)
+		idx := strings.Index(rest, ":")
+		if idx <= 0 {
+			return "", fmt.Errorf("invalid ciphertext format")
+		}
+		keyID, encoded = rest[:idx], rest[idx+1:]
+	case strings.HasPrefix(value, LegacyCipherPrefix):
+		keyID, encoded = LegacyKeyID, strings.TrimPrefix(value, LegacyCipherPrefix)
+	default:
 		return "", fmt.Errorf("invalid ciphertext format")
 	}
 
-	encoded := strings.TrimPrefix(value, CipherPrefix)
+	gcm, ok := c.gcms[keyID]
+	if !ok {
+		return "", fmt.Errorf("unknown key id: %s", keyID)
+	}
+
 	raw, err := base64.StdEncoding.DecodeString(encoded)
 	if err != nil {
 		return "", fmt.Errorf("decode ciphertext failed: %w", err)
 	}
 
-	nonceSize := c.gcm.NonceSize()
+	nonceSize := gcm.NonceSize()
 	if len(raw) <= nonceSize {
 		return "", fmt.Errorf("invalid ciphertext payload")
 	}
@@ -100,7 +192,7 @@ func (c *Codec) Decrypt(field, value string) (string, error) {
 	nonce := raw[:nonceSize]
 	ciphertext := raw[nonceSize:]
 
-	plain, err := c.gcm.Open(nil, nonce, ciphertext, fieldAAD(field))
+	plain, err := gcm.Open(nil, nonce, ciphertext, fieldAAD(field))
 	if err != nil {
 		return "", fmt.Errorf("gcm decrypt failed: %w", err)
 	}
@@ -108,15 +200,38 @@ func (c *Codec) Decrypt(field, value string) (string, error) {
 	return string(plain), nil
 }
 
-// HashStudentID 使用 HMAC-SHA256 生成学号哈希索引值。
+// HashKeyID 返回当前学号哈希密钥ID。
+func (c *Codec) HashKeyID() string {
+	return c.activeHashKeyID
+}
+
+// HashStudentID 使用当前哈希密钥生成学号哈希索引值（HMAC-SHA256）。
 func (c *Codec) HashStudentID(schoolName, studentID string) (string, error) {
+	return c.hashWithKey(c.hashKeys[c.activeHashKeyID], schoolName, studentID)
+}
+
+// HashStudentIDCandidates 使用全部哈希密钥生成学号哈希（当前密钥在前）。
+// 哈希密钥轮换期间新旧哈希并存，唯一性检查需覆盖全部候选值。
+func (c *Codec) HashStudentIDCandidates(schoolName, studentID string) ([]string, error) {
+	hashes := make([]string, 0, len(c.hashKeyOrder))
+	for _, id := range c.hashKeyOrder {
+		h, err := c.hashWithKey(c.hashKeys[id], schoolName, studentID)
+		if err != nil {
+			return nil, err
+		}
+		hashes = append(hashes, h)
+	}
+	return hashes, nil
+}
+
+func (c *Codec) hashWithKey(key []byte, schoolName, studentID string) (string, error) {
 	school := strings.TrimSpace(schoolName)
 	student := strings.TrimSpace(studentID)
 	if student == "" {
 		return "", fmt.Errorf("student_id is empty")
 	}
 
-	mac := hmac.New(sha256.New, c.hashKey)
+	mac := hmac.New(sha256.New, key)
 	_, _ = mac.Write([]byte(school + "\n" + student))
 	return hex.EncodeToString(mac.Sum(nil)), nil
 }
@@ -88,3 +88,77 @@ func TestHashStudentID(t *testing.T) {
 		t.Fatalf("different school should produce different hash")
 	}
 }
+
+const testRotatedKeyBase64 = "YWJjZGVmMDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODk="
+
+func newRotatedCodec(t *testing.T) *Codec {
+	t.Helper()
+
+	codec, err := NewWithKeyring(Keyring{
+		ActiveKeyID:     "k2",
+		AesKeys:         map[string]string{LegacyKeyID: testAESKeyBase64, "k2": testRotatedKeyBase64},
+		ActiveHashKeyID: "h2",
+		HashKeys:        map[string]string{LegacyKeyID: testHashKeyBase64, "h2": testRotatedKeyBase64},
+	})
+	if err != nil {
+		t.Fatalf("new rotated codec failed: %v", err)
+	}
+	return codec
+}
+
+func TestDecryptWithRetiredKey(t *testing.T) {
+	oldCodec := newTestCodec(t)
+	newCodec := newRotatedCodec(t)
+
+	ciphertext, err := oldCodec.Encrypt(fieldRealName, "张三")
+	if err != nil {
+		t.Fatalf("encrypt failed: %v", err)
+	}
+	if !newCodec.NeedsReencrypt(ciphertext) {
+		t.Fatalf("ciphertext from retired key should need re-encrypt")
+	}
+
+	plaintext, err := newCodec.Decrypt(fieldRealName, ciphertext)
+	if err != nil {
+		t.Fatalf("decrypt with retired key failed: %v", err)
+	}
+	if plaintext != "张三" {
+		t.Fatalf("unexpected plaintext: %s", plaintext)
+	}
+
+	rotated, err := newCodec.Encrypt(fieldRealName, plaintext)
+	if err != nil {
+		t.Fatalf("re-encrypt failed: %v", err)
+	}
+	if newCodec.NeedsReencrypt(rotated) {
+		t.Fatalf("ciphertext from active key should not need re-encrypt: %s", rotated)
+	}
+	if _, err := oldCodec.Decrypt(fieldRealName, rotated); err == nil {
+		t.Fatalf("decrypt with unknown key id should fail")
+	}
+}
+
+func TestHashStudentIDCandidatesCoverRetiredKey(t *testing.T) {
+	oldCodec := newTestCodec(t)
+	newCodec := newRotatedCodec(t)
+
+	oldHash, err := oldCodec.HashStudentID("华中科技大学", "20230001")
+	if err != nil {
+		t.Fatalf("old hash failed: %v", err)
+	}
+	newHash, err := newCodec.HashStudentID("华中科技大学", "20230001")
+	if err != nil {
+		t.Fatalf("new hash failed: %v", err)
+	}
+	if oldHash == newHash {
+		t.Fatalf("rotated hash key should produce different hash")
+	}
+
+	candidates, err := newCodec.HashStudentIDCandidates("华中科技大学", "20230001")
+	if err != nil {
+		t.Fatalf("hash candidates failed: %v", err)
+	}
+	if len(candidates) != 2 || candidates[0] != newHash || candidates[1] != oldHash {
+		t.Fatalf("unexpected candidates: %v", candidates)
+	}
+}
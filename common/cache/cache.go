@@ -22,6 +22,9 @@ const (
 	// LongTTL 长缓存过期时间（30 分钟，适用于变化少的数据如分类列表）
 	LongTTL = 30 * time.Minute
 
+	// SuggestTTL 搜索建议缓存过期时间（1 分钟，输入联想对实时性要求不高，但前缀组合多）
+	SuggestTTL = 1 * time.Minute
+
 	// DefaultJitter 默认 TTL 抖动系数（±10%）
 	// 5min ± 10% = 4.5min ~ 5.5min
 	DefaultJitter = 0.1
@@ -84,6 +87,15 @@ func HotActivitiesKey() string {
 	return "activity:hot:top10"
 }
 
+// SuggestKey 搜索建议缓存 Key
+//
+// 格式：activity:suggest:{category_id}:{size}:{prefix}
+// TTL：1min
+// 用途：缓存输入联想结果，连续输入时相同前缀直接命中
+func SuggestKey(categoryID uint64, size int, prefix string) string {
+	return fmt.Sprintf("activity:suggest:%d:%d:%s", categoryID, size, prefix)
+}
+
 // ViewCountKey 浏览量防刷 Key
 //
 // 格式：activity:view:{activity_id}:{user_or_ip}
@@ -93,6 +105,62 @@ func ViewCountKey(activityID uint64, userOrIP string) string {
 	return fmt.Sprintf("activity:view:%d:%s", activityID, userOrIP)
 }
 
+// CalendarFeedKey 用户日历订阅缓存 Key
+//
+// 格式：activity:calendar:feed:{user_id}
+// TTL：30min
+// 用途：缓存渲染好的 iCalendar 订阅内容，报名/取消报名及活动变更时主动删除
+func CalendarFeedKey(userID uint64) string {
+	return fmt.Sprintf("activity:calendar:feed:%d", userID)
+}
+
+// 活动分析 Key（浏览埋点，由 AnalyticsCron 聚合写入 activity_daily_stats）
+
+// ActivityStatsPVKey 活动每日浏览次数 Key
+//
+// 格式：activity:stats:pv:{date}:{activity_id}
+// TTL：8 天（聚合任务最多回补 7 天）
+// 用途：INCR 计数，每次浏览都计入（不受浏览量防刷影响）
+func ActivityStatsPVKey(date string, activityID uint64) string {
+	return fmt.Sprintf("activity:stats:pv:%s:%d", date, activityID)
+}
+
+// ActivityStatsUVKey 活动每日浏览人数 Key
+//
+// 格式：activity:stats:uv:{date}:{activity_id}
+// TTL：8 天
+// 用途：HyperLogLog 记录访问者（user_id 或 IP）
+func ActivityStatsUVKey(date string, activityID uint64) string {
+	return fmt.Sprintf("activity:stats:uv:%s:%d", date, activityID)
+}
+
+// ActivityStatsTotalUVKey 活动累计浏览人数 Key
+//
+// 格式：activity:stats:uvtotal:{activity_id}
+// TTL：90 天（每次浏览续期）
+// 用途：HyperLogLog 记录活动全部访问者，按天 UV 相加会重复计算同一访问者
+func ActivityStatsTotalUVKey(activityID uint64) string {
+	return fmt.Sprintf("activity:stats:uvtotal:%d", activityID)
+}
+
+// ActivityStatsViewedKey 当天有浏览的活动集合 Key
+//
+// 格式：activity:stats:viewed:{date}
+// TTL：8 天
+// 用途：聚合任务据此确定当天需要统计的活动
+func ActivityStatsViewedKey(date string) string {
+	return fmt.Sprintf("activity:stats:viewed:%s", date)
+}
+
+// AnalyticsDoneKey 聚合完成标记 Key
+//
+// 格式：activity:analytics:done:{date}
+// TTL：8 天
+// 用途：已结束的日期聚合成功后标记，后续轮次不再重复聚合
+func AnalyticsDoneKey(date string) string {
+	return fmt.Sprintf("activity:analytics:done:%s", date)
+}
+
 // ==================== 缓存统计 Key ====================
 
 // CacheStatsKey 缓存统计 Key
@@ -105,6 +105,10 @@ const (
 	CreditChangeTypeHostSuccess int32 = 6
 	// CreditChangeTypeHostDelete 组织者违约（取消/删除已有报名活动）-> -10
 	CreditChangeTypeHostDelete int32 = 7
+	// CreditChangeTypeRecovery 信用修复（连续一段时间无违规）-> 分值由admin_delta指定
+	CreditChangeTypeRecovery int32 = 8
+	// CreditChangeTypeAppealReverse 申诉通过撤销扣分 -> 分值由admin_delta指定
+	CreditChangeTypeAppealReverse int32 = 9
 	// CreditChangeTypeAdminAdjust 管理员人工调整 -> 分值由admin_delta指定
 	CreditChangeTypeAdminAdjust int32 = 99
 )
@@ -122,14 +126,16 @@ var CreditChangeDeltas = map[int32]int{
 
 // CreditChangeTypeNames 信用变更类型名称映射
 var CreditChangeTypeNames = map[int]string{
-	int(CreditChangeTypeInit):        "注册初始化",
-	int(CreditChangeTypeCheckin):     "正常履约",
-	int(CreditChangeTypeCancelEarly): "提前取消",
-	int(CreditChangeTypeCancelLate):  "临期取消",
-	int(CreditChangeTypeNoShow):      "爽约",
-	int(CreditChangeTypeHostSuccess): "圆满举办",
-	int(CreditChangeTypeHostDelete):  "组织者违约",
-	int(CreditChangeTypeAdminAdjust): "管理员调整",
+	int(CreditChangeTypeInit):          "注册初始化",
+	int(CreditChangeTypeCheckin):       "正常履约",
+	int(CreditChangeTypeCancelEarly):   "提前取消",
+	int(CreditChangeTypeCancelLate):    "临期取消",
+	int(CreditChangeTypeNoShow):        "爽约",
+	int(CreditChangeTypeHostSuccess):   "圆满举办",
+	int(CreditChangeTypeHostDelete):    "组织者违约",
+	int(CreditChangeTypeRecovery):      "信用修复",
+	int(CreditChangeTypeAppealReverse): "申诉撤销",
+	int(CreditChangeTypeAdminAdjust):   "管理员调整",
 }
 
 // GetCreditChangeTypeName 获取信用变更类型名称
@@ -142,9 +148,10 @@ func GetCreditChangeTypeName(changeType int) string {
 
 // GetCreditDelta 获取信用变更类型对应的分值变动
 // changeType: 变更类型
-// adminDelta: 管理员调整时的自定义分值
+// adminDelta: 管理员调整、信用修复、申诉撤销时的自定义分值
 func GetCreditDelta(changeType int32, adminDelta int64) int {
-	if changeType == CreditChangeTypeAdminAdjust {
+	switch changeType {
+	case CreditChangeTypeAdminAdjust, CreditChangeTypeRecovery, CreditChangeTypeAppealReverse:
 		return int(adminDelta)
 	}
 	if delta, ok := CreditChangeDeltas[changeType]; ok {
@@ -167,6 +174,59 @@ const (
 	RiskUserDailyParticipateLimit = 1
 )
 
+// ==================== 信用修复 ====================
+// 连续 N 天无扣分记录的用户，每 N 天自动恢复少量分数，直至上限
+
+const (
+	// CreditRecoveryQuietDays 触发修复所需的连续无违规天数
+	CreditRecoveryQuietDays = 14
+	// CreditRecoveryPoints 每次修复恢复的分数
+	CreditRecoveryPoints = 3
+	// CreditRecoveryScoreCap 修复可达到的最高分数
+	CreditRecoveryScoreCap = CreditScoreMax
+)
+
+// ==================== 信用申诉 ====================
+
+const (
+	// CreditAppealStatusPending 待处理
+	CreditAppealStatusPending int8 = 1
+	// CreditAppealStatusAccepted 已通过（扣分已撤销）
+	CreditAppealStatusAccepted int8 = 2
+	// CreditAppealStatusRejected 已驳回
+	CreditAppealStatusRejected int8 = 3
+)
+
+// CreditAppealStatusNames 申诉状态名称映射
+var CreditAppealStatusNames = map[int8]string{
+	CreditAppealStatusPending:  "待处理",
+	CreditAppealStatusAccepted: "已通过",
+	CreditAppealStatusRejected: "已驳回",
+}
+
+const (
+	// CreditAppealWindowDays 扣分后可申诉的天数
+	CreditAppealWindowDays = 30
+	// CreditAppealMaxPending 每个用户同时待处理的申诉上限
+	CreditAppealMaxPending = 3
+	// CreditAppealReasonMaxLen 申诉理由最大长度（字符）
+	CreditAppealReasonMaxLen = 500
+)
+
+// ==================== 管理员调整与审计 ====================
+
+const (
+	// CreditAdminAdjustMaxDelta 管理员单次调整的最大绝对值
+	CreditAdminAdjustMaxDelta = 100
+
+	// CreditAuditActionAdjust 管理员手动调整
+	CreditAuditActionAdjust = "adjust"
+	// CreditAuditActionAppealAccept 申诉通过
+	CreditAuditActionAppealAccept = "appeal_accept"
+	// CreditAuditActionAppealReject 申诉驳回
+	CreditAuditActionAppealReject = "appeal_reject"
+)
+
 // 注意: Redis Key 常量统一定义在 common/constants/redis_key.go 中
 // - CacheUserCreditPrefix: 用户信用分缓存
 // - CacheRiskDailyCountPrefix: 风险用户每日报名计数
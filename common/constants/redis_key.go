@@ -62,6 +62,14 @@ const (
 	// 格式: verify:rate_limit:{userId}
 	VerifyRateLimitPrefix = "verify:rate_limit:"
 
+	// VerifyReviewClaimPrefix 人工审核领取Key前缀（值为审核员ID）
+	// 格式: verify:review:claim:{verifyId}
+	VerifyReviewClaimPrefix = "verify:review:claim:"
+
+	// VerifyRekeyCursorPrefix 敏感数据重新加密任务进度Key前缀（值为已处理的最大记录ID）
+	// 格式: verify:rekey:cursor:{activeKeyId}:{activeHashKeyId}
+	VerifyRekeyCursorPrefix = "verify:rekey:cursor:"
+
 	// ============ OCR服务 Redis Key ============
 
 	// OcrCircuitBreakerPrefix OCR熔断器Key前缀
@@ -71,6 +79,16 @@ const (
 	// OcrCircuitFailuresPrefix OCR失败计数Key前缀
 	// 格式: ocr:circuit:{provider}:failures
 	OcrCircuitFailuresPrefix = "ocr:circuit:failures:"
+
+	// ============ 平台统计 Redis Key ============
+
+	// StatsDAUPrefix 日活用户 HyperLogLog 前缀
+	// 格式: stats:dau:{date}（去重总数）/ stats:dau:{date}:{source}（按来源）
+	StatsDAUPrefix = "stats:dau:"
+
+	// StatsOcrCallsPrefix OCR调用计数 Hash 前缀（field: {provider}:success / {provider}:failure）
+	// 格式: stats:ocr:{date}
+	StatsOcrCallsPrefix = "stats:ocr:"
 )
 
 // ============ 缓存过期时间 ============
@@ -0,0 +1,81 @@
+/**
+ * @projectName: CampusHub
+ * @package: constants
+ * @className: stats
+ * @author: lijunqi
+ * @description: 平台统计（管理后台运营报表）相关常量定义
+ * @date: 2026-10-19
+ * @version: 1.0
+ */
+
+package constants
+
+import "time"
+
+// ==================== 统计指标 ====================
+// 统计表 stats_daily_metrics 以 日期 + 指标 + 维度 为唯一键，维度为空表示总量
+
+const (
+	// StatsMetricDAU 日活用户数（维度: 空=去重总数 / login / ws）
+	StatsMetricDAU = "dau"
+	// StatsMetricNewUsers 新注册用户数
+	StatsMetricNewUsers = "new_users"
+	// StatsMetricCreditLevel 信用等级分布（维度: 等级 0-4，每日快照）
+	StatsMetricCreditLevel = "credit_level"
+	// StatsMetricVerifyPassed 学生认证通过数
+	StatsMetricVerifyPassed = "verify_passed"
+	// StatsMetricVerifyRejected 学生认证拒绝数
+	StatsMetricVerifyRejected = "verify_rejected"
+	// StatsMetricVerifyPassRate 学生认证通过率（通过 / (通过 + 拒绝)，百分比）
+	StatsMetricVerifyPassRate = "verify_pass_rate"
+	// StatsMetricOcrCalls OCR调用次数（维度: {provider}:success / {provider}:failure）
+	StatsMetricOcrCalls = "ocr_calls"
+
+	// StatsMetricActivityPublished 活动发布数（维度: 空=总数 / 分类名称）
+	StatsMetricActivityPublished = "activity_published"
+	// StatsMetricActivityCancelled 活动取消数（维度: 空=总数 / 分类名称）
+	StatsMetricActivityCancelled = "activity_cancelled"
+	// StatsMetricActivityFinished 活动结束数（维度: 空=总数 / 分类名称）
+	StatsMetricActivityFinished = "activity_finished"
+
+	// StatsMetricChatMessages 聊天消息量（维度: 空=总数 / text / image）
+	StatsMetricChatMessages = "chat_messages"
+)
+
+// ==================== 统计来源 ====================
+
+const (
+	// StatsSourceUser 用户服务（本服务定时任务汇总）
+	StatsSourceUser = "user"
+	// StatsSourceActivity 活动服务（ReportDailyStats 上报）
+	StatsSourceActivity = "activity"
+	// StatsSourceChat 聊天服务（ReportDailyStats 上报）
+	StatsSourceChat = "chat"
+)
+
+// StatsReportMetrics 各上报来源允许写入的指标（防止上报方覆盖其他来源的数据）
+var StatsReportMetrics = map[string][]string{
+	StatsSourceActivity: {StatsMetricActivityPublished, StatsMetricActivityCancelled, StatsMetricActivityFinished},
+	StatsSourceChat:     {StatsMetricChatMessages},
+}
+
+// ==================== 日活来源 ====================
+
+const (
+	// StatsActiveSourceLogin 登录
+	StatsActiveSourceLogin = "login"
+	// StatsActiveSourceWS WebSocket 连接
+	StatsActiveSourceWS = "ws"
+)
+
+// ==================== 其他 ====================
+
+const (
+	// StatsDateLayout 统计日期格式
+	StatsDateLayout = "2006-01-02"
+	// StatsMaxQueryDays 单次查询最大天数
+	StatsMaxQueryDays = 366
+	// StatsRawDataTTL 日活 HyperLogLog、OCR 调用计数等原始数据保留时间
+	// 定时任务回溯天数不能超过该时间，否则已过期的原始数据无法重算
+	StatsRawDataTTL = 8 * 24 * time.Hour
+)
@@ -17,9 +17,10 @@ package constants
 // 状态流转图：
 //   0(初始) -> 1(OCR审核中)
 //   1(OCR审核中) -> 2(待确认) | 6(超时) | 7(取消) | 8(OCR失败)
-//   2(待确认) -> 4(通过) | 3(人工审核) | 7(取消)
+//   2(待确认) -> 4(通过) | 3(人工审核) | 5(拒绝) [OCR判定策略自动处理] | 7(取消)
 //   3(人工审核) -> 4(通过) | 5(拒绝) | 7(取消)
-//   5,6,7,8 -> 0(初始) [允许重新申请]
+//   4(通过) -> 9(已过期) [超过预计毕业时间]
+//   5,6,7,8,9 -> 0(初始) [允许重新申请]
 //
 // ============================================================================
 
@@ -43,6 +44,8 @@ const (
 	VerifyStatusCancelled int8 = 7
 	// VerifyStatusOcrFailed OCR失败（双OCR都失败，可重试）
 	VerifyStatusOcrFailed int8 = 8
+	// VerifyStatusExpired 已过期（超过预计毕业时间，需重新认证）
+	VerifyStatusExpired int8 = 9
 )
 
 // VerifyStatusNameMap 状态名称映射
@@ -56,6 +59,7 @@ var VerifyStatusNameMap = map[int8]string{
 	VerifyStatusTimeout:      "已超时",
 	VerifyStatusCancelled:    "已取消",
 	VerifyStatusOcrFailed:    "识别失败",
+	VerifyStatusExpired:      "已过期",
 }
 
 // GetVerifyStatusName 获取状态名称
@@ -71,12 +75,14 @@ func GetVerifyStatusName(status int8) string {
 var VerifyStatusTransitions = map[int8][]int8{
 	VerifyStatusInit:         {VerifyStatusOcrPending},
 	VerifyStatusOcrPending:   {VerifyStatusWaitConfirm, VerifyStatusOcrFailed, VerifyStatusTimeout, VerifyStatusCancelled},
-	VerifyStatusWaitConfirm:  {VerifyStatusPassed, VerifyStatusManualReview, VerifyStatusCancelled},
+	VerifyStatusWaitConfirm:  {VerifyStatusPassed, VerifyStatusManualReview, VerifyStatusRejected, VerifyStatusCancelled},
 	VerifyStatusManualReview: {VerifyStatusPassed, VerifyStatusRejected, VerifyStatusCancelled},
+	VerifyStatusPassed:       {VerifyStatusExpired},
 	VerifyStatusOcrFailed:    {VerifyStatusInit},
 	VerifyStatusRejected:     {VerifyStatusInit},
 	VerifyStatusTimeout:      {VerifyStatusInit},
 	VerifyStatusCancelled:    {VerifyStatusInit},
+	VerifyStatusExpired:      {VerifyStatusInit},
 }
 
 // CanApplyStatuses 可以提交申请的状态集合
@@ -86,6 +92,7 @@ var CanApplyStatuses = []int8{
 	VerifyStatusRejected,
 	VerifyStatusTimeout,
 	VerifyStatusCancelled,
+	VerifyStatusExpired,
 }
 
 // CanCancelStatuses 可以取消的状态集合
@@ -175,6 +182,8 @@ const (
 	VerifyActionTimeout = "识别超时，请重新提交"
 	// VerifyActionCancelled 已取消可重新申请
 	VerifyActionCancelled = "已取消，可重新申请"
+	// VerifyActionExpired 已过期需重新认证
+	VerifyActionExpired = "认证已过期，请重新认证"
 )
 
 // GetNeedAction 根据状态获取前端应执行的动作
@@ -198,6 +207,8 @@ func GetNeedAction(status int8) string {
 		return VerifyActionCancelled
 	case VerifyStatusOcrFailed:
 		return VerifyActionFailed
+	case VerifyStatusExpired:
+		return VerifyActionExpired
 	default:
 		return VerifyActionApply
 	}
@@ -219,6 +230,47 @@ const (
 
 	// VerifyRejectCooldownHours 拒绝后冷却期（小时）
 	VerifyRejectCooldownHours = 24
+
+	// VerifyReviewClaimTTL 人工审核领取有效期（秒），超时自动释放
+	VerifyReviewClaimTTL = 900
+
+	// VerifyReviewImageURLExpire 审核图片签名URL有效期（秒）
+	VerifyReviewImageURLExpire = 600
+
+	// VerifyRejectReasonMaxLen 拒绝原因最大长度（字符）
+	VerifyRejectReasonMaxLen = 255
+
+	// VerifyProgramYears 默认学制（年），用于推算预计毕业时间
+	VerifyProgramYears = 4
+
+	// VerifyGraduationMonth 默认毕业月份（预计毕业时间为该月月底）
+	VerifyGraduationMonth = 7
+
+	// VerifyExpiryRemindDays 默认过期前提醒天数
+	VerifyExpiryRemindDays = 30
+)
+
+// ============================================================================
+// 人工审核操作类型（审核日志）
+// ============================================================================
+
+const (
+	// VerifyReviewActionView 查看（读取解密后的敏感信息）
+	VerifyReviewActionView = "view"
+	// VerifyReviewActionClaim 领取
+	VerifyReviewActionClaim = "claim"
+	// VerifyReviewActionRelease 释放
+	VerifyReviewActionRelease = "release"
+	// VerifyReviewActionApprove 审核通过
+	VerifyReviewActionApprove = "approve"
+	// VerifyReviewActionReject 审核拒绝
+	VerifyReviewActionReject = "reject"
+	// VerifyReviewActionAutoApprove OCR判定策略自动通过
+	VerifyReviewActionAutoApprove = "auto_approve"
+	// VerifyReviewActionAutoReview OCR判定策略转人工审核
+	VerifyReviewActionAutoReview = "auto_review"
+	// VerifyReviewActionAutoReject OCR判定策略自动拒绝
+	VerifyReviewActionAutoReject = "auto_reject"
 )
 
 // ============================================================================
@@ -238,4 +290,8 @@ const (
 	VerifyOperatorUserConfirm = "user_confirm"
 	// VerifyOperatorUserCancel 用户取消
 	VerifyOperatorUserCancel = "user_cancel"
+	// VerifyOperatorExpiryJob 认证过期任务
+	VerifyOperatorExpiryJob = "expiry_job"
+	// VerifyOperatorAutoPolicy OCR判定策略
+	VerifyOperatorAutoPolicy = "auto_policy"
 )
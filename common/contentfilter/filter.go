@@ -0,0 +1,349 @@
+// Package contentfilter 提供敏感词过滤能力
+//
+// 设计要点：
+//   - 基于 Aho-Corasick 自动机，一次扫描匹配全部敏感词
+//   - 每个词条归属一个分类，处置动作按分类配置（替换 / 拦截 / 送审）
+//   - 词库存储在外部（见 Source），定时比对版本号热加载，加载失败沿用旧词库
+//
+// 使用方式：
+//
+//	filter := contentfilter.New(contentfilter.NewRedisSource(contentfilter.GoZeroRedis(rds)))
+//	filter.Start()
+//	defer filter.Stop()
+//
+//	result := filter.Check(text)
+//	switch result.Action {
+//	case contentfilter.ActionBlock:  // 拒绝
+//	case contentfilter.ActionFlag:   // 放行并记录待审核
+//	case contentfilter.ActionReplace: // 使用 result.Text
+//	}
+package contentfilter
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// ==================== 处置动作 ====================
+
+// Action 命中后的处置动作
+type Action string
+
+const (
+	ActionNone    Action = ""        // 未命中
+	ActionReplace Action = "replace" // 替换为掩码后放行
+	ActionFlag    Action = "flag"    // 原文放行，记录待人工审核
+	ActionBlock   Action = "block"   // 直接拒绝
+)
+
+// severity 动作严重程度（多个命中时取最严重的）
+func (a Action) severity() int {
+	switch a {
+	case ActionReplace:
+		return 1
+	case ActionFlag:
+		return 2
+	case ActionBlock:
+		return 3
+	default:
+		return 0
+	}
+}
+
+// ParseAction 解析动作（不识别时返回 false）
+func ParseAction(s string) (Action, bool) {
+	switch a := Action(strings.ToLower(strings.TrimSpace(s))); a {
+	case ActionReplace, ActionFlag, ActionBlock:
+		return a, true
+	default:
+		return ActionNone, false
+	}
+}
+
+// ==================== 词库与结果 ====================
+
+const (
+	// DefaultCategory 未指定分类时的默认分类
+	DefaultCategory = "default"
+
+	// DefaultReloadInterval 默认热加载检查间隔
+	DefaultReloadInterval = 30 * time.Second
+
+	// maskRune 替换掩码
+	maskRune = '*'
+)
+
+// Word 敏感词条
+type Word struct {
+	Word     string
+	Category string
+}
+
+// Hit 单个命中
+type Hit struct {
+	Word     string `json:"word"`     // 命中的词条
+	Category string `json:"category"` // 词条分类
+	Action   Action `json:"action"`   // 分类对应的动作
+	Start    int    `json:"start"`    // 原文 rune 下标（含）
+	End      int    `json:"end"`      // 原文 rune 下标（不含）
+}
+
+// Result 检测结果
+type Result struct {
+	Action Action // 最严重的动作（未命中为 ActionNone）
+	Text   string // 处理后的文本（替换类命中已掩码，其余保持原文）
+	Hits   []Hit  // 全部命中（按出现位置排序）
+}
+
+// Hit 是否有命中
+func (r *Result) Hit() bool {
+	return len(r.Hits) > 0
+}
+
+// Blocked 是否需要拒绝
+func (r *Result) Blocked() bool {
+	return r.Action == ActionBlock
+}
+
+// Flagged 是否需要人工审核
+func (r *Result) Flagged() bool {
+	return r.Action == ActionFlag
+}
+
+// Words 命中词条（去重，保持出现顺序）
+func (r *Result) Words() []string {
+	seen := make(map[string]struct{}, len(r.Hits))
+	words := make([]string, 0, len(r.Hits))
+	for _, h := range r.Hits {
+		if _, ok := seen[h.Word]; ok {
+			continue
+		}
+		seen[h.Word] = struct{}{}
+		words = append(words, h.Word)
+	}
+	return words
+}
+
+// ==================== Filter ====================
+
+// Options 过滤器选项
+type Options struct {
+	ReloadInterval time.Duration // 热加载检查间隔（默认 30s）
+	DefaultAction  Action        // 分类未配置动作时的默认动作（默认 replace）
+}
+
+// snapshot 词库快照（整体替换，读路径无锁）
+type snapshot struct {
+	version string
+	matcher *matcher
+	actions map[string]Action
+}
+
+// Filter 敏感词过滤器（并发安全）
+type Filter struct {
+	source  Source
+	opts    Options
+	current atomic.Pointer[snapshot]
+
+	reloadMu sync.Mutex
+	stopOnce sync.Once
+	stopCh   chan struct{}
+}
+
+// New 创建过滤器（初始为空词库，调用 Start 或 Reload 后生效）
+func New(source Source, opts ...Options) *Filter {
+	var o Options
+	if len(opts) > 0 {
+		o = opts[0]
+	}
+	if o.ReloadInterval <= 0 {
+		o.ReloadInterval = DefaultReloadInterval
+	}
+	if _, ok := ParseAction(string(o.DefaultAction)); !ok {
+		o.DefaultAction = ActionReplace
+	}
+
+	f := &Filter{
+		source: source,
+		opts:   o,
+		stopCh: make(chan struct{}),
+	}
+	f.current.Store(&snapshot{matcher: newMatcher(nil), actions: map[string]Action{}})
+	return f
+}
+
+// NewStatic 使用固定词库创建过滤器（不热加载，用于测试或内置词库）
+func NewStatic(words []Word, actions map[string]Action, opts ...Options) *Filter {
+	f := New(nil, opts...)
+	f.current.Store(&snapshot{matcher: newMatcher(words), actions: copyActions(actions)})
+	return f
+}
+
+// Start 立即加载一次词库，并启动后台热加载
+func (f *Filter) Start() {
+	if f.source == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	if _, err := f.Reload(ctx); err != nil {
+		logx.Errorf("[ContentFilter] 首次加载词库失败（空词库运行，稍后重试）: %v", err)
+	}
+	cancel()
+
+	go func() {
+		ticker := time.NewTicker(f.opts.ReloadInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				if _, err := f.Reload(ctx); err != nil {
+					logx.Errorf("[ContentFilter] 热加载词库失败，沿用旧词库: %v", err)
+				}
+				cancel()
+			case <-f.stopCh:
+				return
+			}
+		}
+	}()
+}
+
+// Stop 停止后台热加载
+func (f *Filter) Stop() {
+	f.stopOnce.Do(func() {
+		close(f.stopCh)
+	})
+}
+
+// Reload 检查版本号，有变化时重新加载词库
+//
+// 返回是否发生了重新加载
+func (f *Filter) Reload(ctx context.Context) (bool, error) {
+	if f.source == nil {
+		return false, nil
+	}
+
+	f.reloadMu.Lock()
+	defer f.reloadMu.Unlock()
+
+	version, err := f.source.Version(ctx)
+	if err != nil {
+		return false, err
+	}
+	cur := f.current.Load()
+	if cur.version != "" && cur.version == version {
+		return false, nil
+	}
+
+	words, actions, err := f.source.Load(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	f.current.Store(&snapshot{
+		version: version,
+		matcher: newMatcher(words),
+		actions: copyActions(actions),
+	})
+	logx.Infof("[ContentFilter] 词库已加载: version=%s, words=%d, categories=%d",
+		version, len(words), len(actions))
+	return true, nil
+}
+
+// Size 当前词条数量
+func (f *Filter) Size() int {
+	return f.current.Load().matcher.size()
+}
+
+// Check 检测文本
+func (f *Filter) Check(text string) *Result {
+	result := &Result{Text: text}
+	if text == "" {
+		return result
+	}
+
+	snap := f.current.Load()
+	matches := snap.matcher.find(text)
+	if len(matches) == 0 {
+		return result
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].start != matches[j].start {
+			return matches[i].start < matches[j].start
+		}
+		return matches[i].end > matches[j].end
+	})
+
+	var masked []rune
+	result.Hits = make([]Hit, 0, len(matches))
+	for _, m := range matches {
+		e := snap.matcher.entries[m.entry]
+		action := f.actionOf(snap, e.category)
+		result.Hits = append(result.Hits, Hit{
+			Word:     e.word,
+			Category: e.category,
+			Action:   action,
+			Start:    m.start,
+			End:      m.end,
+		})
+		if action.severity() > result.Action.severity() {
+			result.Action = action
+		}
+		if action == ActionReplace {
+			if masked == nil {
+				masked = []rune(text)
+			}
+			for i := m.start; i < m.end; i++ {
+				if !isSeparator(masked[i]) {
+					masked[i] = maskRune
+				}
+			}
+		}
+	}
+	if masked != nil {
+		result.Text = string(masked)
+	}
+	return result
+}
+
+// CheckAll 检测多段文本，返回每段的结果及合并后的最严重动作
+func (f *Filter) CheckAll(texts ...string) ([]*Result, Action) {
+	results := make([]*Result, len(texts))
+	action := ActionNone
+	for i, text := range texts {
+		results[i] = f.Check(text)
+		if results[i].Action.severity() > action.severity() {
+			action = results[i].Action
+		}
+	}
+	return results, action
+}
+
+// actionOf 分类对应的动作
+func (f *Filter) actionOf(snap *snapshot, category string) Action {
+	if category == "" {
+		category = DefaultCategory
+	}
+	if action, ok := snap.actions[category]; ok {
+		return action
+	}
+	return f.opts.DefaultAction
+}
+
+// copyActions 复制分类动作表（过滤无效动作）
+func copyActions(actions map[string]Action) map[string]Action {
+	out := make(map[string]Action, len(actions))
+	for category, action := range actions {
+		if a, ok := ParseAction(string(action)); ok {
+			out[category] = a
+		}
+	}
+	return out
+}
@@ -0,0 +1,235 @@
+package contentfilter
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+const (
+	testCategoryAbuse    = "abuse"
+	testCategoryAd       = "ad"
+	testCategoryPolitics = "politics"
+)
+
+var testActions = map[string]Action{
+	testCategoryAbuse:    ActionReplace,
+	testCategoryAd:       ActionFlag,
+	testCategoryPolitics: ActionBlock,
+}
+
+// hitKeys 将命中转为 "词条@起-止" 便于比较
+func hitKeys(hits []Hit) []string {
+	keys := make([]string, 0, len(hits))
+	for _, h := range hits {
+		keys = append(keys, fmt.Sprintf("%s@%d-%d", h.Word, h.Start, h.End))
+	}
+	return keys
+}
+
+func TestFilterCheck(t *testing.T) {
+	tests := []struct {
+		name       string
+		words      []Word
+		text       string
+		wantAction Action
+		wantText   string
+		wantHits   []string
+	}{
+		{
+			name:       "未命中",
+			words:      []Word{{Word: "敏感词", Category: testCategoryAbuse}},
+			text:       "今天天气不错",
+			wantAction: ActionNone,
+			wantText:   "今天天气不错",
+		},
+		{
+			name:       "替换命中",
+			words:      []Word{{Word: "敏感词", Category: testCategoryAbuse}},
+			text:       "这是敏感词呀",
+			wantAction: ActionReplace,
+			wantText:   "这是***呀",
+			wantHits:   []string{"敏感词@2-5"},
+		},
+		{
+			name:       "重叠命中合并掩码",
+			words:      []Word{{Word: "abc", Category: testCategoryAbuse}, {Word: "bcd", Category: testCategoryAbuse}},
+			text:       "xabcdx",
+			wantAction: ActionReplace,
+			wantText:   "x****x",
+			wantHits:   []string{"abc@1-4", "bcd@2-5"},
+		},
+		{
+			name:       "包含关系按起点升序、长词在前",
+			words:      []Word{{Word: "坏人", Category: testCategoryAbuse}, {Word: "坏人坏事", Category: testCategoryAbuse}},
+			text:       "坏人坏事",
+			wantAction: ActionReplace,
+			wantText:   "****",
+			wantHits:   []string{"坏人坏事@0-4", "坏人@0-2"},
+		},
+		{
+			name: "fail 链输出后缀词条",
+			words: []Word{
+				{Word: "he", Category: testCategoryAbuse},
+				{Word: "she", Category: testCategoryAbuse},
+				{Word: "his", Category: testCategoryAbuse},
+				{Word: "hers", Category: testCategoryAbuse},
+			},
+			text:       "ushers",
+			wantAction: ActionReplace,
+			wantText:   "u*****",
+			wantHits:   []string{"she@1-4", "hers@2-6", "he@2-4"},
+		},
+		{
+			name:       "词内分隔符跳过且保留原样",
+			words:      []Word{{Word: "敏感词", Category: testCategoryAbuse}},
+			text:       "这是敏 感-词！",
+			wantAction: ActionReplace,
+			wantText:   "这是* *-*！",
+			wantHits:   []string{"敏感词@2-7"},
+		},
+		{
+			name:       "词条本身含分隔符",
+			words:      []Word{{Word: "敏-感 词", Category: testCategoryAbuse}},
+			text:       "敏感词",
+			wantAction: ActionReplace,
+			wantText:   "***",
+			wantHits:   []string{"敏-感 词@0-3"},
+		},
+		{
+			name:       "大小写与全角归一化",
+			words:      []Word{{Word: "Spam", Category: testCategoryAbuse}},
+			text:       "SPAM ｓｐａｍ",
+			wantAction: ActionReplace,
+			wantText:   "**** ****",
+			wantHits:   []string{"Spam@0-4", "Spam@5-9"},
+		},
+		{
+			name: "混合分类取最严重动作，仅替换类掩码",
+			words: []Word{
+				{Word: "笨蛋", Category: testCategoryAbuse},
+				{Word: "加微信", Category: testCategoryAd},
+				{Word: "禁词", Category: testCategoryPolitics},
+			},
+			text:       "笨蛋加微信禁词",
+			wantAction: ActionBlock,
+			wantText:   "**加微信禁词",
+			wantHits:   []string{"笨蛋@0-2", "加微信@2-5", "禁词@5-7"},
+		},
+		{
+			name:       "送审类原文放行",
+			words:      []Word{{Word: "加微信", Category: testCategoryAd}},
+			text:       "请加微信",
+			wantAction: ActionFlag,
+			wantText:   "请加微信",
+			wantHits:   []string{"加微信@1-4"},
+		},
+		{
+			name:       "未配置动作的分类使用默认动作",
+			words:      []Word{{Word: "广告"}, {Word: "推广", Category: "unknown"}},
+			text:       "广告推广",
+			wantAction: ActionReplace,
+			wantText:   "****",
+			wantHits:   []string{"广告@0-2", "推广@2-4"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := NewStatic(tt.words, testActions).Check(tt.text)
+			if result.Action != tt.wantAction {
+				t.Fatalf("action = %q, want %q", result.Action, tt.wantAction)
+			}
+			if result.Text != tt.wantText {
+				t.Fatalf("text = %q, want %q", result.Text, tt.wantText)
+			}
+			if got := hitKeys(result.Hits); len(got) != len(tt.wantHits) || (len(got) > 0 && !reflect.DeepEqual(got, tt.wantHits)) {
+				t.Fatalf("hits = %v, want %v", got, tt.wantHits)
+			}
+		})
+	}
+}
+
+func TestFilterHitActions(t *testing.T) {
+	f := NewStatic([]Word{
+		{Word: "笨蛋", Category: testCategoryAbuse},
+		{Word: "加微信", Category: testCategoryAd},
+		{Word: "推广"},
+	}, testActions, Options{DefaultAction: ActionFlag})
+
+	result := f.Check("笨蛋推广加微信")
+	want := []Hit{
+		{Word: "笨蛋", Category: testCategoryAbuse, Action: ActionReplace, Start: 0, End: 2},
+		{Word: "推广", Category: "", Action: ActionFlag, Start: 2, End: 4},
+		{Word: "加微信", Category: testCategoryAd, Action: ActionFlag, Start: 4, End: 7},
+	}
+	if !reflect.DeepEqual(result.Hits, want) {
+		t.Fatalf("hits = %+v, want %+v", result.Hits, want)
+	}
+	if !result.Flagged() || result.Blocked() {
+		t.Fatalf("action = %q, want flag", result.Action)
+	}
+	if result.Text != "**推广加微信" {
+		t.Fatalf("text = %q, want %q", result.Text, "**推广加微信")
+	}
+}
+
+func TestNewStaticIgnoresEmptyAndDuplicateWords(t *testing.T) {
+	f := NewStatic([]Word{
+		{Word: "敏感词"},
+		{Word: "敏 感 词"},
+		{Word: "  "},
+		{Word: ""},
+		{Word: "ABC"},
+		{Word: "abc"},
+	}, nil)
+	if f.Size() != 2 {
+		t.Fatalf("size = %d, want 2", f.Size())
+	}
+}
+
+func TestResultWordsDeduplicates(t *testing.T) {
+	f := NewStatic([]Word{{Word: "笨蛋"}, {Word: "坏人"}}, nil)
+	result := f.Check("笨蛋坏人笨蛋")
+	if got := result.Words(); !reflect.DeepEqual(got, []string{"笨蛋", "坏人"}) {
+		t.Fatalf("words = %v, want [笨蛋 坏人]", got)
+	}
+	if !result.Hit() || len(result.Hits) != 3 {
+		t.Fatalf("hits = %d, want 3", len(result.Hits))
+	}
+}
+
+func TestFilterCheckAllMergesSeverity(t *testing.T) {
+	f := NewStatic([]Word{
+		{Word: "笨蛋", Category: testCategoryAbuse},
+		{Word: "加微信", Category: testCategoryAd},
+	}, testActions)
+
+	results, action := f.CheckAll("你是笨蛋", "正常内容", "快加微信")
+	if action != ActionFlag {
+		t.Fatalf("merged action = %q, want flag", action)
+	}
+	if len(results) != 3 || results[0].Text != "你是**" || results[1].Hit() {
+		t.Fatalf("unexpected results: %+v, %+v", results[0], results[1])
+	}
+}
+
+func TestParseAction(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Action
+		ok   bool
+	}{
+		{"replace", ActionReplace, true},
+		{" Block ", ActionBlock, true},
+		{"FLAG", ActionFlag, true},
+		{"drop", ActionNone, false},
+		{"", ActionNone, false},
+	}
+	for _, tt := range tests {
+		got, ok := ParseAction(tt.in)
+		if got != tt.want || ok != tt.ok {
+			t.Fatalf("ParseAction(%q) = (%q, %v), want (%q, %v)", tt.in, got, ok, tt.want, tt.ok)
+		}
+	}
+}
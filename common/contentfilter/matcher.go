@@ -0,0 +1,187 @@
+package contentfilter
+
+import "unicode"
+
+// ==================== Aho-Corasick 自动机 ====================
+//
+// 以 rune 为单位构建，一次扫描即可找出文本中所有敏感词（含重叠命中），
+// 复杂度 O(len(text) + 命中数)，与词库大小无关。
+//
+// 归一化规则（构建与匹配一致）：
+//   - 字母统一转小写（"Ab" 与 "ab" 等价）
+//   - 全角字符转半角（"ＡＢ" 与 "ab" 等价）
+//   - 匹配时跳过空白、标点、符号（"敏 感-词" 与 "敏感词" 等价），命中区间按原文计算
+
+// acNode 自动机节点
+type acNode struct {
+	children map[rune]int32
+	fail     int32
+	outputs  []int32 // 以该节点结尾的词条下标（含 fail 链上的词条）
+}
+
+// matcher Aho-Corasick 匹配器（构建后只读，可并发使用）
+type matcher struct {
+	nodes   []acNode
+	entries []entry
+}
+
+// entry 词条
+type entry struct {
+	word     string
+	category string
+	length   int // 归一化后的 rune 数
+}
+
+// match 单次命中（rune 下标，左闭右开，基于原文）
+type match struct {
+	entry int32
+	start int
+	end   int
+}
+
+// newMatcher 构建匹配器（空词条、重复词条自动忽略）
+func newMatcher(words []Word) *matcher {
+	m := &matcher{nodes: []acNode{{}}}
+	seen := make(map[string]struct{}, len(words))
+
+	for _, w := range words {
+		key := normalizeWord(w.Word)
+		if key == "" {
+			continue
+		}
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+
+		runes := []rune(key)
+		cur := int32(0)
+		for _, r := range runes {
+			next, ok := m.nodes[cur].children[r]
+			if !ok {
+				next = int32(len(m.nodes))
+				m.nodes = append(m.nodes, acNode{})
+				if m.nodes[cur].children == nil {
+					m.nodes[cur].children = make(map[rune]int32)
+				}
+				m.nodes[cur].children[r] = next
+			}
+			cur = next
+		}
+		m.nodes[cur].outputs = append(m.nodes[cur].outputs, int32(len(m.entries)))
+		m.entries = append(m.entries, entry{word: w.Word, category: w.Category, length: len(runes)})
+	}
+
+	m.buildFailLinks()
+	return m
+}
+
+// buildFailLinks BFS 构建 fail 指针，并沿 fail 链合并输出
+func (m *matcher) buildFailLinks() {
+	queue := make([]int32, 0, len(m.nodes))
+	for _, child := range m.nodes[0].children {
+		m.nodes[child].fail = 0
+		queue = append(queue, child)
+	}
+
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+
+		for r, child := range m.nodes[cur].children {
+			fail := m.nodes[cur].fail
+			for {
+				if next, ok := m.nodes[fail].children[r]; ok && next != child {
+					m.nodes[child].fail = next
+					break
+				}
+				if fail == 0 {
+					m.nodes[child].fail = 0
+					break
+				}
+				fail = m.nodes[fail].fail
+			}
+			if out := m.nodes[m.nodes[child].fail].outputs; len(out) > 0 {
+				m.nodes[child].outputs = append(m.nodes[child].outputs, out...)
+			}
+			queue = append(queue, child)
+		}
+	}
+}
+
+// size 词条数量
+func (m *matcher) size() int {
+	return len(m.entries)
+}
+
+// find 查找文本中的全部命中
+func (m *matcher) find(text string) []match {
+	if len(m.entries) == 0 || text == "" {
+		return nil
+	}
+
+	// positions[i] 为归一化后第 i 个字符在原文中的 rune 下标
+	runes := []rune(text)
+	normalized := make([]rune, 0, len(runes))
+	positions := make([]int, 0, len(runes))
+	for i, r := range runes {
+		if isSeparator(r) {
+			continue
+		}
+		normalized = append(normalized, normalizeRune(r))
+		positions = append(positions, i)
+	}
+
+	var matches []match
+	cur := int32(0)
+	for i, r := range normalized {
+		for {
+			if next, ok := m.nodes[cur].children[r]; ok {
+				cur = next
+				break
+			}
+			if cur == 0 {
+				break
+			}
+			cur = m.nodes[cur].fail
+		}
+		for _, idx := range m.nodes[cur].outputs {
+			startIdx := i - m.entries[idx].length + 1
+			matches = append(matches, match{
+				entry: idx,
+				start: positions[startIdx],
+				end:   positions[i] + 1,
+			})
+		}
+	}
+	return matches
+}
+
+// normalizeWord 归一化词条（去除分隔符）
+func normalizeWord(word string) string {
+	runes := make([]rune, 0, len(word))
+	for _, r := range word {
+		if isSeparator(r) {
+			continue
+		}
+		runes = append(runes, normalizeRune(r))
+	}
+	return string(runes)
+}
+
+// normalizeRune 全角转半角 + 小写
+func normalizeRune(r rune) rune {
+	switch {
+	case r == 0x3000:
+		r = ' '
+	case r >= 0xFF01 && r <= 0xFF5E:
+		r -= 0xFEE0
+	}
+	return unicode.ToLower(r)
+}
+
+// isSeparator 匹配时跳过的字符
+func isSeparator(r rune) bool {
+	r = normalizeRune(r)
+	return unicode.IsSpace(r) || unicode.IsPunct(r) || unicode.IsSymbol(r)
+}
@@ -0,0 +1,161 @@
+package contentfilter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	goredis "github.com/redis/go-redis/v9"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+// ==================== 词库来源 ====================
+
+// Source 词库来源
+type Source interface {
+	// Version 词库版本号（变化时触发重新加载）
+	Version(ctx context.Context) (string, error)
+	// Load 加载全部词条及分类动作
+	Load(ctx context.Context) ([]Word, map[string]Action, error)
+}
+
+// ==================== Redis 词库 ====================
+//
+// Key 设计（前缀默认 contentfilter）：
+//
+//	contentfilter:words       Hash  field=敏感词 value=分类
+//	contentfilter:categories  Hash  field=分类   value=动作（replace / block / flag）
+//	contentfilter:version     String 版本号，维护词库后 INCR 触发各实例热加载
+//
+// 维护示例：
+//
+//	HSET contentfilter:categories ad flag politics block
+//	HSET contentfilter:words 加微信 ad 代开发票 ad
+//	INCR contentfilter:version
+
+const defaultKeyPrefix = "contentfilter"
+
+// KV 词库读取所需的 Redis 操作（屏蔽 go-zero redis 与 go-redis 的差异）
+type KV interface {
+	Get(ctx context.Context, key string) (string, error)
+	HGetAll(ctx context.Context, key string) (map[string]string, error)
+}
+
+// RedisSource Redis 词库来源
+type RedisSource struct {
+	kv     KV
+	prefix string
+}
+
+// NewRedisSource 创建 Redis 词库来源（prefix 为空时使用 contentfilter）
+func NewRedisSource(kv KV, prefix ...string) *RedisSource {
+	p := defaultKeyPrefix
+	if len(prefix) > 0 && prefix[0] != "" {
+		p = prefix[0]
+	}
+	return &RedisSource{kv: kv, prefix: p}
+}
+
+// WordsKey 词条 Hash Key
+func (s *RedisSource) WordsKey() string {
+	return s.prefix + ":words"
+}
+
+// CategoriesKey 分类动作 Hash Key
+func (s *RedisSource) CategoriesKey() string {
+	return s.prefix + ":categories"
+}
+
+// VersionKey 版本号 Key
+func (s *RedisSource) VersionKey() string {
+	return s.prefix + ":version"
+}
+
+// Version 实现 Source
+func (s *RedisSource) Version(ctx context.Context) (string, error) {
+	v, err := s.kv.Get(ctx, s.VersionKey())
+	if err != nil {
+		return "", fmt.Errorf("读取词库版本失败: %w", err)
+	}
+	if v == "" {
+		// 未设置版本号时视为初始版本，保证至少加载一次
+		v = "0"
+	}
+	return v, nil
+}
+
+// Load 实现 Source
+func (s *RedisSource) Load(ctx context.Context) ([]Word, map[string]Action, error) {
+	rawWords, err := s.kv.HGetAll(ctx, s.WordsKey())
+	if err != nil {
+		return nil, nil, fmt.Errorf("读取敏感词失败: %w", err)
+	}
+	rawActions, err := s.kv.HGetAll(ctx, s.CategoriesKey())
+	if err != nil {
+		return nil, nil, fmt.Errorf("读取敏感词分类失败: %w", err)
+	}
+
+	words := make([]Word, 0, len(rawWords))
+	for word, category := range rawWords {
+		word = strings.TrimSpace(word)
+		if word == "" {
+			continue
+		}
+		category = strings.TrimSpace(category)
+		if category == "" {
+			category = DefaultCategory
+		}
+		words = append(words, Word{Word: word, Category: category})
+	}
+
+	actions := make(map[string]Action, len(rawActions))
+	for category, raw := range rawActions {
+		if action, ok := ParseAction(raw); ok {
+			actions[strings.TrimSpace(category)] = action
+		}
+	}
+	return words, actions, nil
+}
+
+// ==================== Redis 客户端适配 ====================
+
+// goZeroKV go-zero redis 适配
+type goZeroKV struct {
+	rds *redis.Redis
+}
+
+// GoZeroRedis 适配 go-zero redis 客户端
+func GoZeroRedis(rds *redis.Redis) KV {
+	return goZeroKV{rds: rds}
+}
+
+func (k goZeroKV) Get(ctx context.Context, key string) (string, error) {
+	return k.rds.GetCtx(ctx, key)
+}
+
+func (k goZeroKV) HGetAll(ctx context.Context, key string) (map[string]string, error) {
+	return k.rds.HgetallCtx(ctx, key)
+}
+
+// goRedisKV go-redis v9 适配
+type goRedisKV struct {
+	client goredis.UniversalClient
+}
+
+// GoRedis 适配 go-redis v9 客户端
+func GoRedis(client goredis.UniversalClient) KV {
+	return goRedisKV{client: client}
+}
+
+func (k goRedisKV) Get(ctx context.Context, key string) (string, error) {
+	v, err := k.client.Get(ctx, key).Result()
+	if errors.Is(err, goredis.Nil) {
+		return "", nil
+	}
+	return v, err
+}
+
+func (k goRedisKV) HGetAll(ctx context.Context, key string) (map[string]string, error) {
+	return k.client.HGetAll(ctx, key).Result()
+}
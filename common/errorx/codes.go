@@ -54,13 +54,18 @@ const (
 	CodeRefreshTokenExpired  = 2056 // 刷新令牌已过期或不存在
 
 	// 用户服务 - 信用分 2101-2120
-	CodeCreditNotFound      = 2101 // 信用记录不存在
-	CodeCreditAlreadyInit   = 2102 // 信用分已初始化
-	CodeCreditBlacklist     = 2103 // 用户在黑名单中
-	CodeCreditRiskLimit     = 2104 // 风险用户已达每日限制
-	CodeCreditCannotPublish = 2105 // 信用分不足，无法发布
-	CodeCreditSourceDup     = 2106 // 信用变更来源重复
-	CodeCreditInvalidChange = 2107 // 无效的信用变更类型
+	CodeCreditNotFound         = 2101 // 信用记录不存在
+	CodeCreditAlreadyInit      = 2102 // 信用分已初始化
+	CodeCreditBlacklist        = 2103 // 用户在黑名单中
+	CodeCreditRiskLimit        = 2104 // 风险用户已达每日限制
+	CodeCreditCannotPublish    = 2105 // 信用分不足，无法发布
+	CodeCreditSourceDup        = 2106 // 信用变更来源重复
+	CodeCreditInvalidChange    = 2107 // 无效的信用变更类型
+	CodeCreditAppealNotFound   = 2108 // 申诉记录不存在
+	CodeCreditAppealExists     = 2109 // 该记录已申诉
+	CodeCreditAppealNotAllowed = 2110 // 该记录不可申诉
+	CodeCreditAppealReviewed   = 2111 // 申诉已处理
+	CodeCreditAppealLimit      = 2112 // 待处理申诉过多
 
 	// 用户服务 - 学生认证 2201-2230
 	CodeVerifyNotFound          = 2201 // 认证记录不存在
@@ -78,6 +83,7 @@ const (
 	CodeVerifyImageTooLarge     = 2213 // 图片大小超出限制
 	CodeVerifyFrontImageMissing = 2214 // 学生证正面照片缺失
 	CodeVerifyBackImageMissing  = 2215 // 学生证详情面照片缺失
+	CodeVerifyReviewClaimed     = 2216 // 认证记录已被其他审核员领取
 
 	// 用户服务 - OCR识别 2231-2250
 	CodeOcrNetworkTimeout      = 2231 // OCR服务网络超时
@@ -89,7 +95,10 @@ const (
 	CodeOcrConfigInvalid       = 2237 // OCR配置无效
 
 	// 用户服务 - 标签业务 2401-2420
-	CodeUserTagUpdateFailed = 2401 // 用户标签更新失败
+	CodeUserTagUpdateFailed     = 2401 // 用户标签更新失败
+	CodeInterestTagNotFound     = 2402 // 兴趣标签不存在
+	CodeInterestTagNameExists   = 2403 // 标签名称已存在
+	CodeInterestTagMergeInvalid = 2404 // 标签合并参数无效
 
 	// 用户服务 - 验证码与消息 2501-2550
 	CodeCaptchaNotFound        = 2501 // 验证码不存在或已过期
@@ -107,15 +116,53 @@ const (
 	CodeActivityConcurrentUpdate = 3004 // 活动并发更新冲突
 	CodeActivityPermissionDenied = 3005 // 无权限操作此活动
 	CodeActivityHasRegistration  = 3006 // 有报名记录不能删除
+	CodeActivityContentViolation = 3007 // 活动内容包含违规词
 
 	// 活动服务 - 分类 3101-3120
-	CodeCategoryNotFound = 3101 // 分类不存在
-	CodeCategoryDisabled = 3102 // 分类已禁用
+	CodeCategoryNotFound   = 3101 // 分类不存在
+	CodeCategoryDisabled   = 3102 // 分类已禁用
+	CodeCategoryNameExists = 3103 // 分类名称已存在
+	CodeCategoryInUse      = 3104 // 分类下仍有活动
 
 	// 活动服务 - 标签 3201-3220
 	CodeTagNotFound      = 3201 // 标签不存在
 	CodeTagLimitExceeded = 3202 // 标签数量超过限制
 
+	// 活动服务 - 评价 3301-3320
+	CodeFeedbackNotAllowed     = 3301 // 未参加活动不能评价
+	CodeFeedbackWindowClosed   = 3302 // 评价时间已过
+	CodeFeedbackReviewNotFound = 3303 // 短评审核记录不存在或已处理
+
+	// 活动服务 - 发布审核 3401-3420
+	CodeActivityReviewNotFound = 3401 // 审核任务不存在
+	CodeActivityReviewAssigned = 3402 // 审核任务已分配给其他审核员
+
+	// 活动服务 - 变更申请 3421-3440
+	CodeActivityChangeNotFound = 3421 // 变更申请不存在
+	CodeActivityChangePending  = 3422 // 已有待审核的变更申请
+	CodeActivityChangeEmpty    = 3423 // 变更内容与当前一致
+
+	// 活动服务 - 系列活动 3441-3460
+	CodeActivitySeriesNotFound       = 3441 // 系列活动不存在
+	CodeActivitySeriesRuleInvalid    = 3442 // 重复规则无效
+	CodeActivitySeriesClosed         = 3443 // 系列活动已结束或已取消
+	CodeActivitySeriesRegisterClosed = 3444 // 系列未开放整期报名
+
+	// 活动服务 - 日历订阅 3461-3470
+	CodeCalendarFeedInvalid = 3461 // 日历订阅链接无效
+
+	// 活动服务 - 自助签到 3471-3490
+	CodeSelfCheckInDisabled      = 3471 // 活动未开启自助签到
+	CodeSelfCheckInNoLocation    = 3472 // 活动未设置坐标，无法开启自助签到
+	CodeSelfCheckInCodeInvalid   = 3473 // 签到码错误或已过期
+	CodeSelfCheckInOutOfRange    = 3474 // 不在签到范围内
+	CodeSelfCheckInTicketInvalid = 3475 // 票券无效或已签到
+	CodeSelfCheckInWindowClosed  = 3476 // 不在签到时间内
+
+	// 活动服务 - 活动模板 3491-3500
+	CodeActivityTemplateNotFound = 3491 // 活动模板不存在或已下架
+	CodeActivityTemplateLimit    = 3492 // 个人模板数量已达上限
+
 	// 用户服务 - 文件服务 2301-2350
 	CodeFileTooLarge     = 2301 // 文件超过大小限制
 	CodeFileTypeInvalid  = 2302 // 文件类型不支持
@@ -186,17 +233,22 @@ var codeMessages = map[int]string{
 	CodeRefreshTokenInvalid:  "无效的刷新令牌",
 	CodeRefreshTokenExpired:  "刷新令牌已过期或不存在",
 
-	CodeCreditNotFound:      "信用记录不存在",
-	CodeCreditAlreadyInit:   "信用分已初始化",
-	CodeCreditBlacklist:     "您的账户信用分过低，已被限制操作",
-	CodeCreditRiskLimit:     "您的信用分处于风险区间，每日仅限报名1次",
-	CodeCreditCannotPublish: "信用分不足90分，暂时无法发布活动",
-	CodeCreditSourceDup:     "该操作已处理，请勿重复提交",
-	CodeCreditInvalidChange: "无效的信用变更类型",
-	CodeVerifyNotFound:      "认证记录不存在",
-	CodeVerifyAlreadyExist:  "认证记录已存在",
-	CodeVerifyNotVerified:   "请先完成学生认证",
-	CodeVerifyStudentIDUsed: "该学号已被其他用户认证",
+	CodeCreditNotFound:         "信用记录不存在",
+	CodeCreditAlreadyInit:      "信用分已初始化",
+	CodeCreditBlacklist:        "您的账户信用分过低，已被限制操作",
+	CodeCreditRiskLimit:        "您的信用分处于风险区间，每日仅限报名1次",
+	CodeCreditCannotPublish:    "信用分不足90分，暂时无法发布活动",
+	CodeCreditSourceDup:        "该操作已处理，请勿重复提交",
+	CodeCreditInvalidChange:    "无效的信用变更类型",
+	CodeCreditAppealNotFound:   "申诉记录不存在",
+	CodeCreditAppealExists:     "该信用记录已提交过申诉",
+	CodeCreditAppealNotAllowed: "该信用记录不支持申诉",
+	CodeCreditAppealReviewed:   "申诉已处理，请勿重复操作",
+	CodeCreditAppealLimit:      "待处理的申诉过多，请等待处理结果",
+	CodeVerifyNotFound:         "认证记录不存在",
+	CodeVerifyAlreadyExist:     "认证记录已存在",
+	CodeVerifyNotVerified:      "请先完成学生认证",
+	CodeVerifyStudentIDUsed:    "该学号已被其他用户认证",
 	// 文件服务
 	CodeFileTooLarge:     "文件大小超过限制",
 	CodeFileTypeInvalid:  "不支持的文件类型",
@@ -204,16 +256,40 @@ var codeMessages = map[int]string{
 	CodeFileDeleteFailed: "文件删除失败，请稍后重试",
 	CodeFileConfigError:  "文件服务配置错误，请联系管理员",
 	// 活动服务
-	CodeActivityNotFound:         "活动不存在",
-	CodeActivityStatusInvalid:    "活动状态不允许此操作",
-	CodeActivityTimeInvalid:      "活动时间设置无效",
-	CodeActivityConcurrentUpdate: "操作冲突，请重试",
-	CodeActivityPermissionDenied: "无权限操作此活动",
-	CodeActivityHasRegistration:  "有报名记录的活动不能删除",
-	CodeCategoryNotFound:         "分类不存在",
-	CodeCategoryDisabled:         "分类已禁用",
-	CodeTagNotFound:              "标签不存在",
-	CodeTagLimitExceeded:         "最多选择5个标签",
+	CodeActivityNotFound:             "活动不存在",
+	CodeActivityStatusInvalid:        "活动状态不允许此操作",
+	CodeActivityTimeInvalid:          "活动时间设置无效",
+	CodeActivityConcurrentUpdate:     "操作冲突，请重试",
+	CodeActivityPermissionDenied:     "无权限操作此活动",
+	CodeActivityHasRegistration:      "有报名记录的活动不能删除",
+	CodeActivityContentViolation:     "活动内容包含违规词，请修改后重试",
+	CodeCategoryNotFound:             "分类不存在",
+	CodeCategoryDisabled:             "分类已禁用",
+	CodeCategoryNameExists:           "分类名称已存在",
+	CodeCategoryInUse:                "该分类下仍有活动，不能删除，可改为禁用",
+	CodeTagNotFound:                  "标签不存在",
+	CodeTagLimitExceeded:             "最多选择5个标签",
+	CodeFeedbackNotAllowed:           "仅签到参加活动的用户可以评价",
+	CodeFeedbackWindowClosed:         "活动评价时间已过",
+	CodeFeedbackReviewNotFound:       "短评审核记录不存在或已处理",
+	CodeActivityReviewNotFound:       "审核任务不存在或已处理",
+	CodeActivityReviewAssigned:       "该审核任务已分配给其他审核员",
+	CodeActivityChangeNotFound:       "变更申请不存在或已处理",
+	CodeActivityChangePending:        "该活动已有待审核的变更申请，请等待审核结果",
+	CodeActivityChangeEmpty:          "变更内容与当前活动信息一致",
+	CodeActivitySeriesNotFound:       "系列活动不存在",
+	CodeActivitySeriesRuleInvalid:    "重复规则无效",
+	CodeActivitySeriesClosed:         "系列活动已结束或已取消",
+	CodeActivitySeriesRegisterClosed: "该系列活动未开放整期报名",
+	CodeCalendarFeedInvalid:          "日历订阅链接无效或已重置",
+	CodeSelfCheckInDisabled:          "该活动未开启自助签到，请联系组织者扫码签到",
+	CodeSelfCheckInNoLocation:        "活动未设置地点坐标，无法开启自助签到",
+	CodeSelfCheckInCodeInvalid:       "签到码错误或已过期，请以现场屏幕为准",
+	CodeSelfCheckInOutOfRange:        "您不在活动签到范围内",
+	CodeSelfCheckInTicketInvalid:     "票券无效或已签到",
+	CodeSelfCheckInWindowClosed:      "当前不在签到时间内",
+	CodeActivityTemplateNotFound:     "活动模板不存在或已下架",
+	CodeActivityTemplateLimit:        "个人模板数量已达上限，请删除不用的模板后再保存",
 	// 聊天服务 - 群组
 	CodeGroupNotFound:         "群组不存在",
 	CodeGroupPermissionDenied: "无权限操作此群组",
@@ -250,6 +326,7 @@ var codeMessages = map[int]string{
 	CodeVerifyImageTooLarge:     "图片大小超出限制（最大5MB）",
 	CodeVerifyFrontImageMissing: "请上传学生证正面照片",
 	CodeVerifyBackImageMissing:  "请上传学生证详情面照片",
+	CodeVerifyReviewClaimed:     "该认证记录正由其他审核员处理",
 	CodeOcrNetworkTimeout:       "识别服务繁忙，请稍后重试",
 	CodeOcrImageInvalid:         "图片无效，请上传清晰的学生证照片",
 	CodeOcrRecognizeFailed:      "识别失败，请重新上传照片",
@@ -259,7 +336,10 @@ var codeMessages = map[int]string{
 	CodeOcrConfigInvalid:        "识别服务配置错误，请联系管理员",
 
 	// 用户标签
-	CodeUserTagUpdateFailed: "用户标签更新失败，请稍后重试",
+	CodeUserTagUpdateFailed:     "用户标签更新失败，请稍后重试",
+	CodeInterestTagNotFound:     "标签不存在",
+	CodeInterestTagNameExists:   "标签名称已存在",
+	CodeInterestTagMergeInvalid: "不能合并到自身或已禁用的标签",
 
 	// 验证码与消息
 	CodeCaptchaNotFound:        "验证码不存在或已过期",
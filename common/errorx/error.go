@@ -211,6 +211,16 @@ func ErrCreditSourceDup() *BizError {
 	return New(CodeCreditSourceDup)
 }
 
+// ErrCreditAppealNotFound 申诉记录不存在
+func ErrCreditAppealNotFound() *BizError {
+	return New(CodeCreditAppealNotFound)
+}
+
+// ErrCreditAppealReviewed 申诉已处理
+func ErrCreditAppealReviewed() *BizError {
+	return New(CodeCreditAppealReviewed)
+}
+
 // ============ 学生认证相关错误 ============
 
 // ErrVerifyNotFound 认证记录不存在
@@ -268,6 +278,11 @@ func ErrVerifyRejectCooldown() *BizError {
 	return New(CodeVerifyRejectCooldown)
 }
 
+// ErrVerifyReviewClaimed 认证记录已被其他审核员领取
+func ErrVerifyReviewClaimed() *BizError {
+	return New(CodeVerifyReviewClaimed)
+}
+
 // ============ OCR识别相关错误 ============
 
 // ErrOcrNetworkTimeout OCR服务网络超时
@@ -5,10 +5,13 @@ import "time"
 // ==================== Topic 定义 ====================
 
 const (
-	TopicActivityCreated      = "activity.created"
-	TopicActivityMemberJoined = "activity.member.joined"
-	TopicActivityMemberLeft   = "activity.member.left"
-	TopicActivityCancelled    = "activity.cancelled"
+	TopicActivityCreated        = "activity.created"
+	TopicActivityMemberJoined   = "activity.member.joined"
+	TopicActivityMemberLeft     = "activity.member.left"
+	TopicActivityCancelled      = "activity.cancelled"
+	TopicActivityReviewed       = "activity.reviewed"
+	TopicActivityChangeReviewed = "activity.change.reviewed"
+	TopicActivityReminder       = "activity.reminder"
 
 	TopicGroupMemberAdded   = "chat.group.member.added"
 	TopicGroupMemberRemoved = "chat.group.member.removed"
@@ -52,9 +55,63 @@ type ActivityCancelledEvent struct {
 	CancelledAt time.Time `json:"cancelled_at"`
 }
 
+// ActivityReviewedEvent 活动发布审核结果事件
+// 消费者：Chat MQ（通知组织者审核结果）
+type ActivityReviewedEvent struct {
+	ActivityID  uint64    `json:"activity_id"`
+	OrganizerID uint64    `json:"organizer_id"`
+	Title       string    `json:"title"`
+	Approved    bool      `json:"approved"`
+	Reason      string    `json:"reason"` // 驳回原因（通过时为空）
+	ReviewedAt  time.Time `json:"reviewed_at"`
+}
+
 // GroupMemberChangedEvent 群成员变更事件
 // 消费者：WS 服务（自动订阅/取消订阅群聊实时消息）
 type GroupMemberChangedEvent struct {
 	GroupID string `json:"group_id"`
 	UserID  uint64 `json:"user_id"`
 }
+
+// ActivityFieldChange 活动字段变更（展示用文本）
+type ActivityFieldChange struct {
+	Label    string `json:"label"`
+	OldValue string `json:"old_value"`
+	NewValue string `json:"new_value"`
+}
+
+// ActivityChangeReviewedEvent 已发布活动变更申请审核结果事件
+// 消费者：Chat MQ（通知组织者审核结果；通过时逐一通知报名者新旧取值与无责取消截止时间）
+type ActivityChangeReviewedEvent struct {
+	ActivityID      uint64                `json:"activity_id"`
+	OrganizerID     uint64                `json:"organizer_id"`
+	Title           string                `json:"title"`
+	Approved        bool                  `json:"approved"`
+	Reason          string                `json:"reason"`         // 通过：组织者填写的变更原因；驳回：驳回原因
+	Changes         []ActivityFieldChange `json:"changes"`        // 变更明细
+	RegistrantIDs   []uint64              `json:"registrant_ids"` // 需通知的报名者（仅通过时）
+	FreeCancelUntil time.Time             `json:"free_cancel_until"`
+	ReviewedAt      time.Time             `json:"reviewed_at"`
+}
+
+// ==================== 定时提醒 ====================
+
+// 提醒类型
+const (
+	ReminderKindStart24h        = "start_24h"        // 活动开始前 24 小时（报名者）
+	ReminderKindStart1h         = "start_1h"         // 活动开始前 1 小时（报名者）
+	ReminderKindRegisterClosing = "register_closing" // 报名即将截止（收藏未报名者）
+)
+
+// ActivityReminderEvent 活动定时提醒事件
+// 消费者：Chat MQ（创建系统通知并经 notification:push 推送 WS）
+type ActivityReminderEvent struct {
+	ActivityID      uint64    `json:"activity_id"`
+	UserID          uint64    `json:"user_id"`
+	Kind            string    `json:"kind"` // 提醒类型（ReminderKind*）
+	Title           string    `json:"title"`
+	Location        string    `json:"location"`
+	StartTime       time.Time `json:"start_time"`
+	RegisterEndTime time.Time `json:"register_end_time"`
+	FiredAt         time.Time `json:"fired_at"`
+}
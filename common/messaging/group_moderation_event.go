@@ -0,0 +1,30 @@
+package messaging
+
+const (
+	// TopicGroupModeration 群管理操作（禁言/全员禁言/踢人/公告/管理员变更）
+	// 消费者：WS 服务（刷新发言权限缓存并向群内广播）
+	TopicGroupModeration = "chat.group.moderation"
+)
+
+// 群管理操作类型
+const (
+	GroupModerationMute         = "mute"         // 禁言成员
+	GroupModerationUnmute       = "unmute"       // 解除成员禁言
+	GroupModerationMuteAll      = "mute_all"     // 全员禁言
+	GroupModerationUnmuteAll    = "unmute_all"   // 解除全员禁言
+	GroupModerationKick         = "kick"         // 踢出成员
+	GroupModerationAnnouncement = "announcement" // 设置群公告
+	GroupModerationSetAdmin     = "set_admin"    // 设为管理员
+	GroupModerationUnsetAdmin   = "unset_admin"  // 取消管理员
+)
+
+// GroupModerationEvent 群管理事件
+type GroupModerationEvent struct {
+	GroupID    string `json:"group_id"`
+	Action     string `json:"action"`
+	OperatorID uint64 `json:"operator_id"`
+	UserID     uint64 `json:"user_id,omitempty"`     // 目标成员（群级操作为 0）
+	MutedUntil int64  `json:"muted_until,omitempty"` // 禁言截止时间（mute）
+	Content    string `json:"content,omitempty"`     // 公告内容（announcement）
+	Timestamp  int64  `json:"timestamp"`
+}
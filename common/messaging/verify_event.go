@@ -25,6 +25,10 @@ const (
 	// TopicVerifyEvent 认证事件消息队列 Topic
 	// 用于 User RPC 发布认证申请事件，User MQ 消费并处理 OCR
 	TopicVerifyEvent = "verify:events"
+
+	// TopicVerifyExpiry 认证过期事件 Topic
+	// 用于 User RPC 过期任务发布过期提醒/已过期事件，Chat MQ 消费并发送系统通知
+	TopicVerifyExpiry = "verify:expiry"
 )
 
 // ==================== 事件类型常量 ====================
@@ -70,6 +74,33 @@ type VerifyApplyEventData struct {
 	TraceID string `json:"trace_id,omitempty"`
 }
 
+// VerifyExpiry 事件类型
+const (
+	// VerifyExpiryRemind 即将过期提醒
+	VerifyExpiryRemind = "remind"
+	// VerifyExpiryExpired 已过期
+	VerifyExpiryExpired = "expired"
+)
+
+// VerifyExpiryEventData 认证过期事件数据
+// 由 User RPC 过期任务发布，Chat MQ 消费并创建系统通知
+type VerifyExpiryEventData struct {
+	// UserID 用户ID
+	UserID int64 `json:"user_id"`
+
+	// VerifyID 认证记录ID
+	VerifyID int64 `json:"verify_id"`
+
+	// Type 事件类型：remind / expired
+	Type string `json:"type"`
+
+	// ExpireAt 过期时间（Unix 秒级时间戳）
+	ExpireAt int64 `json:"expire_at"`
+
+	// Timestamp 事件发生时间（Unix 秒级时间戳）
+	Timestamp int64 `json:"timestamp"`
+}
+
 // ==================== 辅助函数 ====================
 
 // ValidVerifyEventTypes 所有有效的认证事件类型
@@ -41,6 +41,10 @@ const (
 	SourceTypeHostDelete = "host_delete"
 	// SourceTypeAdminAdjust 管理员调整
 	SourceTypeAdminAdjust = "admin_adjust"
+	// SourceTypeRecovery 信用修复
+	SourceTypeRecovery = "recovery"
+	// SourceTypeAppealReverse 申诉撤销
+	SourceTypeAppealReverse = "appeal_reverse"
 )
 
 // GenSourceID 生成幂等来源ID
@@ -111,3 +115,15 @@ func GenHostDeleteSourceID(activityID int64) string {
 func GenAdminAdjustSourceID(userID int64) string {
 	return GenSourceIDWithSub(SourceTypeAdminAdjust, userID, time.Now().UnixMilli())
 }
+
+// GenRecoverySourceID 生成信用修复的来源ID
+// 格式: recovery:{userID}:{day}（day 为 Unix 天数，同一天多实例重复执行只生效一次）
+func GenRecoverySourceID(userID int64, now time.Time) string {
+	return GenSourceIDWithSub(SourceTypeRecovery, userID, now.Unix()/86400)
+}
+
+// GenAppealReverseSourceID 生成申诉撤销的来源ID
+// 格式: appeal_reverse:{creditLogID}（同一条扣分记录最多撤销一次）
+func GenAppealReverseSourceID(creditLogID int64) string {
+	return GenSourceID(SourceTypeAppealReverse, creditLogID)
+}
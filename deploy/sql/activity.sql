@@ -83,6 +83,10 @@ CREATE TABLE IF NOT EXISTS `activities` (
     `view_count` INT UNSIGNED NOT NULL DEFAULT 0 COMMENT '浏览量',
     `like_count` INT UNSIGNED NOT NULL DEFAULT 0 COMMENT '点赞数',
 
+    -- 评价汇总（提交评价时按全量重新统计）
+    `rating_avg` DECIMAL(3,2) NOT NULL DEFAULT 0 COMMENT '平均评分',
+    `rating_count` INT UNSIGNED NOT NULL DEFAULT 0 COMMENT '评价人数',
+
     -- 乐观锁
     `version` INT UNSIGNED NOT NULL DEFAULT 0 COMMENT '乐观锁版本号',
 
@@ -96,7 +100,8 @@ CREATE TABLE IF NOT EXISTS `activities` (
     KEY `idx_status_start` (`status`, `activity_start_time`),
     KEY `idx_organizer` (`organizer_id`),
     KEY `idx_created_at` (`created_at`),
-    KEY `idx_deleted_at` (`deleted_at`)
+    KEY `idx_deleted_at` (`deleted_at`),
+    KEY `idx_geo` (`latitude`, `longitude`)
 ) ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8mb4 COMMENT='活动表';
 
 -- 3. activity_tags 活动-标签关联表
@@ -121,7 +126,8 @@ CREATE TABLE IF NOT EXISTS `activity_status_logs` (
     `reason` VARCHAR(500) NOT NULL DEFAULT '' COMMENT '变更原因',
     `created_at` BIGINT NOT NULL DEFAULT 0 COMMENT '创建时间',
     PRIMARY KEY (`id`),
-    KEY `idx_activity_created` (`activity_id`, `created_at`)
+    KEY `idx_activity_created` (`activity_id`, `created_at`),
+    KEY `idx_created_at` (`created_at`)
 ) ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8mb4 COMMENT='活动状态变更日志表';
 
 -- 5. tag_cache 标签缓存表（从用户服务同步）
@@ -191,6 +197,11 @@ CREATE TABLE IF NOT EXISTS `check_in_records` (
     `check_in_time` bigint NOT NULL DEFAULT 0 COMMENT '核销时间',
     `longitude` decimal(10,7) DEFAULT NULL COMMENT '经度',
     `latitude` decimal(10,7) DEFAULT NULL COMMENT '纬度',
+    `check_in_method` tinyint NOT NULL DEFAULT 1 COMMENT '签到方式: 1扫码 2自助',
+    `device_id` varchar(64) NOT NULL DEFAULT '' COMMENT '设备标识',
+    `distance_meters` int NOT NULL DEFAULT 0 COMMENT '签到位置距活动地点(米)',
+    `flagged` tinyint(1) NOT NULL DEFAULT 0 COMMENT '是否疑似异常',
+    `flag_reason` varchar(255) NOT NULL DEFAULT '' COMMENT '异常原因',
     `client_request_id` varchar(64) NOT NULL COMMENT '请求ID(幂等)',
     `check_in_snapshot` text COMMENT '核销快照(JSON字符串)',
     `created_at` bigint NOT NULL DEFAULT 0 COMMENT '创建时间',
@@ -200,6 +211,7 @@ CREATE TABLE IF NOT EXISTS `check_in_records` (
     UNIQUE KEY `uk_client_request_id` (`client_request_id`),
     KEY `idx_ticket_id` (`ticket_id`),
     KEY `idx_activity_id` (`activity_id`),
+    KEY `idx_activity_device` (`activity_id`, `device_id`),
     KEY `idx_user_id` (`user_id`)
 ) ENGINE=InnoDB AUTO_INCREMENT=1 COMMENT='核销记录表';
 
@@ -239,3 +251,337 @@ CREATE TABLE IF NOT EXISTS `dtm_barrier` (
     UNIQUE KEY `uk_gid_branchid_op_barrierid` (`gid`, `branch_id`, `op`, `barrier_id`),
     KEY `idx_create_time` (`create_time`)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 ROW_FORMAT=DYNAMIC COMMENT='DTM子事务屏障表';
+
+-- 11. activity_change_events 活动变更事件表（Outbox）
+-- 所有修改 activities 的写入方在同一事务内插入一条事件，
+-- 由 activity-rpc 的 ChangeRelay 轮询消费：同步 ES、删除详情缓存、刷新热门/推荐缓存
+CREATE TABLE IF NOT EXISTS `activity_change_events` (
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '事件ID',
+    `activity_id` BIGINT UNSIGNED NOT NULL COMMENT '活动ID',
+    `change_type` VARCHAR(32) NOT NULL COMMENT '变更类型: create/update/status/delete/restore/cover/rating/taxonomy/participants',
+    `source` VARCHAR(32) NOT NULL DEFAULT '' COMMENT '写入方（如 activity-rpc、user-rpc、status-cron）',
+    `retry_count` INT UNSIGNED NOT NULL DEFAULT 0 COMMENT '消费失败次数',
+    `next_retry_at` BIGINT NOT NULL DEFAULT 0 COMMENT '下次允许消费的时间（失败退避，只推迟该事件）',
+    `last_error` VARCHAR(500) NOT NULL DEFAULT '' COMMENT '最近一次消费失败原因',
+    `created_at` BIGINT NOT NULL DEFAULT 0 COMMENT '创建时间',
+    PRIMARY KEY (`id`),
+    KEY `idx_retry_id` (`retry_count`, `id`),
+    KEY `idx_activity_id` (`activity_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='活动变更事件表（Outbox）';
+
+-- 12. activity_eligibility_rules 活动报名资格规则表
+-- 组织者为活动声明的报名条件，报名时逐条校验（规则之间为 AND，同一规则的取值之间为 OR）
+CREATE TABLE IF NOT EXISTS `activity_eligibility_rules` (
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '规则ID',
+    `activity_id` BIGINT UNSIGNED NOT NULL COMMENT '活动ID',
+    `rule_type` VARCHAR(32) NOT NULL COMMENT '规则类型: min_credit/verified_only/school/department/admission_year',
+    `min_value` INT NOT NULL DEFAULT 0 COMMENT '下限（信用分/入学年份，0=不限）',
+    `max_value` INT NOT NULL DEFAULT 0 COMMENT '上限（入学年份，0=不限）',
+    `rule_values` VARCHAR(1000) NOT NULL DEFAULT '' COMMENT '取值列表（JSON数组，学校/院系名称）',
+    `created_at` BIGINT NOT NULL DEFAULT 0 COMMENT '创建时间',
+    PRIMARY KEY (`id`),
+    KEY `idx_activity_id` (`activity_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='活动报名资格规则表';
+
+-- 13. activity_content_reviews 活动内容审核记录表
+-- 活动标题/详情、评价短评命中敏感词时写入（拦截、替换、送审均记录），送审记录等待管理员处理
+CREATE TABLE IF NOT EXISTS `activity_content_reviews` (
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '记录ID',
+    `activity_id` BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '活动ID（创建时被拦截为0）',
+    `organizer_id` BIGINT UNSIGNED NOT NULL COMMENT '组织者ID',
+    `feedback_id` BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '评价ID（仅评价短评场景）',
+    `scene` VARCHAR(20) NOT NULL COMMENT '检测场景: create/update/submit/feedback',
+    `action` VARCHAR(10) NOT NULL COMMENT '处置动作: replace/flag/block',
+    `title` VARCHAR(100) NOT NULL DEFAULT '' COMMENT '检测时的标题原文',
+    `hits` VARCHAR(2000) NOT NULL DEFAULT '' COMMENT '命中明细（JSON数组）',
+    `status` TINYINT NOT NULL DEFAULT 0 COMMENT '审核状态: 0-待审核 1-审核通过 2-审核驳回 3-已自动处置',
+    `reviewer_id` BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '审核人ID',
+    `review_note` VARCHAR(500) NOT NULL DEFAULT '' COMMENT '审核备注',
+    `reviewed_at` BIGINT NOT NULL DEFAULT 0 COMMENT '审核时间',
+    `created_at` BIGINT NOT NULL DEFAULT 0 COMMENT '创建时间',
+    PRIMARY KEY (`id`),
+    KEY `idx_scene_status_id` (`scene`, `status`, `id`),
+    KEY `idx_activity_id` (`activity_id`),
+    KEY `idx_feedback_id` (`feedback_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='活动内容审核记录表';
+
+-- 14. activity_feedbacks 活动评价表
+-- 活动结束后评价窗口内，已核销票据的参与者每人一条（重复提交视为修改）
+CREATE TABLE IF NOT EXISTS `activity_feedbacks` (
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '评价ID',
+    `activity_id` BIGINT UNSIGNED NOT NULL COMMENT '活动ID',
+    `user_id` BIGINT UNSIGNED NOT NULL COMMENT '评价用户ID',
+    `organizer_id` BIGINT UNSIGNED NOT NULL COMMENT '组织者ID（冗余，用于组织者评分汇总）',
+    `rating` TINYINT NOT NULL COMMENT '评分(1-5)',
+    `comment` VARCHAR(500) NOT NULL DEFAULT '' COMMENT '短评（已按敏感词处置）',
+    `comment_status` TINYINT NOT NULL DEFAULT 0 COMMENT '短评状态: 0-公开 1-待审核 2-已隐藏',
+    `created_at` BIGINT NOT NULL DEFAULT 0 COMMENT '创建时间',
+    `updated_at` BIGINT NOT NULL DEFAULT 0 COMMENT '更新时间',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_activity_user` (`activity_id`, `user_id`),
+    KEY `idx_organizer_id` (`organizer_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='活动评价表';
+
+-- 15. activity_reviews 活动发布审核单表
+-- 每次提交发布生成一条：信用等级达标的组织者按策略自动通过，其余进入人工审核队列（带 SLA 截止时间）；
+-- 审核通过时保存内容快照，下次送审时与之对比生成字段差异
+CREATE TABLE IF NOT EXISTS `activity_reviews` (
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '审核单ID',
+    `activity_id` BIGINT UNSIGNED NOT NULL COMMENT '活动ID',
+    `organizer_id` BIGINT UNSIGNED NOT NULL COMMENT '组织者ID',
+    `status` TINYINT NOT NULL DEFAULT 0 COMMENT '审核状态: 0-待审核 1-人工通过 2-驳回 3-策略自动通过',
+    `policy_reason` VARCHAR(200) NOT NULL DEFAULT '' COMMENT '策略判定说明',
+    `flagged` TINYINT(1) NOT NULL DEFAULT 0 COMMENT '内容是否命中送审词',
+    `submitted_at` BIGINT NOT NULL COMMENT '提交时间',
+    `due_at` BIGINT NOT NULL COMMENT 'SLA截止时间',
+    `assignee_id` BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '分配的审核员ID',
+    `assigned_at` BIGINT NOT NULL DEFAULT 0 COMMENT '分配时间',
+    `reviewer_id` BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '审核人ID（自动通过为0）',
+    `reason` VARCHAR(500) NOT NULL DEFAULT '' COMMENT '驳回原因',
+    `decided_at` BIGINT NOT NULL DEFAULT 0 COMMENT '审核时间',
+    `snapshot` TEXT COMMENT '审核通过时的活动内容快照（JSON）',
+    `created_at` BIGINT NOT NULL DEFAULT 0 COMMENT '创建时间',
+    `updated_at` BIGINT NOT NULL DEFAULT 0 COMMENT '更新时间',
+    PRIMARY KEY (`id`),
+    KEY `idx_activity_id` (`activity_id`),
+    KEY `idx_status_due` (`status`, `due_at`),
+    KEY `idx_assignee_id` (`assignee_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='活动发布审核单表';
+
+-- 16. activity_change_requests 已发布活动变更申请表
+-- 已发布活动的时间/地点不能直接修改，组织者提交申请后由管理员重新审核；
+-- 生效后重算票据核销时间窗、通知全部报名者，并开启无责取消窗口（窗口内取消按提前取消处理）
+CREATE TABLE IF NOT EXISTS `activity_change_requests` (
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '申请ID',
+    `activity_id` BIGINT UNSIGNED NOT NULL COMMENT '活动ID',
+    `organizer_id` BIGINT UNSIGNED NOT NULL COMMENT '组织者ID',
+    `status` TINYINT NOT NULL DEFAULT 0 COMMENT '状态: 0-待审核 1-已生效 2-已驳回',
+    `reason` VARCHAR(500) NOT NULL COMMENT '变更原因',
+    `old_values` TEXT COMMENT '变更前字段（JSON）',
+    `new_values` TEXT COMMENT '变更后字段（JSON）',
+    `reviewer_id` BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '审核人ID',
+    `reject_reason` VARCHAR(500) NOT NULL DEFAULT '' COMMENT '驳回原因',
+    `decided_at` BIGINT NOT NULL DEFAULT 0 COMMENT '审核时间',
+    `free_cancel_until` BIGINT NOT NULL DEFAULT 0 COMMENT '无责取消截止时间',
+    `created_at` BIGINT NOT NULL DEFAULT 0 COMMENT '创建时间',
+    `updated_at` BIGINT NOT NULL DEFAULT 0 COMMENT '更新时间',
+    PRIMARY KEY (`id`),
+    KEY `idx_activity_status` (`activity_id`, `status`),
+    KEY `idx_created_at` (`created_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='已发布活动变更申请表';
+
+-- 17. activity_favorites 活动收藏表
+-- 收藏但未报名的用户在报名截止前收到提醒（提醒任务存放在 Redis 延迟队列）
+CREATE TABLE IF NOT EXISTS `activity_favorites` (
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '收藏ID',
+    `activity_id` BIGINT UNSIGNED NOT NULL COMMENT '活动ID',
+    `user_id` BIGINT UNSIGNED NOT NULL COMMENT '用户ID',
+    `created_at` BIGINT NOT NULL DEFAULT 0 COMMENT '收藏时间',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_activity_user` (`activity_id`, `user_id`),
+    KEY `idx_user_id` (`user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='活动收藏表';
+
+-- 18. activity_series 系列活动表
+-- 保存活动模板与重复规则（RRULE 子集），场次由 SeriesCron 提前生成为普通活动
+CREATE TABLE IF NOT EXISTS `activity_series` (
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '系列ID',
+    `organizer_id` BIGINT UNSIGNED NOT NULL COMMENT '组织者ID',
+    `status` TINYINT NOT NULL DEFAULT 1 COMMENT '状态: 1生成中 2已排完 3已取消',
+    `rrule` VARCHAR(255) NOT NULL COMMENT '重复规则(RRULE子集)',
+    `first_start_time` BIGINT NOT NULL COMMENT '首场开始时间(锚点)',
+    `duration_seconds` BIGINT NOT NULL COMMENT '每场时长(秒)',
+    `register_open_before` BIGINT NOT NULL COMMENT '开始前多久开放报名(秒)',
+    `register_close_before` BIGINT NOT NULL COMMENT '开始前多久截止报名(秒)',
+    `allow_series_register` TINYINT(1) NOT NULL DEFAULT 0 COMMENT '是否允许整期报名',
+    `generated_count` INT NOT NULL DEFAULT 0 COMMENT '已处理的场次数(含跳过)',
+    `last_generated_start_at` BIGINT NOT NULL DEFAULT 0 COMMENT '最近生成场次的开始时间',
+    `title` VARCHAR(100) NOT NULL COMMENT '活动标题',
+    `description` TEXT COMMENT '活动详情',
+    `cover_image_id` BIGINT NOT NULL DEFAULT 0 COMMENT '封面图片ID',
+    `cover_type` TINYINT NOT NULL DEFAULT 1 COMMENT '封面类型',
+    `category_id` BIGINT UNSIGNED NOT NULL COMMENT '分类ID',
+    `contact_phone` VARCHAR(20) NOT NULL DEFAULT '' COMMENT '联系电话',
+    `location` VARCHAR(200) NOT NULL COMMENT '活动地点',
+    `address_detail` VARCHAR(500) NOT NULL DEFAULT '' COMMENT '详细地址',
+    `longitude` DECIMAL(10,7) NOT NULL DEFAULT 0 COMMENT '经度',
+    `latitude` DECIMAL(10,7) NOT NULL DEFAULT 0 COMMENT '纬度',
+    `max_participants` INT UNSIGNED NOT NULL DEFAULT 0 COMMENT '每场人数上限',
+    `require_approval` TINYINT(1) NOT NULL DEFAULT 0 COMMENT '报名是否需要审核',
+    `require_student_verify` TINYINT(1) NOT NULL DEFAULT 0 COMMENT '是否需要学生认证',
+    `min_credit_score` INT NOT NULL DEFAULT 0 COMMENT '最低信用分',
+    `tag_ids` VARCHAR(100) NOT NULL DEFAULT '' COMMENT '标签ID(JSON)',
+    `organizer_name` VARCHAR(50) NOT NULL DEFAULT '' COMMENT '组织者昵称快照',
+    `organizer_avatar` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '组织者头像快照',
+    `created_at` BIGINT NOT NULL DEFAULT 0 COMMENT '创建时间',
+    `updated_at` BIGINT NOT NULL DEFAULT 0 COMMENT '更新时间',
+    PRIMARY KEY (`id`),
+    KEY `idx_organizer` (`organizer_id`),
+    KEY `idx_status` (`status`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='系列活动表';
+
+-- 19. activity_series_occurrences 系列场次表
+-- (series_id, occurrence_index) 唯一：先占位再创建活动，防止重复生成
+CREATE TABLE IF NOT EXISTS `activity_series_occurrences` (
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '场次ID',
+    `series_id` BIGINT UNSIGNED NOT NULL COMMENT '系列ID',
+    `occurrence_index` INT NOT NULL COMMENT '场次序号',
+    `activity_id` BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '活动ID(0表示生成中)',
+    `start_time` BIGINT NOT NULL COMMENT '场次开始时间',
+    `overridden` TINYINT(1) NOT NULL DEFAULT 0 COMMENT '是否被单独修改(整期修改不覆盖)',
+    `created_at` BIGINT NOT NULL DEFAULT 0 COMMENT '创建时间',
+    `updated_at` BIGINT NOT NULL DEFAULT 0 COMMENT '更新时间',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_series_index` (`series_id`, `occurrence_index`),
+    KEY `idx_activity_id` (`activity_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='系列场次表';
+
+-- 20. activity_series_subscriptions 整期报名表
+CREATE TABLE IF NOT EXISTS `activity_series_subscriptions` (
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT 'ID',
+    `series_id` BIGINT UNSIGNED NOT NULL COMMENT '系列ID',
+    `user_id` BIGINT UNSIGNED NOT NULL COMMENT '用户ID',
+    `status` TINYINT NOT NULL DEFAULT 1 COMMENT '状态: 1整期报名中 0已退出',
+    `created_at` BIGINT NOT NULL DEFAULT 0 COMMENT '创建时间',
+    `updated_at` BIGINT NOT NULL DEFAULT 0 COMMENT '更新时间',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_series_user` (`series_id`, `user_id`),
+    KEY `idx_user_id` (`user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='整期报名表';
+
+-- 21. activity_series_enrollments 整期报名单场出票记录
+-- 每个 (场次, 用户) 只处理一次，失败或用户取消单场报名后不再重复报名
+CREATE TABLE IF NOT EXISTS `activity_series_enrollments` (
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT 'ID',
+    `series_id` BIGINT UNSIGNED NOT NULL COMMENT '系列ID',
+    `activity_id` BIGINT UNSIGNED NOT NULL COMMENT '场次活动ID',
+    `user_id` BIGINT UNSIGNED NOT NULL COMMENT '用户ID',
+    `result` TINYINT NOT NULL COMMENT '结果: 1已出票 2失败',
+    `reason` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '失败原因',
+    `created_at` BIGINT NOT NULL DEFAULT 0 COMMENT '处理时间',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_activity_user` (`activity_id`, `user_id`),
+    KEY `idx_series_id` (`series_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='整期报名单场出票记录';
+
+-- 22. calendar_feed_tokens 日历订阅令牌表
+-- 订阅地址不携带登录态，以随机令牌识别用户；重置令牌后旧地址立即失效
+CREATE TABLE IF NOT EXISTS `calendar_feed_tokens` (
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT 'ID',
+    `user_id` BIGINT UNSIGNED NOT NULL COMMENT '用户ID',
+    `token` VARCHAR(64) NOT NULL COMMENT '订阅令牌',
+    `created_at` BIGINT NOT NULL DEFAULT 0 COMMENT '创建时间',
+    `updated_at` BIGINT NOT NULL DEFAULT 0 COMMENT '更新时间',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_user_id` (`user_id`),
+    UNIQUE KEY `uk_token` (`token`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='日历订阅令牌表';
+
+-- 23. activity_self_check_in_settings 自助签到设置表
+-- code_secret 为现场签到码派生密钥，重新开启自助签到时更换
+CREATE TABLE IF NOT EXISTS `activity_self_check_in_settings` (
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT 'ID',
+    `activity_id` BIGINT UNSIGNED NOT NULL COMMENT '活动ID',
+    `enabled` TINYINT(1) NOT NULL DEFAULT 0 COMMENT '是否开启',
+    `radius_meters` INT NOT NULL COMMENT '签到半径(米)',
+    `code_secret` VARCHAR(64) NOT NULL COMMENT '签到码密钥',
+    `operator_id` BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '最后设置人',
+    `created_at` BIGINT NOT NULL DEFAULT 0 COMMENT '创建时间',
+    `updated_at` BIGINT NOT NULL DEFAULT 0 COMMENT '更新时间',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_activity_id` (`activity_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='自助签到设置表';
+
+-- 24. activity_templates 活动模板表
+-- 时间以相对活动开始时间的秒数保存，使用模板时按新的开始时间推算；
+-- 封面图片被模板引用期间计入 sys_images.ref_count
+CREATE TABLE IF NOT EXISTS `activity_templates` (
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT 'ID',
+    `scope` TINYINT NOT NULL COMMENT '范围: 1个人 2公共',
+    `category_id` BIGINT UNSIGNED NOT NULL COMMENT '分类ID',
+    `owner_id` BIGINT UNSIGNED NOT NULL COMMENT '创建者ID(公共模板为管理员)',
+    `name` VARCHAR(50) NOT NULL COMMENT '模板名称',
+    `status` TINYINT NOT NULL DEFAULT 1 COMMENT '状态: 1可用 0已下架',
+    `source_activity_id` BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '来源活动ID',
+    `use_count` BIGINT NOT NULL DEFAULT 0 COMMENT '使用次数',
+    `duration_seconds` BIGINT NOT NULL COMMENT '活动时长(秒)',
+    `register_open_before` BIGINT NOT NULL COMMENT '开始前多久开放报名(秒)',
+    `register_close_before` BIGINT NOT NULL COMMENT '开始前多久截止报名(秒)',
+    `title` VARCHAR(100) NOT NULL COMMENT '活动标题',
+    `description` TEXT COMMENT '活动详情',
+    `cover_image_id` BIGINT NOT NULL DEFAULT 0 COMMENT '封面图片ID',
+    `cover_url` VARCHAR(500) NOT NULL DEFAULT '' COMMENT '封面URL快照(列表展示)',
+    `cover_type` TINYINT NOT NULL DEFAULT 1 COMMENT '封面类型',
+    `contact_phone` VARCHAR(20) NOT NULL DEFAULT '' COMMENT '联系电话',
+    `location` VARCHAR(200) NOT NULL COMMENT '活动地点',
+    `address_detail` VARCHAR(500) NOT NULL DEFAULT '' COMMENT '详细地址',
+    `longitude` DECIMAL(10,7) NOT NULL DEFAULT 0 COMMENT '经度',
+    `latitude` DECIMAL(10,7) NOT NULL DEFAULT 0 COMMENT '纬度',
+    `max_participants` INT UNSIGNED NOT NULL DEFAULT 0 COMMENT '人数上限',
+    `require_approval` TINYINT(1) NOT NULL DEFAULT 0 COMMENT '报名是否需要审核',
+    `require_student_verify` TINYINT(1) NOT NULL DEFAULT 0 COMMENT '是否需要学生认证',
+    `min_credit_score` INT NOT NULL DEFAULT 0 COMMENT '最低信用分',
+    `tag_ids` VARCHAR(100) NOT NULL DEFAULT '' COMMENT '标签ID(JSON)',
+    `eligibility_rules` TEXT COMMENT '报名资格规则(JSON)',
+    `created_at` BIGINT NOT NULL DEFAULT 0 COMMENT '创建时间',
+    `updated_at` BIGINT NOT NULL DEFAULT 0 COMMENT '更新时间',
+    PRIMARY KEY (`id`),
+    KEY `idx_scope_category` (`scope`, `category_id`),
+    KEY `idx_owner` (`owner_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='活动模板表';
+
+-- 25. activity_daily_stats 活动每日统计表
+-- 由 AnalyticsCron 聚合浏览埋点与报名、签到记录写入，按 (活动, 日期) 幂等覆盖；
+-- stat_date 为服务器本地时区日期 YYYY-MM-DD
+CREATE TABLE IF NOT EXISTS `activity_daily_stats` (
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT 'ID',
+    `activity_id` BIGINT UNSIGNED NOT NULL COMMENT '活动ID',
+    `stat_date` CHAR(10) NOT NULL COMMENT '统计日期',
+    `organizer_id` BIGINT UNSIGNED NOT NULL COMMENT '组织者ID',
+    `pv` BIGINT NOT NULL DEFAULT 0 COMMENT '浏览次数',
+    `uv` BIGINT NOT NULL DEFAULT 0 COMMENT '浏览人数',
+    `registrations` BIGINT NOT NULL DEFAULT 0 COMMENT '报名数',
+    `cancellations` BIGINT NOT NULL DEFAULT 0 COMMENT '取消数',
+    `check_ins` BIGINT NOT NULL DEFAULT 0 COMMENT '签到数',
+    `updated_at` BIGINT NOT NULL DEFAULT 0 COMMENT '更新时间',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_activity_date` (`activity_id`, `stat_date`),
+    KEY `idx_organizer_date` (`organizer_id`, `stat_date`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='活动每日统计表';
+
+-- 26. activity_stats_summary 活动累计统计表
+-- 组织者数据看板的漏斗、报名速度与到场率，每次聚合时按活动整体重算
+CREATE TABLE IF NOT EXISTS `activity_stats_summary` (
+    `activity_id` BIGINT UNSIGNED NOT NULL COMMENT '活动ID',
+    `organizer_id` BIGINT UNSIGNED NOT NULL COMMENT '组织者ID',
+    `pv` BIGINT NOT NULL DEFAULT 0 COMMENT '累计浏览次数',
+    `uv` BIGINT NOT NULL DEFAULT 0 COMMENT '累计浏览人数',
+    `registrations` BIGINT NOT NULL DEFAULT 0 COMMENT '累计报名数(含已取消)',
+    `cancellations` BIGINT NOT NULL DEFAULT 0 COMMENT '取消数',
+    `active_registrations` BIGINT NOT NULL DEFAULT 0 COMMENT '有效报名数',
+    `check_ins` BIGINT NOT NULL DEFAULT 0 COMMENT '签到人数',
+    `no_shows` BIGINT NOT NULL DEFAULT 0 COMMENT '未到场人数(活动结束后统计)',
+    `attendance_rate` DECIMAL(5,4) NOT NULL DEFAULT 0 COMMENT '到场率',
+    `first_24h_registrations` BIGINT NOT NULL DEFAULT 0 COMMENT '开放报名24小时内报名数',
+    `peak_daily_registrations` BIGINT NOT NULL DEFAULT 0 COMMENT '单日最高报名数',
+    `avg_daily_registrations` DECIMAL(10,2) NOT NULL DEFAULT 0 COMMENT '报名期日均报名数',
+    `verified_registrants` BIGINT NOT NULL DEFAULT 0 COMMENT '已认证报名人数',
+    `stat_date` CHAR(10) NOT NULL DEFAULT '' COMMENT '最近聚合日期',
+    `updated_at` BIGINT NOT NULL DEFAULT 0 COMMENT '更新时间',
+    PRIMARY KEY (`activity_id`),
+    KEY `idx_organizer` (`organizer_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='活动累计统计表';
+
+-- 27. activity_audience_stats 报名者构成统计表
+-- 有效报名者中已通过学生认证用户的院系、入学年份分布（由用户服务批量查询认证画像）
+CREATE TABLE IF NOT EXISTS `activity_audience_stats` (
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT 'ID',
+    `activity_id` BIGINT UNSIGNED NOT NULL COMMENT '活动ID',
+    `dimension` VARCHAR(20) NOT NULL COMMENT '维度: department/admission_year',
+    `value` VARCHAR(100) NOT NULL COMMENT '维度取值',
+    `organizer_id` BIGINT UNSIGNED NOT NULL COMMENT '组织者ID',
+    `count` BIGINT NOT NULL DEFAULT 0 COMMENT '人数',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_activity_dimension_value` (`activity_id`, `dimension`, `value`),
+    KEY `idx_organizer` (`organizer_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='报名者构成统计表';
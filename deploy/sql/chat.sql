@@ -24,6 +24,9 @@ CREATE TABLE IF NOT EXISTS `groups` (
     `status` TINYINT NOT NULL DEFAULT 1 COMMENT '状态: 1-正常 2-已解散',
     `max_members` INT NOT NULL COMMENT '最大成员数',
     `member_count` INT NOT NULL DEFAULT 0 COMMENT '当前成员数量',
+    `all_muted` TINYINT(1) NOT NULL DEFAULT 0 COMMENT '是否全员禁言: 0-否 1-是（群主/管理员除外）',
+    `announcement` VARCHAR(1000) NOT NULL DEFAULT '' COMMENT '群公告',
+    `announcement_at` DATETIME DEFAULT NULL COMMENT '公告更新时间',
     `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
     `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
     `deleted_at` DATETIME DEFAULT NULL COMMENT '删除时间',
@@ -41,10 +44,11 @@ CREATE TABLE IF NOT EXISTS `group_members` (
     `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '自增主键',
     `group_id` VARCHAR(64) NOT NULL COMMENT '群聊ID',
     `user_id` BIGINT UNSIGNED NOT NULL COMMENT '用户ID',
-    `role` TINYINT NOT NULL DEFAULT 1 COMMENT '角色: 1-普通成员 2-群主',
+    `role` TINYINT NOT NULL DEFAULT 1 COMMENT '角色: 1-普通成员 2-群主 3-管理员',
     `status` TINYINT NOT NULL DEFAULT 1 COMMENT '状态: 1-正常 2-已退出',
     `joined_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '加入时间',
     `left_at` DATETIME DEFAULT NULL COMMENT '退出时间',
+    `muted_until` DATETIME DEFAULT NULL COMMENT '禁言截止时间（NULL 表示未禁言）',
     PRIMARY KEY (`id`),
     UNIQUE KEY `uk_group_user` (`group_id`, `user_id`),
     KEY `idx_user_id` (`user_id`),
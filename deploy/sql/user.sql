@@ -59,37 +59,108 @@ CREATE TABLE `credit_logs` (
     KEY `idx_user_id` (`user_id`)
 ) ENGINE=InnoDB AUTO_INCREMENT=1 COMMENT='信用变更记录表';
 
+-- 2.1 credit_appeals 信用申诉表（用户对扣分记录提出申诉，管理员处理）
+CREATE TABLE `credit_appeals` (
+    `id` bigint NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+    `user_id` bigint NOT NULL COMMENT '申诉用户ID',
+    `credit_log_id` bigint NOT NULL COMMENT '被申诉的信用变更记录ID',
+    `reason` varchar(500) NOT NULL COMMENT '申诉理由',
+    `status` tinyint NOT NULL DEFAULT 1 COMMENT '状态：1待处理 2已通过 3已驳回',
+    `reviewer_id` bigint NOT NULL DEFAULT 0 COMMENT '处理人（管理员）ID',
+    `review_note` varchar(500) DEFAULT NULL COMMENT '处理意见',
+    `reviewed_at` datetime DEFAULT NULL COMMENT '处理时间',
+    `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    `updated_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_credit_log_id` (`credit_log_id`),
+    KEY `idx_user_status` (`user_id`, `status`),
+    KEY `idx_status` (`status`)
+) ENGINE=InnoDB AUTO_INCREMENT=1 COMMENT='信用申诉表';
+
+-- 2.2 credit_audit_logs 信用管理审计日志表（管理员手动调整、申诉处理，只增不改）
+CREATE TABLE `credit_audit_logs` (
+    `id` bigint NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+    `operator_id` bigint NOT NULL COMMENT '操作人（管理员）ID',
+    `user_id` bigint NOT NULL COMMENT '被操作用户ID',
+    `action` varchar(32) NOT NULL COMMENT '操作类型：adjust/appeal_accept/appeal_reject',
+    `target_id` bigint NOT NULL DEFAULT 0 COMMENT '关联对象ID（申诉ID）',
+    `source_id` varchar(128) DEFAULT NULL COMMENT '关联的信用变更来源ID',
+    `delta` int NOT NULL DEFAULT 0 COMMENT '实际分数变动',
+    `before_score` int NOT NULL DEFAULT 0 COMMENT '变更前分数',
+    `after_score` int NOT NULL DEFAULT 0 COMMENT '变更后分数',
+    `reason` varchar(500) DEFAULT NULL COMMENT '操作原因/备注',
+    `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    PRIMARY KEY (`id`),
+    KEY `idx_operator_id` (`operator_id`),
+    KEY `idx_user_id` (`user_id`)
+) ENGINE=InnoDB AUTO_INCREMENT=1 COMMENT='信用管理审计日志表';
+
 -- 3. student_verifications 学生认证表（VerifyService依赖）
--- 状态机：0初始 1OCR中 2待确认 3人工审核 4通过 5拒绝 6超时 7取消 8OCR失败
+-- 状态机：0初始 1OCR中 2待确认 3人工审核 4通过 5拒绝 6超时 7取消 8OCR失败 9过期
 CREATE TABLE `student_verifications` (
     `id` bigint NOT NULL AUTO_INCREMENT COMMENT '主键ID',
     `user_id` bigint NOT NULL COMMENT '用户ID',
-    `status` tinyint NOT NULL DEFAULT 0 COMMENT '认证状态：0初始 1OCR中 2待确认 3人工审核 4通过 5拒绝 6超时 7取消 8OCR失败',
-    `real_name` varchar(255) COMMENT '真实姓名（AES-256-GCM密文）',
+    `status` tinyint NOT NULL DEFAULT 0 COMMENT '认证状态：0初始 1OCR中 2待确认 3人工审核 4通过 5拒绝 6超时 7取消 8OCR失败 9过期',
+    `real_name` varchar(255) COMMENT '真实姓名（AES-256-GCM密文，enc:v2:{keyId}:...）',
     `school_name` varchar(100) COMMENT '学校名称',
-    `student_id` varchar(255) COMMENT '学号（AES-256-GCM密文）',
+    `student_id` varchar(255) COMMENT '学号（AES-256-GCM密文，enc:v2:{keyId}:...）',
     `student_id_hash` varchar(64) NOT NULL DEFAULT '' COMMENT '学号哈希（HMAC-SHA256，用于索引查找）',
+    `hash_key_id` varchar(16) NOT NULL DEFAULT '' COMMENT '学号哈希所用密钥ID（空表示旧版单密钥）',
     `department` varchar(100) COMMENT '院系',
     `admission_year` varchar(10) COMMENT '入学年份',
     `front_image_url` varchar(500) DEFAULT '' COMMENT '学生证正面图片URL',
     `back_image_url` varchar(500) DEFAULT '' COMMENT '学生证详情面图片URL',
-    `ocr_platform` varchar(20) NOT NULL DEFAULT '' COMMENT 'OCR平台：tencent/aliyun',
+    `ocr_platform` varchar(20) NOT NULL DEFAULT '' COMMENT 'OCR平台：tencent/aliyun/local',
     `ocr_raw_json` text COMMENT 'OCR原始响应JSON（用于审计追溯）',
     `ocr_confidence` decimal(5,2) COMMENT 'OCR识别置信度（0-100）',
+    `policy_decision` varchar(20) NOT NULL DEFAULT '' COMMENT '判定策略结论：approve/confirm/manual_review/reject',
+    `policy_score` decimal(5,2) COMMENT '判定策略总分（0-100）',
+    `policy_detail` text COMMENT '判定策略明细JSON（各字段得分与原因）',
     `reject_reason` varchar(255) COMMENT '拒绝原因',
     `cancel_reason` varchar(255) COMMENT '取消原因',
     `reviewer_id` bigint COMMENT '审核人ID（人工审核时）',
-    `operator` varchar(50) COMMENT '操作来源：user_apply/ocr_callback/manual_review/timeout_job',
+    `operator` varchar(50) COMMENT '操作来源：user_apply/ocr_callback/manual_review/timeout_job/expiry_job/auto_policy',
     `verified_at` datetime COMMENT '认证通过时间',
     `ocr_completed_at` datetime COMMENT 'OCR完成时间',
     `reviewed_at` datetime COMMENT '人工审核时间',
+    `expire_at` datetime DEFAULT NULL COMMENT '认证过期时间（按入学年份+学制推算的预计毕业时间）',
+    `expiry_reminded_at` datetime DEFAULT NULL COMMENT '过期提醒发送时间',
     `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
     `updated_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
     PRIMARY KEY (`id`),
     UNIQUE KEY `uk_user_id` (`user_id`),
-    UNIQUE KEY `uk_school_student_hash` (`school_name`, `student_id_hash`)
+    UNIQUE KEY `uk_school_student_hash` (`school_name`, `student_id_hash`),
+    KEY `idx_status_expire` (`status`, `expire_at`)
 ) ENGINE=InnoDB COMMENT='学生认证表';
 
+-- 3.1 verify_review_logs 学生认证人工审核日志表（查看/领取/释放/通过/拒绝，只增不改）
+CREATE TABLE `verify_review_logs` (
+    `id` bigint NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+    `verify_id` bigint NOT NULL COMMENT '认证记录ID',
+    `user_id` bigint NOT NULL COMMENT '被审核用户ID',
+    `reviewer_id` bigint NOT NULL COMMENT '审核员ID',
+    `action` varchar(20) NOT NULL COMMENT '操作类型：view/claim/release/approve/reject/auto_approve/auto_review/auto_reject',
+    `reason` varchar(255) DEFAULT NULL COMMENT '备注（拒绝原因等）',
+    `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    PRIMARY KEY (`id`),
+    KEY `idx_verify_id` (`verify_id`),
+    KEY `idx_reviewer_created` (`reviewer_id`, `created_at`)
+) ENGINE=InnoDB AUTO_INCREMENT=1 COMMENT='学生认证人工审核日志表';
+
+-- 4. stats_daily_metrics 平台统计日指标表（管理后台运营报表，各服务定时任务按天汇总写入）
+-- 用户服务汇总日活/新注册/信用等级分布/认证通过率/OCR调用量，活动、聊天服务通过 PlatformStatsService 上报
+CREATE TABLE `stats_daily_metrics` (
+    `id` bigint NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+    `stat_date` char(10) NOT NULL COMMENT '统计日期 yyyy-MM-dd',
+    `metric` varchar(50) NOT NULL COMMENT '指标名称：dau/new_users/credit_level/verify_pass_rate/ocr_calls/activity_published/chat_messages 等',
+    `dimension` varchar(64) NOT NULL DEFAULT '' COMMENT '维度（为空表示总量，如活动分类、OCR提供商、消息类型）',
+    `value` double NOT NULL DEFAULT 0 COMMENT '数值',
+    `source` varchar(20) NOT NULL COMMENT '数据来源服务：user/activity/chat',
+    `updated_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_date_metric_dim` (`stat_date`, `metric`, `dimension`)
+) ENGINE=InnoDB AUTO_INCREMENT=1 COMMENT='平台统计日指标表';
+
 
 CREATE TABLE `interest_tags` (
     `tag_id` INT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '标签主键ID，自增',
@@ -99,6 +170,7 @@ CREATE TABLE `interest_tags` (
     `usage_count` INT UNSIGNED DEFAULT 0 COMMENT '标签被用户使用的总次数',
     `status` TINYINT UNSIGNED DEFAULT 1 COMMENT '标签状态：0-禁用，1-正常',
     `tag_desc` VARCHAR(200) DEFAULT '' COMMENT '标签描述（可选，如：运动包含跑步、篮球等）',
+    `merged_into` INT UNSIGNED NOT NULL DEFAULT 0 COMMENT '被合并到的标签ID（0-未合并，合并后本标签禁用）',
     `create_time` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '标签创建时间',
     `update_time` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '标签更新时间',
     PRIMARY KEY (`tag_id`),